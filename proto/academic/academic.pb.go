@@ -32,6 +32,9 @@ type Semester struct {
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	CreatedBy     string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	UpdatedBy     string                 `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       int32                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`                     // bumped by every update
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // set while the record is soft-deleted
+	DeletedBy     string                 `protobuf:"bytes,9,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -108,6 +111,27 @@ func (x *Semester) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *Semester) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Semester) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Semester) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
 type CreateSemesterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -205,10 +229,11 @@ func (x *CreateSemesterResponse) GetSemester() *Semester {
 }
 
 type GetSemesterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also find a soft-deleted record
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetSemesterRequest) Reset() {
@@ -248,6 +273,13 @@ func (x *GetSemesterRequest) GetId() string {
 	return ""
 }
 
+func (x *GetSemesterRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type GetSemesterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Semester      *Semester              `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
@@ -297,6 +329,7 @@ type UpdateSemesterRequest struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
 	UpdatedBy     string                 `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       *int32                 `protobuf:"varint,4,opt,name=version,proto3,oneof" json:"version,omitempty"` // expected version; a stale one fails with ABORTED
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -352,6 +385,13 @@ func (x *UpdateSemesterRequest) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *UpdateSemesterRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
 type UpdateSemesterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Semester      *Semester              `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
@@ -399,6 +439,7 @@ func (x *UpdateSemesterResponse) GetSemester() *Semester {
 type DeleteSemesterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -440,6 +481,13 @@ func (x *DeleteSemesterRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteSemesterRequest) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
 type DeleteSemesterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -484,16 +532,113 @@ func (x *DeleteSemesterResponse) GetSuccess() bool {
 	return false
 }
 
-type ListSemestersRequest struct {
+type RestoreSemesterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RestoredBy    string                 `protobuf:"bytes,2,opt,name=restored_by,json=restoredBy,proto3" json:"restored_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *RestoreSemesterRequest) Reset() {
+	*x = RestoreSemesterRequest{}
+	mi := &file_proto_academic_academic_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreSemesterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSemesterRequest) ProtoMessage() {}
+
+func (x *RestoreSemesterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_academic_academic_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSemesterRequest.ProtoReflect.Descriptor instead.
+func (*RestoreSemesterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RestoreSemesterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreSemesterRequest) GetRestoredBy() string {
+	if x != nil {
+		return x.RestoredBy
+	}
+	return ""
+}
+
+type RestoreSemesterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Semester      *Semester              `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreSemesterResponse) Reset() {
+	*x = RestoreSemesterResponse{}
+	mi := &file_proto_academic_academic_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreSemesterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSemesterResponse) ProtoMessage() {}
+
+func (x *RestoreSemesterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_academic_academic_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSemesterResponse.ProtoReflect.Descriptor instead.
+func (*RestoreSemesterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RestoreSemesterResponse) GetSemester() *Semester {
+	if x != nil {
+		return x.Semester
+	}
+	return nil
+}
+
+type ListSemestersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Search         *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also list soft-deleted records
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *ListSemestersRequest) Reset() {
 	*x = ListSemestersRequest{}
-	mi := &file_proto_academic_academic_proto_msgTypes[9]
+	mi := &file_proto_academic_academic_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -505,7 +650,7 @@ func (x *ListSemestersRequest) String() string {
 func (*ListSemestersRequest) ProtoMessage() {}
 
 func (x *ListSemestersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[9]
+	mi := &file_proto_academic_academic_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -518,7 +663,7 @@ func (x *ListSemestersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSemestersRequest.ProtoReflect.Descriptor instead.
 func (*ListSemestersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{9}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListSemestersRequest) GetSearch() *common.SearchRequest {
@@ -528,6 +673,13 @@ func (x *ListSemestersRequest) GetSearch() *common.SearchRequest {
 	return nil
 }
 
+func (x *ListSemestersRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type ListSemestersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Semesters     []*Semester            `protobuf:"bytes,1,rep,name=semesters,proto3" json:"semesters,omitempty"`
@@ -540,7 +692,7 @@ type ListSemestersResponse struct {
 
 func (x *ListSemestersResponse) Reset() {
 	*x = ListSemestersResponse{}
-	mi := &file_proto_academic_academic_proto_msgTypes[10]
+	mi := &file_proto_academic_academic_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -552,7 +704,7 @@ func (x *ListSemestersResponse) String() string {
 func (*ListSemestersResponse) ProtoMessage() {}
 
 func (x *ListSemestersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[10]
+	mi := &file_proto_academic_academic_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -565,7 +717,7 @@ func (x *ListSemestersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSemestersResponse.ProtoReflect.Descriptor instead.
 func (*ListSemestersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{10}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListSemestersResponse) GetSemesters() []*Semester {
@@ -605,13 +757,16 @@ type Faculty struct {
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	CreatedBy     string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	UpdatedBy     string                 `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       int32                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`                     // bumped by every update
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // set while the record is soft-deleted
+	DeletedBy     string                 `protobuf:"bytes,9,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Faculty) Reset() {
 	*x = Faculty{}
-	mi := &file_proto_academic_academic_proto_msgTypes[11]
+	mi := &file_proto_academic_academic_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -623,7 +778,7 @@ func (x *Faculty) String() string {
 func (*Faculty) ProtoMessage() {}
 
 func (x *Faculty) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[11]
+	mi := &file_proto_academic_academic_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -636,7 +791,7 @@ func (x *Faculty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Faculty.ProtoReflect.Descriptor instead.
 func (*Faculty) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{11}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Faculty) GetId() string {
@@ -681,6 +836,27 @@ func (x *Faculty) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *Faculty) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Faculty) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Faculty) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
 type CreateFacultyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -691,7 +867,7 @@ type CreateFacultyRequest struct {
 
 func (x *CreateFacultyRequest) Reset() {
 	*x = CreateFacultyRequest{}
-	mi := &file_proto_academic_academic_proto_msgTypes[12]
+	mi := &file_proto_academic_academic_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -703,7 +879,7 @@ func (x *CreateFacultyRequest) String() string {
 func (*CreateFacultyRequest) ProtoMessage() {}
 
 func (x *CreateFacultyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[12]
+	mi := &file_proto_academic_academic_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -716,7 +892,7 @@ func (x *CreateFacultyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFacultyRequest.ProtoReflect.Descriptor instead.
 func (*CreateFacultyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{12}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CreateFacultyRequest) GetTitle() string {
@@ -742,7 +918,7 @@ type CreateFacultyResponse struct {
 
 func (x *CreateFacultyResponse) Reset() {
 	*x = CreateFacultyResponse{}
-	mi := &file_proto_academic_academic_proto_msgTypes[13]
+	mi := &file_proto_academic_academic_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -754,7 +930,7 @@ func (x *CreateFacultyResponse) String() string {
 func (*CreateFacultyResponse) ProtoMessage() {}
 
 func (x *CreateFacultyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[13]
+	mi := &file_proto_academic_academic_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -767,7 +943,7 @@ func (x *CreateFacultyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFacultyResponse.ProtoReflect.Descriptor instead.
 func (*CreateFacultyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{13}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CreateFacultyResponse) GetFaculty() *Faculty {
@@ -778,15 +954,16 @@ func (x *CreateFacultyResponse) GetFaculty() *Faculty {
 }
 
 type GetFacultyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also find a soft-deleted record
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetFacultyRequest) Reset() {
 	*x = GetFacultyRequest{}
-	mi := &file_proto_academic_academic_proto_msgTypes[14]
+	mi := &file_proto_academic_academic_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -798,7 +975,7 @@ func (x *GetFacultyRequest) String() string {
 func (*GetFacultyRequest) ProtoMessage() {}
 
 func (x *GetFacultyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[14]
+	mi := &file_proto_academic_academic_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -811,7 +988,7 @@ func (x *GetFacultyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFacultyRequest.ProtoReflect.Descriptor instead.
 func (*GetFacultyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{14}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetFacultyRequest) GetId() string {
@@ -821,6 +998,13 @@ func (x *GetFacultyRequest) GetId() string {
 	return ""
 }
 
+func (x *GetFacultyRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type GetFacultyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Faculty       *Faculty               `protobuf:"bytes,1,opt,name=faculty,proto3" json:"faculty,omitempty"`
@@ -830,7 +1014,7 @@ type GetFacultyResponse struct {
 
 func (x *GetFacultyResponse) Reset() {
 	*x = GetFacultyResponse{}
-	mi := &file_proto_academic_academic_proto_msgTypes[15]
+	mi := &file_proto_academic_academic_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -842,7 +1026,7 @@ func (x *GetFacultyResponse) String() string {
 func (*GetFacultyResponse) ProtoMessage() {}
 
 func (x *GetFacultyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[15]
+	mi := &file_proto_academic_academic_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -855,7 +1039,7 @@ func (x *GetFacultyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFacultyResponse.ProtoReflect.Descriptor instead.
 func (*GetFacultyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{15}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetFacultyResponse) GetFaculty() *Faculty {
@@ -870,13 +1054,14 @@ type UpdateFacultyRequest struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
 	UpdatedBy     string                 `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       *int32                 `protobuf:"varint,4,opt,name=version,proto3,oneof" json:"version,omitempty"` // expected version; a stale one fails with ABORTED
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateFacultyRequest) Reset() {
 	*x = UpdateFacultyRequest{}
-	mi := &file_proto_academic_academic_proto_msgTypes[16]
+	mi := &file_proto_academic_academic_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -888,7 +1073,7 @@ func (x *UpdateFacultyRequest) String() string {
 func (*UpdateFacultyRequest) ProtoMessage() {}
 
 func (x *UpdateFacultyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[16]
+	mi := &file_proto_academic_academic_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -901,7 +1086,7 @@ func (x *UpdateFacultyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFacultyRequest.ProtoReflect.Descriptor instead.
 func (*UpdateFacultyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{16}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdateFacultyRequest) GetId() string {
@@ -925,6 +1110,13 @@ func (x *UpdateFacultyRequest) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *UpdateFacultyRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
 type UpdateFacultyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Faculty       *Faculty               `protobuf:"bytes,1,opt,name=faculty,proto3" json:"faculty,omitempty"`
@@ -934,7 +1126,7 @@ type UpdateFacultyResponse struct {
 
 func (x *UpdateFacultyResponse) Reset() {
 	*x = UpdateFacultyResponse{}
-	mi := &file_proto_academic_academic_proto_msgTypes[17]
+	mi := &file_proto_academic_academic_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -946,7 +1138,7 @@ func (x *UpdateFacultyResponse) String() string {
 func (*UpdateFacultyResponse) ProtoMessage() {}
 
 func (x *UpdateFacultyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[17]
+	mi := &file_proto_academic_academic_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,7 +1151,7 @@ func (x *UpdateFacultyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFacultyResponse.ProtoReflect.Descriptor instead.
 func (*UpdateFacultyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{17}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UpdateFacultyResponse) GetFaculty() *Faculty {
@@ -972,13 +1164,14 @@ func (x *UpdateFacultyResponse) GetFaculty() *Faculty {
 type DeleteFacultyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteFacultyRequest) Reset() {
 	*x = DeleteFacultyRequest{}
-	mi := &file_proto_academic_academic_proto_msgTypes[18]
+	mi := &file_proto_academic_academic_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -990,7 +1183,7 @@ func (x *DeleteFacultyRequest) String() string {
 func (*DeleteFacultyRequest) ProtoMessage() {}
 
 func (x *DeleteFacultyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[18]
+	mi := &file_proto_academic_academic_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1003,7 +1196,7 @@ func (x *DeleteFacultyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFacultyRequest.ProtoReflect.Descriptor instead.
 func (*DeleteFacultyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{18}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DeleteFacultyRequest) GetId() string {
@@ -1013,6 +1206,13 @@ func (x *DeleteFacultyRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteFacultyRequest) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
 type DeleteFacultyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -1022,7 +1222,7 @@ type DeleteFacultyResponse struct {
 
 func (x *DeleteFacultyResponse) Reset() {
 	*x = DeleteFacultyResponse{}
-	mi := &file_proto_academic_academic_proto_msgTypes[19]
+	mi := &file_proto_academic_academic_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1034,7 +1234,7 @@ func (x *DeleteFacultyResponse) String() string {
 func (*DeleteFacultyResponse) ProtoMessage() {}
 
 func (x *DeleteFacultyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[19]
+	mi := &file_proto_academic_academic_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1047,7 +1247,7 @@ func (x *DeleteFacultyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFacultyResponse.ProtoReflect.Descriptor instead.
 func (*DeleteFacultyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{19}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DeleteFacultyResponse) GetSuccess() bool {
@@ -1057,16 +1257,113 @@ func (x *DeleteFacultyResponse) GetSuccess() bool {
 	return false
 }
 
-type ListFacultiesRequest struct {
+type RestoreFacultyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RestoredBy    string                 `protobuf:"bytes,2,opt,name=restored_by,json=restoredBy,proto3" json:"restored_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreFacultyRequest) Reset() {
+	*x = RestoreFacultyRequest{}
+	mi := &file_proto_academic_academic_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreFacultyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFacultyRequest) ProtoMessage() {}
+
+func (x *RestoreFacultyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_academic_academic_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreFacultyRequest.ProtoReflect.Descriptor instead.
+func (*RestoreFacultyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RestoreFacultyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreFacultyRequest) GetRestoredBy() string {
+	if x != nil {
+		return x.RestoredBy
+	}
+	return ""
+}
+
+type RestoreFacultyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	Faculty       *Faculty               `protobuf:"bytes,1,opt,name=faculty,proto3" json:"faculty,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *RestoreFacultyResponse) Reset() {
+	*x = RestoreFacultyResponse{}
+	mi := &file_proto_academic_academic_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreFacultyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFacultyResponse) ProtoMessage() {}
+
+func (x *RestoreFacultyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_academic_academic_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreFacultyResponse.ProtoReflect.Descriptor instead.
+func (*RestoreFacultyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RestoreFacultyResponse) GetFaculty() *Faculty {
+	if x != nil {
+		return x.Faculty
+	}
+	return nil
+}
+
+type ListFacultiesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Search         *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also list soft-deleted records
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *ListFacultiesRequest) Reset() {
 	*x = ListFacultiesRequest{}
-	mi := &file_proto_academic_academic_proto_msgTypes[20]
+	mi := &file_proto_academic_academic_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1078,7 +1375,7 @@ func (x *ListFacultiesRequest) String() string {
 func (*ListFacultiesRequest) ProtoMessage() {}
 
 func (x *ListFacultiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[20]
+	mi := &file_proto_academic_academic_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1091,7 +1388,7 @@ func (x *ListFacultiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFacultiesRequest.ProtoReflect.Descriptor instead.
 func (*ListFacultiesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{20}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListFacultiesRequest) GetSearch() *common.SearchRequest {
@@ -1101,6 +1398,13 @@ func (x *ListFacultiesRequest) GetSearch() *common.SearchRequest {
 	return nil
 }
 
+func (x *ListFacultiesRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type ListFacultiesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Faculties     []*Faculty             `protobuf:"bytes,1,rep,name=faculties,proto3" json:"faculties,omitempty"`
@@ -1113,7 +1417,7 @@ type ListFacultiesResponse struct {
 
 func (x *ListFacultiesResponse) Reset() {
 	*x = ListFacultiesResponse{}
-	mi := &file_proto_academic_academic_proto_msgTypes[21]
+	mi := &file_proto_academic_academic_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1125,7 +1429,7 @@ func (x *ListFacultiesResponse) String() string {
 func (*ListFacultiesResponse) ProtoMessage() {}
 
 func (x *ListFacultiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[21]
+	mi := &file_proto_academic_academic_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1138,7 +1442,7 @@ func (x *ListFacultiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFacultiesResponse.ProtoReflect.Descriptor instead.
 func (*ListFacultiesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{21}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ListFacultiesResponse) GetFaculties() []*Faculty {
@@ -1179,13 +1483,16 @@ type Major struct {
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	UpdatedBy     string                 `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       int32                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`                     // bumped by every update
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // set while the record is soft-deleted
+	DeletedBy     string                 `protobuf:"bytes,10,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Major) Reset() {
 	*x = Major{}
-	mi := &file_proto_academic_academic_proto_msgTypes[22]
+	mi := &file_proto_academic_academic_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1197,7 +1504,7 @@ func (x *Major) String() string {
 func (*Major) ProtoMessage() {}
 
 func (x *Major) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[22]
+	mi := &file_proto_academic_academic_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1210,7 +1517,7 @@ func (x *Major) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Major.ProtoReflect.Descriptor instead.
 func (*Major) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{22}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *Major) GetId() string {
@@ -1262,6 +1569,27 @@ func (x *Major) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *Major) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Major) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Major) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
 type CreateMajorRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -1273,7 +1601,7 @@ type CreateMajorRequest struct {
 
 func (x *CreateMajorRequest) Reset() {
 	*x = CreateMajorRequest{}
-	mi := &file_proto_academic_academic_proto_msgTypes[23]
+	mi := &file_proto_academic_academic_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1285,7 +1613,7 @@ func (x *CreateMajorRequest) String() string {
 func (*CreateMajorRequest) ProtoMessage() {}
 
 func (x *CreateMajorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[23]
+	mi := &file_proto_academic_academic_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1298,7 +1626,7 @@ func (x *CreateMajorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMajorRequest.ProtoReflect.Descriptor instead.
 func (*CreateMajorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{23}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *CreateMajorRequest) GetTitle() string {
@@ -1331,7 +1659,7 @@ type CreateMajorResponse struct {
 
 func (x *CreateMajorResponse) Reset() {
 	*x = CreateMajorResponse{}
-	mi := &file_proto_academic_academic_proto_msgTypes[24]
+	mi := &file_proto_academic_academic_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1343,7 +1671,7 @@ func (x *CreateMajorResponse) String() string {
 func (*CreateMajorResponse) ProtoMessage() {}
 
 func (x *CreateMajorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[24]
+	mi := &file_proto_academic_academic_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1356,7 +1684,7 @@ func (x *CreateMajorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMajorResponse.ProtoReflect.Descriptor instead.
 func (*CreateMajorResponse) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{24}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *CreateMajorResponse) GetMajor() *Major {
@@ -1367,15 +1695,16 @@ func (x *CreateMajorResponse) GetMajor() *Major {
 }
 
 type GetMajorRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also find a soft-deleted record
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetMajorRequest) Reset() {
 	*x = GetMajorRequest{}
-	mi := &file_proto_academic_academic_proto_msgTypes[25]
+	mi := &file_proto_academic_academic_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1387,7 +1716,7 @@ func (x *GetMajorRequest) String() string {
 func (*GetMajorRequest) ProtoMessage() {}
 
 func (x *GetMajorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[25]
+	mi := &file_proto_academic_academic_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1400,7 +1729,7 @@ func (x *GetMajorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMajorRequest.ProtoReflect.Descriptor instead.
 func (*GetMajorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{25}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetMajorRequest) GetId() string {
@@ -1410,6 +1739,13 @@ func (x *GetMajorRequest) GetId() string {
 	return ""
 }
 
+func (x *GetMajorRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type GetMajorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Major         *Major                 `protobuf:"bytes,1,opt,name=major,proto3" json:"major,omitempty"`
@@ -1419,7 +1755,7 @@ type GetMajorResponse struct {
 
 func (x *GetMajorResponse) Reset() {
 	*x = GetMajorResponse{}
-	mi := &file_proto_academic_academic_proto_msgTypes[26]
+	mi := &file_proto_academic_academic_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1431,7 +1767,7 @@ func (x *GetMajorResponse) String() string {
 func (*GetMajorResponse) ProtoMessage() {}
 
 func (x *GetMajorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[26]
+	mi := &file_proto_academic_academic_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1444,7 +1780,7 @@ func (x *GetMajorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMajorResponse.ProtoReflect.Descriptor instead.
 func (*GetMajorResponse) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{26}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetMajorResponse) GetMajor() *Major {
@@ -1460,13 +1796,14 @@ type UpdateMajorRequest struct {
 	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
 	FacultyCode   *string                `protobuf:"bytes,3,opt,name=faculty_code,json=facultyCode,proto3,oneof" json:"faculty_code,omitempty"`
 	UpdatedBy     string                 `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       *int32                 `protobuf:"varint,5,opt,name=version,proto3,oneof" json:"version,omitempty"` // expected version; a stale one fails with ABORTED
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateMajorRequest) Reset() {
 	*x = UpdateMajorRequest{}
-	mi := &file_proto_academic_academic_proto_msgTypes[27]
+	mi := &file_proto_academic_academic_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1478,7 +1815,7 @@ func (x *UpdateMajorRequest) String() string {
 func (*UpdateMajorRequest) ProtoMessage() {}
 
 func (x *UpdateMajorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[27]
+	mi := &file_proto_academic_academic_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1491,7 +1828,7 @@ func (x *UpdateMajorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMajorRequest.ProtoReflect.Descriptor instead.
 func (*UpdateMajorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{27}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *UpdateMajorRequest) GetId() string {
@@ -1522,6 +1859,13 @@ func (x *UpdateMajorRequest) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *UpdateMajorRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
 type UpdateMajorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Major         *Major                 `protobuf:"bytes,1,opt,name=major,proto3" json:"major,omitempty"`
@@ -1531,7 +1875,7 @@ type UpdateMajorResponse struct {
 
 func (x *UpdateMajorResponse) Reset() {
 	*x = UpdateMajorResponse{}
-	mi := &file_proto_academic_academic_proto_msgTypes[28]
+	mi := &file_proto_academic_academic_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1543,7 +1887,7 @@ func (x *UpdateMajorResponse) String() string {
 func (*UpdateMajorResponse) ProtoMessage() {}
 
 func (x *UpdateMajorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[28]
+	mi := &file_proto_academic_academic_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1556,7 +1900,7 @@ func (x *UpdateMajorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMajorResponse.ProtoReflect.Descriptor instead.
 func (*UpdateMajorResponse) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{28}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *UpdateMajorResponse) GetMajor() *Major {
@@ -1569,13 +1913,14 @@ func (x *UpdateMajorResponse) GetMajor() *Major {
 type DeleteMajorRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteMajorRequest) Reset() {
 	*x = DeleteMajorRequest{}
-	mi := &file_proto_academic_academic_proto_msgTypes[29]
+	mi := &file_proto_academic_academic_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1587,7 +1932,7 @@ func (x *DeleteMajorRequest) String() string {
 func (*DeleteMajorRequest) ProtoMessage() {}
 
 func (x *DeleteMajorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[29]
+	mi := &file_proto_academic_academic_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1600,7 +1945,7 @@ func (x *DeleteMajorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMajorRequest.ProtoReflect.Descriptor instead.
 func (*DeleteMajorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{29}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *DeleteMajorRequest) GetId() string {
@@ -1610,6 +1955,13 @@ func (x *DeleteMajorRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteMajorRequest) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
 type DeleteMajorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -1619,7 +1971,7 @@ type DeleteMajorResponse struct {
 
 func (x *DeleteMajorResponse) Reset() {
 	*x = DeleteMajorResponse{}
-	mi := &file_proto_academic_academic_proto_msgTypes[30]
+	mi := &file_proto_academic_academic_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1631,7 +1983,7 @@ func (x *DeleteMajorResponse) String() string {
 func (*DeleteMajorResponse) ProtoMessage() {}
 
 func (x *DeleteMajorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[30]
+	mi := &file_proto_academic_academic_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1644,7 +1996,7 @@ func (x *DeleteMajorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMajorResponse.ProtoReflect.Descriptor instead.
 func (*DeleteMajorResponse) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{30}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DeleteMajorResponse) GetSuccess() bool {
@@ -1654,16 +2006,113 @@ func (x *DeleteMajorResponse) GetSuccess() bool {
 	return false
 }
 
-type ListMajorsRequest struct {
+type RestoreMajorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RestoredBy    string                 `protobuf:"bytes,2,opt,name=restored_by,json=restoredBy,proto3" json:"restored_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreMajorRequest) Reset() {
+	*x = RestoreMajorRequest{}
+	mi := &file_proto_academic_academic_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreMajorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreMajorRequest) ProtoMessage() {}
+
+func (x *RestoreMajorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_academic_academic_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreMajorRequest.ProtoReflect.Descriptor instead.
+func (*RestoreMajorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RestoreMajorRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreMajorRequest) GetRestoredBy() string {
+	if x != nil {
+		return x.RestoredBy
+	}
+	return ""
+}
+
+type RestoreMajorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	Major         *Major                 `protobuf:"bytes,1,opt,name=major,proto3" json:"major,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *RestoreMajorResponse) Reset() {
+	*x = RestoreMajorResponse{}
+	mi := &file_proto_academic_academic_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreMajorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreMajorResponse) ProtoMessage() {}
+
+func (x *RestoreMajorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_academic_academic_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreMajorResponse.ProtoReflect.Descriptor instead.
+func (*RestoreMajorResponse) Descriptor() ([]byte, []int) {
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RestoreMajorResponse) GetMajor() *Major {
+	if x != nil {
+		return x.Major
+	}
+	return nil
+}
+
+type ListMajorsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Search         *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also list soft-deleted records
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *ListMajorsRequest) Reset() {
 	*x = ListMajorsRequest{}
-	mi := &file_proto_academic_academic_proto_msgTypes[31]
+	mi := &file_proto_academic_academic_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1675,7 +2124,7 @@ func (x *ListMajorsRequest) String() string {
 func (*ListMajorsRequest) ProtoMessage() {}
 
 func (x *ListMajorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[31]
+	mi := &file_proto_academic_academic_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1688,7 +2137,7 @@ func (x *ListMajorsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMajorsRequest.ProtoReflect.Descriptor instead.
 func (*ListMajorsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{31}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ListMajorsRequest) GetSearch() *common.SearchRequest {
@@ -1698,6 +2147,13 @@ func (x *ListMajorsRequest) GetSearch() *common.SearchRequest {
 	return nil
 }
 
+func (x *ListMajorsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type ListMajorsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Majors        []*Major               `protobuf:"bytes,1,rep,name=majors,proto3" json:"majors,omitempty"`
@@ -1710,7 +2166,7 @@ type ListMajorsResponse struct {
 
 func (x *ListMajorsResponse) Reset() {
 	*x = ListMajorsResponse{}
-	mi := &file_proto_academic_academic_proto_msgTypes[32]
+	mi := &file_proto_academic_academic_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1722,7 +2178,7 @@ func (x *ListMajorsResponse) String() string {
 func (*ListMajorsResponse) ProtoMessage() {}
 
 func (x *ListMajorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_academic_academic_proto_msgTypes[32]
+	mi := &file_proto_academic_academic_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1735,7 +2191,7 @@ func (x *ListMajorsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMajorsResponse.ProtoReflect.Descriptor instead.
 func (*ListMajorsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_academic_academic_proto_rawDescGZIP(), []int{32}
+	return file_proto_academic_academic_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ListMajorsResponse) GetMajors() []*Major {
@@ -1770,7 +2226,7 @@ var File_proto_academic_academic_proto protoreflect.FileDescriptor
 
 const file_proto_academic_academic_proto_rawDesc = "" +
 	"\n" +
-	"\x1dproto/academic/academic.proto\x12\bacademic\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19proto/common/common.proto\"\xe4\x01\n" +
+	"\x1dproto/academic/academic.proto\x12\bacademic\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19proto/common/common.proto\"\xd8\x02\n" +
 	"\bSemester\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x129\n" +
@@ -1781,36 +2237,54 @@ const file_proto_academic_academic_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\x05 \x01(\tR\tcreatedBy\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\x06 \x01(\tR\tupdatedBy\"L\n" +
+	"updated_by\x18\x06 \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\a \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\t \x01(\tR\tdeletedBy\"L\n" +
 	"\x15CreateSemesterRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1d\n" +
 	"\n" +
 	"created_by\x18\x02 \x01(\tR\tcreatedBy\"H\n" +
 	"\x16CreateSemesterResponse\x12.\n" +
-	"\bsemester\x18\x01 \x01(\v2\x12.academic.SemesterR\bsemester\"$\n" +
+	"\bsemester\x18\x01 \x01(\v2\x12.academic.SemesterR\bsemester\"M\n" +
 	"\x12GetSemesterRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"E\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"E\n" +
 	"\x13GetSemesterResponse\x12.\n" +
-	"\bsemester\x18\x01 \x01(\v2\x12.academic.SemesterR\bsemester\"k\n" +
+	"\bsemester\x18\x01 \x01(\v2\x12.academic.SemesterR\bsemester\"\x96\x01\n" +
 	"\x15UpdateSemesterRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\x03 \x01(\tR\tupdatedByB\b\n" +
-	"\x06_title\"H\n" +
+	"updated_by\x18\x03 \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\aversion\x18\x04 \x01(\x05H\x01R\aversion\x88\x01\x01B\b\n" +
+	"\x06_titleB\n" +
+	"\n" +
+	"\b_version\"H\n" +
 	"\x16UpdateSemesterResponse\x12.\n" +
-	"\bsemester\x18\x01 \x01(\v2\x12.academic.SemesterR\bsemester\"'\n" +
+	"\bsemester\x18\x01 \x01(\v2\x12.academic.SemesterR\bsemester\"F\n" +
 	"\x15DeleteSemesterRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"2\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\"2\n" +
 	"\x16DeleteSemesterResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"E\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"I\n" +
+	"\x16RestoreSemesterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrestored_by\x18\x02 \x01(\tR\n" +
+	"restoredBy\"I\n" +
+	"\x17RestoreSemesterResponse\x12.\n" +
+	"\bsemester\x18\x01 \x01(\v2\x12.academic.SemesterR\bsemester\"n\n" +
 	"\x14ListSemestersRequest\x12-\n" +
-	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\"\x90\x01\n" +
+	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"\x90\x01\n" +
 	"\x15ListSemestersResponse\x120\n" +
 	"\tsemesters\x18\x01 \x03(\v2\x12.academic.SemesterR\tsemesters\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xe3\x01\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xd7\x02\n" +
 	"\aFaculty\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x129\n" +
@@ -1821,36 +2295,54 @@ const file_proto_academic_academic_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\x05 \x01(\tR\tcreatedBy\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\x06 \x01(\tR\tupdatedBy\"K\n" +
+	"updated_by\x18\x06 \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\a \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\t \x01(\tR\tdeletedBy\"K\n" +
 	"\x14CreateFacultyRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1d\n" +
 	"\n" +
 	"created_by\x18\x05 \x01(\tR\tcreatedBy\"D\n" +
 	"\x15CreateFacultyResponse\x12+\n" +
-	"\afaculty\x18\x01 \x01(\v2\x11.academic.FacultyR\afaculty\"#\n" +
+	"\afaculty\x18\x01 \x01(\v2\x11.academic.FacultyR\afaculty\"L\n" +
 	"\x11GetFacultyRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"A\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"A\n" +
 	"\x12GetFacultyResponse\x12+\n" +
-	"\afaculty\x18\x01 \x01(\v2\x11.academic.FacultyR\afaculty\"j\n" +
+	"\afaculty\x18\x01 \x01(\v2\x11.academic.FacultyR\afaculty\"\x95\x01\n" +
 	"\x14UpdateFacultyRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\x03 \x01(\tR\tupdatedByB\b\n" +
-	"\x06_title\"D\n" +
+	"updated_by\x18\x03 \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\aversion\x18\x04 \x01(\x05H\x01R\aversion\x88\x01\x01B\b\n" +
+	"\x06_titleB\n" +
+	"\n" +
+	"\b_version\"D\n" +
 	"\x15UpdateFacultyResponse\x12+\n" +
-	"\afaculty\x18\x01 \x01(\v2\x11.academic.FacultyR\afaculty\"&\n" +
+	"\afaculty\x18\x01 \x01(\v2\x11.academic.FacultyR\afaculty\"E\n" +
 	"\x14DeleteFacultyRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\"1\n" +
 	"\x15DeleteFacultyResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"E\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"H\n" +
+	"\x15RestoreFacultyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrestored_by\x18\x02 \x01(\tR\n" +
+	"restoredBy\"E\n" +
+	"\x16RestoreFacultyResponse\x12+\n" +
+	"\afaculty\x18\x01 \x01(\v2\x11.academic.FacultyR\afaculty\"n\n" +
 	"\x14ListFacultiesRequest\x12-\n" +
-	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\"\x8f\x01\n" +
+	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"\x8f\x01\n" +
 	"\x15ListFacultiesResponse\x12/\n" +
 	"\tfaculties\x18\x01 \x03(\v2\x11.academic.FacultyR\tfaculties\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x84\x02\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xf8\x02\n" +
 	"\x05Major\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12!\n" +
@@ -1862,57 +2354,80 @@ const file_proto_academic_academic_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\x06 \x01(\tR\tcreatedBy\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\a \x01(\tR\tupdatedBy\"l\n" +
+	"updated_by\x18\a \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\b \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\n" +
+	" \x01(\tR\tdeletedBy\"l\n" +
 	"\x12CreateMajorRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12!\n" +
 	"\ffaculty_code\x18\x02 \x01(\tR\vfacultyCode\x12\x1d\n" +
 	"\n" +
 	"created_by\x18\x03 \x01(\tR\tcreatedBy\"<\n" +
 	"\x13CreateMajorResponse\x12%\n" +
-	"\x05major\x18\x01 \x01(\v2\x0f.academic.MajorR\x05major\"!\n" +
+	"\x05major\x18\x01 \x01(\v2\x0f.academic.MajorR\x05major\"J\n" +
 	"\x0fGetMajorRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"9\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"9\n" +
 	"\x10GetMajorResponse\x12%\n" +
-	"\x05major\x18\x01 \x01(\v2\x0f.academic.MajorR\x05major\"\xa1\x01\n" +
+	"\x05major\x18\x01 \x01(\v2\x0f.academic.MajorR\x05major\"\xcc\x01\n" +
 	"\x12UpdateMajorRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12&\n" +
 	"\ffaculty_code\x18\x03 \x01(\tH\x01R\vfacultyCode\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\x04 \x01(\tR\tupdatedByB\b\n" +
+	"updated_by\x18\x04 \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\aversion\x18\x05 \x01(\x05H\x02R\aversion\x88\x01\x01B\b\n" +
 	"\x06_titleB\x0f\n" +
-	"\r_faculty_code\"<\n" +
+	"\r_faculty_codeB\n" +
+	"\n" +
+	"\b_version\"<\n" +
 	"\x13UpdateMajorResponse\x12%\n" +
-	"\x05major\x18\x01 \x01(\v2\x0f.academic.MajorR\x05major\"$\n" +
+	"\x05major\x18\x01 \x01(\v2\x0f.academic.MajorR\x05major\"C\n" +
 	"\x12DeleteMajorRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\"/\n" +
 	"\x13DeleteMajorResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"B\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"F\n" +
+	"\x13RestoreMajorRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrestored_by\x18\x02 \x01(\tR\n" +
+	"restoredBy\"=\n" +
+	"\x14RestoreMajorResponse\x12%\n" +
+	"\x05major\x18\x01 \x01(\v2\x0f.academic.MajorR\x05major\"k\n" +
 	"\x11ListMajorsRequest\x12-\n" +
-	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\"\x84\x01\n" +
+	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"\x84\x01\n" +
 	"\x12ListMajorsResponse\x12'\n" +
 	"\x06majors\x18\x01 \x03(\v2\x0f.academic.MajorR\x06majors\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize2\xaf\t\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize2\xfd\v\n" +
 	"\x0fAcademicService\x12S\n" +
 	"\x0eCreateSemester\x12\x1f.academic.CreateSemesterRequest\x1a .academic.CreateSemesterResponse\x12J\n" +
 	"\vGetSemester\x12\x1c.academic.GetSemesterRequest\x1a\x1d.academic.GetSemesterResponse\x12S\n" +
 	"\x0eUpdateSemester\x12\x1f.academic.UpdateSemesterRequest\x1a .academic.UpdateSemesterResponse\x12S\n" +
-	"\x0eDeleteSemester\x12\x1f.academic.DeleteSemesterRequest\x1a .academic.DeleteSemesterResponse\x12P\n" +
+	"\x0eDeleteSemester\x12\x1f.academic.DeleteSemesterRequest\x1a .academic.DeleteSemesterResponse\x12V\n" +
+	"\x0fRestoreSemester\x12 .academic.RestoreSemesterRequest\x1a!.academic.RestoreSemesterResponse\x12P\n" +
 	"\rListSemesters\x12\x1e.academic.ListSemestersRequest\x1a\x1f.academic.ListSemestersResponse\x12P\n" +
 	"\rCreateFaculty\x12\x1e.academic.CreateFacultyRequest\x1a\x1f.academic.CreateFacultyResponse\x12G\n" +
 	"\n" +
 	"GetFaculty\x12\x1b.academic.GetFacultyRequest\x1a\x1c.academic.GetFacultyResponse\x12P\n" +
 	"\rUpdateFaculty\x12\x1e.academic.UpdateFacultyRequest\x1a\x1f.academic.UpdateFacultyResponse\x12P\n" +
-	"\rDeleteFaculty\x12\x1e.academic.DeleteFacultyRequest\x1a\x1f.academic.DeleteFacultyResponse\x12P\n" +
+	"\rDeleteFaculty\x12\x1e.academic.DeleteFacultyRequest\x1a\x1f.academic.DeleteFacultyResponse\x12S\n" +
+	"\x0eRestoreFaculty\x12\x1f.academic.RestoreFacultyRequest\x1a .academic.RestoreFacultyResponse\x12P\n" +
 	"\rListFaculties\x12\x1e.academic.ListFacultiesRequest\x1a\x1f.academic.ListFacultiesResponse\x12J\n" +
 	"\vCreateMajor\x12\x1c.academic.CreateMajorRequest\x1a\x1d.academic.CreateMajorResponse\x12A\n" +
 	"\bGetMajor\x12\x19.academic.GetMajorRequest\x1a\x1a.academic.GetMajorResponse\x12J\n" +
 	"\vUpdateMajor\x12\x1c.academic.UpdateMajorRequest\x1a\x1d.academic.UpdateMajorResponse\x12J\n" +
-	"\vDeleteMajor\x12\x1c.academic.DeleteMajorRequest\x1a\x1d.academic.DeleteMajorResponse\x12G\n" +
+	"\vDeleteMajor\x12\x1c.academic.DeleteMajorRequest\x1a\x1d.academic.DeleteMajorResponse\x12M\n" +
+	"\fRestoreMajor\x12\x1d.academic.RestoreMajorRequest\x1a\x1e.academic.RestoreMajorResponse\x12G\n" +
 	"\n" +
-	"ListMajors\x12\x1b.academic.ListMajorsRequest\x1a\x1c.academic.ListMajorsResponseB\fZ\n" +
+	"ListMajors\x12\x1b.academic.ListMajorsRequest\x1a\x1c.academic.ListMajorsResponse\x12P\n" +
+	"\x0fCheckReferences\x12\x1d.common.ReferenceCheckRequest\x1a\x1e.common.ReferenceCheckResponseB\fZ\n" +
 	"./academicb\x06proto3"
 
 var (
@@ -1927,101 +2442,123 @@ func file_proto_academic_academic_proto_rawDescGZIP() []byte {
 	return file_proto_academic_academic_proto_rawDescData
 }
 
-var file_proto_academic_academic_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_proto_academic_academic_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_proto_academic_academic_proto_goTypes = []any{
-	(*Semester)(nil),               // 0: academic.Semester
-	(*CreateSemesterRequest)(nil),  // 1: academic.CreateSemesterRequest
-	(*CreateSemesterResponse)(nil), // 2: academic.CreateSemesterResponse
-	(*GetSemesterRequest)(nil),     // 3: academic.GetSemesterRequest
-	(*GetSemesterResponse)(nil),    // 4: academic.GetSemesterResponse
-	(*UpdateSemesterRequest)(nil),  // 5: academic.UpdateSemesterRequest
-	(*UpdateSemesterResponse)(nil), // 6: academic.UpdateSemesterResponse
-	(*DeleteSemesterRequest)(nil),  // 7: academic.DeleteSemesterRequest
-	(*DeleteSemesterResponse)(nil), // 8: academic.DeleteSemesterResponse
-	(*ListSemestersRequest)(nil),   // 9: academic.ListSemestersRequest
-	(*ListSemestersResponse)(nil),  // 10: academic.ListSemestersResponse
-	(*Faculty)(nil),                // 11: academic.Faculty
-	(*CreateFacultyRequest)(nil),   // 12: academic.CreateFacultyRequest
-	(*CreateFacultyResponse)(nil),  // 13: academic.CreateFacultyResponse
-	(*GetFacultyRequest)(nil),      // 14: academic.GetFacultyRequest
-	(*GetFacultyResponse)(nil),     // 15: academic.GetFacultyResponse
-	(*UpdateFacultyRequest)(nil),   // 16: academic.UpdateFacultyRequest
-	(*UpdateFacultyResponse)(nil),  // 17: academic.UpdateFacultyResponse
-	(*DeleteFacultyRequest)(nil),   // 18: academic.DeleteFacultyRequest
-	(*DeleteFacultyResponse)(nil),  // 19: academic.DeleteFacultyResponse
-	(*ListFacultiesRequest)(nil),   // 20: academic.ListFacultiesRequest
-	(*ListFacultiesResponse)(nil),  // 21: academic.ListFacultiesResponse
-	(*Major)(nil),                  // 22: academic.Major
-	(*CreateMajorRequest)(nil),     // 23: academic.CreateMajorRequest
-	(*CreateMajorResponse)(nil),    // 24: academic.CreateMajorResponse
-	(*GetMajorRequest)(nil),        // 25: academic.GetMajorRequest
-	(*GetMajorResponse)(nil),       // 26: academic.GetMajorResponse
-	(*UpdateMajorRequest)(nil),     // 27: academic.UpdateMajorRequest
-	(*UpdateMajorResponse)(nil),    // 28: academic.UpdateMajorResponse
-	(*DeleteMajorRequest)(nil),     // 29: academic.DeleteMajorRequest
-	(*DeleteMajorResponse)(nil),    // 30: academic.DeleteMajorResponse
-	(*ListMajorsRequest)(nil),      // 31: academic.ListMajorsRequest
-	(*ListMajorsResponse)(nil),     // 32: academic.ListMajorsResponse
-	(*timestamppb.Timestamp)(nil),  // 33: google.protobuf.Timestamp
-	(*common.SearchRequest)(nil),   // 34: common.SearchRequest
+	(*Semester)(nil),                      // 0: academic.Semester
+	(*CreateSemesterRequest)(nil),         // 1: academic.CreateSemesterRequest
+	(*CreateSemesterResponse)(nil),        // 2: academic.CreateSemesterResponse
+	(*GetSemesterRequest)(nil),            // 3: academic.GetSemesterRequest
+	(*GetSemesterResponse)(nil),           // 4: academic.GetSemesterResponse
+	(*UpdateSemesterRequest)(nil),         // 5: academic.UpdateSemesterRequest
+	(*UpdateSemesterResponse)(nil),        // 6: academic.UpdateSemesterResponse
+	(*DeleteSemesterRequest)(nil),         // 7: academic.DeleteSemesterRequest
+	(*DeleteSemesterResponse)(nil),        // 8: academic.DeleteSemesterResponse
+	(*RestoreSemesterRequest)(nil),        // 9: academic.RestoreSemesterRequest
+	(*RestoreSemesterResponse)(nil),       // 10: academic.RestoreSemesterResponse
+	(*ListSemestersRequest)(nil),          // 11: academic.ListSemestersRequest
+	(*ListSemestersResponse)(nil),         // 12: academic.ListSemestersResponse
+	(*Faculty)(nil),                       // 13: academic.Faculty
+	(*CreateFacultyRequest)(nil),          // 14: academic.CreateFacultyRequest
+	(*CreateFacultyResponse)(nil),         // 15: academic.CreateFacultyResponse
+	(*GetFacultyRequest)(nil),             // 16: academic.GetFacultyRequest
+	(*GetFacultyResponse)(nil),            // 17: academic.GetFacultyResponse
+	(*UpdateFacultyRequest)(nil),          // 18: academic.UpdateFacultyRequest
+	(*UpdateFacultyResponse)(nil),         // 19: academic.UpdateFacultyResponse
+	(*DeleteFacultyRequest)(nil),          // 20: academic.DeleteFacultyRequest
+	(*DeleteFacultyResponse)(nil),         // 21: academic.DeleteFacultyResponse
+	(*RestoreFacultyRequest)(nil),         // 22: academic.RestoreFacultyRequest
+	(*RestoreFacultyResponse)(nil),        // 23: academic.RestoreFacultyResponse
+	(*ListFacultiesRequest)(nil),          // 24: academic.ListFacultiesRequest
+	(*ListFacultiesResponse)(nil),         // 25: academic.ListFacultiesResponse
+	(*Major)(nil),                         // 26: academic.Major
+	(*CreateMajorRequest)(nil),            // 27: academic.CreateMajorRequest
+	(*CreateMajorResponse)(nil),           // 28: academic.CreateMajorResponse
+	(*GetMajorRequest)(nil),               // 29: academic.GetMajorRequest
+	(*GetMajorResponse)(nil),              // 30: academic.GetMajorResponse
+	(*UpdateMajorRequest)(nil),            // 31: academic.UpdateMajorRequest
+	(*UpdateMajorResponse)(nil),           // 32: academic.UpdateMajorResponse
+	(*DeleteMajorRequest)(nil),            // 33: academic.DeleteMajorRequest
+	(*DeleteMajorResponse)(nil),           // 34: academic.DeleteMajorResponse
+	(*RestoreMajorRequest)(nil),           // 35: academic.RestoreMajorRequest
+	(*RestoreMajorResponse)(nil),          // 36: academic.RestoreMajorResponse
+	(*ListMajorsRequest)(nil),             // 37: academic.ListMajorsRequest
+	(*ListMajorsResponse)(nil),            // 38: academic.ListMajorsResponse
+	(*timestamppb.Timestamp)(nil),         // 39: google.protobuf.Timestamp
+	(*common.SearchRequest)(nil),          // 40: common.SearchRequest
+	(*common.ReferenceCheckRequest)(nil),  // 41: common.ReferenceCheckRequest
+	(*common.ReferenceCheckResponse)(nil), // 42: common.ReferenceCheckResponse
 }
 var file_proto_academic_academic_proto_depIdxs = []int32{
-	33, // 0: academic.Semester.created_at:type_name -> google.protobuf.Timestamp
-	33, // 1: academic.Semester.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: academic.CreateSemesterResponse.semester:type_name -> academic.Semester
-	0,  // 3: academic.GetSemesterResponse.semester:type_name -> academic.Semester
-	0,  // 4: academic.UpdateSemesterResponse.semester:type_name -> academic.Semester
-	34, // 5: academic.ListSemestersRequest.search:type_name -> common.SearchRequest
-	0,  // 6: academic.ListSemestersResponse.semesters:type_name -> academic.Semester
-	33, // 7: academic.Faculty.created_at:type_name -> google.protobuf.Timestamp
-	33, // 8: academic.Faculty.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 9: academic.CreateFacultyResponse.faculty:type_name -> academic.Faculty
-	11, // 10: academic.GetFacultyResponse.faculty:type_name -> academic.Faculty
-	11, // 11: academic.UpdateFacultyResponse.faculty:type_name -> academic.Faculty
-	34, // 12: academic.ListFacultiesRequest.search:type_name -> common.SearchRequest
-	11, // 13: academic.ListFacultiesResponse.faculties:type_name -> academic.Faculty
-	33, // 14: academic.Major.created_at:type_name -> google.protobuf.Timestamp
-	33, // 15: academic.Major.updated_at:type_name -> google.protobuf.Timestamp
-	22, // 16: academic.CreateMajorResponse.major:type_name -> academic.Major
-	22, // 17: academic.GetMajorResponse.major:type_name -> academic.Major
-	22, // 18: academic.UpdateMajorResponse.major:type_name -> academic.Major
-	34, // 19: academic.ListMajorsRequest.search:type_name -> common.SearchRequest
-	22, // 20: academic.ListMajorsResponse.majors:type_name -> academic.Major
-	1,  // 21: academic.AcademicService.CreateSemester:input_type -> academic.CreateSemesterRequest
-	3,  // 22: academic.AcademicService.GetSemester:input_type -> academic.GetSemesterRequest
-	5,  // 23: academic.AcademicService.UpdateSemester:input_type -> academic.UpdateSemesterRequest
-	7,  // 24: academic.AcademicService.DeleteSemester:input_type -> academic.DeleteSemesterRequest
-	9,  // 25: academic.AcademicService.ListSemesters:input_type -> academic.ListSemestersRequest
-	12, // 26: academic.AcademicService.CreateFaculty:input_type -> academic.CreateFacultyRequest
-	14, // 27: academic.AcademicService.GetFaculty:input_type -> academic.GetFacultyRequest
-	16, // 28: academic.AcademicService.UpdateFaculty:input_type -> academic.UpdateFacultyRequest
-	18, // 29: academic.AcademicService.DeleteFaculty:input_type -> academic.DeleteFacultyRequest
-	20, // 30: academic.AcademicService.ListFaculties:input_type -> academic.ListFacultiesRequest
-	23, // 31: academic.AcademicService.CreateMajor:input_type -> academic.CreateMajorRequest
-	25, // 32: academic.AcademicService.GetMajor:input_type -> academic.GetMajorRequest
-	27, // 33: academic.AcademicService.UpdateMajor:input_type -> academic.UpdateMajorRequest
-	29, // 34: academic.AcademicService.DeleteMajor:input_type -> academic.DeleteMajorRequest
-	31, // 35: academic.AcademicService.ListMajors:input_type -> academic.ListMajorsRequest
-	2,  // 36: academic.AcademicService.CreateSemester:output_type -> academic.CreateSemesterResponse
-	4,  // 37: academic.AcademicService.GetSemester:output_type -> academic.GetSemesterResponse
-	6,  // 38: academic.AcademicService.UpdateSemester:output_type -> academic.UpdateSemesterResponse
-	8,  // 39: academic.AcademicService.DeleteSemester:output_type -> academic.DeleteSemesterResponse
-	10, // 40: academic.AcademicService.ListSemesters:output_type -> academic.ListSemestersResponse
-	13, // 41: academic.AcademicService.CreateFaculty:output_type -> academic.CreateFacultyResponse
-	15, // 42: academic.AcademicService.GetFaculty:output_type -> academic.GetFacultyResponse
-	17, // 43: academic.AcademicService.UpdateFaculty:output_type -> academic.UpdateFacultyResponse
-	19, // 44: academic.AcademicService.DeleteFaculty:output_type -> academic.DeleteFacultyResponse
-	21, // 45: academic.AcademicService.ListFaculties:output_type -> academic.ListFacultiesResponse
-	24, // 46: academic.AcademicService.CreateMajor:output_type -> academic.CreateMajorResponse
-	26, // 47: academic.AcademicService.GetMajor:output_type -> academic.GetMajorResponse
-	28, // 48: academic.AcademicService.UpdateMajor:output_type -> academic.UpdateMajorResponse
-	30, // 49: academic.AcademicService.DeleteMajor:output_type -> academic.DeleteMajorResponse
-	32, // 50: academic.AcademicService.ListMajors:output_type -> academic.ListMajorsResponse
-	36, // [36:51] is the sub-list for method output_type
-	21, // [21:36] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	39, // 0: academic.Semester.created_at:type_name -> google.protobuf.Timestamp
+	39, // 1: academic.Semester.updated_at:type_name -> google.protobuf.Timestamp
+	39, // 2: academic.Semester.deleted_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: academic.CreateSemesterResponse.semester:type_name -> academic.Semester
+	0,  // 4: academic.GetSemesterResponse.semester:type_name -> academic.Semester
+	0,  // 5: academic.UpdateSemesterResponse.semester:type_name -> academic.Semester
+	0,  // 6: academic.RestoreSemesterResponse.semester:type_name -> academic.Semester
+	40, // 7: academic.ListSemestersRequest.search:type_name -> common.SearchRequest
+	0,  // 8: academic.ListSemestersResponse.semesters:type_name -> academic.Semester
+	39, // 9: academic.Faculty.created_at:type_name -> google.protobuf.Timestamp
+	39, // 10: academic.Faculty.updated_at:type_name -> google.protobuf.Timestamp
+	39, // 11: academic.Faculty.deleted_at:type_name -> google.protobuf.Timestamp
+	13, // 12: academic.CreateFacultyResponse.faculty:type_name -> academic.Faculty
+	13, // 13: academic.GetFacultyResponse.faculty:type_name -> academic.Faculty
+	13, // 14: academic.UpdateFacultyResponse.faculty:type_name -> academic.Faculty
+	13, // 15: academic.RestoreFacultyResponse.faculty:type_name -> academic.Faculty
+	40, // 16: academic.ListFacultiesRequest.search:type_name -> common.SearchRequest
+	13, // 17: academic.ListFacultiesResponse.faculties:type_name -> academic.Faculty
+	39, // 18: academic.Major.created_at:type_name -> google.protobuf.Timestamp
+	39, // 19: academic.Major.updated_at:type_name -> google.protobuf.Timestamp
+	39, // 20: academic.Major.deleted_at:type_name -> google.protobuf.Timestamp
+	26, // 21: academic.CreateMajorResponse.major:type_name -> academic.Major
+	26, // 22: academic.GetMajorResponse.major:type_name -> academic.Major
+	26, // 23: academic.UpdateMajorResponse.major:type_name -> academic.Major
+	26, // 24: academic.RestoreMajorResponse.major:type_name -> academic.Major
+	40, // 25: academic.ListMajorsRequest.search:type_name -> common.SearchRequest
+	26, // 26: academic.ListMajorsResponse.majors:type_name -> academic.Major
+	1,  // 27: academic.AcademicService.CreateSemester:input_type -> academic.CreateSemesterRequest
+	3,  // 28: academic.AcademicService.GetSemester:input_type -> academic.GetSemesterRequest
+	5,  // 29: academic.AcademicService.UpdateSemester:input_type -> academic.UpdateSemesterRequest
+	7,  // 30: academic.AcademicService.DeleteSemester:input_type -> academic.DeleteSemesterRequest
+	9,  // 31: academic.AcademicService.RestoreSemester:input_type -> academic.RestoreSemesterRequest
+	11, // 32: academic.AcademicService.ListSemesters:input_type -> academic.ListSemestersRequest
+	14, // 33: academic.AcademicService.CreateFaculty:input_type -> academic.CreateFacultyRequest
+	16, // 34: academic.AcademicService.GetFaculty:input_type -> academic.GetFacultyRequest
+	18, // 35: academic.AcademicService.UpdateFaculty:input_type -> academic.UpdateFacultyRequest
+	20, // 36: academic.AcademicService.DeleteFaculty:input_type -> academic.DeleteFacultyRequest
+	22, // 37: academic.AcademicService.RestoreFaculty:input_type -> academic.RestoreFacultyRequest
+	24, // 38: academic.AcademicService.ListFaculties:input_type -> academic.ListFacultiesRequest
+	27, // 39: academic.AcademicService.CreateMajor:input_type -> academic.CreateMajorRequest
+	29, // 40: academic.AcademicService.GetMajor:input_type -> academic.GetMajorRequest
+	31, // 41: academic.AcademicService.UpdateMajor:input_type -> academic.UpdateMajorRequest
+	33, // 42: academic.AcademicService.DeleteMajor:input_type -> academic.DeleteMajorRequest
+	35, // 43: academic.AcademicService.RestoreMajor:input_type -> academic.RestoreMajorRequest
+	37, // 44: academic.AcademicService.ListMajors:input_type -> academic.ListMajorsRequest
+	41, // 45: academic.AcademicService.CheckReferences:input_type -> common.ReferenceCheckRequest
+	2,  // 46: academic.AcademicService.CreateSemester:output_type -> academic.CreateSemesterResponse
+	4,  // 47: academic.AcademicService.GetSemester:output_type -> academic.GetSemesterResponse
+	6,  // 48: academic.AcademicService.UpdateSemester:output_type -> academic.UpdateSemesterResponse
+	8,  // 49: academic.AcademicService.DeleteSemester:output_type -> academic.DeleteSemesterResponse
+	10, // 50: academic.AcademicService.RestoreSemester:output_type -> academic.RestoreSemesterResponse
+	12, // 51: academic.AcademicService.ListSemesters:output_type -> academic.ListSemestersResponse
+	15, // 52: academic.AcademicService.CreateFaculty:output_type -> academic.CreateFacultyResponse
+	17, // 53: academic.AcademicService.GetFaculty:output_type -> academic.GetFacultyResponse
+	19, // 54: academic.AcademicService.UpdateFaculty:output_type -> academic.UpdateFacultyResponse
+	21, // 55: academic.AcademicService.DeleteFaculty:output_type -> academic.DeleteFacultyResponse
+	23, // 56: academic.AcademicService.RestoreFaculty:output_type -> academic.RestoreFacultyResponse
+	25, // 57: academic.AcademicService.ListFaculties:output_type -> academic.ListFacultiesResponse
+	28, // 58: academic.AcademicService.CreateMajor:output_type -> academic.CreateMajorResponse
+	30, // 59: academic.AcademicService.GetMajor:output_type -> academic.GetMajorResponse
+	32, // 60: academic.AcademicService.UpdateMajor:output_type -> academic.UpdateMajorResponse
+	34, // 61: academic.AcademicService.DeleteMajor:output_type -> academic.DeleteMajorResponse
+	36, // 62: academic.AcademicService.RestoreMajor:output_type -> academic.RestoreMajorResponse
+	38, // 63: academic.AcademicService.ListMajors:output_type -> academic.ListMajorsResponse
+	42, // 64: academic.AcademicService.CheckReferences:output_type -> common.ReferenceCheckResponse
+	46, // [46:65] is the sub-list for method output_type
+	27, // [27:46] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_proto_academic_academic_proto_init() }
@@ -2030,15 +2567,15 @@ func file_proto_academic_academic_proto_init() {
 		return
 	}
 	file_proto_academic_academic_proto_msgTypes[5].OneofWrappers = []any{}
-	file_proto_academic_academic_proto_msgTypes[16].OneofWrappers = []any{}
-	file_proto_academic_academic_proto_msgTypes[27].OneofWrappers = []any{}
+	file_proto_academic_academic_proto_msgTypes[18].OneofWrappers = []any{}
+	file_proto_academic_academic_proto_msgTypes[31].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_academic_academic_proto_rawDesc), len(file_proto_academic_academic_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   33,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	common "thaily/proto/common"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,21 +20,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AcademicService_CreateSemester_FullMethodName = "/academic.AcademicService/CreateSemester"
-	AcademicService_GetSemester_FullMethodName    = "/academic.AcademicService/GetSemester"
-	AcademicService_UpdateSemester_FullMethodName = "/academic.AcademicService/UpdateSemester"
-	AcademicService_DeleteSemester_FullMethodName = "/academic.AcademicService/DeleteSemester"
-	AcademicService_ListSemesters_FullMethodName  = "/academic.AcademicService/ListSemesters"
-	AcademicService_CreateFaculty_FullMethodName  = "/academic.AcademicService/CreateFaculty"
-	AcademicService_GetFaculty_FullMethodName     = "/academic.AcademicService/GetFaculty"
-	AcademicService_UpdateFaculty_FullMethodName  = "/academic.AcademicService/UpdateFaculty"
-	AcademicService_DeleteFaculty_FullMethodName  = "/academic.AcademicService/DeleteFaculty"
-	AcademicService_ListFaculties_FullMethodName  = "/academic.AcademicService/ListFaculties"
-	AcademicService_CreateMajor_FullMethodName    = "/academic.AcademicService/CreateMajor"
-	AcademicService_GetMajor_FullMethodName       = "/academic.AcademicService/GetMajor"
-	AcademicService_UpdateMajor_FullMethodName    = "/academic.AcademicService/UpdateMajor"
-	AcademicService_DeleteMajor_FullMethodName    = "/academic.AcademicService/DeleteMajor"
-	AcademicService_ListMajors_FullMethodName     = "/academic.AcademicService/ListMajors"
+	AcademicService_CreateSemester_FullMethodName  = "/academic.AcademicService/CreateSemester"
+	AcademicService_GetSemester_FullMethodName     = "/academic.AcademicService/GetSemester"
+	AcademicService_UpdateSemester_FullMethodName  = "/academic.AcademicService/UpdateSemester"
+	AcademicService_DeleteSemester_FullMethodName  = "/academic.AcademicService/DeleteSemester"
+	AcademicService_RestoreSemester_FullMethodName = "/academic.AcademicService/RestoreSemester"
+	AcademicService_ListSemesters_FullMethodName   = "/academic.AcademicService/ListSemesters"
+	AcademicService_CreateFaculty_FullMethodName   = "/academic.AcademicService/CreateFaculty"
+	AcademicService_GetFaculty_FullMethodName      = "/academic.AcademicService/GetFaculty"
+	AcademicService_UpdateFaculty_FullMethodName   = "/academic.AcademicService/UpdateFaculty"
+	AcademicService_DeleteFaculty_FullMethodName   = "/academic.AcademicService/DeleteFaculty"
+	AcademicService_RestoreFaculty_FullMethodName  = "/academic.AcademicService/RestoreFaculty"
+	AcademicService_ListFaculties_FullMethodName   = "/academic.AcademicService/ListFaculties"
+	AcademicService_CreateMajor_FullMethodName     = "/academic.AcademicService/CreateMajor"
+	AcademicService_GetMajor_FullMethodName        = "/academic.AcademicService/GetMajor"
+	AcademicService_UpdateMajor_FullMethodName     = "/academic.AcademicService/UpdateMajor"
+	AcademicService_DeleteMajor_FullMethodName     = "/academic.AcademicService/DeleteMajor"
+	AcademicService_RestoreMajor_FullMethodName    = "/academic.AcademicService/RestoreMajor"
+	AcademicService_ListMajors_FullMethodName      = "/academic.AcademicService/ListMajors"
+	AcademicService_CheckReferences_FullMethodName = "/academic.AcademicService/CheckReferences"
 )
 
 // AcademicServiceClient is the client API for AcademicService service.
@@ -47,19 +52,24 @@ type AcademicServiceClient interface {
 	GetSemester(ctx context.Context, in *GetSemesterRequest, opts ...grpc.CallOption) (*GetSemesterResponse, error)
 	UpdateSemester(ctx context.Context, in *UpdateSemesterRequest, opts ...grpc.CallOption) (*UpdateSemesterResponse, error)
 	DeleteSemester(ctx context.Context, in *DeleteSemesterRequest, opts ...grpc.CallOption) (*DeleteSemesterResponse, error)
+	RestoreSemester(ctx context.Context, in *RestoreSemesterRequest, opts ...grpc.CallOption) (*RestoreSemesterResponse, error)
 	ListSemesters(ctx context.Context, in *ListSemestersRequest, opts ...grpc.CallOption) (*ListSemestersResponse, error)
 	// Faculty
 	CreateFaculty(ctx context.Context, in *CreateFacultyRequest, opts ...grpc.CallOption) (*CreateFacultyResponse, error)
 	GetFaculty(ctx context.Context, in *GetFacultyRequest, opts ...grpc.CallOption) (*GetFacultyResponse, error)
 	UpdateFaculty(ctx context.Context, in *UpdateFacultyRequest, opts ...grpc.CallOption) (*UpdateFacultyResponse, error)
 	DeleteFaculty(ctx context.Context, in *DeleteFacultyRequest, opts ...grpc.CallOption) (*DeleteFacultyResponse, error)
+	RestoreFaculty(ctx context.Context, in *RestoreFacultyRequest, opts ...grpc.CallOption) (*RestoreFacultyResponse, error)
 	ListFaculties(ctx context.Context, in *ListFacultiesRequest, opts ...grpc.CallOption) (*ListFacultiesResponse, error)
 	// Major
 	CreateMajor(ctx context.Context, in *CreateMajorRequest, opts ...grpc.CallOption) (*CreateMajorResponse, error)
 	GetMajor(ctx context.Context, in *GetMajorRequest, opts ...grpc.CallOption) (*GetMajorResponse, error)
 	UpdateMajor(ctx context.Context, in *UpdateMajorRequest, opts ...grpc.CallOption) (*UpdateMajorResponse, error)
 	DeleteMajor(ctx context.Context, in *DeleteMajorRequest, opts ...grpc.CallOption) (*DeleteMajorResponse, error)
+	RestoreMajor(ctx context.Context, in *RestoreMajorRequest, opts ...grpc.CallOption) (*RestoreMajorResponse, error)
 	ListMajors(ctx context.Context, in *ListMajorsRequest, opts ...grpc.CallOption) (*ListMajorsResponse, error)
+	// Cross-service reference validation
+	CheckReferences(ctx context.Context, in *common.ReferenceCheckRequest, opts ...grpc.CallOption) (*common.ReferenceCheckResponse, error)
 }
 
 type academicServiceClient struct {
@@ -110,6 +120,16 @@ func (c *academicServiceClient) DeleteSemester(ctx context.Context, in *DeleteSe
 	return out, nil
 }
 
+func (c *academicServiceClient) RestoreSemester(ctx context.Context, in *RestoreSemesterRequest, opts ...grpc.CallOption) (*RestoreSemesterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreSemesterResponse)
+	err := c.cc.Invoke(ctx, AcademicService_RestoreSemester_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *academicServiceClient) ListSemesters(ctx context.Context, in *ListSemestersRequest, opts ...grpc.CallOption) (*ListSemestersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListSemestersResponse)
@@ -160,6 +180,16 @@ func (c *academicServiceClient) DeleteFaculty(ctx context.Context, in *DeleteFac
 	return out, nil
 }
 
+func (c *academicServiceClient) RestoreFaculty(ctx context.Context, in *RestoreFacultyRequest, opts ...grpc.CallOption) (*RestoreFacultyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreFacultyResponse)
+	err := c.cc.Invoke(ctx, AcademicService_RestoreFaculty_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *academicServiceClient) ListFaculties(ctx context.Context, in *ListFacultiesRequest, opts ...grpc.CallOption) (*ListFacultiesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListFacultiesResponse)
@@ -210,6 +240,16 @@ func (c *academicServiceClient) DeleteMajor(ctx context.Context, in *DeleteMajor
 	return out, nil
 }
 
+func (c *academicServiceClient) RestoreMajor(ctx context.Context, in *RestoreMajorRequest, opts ...grpc.CallOption) (*RestoreMajorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreMajorResponse)
+	err := c.cc.Invoke(ctx, AcademicService_RestoreMajor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *academicServiceClient) ListMajors(ctx context.Context, in *ListMajorsRequest, opts ...grpc.CallOption) (*ListMajorsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListMajorsResponse)
@@ -220,6 +260,16 @@ func (c *academicServiceClient) ListMajors(ctx context.Context, in *ListMajorsRe
 	return out, nil
 }
 
+func (c *academicServiceClient) CheckReferences(ctx context.Context, in *common.ReferenceCheckRequest, opts ...grpc.CallOption) (*common.ReferenceCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.ReferenceCheckResponse)
+	err := c.cc.Invoke(ctx, AcademicService_CheckReferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AcademicServiceServer is the server API for AcademicService service.
 // All implementations must embed UnimplementedAcademicServiceServer
 // for forward compatibility.
@@ -231,19 +281,24 @@ type AcademicServiceServer interface {
 	GetSemester(context.Context, *GetSemesterRequest) (*GetSemesterResponse, error)
 	UpdateSemester(context.Context, *UpdateSemesterRequest) (*UpdateSemesterResponse, error)
 	DeleteSemester(context.Context, *DeleteSemesterRequest) (*DeleteSemesterResponse, error)
+	RestoreSemester(context.Context, *RestoreSemesterRequest) (*RestoreSemesterResponse, error)
 	ListSemesters(context.Context, *ListSemestersRequest) (*ListSemestersResponse, error)
 	// Faculty
 	CreateFaculty(context.Context, *CreateFacultyRequest) (*CreateFacultyResponse, error)
 	GetFaculty(context.Context, *GetFacultyRequest) (*GetFacultyResponse, error)
 	UpdateFaculty(context.Context, *UpdateFacultyRequest) (*UpdateFacultyResponse, error)
 	DeleteFaculty(context.Context, *DeleteFacultyRequest) (*DeleteFacultyResponse, error)
+	RestoreFaculty(context.Context, *RestoreFacultyRequest) (*RestoreFacultyResponse, error)
 	ListFaculties(context.Context, *ListFacultiesRequest) (*ListFacultiesResponse, error)
 	// Major
 	CreateMajor(context.Context, *CreateMajorRequest) (*CreateMajorResponse, error)
 	GetMajor(context.Context, *GetMajorRequest) (*GetMajorResponse, error)
 	UpdateMajor(context.Context, *UpdateMajorRequest) (*UpdateMajorResponse, error)
 	DeleteMajor(context.Context, *DeleteMajorRequest) (*DeleteMajorResponse, error)
+	RestoreMajor(context.Context, *RestoreMajorRequest) (*RestoreMajorResponse, error)
 	ListMajors(context.Context, *ListMajorsRequest) (*ListMajorsResponse, error)
+	// Cross-service reference validation
+	CheckReferences(context.Context, *common.ReferenceCheckRequest) (*common.ReferenceCheckResponse, error)
 	mustEmbedUnimplementedAcademicServiceServer()
 }
 
@@ -266,6 +321,9 @@ func (UnimplementedAcademicServiceServer) UpdateSemester(context.Context, *Updat
 func (UnimplementedAcademicServiceServer) DeleteSemester(context.Context, *DeleteSemesterRequest) (*DeleteSemesterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSemester not implemented")
 }
+func (UnimplementedAcademicServiceServer) RestoreSemester(context.Context, *RestoreSemesterRequest) (*RestoreSemesterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSemester not implemented")
+}
 func (UnimplementedAcademicServiceServer) ListSemesters(context.Context, *ListSemestersRequest) (*ListSemestersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSemesters not implemented")
 }
@@ -281,6 +339,9 @@ func (UnimplementedAcademicServiceServer) UpdateFaculty(context.Context, *Update
 func (UnimplementedAcademicServiceServer) DeleteFaculty(context.Context, *DeleteFacultyRequest) (*DeleteFacultyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFaculty not implemented")
 }
+func (UnimplementedAcademicServiceServer) RestoreFaculty(context.Context, *RestoreFacultyRequest) (*RestoreFacultyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreFaculty not implemented")
+}
 func (UnimplementedAcademicServiceServer) ListFaculties(context.Context, *ListFacultiesRequest) (*ListFacultiesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFaculties not implemented")
 }
@@ -296,9 +357,15 @@ func (UnimplementedAcademicServiceServer) UpdateMajor(context.Context, *UpdateMa
 func (UnimplementedAcademicServiceServer) DeleteMajor(context.Context, *DeleteMajorRequest) (*DeleteMajorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMajor not implemented")
 }
+func (UnimplementedAcademicServiceServer) RestoreMajor(context.Context, *RestoreMajorRequest) (*RestoreMajorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMajor not implemented")
+}
 func (UnimplementedAcademicServiceServer) ListMajors(context.Context, *ListMajorsRequest) (*ListMajorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMajors not implemented")
 }
+func (UnimplementedAcademicServiceServer) CheckReferences(context.Context, *common.ReferenceCheckRequest) (*common.ReferenceCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckReferences not implemented")
+}
 func (UnimplementedAcademicServiceServer) mustEmbedUnimplementedAcademicServiceServer() {}
 func (UnimplementedAcademicServiceServer) testEmbeddedByValue()                         {}
 
@@ -392,6 +459,24 @@ func _AcademicService_DeleteSemester_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AcademicService_RestoreSemester_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSemesterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicServiceServer).RestoreSemester(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicService_RestoreSemester_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicServiceServer).RestoreSemester(ctx, req.(*RestoreSemesterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AcademicService_ListSemesters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListSemestersRequest)
 	if err := dec(in); err != nil {
@@ -482,6 +567,24 @@ func _AcademicService_DeleteFaculty_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AcademicService_RestoreFaculty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreFacultyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicServiceServer).RestoreFaculty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicService_RestoreFaculty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicServiceServer).RestoreFaculty(ctx, req.(*RestoreFacultyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AcademicService_ListFaculties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListFacultiesRequest)
 	if err := dec(in); err != nil {
@@ -572,6 +675,24 @@ func _AcademicService_DeleteMajor_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AcademicService_RestoreMajor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMajorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicServiceServer).RestoreMajor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicService_RestoreMajor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicServiceServer).RestoreMajor(ctx, req.(*RestoreMajorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AcademicService_ListMajors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListMajorsRequest)
 	if err := dec(in); err != nil {
@@ -590,6 +711,24 @@ func _AcademicService_ListMajors_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AcademicService_CheckReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.ReferenceCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicServiceServer).CheckReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicService_CheckReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicServiceServer).CheckReferences(ctx, req.(*common.ReferenceCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AcademicService_ServiceDesc is the grpc.ServiceDesc for AcademicService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -613,6 +752,10 @@ var AcademicService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSemester",
 			Handler:    _AcademicService_DeleteSemester_Handler,
 		},
+		{
+			MethodName: "RestoreSemester",
+			Handler:    _AcademicService_RestoreSemester_Handler,
+		},
 		{
 			MethodName: "ListSemesters",
 			Handler:    _AcademicService_ListSemesters_Handler,
@@ -633,6 +776,10 @@ var AcademicService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFaculty",
 			Handler:    _AcademicService_DeleteFaculty_Handler,
 		},
+		{
+			MethodName: "RestoreFaculty",
+			Handler:    _AcademicService_RestoreFaculty_Handler,
+		},
 		{
 			MethodName: "ListFaculties",
 			Handler:    _AcademicService_ListFaculties_Handler,
@@ -653,10 +800,18 @@ var AcademicService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMajor",
 			Handler:    _AcademicService_DeleteMajor_Handler,
 		},
+		{
+			MethodName: "RestoreMajor",
+			Handler:    _AcademicService_RestoreMajor_Handler,
+		},
 		{
 			MethodName: "ListMajors",
 			Handler:    _AcademicService_ListMajors_Handler,
 		},
+		{
+			MethodName: "CheckReferences",
+			Handler:    _AcademicService_CheckReferences_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/academic/academic.proto",
@@ -37,6 +37,7 @@ const (
 	FilterOperator_IS_NULL            FilterOperator = 9  // IS NULL
 	FilterOperator_IS_NOT_NULL        FilterOperator = 10 // IS NOT NULL
 	FilterOperator_BETWEEN            FilterOperator = 11 // BETWEEN val1 AND val2
+	FilterOperator_SEARCH             FilterOperator = 12 // full-text match, on fields with a FULLTEXT index
 )
 
 // Enum value maps for FilterOperator.
@@ -54,6 +55,7 @@ var (
 		9:  "IS_NULL",
 		10: "IS_NOT_NULL",
 		11: "BETWEEN",
+		12: "SEARCH",
 	}
 	FilterOperator_value = map[string]int32{
 		"EQUAL":              0,
@@ -68,6 +70,7 @@ var (
 		"IS_NULL":            9,
 		"IS_NOT_NULL":        10,
 		"BETWEEN":            11,
+		"SEARCH":             12,
 	}
 )
 
@@ -145,6 +148,53 @@ func (LogicalCondition) EnumDescriptor() ([]byte, []int) {
 	return file_proto_common_common_proto_rawDescGZIP(), []int{1}
 }
 
+// ============= Sorting =============
+type SortDirection int32
+
+const (
+	SortDirection_ASC  SortDirection = 0
+	SortDirection_DESC SortDirection = 1
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "ASC",
+		1: "DESC",
+	}
+	SortDirection_value = map[string]int32{
+		"ASC":  0,
+		"DESC": 1,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_common_common_proto_enumTypes[2].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_proto_common_common_proto_enumTypes[2]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_proto_common_common_proto_rawDescGZIP(), []int{2}
+}
+
 // ============= Filter Criteria (Nested Support) =============
 type FilterCriteria struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -340,20 +390,73 @@ func (x *FilterGroup) GetFilters() []*FilterCriteria {
 	return nil
 }
 
+type SortSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // field to sort by, same names as the filters
+	Direction     SortDirection          `protobuf:"varint,2,opt,name=direction,proto3,enum=common.SortDirection" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortSpec) Reset() {
+	*x = SortSpec{}
+	mi := &file_proto_common_common_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortSpec) ProtoMessage() {}
+
+func (x *SortSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_common_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortSpec.ProtoReflect.Descriptor instead.
+func (*SortSpec) Descriptor() ([]byte, []int) {
+	return file_proto_common_common_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SortSpec) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SortSpec) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_ASC
+}
+
 // ============= Pagination =============
 type Pagination struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                         // page number (starting from 1)
 	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // number of items per page
-	SortBy        string                 `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`        // field to sort by
+	SortBy        string                 `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`        // field to sort by, used when sort is empty
 	Descending    bool                   `protobuf:"varint,4,opt,name=descending,proto3" json:"descending,omitempty"`             // sort direction (false = ASC, true = DESC)
+	Sort          []*SortSpec            `protobuf:"bytes,5,rep,name=sort,proto3" json:"sort,omitempty"`                          // sort keys in priority order, id is always the last tiebreaker
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Pagination) Reset() {
 	*x = Pagination{}
-	mi := &file_proto_common_common_proto_msgTypes[3]
+	mi := &file_proto_common_common_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -365,7 +468,7 @@ func (x *Pagination) String() string {
 func (*Pagination) ProtoMessage() {}
 
 func (x *Pagination) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_common_common_proto_msgTypes[3]
+	mi := &file_proto_common_common_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -378,7 +481,7 @@ func (x *Pagination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
 func (*Pagination) Descriptor() ([]byte, []int) {
-	return file_proto_common_common_proto_rawDescGZIP(), []int{3}
+	return file_proto_common_common_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Pagination) GetPage() int32 {
@@ -409,6 +512,188 @@ func (x *Pagination) GetDescending() bool {
 	return false
 }
 
+func (x *Pagination) GetSort() []*SortSpec {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+// ============= Full-text Search =============
+type SearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // "topic", "student", "teacher", "file"
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`       // topic/file title or user name
+	Subtitle      string                 `protobuf:"bytes,4,opt,name=subtitle,proto3" json:"subtitle,omitempty"` // email, status or the like
+	Score         float64                `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`     // relevance, higher is better
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_proto_common_common_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_common_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_proto_common_common_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchHit) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SearchHit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SearchHit) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchHit) GetSubtitle() string {
+	if x != nil {
+		return x.Subtitle
+	}
+	return ""
+}
+
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// ============= Cross-service References =============
+// Asks the service owning a table which of the given ids do not exist
+type ReferenceCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Table         string                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"` // e.g. "Student", must be owned by the service
+	Ids           []string               `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReferenceCheckRequest) Reset() {
+	*x = ReferenceCheckRequest{}
+	mi := &file_proto_common_common_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReferenceCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferenceCheckRequest) ProtoMessage() {}
+
+func (x *ReferenceCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_common_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferenceCheckRequest.ProtoReflect.Descriptor instead.
+func (*ReferenceCheckRequest) Descriptor() ([]byte, []int) {
+	return file_proto_common_common_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReferenceCheckRequest) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *ReferenceCheckRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ReferenceCheckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Missing       []string               `protobuf:"bytes,1,rep,name=missing,proto3" json:"missing,omitempty"` // ids with no row in the table
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReferenceCheckResponse) Reset() {
+	*x = ReferenceCheckResponse{}
+	mi := &file_proto_common_common_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReferenceCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferenceCheckResponse) ProtoMessage() {}
+
+func (x *ReferenceCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_common_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferenceCheckResponse.ProtoReflect.Descriptor instead.
+func (*ReferenceCheckResponse) Descriptor() ([]byte, []int) {
+	return file_proto_common_common_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReferenceCheckResponse) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
 // ============= Generic Search Request =============
 type SearchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -420,7 +705,7 @@ type SearchRequest struct {
 
 func (x *SearchRequest) Reset() {
 	*x = SearchRequest{}
-	mi := &file_proto_common_common_proto_msgTypes[4]
+	mi := &file_proto_common_common_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -432,7 +717,7 @@ func (x *SearchRequest) String() string {
 func (*SearchRequest) ProtoMessage() {}
 
 func (x *SearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_common_common_proto_msgTypes[4]
+	mi := &file_proto_common_common_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,7 +730,7 @@ func (x *SearchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return file_proto_common_common_proto_rawDescGZIP(), []int{4}
+	return file_proto_common_common_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SearchRequest) GetPagination() *Pagination {
@@ -478,7 +763,10 @@ const file_proto_common_common_proto_rawDesc = "" +
 	"\x06values\x18\x03 \x03(\tR\x06values\"o\n" +
 	"\vFilterGroup\x12.\n" +
 	"\x05logic\x18\x01 \x01(\x0e2\x18.common.LogicalConditionR\x05logic\x120\n" +
-	"\afilters\x18\x02 \x03(\v2\x16.common.FilterCriteriaR\afilters\"v\n" +
+	"\afilters\x18\x02 \x03(\v2\x16.common.FilterCriteriaR\afilters\"U\n" +
+	"\bSortSpec\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x123\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x15.common.SortDirectionR\tdirection\"\x9c\x01\n" +
 	"\n" +
 	"Pagination\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
@@ -486,12 +774,24 @@ const file_proto_common_common_proto_rawDesc = "" +
 	"\asort_by\x18\x03 \x01(\tR\x06sortBy\x12\x1e\n" +
 	"\n" +
 	"descending\x18\x04 \x01(\bR\n" +
-	"descending\"u\n" +
+	"descending\x12$\n" +
+	"\x04sort\x18\x05 \x03(\v2\x10.common.SortSpecR\x04sort\"w\n" +
+	"\tSearchHit\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1a\n" +
+	"\bsubtitle\x18\x04 \x01(\tR\bsubtitle\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x01R\x05score\"?\n" +
+	"\x15ReferenceCheckRequest\x12\x14\n" +
+	"\x05table\x18\x01 \x01(\tR\x05table\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\tR\x03ids\"2\n" +
+	"\x16ReferenceCheckResponse\x12\x18\n" +
+	"\amissing\x18\x01 \x03(\tR\amissing\"u\n" +
 	"\rSearchRequest\x122\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x12.common.PaginationR\n" +
 	"pagination\x120\n" +
-	"\afilters\x18\x02 \x03(\v2\x16.common.FilterCriteriaR\afilters*\xc1\x01\n" +
+	"\afilters\x18\x02 \x03(\v2\x16.common.FilterCriteriaR\afilters*\xcd\x01\n" +
 	"\x0eFilterOperator\x12\t\n" +
 	"\x05EQUAL\x10\x00\x12\r\n" +
 	"\tNOT_EQUAL\x10\x01\x12\x10\n" +
@@ -506,10 +806,15 @@ const file_proto_common_common_proto_rawDesc = "" +
 	"\aIS_NULL\x10\t\x12\x0f\n" +
 	"\vIS_NOT_NULL\x10\n" +
 	"\x12\v\n" +
-	"\aBETWEEN\x10\v*#\n" +
+	"\aBETWEEN\x10\v\x12\n" +
+	"\n" +
+	"\x06SEARCH\x10\f*#\n" +
 	"\x10LogicalCondition\x12\a\n" +
 	"\x03AND\x10\x00\x12\x06\n" +
-	"\x02OR\x10\x01B\x15Z\x13thaily/proto/commonb\x06proto3"
+	"\x02OR\x10\x01*\"\n" +
+	"\rSortDirection\x12\a\n" +
+	"\x03ASC\x10\x00\x12\b\n" +
+	"\x04DESC\x10\x01B\x15Z\x13thaily/proto/commonb\x06proto3"
 
 var (
 	file_proto_common_common_proto_rawDescOnce sync.Once
@@ -523,30 +828,37 @@ func file_proto_common_common_proto_rawDescGZIP() []byte {
 	return file_proto_common_common_proto_rawDescData
 }
 
-var file_proto_common_common_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_common_common_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_common_common_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_common_common_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_proto_common_common_proto_goTypes = []any{
-	(FilterOperator)(0),     // 0: common.FilterOperator
-	(LogicalCondition)(0),   // 1: common.LogicalCondition
-	(*FilterCriteria)(nil),  // 2: common.FilterCriteria
-	(*FilterCondition)(nil), // 3: common.FilterCondition
-	(*FilterGroup)(nil),     // 4: common.FilterGroup
-	(*Pagination)(nil),      // 5: common.Pagination
-	(*SearchRequest)(nil),   // 6: common.SearchRequest
+	(FilterOperator)(0),            // 0: common.FilterOperator
+	(LogicalCondition)(0),          // 1: common.LogicalCondition
+	(SortDirection)(0),             // 2: common.SortDirection
+	(*FilterCriteria)(nil),         // 3: common.FilterCriteria
+	(*FilterCondition)(nil),        // 4: common.FilterCondition
+	(*FilterGroup)(nil),            // 5: common.FilterGroup
+	(*SortSpec)(nil),               // 6: common.SortSpec
+	(*Pagination)(nil),             // 7: common.Pagination
+	(*SearchHit)(nil),              // 8: common.SearchHit
+	(*ReferenceCheckRequest)(nil),  // 9: common.ReferenceCheckRequest
+	(*ReferenceCheckResponse)(nil), // 10: common.ReferenceCheckResponse
+	(*SearchRequest)(nil),          // 11: common.SearchRequest
 }
 var file_proto_common_common_proto_depIdxs = []int32{
-	3, // 0: common.FilterCriteria.condition:type_name -> common.FilterCondition
-	4, // 1: common.FilterCriteria.group:type_name -> common.FilterGroup
+	4, // 0: common.FilterCriteria.condition:type_name -> common.FilterCondition
+	5, // 1: common.FilterCriteria.group:type_name -> common.FilterGroup
 	0, // 2: common.FilterCondition.operator:type_name -> common.FilterOperator
 	1, // 3: common.FilterGroup.logic:type_name -> common.LogicalCondition
-	2, // 4: common.FilterGroup.filters:type_name -> common.FilterCriteria
-	5, // 5: common.SearchRequest.pagination:type_name -> common.Pagination
-	2, // 6: common.SearchRequest.filters:type_name -> common.FilterCriteria
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	3, // 4: common.FilterGroup.filters:type_name -> common.FilterCriteria
+	2, // 5: common.SortSpec.direction:type_name -> common.SortDirection
+	6, // 6: common.Pagination.sort:type_name -> common.SortSpec
+	7, // 7: common.SearchRequest.pagination:type_name -> common.Pagination
+	3, // 8: common.SearchRequest.filters:type_name -> common.FilterCriteria
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_proto_common_common_proto_init() }
@@ -563,8 +875,8 @@ func file_proto_common_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_common_common_proto_rawDesc), len(file_proto_common_common_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   5,
+			NumEnums:      3,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
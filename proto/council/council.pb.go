@@ -76,20 +76,190 @@ func (DefencePosition) EnumDescriptor() ([]byte, []int) {
 	return file_proto_council_council_proto_rawDescGZIP(), []int{0}
 }
 
+type RubricStage int32
+
+const (
+	RubricStage_RUBRIC_STAGE_DACN RubricStage = 0
+	RubricStage_RUBRIC_STAGE_LVTN RubricStage = 1
+)
+
+// Enum value maps for RubricStage.
+var (
+	RubricStage_name = map[int32]string{
+		0: "RUBRIC_STAGE_DACN",
+		1: "RUBRIC_STAGE_LVTN",
+	}
+	RubricStage_value = map[string]int32{
+		"RUBRIC_STAGE_DACN": 0,
+		"RUBRIC_STAGE_LVTN": 1,
+	}
+)
+
+func (x RubricStage) Enum() *RubricStage {
+	p := new(RubricStage)
+	*p = x
+	return p
+}
+
+func (x RubricStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RubricStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_council_council_proto_enumTypes[1].Descriptor()
+}
+
+func (RubricStage) Type() protoreflect.EnumType {
+	return &file_proto_council_council_proto_enumTypes[1]
+}
+
+func (x RubricStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RubricStage.Descriptor instead.
+func (RubricStage) EnumDescriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{1}
+}
+
+// ============= Council validation =============
+type CouncilConflictKind int32
+
+const (
+	CouncilConflictKind_SUPERVISOR_ON_COUNCIL  CouncilConflictKind = 0
+	CouncilConflictKind_REVIEWER_IS_SUPERVISOR CouncilConflictKind = 1
+	CouncilConflictKind_DUPLICATE_POSITION     CouncilConflictKind = 2
+	CouncilConflictKind_DUPLICATE_MEMBER       CouncilConflictKind = 3
+	CouncilConflictKind_MISSING_PRESIDENT      CouncilConflictKind = 4
+	CouncilConflictKind_MISSING_SECRETARY      CouncilConflictKind = 5
+	CouncilConflictKind_CROSS_MAJOR_MEMBER     CouncilConflictKind = 6
+)
+
+// Enum value maps for CouncilConflictKind.
+var (
+	CouncilConflictKind_name = map[int32]string{
+		0: "SUPERVISOR_ON_COUNCIL",
+		1: "REVIEWER_IS_SUPERVISOR",
+		2: "DUPLICATE_POSITION",
+		3: "DUPLICATE_MEMBER",
+		4: "MISSING_PRESIDENT",
+		5: "MISSING_SECRETARY",
+		6: "CROSS_MAJOR_MEMBER",
+	}
+	CouncilConflictKind_value = map[string]int32{
+		"SUPERVISOR_ON_COUNCIL":  0,
+		"REVIEWER_IS_SUPERVISOR": 1,
+		"DUPLICATE_POSITION":     2,
+		"DUPLICATE_MEMBER":       3,
+		"MISSING_PRESIDENT":      4,
+		"MISSING_SECRETARY":      5,
+		"CROSS_MAJOR_MEMBER":     6,
+	}
+)
+
+func (x CouncilConflictKind) Enum() *CouncilConflictKind {
+	p := new(CouncilConflictKind)
+	*p = x
+	return p
+}
+
+func (x CouncilConflictKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CouncilConflictKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_council_council_proto_enumTypes[2].Descriptor()
+}
+
+func (CouncilConflictKind) Type() protoreflect.EnumType {
+	return &file_proto_council_council_proto_enumTypes[2]
+}
+
+func (x CouncilConflictKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CouncilConflictKind.Descriptor instead.
+func (CouncilConflictKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{2}
+}
+
+type GradeDefenceAmendmentStatus int32
+
+const (
+	GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_PENDING  GradeDefenceAmendmentStatus = 0
+	GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_APPROVED GradeDefenceAmendmentStatus = 1
+	GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_REJECTED GradeDefenceAmendmentStatus = 2
+)
+
+// Enum value maps for GradeDefenceAmendmentStatus.
+var (
+	GradeDefenceAmendmentStatus_name = map[int32]string{
+		0: "DEFENCE_AMENDMENT_PENDING",
+		1: "DEFENCE_AMENDMENT_APPROVED",
+		2: "DEFENCE_AMENDMENT_REJECTED",
+	}
+	GradeDefenceAmendmentStatus_value = map[string]int32{
+		"DEFENCE_AMENDMENT_PENDING":  0,
+		"DEFENCE_AMENDMENT_APPROVED": 1,
+		"DEFENCE_AMENDMENT_REJECTED": 2,
+	}
+)
+
+func (x GradeDefenceAmendmentStatus) Enum() *GradeDefenceAmendmentStatus {
+	p := new(GradeDefenceAmendmentStatus)
+	*p = x
+	return p
+}
+
+func (x GradeDefenceAmendmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GradeDefenceAmendmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_council_council_proto_enumTypes[3].Descriptor()
+}
+
+func (GradeDefenceAmendmentStatus) Type() protoreflect.EnumType {
+	return &file_proto_council_council_proto_enumTypes[3]
+}
+
+func (x GradeDefenceAmendmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GradeDefenceAmendmentStatus.Descriptor instead.
+func (GradeDefenceAmendmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{3}
+}
+
 // ============= Council =============
 type Council struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	MajorCode     string                 `protobuf:"bytes,3,opt,name=major_code,json=majorCode,proto3" json:"major_code,omitempty"`
-	SemesterCode  string                 `protobuf:"bytes,4,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
-	TimeStart     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedBy     string                 `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title        string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	MajorCode    string                 `protobuf:"bytes,3,opt,name=major_code,json=majorCode,proto3" json:"major_code,omitempty"`
+	SemesterCode string                 `protobuf:"bytes,4,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	TimeStart    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy    string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy    string                 `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Room         string                 `protobuf:"bytes,10,opt,name=room,proto3" json:"room,omitempty"`
+	// Once locked, the council's grade defences and their criteria only
+	// change through an approved GradeDefenceAmendment
+	GradesLocked   bool                   `protobuf:"varint,11,opt,name=grades_locked,json=gradesLocked,proto3" json:"grades_locked,omitempty"`
+	GradesLockedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=grades_locked_at,json=gradesLockedAt,proto3" json:"grades_locked_at,omitempty"`
+	GradesLockedBy string                 `protobuf:"bytes,13,opt,name=grades_locked_by,json=gradesLockedBy,proto3" json:"grades_locked_by,omitempty"`
+	// Students see the council's grades once published, which requires a lock
+	GradesPublished   bool                   `protobuf:"varint,14,opt,name=grades_published,json=gradesPublished,proto3" json:"grades_published,omitempty"`
+	GradesPublishedAt *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=grades_published_at,json=gradesPublishedAt,proto3" json:"grades_published_at,omitempty"`
+	GradesPublishedBy string                 `protobuf:"bytes,16,opt,name=grades_published_by,json=gradesPublishedBy,proto3" json:"grades_published_by,omitempty"`
+	Version           int32                  `protobuf:"varint,17,opt,name=version,proto3" json:"version,omitempty"`                     // bumped by every update
+	DeletedAt         *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // set while the record is soft-deleted
+	DeletedBy         string                 `protobuf:"bytes,19,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Council) Reset() {
@@ -185,6 +355,76 @@ func (x *Council) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *Council) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *Council) GetGradesLocked() bool {
+	if x != nil {
+		return x.GradesLocked
+	}
+	return false
+}
+
+func (x *Council) GetGradesLockedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GradesLockedAt
+	}
+	return nil
+}
+
+func (x *Council) GetGradesLockedBy() string {
+	if x != nil {
+		return x.GradesLockedBy
+	}
+	return ""
+}
+
+func (x *Council) GetGradesPublished() bool {
+	if x != nil {
+		return x.GradesPublished
+	}
+	return false
+}
+
+func (x *Council) GetGradesPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GradesPublishedAt
+	}
+	return nil
+}
+
+func (x *Council) GetGradesPublishedBy() string {
+	if x != nil {
+		return x.GradesPublishedBy
+	}
+	return ""
+}
+
+func (x *Council) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Council) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Council) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
 type CreateCouncilRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -192,6 +432,7 @@ type CreateCouncilRequest struct {
 	SemesterCode  string                 `protobuf:"bytes,3,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
 	TimeStart     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time_start,json=timeStart,proto3,oneof" json:"time_start,omitempty"`
 	CreatedBy     string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Room          *string                `protobuf:"bytes,6,opt,name=room,proto3,oneof" json:"room,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -261,6 +502,13 @@ func (x *CreateCouncilRequest) GetCreatedBy() string {
 	return ""
 }
 
+func (x *CreateCouncilRequest) GetRoom() string {
+	if x != nil && x.Room != nil {
+		return *x.Room
+	}
+	return ""
+}
+
 type CreateCouncilResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Council       *Council               `protobuf:"bytes,1,opt,name=council,proto3" json:"council,omitempty"`
@@ -306,10 +554,11 @@ func (x *CreateCouncilResponse) GetCouncil() *Council {
 }
 
 type GetCouncilRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also find a soft-deleted record
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetCouncilRequest) Reset() {
@@ -349,6 +598,13 @@ func (x *GetCouncilRequest) GetId() string {
 	return ""
 }
 
+func (x *GetCouncilRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type GetCouncilResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Council       *Council               `protobuf:"bytes,1,opt,name=council,proto3" json:"council,omitempty"`
@@ -401,6 +657,8 @@ type UpdateCouncilRequest struct {
 	SemesterCode  *string                `protobuf:"bytes,4,opt,name=semester_code,json=semesterCode,proto3,oneof" json:"semester_code,omitempty"`
 	TimeStart     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time_start,json=timeStart,proto3,oneof" json:"time_start,omitempty"`
 	UpdatedBy     string                 `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Room          *string                `protobuf:"bytes,7,opt,name=room,proto3,oneof" json:"room,omitempty"`
+	Version       *int32                 `protobuf:"varint,8,opt,name=version,proto3,oneof" json:"version,omitempty"` // expected version; a stale one fails with ABORTED
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -477,6 +735,20 @@ func (x *UpdateCouncilRequest) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *UpdateCouncilRequest) GetRoom() string {
+	if x != nil && x.Room != nil {
+		return *x.Room
+	}
+	return ""
+}
+
+func (x *UpdateCouncilRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
 type UpdateCouncilResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Council       *Council               `protobuf:"bytes,1,opt,name=council,proto3" json:"council,omitempty"`
@@ -521,27 +793,31 @@ func (x *UpdateCouncilResponse) GetCouncil() *Council {
 	return nil
 }
 
-type DeleteCouncilRequest struct {
+// Room and time of a council in a defence timetable
+type CouncilSlot struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Room          string                 `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	TimeStart     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"` // unset clears it
+	Version       *int32                 `protobuf:"varint,4,opt,name=version,proto3,oneof" json:"version,omitempty"`               // expected version; a stale one fails with ABORTED
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCouncilRequest) Reset() {
-	*x = DeleteCouncilRequest{}
+func (x *CouncilSlot) Reset() {
+	*x = CouncilSlot{}
 	mi := &file_proto_council_council_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCouncilRequest) String() string {
+func (x *CouncilSlot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCouncilRequest) ProtoMessage() {}
+func (*CouncilSlot) ProtoMessage() {}
 
-func (x *DeleteCouncilRequest) ProtoReflect() protoreflect.Message {
+func (x *CouncilSlot) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_council_council_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -553,39 +829,62 @@ func (x *DeleteCouncilRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCouncilRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCouncilRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CouncilSlot.ProtoReflect.Descriptor instead.
+func (*CouncilSlot) Descriptor() ([]byte, []int) {
 	return file_proto_council_council_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DeleteCouncilRequest) GetId() string {
+func (x *CouncilSlot) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteCouncilResponse struct {
+func (x *CouncilSlot) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *CouncilSlot) GetTimeStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeStart
+	}
+	return nil
+}
+
+func (x *CouncilSlot) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+// Places every council of a timetable in one transaction, or none
+type ScheduleCouncilsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Councils      []*CouncilSlot         `protobuf:"bytes,1,rep,name=councils,proto3" json:"councils,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCouncilResponse) Reset() {
-	*x = DeleteCouncilResponse{}
+func (x *ScheduleCouncilsRequest) Reset() {
+	*x = ScheduleCouncilsRequest{}
 	mi := &file_proto_council_council_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCouncilResponse) String() string {
+func (x *ScheduleCouncilsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCouncilResponse) ProtoMessage() {}
+func (*ScheduleCouncilsRequest) ProtoMessage() {}
 
-func (x *DeleteCouncilResponse) ProtoReflect() protoreflect.Message {
+func (x *ScheduleCouncilsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_council_council_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -597,39 +896,46 @@ func (x *DeleteCouncilResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCouncilResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCouncilResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScheduleCouncilsRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleCouncilsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_council_council_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DeleteCouncilResponse) GetSuccess() bool {
+func (x *ScheduleCouncilsRequest) GetCouncils() []*CouncilSlot {
 	if x != nil {
-		return x.Success
+		return x.Councils
 	}
-	return false
+	return nil
 }
 
-type ListCouncilsRequest struct {
+func (x *ScheduleCouncilsRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type ScheduleCouncilsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	Councils      []*Council             `protobuf:"bytes,1,rep,name=councils,proto3" json:"councils,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCouncilsRequest) Reset() {
-	*x = ListCouncilsRequest{}
+func (x *ScheduleCouncilsResponse) Reset() {
+	*x = ScheduleCouncilsResponse{}
 	mi := &file_proto_council_council_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCouncilsRequest) String() string {
+func (x *ScheduleCouncilsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCouncilsRequest) ProtoMessage() {}
+func (*ScheduleCouncilsResponse) ProtoMessage() {}
 
-func (x *ListCouncilsRequest) ProtoReflect() protoreflect.Message {
+func (x *ScheduleCouncilsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_council_council_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -641,42 +947,40 @@ func (x *ListCouncilsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCouncilsRequest.ProtoReflect.Descriptor instead.
-func (*ListCouncilsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScheduleCouncilsResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleCouncilsResponse) Descriptor() ([]byte, []int) {
 	return file_proto_council_council_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ListCouncilsRequest) GetSearch() *common.SearchRequest {
+func (x *ScheduleCouncilsResponse) GetCouncils() []*Council {
 	if x != nil {
-		return x.Search
+		return x.Councils
 	}
 	return nil
 }
 
-type ListCouncilsResponse struct {
+type DeleteCouncilRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Councils      []*Council             `protobuf:"bytes,1,rep,name=councils,proto3" json:"councils,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCouncilsResponse) Reset() {
-	*x = ListCouncilsResponse{}
+func (x *DeleteCouncilRequest) Reset() {
+	*x = DeleteCouncilRequest{}
 	mi := &file_proto_council_council_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCouncilsResponse) String() string {
+func (x *DeleteCouncilRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCouncilsResponse) ProtoMessage() {}
+func (*DeleteCouncilRequest) ProtoMessage() {}
 
-func (x *ListCouncilsResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteCouncilRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_council_council_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -688,70 +992,2763 @@ func (x *ListCouncilsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCouncilsResponse.ProtoReflect.Descriptor instead.
-func (*ListCouncilsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCouncilRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCouncilRequest) Descriptor() ([]byte, []int) {
 	return file_proto_council_council_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListCouncilsResponse) GetCouncils() []*Council {
+func (x *DeleteCouncilRequest) GetId() string {
 	if x != nil {
-		return x.Councils
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ListCouncilsResponse) GetTotal() int32 {
+func (x *DeleteCouncilRequest) GetDeletedBy() string {
 	if x != nil {
-		return x.Total
+		return x.DeletedBy
 	}
-	return 0
+	return ""
 }
 
-func (x *ListCouncilsResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
+type DeleteCouncilResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCouncilResponse) Reset() {
+	*x = DeleteCouncilResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCouncilResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCouncilResponse) ProtoMessage() {}
+
+func (x *DeleteCouncilResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCouncilResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCouncilResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteCouncilResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RestoreCouncilRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RestoredBy    string                 `protobuf:"bytes,2,opt,name=restored_by,json=restoredBy,proto3" json:"restored_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCouncilRequest) Reset() {
+	*x = RestoreCouncilRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCouncilRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCouncilRequest) ProtoMessage() {}
+
+func (x *RestoreCouncilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCouncilRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCouncilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RestoreCouncilRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreCouncilRequest) GetRestoredBy() string {
+	if x != nil {
+		return x.RestoredBy
+	}
+	return ""
+}
+
+type RestoreCouncilResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Council       *Council               `protobuf:"bytes,1,opt,name=council,proto3" json:"council,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCouncilResponse) Reset() {
+	*x = RestoreCouncilResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCouncilResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCouncilResponse) ProtoMessage() {}
+
+func (x *RestoreCouncilResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCouncilResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCouncilResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RestoreCouncilResponse) GetCouncil() *Council {
+	if x != nil {
+		return x.Council
+	}
+	return nil
+}
+
+type ListCouncilsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Search         *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also list soft-deleted records
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListCouncilsRequest) Reset() {
+	*x = ListCouncilsRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouncilsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouncilsRequest) ProtoMessage() {}
+
+func (x *ListCouncilsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouncilsRequest.ProtoReflect.Descriptor instead.
+func (*ListCouncilsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListCouncilsRequest) GetSearch() *common.SearchRequest {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *ListCouncilsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ListCouncilsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Councils      []*Council             `protobuf:"bytes,1,rep,name=councils,proto3" json:"councils,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouncilsResponse) Reset() {
+	*x = ListCouncilsResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouncilsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouncilsResponse) ProtoMessage() {}
+
+func (x *ListCouncilsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouncilsResponse.ProtoReflect.Descriptor instead.
+func (*ListCouncilsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListCouncilsResponse) GetCouncils() []*Council {
+	if x != nil {
+		return x.Councils
+	}
+	return nil
+}
+
+func (x *ListCouncilsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListCouncilsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCouncilsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ============= Defence =============
+type Defence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	CouncilCode   string                 `protobuf:"bytes,3,opt,name=council_code,json=councilCode,proto3" json:"council_code,omitempty"`
+	TeacherCode   string                 `protobuf:"bytes,4,opt,name=teacher_code,json=teacherCode,proto3" json:"teacher_code,omitempty"`
+	Position      DefencePosition        `protobuf:"varint,5,opt,name=position,proto3,enum=council.DefencePosition" json:"position,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       int32                  `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`                     // bumped by every update
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // set while the record is soft-deleted
+	DeletedBy     string                 `protobuf:"bytes,12,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Defence) Reset() {
+	*x = Defence{}
+	mi := &file_proto_council_council_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Defence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Defence) ProtoMessage() {}
+
+func (x *Defence) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Defence.ProtoReflect.Descriptor instead.
+func (*Defence) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Defence) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Defence) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Defence) GetCouncilCode() string {
+	if x != nil {
+		return x.CouncilCode
+	}
+	return ""
+}
+
+func (x *Defence) GetTeacherCode() string {
+	if x != nil {
+		return x.TeacherCode
+	}
+	return ""
+}
+
+func (x *Defence) GetPosition() DefencePosition {
+	if x != nil {
+		return x.Position
+	}
+	return DefencePosition_PRESIDENT
+}
+
+func (x *Defence) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Defence) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Defence) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Defence) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *Defence) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Defence) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Defence) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type CreateDefenceRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	CouncilCode string                 `protobuf:"bytes,2,opt,name=council_code,json=councilCode,proto3" json:"council_code,omitempty"`
+	TeacherCode string                 `protobuf:"bytes,3,opt,name=teacher_code,json=teacherCode,proto3" json:"teacher_code,omitempty"`
+	Position    DefencePosition        `protobuf:"varint,4,opt,name=position,proto3,enum=council.DefencePosition" json:"position,omitempty"`
+	CreatedBy   string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// User data the conflict checks need. The supervisors and reviewers are
+	// read from the thesis service; only the topic council codes of topics
+	// are used, to list councils about to be assigned.
+	Validation *CouncilValidationContext `protobuf:"bytes,6,opt,name=validation,proto3" json:"validation,omitempty"`
+	// Adds the member despite conflicts; the override is recorded
+	OverrideReason *string `protobuf:"bytes,7,opt,name=override_reason,json=overrideReason,proto3,oneof" json:"override_reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateDefenceRequest) Reset() {
+	*x = CreateDefenceRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDefenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDefenceRequest) ProtoMessage() {}
+
+func (x *CreateDefenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDefenceRequest.ProtoReflect.Descriptor instead.
+func (*CreateDefenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateDefenceRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateDefenceRequest) GetCouncilCode() string {
+	if x != nil {
+		return x.CouncilCode
+	}
+	return ""
+}
+
+func (x *CreateDefenceRequest) GetTeacherCode() string {
+	if x != nil {
+		return x.TeacherCode
+	}
+	return ""
+}
+
+func (x *CreateDefenceRequest) GetPosition() DefencePosition {
+	if x != nil {
+		return x.Position
+	}
+	return DefencePosition_PRESIDENT
+}
+
+func (x *CreateDefenceRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CreateDefenceRequest) GetValidation() *CouncilValidationContext {
+	if x != nil {
+		return x.Validation
+	}
+	return nil
+}
+
+func (x *CreateDefenceRequest) GetOverrideReason() string {
+	if x != nil && x.OverrideReason != nil {
+		return *x.OverrideReason
+	}
+	return ""
+}
+
+type CreateDefenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Defence       *Defence               `protobuf:"bytes,1,opt,name=defence,proto3" json:"defence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDefenceResponse) Reset() {
+	*x = CreateDefenceResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDefenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDefenceResponse) ProtoMessage() {}
+
+func (x *CreateDefenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDefenceResponse.ProtoReflect.Descriptor instead.
+func (*CreateDefenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateDefenceResponse) GetDefence() *Defence {
+	if x != nil {
+		return x.Defence
+	}
+	return nil
+}
+
+type GetDefenceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also find a soft-deleted record
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetDefenceRequest) Reset() {
+	*x = GetDefenceRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDefenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDefenceRequest) ProtoMessage() {}
+
+func (x *GetDefenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDefenceRequest.ProtoReflect.Descriptor instead.
+func (*GetDefenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetDefenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetDefenceRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type GetDefenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Defence       *Defence               `protobuf:"bytes,1,opt,name=defence,proto3" json:"defence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDefenceResponse) Reset() {
+	*x = GetDefenceResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDefenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDefenceResponse) ProtoMessage() {}
+
+func (x *GetDefenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDefenceResponse.ProtoReflect.Descriptor instead.
+func (*GetDefenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetDefenceResponse) GetDefence() *Defence {
+	if x != nil {
+		return x.Defence
+	}
+	return nil
+}
+
+type UpdateDefenceRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	CouncilCode *string                `protobuf:"bytes,3,opt,name=council_code,json=councilCode,proto3,oneof" json:"council_code,omitempty"`
+	TeacherCode *string                `protobuf:"bytes,4,opt,name=teacher_code,json=teacherCode,proto3,oneof" json:"teacher_code,omitempty"`
+	Position    *DefencePosition       `protobuf:"varint,5,opt,name=position,proto3,enum=council.DefencePosition,oneof" json:"position,omitempty"`
+	UpdatedBy   string                 `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version     *int32                 `protobuf:"varint,7,opt,name=version,proto3,oneof" json:"version,omitempty"` // expected version; a stale one fails with ABORTED
+	// User data the conflict checks need, as in CreateDefence
+	Validation *CouncilValidationContext `protobuf:"bytes,8,opt,name=validation,proto3" json:"validation,omitempty"`
+	// Applies the change despite conflicts; the override is recorded
+	OverrideReason *string `protobuf:"bytes,9,opt,name=override_reason,json=overrideReason,proto3,oneof" json:"override_reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateDefenceRequest) Reset() {
+	*x = UpdateDefenceRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDefenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDefenceRequest) ProtoMessage() {}
+
+func (x *UpdateDefenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDefenceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDefenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateDefenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateDefenceRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateDefenceRequest) GetCouncilCode() string {
+	if x != nil && x.CouncilCode != nil {
+		return *x.CouncilCode
+	}
+	return ""
+}
+
+func (x *UpdateDefenceRequest) GetTeacherCode() string {
+	if x != nil && x.TeacherCode != nil {
+		return *x.TeacherCode
+	}
+	return ""
+}
+
+func (x *UpdateDefenceRequest) GetPosition() DefencePosition {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return DefencePosition_PRESIDENT
+}
+
+func (x *UpdateDefenceRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *UpdateDefenceRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *UpdateDefenceRequest) GetValidation() *CouncilValidationContext {
+	if x != nil {
+		return x.Validation
+	}
+	return nil
+}
+
+func (x *UpdateDefenceRequest) GetOverrideReason() string {
+	if x != nil && x.OverrideReason != nil {
+		return *x.OverrideReason
+	}
+	return ""
+}
+
+type UpdateDefenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Defence       *Defence               `protobuf:"bytes,1,opt,name=defence,proto3" json:"defence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDefenceResponse) Reset() {
+	*x = UpdateDefenceResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDefenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDefenceResponse) ProtoMessage() {}
+
+func (x *UpdateDefenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDefenceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDefenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateDefenceResponse) GetDefence() *Defence {
+	if x != nil {
+		return x.Defence
+	}
+	return nil
+}
+
+type DeleteDefenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDefenceRequest) Reset() {
+	*x = DeleteDefenceRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDefenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDefenceRequest) ProtoMessage() {}
+
+func (x *DeleteDefenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDefenceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDefenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteDefenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteDefenceRequest) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type DeleteDefenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDefenceResponse) Reset() {
+	*x = DeleteDefenceResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDefenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDefenceResponse) ProtoMessage() {}
+
+func (x *DeleteDefenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDefenceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDefenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteDefenceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RestoreDefenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RestoredBy    string                 `protobuf:"bytes,2,opt,name=restored_by,json=restoredBy,proto3" json:"restored_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreDefenceRequest) Reset() {
+	*x = RestoreDefenceRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreDefenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreDefenceRequest) ProtoMessage() {}
+
+func (x *RestoreDefenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreDefenceRequest.ProtoReflect.Descriptor instead.
+func (*RestoreDefenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RestoreDefenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreDefenceRequest) GetRestoredBy() string {
+	if x != nil {
+		return x.RestoredBy
+	}
+	return ""
+}
+
+type RestoreDefenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Defence       *Defence               `protobuf:"bytes,1,opt,name=defence,proto3" json:"defence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreDefenceResponse) Reset() {
+	*x = RestoreDefenceResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreDefenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreDefenceResponse) ProtoMessage() {}
+
+func (x *RestoreDefenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreDefenceResponse.ProtoReflect.Descriptor instead.
+func (*RestoreDefenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RestoreDefenceResponse) GetDefence() *Defence {
+	if x != nil {
+		return x.Defence
+	}
+	return nil
+}
+
+type ListDefencesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Search         *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also list soft-deleted records
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListDefencesRequest) Reset() {
+	*x = ListDefencesRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDefencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDefencesRequest) ProtoMessage() {}
+
+func (x *ListDefencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDefencesRequest.ProtoReflect.Descriptor instead.
+func (*ListDefencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListDefencesRequest) GetSearch() *common.SearchRequest {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *ListDefencesRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ListDefencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Defences      []*Defence             `protobuf:"bytes,1,rep,name=defences,proto3" json:"defences,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDefencesResponse) Reset() {
+	*x = ListDefencesResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDefencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDefencesResponse) ProtoMessage() {}
+
+func (x *ListDefencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDefencesResponse.ProtoReflect.Descriptor instead.
+func (*ListDefencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListDefencesResponse) GetDefences() []*Defence {
+	if x != nil {
+		return x.Defences
+	}
+	return nil
+}
+
+func (x *ListDefencesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListDefencesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDefencesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ============= Grade_defence =============
+type GradeDefence struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DefenceCode    string                 `protobuf:"bytes,2,opt,name=defence_code,json=defenceCode,proto3" json:"defence_code,omitempty"`
+	EnrollmentCode string                 `protobuf:"bytes,3,opt,name=enrollment_code,json=enrollmentCode,proto3" json:"enrollment_code,omitempty"`
+	Note           string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	// Computed from the weighted criteria once every criterion is scored, on a 10-point scale
+	TotalScore         *float64               `protobuf:"fixed64,5,opt,name=total_score,json=totalScore,proto3,oneof" json:"total_score,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy          string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy          string                 `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	RubricTemplateCode string                 `protobuf:"bytes,10,opt,name=rubric_template_code,json=rubricTemplateCode,proto3" json:"rubric_template_code,omitempty"`
+	Version            int32                  `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`                     // bumped by every update
+	DeletedAt          *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // set while the record is soft-deleted
+	DeletedBy          string                 `protobuf:"bytes,13,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GradeDefence) Reset() {
+	*x = GradeDefence{}
+	mi := &file_proto_council_council_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeDefence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeDefence) ProtoMessage() {}
+
+func (x *GradeDefence) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeDefence.ProtoReflect.Descriptor instead.
+func (*GradeDefence) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GradeDefence) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GradeDefence) GetDefenceCode() string {
+	if x != nil {
+		return x.DefenceCode
+	}
+	return ""
+}
+
+func (x *GradeDefence) GetEnrollmentCode() string {
+	if x != nil {
+		return x.EnrollmentCode
+	}
+	return ""
+}
+
+func (x *GradeDefence) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *GradeDefence) GetTotalScore() float64 {
+	if x != nil && x.TotalScore != nil {
+		return *x.TotalScore
+	}
+	return 0
+}
+
+func (x *GradeDefence) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GradeDefence) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GradeDefence) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *GradeDefence) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *GradeDefence) GetRubricTemplateCode() string {
+	if x != nil {
+		return x.RubricTemplateCode
+	}
+	return ""
+}
+
+func (x *GradeDefence) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GradeDefence) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *GradeDefence) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type CreateGradeDefenceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DefenceCode    string                 `protobuf:"bytes,1,opt,name=defence_code,json=defenceCode,proto3" json:"defence_code,omitempty"`
+	EnrollmentCode string                 `protobuf:"bytes,2,opt,name=enrollment_code,json=enrollmentCode,proto3" json:"enrollment_code,omitempty"`
+	Note           *string                `protobuf:"bytes,3,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// Stage of the enrollment's topic; picks the rubric template with the council's major
+	Stage         RubricStage `protobuf:"varint,6,opt,name=stage,proto3,enum=council.RubricStage" json:"stage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGradeDefenceRequest) Reset() {
+	*x = CreateGradeDefenceRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGradeDefenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGradeDefenceRequest) ProtoMessage() {}
+
+func (x *CreateGradeDefenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGradeDefenceRequest.ProtoReflect.Descriptor instead.
+func (*CreateGradeDefenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateGradeDefenceRequest) GetDefenceCode() string {
+	if x != nil {
+		return x.DefenceCode
+	}
+	return ""
+}
+
+func (x *CreateGradeDefenceRequest) GetEnrollmentCode() string {
+	if x != nil {
+		return x.EnrollmentCode
+	}
+	return ""
+}
+
+func (x *CreateGradeDefenceRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *CreateGradeDefenceRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CreateGradeDefenceRequest) GetStage() RubricStage {
+	if x != nil {
+		return x.Stage
+	}
+	return RubricStage_RUBRIC_STAGE_DACN
+}
+
+type CreateGradeDefenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GradeDefence  *GradeDefence          `protobuf:"bytes,1,opt,name=grade_defence,json=gradeDefence,proto3" json:"grade_defence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGradeDefenceResponse) Reset() {
+	*x = CreateGradeDefenceResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGradeDefenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGradeDefenceResponse) ProtoMessage() {}
+
+func (x *CreateGradeDefenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGradeDefenceResponse.ProtoReflect.Descriptor instead.
+func (*CreateGradeDefenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateGradeDefenceResponse) GetGradeDefence() *GradeDefence {
+	if x != nil {
+		return x.GradeDefence
+	}
+	return nil
+}
+
+type GetGradeDefenceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also find a soft-deleted record
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetGradeDefenceRequest) Reset() {
+	*x = GetGradeDefenceRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeDefenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeDefenceRequest) ProtoMessage() {}
+
+func (x *GetGradeDefenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeDefenceRequest.ProtoReflect.Descriptor instead.
+func (*GetGradeDefenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetGradeDefenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGradeDefenceRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type GetGradeDefenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GradeDefence  *GradeDefence          `protobuf:"bytes,1,opt,name=grade_defence,json=gradeDefence,proto3" json:"grade_defence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradeDefenceResponse) Reset() {
+	*x = GetGradeDefenceResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeDefenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeDefenceResponse) ProtoMessage() {}
+
+func (x *GetGradeDefenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeDefenceResponse.ProtoReflect.Descriptor instead.
+func (*GetGradeDefenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetGradeDefenceResponse) GetGradeDefence() *GradeDefence {
+	if x != nil {
+		return x.GradeDefence
+	}
+	return nil
+}
+
+type UpdateGradeDefenceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DefenceCode    *string                `protobuf:"bytes,2,opt,name=defence_code,json=defenceCode,proto3,oneof" json:"defence_code,omitempty"`
+	EnrollmentCode *string                `protobuf:"bytes,3,opt,name=enrollment_code,json=enrollmentCode,proto3,oneof" json:"enrollment_code,omitempty"`
+	Note           *string                `protobuf:"bytes,4,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	UpdatedBy      string                 `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version        *int32                 `protobuf:"varint,7,opt,name=version,proto3,oneof" json:"version,omitempty"` // expected version; a stale one fails with ABORTED
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateGradeDefenceRequest) Reset() {
+	*x = UpdateGradeDefenceRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGradeDefenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGradeDefenceRequest) ProtoMessage() {}
+
+func (x *UpdateGradeDefenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGradeDefenceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGradeDefenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateGradeDefenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateGradeDefenceRequest) GetDefenceCode() string {
+	if x != nil && x.DefenceCode != nil {
+		return *x.DefenceCode
+	}
+	return ""
+}
+
+func (x *UpdateGradeDefenceRequest) GetEnrollmentCode() string {
+	if x != nil && x.EnrollmentCode != nil {
+		return *x.EnrollmentCode
+	}
+	return ""
+}
+
+func (x *UpdateGradeDefenceRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *UpdateGradeDefenceRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *UpdateGradeDefenceRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type UpdateGradeDefenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GradeDefence  *GradeDefence          `protobuf:"bytes,1,opt,name=grade_defence,json=gradeDefence,proto3" json:"grade_defence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGradeDefenceResponse) Reset() {
+	*x = UpdateGradeDefenceResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGradeDefenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGradeDefenceResponse) ProtoMessage() {}
+
+func (x *UpdateGradeDefenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGradeDefenceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGradeDefenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateGradeDefenceResponse) GetGradeDefence() *GradeDefence {
+	if x != nil {
+		return x.GradeDefence
+	}
+	return nil
+}
+
+type DeleteGradeDefenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGradeDefenceRequest) Reset() {
+	*x = DeleteGradeDefenceRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGradeDefenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGradeDefenceRequest) ProtoMessage() {}
+
+func (x *DeleteGradeDefenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGradeDefenceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGradeDefenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteGradeDefenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteGradeDefenceRequest) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type DeleteGradeDefenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGradeDefenceResponse) Reset() {
+	*x = DeleteGradeDefenceResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGradeDefenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGradeDefenceResponse) ProtoMessage() {}
+
+func (x *DeleteGradeDefenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGradeDefenceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGradeDefenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeleteGradeDefenceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RestoreGradeDefenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RestoredBy    string                 `protobuf:"bytes,2,opt,name=restored_by,json=restoredBy,proto3" json:"restored_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreGradeDefenceRequest) Reset() {
+	*x = RestoreGradeDefenceRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreGradeDefenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreGradeDefenceRequest) ProtoMessage() {}
+
+func (x *RestoreGradeDefenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreGradeDefenceRequest.ProtoReflect.Descriptor instead.
+func (*RestoreGradeDefenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RestoreGradeDefenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreGradeDefenceRequest) GetRestoredBy() string {
+	if x != nil {
+		return x.RestoredBy
+	}
+	return ""
+}
+
+type RestoreGradeDefenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GradeDefence  *GradeDefence          `protobuf:"bytes,1,opt,name=grade_defence,json=gradeDefence,proto3" json:"grade_defence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreGradeDefenceResponse) Reset() {
+	*x = RestoreGradeDefenceResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreGradeDefenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreGradeDefenceResponse) ProtoMessage() {}
+
+func (x *RestoreGradeDefenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreGradeDefenceResponse.ProtoReflect.Descriptor instead.
+func (*RestoreGradeDefenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RestoreGradeDefenceResponse) GetGradeDefence() *GradeDefence {
+	if x != nil {
+		return x.GradeDefence
+	}
+	return nil
+}
+
+type ListGradeDefencesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Search         *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also list soft-deleted records
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListGradeDefencesRequest) Reset() {
+	*x = ListGradeDefencesRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGradeDefencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGradeDefencesRequest) ProtoMessage() {}
+
+func (x *ListGradeDefencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGradeDefencesRequest.ProtoReflect.Descriptor instead.
+func (*ListGradeDefencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListGradeDefencesRequest) GetSearch() *common.SearchRequest {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *ListGradeDefencesRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ListGradeDefencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GradeDefences []*GradeDefence        `protobuf:"bytes,1,rep,name=grade_defences,json=gradeDefences,proto3" json:"grade_defences,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGradeDefencesResponse) Reset() {
+	*x = ListGradeDefencesResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGradeDefencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGradeDefencesResponse) ProtoMessage() {}
+
+func (x *ListGradeDefencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGradeDefencesResponse.ProtoReflect.Descriptor instead.
+func (*ListGradeDefencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListGradeDefencesResponse) GetGradeDefences() []*GradeDefence {
+	if x != nil {
+		return x.GradeDefences
+	}
+	return nil
+}
+
+func (x *ListGradeDefencesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListGradeDefencesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListGradeDefencesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ============= Grade_defence_criterion =============
+type GradeDefenceCriterion struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GradeDefenceCode string                 `protobuf:"bytes,2,opt,name=grade_defence_code,json=gradeDefenceCode,proto3" json:"grade_defence_code,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Score            *float64               `protobuf:"fixed64,4,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	MaxScore         float64                `protobuf:"fixed64,5,opt,name=maxScore,proto3" json:"maxScore,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy        string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy        string                 `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Description      string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	Weight           float64                `protobuf:"fixed64,11,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Set when the criterion was copied from a rubric template; only its score can change
+	RubricCriterionCode string `protobuf:"bytes,12,opt,name=rubric_criterion_code,json=rubricCriterionCode,proto3" json:"rubric_criterion_code,omitempty"`
+	Version             int32  `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"` // bumped by every update
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GradeDefenceCriterion) Reset() {
+	*x = GradeDefenceCriterion{}
+	mi := &file_proto_council_council_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeDefenceCriterion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeDefenceCriterion) ProtoMessage() {}
+
+func (x *GradeDefenceCriterion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeDefenceCriterion.ProtoReflect.Descriptor instead.
+func (*GradeDefenceCriterion) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GradeDefenceCriterion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GradeDefenceCriterion) GetGradeDefenceCode() string {
+	if x != nil {
+		return x.GradeDefenceCode
+	}
+	return ""
+}
+
+func (x *GradeDefenceCriterion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GradeDefenceCriterion) GetScore() float64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *GradeDefenceCriterion) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *GradeDefenceCriterion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GradeDefenceCriterion) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GradeDefenceCriterion) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *GradeDefenceCriterion) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *GradeDefenceCriterion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GradeDefenceCriterion) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *GradeDefenceCriterion) GetRubricCriterionCode() string {
+	if x != nil {
+		return x.RubricCriterionCode
+	}
+	return ""
+}
+
+func (x *GradeDefenceCriterion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type CreateGradeDefenceCriterionRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	GradeDefenceCode string                 `protobuf:"bytes,1,opt,name=grade_defence_code,json=gradeDefenceCode,proto3" json:"grade_defence_code,omitempty"`
+	Name             *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Score            *float64               `protobuf:"fixed64,3,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	MaxScore         *float64               `protobuf:"fixed64,4,opt,name=maxScore,proto3,oneof" json:"maxScore,omitempty"`
+	CreatedBy        *string                `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	Description      *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Defaults to 1
+	Weight        *float64 `protobuf:"fixed64,7,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGradeDefenceCriterionRequest) Reset() {
+	*x = CreateGradeDefenceCriterionRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGradeDefenceCriterionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGradeDefenceCriterionRequest) ProtoMessage() {}
+
+func (x *CreateGradeDefenceCriterionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGradeDefenceCriterionRequest.ProtoReflect.Descriptor instead.
+func (*CreateGradeDefenceCriterionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateGradeDefenceCriterionRequest) GetGradeDefenceCode() string {
+	if x != nil {
+		return x.GradeDefenceCode
+	}
+	return ""
+}
+
+func (x *CreateGradeDefenceCriterionRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *CreateGradeDefenceCriterionRequest) GetScore() float64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *CreateGradeDefenceCriterionRequest) GetMaxScore() float64 {
+	if x != nil && x.MaxScore != nil {
+		return *x.MaxScore
+	}
+	return 0
+}
+
+func (x *CreateGradeDefenceCriterionRequest) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CreateGradeDefenceCriterionRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateGradeDefenceCriterionRequest) GetWeight() float64 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+type CreateGradeDefenceCriterionResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	GradeDefenceCriterion *GradeDefenceCriterion `protobuf:"bytes,1,opt,name=grade_defence_criterion,json=gradeDefenceCriterion,proto3" json:"grade_defence_criterion,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreateGradeDefenceCriterionResponse) Reset() {
+	*x = CreateGradeDefenceCriterionResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGradeDefenceCriterionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGradeDefenceCriterionResponse) ProtoMessage() {}
+
+func (x *CreateGradeDefenceCriterionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGradeDefenceCriterionResponse.ProtoReflect.Descriptor instead.
+func (*CreateGradeDefenceCriterionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateGradeDefenceCriterionResponse) GetGradeDefenceCriterion() *GradeDefenceCriterion {
+	if x != nil {
+		return x.GradeDefenceCriterion
+	}
+	return nil
+}
+
+type GetGradeDefenceCriterionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradeDefenceCriterionRequest) Reset() {
+	*x = GetGradeDefenceCriterionRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeDefenceCriterionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeDefenceCriterionRequest) ProtoMessage() {}
+
+func (x *GetGradeDefenceCriterionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeDefenceCriterionRequest.ProtoReflect.Descriptor instead.
+func (*GetGradeDefenceCriterionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetGradeDefenceCriterionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetGradeDefenceCriterionResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	GradeDefenceCriterion *GradeDefenceCriterion `protobuf:"bytes,1,opt,name=grade_defence_criterion,json=gradeDefenceCriterion,proto3" json:"grade_defence_criterion,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetGradeDefenceCriterionResponse) Reset() {
+	*x = GetGradeDefenceCriterionResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeDefenceCriterionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeDefenceCriterionResponse) ProtoMessage() {}
+
+func (x *GetGradeDefenceCriterionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeDefenceCriterionResponse.ProtoReflect.Descriptor instead.
+func (*GetGradeDefenceCriterionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetGradeDefenceCriterionResponse) GetGradeDefenceCriterion() *GradeDefenceCriterion {
+	if x != nil {
+		return x.GradeDefenceCriterion
+	}
+	return nil
+}
+
+type UpdateGradeDefenceCriterionRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GradeDefenceCode *string                `protobuf:"bytes,2,opt,name=grade_defence_code,json=gradeDefenceCode,proto3,oneof" json:"grade_defence_code,omitempty"`
+	Name             *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Score            *float64               `protobuf:"fixed64,4,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	MaxScore         *float64               `protobuf:"fixed64,5,opt,name=maxScore,proto3,oneof" json:"maxScore,omitempty"`
+	UpdatedBy        *string                `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
+	Description      *string                `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Weight           *float64               `protobuf:"fixed64,8,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
+	Version          *int32                 `protobuf:"varint,9,opt,name=version,proto3,oneof" json:"version,omitempty"` // expected version; a stale one fails with ABORTED
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateGradeDefenceCriterionRequest) Reset() {
+	*x = UpdateGradeDefenceCriterionRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGradeDefenceCriterionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGradeDefenceCriterionRequest) ProtoMessage() {}
+
+func (x *UpdateGradeDefenceCriterionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGradeDefenceCriterionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGradeDefenceCriterionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateGradeDefenceCriterionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateGradeDefenceCriterionRequest) GetGradeDefenceCode() string {
+	if x != nil && x.GradeDefenceCode != nil {
+		return *x.GradeDefenceCode
+	}
+	return ""
+}
+
+func (x *UpdateGradeDefenceCriterionRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGradeDefenceCriterionRequest) GetScore() float64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *UpdateGradeDefenceCriterionRequest) GetMaxScore() float64 {
+	if x != nil && x.MaxScore != nil {
+		return *x.MaxScore
+	}
+	return 0
+}
+
+func (x *UpdateGradeDefenceCriterionRequest) GetUpdatedBy() string {
+	if x != nil && x.UpdatedBy != nil {
+		return *x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *UpdateGradeDefenceCriterionRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateGradeDefenceCriterionRequest) GetWeight() float64 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+func (x *UpdateGradeDefenceCriterionRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type UpdateGradeDefenceCriterionResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	GradeDefenceCriterion *GradeDefenceCriterion `protobuf:"bytes,1,opt,name=grade_defence_criterion,json=gradeDefenceCriterion,proto3" json:"grade_defence_criterion,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateGradeDefenceCriterionResponse) Reset() {
+	*x = UpdateGradeDefenceCriterionResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGradeDefenceCriterionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGradeDefenceCriterionResponse) ProtoMessage() {}
+
+func (x *UpdateGradeDefenceCriterionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGradeDefenceCriterionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGradeDefenceCriterionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateGradeDefenceCriterionResponse) GetGradeDefenceCriterion() *GradeDefenceCriterion {
+	if x != nil {
+		return x.GradeDefenceCriterion
+	}
+	return nil
+}
+
+type DeleteGradeDefenceCriterionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGradeDefenceCriterionRequest) Reset() {
+	*x = DeleteGradeDefenceCriterionRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGradeDefenceCriterionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGradeDefenceCriterionRequest) ProtoMessage() {}
+
+func (x *DeleteGradeDefenceCriterionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGradeDefenceCriterionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGradeDefenceCriterionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteGradeDefenceCriterionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteGradeDefenceCriterionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGradeDefenceCriterionResponse) Reset() {
+	*x = DeleteGradeDefenceCriterionResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGradeDefenceCriterionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGradeDefenceCriterionResponse) ProtoMessage() {}
+
+func (x *DeleteGradeDefenceCriterionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGradeDefenceCriterionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGradeDefenceCriterionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteGradeDefenceCriterionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListGradeDefenceCriteriaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Search        *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGradeDefenceCriteriaRequest) Reset() {
+	*x = ListGradeDefenceCriteriaRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGradeDefenceCriteriaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGradeDefenceCriteriaRequest) ProtoMessage() {}
 
-func (x *ListCouncilsResponse) GetPageSize() int32 {
+func (x *ListGradeDefenceCriteriaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGradeDefenceCriteriaRequest.ProtoReflect.Descriptor instead.
+func (*ListGradeDefenceCriteriaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListGradeDefenceCriteriaRequest) GetSearch() *common.SearchRequest {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+type ListGradeDefenceCriteriaResponse struct {
+	state                protoimpl.MessageState   `protogen:"open.v1"`
+	GradeDefenceCriteria []*GradeDefenceCriterion `protobuf:"bytes,1,rep,name=grade_defence_criteria,json=gradeDefenceCriteria,proto3" json:"grade_defence_criteria,omitempty"`
+	Total                int32                    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page                 int32                    `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize             int32                    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListGradeDefenceCriteriaResponse) Reset() {
+	*x = ListGradeDefenceCriteriaResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGradeDefenceCriteriaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGradeDefenceCriteriaResponse) ProtoMessage() {}
+
+func (x *ListGradeDefenceCriteriaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGradeDefenceCriteriaResponse.ProtoReflect.Descriptor instead.
+func (*ListGradeDefenceCriteriaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListGradeDefenceCriteriaResponse) GetGradeDefenceCriteria() []*GradeDefenceCriterion {
+	if x != nil {
+		return x.GradeDefenceCriteria
+	}
+	return nil
+}
+
+func (x *ListGradeDefenceCriteriaResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListGradeDefenceCriteriaResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListGradeDefenceCriteriaResponse) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-// ============= Defence =============
-type Defence struct {
+// ============= Rubric template =============
+type RubricCriterion struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RubricTemplateCode string                 `protobuf:"bytes,2,opt,name=rubric_template_code,json=rubricTemplateCode,proto3" json:"rubric_template_code,omitempty"`
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	MaxScore           float64                `protobuf:"fixed64,5,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	Weight             float64                `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	SortOrder          int32                  `protobuf:"varint,7,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RubricCriterion) Reset() {
+	*x = RubricCriterion{}
+	mi := &file_proto_council_council_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RubricCriterion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricCriterion) ProtoMessage() {}
+
+func (x *RubricCriterion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricCriterion.ProtoReflect.Descriptor instead.
+func (*RubricCriterion) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RubricCriterion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RubricCriterion) GetRubricTemplateCode() string {
+	if x != nil {
+		return x.RubricTemplateCode
+	}
+	return ""
+}
+
+func (x *RubricCriterion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RubricCriterion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RubricCriterion) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *RubricCriterion) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *RubricCriterion) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+// Criteria of a major and stage's defence grading, copied into every new GradeDefence
+type RubricTemplate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	CouncilCode   string                 `protobuf:"bytes,3,opt,name=council_code,json=councilCode,proto3" json:"council_code,omitempty"`
-	TeacherCode   string                 `protobuf:"bytes,4,opt,name=teacher_code,json=teacherCode,proto3" json:"teacher_code,omitempty"`
-	Position      DefencePosition        `protobuf:"varint,5,opt,name=position,proto3,enum=council.DefencePosition" json:"position,omitempty"`
+	MajorCode     string                 `protobuf:"bytes,3,opt,name=major_code,json=majorCode,proto3" json:"major_code,omitempty"`
+	Stage         RubricStage            `protobuf:"varint,4,opt,name=stage,proto3,enum=council.RubricStage" json:"stage,omitempty"`
+	Criteria      []*RubricCriterion     `protobuf:"bytes,5,rep,name=criteria,proto3" json:"criteria,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	CreatedBy     string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	UpdatedBy     string                 `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       int32                  `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"` // bumped by every update
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Defence) Reset() {
-	*x = Defence{}
-	mi := &file_proto_council_council_proto_msgTypes[11]
+func (x *RubricTemplate) Reset() {
+	*x = RubricTemplate{}
+	mi := &file_proto_council_council_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Defence) String() string {
+func (x *RubricTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Defence) ProtoMessage() {}
+func (*RubricTemplate) ProtoMessage() {}
 
-func (x *Defence) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[11]
+func (x *RubricTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -762,100 +3759,106 @@ func (x *Defence) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Defence.ProtoReflect.Descriptor instead.
-func (*Defence) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use RubricTemplate.ProtoReflect.Descriptor instead.
+func (*RubricTemplate) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *Defence) GetId() string {
+func (x *RubricTemplate) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Defence) GetTitle() string {
+func (x *RubricTemplate) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *Defence) GetCouncilCode() string {
+func (x *RubricTemplate) GetMajorCode() string {
 	if x != nil {
-		return x.CouncilCode
+		return x.MajorCode
 	}
 	return ""
 }
 
-func (x *Defence) GetTeacherCode() string {
+func (x *RubricTemplate) GetStage() RubricStage {
 	if x != nil {
-		return x.TeacherCode
+		return x.Stage
 	}
-	return ""
+	return RubricStage_RUBRIC_STAGE_DACN
 }
 
-func (x *Defence) GetPosition() DefencePosition {
+func (x *RubricTemplate) GetCriteria() []*RubricCriterion {
 	if x != nil {
-		return x.Position
+		return x.Criteria
 	}
-	return DefencePosition_PRESIDENT
+	return nil
 }
 
-func (x *Defence) GetCreatedAt() *timestamppb.Timestamp {
+func (x *RubricTemplate) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Defence) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *RubricTemplate) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (x *Defence) GetCreatedBy() string {
+func (x *RubricTemplate) GetCreatedBy() string {
 	if x != nil {
 		return x.CreatedBy
 	}
 	return ""
 }
 
-func (x *Defence) GetUpdatedBy() string {
+func (x *RubricTemplate) GetUpdatedBy() string {
 	if x != nil {
 		return x.UpdatedBy
 	}
 	return ""
 }
 
-type CreateDefenceRequest struct {
+func (x *RubricTemplate) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RubricCriterionInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	CouncilCode   string                 `protobuf:"bytes,2,opt,name=council_code,json=councilCode,proto3" json:"council_code,omitempty"`
-	TeacherCode   string                 `protobuf:"bytes,3,opt,name=teacher_code,json=teacherCode,proto3" json:"teacher_code,omitempty"`
-	Position      DefencePosition        `protobuf:"varint,4,opt,name=position,proto3,enum=council.DefencePosition" json:"position,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	MaxScore      float64                `protobuf:"fixed64,3,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	Weight        float64                `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateDefenceRequest) Reset() {
-	*x = CreateDefenceRequest{}
-	mi := &file_proto_council_council_proto_msgTypes[12]
+func (x *RubricCriterionInput) Reset() {
+	*x = RubricCriterionInput{}
+	mi := &file_proto_council_council_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDefenceRequest) String() string {
+func (x *RubricCriterionInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDefenceRequest) ProtoMessage() {}
+func (*RubricCriterionInput) ProtoMessage() {}
 
-func (x *CreateDefenceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[12]
+func (x *RubricCriterionInput) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -866,68 +3869,137 @@ func (x *CreateDefenceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDefenceRequest.ProtoReflect.Descriptor instead.
-func (*CreateDefenceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use RubricCriterionInput.ProtoReflect.Descriptor instead.
+func (*RubricCriterionInput) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *CreateDefenceRequest) GetTitle() string {
+func (x *RubricCriterionInput) GetName() string {
 	if x != nil {
-		return x.Title
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateDefenceRequest) GetCouncilCode() string {
+func (x *RubricCriterionInput) GetDescription() string {
 	if x != nil {
-		return x.CouncilCode
+		return x.Description
 	}
 	return ""
 }
 
-func (x *CreateDefenceRequest) GetTeacherCode() string {
+func (x *RubricCriterionInput) GetMaxScore() float64 {
 	if x != nil {
-		return x.TeacherCode
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *RubricCriterionInput) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type CreateRubricTemplateRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Title         string                  `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	MajorCode     string                  `protobuf:"bytes,2,opt,name=major_code,json=majorCode,proto3" json:"major_code,omitempty"`
+	Stage         RubricStage             `protobuf:"varint,3,opt,name=stage,proto3,enum=council.RubricStage" json:"stage,omitempty"`
+	Criteria      []*RubricCriterionInput `protobuf:"bytes,4,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	CreatedBy     string                  `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRubricTemplateRequest) Reset() {
+	*x = CreateRubricTemplateRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRubricTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRubricTemplateRequest) ProtoMessage() {}
+
+func (x *CreateRubricTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRubricTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateRubricTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateRubricTemplateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-func (x *CreateDefenceRequest) GetPosition() DefencePosition {
+func (x *CreateRubricTemplateRequest) GetMajorCode() string {
 	if x != nil {
-		return x.Position
+		return x.MajorCode
 	}
-	return DefencePosition_PRESIDENT
+	return ""
 }
 
-func (x *CreateDefenceRequest) GetCreatedBy() string {
+func (x *CreateRubricTemplateRequest) GetStage() RubricStage {
+	if x != nil {
+		return x.Stage
+	}
+	return RubricStage_RUBRIC_STAGE_DACN
+}
+
+func (x *CreateRubricTemplateRequest) GetCriteria() []*RubricCriterionInput {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *CreateRubricTemplateRequest) GetCreatedBy() string {
 	if x != nil {
 		return x.CreatedBy
 	}
 	return ""
 }
 
-type CreateDefenceResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Defence       *Defence               `protobuf:"bytes,1,opt,name=defence,proto3" json:"defence,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateRubricTemplateResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RubricTemplate *RubricTemplate        `protobuf:"bytes,1,opt,name=rubric_template,json=rubricTemplate,proto3" json:"rubric_template,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateDefenceResponse) Reset() {
-	*x = CreateDefenceResponse{}
-	mi := &file_proto_council_council_proto_msgTypes[13]
+func (x *CreateRubricTemplateResponse) Reset() {
+	*x = CreateRubricTemplateResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDefenceResponse) String() string {
+func (x *CreateRubricTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDefenceResponse) ProtoMessage() {}
+func (*CreateRubricTemplateResponse) ProtoMessage() {}
 
-func (x *CreateDefenceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[13]
+func (x *CreateRubricTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -938,40 +4010,40 @@ func (x *CreateDefenceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDefenceResponse.ProtoReflect.Descriptor instead.
-func (*CreateDefenceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CreateRubricTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateRubricTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *CreateDefenceResponse) GetDefence() *Defence {
+func (x *CreateRubricTemplateResponse) GetRubricTemplate() *RubricTemplate {
 	if x != nil {
-		return x.Defence
+		return x.RubricTemplate
 	}
 	return nil
 }
 
-type GetDefenceRequest struct {
+type GetRubricTemplateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDefenceRequest) Reset() {
-	*x = GetDefenceRequest{}
-	mi := &file_proto_council_council_proto_msgTypes[14]
+func (x *GetRubricTemplateRequest) Reset() {
+	*x = GetRubricTemplateRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDefenceRequest) String() string {
+func (x *GetRubricTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDefenceRequest) ProtoMessage() {}
+func (*GetRubricTemplateRequest) ProtoMessage() {}
 
-func (x *GetDefenceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[14]
+func (x *GetRubricTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -982,40 +4054,40 @@ func (x *GetDefenceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDefenceRequest.ProtoReflect.Descriptor instead.
-func (*GetDefenceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetRubricTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetRubricTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetDefenceRequest) GetId() string {
+func (x *GetRubricTemplateRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetDefenceResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Defence       *Defence               `protobuf:"bytes,1,opt,name=defence,proto3" json:"defence,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetRubricTemplateResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RubricTemplate *RubricTemplate        `protobuf:"bytes,1,opt,name=rubric_template,json=rubricTemplate,proto3" json:"rubric_template,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetDefenceResponse) Reset() {
-	*x = GetDefenceResponse{}
-	mi := &file_proto_council_council_proto_msgTypes[15]
+func (x *GetRubricTemplateResponse) Reset() {
+	*x = GetRubricTemplateResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDefenceResponse) String() string {
+func (x *GetRubricTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDefenceResponse) ProtoMessage() {}
+func (*GetRubricTemplateResponse) ProtoMessage() {}
 
-func (x *GetDefenceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[15]
+func (x *GetRubricTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1026,45 +4098,45 @@ func (x *GetDefenceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDefenceResponse.ProtoReflect.Descriptor instead.
-func (*GetDefenceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetRubricTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetRubricTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *GetDefenceResponse) GetDefence() *Defence {
+func (x *GetRubricTemplateResponse) GetRubricTemplate() *RubricTemplate {
 	if x != nil {
-		return x.Defence
+		return x.RubricTemplate
 	}
 	return nil
 }
 
-type UpdateDefenceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	CouncilCode   *string                `protobuf:"bytes,3,opt,name=council_code,json=councilCode,proto3,oneof" json:"council_code,omitempty"`
-	TeacherCode   *string                `protobuf:"bytes,4,opt,name=teacher_code,json=teacherCode,proto3,oneof" json:"teacher_code,omitempty"`
-	Position      *DefencePosition       `protobuf:"varint,5,opt,name=position,proto3,enum=council.DefencePosition,oneof" json:"position,omitempty"`
-	UpdatedBy     string                 `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+type UpdateRubricTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	// Replaces the criteria when not empty; existing GradeDefence rows keep their copies
+	Criteria      []*RubricCriterionInput `protobuf:"bytes,3,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	UpdatedBy     string                  `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       *int32                  `protobuf:"varint,5,opt,name=version,proto3,oneof" json:"version,omitempty"` // expected version; a stale one fails with ABORTED
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDefenceRequest) Reset() {
-	*x = UpdateDefenceRequest{}
-	mi := &file_proto_council_council_proto_msgTypes[16]
+func (x *UpdateRubricTemplateRequest) Reset() {
+	*x = UpdateRubricTemplateRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDefenceRequest) String() string {
+func (x *UpdateRubricTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDefenceRequest) ProtoMessage() {}
+func (*UpdateRubricTemplateRequest) ProtoMessage() {}
 
-func (x *UpdateDefenceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[16]
+func (x *UpdateRubricTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1075,75 +4147,68 @@ func (x *UpdateDefenceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDefenceRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDefenceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use UpdateRubricTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRubricTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *UpdateDefenceRequest) GetId() string {
+func (x *UpdateRubricTemplateRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateDefenceRequest) GetTitle() string {
+func (x *UpdateRubricTemplateRequest) GetTitle() string {
 	if x != nil && x.Title != nil {
 		return *x.Title
 	}
 	return ""
 }
 
-func (x *UpdateDefenceRequest) GetCouncilCode() string {
-	if x != nil && x.CouncilCode != nil {
-		return *x.CouncilCode
+func (x *UpdateRubricTemplateRequest) GetCriteria() []*RubricCriterionInput {
+	if x != nil {
+		return x.Criteria
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateDefenceRequest) GetTeacherCode() string {
-	if x != nil && x.TeacherCode != nil {
-		return *x.TeacherCode
+func (x *UpdateRubricTemplateRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
 	}
 	return ""
 }
 
-func (x *UpdateDefenceRequest) GetPosition() DefencePosition {
-	if x != nil && x.Position != nil {
-		return *x.Position
-	}
-	return DefencePosition_PRESIDENT
-}
-
-func (x *UpdateDefenceRequest) GetUpdatedBy() string {
-	if x != nil {
-		return x.UpdatedBy
+func (x *UpdateRubricTemplateRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
-	return ""
+	return 0
 }
 
-type UpdateDefenceResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Defence       *Defence               `protobuf:"bytes,1,opt,name=defence,proto3" json:"defence,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateRubricTemplateResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RubricTemplate *RubricTemplate        `protobuf:"bytes,1,opt,name=rubric_template,json=rubricTemplate,proto3" json:"rubric_template,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateDefenceResponse) Reset() {
-	*x = UpdateDefenceResponse{}
-	mi := &file_proto_council_council_proto_msgTypes[17]
+func (x *UpdateRubricTemplateResponse) Reset() {
+	*x = UpdateRubricTemplateResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDefenceResponse) String() string {
+func (x *UpdateRubricTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDefenceResponse) ProtoMessage() {}
+func (*UpdateRubricTemplateResponse) ProtoMessage() {}
 
-func (x *UpdateDefenceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[17]
+func (x *UpdateRubricTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1154,40 +4219,40 @@ func (x *UpdateDefenceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDefenceResponse.ProtoReflect.Descriptor instead.
-func (*UpdateDefenceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use UpdateRubricTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRubricTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *UpdateDefenceResponse) GetDefence() *Defence {
+func (x *UpdateRubricTemplateResponse) GetRubricTemplate() *RubricTemplate {
 	if x != nil {
-		return x.Defence
+		return x.RubricTemplate
 	}
 	return nil
 }
 
-type DeleteDefenceRequest struct {
+type DeleteRubricTemplateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteDefenceRequest) Reset() {
-	*x = DeleteDefenceRequest{}
-	mi := &file_proto_council_council_proto_msgTypes[18]
+func (x *DeleteRubricTemplateRequest) Reset() {
+	*x = DeleteRubricTemplateRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteDefenceRequest) String() string {
+func (x *DeleteRubricTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDefenceRequest) ProtoMessage() {}
+func (*DeleteRubricTemplateRequest) ProtoMessage() {}
 
-func (x *DeleteDefenceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[18]
+func (x *DeleteRubricTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1198,40 +4263,40 @@ func (x *DeleteDefenceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDefenceRequest.ProtoReflect.Descriptor instead.
-func (*DeleteDefenceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DeleteRubricTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRubricTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *DeleteDefenceRequest) GetId() string {
+func (x *DeleteRubricTemplateRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteDefenceResponse struct {
+type DeleteRubricTemplateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteDefenceResponse) Reset() {
-	*x = DeleteDefenceResponse{}
-	mi := &file_proto_council_council_proto_msgTypes[19]
+func (x *DeleteRubricTemplateResponse) Reset() {
+	*x = DeleteRubricTemplateResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteDefenceResponse) String() string {
+func (x *DeleteRubricTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDefenceResponse) ProtoMessage() {}
+func (*DeleteRubricTemplateResponse) ProtoMessage() {}
 
-func (x *DeleteDefenceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[19]
+func (x *DeleteRubricTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1242,40 +4307,41 @@ func (x *DeleteDefenceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDefenceResponse.ProtoReflect.Descriptor instead.
-func (*DeleteDefenceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use DeleteRubricTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRubricTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *DeleteDefenceResponse) GetSuccess() bool {
+func (x *DeleteRubricTemplateResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type ListDefencesRequest struct {
+type ListRubricTemplatesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	MajorCode     *string                `protobuf:"bytes,1,opt,name=major_code,json=majorCode,proto3,oneof" json:"major_code,omitempty"`
+	Stage         *RubricStage           `protobuf:"varint,2,opt,name=stage,proto3,enum=council.RubricStage,oneof" json:"stage,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListDefencesRequest) Reset() {
-	*x = ListDefencesRequest{}
-	mi := &file_proto_council_council_proto_msgTypes[20]
+func (x *ListRubricTemplatesRequest) Reset() {
+	*x = ListRubricTemplatesRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDefencesRequest) String() string {
+func (x *ListRubricTemplatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDefencesRequest) ProtoMessage() {}
+func (*ListRubricTemplatesRequest) ProtoMessage() {}
 
-func (x *ListDefencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[20]
+func (x *ListRubricTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1286,43 +4352,95 @@ func (x *ListDefencesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDefencesRequest.ProtoReflect.Descriptor instead.
-func (*ListDefencesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListRubricTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListRubricTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ListDefencesRequest) GetSearch() *common.SearchRequest {
+func (x *ListRubricTemplatesRequest) GetMajorCode() string {
+	if x != nil && x.MajorCode != nil {
+		return *x.MajorCode
+	}
+	return ""
+}
+
+func (x *ListRubricTemplatesRequest) GetStage() RubricStage {
+	if x != nil && x.Stage != nil {
+		return *x.Stage
+	}
+	return RubricStage_RUBRIC_STAGE_DACN
+}
+
+type ListRubricTemplatesResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RubricTemplates []*RubricTemplate      `protobuf:"bytes,1,rep,name=rubric_templates,json=rubricTemplates,proto3" json:"rubric_templates,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListRubricTemplatesResponse) Reset() {
+	*x = ListRubricTemplatesResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRubricTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRubricTemplatesResponse) ProtoMessage() {}
+
+func (x *ListRubricTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[65]
 	if x != nil {
-		return x.Search
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRubricTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListRubricTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListRubricTemplatesResponse) GetRubricTemplates() []*RubricTemplate {
+	if x != nil {
+		return x.RubricTemplates
 	}
 	return nil
 }
 
-type ListDefencesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Defences      []*Defence             `protobuf:"bytes,1,rep,name=defences,proto3" json:"defences,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// A topic council judged by the council, with the teachers supervising it
+// (Topic_council_supervisor) and reviewing it (Grade_review.teacher_code)
+type CouncilTopic struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TopicCouncilCode string                 `protobuf:"bytes,1,opt,name=topic_council_code,json=topicCouncilCode,proto3" json:"topic_council_code,omitempty"`
+	SupervisorCodes  []string               `protobuf:"bytes,2,rep,name=supervisor_codes,json=supervisorCodes,proto3" json:"supervisor_codes,omitempty"`
+	ReviewerCodes    []string               `protobuf:"bytes,3,rep,name=reviewer_codes,json=reviewerCodes,proto3" json:"reviewer_codes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListDefencesResponse) Reset() {
-	*x = ListDefencesResponse{}
-	mi := &file_proto_council_council_proto_msgTypes[21]
+func (x *CouncilTopic) Reset() {
+	*x = CouncilTopic{}
+	mi := &file_proto_council_council_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDefencesResponse) String() string {
+func (x *CouncilTopic) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDefencesResponse) ProtoMessage() {}
+func (*CouncilTopic) ProtoMessage() {}
 
-func (x *ListDefencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[21]
+func (x *CouncilTopic) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1333,70 +4451,110 @@ func (x *ListDefencesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDefencesResponse.ProtoReflect.Descriptor instead.
-func (*ListDefencesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use CouncilTopic.ProtoReflect.Descriptor instead.
+func (*CouncilTopic) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ListDefencesResponse) GetDefences() []*Defence {
+func (x *CouncilTopic) GetTopicCouncilCode() string {
 	if x != nil {
-		return x.Defences
+		return x.TopicCouncilCode
+	}
+	return ""
+}
+
+func (x *CouncilTopic) GetSupervisorCodes() []string {
+	if x != nil {
+		return x.SupervisorCodes
 	}
 	return nil
 }
 
-func (x *ListDefencesResponse) GetTotal() int32 {
+func (x *CouncilTopic) GetReviewerCodes() []string {
 	if x != nil {
-		return x.Total
+		return x.ReviewerCodes
 	}
-	return 0
+	return nil
 }
 
-func (x *ListDefencesResponse) GetPage() int32 {
+type CouncilMemberMajor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherCode   string                 `protobuf:"bytes,1,opt,name=teacher_code,json=teacherCode,proto3" json:"teacher_code,omitempty"`
+	MajorCode     string                 `protobuf:"bytes,2,opt,name=major_code,json=majorCode,proto3" json:"major_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CouncilMemberMajor) Reset() {
+	*x = CouncilMemberMajor{}
+	mi := &file_proto_council_council_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouncilMemberMajor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouncilMemberMajor) ProtoMessage() {}
+
+func (x *CouncilMemberMajor) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[67]
 	if x != nil {
-		return x.Page
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ListDefencesResponse) GetPageSize() int32 {
+// Deprecated: Use CouncilMemberMajor.ProtoReflect.Descriptor instead.
+func (*CouncilMemberMajor) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CouncilMemberMajor) GetTeacherCode() string {
 	if x != nil {
-		return x.PageSize
+		return x.TeacherCode
 	}
-	return 0
+	return ""
 }
 
-// ============= Grade_defence =============
-type GradeDefence struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	DefenceCode    string                 `protobuf:"bytes,2,opt,name=defence_code,json=defenceCode,proto3" json:"defence_code,omitempty"`
-	EnrollmentCode string                 `protobuf:"bytes,3,opt,name=enrollment_code,json=enrollmentCode,proto3" json:"enrollment_code,omitempty"`
-	Note           string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
-	TotalScore     int32                  `protobuf:"varint,5,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedBy      string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedBy      string                 `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *CouncilMemberMajor) GetMajorCode() string {
+	if x != nil {
+		return x.MajorCode
+	}
+	return ""
 }
 
-func (x *GradeDefence) Reset() {
-	*x = GradeDefence{}
-	mi := &file_proto_council_council_proto_msgTypes[22]
+// Data owned by other services that the conflict checks need. The council
+// service reads the supervisors and reviewers from the thesis service itself;
+// of topics, only the codes of topic councils not assigned yet are used.
+type CouncilValidationContext struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topics        []*CouncilTopic        `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	MemberMajors  []*CouncilMemberMajor  `protobuf:"bytes,2,rep,name=member_majors,json=memberMajors,proto3" json:"member_majors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CouncilValidationContext) Reset() {
+	*x = CouncilValidationContext{}
+	mi := &file_proto_council_council_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GradeDefence) String() string {
+func (x *CouncilValidationContext) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GradeDefence) ProtoMessage() {}
+func (*CouncilValidationContext) ProtoMessage() {}
 
-func (x *GradeDefence) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[22]
+func (x *CouncilValidationContext) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1407,100 +4565,128 @@ func (x *GradeDefence) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GradeDefence.ProtoReflect.Descriptor instead.
-func (*GradeDefence) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use CouncilValidationContext.ProtoReflect.Descriptor instead.
+func (*CouncilValidationContext) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GradeDefence) GetId() string {
+func (x *CouncilValidationContext) GetTopics() []*CouncilTopic {
 	if x != nil {
-		return x.Id
+		return x.Topics
 	}
-	return ""
+	return nil
 }
 
-func (x *GradeDefence) GetDefenceCode() string {
+func (x *CouncilValidationContext) GetMemberMajors() []*CouncilMemberMajor {
 	if x != nil {
-		return x.DefenceCode
+		return x.MemberMajors
 	}
-	return ""
+	return nil
 }
 
-func (x *GradeDefence) GetEnrollmentCode() string {
-	if x != nil {
-		return x.EnrollmentCode
-	}
-	return ""
+type CouncilConflict struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Kind             CouncilConflictKind    `protobuf:"varint,1,opt,name=kind,proto3,enum=council.CouncilConflictKind" json:"kind,omitempty"`
+	TeacherCode      string                 `protobuf:"bytes,2,opt,name=teacher_code,json=teacherCode,proto3" json:"teacher_code,omitempty"`
+	TopicCouncilCode string                 `protobuf:"bytes,3,opt,name=topic_council_code,json=topicCouncilCode,proto3" json:"topic_council_code,omitempty"`
+	Message          string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// An override with a reason was recorded for this conflict
+	Overridden    bool `protobuf:"varint,5,opt,name=overridden,proto3" json:"overridden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GradeDefence) GetNote() string {
+func (x *CouncilConflict) Reset() {
+	*x = CouncilConflict{}
+	mi := &file_proto_council_council_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouncilConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouncilConflict) ProtoMessage() {}
+
+func (x *CouncilConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[69]
 	if x != nil {
-		return x.Note
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouncilConflict.ProtoReflect.Descriptor instead.
+func (*CouncilConflict) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GradeDefence) GetTotalScore() int32 {
+func (x *CouncilConflict) GetKind() CouncilConflictKind {
 	if x != nil {
-		return x.TotalScore
+		return x.Kind
 	}
-	return 0
+	return CouncilConflictKind_SUPERVISOR_ON_COUNCIL
 }
 
-func (x *GradeDefence) GetCreatedAt() *timestamppb.Timestamp {
+func (x *CouncilConflict) GetTeacherCode() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.TeacherCode
 	}
-	return nil
+	return ""
 }
 
-func (x *GradeDefence) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *CouncilConflict) GetTopicCouncilCode() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.TopicCouncilCode
 	}
-	return nil
+	return ""
 }
 
-func (x *GradeDefence) GetCreatedBy() string {
+func (x *CouncilConflict) GetMessage() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.Message
 	}
 	return ""
 }
 
-func (x *GradeDefence) GetUpdatedBy() string {
+func (x *CouncilConflict) GetOverridden() bool {
 	if x != nil {
-		return x.UpdatedBy
+		return x.Overridden
 	}
-	return ""
+	return false
 }
 
-type CreateGradeDefenceRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	DefenceCode    string                 `protobuf:"bytes,1,opt,name=defence_code,json=defenceCode,proto3" json:"defence_code,omitempty"`
-	EnrollmentCode string                 `protobuf:"bytes,2,opt,name=enrollment_code,json=enrollmentCode,proto3" json:"enrollment_code,omitempty"`
-	Note           *string                `protobuf:"bytes,3,opt,name=note,proto3,oneof" json:"note,omitempty"`
-	TotalScore     *int32                 `protobuf:"varint,4,opt,name=total_score,json=totalScore,proto3,oneof" json:"total_score,omitempty"`
-	CreatedBy      string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+type ValidateCouncilRequest struct {
+	state       protoimpl.MessageState    `protogen:"open.v1"`
+	CouncilCode string                    `protobuf:"bytes,1,opt,name=council_code,json=councilCode,proto3" json:"council_code,omitempty"`
+	Validation  *CouncilValidationContext `protobuf:"bytes,2,opt,name=validation,proto3" json:"validation,omitempty"`
+	// Records an override of every open conflict
+	OverrideReason *string `protobuf:"bytes,3,opt,name=override_reason,json=overrideReason,proto3,oneof" json:"override_reason,omitempty"`
+	Actor          string  `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateGradeDefenceRequest) Reset() {
-	*x = CreateGradeDefenceRequest{}
-	mi := &file_proto_council_council_proto_msgTypes[23]
+func (x *ValidateCouncilRequest) Reset() {
+	*x = ValidateCouncilRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGradeDefenceRequest) String() string {
+func (x *ValidateCouncilRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGradeDefenceRequest) ProtoMessage() {}
+func (*ValidateCouncilRequest) ProtoMessage() {}
 
-func (x *CreateGradeDefenceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[23]
+func (x *ValidateCouncilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1511,68 +4697,63 @@ func (x *CreateGradeDefenceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGradeDefenceRequest.ProtoReflect.Descriptor instead.
-func (*CreateGradeDefenceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ValidateCouncilRequest.ProtoReflect.Descriptor instead.
+func (*ValidateCouncilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *CreateGradeDefenceRequest) GetDefenceCode() string {
+func (x *ValidateCouncilRequest) GetCouncilCode() string {
 	if x != nil {
-		return x.DefenceCode
+		return x.CouncilCode
 	}
 	return ""
 }
 
-func (x *CreateGradeDefenceRequest) GetEnrollmentCode() string {
+func (x *ValidateCouncilRequest) GetValidation() *CouncilValidationContext {
 	if x != nil {
-		return x.EnrollmentCode
+		return x.Validation
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateGradeDefenceRequest) GetNote() string {
-	if x != nil && x.Note != nil {
-		return *x.Note
+func (x *ValidateCouncilRequest) GetOverrideReason() string {
+	if x != nil && x.OverrideReason != nil {
+		return *x.OverrideReason
 	}
 	return ""
 }
 
-func (x *CreateGradeDefenceRequest) GetTotalScore() int32 {
-	if x != nil && x.TotalScore != nil {
-		return *x.TotalScore
-	}
-	return 0
-}
-
-func (x *CreateGradeDefenceRequest) GetCreatedBy() string {
+func (x *ValidateCouncilRequest) GetActor() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.Actor
 	}
 	return ""
 }
 
-type CreateGradeDefenceResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GradeDefence  *GradeDefence          `protobuf:"bytes,1,opt,name=grade_defence,json=gradeDefence,proto3" json:"grade_defence,omitempty"`
+type ValidateCouncilResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Conflicts []*CouncilConflict     `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	// No conflict is left without an override
+	Valid         bool `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGradeDefenceResponse) Reset() {
-	*x = CreateGradeDefenceResponse{}
-	mi := &file_proto_council_council_proto_msgTypes[24]
+func (x *ValidateCouncilResponse) Reset() {
+	*x = ValidateCouncilResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGradeDefenceResponse) String() string {
+func (x *ValidateCouncilResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGradeDefenceResponse) ProtoMessage() {}
+func (*ValidateCouncilResponse) ProtoMessage() {}
 
-func (x *CreateGradeDefenceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[24]
+func (x *ValidateCouncilResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1583,40 +4764,53 @@ func (x *CreateGradeDefenceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGradeDefenceResponse.ProtoReflect.Descriptor instead.
-func (*CreateGradeDefenceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ValidateCouncilResponse.ProtoReflect.Descriptor instead.
+func (*ValidateCouncilResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *CreateGradeDefenceResponse) GetGradeDefence() *GradeDefence {
+func (x *ValidateCouncilResponse) GetConflicts() []*CouncilConflict {
 	if x != nil {
-		return x.GradeDefence
+		return x.Conflicts
 	}
 	return nil
 }
 
-type GetGradeDefenceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ValidateCouncilResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
 }
 
-func (x *GetGradeDefenceRequest) Reset() {
-	*x = GetGradeDefenceRequest{}
-	mi := &file_proto_council_council_proto_msgTypes[25]
+// Checks the conflicts a topic council brings to the council it is being
+// assigned to: its supervisors on the council and its reviewers supervising it
+type AdmitTopicCouncilRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CouncilCode string                 `protobuf:"bytes,1,opt,name=council_code,json=councilCode,proto3" json:"council_code,omitempty"`
+	Topic       *CouncilTopic          `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Records an override of every open conflict of the topic council
+	OverrideReason *string `protobuf:"bytes,3,opt,name=override_reason,json=overrideReason,proto3,oneof" json:"override_reason,omitempty"`
+	Actor          string  `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdmitTopicCouncilRequest) Reset() {
+	*x = AdmitTopicCouncilRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGradeDefenceRequest) String() string {
+func (x *AdmitTopicCouncilRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGradeDefenceRequest) ProtoMessage() {}
+func (*AdmitTopicCouncilRequest) ProtoMessage() {}
 
-func (x *GetGradeDefenceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[25]
+func (x *AdmitTopicCouncilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1627,40 +4821,61 @@ func (x *GetGradeDefenceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGradeDefenceRequest.ProtoReflect.Descriptor instead.
-func (*GetGradeDefenceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use AdmitTopicCouncilRequest.ProtoReflect.Descriptor instead.
+func (*AdmitTopicCouncilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *GetGradeDefenceRequest) GetId() string {
+func (x *AdmitTopicCouncilRequest) GetCouncilCode() string {
 	if x != nil {
-		return x.Id
+		return x.CouncilCode
 	}
 	return ""
 }
 
-type GetGradeDefenceResponse struct {
+func (x *AdmitTopicCouncilRequest) GetTopic() *CouncilTopic {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+func (x *AdmitTopicCouncilRequest) GetOverrideReason() string {
+	if x != nil && x.OverrideReason != nil {
+		return *x.OverrideReason
+	}
+	return ""
+}
+
+func (x *AdmitTopicCouncilRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type AdmitTopicCouncilResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GradeDefence  *GradeDefence          `protobuf:"bytes,1,opt,name=grade_defence,json=gradeDefence,proto3" json:"grade_defence,omitempty"`
+	Conflicts     []*CouncilConflict     `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGradeDefenceResponse) Reset() {
-	*x = GetGradeDefenceResponse{}
-	mi := &file_proto_council_council_proto_msgTypes[26]
+func (x *AdmitTopicCouncilResponse) Reset() {
+	*x = AdmitTopicCouncilResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGradeDefenceResponse) String() string {
+func (x *AdmitTopicCouncilResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGradeDefenceResponse) ProtoMessage() {}
+func (*AdmitTopicCouncilResponse) ProtoMessage() {}
 
-func (x *GetGradeDefenceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[26]
+func (x *AdmitTopicCouncilResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1671,45 +4886,48 @@ func (x *GetGradeDefenceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGradeDefenceResponse.ProtoReflect.Descriptor instead.
-func (*GetGradeDefenceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use AdmitTopicCouncilResponse.ProtoReflect.Descriptor instead.
+func (*AdmitTopicCouncilResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *GetGradeDefenceResponse) GetGradeDefence() *GradeDefence {
+func (x *AdmitTopicCouncilResponse) GetConflicts() []*CouncilConflict {
 	if x != nil {
-		return x.GradeDefence
+		return x.Conflicts
 	}
 	return nil
 }
 
-type UpdateGradeDefenceRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	DefenceCode    *string                `protobuf:"bytes,2,opt,name=defence_code,json=defenceCode,proto3,oneof" json:"defence_code,omitempty"`
-	EnrollmentCode *string                `protobuf:"bytes,3,opt,name=enrollment_code,json=enrollmentCode,proto3,oneof" json:"enrollment_code,omitempty"`
-	Note           *string                `protobuf:"bytes,4,opt,name=note,proto3,oneof" json:"note,omitempty"`
-	TotalScore     *int32                 `protobuf:"varint,5,opt,name=total_score,json=totalScore,proto3,oneof" json:"total_score,omitempty"`
-	UpdatedBy      string                 `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type CouncilConflictOverride struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CouncilCode      string                 `protobuf:"bytes,2,opt,name=council_code,json=councilCode,proto3" json:"council_code,omitempty"`
+	Kind             CouncilConflictKind    `protobuf:"varint,3,opt,name=kind,proto3,enum=council.CouncilConflictKind" json:"kind,omitempty"`
+	TeacherCode      string                 `protobuf:"bytes,4,opt,name=teacher_code,json=teacherCode,proto3" json:"teacher_code,omitempty"`
+	TopicCouncilCode string                 `protobuf:"bytes,5,opt,name=topic_council_code,json=topicCouncilCode,proto3" json:"topic_council_code,omitempty"`
+	Message          string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Reason           string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy        string                 `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *UpdateGradeDefenceRequest) Reset() {
-	*x = UpdateGradeDefenceRequest{}
-	mi := &file_proto_council_council_proto_msgTypes[27]
+func (x *CouncilConflictOverride) Reset() {
+	*x = CouncilConflictOverride{}
+	mi := &file_proto_council_council_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGradeDefenceRequest) String() string {
+func (x *CouncilConflictOverride) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGradeDefenceRequest) ProtoMessage() {}
+func (*CouncilConflictOverride) ProtoMessage() {}
 
-func (x *UpdateGradeDefenceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[27]
+func (x *CouncilConflictOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1720,75 +4938,140 @@ func (x *UpdateGradeDefenceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGradeDefenceRequest.ProtoReflect.Descriptor instead.
-func (*UpdateGradeDefenceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use CouncilConflictOverride.ProtoReflect.Descriptor instead.
+func (*CouncilConflictOverride) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *UpdateGradeDefenceRequest) GetId() string {
+func (x *CouncilConflictOverride) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateGradeDefenceRequest) GetDefenceCode() string {
-	if x != nil && x.DefenceCode != nil {
-		return *x.DefenceCode
+func (x *CouncilConflictOverride) GetCouncilCode() string {
+	if x != nil {
+		return x.CouncilCode
 	}
 	return ""
 }
 
-func (x *UpdateGradeDefenceRequest) GetEnrollmentCode() string {
-	if x != nil && x.EnrollmentCode != nil {
-		return *x.EnrollmentCode
+func (x *CouncilConflictOverride) GetKind() CouncilConflictKind {
+	if x != nil {
+		return x.Kind
+	}
+	return CouncilConflictKind_SUPERVISOR_ON_COUNCIL
+}
+
+func (x *CouncilConflictOverride) GetTeacherCode() string {
+	if x != nil {
+		return x.TeacherCode
 	}
 	return ""
 }
 
-func (x *UpdateGradeDefenceRequest) GetNote() string {
-	if x != nil && x.Note != nil {
-		return *x.Note
+func (x *CouncilConflictOverride) GetTopicCouncilCode() string {
+	if x != nil {
+		return x.TopicCouncilCode
 	}
 	return ""
 }
 
-func (x *UpdateGradeDefenceRequest) GetTotalScore() int32 {
-	if x != nil && x.TotalScore != nil {
-		return *x.TotalScore
+func (x *CouncilConflictOverride) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateGradeDefenceRequest) GetUpdatedBy() string {
+func (x *CouncilConflictOverride) GetReason() string {
 	if x != nil {
-		return x.UpdatedBy
+		return x.Reason
 	}
 	return ""
 }
 
-type UpdateGradeDefenceResponse struct {
+func (x *CouncilConflictOverride) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CouncilConflictOverride) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type ListCouncilConflictOverridesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GradeDefence  *GradeDefence          `protobuf:"bytes,1,opt,name=grade_defence,json=gradeDefence,proto3" json:"grade_defence,omitempty"`
+	CouncilCode   string                 `protobuf:"bytes,1,opt,name=council_code,json=councilCode,proto3" json:"council_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateGradeDefenceResponse) Reset() {
-	*x = UpdateGradeDefenceResponse{}
-	mi := &file_proto_council_council_proto_msgTypes[28]
+func (x *ListCouncilConflictOverridesRequest) Reset() {
+	*x = ListCouncilConflictOverridesRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouncilConflictOverridesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouncilConflictOverridesRequest) ProtoMessage() {}
+
+func (x *ListCouncilConflictOverridesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouncilConflictOverridesRequest.ProtoReflect.Descriptor instead.
+func (*ListCouncilConflictOverridesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListCouncilConflictOverridesRequest) GetCouncilCode() string {
+	if x != nil {
+		return x.CouncilCode
+	}
+	return ""
+}
+
+type ListCouncilConflictOverridesResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Overrides     []*CouncilConflictOverride `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouncilConflictOverridesResponse) Reset() {
+	*x = ListCouncilConflictOverridesResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGradeDefenceResponse) String() string {
+func (x *ListCouncilConflictOverridesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGradeDefenceResponse) ProtoMessage() {}
+func (*ListCouncilConflictOverridesResponse) ProtoMessage() {}
 
-func (x *UpdateGradeDefenceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[28]
+func (x *ListCouncilConflictOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1799,40 +5082,42 @@ func (x *UpdateGradeDefenceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGradeDefenceResponse.ProtoReflect.Descriptor instead.
-func (*UpdateGradeDefenceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ListCouncilConflictOverridesResponse.ProtoReflect.Descriptor instead.
+func (*ListCouncilConflictOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *UpdateGradeDefenceResponse) GetGradeDefence() *GradeDefence {
+func (x *ListCouncilConflictOverridesResponse) GetOverrides() []*CouncilConflictOverride {
 	if x != nil {
-		return x.GradeDefence
+		return x.Overrides
 	}
 	return nil
 }
 
-type DeleteGradeDefenceRequest struct {
+// ============= Grade lock, publication & amendments =============
+type LockCouncilGradesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CouncilCode   string                 `protobuf:"bytes,1,opt,name=council_code,json=councilCode,proto3" json:"council_code,omitempty"`
+	Actor         string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGradeDefenceRequest) Reset() {
-	*x = DeleteGradeDefenceRequest{}
-	mi := &file_proto_council_council_proto_msgTypes[29]
+func (x *LockCouncilGradesRequest) Reset() {
+	*x = LockCouncilGradesRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGradeDefenceRequest) String() string {
+func (x *LockCouncilGradesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGradeDefenceRequest) ProtoMessage() {}
+func (*LockCouncilGradesRequest) ProtoMessage() {}
 
-func (x *DeleteGradeDefenceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[29]
+func (x *LockCouncilGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1843,40 +5128,47 @@ func (x *DeleteGradeDefenceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGradeDefenceRequest.ProtoReflect.Descriptor instead.
-func (*DeleteGradeDefenceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use LockCouncilGradesRequest.ProtoReflect.Descriptor instead.
+func (*LockCouncilGradesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *DeleteGradeDefenceRequest) GetId() string {
+func (x *LockCouncilGradesRequest) GetCouncilCode() string {
 	if x != nil {
-		return x.Id
+		return x.CouncilCode
 	}
 	return ""
 }
 
-type DeleteGradeDefenceResponse struct {
+func (x *LockCouncilGradesRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type LockCouncilGradesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Council       *Council               `protobuf:"bytes,1,opt,name=council,proto3" json:"council,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGradeDefenceResponse) Reset() {
-	*x = DeleteGradeDefenceResponse{}
-	mi := &file_proto_council_council_proto_msgTypes[30]
+func (x *LockCouncilGradesResponse) Reset() {
+	*x = LockCouncilGradesResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGradeDefenceResponse) String() string {
+func (x *LockCouncilGradesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGradeDefenceResponse) ProtoMessage() {}
+func (*LockCouncilGradesResponse) ProtoMessage() {}
 
-func (x *DeleteGradeDefenceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[30]
+func (x *LockCouncilGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1887,40 +5179,41 @@ func (x *DeleteGradeDefenceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGradeDefenceResponse.ProtoReflect.Descriptor instead.
-func (*DeleteGradeDefenceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use LockCouncilGradesResponse.ProtoReflect.Descriptor instead.
+func (*LockCouncilGradesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *DeleteGradeDefenceResponse) GetSuccess() bool {
+func (x *LockCouncilGradesResponse) GetCouncil() *Council {
 	if x != nil {
-		return x.Success
+		return x.Council
 	}
-	return false
+	return nil
 }
 
-type ListGradeDefencesRequest struct {
+type PublishCouncilGradesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	CouncilCode   string                 `protobuf:"bytes,1,opt,name=council_code,json=councilCode,proto3" json:"council_code,omitempty"`
+	Actor         string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListGradeDefencesRequest) Reset() {
-	*x = ListGradeDefencesRequest{}
-	mi := &file_proto_council_council_proto_msgTypes[31]
+func (x *PublishCouncilGradesRequest) Reset() {
+	*x = PublishCouncilGradesRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGradeDefencesRequest) String() string {
+func (x *PublishCouncilGradesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGradeDefencesRequest) ProtoMessage() {}
+func (*PublishCouncilGradesRequest) ProtoMessage() {}
 
-func (x *ListGradeDefencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[31]
+func (x *PublishCouncilGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1931,43 +5224,47 @@ func (x *ListGradeDefencesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGradeDefencesRequest.ProtoReflect.Descriptor instead.
-func (*ListGradeDefencesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use PublishCouncilGradesRequest.ProtoReflect.Descriptor instead.
+func (*PublishCouncilGradesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *ListGradeDefencesRequest) GetSearch() *common.SearchRequest {
+func (x *PublishCouncilGradesRequest) GetCouncilCode() string {
 	if x != nil {
-		return x.Search
+		return x.CouncilCode
 	}
-	return nil
+	return ""
 }
 
-type ListGradeDefencesResponse struct {
+func (x *PublishCouncilGradesRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type PublishCouncilGradesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GradeDefences []*GradeDefence        `protobuf:"bytes,1,rep,name=grade_defences,json=gradeDefences,proto3" json:"grade_defences,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Council       *Council               `protobuf:"bytes,1,opt,name=council,proto3" json:"council,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListGradeDefencesResponse) Reset() {
-	*x = ListGradeDefencesResponse{}
-	mi := &file_proto_council_council_proto_msgTypes[32]
+func (x *PublishCouncilGradesResponse) Reset() {
+	*x = PublishCouncilGradesResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGradeDefencesResponse) String() string {
+func (x *PublishCouncilGradesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGradeDefencesResponse) ProtoMessage() {}
+func (*PublishCouncilGradesResponse) ProtoMessage() {}
 
-func (x *ListGradeDefencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[32]
+func (x *PublishCouncilGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1978,70 +5275,55 @@ func (x *ListGradeDefencesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGradeDefencesResponse.ProtoReflect.Descriptor instead.
-func (*ListGradeDefencesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use PublishCouncilGradesResponse.ProtoReflect.Descriptor instead.
+func (*PublishCouncilGradesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *ListGradeDefencesResponse) GetGradeDefences() []*GradeDefence {
+func (x *PublishCouncilGradesResponse) GetCouncil() *Council {
 	if x != nil {
-		return x.GradeDefences
+		return x.Council
 	}
 	return nil
 }
 
-func (x *ListGradeDefencesResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *ListGradeDefencesResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListGradeDefencesResponse) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-// ============= Grade_defence_criterion =============
-type GradeDefenceCriterion struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	GradeDefenceCode string                 `protobuf:"bytes,2,opt,name=grade_defence_code,json=gradeDefenceCode,proto3" json:"grade_defence_code,omitempty"`
-	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Score            string                 `protobuf:"bytes,4,opt,name=score,proto3" json:"score,omitempty"`
-	MaxScore         string                 `protobuf:"bytes,5,opt,name=maxScore,proto3" json:"maxScore,omitempty"`
-	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedBy        string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedBy        string                 `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+// A change of one criterion's score in a locked council
+type GradeDefenceAmendment struct {
+	state            protoimpl.MessageState      `protogen:"open.v1"`
+	Id               string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CriterionCode    string                      `protobuf:"bytes,2,opt,name=criterion_code,json=criterionCode,proto3" json:"criterion_code,omitempty"`
+	GradeDefenceCode string                      `protobuf:"bytes,3,opt,name=grade_defence_code,json=gradeDefenceCode,proto3" json:"grade_defence_code,omitempty"`
+	CouncilCode      string                      `protobuf:"bytes,4,opt,name=council_code,json=councilCode,proto3" json:"council_code,omitempty"`
+	EnrollmentCode   string                      `protobuf:"bytes,5,opt,name=enrollment_code,json=enrollmentCode,proto3" json:"enrollment_code,omitempty"`
+	OldScore         *float64                    `protobuf:"fixed64,6,opt,name=old_score,json=oldScore,proto3,oneof" json:"old_score,omitempty"`
+	NewScore         float64                     `protobuf:"fixed64,7,opt,name=new_score,json=newScore,proto3" json:"new_score,omitempty"`
+	Reason           string                      `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status           GradeDefenceAmendmentStatus `protobuf:"varint,9,opt,name=status,proto3,enum=council.GradeDefenceAmendmentStatus" json:"status,omitempty"`
+	RequestedBy      string                      `protobuf:"bytes,10,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	DecidedBy        string                      `protobuf:"bytes,11,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	DecidedAt        *timestamppb.Timestamp      `protobuf:"bytes,12,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	DecisionNote     string                      `protobuf:"bytes,13,opt,name=decision_note,json=decisionNote,proto3" json:"decision_note,omitempty"`
+	CreatedAt        *timestamppb.Timestamp      `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp      `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GradeDefenceCriterion) Reset() {
-	*x = GradeDefenceCriterion{}
-	mi := &file_proto_council_council_proto_msgTypes[33]
+func (x *GradeDefenceAmendment) Reset() {
+	*x = GradeDefenceAmendment{}
+	mi := &file_proto_council_council_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GradeDefenceCriterion) String() string {
+func (x *GradeDefenceAmendment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GradeDefenceCriterion) ProtoMessage() {}
+func (*GradeDefenceAmendment) ProtoMessage() {}
 
-func (x *GradeDefenceCriterion) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[33]
+func (x *GradeDefenceAmendment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2052,172 +5334,142 @@ func (x *GradeDefenceCriterion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GradeDefenceCriterion.ProtoReflect.Descriptor instead.
-func (*GradeDefenceCriterion) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use GradeDefenceAmendment.ProtoReflect.Descriptor instead.
+func (*GradeDefenceAmendment) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *GradeDefenceCriterion) GetId() string {
+func (x *GradeDefenceAmendment) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GradeDefenceCriterion) GetGradeDefenceCode() string {
+func (x *GradeDefenceAmendment) GetCriterionCode() string {
 	if x != nil {
-		return x.GradeDefenceCode
+		return x.CriterionCode
 	}
 	return ""
 }
 
-func (x *GradeDefenceCriterion) GetName() string {
+func (x *GradeDefenceAmendment) GetGradeDefenceCode() string {
 	if x != nil {
-		return x.Name
+		return x.GradeDefenceCode
 	}
 	return ""
 }
 
-func (x *GradeDefenceCriterion) GetScore() string {
+func (x *GradeDefenceAmendment) GetCouncilCode() string {
 	if x != nil {
-		return x.Score
+		return x.CouncilCode
 	}
 	return ""
 }
 
-func (x *GradeDefenceCriterion) GetMaxScore() string {
+func (x *GradeDefenceAmendment) GetEnrollmentCode() string {
 	if x != nil {
-		return x.MaxScore
+		return x.EnrollmentCode
 	}
 	return ""
 }
 
-func (x *GradeDefenceCriterion) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
+func (x *GradeDefenceAmendment) GetOldScore() float64 {
+	if x != nil && x.OldScore != nil {
+		return *x.OldScore
 	}
-	return nil
+	return 0
 }
 
-func (x *GradeDefenceCriterion) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *GradeDefenceAmendment) GetNewScore() float64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.NewScore
 	}
-	return nil
+	return 0
 }
 
-func (x *GradeDefenceCriterion) GetCreatedBy() string {
+func (x *GradeDefenceAmendment) GetReason() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *GradeDefenceCriterion) GetUpdatedBy() string {
+func (x *GradeDefenceAmendment) GetStatus() GradeDefenceAmendmentStatus {
 	if x != nil {
-		return x.UpdatedBy
+		return x.Status
 	}
-	return ""
-}
-
-type CreateGradeDefenceCriterionRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	GradeDefenceCode string                 `protobuf:"bytes,1,opt,name=grade_defence_code,json=gradeDefenceCode,proto3" json:"grade_defence_code,omitempty"`
-	Name             *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Score            *string                `protobuf:"bytes,3,opt,name=score,proto3,oneof" json:"score,omitempty"`
-	MaxScore         *string                `protobuf:"bytes,4,opt,name=maxScore,proto3,oneof" json:"maxScore,omitempty"`
-	CreatedBy        *string                `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *CreateGradeDefenceCriterionRequest) Reset() {
-	*x = CreateGradeDefenceCriterionRequest{}
-	mi := &file_proto_council_council_proto_msgTypes[34]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateGradeDefenceCriterionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_PENDING
 }
 
-func (*CreateGradeDefenceCriterionRequest) ProtoMessage() {}
-
-func (x *CreateGradeDefenceCriterionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[34]
+func (x *GradeDefenceAmendment) GetRequestedBy() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RequestedBy
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateGradeDefenceCriterionRequest.ProtoReflect.Descriptor instead.
-func (*CreateGradeDefenceCriterionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{34}
+	return ""
 }
 
-func (x *CreateGradeDefenceCriterionRequest) GetGradeDefenceCode() string {
+func (x *GradeDefenceAmendment) GetDecidedBy() string {
 	if x != nil {
-		return x.GradeDefenceCode
+		return x.DecidedBy
 	}
 	return ""
 }
 
-func (x *CreateGradeDefenceCriterionRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *GradeDefenceAmendment) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateGradeDefenceCriterionRequest) GetScore() string {
-	if x != nil && x.Score != nil {
-		return *x.Score
+func (x *GradeDefenceAmendment) GetDecisionNote() string {
+	if x != nil {
+		return x.DecisionNote
 	}
 	return ""
 }
 
-func (x *CreateGradeDefenceCriterionRequest) GetMaxScore() string {
-	if x != nil && x.MaxScore != nil {
-		return *x.MaxScore
+func (x *GradeDefenceAmendment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateGradeDefenceCriterionRequest) GetCreatedBy() string {
-	if x != nil && x.CreatedBy != nil {
-		return *x.CreatedBy
+func (x *GradeDefenceAmendment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-type CreateGradeDefenceCriterionResponse struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	GradeDefenceCriterion *GradeDefenceCriterion `protobuf:"bytes,1,opt,name=grade_defence_criterion,json=gradeDefenceCriterion,proto3" json:"grade_defence_criterion,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+// The gateway checks the requester owns the criterion's grade defence
+type RequestGradeDefenceAmendmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CriterionCode string                 `protobuf:"bytes,1,opt,name=criterion_code,json=criterionCode,proto3" json:"criterion_code,omitempty"`
+	NewScore      float64                `protobuf:"fixed64,2,opt,name=new_score,json=newScore,proto3" json:"new_score,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGradeDefenceCriterionResponse) Reset() {
-	*x = CreateGradeDefenceCriterionResponse{}
-	mi := &file_proto_council_council_proto_msgTypes[35]
+func (x *RequestGradeDefenceAmendmentRequest) Reset() {
+	*x = RequestGradeDefenceAmendmentRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGradeDefenceCriterionResponse) String() string {
+func (x *RequestGradeDefenceAmendmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGradeDefenceCriterionResponse) ProtoMessage() {}
+func (*RequestGradeDefenceAmendmentRequest) ProtoMessage() {}
 
-func (x *CreateGradeDefenceCriterionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[35]
+func (x *RequestGradeDefenceAmendmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2228,84 +5480,61 @@ func (x *CreateGradeDefenceCriterionResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGradeDefenceCriterionResponse.ProtoReflect.Descriptor instead.
-func (*CreateGradeDefenceCriterionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use RequestGradeDefenceAmendmentRequest.ProtoReflect.Descriptor instead.
+func (*RequestGradeDefenceAmendmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *CreateGradeDefenceCriterionResponse) GetGradeDefenceCriterion() *GradeDefenceCriterion {
+func (x *RequestGradeDefenceAmendmentRequest) GetCriterionCode() string {
 	if x != nil {
-		return x.GradeDefenceCriterion
+		return x.CriterionCode
 	}
-	return nil
-}
-
-type GetGradeDefenceCriterionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetGradeDefenceCriterionRequest) Reset() {
-	*x = GetGradeDefenceCriterionRequest{}
-	mi := &file_proto_council_council_proto_msgTypes[36]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetGradeDefenceCriterionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*GetGradeDefenceCriterionRequest) ProtoMessage() {}
-
-func (x *GetGradeDefenceCriterionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[36]
+func (x *RequestGradeDefenceAmendmentRequest) GetNewScore() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.NewScore
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetGradeDefenceCriterionRequest.ProtoReflect.Descriptor instead.
-func (*GetGradeDefenceCriterionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{36}
+func (x *RequestGradeDefenceAmendmentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-func (x *GetGradeDefenceCriterionRequest) GetId() string {
+func (x *RequestGradeDefenceAmendmentRequest) GetRequestedBy() string {
 	if x != nil {
-		return x.Id
+		return x.RequestedBy
 	}
 	return ""
 }
 
-type GetGradeDefenceCriterionResponse struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	GradeDefenceCriterion *GradeDefenceCriterion `protobuf:"bytes,1,opt,name=grade_defence_criterion,json=gradeDefenceCriterion,proto3" json:"grade_defence_criterion,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type RequestGradeDefenceAmendmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amendment     *GradeDefenceAmendment `protobuf:"bytes,1,opt,name=amendment,proto3" json:"amendment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGradeDefenceCriterionResponse) Reset() {
-	*x = GetGradeDefenceCriterionResponse{}
-	mi := &file_proto_council_council_proto_msgTypes[37]
+func (x *RequestGradeDefenceAmendmentResponse) Reset() {
+	*x = RequestGradeDefenceAmendmentResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGradeDefenceCriterionResponse) String() string {
+func (x *RequestGradeDefenceAmendmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGradeDefenceCriterionResponse) ProtoMessage() {}
+func (*RequestGradeDefenceAmendmentResponse) ProtoMessage() {}
 
-func (x *GetGradeDefenceCriterionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[37]
+func (x *RequestGradeDefenceAmendmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2316,45 +5545,44 @@ func (x *GetGradeDefenceCriterionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGradeDefenceCriterionResponse.ProtoReflect.Descriptor instead.
-func (*GetGradeDefenceCriterionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use RequestGradeDefenceAmendmentResponse.ProtoReflect.Descriptor instead.
+func (*RequestGradeDefenceAmendmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *GetGradeDefenceCriterionResponse) GetGradeDefenceCriterion() *GradeDefenceCriterion {
+func (x *RequestGradeDefenceAmendmentResponse) GetAmendment() *GradeDefenceAmendment {
 	if x != nil {
-		return x.GradeDefenceCriterion
+		return x.Amendment
 	}
 	return nil
 }
 
-type UpdateGradeDefenceCriterionRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	GradeDefenceCode *string                `protobuf:"bytes,2,opt,name=grade_defence_code,json=gradeDefenceCode,proto3,oneof" json:"grade_defence_code,omitempty"`
-	Name             *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Score            *string                `protobuf:"bytes,4,opt,name=score,proto3,oneof" json:"score,omitempty"`
-	MaxScore         *string                `protobuf:"bytes,5,opt,name=maxScore,proto3,oneof" json:"maxScore,omitempty"`
-	UpdatedBy        *string                `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// Approving writes new_score and recomputes the grade defence's total_score
+type DecideGradeDefenceAmendmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	DecidedBy     string                 `protobuf:"bytes,4,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateGradeDefenceCriterionRequest) Reset() {
-	*x = UpdateGradeDefenceCriterionRequest{}
-	mi := &file_proto_council_council_proto_msgTypes[38]
+func (x *DecideGradeDefenceAmendmentRequest) Reset() {
+	*x = DecideGradeDefenceAmendmentRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGradeDefenceCriterionRequest) String() string {
+func (x *DecideGradeDefenceAmendmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGradeDefenceCriterionRequest) ProtoMessage() {}
+func (*DecideGradeDefenceAmendmentRequest) ProtoMessage() {}
 
-func (x *UpdateGradeDefenceCriterionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[38]
+func (x *DecideGradeDefenceAmendmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2365,75 +5593,61 @@ func (x *UpdateGradeDefenceCriterionRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGradeDefenceCriterionRequest.ProtoReflect.Descriptor instead.
-func (*UpdateGradeDefenceCriterionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use DecideGradeDefenceAmendmentRequest.ProtoReflect.Descriptor instead.
+func (*DecideGradeDefenceAmendmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *UpdateGradeDefenceCriterionRequest) GetId() string {
+func (x *DecideGradeDefenceAmendmentRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateGradeDefenceCriterionRequest) GetGradeDefenceCode() string {
-	if x != nil && x.GradeDefenceCode != nil {
-		return *x.GradeDefenceCode
-	}
-	return ""
-}
-
-func (x *UpdateGradeDefenceCriterionRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-func (x *UpdateGradeDefenceCriterionRequest) GetScore() string {
-	if x != nil && x.Score != nil {
-		return *x.Score
+func (x *DecideGradeDefenceAmendmentRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateGradeDefenceCriterionRequest) GetMaxScore() string {
-	if x != nil && x.MaxScore != nil {
-		return *x.MaxScore
+func (x *DecideGradeDefenceAmendmentRequest) GetNote() string {
+	if x != nil {
+		return x.Note
 	}
 	return ""
 }
 
-func (x *UpdateGradeDefenceCriterionRequest) GetUpdatedBy() string {
-	if x != nil && x.UpdatedBy != nil {
-		return *x.UpdatedBy
+func (x *DecideGradeDefenceAmendmentRequest) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
 	}
 	return ""
 }
 
-type UpdateGradeDefenceCriterionResponse struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	GradeDefenceCriterion *GradeDefenceCriterion `protobuf:"bytes,1,opt,name=grade_defence_criterion,json=gradeDefenceCriterion,proto3" json:"grade_defence_criterion,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type DecideGradeDefenceAmendmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amendment     *GradeDefenceAmendment `protobuf:"bytes,1,opt,name=amendment,proto3" json:"amendment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateGradeDefenceCriterionResponse) Reset() {
-	*x = UpdateGradeDefenceCriterionResponse{}
-	mi := &file_proto_council_council_proto_msgTypes[39]
+func (x *DecideGradeDefenceAmendmentResponse) Reset() {
+	*x = DecideGradeDefenceAmendmentResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGradeDefenceCriterionResponse) String() string {
+func (x *DecideGradeDefenceAmendmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGradeDefenceCriterionResponse) ProtoMessage() {}
+func (*DecideGradeDefenceAmendmentResponse) ProtoMessage() {}
 
-func (x *UpdateGradeDefenceCriterionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[39]
+func (x *DecideGradeDefenceAmendmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2444,40 +5658,40 @@ func (x *UpdateGradeDefenceCriterionResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGradeDefenceCriterionResponse.ProtoReflect.Descriptor instead.
-func (*UpdateGradeDefenceCriterionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use DecideGradeDefenceAmendmentResponse.ProtoReflect.Descriptor instead.
+func (*DecideGradeDefenceAmendmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *UpdateGradeDefenceCriterionResponse) GetGradeDefenceCriterion() *GradeDefenceCriterion {
+func (x *DecideGradeDefenceAmendmentResponse) GetAmendment() *GradeDefenceAmendment {
 	if x != nil {
-		return x.GradeDefenceCriterion
+		return x.Amendment
 	}
 	return nil
 }
 
-type DeleteGradeDefenceCriterionRequest struct {
+type GetGradeDefenceAmendmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGradeDefenceCriterionRequest) Reset() {
-	*x = DeleteGradeDefenceCriterionRequest{}
-	mi := &file_proto_council_council_proto_msgTypes[40]
+func (x *GetGradeDefenceAmendmentRequest) Reset() {
+	*x = GetGradeDefenceAmendmentRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGradeDefenceCriterionRequest) String() string {
+func (x *GetGradeDefenceAmendmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGradeDefenceCriterionRequest) ProtoMessage() {}
+func (*GetGradeDefenceAmendmentRequest) ProtoMessage() {}
 
-func (x *DeleteGradeDefenceCriterionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[40]
+func (x *GetGradeDefenceAmendmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2488,40 +5702,40 @@ func (x *DeleteGradeDefenceCriterionRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGradeDefenceCriterionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteGradeDefenceCriterionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use GetGradeDefenceAmendmentRequest.ProtoReflect.Descriptor instead.
+func (*GetGradeDefenceAmendmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *DeleteGradeDefenceCriterionRequest) GetId() string {
+func (x *GetGradeDefenceAmendmentRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteGradeDefenceCriterionResponse struct {
+type GetGradeDefenceAmendmentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Amendment     *GradeDefenceAmendment `protobuf:"bytes,1,opt,name=amendment,proto3" json:"amendment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGradeDefenceCriterionResponse) Reset() {
-	*x = DeleteGradeDefenceCriterionResponse{}
-	mi := &file_proto_council_council_proto_msgTypes[41]
+func (x *GetGradeDefenceAmendmentResponse) Reset() {
+	*x = GetGradeDefenceAmendmentResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGradeDefenceCriterionResponse) String() string {
+func (x *GetGradeDefenceAmendmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGradeDefenceCriterionResponse) ProtoMessage() {}
+func (*GetGradeDefenceAmendmentResponse) ProtoMessage() {}
 
-func (x *DeleteGradeDefenceCriterionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[41]
+func (x *GetGradeDefenceAmendmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2532,40 +5746,42 @@ func (x *DeleteGradeDefenceCriterionResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGradeDefenceCriterionResponse.ProtoReflect.Descriptor instead.
-func (*DeleteGradeDefenceCriterionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use GetGradeDefenceAmendmentResponse.ProtoReflect.Descriptor instead.
+func (*GetGradeDefenceAmendmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *DeleteGradeDefenceCriterionResponse) GetSuccess() bool {
+func (x *GetGradeDefenceAmendmentResponse) GetAmendment() *GradeDefenceAmendment {
 	if x != nil {
-		return x.Success
+		return x.Amendment
 	}
-	return false
+	return nil
 }
 
-type ListGradeDefenceCriteriaRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+type ListGradeDefenceAmendmentsRequest struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	CouncilCode   *string                      `protobuf:"bytes,1,opt,name=council_code,json=councilCode,proto3,oneof" json:"council_code,omitempty"`
+	SemesterCode  *string                      `protobuf:"bytes,2,opt,name=semester_code,json=semesterCode,proto3,oneof" json:"semester_code,omitempty"`
+	Status        *GradeDefenceAmendmentStatus `protobuf:"varint,3,opt,name=status,proto3,enum=council.GradeDefenceAmendmentStatus,oneof" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListGradeDefenceCriteriaRequest) Reset() {
-	*x = ListGradeDefenceCriteriaRequest{}
-	mi := &file_proto_council_council_proto_msgTypes[42]
+func (x *ListGradeDefenceAmendmentsRequest) Reset() {
+	*x = ListGradeDefenceAmendmentsRequest{}
+	mi := &file_proto_council_council_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGradeDefenceCriteriaRequest) String() string {
+func (x *ListGradeDefenceAmendmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGradeDefenceCriteriaRequest) ProtoMessage() {}
+func (*ListGradeDefenceAmendmentsRequest) ProtoMessage() {}
 
-func (x *ListGradeDefenceCriteriaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[42]
+func (x *ListGradeDefenceAmendmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2576,43 +5792,54 @@ func (x *ListGradeDefenceCriteriaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGradeDefenceCriteriaRequest.ProtoReflect.Descriptor instead.
-func (*ListGradeDefenceCriteriaRequest) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use ListGradeDefenceAmendmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListGradeDefenceAmendmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *ListGradeDefenceCriteriaRequest) GetSearch() *common.SearchRequest {
-	if x != nil {
-		return x.Search
+func (x *ListGradeDefenceAmendmentsRequest) GetCouncilCode() string {
+	if x != nil && x.CouncilCode != nil {
+		return *x.CouncilCode
 	}
-	return nil
+	return ""
 }
 
-type ListGradeDefenceCriteriaResponse struct {
-	state                protoimpl.MessageState   `protogen:"open.v1"`
-	GradeDefenceCriteria []*GradeDefenceCriterion `protobuf:"bytes,1,rep,name=grade_defence_criteria,json=gradeDefenceCriteria,proto3" json:"grade_defence_criteria,omitempty"`
-	Total                int32                    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page                 int32                    `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize             int32                    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *ListGradeDefenceAmendmentsRequest) GetSemesterCode() string {
+	if x != nil && x.SemesterCode != nil {
+		return *x.SemesterCode
+	}
+	return ""
 }
 
-func (x *ListGradeDefenceCriteriaResponse) Reset() {
-	*x = ListGradeDefenceCriteriaResponse{}
-	mi := &file_proto_council_council_proto_msgTypes[43]
+func (x *ListGradeDefenceAmendmentsRequest) GetStatus() GradeDefenceAmendmentStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_PENDING
+}
+
+type ListGradeDefenceAmendmentsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Amendments    []*GradeDefenceAmendment `protobuf:"bytes,1,rep,name=amendments,proto3" json:"amendments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGradeDefenceAmendmentsResponse) Reset() {
+	*x = ListGradeDefenceAmendmentsResponse{}
+	mi := &file_proto_council_council_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGradeDefenceCriteriaResponse) String() string {
+func (x *ListGradeDefenceAmendmentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGradeDefenceCriteriaResponse) ProtoMessage() {}
+func (*ListGradeDefenceAmendmentsResponse) ProtoMessage() {}
 
-func (x *ListGradeDefenceCriteriaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_council_council_proto_msgTypes[43]
+func (x *ListGradeDefenceAmendmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_council_council_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2623,44 +5850,23 @@ func (x *ListGradeDefenceCriteriaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGradeDefenceCriteriaResponse.ProtoReflect.Descriptor instead.
-func (*ListGradeDefenceCriteriaResponse) Descriptor() ([]byte, []int) {
-	return file_proto_council_council_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ListGradeDefenceAmendmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListGradeDefenceAmendmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_council_council_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *ListGradeDefenceCriteriaResponse) GetGradeDefenceCriteria() []*GradeDefenceCriterion {
+func (x *ListGradeDefenceAmendmentsResponse) GetAmendments() []*GradeDefenceAmendment {
 	if x != nil {
-		return x.GradeDefenceCriteria
+		return x.Amendments
 	}
 	return nil
 }
 
-func (x *ListGradeDefenceCriteriaResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *ListGradeDefenceCriteriaResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListGradeDefenceCriteriaResponse) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
 var File_proto_council_council_proto protoreflect.FileDescriptor
 
 const file_proto_council_council_proto_rawDesc = "" +
 	"\n" +
-	"\x1bproto/council/council.proto\x12\acouncil\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19proto/common/common.proto\"\xe2\x02\n" +
+	"\x1bproto/council/council.proto\x12\acouncil\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19proto/common/common.proto\"\xa6\x06\n" +
 	"\aCouncil\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
@@ -2676,7 +5882,20 @@ const file_proto_council_council_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\b \x01(\tR\tcreatedBy\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\t \x01(\tR\tupdatedBy\"\xde\x01\n" +
+	"updated_by\x18\t \x01(\tR\tupdatedBy\x12\x12\n" +
+	"\x04room\x18\n" +
+	" \x01(\tR\x04room\x12#\n" +
+	"\rgrades_locked\x18\v \x01(\bR\fgradesLocked\x12D\n" +
+	"\x10grades_locked_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x0egradesLockedAt\x12(\n" +
+	"\x10grades_locked_by\x18\r \x01(\tR\x0egradesLockedBy\x12)\n" +
+	"\x10grades_published\x18\x0e \x01(\bR\x0fgradesPublished\x12J\n" +
+	"\x13grades_published_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x11gradesPublishedAt\x12.\n" +
+	"\x13grades_published_by\x18\x10 \x01(\tR\x11gradesPublishedBy\x12\x18\n" +
+	"\aversion\x18\x11 \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x13 \x01(\tR\tdeletedBy\"\x80\x02\n" +
 	"\x14CreateCouncilRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1d\n" +
 	"\n" +
@@ -2685,14 +5904,17 @@ const file_proto_council_council_proto_rawDesc = "" +
 	"\n" +
 	"time_start\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\ttimeStart\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"created_by\x18\x05 \x01(\tR\tcreatedByB\r\n" +
-	"\v_time_start\"C\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\x12\x17\n" +
+	"\x04room\x18\x06 \x01(\tH\x01R\x04room\x88\x01\x01B\r\n" +
+	"\v_time_startB\a\n" +
+	"\x05_room\"C\n" +
 	"\x15CreateCouncilResponse\x12*\n" +
-	"\acouncil\x18\x01 \x01(\v2\x10.council.CouncilR\acouncil\"#\n" +
+	"\acouncil\x18\x01 \x01(\v2\x10.council.CouncilR\acouncil\"L\n" +
 	"\x11GetCouncilRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"@\n" +
 	"\x12GetCouncilResponse\x12*\n" +
-	"\acouncil\x18\x01 \x01(\v2\x10.council.CouncilR\acouncil\"\xa8\x02\n" +
+	"\acouncil\x18\x01 \x01(\v2\x10.council.CouncilR\acouncil\"\xf5\x02\n" +
 	"\x14UpdateCouncilRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\"\n" +
@@ -2702,24 +5924,52 @@ const file_proto_council_council_proto_rawDesc = "" +
 	"\n" +
 	"time_start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\ttimeStart\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\x06 \x01(\tR\tupdatedByB\b\n" +
+	"updated_by\x18\x06 \x01(\tR\tupdatedBy\x12\x17\n" +
+	"\x04room\x18\a \x01(\tH\x04R\x04room\x88\x01\x01\x12\x1d\n" +
+	"\aversion\x18\b \x01(\x05H\x05R\aversion\x88\x01\x01B\b\n" +
 	"\x06_titleB\r\n" +
 	"\v_major_codeB\x10\n" +
 	"\x0e_semester_codeB\r\n" +
-	"\v_time_start\"C\n" +
+	"\v_time_startB\a\n" +
+	"\x05_roomB\n" +
+	"\n" +
+	"\b_version\"C\n" +
 	"\x15UpdateCouncilResponse\x12*\n" +
-	"\acouncil\x18\x01 \x01(\v2\x10.council.CouncilR\acouncil\"&\n" +
+	"\acouncil\x18\x01 \x01(\v2\x10.council.CouncilR\acouncil\"\x97\x01\n" +
+	"\vCouncilSlot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04room\x18\x02 \x01(\tR\x04room\x129\n" +
+	"\n" +
+	"time_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimeStart\x12\x1d\n" +
+	"\aversion\x18\x04 \x01(\x05H\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"j\n" +
+	"\x17ScheduleCouncilsRequest\x120\n" +
+	"\bcouncils\x18\x01 \x03(\v2\x14.council.CouncilSlotR\bcouncils\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x02 \x01(\tR\tupdatedBy\"H\n" +
+	"\x18ScheduleCouncilsResponse\x12,\n" +
+	"\bcouncils\x18\x01 \x03(\v2\x10.council.CouncilR\bcouncils\"E\n" +
 	"\x14DeleteCouncilRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\"1\n" +
 	"\x15DeleteCouncilResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"D\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"H\n" +
+	"\x15RestoreCouncilRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrestored_by\x18\x02 \x01(\tR\n" +
+	"restoredBy\"D\n" +
+	"\x16RestoreCouncilResponse\x12*\n" +
+	"\acouncil\x18\x01 \x01(\v2\x10.council.CouncilR\acouncil\"m\n" +
 	"\x13ListCouncilsRequest\x12-\n" +
-	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\"\x8b\x01\n" +
+	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"\x8b\x01\n" +
 	"\x14ListCouncilsResponse\x12,\n" +
 	"\bcouncils\x18\x01 \x03(\v2\x10.council.CouncilR\bcouncils\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xdf\x02\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xd3\x03\n" +
 	"\aDefence\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12!\n" +
@@ -2733,20 +5983,32 @@ const file_proto_council_council_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\b \x01(\tR\tcreatedBy\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\t \x01(\tR\tupdatedBy\"\xc7\x01\n" +
+	"updated_by\x18\t \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\f \x01(\tR\tdeletedBy\"\xcc\x02\n" +
 	"\x14CreateDefenceRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12!\n" +
 	"\fcouncil_code\x18\x02 \x01(\tR\vcouncilCode\x12!\n" +
 	"\fteacher_code\x18\x03 \x01(\tR\vteacherCode\x124\n" +
 	"\bposition\x18\x04 \x01(\x0e2\x18.council.DefencePositionR\bposition\x12\x1d\n" +
 	"\n" +
-	"created_by\x18\x05 \x01(\tR\tcreatedBy\"C\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\x12A\n" +
+	"\n" +
+	"validation\x18\x06 \x01(\v2!.council.CouncilValidationContextR\n" +
+	"validation\x12,\n" +
+	"\x0foverride_reason\x18\a \x01(\tH\x00R\x0eoverrideReason\x88\x01\x01B\x12\n" +
+	"\x10_override_reason\"C\n" +
 	"\x15CreateDefenceResponse\x12*\n" +
-	"\adefence\x18\x01 \x01(\v2\x10.council.DefenceR\adefence\"#\n" +
+	"\adefence\x18\x01 \x01(\v2\x10.council.DefenceR\adefence\"L\n" +
 	"\x11GetDefenceRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"@\n" +
 	"\x12GetDefenceResponse\x12*\n" +
-	"\adefence\x18\x01 \x01(\v2\x10.council.DefenceR\adefence\"\xa4\x02\n" +
+	"\adefence\x18\x01 \x01(\v2\x10.council.DefenceR\adefence\"\xd4\x03\n" +
 	"\x14UpdateDefenceRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12&\n" +
@@ -2754,31 +6016,48 @@ const file_proto_council_council_proto_rawDesc = "" +
 	"\fteacher_code\x18\x04 \x01(\tH\x02R\vteacherCode\x88\x01\x01\x129\n" +
 	"\bposition\x18\x05 \x01(\x0e2\x18.council.DefencePositionH\x03R\bposition\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\x06 \x01(\tR\tupdatedByB\b\n" +
+	"updated_by\x18\x06 \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\aversion\x18\a \x01(\x05H\x04R\aversion\x88\x01\x01\x12A\n" +
+	"\n" +
+	"validation\x18\b \x01(\v2!.council.CouncilValidationContextR\n" +
+	"validation\x12,\n" +
+	"\x0foverride_reason\x18\t \x01(\tH\x05R\x0eoverrideReason\x88\x01\x01B\b\n" +
 	"\x06_titleB\x0f\n" +
 	"\r_council_codeB\x0f\n" +
 	"\r_teacher_codeB\v\n" +
-	"\t_position\"C\n" +
+	"\t_positionB\n" +
+	"\n" +
+	"\b_versionB\x12\n" +
+	"\x10_override_reason\"C\n" +
 	"\x15UpdateDefenceResponse\x12*\n" +
-	"\adefence\x18\x01 \x01(\v2\x10.council.DefenceR\adefence\"&\n" +
+	"\adefence\x18\x01 \x01(\v2\x10.council.DefenceR\adefence\"E\n" +
 	"\x14DeleteDefenceRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\"1\n" +
 	"\x15DeleteDefenceResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"D\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"H\n" +
+	"\x15RestoreDefenceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrestored_by\x18\x02 \x01(\tR\n" +
+	"restoredBy\"D\n" +
+	"\x16RestoreDefenceResponse\x12*\n" +
+	"\adefence\x18\x01 \x01(\v2\x10.council.DefenceR\adefence\"m\n" +
 	"\x13ListDefencesRequest\x12-\n" +
-	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\"\x8b\x01\n" +
+	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"\x8b\x01\n" +
 	"\x14ListDefencesResponse\x12,\n" +
 	"\bdefences\x18\x01 \x03(\v2\x10.council.DefenceR\bdefences\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xd3\x02\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x8e\x04\n" +
 	"\fGradeDefence\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
 	"\fdefence_code\x18\x02 \x01(\tR\vdefenceCode\x12'\n" +
 	"\x0fenrollment_code\x18\x03 \x01(\tR\x0eenrollmentCode\x12\x12\n" +
-	"\x04note\x18\x04 \x01(\tR\x04note\x12\x1f\n" +
-	"\vtotal_score\x18\x05 \x01(\x05R\n" +
-	"totalScore\x129\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12$\n" +
+	"\vtotal_score\x18\x05 \x01(\x01H\x00R\n" +
+	"totalScore\x88\x01\x01\x129\n" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
@@ -2786,55 +6065,71 @@ const file_proto_council_council_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\b \x01(\tR\tcreatedBy\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\t \x01(\tR\tupdatedBy\"\xde\x01\n" +
+	"updated_by\x18\t \x01(\tR\tupdatedBy\x120\n" +
+	"\x14rubric_template_code\x18\n" +
+	" \x01(\tR\x12rubricTemplateCode\x12\x18\n" +
+	"\aversion\x18\v \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\r \x01(\tR\tdeletedByB\x0e\n" +
+	"\f_total_score\"\xda\x01\n" +
 	"\x19CreateGradeDefenceRequest\x12!\n" +
 	"\fdefence_code\x18\x01 \x01(\tR\vdefenceCode\x12'\n" +
 	"\x0fenrollment_code\x18\x02 \x01(\tR\x0eenrollmentCode\x12\x17\n" +
-	"\x04note\x18\x03 \x01(\tH\x00R\x04note\x88\x01\x01\x12$\n" +
-	"\vtotal_score\x18\x04 \x01(\x05H\x01R\n" +
-	"totalScore\x88\x01\x01\x12\x1d\n" +
+	"\x04note\x18\x03 \x01(\tH\x00R\x04note\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"created_by\x18\x05 \x01(\tR\tcreatedByB\a\n" +
-	"\x05_noteB\x0e\n" +
-	"\f_total_score\"X\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\x12*\n" +
+	"\x05stage\x18\x06 \x01(\x0e2\x14.council.RubricStageR\x05stageB\a\n" +
+	"\x05_noteJ\x04\b\x04\x10\x05\"X\n" +
 	"\x1aCreateGradeDefenceResponse\x12:\n" +
-	"\rgrade_defence\x18\x01 \x01(\v2\x15.council.GradeDefenceR\fgradeDefence\"(\n" +
+	"\rgrade_defence\x18\x01 \x01(\v2\x15.council.GradeDefenceR\fgradeDefence\"Q\n" +
 	"\x16GetGradeDefenceRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"U\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"U\n" +
 	"\x17GetGradeDefenceResponse\x12:\n" +
-	"\rgrade_defence\x18\x01 \x01(\v2\x15.council.GradeDefenceR\fgradeDefence\"\x9d\x02\n" +
+	"\rgrade_defence\x18\x01 \x01(\v2\x15.council.GradeDefenceR\fgradeDefence\"\x98\x02\n" +
 	"\x19UpdateGradeDefenceRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
 	"\fdefence_code\x18\x02 \x01(\tH\x00R\vdefenceCode\x88\x01\x01\x12,\n" +
 	"\x0fenrollment_code\x18\x03 \x01(\tH\x01R\x0eenrollmentCode\x88\x01\x01\x12\x17\n" +
-	"\x04note\x18\x04 \x01(\tH\x02R\x04note\x88\x01\x01\x12$\n" +
-	"\vtotal_score\x18\x05 \x01(\x05H\x03R\n" +
-	"totalScore\x88\x01\x01\x12\x1d\n" +
+	"\x04note\x18\x04 \x01(\tH\x02R\x04note\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\x06 \x01(\tR\tupdatedByB\x0f\n" +
+	"updated_by\x18\x06 \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\aversion\x18\a \x01(\x05H\x03R\aversion\x88\x01\x01B\x0f\n" +
 	"\r_defence_codeB\x12\n" +
 	"\x10_enrollment_codeB\a\n" +
-	"\x05_noteB\x0e\n" +
-	"\f_total_score\"X\n" +
+	"\x05_noteB\n" +
+	"\n" +
+	"\b_versionJ\x04\b\x05\x10\x06\"X\n" +
 	"\x1aUpdateGradeDefenceResponse\x12:\n" +
-	"\rgrade_defence\x18\x01 \x01(\v2\x15.council.GradeDefenceR\fgradeDefence\"+\n" +
+	"\rgrade_defence\x18\x01 \x01(\v2\x15.council.GradeDefenceR\fgradeDefence\"J\n" +
 	"\x19DeleteGradeDefenceRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\"6\n" +
 	"\x1aDeleteGradeDefenceResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"I\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"M\n" +
+	"\x1aRestoreGradeDefenceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrestored_by\x18\x02 \x01(\tR\n" +
+	"restoredBy\"Y\n" +
+	"\x1bRestoreGradeDefenceResponse\x12:\n" +
+	"\rgrade_defence\x18\x01 \x01(\v2\x15.council.GradeDefenceR\fgradeDefence\"r\n" +
 	"\x18ListGradeDefencesRequest\x12-\n" +
-	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\"\xa0\x01\n" +
+	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"\xa0\x01\n" +
 	"\x19ListGradeDefencesResponse\x12<\n" +
 	"\x0egrade_defences\x18\x01 \x03(\v2\x15.council.GradeDefenceR\rgradeDefences\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xcf\x02\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xe6\x03\n" +
 	"\x15GradeDefenceCriterion\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
 	"\x12grade_defence_code\x18\x02 \x01(\tR\x10gradeDefenceCode\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
-	"\x05score\x18\x04 \x01(\tR\x05score\x12\x1a\n" +
-	"\bmaxScore\x18\x05 \x01(\tR\bmaxScore\x129\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x19\n" +
+	"\x05score\x18\x04 \x01(\x01H\x00R\x05score\x88\x01\x01\x12\x1a\n" +
+	"\bmaxScore\x18\x05 \x01(\x01R\bmaxScore\x129\n" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
@@ -2842,37 +6137,54 @@ const file_proto_council_council_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\b \x01(\tR\tcreatedBy\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\t \x01(\tR\tupdatedBy\"\xfa\x01\n" +
+	"updated_by\x18\t \x01(\tR\tupdatedBy\x12 \n" +
+	"\vdescription\x18\n" +
+	" \x01(\tR\vdescription\x12\x16\n" +
+	"\x06weight\x18\v \x01(\x01R\x06weight\x122\n" +
+	"\x15rubric_criterion_code\x18\f \x01(\tR\x13rubricCriterionCode\x12\x18\n" +
+	"\aversion\x18\r \x01(\x05R\aversionB\b\n" +
+	"\x06_score\"\xd9\x02\n" +
 	"\"CreateGradeDefenceCriterionRequest\x12,\n" +
 	"\x12grade_defence_code\x18\x01 \x01(\tR\x10gradeDefenceCode\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
-	"\x05score\x18\x03 \x01(\tH\x01R\x05score\x88\x01\x01\x12\x1f\n" +
-	"\bmaxScore\x18\x04 \x01(\tH\x02R\bmaxScore\x88\x01\x01\x12\"\n" +
+	"\x05score\x18\x03 \x01(\x01H\x01R\x05score\x88\x01\x01\x12\x1f\n" +
+	"\bmaxScore\x18\x04 \x01(\x01H\x02R\bmaxScore\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"created_by\x18\x05 \x01(\tH\x03R\tcreatedBy\x88\x01\x01B\a\n" +
+	"created_by\x18\x05 \x01(\tH\x03R\tcreatedBy\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x06 \x01(\tH\x04R\vdescription\x88\x01\x01\x12\x1b\n" +
+	"\x06weight\x18\a \x01(\x01H\x05R\x06weight\x88\x01\x01B\a\n" +
 	"\x05_nameB\b\n" +
 	"\x06_scoreB\v\n" +
 	"\t_maxScoreB\r\n" +
-	"\v_created_by\"}\n" +
+	"\v_created_byB\x0e\n" +
+	"\f_descriptionB\t\n" +
+	"\a_weight\"}\n" +
 	"#CreateGradeDefenceCriterionResponse\x12V\n" +
 	"\x17grade_defence_criterion\x18\x01 \x01(\v2\x1e.council.GradeDefenceCriterionR\x15gradeDefenceCriterion\"1\n" +
 	"\x1fGetGradeDefenceCriterionRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"z\n" +
 	" GetGradeDefenceCriterionResponse\x12V\n" +
-	"\x17grade_defence_criterion\x18\x01 \x01(\v2\x1e.council.GradeDefenceCriterionR\x15gradeDefenceCriterion\"\xa6\x02\n" +
+	"\x17grade_defence_criterion\x18\x01 \x01(\v2\x1e.council.GradeDefenceCriterionR\x15gradeDefenceCriterion\"\xb0\x03\n" +
 	"\"UpdateGradeDefenceCriterionRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
 	"\x12grade_defence_code\x18\x02 \x01(\tH\x00R\x10gradeDefenceCode\x88\x01\x01\x12\x17\n" +
 	"\x04name\x18\x03 \x01(\tH\x01R\x04name\x88\x01\x01\x12\x19\n" +
-	"\x05score\x18\x04 \x01(\tH\x02R\x05score\x88\x01\x01\x12\x1f\n" +
-	"\bmaxScore\x18\x05 \x01(\tH\x03R\bmaxScore\x88\x01\x01\x12\"\n" +
+	"\x05score\x18\x04 \x01(\x01H\x02R\x05score\x88\x01\x01\x12\x1f\n" +
+	"\bmaxScore\x18\x05 \x01(\x01H\x03R\bmaxScore\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"updated_by\x18\x06 \x01(\tH\x04R\tupdatedBy\x88\x01\x01B\x15\n" +
+	"updated_by\x18\x06 \x01(\tH\x04R\tupdatedBy\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\a \x01(\tH\x05R\vdescription\x88\x01\x01\x12\x1b\n" +
+	"\x06weight\x18\b \x01(\x01H\x06R\x06weight\x88\x01\x01\x12\x1d\n" +
+	"\aversion\x18\t \x01(\x05H\aR\aversion\x88\x01\x01B\x15\n" +
 	"\x13_grade_defence_codeB\a\n" +
 	"\x05_nameB\b\n" +
 	"\x06_scoreB\v\n" +
 	"\t_maxScoreB\r\n" +
-	"\v_updated_by\"}\n" +
+	"\v_updated_byB\x0e\n" +
+	"\f_descriptionB\t\n" +
+	"\a_weightB\n" +
+	"\n" +
+	"\b_version\"}\n" +
 	"#UpdateGradeDefenceCriterionResponse\x12V\n" +
 	"\x17grade_defence_criterion\x18\x01 \x01(\v2\x1e.council.GradeDefenceCriterionR\x15gradeDefenceCriterion\"4\n" +
 	"\"DeleteGradeDefenceCriterionRequest\x12\x0e\n" +
@@ -2885,36 +6197,256 @@ const file_proto_council_council_proto_rawDesc = "" +
 	"\x16grade_defence_criteria\x18\x01 \x03(\v2\x1e.council.GradeDefenceCriterionR\x14gradeDefenceCriteria\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize*I\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xdd\x01\n" +
+	"\x0fRubricCriterion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x14rubric_template_code\x18\x02 \x01(\tR\x12rubricTemplateCode\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tmax_score\x18\x05 \x01(\x01R\bmaxScore\x12\x16\n" +
+	"\x06weight\x18\x06 \x01(\x01R\x06weight\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\a \x01(\x05R\tsortOrder\"\x85\x03\n" +
+	"\x0eRubricTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"major_code\x18\x03 \x01(\tR\tmajorCode\x12*\n" +
+	"\x05stage\x18\x04 \x01(\x0e2\x14.council.RubricStageR\x05stage\x124\n" +
+	"\bcriteria\x18\x05 \x03(\v2\x18.council.RubricCriterionR\bcriteria\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\t \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x05R\aversion\"\x81\x01\n" +
+	"\x14RubricCriterionInput\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tmax_score\x18\x03 \x01(\x01R\bmaxScore\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x01R\x06weight\"\xd8\x01\n" +
+	"\x1bCreateRubricTemplateRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"major_code\x18\x02 \x01(\tR\tmajorCode\x12*\n" +
+	"\x05stage\x18\x03 \x01(\x0e2\x14.council.RubricStageR\x05stage\x129\n" +
+	"\bcriteria\x18\x04 \x03(\v2\x1d.council.RubricCriterionInputR\bcriteria\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\"`\n" +
+	"\x1cCreateRubricTemplateResponse\x12@\n" +
+	"\x0frubric_template\x18\x01 \x01(\v2\x17.council.RubricTemplateR\x0erubricTemplate\"*\n" +
+	"\x18GetRubricTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"]\n" +
+	"\x19GetRubricTemplateResponse\x12@\n" +
+	"\x0frubric_template\x18\x01 \x01(\v2\x17.council.RubricTemplateR\x0erubricTemplate\"\xd7\x01\n" +
+	"\x1bUpdateRubricTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x129\n" +
+	"\bcriteria\x18\x03 \x03(\v2\x1d.council.RubricCriterionInputR\bcriteria\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x04 \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\aversion\x18\x05 \x01(\x05H\x01R\aversion\x88\x01\x01B\b\n" +
+	"\x06_titleB\n" +
+	"\n" +
+	"\b_version\"`\n" +
+	"\x1cUpdateRubricTemplateResponse\x12@\n" +
+	"\x0frubric_template\x18\x01 \x01(\v2\x17.council.RubricTemplateR\x0erubricTemplate\"-\n" +
+	"\x1bDeleteRubricTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"8\n" +
+	"\x1cDeleteRubricTemplateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x8a\x01\n" +
+	"\x1aListRubricTemplatesRequest\x12\"\n" +
+	"\n" +
+	"major_code\x18\x01 \x01(\tH\x00R\tmajorCode\x88\x01\x01\x12/\n" +
+	"\x05stage\x18\x02 \x01(\x0e2\x14.council.RubricStageH\x01R\x05stage\x88\x01\x01B\r\n" +
+	"\v_major_codeB\b\n" +
+	"\x06_stage\"a\n" +
+	"\x1bListRubricTemplatesResponse\x12B\n" +
+	"\x10rubric_templates\x18\x01 \x03(\v2\x17.council.RubricTemplateR\x0frubricTemplates\"\x8e\x01\n" +
+	"\fCouncilTopic\x12,\n" +
+	"\x12topic_council_code\x18\x01 \x01(\tR\x10topicCouncilCode\x12)\n" +
+	"\x10supervisor_codes\x18\x02 \x03(\tR\x0fsupervisorCodes\x12%\n" +
+	"\x0ereviewer_codes\x18\x03 \x03(\tR\rreviewerCodes\"V\n" +
+	"\x12CouncilMemberMajor\x12!\n" +
+	"\fteacher_code\x18\x01 \x01(\tR\vteacherCode\x12\x1d\n" +
+	"\n" +
+	"major_code\x18\x02 \x01(\tR\tmajorCode\"\x8b\x01\n" +
+	"\x18CouncilValidationContext\x12-\n" +
+	"\x06topics\x18\x01 \x03(\v2\x15.council.CouncilTopicR\x06topics\x12@\n" +
+	"\rmember_majors\x18\x02 \x03(\v2\x1b.council.CouncilMemberMajorR\fmemberMajors\"\xce\x01\n" +
+	"\x0fCouncilConflict\x120\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1c.council.CouncilConflictKindR\x04kind\x12!\n" +
+	"\fteacher_code\x18\x02 \x01(\tR\vteacherCode\x12,\n" +
+	"\x12topic_council_code\x18\x03 \x01(\tR\x10topicCouncilCode\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x1e\n" +
+	"\n" +
+	"overridden\x18\x05 \x01(\bR\n" +
+	"overridden\"\xd6\x01\n" +
+	"\x16ValidateCouncilRequest\x12!\n" +
+	"\fcouncil_code\x18\x01 \x01(\tR\vcouncilCode\x12A\n" +
+	"\n" +
+	"validation\x18\x02 \x01(\v2!.council.CouncilValidationContextR\n" +
+	"validation\x12,\n" +
+	"\x0foverride_reason\x18\x03 \x01(\tH\x00R\x0eoverrideReason\x88\x01\x01\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actorB\x12\n" +
+	"\x10_override_reason\"g\n" +
+	"\x17ValidateCouncilResponse\x126\n" +
+	"\tconflicts\x18\x01 \x03(\v2\x18.council.CouncilConflictR\tconflicts\x12\x14\n" +
+	"\x05valid\x18\x02 \x01(\bR\x05valid\"\xc2\x01\n" +
+	"\x18AdmitTopicCouncilRequest\x12!\n" +
+	"\fcouncil_code\x18\x01 \x01(\tR\vcouncilCode\x12+\n" +
+	"\x05topic\x18\x02 \x01(\v2\x15.council.CouncilTopicR\x05topic\x12,\n" +
+	"\x0foverride_reason\x18\x03 \x01(\tH\x00R\x0eoverrideReason\x88\x01\x01\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actorB\x12\n" +
+	"\x10_override_reason\"S\n" +
+	"\x19AdmitTopicCouncilResponse\x126\n" +
+	"\tconflicts\x18\x01 \x03(\v2\x18.council.CouncilConflictR\tconflicts\"\xdb\x02\n" +
+	"\x17CouncilConflictOverride\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fcouncil_code\x18\x02 \x01(\tR\vcouncilCode\x120\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x1c.council.CouncilConflictKindR\x04kind\x12!\n" +
+	"\fteacher_code\x18\x04 \x01(\tR\vteacherCode\x12,\n" +
+	"\x12topic_council_code\x18\x05 \x01(\tR\x10topicCouncilCode\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tR\tcreatedBy\"H\n" +
+	"#ListCouncilConflictOverridesRequest\x12!\n" +
+	"\fcouncil_code\x18\x01 \x01(\tR\vcouncilCode\"f\n" +
+	"$ListCouncilConflictOverridesResponse\x12>\n" +
+	"\toverrides\x18\x01 \x03(\v2 .council.CouncilConflictOverrideR\toverrides\"S\n" +
+	"\x18LockCouncilGradesRequest\x12!\n" +
+	"\fcouncil_code\x18\x01 \x01(\tR\vcouncilCode\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\"G\n" +
+	"\x19LockCouncilGradesResponse\x12*\n" +
+	"\acouncil\x18\x01 \x01(\v2\x10.council.CouncilR\acouncil\"V\n" +
+	"\x1bPublishCouncilGradesRequest\x12!\n" +
+	"\fcouncil_code\x18\x01 \x01(\tR\vcouncilCode\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\"J\n" +
+	"\x1cPublishCouncilGradesResponse\x12*\n" +
+	"\acouncil\x18\x01 \x01(\v2\x10.council.CouncilR\acouncil\"\x83\x05\n" +
+	"\x15GradeDefenceAmendment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0ecriterion_code\x18\x02 \x01(\tR\rcriterionCode\x12,\n" +
+	"\x12grade_defence_code\x18\x03 \x01(\tR\x10gradeDefenceCode\x12!\n" +
+	"\fcouncil_code\x18\x04 \x01(\tR\vcouncilCode\x12'\n" +
+	"\x0fenrollment_code\x18\x05 \x01(\tR\x0eenrollmentCode\x12 \n" +
+	"\told_score\x18\x06 \x01(\x01H\x00R\boldScore\x88\x01\x01\x12\x1b\n" +
+	"\tnew_score\x18\a \x01(\x01R\bnewScore\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12<\n" +
+	"\x06status\x18\t \x01(\x0e2$.council.GradeDefenceAmendmentStatusR\x06status\x12!\n" +
+	"\frequested_by\x18\n" +
+	" \x01(\tR\vrequestedBy\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\v \x01(\tR\tdecidedBy\x129\n" +
+	"\n" +
+	"decided_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\x12#\n" +
+	"\rdecision_note\x18\r \x01(\tR\fdecisionNote\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\f\n" +
+	"\n" +
+	"_old_score\"\xa4\x01\n" +
+	"#RequestGradeDefenceAmendmentRequest\x12%\n" +
+	"\x0ecriterion_code\x18\x01 \x01(\tR\rcriterionCode\x12\x1b\n" +
+	"\tnew_score\x18\x02 \x01(\x01R\bnewScore\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12!\n" +
+	"\frequested_by\x18\x04 \x01(\tR\vrequestedBy\"d\n" +
+	"$RequestGradeDefenceAmendmentResponse\x12<\n" +
+	"\tamendment\x18\x01 \x01(\v2\x1e.council.GradeDefenceAmendmentR\tamendment\"\x81\x01\n" +
+	"\"DecideGradeDefenceAmendmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\x04 \x01(\tR\tdecidedBy\"c\n" +
+	"#DecideGradeDefenceAmendmentResponse\x12<\n" +
+	"\tamendment\x18\x01 \x01(\v2\x1e.council.GradeDefenceAmendmentR\tamendment\"1\n" +
+	"\x1fGetGradeDefenceAmendmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"`\n" +
+	" GetGradeDefenceAmendmentResponse\x12<\n" +
+	"\tamendment\x18\x01 \x01(\v2\x1e.council.GradeDefenceAmendmentR\tamendment\"\xe6\x01\n" +
+	"!ListGradeDefenceAmendmentsRequest\x12&\n" +
+	"\fcouncil_code\x18\x01 \x01(\tH\x00R\vcouncilCode\x88\x01\x01\x12(\n" +
+	"\rsemester_code\x18\x02 \x01(\tH\x01R\fsemesterCode\x88\x01\x01\x12A\n" +
+	"\x06status\x18\x03 \x01(\x0e2$.council.GradeDefenceAmendmentStatusH\x02R\x06status\x88\x01\x01B\x0f\n" +
+	"\r_council_codeB\x10\n" +
+	"\x0e_semester_codeB\t\n" +
+	"\a_status\"d\n" +
+	"\"ListGradeDefenceAmendmentsResponse\x12>\n" +
+	"\n" +
+	"amendments\x18\x01 \x03(\v2\x1e.council.GradeDefenceAmendmentR\n" +
+	"amendments*I\n" +
 	"\x0fDefencePosition\x12\r\n" +
 	"\tPRESIDENT\x10\x00\x12\r\n" +
 	"\tSECRETARY\x10\x01\x12\f\n" +
 	"\bREVIEWER\x10\x02\x12\n" +
 	"\n" +
-	"\x06MEMBER\x10\x032\xb7\x0e\n" +
+	"\x06MEMBER\x10\x03*;\n" +
+	"\vRubricStage\x12\x15\n" +
+	"\x11RUBRIC_STAGE_DACN\x10\x00\x12\x15\n" +
+	"\x11RUBRIC_STAGE_LVTN\x10\x01*\xc0\x01\n" +
+	"\x13CouncilConflictKind\x12\x19\n" +
+	"\x15SUPERVISOR_ON_COUNCIL\x10\x00\x12\x1a\n" +
+	"\x16REVIEWER_IS_SUPERVISOR\x10\x01\x12\x16\n" +
+	"\x12DUPLICATE_POSITION\x10\x02\x12\x14\n" +
+	"\x10DUPLICATE_MEMBER\x10\x03\x12\x15\n" +
+	"\x11MISSING_PRESIDENT\x10\x04\x12\x15\n" +
+	"\x11MISSING_SECRETARY\x10\x05\x12\x16\n" +
+	"\x12CROSS_MAJOR_MEMBER\x10\x06*|\n" +
+	"\x1bGradeDefenceAmendmentStatus\x12\x1d\n" +
+	"\x19DEFENCE_AMENDMENT_PENDING\x10\x00\x12\x1e\n" +
+	"\x1aDEFENCE_AMENDMENT_APPROVED\x10\x01\x12\x1e\n" +
+	"\x1aDEFENCE_AMENDMENT_REJECTED\x10\x022\xa6\x1d\n" +
 	"\x0eCouncilService\x12N\n" +
 	"\rCreateCouncil\x12\x1d.council.CreateCouncilRequest\x1a\x1e.council.CreateCouncilResponse\x12E\n" +
 	"\n" +
 	"GetCouncil\x12\x1a.council.GetCouncilRequest\x1a\x1b.council.GetCouncilResponse\x12N\n" +
-	"\rUpdateCouncil\x12\x1d.council.UpdateCouncilRequest\x1a\x1e.council.UpdateCouncilResponse\x12N\n" +
-	"\rDeleteCouncil\x12\x1d.council.DeleteCouncilRequest\x1a\x1e.council.DeleteCouncilResponse\x12K\n" +
-	"\fListCouncils\x12\x1c.council.ListCouncilsRequest\x1a\x1d.council.ListCouncilsResponse\x12N\n" +
+	"\rUpdateCouncil\x12\x1d.council.UpdateCouncilRequest\x1a\x1e.council.UpdateCouncilResponse\x12W\n" +
+	"\x10ScheduleCouncils\x12 .council.ScheduleCouncilsRequest\x1a!.council.ScheduleCouncilsResponse\x12N\n" +
+	"\rDeleteCouncil\x12\x1d.council.DeleteCouncilRequest\x1a\x1e.council.DeleteCouncilResponse\x12Q\n" +
+	"\x0eRestoreCouncil\x12\x1e.council.RestoreCouncilRequest\x1a\x1f.council.RestoreCouncilResponse\x12K\n" +
+	"\fListCouncils\x12\x1c.council.ListCouncilsRequest\x1a\x1d.council.ListCouncilsResponse\x12T\n" +
+	"\x0fValidateCouncil\x12\x1f.council.ValidateCouncilRequest\x1a .council.ValidateCouncilResponse\x12Z\n" +
+	"\x11AdmitTopicCouncil\x12!.council.AdmitTopicCouncilRequest\x1a\".council.AdmitTopicCouncilResponse\x12{\n" +
+	"\x1cListCouncilConflictOverrides\x12,.council.ListCouncilConflictOverridesRequest\x1a-.council.ListCouncilConflictOverridesResponse\x12N\n" +
 	"\rCreateDefence\x12\x1d.council.CreateDefenceRequest\x1a\x1e.council.CreateDefenceResponse\x12E\n" +
 	"\n" +
 	"GetDefence\x12\x1a.council.GetDefenceRequest\x1a\x1b.council.GetDefenceResponse\x12N\n" +
 	"\rUpdateDefence\x12\x1d.council.UpdateDefenceRequest\x1a\x1e.council.UpdateDefenceResponse\x12N\n" +
-	"\rDeleteDefence\x12\x1d.council.DeleteDefenceRequest\x1a\x1e.council.DeleteDefenceResponse\x12K\n" +
+	"\rDeleteDefence\x12\x1d.council.DeleteDefenceRequest\x1a\x1e.council.DeleteDefenceResponse\x12Q\n" +
+	"\x0eRestoreDefence\x12\x1e.council.RestoreDefenceRequest\x1a\x1f.council.RestoreDefenceResponse\x12K\n" +
 	"\fListDefences\x12\x1c.council.ListDefencesRequest\x1a\x1d.council.ListDefencesResponse\x12]\n" +
 	"\x12CreateGradeDefence\x12\".council.CreateGradeDefenceRequest\x1a#.council.CreateGradeDefenceResponse\x12T\n" +
 	"\x0fGetGradeDefence\x12\x1f.council.GetGradeDefenceRequest\x1a .council.GetGradeDefenceResponse\x12]\n" +
 	"\x12UpdateGradeDefence\x12\".council.UpdateGradeDefenceRequest\x1a#.council.UpdateGradeDefenceResponse\x12]\n" +
-	"\x12DeleteGradeDefence\x12\".council.DeleteGradeDefenceRequest\x1a#.council.DeleteGradeDefenceResponse\x12Z\n" +
+	"\x12DeleteGradeDefence\x12\".council.DeleteGradeDefenceRequest\x1a#.council.DeleteGradeDefenceResponse\x12`\n" +
+	"\x13RestoreGradeDefence\x12#.council.RestoreGradeDefenceRequest\x1a$.council.RestoreGradeDefenceResponse\x12Z\n" +
 	"\x11ListGradeDefences\x12!.council.ListGradeDefencesRequest\x1a\".council.ListGradeDefencesResponse\x12x\n" +
 	"\x1bCreateGradeDefenceCriterion\x12+.council.CreateGradeDefenceCriterionRequest\x1a,.council.CreateGradeDefenceCriterionResponse\x12o\n" +
 	"\x18GetGradeDefenceCriterion\x12(.council.GetGradeDefenceCriterionRequest\x1a).council.GetGradeDefenceCriterionResponse\x12x\n" +
 	"\x1bUpdateGradeDefenceCriterion\x12+.council.UpdateGradeDefenceCriterionRequest\x1a,.council.UpdateGradeDefenceCriterionResponse\x12x\n" +
 	"\x1bDeleteGradeDefenceCriterion\x12+.council.DeleteGradeDefenceCriterionRequest\x1a,.council.DeleteGradeDefenceCriterionResponse\x12o\n" +
-	"\x18ListGradeDefenceCriteria\x12(.council.ListGradeDefenceCriteriaRequest\x1a).council.ListGradeDefenceCriteriaResponseB\vZ\t./councilb\x06proto3"
+	"\x18ListGradeDefenceCriteria\x12(.council.ListGradeDefenceCriteriaRequest\x1a).council.ListGradeDefenceCriteriaResponse\x12c\n" +
+	"\x14CreateRubricTemplate\x12$.council.CreateRubricTemplateRequest\x1a%.council.CreateRubricTemplateResponse\x12Z\n" +
+	"\x11GetRubricTemplate\x12!.council.GetRubricTemplateRequest\x1a\".council.GetRubricTemplateResponse\x12c\n" +
+	"\x14UpdateRubricTemplate\x12$.council.UpdateRubricTemplateRequest\x1a%.council.UpdateRubricTemplateResponse\x12c\n" +
+	"\x14DeleteRubricTemplate\x12$.council.DeleteRubricTemplateRequest\x1a%.council.DeleteRubricTemplateResponse\x12`\n" +
+	"\x13ListRubricTemplates\x12#.council.ListRubricTemplatesRequest\x1a$.council.ListRubricTemplatesResponse\x12Z\n" +
+	"\x11LockCouncilGrades\x12!.council.LockCouncilGradesRequest\x1a\".council.LockCouncilGradesResponse\x12c\n" +
+	"\x14PublishCouncilGrades\x12$.council.PublishCouncilGradesRequest\x1a%.council.PublishCouncilGradesResponse\x12{\n" +
+	"\x1cRequestGradeDefenceAmendment\x12,.council.RequestGradeDefenceAmendmentRequest\x1a-.council.RequestGradeDefenceAmendmentResponse\x12x\n" +
+	"\x1bDecideGradeDefenceAmendment\x12+.council.DecideGradeDefenceAmendmentRequest\x1a,.council.DecideGradeDefenceAmendmentResponse\x12o\n" +
+	"\x18GetGradeDefenceAmendment\x12(.council.GetGradeDefenceAmendmentRequest\x1a).council.GetGradeDefenceAmendmentResponse\x12u\n" +
+	"\x1aListGradeDefenceAmendments\x12*.council.ListGradeDefenceAmendmentsRequest\x1a+.council.ListGradeDefenceAmendmentsResponse\x12P\n" +
+	"\x0fCheckReferences\x12\x1d.common.ReferenceCheckRequest\x1a\x1e.common.ReferenceCheckResponseB\vZ\t./councilb\x06proto3"
 
 var (
 	file_proto_council_council_proto_rawDescOnce sync.Once
@@ -2928,137 +6460,273 @@ func file_proto_council_council_proto_rawDescGZIP() []byte {
 	return file_proto_council_council_proto_rawDescData
 }
 
-var file_proto_council_council_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_council_council_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_proto_council_council_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_council_council_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
 var file_proto_council_council_proto_goTypes = []any{
-	(DefencePosition)(0),                        // 0: council.DefencePosition
-	(*Council)(nil),                             // 1: council.Council
-	(*CreateCouncilRequest)(nil),                // 2: council.CreateCouncilRequest
-	(*CreateCouncilResponse)(nil),               // 3: council.CreateCouncilResponse
-	(*GetCouncilRequest)(nil),                   // 4: council.GetCouncilRequest
-	(*GetCouncilResponse)(nil),                  // 5: council.GetCouncilResponse
-	(*UpdateCouncilRequest)(nil),                // 6: council.UpdateCouncilRequest
-	(*UpdateCouncilResponse)(nil),               // 7: council.UpdateCouncilResponse
-	(*DeleteCouncilRequest)(nil),                // 8: council.DeleteCouncilRequest
-	(*DeleteCouncilResponse)(nil),               // 9: council.DeleteCouncilResponse
-	(*ListCouncilsRequest)(nil),                 // 10: council.ListCouncilsRequest
-	(*ListCouncilsResponse)(nil),                // 11: council.ListCouncilsResponse
-	(*Defence)(nil),                             // 12: council.Defence
-	(*CreateDefenceRequest)(nil),                // 13: council.CreateDefenceRequest
-	(*CreateDefenceResponse)(nil),               // 14: council.CreateDefenceResponse
-	(*GetDefenceRequest)(nil),                   // 15: council.GetDefenceRequest
-	(*GetDefenceResponse)(nil),                  // 16: council.GetDefenceResponse
-	(*UpdateDefenceRequest)(nil),                // 17: council.UpdateDefenceRequest
-	(*UpdateDefenceResponse)(nil),               // 18: council.UpdateDefenceResponse
-	(*DeleteDefenceRequest)(nil),                // 19: council.DeleteDefenceRequest
-	(*DeleteDefenceResponse)(nil),               // 20: council.DeleteDefenceResponse
-	(*ListDefencesRequest)(nil),                 // 21: council.ListDefencesRequest
-	(*ListDefencesResponse)(nil),                // 22: council.ListDefencesResponse
-	(*GradeDefence)(nil),                        // 23: council.GradeDefence
-	(*CreateGradeDefenceRequest)(nil),           // 24: council.CreateGradeDefenceRequest
-	(*CreateGradeDefenceResponse)(nil),          // 25: council.CreateGradeDefenceResponse
-	(*GetGradeDefenceRequest)(nil),              // 26: council.GetGradeDefenceRequest
-	(*GetGradeDefenceResponse)(nil),             // 27: council.GetGradeDefenceResponse
-	(*UpdateGradeDefenceRequest)(nil),           // 28: council.UpdateGradeDefenceRequest
-	(*UpdateGradeDefenceResponse)(nil),          // 29: council.UpdateGradeDefenceResponse
-	(*DeleteGradeDefenceRequest)(nil),           // 30: council.DeleteGradeDefenceRequest
-	(*DeleteGradeDefenceResponse)(nil),          // 31: council.DeleteGradeDefenceResponse
-	(*ListGradeDefencesRequest)(nil),            // 32: council.ListGradeDefencesRequest
-	(*ListGradeDefencesResponse)(nil),           // 33: council.ListGradeDefencesResponse
-	(*GradeDefenceCriterion)(nil),               // 34: council.GradeDefenceCriterion
-	(*CreateGradeDefenceCriterionRequest)(nil),  // 35: council.CreateGradeDefenceCriterionRequest
-	(*CreateGradeDefenceCriterionResponse)(nil), // 36: council.CreateGradeDefenceCriterionResponse
-	(*GetGradeDefenceCriterionRequest)(nil),     // 37: council.GetGradeDefenceCriterionRequest
-	(*GetGradeDefenceCriterionResponse)(nil),    // 38: council.GetGradeDefenceCriterionResponse
-	(*UpdateGradeDefenceCriterionRequest)(nil),  // 39: council.UpdateGradeDefenceCriterionRequest
-	(*UpdateGradeDefenceCriterionResponse)(nil), // 40: council.UpdateGradeDefenceCriterionResponse
-	(*DeleteGradeDefenceCriterionRequest)(nil),  // 41: council.DeleteGradeDefenceCriterionRequest
-	(*DeleteGradeDefenceCriterionResponse)(nil), // 42: council.DeleteGradeDefenceCriterionResponse
-	(*ListGradeDefenceCriteriaRequest)(nil),     // 43: council.ListGradeDefenceCriteriaRequest
-	(*ListGradeDefenceCriteriaResponse)(nil),    // 44: council.ListGradeDefenceCriteriaResponse
-	(*timestamppb.Timestamp)(nil),               // 45: google.protobuf.Timestamp
-	(*common.SearchRequest)(nil),                // 46: common.SearchRequest
+	(DefencePosition)(0),                         // 0: council.DefencePosition
+	(RubricStage)(0),                             // 1: council.RubricStage
+	(CouncilConflictKind)(0),                     // 2: council.CouncilConflictKind
+	(GradeDefenceAmendmentStatus)(0),             // 3: council.GradeDefenceAmendmentStatus
+	(*Council)(nil),                              // 4: council.Council
+	(*CreateCouncilRequest)(nil),                 // 5: council.CreateCouncilRequest
+	(*CreateCouncilResponse)(nil),                // 6: council.CreateCouncilResponse
+	(*GetCouncilRequest)(nil),                    // 7: council.GetCouncilRequest
+	(*GetCouncilResponse)(nil),                   // 8: council.GetCouncilResponse
+	(*UpdateCouncilRequest)(nil),                 // 9: council.UpdateCouncilRequest
+	(*UpdateCouncilResponse)(nil),                // 10: council.UpdateCouncilResponse
+	(*CouncilSlot)(nil),                          // 11: council.CouncilSlot
+	(*ScheduleCouncilsRequest)(nil),              // 12: council.ScheduleCouncilsRequest
+	(*ScheduleCouncilsResponse)(nil),             // 13: council.ScheduleCouncilsResponse
+	(*DeleteCouncilRequest)(nil),                 // 14: council.DeleteCouncilRequest
+	(*DeleteCouncilResponse)(nil),                // 15: council.DeleteCouncilResponse
+	(*RestoreCouncilRequest)(nil),                // 16: council.RestoreCouncilRequest
+	(*RestoreCouncilResponse)(nil),               // 17: council.RestoreCouncilResponse
+	(*ListCouncilsRequest)(nil),                  // 18: council.ListCouncilsRequest
+	(*ListCouncilsResponse)(nil),                 // 19: council.ListCouncilsResponse
+	(*Defence)(nil),                              // 20: council.Defence
+	(*CreateDefenceRequest)(nil),                 // 21: council.CreateDefenceRequest
+	(*CreateDefenceResponse)(nil),                // 22: council.CreateDefenceResponse
+	(*GetDefenceRequest)(nil),                    // 23: council.GetDefenceRequest
+	(*GetDefenceResponse)(nil),                   // 24: council.GetDefenceResponse
+	(*UpdateDefenceRequest)(nil),                 // 25: council.UpdateDefenceRequest
+	(*UpdateDefenceResponse)(nil),                // 26: council.UpdateDefenceResponse
+	(*DeleteDefenceRequest)(nil),                 // 27: council.DeleteDefenceRequest
+	(*DeleteDefenceResponse)(nil),                // 28: council.DeleteDefenceResponse
+	(*RestoreDefenceRequest)(nil),                // 29: council.RestoreDefenceRequest
+	(*RestoreDefenceResponse)(nil),               // 30: council.RestoreDefenceResponse
+	(*ListDefencesRequest)(nil),                  // 31: council.ListDefencesRequest
+	(*ListDefencesResponse)(nil),                 // 32: council.ListDefencesResponse
+	(*GradeDefence)(nil),                         // 33: council.GradeDefence
+	(*CreateGradeDefenceRequest)(nil),            // 34: council.CreateGradeDefenceRequest
+	(*CreateGradeDefenceResponse)(nil),           // 35: council.CreateGradeDefenceResponse
+	(*GetGradeDefenceRequest)(nil),               // 36: council.GetGradeDefenceRequest
+	(*GetGradeDefenceResponse)(nil),              // 37: council.GetGradeDefenceResponse
+	(*UpdateGradeDefenceRequest)(nil),            // 38: council.UpdateGradeDefenceRequest
+	(*UpdateGradeDefenceResponse)(nil),           // 39: council.UpdateGradeDefenceResponse
+	(*DeleteGradeDefenceRequest)(nil),            // 40: council.DeleteGradeDefenceRequest
+	(*DeleteGradeDefenceResponse)(nil),           // 41: council.DeleteGradeDefenceResponse
+	(*RestoreGradeDefenceRequest)(nil),           // 42: council.RestoreGradeDefenceRequest
+	(*RestoreGradeDefenceResponse)(nil),          // 43: council.RestoreGradeDefenceResponse
+	(*ListGradeDefencesRequest)(nil),             // 44: council.ListGradeDefencesRequest
+	(*ListGradeDefencesResponse)(nil),            // 45: council.ListGradeDefencesResponse
+	(*GradeDefenceCriterion)(nil),                // 46: council.GradeDefenceCriterion
+	(*CreateGradeDefenceCriterionRequest)(nil),   // 47: council.CreateGradeDefenceCriterionRequest
+	(*CreateGradeDefenceCriterionResponse)(nil),  // 48: council.CreateGradeDefenceCriterionResponse
+	(*GetGradeDefenceCriterionRequest)(nil),      // 49: council.GetGradeDefenceCriterionRequest
+	(*GetGradeDefenceCriterionResponse)(nil),     // 50: council.GetGradeDefenceCriterionResponse
+	(*UpdateGradeDefenceCriterionRequest)(nil),   // 51: council.UpdateGradeDefenceCriterionRequest
+	(*UpdateGradeDefenceCriterionResponse)(nil),  // 52: council.UpdateGradeDefenceCriterionResponse
+	(*DeleteGradeDefenceCriterionRequest)(nil),   // 53: council.DeleteGradeDefenceCriterionRequest
+	(*DeleteGradeDefenceCriterionResponse)(nil),  // 54: council.DeleteGradeDefenceCriterionResponse
+	(*ListGradeDefenceCriteriaRequest)(nil),      // 55: council.ListGradeDefenceCriteriaRequest
+	(*ListGradeDefenceCriteriaResponse)(nil),     // 56: council.ListGradeDefenceCriteriaResponse
+	(*RubricCriterion)(nil),                      // 57: council.RubricCriterion
+	(*RubricTemplate)(nil),                       // 58: council.RubricTemplate
+	(*RubricCriterionInput)(nil),                 // 59: council.RubricCriterionInput
+	(*CreateRubricTemplateRequest)(nil),          // 60: council.CreateRubricTemplateRequest
+	(*CreateRubricTemplateResponse)(nil),         // 61: council.CreateRubricTemplateResponse
+	(*GetRubricTemplateRequest)(nil),             // 62: council.GetRubricTemplateRequest
+	(*GetRubricTemplateResponse)(nil),            // 63: council.GetRubricTemplateResponse
+	(*UpdateRubricTemplateRequest)(nil),          // 64: council.UpdateRubricTemplateRequest
+	(*UpdateRubricTemplateResponse)(nil),         // 65: council.UpdateRubricTemplateResponse
+	(*DeleteRubricTemplateRequest)(nil),          // 66: council.DeleteRubricTemplateRequest
+	(*DeleteRubricTemplateResponse)(nil),         // 67: council.DeleteRubricTemplateResponse
+	(*ListRubricTemplatesRequest)(nil),           // 68: council.ListRubricTemplatesRequest
+	(*ListRubricTemplatesResponse)(nil),          // 69: council.ListRubricTemplatesResponse
+	(*CouncilTopic)(nil),                         // 70: council.CouncilTopic
+	(*CouncilMemberMajor)(nil),                   // 71: council.CouncilMemberMajor
+	(*CouncilValidationContext)(nil),             // 72: council.CouncilValidationContext
+	(*CouncilConflict)(nil),                      // 73: council.CouncilConflict
+	(*ValidateCouncilRequest)(nil),               // 74: council.ValidateCouncilRequest
+	(*ValidateCouncilResponse)(nil),              // 75: council.ValidateCouncilResponse
+	(*AdmitTopicCouncilRequest)(nil),             // 76: council.AdmitTopicCouncilRequest
+	(*AdmitTopicCouncilResponse)(nil),            // 77: council.AdmitTopicCouncilResponse
+	(*CouncilConflictOverride)(nil),              // 78: council.CouncilConflictOverride
+	(*ListCouncilConflictOverridesRequest)(nil),  // 79: council.ListCouncilConflictOverridesRequest
+	(*ListCouncilConflictOverridesResponse)(nil), // 80: council.ListCouncilConflictOverridesResponse
+	(*LockCouncilGradesRequest)(nil),             // 81: council.LockCouncilGradesRequest
+	(*LockCouncilGradesResponse)(nil),            // 82: council.LockCouncilGradesResponse
+	(*PublishCouncilGradesRequest)(nil),          // 83: council.PublishCouncilGradesRequest
+	(*PublishCouncilGradesResponse)(nil),         // 84: council.PublishCouncilGradesResponse
+	(*GradeDefenceAmendment)(nil),                // 85: council.GradeDefenceAmendment
+	(*RequestGradeDefenceAmendmentRequest)(nil),  // 86: council.RequestGradeDefenceAmendmentRequest
+	(*RequestGradeDefenceAmendmentResponse)(nil), // 87: council.RequestGradeDefenceAmendmentResponse
+	(*DecideGradeDefenceAmendmentRequest)(nil),   // 88: council.DecideGradeDefenceAmendmentRequest
+	(*DecideGradeDefenceAmendmentResponse)(nil),  // 89: council.DecideGradeDefenceAmendmentResponse
+	(*GetGradeDefenceAmendmentRequest)(nil),      // 90: council.GetGradeDefenceAmendmentRequest
+	(*GetGradeDefenceAmendmentResponse)(nil),     // 91: council.GetGradeDefenceAmendmentResponse
+	(*ListGradeDefenceAmendmentsRequest)(nil),    // 92: council.ListGradeDefenceAmendmentsRequest
+	(*ListGradeDefenceAmendmentsResponse)(nil),   // 93: council.ListGradeDefenceAmendmentsResponse
+	(*timestamppb.Timestamp)(nil),                // 94: google.protobuf.Timestamp
+	(*common.SearchRequest)(nil),                 // 95: common.SearchRequest
+	(*common.ReferenceCheckRequest)(nil),         // 96: common.ReferenceCheckRequest
+	(*common.ReferenceCheckResponse)(nil),        // 97: common.ReferenceCheckResponse
 }
 var file_proto_council_council_proto_depIdxs = []int32{
-	45, // 0: council.Council.time_start:type_name -> google.protobuf.Timestamp
-	45, // 1: council.Council.created_at:type_name -> google.protobuf.Timestamp
-	45, // 2: council.Council.updated_at:type_name -> google.protobuf.Timestamp
-	45, // 3: council.CreateCouncilRequest.time_start:type_name -> google.protobuf.Timestamp
-	1,  // 4: council.CreateCouncilResponse.council:type_name -> council.Council
-	1,  // 5: council.GetCouncilResponse.council:type_name -> council.Council
-	45, // 6: council.UpdateCouncilRequest.time_start:type_name -> google.protobuf.Timestamp
-	1,  // 7: council.UpdateCouncilResponse.council:type_name -> council.Council
-	46, // 8: council.ListCouncilsRequest.search:type_name -> common.SearchRequest
-	1,  // 9: council.ListCouncilsResponse.councils:type_name -> council.Council
-	0,  // 10: council.Defence.position:type_name -> council.DefencePosition
-	45, // 11: council.Defence.created_at:type_name -> google.protobuf.Timestamp
-	45, // 12: council.Defence.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 13: council.CreateDefenceRequest.position:type_name -> council.DefencePosition
-	12, // 14: council.CreateDefenceResponse.defence:type_name -> council.Defence
-	12, // 15: council.GetDefenceResponse.defence:type_name -> council.Defence
-	0,  // 16: council.UpdateDefenceRequest.position:type_name -> council.DefencePosition
-	12, // 17: council.UpdateDefenceResponse.defence:type_name -> council.Defence
-	46, // 18: council.ListDefencesRequest.search:type_name -> common.SearchRequest
-	12, // 19: council.ListDefencesResponse.defences:type_name -> council.Defence
-	45, // 20: council.GradeDefence.created_at:type_name -> google.protobuf.Timestamp
-	45, // 21: council.GradeDefence.updated_at:type_name -> google.protobuf.Timestamp
-	23, // 22: council.CreateGradeDefenceResponse.grade_defence:type_name -> council.GradeDefence
-	23, // 23: council.GetGradeDefenceResponse.grade_defence:type_name -> council.GradeDefence
-	23, // 24: council.UpdateGradeDefenceResponse.grade_defence:type_name -> council.GradeDefence
-	46, // 25: council.ListGradeDefencesRequest.search:type_name -> common.SearchRequest
-	23, // 26: council.ListGradeDefencesResponse.grade_defences:type_name -> council.GradeDefence
-	45, // 27: council.GradeDefenceCriterion.created_at:type_name -> google.protobuf.Timestamp
-	45, // 28: council.GradeDefenceCriterion.updated_at:type_name -> google.protobuf.Timestamp
-	34, // 29: council.CreateGradeDefenceCriterionResponse.grade_defence_criterion:type_name -> council.GradeDefenceCriterion
-	34, // 30: council.GetGradeDefenceCriterionResponse.grade_defence_criterion:type_name -> council.GradeDefenceCriterion
-	34, // 31: council.UpdateGradeDefenceCriterionResponse.grade_defence_criterion:type_name -> council.GradeDefenceCriterion
-	46, // 32: council.ListGradeDefenceCriteriaRequest.search:type_name -> common.SearchRequest
-	34, // 33: council.ListGradeDefenceCriteriaResponse.grade_defence_criteria:type_name -> council.GradeDefenceCriterion
-	2,  // 34: council.CouncilService.CreateCouncil:input_type -> council.CreateCouncilRequest
-	4,  // 35: council.CouncilService.GetCouncil:input_type -> council.GetCouncilRequest
-	6,  // 36: council.CouncilService.UpdateCouncil:input_type -> council.UpdateCouncilRequest
-	8,  // 37: council.CouncilService.DeleteCouncil:input_type -> council.DeleteCouncilRequest
-	10, // 38: council.CouncilService.ListCouncils:input_type -> council.ListCouncilsRequest
-	13, // 39: council.CouncilService.CreateDefence:input_type -> council.CreateDefenceRequest
-	15, // 40: council.CouncilService.GetDefence:input_type -> council.GetDefenceRequest
-	17, // 41: council.CouncilService.UpdateDefence:input_type -> council.UpdateDefenceRequest
-	19, // 42: council.CouncilService.DeleteDefence:input_type -> council.DeleteDefenceRequest
-	21, // 43: council.CouncilService.ListDefences:input_type -> council.ListDefencesRequest
-	24, // 44: council.CouncilService.CreateGradeDefence:input_type -> council.CreateGradeDefenceRequest
-	26, // 45: council.CouncilService.GetGradeDefence:input_type -> council.GetGradeDefenceRequest
-	28, // 46: council.CouncilService.UpdateGradeDefence:input_type -> council.UpdateGradeDefenceRequest
-	30, // 47: council.CouncilService.DeleteGradeDefence:input_type -> council.DeleteGradeDefenceRequest
-	32, // 48: council.CouncilService.ListGradeDefences:input_type -> council.ListGradeDefencesRequest
-	35, // 49: council.CouncilService.CreateGradeDefenceCriterion:input_type -> council.CreateGradeDefenceCriterionRequest
-	37, // 50: council.CouncilService.GetGradeDefenceCriterion:input_type -> council.GetGradeDefenceCriterionRequest
-	39, // 51: council.CouncilService.UpdateGradeDefenceCriterion:input_type -> council.UpdateGradeDefenceCriterionRequest
-	41, // 52: council.CouncilService.DeleteGradeDefenceCriterion:input_type -> council.DeleteGradeDefenceCriterionRequest
-	43, // 53: council.CouncilService.ListGradeDefenceCriteria:input_type -> council.ListGradeDefenceCriteriaRequest
-	3,  // 54: council.CouncilService.CreateCouncil:output_type -> council.CreateCouncilResponse
-	5,  // 55: council.CouncilService.GetCouncil:output_type -> council.GetCouncilResponse
-	7,  // 56: council.CouncilService.UpdateCouncil:output_type -> council.UpdateCouncilResponse
-	9,  // 57: council.CouncilService.DeleteCouncil:output_type -> council.DeleteCouncilResponse
-	11, // 58: council.CouncilService.ListCouncils:output_type -> council.ListCouncilsResponse
-	14, // 59: council.CouncilService.CreateDefence:output_type -> council.CreateDefenceResponse
-	16, // 60: council.CouncilService.GetDefence:output_type -> council.GetDefenceResponse
-	18, // 61: council.CouncilService.UpdateDefence:output_type -> council.UpdateDefenceResponse
-	20, // 62: council.CouncilService.DeleteDefence:output_type -> council.DeleteDefenceResponse
-	22, // 63: council.CouncilService.ListDefences:output_type -> council.ListDefencesResponse
-	25, // 64: council.CouncilService.CreateGradeDefence:output_type -> council.CreateGradeDefenceResponse
-	27, // 65: council.CouncilService.GetGradeDefence:output_type -> council.GetGradeDefenceResponse
-	29, // 66: council.CouncilService.UpdateGradeDefence:output_type -> council.UpdateGradeDefenceResponse
-	31, // 67: council.CouncilService.DeleteGradeDefence:output_type -> council.DeleteGradeDefenceResponse
-	33, // 68: council.CouncilService.ListGradeDefences:output_type -> council.ListGradeDefencesResponse
-	36, // 69: council.CouncilService.CreateGradeDefenceCriterion:output_type -> council.CreateGradeDefenceCriterionResponse
-	38, // 70: council.CouncilService.GetGradeDefenceCriterion:output_type -> council.GetGradeDefenceCriterionResponse
-	40, // 71: council.CouncilService.UpdateGradeDefenceCriterion:output_type -> council.UpdateGradeDefenceCriterionResponse
-	42, // 72: council.CouncilService.DeleteGradeDefenceCriterion:output_type -> council.DeleteGradeDefenceCriterionResponse
-	44, // 73: council.CouncilService.ListGradeDefenceCriteria:output_type -> council.ListGradeDefenceCriteriaResponse
-	54, // [54:74] is the sub-list for method output_type
-	34, // [34:54] is the sub-list for method input_type
-	34, // [34:34] is the sub-list for extension type_name
-	34, // [34:34] is the sub-list for extension extendee
-	0,  // [0:34] is the sub-list for field type_name
+	94,  // 0: council.Council.time_start:type_name -> google.protobuf.Timestamp
+	94,  // 1: council.Council.created_at:type_name -> google.protobuf.Timestamp
+	94,  // 2: council.Council.updated_at:type_name -> google.protobuf.Timestamp
+	94,  // 3: council.Council.grades_locked_at:type_name -> google.protobuf.Timestamp
+	94,  // 4: council.Council.grades_published_at:type_name -> google.protobuf.Timestamp
+	94,  // 5: council.Council.deleted_at:type_name -> google.protobuf.Timestamp
+	94,  // 6: council.CreateCouncilRequest.time_start:type_name -> google.protobuf.Timestamp
+	4,   // 7: council.CreateCouncilResponse.council:type_name -> council.Council
+	4,   // 8: council.GetCouncilResponse.council:type_name -> council.Council
+	94,  // 9: council.UpdateCouncilRequest.time_start:type_name -> google.protobuf.Timestamp
+	4,   // 10: council.UpdateCouncilResponse.council:type_name -> council.Council
+	94,  // 11: council.CouncilSlot.time_start:type_name -> google.protobuf.Timestamp
+	11,  // 12: council.ScheduleCouncilsRequest.councils:type_name -> council.CouncilSlot
+	4,   // 13: council.ScheduleCouncilsResponse.councils:type_name -> council.Council
+	4,   // 14: council.RestoreCouncilResponse.council:type_name -> council.Council
+	95,  // 15: council.ListCouncilsRequest.search:type_name -> common.SearchRequest
+	4,   // 16: council.ListCouncilsResponse.councils:type_name -> council.Council
+	0,   // 17: council.Defence.position:type_name -> council.DefencePosition
+	94,  // 18: council.Defence.created_at:type_name -> google.protobuf.Timestamp
+	94,  // 19: council.Defence.updated_at:type_name -> google.protobuf.Timestamp
+	94,  // 20: council.Defence.deleted_at:type_name -> google.protobuf.Timestamp
+	0,   // 21: council.CreateDefenceRequest.position:type_name -> council.DefencePosition
+	72,  // 22: council.CreateDefenceRequest.validation:type_name -> council.CouncilValidationContext
+	20,  // 23: council.CreateDefenceResponse.defence:type_name -> council.Defence
+	20,  // 24: council.GetDefenceResponse.defence:type_name -> council.Defence
+	0,   // 25: council.UpdateDefenceRequest.position:type_name -> council.DefencePosition
+	72,  // 26: council.UpdateDefenceRequest.validation:type_name -> council.CouncilValidationContext
+	20,  // 27: council.UpdateDefenceResponse.defence:type_name -> council.Defence
+	20,  // 28: council.RestoreDefenceResponse.defence:type_name -> council.Defence
+	95,  // 29: council.ListDefencesRequest.search:type_name -> common.SearchRequest
+	20,  // 30: council.ListDefencesResponse.defences:type_name -> council.Defence
+	94,  // 31: council.GradeDefence.created_at:type_name -> google.protobuf.Timestamp
+	94,  // 32: council.GradeDefence.updated_at:type_name -> google.protobuf.Timestamp
+	94,  // 33: council.GradeDefence.deleted_at:type_name -> google.protobuf.Timestamp
+	1,   // 34: council.CreateGradeDefenceRequest.stage:type_name -> council.RubricStage
+	33,  // 35: council.CreateGradeDefenceResponse.grade_defence:type_name -> council.GradeDefence
+	33,  // 36: council.GetGradeDefenceResponse.grade_defence:type_name -> council.GradeDefence
+	33,  // 37: council.UpdateGradeDefenceResponse.grade_defence:type_name -> council.GradeDefence
+	33,  // 38: council.RestoreGradeDefenceResponse.grade_defence:type_name -> council.GradeDefence
+	95,  // 39: council.ListGradeDefencesRequest.search:type_name -> common.SearchRequest
+	33,  // 40: council.ListGradeDefencesResponse.grade_defences:type_name -> council.GradeDefence
+	94,  // 41: council.GradeDefenceCriterion.created_at:type_name -> google.protobuf.Timestamp
+	94,  // 42: council.GradeDefenceCriterion.updated_at:type_name -> google.protobuf.Timestamp
+	46,  // 43: council.CreateGradeDefenceCriterionResponse.grade_defence_criterion:type_name -> council.GradeDefenceCriterion
+	46,  // 44: council.GetGradeDefenceCriterionResponse.grade_defence_criterion:type_name -> council.GradeDefenceCriterion
+	46,  // 45: council.UpdateGradeDefenceCriterionResponse.grade_defence_criterion:type_name -> council.GradeDefenceCriterion
+	95,  // 46: council.ListGradeDefenceCriteriaRequest.search:type_name -> common.SearchRequest
+	46,  // 47: council.ListGradeDefenceCriteriaResponse.grade_defence_criteria:type_name -> council.GradeDefenceCriterion
+	1,   // 48: council.RubricTemplate.stage:type_name -> council.RubricStage
+	57,  // 49: council.RubricTemplate.criteria:type_name -> council.RubricCriterion
+	94,  // 50: council.RubricTemplate.created_at:type_name -> google.protobuf.Timestamp
+	94,  // 51: council.RubricTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 52: council.CreateRubricTemplateRequest.stage:type_name -> council.RubricStage
+	59,  // 53: council.CreateRubricTemplateRequest.criteria:type_name -> council.RubricCriterionInput
+	58,  // 54: council.CreateRubricTemplateResponse.rubric_template:type_name -> council.RubricTemplate
+	58,  // 55: council.GetRubricTemplateResponse.rubric_template:type_name -> council.RubricTemplate
+	59,  // 56: council.UpdateRubricTemplateRequest.criteria:type_name -> council.RubricCriterionInput
+	58,  // 57: council.UpdateRubricTemplateResponse.rubric_template:type_name -> council.RubricTemplate
+	1,   // 58: council.ListRubricTemplatesRequest.stage:type_name -> council.RubricStage
+	58,  // 59: council.ListRubricTemplatesResponse.rubric_templates:type_name -> council.RubricTemplate
+	70,  // 60: council.CouncilValidationContext.topics:type_name -> council.CouncilTopic
+	71,  // 61: council.CouncilValidationContext.member_majors:type_name -> council.CouncilMemberMajor
+	2,   // 62: council.CouncilConflict.kind:type_name -> council.CouncilConflictKind
+	72,  // 63: council.ValidateCouncilRequest.validation:type_name -> council.CouncilValidationContext
+	73,  // 64: council.ValidateCouncilResponse.conflicts:type_name -> council.CouncilConflict
+	70,  // 65: council.AdmitTopicCouncilRequest.topic:type_name -> council.CouncilTopic
+	73,  // 66: council.AdmitTopicCouncilResponse.conflicts:type_name -> council.CouncilConflict
+	2,   // 67: council.CouncilConflictOverride.kind:type_name -> council.CouncilConflictKind
+	94,  // 68: council.CouncilConflictOverride.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 69: council.ListCouncilConflictOverridesResponse.overrides:type_name -> council.CouncilConflictOverride
+	4,   // 70: council.LockCouncilGradesResponse.council:type_name -> council.Council
+	4,   // 71: council.PublishCouncilGradesResponse.council:type_name -> council.Council
+	3,   // 72: council.GradeDefenceAmendment.status:type_name -> council.GradeDefenceAmendmentStatus
+	94,  // 73: council.GradeDefenceAmendment.decided_at:type_name -> google.protobuf.Timestamp
+	94,  // 74: council.GradeDefenceAmendment.created_at:type_name -> google.protobuf.Timestamp
+	94,  // 75: council.GradeDefenceAmendment.updated_at:type_name -> google.protobuf.Timestamp
+	85,  // 76: council.RequestGradeDefenceAmendmentResponse.amendment:type_name -> council.GradeDefenceAmendment
+	85,  // 77: council.DecideGradeDefenceAmendmentResponse.amendment:type_name -> council.GradeDefenceAmendment
+	85,  // 78: council.GetGradeDefenceAmendmentResponse.amendment:type_name -> council.GradeDefenceAmendment
+	3,   // 79: council.ListGradeDefenceAmendmentsRequest.status:type_name -> council.GradeDefenceAmendmentStatus
+	85,  // 80: council.ListGradeDefenceAmendmentsResponse.amendments:type_name -> council.GradeDefenceAmendment
+	5,   // 81: council.CouncilService.CreateCouncil:input_type -> council.CreateCouncilRequest
+	7,   // 82: council.CouncilService.GetCouncil:input_type -> council.GetCouncilRequest
+	9,   // 83: council.CouncilService.UpdateCouncil:input_type -> council.UpdateCouncilRequest
+	12,  // 84: council.CouncilService.ScheduleCouncils:input_type -> council.ScheduleCouncilsRequest
+	14,  // 85: council.CouncilService.DeleteCouncil:input_type -> council.DeleteCouncilRequest
+	16,  // 86: council.CouncilService.RestoreCouncil:input_type -> council.RestoreCouncilRequest
+	18,  // 87: council.CouncilService.ListCouncils:input_type -> council.ListCouncilsRequest
+	74,  // 88: council.CouncilService.ValidateCouncil:input_type -> council.ValidateCouncilRequest
+	76,  // 89: council.CouncilService.AdmitTopicCouncil:input_type -> council.AdmitTopicCouncilRequest
+	79,  // 90: council.CouncilService.ListCouncilConflictOverrides:input_type -> council.ListCouncilConflictOverridesRequest
+	21,  // 91: council.CouncilService.CreateDefence:input_type -> council.CreateDefenceRequest
+	23,  // 92: council.CouncilService.GetDefence:input_type -> council.GetDefenceRequest
+	25,  // 93: council.CouncilService.UpdateDefence:input_type -> council.UpdateDefenceRequest
+	27,  // 94: council.CouncilService.DeleteDefence:input_type -> council.DeleteDefenceRequest
+	29,  // 95: council.CouncilService.RestoreDefence:input_type -> council.RestoreDefenceRequest
+	31,  // 96: council.CouncilService.ListDefences:input_type -> council.ListDefencesRequest
+	34,  // 97: council.CouncilService.CreateGradeDefence:input_type -> council.CreateGradeDefenceRequest
+	36,  // 98: council.CouncilService.GetGradeDefence:input_type -> council.GetGradeDefenceRequest
+	38,  // 99: council.CouncilService.UpdateGradeDefence:input_type -> council.UpdateGradeDefenceRequest
+	40,  // 100: council.CouncilService.DeleteGradeDefence:input_type -> council.DeleteGradeDefenceRequest
+	42,  // 101: council.CouncilService.RestoreGradeDefence:input_type -> council.RestoreGradeDefenceRequest
+	44,  // 102: council.CouncilService.ListGradeDefences:input_type -> council.ListGradeDefencesRequest
+	47,  // 103: council.CouncilService.CreateGradeDefenceCriterion:input_type -> council.CreateGradeDefenceCriterionRequest
+	49,  // 104: council.CouncilService.GetGradeDefenceCriterion:input_type -> council.GetGradeDefenceCriterionRequest
+	51,  // 105: council.CouncilService.UpdateGradeDefenceCriterion:input_type -> council.UpdateGradeDefenceCriterionRequest
+	53,  // 106: council.CouncilService.DeleteGradeDefenceCriterion:input_type -> council.DeleteGradeDefenceCriterionRequest
+	55,  // 107: council.CouncilService.ListGradeDefenceCriteria:input_type -> council.ListGradeDefenceCriteriaRequest
+	60,  // 108: council.CouncilService.CreateRubricTemplate:input_type -> council.CreateRubricTemplateRequest
+	62,  // 109: council.CouncilService.GetRubricTemplate:input_type -> council.GetRubricTemplateRequest
+	64,  // 110: council.CouncilService.UpdateRubricTemplate:input_type -> council.UpdateRubricTemplateRequest
+	66,  // 111: council.CouncilService.DeleteRubricTemplate:input_type -> council.DeleteRubricTemplateRequest
+	68,  // 112: council.CouncilService.ListRubricTemplates:input_type -> council.ListRubricTemplatesRequest
+	81,  // 113: council.CouncilService.LockCouncilGrades:input_type -> council.LockCouncilGradesRequest
+	83,  // 114: council.CouncilService.PublishCouncilGrades:input_type -> council.PublishCouncilGradesRequest
+	86,  // 115: council.CouncilService.RequestGradeDefenceAmendment:input_type -> council.RequestGradeDefenceAmendmentRequest
+	88,  // 116: council.CouncilService.DecideGradeDefenceAmendment:input_type -> council.DecideGradeDefenceAmendmentRequest
+	90,  // 117: council.CouncilService.GetGradeDefenceAmendment:input_type -> council.GetGradeDefenceAmendmentRequest
+	92,  // 118: council.CouncilService.ListGradeDefenceAmendments:input_type -> council.ListGradeDefenceAmendmentsRequest
+	96,  // 119: council.CouncilService.CheckReferences:input_type -> common.ReferenceCheckRequest
+	6,   // 120: council.CouncilService.CreateCouncil:output_type -> council.CreateCouncilResponse
+	8,   // 121: council.CouncilService.GetCouncil:output_type -> council.GetCouncilResponse
+	10,  // 122: council.CouncilService.UpdateCouncil:output_type -> council.UpdateCouncilResponse
+	13,  // 123: council.CouncilService.ScheduleCouncils:output_type -> council.ScheduleCouncilsResponse
+	15,  // 124: council.CouncilService.DeleteCouncil:output_type -> council.DeleteCouncilResponse
+	17,  // 125: council.CouncilService.RestoreCouncil:output_type -> council.RestoreCouncilResponse
+	19,  // 126: council.CouncilService.ListCouncils:output_type -> council.ListCouncilsResponse
+	75,  // 127: council.CouncilService.ValidateCouncil:output_type -> council.ValidateCouncilResponse
+	77,  // 128: council.CouncilService.AdmitTopicCouncil:output_type -> council.AdmitTopicCouncilResponse
+	80,  // 129: council.CouncilService.ListCouncilConflictOverrides:output_type -> council.ListCouncilConflictOverridesResponse
+	22,  // 130: council.CouncilService.CreateDefence:output_type -> council.CreateDefenceResponse
+	24,  // 131: council.CouncilService.GetDefence:output_type -> council.GetDefenceResponse
+	26,  // 132: council.CouncilService.UpdateDefence:output_type -> council.UpdateDefenceResponse
+	28,  // 133: council.CouncilService.DeleteDefence:output_type -> council.DeleteDefenceResponse
+	30,  // 134: council.CouncilService.RestoreDefence:output_type -> council.RestoreDefenceResponse
+	32,  // 135: council.CouncilService.ListDefences:output_type -> council.ListDefencesResponse
+	35,  // 136: council.CouncilService.CreateGradeDefence:output_type -> council.CreateGradeDefenceResponse
+	37,  // 137: council.CouncilService.GetGradeDefence:output_type -> council.GetGradeDefenceResponse
+	39,  // 138: council.CouncilService.UpdateGradeDefence:output_type -> council.UpdateGradeDefenceResponse
+	41,  // 139: council.CouncilService.DeleteGradeDefence:output_type -> council.DeleteGradeDefenceResponse
+	43,  // 140: council.CouncilService.RestoreGradeDefence:output_type -> council.RestoreGradeDefenceResponse
+	45,  // 141: council.CouncilService.ListGradeDefences:output_type -> council.ListGradeDefencesResponse
+	48,  // 142: council.CouncilService.CreateGradeDefenceCriterion:output_type -> council.CreateGradeDefenceCriterionResponse
+	50,  // 143: council.CouncilService.GetGradeDefenceCriterion:output_type -> council.GetGradeDefenceCriterionResponse
+	52,  // 144: council.CouncilService.UpdateGradeDefenceCriterion:output_type -> council.UpdateGradeDefenceCriterionResponse
+	54,  // 145: council.CouncilService.DeleteGradeDefenceCriterion:output_type -> council.DeleteGradeDefenceCriterionResponse
+	56,  // 146: council.CouncilService.ListGradeDefenceCriteria:output_type -> council.ListGradeDefenceCriteriaResponse
+	61,  // 147: council.CouncilService.CreateRubricTemplate:output_type -> council.CreateRubricTemplateResponse
+	63,  // 148: council.CouncilService.GetRubricTemplate:output_type -> council.GetRubricTemplateResponse
+	65,  // 149: council.CouncilService.UpdateRubricTemplate:output_type -> council.UpdateRubricTemplateResponse
+	67,  // 150: council.CouncilService.DeleteRubricTemplate:output_type -> council.DeleteRubricTemplateResponse
+	69,  // 151: council.CouncilService.ListRubricTemplates:output_type -> council.ListRubricTemplatesResponse
+	82,  // 152: council.CouncilService.LockCouncilGrades:output_type -> council.LockCouncilGradesResponse
+	84,  // 153: council.CouncilService.PublishCouncilGrades:output_type -> council.PublishCouncilGradesResponse
+	87,  // 154: council.CouncilService.RequestGradeDefenceAmendment:output_type -> council.RequestGradeDefenceAmendmentResponse
+	89,  // 155: council.CouncilService.DecideGradeDefenceAmendment:output_type -> council.DecideGradeDefenceAmendmentResponse
+	91,  // 156: council.CouncilService.GetGradeDefenceAmendment:output_type -> council.GetGradeDefenceAmendmentResponse
+	93,  // 157: council.CouncilService.ListGradeDefenceAmendments:output_type -> council.ListGradeDefenceAmendmentsResponse
+	97,  // 158: council.CouncilService.CheckReferences:output_type -> common.ReferenceCheckResponse
+	120, // [120:159] is the sub-list for method output_type
+	81,  // [81:120] is the sub-list for method input_type
+	81,  // [81:81] is the sub-list for extension type_name
+	81,  // [81:81] is the sub-list for extension extendee
+	0,   // [0:81] is the sub-list for field type_name
 }
 
 func init() { file_proto_council_council_proto_init() }
@@ -3068,18 +6736,28 @@ func file_proto_council_council_proto_init() {
 	}
 	file_proto_council_council_proto_msgTypes[1].OneofWrappers = []any{}
 	file_proto_council_council_proto_msgTypes[5].OneofWrappers = []any{}
-	file_proto_council_council_proto_msgTypes[16].OneofWrappers = []any{}
-	file_proto_council_council_proto_msgTypes[23].OneofWrappers = []any{}
-	file_proto_council_council_proto_msgTypes[27].OneofWrappers = []any{}
+	file_proto_council_council_proto_msgTypes[7].OneofWrappers = []any{}
+	file_proto_council_council_proto_msgTypes[17].OneofWrappers = []any{}
+	file_proto_council_council_proto_msgTypes[21].OneofWrappers = []any{}
+	file_proto_council_council_proto_msgTypes[29].OneofWrappers = []any{}
+	file_proto_council_council_proto_msgTypes[30].OneofWrappers = []any{}
 	file_proto_council_council_proto_msgTypes[34].OneofWrappers = []any{}
-	file_proto_council_council_proto_msgTypes[38].OneofWrappers = []any{}
+	file_proto_council_council_proto_msgTypes[42].OneofWrappers = []any{}
+	file_proto_council_council_proto_msgTypes[43].OneofWrappers = []any{}
+	file_proto_council_council_proto_msgTypes[47].OneofWrappers = []any{}
+	file_proto_council_council_proto_msgTypes[60].OneofWrappers = []any{}
+	file_proto_council_council_proto_msgTypes[64].OneofWrappers = []any{}
+	file_proto_council_council_proto_msgTypes[70].OneofWrappers = []any{}
+	file_proto_council_council_proto_msgTypes[72].OneofWrappers = []any{}
+	file_proto_council_council_proto_msgTypes[81].OneofWrappers = []any{}
+	file_proto_council_council_proto_msgTypes[88].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_council_council_proto_rawDesc), len(file_proto_council_council_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   44,
+			NumEnums:      4,
+			NumMessages:   90,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	common "thaily/proto/common"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,26 +20,45 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CouncilService_CreateCouncil_FullMethodName               = "/council.CouncilService/CreateCouncil"
-	CouncilService_GetCouncil_FullMethodName                  = "/council.CouncilService/GetCouncil"
-	CouncilService_UpdateCouncil_FullMethodName               = "/council.CouncilService/UpdateCouncil"
-	CouncilService_DeleteCouncil_FullMethodName               = "/council.CouncilService/DeleteCouncil"
-	CouncilService_ListCouncils_FullMethodName                = "/council.CouncilService/ListCouncils"
-	CouncilService_CreateDefence_FullMethodName               = "/council.CouncilService/CreateDefence"
-	CouncilService_GetDefence_FullMethodName                  = "/council.CouncilService/GetDefence"
-	CouncilService_UpdateDefence_FullMethodName               = "/council.CouncilService/UpdateDefence"
-	CouncilService_DeleteDefence_FullMethodName               = "/council.CouncilService/DeleteDefence"
-	CouncilService_ListDefences_FullMethodName                = "/council.CouncilService/ListDefences"
-	CouncilService_CreateGradeDefence_FullMethodName          = "/council.CouncilService/CreateGradeDefence"
-	CouncilService_GetGradeDefence_FullMethodName             = "/council.CouncilService/GetGradeDefence"
-	CouncilService_UpdateGradeDefence_FullMethodName          = "/council.CouncilService/UpdateGradeDefence"
-	CouncilService_DeleteGradeDefence_FullMethodName          = "/council.CouncilService/DeleteGradeDefence"
-	CouncilService_ListGradeDefences_FullMethodName           = "/council.CouncilService/ListGradeDefences"
-	CouncilService_CreateGradeDefenceCriterion_FullMethodName = "/council.CouncilService/CreateGradeDefenceCriterion"
-	CouncilService_GetGradeDefenceCriterion_FullMethodName    = "/council.CouncilService/GetGradeDefenceCriterion"
-	CouncilService_UpdateGradeDefenceCriterion_FullMethodName = "/council.CouncilService/UpdateGradeDefenceCriterion"
-	CouncilService_DeleteGradeDefenceCriterion_FullMethodName = "/council.CouncilService/DeleteGradeDefenceCriterion"
-	CouncilService_ListGradeDefenceCriteria_FullMethodName    = "/council.CouncilService/ListGradeDefenceCriteria"
+	CouncilService_CreateCouncil_FullMethodName                = "/council.CouncilService/CreateCouncil"
+	CouncilService_GetCouncil_FullMethodName                   = "/council.CouncilService/GetCouncil"
+	CouncilService_UpdateCouncil_FullMethodName                = "/council.CouncilService/UpdateCouncil"
+	CouncilService_ScheduleCouncils_FullMethodName             = "/council.CouncilService/ScheduleCouncils"
+	CouncilService_DeleteCouncil_FullMethodName                = "/council.CouncilService/DeleteCouncil"
+	CouncilService_RestoreCouncil_FullMethodName               = "/council.CouncilService/RestoreCouncil"
+	CouncilService_ListCouncils_FullMethodName                 = "/council.CouncilService/ListCouncils"
+	CouncilService_ValidateCouncil_FullMethodName              = "/council.CouncilService/ValidateCouncil"
+	CouncilService_AdmitTopicCouncil_FullMethodName            = "/council.CouncilService/AdmitTopicCouncil"
+	CouncilService_ListCouncilConflictOverrides_FullMethodName = "/council.CouncilService/ListCouncilConflictOverrides"
+	CouncilService_CreateDefence_FullMethodName                = "/council.CouncilService/CreateDefence"
+	CouncilService_GetDefence_FullMethodName                   = "/council.CouncilService/GetDefence"
+	CouncilService_UpdateDefence_FullMethodName                = "/council.CouncilService/UpdateDefence"
+	CouncilService_DeleteDefence_FullMethodName                = "/council.CouncilService/DeleteDefence"
+	CouncilService_RestoreDefence_FullMethodName               = "/council.CouncilService/RestoreDefence"
+	CouncilService_ListDefences_FullMethodName                 = "/council.CouncilService/ListDefences"
+	CouncilService_CreateGradeDefence_FullMethodName           = "/council.CouncilService/CreateGradeDefence"
+	CouncilService_GetGradeDefence_FullMethodName              = "/council.CouncilService/GetGradeDefence"
+	CouncilService_UpdateGradeDefence_FullMethodName           = "/council.CouncilService/UpdateGradeDefence"
+	CouncilService_DeleteGradeDefence_FullMethodName           = "/council.CouncilService/DeleteGradeDefence"
+	CouncilService_RestoreGradeDefence_FullMethodName          = "/council.CouncilService/RestoreGradeDefence"
+	CouncilService_ListGradeDefences_FullMethodName            = "/council.CouncilService/ListGradeDefences"
+	CouncilService_CreateGradeDefenceCriterion_FullMethodName  = "/council.CouncilService/CreateGradeDefenceCriterion"
+	CouncilService_GetGradeDefenceCriterion_FullMethodName     = "/council.CouncilService/GetGradeDefenceCriterion"
+	CouncilService_UpdateGradeDefenceCriterion_FullMethodName  = "/council.CouncilService/UpdateGradeDefenceCriterion"
+	CouncilService_DeleteGradeDefenceCriterion_FullMethodName  = "/council.CouncilService/DeleteGradeDefenceCriterion"
+	CouncilService_ListGradeDefenceCriteria_FullMethodName     = "/council.CouncilService/ListGradeDefenceCriteria"
+	CouncilService_CreateRubricTemplate_FullMethodName         = "/council.CouncilService/CreateRubricTemplate"
+	CouncilService_GetRubricTemplate_FullMethodName            = "/council.CouncilService/GetRubricTemplate"
+	CouncilService_UpdateRubricTemplate_FullMethodName         = "/council.CouncilService/UpdateRubricTemplate"
+	CouncilService_DeleteRubricTemplate_FullMethodName         = "/council.CouncilService/DeleteRubricTemplate"
+	CouncilService_ListRubricTemplates_FullMethodName          = "/council.CouncilService/ListRubricTemplates"
+	CouncilService_LockCouncilGrades_FullMethodName            = "/council.CouncilService/LockCouncilGrades"
+	CouncilService_PublishCouncilGrades_FullMethodName         = "/council.CouncilService/PublishCouncilGrades"
+	CouncilService_RequestGradeDefenceAmendment_FullMethodName = "/council.CouncilService/RequestGradeDefenceAmendment"
+	CouncilService_DecideGradeDefenceAmendment_FullMethodName  = "/council.CouncilService/DecideGradeDefenceAmendment"
+	CouncilService_GetGradeDefenceAmendment_FullMethodName     = "/council.CouncilService/GetGradeDefenceAmendment"
+	CouncilService_ListGradeDefenceAmendments_FullMethodName   = "/council.CouncilService/ListGradeDefenceAmendments"
+	CouncilService_CheckReferences_FullMethodName              = "/council.CouncilService/CheckReferences"
 )
 
 // CouncilServiceClient is the client API for CouncilService service.
@@ -51,19 +71,26 @@ type CouncilServiceClient interface {
 	CreateCouncil(ctx context.Context, in *CreateCouncilRequest, opts ...grpc.CallOption) (*CreateCouncilResponse, error)
 	GetCouncil(ctx context.Context, in *GetCouncilRequest, opts ...grpc.CallOption) (*GetCouncilResponse, error)
 	UpdateCouncil(ctx context.Context, in *UpdateCouncilRequest, opts ...grpc.CallOption) (*UpdateCouncilResponse, error)
+	ScheduleCouncils(ctx context.Context, in *ScheduleCouncilsRequest, opts ...grpc.CallOption) (*ScheduleCouncilsResponse, error)
 	DeleteCouncil(ctx context.Context, in *DeleteCouncilRequest, opts ...grpc.CallOption) (*DeleteCouncilResponse, error)
+	RestoreCouncil(ctx context.Context, in *RestoreCouncilRequest, opts ...grpc.CallOption) (*RestoreCouncilResponse, error)
 	ListCouncils(ctx context.Context, in *ListCouncilsRequest, opts ...grpc.CallOption) (*ListCouncilsResponse, error)
+	ValidateCouncil(ctx context.Context, in *ValidateCouncilRequest, opts ...grpc.CallOption) (*ValidateCouncilResponse, error)
+	AdmitTopicCouncil(ctx context.Context, in *AdmitTopicCouncilRequest, opts ...grpc.CallOption) (*AdmitTopicCouncilResponse, error)
+	ListCouncilConflictOverrides(ctx context.Context, in *ListCouncilConflictOverridesRequest, opts ...grpc.CallOption) (*ListCouncilConflictOverridesResponse, error)
 	// Defence
 	CreateDefence(ctx context.Context, in *CreateDefenceRequest, opts ...grpc.CallOption) (*CreateDefenceResponse, error)
 	GetDefence(ctx context.Context, in *GetDefenceRequest, opts ...grpc.CallOption) (*GetDefenceResponse, error)
 	UpdateDefence(ctx context.Context, in *UpdateDefenceRequest, opts ...grpc.CallOption) (*UpdateDefenceResponse, error)
 	DeleteDefence(ctx context.Context, in *DeleteDefenceRequest, opts ...grpc.CallOption) (*DeleteDefenceResponse, error)
+	RestoreDefence(ctx context.Context, in *RestoreDefenceRequest, opts ...grpc.CallOption) (*RestoreDefenceResponse, error)
 	ListDefences(ctx context.Context, in *ListDefencesRequest, opts ...grpc.CallOption) (*ListDefencesResponse, error)
 	// GradeDefence
 	CreateGradeDefence(ctx context.Context, in *CreateGradeDefenceRequest, opts ...grpc.CallOption) (*CreateGradeDefenceResponse, error)
 	GetGradeDefence(ctx context.Context, in *GetGradeDefenceRequest, opts ...grpc.CallOption) (*GetGradeDefenceResponse, error)
 	UpdateGradeDefence(ctx context.Context, in *UpdateGradeDefenceRequest, opts ...grpc.CallOption) (*UpdateGradeDefenceResponse, error)
 	DeleteGradeDefence(ctx context.Context, in *DeleteGradeDefenceRequest, opts ...grpc.CallOption) (*DeleteGradeDefenceResponse, error)
+	RestoreGradeDefence(ctx context.Context, in *RestoreGradeDefenceRequest, opts ...grpc.CallOption) (*RestoreGradeDefenceResponse, error)
 	ListGradeDefences(ctx context.Context, in *ListGradeDefencesRequest, opts ...grpc.CallOption) (*ListGradeDefencesResponse, error)
 	// GradeDefenceCriterion
 	CreateGradeDefenceCriterion(ctx context.Context, in *CreateGradeDefenceCriterionRequest, opts ...grpc.CallOption) (*CreateGradeDefenceCriterionResponse, error)
@@ -71,6 +98,21 @@ type CouncilServiceClient interface {
 	UpdateGradeDefenceCriterion(ctx context.Context, in *UpdateGradeDefenceCriterionRequest, opts ...grpc.CallOption) (*UpdateGradeDefenceCriterionResponse, error)
 	DeleteGradeDefenceCriterion(ctx context.Context, in *DeleteGradeDefenceCriterionRequest, opts ...grpc.CallOption) (*DeleteGradeDefenceCriterionResponse, error)
 	ListGradeDefenceCriteria(ctx context.Context, in *ListGradeDefenceCriteriaRequest, opts ...grpc.CallOption) (*ListGradeDefenceCriteriaResponse, error)
+	// RubricTemplate
+	CreateRubricTemplate(ctx context.Context, in *CreateRubricTemplateRequest, opts ...grpc.CallOption) (*CreateRubricTemplateResponse, error)
+	GetRubricTemplate(ctx context.Context, in *GetRubricTemplateRequest, opts ...grpc.CallOption) (*GetRubricTemplateResponse, error)
+	UpdateRubricTemplate(ctx context.Context, in *UpdateRubricTemplateRequest, opts ...grpc.CallOption) (*UpdateRubricTemplateResponse, error)
+	DeleteRubricTemplate(ctx context.Context, in *DeleteRubricTemplateRequest, opts ...grpc.CallOption) (*DeleteRubricTemplateResponse, error)
+	ListRubricTemplates(ctx context.Context, in *ListRubricTemplatesRequest, opts ...grpc.CallOption) (*ListRubricTemplatesResponse, error)
+	// Grade lock, publication & amendments
+	LockCouncilGrades(ctx context.Context, in *LockCouncilGradesRequest, opts ...grpc.CallOption) (*LockCouncilGradesResponse, error)
+	PublishCouncilGrades(ctx context.Context, in *PublishCouncilGradesRequest, opts ...grpc.CallOption) (*PublishCouncilGradesResponse, error)
+	RequestGradeDefenceAmendment(ctx context.Context, in *RequestGradeDefenceAmendmentRequest, opts ...grpc.CallOption) (*RequestGradeDefenceAmendmentResponse, error)
+	DecideGradeDefenceAmendment(ctx context.Context, in *DecideGradeDefenceAmendmentRequest, opts ...grpc.CallOption) (*DecideGradeDefenceAmendmentResponse, error)
+	GetGradeDefenceAmendment(ctx context.Context, in *GetGradeDefenceAmendmentRequest, opts ...grpc.CallOption) (*GetGradeDefenceAmendmentResponse, error)
+	ListGradeDefenceAmendments(ctx context.Context, in *ListGradeDefenceAmendmentsRequest, opts ...grpc.CallOption) (*ListGradeDefenceAmendmentsResponse, error)
+	// Cross-service reference validation
+	CheckReferences(ctx context.Context, in *common.ReferenceCheckRequest, opts ...grpc.CallOption) (*common.ReferenceCheckResponse, error)
 }
 
 type councilServiceClient struct {
@@ -111,6 +153,16 @@ func (c *councilServiceClient) UpdateCouncil(ctx context.Context, in *UpdateCoun
 	return out, nil
 }
 
+func (c *councilServiceClient) ScheduleCouncils(ctx context.Context, in *ScheduleCouncilsRequest, opts ...grpc.CallOption) (*ScheduleCouncilsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleCouncilsResponse)
+	err := c.cc.Invoke(ctx, CouncilService_ScheduleCouncils_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *councilServiceClient) DeleteCouncil(ctx context.Context, in *DeleteCouncilRequest, opts ...grpc.CallOption) (*DeleteCouncilResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteCouncilResponse)
@@ -121,6 +173,16 @@ func (c *councilServiceClient) DeleteCouncil(ctx context.Context, in *DeleteCoun
 	return out, nil
 }
 
+func (c *councilServiceClient) RestoreCouncil(ctx context.Context, in *RestoreCouncilRequest, opts ...grpc.CallOption) (*RestoreCouncilResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreCouncilResponse)
+	err := c.cc.Invoke(ctx, CouncilService_RestoreCouncil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *councilServiceClient) ListCouncils(ctx context.Context, in *ListCouncilsRequest, opts ...grpc.CallOption) (*ListCouncilsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListCouncilsResponse)
@@ -131,6 +193,36 @@ func (c *councilServiceClient) ListCouncils(ctx context.Context, in *ListCouncil
 	return out, nil
 }
 
+func (c *councilServiceClient) ValidateCouncil(ctx context.Context, in *ValidateCouncilRequest, opts ...grpc.CallOption) (*ValidateCouncilResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateCouncilResponse)
+	err := c.cc.Invoke(ctx, CouncilService_ValidateCouncil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *councilServiceClient) AdmitTopicCouncil(ctx context.Context, in *AdmitTopicCouncilRequest, opts ...grpc.CallOption) (*AdmitTopicCouncilResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdmitTopicCouncilResponse)
+	err := c.cc.Invoke(ctx, CouncilService_AdmitTopicCouncil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *councilServiceClient) ListCouncilConflictOverrides(ctx context.Context, in *ListCouncilConflictOverridesRequest, opts ...grpc.CallOption) (*ListCouncilConflictOverridesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCouncilConflictOverridesResponse)
+	err := c.cc.Invoke(ctx, CouncilService_ListCouncilConflictOverrides_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *councilServiceClient) CreateDefence(ctx context.Context, in *CreateDefenceRequest, opts ...grpc.CallOption) (*CreateDefenceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateDefenceResponse)
@@ -171,6 +263,16 @@ func (c *councilServiceClient) DeleteDefence(ctx context.Context, in *DeleteDefe
 	return out, nil
 }
 
+func (c *councilServiceClient) RestoreDefence(ctx context.Context, in *RestoreDefenceRequest, opts ...grpc.CallOption) (*RestoreDefenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreDefenceResponse)
+	err := c.cc.Invoke(ctx, CouncilService_RestoreDefence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *councilServiceClient) ListDefences(ctx context.Context, in *ListDefencesRequest, opts ...grpc.CallOption) (*ListDefencesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListDefencesResponse)
@@ -221,6 +323,16 @@ func (c *councilServiceClient) DeleteGradeDefence(ctx context.Context, in *Delet
 	return out, nil
 }
 
+func (c *councilServiceClient) RestoreGradeDefence(ctx context.Context, in *RestoreGradeDefenceRequest, opts ...grpc.CallOption) (*RestoreGradeDefenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreGradeDefenceResponse)
+	err := c.cc.Invoke(ctx, CouncilService_RestoreGradeDefence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *councilServiceClient) ListGradeDefences(ctx context.Context, in *ListGradeDefencesRequest, opts ...grpc.CallOption) (*ListGradeDefencesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListGradeDefencesResponse)
@@ -281,6 +393,126 @@ func (c *councilServiceClient) ListGradeDefenceCriteria(ctx context.Context, in
 	return out, nil
 }
 
+func (c *councilServiceClient) CreateRubricTemplate(ctx context.Context, in *CreateRubricTemplateRequest, opts ...grpc.CallOption) (*CreateRubricTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRubricTemplateResponse)
+	err := c.cc.Invoke(ctx, CouncilService_CreateRubricTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *councilServiceClient) GetRubricTemplate(ctx context.Context, in *GetRubricTemplateRequest, opts ...grpc.CallOption) (*GetRubricTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRubricTemplateResponse)
+	err := c.cc.Invoke(ctx, CouncilService_GetRubricTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *councilServiceClient) UpdateRubricTemplate(ctx context.Context, in *UpdateRubricTemplateRequest, opts ...grpc.CallOption) (*UpdateRubricTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRubricTemplateResponse)
+	err := c.cc.Invoke(ctx, CouncilService_UpdateRubricTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *councilServiceClient) DeleteRubricTemplate(ctx context.Context, in *DeleteRubricTemplateRequest, opts ...grpc.CallOption) (*DeleteRubricTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRubricTemplateResponse)
+	err := c.cc.Invoke(ctx, CouncilService_DeleteRubricTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *councilServiceClient) ListRubricTemplates(ctx context.Context, in *ListRubricTemplatesRequest, opts ...grpc.CallOption) (*ListRubricTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRubricTemplatesResponse)
+	err := c.cc.Invoke(ctx, CouncilService_ListRubricTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *councilServiceClient) LockCouncilGrades(ctx context.Context, in *LockCouncilGradesRequest, opts ...grpc.CallOption) (*LockCouncilGradesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LockCouncilGradesResponse)
+	err := c.cc.Invoke(ctx, CouncilService_LockCouncilGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *councilServiceClient) PublishCouncilGrades(ctx context.Context, in *PublishCouncilGradesRequest, opts ...grpc.CallOption) (*PublishCouncilGradesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishCouncilGradesResponse)
+	err := c.cc.Invoke(ctx, CouncilService_PublishCouncilGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *councilServiceClient) RequestGradeDefenceAmendment(ctx context.Context, in *RequestGradeDefenceAmendmentRequest, opts ...grpc.CallOption) (*RequestGradeDefenceAmendmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestGradeDefenceAmendmentResponse)
+	err := c.cc.Invoke(ctx, CouncilService_RequestGradeDefenceAmendment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *councilServiceClient) DecideGradeDefenceAmendment(ctx context.Context, in *DecideGradeDefenceAmendmentRequest, opts ...grpc.CallOption) (*DecideGradeDefenceAmendmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecideGradeDefenceAmendmentResponse)
+	err := c.cc.Invoke(ctx, CouncilService_DecideGradeDefenceAmendment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *councilServiceClient) GetGradeDefenceAmendment(ctx context.Context, in *GetGradeDefenceAmendmentRequest, opts ...grpc.CallOption) (*GetGradeDefenceAmendmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGradeDefenceAmendmentResponse)
+	err := c.cc.Invoke(ctx, CouncilService_GetGradeDefenceAmendment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *councilServiceClient) ListGradeDefenceAmendments(ctx context.Context, in *ListGradeDefenceAmendmentsRequest, opts ...grpc.CallOption) (*ListGradeDefenceAmendmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGradeDefenceAmendmentsResponse)
+	err := c.cc.Invoke(ctx, CouncilService_ListGradeDefenceAmendments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *councilServiceClient) CheckReferences(ctx context.Context, in *common.ReferenceCheckRequest, opts ...grpc.CallOption) (*common.ReferenceCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.ReferenceCheckResponse)
+	err := c.cc.Invoke(ctx, CouncilService_CheckReferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CouncilServiceServer is the server API for CouncilService service.
 // All implementations must embed UnimplementedCouncilServiceServer
 // for forward compatibility.
@@ -291,19 +523,26 @@ type CouncilServiceServer interface {
 	CreateCouncil(context.Context, *CreateCouncilRequest) (*CreateCouncilResponse, error)
 	GetCouncil(context.Context, *GetCouncilRequest) (*GetCouncilResponse, error)
 	UpdateCouncil(context.Context, *UpdateCouncilRequest) (*UpdateCouncilResponse, error)
+	ScheduleCouncils(context.Context, *ScheduleCouncilsRequest) (*ScheduleCouncilsResponse, error)
 	DeleteCouncil(context.Context, *DeleteCouncilRequest) (*DeleteCouncilResponse, error)
+	RestoreCouncil(context.Context, *RestoreCouncilRequest) (*RestoreCouncilResponse, error)
 	ListCouncils(context.Context, *ListCouncilsRequest) (*ListCouncilsResponse, error)
+	ValidateCouncil(context.Context, *ValidateCouncilRequest) (*ValidateCouncilResponse, error)
+	AdmitTopicCouncil(context.Context, *AdmitTopicCouncilRequest) (*AdmitTopicCouncilResponse, error)
+	ListCouncilConflictOverrides(context.Context, *ListCouncilConflictOverridesRequest) (*ListCouncilConflictOverridesResponse, error)
 	// Defence
 	CreateDefence(context.Context, *CreateDefenceRequest) (*CreateDefenceResponse, error)
 	GetDefence(context.Context, *GetDefenceRequest) (*GetDefenceResponse, error)
 	UpdateDefence(context.Context, *UpdateDefenceRequest) (*UpdateDefenceResponse, error)
 	DeleteDefence(context.Context, *DeleteDefenceRequest) (*DeleteDefenceResponse, error)
+	RestoreDefence(context.Context, *RestoreDefenceRequest) (*RestoreDefenceResponse, error)
 	ListDefences(context.Context, *ListDefencesRequest) (*ListDefencesResponse, error)
 	// GradeDefence
 	CreateGradeDefence(context.Context, *CreateGradeDefenceRequest) (*CreateGradeDefenceResponse, error)
 	GetGradeDefence(context.Context, *GetGradeDefenceRequest) (*GetGradeDefenceResponse, error)
 	UpdateGradeDefence(context.Context, *UpdateGradeDefenceRequest) (*UpdateGradeDefenceResponse, error)
 	DeleteGradeDefence(context.Context, *DeleteGradeDefenceRequest) (*DeleteGradeDefenceResponse, error)
+	RestoreGradeDefence(context.Context, *RestoreGradeDefenceRequest) (*RestoreGradeDefenceResponse, error)
 	ListGradeDefences(context.Context, *ListGradeDefencesRequest) (*ListGradeDefencesResponse, error)
 	// GradeDefenceCriterion
 	CreateGradeDefenceCriterion(context.Context, *CreateGradeDefenceCriterionRequest) (*CreateGradeDefenceCriterionResponse, error)
@@ -311,6 +550,21 @@ type CouncilServiceServer interface {
 	UpdateGradeDefenceCriterion(context.Context, *UpdateGradeDefenceCriterionRequest) (*UpdateGradeDefenceCriterionResponse, error)
 	DeleteGradeDefenceCriterion(context.Context, *DeleteGradeDefenceCriterionRequest) (*DeleteGradeDefenceCriterionResponse, error)
 	ListGradeDefenceCriteria(context.Context, *ListGradeDefenceCriteriaRequest) (*ListGradeDefenceCriteriaResponse, error)
+	// RubricTemplate
+	CreateRubricTemplate(context.Context, *CreateRubricTemplateRequest) (*CreateRubricTemplateResponse, error)
+	GetRubricTemplate(context.Context, *GetRubricTemplateRequest) (*GetRubricTemplateResponse, error)
+	UpdateRubricTemplate(context.Context, *UpdateRubricTemplateRequest) (*UpdateRubricTemplateResponse, error)
+	DeleteRubricTemplate(context.Context, *DeleteRubricTemplateRequest) (*DeleteRubricTemplateResponse, error)
+	ListRubricTemplates(context.Context, *ListRubricTemplatesRequest) (*ListRubricTemplatesResponse, error)
+	// Grade lock, publication & amendments
+	LockCouncilGrades(context.Context, *LockCouncilGradesRequest) (*LockCouncilGradesResponse, error)
+	PublishCouncilGrades(context.Context, *PublishCouncilGradesRequest) (*PublishCouncilGradesResponse, error)
+	RequestGradeDefenceAmendment(context.Context, *RequestGradeDefenceAmendmentRequest) (*RequestGradeDefenceAmendmentResponse, error)
+	DecideGradeDefenceAmendment(context.Context, *DecideGradeDefenceAmendmentRequest) (*DecideGradeDefenceAmendmentResponse, error)
+	GetGradeDefenceAmendment(context.Context, *GetGradeDefenceAmendmentRequest) (*GetGradeDefenceAmendmentResponse, error)
+	ListGradeDefenceAmendments(context.Context, *ListGradeDefenceAmendmentsRequest) (*ListGradeDefenceAmendmentsResponse, error)
+	// Cross-service reference validation
+	CheckReferences(context.Context, *common.ReferenceCheckRequest) (*common.ReferenceCheckResponse, error)
 	mustEmbedUnimplementedCouncilServiceServer()
 }
 
@@ -330,12 +584,27 @@ func (UnimplementedCouncilServiceServer) GetCouncil(context.Context, *GetCouncil
 func (UnimplementedCouncilServiceServer) UpdateCouncil(context.Context, *UpdateCouncilRequest) (*UpdateCouncilResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCouncil not implemented")
 }
+func (UnimplementedCouncilServiceServer) ScheduleCouncils(context.Context, *ScheduleCouncilsRequest) (*ScheduleCouncilsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleCouncils not implemented")
+}
 func (UnimplementedCouncilServiceServer) DeleteCouncil(context.Context, *DeleteCouncilRequest) (*DeleteCouncilResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCouncil not implemented")
 }
+func (UnimplementedCouncilServiceServer) RestoreCouncil(context.Context, *RestoreCouncilRequest) (*RestoreCouncilResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCouncil not implemented")
+}
 func (UnimplementedCouncilServiceServer) ListCouncils(context.Context, *ListCouncilsRequest) (*ListCouncilsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCouncils not implemented")
 }
+func (UnimplementedCouncilServiceServer) ValidateCouncil(context.Context, *ValidateCouncilRequest) (*ValidateCouncilResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCouncil not implemented")
+}
+func (UnimplementedCouncilServiceServer) AdmitTopicCouncil(context.Context, *AdmitTopicCouncilRequest) (*AdmitTopicCouncilResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdmitTopicCouncil not implemented")
+}
+func (UnimplementedCouncilServiceServer) ListCouncilConflictOverrides(context.Context, *ListCouncilConflictOverridesRequest) (*ListCouncilConflictOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCouncilConflictOverrides not implemented")
+}
 func (UnimplementedCouncilServiceServer) CreateDefence(context.Context, *CreateDefenceRequest) (*CreateDefenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDefence not implemented")
 }
@@ -348,6 +617,9 @@ func (UnimplementedCouncilServiceServer) UpdateDefence(context.Context, *UpdateD
 func (UnimplementedCouncilServiceServer) DeleteDefence(context.Context, *DeleteDefenceRequest) (*DeleteDefenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteDefence not implemented")
 }
+func (UnimplementedCouncilServiceServer) RestoreDefence(context.Context, *RestoreDefenceRequest) (*RestoreDefenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDefence not implemented")
+}
 func (UnimplementedCouncilServiceServer) ListDefences(context.Context, *ListDefencesRequest) (*ListDefencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDefences not implemented")
 }
@@ -363,6 +635,9 @@ func (UnimplementedCouncilServiceServer) UpdateGradeDefence(context.Context, *Up
 func (UnimplementedCouncilServiceServer) DeleteGradeDefence(context.Context, *DeleteGradeDefenceRequest) (*DeleteGradeDefenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteGradeDefence not implemented")
 }
+func (UnimplementedCouncilServiceServer) RestoreGradeDefence(context.Context, *RestoreGradeDefenceRequest) (*RestoreGradeDefenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreGradeDefence not implemented")
+}
 func (UnimplementedCouncilServiceServer) ListGradeDefences(context.Context, *ListGradeDefencesRequest) (*ListGradeDefencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListGradeDefences not implemented")
 }
@@ -381,6 +656,42 @@ func (UnimplementedCouncilServiceServer) DeleteGradeDefenceCriterion(context.Con
 func (UnimplementedCouncilServiceServer) ListGradeDefenceCriteria(context.Context, *ListGradeDefenceCriteriaRequest) (*ListGradeDefenceCriteriaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListGradeDefenceCriteria not implemented")
 }
+func (UnimplementedCouncilServiceServer) CreateRubricTemplate(context.Context, *CreateRubricTemplateRequest) (*CreateRubricTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRubricTemplate not implemented")
+}
+func (UnimplementedCouncilServiceServer) GetRubricTemplate(context.Context, *GetRubricTemplateRequest) (*GetRubricTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRubricTemplate not implemented")
+}
+func (UnimplementedCouncilServiceServer) UpdateRubricTemplate(context.Context, *UpdateRubricTemplateRequest) (*UpdateRubricTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRubricTemplate not implemented")
+}
+func (UnimplementedCouncilServiceServer) DeleteRubricTemplate(context.Context, *DeleteRubricTemplateRequest) (*DeleteRubricTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRubricTemplate not implemented")
+}
+func (UnimplementedCouncilServiceServer) ListRubricTemplates(context.Context, *ListRubricTemplatesRequest) (*ListRubricTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRubricTemplates not implemented")
+}
+func (UnimplementedCouncilServiceServer) LockCouncilGrades(context.Context, *LockCouncilGradesRequest) (*LockCouncilGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockCouncilGrades not implemented")
+}
+func (UnimplementedCouncilServiceServer) PublishCouncilGrades(context.Context, *PublishCouncilGradesRequest) (*PublishCouncilGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishCouncilGrades not implemented")
+}
+func (UnimplementedCouncilServiceServer) RequestGradeDefenceAmendment(context.Context, *RequestGradeDefenceAmendmentRequest) (*RequestGradeDefenceAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestGradeDefenceAmendment not implemented")
+}
+func (UnimplementedCouncilServiceServer) DecideGradeDefenceAmendment(context.Context, *DecideGradeDefenceAmendmentRequest) (*DecideGradeDefenceAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecideGradeDefenceAmendment not implemented")
+}
+func (UnimplementedCouncilServiceServer) GetGradeDefenceAmendment(context.Context, *GetGradeDefenceAmendmentRequest) (*GetGradeDefenceAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradeDefenceAmendment not implemented")
+}
+func (UnimplementedCouncilServiceServer) ListGradeDefenceAmendments(context.Context, *ListGradeDefenceAmendmentsRequest) (*ListGradeDefenceAmendmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGradeDefenceAmendments not implemented")
+}
+func (UnimplementedCouncilServiceServer) CheckReferences(context.Context, *common.ReferenceCheckRequest) (*common.ReferenceCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckReferences not implemented")
+}
 func (UnimplementedCouncilServiceServer) mustEmbedUnimplementedCouncilServiceServer() {}
 func (UnimplementedCouncilServiceServer) testEmbeddedByValue()                        {}
 
@@ -456,6 +767,24 @@ func _CouncilService_UpdateCouncil_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CouncilService_ScheduleCouncils_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleCouncilsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouncilServiceServer).ScheduleCouncils(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouncilService_ScheduleCouncils_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouncilServiceServer).ScheduleCouncils(ctx, req.(*ScheduleCouncilsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CouncilService_DeleteCouncil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteCouncilRequest)
 	if err := dec(in); err != nil {
@@ -474,6 +803,24 @@ func _CouncilService_DeleteCouncil_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CouncilService_RestoreCouncil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCouncilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouncilServiceServer).RestoreCouncil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouncilService_RestoreCouncil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouncilServiceServer).RestoreCouncil(ctx, req.(*RestoreCouncilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CouncilService_ListCouncils_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListCouncilsRequest)
 	if err := dec(in); err != nil {
@@ -492,6 +839,60 @@ func _CouncilService_ListCouncils_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CouncilService_ValidateCouncil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateCouncilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouncilServiceServer).ValidateCouncil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouncilService_ValidateCouncil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouncilServiceServer).ValidateCouncil(ctx, req.(*ValidateCouncilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouncilService_AdmitTopicCouncil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdmitTopicCouncilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouncilServiceServer).AdmitTopicCouncil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouncilService_AdmitTopicCouncil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouncilServiceServer).AdmitTopicCouncil(ctx, req.(*AdmitTopicCouncilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouncilService_ListCouncilConflictOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCouncilConflictOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouncilServiceServer).ListCouncilConflictOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouncilService_ListCouncilConflictOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouncilServiceServer).ListCouncilConflictOverrides(ctx, req.(*ListCouncilConflictOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CouncilService_CreateDefence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateDefenceRequest)
 	if err := dec(in); err != nil {
@@ -564,6 +965,24 @@ func _CouncilService_DeleteDefence_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CouncilService_RestoreDefence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreDefenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouncilServiceServer).RestoreDefence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouncilService_RestoreDefence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouncilServiceServer).RestoreDefence(ctx, req.(*RestoreDefenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CouncilService_ListDefences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListDefencesRequest)
 	if err := dec(in); err != nil {
@@ -654,6 +1073,24 @@ func _CouncilService_DeleteGradeDefence_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CouncilService_RestoreGradeDefence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreGradeDefenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouncilServiceServer).RestoreGradeDefence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouncilService_RestoreGradeDefence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouncilServiceServer).RestoreGradeDefence(ctx, req.(*RestoreGradeDefenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CouncilService_ListGradeDefences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListGradeDefencesRequest)
 	if err := dec(in); err != nil {
@@ -762,6 +1199,222 @@ func _CouncilService_ListGradeDefenceCriteria_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CouncilService_CreateRubricTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRubricTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouncilServiceServer).CreateRubricTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouncilService_CreateRubricTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouncilServiceServer).CreateRubricTemplate(ctx, req.(*CreateRubricTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouncilService_GetRubricTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRubricTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouncilServiceServer).GetRubricTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouncilService_GetRubricTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouncilServiceServer).GetRubricTemplate(ctx, req.(*GetRubricTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouncilService_UpdateRubricTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRubricTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouncilServiceServer).UpdateRubricTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouncilService_UpdateRubricTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouncilServiceServer).UpdateRubricTemplate(ctx, req.(*UpdateRubricTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouncilService_DeleteRubricTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRubricTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouncilServiceServer).DeleteRubricTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouncilService_DeleteRubricTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouncilServiceServer).DeleteRubricTemplate(ctx, req.(*DeleteRubricTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouncilService_ListRubricTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRubricTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouncilServiceServer).ListRubricTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouncilService_ListRubricTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouncilServiceServer).ListRubricTemplates(ctx, req.(*ListRubricTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouncilService_LockCouncilGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockCouncilGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouncilServiceServer).LockCouncilGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouncilService_LockCouncilGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouncilServiceServer).LockCouncilGrades(ctx, req.(*LockCouncilGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouncilService_PublishCouncilGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishCouncilGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouncilServiceServer).PublishCouncilGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouncilService_PublishCouncilGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouncilServiceServer).PublishCouncilGrades(ctx, req.(*PublishCouncilGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouncilService_RequestGradeDefenceAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestGradeDefenceAmendmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouncilServiceServer).RequestGradeDefenceAmendment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouncilService_RequestGradeDefenceAmendment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouncilServiceServer).RequestGradeDefenceAmendment(ctx, req.(*RequestGradeDefenceAmendmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouncilService_DecideGradeDefenceAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideGradeDefenceAmendmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouncilServiceServer).DecideGradeDefenceAmendment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouncilService_DecideGradeDefenceAmendment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouncilServiceServer).DecideGradeDefenceAmendment(ctx, req.(*DecideGradeDefenceAmendmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouncilService_GetGradeDefenceAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradeDefenceAmendmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouncilServiceServer).GetGradeDefenceAmendment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouncilService_GetGradeDefenceAmendment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouncilServiceServer).GetGradeDefenceAmendment(ctx, req.(*GetGradeDefenceAmendmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouncilService_ListGradeDefenceAmendments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGradeDefenceAmendmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouncilServiceServer).ListGradeDefenceAmendments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouncilService_ListGradeDefenceAmendments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouncilServiceServer).ListGradeDefenceAmendments(ctx, req.(*ListGradeDefenceAmendmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouncilService_CheckReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.ReferenceCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouncilServiceServer).CheckReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouncilService_CheckReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouncilServiceServer).CheckReferences(ctx, req.(*common.ReferenceCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CouncilService_ServiceDesc is the grpc.ServiceDesc for CouncilService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -781,14 +1434,34 @@ var CouncilService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCouncil",
 			Handler:    _CouncilService_UpdateCouncil_Handler,
 		},
+		{
+			MethodName: "ScheduleCouncils",
+			Handler:    _CouncilService_ScheduleCouncils_Handler,
+		},
 		{
 			MethodName: "DeleteCouncil",
 			Handler:    _CouncilService_DeleteCouncil_Handler,
 		},
+		{
+			MethodName: "RestoreCouncil",
+			Handler:    _CouncilService_RestoreCouncil_Handler,
+		},
 		{
 			MethodName: "ListCouncils",
 			Handler:    _CouncilService_ListCouncils_Handler,
 		},
+		{
+			MethodName: "ValidateCouncil",
+			Handler:    _CouncilService_ValidateCouncil_Handler,
+		},
+		{
+			MethodName: "AdmitTopicCouncil",
+			Handler:    _CouncilService_AdmitTopicCouncil_Handler,
+		},
+		{
+			MethodName: "ListCouncilConflictOverrides",
+			Handler:    _CouncilService_ListCouncilConflictOverrides_Handler,
+		},
 		{
 			MethodName: "CreateDefence",
 			Handler:    _CouncilService_CreateDefence_Handler,
@@ -805,6 +1478,10 @@ var CouncilService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDefence",
 			Handler:    _CouncilService_DeleteDefence_Handler,
 		},
+		{
+			MethodName: "RestoreDefence",
+			Handler:    _CouncilService_RestoreDefence_Handler,
+		},
 		{
 			MethodName: "ListDefences",
 			Handler:    _CouncilService_ListDefences_Handler,
@@ -825,6 +1502,10 @@ var CouncilService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteGradeDefence",
 			Handler:    _CouncilService_DeleteGradeDefence_Handler,
 		},
+		{
+			MethodName: "RestoreGradeDefence",
+			Handler:    _CouncilService_RestoreGradeDefence_Handler,
+		},
 		{
 			MethodName: "ListGradeDefences",
 			Handler:    _CouncilService_ListGradeDefences_Handler,
@@ -849,6 +1530,54 @@ var CouncilService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListGradeDefenceCriteria",
 			Handler:    _CouncilService_ListGradeDefenceCriteria_Handler,
 		},
+		{
+			MethodName: "CreateRubricTemplate",
+			Handler:    _CouncilService_CreateRubricTemplate_Handler,
+		},
+		{
+			MethodName: "GetRubricTemplate",
+			Handler:    _CouncilService_GetRubricTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateRubricTemplate",
+			Handler:    _CouncilService_UpdateRubricTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteRubricTemplate",
+			Handler:    _CouncilService_DeleteRubricTemplate_Handler,
+		},
+		{
+			MethodName: "ListRubricTemplates",
+			Handler:    _CouncilService_ListRubricTemplates_Handler,
+		},
+		{
+			MethodName: "LockCouncilGrades",
+			Handler:    _CouncilService_LockCouncilGrades_Handler,
+		},
+		{
+			MethodName: "PublishCouncilGrades",
+			Handler:    _CouncilService_PublishCouncilGrades_Handler,
+		},
+		{
+			MethodName: "RequestGradeDefenceAmendment",
+			Handler:    _CouncilService_RequestGradeDefenceAmendment_Handler,
+		},
+		{
+			MethodName: "DecideGradeDefenceAmendment",
+			Handler:    _CouncilService_DecideGradeDefenceAmendment_Handler,
+		},
+		{
+			MethodName: "GetGradeDefenceAmendment",
+			Handler:    _CouncilService_GetGradeDefenceAmendment_Handler,
+		},
+		{
+			MethodName: "ListGradeDefenceAmendments",
+			Handler:    _CouncilService_ListGradeDefenceAmendments_Handler,
+		},
+		{
+			MethodName: "CheckReferences",
+			Handler:    _CouncilService_CheckReferences_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/council/council.proto",
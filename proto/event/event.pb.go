@@ -0,0 +1,722 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.21.12
+// source: proto/event/event.proto
+
+package event
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Event is the envelope of every domain event
+type Event struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                      // UUID of the outbox row, the same on redelivery
+	Type        string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                  // name of the payload message, e.g. "TopicStatusChanged"
+	Service     string                 `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`                            // service that wrote the event, e.g. "thesis"
+	Aggregate   string                 `protobuf:"bytes,4,opt,name=aggregate,proto3" json:"aggregate,omitempty"`                        // entity the event is about, e.g. "Topic"
+	AggregateId string                 `protobuf:"bytes,5,opt,name=aggregate_id,json=aggregateId,proto3" json:"aggregate_id,omitempty"` // id of that entity
+	OccurredAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`    // when the change was made
+	Actor       string                 `protobuf:"bytes,7,opt,name=actor,proto3" json:"actor,omitempty"`                                // who made the change
+	RequestId   string                 `protobuf:"bytes,8,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`       // request the change was made in
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*Event_TopicStatusChanged
+	//	*Event_EnrollmentCreated
+	//	*Event_FileUploaded
+	//	*Event_GradeLocked
+	//	*Event_GradeDefenceChanged
+	Payload       isEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_proto_event_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_proto_event_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Event) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Event) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Event) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *Event) GetAggregate() string {
+	if x != nil {
+		return x.Aggregate
+	}
+	return ""
+}
+
+func (x *Event) GetAggregateId() string {
+	if x != nil {
+		return x.AggregateId
+	}
+	return ""
+}
+
+func (x *Event) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *Event) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *Event) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *Event) GetPayload() isEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Event) GetTopicStatusChanged() *TopicStatusChanged {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_TopicStatusChanged); ok {
+			return x.TopicStatusChanged
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetEnrollmentCreated() *EnrollmentCreated {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_EnrollmentCreated); ok {
+			return x.EnrollmentCreated
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetFileUploaded() *FileUploaded {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_FileUploaded); ok {
+			return x.FileUploaded
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetGradeLocked() *GradeLocked {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_GradeLocked); ok {
+			return x.GradeLocked
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetGradeDefenceChanged() *GradeDefenceChanged {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_GradeDefenceChanged); ok {
+			return x.GradeDefenceChanged
+		}
+	}
+	return nil
+}
+
+type isEvent_Payload interface {
+	isEvent_Payload()
+}
+
+type Event_TopicStatusChanged struct {
+	TopicStatusChanged *TopicStatusChanged `protobuf:"bytes,10,opt,name=topic_status_changed,json=topicStatusChanged,proto3,oneof"`
+}
+
+type Event_EnrollmentCreated struct {
+	EnrollmentCreated *EnrollmentCreated `protobuf:"bytes,11,opt,name=enrollment_created,json=enrollmentCreated,proto3,oneof"`
+}
+
+type Event_FileUploaded struct {
+	FileUploaded *FileUploaded `protobuf:"bytes,12,opt,name=file_uploaded,json=fileUploaded,proto3,oneof"`
+}
+
+type Event_GradeLocked struct {
+	GradeLocked *GradeLocked `protobuf:"bytes,13,opt,name=grade_locked,json=gradeLocked,proto3,oneof"`
+}
+
+type Event_GradeDefenceChanged struct {
+	GradeDefenceChanged *GradeDefenceChanged `protobuf:"bytes,14,opt,name=grade_defence_changed,json=gradeDefenceChanged,proto3,oneof"`
+}
+
+func (*Event_TopicStatusChanged) isEvent_Payload() {}
+
+func (*Event_EnrollmentCreated) isEvent_Payload() {}
+
+func (*Event_FileUploaded) isEvent_Payload() {}
+
+func (*Event_GradeLocked) isEvent_Payload() {}
+
+func (*Event_GradeDefenceChanged) isEvent_Payload() {}
+
+// TopicStatusChanged: a topic moved through its lifecycle (aggregate Topic)
+type TopicStatusChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TopicId       string                 `protobuf:"bytes,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	FromStatus    string                 `protobuf:"bytes,2,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"` // lifecycle status as stored, e.g. "pending"
+	ToStatus      string                 `protobuf:"bytes,3,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`       // e.g. "approved_1"
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                           // rejection reason or approval note
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopicStatusChanged) Reset() {
+	*x = TopicStatusChanged{}
+	mi := &file_proto_event_event_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopicStatusChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicStatusChanged) ProtoMessage() {}
+
+func (x *TopicStatusChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_event_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicStatusChanged.ProtoReflect.Descriptor instead.
+func (*TopicStatusChanged) Descriptor() ([]byte, []int) {
+	return file_proto_event_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TopicStatusChanged) GetTopicId() string {
+	if x != nil {
+		return x.TopicId
+	}
+	return ""
+}
+
+func (x *TopicStatusChanged) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *TopicStatusChanged) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *TopicStatusChanged) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// EnrollmentCreated: a student was enrolled in a topic council (aggregate Enrollment)
+type EnrollmentCreated struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EnrollmentId     string                 `protobuf:"bytes,1,opt,name=enrollment_id,json=enrollmentId,proto3" json:"enrollment_id,omitempty"`
+	StudentCode      string                 `protobuf:"bytes,2,opt,name=student_code,json=studentCode,proto3" json:"student_code,omitempty"`
+	TopicCouncilCode string                 `protobuf:"bytes,3,opt,name=topic_council_code,json=topicCouncilCode,proto3" json:"topic_council_code,omitempty"`
+	MidtermCode      string                 `protobuf:"bytes,4,opt,name=midterm_code,json=midtermCode,proto3" json:"midterm_code,omitempty"`
+	FinalCode        string                 `protobuf:"bytes,5,opt,name=final_code,json=finalCode,proto3" json:"final_code,omitempty"`
+	GradeReviewCode  string                 `protobuf:"bytes,6,opt,name=grade_review_code,json=gradeReviewCode,proto3" json:"grade_review_code,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *EnrollmentCreated) Reset() {
+	*x = EnrollmentCreated{}
+	mi := &file_proto_event_event_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollmentCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollmentCreated) ProtoMessage() {}
+
+func (x *EnrollmentCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_event_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollmentCreated.ProtoReflect.Descriptor instead.
+func (*EnrollmentCreated) Descriptor() ([]byte, []int) {
+	return file_proto_event_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EnrollmentCreated) GetEnrollmentId() string {
+	if x != nil {
+		return x.EnrollmentId
+	}
+	return ""
+}
+
+func (x *EnrollmentCreated) GetStudentCode() string {
+	if x != nil {
+		return x.StudentCode
+	}
+	return ""
+}
+
+func (x *EnrollmentCreated) GetTopicCouncilCode() string {
+	if x != nil {
+		return x.TopicCouncilCode
+	}
+	return ""
+}
+
+func (x *EnrollmentCreated) GetMidtermCode() string {
+	if x != nil {
+		return x.MidtermCode
+	}
+	return ""
+}
+
+func (x *EnrollmentCreated) GetFinalCode() string {
+	if x != nil {
+		return x.FinalCode
+	}
+	return ""
+}
+
+func (x *EnrollmentCreated) GetGradeReviewCode() string {
+	if x != nil {
+		return x.GradeReviewCode
+	}
+	return ""
+}
+
+// FileUploaded: a new version of a file was stored (aggregate File)
+type FileUploaded struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Table         string                 `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"` // owning table as stored, e.g. "final"
+	TableId       string                 `protobuf:"bytes,3,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Option        string                 `protobuf:"bytes,4,opt,name=option,proto3" json:"option,omitempty"`
+	Version       int32                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	Size          int64                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	ContentType   string                 `protobuf:"bytes,7,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Late          bool                   `protobuf:"varint,8,opt,name=late,proto3" json:"late,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileUploaded) Reset() {
+	*x = FileUploaded{}
+	mi := &file_proto_event_event_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileUploaded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileUploaded) ProtoMessage() {}
+
+func (x *FileUploaded) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_event_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileUploaded.ProtoReflect.Descriptor instead.
+func (*FileUploaded) Descriptor() ([]byte, []int) {
+	return file_proto_event_event_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FileUploaded) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *FileUploaded) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *FileUploaded) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *FileUploaded) GetOption() string {
+	if x != nil {
+		return x.Option
+	}
+	return ""
+}
+
+func (x *FileUploaded) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *FileUploaded) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileUploaded) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *FileUploaded) GetLate() bool {
+	if x != nil {
+		return x.Late
+	}
+	return false
+}
+
+// GradeLocked: grades were frozen, either a semester's (aggregate Semester)
+// or a council's (aggregate Council)
+type GradeLocked struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SemesterCode  string                 `protobuf:"bytes,1,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"` // set when a semester was locked
+	CouncilCode   string                 `protobuf:"bytes,2,opt,name=council_code,json=councilCode,proto3" json:"council_code,omitempty"`    // set when a council was locked
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeLocked) Reset() {
+	*x = GradeLocked{}
+	mi := &file_proto_event_event_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeLocked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeLocked) ProtoMessage() {}
+
+func (x *GradeLocked) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_event_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeLocked.ProtoReflect.Descriptor instead.
+func (*GradeLocked) Descriptor() ([]byte, []int) {
+	return file_proto_event_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GradeLocked) GetSemesterCode() string {
+	if x != nil {
+		return x.SemesterCode
+	}
+	return ""
+}
+
+func (x *GradeLocked) GetCouncilCode() string {
+	if x != nil {
+		return x.CouncilCode
+	}
+	return ""
+}
+
+// GradeDefenceChanged: the total score of a grade defence was recomputed
+// from its criteria (aggregate GradeDefence)
+type GradeDefenceChanged struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GradeDefenceId string                 `protobuf:"bytes,1,opt,name=grade_defence_id,json=gradeDefenceId,proto3" json:"grade_defence_id,omitempty"`
+	DefenceCode    string                 `protobuf:"bytes,2,opt,name=defence_code,json=defenceCode,proto3" json:"defence_code,omitempty"`
+	EnrollmentCode string                 `protobuf:"bytes,3,opt,name=enrollment_code,json=enrollmentCode,proto3" json:"enrollment_code,omitempty"`
+	TotalScore     *float64               `protobuf:"fixed64,4,opt,name=total_score,json=totalScore,proto3,oneof" json:"total_score,omitempty"` // unset until a criterion is scored
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GradeDefenceChanged) Reset() {
+	*x = GradeDefenceChanged{}
+	mi := &file_proto_event_event_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeDefenceChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeDefenceChanged) ProtoMessage() {}
+
+func (x *GradeDefenceChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_event_event_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeDefenceChanged.ProtoReflect.Descriptor instead.
+func (*GradeDefenceChanged) Descriptor() ([]byte, []int) {
+	return file_proto_event_event_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GradeDefenceChanged) GetGradeDefenceId() string {
+	if x != nil {
+		return x.GradeDefenceId
+	}
+	return ""
+}
+
+func (x *GradeDefenceChanged) GetDefenceCode() string {
+	if x != nil {
+		return x.DefenceCode
+	}
+	return ""
+}
+
+func (x *GradeDefenceChanged) GetEnrollmentCode() string {
+	if x != nil {
+		return x.EnrollmentCode
+	}
+	return ""
+}
+
+func (x *GradeDefenceChanged) GetTotalScore() float64 {
+	if x != nil && x.TotalScore != nil {
+		return *x.TotalScore
+	}
+	return 0
+}
+
+var File_proto_event_event_proto protoreflect.FileDescriptor
+
+const file_proto_event_event_proto_rawDesc = "" +
+	"\n" +
+	"\x17proto/event/event.proto\x12\x05event\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe4\x04\n" +
+	"\x05Event\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x18\n" +
+	"\aservice\x18\x03 \x01(\tR\aservice\x12\x1c\n" +
+	"\taggregate\x18\x04 \x01(\tR\taggregate\x12!\n" +
+	"\faggregate_id\x18\x05 \x01(\tR\vaggregateId\x12;\n" +
+	"\voccurred_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x14\n" +
+	"\x05actor\x18\a \x01(\tR\x05actor\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\b \x01(\tR\trequestId\x12M\n" +
+	"\x14topic_status_changed\x18\n" +
+	" \x01(\v2\x19.event.TopicStatusChangedH\x00R\x12topicStatusChanged\x12I\n" +
+	"\x12enrollment_created\x18\v \x01(\v2\x18.event.EnrollmentCreatedH\x00R\x11enrollmentCreated\x12:\n" +
+	"\rfile_uploaded\x18\f \x01(\v2\x13.event.FileUploadedH\x00R\ffileUploaded\x127\n" +
+	"\fgrade_locked\x18\r \x01(\v2\x12.event.GradeLockedH\x00R\vgradeLocked\x12P\n" +
+	"\x15grade_defence_changed\x18\x0e \x01(\v2\x1a.event.GradeDefenceChangedH\x00R\x13gradeDefenceChangedB\t\n" +
+	"\apayload\"\x85\x01\n" +
+	"\x12TopicStatusChanged\x12\x19\n" +
+	"\btopic_id\x18\x01 \x01(\tR\atopicId\x12\x1f\n" +
+	"\vfrom_status\x18\x02 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x03 \x01(\tR\btoStatus\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xf7\x01\n" +
+	"\x11EnrollmentCreated\x12#\n" +
+	"\renrollment_id\x18\x01 \x01(\tR\fenrollmentId\x12!\n" +
+	"\fstudent_code\x18\x02 \x01(\tR\vstudentCode\x12,\n" +
+	"\x12topic_council_code\x18\x03 \x01(\tR\x10topicCouncilCode\x12!\n" +
+	"\fmidterm_code\x18\x04 \x01(\tR\vmidtermCode\x12\x1d\n" +
+	"\n" +
+	"final_code\x18\x05 \x01(\tR\tfinalCode\x12*\n" +
+	"\x11grade_review_code\x18\x06 \x01(\tR\x0fgradeReviewCode\"\xd5\x01\n" +
+	"\fFileUploaded\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x14\n" +
+	"\x05table\x18\x02 \x01(\tR\x05table\x12\x19\n" +
+	"\btable_id\x18\x03 \x01(\tR\atableId\x12\x16\n" +
+	"\x06option\x18\x04 \x01(\tR\x06option\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x05R\aversion\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\x12!\n" +
+	"\fcontent_type\x18\a \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04late\x18\b \x01(\bR\x04late\"U\n" +
+	"\vGradeLocked\x12#\n" +
+	"\rsemester_code\x18\x01 \x01(\tR\fsemesterCode\x12!\n" +
+	"\fcouncil_code\x18\x02 \x01(\tR\vcouncilCode\"\xc1\x01\n" +
+	"\x13GradeDefenceChanged\x12(\n" +
+	"\x10grade_defence_id\x18\x01 \x01(\tR\x0egradeDefenceId\x12!\n" +
+	"\fdefence_code\x18\x02 \x01(\tR\vdefenceCode\x12'\n" +
+	"\x0fenrollment_code\x18\x03 \x01(\tR\x0eenrollmentCode\x12$\n" +
+	"\vtotal_score\x18\x04 \x01(\x01H\x00R\n" +
+	"totalScore\x88\x01\x01B\x0e\n" +
+	"\f_total_scoreB\x14Z\x12thaily/proto/eventb\x06proto3"
+
+var (
+	file_proto_event_event_proto_rawDescOnce sync.Once
+	file_proto_event_event_proto_rawDescData []byte
+)
+
+func file_proto_event_event_proto_rawDescGZIP() []byte {
+	file_proto_event_event_proto_rawDescOnce.Do(func() {
+		file_proto_event_event_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_event_event_proto_rawDesc), len(file_proto_event_event_proto_rawDesc)))
+	})
+	return file_proto_event_event_proto_rawDescData
+}
+
+var file_proto_event_event_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_event_event_proto_goTypes = []any{
+	(*Event)(nil),                 // 0: event.Event
+	(*TopicStatusChanged)(nil),    // 1: event.TopicStatusChanged
+	(*EnrollmentCreated)(nil),     // 2: event.EnrollmentCreated
+	(*FileUploaded)(nil),          // 3: event.FileUploaded
+	(*GradeLocked)(nil),           // 4: event.GradeLocked
+	(*GradeDefenceChanged)(nil),   // 5: event.GradeDefenceChanged
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+}
+var file_proto_event_event_proto_depIdxs = []int32{
+	6, // 0: event.Event.occurred_at:type_name -> google.protobuf.Timestamp
+	1, // 1: event.Event.topic_status_changed:type_name -> event.TopicStatusChanged
+	2, // 2: event.Event.enrollment_created:type_name -> event.EnrollmentCreated
+	3, // 3: event.Event.file_uploaded:type_name -> event.FileUploaded
+	4, // 4: event.Event.grade_locked:type_name -> event.GradeLocked
+	5, // 5: event.Event.grade_defence_changed:type_name -> event.GradeDefenceChanged
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_proto_event_event_proto_init() }
+func file_proto_event_event_proto_init() {
+	if File_proto_event_event_proto != nil {
+		return
+	}
+	file_proto_event_event_proto_msgTypes[0].OneofWrappers = []any{
+		(*Event_TopicStatusChanged)(nil),
+		(*Event_EnrollmentCreated)(nil),
+		(*Event_FileUploaded)(nil),
+		(*Event_GradeLocked)(nil),
+		(*Event_GradeDefenceChanged)(nil),
+	}
+	file_proto_event_event_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_event_event_proto_rawDesc), len(file_proto_event_event_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_event_event_proto_goTypes,
+		DependencyIndexes: file_proto_event_event_proto_depIdxs,
+		MessageInfos:      file_proto_event_event_proto_msgTypes,
+	}.Build()
+	File_proto_event_event_proto = out.File
+	file_proto_event_event_proto_goTypes = nil
+	file_proto_event_event_proto_depIdxs = nil
+}
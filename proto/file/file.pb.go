@@ -75,10 +75,12 @@ func (FileStatus) EnumDescriptor() ([]byte, []int) {
 type TableType int32
 
 const (
-	TableType_TOPIC   TableType = 0
-	TableType_MIDTERM TableType = 1
-	TableType_FINAL   TableType = 2
-	TableType_ORDER   TableType = 3
+	TableType_TOPIC             TableType = 0
+	TableType_MIDTERM           TableType = 1
+	TableType_FINAL             TableType = 2
+	TableType_ORDER             TableType = 3
+	TableType_GRADE_APPEAL      TableType = 4
+	TableType_MILESTONE_CHECKIN TableType = 5
 )
 
 // Enum value maps for TableType.
@@ -88,12 +90,16 @@ var (
 		1: "MIDTERM",
 		2: "FINAL",
 		3: "ORDER",
+		4: "GRADE_APPEAL",
+		5: "MILESTONE_CHECKIN",
 	}
 	TableType_value = map[string]int32{
-		"TOPIC":   0,
-		"MIDTERM": 1,
-		"FINAL":   2,
-		"ORDER":   3,
+		"TOPIC":             0,
+		"MIDTERM":           1,
+		"FINAL":             2,
+		"ORDER":             3,
+		"GRADE_APPEAL":      4,
+		"MILESTONE_CHECKIN": 5,
 	}
 )
 
@@ -138,6 +144,16 @@ type File struct {
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	CreatedBy     string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	UpdatedBy     string                 `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       int32                  `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`  // 1-based, per (table, table_id, option)
+	Size          int64                  `protobuf:"varint,13,opt,name=size,proto3" json:"size,omitempty"`        // bytes
+	Checksum      string                 `protobuf:"bytes,14,opt,name=checksum,proto3" json:"checksum,omitempty"` // sha256 hex of the object
+	ContentType   string                 `protobuf:"bytes,15,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Late          bool                   `protobuf:"varint,16,opt,name=late,proto3" json:"late,omitempty"`                              // submitted inside the grace period
+	Quarantined   bool                   `protobuf:"varint,17,opt,name=quarantined,proto3" json:"quarantined,omitempty"`                // stored under the quarantine prefix until cleared
+	ScanResult    string                 `protobuf:"bytes,18,opt,name=scan_result,json=scanResult,proto3" json:"scan_result,omitempty"` // why the file was quarantined
+	Revision      int32                  `protobuf:"varint,19,opt,name=revision,proto3" json:"revision,omitempty"`                      // optimistic concurrency counter, bumped by every update (version numbers the document)
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`    // set while the record is soft-deleted
+	DeletedBy     string                 `protobuf:"bytes,21,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -249,6 +265,76 @@ func (x *File) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *File) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *File) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *File) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *File) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *File) GetLate() bool {
+	if x != nil {
+		return x.Late
+	}
+	return false
+}
+
+func (x *File) GetQuarantined() bool {
+	if x != nil {
+		return x.Quarantined
+	}
+	return false
+}
+
+func (x *File) GetScanResult() string {
+	if x != nil {
+		return x.ScanResult
+	}
+	return ""
+}
+
+func (x *File) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *File) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *File) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
 type CreateFileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -258,6 +344,12 @@ type CreateFileRequest struct {
 	Option        string                 `protobuf:"bytes,5,opt,name=option,proto3" json:"option,omitempty"`
 	TableId       string                 `protobuf:"bytes,6,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
 	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Size          int64                  `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`
+	Checksum      string                 `protobuf:"bytes,9,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	ContentType   string                 `protobuf:"bytes,10,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Late          bool                   `protobuf:"varint,11,opt,name=late,proto3" json:"late,omitempty"`
+	Quarantined   bool                   `protobuf:"varint,12,opt,name=quarantined,proto3" json:"quarantined,omitempty"`
+	ScanResult    string                 `protobuf:"bytes,13,opt,name=scan_result,json=scanResult,proto3" json:"scan_result,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -341,6 +433,48 @@ func (x *CreateFileRequest) GetCreatedBy() string {
 	return ""
 }
 
+func (x *CreateFileRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CreateFileRequest) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *CreateFileRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *CreateFileRequest) GetLate() bool {
+	if x != nil {
+		return x.Late
+	}
+	return false
+}
+
+func (x *CreateFileRequest) GetQuarantined() bool {
+	if x != nil {
+		return x.Quarantined
+	}
+	return false
+}
+
+func (x *CreateFileRequest) GetScanResult() string {
+	if x != nil {
+		return x.ScanResult
+	}
+	return ""
+}
+
 type CreateFileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	File          *File                  `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
@@ -386,10 +520,11 @@ func (x *CreateFileResponse) GetFile() *File {
 }
 
 type GetFileRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also find a soft-deleted record
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetFileRequest) Reset() {
@@ -429,6 +564,13 @@ func (x *GetFileRequest) GetId() string {
 	return ""
 }
 
+func (x *GetFileRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type GetFileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	File          *File                  `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
@@ -483,6 +625,9 @@ type UpdateFileRequest struct {
 	Option        *string                `protobuf:"bytes,6,opt,name=option,proto3,oneof" json:"option,omitempty"`
 	TableId       *string                `protobuf:"bytes,7,opt,name=table_id,json=tableId,proto3,oneof" json:"table_id,omitempty"`
 	UpdatedBy     string                 `protobuf:"bytes,8,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Quarantined   *bool                  `protobuf:"varint,9,opt,name=quarantined,proto3,oneof" json:"quarantined,omitempty"`
+	ScanResult    *string                `protobuf:"bytes,10,opt,name=scan_result,json=scanResult,proto3,oneof" json:"scan_result,omitempty"`
+	Revision      *int32                 `protobuf:"varint,11,opt,name=revision,proto3,oneof" json:"revision,omitempty"` // expected revision; a stale one fails with ABORTED
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -573,6 +718,27 @@ func (x *UpdateFileRequest) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *UpdateFileRequest) GetQuarantined() bool {
+	if x != nil && x.Quarantined != nil {
+		return *x.Quarantined
+	}
+	return false
+}
+
+func (x *UpdateFileRequest) GetScanResult() string {
+	if x != nil && x.ScanResult != nil {
+		return *x.ScanResult
+	}
+	return ""
+}
+
+func (x *UpdateFileRequest) GetRevision() int32 {
+	if x != nil && x.Revision != nil {
+		return *x.Revision
+	}
+	return 0
+}
+
 type UpdateFileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	File          *File                  `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
@@ -620,6 +786,7 @@ func (x *UpdateFileResponse) GetFile() *File {
 type DeleteFileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -661,6 +828,13 @@ func (x *DeleteFileRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteFileRequest) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
 type DeleteFileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -705,16 +879,113 @@ func (x *DeleteFileResponse) GetSuccess() bool {
 	return false
 }
 
-type ListFilesRequest struct {
+type RestoreFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RestoredBy    string                 `protobuf:"bytes,2,opt,name=restored_by,json=restoredBy,proto3" json:"restored_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreFileRequest) Reset() {
+	*x = RestoreFileRequest{}
+	mi := &file_proto_file_file_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFileRequest) ProtoMessage() {}
+
+func (x *RestoreFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_file_file_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreFileRequest.ProtoReflect.Descriptor instead.
+func (*RestoreFileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_file_file_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RestoreFileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreFileRequest) GetRestoredBy() string {
+	if x != nil {
+		return x.RestoredBy
+	}
+	return ""
+}
+
+type RestoreFileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	File          *File                  `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *RestoreFileResponse) Reset() {
+	*x = RestoreFileResponse{}
+	mi := &file_proto_file_file_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFileResponse) ProtoMessage() {}
+
+func (x *RestoreFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_file_file_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreFileResponse.ProtoReflect.Descriptor instead.
+func (*RestoreFileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_file_file_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RestoreFileResponse) GetFile() *File {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+type ListFilesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Search         *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also list soft-deleted records
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *ListFilesRequest) Reset() {
 	*x = ListFilesRequest{}
-	mi := &file_proto_file_file_proto_msgTypes[9]
+	mi := &file_proto_file_file_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -726,7 +997,7 @@ func (x *ListFilesRequest) String() string {
 func (*ListFilesRequest) ProtoMessage() {}
 
 func (x *ListFilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_file_file_proto_msgTypes[9]
+	mi := &file_proto_file_file_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -739,7 +1010,7 @@ func (x *ListFilesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFilesRequest.ProtoReflect.Descriptor instead.
 func (*ListFilesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_file_file_proto_rawDescGZIP(), []int{9}
+	return file_proto_file_file_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListFilesRequest) GetSearch() *common.SearchRequest {
@@ -749,6 +1020,13 @@ func (x *ListFilesRequest) GetSearch() *common.SearchRequest {
 	return nil
 }
 
+func (x *ListFilesRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type ListFilesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Files         []*File                `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
@@ -761,7 +1039,7 @@ type ListFilesResponse struct {
 
 func (x *ListFilesResponse) Reset() {
 	*x = ListFilesResponse{}
-	mi := &file_proto_file_file_proto_msgTypes[10]
+	mi := &file_proto_file_file_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -773,7 +1051,7 @@ func (x *ListFilesResponse) String() string {
 func (*ListFilesResponse) ProtoMessage() {}
 
 func (x *ListFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_file_file_proto_msgTypes[10]
+	mi := &file_proto_file_file_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,7 +1064,7 @@ func (x *ListFilesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFilesResponse.ProtoReflect.Descriptor instead.
 func (*ListFilesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_file_file_proto_rawDescGZIP(), []int{10}
+	return file_proto_file_file_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListFilesResponse) GetFiles() []*File {
@@ -817,86 +1095,1087 @@ func (x *ListFilesResponse) GetPageSize() int32 {
 	return 0
 }
 
-var File_proto_file_file_proto protoreflect.FileDescriptor
+// ============= File Versions =============
+type ListFileVersionsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Table          TableType              `protobuf:"varint,1,opt,name=table,proto3,enum=file.TableType" json:"table,omitempty"`
+	TableId        string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Option         string                 `protobuf:"bytes,3,opt,name=option,proto3" json:"option,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,4,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also list soft-deleted versions
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
 
-const file_proto_file_file_proto_rawDesc = "" +
-	"\n" +
-	"\x15proto/file/file.proto\x12\x04file\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19proto/common/common.proto\"\xf8\x02\n" +
-	"\x04File\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
-	"\x04file\x18\x03 \x01(\tR\x04file\x12(\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x10.file.FileStatusR\x06status\x12%\n" +
-	"\x05table\x18\x05 \x01(\x0e2\x0f.file.TableTypeR\x05table\x12\x16\n" +
-	"\x06option\x18\x06 \x01(\tR\x06option\x12\x19\n" +
-	"\btable_id\x18\a \x01(\tR\atableId\x129\n" +
-	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
-	"\n" +
-	"created_by\x18\n" +
-	" \x01(\tR\tcreatedBy\x12\x1d\n" +
-	"\n" +
-	"updated_by\x18\v \x01(\tR\tupdatedBy\"\xe0\x01\n" +
-	"\x11CreateFileRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
-	"\x04file\x18\x02 \x01(\tR\x04file\x12(\n" +
-	"\x06status\x18\x03 \x01(\x0e2\x10.file.FileStatusR\x06status\x12%\n" +
-	"\x05table\x18\x04 \x01(\x0e2\x0f.file.TableTypeR\x05table\x12\x16\n" +
-	"\x06option\x18\x05 \x01(\tR\x06option\x12\x19\n" +
-	"\btable_id\x18\x06 \x01(\tR\atableId\x12\x1d\n" +
-	"\n" +
-	"created_by\x18\a \x01(\tR\tcreatedBy\"4\n" +
-	"\x12CreateFileResponse\x12\x1e\n" +
-	"\x04file\x18\x01 \x01(\v2\n" +
-	".file.FileR\x04file\" \n" +
-	"\x0eGetFileRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
-	"\x0fGetFileResponse\x12\x1e\n" +
-	"\x04file\x18\x01 \x01(\v2\n" +
-	".file.FileR\x04file\"\xce\x02\n" +
-	"\x11UpdateFileRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
-	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x17\n" +
-	"\x04file\x18\x03 \x01(\tH\x01R\x04file\x88\x01\x01\x12-\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x10.file.FileStatusH\x02R\x06status\x88\x01\x01\x12*\n" +
-	"\x05table\x18\x05 \x01(\x0e2\x0f.file.TableTypeH\x03R\x05table\x88\x01\x01\x12\x1b\n" +
-	"\x06option\x18\x06 \x01(\tH\x04R\x06option\x88\x01\x01\x12\x1e\n" +
-	"\btable_id\x18\a \x01(\tH\x05R\atableId\x88\x01\x01\x12\x1d\n" +
-	"\n" +
-	"updated_by\x18\b \x01(\tR\tupdatedByB\b\n" +
-	"\x06_titleB\a\n" +
-	"\x05_fileB\t\n" +
-	"\a_statusB\b\n" +
-	"\x06_tableB\t\n" +
-	"\a_optionB\v\n" +
-	"\t_table_id\"4\n" +
-	"\x12UpdateFileResponse\x12\x1e\n" +
-	"\x04file\x18\x01 \x01(\v2\n" +
-	".file.FileR\x04file\"#\n" +
-	"\x11DeleteFileRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\".\n" +
-	"\x12DeleteFileResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"A\n" +
-	"\x10ListFilesRequest\x12-\n" +
-	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\"|\n" +
-	"\x11ListFilesResponse\x12 \n" +
-	"\x05files\x18\x01 \x03(\v2\n" +
-	".file.FileR\x05files\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
-	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize*:\n" +
+func (x *ListFileVersionsRequest) Reset() {
+	*x = ListFileVersionsRequest{}
+	mi := &file_proto_file_file_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFileVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFileVersionsRequest) ProtoMessage() {}
+
+func (x *ListFileVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_file_file_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFileVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListFileVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_file_file_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListFileVersionsRequest) GetTable() TableType {
+	if x != nil {
+		return x.Table
+	}
+	return TableType_TOPIC
+}
+
+func (x *ListFileVersionsRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ListFileVersionsRequest) GetOption() string {
+	if x != nil {
+		return x.Option
+	}
+	return ""
+}
+
+func (x *ListFileVersionsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ListFileVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Files         []*File                `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"` // newest version first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFileVersionsResponse) Reset() {
+	*x = ListFileVersionsResponse{}
+	mi := &file_proto_file_file_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFileVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFileVersionsResponse) ProtoMessage() {}
+
+func (x *ListFileVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_file_file_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFileVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListFileVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_file_file_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListFileVersionsResponse) GetFiles() []*File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+// ============= Similarity =============
+type IndexFileContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	SemesterCode  string                 `protobuf:"bytes,2,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"` // plain text extracted from the document
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IndexFileContentRequest) Reset() {
+	*x = IndexFileContentRequest{}
+	mi := &file_proto_file_file_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndexFileContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexFileContentRequest) ProtoMessage() {}
+
+func (x *IndexFileContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_file_file_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexFileContentRequest.ProtoReflect.Descriptor instead.
+func (*IndexFileContentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_file_file_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *IndexFileContentRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *IndexFileContentRequest) GetSemesterCode() string {
+	if x != nil {
+		return x.SemesterCode
+	}
+	return ""
+}
+
+func (x *IndexFileContentRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type IndexFileContentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WordCount     int32                  `protobuf:"varint,1,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	ShingleCount  int32                  `protobuf:"varint,2,opt,name=shingle_count,json=shingleCount,proto3" json:"shingle_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IndexFileContentResponse) Reset() {
+	*x = IndexFileContentResponse{}
+	mi := &file_proto_file_file_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndexFileContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexFileContentResponse) ProtoMessage() {}
+
+func (x *IndexFileContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_file_file_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexFileContentResponse.ProtoReflect.Descriptor instead.
+func (*IndexFileContentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_file_file_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *IndexFileContentResponse) GetWordCount() int32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+func (x *IndexFileContentResponse) GetShingleCount() int32 {
+	if x != nil {
+		return x.ShingleCount
+	}
+	return 0
+}
+
+type MatchedPassage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`                                 // word offset in the checked file
+	SourceOffset  int32                  `protobuf:"varint,3,opt,name=source_offset,json=sourceOffset,proto3" json:"source_offset,omitempty"` // word offset in the matched file
+	Words         int32                  `protobuf:"varint,4,opt,name=words,proto3" json:"words,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchedPassage) Reset() {
+	*x = MatchedPassage{}
+	mi := &file_proto_file_file_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchedPassage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchedPassage) ProtoMessage() {}
+
+func (x *MatchedPassage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_file_file_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchedPassage.ProtoReflect.Descriptor instead.
+func (*MatchedPassage) Descriptor() ([]byte, []int) {
+	return file_proto_file_file_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MatchedPassage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *MatchedPassage) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *MatchedPassage) GetSourceOffset() int32 {
+	if x != nil {
+		return x.SourceOffset
+	}
+	return 0
+}
+
+func (x *MatchedPassage) GetWords() int32 {
+	if x != nil {
+		return x.Words
+	}
+	return 0
+}
+
+type SimilarityMatch struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	File           *File                  `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	SemesterCode   string                 `protobuf:"bytes,2,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Similarity     float64                `protobuf:"fixed64,3,opt,name=similarity,proto3" json:"similarity,omitempty"`                               // estimated Jaccard similarity of the shingle sets (0-1)
+	OverlapPercent float64                `protobuf:"fixed64,4,opt,name=overlap_percent,json=overlapPercent,proto3" json:"overlap_percent,omitempty"` // share of the checked file's words found in the matched file
+	Passages       []*MatchedPassage      `protobuf:"bytes,5,rep,name=passages,proto3" json:"passages,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SimilarityMatch) Reset() {
+	*x = SimilarityMatch{}
+	mi := &file_proto_file_file_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimilarityMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarityMatch) ProtoMessage() {}
+
+func (x *SimilarityMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_file_file_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarityMatch.ProtoReflect.Descriptor instead.
+func (*SimilarityMatch) Descriptor() ([]byte, []int) {
+	return file_proto_file_file_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SimilarityMatch) GetFile() *File {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *SimilarityMatch) GetSemesterCode() string {
+	if x != nil {
+		return x.SemesterCode
+	}
+	return ""
+}
+
+func (x *SimilarityMatch) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *SimilarityMatch) GetOverlapPercent() float64 {
+	if x != nil {
+		return x.OverlapPercent
+	}
+	return 0
+}
+
+func (x *SimilarityMatch) GetPassages() []*MatchedPassage {
+	if x != nil {
+		return x.Passages
+	}
+	return nil
+}
+
+type GetSimilarityReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	TopN          int32                  `protobuf:"varint,2,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSimilarityReportRequest) Reset() {
+	*x = GetSimilarityReportRequest{}
+	mi := &file_proto_file_file_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSimilarityReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimilarityReportRequest) ProtoMessage() {}
+
+func (x *GetSimilarityReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_file_file_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimilarityReportRequest.ProtoReflect.Descriptor instead.
+func (*GetSimilarityReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_file_file_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetSimilarityReportRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *GetSimilarityReportRequest) GetTopN() int32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+type GetSimilarityReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Indexed       bool                   `protobuf:"varint,2,opt,name=indexed,proto3" json:"indexed,omitempty"` // false until the file's text has been extracted
+	WordCount     int32                  `protobuf:"varint,3,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	Matches       []*SimilarityMatch     `protobuf:"bytes,4,rep,name=matches,proto3" json:"matches,omitempty"` // most similar first
+	IndexedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=indexed_at,json=indexedAt,proto3" json:"indexed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSimilarityReportResponse) Reset() {
+	*x = GetSimilarityReportResponse{}
+	mi := &file_proto_file_file_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSimilarityReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimilarityReportResponse) ProtoMessage() {}
+
+func (x *GetSimilarityReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_file_file_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimilarityReportResponse.ProtoReflect.Descriptor instead.
+func (*GetSimilarityReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_file_file_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetSimilarityReportResponse) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *GetSimilarityReportResponse) GetIndexed() bool {
+	if x != nil {
+		return x.Indexed
+	}
+	return false
+}
+
+func (x *GetSimilarityReportResponse) GetWordCount() int32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+func (x *GetSimilarityReportResponse) GetMatches() []*SimilarityMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *GetSimilarityReportResponse) GetIndexedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IndexedAt
+	}
+	return nil
+}
+
+type SearchFilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	CreatedBy     *string                `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"` // only files uploaded by this user
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFilesRequest) Reset() {
+	*x = SearchFilesRequest{}
+	mi := &file_proto_file_file_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFilesRequest) ProtoMessage() {}
+
+func (x *SearchFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_file_file_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFilesRequest.ProtoReflect.Descriptor instead.
+func (*SearchFilesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_file_file_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SearchFilesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchFilesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchFilesRequest) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
+}
+
+type SearchFilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*common.SearchHit    `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"` // best first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFilesResponse) Reset() {
+	*x = SearchFilesResponse{}
+	mi := &file_proto_file_file_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFilesResponse) ProtoMessage() {}
+
+func (x *SearchFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_file_file_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFilesResponse.ProtoReflect.Descriptor instead.
+func (*SearchFilesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_file_file_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SearchFilesResponse) GetHits() []*common.SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+// ============= Retention =============
+// Soft-deleted files are purged by the gateway, which owns the MinIO objects:
+// it lists the files deleted longer ago than the retention period, removes
+// their objects and only then purges the rows.
+type ListExpiredFilesRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RetentionSeconds int64                  `protobuf:"varint,1,opt,name=retention_seconds,json=retentionSeconds,proto3" json:"retention_seconds,omitempty"` // deleted at least this long ago
+	Limit            int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListExpiredFilesRequest) Reset() {
+	*x = ListExpiredFilesRequest{}
+	mi := &file_proto_file_file_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpiredFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpiredFilesRequest) ProtoMessage() {}
+
+func (x *ListExpiredFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_file_file_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpiredFilesRequest.ProtoReflect.Descriptor instead.
+func (*ListExpiredFilesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_file_file_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListExpiredFilesRequest) GetRetentionSeconds() int64 {
+	if x != nil {
+		return x.RetentionSeconds
+	}
+	return 0
+}
+
+func (x *ListExpiredFilesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListExpiredFilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Files         []*File                `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"` // oldest deletion first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpiredFilesResponse) Reset() {
+	*x = ListExpiredFilesResponse{}
+	mi := &file_proto_file_file_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpiredFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpiredFilesResponse) ProtoMessage() {}
+
+func (x *ListExpiredFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_file_file_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpiredFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListExpiredFilesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_file_file_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListExpiredFilesResponse) GetFiles() []*File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type ClaimFilePurgeRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RetentionSeconds int64                  `protobuf:"varint,2,opt,name=retention_seconds,json=retentionSeconds,proto3" json:"retention_seconds,omitempty"` // refused unless still deleted this long ago
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ClaimFilePurgeRequest) Reset() {
+	*x = ClaimFilePurgeRequest{}
+	mi := &file_proto_file_file_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimFilePurgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimFilePurgeRequest) ProtoMessage() {}
+
+func (x *ClaimFilePurgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_file_file_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimFilePurgeRequest.ProtoReflect.Descriptor instead.
+func (*ClaimFilePurgeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_file_file_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ClaimFilePurgeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClaimFilePurgeRequest) GetRetentionSeconds() int64 {
+	if x != nil {
+		return x.RetentionSeconds
+	}
+	return 0
+}
+
+type ClaimFilePurgeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimFilePurgeResponse) Reset() {
+	*x = ClaimFilePurgeResponse{}
+	mi := &file_proto_file_file_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimFilePurgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimFilePurgeResponse) ProtoMessage() {}
+
+func (x *ClaimFilePurgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_file_file_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimFilePurgeResponse.ProtoReflect.Descriptor instead.
+func (*ClaimFilePurgeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_file_file_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ClaimFilePurgeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type PurgeFileRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RetentionSeconds int64                  `protobuf:"varint,2,opt,name=retention_seconds,json=retentionSeconds,proto3" json:"retention_seconds,omitempty"` // refused unless still deleted this long ago and claimed
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PurgeFileRequest) Reset() {
+	*x = PurgeFileRequest{}
+	mi := &file_proto_file_file_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeFileRequest) ProtoMessage() {}
+
+func (x *PurgeFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_file_file_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeFileRequest.ProtoReflect.Descriptor instead.
+func (*PurgeFileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_file_file_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PurgeFileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PurgeFileRequest) GetRetentionSeconds() int64 {
+	if x != nil {
+		return x.RetentionSeconds
+	}
+	return 0
+}
+
+type PurgeFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeFileResponse) Reset() {
+	*x = PurgeFileResponse{}
+	mi := &file_proto_file_file_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeFileResponse) ProtoMessage() {}
+
+func (x *PurgeFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_file_file_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeFileResponse.ProtoReflect.Descriptor instead.
+func (*PurgeFileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_file_file_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PurgeFileResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+var File_proto_file_file_proto protoreflect.FileDescriptor
+
+const file_proto_file_file_proto_rawDesc = "" +
+	"\n" +
+	"\x15proto/file/file.proto\x12\x04file\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19proto/common/common.proto\"\xb2\x05\n" +
+	"\x04File\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04file\x18\x03 \x01(\tR\x04file\x12(\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x10.file.FileStatusR\x06status\x12%\n" +
+	"\x05table\x18\x05 \x01(\x0e2\x0f.file.TableTypeR\x05table\x12\x16\n" +
+	"\x06option\x18\x06 \x01(\tR\x06option\x12\x19\n" +
+	"\btable_id\x18\a \x01(\tR\atableId\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\v \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\f \x01(\x05R\aversion\x12\x12\n" +
+	"\x04size\x18\r \x01(\x03R\x04size\x12\x1a\n" +
+	"\bchecksum\x18\x0e \x01(\tR\bchecksum\x12!\n" +
+	"\fcontent_type\x18\x0f \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04late\x18\x10 \x01(\bR\x04late\x12 \n" +
+	"\vquarantined\x18\x11 \x01(\bR\vquarantined\x12\x1f\n" +
+	"\vscan_result\x18\x12 \x01(\tR\n" +
+	"scanResult\x12\x1a\n" +
+	"\brevision\x18\x13 \x01(\x05R\brevision\x129\n" +
+	"\n" +
+	"deleted_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x15 \x01(\tR\tdeletedBy\"\x8a\x03\n" +
+	"\x11CreateFileRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04file\x18\x02 \x01(\tR\x04file\x12(\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x10.file.FileStatusR\x06status\x12%\n" +
+	"\x05table\x18\x04 \x01(\x0e2\x0f.file.TableTypeR\x05table\x12\x16\n" +
+	"\x06option\x18\x05 \x01(\tR\x06option\x12\x19\n" +
+	"\btable_id\x18\x06 \x01(\tR\atableId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\x12\x12\n" +
+	"\x04size\x18\b \x01(\x03R\x04size\x12\x1a\n" +
+	"\bchecksum\x18\t \x01(\tR\bchecksum\x12!\n" +
+	"\fcontent_type\x18\n" +
+	" \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04late\x18\v \x01(\bR\x04late\x12 \n" +
+	"\vquarantined\x18\f \x01(\bR\vquarantined\x12\x1f\n" +
+	"\vscan_result\x18\r \x01(\tR\n" +
+	"scanResult\"4\n" +
+	"\x12CreateFileResponse\x12\x1e\n" +
+	"\x04file\x18\x01 \x01(\v2\n" +
+	".file.FileR\x04file\"I\n" +
+	"\x0eGetFileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"1\n" +
+	"\x0fGetFileResponse\x12\x1e\n" +
+	"\x04file\x18\x01 \x01(\v2\n" +
+	".file.FileR\x04file\"\xe9\x03\n" +
+	"\x11UpdateFileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x17\n" +
+	"\x04file\x18\x03 \x01(\tH\x01R\x04file\x88\x01\x01\x12-\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x10.file.FileStatusH\x02R\x06status\x88\x01\x01\x12*\n" +
+	"\x05table\x18\x05 \x01(\x0e2\x0f.file.TableTypeH\x03R\x05table\x88\x01\x01\x12\x1b\n" +
+	"\x06option\x18\x06 \x01(\tH\x04R\x06option\x88\x01\x01\x12\x1e\n" +
+	"\btable_id\x18\a \x01(\tH\x05R\atableId\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\b \x01(\tR\tupdatedBy\x12%\n" +
+	"\vquarantined\x18\t \x01(\bH\x06R\vquarantined\x88\x01\x01\x12$\n" +
+	"\vscan_result\x18\n" +
+	" \x01(\tH\aR\n" +
+	"scanResult\x88\x01\x01\x12\x1f\n" +
+	"\brevision\x18\v \x01(\x05H\bR\brevision\x88\x01\x01B\b\n" +
+	"\x06_titleB\a\n" +
+	"\x05_fileB\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_tableB\t\n" +
+	"\a_optionB\v\n" +
+	"\t_table_idB\x0e\n" +
+	"\f_quarantinedB\x0e\n" +
+	"\f_scan_resultB\v\n" +
+	"\t_revision\"4\n" +
+	"\x12UpdateFileResponse\x12\x1e\n" +
+	"\x04file\x18\x01 \x01(\v2\n" +
+	".file.FileR\x04file\"B\n" +
+	"\x11DeleteFileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\".\n" +
+	"\x12DeleteFileResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"E\n" +
+	"\x12RestoreFileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrestored_by\x18\x02 \x01(\tR\n" +
+	"restoredBy\"5\n" +
+	"\x13RestoreFileResponse\x12\x1e\n" +
+	"\x04file\x18\x01 \x01(\v2\n" +
+	".file.FileR\x04file\"j\n" +
+	"\x10ListFilesRequest\x12-\n" +
+	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"|\n" +
+	"\x11ListFilesResponse\x12 \n" +
+	"\x05files\x18\x01 \x03(\v2\n" +
+	".file.FileR\x05files\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x9c\x01\n" +
+	"\x17ListFileVersionsRequest\x12%\n" +
+	"\x05table\x18\x01 \x01(\x0e2\x0f.file.TableTypeR\x05table\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\x12\x16\n" +
+	"\x06option\x18\x03 \x01(\tR\x06option\x12'\n" +
+	"\x0finclude_deleted\x18\x04 \x01(\bR\x0eincludeDeleted\"<\n" +
+	"\x18ListFileVersionsResponse\x12 \n" +
+	"\x05files\x18\x01 \x03(\v2\n" +
+	".file.FileR\x05files\"k\n" +
+	"\x17IndexFileContentRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12#\n" +
+	"\rsemester_code\x18\x02 \x01(\tR\fsemesterCode\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"^\n" +
+	"\x18IndexFileContentResponse\x12\x1d\n" +
+	"\n" +
+	"word_count\x18\x01 \x01(\x05R\twordCount\x12#\n" +
+	"\rshingle_count\x18\x02 \x01(\x05R\fshingleCount\"w\n" +
+	"\x0eMatchedPassage\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12#\n" +
+	"\rsource_offset\x18\x03 \x01(\x05R\fsourceOffset\x12\x14\n" +
+	"\x05words\x18\x04 \x01(\x05R\x05words\"\xd1\x01\n" +
+	"\x0fSimilarityMatch\x12\x1e\n" +
+	"\x04file\x18\x01 \x01(\v2\n" +
+	".file.FileR\x04file\x12#\n" +
+	"\rsemester_code\x18\x02 \x01(\tR\fsemesterCode\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x03 \x01(\x01R\n" +
+	"similarity\x12'\n" +
+	"\x0foverlap_percent\x18\x04 \x01(\x01R\x0eoverlapPercent\x120\n" +
+	"\bpassages\x18\x05 \x03(\v2\x14.file.MatchedPassageR\bpassages\"J\n" +
+	"\x1aGetSimilarityReportRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x13\n" +
+	"\x05top_n\x18\x02 \x01(\x05R\x04topN\"\xdb\x01\n" +
+	"\x1bGetSimilarityReportResponse\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x18\n" +
+	"\aindexed\x18\x02 \x01(\bR\aindexed\x12\x1d\n" +
+	"\n" +
+	"word_count\x18\x03 \x01(\x05R\twordCount\x12/\n" +
+	"\amatches\x18\x04 \x03(\v2\x15.file.SimilarityMatchR\amatches\x129\n" +
+	"\n" +
+	"indexed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tindexedAt\"s\n" +
+	"\x12SearchFilesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\"\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tH\x00R\tcreatedBy\x88\x01\x01B\r\n" +
+	"\v_created_by\"<\n" +
+	"\x13SearchFilesResponse\x12%\n" +
+	"\x04hits\x18\x01 \x03(\v2\x11.common.SearchHitR\x04hits\"\\\n" +
+	"\x17ListExpiredFilesRequest\x12+\n" +
+	"\x11retention_seconds\x18\x01 \x01(\x03R\x10retentionSeconds\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"<\n" +
+	"\x18ListExpiredFilesResponse\x12 \n" +
+	"\x05files\x18\x01 \x03(\v2\n" +
+	".file.FileR\x05files\"T\n" +
+	"\x15ClaimFilePurgeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
+	"\x11retention_seconds\x18\x02 \x01(\x03R\x10retentionSeconds\"2\n" +
+	"\x16ClaimFilePurgeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"O\n" +
+	"\x10PurgeFileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
+	"\x11retention_seconds\x18\x02 \x01(\x03R\x10retentionSeconds\"-\n" +
+	"\x11PurgeFileResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess*:\n" +
 	"\n" +
 	"FileStatus\x12\x10\n" +
 	"\fFILE_PENDING\x10\x00\x12\f\n" +
 	"\bAPPROVED\x10\x01\x12\f\n" +
-	"\bREJECTED\x10\x02*9\n" +
+	"\bREJECTED\x10\x02*b\n" +
 	"\tTableType\x12\t\n" +
 	"\x05TOPIC\x10\x00\x12\v\n" +
 	"\aMIDTERM\x10\x01\x12\t\n" +
 	"\x05FINAL\x10\x02\x12\t\n" +
-	"\x05ORDER\x10\x032\xc6\x02\n" +
+	"\x05ORDER\x10\x03\x12\x10\n" +
+	"\fGRADE_APPEAL\x10\x04\x12\x15\n" +
+	"\x11MILESTONE_CHECKIN\x10\x052\xae\a\n" +
 	"\vFileService\x12?\n" +
 	"\n" +
 	"CreateFile\x12\x17.file.CreateFileRequest\x1a\x18.file.CreateFileResponse\x126\n" +
@@ -904,8 +2183,16 @@ const file_proto_file_file_proto_rawDesc = "" +
 	"\n" +
 	"UpdateFile\x12\x17.file.UpdateFileRequest\x1a\x18.file.UpdateFileResponse\x12?\n" +
 	"\n" +
-	"DeleteFile\x12\x17.file.DeleteFileRequest\x1a\x18.file.DeleteFileResponse\x12<\n" +
-	"\tListFiles\x12\x16.file.ListFilesRequest\x1a\x17.file.ListFilesResponseB\bZ\x06./fileb\x06proto3"
+	"DeleteFile\x12\x17.file.DeleteFileRequest\x1a\x18.file.DeleteFileResponse\x12B\n" +
+	"\vRestoreFile\x12\x18.file.RestoreFileRequest\x1a\x19.file.RestoreFileResponse\x12<\n" +
+	"\tListFiles\x12\x16.file.ListFilesRequest\x1a\x17.file.ListFilesResponse\x12Q\n" +
+	"\x10ListFileVersions\x12\x1d.file.ListFileVersionsRequest\x1a\x1e.file.ListFileVersionsResponse\x12Q\n" +
+	"\x10IndexFileContent\x12\x1d.file.IndexFileContentRequest\x1a\x1e.file.IndexFileContentResponse\x12Z\n" +
+	"\x13GetSimilarityReport\x12 .file.GetSimilarityReportRequest\x1a!.file.GetSimilarityReportResponse\x12B\n" +
+	"\vSearchFiles\x12\x18.file.SearchFilesRequest\x1a\x19.file.SearchFilesResponse\x12Q\n" +
+	"\x10ListExpiredFiles\x12\x1d.file.ListExpiredFilesRequest\x1a\x1e.file.ListExpiredFilesResponse\x12K\n" +
+	"\x0eClaimFilePurge\x12\x1b.file.ClaimFilePurgeRequest\x1a\x1c.file.ClaimFilePurgeResponse\x12<\n" +
+	"\tPurgeFile\x12\x16.file.PurgeFileRequest\x1a\x17.file.PurgeFileResponseB\bZ\x06./fileb\x06proto3"
 
 var (
 	file_proto_file_file_proto_rawDescOnce sync.Once
@@ -920,53 +2207,98 @@ func file_proto_file_file_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_file_file_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_file_file_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_file_file_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_proto_file_file_proto_goTypes = []any{
-	(FileStatus)(0),               // 0: file.FileStatus
-	(TableType)(0),                // 1: file.TableType
-	(*File)(nil),                  // 2: file.File
-	(*CreateFileRequest)(nil),     // 3: file.CreateFileRequest
-	(*CreateFileResponse)(nil),    // 4: file.CreateFileResponse
-	(*GetFileRequest)(nil),        // 5: file.GetFileRequest
-	(*GetFileResponse)(nil),       // 6: file.GetFileResponse
-	(*UpdateFileRequest)(nil),     // 7: file.UpdateFileRequest
-	(*UpdateFileResponse)(nil),    // 8: file.UpdateFileResponse
-	(*DeleteFileRequest)(nil),     // 9: file.DeleteFileRequest
-	(*DeleteFileResponse)(nil),    // 10: file.DeleteFileResponse
-	(*ListFilesRequest)(nil),      // 11: file.ListFilesRequest
-	(*ListFilesResponse)(nil),     // 12: file.ListFilesResponse
-	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
-	(*common.SearchRequest)(nil),  // 14: common.SearchRequest
+	(FileStatus)(0),                     // 0: file.FileStatus
+	(TableType)(0),                      // 1: file.TableType
+	(*File)(nil),                        // 2: file.File
+	(*CreateFileRequest)(nil),           // 3: file.CreateFileRequest
+	(*CreateFileResponse)(nil),          // 4: file.CreateFileResponse
+	(*GetFileRequest)(nil),              // 5: file.GetFileRequest
+	(*GetFileResponse)(nil),             // 6: file.GetFileResponse
+	(*UpdateFileRequest)(nil),           // 7: file.UpdateFileRequest
+	(*UpdateFileResponse)(nil),          // 8: file.UpdateFileResponse
+	(*DeleteFileRequest)(nil),           // 9: file.DeleteFileRequest
+	(*DeleteFileResponse)(nil),          // 10: file.DeleteFileResponse
+	(*RestoreFileRequest)(nil),          // 11: file.RestoreFileRequest
+	(*RestoreFileResponse)(nil),         // 12: file.RestoreFileResponse
+	(*ListFilesRequest)(nil),            // 13: file.ListFilesRequest
+	(*ListFilesResponse)(nil),           // 14: file.ListFilesResponse
+	(*ListFileVersionsRequest)(nil),     // 15: file.ListFileVersionsRequest
+	(*ListFileVersionsResponse)(nil),    // 16: file.ListFileVersionsResponse
+	(*IndexFileContentRequest)(nil),     // 17: file.IndexFileContentRequest
+	(*IndexFileContentResponse)(nil),    // 18: file.IndexFileContentResponse
+	(*MatchedPassage)(nil),              // 19: file.MatchedPassage
+	(*SimilarityMatch)(nil),             // 20: file.SimilarityMatch
+	(*GetSimilarityReportRequest)(nil),  // 21: file.GetSimilarityReportRequest
+	(*GetSimilarityReportResponse)(nil), // 22: file.GetSimilarityReportResponse
+	(*SearchFilesRequest)(nil),          // 23: file.SearchFilesRequest
+	(*SearchFilesResponse)(nil),         // 24: file.SearchFilesResponse
+	(*ListExpiredFilesRequest)(nil),     // 25: file.ListExpiredFilesRequest
+	(*ListExpiredFilesResponse)(nil),    // 26: file.ListExpiredFilesResponse
+	(*ClaimFilePurgeRequest)(nil),       // 27: file.ClaimFilePurgeRequest
+	(*ClaimFilePurgeResponse)(nil),      // 28: file.ClaimFilePurgeResponse
+	(*PurgeFileRequest)(nil),            // 29: file.PurgeFileRequest
+	(*PurgeFileResponse)(nil),           // 30: file.PurgeFileResponse
+	(*timestamppb.Timestamp)(nil),       // 31: google.protobuf.Timestamp
+	(*common.SearchRequest)(nil),        // 32: common.SearchRequest
+	(*common.SearchHit)(nil),            // 33: common.SearchHit
 }
 var file_proto_file_file_proto_depIdxs = []int32{
 	0,  // 0: file.File.status:type_name -> file.FileStatus
 	1,  // 1: file.File.table:type_name -> file.TableType
-	13, // 2: file.File.created_at:type_name -> google.protobuf.Timestamp
-	13, // 3: file.File.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 4: file.CreateFileRequest.status:type_name -> file.FileStatus
-	1,  // 5: file.CreateFileRequest.table:type_name -> file.TableType
-	2,  // 6: file.CreateFileResponse.file:type_name -> file.File
-	2,  // 7: file.GetFileResponse.file:type_name -> file.File
-	0,  // 8: file.UpdateFileRequest.status:type_name -> file.FileStatus
-	1,  // 9: file.UpdateFileRequest.table:type_name -> file.TableType
-	2,  // 10: file.UpdateFileResponse.file:type_name -> file.File
-	14, // 11: file.ListFilesRequest.search:type_name -> common.SearchRequest
-	2,  // 12: file.ListFilesResponse.files:type_name -> file.File
-	3,  // 13: file.FileService.CreateFile:input_type -> file.CreateFileRequest
-	5,  // 14: file.FileService.GetFile:input_type -> file.GetFileRequest
-	7,  // 15: file.FileService.UpdateFile:input_type -> file.UpdateFileRequest
-	9,  // 16: file.FileService.DeleteFile:input_type -> file.DeleteFileRequest
-	11, // 17: file.FileService.ListFiles:input_type -> file.ListFilesRequest
-	4,  // 18: file.FileService.CreateFile:output_type -> file.CreateFileResponse
-	6,  // 19: file.FileService.GetFile:output_type -> file.GetFileResponse
-	8,  // 20: file.FileService.UpdateFile:output_type -> file.UpdateFileResponse
-	10, // 21: file.FileService.DeleteFile:output_type -> file.DeleteFileResponse
-	12, // 22: file.FileService.ListFiles:output_type -> file.ListFilesResponse
-	18, // [18:23] is the sub-list for method output_type
-	13, // [13:18] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	31, // 2: file.File.created_at:type_name -> google.protobuf.Timestamp
+	31, // 3: file.File.updated_at:type_name -> google.protobuf.Timestamp
+	31, // 4: file.File.deleted_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: file.CreateFileRequest.status:type_name -> file.FileStatus
+	1,  // 6: file.CreateFileRequest.table:type_name -> file.TableType
+	2,  // 7: file.CreateFileResponse.file:type_name -> file.File
+	2,  // 8: file.GetFileResponse.file:type_name -> file.File
+	0,  // 9: file.UpdateFileRequest.status:type_name -> file.FileStatus
+	1,  // 10: file.UpdateFileRequest.table:type_name -> file.TableType
+	2,  // 11: file.UpdateFileResponse.file:type_name -> file.File
+	2,  // 12: file.RestoreFileResponse.file:type_name -> file.File
+	32, // 13: file.ListFilesRequest.search:type_name -> common.SearchRequest
+	2,  // 14: file.ListFilesResponse.files:type_name -> file.File
+	1,  // 15: file.ListFileVersionsRequest.table:type_name -> file.TableType
+	2,  // 16: file.ListFileVersionsResponse.files:type_name -> file.File
+	2,  // 17: file.SimilarityMatch.file:type_name -> file.File
+	19, // 18: file.SimilarityMatch.passages:type_name -> file.MatchedPassage
+	20, // 19: file.GetSimilarityReportResponse.matches:type_name -> file.SimilarityMatch
+	31, // 20: file.GetSimilarityReportResponse.indexed_at:type_name -> google.protobuf.Timestamp
+	33, // 21: file.SearchFilesResponse.hits:type_name -> common.SearchHit
+	2,  // 22: file.ListExpiredFilesResponse.files:type_name -> file.File
+	3,  // 23: file.FileService.CreateFile:input_type -> file.CreateFileRequest
+	5,  // 24: file.FileService.GetFile:input_type -> file.GetFileRequest
+	7,  // 25: file.FileService.UpdateFile:input_type -> file.UpdateFileRequest
+	9,  // 26: file.FileService.DeleteFile:input_type -> file.DeleteFileRequest
+	11, // 27: file.FileService.RestoreFile:input_type -> file.RestoreFileRequest
+	13, // 28: file.FileService.ListFiles:input_type -> file.ListFilesRequest
+	15, // 29: file.FileService.ListFileVersions:input_type -> file.ListFileVersionsRequest
+	17, // 30: file.FileService.IndexFileContent:input_type -> file.IndexFileContentRequest
+	21, // 31: file.FileService.GetSimilarityReport:input_type -> file.GetSimilarityReportRequest
+	23, // 32: file.FileService.SearchFiles:input_type -> file.SearchFilesRequest
+	25, // 33: file.FileService.ListExpiredFiles:input_type -> file.ListExpiredFilesRequest
+	27, // 34: file.FileService.ClaimFilePurge:input_type -> file.ClaimFilePurgeRequest
+	29, // 35: file.FileService.PurgeFile:input_type -> file.PurgeFileRequest
+	4,  // 36: file.FileService.CreateFile:output_type -> file.CreateFileResponse
+	6,  // 37: file.FileService.GetFile:output_type -> file.GetFileResponse
+	8,  // 38: file.FileService.UpdateFile:output_type -> file.UpdateFileResponse
+	10, // 39: file.FileService.DeleteFile:output_type -> file.DeleteFileResponse
+	12, // 40: file.FileService.RestoreFile:output_type -> file.RestoreFileResponse
+	14, // 41: file.FileService.ListFiles:output_type -> file.ListFilesResponse
+	16, // 42: file.FileService.ListFileVersions:output_type -> file.ListFileVersionsResponse
+	18, // 43: file.FileService.IndexFileContent:output_type -> file.IndexFileContentResponse
+	22, // 44: file.FileService.GetSimilarityReport:output_type -> file.GetSimilarityReportResponse
+	24, // 45: file.FileService.SearchFiles:output_type -> file.SearchFilesResponse
+	26, // 46: file.FileService.ListExpiredFiles:output_type -> file.ListExpiredFilesResponse
+	28, // 47: file.FileService.ClaimFilePurge:output_type -> file.ClaimFilePurgeResponse
+	30, // 48: file.FileService.PurgeFile:output_type -> file.PurgeFileResponse
+	36, // [36:49] is the sub-list for method output_type
+	23, // [23:36] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_proto_file_file_proto_init() }
@@ -975,13 +2307,14 @@ func file_proto_file_file_proto_init() {
 		return
 	}
 	file_proto_file_file_proto_msgTypes[5].OneofWrappers = []any{}
+	file_proto_file_file_proto_msgTypes[21].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_file_file_proto_rawDesc), len(file_proto_file_file_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   11,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,11 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FileService_CreateFile_FullMethodName = "/file.FileService/CreateFile"
-	FileService_GetFile_FullMethodName    = "/file.FileService/GetFile"
-	FileService_UpdateFile_FullMethodName = "/file.FileService/UpdateFile"
-	FileService_DeleteFile_FullMethodName = "/file.FileService/DeleteFile"
-	FileService_ListFiles_FullMethodName  = "/file.FileService/ListFiles"
+	FileService_CreateFile_FullMethodName          = "/file.FileService/CreateFile"
+	FileService_GetFile_FullMethodName             = "/file.FileService/GetFile"
+	FileService_UpdateFile_FullMethodName          = "/file.FileService/UpdateFile"
+	FileService_DeleteFile_FullMethodName          = "/file.FileService/DeleteFile"
+	FileService_RestoreFile_FullMethodName         = "/file.FileService/RestoreFile"
+	FileService_ListFiles_FullMethodName           = "/file.FileService/ListFiles"
+	FileService_ListFileVersions_FullMethodName    = "/file.FileService/ListFileVersions"
+	FileService_IndexFileContent_FullMethodName    = "/file.FileService/IndexFileContent"
+	FileService_GetSimilarityReport_FullMethodName = "/file.FileService/GetSimilarityReport"
+	FileService_SearchFiles_FullMethodName         = "/file.FileService/SearchFiles"
+	FileService_ListExpiredFiles_FullMethodName    = "/file.FileService/ListExpiredFiles"
+	FileService_ClaimFilePurge_FullMethodName      = "/file.FileService/ClaimFilePurge"
+	FileService_PurgeFile_FullMethodName           = "/file.FileService/PurgeFile"
 )
 
 // FileServiceClient is the client API for FileService service.
@@ -36,7 +44,15 @@ type FileServiceClient interface {
 	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*GetFileResponse, error)
 	UpdateFile(ctx context.Context, in *UpdateFileRequest, opts ...grpc.CallOption) (*UpdateFileResponse, error)
 	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*DeleteFileResponse, error)
+	RestoreFile(ctx context.Context, in *RestoreFileRequest, opts ...grpc.CallOption) (*RestoreFileResponse, error)
 	ListFiles(ctx context.Context, in *ListFilesRequest, opts ...grpc.CallOption) (*ListFilesResponse, error)
+	ListFileVersions(ctx context.Context, in *ListFileVersionsRequest, opts ...grpc.CallOption) (*ListFileVersionsResponse, error)
+	IndexFileContent(ctx context.Context, in *IndexFileContentRequest, opts ...grpc.CallOption) (*IndexFileContentResponse, error)
+	GetSimilarityReport(ctx context.Context, in *GetSimilarityReportRequest, opts ...grpc.CallOption) (*GetSimilarityReportResponse, error)
+	SearchFiles(ctx context.Context, in *SearchFilesRequest, opts ...grpc.CallOption) (*SearchFilesResponse, error)
+	ListExpiredFiles(ctx context.Context, in *ListExpiredFilesRequest, opts ...grpc.CallOption) (*ListExpiredFilesResponse, error)
+	ClaimFilePurge(ctx context.Context, in *ClaimFilePurgeRequest, opts ...grpc.CallOption) (*ClaimFilePurgeResponse, error)
+	PurgeFile(ctx context.Context, in *PurgeFileRequest, opts ...grpc.CallOption) (*PurgeFileResponse, error)
 }
 
 type fileServiceClient struct {
@@ -87,6 +103,16 @@ func (c *fileServiceClient) DeleteFile(ctx context.Context, in *DeleteFileReques
 	return out, nil
 }
 
+func (c *fileServiceClient) RestoreFile(ctx context.Context, in *RestoreFileRequest, opts ...grpc.CallOption) (*RestoreFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreFileResponse)
+	err := c.cc.Invoke(ctx, FileService_RestoreFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fileServiceClient) ListFiles(ctx context.Context, in *ListFilesRequest, opts ...grpc.CallOption) (*ListFilesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListFilesResponse)
@@ -97,6 +123,76 @@ func (c *fileServiceClient) ListFiles(ctx context.Context, in *ListFilesRequest,
 	return out, nil
 }
 
+func (c *fileServiceClient) ListFileVersions(ctx context.Context, in *ListFileVersionsRequest, opts ...grpc.CallOption) (*ListFileVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFileVersionsResponse)
+	err := c.cc.Invoke(ctx, FileService_ListFileVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) IndexFileContent(ctx context.Context, in *IndexFileContentRequest, opts ...grpc.CallOption) (*IndexFileContentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IndexFileContentResponse)
+	err := c.cc.Invoke(ctx, FileService_IndexFileContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) GetSimilarityReport(ctx context.Context, in *GetSimilarityReportRequest, opts ...grpc.CallOption) (*GetSimilarityReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSimilarityReportResponse)
+	err := c.cc.Invoke(ctx, FileService_GetSimilarityReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) SearchFiles(ctx context.Context, in *SearchFilesRequest, opts ...grpc.CallOption) (*SearchFilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchFilesResponse)
+	err := c.cc.Invoke(ctx, FileService_SearchFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) ListExpiredFiles(ctx context.Context, in *ListExpiredFilesRequest, opts ...grpc.CallOption) (*ListExpiredFilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExpiredFilesResponse)
+	err := c.cc.Invoke(ctx, FileService_ListExpiredFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) ClaimFilePurge(ctx context.Context, in *ClaimFilePurgeRequest, opts ...grpc.CallOption) (*ClaimFilePurgeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimFilePurgeResponse)
+	err := c.cc.Invoke(ctx, FileService_ClaimFilePurge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) PurgeFile(ctx context.Context, in *PurgeFileRequest, opts ...grpc.CallOption) (*PurgeFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeFileResponse)
+	err := c.cc.Invoke(ctx, FileService_PurgeFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FileServiceServer is the server API for FileService service.
 // All implementations must embed UnimplementedFileServiceServer
 // for forward compatibility.
@@ -107,7 +203,15 @@ type FileServiceServer interface {
 	GetFile(context.Context, *GetFileRequest) (*GetFileResponse, error)
 	UpdateFile(context.Context, *UpdateFileRequest) (*UpdateFileResponse, error)
 	DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error)
+	RestoreFile(context.Context, *RestoreFileRequest) (*RestoreFileResponse, error)
 	ListFiles(context.Context, *ListFilesRequest) (*ListFilesResponse, error)
+	ListFileVersions(context.Context, *ListFileVersionsRequest) (*ListFileVersionsResponse, error)
+	IndexFileContent(context.Context, *IndexFileContentRequest) (*IndexFileContentResponse, error)
+	GetSimilarityReport(context.Context, *GetSimilarityReportRequest) (*GetSimilarityReportResponse, error)
+	SearchFiles(context.Context, *SearchFilesRequest) (*SearchFilesResponse, error)
+	ListExpiredFiles(context.Context, *ListExpiredFilesRequest) (*ListExpiredFilesResponse, error)
+	ClaimFilePurge(context.Context, *ClaimFilePurgeRequest) (*ClaimFilePurgeResponse, error)
+	PurgeFile(context.Context, *PurgeFileRequest) (*PurgeFileResponse, error)
 	mustEmbedUnimplementedFileServiceServer()
 }
 
@@ -130,9 +234,33 @@ func (UnimplementedFileServiceServer) UpdateFile(context.Context, *UpdateFileReq
 func (UnimplementedFileServiceServer) DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFile not implemented")
 }
+func (UnimplementedFileServiceServer) RestoreFile(context.Context, *RestoreFileRequest) (*RestoreFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreFile not implemented")
+}
 func (UnimplementedFileServiceServer) ListFiles(context.Context, *ListFilesRequest) (*ListFilesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFiles not implemented")
 }
+func (UnimplementedFileServiceServer) ListFileVersions(context.Context, *ListFileVersionsRequest) (*ListFileVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFileVersions not implemented")
+}
+func (UnimplementedFileServiceServer) IndexFileContent(context.Context, *IndexFileContentRequest) (*IndexFileContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexFileContent not implemented")
+}
+func (UnimplementedFileServiceServer) GetSimilarityReport(context.Context, *GetSimilarityReportRequest) (*GetSimilarityReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSimilarityReport not implemented")
+}
+func (UnimplementedFileServiceServer) SearchFiles(context.Context, *SearchFilesRequest) (*SearchFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchFiles not implemented")
+}
+func (UnimplementedFileServiceServer) ListExpiredFiles(context.Context, *ListExpiredFilesRequest) (*ListExpiredFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpiredFiles not implemented")
+}
+func (UnimplementedFileServiceServer) ClaimFilePurge(context.Context, *ClaimFilePurgeRequest) (*ClaimFilePurgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimFilePurge not implemented")
+}
+func (UnimplementedFileServiceServer) PurgeFile(context.Context, *PurgeFileRequest) (*PurgeFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeFile not implemented")
+}
 func (UnimplementedFileServiceServer) mustEmbedUnimplementedFileServiceServer() {}
 func (UnimplementedFileServiceServer) testEmbeddedByValue()                     {}
 
@@ -226,6 +354,24 @@ func _FileService_DeleteFile_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FileService_RestoreFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).RestoreFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_RestoreFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).RestoreFile(ctx, req.(*RestoreFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FileService_ListFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListFilesRequest)
 	if err := dec(in); err != nil {
@@ -244,6 +390,132 @@ func _FileService_ListFiles_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FileService_ListFileVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFileVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).ListFileVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_ListFileVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ListFileVersions(ctx, req.(*ListFileVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_IndexFileContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexFileContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).IndexFileContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_IndexFileContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).IndexFileContent(ctx, req.(*IndexFileContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_GetSimilarityReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSimilarityReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).GetSimilarityReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_GetSimilarityReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).GetSimilarityReport(ctx, req.(*GetSimilarityReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_SearchFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).SearchFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_SearchFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).SearchFiles(ctx, req.(*SearchFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_ListExpiredFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpiredFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).ListExpiredFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_ListExpiredFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ListExpiredFiles(ctx, req.(*ListExpiredFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_ClaimFilePurge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimFilePurgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).ClaimFilePurge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_ClaimFilePurge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ClaimFilePurge(ctx, req.(*ClaimFilePurgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_PurgeFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).PurgeFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_PurgeFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).PurgeFile(ctx, req.(*PurgeFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FileService_ServiceDesc is the grpc.ServiceDesc for FileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,10 +539,42 @@ var FileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFile",
 			Handler:    _FileService_DeleteFile_Handler,
 		},
+		{
+			MethodName: "RestoreFile",
+			Handler:    _FileService_RestoreFile_Handler,
+		},
 		{
 			MethodName: "ListFiles",
 			Handler:    _FileService_ListFiles_Handler,
 		},
+		{
+			MethodName: "ListFileVersions",
+			Handler:    _FileService_ListFileVersions_Handler,
+		},
+		{
+			MethodName: "IndexFileContent",
+			Handler:    _FileService_IndexFileContent_Handler,
+		},
+		{
+			MethodName: "GetSimilarityReport",
+			Handler:    _FileService_GetSimilarityReport_Handler,
+		},
+		{
+			MethodName: "SearchFiles",
+			Handler:    _FileService_SearchFiles_Handler,
+		},
+		{
+			MethodName: "ListExpiredFiles",
+			Handler:    _FileService_ListExpiredFiles_Handler,
+		},
+		{
+			MethodName: "ClaimFilePurge",
+			Handler:    _FileService_ClaimFilePurge_Handler,
+		},
+		{
+			MethodName: "PurgeFile",
+			Handler:    _FileService_PurgeFile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/file/file.proto",
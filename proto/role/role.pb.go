@@ -85,6 +85,9 @@ type RoleSystem struct {
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	CreatedBy     string                 `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	UpdatedBy     string                 `protobuf:"bytes,10,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       int32                  `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`                     // bumped by every update
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // set while the record is soft-deleted
+	DeletedBy     string                 `protobuf:"bytes,13,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -189,6 +192,27 @@ func (x *RoleSystem) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *RoleSystem) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RoleSystem) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *RoleSystem) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
 type CreateRoleSystemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -318,10 +342,11 @@ func (x *CreateRoleSystemResponse) GetRoleSystem() *RoleSystem {
 }
 
 type GetRoleSystemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also find a soft-deleted record
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetRoleSystemRequest) Reset() {
@@ -361,6 +386,13 @@ func (x *GetRoleSystemRequest) GetId() string {
 	return ""
 }
 
+func (x *GetRoleSystemRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type GetRoleSystemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoleSystem    *RoleSystem            `protobuf:"bytes,1,opt,name=role_system,json=roleSystem,proto3" json:"role_system,omitempty"`
@@ -414,6 +446,7 @@ type UpdateRoleSystemRequest struct {
 	SemesterCode  *string                `protobuf:"bytes,5,opt,name=semester_code,json=semesterCode,proto3,oneof" json:"semester_code,omitempty"`
 	Activate      *bool                  `protobuf:"varint,6,opt,name=activate,proto3,oneof" json:"activate,omitempty"`
 	UpdatedBy     string                 `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       *int32                 `protobuf:"varint,8,opt,name=version,proto3,oneof" json:"version,omitempty"` // expected version; a stale one fails with ABORTED
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -497,6 +530,13 @@ func (x *UpdateRoleSystemRequest) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *UpdateRoleSystemRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
 type UpdateRoleSystemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoleSystem    *RoleSystem            `protobuf:"bytes,1,opt,name=role_system,json=roleSystem,proto3" json:"role_system,omitempty"`
@@ -544,6 +584,7 @@ func (x *UpdateRoleSystemResponse) GetRoleSystem() *RoleSystem {
 type DeleteRoleSystemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -585,6 +626,13 @@ func (x *DeleteRoleSystemRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteRoleSystemRequest) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
 type DeleteRoleSystemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -629,16 +677,113 @@ func (x *DeleteRoleSystemResponse) GetSuccess() bool {
 	return false
 }
 
-type ListRoleSystemsRequest struct {
+type RestoreRoleSystemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RestoredBy    string                 `protobuf:"bytes,2,opt,name=restored_by,json=restoredBy,proto3" json:"restored_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *RestoreRoleSystemRequest) Reset() {
+	*x = RestoreRoleSystemRequest{}
+	mi := &file_proto_role_role_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRoleSystemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRoleSystemRequest) ProtoMessage() {}
+
+func (x *RestoreRoleSystemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_role_role_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRoleSystemRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRoleSystemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_role_role_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RestoreRoleSystemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreRoleSystemRequest) GetRestoredBy() string {
+	if x != nil {
+		return x.RestoredBy
+	}
+	return ""
+}
+
+type RestoreRoleSystemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleSystem    *RoleSystem            `protobuf:"bytes,1,opt,name=role_system,json=roleSystem,proto3" json:"role_system,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRoleSystemResponse) Reset() {
+	*x = RestoreRoleSystemResponse{}
+	mi := &file_proto_role_role_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRoleSystemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRoleSystemResponse) ProtoMessage() {}
+
+func (x *RestoreRoleSystemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_role_role_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRoleSystemResponse.ProtoReflect.Descriptor instead.
+func (*RestoreRoleSystemResponse) Descriptor() ([]byte, []int) {
+	return file_proto_role_role_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RestoreRoleSystemResponse) GetRoleSystem() *RoleSystem {
+	if x != nil {
+		return x.RoleSystem
+	}
+	return nil
+}
+
+type ListRoleSystemsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Search         *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also list soft-deleted records
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *ListRoleSystemsRequest) Reset() {
 	*x = ListRoleSystemsRequest{}
-	mi := &file_proto_role_role_proto_msgTypes[9]
+	mi := &file_proto_role_role_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -650,7 +795,7 @@ func (x *ListRoleSystemsRequest) String() string {
 func (*ListRoleSystemsRequest) ProtoMessage() {}
 
 func (x *ListRoleSystemsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_role_role_proto_msgTypes[9]
+	mi := &file_proto_role_role_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -663,7 +808,7 @@ func (x *ListRoleSystemsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRoleSystemsRequest.ProtoReflect.Descriptor instead.
 func (*ListRoleSystemsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_role_role_proto_rawDescGZIP(), []int{9}
+	return file_proto_role_role_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListRoleSystemsRequest) GetSearch() *common.SearchRequest {
@@ -673,6 +818,13 @@ func (x *ListRoleSystemsRequest) GetSearch() *common.SearchRequest {
 	return nil
 }
 
+func (x *ListRoleSystemsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type ListRoleSystemsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoleSystems   []*RoleSystem          `protobuf:"bytes,1,rep,name=role_systems,json=roleSystems,proto3" json:"role_systems,omitempty"`
@@ -685,7 +837,7 @@ type ListRoleSystemsResponse struct {
 
 func (x *ListRoleSystemsResponse) Reset() {
 	*x = ListRoleSystemsResponse{}
-	mi := &file_proto_role_role_proto_msgTypes[10]
+	mi := &file_proto_role_role_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -697,7 +849,7 @@ func (x *ListRoleSystemsResponse) String() string {
 func (*ListRoleSystemsResponse) ProtoMessage() {}
 
 func (x *ListRoleSystemsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_role_role_proto_msgTypes[10]
+	mi := &file_proto_role_role_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -710,7 +862,7 @@ func (x *ListRoleSystemsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRoleSystemsResponse.ProtoReflect.Descriptor instead.
 func (*ListRoleSystemsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_role_role_proto_rawDescGZIP(), []int{10}
+	return file_proto_role_role_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListRoleSystemsResponse) GetRoleSystems() []*RoleSystem {
@@ -745,7 +897,7 @@ var File_proto_role_role_proto protoreflect.FileDescriptor
 
 const file_proto_role_role_proto_rawDesc = "" +
 	"\n" +
-	"\x15proto/role/role.proto\x12\x04role\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19proto/common/common.proto\"\xee\x02\n" +
+	"\x15proto/role/role.proto\x12\x04role\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19proto/common/common.proto\"\xe2\x03\n" +
 	"\n" +
 	"RoleSystem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
@@ -762,7 +914,12 @@ const file_proto_role_role_proto_rawDesc = "" +
 	"created_by\x18\t \x01(\tR\tcreatedBy\x12\x1d\n" +
 	"\n" +
 	"updated_by\x18\n" +
-	" \x01(\tR\tupdatedBy\"\xd6\x01\n" +
+	" \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\v \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\r \x01(\tR\tdeletedBy\"\xd6\x01\n" +
 	"\x17CreateRoleSystemRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12!\n" +
 	"\fteacher_code\x18\x02 \x01(\tR\vteacherCode\x12\"\n" +
@@ -773,12 +930,13 @@ const file_proto_role_role_proto_rawDesc = "" +
 	"created_by\x18\x06 \x01(\tR\tcreatedBy\"M\n" +
 	"\x18CreateRoleSystemResponse\x121\n" +
 	"\vrole_system\x18\x01 \x01(\v2\x10.role.RoleSystemR\n" +
-	"roleSystem\"&\n" +
+	"roleSystem\"O\n" +
 	"\x14GetRoleSystemRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"J\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"J\n" +
 	"\x15GetRoleSystemResponse\x121\n" +
 	"\vrole_system\x18\x01 \x01(\v2\x10.role.RoleSystemR\n" +
-	"roleSystem\"\xc2\x02\n" +
+	"roleSystem\"\xed\x02\n" +
 	"\x17UpdateRoleSystemRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12&\n" +
@@ -787,21 +945,34 @@ const file_proto_role_role_proto_rawDesc = "" +
 	"\rsemester_code\x18\x05 \x01(\tH\x03R\fsemesterCode\x88\x01\x01\x12\x1f\n" +
 	"\bactivate\x18\x06 \x01(\bH\x04R\bactivate\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\a \x01(\tR\tupdatedByB\b\n" +
+	"updated_by\x18\a \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\aversion\x18\b \x01(\x05H\x05R\aversion\x88\x01\x01B\b\n" +
 	"\x06_titleB\x0f\n" +
 	"\r_teacher_codeB\a\n" +
 	"\x05_roleB\x10\n" +
 	"\x0e_semester_codeB\v\n" +
-	"\t_activate\"M\n" +
+	"\t_activateB\n" +
+	"\n" +
+	"\b_version\"M\n" +
 	"\x18UpdateRoleSystemResponse\x121\n" +
 	"\vrole_system\x18\x01 \x01(\v2\x10.role.RoleSystemR\n" +
-	"roleSystem\")\n" +
+	"roleSystem\"H\n" +
 	"\x17DeleteRoleSystemRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\"4\n" +
 	"\x18DeleteRoleSystemResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"G\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"K\n" +
+	"\x18RestoreRoleSystemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrestored_by\x18\x02 \x01(\tR\n" +
+	"restoredBy\"N\n" +
+	"\x19RestoreRoleSystemResponse\x121\n" +
+	"\vrole_system\x18\x01 \x01(\v2\x10.role.RoleSystemR\n" +
+	"roleSystem\"p\n" +
 	"\x16ListRoleSystemsRequest\x12-\n" +
-	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\"\x95\x01\n" +
+	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"\x95\x01\n" +
 	"\x17ListRoleSystemsResponse\x123\n" +
 	"\frole_systems\x18\x01 \x03(\v2\x10.role.RoleSystemR\vroleSystems\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
@@ -810,12 +981,13 @@ const file_proto_role_role_proto_rawDesc = "" +
 	"\bRoleType\x12\x1a\n" +
 	"\x16ACADEMIC_AFFAIRS_STAFF\x10\x00\x12\x17\n" +
 	"\x13DEPARTMENT_LECTURER\x10\x01\x12\v\n" +
-	"\aTEACHER\x10\x022\xa0\x03\n" +
+	"\aTEACHER\x10\x022\xf6\x03\n" +
 	"\vRoleService\x12Q\n" +
 	"\x10CreateRoleSystem\x12\x1d.role.CreateRoleSystemRequest\x1a\x1e.role.CreateRoleSystemResponse\x12H\n" +
 	"\rGetRoleSystem\x12\x1a.role.GetRoleSystemRequest\x1a\x1b.role.GetRoleSystemResponse\x12Q\n" +
 	"\x10UpdateRoleSystem\x12\x1d.role.UpdateRoleSystemRequest\x1a\x1e.role.UpdateRoleSystemResponse\x12Q\n" +
-	"\x10DeleteRoleSystem\x12\x1d.role.DeleteRoleSystemRequest\x1a\x1e.role.DeleteRoleSystemResponse\x12N\n" +
+	"\x10DeleteRoleSystem\x12\x1d.role.DeleteRoleSystemRequest\x1a\x1e.role.DeleteRoleSystemResponse\x12T\n" +
+	"\x11RestoreRoleSystem\x12\x1e.role.RestoreRoleSystemRequest\x1a\x1f.role.RestoreRoleSystemResponse\x12N\n" +
 	"\x0fListRoleSystems\x12\x1c.role.ListRoleSystemsRequest\x1a\x1d.role.ListRoleSystemsResponseB\bZ\x06./roleb\x06proto3"
 
 var (
@@ -831,49 +1003,55 @@ func file_proto_role_role_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_role_role_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_role_role_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_role_role_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_proto_role_role_proto_goTypes = []any{
-	(RoleType)(0),                    // 0: role.RoleType
-	(*RoleSystem)(nil),               // 1: role.RoleSystem
-	(*CreateRoleSystemRequest)(nil),  // 2: role.CreateRoleSystemRequest
-	(*CreateRoleSystemResponse)(nil), // 3: role.CreateRoleSystemResponse
-	(*GetRoleSystemRequest)(nil),     // 4: role.GetRoleSystemRequest
-	(*GetRoleSystemResponse)(nil),    // 5: role.GetRoleSystemResponse
-	(*UpdateRoleSystemRequest)(nil),  // 6: role.UpdateRoleSystemRequest
-	(*UpdateRoleSystemResponse)(nil), // 7: role.UpdateRoleSystemResponse
-	(*DeleteRoleSystemRequest)(nil),  // 8: role.DeleteRoleSystemRequest
-	(*DeleteRoleSystemResponse)(nil), // 9: role.DeleteRoleSystemResponse
-	(*ListRoleSystemsRequest)(nil),   // 10: role.ListRoleSystemsRequest
-	(*ListRoleSystemsResponse)(nil),  // 11: role.ListRoleSystemsResponse
-	(*timestamppb.Timestamp)(nil),    // 12: google.protobuf.Timestamp
-	(*common.SearchRequest)(nil),     // 13: common.SearchRequest
+	(RoleType)(0),                     // 0: role.RoleType
+	(*RoleSystem)(nil),                // 1: role.RoleSystem
+	(*CreateRoleSystemRequest)(nil),   // 2: role.CreateRoleSystemRequest
+	(*CreateRoleSystemResponse)(nil),  // 3: role.CreateRoleSystemResponse
+	(*GetRoleSystemRequest)(nil),      // 4: role.GetRoleSystemRequest
+	(*GetRoleSystemResponse)(nil),     // 5: role.GetRoleSystemResponse
+	(*UpdateRoleSystemRequest)(nil),   // 6: role.UpdateRoleSystemRequest
+	(*UpdateRoleSystemResponse)(nil),  // 7: role.UpdateRoleSystemResponse
+	(*DeleteRoleSystemRequest)(nil),   // 8: role.DeleteRoleSystemRequest
+	(*DeleteRoleSystemResponse)(nil),  // 9: role.DeleteRoleSystemResponse
+	(*RestoreRoleSystemRequest)(nil),  // 10: role.RestoreRoleSystemRequest
+	(*RestoreRoleSystemResponse)(nil), // 11: role.RestoreRoleSystemResponse
+	(*ListRoleSystemsRequest)(nil),    // 12: role.ListRoleSystemsRequest
+	(*ListRoleSystemsResponse)(nil),   // 13: role.ListRoleSystemsResponse
+	(*timestamppb.Timestamp)(nil),     // 14: google.protobuf.Timestamp
+	(*common.SearchRequest)(nil),      // 15: common.SearchRequest
 }
 var file_proto_role_role_proto_depIdxs = []int32{
 	0,  // 0: role.RoleSystem.role:type_name -> role.RoleType
-	12, // 1: role.RoleSystem.created_at:type_name -> google.protobuf.Timestamp
-	12, // 2: role.RoleSystem.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: role.CreateRoleSystemRequest.role:type_name -> role.RoleType
-	1,  // 4: role.CreateRoleSystemResponse.role_system:type_name -> role.RoleSystem
-	1,  // 5: role.GetRoleSystemResponse.role_system:type_name -> role.RoleSystem
-	0,  // 6: role.UpdateRoleSystemRequest.role:type_name -> role.RoleType
-	1,  // 7: role.UpdateRoleSystemResponse.role_system:type_name -> role.RoleSystem
-	13, // 8: role.ListRoleSystemsRequest.search:type_name -> common.SearchRequest
-	1,  // 9: role.ListRoleSystemsResponse.role_systems:type_name -> role.RoleSystem
-	2,  // 10: role.RoleService.CreateRoleSystem:input_type -> role.CreateRoleSystemRequest
-	4,  // 11: role.RoleService.GetRoleSystem:input_type -> role.GetRoleSystemRequest
-	6,  // 12: role.RoleService.UpdateRoleSystem:input_type -> role.UpdateRoleSystemRequest
-	8,  // 13: role.RoleService.DeleteRoleSystem:input_type -> role.DeleteRoleSystemRequest
-	10, // 14: role.RoleService.ListRoleSystems:input_type -> role.ListRoleSystemsRequest
-	3,  // 15: role.RoleService.CreateRoleSystem:output_type -> role.CreateRoleSystemResponse
-	5,  // 16: role.RoleService.GetRoleSystem:output_type -> role.GetRoleSystemResponse
-	7,  // 17: role.RoleService.UpdateRoleSystem:output_type -> role.UpdateRoleSystemResponse
-	9,  // 18: role.RoleService.DeleteRoleSystem:output_type -> role.DeleteRoleSystemResponse
-	11, // 19: role.RoleService.ListRoleSystems:output_type -> role.ListRoleSystemsResponse
-	15, // [15:20] is the sub-list for method output_type
-	10, // [10:15] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	14, // 1: role.RoleSystem.created_at:type_name -> google.protobuf.Timestamp
+	14, // 2: role.RoleSystem.updated_at:type_name -> google.protobuf.Timestamp
+	14, // 3: role.RoleSystem.deleted_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: role.CreateRoleSystemRequest.role:type_name -> role.RoleType
+	1,  // 5: role.CreateRoleSystemResponse.role_system:type_name -> role.RoleSystem
+	1,  // 6: role.GetRoleSystemResponse.role_system:type_name -> role.RoleSystem
+	0,  // 7: role.UpdateRoleSystemRequest.role:type_name -> role.RoleType
+	1,  // 8: role.UpdateRoleSystemResponse.role_system:type_name -> role.RoleSystem
+	1,  // 9: role.RestoreRoleSystemResponse.role_system:type_name -> role.RoleSystem
+	15, // 10: role.ListRoleSystemsRequest.search:type_name -> common.SearchRequest
+	1,  // 11: role.ListRoleSystemsResponse.role_systems:type_name -> role.RoleSystem
+	2,  // 12: role.RoleService.CreateRoleSystem:input_type -> role.CreateRoleSystemRequest
+	4,  // 13: role.RoleService.GetRoleSystem:input_type -> role.GetRoleSystemRequest
+	6,  // 14: role.RoleService.UpdateRoleSystem:input_type -> role.UpdateRoleSystemRequest
+	8,  // 15: role.RoleService.DeleteRoleSystem:input_type -> role.DeleteRoleSystemRequest
+	10, // 16: role.RoleService.RestoreRoleSystem:input_type -> role.RestoreRoleSystemRequest
+	12, // 17: role.RoleService.ListRoleSystems:input_type -> role.ListRoleSystemsRequest
+	3,  // 18: role.RoleService.CreateRoleSystem:output_type -> role.CreateRoleSystemResponse
+	5,  // 19: role.RoleService.GetRoleSystem:output_type -> role.GetRoleSystemResponse
+	7,  // 20: role.RoleService.UpdateRoleSystem:output_type -> role.UpdateRoleSystemResponse
+	9,  // 21: role.RoleService.DeleteRoleSystem:output_type -> role.DeleteRoleSystemResponse
+	11, // 22: role.RoleService.RestoreRoleSystem:output_type -> role.RestoreRoleSystemResponse
+	13, // 23: role.RoleService.ListRoleSystems:output_type -> role.ListRoleSystemsResponse
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_proto_role_role_proto_init() }
@@ -888,7 +1066,7 @@ func file_proto_role_role_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_role_role_proto_rawDesc), len(file_proto_role_role_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,11 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RoleService_CreateRoleSystem_FullMethodName = "/role.RoleService/CreateRoleSystem"
-	RoleService_GetRoleSystem_FullMethodName    = "/role.RoleService/GetRoleSystem"
-	RoleService_UpdateRoleSystem_FullMethodName = "/role.RoleService/UpdateRoleSystem"
-	RoleService_DeleteRoleSystem_FullMethodName = "/role.RoleService/DeleteRoleSystem"
-	RoleService_ListRoleSystems_FullMethodName  = "/role.RoleService/ListRoleSystems"
+	RoleService_CreateRoleSystem_FullMethodName  = "/role.RoleService/CreateRoleSystem"
+	RoleService_GetRoleSystem_FullMethodName     = "/role.RoleService/GetRoleSystem"
+	RoleService_UpdateRoleSystem_FullMethodName  = "/role.RoleService/UpdateRoleSystem"
+	RoleService_DeleteRoleSystem_FullMethodName  = "/role.RoleService/DeleteRoleSystem"
+	RoleService_RestoreRoleSystem_FullMethodName = "/role.RoleService/RestoreRoleSystem"
+	RoleService_ListRoleSystems_FullMethodName   = "/role.RoleService/ListRoleSystems"
 )
 
 // RoleServiceClient is the client API for RoleService service.
@@ -36,6 +37,7 @@ type RoleServiceClient interface {
 	GetRoleSystem(ctx context.Context, in *GetRoleSystemRequest, opts ...grpc.CallOption) (*GetRoleSystemResponse, error)
 	UpdateRoleSystem(ctx context.Context, in *UpdateRoleSystemRequest, opts ...grpc.CallOption) (*UpdateRoleSystemResponse, error)
 	DeleteRoleSystem(ctx context.Context, in *DeleteRoleSystemRequest, opts ...grpc.CallOption) (*DeleteRoleSystemResponse, error)
+	RestoreRoleSystem(ctx context.Context, in *RestoreRoleSystemRequest, opts ...grpc.CallOption) (*RestoreRoleSystemResponse, error)
 	ListRoleSystems(ctx context.Context, in *ListRoleSystemsRequest, opts ...grpc.CallOption) (*ListRoleSystemsResponse, error)
 }
 
@@ -87,6 +89,16 @@ func (c *roleServiceClient) DeleteRoleSystem(ctx context.Context, in *DeleteRole
 	return out, nil
 }
 
+func (c *roleServiceClient) RestoreRoleSystem(ctx context.Context, in *RestoreRoleSystemRequest, opts ...grpc.CallOption) (*RestoreRoleSystemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreRoleSystemResponse)
+	err := c.cc.Invoke(ctx, RoleService_RestoreRoleSystem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *roleServiceClient) ListRoleSystems(ctx context.Context, in *ListRoleSystemsRequest, opts ...grpc.CallOption) (*ListRoleSystemsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListRoleSystemsResponse)
@@ -107,6 +119,7 @@ type RoleServiceServer interface {
 	GetRoleSystem(context.Context, *GetRoleSystemRequest) (*GetRoleSystemResponse, error)
 	UpdateRoleSystem(context.Context, *UpdateRoleSystemRequest) (*UpdateRoleSystemResponse, error)
 	DeleteRoleSystem(context.Context, *DeleteRoleSystemRequest) (*DeleteRoleSystemResponse, error)
+	RestoreRoleSystem(context.Context, *RestoreRoleSystemRequest) (*RestoreRoleSystemResponse, error)
 	ListRoleSystems(context.Context, *ListRoleSystemsRequest) (*ListRoleSystemsResponse, error)
 	mustEmbedUnimplementedRoleServiceServer()
 }
@@ -130,6 +143,9 @@ func (UnimplementedRoleServiceServer) UpdateRoleSystem(context.Context, *UpdateR
 func (UnimplementedRoleServiceServer) DeleteRoleSystem(context.Context, *DeleteRoleSystemRequest) (*DeleteRoleSystemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoleSystem not implemented")
 }
+func (UnimplementedRoleServiceServer) RestoreRoleSystem(context.Context, *RestoreRoleSystemRequest) (*RestoreRoleSystemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRoleSystem not implemented")
+}
 func (UnimplementedRoleServiceServer) ListRoleSystems(context.Context, *ListRoleSystemsRequest) (*ListRoleSystemsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRoleSystems not implemented")
 }
@@ -226,6 +242,24 @@ func _RoleService_DeleteRoleSystem_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoleService_RestoreRoleSystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRoleSystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).RestoreRoleSystem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_RestoreRoleSystem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).RestoreRoleSystem(ctx, req.(*RestoreRoleSystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RoleService_ListRoleSystems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListRoleSystemsRequest)
 	if err := dec(in); err != nil {
@@ -267,6 +301,10 @@ var RoleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRoleSystem",
 			Handler:    _RoleService_DeleteRoleSystem_Handler,
 		},
+		{
+			MethodName: "RestoreRoleSystem",
+			Handler:    _RoleService_RestoreRoleSystem_Handler,
+		},
 		{
 			MethodName: "ListRoleSystems",
 			Handler:    _RoleService_ListRoleSystems_Handler,
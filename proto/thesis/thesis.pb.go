@@ -189,6 +189,159 @@ func (TopicStatus) EnumDescriptor() ([]byte, []int) {
 	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{2}
 }
 
+type CoSignStatus int32
+
+const (
+	CoSignStatus_COSIGN_PENDING  CoSignStatus = 0
+	CoSignStatus_COSIGN_SIGNED   CoSignStatus = 1
+	CoSignStatus_COSIGN_DECLINED CoSignStatus = 2
+)
+
+// Enum value maps for CoSignStatus.
+var (
+	CoSignStatus_name = map[int32]string{
+		0: "COSIGN_PENDING",
+		1: "COSIGN_SIGNED",
+		2: "COSIGN_DECLINED",
+	}
+	CoSignStatus_value = map[string]int32{
+		"COSIGN_PENDING":  0,
+		"COSIGN_SIGNED":   1,
+		"COSIGN_DECLINED": 2,
+	}
+)
+
+func (x CoSignStatus) Enum() *CoSignStatus {
+	p := new(CoSignStatus)
+	*p = x
+	return p
+}
+
+func (x CoSignStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CoSignStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_thesis_thesis_proto_enumTypes[3].Descriptor()
+}
+
+func (CoSignStatus) Type() protoreflect.EnumType {
+	return &file_proto_thesis_thesis_proto_enumTypes[3]
+}
+
+func (x CoSignStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CoSignStatus.Descriptor instead.
+func (CoSignStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{3}
+}
+
+type RegistrationStatus int32
+
+const (
+	RegistrationStatus_REGISTRATION_PENDING   RegistrationStatus = 0
+	RegistrationStatus_REGISTRATION_ACCEPTED  RegistrationStatus = 1
+	RegistrationStatus_REGISTRATION_DECLINED  RegistrationStatus = 2
+	RegistrationStatus_REGISTRATION_WITHDRAWN RegistrationStatus = 3 // another choice of the student was accepted
+)
+
+// Enum value maps for RegistrationStatus.
+var (
+	RegistrationStatus_name = map[int32]string{
+		0: "REGISTRATION_PENDING",
+		1: "REGISTRATION_ACCEPTED",
+		2: "REGISTRATION_DECLINED",
+		3: "REGISTRATION_WITHDRAWN",
+	}
+	RegistrationStatus_value = map[string]int32{
+		"REGISTRATION_PENDING":   0,
+		"REGISTRATION_ACCEPTED":  1,
+		"REGISTRATION_DECLINED":  2,
+		"REGISTRATION_WITHDRAWN": 3,
+	}
+)
+
+func (x RegistrationStatus) Enum() *RegistrationStatus {
+	p := new(RegistrationStatus)
+	*p = x
+	return p
+}
+
+func (x RegistrationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RegistrationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_thesis_thesis_proto_enumTypes[4].Descriptor()
+}
+
+func (RegistrationStatus) Type() protoreflect.EnumType {
+	return &file_proto_thesis_thesis_proto_enumTypes[4]
+}
+
+func (x RegistrationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RegistrationStatus.Descriptor instead.
+func (RegistrationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{4}
+}
+
+type MatchRejectionReason int32
+
+const (
+	MatchRejectionReason_MATCH_OUTRANKED      MatchRejectionReason = 0 // the topic filled up with applicants ranked higher
+	MatchRejectionReason_MATCH_NOT_ACCEPTABLE MatchRejectionReason = 1 // not on the supervisors' acceptance list
+	MatchRejectionReason_MATCH_NO_CAPACITY    MatchRejectionReason = 2 // the topic had no seats left
+	MatchRejectionReason_MATCH_TOPIC_CLOSED   MatchRejectionReason = 3 // the topic is not open for registration
+)
+
+// Enum value maps for MatchRejectionReason.
+var (
+	MatchRejectionReason_name = map[int32]string{
+		0: "MATCH_OUTRANKED",
+		1: "MATCH_NOT_ACCEPTABLE",
+		2: "MATCH_NO_CAPACITY",
+		3: "MATCH_TOPIC_CLOSED",
+	}
+	MatchRejectionReason_value = map[string]int32{
+		"MATCH_OUTRANKED":      0,
+		"MATCH_NOT_ACCEPTABLE": 1,
+		"MATCH_NO_CAPACITY":    2,
+		"MATCH_TOPIC_CLOSED":   3,
+	}
+)
+
+func (x MatchRejectionReason) Enum() *MatchRejectionReason {
+	p := new(MatchRejectionReason)
+	*p = x
+	return p
+}
+
+func (x MatchRejectionReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MatchRejectionReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_thesis_thesis_proto_enumTypes[5].Descriptor()
+}
+
+func (MatchRejectionReason) Type() protoreflect.EnumType {
+	return &file_proto_thesis_thesis_proto_enumTypes[5]
+}
+
+func (x MatchRejectionReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MatchRejectionReason.Descriptor instead.
+func (MatchRejectionReason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{5}
+}
+
 type TopicStage int32
 
 const (
@@ -219,53 +372,8785 @@ func (x TopicStage) String() string {
 }
 
 func (TopicStage) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_thesis_thesis_proto_enumTypes[3].Descriptor()
+	return file_proto_thesis_thesis_proto_enumTypes[6].Descriptor()
 }
 
 func (TopicStage) Type() protoreflect.EnumType {
-	return &file_proto_thesis_thesis_proto_enumTypes[3]
+	return &file_proto_thesis_thesis_proto_enumTypes[6]
+}
+
+func (x TopicStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TopicStage.Descriptor instead.
+func (TopicStage) EnumDescriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{6}
+}
+
+// ============= SubmissionDeadline =============
+type SubmissionKind int32
+
+const (
+	SubmissionKind_SUBMISSION_MIDTERM SubmissionKind = 0
+	SubmissionKind_SUBMISSION_FINAL   SubmissionKind = 1
+)
+
+// Enum value maps for SubmissionKind.
+var (
+	SubmissionKind_name = map[int32]string{
+		0: "SUBMISSION_MIDTERM",
+		1: "SUBMISSION_FINAL",
+	}
+	SubmissionKind_value = map[string]int32{
+		"SUBMISSION_MIDTERM": 0,
+		"SUBMISSION_FINAL":   1,
+	}
+)
+
+func (x SubmissionKind) Enum() *SubmissionKind {
+	p := new(SubmissionKind)
+	*p = x
+	return p
+}
+
+func (x SubmissionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubmissionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_thesis_thesis_proto_enumTypes[7].Descriptor()
+}
+
+func (SubmissionKind) Type() protoreflect.EnumType {
+	return &file_proto_thesis_thesis_proto_enumTypes[7]
+}
+
+func (x SubmissionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubmissionKind.Descriptor instead.
+func (SubmissionKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{7}
+}
+
+// ============= Grading policy =============
+type GradeRounding int32
+
+const (
+	GradeRounding_ROUND_HALF_UP GradeRounding = 0
+	GradeRounding_ROUND_DOWN    GradeRounding = 1
+	GradeRounding_ROUND_UP      GradeRounding = 2
+)
+
+// Enum value maps for GradeRounding.
+var (
+	GradeRounding_name = map[int32]string{
+		0: "ROUND_HALF_UP",
+		1: "ROUND_DOWN",
+		2: "ROUND_UP",
+	}
+	GradeRounding_value = map[string]int32{
+		"ROUND_HALF_UP": 0,
+		"ROUND_DOWN":    1,
+		"ROUND_UP":      2,
+	}
+)
+
+func (x GradeRounding) Enum() *GradeRounding {
+	p := new(GradeRounding)
+	*p = x
+	return p
+}
+
+func (x GradeRounding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GradeRounding) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_thesis_thesis_proto_enumTypes[8].Descriptor()
+}
+
+func (GradeRounding) Type() protoreflect.EnumType {
+	return &file_proto_thesis_thesis_proto_enumTypes[8]
+}
+
+func (x GradeRounding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GradeRounding.Descriptor instead.
+func (GradeRounding) EnumDescriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{8}
+}
+
+type GradeAmendmentTarget int32
+
+const (
+	GradeAmendmentTarget_AMEND_SUPERVISOR_GRADE GradeAmendmentTarget = 0 // Final.supervisor_grade
+	GradeAmendmentTarget_AMEND_REVIEW_GRADE     GradeAmendmentTarget = 1 // Grade_review.review_grade
+	GradeAmendmentTarget_AMEND_MIDTERM_GRADE    GradeAmendmentTarget = 2 // Midterm.grade
+	GradeAmendmentTarget_AMEND_FINAL_GRADE      GradeAmendmentTarget = 3 // Final.final_grade, status follows the pass threshold
+)
+
+// Enum value maps for GradeAmendmentTarget.
+var (
+	GradeAmendmentTarget_name = map[int32]string{
+		0: "AMEND_SUPERVISOR_GRADE",
+		1: "AMEND_REVIEW_GRADE",
+		2: "AMEND_MIDTERM_GRADE",
+		3: "AMEND_FINAL_GRADE",
+	}
+	GradeAmendmentTarget_value = map[string]int32{
+		"AMEND_SUPERVISOR_GRADE": 0,
+		"AMEND_REVIEW_GRADE":     1,
+		"AMEND_MIDTERM_GRADE":    2,
+		"AMEND_FINAL_GRADE":      3,
+	}
+)
+
+func (x GradeAmendmentTarget) Enum() *GradeAmendmentTarget {
+	p := new(GradeAmendmentTarget)
+	*p = x
+	return p
+}
+
+func (x GradeAmendmentTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GradeAmendmentTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_thesis_thesis_proto_enumTypes[9].Descriptor()
+}
+
+func (GradeAmendmentTarget) Type() protoreflect.EnumType {
+	return &file_proto_thesis_thesis_proto_enumTypes[9]
+}
+
+func (x GradeAmendmentTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GradeAmendmentTarget.Descriptor instead.
+func (GradeAmendmentTarget) EnumDescriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{9}
+}
+
+type GradeAmendmentStatus int32
+
+const (
+	GradeAmendmentStatus_AMENDMENT_PENDING  GradeAmendmentStatus = 0
+	GradeAmendmentStatus_AMENDMENT_APPROVED GradeAmendmentStatus = 1
+	GradeAmendmentStatus_AMENDMENT_REJECTED GradeAmendmentStatus = 2
+)
+
+// Enum value maps for GradeAmendmentStatus.
+var (
+	GradeAmendmentStatus_name = map[int32]string{
+		0: "AMENDMENT_PENDING",
+		1: "AMENDMENT_APPROVED",
+		2: "AMENDMENT_REJECTED",
+	}
+	GradeAmendmentStatus_value = map[string]int32{
+		"AMENDMENT_PENDING":  0,
+		"AMENDMENT_APPROVED": 1,
+		"AMENDMENT_REJECTED": 2,
+	}
+)
+
+func (x GradeAmendmentStatus) Enum() *GradeAmendmentStatus {
+	p := new(GradeAmendmentStatus)
+	*p = x
+	return p
+}
+
+func (x GradeAmendmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GradeAmendmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_thesis_thesis_proto_enumTypes[10].Descriptor()
+}
+
+func (GradeAmendmentStatus) Type() protoreflect.EnumType {
+	return &file_proto_thesis_thesis_proto_enumTypes[10]
+}
+
+func (x GradeAmendmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GradeAmendmentStatus.Descriptor instead.
+func (GradeAmendmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{10}
+}
+
+// ============= Grade appeals =============
+// A student contests a published grade within the semester's appeal window.
+// Academic affairs assigns a reviewer, who either confirms the grade or
+// requests a GradeAmendment (or, for the defence, a council
+// GradeDefenceAmendment) whose id is recorded on the appeal. The appeal is
+// amended once academic affairs approves that amendment; a rejected one
+// leaves the appeal assigned.
+type GradeAppealComponent int32
+
+const (
+	GradeAppealComponent_APPEAL_SUPERVISOR_GRADE GradeAppealComponent = 0
+	GradeAppealComponent_APPEAL_REVIEW_GRADE     GradeAppealComponent = 1
+	GradeAppealComponent_APPEAL_MIDTERM_GRADE    GradeAppealComponent = 2
+	GradeAppealComponent_APPEAL_FINAL_GRADE      GradeAppealComponent = 3
+	GradeAppealComponent_APPEAL_DEFENCE          GradeAppealComponent = 4 // Council grade defences
+)
+
+// Enum value maps for GradeAppealComponent.
+var (
+	GradeAppealComponent_name = map[int32]string{
+		0: "APPEAL_SUPERVISOR_GRADE",
+		1: "APPEAL_REVIEW_GRADE",
+		2: "APPEAL_MIDTERM_GRADE",
+		3: "APPEAL_FINAL_GRADE",
+		4: "APPEAL_DEFENCE",
+	}
+	GradeAppealComponent_value = map[string]int32{
+		"APPEAL_SUPERVISOR_GRADE": 0,
+		"APPEAL_REVIEW_GRADE":     1,
+		"APPEAL_MIDTERM_GRADE":    2,
+		"APPEAL_FINAL_GRADE":      3,
+		"APPEAL_DEFENCE":          4,
+	}
+)
+
+func (x GradeAppealComponent) Enum() *GradeAppealComponent {
+	p := new(GradeAppealComponent)
+	*p = x
+	return p
+}
+
+func (x GradeAppealComponent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GradeAppealComponent) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_thesis_thesis_proto_enumTypes[11].Descriptor()
+}
+
+func (GradeAppealComponent) Type() protoreflect.EnumType {
+	return &file_proto_thesis_thesis_proto_enumTypes[11]
+}
+
+func (x GradeAppealComponent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GradeAppealComponent.Descriptor instead.
+func (GradeAppealComponent) EnumDescriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{11}
+}
+
+type GradeAppealStatus int32
+
+const (
+	GradeAppealStatus_APPEAL_SUBMITTED GradeAppealStatus = 0
+	GradeAppealStatus_APPEAL_ASSIGNED  GradeAppealStatus = 1
+	GradeAppealStatus_APPEAL_CONFIRMED GradeAppealStatus = 2
+	GradeAppealStatus_APPEAL_AMENDED   GradeAppealStatus = 3
+)
+
+// Enum value maps for GradeAppealStatus.
+var (
+	GradeAppealStatus_name = map[int32]string{
+		0: "APPEAL_SUBMITTED",
+		1: "APPEAL_ASSIGNED",
+		2: "APPEAL_CONFIRMED",
+		3: "APPEAL_AMENDED",
+	}
+	GradeAppealStatus_value = map[string]int32{
+		"APPEAL_SUBMITTED": 0,
+		"APPEAL_ASSIGNED":  1,
+		"APPEAL_CONFIRMED": 2,
+		"APPEAL_AMENDED":   3,
+	}
+)
+
+func (x GradeAppealStatus) Enum() *GradeAppealStatus {
+	p := new(GradeAppealStatus)
+	*p = x
+	return p
+}
+
+func (x GradeAppealStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GradeAppealStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_thesis_thesis_proto_enumTypes[12].Descriptor()
+}
+
+func (GradeAppealStatus) Type() protoreflect.EnumType {
+	return &file_proto_thesis_thesis_proto_enumTypes[12]
+}
+
+func (x GradeAppealStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GradeAppealStatus.Descriptor instead.
+func (GradeAppealStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{12}
+}
+
+type MilestoneResult int32
+
+const (
+	MilestoneResult_MILESTONE_PENDING MilestoneResult = 0
+	MilestoneResult_MILESTONE_PASSED  MilestoneResult = 1
+	MilestoneResult_MILESTONE_FAILED  MilestoneResult = 2
+)
+
+// Enum value maps for MilestoneResult.
+var (
+	MilestoneResult_name = map[int32]string{
+		0: "MILESTONE_PENDING",
+		1: "MILESTONE_PASSED",
+		2: "MILESTONE_FAILED",
+	}
+	MilestoneResult_value = map[string]int32{
+		"MILESTONE_PENDING": 0,
+		"MILESTONE_PASSED":  1,
+		"MILESTONE_FAILED":  2,
+	}
+)
+
+func (x MilestoneResult) Enum() *MilestoneResult {
+	p := new(MilestoneResult)
+	*p = x
+	return p
+}
+
+func (x MilestoneResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MilestoneResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_thesis_thesis_proto_enumTypes[13].Descriptor()
+}
+
+func (MilestoneResult) Type() protoreflect.EnumType {
+	return &file_proto_thesis_thesis_proto_enumTypes[13]
+}
+
+func (x MilestoneResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MilestoneResult.Descriptor instead.
+func (MilestoneResult) EnumDescriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{13}
+}
+
+// ============= Midterm =============
+type Midterm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Grade         int32                  `protobuf:"varint,3,opt,name=grade,proto3" json:"grade,omitempty"`
+	Status        MidtermStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=thesis.MidtermStatus" json:"status,omitempty"`
+	Feedback      string                 `protobuf:"bytes,5,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       int32                  `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`                     // bumped by every update
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // set while the record is soft-deleted
+	DeletedBy     string                 `protobuf:"bytes,12,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Midterm) Reset() {
+	*x = Midterm{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Midterm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Midterm) ProtoMessage() {}
+
+func (x *Midterm) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Midterm.ProtoReflect.Descriptor instead.
+func (*Midterm) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Midterm) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Midterm) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Midterm) GetGrade() int32 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *Midterm) GetStatus() MidtermStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MidtermStatus_NOT_SUBMITTED
+}
+
+func (x *Midterm) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *Midterm) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Midterm) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Midterm) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Midterm) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *Midterm) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Midterm) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Midterm) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type CreateMidtermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Grade         *int32                 `protobuf:"varint,2,opt,name=grade,proto3,oneof" json:"grade,omitempty"`
+	Status        MidtermStatus          `protobuf:"varint,3,opt,name=status,proto3,enum=thesis.MidtermStatus" json:"status,omitempty"`
+	Feedback      *string                `protobuf:"bytes,4,opt,name=feedback,proto3,oneof" json:"feedback,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMidtermRequest) Reset() {
+	*x = CreateMidtermRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMidtermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMidtermRequest) ProtoMessage() {}
+
+func (x *CreateMidtermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMidtermRequest.ProtoReflect.Descriptor instead.
+func (*CreateMidtermRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateMidtermRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateMidtermRequest) GetGrade() int32 {
+	if x != nil && x.Grade != nil {
+		return *x.Grade
+	}
+	return 0
+}
+
+func (x *CreateMidtermRequest) GetStatus() MidtermStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MidtermStatus_NOT_SUBMITTED
+}
+
+func (x *CreateMidtermRequest) GetFeedback() string {
+	if x != nil && x.Feedback != nil {
+		return *x.Feedback
+	}
+	return ""
+}
+
+func (x *CreateMidtermRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreateMidtermResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Midterm       *Midterm               `protobuf:"bytes,1,opt,name=midterm,proto3" json:"midterm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMidtermResponse) Reset() {
+	*x = CreateMidtermResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMidtermResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMidtermResponse) ProtoMessage() {}
+
+func (x *CreateMidtermResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMidtermResponse.ProtoReflect.Descriptor instead.
+func (*CreateMidtermResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateMidtermResponse) GetMidterm() *Midterm {
+	if x != nil {
+		return x.Midterm
+	}
+	return nil
+}
+
+type GetMidtermRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also find a soft-deleted record
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetMidtermRequest) Reset() {
+	*x = GetMidtermRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMidtermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMidtermRequest) ProtoMessage() {}
+
+func (x *GetMidtermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMidtermRequest.ProtoReflect.Descriptor instead.
+func (*GetMidtermRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetMidtermRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetMidtermRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type GetMidtermResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Midterm       *Midterm               `protobuf:"bytes,1,opt,name=midterm,proto3" json:"midterm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMidtermResponse) Reset() {
+	*x = GetMidtermResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMidtermResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMidtermResponse) ProtoMessage() {}
+
+func (x *GetMidtermResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMidtermResponse.ProtoReflect.Descriptor instead.
+func (*GetMidtermResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetMidtermResponse) GetMidterm() *Midterm {
+	if x != nil {
+		return x.Midterm
+	}
+	return nil
+}
+
+type UpdateMidtermRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Grade *int32                 `protobuf:"varint,3,opt,name=grade,proto3,oneof" json:"grade,omitempty"`
+	// Derived from the milestone check-ins; setting it is refused
+	Status        *MidtermStatus `protobuf:"varint,4,opt,name=status,proto3,enum=thesis.MidtermStatus,oneof" json:"status,omitempty"`
+	Feedback      *string        `protobuf:"bytes,5,opt,name=feedback,proto3,oneof" json:"feedback,omitempty"`
+	UpdatedBy     string         `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       *int32         `protobuf:"varint,7,opt,name=version,proto3,oneof" json:"version,omitempty"` // expected version; a stale one fails with ABORTED
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMidtermRequest) Reset() {
+	*x = UpdateMidtermRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMidtermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMidtermRequest) ProtoMessage() {}
+
+func (x *UpdateMidtermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMidtermRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMidtermRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateMidtermRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateMidtermRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateMidtermRequest) GetGrade() int32 {
+	if x != nil && x.Grade != nil {
+		return *x.Grade
+	}
+	return 0
+}
+
+func (x *UpdateMidtermRequest) GetStatus() MidtermStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return MidtermStatus_NOT_SUBMITTED
+}
+
+func (x *UpdateMidtermRequest) GetFeedback() string {
+	if x != nil && x.Feedback != nil {
+		return *x.Feedback
+	}
+	return ""
+}
+
+func (x *UpdateMidtermRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *UpdateMidtermRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type UpdateMidtermResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Midterm       *Midterm               `protobuf:"bytes,1,opt,name=midterm,proto3" json:"midterm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMidtermResponse) Reset() {
+	*x = UpdateMidtermResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMidtermResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMidtermResponse) ProtoMessage() {}
+
+func (x *UpdateMidtermResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMidtermResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMidtermResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateMidtermResponse) GetMidterm() *Midterm {
+	if x != nil {
+		return x.Midterm
+	}
+	return nil
+}
+
+type DeleteMidtermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMidtermRequest) Reset() {
+	*x = DeleteMidtermRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMidtermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMidtermRequest) ProtoMessage() {}
+
+func (x *DeleteMidtermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMidtermRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMidtermRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteMidtermRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteMidtermRequest) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type DeleteMidtermResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMidtermResponse) Reset() {
+	*x = DeleteMidtermResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMidtermResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMidtermResponse) ProtoMessage() {}
+
+func (x *DeleteMidtermResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMidtermResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMidtermResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteMidtermResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RestoreMidtermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RestoredBy    string                 `protobuf:"bytes,2,opt,name=restored_by,json=restoredBy,proto3" json:"restored_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreMidtermRequest) Reset() {
+	*x = RestoreMidtermRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreMidtermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreMidtermRequest) ProtoMessage() {}
+
+func (x *RestoreMidtermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreMidtermRequest.ProtoReflect.Descriptor instead.
+func (*RestoreMidtermRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RestoreMidtermRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreMidtermRequest) GetRestoredBy() string {
+	if x != nil {
+		return x.RestoredBy
+	}
+	return ""
+}
+
+type RestoreMidtermResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Midterm       *Midterm               `protobuf:"bytes,1,opt,name=midterm,proto3" json:"midterm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreMidtermResponse) Reset() {
+	*x = RestoreMidtermResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreMidtermResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreMidtermResponse) ProtoMessage() {}
+
+func (x *RestoreMidtermResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreMidtermResponse.ProtoReflect.Descriptor instead.
+func (*RestoreMidtermResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RestoreMidtermResponse) GetMidterm() *Midterm {
+	if x != nil {
+		return x.Midterm
+	}
+	return nil
+}
+
+type ListMidtermsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Search         *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also list soft-deleted records
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListMidtermsRequest) Reset() {
+	*x = ListMidtermsRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMidtermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMidtermsRequest) ProtoMessage() {}
+
+func (x *ListMidtermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMidtermsRequest.ProtoReflect.Descriptor instead.
+func (*ListMidtermsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListMidtermsRequest) GetSearch() *common.SearchRequest {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *ListMidtermsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ListMidtermsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Midterms      []*Midterm             `protobuf:"bytes,1,rep,name=midterms,proto3" json:"midterms,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMidtermsResponse) Reset() {
+	*x = ListMidtermsResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMidtermsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMidtermsResponse) ProtoMessage() {}
+
+func (x *ListMidtermsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMidtermsResponse.ProtoReflect.Descriptor instead.
+func (*ListMidtermsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListMidtermsResponse) GetMidterms() []*Midterm {
+	if x != nil {
+		return x.Midterms
+	}
+	return nil
+}
+
+func (x *ListMidtermsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListMidtermsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMidtermsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ============= Final =============
+type Final struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	SupervisorGrade *int32                 `protobuf:"varint,3,opt,name=supervisor_grade,json=supervisorGrade,proto3,oneof" json:"supervisor_grade,omitempty"`
+	DepartmentGrade *int32                 `protobuf:"varint,4,opt,name=department_grade,json=departmentGrade,proto3,oneof" json:"department_grade,omitempty"`
+	// Set by ComputeFinalGrade under the grading policy, on a 10-point scale
+	FinalGrade     *float64               `protobuf:"fixed64,5,opt,name=final_grade,json=finalGrade,proto3,oneof" json:"final_grade,omitempty"`
+	Status         FinalStatus            `protobuf:"varint,6,opt,name=status,proto3,enum=thesis.FinalStatus" json:"status,omitempty"`
+	Notes          string                 `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	CompletionDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=completion_date,json=completionDate,proto3" json:"completion_date,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy      string                 `protobuf:"bytes,12,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version        int32                  `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`                     // bumped by every update
+	DeletedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // set while the record is soft-deleted
+	DeletedBy      string                 `protobuf:"bytes,15,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Final) Reset() {
+	*x = Final{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Final) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Final) ProtoMessage() {}
+
+func (x *Final) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Final.ProtoReflect.Descriptor instead.
+func (*Final) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Final) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Final) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Final) GetSupervisorGrade() int32 {
+	if x != nil && x.SupervisorGrade != nil {
+		return *x.SupervisorGrade
+	}
+	return 0
+}
+
+func (x *Final) GetDepartmentGrade() int32 {
+	if x != nil && x.DepartmentGrade != nil {
+		return *x.DepartmentGrade
+	}
+	return 0
+}
+
+func (x *Final) GetFinalGrade() float64 {
+	if x != nil && x.FinalGrade != nil {
+		return *x.FinalGrade
+	}
+	return 0
+}
+
+func (x *Final) GetStatus() FinalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FinalStatus_PENDING
+}
+
+func (x *Final) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Final) GetCompletionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletionDate
+	}
+	return nil
+}
+
+func (x *Final) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Final) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Final) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Final) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *Final) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Final) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Final) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type CreateFinalRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	SupervisorGrade *int32                 `protobuf:"varint,2,opt,name=supervisor_grade,json=supervisorGrade,proto3,oneof" json:"supervisor_grade,omitempty"`
+	DepartmentGrade *int32                 `protobuf:"varint,3,opt,name=department_grade,json=departmentGrade,proto3,oneof" json:"department_grade,omitempty"`
+	Status          FinalStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=thesis.FinalStatus" json:"status,omitempty"`
+	Notes           *string                `protobuf:"bytes,6,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	CompletionDate  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completion_date,json=completionDate,proto3,oneof" json:"completion_date,omitempty"`
+	CreatedBy       string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateFinalRequest) Reset() {
+	*x = CreateFinalRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFinalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFinalRequest) ProtoMessage() {}
+
+func (x *CreateFinalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFinalRequest.ProtoReflect.Descriptor instead.
+func (*CreateFinalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateFinalRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateFinalRequest) GetSupervisorGrade() int32 {
+	if x != nil && x.SupervisorGrade != nil {
+		return *x.SupervisorGrade
+	}
+	return 0
+}
+
+func (x *CreateFinalRequest) GetDepartmentGrade() int32 {
+	if x != nil && x.DepartmentGrade != nil {
+		return *x.DepartmentGrade
+	}
+	return 0
+}
+
+func (x *CreateFinalRequest) GetStatus() FinalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FinalStatus_PENDING
+}
+
+func (x *CreateFinalRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *CreateFinalRequest) GetCompletionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletionDate
+	}
+	return nil
+}
+
+func (x *CreateFinalRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreateFinalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Final         *Final                 `protobuf:"bytes,1,opt,name=final,proto3" json:"final,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFinalResponse) Reset() {
+	*x = CreateFinalResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFinalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFinalResponse) ProtoMessage() {}
+
+func (x *CreateFinalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFinalResponse.ProtoReflect.Descriptor instead.
+func (*CreateFinalResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateFinalResponse) GetFinal() *Final {
+	if x != nil {
+		return x.Final
+	}
+	return nil
+}
+
+type GetFinalRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also find a soft-deleted record
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetFinalRequest) Reset() {
+	*x = GetFinalRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFinalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFinalRequest) ProtoMessage() {}
+
+func (x *GetFinalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFinalRequest.ProtoReflect.Descriptor instead.
+func (*GetFinalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetFinalRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetFinalRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type GetFinalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Final         *Final                 `protobuf:"bytes,1,opt,name=final,proto3" json:"final,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFinalResponse) Reset() {
+	*x = GetFinalResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFinalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFinalResponse) ProtoMessage() {}
+
+func (x *GetFinalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFinalResponse.ProtoReflect.Descriptor instead.
+func (*GetFinalResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetFinalResponse) GetFinal() *Final {
+	if x != nil {
+		return x.Final
+	}
+	return nil
+}
+
+type UpdateFinalRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	SupervisorGrade *int32                 `protobuf:"varint,3,opt,name=supervisor_grade,json=supervisorGrade,proto3,oneof" json:"supervisor_grade,omitempty"`
+	DepartmentGrade *int32                 `protobuf:"varint,4,opt,name=department_grade,json=departmentGrade,proto3,oneof" json:"department_grade,omitempty"`
+	// Derived by ComputeFinalGrade; setting it is refused
+	Status         *FinalStatus           `protobuf:"varint,6,opt,name=status,proto3,enum=thesis.FinalStatus,oneof" json:"status,omitempty"`
+	Notes          *string                `protobuf:"bytes,7,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	CompletionDate *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=completion_date,json=completionDate,proto3,oneof" json:"completion_date,omitempty"`
+	UpdatedBy      string                 `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version        *int32                 `protobuf:"varint,10,opt,name=version,proto3,oneof" json:"version,omitempty"` // expected version; a stale one fails with ABORTED
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateFinalRequest) Reset() {
+	*x = UpdateFinalRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFinalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFinalRequest) ProtoMessage() {}
+
+func (x *UpdateFinalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFinalRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFinalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateFinalRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateFinalRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateFinalRequest) GetSupervisorGrade() int32 {
+	if x != nil && x.SupervisorGrade != nil {
+		return *x.SupervisorGrade
+	}
+	return 0
+}
+
+func (x *UpdateFinalRequest) GetDepartmentGrade() int32 {
+	if x != nil && x.DepartmentGrade != nil {
+		return *x.DepartmentGrade
+	}
+	return 0
+}
+
+func (x *UpdateFinalRequest) GetStatus() FinalStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return FinalStatus_PENDING
+}
+
+func (x *UpdateFinalRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *UpdateFinalRequest) GetCompletionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletionDate
+	}
+	return nil
+}
+
+func (x *UpdateFinalRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *UpdateFinalRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type UpdateFinalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Final         *Final                 `protobuf:"bytes,1,opt,name=final,proto3" json:"final,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFinalResponse) Reset() {
+	*x = UpdateFinalResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFinalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFinalResponse) ProtoMessage() {}
+
+func (x *UpdateFinalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFinalResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFinalResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateFinalResponse) GetFinal() *Final {
+	if x != nil {
+		return x.Final
+	}
+	return nil
+}
+
+type DeleteFinalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFinalRequest) Reset() {
+	*x = DeleteFinalRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFinalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFinalRequest) ProtoMessage() {}
+
+func (x *DeleteFinalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFinalRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFinalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteFinalRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteFinalRequest) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type DeleteFinalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFinalResponse) Reset() {
+	*x = DeleteFinalResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFinalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFinalResponse) ProtoMessage() {}
+
+func (x *DeleteFinalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFinalResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFinalResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteFinalResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RestoreFinalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RestoredBy    string                 `protobuf:"bytes,2,opt,name=restored_by,json=restoredBy,proto3" json:"restored_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreFinalRequest) Reset() {
+	*x = RestoreFinalRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreFinalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFinalRequest) ProtoMessage() {}
+
+func (x *RestoreFinalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreFinalRequest.ProtoReflect.Descriptor instead.
+func (*RestoreFinalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RestoreFinalRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreFinalRequest) GetRestoredBy() string {
+	if x != nil {
+		return x.RestoredBy
+	}
+	return ""
+}
+
+type RestoreFinalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Final         *Final                 `protobuf:"bytes,1,opt,name=final,proto3" json:"final,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreFinalResponse) Reset() {
+	*x = RestoreFinalResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreFinalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFinalResponse) ProtoMessage() {}
+
+func (x *RestoreFinalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreFinalResponse.ProtoReflect.Descriptor instead.
+func (*RestoreFinalResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RestoreFinalResponse) GetFinal() *Final {
+	if x != nil {
+		return x.Final
+	}
+	return nil
+}
+
+type ListFinalsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Search         *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also list soft-deleted records
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListFinalsRequest) Reset() {
+	*x = ListFinalsRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFinalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFinalsRequest) ProtoMessage() {}
+
+func (x *ListFinalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFinalsRequest.ProtoReflect.Descriptor instead.
+func (*ListFinalsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListFinalsRequest) GetSearch() *common.SearchRequest {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *ListFinalsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ListFinalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Finals        []*Final               `protobuf:"bytes,1,rep,name=finals,proto3" json:"finals,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFinalsResponse) Reset() {
+	*x = ListFinalsResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFinalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFinalsResponse) ProtoMessage() {}
+
+func (x *ListFinalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFinalsResponse.ProtoReflect.Descriptor instead.
+func (*ListFinalsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListFinalsResponse) GetFinals() []*Final {
+	if x != nil {
+		return x.Finals
+	}
+	return nil
+}
+
+func (x *ListFinalsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListFinalsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFinalsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ============= Enrollment =============
+type Enrollment struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title            string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	StudentCode      string                 `protobuf:"bytes,3,opt,name=student_code,json=studentCode,proto3" json:"student_code,omitempty"`
+	TopicCouncilCode string                 `protobuf:"bytes,4,opt,name=topic_council_code,json=topicCouncilCode,proto3" json:"topic_council_code,omitempty"`
+	FinalCode        *string                `protobuf:"bytes,5,opt,name=final_code,json=finalCode,proto3,oneof" json:"final_code,omitempty"`
+	GradeReviewCode  *string                `protobuf:"bytes,6,opt,name=grade_review_code,json=gradeReviewCode,proto3,oneof" json:"grade_review_code,omitempty"`
+	MidtermCode      *string                `protobuf:"bytes,7,opt,name=midterm_code,json=midtermCode,proto3,oneof" json:"midterm_code,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy        string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy        string                 `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version          int32                  `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`                     // bumped by every update
+	DeletedAt        *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // set while the record is soft-deleted
+	DeletedBy        string                 `protobuf:"bytes,14,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Enrollment) Reset() {
+	*x = Enrollment{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Enrollment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enrollment) ProtoMessage() {}
+
+func (x *Enrollment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enrollment.ProtoReflect.Descriptor instead.
+func (*Enrollment) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Enrollment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Enrollment) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Enrollment) GetStudentCode() string {
+	if x != nil {
+		return x.StudentCode
+	}
+	return ""
+}
+
+func (x *Enrollment) GetTopicCouncilCode() string {
+	if x != nil {
+		return x.TopicCouncilCode
+	}
+	return ""
+}
+
+func (x *Enrollment) GetFinalCode() string {
+	if x != nil && x.FinalCode != nil {
+		return *x.FinalCode
+	}
+	return ""
+}
+
+func (x *Enrollment) GetGradeReviewCode() string {
+	if x != nil && x.GradeReviewCode != nil {
+		return *x.GradeReviewCode
+	}
+	return ""
+}
+
+func (x *Enrollment) GetMidtermCode() string {
+	if x != nil && x.MidtermCode != nil {
+		return *x.MidtermCode
+	}
+	return ""
+}
+
+func (x *Enrollment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Enrollment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Enrollment) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Enrollment) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *Enrollment) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Enrollment) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Enrollment) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type CreateEnrollmentRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Title            string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	StudentCode      string                 `protobuf:"bytes,2,opt,name=student_code,json=studentCode,proto3" json:"student_code,omitempty"`
+	TopicCouncilCode string                 `protobuf:"bytes,3,opt,name=topic_council_code,json=topicCouncilCode,proto3" json:"topic_council_code,omitempty"`
+	FinalCode        *string                `protobuf:"bytes,4,opt,name=final_code,json=finalCode,proto3,oneof" json:"final_code,omitempty"`
+	GradeReviewCode  *string                `protobuf:"bytes,5,opt,name=grade_review_code,json=gradeReviewCode,proto3,oneof" json:"grade_review_code,omitempty"`
+	MidtermCode      *string                `protobuf:"bytes,6,opt,name=midterm_code,json=midtermCode,proto3,oneof" json:"midterm_code,omitempty"`
+	CreatedBy        string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateEnrollmentRequest) Reset() {
+	*x = CreateEnrollmentRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEnrollmentRequest) ProtoMessage() {}
+
+func (x *CreateEnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateEnrollmentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateEnrollmentRequest) GetStudentCode() string {
+	if x != nil {
+		return x.StudentCode
+	}
+	return ""
+}
+
+func (x *CreateEnrollmentRequest) GetTopicCouncilCode() string {
+	if x != nil {
+		return x.TopicCouncilCode
+	}
+	return ""
+}
+
+func (x *CreateEnrollmentRequest) GetFinalCode() string {
+	if x != nil && x.FinalCode != nil {
+		return *x.FinalCode
+	}
+	return ""
+}
+
+func (x *CreateEnrollmentRequest) GetGradeReviewCode() string {
+	if x != nil && x.GradeReviewCode != nil {
+		return *x.GradeReviewCode
+	}
+	return ""
+}
+
+func (x *CreateEnrollmentRequest) GetMidtermCode() string {
+	if x != nil && x.MidtermCode != nil {
+		return *x.MidtermCode
+	}
+	return ""
+}
+
+func (x *CreateEnrollmentRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreateEnrollmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enrollment    *Enrollment            `protobuf:"bytes,1,opt,name=enrollment,proto3" json:"enrollment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEnrollmentResponse) Reset() {
+	*x = CreateEnrollmentResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEnrollmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEnrollmentResponse) ProtoMessage() {}
+
+func (x *CreateEnrollmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEnrollmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateEnrollmentResponse) GetEnrollment() *Enrollment {
+	if x != nil {
+		return x.Enrollment
+	}
+	return nil
+}
+
+type GetEnrollmentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also find a soft-deleted record
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetEnrollmentRequest) Reset() {
+	*x = GetEnrollmentRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnrollmentRequest) ProtoMessage() {}
+
+func (x *GetEnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*GetEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetEnrollmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetEnrollmentRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type GetEnrollmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enrollment    *Enrollment            `protobuf:"bytes,1,opt,name=enrollment,proto3" json:"enrollment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEnrollmentResponse) Reset() {
+	*x = GetEnrollmentResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnrollmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnrollmentResponse) ProtoMessage() {}
+
+func (x *GetEnrollmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnrollmentResponse.ProtoReflect.Descriptor instead.
+func (*GetEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetEnrollmentResponse) GetEnrollment() *Enrollment {
+	if x != nil {
+		return x.Enrollment
+	}
+	return nil
+}
+
+type UpdateEnrollmentRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title            *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	StudentCode      *string                `protobuf:"bytes,3,opt,name=student_code,json=studentCode,proto3,oneof" json:"student_code,omitempty"`
+	TopicCouncilCode *string                `protobuf:"bytes,4,opt,name=topic_council_code,json=topicCouncilCode,proto3,oneof" json:"topic_council_code,omitempty"`
+	FinalCode        *string                `protobuf:"bytes,5,opt,name=final_code,json=finalCode,proto3,oneof" json:"final_code,omitempty"`
+	GradeReviewCode  *string                `protobuf:"bytes,6,opt,name=grade_review_code,json=gradeReviewCode,proto3,oneof" json:"grade_review_code,omitempty"`
+	MidtermCode      *string                `protobuf:"bytes,7,opt,name=midterm_code,json=midtermCode,proto3,oneof" json:"midterm_code,omitempty"`
+	UpdatedBy        string                 `protobuf:"bytes,8,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version          *int32                 `protobuf:"varint,9,opt,name=version,proto3,oneof" json:"version,omitempty"` // expected version; a stale one fails with ABORTED
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateEnrollmentRequest) Reset() {
+	*x = UpdateEnrollmentRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEnrollmentRequest) ProtoMessage() {}
+
+func (x *UpdateEnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateEnrollmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateEnrollmentRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateEnrollmentRequest) GetStudentCode() string {
+	if x != nil && x.StudentCode != nil {
+		return *x.StudentCode
+	}
+	return ""
+}
+
+func (x *UpdateEnrollmentRequest) GetTopicCouncilCode() string {
+	if x != nil && x.TopicCouncilCode != nil {
+		return *x.TopicCouncilCode
+	}
+	return ""
+}
+
+func (x *UpdateEnrollmentRequest) GetFinalCode() string {
+	if x != nil && x.FinalCode != nil {
+		return *x.FinalCode
+	}
+	return ""
+}
+
+func (x *UpdateEnrollmentRequest) GetGradeReviewCode() string {
+	if x != nil && x.GradeReviewCode != nil {
+		return *x.GradeReviewCode
+	}
+	return ""
+}
+
+func (x *UpdateEnrollmentRequest) GetMidtermCode() string {
+	if x != nil && x.MidtermCode != nil {
+		return *x.MidtermCode
+	}
+	return ""
+}
+
+func (x *UpdateEnrollmentRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *UpdateEnrollmentRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type UpdateEnrollmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enrollment    *Enrollment            `protobuf:"bytes,1,opt,name=enrollment,proto3" json:"enrollment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEnrollmentResponse) Reset() {
+	*x = UpdateEnrollmentResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEnrollmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEnrollmentResponse) ProtoMessage() {}
+
+func (x *UpdateEnrollmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEnrollmentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateEnrollmentResponse) GetEnrollment() *Enrollment {
+	if x != nil {
+		return x.Enrollment
+	}
+	return nil
+}
+
+type DeleteEnrollmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEnrollmentRequest) Reset() {
+	*x = DeleteEnrollmentRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEnrollmentRequest) ProtoMessage() {}
+
+func (x *DeleteEnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteEnrollmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteEnrollmentRequest) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type DeleteEnrollmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEnrollmentResponse) Reset() {
+	*x = DeleteEnrollmentResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEnrollmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEnrollmentResponse) ProtoMessage() {}
+
+func (x *DeleteEnrollmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEnrollmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteEnrollmentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RestoreEnrollmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RestoredBy    string                 `protobuf:"bytes,2,opt,name=restored_by,json=restoredBy,proto3" json:"restored_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreEnrollmentRequest) Reset() {
+	*x = RestoreEnrollmentRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreEnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEnrollmentRequest) ProtoMessage() {}
+
+func (x *RestoreEnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*RestoreEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RestoreEnrollmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreEnrollmentRequest) GetRestoredBy() string {
+	if x != nil {
+		return x.RestoredBy
+	}
+	return ""
+}
+
+type RestoreEnrollmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enrollment    *Enrollment            `protobuf:"bytes,1,opt,name=enrollment,proto3" json:"enrollment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreEnrollmentResponse) Reset() {
+	*x = RestoreEnrollmentResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreEnrollmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEnrollmentResponse) ProtoMessage() {}
+
+func (x *RestoreEnrollmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEnrollmentResponse.ProtoReflect.Descriptor instead.
+func (*RestoreEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RestoreEnrollmentResponse) GetEnrollment() *Enrollment {
+	if x != nil {
+		return x.Enrollment
+	}
+	return nil
+}
+
+type ListEnrollmentsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Search         *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also list soft-deleted records
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListEnrollmentsRequest) Reset() {
+	*x = ListEnrollmentsRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEnrollmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEnrollmentsRequest) ProtoMessage() {}
+
+func (x *ListEnrollmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEnrollmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListEnrollmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListEnrollmentsRequest) GetSearch() *common.SearchRequest {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *ListEnrollmentsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ListEnrollmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enrollments   []*Enrollment          `protobuf:"bytes,1,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEnrollmentsResponse) Reset() {
+	*x = ListEnrollmentsResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEnrollmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEnrollmentsResponse) ProtoMessage() {}
+
+func (x *ListEnrollmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEnrollmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListEnrollmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListEnrollmentsResponse) GetEnrollments() []*Enrollment {
+	if x != nil {
+		return x.Enrollments
+	}
+	return nil
+}
+
+func (x *ListEnrollmentsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListEnrollmentsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListEnrollmentsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ============= Enrollment bundle =============
+// Creates an enrollment together with its Midterm, Final and, when the
+// reviewer is known, Grade_review rows in one transaction
+type CreateEnrollmentBundleRequest struct {
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	Title            string                    `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	StudentCode      string                    `protobuf:"bytes,2,opt,name=student_code,json=studentCode,proto3" json:"student_code,omitempty"`
+	TopicCouncilCode string                    `protobuf:"bytes,3,opt,name=topic_council_code,json=topicCouncilCode,proto3" json:"topic_council_code,omitempty"`
+	CreatedBy        string                    `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Midterm          *CreateMidtermRequest     `protobuf:"bytes,5,opt,name=midterm,proto3" json:"midterm,omitempty"`                            // defaults to the enrollment title, not submitted
+	Final            *CreateFinalRequest       `protobuf:"bytes,6,opt,name=final,proto3" json:"final,omitempty"`                                // defaults to the enrollment title, pending
+	GradeReview      *CreateGradeReviewRequest `protobuf:"bytes,7,opt,name=grade_review,json=gradeReview,proto3" json:"grade_review,omitempty"` // optional, needs the reviewer's teacher_code
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateEnrollmentBundleRequest) Reset() {
+	*x = CreateEnrollmentBundleRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEnrollmentBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEnrollmentBundleRequest) ProtoMessage() {}
+
+func (x *CreateEnrollmentBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEnrollmentBundleRequest.ProtoReflect.Descriptor instead.
+func (*CreateEnrollmentBundleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateEnrollmentBundleRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateEnrollmentBundleRequest) GetStudentCode() string {
+	if x != nil {
+		return x.StudentCode
+	}
+	return ""
+}
+
+func (x *CreateEnrollmentBundleRequest) GetTopicCouncilCode() string {
+	if x != nil {
+		return x.TopicCouncilCode
+	}
+	return ""
+}
+
+func (x *CreateEnrollmentBundleRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CreateEnrollmentBundleRequest) GetMidterm() *CreateMidtermRequest {
+	if x != nil {
+		return x.Midterm
+	}
+	return nil
+}
+
+func (x *CreateEnrollmentBundleRequest) GetFinal() *CreateFinalRequest {
+	if x != nil {
+		return x.Final
+	}
+	return nil
+}
+
+func (x *CreateEnrollmentBundleRequest) GetGradeReview() *CreateGradeReviewRequest {
+	if x != nil {
+		return x.GradeReview
+	}
+	return nil
+}
+
+type CreateEnrollmentBundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enrollment    *Enrollment            `protobuf:"bytes,1,opt,name=enrollment,proto3" json:"enrollment,omitempty"`
+	Midterm       *Midterm               `protobuf:"bytes,2,opt,name=midterm,proto3" json:"midterm,omitempty"`
+	Final         *Final                 `protobuf:"bytes,3,opt,name=final,proto3" json:"final,omitempty"`
+	GradeReview   *GradeReview           `protobuf:"bytes,4,opt,name=grade_review,json=gradeReview,proto3,oneof" json:"grade_review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEnrollmentBundleResponse) Reset() {
+	*x = CreateEnrollmentBundleResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEnrollmentBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEnrollmentBundleResponse) ProtoMessage() {}
+
+func (x *CreateEnrollmentBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEnrollmentBundleResponse.ProtoReflect.Descriptor instead.
+func (*CreateEnrollmentBundleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateEnrollmentBundleResponse) GetEnrollment() *Enrollment {
+	if x != nil {
+		return x.Enrollment
+	}
+	return nil
+}
+
+func (x *CreateEnrollmentBundleResponse) GetMidterm() *Midterm {
+	if x != nil {
+		return x.Midterm
+	}
+	return nil
+}
+
+func (x *CreateEnrollmentBundleResponse) GetFinal() *Final {
+	if x != nil {
+		return x.Final
+	}
+	return nil
+}
+
+func (x *CreateEnrollmentBundleResponse) GetGradeReview() *GradeReview {
+	if x != nil {
+		return x.GradeReview
+	}
+	return nil
+}
+
+// Soft-deletes an enrollment with its Midterm, Final and Grade_review rows;
+// RestoreEnrollment brings them back. Amendments, appeals and milestone
+// check-ins go with it once the retention job purges the enrollment.
+type DeleteEnrollmentCascadeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEnrollmentCascadeRequest) Reset() {
+	*x = DeleteEnrollmentCascadeRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEnrollmentCascadeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEnrollmentCascadeRequest) ProtoMessage() {}
+
+func (x *DeleteEnrollmentCascadeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEnrollmentCascadeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEnrollmentCascadeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteEnrollmentCascadeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteEnrollmentCascadeRequest) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type DeleteEnrollmentCascadeResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	MidtermCode     *string                `protobuf:"bytes,2,opt,name=midterm_code,json=midtermCode,proto3,oneof" json:"midterm_code,omitempty"`               // deleted midterm, if any
+	FinalCode       *string                `protobuf:"bytes,3,opt,name=final_code,json=finalCode,proto3,oneof" json:"final_code,omitempty"`                     // deleted final, if any
+	GradeReviewCode *string                `protobuf:"bytes,4,opt,name=grade_review_code,json=gradeReviewCode,proto3,oneof" json:"grade_review_code,omitempty"` // deleted grade review, if any
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteEnrollmentCascadeResponse) Reset() {
+	*x = DeleteEnrollmentCascadeResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEnrollmentCascadeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEnrollmentCascadeResponse) ProtoMessage() {}
+
+func (x *DeleteEnrollmentCascadeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEnrollmentCascadeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEnrollmentCascadeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteEnrollmentCascadeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteEnrollmentCascadeResponse) GetMidtermCode() string {
+	if x != nil && x.MidtermCode != nil {
+		return *x.MidtermCode
+	}
+	return ""
+}
+
+func (x *DeleteEnrollmentCascadeResponse) GetFinalCode() string {
+	if x != nil && x.FinalCode != nil {
+		return *x.FinalCode
+	}
+	return ""
+}
+
+func (x *DeleteEnrollmentCascadeResponse) GetGradeReviewCode() string {
+	if x != nil && x.GradeReviewCode != nil {
+		return *x.GradeReviewCode
+	}
+	return ""
+}
+
+// ============= Topic =============
+type Topic struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	MajorCode      string                 `protobuf:"bytes,3,opt,name=major_code,json=majorCode,proto3" json:"major_code,omitempty"`
+	SemesterCode   string                 `protobuf:"bytes,4,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Status         TopicStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=thesis.TopicStatus" json:"status,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PercentStage_1 *int32                 `protobuf:"varint,8,opt,name=percent_stage_1,json=percentStage1,proto3,oneof" json:"percent_stage_1,omitempty"`
+	PercentStage_2 *int32                 `protobuf:"varint,9,opt,name=percent_stage_2,json=percentStage2,proto3,oneof" json:"percent_stage_2,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy      string                 `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	MaxStudents    int32                  `protobuf:"varint,12,opt,name=max_students,json=maxStudents,proto3" json:"max_students,omitempty"` // registration capacity
+	RequiredSkills []string               `protobuf:"bytes,13,rep,name=required_skills,json=requiredSkills,proto3" json:"required_skills,omitempty"`
+	Version        int32                  `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`                     // bumped by every update
+	DeletedAt      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // set while the record is soft-deleted
+	DeletedBy      string                 `protobuf:"bytes,16,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Topic) Reset() {
+	*x = Topic{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Topic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Topic) ProtoMessage() {}
+
+func (x *Topic) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Topic.ProtoReflect.Descriptor instead.
+func (*Topic) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Topic) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Topic) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Topic) GetMajorCode() string {
+	if x != nil {
+		return x.MajorCode
+	}
+	return ""
+}
+
+func (x *Topic) GetSemesterCode() string {
+	if x != nil {
+		return x.SemesterCode
+	}
+	return ""
+}
+
+func (x *Topic) GetStatus() TopicStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TopicStatus_SUBMIT
+}
+
+func (x *Topic) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Topic) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Topic) GetPercentStage_1() int32 {
+	if x != nil && x.PercentStage_1 != nil {
+		return *x.PercentStage_1
+	}
+	return 0
+}
+
+func (x *Topic) GetPercentStage_2() int32 {
+	if x != nil && x.PercentStage_2 != nil {
+		return *x.PercentStage_2
+	}
+	return 0
+}
+
+func (x *Topic) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Topic) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *Topic) GetMaxStudents() int32 {
+	if x != nil {
+		return x.MaxStudents
+	}
+	return 0
+}
+
+func (x *Topic) GetRequiredSkills() []string {
+	if x != nil {
+		return x.RequiredSkills
+	}
+	return nil
+}
+
+func (x *Topic) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Topic) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Topic) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type CreateTopicRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Title          string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	MajorCode      string                 `protobuf:"bytes,2,opt,name=major_code,json=majorCode,proto3" json:"major_code,omitempty"`
+	SemesterCode   string                 `protobuf:"bytes,3,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Status         TopicStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=thesis.TopicStatus" json:"status,omitempty"`
+	PercentStage_1 *int32                 `protobuf:"varint,5,opt,name=percent_stage_1,json=percentStage1,proto3,oneof" json:"percent_stage_1,omitempty"`
+	PercentStage_2 *int32                 `protobuf:"varint,6,opt,name=percent_stage_2,json=percentStage2,proto3,oneof" json:"percent_stage_2,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateTopicRequest) Reset() {
+	*x = CreateTopicRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTopicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTopicRequest) ProtoMessage() {}
+
+func (x *CreateTopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTopicRequest.ProtoReflect.Descriptor instead.
+func (*CreateTopicRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateTopicRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTopicRequest) GetMajorCode() string {
+	if x != nil {
+		return x.MajorCode
+	}
+	return ""
+}
+
+func (x *CreateTopicRequest) GetSemesterCode() string {
+	if x != nil {
+		return x.SemesterCode
+	}
+	return ""
+}
+
+func (x *CreateTopicRequest) GetStatus() TopicStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TopicStatus_SUBMIT
+}
+
+func (x *CreateTopicRequest) GetPercentStage_1() int32 {
+	if x != nil && x.PercentStage_1 != nil {
+		return *x.PercentStage_1
+	}
+	return 0
+}
+
+func (x *CreateTopicRequest) GetPercentStage_2() int32 {
+	if x != nil && x.PercentStage_2 != nil {
+		return *x.PercentStage_2
+	}
+	return 0
+}
+
+func (x *CreateTopicRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreateTopicResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         *Topic                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTopicResponse) Reset() {
+	*x = CreateTopicResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTopicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTopicResponse) ProtoMessage() {}
+
+func (x *CreateTopicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTopicResponse.ProtoReflect.Descriptor instead.
+func (*CreateTopicResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateTopicResponse) GetTopic() *Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+type GetTopicRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also find a soft-deleted record
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetTopicRequest) Reset() {
+	*x = GetTopicRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopicRequest) ProtoMessage() {}
+
+func (x *GetTopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopicRequest.ProtoReflect.Descriptor instead.
+func (*GetTopicRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetTopicRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetTopicRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type GetTopicResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         *Topic                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopicResponse) Reset() {
+	*x = GetTopicResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopicResponse) ProtoMessage() {}
+
+func (x *GetTopicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopicResponse.ProtoReflect.Descriptor instead.
+func (*GetTopicResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetTopicResponse) GetTopic() *Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+type UpdateTopicRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	MajorCode      *string                `protobuf:"bytes,3,opt,name=major_code,json=majorCode,proto3,oneof" json:"major_code,omitempty"`
+	SemesterCode   *string                `protobuf:"bytes,4,opt,name=semester_code,json=semesterCode,proto3,oneof" json:"semester_code,omitempty"`
+	Status         *TopicStatus           `protobuf:"varint,5,opt,name=status,proto3,enum=thesis.TopicStatus,oneof" json:"status,omitempty"`
+	PercentStage_1 *int32                 `protobuf:"varint,6,opt,name=percent_stage_1,json=percentStage1,proto3,oneof" json:"percent_stage_1,omitempty"`
+	PercentStage_2 *int32                 `protobuf:"varint,7,opt,name=percent_stage_2,json=percentStage2,proto3,oneof" json:"percent_stage_2,omitempty"`
+	UpdatedBy      string                 `protobuf:"bytes,8,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	MaxStudents    *int32                 `protobuf:"varint,9,opt,name=max_students,json=maxStudents,proto3,oneof" json:"max_students,omitempty"`
+	RequiredSkills []string               `protobuf:"bytes,10,rep,name=required_skills,json=requiredSkills,proto3" json:"required_skills,omitempty"` // replaces the list when non-empty
+	Version        *int32                 `protobuf:"varint,11,opt,name=version,proto3,oneof" json:"version,omitempty"`                              // expected version; a stale one fails with ABORTED
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateTopicRequest) Reset() {
+	*x = UpdateTopicRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTopicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTopicRequest) ProtoMessage() {}
+
+func (x *UpdateTopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTopicRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTopicRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateTopicRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTopicRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTopicRequest) GetMajorCode() string {
+	if x != nil && x.MajorCode != nil {
+		return *x.MajorCode
+	}
+	return ""
+}
+
+func (x *UpdateTopicRequest) GetSemesterCode() string {
+	if x != nil && x.SemesterCode != nil {
+		return *x.SemesterCode
+	}
+	return ""
+}
+
+func (x *UpdateTopicRequest) GetStatus() TopicStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return TopicStatus_SUBMIT
+}
+
+func (x *UpdateTopicRequest) GetPercentStage_1() int32 {
+	if x != nil && x.PercentStage_1 != nil {
+		return *x.PercentStage_1
+	}
+	return 0
+}
+
+func (x *UpdateTopicRequest) GetPercentStage_2() int32 {
+	if x != nil && x.PercentStage_2 != nil {
+		return *x.PercentStage_2
+	}
+	return 0
+}
+
+func (x *UpdateTopicRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *UpdateTopicRequest) GetMaxStudents() int32 {
+	if x != nil && x.MaxStudents != nil {
+		return *x.MaxStudents
+	}
+	return 0
+}
+
+func (x *UpdateTopicRequest) GetRequiredSkills() []string {
+	if x != nil {
+		return x.RequiredSkills
+	}
+	return nil
+}
+
+func (x *UpdateTopicRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type UpdateTopicResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         *Topic                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTopicResponse) Reset() {
+	*x = UpdateTopicResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTopicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTopicResponse) ProtoMessage() {}
+
+func (x *UpdateTopicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTopicResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTopicResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateTopicResponse) GetTopic() *Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+type DeleteTopicRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTopicRequest) Reset() {
+	*x = DeleteTopicRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTopicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTopicRequest) ProtoMessage() {}
+
+func (x *DeleteTopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTopicRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTopicRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteTopicRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteTopicRequest) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type DeleteTopicResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTopicResponse) Reset() {
+	*x = DeleteTopicResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTopicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTopicResponse) ProtoMessage() {}
+
+func (x *DeleteTopicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTopicResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTopicResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteTopicResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RestoreTopicRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RestoredBy    string                 `protobuf:"bytes,2,opt,name=restored_by,json=restoredBy,proto3" json:"restored_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTopicRequest) Reset() {
+	*x = RestoreTopicRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTopicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTopicRequest) ProtoMessage() {}
+
+func (x *RestoreTopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTopicRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTopicRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RestoreTopicRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreTopicRequest) GetRestoredBy() string {
+	if x != nil {
+		return x.RestoredBy
+	}
+	return ""
+}
+
+type RestoreTopicResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         *Topic                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTopicResponse) Reset() {
+	*x = RestoreTopicResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTopicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTopicResponse) ProtoMessage() {}
+
+func (x *RestoreTopicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTopicResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTopicResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RestoreTopicResponse) GetTopic() *Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+type ListTopicsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Search         *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also list soft-deleted records
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListTopicsRequest) Reset() {
+	*x = ListTopicsRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTopicsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopicsRequest) ProtoMessage() {}
+
+func (x *ListTopicsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopicsRequest.ProtoReflect.Descriptor instead.
+func (*ListTopicsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListTopicsRequest) GetSearch() *common.SearchRequest {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *ListTopicsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ListTopicsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topics        []*Topic               `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTopicsResponse) Reset() {
+	*x = ListTopicsResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTopicsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopicsResponse) ProtoMessage() {}
+
+func (x *ListTopicsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopicsResponse.ProtoReflect.Descriptor instead.
+func (*ListTopicsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListTopicsResponse) GetTopics() []*Topic {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *ListTopicsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListTopicsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTopicsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ============= Topic lifecycle =============
+// Status changes go through the transition RPCs; each one is recorded in
+// Topic_status_history with who made it, when and why.
+type TopicStatusHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TopicCode     string                 `protobuf:"bytes,2,opt,name=topic_code,json=topicCode,proto3" json:"topic_code,omitempty"`
+	FromStatus    *TopicStatus           `protobuf:"varint,3,opt,name=from_status,json=fromStatus,proto3,enum=thesis.TopicStatus,oneof" json:"from_status,omitempty"` // unset for the creation entry
+	ToStatus      TopicStatus            `protobuf:"varint,4,opt,name=to_status,json=toStatus,proto3,enum=thesis.TopicStatus" json:"to_status,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopicStatusHistory) Reset() {
+	*x = TopicStatusHistory{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopicStatusHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicStatusHistory) ProtoMessage() {}
+
+func (x *TopicStatusHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicStatusHistory.ProtoReflect.Descriptor instead.
+func (*TopicStatusHistory) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TopicStatusHistory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TopicStatusHistory) GetTopicCode() string {
+	if x != nil {
+		return x.TopicCode
+	}
+	return ""
+}
+
+func (x *TopicStatusHistory) GetFromStatus() TopicStatus {
+	if x != nil && x.FromStatus != nil {
+		return *x.FromStatus
+	}
+	return TopicStatus_SUBMIT
+}
+
+func (x *TopicStatusHistory) GetToStatus() TopicStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return TopicStatus_SUBMIT
+}
+
+func (x *TopicStatusHistory) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TopicStatusHistory) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *TopicStatusHistory) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SubmitTopicRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Actor         string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitTopicRequest) Reset() {
+	*x = SubmitTopicRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitTopicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitTopicRequest) ProtoMessage() {}
+
+func (x *SubmitTopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitTopicRequest.ProtoReflect.Descriptor instead.
+func (*SubmitTopicRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SubmitTopicRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SubmitTopicRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type SubmitTopicResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         *Topic                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	History       *TopicStatusHistory    `protobuf:"bytes,2,opt,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitTopicResponse) Reset() {
+	*x = SubmitTopicResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitTopicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitTopicResponse) ProtoMessage() {}
+
+func (x *SubmitTopicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitTopicResponse.ProtoReflect.Descriptor instead.
+func (*SubmitTopicResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SubmitTopicResponse) GetTopic() *Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+func (x *SubmitTopicResponse) GetHistory() *TopicStatusHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type ApproveTopicRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Stage         int32                  `protobuf:"varint,2,opt,name=stage,proto3" json:"stage,omitempty"` // 1: TOPIC_PENDING -> APPROVED_1, 2: APPROVED_1 -> APPROVED_2
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveTopicRequest) Reset() {
+	*x = ApproveTopicRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveTopicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveTopicRequest) ProtoMessage() {}
+
+func (x *ApproveTopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveTopicRequest.ProtoReflect.Descriptor instead.
+func (*ApproveTopicRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ApproveTopicRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApproveTopicRequest) GetStage() int32 {
+	if x != nil {
+		return x.Stage
+	}
+	return 0
+}
+
+func (x *ApproveTopicRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ApproveTopicRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ApproveTopicResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         *Topic                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	History       *TopicStatusHistory    `protobuf:"bytes,2,opt,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveTopicResponse) Reset() {
+	*x = ApproveTopicResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveTopicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveTopicResponse) ProtoMessage() {}
+
+func (x *ApproveTopicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveTopicResponse.ProtoReflect.Descriptor instead.
+func (*ApproveTopicResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ApproveTopicResponse) GetTopic() *Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+func (x *ApproveTopicResponse) GetHistory() *TopicStatusHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type RejectTopicRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Stage         int32                  `protobuf:"varint,4,opt,name=stage,proto3" json:"stage,omitempty"` // 1: rejects a TOPIC_PENDING topic, 2: an APPROVED_1 one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectTopicRequest) Reset() {
+	*x = RejectTopicRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectTopicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectTopicRequest) ProtoMessage() {}
+
+func (x *RejectTopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectTopicRequest.ProtoReflect.Descriptor instead.
+func (*RejectTopicRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RejectTopicRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RejectTopicRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RejectTopicRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *RejectTopicRequest) GetStage() int32 {
+	if x != nil {
+		return x.Stage
+	}
+	return 0
+}
+
+type RejectTopicResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         *Topic                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	History       *TopicStatusHistory    `protobuf:"bytes,2,opt,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectTopicResponse) Reset() {
+	*x = RejectTopicResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectTopicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectTopicResponse) ProtoMessage() {}
+
+func (x *RejectTopicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectTopicResponse.ProtoReflect.Descriptor instead.
+func (*RejectTopicResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RejectTopicResponse) GetTopic() *Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+func (x *RejectTopicResponse) GetHistory() *TopicStatusHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type StartTopicRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Actor         string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTopicRequest) Reset() {
+	*x = StartTopicRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTopicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTopicRequest) ProtoMessage() {}
+
+func (x *StartTopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTopicRequest.ProtoReflect.Descriptor instead.
+func (*StartTopicRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *StartTopicRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StartTopicRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type StartTopicResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         *Topic                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	History       *TopicStatusHistory    `protobuf:"bytes,2,opt,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTopicResponse) Reset() {
+	*x = StartTopicResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTopicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTopicResponse) ProtoMessage() {}
+
+func (x *StartTopicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTopicResponse.ProtoReflect.Descriptor instead.
+func (*StartTopicResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *StartTopicResponse) GetTopic() *Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+func (x *StartTopicResponse) GetHistory() *TopicStatusHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type CompleteTopicRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Actor         string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTopicRequest) Reset() {
+	*x = CompleteTopicRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTopicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTopicRequest) ProtoMessage() {}
+
+func (x *CompleteTopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTopicRequest.ProtoReflect.Descriptor instead.
+func (*CompleteTopicRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CompleteTopicRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompleteTopicRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type CompleteTopicResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         *Topic                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	History       *TopicStatusHistory    `protobuf:"bytes,2,opt,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTopicResponse) Reset() {
+	*x = CompleteTopicResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTopicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTopicResponse) ProtoMessage() {}
+
+func (x *CompleteTopicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTopicResponse.ProtoReflect.Descriptor instead.
+func (*CompleteTopicResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CompleteTopicResponse) GetTopic() *Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+func (x *CompleteTopicResponse) GetHistory() *TopicStatusHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type ListTopicStatusHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TopicId       string                 `protobuf:"bytes,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTopicStatusHistoryRequest) Reset() {
+	*x = ListTopicStatusHistoryRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTopicStatusHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopicStatusHistoryRequest) ProtoMessage() {}
+
+func (x *ListTopicStatusHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopicStatusHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListTopicStatusHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListTopicStatusHistoryRequest) GetTopicId() string {
+	if x != nil {
+		return x.TopicId
+	}
+	return ""
+}
+
+type ListTopicStatusHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	History       []*TopicStatusHistory  `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"` // oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTopicStatusHistoryResponse) Reset() {
+	*x = ListTopicStatusHistoryResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTopicStatusHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopicStatusHistoryResponse) ProtoMessage() {}
+
+func (x *ListTopicStatusHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopicStatusHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListTopicStatusHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListTopicStatusHistoryResponse) GetHistory() []*TopicStatusHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// ============= Topic proposal & registration =============
+// A teacher proposes a topic with a capacity; invited co-supervisors co-sign it
+// before it is submitted for approval. Once approved, students rank topics
+// during the registration window and supervisors accept or decline them.
+// Signing creates the Topic_council_supervisor row, accepting creates the Enrollment.
+type ProposeTopicRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Title             string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	MajorCode         string                 `protobuf:"bytes,2,opt,name=major_code,json=majorCode,proto3" json:"major_code,omitempty"`
+	SemesterCode      string                 `protobuf:"bytes,3,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Stage             TopicStage             `protobuf:"varint,4,opt,name=stage,proto3,enum=thesis.TopicStage" json:"stage,omitempty"`
+	MaxStudents       int32                  `protobuf:"varint,5,opt,name=max_students,json=maxStudents,proto3" json:"max_students,omitempty"`
+	RequiredSkills    []string               `protobuf:"bytes,6,rep,name=required_skills,json=requiredSkills,proto3" json:"required_skills,omitempty"`
+	CoSupervisorCodes []string               `protobuf:"bytes,7,rep,name=co_supervisor_codes,json=coSupervisorCodes,proto3" json:"co_supervisor_codes,omitempty"`
+	TimeStart         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
+	TimeEnd           *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
+	ProposedBy        string                 `protobuf:"bytes,10,opt,name=proposed_by,json=proposedBy,proto3" json:"proposed_by,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ProposeTopicRequest) Reset() {
+	*x = ProposeTopicRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposeTopicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeTopicRequest) ProtoMessage() {}
+
+func (x *ProposeTopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeTopicRequest.ProtoReflect.Descriptor instead.
+func (*ProposeTopicRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ProposeTopicRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ProposeTopicRequest) GetMajorCode() string {
+	if x != nil {
+		return x.MajorCode
+	}
+	return ""
+}
+
+func (x *ProposeTopicRequest) GetSemesterCode() string {
+	if x != nil {
+		return x.SemesterCode
+	}
+	return ""
+}
+
+func (x *ProposeTopicRequest) GetStage() TopicStage {
+	if x != nil {
+		return x.Stage
+	}
+	return TopicStage_STAGE_DACN
+}
+
+func (x *ProposeTopicRequest) GetMaxStudents() int32 {
+	if x != nil {
+		return x.MaxStudents
+	}
+	return 0
+}
+
+func (x *ProposeTopicRequest) GetRequiredSkills() []string {
+	if x != nil {
+		return x.RequiredSkills
+	}
+	return nil
+}
+
+func (x *ProposeTopicRequest) GetCoSupervisorCodes() []string {
+	if x != nil {
+		return x.CoSupervisorCodes
+	}
+	return nil
+}
+
+func (x *ProposeTopicRequest) GetTimeStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeStart
+	}
+	return nil
+}
+
+func (x *ProposeTopicRequest) GetTimeEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeEnd
+	}
+	return nil
+}
+
+func (x *ProposeTopicRequest) GetProposedBy() string {
+	if x != nil {
+		return x.ProposedBy
+	}
+	return ""
+}
+
+type ProposeTopicResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Topic          *Topic                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	TopicCouncilId string                 `protobuf:"bytes,2,opt,name=topic_council_id,json=topicCouncilId,proto3" json:"topic_council_id,omitempty"`
+	CoSigns        []*TopicCoSign         `protobuf:"bytes,3,rep,name=co_signs,json=coSigns,proto3" json:"co_signs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProposeTopicResponse) Reset() {
+	*x = ProposeTopicResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposeTopicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeTopicResponse) ProtoMessage() {}
+
+func (x *ProposeTopicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeTopicResponse.ProtoReflect.Descriptor instead.
+func (*ProposeTopicResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ProposeTopicResponse) GetTopic() *Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+func (x *ProposeTopicResponse) GetTopicCouncilId() string {
+	if x != nil {
+		return x.TopicCouncilId
+	}
+	return ""
+}
+
+func (x *ProposeTopicResponse) GetCoSigns() []*TopicCoSign {
+	if x != nil {
+		return x.CoSigns
+	}
+	return nil
+}
+
+type TopicCoSign struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TopicCode     string                 `protobuf:"bytes,2,opt,name=topic_code,json=topicCode,proto3" json:"topic_code,omitempty"`
+	TeacherCode   string                 `protobuf:"bytes,3,opt,name=teacher_code,json=teacherCode,proto3" json:"teacher_code,omitempty"`
+	Status        CoSignStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=thesis.CoSignStatus" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopicCoSign) Reset() {
+	*x = TopicCoSign{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopicCoSign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicCoSign) ProtoMessage() {}
+
+func (x *TopicCoSign) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicCoSign.ProtoReflect.Descriptor instead.
+func (*TopicCoSign) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *TopicCoSign) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TopicCoSign) GetTopicCode() string {
+	if x != nil {
+		return x.TopicCode
+	}
+	return ""
+}
+
+func (x *TopicCoSign) GetTeacherCode() string {
+	if x != nil {
+		return x.TeacherCode
+	}
+	return ""
+}
+
+func (x *TopicCoSign) GetStatus() CoSignStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CoSignStatus_COSIGN_PENDING
+}
+
+func (x *TopicCoSign) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TopicCoSign) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CoSignTopicRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TopicId       string                 `protobuf:"bytes,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	TeacherCode   string                 `protobuf:"bytes,2,opt,name=teacher_code,json=teacherCode,proto3" json:"teacher_code,omitempty"`
+	Accept        bool                   `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoSignTopicRequest) Reset() {
+	*x = CoSignTopicRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoSignTopicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoSignTopicRequest) ProtoMessage() {}
+
+func (x *CoSignTopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoSignTopicRequest.ProtoReflect.Descriptor instead.
+func (*CoSignTopicRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CoSignTopicRequest) GetTopicId() string {
+	if x != nil {
+		return x.TopicId
+	}
+	return ""
+}
+
+func (x *CoSignTopicRequest) GetTeacherCode() string {
+	if x != nil {
+		return x.TeacherCode
+	}
+	return ""
+}
+
+func (x *CoSignTopicRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type CoSignTopicResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CoSign        *TopicCoSign           `protobuf:"bytes,1,opt,name=co_sign,json=coSign,proto3" json:"co_sign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoSignTopicResponse) Reset() {
+	*x = CoSignTopicResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoSignTopicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoSignTopicResponse) ProtoMessage() {}
+
+func (x *CoSignTopicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoSignTopicResponse.ProtoReflect.Descriptor instead.
+func (*CoSignTopicResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CoSignTopicResponse) GetCoSign() *TopicCoSign {
+	if x != nil {
+		return x.CoSign
+	}
+	return nil
+}
+
+type ListTopicCoSignsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TopicId       string                 `protobuf:"bytes,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTopicCoSignsRequest) Reset() {
+	*x = ListTopicCoSignsRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTopicCoSignsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopicCoSignsRequest) ProtoMessage() {}
+
+func (x *ListTopicCoSignsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopicCoSignsRequest.ProtoReflect.Descriptor instead.
+func (*ListTopicCoSignsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListTopicCoSignsRequest) GetTopicId() string {
+	if x != nil {
+		return x.TopicId
+	}
+	return ""
+}
+
+type ListTopicCoSignsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CoSigns       []*TopicCoSign         `protobuf:"bytes,1,rep,name=co_signs,json=coSigns,proto3" json:"co_signs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTopicCoSignsResponse) Reset() {
+	*x = ListTopicCoSignsResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTopicCoSignsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopicCoSignsResponse) ProtoMessage() {}
+
+func (x *ListTopicCoSignsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopicCoSignsResponse.ProtoReflect.Descriptor instead.
+func (*ListTopicCoSignsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListTopicCoSignsResponse) GetCoSigns() []*TopicCoSign {
+	if x != nil {
+		return x.CoSigns
+	}
+	return nil
+}
+
+type RegistrationWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SemesterCode  string                 `protobuf:"bytes,2,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	OpensAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=opens_at,json=opensAt,proto3" json:"opens_at,omitempty"`
+	ClosesAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
+	MaxChoices    int32                  `protobuf:"varint,5,opt,name=max_choices,json=maxChoices,proto3" json:"max_choices,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegistrationWindow) Reset() {
+	*x = RegistrationWindow{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegistrationWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationWindow) ProtoMessage() {}
+
+func (x *RegistrationWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationWindow.ProtoReflect.Descriptor instead.
+func (*RegistrationWindow) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RegistrationWindow) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RegistrationWindow) GetSemesterCode() string {
+	if x != nil {
+		return x.SemesterCode
+	}
+	return ""
+}
+
+func (x *RegistrationWindow) GetOpensAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpensAt
+	}
+	return nil
+}
+
+func (x *RegistrationWindow) GetClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
+func (x *RegistrationWindow) GetMaxChoices() int32 {
+	if x != nil {
+		return x.MaxChoices
+	}
+	return 0
+}
+
+func (x *RegistrationWindow) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RegistrationWindow) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *RegistrationWindow) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *RegistrationWindow) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type SetRegistrationWindowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SemesterCode  string                 `protobuf:"bytes,1,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	OpensAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=opens_at,json=opensAt,proto3" json:"opens_at,omitempty"`
+	ClosesAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
+	MaxChoices    int32                  `protobuf:"varint,4,opt,name=max_choices,json=maxChoices,proto3" json:"max_choices,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRegistrationWindowRequest) Reset() {
+	*x = SetRegistrationWindowRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRegistrationWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRegistrationWindowRequest) ProtoMessage() {}
+
+func (x *SetRegistrationWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRegistrationWindowRequest.ProtoReflect.Descriptor instead.
+func (*SetRegistrationWindowRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SetRegistrationWindowRequest) GetSemesterCode() string {
+	if x != nil {
+		return x.SemesterCode
+	}
+	return ""
+}
+
+func (x *SetRegistrationWindowRequest) GetOpensAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpensAt
+	}
+	return nil
+}
+
+func (x *SetRegistrationWindowRequest) GetClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
+func (x *SetRegistrationWindowRequest) GetMaxChoices() int32 {
+	if x != nil {
+		return x.MaxChoices
+	}
+	return 0
+}
+
+func (x *SetRegistrationWindowRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type SetRegistrationWindowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        *RegistrationWindow    `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRegistrationWindowResponse) Reset() {
+	*x = SetRegistrationWindowResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRegistrationWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRegistrationWindowResponse) ProtoMessage() {}
+
+func (x *SetRegistrationWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRegistrationWindowResponse.ProtoReflect.Descriptor instead.
+func (*SetRegistrationWindowResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SetRegistrationWindowResponse) GetWindow() *RegistrationWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type GetRegistrationWindowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SemesterCode  string                 `protobuf:"bytes,1,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRegistrationWindowRequest) Reset() {
+	*x = GetRegistrationWindowRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRegistrationWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegistrationWindowRequest) ProtoMessage() {}
+
+func (x *GetRegistrationWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegistrationWindowRequest.ProtoReflect.Descriptor instead.
+func (*GetRegistrationWindowRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetRegistrationWindowRequest) GetSemesterCode() string {
+	if x != nil {
+		return x.SemesterCode
+	}
+	return ""
+}
+
+type GetRegistrationWindowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        *RegistrationWindow    `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRegistrationWindowResponse) Reset() {
+	*x = GetRegistrationWindowResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRegistrationWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegistrationWindowResponse) ProtoMessage() {}
+
+func (x *GetRegistrationWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegistrationWindowResponse.ProtoReflect.Descriptor instead.
+func (*GetRegistrationWindowResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetRegistrationWindowResponse) GetWindow() *RegistrationWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type TopicRegistration struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TopicCode      string                 `protobuf:"bytes,2,opt,name=topic_code,json=topicCode,proto3" json:"topic_code,omitempty"`
+	StudentCode    string                 `protobuf:"bytes,3,opt,name=student_code,json=studentCode,proto3" json:"student_code,omitempty"`
+	SemesterCode   string                 `protobuf:"bytes,4,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Rank           int32                  `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"` // 1 = first choice
+	Status         RegistrationStatus     `protobuf:"varint,6,opt,name=status,proto3,enum=thesis.RegistrationStatus" json:"status,omitempty"`
+	Reason         string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"` // why the supervisor declined
+	DecidedBy      string                 `protobuf:"bytes,8,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	DecidedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	EnrollmentCode string                 `protobuf:"bytes,10,opt,name=enrollment_code,json=enrollmentCode,proto3" json:"enrollment_code,omitempty"` // set once accepted
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TopicRegistration) Reset() {
+	*x = TopicRegistration{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopicRegistration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicRegistration) ProtoMessage() {}
+
+func (x *TopicRegistration) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicRegistration.ProtoReflect.Descriptor instead.
+func (*TopicRegistration) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *TopicRegistration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TopicRegistration) GetTopicCode() string {
+	if x != nil {
+		return x.TopicCode
+	}
+	return ""
+}
+
+func (x *TopicRegistration) GetStudentCode() string {
+	if x != nil {
+		return x.StudentCode
+	}
+	return ""
+}
+
+func (x *TopicRegistration) GetSemesterCode() string {
+	if x != nil {
+		return x.SemesterCode
+	}
+	return ""
+}
+
+func (x *TopicRegistration) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TopicRegistration) GetStatus() RegistrationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RegistrationStatus_REGISTRATION_PENDING
+}
+
+func (x *TopicRegistration) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TopicRegistration) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *TopicRegistration) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+func (x *TopicRegistration) GetEnrollmentCode() string {
+	if x != nil {
+		return x.EnrollmentCode
+	}
+	return ""
+}
+
+func (x *TopicRegistration) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TopicRegistration) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type RegisterTopicPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentCode   string                 `protobuf:"bytes,1,opt,name=student_code,json=studentCode,proto3" json:"student_code,omitempty"`
+	SemesterCode  string                 `protobuf:"bytes,2,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	TopicCodes    []string               `protobuf:"bytes,3,rep,name=topic_codes,json=topicCodes,proto3" json:"topic_codes,omitempty"` // most preferred first; replaces pending choices
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterTopicPreferencesRequest) Reset() {
+	*x = RegisterTopicPreferencesRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterTopicPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterTopicPreferencesRequest) ProtoMessage() {}
+
+func (x *RegisterTopicPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterTopicPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*RegisterTopicPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *RegisterTopicPreferencesRequest) GetStudentCode() string {
+	if x != nil {
+		return x.StudentCode
+	}
+	return ""
+}
+
+func (x *RegisterTopicPreferencesRequest) GetSemesterCode() string {
+	if x != nil {
+		return x.SemesterCode
+	}
+	return ""
+}
+
+func (x *RegisterTopicPreferencesRequest) GetTopicCodes() []string {
+	if x != nil {
+		return x.TopicCodes
+	}
+	return nil
+}
+
+type RegisterTopicPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Registrations []*TopicRegistration   `protobuf:"bytes,1,rep,name=registrations,proto3" json:"registrations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterTopicPreferencesResponse) Reset() {
+	*x = RegisterTopicPreferencesResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterTopicPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterTopicPreferencesResponse) ProtoMessage() {}
+
+func (x *RegisterTopicPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterTopicPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*RegisterTopicPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RegisterTopicPreferencesResponse) GetRegistrations() []*TopicRegistration {
+	if x != nil {
+		return x.Registrations
+	}
+	return nil
+}
+
+type ListTopicRegistrationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TopicCode     string                 `protobuf:"bytes,1,opt,name=topic_code,json=topicCode,proto3" json:"topic_code,omitempty"`
+	StudentCode   string                 `protobuf:"bytes,2,opt,name=student_code,json=studentCode,proto3" json:"student_code,omitempty"`
+	SemesterCode  string                 `protobuf:"bytes,3,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTopicRegistrationsRequest) Reset() {
+	*x = ListTopicRegistrationsRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTopicRegistrationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopicRegistrationsRequest) ProtoMessage() {}
+
+func (x *ListTopicRegistrationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopicRegistrationsRequest.ProtoReflect.Descriptor instead.
+func (*ListTopicRegistrationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListTopicRegistrationsRequest) GetTopicCode() string {
+	if x != nil {
+		return x.TopicCode
+	}
+	return ""
+}
+
+func (x *ListTopicRegistrationsRequest) GetStudentCode() string {
+	if x != nil {
+		return x.StudentCode
+	}
+	return ""
+}
+
+func (x *ListTopicRegistrationsRequest) GetSemesterCode() string {
+	if x != nil {
+		return x.SemesterCode
+	}
+	return ""
+}
+
+type ListTopicRegistrationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Registrations []*TopicRegistration   `protobuf:"bytes,1,rep,name=registrations,proto3" json:"registrations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTopicRegistrationsResponse) Reset() {
+	*x = ListTopicRegistrationsResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTopicRegistrationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopicRegistrationsResponse) ProtoMessage() {}
+
+func (x *ListTopicRegistrationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopicRegistrationsResponse.ProtoReflect.Descriptor instead.
+func (*ListTopicRegistrationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListTopicRegistrationsResponse) GetRegistrations() []*TopicRegistration {
+	if x != nil {
+		return x.Registrations
+	}
+	return nil
+}
+
+type DecideTopicRegistrationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RegistrationId string                 `protobuf:"bytes,1,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
+	SupervisorCode string                 `protobuf:"bytes,2,opt,name=supervisor_code,json=supervisorCode,proto3" json:"supervisor_code,omitempty"`
+	Accept         bool                   `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
+	Reason         string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DecideTopicRegistrationRequest) Reset() {
+	*x = DecideTopicRegistrationRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideTopicRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideTopicRegistrationRequest) ProtoMessage() {}
+
+func (x *DecideTopicRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideTopicRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*DecideTopicRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DecideTopicRegistrationRequest) GetRegistrationId() string {
+	if x != nil {
+		return x.RegistrationId
+	}
+	return ""
+}
+
+func (x *DecideTopicRegistrationRequest) GetSupervisorCode() string {
+	if x != nil {
+		return x.SupervisorCode
+	}
+	return ""
+}
+
+func (x *DecideTopicRegistrationRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+func (x *DecideTopicRegistrationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DecideTopicRegistrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Registration  *TopicRegistration     `protobuf:"bytes,1,opt,name=registration,proto3" json:"registration,omitempty"`
+	Enrollment    *Enrollment            `protobuf:"bytes,2,opt,name=enrollment,proto3" json:"enrollment,omitempty"` // created when accepted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideTopicRegistrationResponse) Reset() {
+	*x = DecideTopicRegistrationResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideTopicRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideTopicRegistrationResponse) ProtoMessage() {}
+
+func (x *DecideTopicRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideTopicRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*DecideTopicRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *DecideTopicRegistrationResponse) GetRegistration() *TopicRegistration {
+	if x != nil {
+		return x.Registration
+	}
+	return nil
+}
+
+func (x *DecideTopicRegistrationResponse) GetEnrollment() *Enrollment {
+	if x != nil {
+		return x.Enrollment
+	}
+	return nil
+}
+
+// ============= Topic Matching =============
+type SetApplicantRankingRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TopicCode      string                 `protobuf:"bytes,1,opt,name=topic_code,json=topicCode,proto3" json:"topic_code,omitempty"`
+	SupervisorCode string                 `protobuf:"bytes,2,opt,name=supervisor_code,json=supervisorCode,proto3" json:"supervisor_code,omitempty"`
+	StudentCodes   []string               `protobuf:"bytes,3,rep,name=student_codes,json=studentCodes,proto3" json:"student_codes,omitempty"` // best first; replaces the previous ranking
+	Restricted     bool                   `protobuf:"varint,4,opt,name=restricted,proto3" json:"restricted,omitempty"`                        // only ranked students are acceptable
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetApplicantRankingRequest) Reset() {
+	*x = SetApplicantRankingRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetApplicantRankingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetApplicantRankingRequest) ProtoMessage() {}
+
+func (x *SetApplicantRankingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetApplicantRankingRequest.ProtoReflect.Descriptor instead.
+func (*SetApplicantRankingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SetApplicantRankingRequest) GetTopicCode() string {
+	if x != nil {
+		return x.TopicCode
+	}
+	return ""
+}
+
+func (x *SetApplicantRankingRequest) GetSupervisorCode() string {
+	if x != nil {
+		return x.SupervisorCode
+	}
+	return ""
+}
+
+func (x *SetApplicantRankingRequest) GetStudentCodes() []string {
+	if x != nil {
+		return x.StudentCodes
+	}
+	return nil
+}
+
+func (x *SetApplicantRankingRequest) GetRestricted() bool {
+	if x != nil {
+		return x.Restricted
+	}
+	return false
+}
+
+type SetApplicantRankingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TopicCode     string                 `protobuf:"bytes,1,opt,name=topic_code,json=topicCode,proto3" json:"topic_code,omitempty"`
+	StudentCodes  []string               `protobuf:"bytes,2,rep,name=student_codes,json=studentCodes,proto3" json:"student_codes,omitempty"`
+	Restricted    bool                   `protobuf:"varint,3,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetApplicantRankingResponse) Reset() {
+	*x = SetApplicantRankingResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetApplicantRankingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetApplicantRankingResponse) ProtoMessage() {}
+
+func (x *SetApplicantRankingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetApplicantRankingResponse.ProtoReflect.Descriptor instead.
+func (*SetApplicantRankingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SetApplicantRankingResponse) GetTopicCode() string {
+	if x != nil {
+		return x.TopicCode
+	}
+	return ""
+}
+
+func (x *SetApplicantRankingResponse) GetStudentCodes() []string {
+	if x != nil {
+		return x.StudentCodes
+	}
+	return nil
+}
+
+func (x *SetApplicantRankingResponse) GetRestricted() bool {
+	if x != nil {
+		return x.Restricted
+	}
+	return false
+}
+
+type MatchAssignment struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StudentCode      string                 `protobuf:"bytes,1,opt,name=student_code,json=studentCode,proto3" json:"student_code,omitempty"`
+	TopicCode        string                 `protobuf:"bytes,2,opt,name=topic_code,json=topicCode,proto3" json:"topic_code,omitempty"`
+	TopicCouncilCode string                 `protobuf:"bytes,3,opt,name=topic_council_code,json=topicCouncilCode,proto3" json:"topic_council_code,omitempty"`
+	Choice           int32                  `protobuf:"varint,4,opt,name=choice,proto3" json:"choice,omitempty"` // rank the student gave the topic
+	RegistrationId   string                 `protobuf:"bytes,5,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
+	EnrollmentCode   string                 `protobuf:"bytes,6,opt,name=enrollment_code,json=enrollmentCode,proto3" json:"enrollment_code,omitempty"` // set once committed
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MatchAssignment) Reset() {
+	*x = MatchAssignment{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchAssignment) ProtoMessage() {}
+
+func (x *MatchAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchAssignment.ProtoReflect.Descriptor instead.
+func (*MatchAssignment) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *MatchAssignment) GetStudentCode() string {
+	if x != nil {
+		return x.StudentCode
+	}
+	return ""
+}
+
+func (x *MatchAssignment) GetTopicCode() string {
+	if x != nil {
+		return x.TopicCode
+	}
+	return ""
+}
+
+func (x *MatchAssignment) GetTopicCouncilCode() string {
+	if x != nil {
+		return x.TopicCouncilCode
+	}
+	return ""
+}
+
+func (x *MatchAssignment) GetChoice() int32 {
+	if x != nil {
+		return x.Choice
+	}
+	return 0
+}
+
+func (x *MatchAssignment) GetRegistrationId() string {
+	if x != nil {
+		return x.RegistrationId
+	}
+	return ""
+}
+
+func (x *MatchAssignment) GetEnrollmentCode() string {
+	if x != nil {
+		return x.EnrollmentCode
+	}
+	return ""
+}
+
+type MatchRejection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TopicCode     string                 `protobuf:"bytes,1,opt,name=topic_code,json=topicCode,proto3" json:"topic_code,omitempty"`
+	Choice        int32                  `protobuf:"varint,2,opt,name=choice,proto3" json:"choice,omitempty"`
+	Reason        MatchRejectionReason   `protobuf:"varint,3,opt,name=reason,proto3,enum=thesis.MatchRejectionReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchRejection) Reset() {
+	*x = MatchRejection{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchRejection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchRejection) ProtoMessage() {}
+
+func (x *MatchRejection) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchRejection.ProtoReflect.Descriptor instead.
+func (*MatchRejection) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *MatchRejection) GetTopicCode() string {
+	if x != nil {
+		return x.TopicCode
+	}
+	return ""
+}
+
+func (x *MatchRejection) GetChoice() int32 {
+	if x != nil {
+		return x.Choice
+	}
+	return 0
+}
+
+func (x *MatchRejection) GetReason() MatchRejectionReason {
+	if x != nil {
+		return x.Reason
+	}
+	return MatchRejectionReason_MATCH_OUTRANKED
+}
+
+type UnmatchedStudent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentCode   string                 `protobuf:"bytes,1,opt,name=student_code,json=studentCode,proto3" json:"student_code,omitempty"`
+	Rejections    []*MatchRejection      `protobuf:"bytes,2,rep,name=rejections,proto3" json:"rejections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmatchedStudent) Reset() {
+	*x = UnmatchedStudent{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmatchedStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmatchedStudent) ProtoMessage() {}
+
+func (x *UnmatchedStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmatchedStudent.ProtoReflect.Descriptor instead.
+func (*UnmatchedStudent) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *UnmatchedStudent) GetStudentCode() string {
+	if x != nil {
+		return x.StudentCode
+	}
+	return ""
+}
+
+func (x *UnmatchedStudent) GetRejections() []*MatchRejection {
+	if x != nil {
+		return x.Rejections
+	}
+	return nil
+}
+
+type TopicMatchingResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SemesterCode  string                 `protobuf:"bytes,1,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	StudentsTotal int32                  `protobuf:"varint,2,opt,name=students_total,json=studentsTotal,proto3" json:"students_total,omitempty"`
+	MatchedTotal  int32                  `protobuf:"varint,3,opt,name=matched_total,json=matchedTotal,proto3" json:"matched_total,omitempty"`
+	Assignments   []*MatchAssignment     `protobuf:"bytes,4,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	Unmatched     []*UnmatchedStudent    `protobuf:"bytes,5,rep,name=unmatched,proto3" json:"unmatched,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopicMatchingResult) Reset() {
+	*x = TopicMatchingResult{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopicMatchingResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicMatchingResult) ProtoMessage() {}
+
+func (x *TopicMatchingResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicMatchingResult.ProtoReflect.Descriptor instead.
+func (*TopicMatchingResult) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *TopicMatchingResult) GetSemesterCode() string {
+	if x != nil {
+		return x.SemesterCode
+	}
+	return ""
+}
+
+func (x *TopicMatchingResult) GetStudentsTotal() int32 {
+	if x != nil {
+		return x.StudentsTotal
+	}
+	return 0
+}
+
+func (x *TopicMatchingResult) GetMatchedTotal() int32 {
+	if x != nil {
+		return x.MatchedTotal
+	}
+	return 0
+}
+
+func (x *TopicMatchingResult) GetAssignments() []*MatchAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *TopicMatchingResult) GetUnmatched() []*UnmatchedStudent {
+	if x != nil {
+		return x.Unmatched
+	}
+	return nil
+}
+
+type PreviewTopicMatchingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SemesterCode  string                 `protobuf:"bytes,1,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewTopicMatchingRequest) Reset() {
+	*x = PreviewTopicMatchingRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewTopicMatchingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewTopicMatchingRequest) ProtoMessage() {}
+
+func (x *PreviewTopicMatchingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewTopicMatchingRequest.ProtoReflect.Descriptor instead.
+func (*PreviewTopicMatchingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *PreviewTopicMatchingRequest) GetSemesterCode() string {
+	if x != nil {
+		return x.SemesterCode
+	}
+	return ""
+}
+
+type PreviewTopicMatchingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *TopicMatchingResult   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewTopicMatchingResponse) Reset() {
+	*x = PreviewTopicMatchingResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewTopicMatchingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewTopicMatchingResponse) ProtoMessage() {}
+
+func (x *PreviewTopicMatchingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewTopicMatchingResponse.ProtoReflect.Descriptor instead.
+func (*PreviewTopicMatchingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *PreviewTopicMatchingResponse) GetResult() *TopicMatchingResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type CommitTopicMatchingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SemesterCode  string                 `protobuf:"bytes,1,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	CommittedBy   string                 `protobuf:"bytes,2,opt,name=committed_by,json=committedBy,proto3" json:"committed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitTopicMatchingRequest) Reset() {
+	*x = CommitTopicMatchingRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitTopicMatchingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitTopicMatchingRequest) ProtoMessage() {}
+
+func (x *CommitTopicMatchingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitTopicMatchingRequest.ProtoReflect.Descriptor instead.
+func (*CommitTopicMatchingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CommitTopicMatchingRequest) GetSemesterCode() string {
+	if x != nil {
+		return x.SemesterCode
+	}
+	return ""
+}
+
+func (x *CommitTopicMatchingRequest) GetCommittedBy() string {
+	if x != nil {
+		return x.CommittedBy
+	}
+	return ""
+}
+
+type CommitTopicMatchingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *TopicMatchingResult   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitTopicMatchingResponse) Reset() {
+	*x = CommitTopicMatchingResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitTopicMatchingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitTopicMatchingResponse) ProtoMessage() {}
+
+func (x *CommitTopicMatchingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitTopicMatchingResponse.ProtoReflect.Descriptor instead.
+func (*CommitTopicMatchingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *CommitTopicMatchingResponse) GetResult() *TopicMatchingResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// ============= TopicCouncil =============
+type TopicCouncil struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Stage         TopicStage             `protobuf:"varint,3,opt,name=stage,proto3,enum=thesis.TopicStage" json:"stage,omitempty"`
+	TopicCode     string                 `protobuf:"bytes,4,opt,name=topic_code,json=topicCode,proto3" json:"topic_code,omitempty"`
+	CouncilCode   *string                `protobuf:"bytes,5,opt,name=council_code,json=councilCode,proto3,oneof" json:"council_code,omitempty"`
+	TimeStart     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
+	TimeEnd       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       int32                  `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`                     // bumped by every update
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // set while the record is soft-deleted
+	DeletedBy     string                 `protobuf:"bytes,14,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopicCouncil) Reset() {
+	*x = TopicCouncil{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopicCouncil) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicCouncil) ProtoMessage() {}
+
+func (x *TopicCouncil) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicCouncil.ProtoReflect.Descriptor instead.
+func (*TopicCouncil) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *TopicCouncil) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TopicCouncil) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TopicCouncil) GetStage() TopicStage {
+	if x != nil {
+		return x.Stage
+	}
+	return TopicStage_STAGE_DACN
+}
+
+func (x *TopicCouncil) GetTopicCode() string {
+	if x != nil {
+		return x.TopicCode
+	}
+	return ""
+}
+
+func (x *TopicCouncil) GetCouncilCode() string {
+	if x != nil && x.CouncilCode != nil {
+		return *x.CouncilCode
+	}
+	return ""
+}
+
+func (x *TopicCouncil) GetTimeStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeStart
+	}
+	return nil
+}
+
+func (x *TopicCouncil) GetTimeEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeEnd
+	}
+	return nil
+}
+
+func (x *TopicCouncil) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TopicCouncil) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TopicCouncil) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *TopicCouncil) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *TopicCouncil) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TopicCouncil) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *TopicCouncil) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type CreateTopicCouncilRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Stage         TopicStage             `protobuf:"varint,2,opt,name=stage,proto3,enum=thesis.TopicStage" json:"stage,omitempty"`
+	TopicCode     string                 `protobuf:"bytes,3,opt,name=topic_code,json=topicCode,proto3" json:"topic_code,omitempty"`
+	CouncilCode   *string                `protobuf:"bytes,4,opt,name=council_code,json=councilCode,proto3,oneof" json:"council_code,omitempty"`
+	TimeStart     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
+	TimeEnd       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTopicCouncilRequest) Reset() {
+	*x = CreateTopicCouncilRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTopicCouncilRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTopicCouncilRequest) ProtoMessage() {}
+
+func (x *CreateTopicCouncilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTopicCouncilRequest.ProtoReflect.Descriptor instead.
+func (*CreateTopicCouncilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *CreateTopicCouncilRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTopicCouncilRequest) GetStage() TopicStage {
+	if x != nil {
+		return x.Stage
+	}
+	return TopicStage_STAGE_DACN
+}
+
+func (x *CreateTopicCouncilRequest) GetTopicCode() string {
+	if x != nil {
+		return x.TopicCode
+	}
+	return ""
+}
+
+func (x *CreateTopicCouncilRequest) GetCouncilCode() string {
+	if x != nil && x.CouncilCode != nil {
+		return *x.CouncilCode
+	}
+	return ""
+}
+
+func (x *CreateTopicCouncilRequest) GetTimeStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeStart
+	}
+	return nil
+}
+
+func (x *CreateTopicCouncilRequest) GetTimeEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeEnd
+	}
+	return nil
+}
+
+func (x *CreateTopicCouncilRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreateTopicCouncilResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TopicCouncil  *TopicCouncil          `protobuf:"bytes,1,opt,name=topic_council,json=topicCouncil,proto3" json:"topic_council,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTopicCouncilResponse) Reset() {
+	*x = CreateTopicCouncilResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTopicCouncilResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTopicCouncilResponse) ProtoMessage() {}
+
+func (x *CreateTopicCouncilResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTopicCouncilResponse.ProtoReflect.Descriptor instead.
+func (*CreateTopicCouncilResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CreateTopicCouncilResponse) GetTopicCouncil() *TopicCouncil {
+	if x != nil {
+		return x.TopicCouncil
+	}
+	return nil
+}
+
+type GetTopicCouncilRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also find a soft-deleted record
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetTopicCouncilRequest) Reset() {
+	*x = GetTopicCouncilRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopicCouncilRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopicCouncilRequest) ProtoMessage() {}
+
+func (x *GetTopicCouncilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopicCouncilRequest.ProtoReflect.Descriptor instead.
+func (*GetTopicCouncilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetTopicCouncilRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetTopicCouncilRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type GetTopicCouncilResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TopicCouncil  *TopicCouncil          `protobuf:"bytes,1,opt,name=topic_council,json=topicCouncil,proto3" json:"topic_council,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopicCouncilResponse) Reset() {
+	*x = GetTopicCouncilResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopicCouncilResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopicCouncilResponse) ProtoMessage() {}
+
+func (x *GetTopicCouncilResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopicCouncilResponse.ProtoReflect.Descriptor instead.
+func (*GetTopicCouncilResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetTopicCouncilResponse) GetTopicCouncil() *TopicCouncil {
+	if x != nil {
+		return x.TopicCouncil
+	}
+	return nil
+}
+
+type UpdateTopicCouncilRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Stage       *TopicStage            `protobuf:"varint,3,opt,name=stage,proto3,enum=thesis.TopicStage,oneof" json:"stage,omitempty"`
+	TopicCode   *string                `protobuf:"bytes,4,opt,name=topic_code,json=topicCode,proto3,oneof" json:"topic_code,omitempty"`
+	CouncilCode *string                `protobuf:"bytes,5,opt,name=council_code,json=councilCode,proto3,oneof" json:"council_code,omitempty"`
+	TimeStart   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time_start,json=timeStart,proto3,oneof" json:"time_start,omitempty"`
+	TimeEnd     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=time_end,json=timeEnd,proto3,oneof" json:"time_end,omitempty"`
+	UpdatedBy   string                 `protobuf:"bytes,8,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version     *int32                 `protobuf:"varint,9,opt,name=version,proto3,oneof" json:"version,omitempty"` // expected version; a stale one fails with ABORTED
+	// Overrides the conflicts of interest a new council_code brings
+	OverrideReason *string `protobuf:"bytes,10,opt,name=override_reason,json=overrideReason,proto3,oneof" json:"override_reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateTopicCouncilRequest) Reset() {
+	*x = UpdateTopicCouncilRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTopicCouncilRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTopicCouncilRequest) ProtoMessage() {}
+
+func (x *UpdateTopicCouncilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTopicCouncilRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTopicCouncilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *UpdateTopicCouncilRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTopicCouncilRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTopicCouncilRequest) GetStage() TopicStage {
+	if x != nil && x.Stage != nil {
+		return *x.Stage
+	}
+	return TopicStage_STAGE_DACN
+}
+
+func (x *UpdateTopicCouncilRequest) GetTopicCode() string {
+	if x != nil && x.TopicCode != nil {
+		return *x.TopicCode
+	}
+	return ""
+}
+
+func (x *UpdateTopicCouncilRequest) GetCouncilCode() string {
+	if x != nil && x.CouncilCode != nil {
+		return *x.CouncilCode
+	}
+	return ""
+}
+
+func (x *UpdateTopicCouncilRequest) GetTimeStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeStart
+	}
+	return nil
+}
+
+func (x *UpdateTopicCouncilRequest) GetTimeEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeEnd
+	}
+	return nil
+}
+
+func (x *UpdateTopicCouncilRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *UpdateTopicCouncilRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *UpdateTopicCouncilRequest) GetOverrideReason() string {
+	if x != nil && x.OverrideReason != nil {
+		return *x.OverrideReason
+	}
+	return ""
+}
+
+type UpdateTopicCouncilResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TopicCouncil  *TopicCouncil          `protobuf:"bytes,1,opt,name=topic_council,json=topicCouncil,proto3" json:"topic_council,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTopicCouncilResponse) Reset() {
+	*x = UpdateTopicCouncilResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTopicCouncilResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTopicCouncilResponse) ProtoMessage() {}
+
+func (x *UpdateTopicCouncilResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTopicCouncilResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTopicCouncilResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *UpdateTopicCouncilResponse) GetTopicCouncil() *TopicCouncil {
+	if x != nil {
+		return x.TopicCouncil
+	}
+	return nil
+}
+
+// Council and time of a topic council in a defence timetable
+type TopicCouncilSlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CouncilCode   string                 `protobuf:"bytes,2,opt,name=council_code,json=councilCode,proto3" json:"council_code,omitempty"`
+	TimeStart     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
+	TimeEnd       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
+	Version       *int32                 `protobuf:"varint,5,opt,name=version,proto3,oneof" json:"version,omitempty"` // expected version; a stale one fails with ABORTED
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopicCouncilSlot) Reset() {
+	*x = TopicCouncilSlot{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopicCouncilSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicCouncilSlot) ProtoMessage() {}
+
+func (x *TopicCouncilSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicCouncilSlot.ProtoReflect.Descriptor instead.
+func (*TopicCouncilSlot) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *TopicCouncilSlot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TopicCouncilSlot) GetCouncilCode() string {
+	if x != nil {
+		return x.CouncilCode
+	}
+	return ""
+}
+
+func (x *TopicCouncilSlot) GetTimeStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeStart
+	}
+	return nil
+}
+
+func (x *TopicCouncilSlot) GetTimeEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeEnd
+	}
+	return nil
+}
+
+func (x *TopicCouncilSlot) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+// Places every topic council of a timetable in one transaction, or none
+type ScheduleTopicCouncilsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TopicCouncils []*TopicCouncilSlot    `protobuf:"bytes,1,rep,name=topic_councils,json=topicCouncils,proto3" json:"topic_councils,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleTopicCouncilsRequest) Reset() {
+	*x = ScheduleTopicCouncilsRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleTopicCouncilsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleTopicCouncilsRequest) ProtoMessage() {}
+
+func (x *ScheduleTopicCouncilsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleTopicCouncilsRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleTopicCouncilsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ScheduleTopicCouncilsRequest) GetTopicCouncils() []*TopicCouncilSlot {
+	if x != nil {
+		return x.TopicCouncils
+	}
+	return nil
+}
+
+func (x *ScheduleTopicCouncilsRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type ScheduleTopicCouncilsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TopicCouncils []*TopicCouncil        `protobuf:"bytes,1,rep,name=topic_councils,json=topicCouncils,proto3" json:"topic_councils,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleTopicCouncilsResponse) Reset() {
+	*x = ScheduleTopicCouncilsResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleTopicCouncilsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleTopicCouncilsResponse) ProtoMessage() {}
+
+func (x *ScheduleTopicCouncilsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleTopicCouncilsResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleTopicCouncilsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ScheduleTopicCouncilsResponse) GetTopicCouncils() []*TopicCouncil {
+	if x != nil {
+		return x.TopicCouncils
+	}
+	return nil
+}
+
+type DeleteTopicCouncilRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTopicCouncilRequest) Reset() {
+	*x = DeleteTopicCouncilRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTopicCouncilRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTopicCouncilRequest) ProtoMessage() {}
+
+func (x *DeleteTopicCouncilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTopicCouncilRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTopicCouncilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *DeleteTopicCouncilRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteTopicCouncilRequest) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type DeleteTopicCouncilResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTopicCouncilResponse) Reset() {
+	*x = DeleteTopicCouncilResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTopicCouncilResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTopicCouncilResponse) ProtoMessage() {}
+
+func (x *DeleteTopicCouncilResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTopicCouncilResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTopicCouncilResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *DeleteTopicCouncilResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RestoreTopicCouncilRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RestoredBy    string                 `protobuf:"bytes,2,opt,name=restored_by,json=restoredBy,proto3" json:"restored_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTopicCouncilRequest) Reset() {
+	*x = RestoreTopicCouncilRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTopicCouncilRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTopicCouncilRequest) ProtoMessage() {}
+
+func (x *RestoreTopicCouncilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTopicCouncilRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTopicCouncilRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *RestoreTopicCouncilRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreTopicCouncilRequest) GetRestoredBy() string {
+	if x != nil {
+		return x.RestoredBy
+	}
+	return ""
+}
+
+type RestoreTopicCouncilResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TopicCouncil  *TopicCouncil          `protobuf:"bytes,1,opt,name=topic_council,json=topicCouncil,proto3" json:"topic_council,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTopicCouncilResponse) Reset() {
+	*x = RestoreTopicCouncilResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTopicCouncilResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTopicCouncilResponse) ProtoMessage() {}
+
+func (x *RestoreTopicCouncilResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTopicCouncilResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTopicCouncilResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *RestoreTopicCouncilResponse) GetTopicCouncil() *TopicCouncil {
+	if x != nil {
+		return x.TopicCouncil
+	}
+	return nil
+}
+
+type ListTopicCouncilsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Search         *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also list soft-deleted records
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListTopicCouncilsRequest) Reset() {
+	*x = ListTopicCouncilsRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTopicCouncilsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopicCouncilsRequest) ProtoMessage() {}
+
+func (x *ListTopicCouncilsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopicCouncilsRequest.ProtoReflect.Descriptor instead.
+func (*ListTopicCouncilsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ListTopicCouncilsRequest) GetSearch() *common.SearchRequest {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *ListTopicCouncilsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ListTopicCouncilsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TopicCouncils []*TopicCouncil        `protobuf:"bytes,1,rep,name=topic_councils,json=topicCouncils,proto3" json:"topic_councils,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTopicCouncilsResponse) Reset() {
+	*x = ListTopicCouncilsResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTopicCouncilsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopicCouncilsResponse) ProtoMessage() {}
+
+func (x *ListTopicCouncilsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopicCouncilsResponse.ProtoReflect.Descriptor instead.
+func (*ListTopicCouncilsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ListTopicCouncilsResponse) GetTopicCouncils() []*TopicCouncil {
+	if x != nil {
+		return x.TopicCouncils
+	}
+	return nil
+}
+
+func (x *ListTopicCouncilsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListTopicCouncilsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTopicCouncilsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ============= TopicCouncilSupervisor =============
+type TopicCouncilSupervisor struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TeacherSupervisorCode string                 `protobuf:"bytes,2,opt,name=teacher_supervisor_code,json=teacherSupervisorCode,proto3" json:"teacher_supervisor_code,omitempty"`
+	TopicCouncilCode      string                 `protobuf:"bytes,3,opt,name=topic_council_code,json=topicCouncilCode,proto3" json:"topic_council_code,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy             string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy             string                 `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version               int32                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`                     // bumped by every update
+	DeletedAt             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // set while the record is soft-deleted
+	DeletedBy             string                 `protobuf:"bytes,10,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *TopicCouncilSupervisor) Reset() {
+	*x = TopicCouncilSupervisor{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopicCouncilSupervisor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicCouncilSupervisor) ProtoMessage() {}
+
+func (x *TopicCouncilSupervisor) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicCouncilSupervisor.ProtoReflect.Descriptor instead.
+func (*TopicCouncilSupervisor) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *TopicCouncilSupervisor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TopicCouncilSupervisor) GetTeacherSupervisorCode() string {
+	if x != nil {
+		return x.TeacherSupervisorCode
+	}
+	return ""
+}
+
+func (x *TopicCouncilSupervisor) GetTopicCouncilCode() string {
+	if x != nil {
+		return x.TopicCouncilCode
+	}
+	return ""
+}
+
+func (x *TopicCouncilSupervisor) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TopicCouncilSupervisor) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TopicCouncilSupervisor) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *TopicCouncilSupervisor) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *TopicCouncilSupervisor) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TopicCouncilSupervisor) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *TopicCouncilSupervisor) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type CreateTopicCouncilSupervisorRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	TeacherSupervisorCode string                 `protobuf:"bytes,1,opt,name=teacher_supervisor_code,json=teacherSupervisorCode,proto3" json:"teacher_supervisor_code,omitempty"`
+	TopicCouncilCode      string                 `protobuf:"bytes,2,opt,name=topic_council_code,json=topicCouncilCode,proto3" json:"topic_council_code,omitempty"`
+	CreatedBy             string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreateTopicCouncilSupervisorRequest) Reset() {
+	*x = CreateTopicCouncilSupervisorRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTopicCouncilSupervisorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTopicCouncilSupervisorRequest) ProtoMessage() {}
+
+func (x *CreateTopicCouncilSupervisorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTopicCouncilSupervisorRequest.ProtoReflect.Descriptor instead.
+func (*CreateTopicCouncilSupervisorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *CreateTopicCouncilSupervisorRequest) GetTeacherSupervisorCode() string {
+	if x != nil {
+		return x.TeacherSupervisorCode
+	}
+	return ""
+}
+
+func (x *CreateTopicCouncilSupervisorRequest) GetTopicCouncilCode() string {
+	if x != nil {
+		return x.TopicCouncilCode
+	}
+	return ""
+}
+
+func (x *CreateTopicCouncilSupervisorRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreateTopicCouncilSupervisorResponse struct {
+	state                  protoimpl.MessageState  `protogen:"open.v1"`
+	TopicCouncilSupervisor *TopicCouncilSupervisor `protobuf:"bytes,1,opt,name=topic_council_supervisor,json=topicCouncilSupervisor,proto3" json:"topic_council_supervisor,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateTopicCouncilSupervisorResponse) Reset() {
+	*x = CreateTopicCouncilSupervisorResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTopicCouncilSupervisorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTopicCouncilSupervisorResponse) ProtoMessage() {}
+
+func (x *CreateTopicCouncilSupervisorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTopicCouncilSupervisorResponse.ProtoReflect.Descriptor instead.
+func (*CreateTopicCouncilSupervisorResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CreateTopicCouncilSupervisorResponse) GetTopicCouncilSupervisor() *TopicCouncilSupervisor {
+	if x != nil {
+		return x.TopicCouncilSupervisor
+	}
+	return nil
+}
+
+type GetTopicCouncilSupervisorRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also find a soft-deleted record
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetTopicCouncilSupervisorRequest) Reset() {
+	*x = GetTopicCouncilSupervisorRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopicCouncilSupervisorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopicCouncilSupervisorRequest) ProtoMessage() {}
+
+func (x *GetTopicCouncilSupervisorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopicCouncilSupervisorRequest.ProtoReflect.Descriptor instead.
+func (*GetTopicCouncilSupervisorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetTopicCouncilSupervisorRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetTopicCouncilSupervisorRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type GetTopicCouncilSupervisorResponse struct {
+	state                  protoimpl.MessageState  `protogen:"open.v1"`
+	TopicCouncilSupervisor *TopicCouncilSupervisor `protobuf:"bytes,1,opt,name=topic_council_supervisor,json=topicCouncilSupervisor,proto3" json:"topic_council_supervisor,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetTopicCouncilSupervisorResponse) Reset() {
+	*x = GetTopicCouncilSupervisorResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopicCouncilSupervisorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopicCouncilSupervisorResponse) ProtoMessage() {}
+
+func (x *GetTopicCouncilSupervisorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopicCouncilSupervisorResponse.ProtoReflect.Descriptor instead.
+func (*GetTopicCouncilSupervisorResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetTopicCouncilSupervisorResponse) GetTopicCouncilSupervisor() *TopicCouncilSupervisor {
+	if x != nil {
+		return x.TopicCouncilSupervisor
+	}
+	return nil
+}
+
+type UpdateTopicCouncilSupervisorRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TeacherSupervisorCode *string                `protobuf:"bytes,2,opt,name=teacher_supervisor_code,json=teacherSupervisorCode,proto3,oneof" json:"teacher_supervisor_code,omitempty"`
+	TopicCouncilCode      *string                `protobuf:"bytes,3,opt,name=topic_council_code,json=topicCouncilCode,proto3,oneof" json:"topic_council_code,omitempty"`
+	UpdatedBy             string                 `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version               *int32                 `protobuf:"varint,5,opt,name=version,proto3,oneof" json:"version,omitempty"` // expected version; a stale one fails with ABORTED
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateTopicCouncilSupervisorRequest) Reset() {
+	*x = UpdateTopicCouncilSupervisorRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTopicCouncilSupervisorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTopicCouncilSupervisorRequest) ProtoMessage() {}
+
+func (x *UpdateTopicCouncilSupervisorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTopicCouncilSupervisorRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTopicCouncilSupervisorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *UpdateTopicCouncilSupervisorRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTopicCouncilSupervisorRequest) GetTeacherSupervisorCode() string {
+	if x != nil && x.TeacherSupervisorCode != nil {
+		return *x.TeacherSupervisorCode
+	}
+	return ""
+}
+
+func (x *UpdateTopicCouncilSupervisorRequest) GetTopicCouncilCode() string {
+	if x != nil && x.TopicCouncilCode != nil {
+		return *x.TopicCouncilCode
+	}
+	return ""
+}
+
+func (x *UpdateTopicCouncilSupervisorRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *UpdateTopicCouncilSupervisorRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type UpdateTopicCouncilSupervisorResponse struct {
+	state                  protoimpl.MessageState  `protogen:"open.v1"`
+	TopicCouncilSupervisor *TopicCouncilSupervisor `protobuf:"bytes,1,opt,name=topic_council_supervisor,json=topicCouncilSupervisor,proto3" json:"topic_council_supervisor,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateTopicCouncilSupervisorResponse) Reset() {
+	*x = UpdateTopicCouncilSupervisorResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTopicCouncilSupervisorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTopicCouncilSupervisorResponse) ProtoMessage() {}
+
+func (x *UpdateTopicCouncilSupervisorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTopicCouncilSupervisorResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTopicCouncilSupervisorResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *UpdateTopicCouncilSupervisorResponse) GetTopicCouncilSupervisor() *TopicCouncilSupervisor {
+	if x != nil {
+		return x.TopicCouncilSupervisor
+	}
+	return nil
+}
+
+type DeleteTopicCouncilSupervisorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTopicCouncilSupervisorRequest) Reset() {
+	*x = DeleteTopicCouncilSupervisorRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTopicCouncilSupervisorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTopicCouncilSupervisorRequest) ProtoMessage() {}
+
+func (x *DeleteTopicCouncilSupervisorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTopicCouncilSupervisorRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTopicCouncilSupervisorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *DeleteTopicCouncilSupervisorRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteTopicCouncilSupervisorRequest) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type DeleteTopicCouncilSupervisorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTopicCouncilSupervisorResponse) Reset() {
+	*x = DeleteTopicCouncilSupervisorResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTopicCouncilSupervisorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTopicCouncilSupervisorResponse) ProtoMessage() {}
+
+func (x *DeleteTopicCouncilSupervisorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTopicCouncilSupervisorResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTopicCouncilSupervisorResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *DeleteTopicCouncilSupervisorResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RestoreTopicCouncilSupervisorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RestoredBy    string                 `protobuf:"bytes,2,opt,name=restored_by,json=restoredBy,proto3" json:"restored_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTopicCouncilSupervisorRequest) Reset() {
+	*x = RestoreTopicCouncilSupervisorRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTopicCouncilSupervisorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTopicCouncilSupervisorRequest) ProtoMessage() {}
+
+func (x *RestoreTopicCouncilSupervisorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTopicCouncilSupervisorRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTopicCouncilSupervisorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *RestoreTopicCouncilSupervisorRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreTopicCouncilSupervisorRequest) GetRestoredBy() string {
+	if x != nil {
+		return x.RestoredBy
+	}
+	return ""
+}
+
+type RestoreTopicCouncilSupervisorResponse struct {
+	state                  protoimpl.MessageState  `protogen:"open.v1"`
+	TopicCouncilSupervisor *TopicCouncilSupervisor `protobuf:"bytes,1,opt,name=topic_council_supervisor,json=topicCouncilSupervisor,proto3" json:"topic_council_supervisor,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RestoreTopicCouncilSupervisorResponse) Reset() {
+	*x = RestoreTopicCouncilSupervisorResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTopicCouncilSupervisorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTopicCouncilSupervisorResponse) ProtoMessage() {}
+
+func (x *RestoreTopicCouncilSupervisorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTopicCouncilSupervisorResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTopicCouncilSupervisorResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *RestoreTopicCouncilSupervisorResponse) GetTopicCouncilSupervisor() *TopicCouncilSupervisor {
+	if x != nil {
+		return x.TopicCouncilSupervisor
+	}
+	return nil
+}
+
+type ListTopicCouncilSupervisorsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Search         *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also list soft-deleted records
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListTopicCouncilSupervisorsRequest) Reset() {
+	*x = ListTopicCouncilSupervisorsRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTopicCouncilSupervisorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopicCouncilSupervisorsRequest) ProtoMessage() {}
+
+func (x *ListTopicCouncilSupervisorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopicCouncilSupervisorsRequest.ProtoReflect.Descriptor instead.
+func (*ListTopicCouncilSupervisorsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ListTopicCouncilSupervisorsRequest) GetSearch() *common.SearchRequest {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *ListTopicCouncilSupervisorsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ListTopicCouncilSupervisorsResponse struct {
+	state                   protoimpl.MessageState    `protogen:"open.v1"`
+	TopicCouncilSupervisors []*TopicCouncilSupervisor `protobuf:"bytes,1,rep,name=topic_council_supervisors,json=topicCouncilSupervisors,proto3" json:"topic_council_supervisors,omitempty"`
+	Total                   int32                     `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page                    int32                     `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize                int32                     `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ListTopicCouncilSupervisorsResponse) Reset() {
+	*x = ListTopicCouncilSupervisorsResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTopicCouncilSupervisorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopicCouncilSupervisorsResponse) ProtoMessage() {}
+
+func (x *ListTopicCouncilSupervisorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopicCouncilSupervisorsResponse.ProtoReflect.Descriptor instead.
+func (*ListTopicCouncilSupervisorsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ListTopicCouncilSupervisorsResponse) GetTopicCouncilSupervisors() []*TopicCouncilSupervisor {
+	if x != nil {
+		return x.TopicCouncilSupervisors
+	}
+	return nil
+}
+
+func (x *ListTopicCouncilSupervisorsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListTopicCouncilSupervisorsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTopicCouncilSupervisorsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ============= GradeReview =============
+type GradeReview struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ReviewGrade    *int32                 `protobuf:"varint,3,opt,name=review_grade,json=reviewGrade,proto3,oneof" json:"review_grade,omitempty"`
+	TeacherCode    string                 `protobuf:"bytes,4,opt,name=teacher_code,json=teacherCode,proto3" json:"teacher_code,omitempty"`
+	Status         FinalStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=thesis.FinalStatus" json:"status,omitempty"`
+	Notes          *string                `protobuf:"bytes,6,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	CompletionDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completion_date,json=completionDate,proto3,oneof" json:"completion_date,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy      string                 `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version        int32                  `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`                     // bumped by every update
+	DeletedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // set while the record is soft-deleted
+	DeletedBy      string                 `protobuf:"bytes,14,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GradeReview) Reset() {
+	*x = GradeReview{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeReview) ProtoMessage() {}
+
+func (x *GradeReview) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeReview.ProtoReflect.Descriptor instead.
+func (*GradeReview) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GradeReview) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GradeReview) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GradeReview) GetReviewGrade() int32 {
+	if x != nil && x.ReviewGrade != nil {
+		return *x.ReviewGrade
+	}
+	return 0
+}
+
+func (x *GradeReview) GetTeacherCode() string {
+	if x != nil {
+		return x.TeacherCode
+	}
+	return ""
+}
+
+func (x *GradeReview) GetStatus() FinalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FinalStatus_PENDING
+}
+
+func (x *GradeReview) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *GradeReview) GetCompletionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletionDate
+	}
+	return nil
+}
+
+func (x *GradeReview) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GradeReview) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GradeReview) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *GradeReview) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *GradeReview) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GradeReview) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *GradeReview) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type CreateGradeReviewRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Title          string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	ReviewGrade    *int32                 `protobuf:"varint,2,opt,name=review_grade,json=reviewGrade,proto3,oneof" json:"review_grade,omitempty"`
+	TeacherCode    string                 `protobuf:"bytes,3,opt,name=teacher_code,json=teacherCode,proto3" json:"teacher_code,omitempty"`
+	Status         FinalStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=thesis.FinalStatus" json:"status,omitempty"`
+	Notes          *string                `protobuf:"bytes,5,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	CompletionDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completion_date,json=completionDate,proto3,oneof" json:"completion_date,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateGradeReviewRequest) Reset() {
+	*x = CreateGradeReviewRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGradeReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGradeReviewRequest) ProtoMessage() {}
+
+func (x *CreateGradeReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGradeReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateGradeReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *CreateGradeReviewRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateGradeReviewRequest) GetReviewGrade() int32 {
+	if x != nil && x.ReviewGrade != nil {
+		return *x.ReviewGrade
+	}
+	return 0
+}
+
+func (x *CreateGradeReviewRequest) GetTeacherCode() string {
+	if x != nil {
+		return x.TeacherCode
+	}
+	return ""
+}
+
+func (x *CreateGradeReviewRequest) GetStatus() FinalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FinalStatus_PENDING
+}
+
+func (x *CreateGradeReviewRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *CreateGradeReviewRequest) GetCompletionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletionDate
+	}
+	return nil
+}
+
+func (x *CreateGradeReviewRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreateGradeReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GradeReview   *GradeReview           `protobuf:"bytes,1,opt,name=grade_review,json=gradeReview,proto3" json:"grade_review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGradeReviewResponse) Reset() {
+	*x = CreateGradeReviewResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGradeReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGradeReviewResponse) ProtoMessage() {}
+
+func (x *CreateGradeReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGradeReviewResponse.ProtoReflect.Descriptor instead.
+func (*CreateGradeReviewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *CreateGradeReviewResponse) GetGradeReview() *GradeReview {
+	if x != nil {
+		return x.GradeReview
+	}
+	return nil
+}
+
+type GetGradeReviewRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also find a soft-deleted record
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetGradeReviewRequest) Reset() {
+	*x = GetGradeReviewRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeReviewRequest) ProtoMessage() {}
+
+func (x *GetGradeReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetGradeReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GetGradeReviewRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGradeReviewRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type GetGradeReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GradeReview   *GradeReview           `protobuf:"bytes,1,opt,name=grade_review,json=gradeReview,proto3" json:"grade_review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradeReviewResponse) Reset() {
+	*x = GetGradeReviewResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeReviewResponse) ProtoMessage() {}
+
+func (x *GetGradeReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x TopicStage) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+// Deprecated: Use GetGradeReviewResponse.ProtoReflect.Descriptor instead.
+func (*GetGradeReviewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{131}
 }
 
-// Deprecated: Use TopicStage.Descriptor instead.
-func (TopicStage) EnumDescriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{3}
+func (x *GetGradeReviewResponse) GetGradeReview() *GradeReview {
+	if x != nil {
+		return x.GradeReview
+	}
+	return nil
 }
 
-// ============= Midterm =============
-type Midterm struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Grade         int32                  `protobuf:"varint,3,opt,name=grade,proto3" json:"grade,omitempty"`
-	Status        MidtermStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=thesis.MidtermStatus" json:"status,omitempty"`
-	Feedback      string                 `protobuf:"bytes,5,opt,name=feedback,proto3" json:"feedback,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedBy     string                 `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateGradeReviewRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	ReviewGrade    *int32                 `protobuf:"varint,3,opt,name=review_grade,json=reviewGrade,proto3,oneof" json:"review_grade,omitempty"`
+	TeacherCode    *string                `protobuf:"bytes,4,opt,name=teacher_code,json=teacherCode,proto3,oneof" json:"teacher_code,omitempty"`
+	Status         *FinalStatus           `protobuf:"varint,5,opt,name=status,proto3,enum=thesis.FinalStatus,oneof" json:"status,omitempty"`
+	Notes          *string                `protobuf:"bytes,6,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	CompletionDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completion_date,json=completionDate,proto3,oneof" json:"completion_date,omitempty"`
+	UpdatedBy      string                 `protobuf:"bytes,8,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version        *int32                 `protobuf:"varint,9,opt,name=version,proto3,oneof" json:"version,omitempty"` // expected version; a stale one fails with ABORTED
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Midterm) Reset() {
-	*x = Midterm{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[0]
+func (x *UpdateGradeReviewRequest) Reset() {
+	*x = UpdateGradeReviewRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Midterm) String() string {
+func (x *UpdateGradeReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Midterm) ProtoMessage() {}
+func (*UpdateGradeReviewRequest) ProtoMessage() {}
 
-func (x *Midterm) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[0]
+func (x *UpdateGradeReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -276,100 +9161,192 @@ func (x *Midterm) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Midterm.ProtoReflect.Descriptor instead.
-func (*Midterm) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use UpdateGradeReviewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGradeReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *Midterm) GetId() string {
+func (x *UpdateGradeReviewRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Midterm) GetTitle() string {
-	if x != nil {
-		return x.Title
+func (x *UpdateGradeReviewRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
 	}
 	return ""
 }
 
-func (x *Midterm) GetGrade() int32 {
-	if x != nil {
-		return x.Grade
+func (x *UpdateGradeReviewRequest) GetReviewGrade() int32 {
+	if x != nil && x.ReviewGrade != nil {
+		return *x.ReviewGrade
 	}
 	return 0
 }
 
-func (x *Midterm) GetStatus() MidtermStatus {
+func (x *UpdateGradeReviewRequest) GetTeacherCode() string {
+	if x != nil && x.TeacherCode != nil {
+		return *x.TeacherCode
+	}
+	return ""
+}
+
+func (x *UpdateGradeReviewRequest) GetStatus() FinalStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return FinalStatus_PENDING
+}
+
+func (x *UpdateGradeReviewRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *UpdateGradeReviewRequest) GetCompletionDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Status
+		return x.CompletionDate
 	}
-	return MidtermStatus_NOT_SUBMITTED
+	return nil
 }
 
-func (x *Midterm) GetFeedback() string {
+func (x *UpdateGradeReviewRequest) GetUpdatedBy() string {
 	if x != nil {
-		return x.Feedback
+		return x.UpdatedBy
 	}
 	return ""
 }
 
-func (x *Midterm) GetCreatedAt() *timestamppb.Timestamp {
+func (x *UpdateGradeReviewRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type UpdateGradeReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GradeReview   *GradeReview           `protobuf:"bytes,1,opt,name=grade_review,json=gradeReview,proto3" json:"grade_review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGradeReviewResponse) Reset() {
+	*x = UpdateGradeReviewResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGradeReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGradeReviewResponse) ProtoMessage() {}
+
+func (x *UpdateGradeReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[133]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Midterm) GetUpdatedAt() *timestamppb.Timestamp {
+// Deprecated: Use UpdateGradeReviewResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGradeReviewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *UpdateGradeReviewResponse) GetGradeReview() *GradeReview {
 	if x != nil {
-		return x.UpdatedAt
+		return x.GradeReview
 	}
 	return nil
 }
 
-func (x *Midterm) GetCreatedBy() string {
+type DeleteGradeReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGradeReviewRequest) Reset() {
+	*x = DeleteGradeReviewRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGradeReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGradeReviewRequest) ProtoMessage() {}
+
+func (x *DeleteGradeReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[134]
 	if x != nil {
-		return x.CreatedBy
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGradeReviewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGradeReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *DeleteGradeReviewRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Midterm) GetUpdatedBy() string {
+func (x *DeleteGradeReviewRequest) GetDeletedBy() string {
 	if x != nil {
-		return x.UpdatedBy
+		return x.DeletedBy
 	}
 	return ""
 }
 
-type CreateMidtermRequest struct {
+type DeleteGradeReviewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Grade         *int32                 `protobuf:"varint,2,opt,name=grade,proto3,oneof" json:"grade,omitempty"`
-	Status        MidtermStatus          `protobuf:"varint,3,opt,name=status,proto3,enum=thesis.MidtermStatus" json:"status,omitempty"`
-	Feedback      *string                `protobuf:"bytes,4,opt,name=feedback,proto3,oneof" json:"feedback,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMidtermRequest) Reset() {
-	*x = CreateMidtermRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[1]
+func (x *DeleteGradeReviewResponse) Reset() {
+	*x = DeleteGradeReviewResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMidtermRequest) String() string {
+func (x *DeleteGradeReviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMidtermRequest) ProtoMessage() {}
+func (*DeleteGradeReviewResponse) ProtoMessage() {}
 
-func (x *CreateMidtermRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[1]
+func (x *DeleteGradeReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -380,68 +9357,92 @@ func (x *CreateMidtermRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMidtermRequest.ProtoReflect.Descriptor instead.
-func (*CreateMidtermRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use DeleteGradeReviewResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGradeReviewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *CreateMidtermRequest) GetTitle() string {
+func (x *DeleteGradeReviewResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Title
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *CreateMidtermRequest) GetGrade() int32 {
-	if x != nil && x.Grade != nil {
-		return *x.Grade
-	}
-	return 0
+type RestoreGradeReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RestoredBy    string                 `protobuf:"bytes,2,opt,name=restored_by,json=restoredBy,proto3" json:"restored_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMidtermRequest) GetStatus() MidtermStatus {
+func (x *RestoreGradeReviewRequest) Reset() {
+	*x = RestoreGradeReviewRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreGradeReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreGradeReviewRequest) ProtoMessage() {}
+
+func (x *RestoreGradeReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[136]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return MidtermStatus_NOT_SUBMITTED
+	return mi.MessageOf(x)
 }
 
-func (x *CreateMidtermRequest) GetFeedback() string {
-	if x != nil && x.Feedback != nil {
-		return *x.Feedback
+// Deprecated: Use RestoreGradeReviewRequest.ProtoReflect.Descriptor instead.
+func (*RestoreGradeReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *RestoreGradeReviewRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateMidtermRequest) GetCreatedBy() string {
+func (x *RestoreGradeReviewRequest) GetRestoredBy() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.RestoredBy
 	}
 	return ""
 }
 
-type CreateMidtermResponse struct {
+type RestoreGradeReviewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Midterm       *Midterm               `protobuf:"bytes,1,opt,name=midterm,proto3" json:"midterm,omitempty"`
+	GradeReview   *GradeReview           `protobuf:"bytes,1,opt,name=grade_review,json=gradeReview,proto3" json:"grade_review,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMidtermResponse) Reset() {
-	*x = CreateMidtermResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[2]
+func (x *RestoreGradeReviewResponse) Reset() {
+	*x = RestoreGradeReviewResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMidtermResponse) String() string {
+func (x *RestoreGradeReviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMidtermResponse) ProtoMessage() {}
+func (*RestoreGradeReviewResponse) ProtoMessage() {}
 
-func (x *CreateMidtermResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[2]
+func (x *RestoreGradeReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,40 +9453,41 @@ func (x *CreateMidtermResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMidtermResponse.ProtoReflect.Descriptor instead.
-func (*CreateMidtermResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use RestoreGradeReviewResponse.ProtoReflect.Descriptor instead.
+func (*RestoreGradeReviewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *CreateMidtermResponse) GetMidterm() *Midterm {
+func (x *RestoreGradeReviewResponse) GetGradeReview() *GradeReview {
 	if x != nil {
-		return x.Midterm
+		return x.GradeReview
 	}
 	return nil
 }
 
-type GetMidtermRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListGradeReviewsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Search         *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also list soft-deleted records
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetMidtermRequest) Reset() {
-	*x = GetMidtermRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[3]
+func (x *ListGradeReviewsRequest) Reset() {
+	*x = ListGradeReviewsRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMidtermRequest) String() string {
+func (x *ListGradeReviewsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMidtermRequest) ProtoMessage() {}
+func (*ListGradeReviewsRequest) ProtoMessage() {}
 
-func (x *GetMidtermRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[3]
+func (x *ListGradeReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -496,40 +9498,50 @@ func (x *GetMidtermRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMidtermRequest.ProtoReflect.Descriptor instead.
-func (*GetMidtermRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListGradeReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListGradeReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *GetMidtermRequest) GetId() string {
+func (x *ListGradeReviewsRequest) GetSearch() *common.SearchRequest {
 	if x != nil {
-		return x.Id
+		return x.Search
 	}
-	return ""
+	return nil
 }
 
-type GetMidtermResponse struct {
+func (x *ListGradeReviewsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ListGradeReviewsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Midterm       *Midterm               `protobuf:"bytes,1,opt,name=midterm,proto3" json:"midterm,omitempty"`
+	GradeReviews  []*GradeReview         `protobuf:"bytes,1,rep,name=grade_reviews,json=gradeReviews,proto3" json:"grade_reviews,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMidtermResponse) Reset() {
-	*x = GetMidtermResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[4]
+func (x *ListGradeReviewsResponse) Reset() {
+	*x = ListGradeReviewsResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMidtermResponse) String() string {
+func (x *ListGradeReviewsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMidtermResponse) ProtoMessage() {}
+func (*ListGradeReviewsResponse) ProtoMessage() {}
 
-func (x *GetMidtermResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[4]
+func (x *ListGradeReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,45 +9552,71 @@ func (x *GetMidtermResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMidtermResponse.ProtoReflect.Descriptor instead.
-func (*GetMidtermResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ListGradeReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListGradeReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *GetMidtermResponse) GetMidterm() *Midterm {
+func (x *ListGradeReviewsResponse) GetGradeReviews() []*GradeReview {
 	if x != nil {
-		return x.Midterm
+		return x.GradeReviews
 	}
 	return nil
 }
 
-type UpdateMidtermRequest struct {
+func (x *ListGradeReviewsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListGradeReviewsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListGradeReviewsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SubmissionDeadline struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Grade         *int32                 `protobuf:"varint,3,opt,name=grade,proto3,oneof" json:"grade,omitempty"`
-	Status        *MidtermStatus         `protobuf:"varint,4,opt,name=status,proto3,enum=thesis.MidtermStatus,oneof" json:"status,omitempty"`
-	Feedback      *string                `protobuf:"bytes,5,opt,name=feedback,proto3,oneof" json:"feedback,omitempty"`
-	UpdatedBy     string                 `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	SemesterCode  string                 `protobuf:"bytes,2,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Stage         TopicStage             `protobuf:"varint,3,opt,name=stage,proto3,enum=thesis.TopicStage" json:"stage,omitempty"`
+	Kind          SubmissionKind         `protobuf:"varint,4,opt,name=kind,proto3,enum=thesis.SubmissionKind" json:"kind,omitempty"`
+	OpensAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=opens_at,json=opensAt,proto3" json:"opens_at,omitempty"`
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	GraceMinutes  int32                  `protobuf:"varint,7,opt,name=grace_minutes,json=graceMinutes,proto3" json:"grace_minutes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMidtermRequest) Reset() {
-	*x = UpdateMidtermRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[5]
+func (x *SubmissionDeadline) Reset() {
+	*x = SubmissionDeadline{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMidtermRequest) String() string {
+func (x *SubmissionDeadline) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMidtermRequest) ProtoMessage() {}
+func (*SubmissionDeadline) ProtoMessage() {}
 
-func (x *UpdateMidtermRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[5]
+func (x *SubmissionDeadline) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,75 +9627,117 @@ func (x *UpdateMidtermRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMidtermRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMidtermRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use SubmissionDeadline.ProtoReflect.Descriptor instead.
+func (*SubmissionDeadline) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *UpdateMidtermRequest) GetId() string {
+func (x *SubmissionDeadline) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateMidtermRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+func (x *SubmissionDeadline) GetSemesterCode() string {
+	if x != nil {
+		return x.SemesterCode
 	}
 	return ""
 }
 
-func (x *UpdateMidtermRequest) GetGrade() int32 {
-	if x != nil && x.Grade != nil {
-		return *x.Grade
+func (x *SubmissionDeadline) GetStage() TopicStage {
+	if x != nil {
+		return x.Stage
+	}
+	return TopicStage_STAGE_DACN
+}
+
+func (x *SubmissionDeadline) GetKind() SubmissionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SubmissionKind_SUBMISSION_MIDTERM
+}
+
+func (x *SubmissionDeadline) GetOpensAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpensAt
+	}
+	return nil
+}
+
+func (x *SubmissionDeadline) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *SubmissionDeadline) GetGraceMinutes() int32 {
+	if x != nil {
+		return x.GraceMinutes
 	}
 	return 0
 }
 
-func (x *UpdateMidtermRequest) GetStatus() MidtermStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *SubmissionDeadline) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
-	return MidtermStatus_NOT_SUBMITTED
+	return nil
 }
 
-func (x *UpdateMidtermRequest) GetFeedback() string {
-	if x != nil && x.Feedback != nil {
-		return *x.Feedback
+func (x *SubmissionDeadline) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SubmissionDeadline) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
 	}
 	return ""
 }
 
-func (x *UpdateMidtermRequest) GetUpdatedBy() string {
+func (x *SubmissionDeadline) GetUpdatedBy() string {
 	if x != nil {
 		return x.UpdatedBy
 	}
 	return ""
 }
 
-type UpdateMidtermResponse struct {
+// SetSubmissionDeadline creates or replaces the window of (semester_code, stage, kind)
+type SetSubmissionDeadlineRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Midterm       *Midterm               `protobuf:"bytes,1,opt,name=midterm,proto3" json:"midterm,omitempty"`
+	SemesterCode  string                 `protobuf:"bytes,1,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Stage         TopicStage             `protobuf:"varint,2,opt,name=stage,proto3,enum=thesis.TopicStage" json:"stage,omitempty"`
+	Kind          SubmissionKind         `protobuf:"varint,3,opt,name=kind,proto3,enum=thesis.SubmissionKind" json:"kind,omitempty"`
+	OpensAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=opens_at,json=opensAt,proto3" json:"opens_at,omitempty"`
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	GraceMinutes  int32                  `protobuf:"varint,6,opt,name=grace_minutes,json=graceMinutes,proto3" json:"grace_minutes,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMidtermResponse) Reset() {
-	*x = UpdateMidtermResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[6]
+func (x *SetSubmissionDeadlineRequest) Reset() {
+	*x = SetSubmissionDeadlineRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMidtermResponse) String() string {
+func (x *SetSubmissionDeadlineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMidtermResponse) ProtoMessage() {}
+func (*SetSubmissionDeadlineRequest) ProtoMessage() {}
 
-func (x *UpdateMidtermResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[6]
+func (x *SetSubmissionDeadlineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,84 +9748,82 @@ func (x *UpdateMidtermResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMidtermResponse.ProtoReflect.Descriptor instead.
-func (*UpdateMidtermResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use SetSubmissionDeadlineRequest.ProtoReflect.Descriptor instead.
+func (*SetSubmissionDeadlineRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *UpdateMidtermResponse) GetMidterm() *Midterm {
+func (x *SetSubmissionDeadlineRequest) GetSemesterCode() string {
 	if x != nil {
-		return x.Midterm
+		return x.SemesterCode
 	}
-	return nil
+	return ""
 }
 
-type DeleteMidtermRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SetSubmissionDeadlineRequest) GetStage() TopicStage {
+	if x != nil {
+		return x.Stage
+	}
+	return TopicStage_STAGE_DACN
 }
 
-func (x *DeleteMidtermRequest) Reset() {
-	*x = DeleteMidtermRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SetSubmissionDeadlineRequest) GetKind() SubmissionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SubmissionKind_SUBMISSION_MIDTERM
 }
 
-func (x *DeleteMidtermRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SetSubmissionDeadlineRequest) GetOpensAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpensAt
+	}
+	return nil
 }
 
-func (*DeleteMidtermRequest) ProtoMessage() {}
-
-func (x *DeleteMidtermRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[7]
+func (x *SetSubmissionDeadlineRequest) GetDueAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DueAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DeleteMidtermRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMidtermRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{7}
+func (x *SetSubmissionDeadlineRequest) GetGraceMinutes() int32 {
+	if x != nil {
+		return x.GraceMinutes
+	}
+	return 0
 }
 
-func (x *DeleteMidtermRequest) GetId() string {
+func (x *SetSubmissionDeadlineRequest) GetCreatedBy() string {
 	if x != nil {
-		return x.Id
+		return x.CreatedBy
 	}
 	return ""
 }
 
-type DeleteMidtermResponse struct {
+type SetSubmissionDeadlineResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Deadline      *SubmissionDeadline    `protobuf:"bytes,1,opt,name=deadline,proto3" json:"deadline,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMidtermResponse) Reset() {
-	*x = DeleteMidtermResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[8]
+func (x *SetSubmissionDeadlineResponse) Reset() {
+	*x = SetSubmissionDeadlineResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMidtermResponse) String() string {
+func (x *SetSubmissionDeadlineResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMidtermResponse) ProtoMessage() {}
+func (*SetSubmissionDeadlineResponse) ProtoMessage() {}
 
-func (x *DeleteMidtermResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[8]
+func (x *SetSubmissionDeadlineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,40 +9834,40 @@ func (x *DeleteMidtermResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMidtermResponse.ProtoReflect.Descriptor instead.
-func (*DeleteMidtermResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SetSubmissionDeadlineResponse.ProtoReflect.Descriptor instead.
+func (*SetSubmissionDeadlineResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *DeleteMidtermResponse) GetSuccess() bool {
+func (x *SetSubmissionDeadlineResponse) GetDeadline() *SubmissionDeadline {
 	if x != nil {
-		return x.Success
+		return x.Deadline
 	}
-	return false
+	return nil
 }
 
-type ListMidtermsRequest struct {
+type ListSubmissionDeadlinesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	SemesterCode  string                 `protobuf:"bytes,1,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMidtermsRequest) Reset() {
-	*x = ListMidtermsRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[9]
+func (x *ListSubmissionDeadlinesRequest) Reset() {
+	*x = ListSubmissionDeadlinesRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMidtermsRequest) String() string {
+func (x *ListSubmissionDeadlinesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMidtermsRequest) ProtoMessage() {}
+func (*ListSubmissionDeadlinesRequest) ProtoMessage() {}
 
-func (x *ListMidtermsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[9]
+func (x *ListSubmissionDeadlinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,43 +9878,40 @@ func (x *ListMidtermsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMidtermsRequest.ProtoReflect.Descriptor instead.
-func (*ListMidtermsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListSubmissionDeadlinesRequest.ProtoReflect.Descriptor instead.
+func (*ListSubmissionDeadlinesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *ListMidtermsRequest) GetSearch() *common.SearchRequest {
+func (x *ListSubmissionDeadlinesRequest) GetSemesterCode() string {
 	if x != nil {
-		return x.Search
+		return x.SemesterCode
 	}
-	return nil
+	return ""
 }
 
-type ListMidtermsResponse struct {
+type ListSubmissionDeadlinesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Midterms      []*Midterm             `protobuf:"bytes,1,rep,name=midterms,proto3" json:"midterms,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Deadlines     []*SubmissionDeadline  `protobuf:"bytes,1,rep,name=deadlines,proto3" json:"deadlines,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMidtermsResponse) Reset() {
-	*x = ListMidtermsResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[10]
+func (x *ListSubmissionDeadlinesResponse) Reset() {
+	*x = ListSubmissionDeadlinesResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMidtermsResponse) String() string {
+func (x *ListSubmissionDeadlinesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMidtermsResponse) ProtoMessage() {}
+func (*ListSubmissionDeadlinesResponse) ProtoMessage() {}
 
-func (x *ListMidtermsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[10]
+func (x *ListSubmissionDeadlinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -847,73 +9922,50 @@ func (x *ListMidtermsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMidtermsResponse.ProtoReflect.Descriptor instead.
-func (*ListMidtermsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListSubmissionDeadlinesResponse.ProtoReflect.Descriptor instead.
+func (*ListSubmissionDeadlinesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *ListMidtermsResponse) GetMidterms() []*Midterm {
+func (x *ListSubmissionDeadlinesResponse) GetDeadlines() []*SubmissionDeadline {
 	if x != nil {
-		return x.Midterms
+		return x.Deadlines
 	}
 	return nil
 }
 
-func (x *ListMidtermsResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *ListMidtermsResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListMidtermsResponse) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-// ============= Final =============
-type Final struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	SupervisorGrade int32                  `protobuf:"varint,3,opt,name=supervisor_grade,json=supervisorGrade,proto3" json:"supervisor_grade,omitempty"`
-	DepartmentGrade int32                  `protobuf:"varint,4,opt,name=department_grade,json=departmentGrade,proto3" json:"department_grade,omitempty"`
-	FinalGrade      int32                  `protobuf:"varint,5,opt,name=final_grade,json=finalGrade,proto3" json:"final_grade,omitempty"`
-	Status          FinalStatus            `protobuf:"varint,6,opt,name=status,proto3,enum=thesis.FinalStatus" json:"status,omitempty"`
-	Notes           string                 `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
-	CompletionDate  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=completion_date,json=completionDate,proto3" json:"completion_date,omitempty"`
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedBy       string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedBy       string                 `protobuf:"bytes,12,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type DeadlineExtension struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SemesterCode  string                 `protobuf:"bytes,2,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Stage         TopicStage             `protobuf:"varint,3,opt,name=stage,proto3,enum=thesis.TopicStage" json:"stage,omitempty"`
+	Kind          SubmissionKind         `protobuf:"varint,4,opt,name=kind,proto3,enum=thesis.SubmissionKind" json:"kind,omitempty"`
+	StudentCode   string                 `protobuf:"bytes,5,opt,name=student_code,json=studentCode,proto3" json:"student_code,omitempty"`
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Final) Reset() {
-	*x = Final{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[11]
+func (x *DeadlineExtension) Reset() {
+	*x = DeadlineExtension{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Final) String() string {
+func (x *DeadlineExtension) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Final) ProtoMessage() {}
+func (*DeadlineExtension) ProtoMessage() {}
 
-func (x *Final) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[11]
+func (x *DeadlineExtension) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -924,124 +9976,116 @@ func (x *Final) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Final.ProtoReflect.Descriptor instead.
-func (*Final) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use DeadlineExtension.ProtoReflect.Descriptor instead.
+func (*DeadlineExtension) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *Final) GetId() string {
+func (x *DeadlineExtension) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Final) GetTitle() string {
+func (x *DeadlineExtension) GetSemesterCode() string {
 	if x != nil {
-		return x.Title
+		return x.SemesterCode
 	}
 	return ""
 }
 
-func (x *Final) GetSupervisorGrade() int32 {
+func (x *DeadlineExtension) GetStage() TopicStage {
 	if x != nil {
-		return x.SupervisorGrade
+		return x.Stage
 	}
-	return 0
+	return TopicStage_STAGE_DACN
 }
 
-func (x *Final) GetDepartmentGrade() int32 {
+func (x *DeadlineExtension) GetKind() SubmissionKind {
 	if x != nil {
-		return x.DepartmentGrade
+		return x.Kind
 	}
-	return 0
+	return SubmissionKind_SUBMISSION_MIDTERM
 }
 
-func (x *Final) GetFinalGrade() int32 {
+func (x *DeadlineExtension) GetStudentCode() string {
 	if x != nil {
-		return x.FinalGrade
+		return x.StudentCode
 	}
-	return 0
+	return ""
 }
 
-func (x *Final) GetStatus() FinalStatus {
+func (x *DeadlineExtension) GetDueAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Status
+		return x.DueAt
 	}
-	return FinalStatus_PENDING
+	return nil
 }
 
-func (x *Final) GetNotes() string {
+func (x *DeadlineExtension) GetReason() string {
 	if x != nil {
-		return x.Notes
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Final) GetCompletionDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CompletionDate
-	}
-	return nil
-}
-
-func (x *Final) GetCreatedAt() *timestamppb.Timestamp {
+func (x *DeadlineExtension) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Final) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *DeadlineExtension) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (x *Final) GetCreatedBy() string {
+func (x *DeadlineExtension) GetCreatedBy() string {
 	if x != nil {
 		return x.CreatedBy
 	}
 	return ""
 }
 
-func (x *Final) GetUpdatedBy() string {
+func (x *DeadlineExtension) GetUpdatedBy() string {
 	if x != nil {
 		return x.UpdatedBy
 	}
 	return ""
 }
 
-type CreateFinalRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	SupervisorGrade *int32                 `protobuf:"varint,2,opt,name=supervisor_grade,json=supervisorGrade,proto3,oneof" json:"supervisor_grade,omitempty"`
-	DepartmentGrade *int32                 `protobuf:"varint,3,opt,name=department_grade,json=departmentGrade,proto3,oneof" json:"department_grade,omitempty"`
-	FinalGrade      *int32                 `protobuf:"varint,4,opt,name=final_grade,json=finalGrade,proto3,oneof" json:"final_grade,omitempty"`
-	Status          FinalStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=thesis.FinalStatus" json:"status,omitempty"`
-	Notes           *string                `protobuf:"bytes,6,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
-	CompletionDate  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completion_date,json=completionDate,proto3,oneof" json:"completion_date,omitempty"`
-	CreatedBy       string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// GrantDeadlineExtension is only accepted from a supervisor of the student's topic council
+type GrantDeadlineExtensionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SemesterCode  string                 `protobuf:"bytes,1,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Kind          SubmissionKind         `protobuf:"varint,2,opt,name=kind,proto3,enum=thesis.SubmissionKind" json:"kind,omitempty"`
+	StudentCode   string                 `protobuf:"bytes,3,opt,name=student_code,json=studentCode,proto3" json:"student_code,omitempty"`
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	GrantedBy     string                 `protobuf:"bytes,6,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateFinalRequest) Reset() {
-	*x = CreateFinalRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[12]
+func (x *GrantDeadlineExtensionRequest) Reset() {
+	*x = GrantDeadlineExtensionRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateFinalRequest) String() string {
+func (x *GrantDeadlineExtensionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateFinalRequest) ProtoMessage() {}
+func (*GrantDeadlineExtensionRequest) ProtoMessage() {}
 
-func (x *CreateFinalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[12]
+func (x *GrantDeadlineExtensionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1052,89 +10096,121 @@ func (x *CreateFinalRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateFinalRequest.ProtoReflect.Descriptor instead.
-func (*CreateFinalRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GrantDeadlineExtensionRequest.ProtoReflect.Descriptor instead.
+func (*GrantDeadlineExtensionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *CreateFinalRequest) GetTitle() string {
+func (x *GrantDeadlineExtensionRequest) GetSemesterCode() string {
 	if x != nil {
-		return x.Title
+		return x.SemesterCode
 	}
 	return ""
 }
 
-func (x *CreateFinalRequest) GetSupervisorGrade() int32 {
-	if x != nil && x.SupervisorGrade != nil {
-		return *x.SupervisorGrade
+func (x *GrantDeadlineExtensionRequest) GetKind() SubmissionKind {
+	if x != nil {
+		return x.Kind
 	}
-	return 0
+	return SubmissionKind_SUBMISSION_MIDTERM
 }
 
-func (x *CreateFinalRequest) GetDepartmentGrade() int32 {
-	if x != nil && x.DepartmentGrade != nil {
-		return *x.DepartmentGrade
+func (x *GrantDeadlineExtensionRequest) GetStudentCode() string {
+	if x != nil {
+		return x.StudentCode
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateFinalRequest) GetFinalGrade() int32 {
-	if x != nil && x.FinalGrade != nil {
-		return *x.FinalGrade
+func (x *GrantDeadlineExtensionRequest) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateFinalRequest) GetStatus() FinalStatus {
+func (x *GrantDeadlineExtensionRequest) GetReason() string {
 	if x != nil {
-		return x.Status
+		return x.Reason
 	}
-	return FinalStatus_PENDING
+	return ""
 }
 
-func (x *CreateFinalRequest) GetNotes() string {
-	if x != nil && x.Notes != nil {
-		return *x.Notes
+func (x *GrantDeadlineExtensionRequest) GetGrantedBy() string {
+	if x != nil {
+		return x.GrantedBy
 	}
 	return ""
 }
 
-func (x *CreateFinalRequest) GetCompletionDate() *timestamppb.Timestamp {
+type GrantDeadlineExtensionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Extension     *DeadlineExtension     `protobuf:"bytes,1,opt,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantDeadlineExtensionResponse) Reset() {
+	*x = GrantDeadlineExtensionResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantDeadlineExtensionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantDeadlineExtensionResponse) ProtoMessage() {}
+
+func (x *GrantDeadlineExtensionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[147]
 	if x != nil {
-		return x.CompletionDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateFinalRequest) GetCreatedBy() string {
+// Deprecated: Use GrantDeadlineExtensionResponse.ProtoReflect.Descriptor instead.
+func (*GrantDeadlineExtensionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GrantDeadlineExtensionResponse) GetExtension() *DeadlineExtension {
 	if x != nil {
-		return x.CreatedBy
+		return x.Extension
 	}
-	return ""
+	return nil
 }
 
-type CreateFinalResponse struct {
+type CheckSubmissionWindowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Final         *Final                 `protobuf:"bytes,1,opt,name=final,proto3" json:"final,omitempty"`
+	SemesterCode  string                 `protobuf:"bytes,1,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Kind          SubmissionKind         `protobuf:"varint,2,opt,name=kind,proto3,enum=thesis.SubmissionKind" json:"kind,omitempty"`
+	StudentCode   string                 `protobuf:"bytes,3,opt,name=student_code,json=studentCode,proto3" json:"student_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateFinalResponse) Reset() {
-	*x = CreateFinalResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[13]
+func (x *CheckSubmissionWindowRequest) Reset() {
+	*x = CheckSubmissionWindowRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateFinalResponse) String() string {
+func (x *CheckSubmissionWindowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateFinalResponse) ProtoMessage() {}
+func (*CheckSubmissionWindowRequest) ProtoMessage() {}
 
-func (x *CreateFinalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[13]
+func (x *CheckSubmissionWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1145,84 +10221,179 @@ func (x *CreateFinalResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateFinalResponse.ProtoReflect.Descriptor instead.
-func (*CreateFinalResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CheckSubmissionWindowRequest.ProtoReflect.Descriptor instead.
+func (*CheckSubmissionWindowRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *CreateFinalResponse) GetFinal() *Final {
+func (x *CheckSubmissionWindowRequest) GetSemesterCode() string {
 	if x != nil {
-		return x.Final
+		return x.SemesterCode
 	}
-	return nil
+	return ""
 }
 
-type GetFinalRequest struct {
+func (x *CheckSubmissionWindowRequest) GetKind() SubmissionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SubmissionKind_SUBMISSION_MIDTERM
+}
+
+func (x *CheckSubmissionWindowRequest) GetStudentCode() string {
+	if x != nil {
+		return x.StudentCode
+	}
+	return ""
+}
+
+type CheckSubmissionWindowResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Late          bool                   `protobuf:"varint,2,opt,name=late,proto3" json:"late,omitempty"`
+	Extended      bool                   `protobuf:"varint,3,opt,name=extended,proto3" json:"extended,omitempty"`
+	Stage         TopicStage             `protobuf:"varint,4,opt,name=stage,proto3,enum=thesis.TopicStage" json:"stage,omitempty"`
+	OpensAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=opens_at,json=opensAt,proto3" json:"opens_at,omitempty"`
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	ClosesAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	TableId       string                 `protobuf:"bytes,9,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"` // the student's Midterm or Final the submission is attached to
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFinalRequest) Reset() {
-	*x = GetFinalRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[14]
+func (x *CheckSubmissionWindowResponse) Reset() {
+	*x = CheckSubmissionWindowResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFinalRequest) String() string {
+func (x *CheckSubmissionWindowResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFinalRequest) ProtoMessage() {}
+func (*CheckSubmissionWindowResponse) ProtoMessage() {}
+
+func (x *CheckSubmissionWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSubmissionWindowResponse.ProtoReflect.Descriptor instead.
+func (*CheckSubmissionWindowResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *CheckSubmissionWindowResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CheckSubmissionWindowResponse) GetLate() bool {
+	if x != nil {
+		return x.Late
+	}
+	return false
+}
+
+func (x *CheckSubmissionWindowResponse) GetExtended() bool {
+	if x != nil {
+		return x.Extended
+	}
+	return false
+}
+
+func (x *CheckSubmissionWindowResponse) GetStage() TopicStage {
+	if x != nil {
+		return x.Stage
+	}
+	return TopicStage_STAGE_DACN
+}
+
+func (x *CheckSubmissionWindowResponse) GetOpensAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpensAt
+	}
+	return nil
+}
+
+func (x *CheckSubmissionWindowResponse) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
 
-func (x *GetFinalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[14]
+func (x *CheckSubmissionWindowResponse) GetClosesAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ClosesAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetFinalRequest.ProtoReflect.Descriptor instead.
-func (*GetFinalRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{14}
+func (x *CheckSubmissionWindowResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-func (x *GetFinalRequest) GetId() string {
+func (x *CheckSubmissionWindowResponse) GetTableId() string {
 	if x != nil {
-		return x.Id
+		return x.TableId
 	}
 	return ""
 }
 
-type GetFinalResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Final         *Final                 `protobuf:"bytes,1,opt,name=final,proto3" json:"final,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// How a major's final grades are computed in a semester and stage. Weights
+// are relative; a zero weight drops the component.
+type GradingPolicy struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MajorCode        string                 `protobuf:"bytes,2,opt,name=major_code,json=majorCode,proto3" json:"major_code,omitempty"`
+	SemesterCode     string                 `protobuf:"bytes,3,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Stage            TopicStage             `protobuf:"varint,4,opt,name=stage,proto3,enum=thesis.TopicStage" json:"stage,omitempty"`
+	SupervisorWeight float64                `protobuf:"fixed64,5,opt,name=supervisor_weight,json=supervisorWeight,proto3" json:"supervisor_weight,omitempty"`
+	ReviewerWeight   float64                `protobuf:"fixed64,6,opt,name=reviewer_weight,json=reviewerWeight,proto3" json:"reviewer_weight,omitempty"`
+	CouncilWeight    float64                `protobuf:"fixed64,7,opt,name=council_weight,json=councilWeight,proto3" json:"council_weight,omitempty"`
+	Rounding         GradeRounding          `protobuf:"varint,8,opt,name=rounding,proto3,enum=thesis.GradeRounding" json:"rounding,omitempty"`
+	RoundingStep     float64                `protobuf:"fixed64,9,opt,name=rounding_step,json=roundingStep,proto3" json:"rounding_step,omitempty"`
+	PassThreshold    float64                `protobuf:"fixed64,10,opt,name=pass_threshold,json=passThreshold,proto3" json:"pass_threshold,omitempty"`
+	// Largest gap between council members' scores before they must reconcile; 0 disables it
+	MaxCouncilSpread float64                `protobuf:"fixed64,11,opt,name=max_council_spread,json=maxCouncilSpread,proto3" json:"max_council_spread,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy        string                 `protobuf:"bytes,14,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy        string                 `protobuf:"bytes,15,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetFinalResponse) Reset() {
-	*x = GetFinalResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[15]
+func (x *GradingPolicy) Reset() {
+	*x = GradingPolicy{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFinalResponse) String() string {
+func (x *GradingPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFinalResponse) ProtoMessage() {}
+func (*GradingPolicy) ProtoMessage() {}
 
-func (x *GetFinalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[15]
+func (x *GradingPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1233,148 +10404,149 @@ func (x *GetFinalResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFinalResponse.ProtoReflect.Descriptor instead.
-func (*GetFinalResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GradingPolicy.ProtoReflect.Descriptor instead.
+func (*GradingPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *GetFinalResponse) GetFinal() *Final {
+func (x *GradingPolicy) GetId() string {
 	if x != nil {
-		return x.Final
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type UpdateFinalRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title           *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	SupervisorGrade *int32                 `protobuf:"varint,3,opt,name=supervisor_grade,json=supervisorGrade,proto3,oneof" json:"supervisor_grade,omitempty"`
-	DepartmentGrade *int32                 `protobuf:"varint,4,opt,name=department_grade,json=departmentGrade,proto3,oneof" json:"department_grade,omitempty"`
-	FinalGrade      *int32                 `protobuf:"varint,5,opt,name=final_grade,json=finalGrade,proto3,oneof" json:"final_grade,omitempty"`
-	Status          *FinalStatus           `protobuf:"varint,6,opt,name=status,proto3,enum=thesis.FinalStatus,oneof" json:"status,omitempty"`
-	Notes           *string                `protobuf:"bytes,7,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
-	CompletionDate  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=completion_date,json=completionDate,proto3,oneof" json:"completion_date,omitempty"`
-	UpdatedBy       string                 `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *GradingPolicy) GetMajorCode() string {
+	if x != nil {
+		return x.MajorCode
+	}
+	return ""
 }
 
-func (x *UpdateFinalRequest) Reset() {
-	*x = UpdateFinalRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[16]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GradingPolicy) GetSemesterCode() string {
+	if x != nil {
+		return x.SemesterCode
+	}
+	return ""
 }
 
-func (x *UpdateFinalRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GradingPolicy) GetStage() TopicStage {
+	if x != nil {
+		return x.Stage
+	}
+	return TopicStage_STAGE_DACN
 }
 
-func (*UpdateFinalRequest) ProtoMessage() {}
-
-func (x *UpdateFinalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[16]
+func (x *GradingPolicy) GetSupervisorWeight() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SupervisorWeight
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use UpdateFinalRequest.ProtoReflect.Descriptor instead.
-func (*UpdateFinalRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{16}
+func (x *GradingPolicy) GetReviewerWeight() float64 {
+	if x != nil {
+		return x.ReviewerWeight
+	}
+	return 0
 }
 
-func (x *UpdateFinalRequest) GetId() string {
+func (x *GradingPolicy) GetCouncilWeight() float64 {
 	if x != nil {
-		return x.Id
+		return x.CouncilWeight
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateFinalRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+func (x *GradingPolicy) GetRounding() GradeRounding {
+	if x != nil {
+		return x.Rounding
 	}
-	return ""
+	return GradeRounding_ROUND_HALF_UP
 }
 
-func (x *UpdateFinalRequest) GetSupervisorGrade() int32 {
-	if x != nil && x.SupervisorGrade != nil {
-		return *x.SupervisorGrade
+func (x *GradingPolicy) GetRoundingStep() float64 {
+	if x != nil {
+		return x.RoundingStep
 	}
 	return 0
 }
 
-func (x *UpdateFinalRequest) GetDepartmentGrade() int32 {
-	if x != nil && x.DepartmentGrade != nil {
-		return *x.DepartmentGrade
+func (x *GradingPolicy) GetPassThreshold() float64 {
+	if x != nil {
+		return x.PassThreshold
 	}
 	return 0
 }
 
-func (x *UpdateFinalRequest) GetFinalGrade() int32 {
-	if x != nil && x.FinalGrade != nil {
-		return *x.FinalGrade
+func (x *GradingPolicy) GetMaxCouncilSpread() float64 {
+	if x != nil {
+		return x.MaxCouncilSpread
 	}
 	return 0
 }
 
-func (x *UpdateFinalRequest) GetStatus() FinalStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *GradingPolicy) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
-	return FinalStatus_PENDING
+	return nil
 }
 
-func (x *UpdateFinalRequest) GetNotes() string {
-	if x != nil && x.Notes != nil {
-		return *x.Notes
+func (x *GradingPolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateFinalRequest) GetCompletionDate() *timestamppb.Timestamp {
+func (x *GradingPolicy) GetCreatedBy() string {
 	if x != nil {
-		return x.CompletionDate
+		return x.CreatedBy
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateFinalRequest) GetUpdatedBy() string {
+func (x *GradingPolicy) GetUpdatedBy() string {
 	if x != nil {
 		return x.UpdatedBy
 	}
 	return ""
 }
 
-type UpdateFinalResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Final         *Final                 `protobuf:"bytes,1,opt,name=final,proto3" json:"final,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// SetGradingPolicy creates or replaces the policy of (major_code, semester_code, stage)
+type SetGradingPolicyRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MajorCode        string                 `protobuf:"bytes,1,opt,name=major_code,json=majorCode,proto3" json:"major_code,omitempty"`
+	SemesterCode     string                 `protobuf:"bytes,2,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Stage            TopicStage             `protobuf:"varint,3,opt,name=stage,proto3,enum=thesis.TopicStage" json:"stage,omitempty"`
+	SupervisorWeight float64                `protobuf:"fixed64,4,opt,name=supervisor_weight,json=supervisorWeight,proto3" json:"supervisor_weight,omitempty"`
+	ReviewerWeight   float64                `protobuf:"fixed64,5,opt,name=reviewer_weight,json=reviewerWeight,proto3" json:"reviewer_weight,omitempty"`
+	CouncilWeight    float64                `protobuf:"fixed64,6,opt,name=council_weight,json=councilWeight,proto3" json:"council_weight,omitempty"`
+	Rounding         GradeRounding          `protobuf:"varint,7,opt,name=rounding,proto3,enum=thesis.GradeRounding" json:"rounding,omitempty"`
+	RoundingStep     float64                `protobuf:"fixed64,8,opt,name=rounding_step,json=roundingStep,proto3" json:"rounding_step,omitempty"`
+	PassThreshold    float64                `protobuf:"fixed64,9,opt,name=pass_threshold,json=passThreshold,proto3" json:"pass_threshold,omitempty"`
+	MaxCouncilSpread float64                `protobuf:"fixed64,10,opt,name=max_council_spread,json=maxCouncilSpread,proto3" json:"max_council_spread,omitempty"`
+	CreatedBy        string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *UpdateFinalResponse) Reset() {
-	*x = UpdateFinalResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[17]
+func (x *SetGradingPolicyRequest) Reset() {
+	*x = SetGradingPolicyRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateFinalResponse) String() string {
+func (x *SetGradingPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateFinalResponse) ProtoMessage() {}
+func (*SetGradingPolicyRequest) ProtoMessage() {}
 
-func (x *UpdateFinalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[17]
+func (x *SetGradingPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1385,40 +10557,110 @@ func (x *UpdateFinalResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateFinalResponse.ProtoReflect.Descriptor instead.
-func (*UpdateFinalResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SetGradingPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetGradingPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *UpdateFinalResponse) GetFinal() *Final {
+func (x *SetGradingPolicyRequest) GetMajorCode() string {
 	if x != nil {
-		return x.Final
+		return x.MajorCode
 	}
-	return nil
+	return ""
 }
 
-type DeleteFinalRequest struct {
+func (x *SetGradingPolicyRequest) GetSemesterCode() string {
+	if x != nil {
+		return x.SemesterCode
+	}
+	return ""
+}
+
+func (x *SetGradingPolicyRequest) GetStage() TopicStage {
+	if x != nil {
+		return x.Stage
+	}
+	return TopicStage_STAGE_DACN
+}
+
+func (x *SetGradingPolicyRequest) GetSupervisorWeight() float64 {
+	if x != nil {
+		return x.SupervisorWeight
+	}
+	return 0
+}
+
+func (x *SetGradingPolicyRequest) GetReviewerWeight() float64 {
+	if x != nil {
+		return x.ReviewerWeight
+	}
+	return 0
+}
+
+func (x *SetGradingPolicyRequest) GetCouncilWeight() float64 {
+	if x != nil {
+		return x.CouncilWeight
+	}
+	return 0
+}
+
+func (x *SetGradingPolicyRequest) GetRounding() GradeRounding {
+	if x != nil {
+		return x.Rounding
+	}
+	return GradeRounding_ROUND_HALF_UP
+}
+
+func (x *SetGradingPolicyRequest) GetRoundingStep() float64 {
+	if x != nil {
+		return x.RoundingStep
+	}
+	return 0
+}
+
+func (x *SetGradingPolicyRequest) GetPassThreshold() float64 {
+	if x != nil {
+		return x.PassThreshold
+	}
+	return 0
+}
+
+func (x *SetGradingPolicyRequest) GetMaxCouncilSpread() float64 {
+	if x != nil {
+		return x.MaxCouncilSpread
+	}
+	return 0
+}
+
+func (x *SetGradingPolicyRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type SetGradingPolicyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Policy        *GradingPolicy         `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteFinalRequest) Reset() {
-	*x = DeleteFinalRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[18]
+func (x *SetGradingPolicyResponse) Reset() {
+	*x = SetGradingPolicyResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteFinalRequest) String() string {
+func (x *SetGradingPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFinalRequest) ProtoMessage() {}
+func (*SetGradingPolicyResponse) ProtoMessage() {}
 
-func (x *DeleteFinalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[18]
+func (x *SetGradingPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1429,40 +10671,40 @@ func (x *DeleteFinalRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFinalRequest.ProtoReflect.Descriptor instead.
-func (*DeleteFinalRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SetGradingPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetGradingPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *DeleteFinalRequest) GetId() string {
+func (x *SetGradingPolicyResponse) GetPolicy() *GradingPolicy {
 	if x != nil {
-		return x.Id
+		return x.Policy
 	}
-	return ""
+	return nil
 }
 
-type DeleteFinalResponse struct {
+type ListGradingPoliciesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	SemesterCode  string                 `protobuf:"bytes,1,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteFinalResponse) Reset() {
-	*x = DeleteFinalResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[19]
+func (x *ListGradingPoliciesRequest) Reset() {
+	*x = ListGradingPoliciesRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteFinalResponse) String() string {
+func (x *ListGradingPoliciesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFinalResponse) ProtoMessage() {}
+func (*ListGradingPoliciesRequest) ProtoMessage() {}
 
-func (x *DeleteFinalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[19]
+func (x *ListGradingPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1473,40 +10715,40 @@ func (x *DeleteFinalResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFinalResponse.ProtoReflect.Descriptor instead.
-func (*DeleteFinalResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListGradingPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListGradingPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *DeleteFinalResponse) GetSuccess() bool {
+func (x *ListGradingPoliciesRequest) GetSemesterCode() string {
 	if x != nil {
-		return x.Success
+		return x.SemesterCode
 	}
-	return false
+	return ""
 }
 
-type ListFinalsRequest struct {
+type ListGradingPoliciesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	Policies      []*GradingPolicy       `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListFinalsRequest) Reset() {
-	*x = ListFinalsRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[20]
+func (x *ListGradingPoliciesResponse) Reset() {
+	*x = ListGradingPoliciesResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListFinalsRequest) String() string {
+func (x *ListGradingPoliciesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFinalsRequest) ProtoMessage() {}
+func (*ListGradingPoliciesResponse) ProtoMessage() {}
 
-func (x *ListFinalsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[20]
+func (x *ListGradingPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1517,43 +10759,40 @@ func (x *ListFinalsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFinalsRequest.ProtoReflect.Descriptor instead.
-func (*ListFinalsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListGradingPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListGradingPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *ListFinalsRequest) GetSearch() *common.SearchRequest {
+func (x *ListGradingPoliciesResponse) GetPolicies() []*GradingPolicy {
 	if x != nil {
-		return x.Search
+		return x.Policies
 	}
 	return nil
 }
 
-type ListFinalsResponse struct {
+type DeleteGradingPolicyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Finals        []*Final               `protobuf:"bytes,1,rep,name=finals,proto3" json:"finals,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
-
-func (x *ListFinalsResponse) Reset() {
-	*x = ListFinalsResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[21]
+
+func (x *DeleteGradingPolicyRequest) Reset() {
+	*x = DeleteGradingPolicyRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListFinalsResponse) String() string {
+func (x *DeleteGradingPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFinalsResponse) ProtoMessage() {}
+func (*DeleteGradingPolicyRequest) ProtoMessage() {}
 
-func (x *ListFinalsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[21]
+func (x *DeleteGradingPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1564,72 +10803,86 @@ func (x *ListFinalsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFinalsResponse.ProtoReflect.Descriptor instead.
-func (*ListFinalsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use DeleteGradingPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGradingPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *ListFinalsResponse) GetFinals() []*Final {
+func (x *DeleteGradingPolicyRequest) GetId() string {
 	if x != nil {
-		return x.Finals
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ListFinalsResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+type DeleteGradingPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListFinalsResponse) GetPage() int32 {
+func (x *DeleteGradingPolicyResponse) Reset() {
+	*x = DeleteGradingPolicyResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGradingPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGradingPolicyResponse) ProtoMessage() {}
+
+func (x *DeleteGradingPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[156]
 	if x != nil {
-		return x.Page
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ListFinalsResponse) GetPageSize() int32 {
+// Deprecated: Use DeleteGradingPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGradingPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *DeleteGradingPolicyResponse) GetSuccess() bool {
 	if x != nil {
-		return x.PageSize
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-// ============= Enrollment =============
-type Enrollment struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title            string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	StudentCode      string                 `protobuf:"bytes,3,opt,name=student_code,json=studentCode,proto3" json:"student_code,omitempty"`
-	TopicCouncilCode string                 `protobuf:"bytes,4,opt,name=topic_council_code,json=topicCouncilCode,proto3" json:"topic_council_code,omitempty"`
-	FinalCode        *string                `protobuf:"bytes,5,opt,name=final_code,json=finalCode,proto3,oneof" json:"final_code,omitempty"`
-	GradeReviewCode  *string                `protobuf:"bytes,6,opt,name=grade_review_code,json=gradeReviewCode,proto3,oneof" json:"grade_review_code,omitempty"`
-	MidtermCode      *string                `protobuf:"bytes,7,opt,name=midterm_code,json=midtermCode,proto3,oneof" json:"midterm_code,omitempty"`
-	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedBy        string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedBy        string                 `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// A council member's GradeDefence.total_score, unset until they finish grading
+type CouncilMemberScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherCode   string                 `protobuf:"bytes,1,opt,name=teacher_code,json=teacherCode,proto3" json:"teacher_code,omitempty"`
+	TotalScore    *float64               `protobuf:"fixed64,2,opt,name=total_score,json=totalScore,proto3,oneof" json:"total_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Enrollment) Reset() {
-	*x = Enrollment{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[22]
+func (x *CouncilMemberScore) Reset() {
+	*x = CouncilMemberScore{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Enrollment) String() string {
+func (x *CouncilMemberScore) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Enrollment) ProtoMessage() {}
+func (*CouncilMemberScore) ProtoMessage() {}
 
-func (x *Enrollment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[22]
+func (x *CouncilMemberScore) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1640,116 +10893,121 @@ func (x *Enrollment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Enrollment.ProtoReflect.Descriptor instead.
-func (*Enrollment) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use CouncilMemberScore.ProtoReflect.Descriptor instead.
+func (*CouncilMemberScore) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *Enrollment) GetId() string {
+func (x *CouncilMemberScore) GetTeacherCode() string {
 	if x != nil {
-		return x.Id
+		return x.TeacherCode
 	}
 	return ""
 }
 
-func (x *Enrollment) GetTitle() string {
-	if x != nil {
-		return x.Title
+func (x *CouncilMemberScore) GetTotalScore() float64 {
+	if x != nil && x.TotalScore != nil {
+		return *x.TotalScore
 	}
-	return ""
+	return 0
 }
 
-func (x *Enrollment) GetStudentCode() string {
-	if x != nil {
-		return x.StudentCode
-	}
-	return ""
+// ComputeFinalGrade derives an enrollment's final grade. Council scores live
+// in the council service and are passed in, one per council member. With
+// finalize, final_grade and status are written to the Final; that is refused
+// while an input is missing or the council must reconcile.
+type ComputeFinalGradeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EnrollmentCode string                 `protobuf:"bytes,1,opt,name=enrollment_code,json=enrollmentCode,proto3" json:"enrollment_code,omitempty"`
+	CouncilScores  []*CouncilMemberScore  `protobuf:"bytes,2,rep,name=council_scores,json=councilScores,proto3" json:"council_scores,omitempty"`
+	Finalize       bool                   `protobuf:"varint,3,opt,name=finalize,proto3" json:"finalize,omitempty"`
+	Actor          string                 `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Enrollment) GetTopicCouncilCode() string {
-	if x != nil {
-		return x.TopicCouncilCode
-	}
-	return ""
+func (x *ComputeFinalGradeRequest) Reset() {
+	*x = ComputeFinalGradeRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Enrollment) GetFinalCode() string {
-	if x != nil && x.FinalCode != nil {
-		return *x.FinalCode
-	}
-	return ""
+func (x *ComputeFinalGradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Enrollment) GetGradeReviewCode() string {
-	if x != nil && x.GradeReviewCode != nil {
-		return *x.GradeReviewCode
+func (*ComputeFinalGradeRequest) ProtoMessage() {}
+
+func (x *ComputeFinalGradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Enrollment) GetMidtermCode() string {
-	if x != nil && x.MidtermCode != nil {
-		return *x.MidtermCode
-	}
-	return ""
+// Deprecated: Use ComputeFinalGradeRequest.ProtoReflect.Descriptor instead.
+func (*ComputeFinalGradeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *Enrollment) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ComputeFinalGradeRequest) GetEnrollmentCode() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.EnrollmentCode
 	}
-	return nil
+	return ""
 }
 
-func (x *Enrollment) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ComputeFinalGradeRequest) GetCouncilScores() []*CouncilMemberScore {
 	if x != nil {
-		return x.UpdatedAt
+		return x.CouncilScores
 	}
 	return nil
 }
 
-func (x *Enrollment) GetCreatedBy() string {
+func (x *ComputeFinalGradeRequest) GetFinalize() bool {
 	if x != nil {
-		return x.CreatedBy
+		return x.Finalize
 	}
-	return ""
+	return false
 }
 
-func (x *Enrollment) GetUpdatedBy() string {
+func (x *ComputeFinalGradeRequest) GetActor() string {
 	if x != nil {
-		return x.UpdatedBy
+		return x.Actor
 	}
 	return ""
 }
 
-type CreateEnrollmentRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Title            string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	StudentCode      string                 `protobuf:"bytes,2,opt,name=student_code,json=studentCode,proto3" json:"student_code,omitempty"`
-	TopicCouncilCode string                 `protobuf:"bytes,3,opt,name=topic_council_code,json=topicCouncilCode,proto3" json:"topic_council_code,omitempty"`
-	FinalCode        *string                `protobuf:"bytes,4,opt,name=final_code,json=finalCode,proto3,oneof" json:"final_code,omitempty"`
-	GradeReviewCode  *string                `protobuf:"bytes,5,opt,name=grade_review_code,json=gradeReviewCode,proto3,oneof" json:"grade_review_code,omitempty"`
-	MidtermCode      *string                `protobuf:"bytes,6,opt,name=midterm_code,json=midtermCode,proto3,oneof" json:"midterm_code,omitempty"`
-	CreatedBy        string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type GradeComponent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Score         *float64               `protobuf:"fixed64,2,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	Weight        float64                `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEnrollmentRequest) Reset() {
-	*x = CreateEnrollmentRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[23]
+func (x *GradeComponent) Reset() {
+	*x = GradeComponent{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEnrollmentRequest) String() string {
+func (x *GradeComponent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEnrollmentRequest) ProtoMessage() {}
+func (*GradeComponent) ProtoMessage() {}
 
-func (x *CreateEnrollmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[23]
+func (x *GradeComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1760,82 +11018,64 @@ func (x *CreateEnrollmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEnrollmentRequest.ProtoReflect.Descriptor instead.
-func (*CreateEnrollmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *CreateEnrollmentRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *CreateEnrollmentRequest) GetStudentCode() string {
-	if x != nil {
-		return x.StudentCode
-	}
-	return ""
+// Deprecated: Use GradeComponent.ProtoReflect.Descriptor instead.
+func (*GradeComponent) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *CreateEnrollmentRequest) GetTopicCouncilCode() string {
+func (x *GradeComponent) GetName() string {
 	if x != nil {
-		return x.TopicCouncilCode
-	}
-	return ""
-}
-
-func (x *CreateEnrollmentRequest) GetFinalCode() string {
-	if x != nil && x.FinalCode != nil {
-		return *x.FinalCode
-	}
-	return ""
-}
-
-func (x *CreateEnrollmentRequest) GetGradeReviewCode() string {
-	if x != nil && x.GradeReviewCode != nil {
-		return *x.GradeReviewCode
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateEnrollmentRequest) GetMidtermCode() string {
-	if x != nil && x.MidtermCode != nil {
-		return *x.MidtermCode
+func (x *GradeComponent) GetScore() float64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateEnrollmentRequest) GetCreatedBy() string {
+func (x *GradeComponent) GetWeight() float64 {
 	if x != nil {
-		return x.CreatedBy
+		return x.Weight
 	}
-	return ""
+	return 0
 }
 
-type CreateEnrollmentResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enrollment    *Enrollment            `protobuf:"bytes,1,opt,name=enrollment,proto3" json:"enrollment,omitempty"`
+type FinalGradeBreakdown struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PolicyCode          string                 `protobuf:"bytes,1,opt,name=policy_code,json=policyCode,proto3" json:"policy_code,omitempty"`
+	Components          []*GradeComponent      `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
+	CouncilSpread       *float64               `protobuf:"fixed64,3,opt,name=council_spread,json=councilSpread,proto3,oneof" json:"council_spread,omitempty"`
+	NeedsReconciliation bool                   `protobuf:"varint,4,opt,name=needs_reconciliation,json=needsReconciliation,proto3" json:"needs_reconciliation,omitempty"`
+	// supervisor, reviewer, council or council:<teacher_code>
+	Missing    []string    `protobuf:"bytes,5,rep,name=missing,proto3" json:"missing,omitempty"`
+	RawGrade   *float64    `protobuf:"fixed64,6,opt,name=raw_grade,json=rawGrade,proto3,oneof" json:"raw_grade,omitempty"`
+	FinalGrade *float64    `protobuf:"fixed64,7,opt,name=final_grade,json=finalGrade,proto3,oneof" json:"final_grade,omitempty"`
+	Status     FinalStatus `protobuf:"varint,8,opt,name=status,proto3,enum=thesis.FinalStatus" json:"status,omitempty"`
+	// Human-readable explanation of the calculation
+	Steps         []string `protobuf:"bytes,9,rep,name=steps,proto3" json:"steps,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEnrollmentResponse) Reset() {
-	*x = CreateEnrollmentResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[24]
+func (x *FinalGradeBreakdown) Reset() {
+	*x = FinalGradeBreakdown{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEnrollmentResponse) String() string {
+func (x *FinalGradeBreakdown) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEnrollmentResponse) ProtoMessage() {}
+func (*FinalGradeBreakdown) ProtoMessage() {}
 
-func (x *CreateEnrollmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[24]
+func (x *FinalGradeBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1846,84 +11086,98 @@ func (x *CreateEnrollmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEnrollmentResponse.ProtoReflect.Descriptor instead.
-func (*CreateEnrollmentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use FinalGradeBreakdown.ProtoReflect.Descriptor instead.
+func (*FinalGradeBreakdown) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *CreateEnrollmentResponse) GetEnrollment() *Enrollment {
+func (x *FinalGradeBreakdown) GetPolicyCode() string {
 	if x != nil {
-		return x.Enrollment
+		return x.PolicyCode
+	}
+	return ""
+}
+
+func (x *FinalGradeBreakdown) GetComponents() []*GradeComponent {
+	if x != nil {
+		return x.Components
 	}
 	return nil
 }
 
-type GetEnrollmentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *FinalGradeBreakdown) GetCouncilSpread() float64 {
+	if x != nil && x.CouncilSpread != nil {
+		return *x.CouncilSpread
+	}
+	return 0
 }
 
-func (x *GetEnrollmentRequest) Reset() {
-	*x = GetEnrollmentRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[25]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *FinalGradeBreakdown) GetNeedsReconciliation() bool {
+	if x != nil {
+		return x.NeedsReconciliation
+	}
+	return false
 }
 
-func (x *GetEnrollmentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FinalGradeBreakdown) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
 }
 
-func (*GetEnrollmentRequest) ProtoMessage() {}
+func (x *FinalGradeBreakdown) GetRawGrade() float64 {
+	if x != nil && x.RawGrade != nil {
+		return *x.RawGrade
+	}
+	return 0
+}
 
-func (x *GetEnrollmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[25]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *FinalGradeBreakdown) GetFinalGrade() float64 {
+	if x != nil && x.FinalGrade != nil {
+		return *x.FinalGrade
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetEnrollmentRequest.ProtoReflect.Descriptor instead.
-func (*GetEnrollmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{25}
+func (x *FinalGradeBreakdown) GetStatus() FinalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FinalStatus_PENDING
 }
 
-func (x *GetEnrollmentRequest) GetId() string {
+func (x *FinalGradeBreakdown) GetSteps() []string {
 	if x != nil {
-		return x.Id
+		return x.Steps
 	}
-	return ""
+	return nil
 }
 
-type GetEnrollmentResponse struct {
+type ComputeFinalGradeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enrollment    *Enrollment            `protobuf:"bytes,1,opt,name=enrollment,proto3" json:"enrollment,omitempty"`
+	Breakdown     *FinalGradeBreakdown   `protobuf:"bytes,1,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
+	Finalized     bool                   `protobuf:"varint,2,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	Final         *Final                 `protobuf:"bytes,3,opt,name=final,proto3" json:"final,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEnrollmentResponse) Reset() {
-	*x = GetEnrollmentResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[26]
+func (x *ComputeFinalGradeResponse) Reset() {
+	*x = ComputeFinalGradeResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEnrollmentResponse) String() string {
+func (x *ComputeFinalGradeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEnrollmentResponse) ProtoMessage() {}
+func (*ComputeFinalGradeResponse) ProtoMessage() {}
 
-func (x *GetEnrollmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[26]
+func (x *ComputeFinalGradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1934,47 +11188,69 @@ func (x *GetEnrollmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEnrollmentResponse.ProtoReflect.Descriptor instead.
-func (*GetEnrollmentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ComputeFinalGradeResponse.ProtoReflect.Descriptor instead.
+func (*ComputeFinalGradeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *GetEnrollmentResponse) GetEnrollment() *Enrollment {
+func (x *ComputeFinalGradeResponse) GetBreakdown() *FinalGradeBreakdown {
 	if x != nil {
-		return x.Enrollment
+		return x.Breakdown
 	}
 	return nil
 }
 
-type UpdateEnrollmentRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title            *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	StudentCode      *string                `protobuf:"bytes,3,opt,name=student_code,json=studentCode,proto3,oneof" json:"student_code,omitempty"`
-	TopicCouncilCode *string                `protobuf:"bytes,4,opt,name=topic_council_code,json=topicCouncilCode,proto3,oneof" json:"topic_council_code,omitempty"`
-	FinalCode        *string                `protobuf:"bytes,5,opt,name=final_code,json=finalCode,proto3,oneof" json:"final_code,omitempty"`
-	GradeReviewCode  *string                `protobuf:"bytes,6,opt,name=grade_review_code,json=gradeReviewCode,proto3,oneof" json:"grade_review_code,omitempty"`
-	MidtermCode      *string                `protobuf:"bytes,7,opt,name=midterm_code,json=midtermCode,proto3,oneof" json:"midterm_code,omitempty"`
-	UpdatedBy        string                 `protobuf:"bytes,8,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *ComputeFinalGradeResponse) GetFinalized() bool {
+	if x != nil {
+		return x.Finalized
+	}
+	return false
 }
 
-func (x *UpdateEnrollmentRequest) Reset() {
-	*x = UpdateEnrollmentRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[27]
+func (x *ComputeFinalGradeResponse) GetFinal() *Final {
+	if x != nil {
+		return x.Final
+	}
+	return nil
+}
+
+// ============= Grade lock, publication & amendments =============
+// Once a semester's grades are locked, UpdateFinal, UpdateMidterm,
+// UpdateGradeReview and ComputeFinalGrade refuse to change grades; an approved
+// GradeAmendment is then the only way to change one. Students see grades once
+// the semester is published, which requires it to be locked.
+type SemesterGradeStatus struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SemesterCode string                 `protobuf:"bytes,1,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Locked       bool                   `protobuf:"varint,2,opt,name=locked,proto3" json:"locked,omitempty"`
+	LockedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=locked_at,json=lockedAt,proto3" json:"locked_at,omitempty"`
+	LockedBy     string                 `protobuf:"bytes,4,opt,name=locked_by,json=lockedBy,proto3" json:"locked_by,omitempty"`
+	Published    bool                   `protobuf:"varint,5,opt,name=published,proto3" json:"published,omitempty"`
+	PublishedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	PublishedBy  string                 `protobuf:"bytes,7,opt,name=published_by,json=publishedBy,proto3" json:"published_by,omitempty"`
+	// Students may appeal a grade for this many days after publication
+	AppealWindowDays int32 `protobuf:"varint,8,opt,name=appeal_window_days,json=appealWindowDays,proto3" json:"appeal_window_days,omitempty"`
+	// Set once published: published_at + appeal_window_days
+	AppealDeadline *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=appeal_deadline,json=appealDeadline,proto3" json:"appeal_deadline,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SemesterGradeStatus) Reset() {
+	*x = SemesterGradeStatus{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEnrollmentRequest) String() string {
+func (x *SemesterGradeStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEnrollmentRequest) ProtoMessage() {}
+func (*SemesterGradeStatus) ProtoMessage() {}
 
-func (x *UpdateEnrollmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[27]
+func (x *SemesterGradeStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1985,89 +11261,97 @@ func (x *UpdateEnrollmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEnrollmentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEnrollmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use SemesterGradeStatus.ProtoReflect.Descriptor instead.
+func (*SemesterGradeStatus) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *UpdateEnrollmentRequest) GetId() string {
+func (x *SemesterGradeStatus) GetSemesterCode() string {
 	if x != nil {
-		return x.Id
+		return x.SemesterCode
 	}
 	return ""
 }
 
-func (x *UpdateEnrollmentRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+func (x *SemesterGradeStatus) GetLocked() bool {
+	if x != nil {
+		return x.Locked
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateEnrollmentRequest) GetStudentCode() string {
-	if x != nil && x.StudentCode != nil {
-		return *x.StudentCode
+func (x *SemesterGradeStatus) GetLockedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LockedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateEnrollmentRequest) GetTopicCouncilCode() string {
-	if x != nil && x.TopicCouncilCode != nil {
-		return *x.TopicCouncilCode
+func (x *SemesterGradeStatus) GetLockedBy() string {
+	if x != nil {
+		return x.LockedBy
 	}
 	return ""
 }
 
-func (x *UpdateEnrollmentRequest) GetFinalCode() string {
-	if x != nil && x.FinalCode != nil {
-		return *x.FinalCode
+func (x *SemesterGradeStatus) GetPublished() bool {
+	if x != nil {
+		return x.Published
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateEnrollmentRequest) GetGradeReviewCode() string {
-	if x != nil && x.GradeReviewCode != nil {
-		return *x.GradeReviewCode
+func (x *SemesterGradeStatus) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateEnrollmentRequest) GetMidtermCode() string {
-	if x != nil && x.MidtermCode != nil {
-		return *x.MidtermCode
+func (x *SemesterGradeStatus) GetPublishedBy() string {
+	if x != nil {
+		return x.PublishedBy
 	}
 	return ""
 }
 
-func (x *UpdateEnrollmentRequest) GetUpdatedBy() string {
+func (x *SemesterGradeStatus) GetAppealWindowDays() int32 {
 	if x != nil {
-		return x.UpdatedBy
+		return x.AppealWindowDays
 	}
-	return ""
+	return 0
 }
 
-type UpdateEnrollmentResponse struct {
+func (x *SemesterGradeStatus) GetAppealDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppealDeadline
+	}
+	return nil
+}
+
+type LockSemesterGradesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enrollment    *Enrollment            `protobuf:"bytes,1,opt,name=enrollment,proto3" json:"enrollment,omitempty"`
+	SemesterCode  string                 `protobuf:"bytes,1,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Actor         string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEnrollmentResponse) Reset() {
-	*x = UpdateEnrollmentResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[28]
+func (x *LockSemesterGradesRequest) Reset() {
+	*x = LockSemesterGradesRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEnrollmentResponse) String() string {
+func (x *LockSemesterGradesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEnrollmentResponse) ProtoMessage() {}
+func (*LockSemesterGradesRequest) ProtoMessage() {}
 
-func (x *UpdateEnrollmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[28]
+func (x *LockSemesterGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2078,40 +11362,47 @@ func (x *UpdateEnrollmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEnrollmentResponse.ProtoReflect.Descriptor instead.
-func (*UpdateEnrollmentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use LockSemesterGradesRequest.ProtoReflect.Descriptor instead.
+func (*LockSemesterGradesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *UpdateEnrollmentResponse) GetEnrollment() *Enrollment {
+func (x *LockSemesterGradesRequest) GetSemesterCode() string {
 	if x != nil {
-		return x.Enrollment
+		return x.SemesterCode
 	}
-	return nil
+	return ""
 }
 
-type DeleteEnrollmentRequest struct {
+func (x *LockSemesterGradesRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type LockSemesterGradesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        *SemesterGradeStatus   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEnrollmentRequest) Reset() {
-	*x = DeleteEnrollmentRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[29]
+func (x *LockSemesterGradesResponse) Reset() {
+	*x = LockSemesterGradesResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEnrollmentRequest) String() string {
+func (x *LockSemesterGradesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEnrollmentRequest) ProtoMessage() {}
+func (*LockSemesterGradesResponse) ProtoMessage() {}
 
-func (x *DeleteEnrollmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[29]
+func (x *LockSemesterGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2122,40 +11413,41 @@ func (x *DeleteEnrollmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEnrollmentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEnrollmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use LockSemesterGradesResponse.ProtoReflect.Descriptor instead.
+func (*LockSemesterGradesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *DeleteEnrollmentRequest) GetId() string {
+func (x *LockSemesterGradesResponse) GetStatus() *SemesterGradeStatus {
 	if x != nil {
-		return x.Id
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-type DeleteEnrollmentResponse struct {
+type PublishSemesterGradesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	SemesterCode  string                 `protobuf:"bytes,1,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Actor         string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEnrollmentResponse) Reset() {
-	*x = DeleteEnrollmentResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[30]
+func (x *PublishSemesterGradesRequest) Reset() {
+	*x = PublishSemesterGradesRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEnrollmentResponse) String() string {
+func (x *PublishSemesterGradesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEnrollmentResponse) ProtoMessage() {}
+func (*PublishSemesterGradesRequest) ProtoMessage() {}
 
-func (x *DeleteEnrollmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[30]
+func (x *PublishSemesterGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2166,40 +11458,47 @@ func (x *DeleteEnrollmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEnrollmentResponse.ProtoReflect.Descriptor instead.
-func (*DeleteEnrollmentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use PublishSemesterGradesRequest.ProtoReflect.Descriptor instead.
+func (*PublishSemesterGradesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *DeleteEnrollmentResponse) GetSuccess() bool {
+func (x *PublishSemesterGradesRequest) GetSemesterCode() string {
 	if x != nil {
-		return x.Success
+		return x.SemesterCode
 	}
-	return false
+	return ""
 }
 
-type ListEnrollmentsRequest struct {
+func (x *PublishSemesterGradesRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type PublishSemesterGradesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	Status        *SemesterGradeStatus   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEnrollmentsRequest) Reset() {
-	*x = ListEnrollmentsRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[31]
+func (x *PublishSemesterGradesResponse) Reset() {
+	*x = PublishSemesterGradesResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEnrollmentsRequest) String() string {
+func (x *PublishSemesterGradesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEnrollmentsRequest) ProtoMessage() {}
+func (*PublishSemesterGradesResponse) ProtoMessage() {}
 
-func (x *ListEnrollmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[31]
+func (x *PublishSemesterGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2210,43 +11509,40 @@ func (x *ListEnrollmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEnrollmentsRequest.ProtoReflect.Descriptor instead.
-func (*ListEnrollmentsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use PublishSemesterGradesResponse.ProtoReflect.Descriptor instead.
+func (*PublishSemesterGradesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *ListEnrollmentsRequest) GetSearch() *common.SearchRequest {
+func (x *PublishSemesterGradesResponse) GetStatus() *SemesterGradeStatus {
 	if x != nil {
-		return x.Search
+		return x.Status
 	}
 	return nil
 }
 
-type ListEnrollmentsResponse struct {
+type GetSemesterGradeStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enrollments   []*Enrollment          `protobuf:"bytes,1,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	SemesterCode  string                 `protobuf:"bytes,1,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEnrollmentsResponse) Reset() {
-	*x = ListEnrollmentsResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[32]
+func (x *GetSemesterGradeStatusRequest) Reset() {
+	*x = GetSemesterGradeStatusRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEnrollmentsResponse) String() string {
+func (x *GetSemesterGradeStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEnrollmentsResponse) ProtoMessage() {}
+func (*GetSemesterGradeStatusRequest) ProtoMessage() {}
 
-func (x *ListEnrollmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[32]
+func (x *GetSemesterGradeStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2257,72 +11553,99 @@ func (x *ListEnrollmentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEnrollmentsResponse.ProtoReflect.Descriptor instead.
-func (*ListEnrollmentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use GetSemesterGradeStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSemesterGradeStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *ListEnrollmentsResponse) GetEnrollments() []*Enrollment {
+func (x *GetSemesterGradeStatusRequest) GetSemesterCode() string {
 	if x != nil {
-		return x.Enrollments
+		return x.SemesterCode
 	}
-	return nil
+	return ""
 }
 
-func (x *ListEnrollmentsResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+type GetSemesterGradeStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *SemesterGradeStatus   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEnrollmentsResponse) GetPage() int32 {
+func (x *GetSemesterGradeStatusResponse) Reset() {
+	*x = GetSemesterGradeStatusResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSemesterGradeStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSemesterGradeStatusResponse) ProtoMessage() {}
+
+func (x *GetSemesterGradeStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[168]
 	if x != nil {
-		return x.Page
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ListEnrollmentsResponse) GetPageSize() int32 {
+// Deprecated: Use GetSemesterGradeStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSemesterGradeStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *GetSemesterGradeStatusResponse) GetStatus() *SemesterGradeStatus {
 	if x != nil {
-		return x.PageSize
+		return x.Status
 	}
-	return 0
+	return nil
 }
 
-// ============= Topic =============
-type Topic struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	MajorCode      string                 `protobuf:"bytes,3,opt,name=major_code,json=majorCode,proto3" json:"major_code,omitempty"`
-	SemesterCode   string                 `protobuf:"bytes,4,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
-	Status         TopicStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=thesis.TopicStatus" json:"status,omitempty"`
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	PercentStage_1 *int32                 `protobuf:"varint,8,opt,name=percent_stage_1,json=percentStage1,proto3,oneof" json:"percent_stage_1,omitempty"`
-	PercentStage_2 *int32                 `protobuf:"varint,9,opt,name=percent_stage_2,json=percentStage2,proto3,oneof" json:"percent_stage_2,omitempty"`
-	CreatedBy      string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedBy      string                 `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+type GradeAmendment struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Target GradeAmendmentTarget   `protobuf:"varint,2,opt,name=target,proto3,enum=thesis.GradeAmendmentTarget" json:"target,omitempty"`
+	// Id of the Final, Grade_review or Midterm
+	TargetCode     string                 `protobuf:"bytes,3,opt,name=target_code,json=targetCode,proto3" json:"target_code,omitempty"`
+	EnrollmentCode string                 `protobuf:"bytes,4,opt,name=enrollment_code,json=enrollmentCode,proto3" json:"enrollment_code,omitempty"`
+	SemesterCode   string                 `protobuf:"bytes,5,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	OldValue       *float64               `protobuf:"fixed64,6,opt,name=old_value,json=oldValue,proto3,oneof" json:"old_value,omitempty"`
+	NewValue       float64                `protobuf:"fixed64,7,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	Reason         string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status         GradeAmendmentStatus   `protobuf:"varint,9,opt,name=status,proto3,enum=thesis.GradeAmendmentStatus" json:"status,omitempty"`
+	RequestedBy    string                 `protobuf:"bytes,10,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	DecidedBy      string                 `protobuf:"bytes,11,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	DecidedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	DecisionNote   string                 `protobuf:"bytes,13,opt,name=decision_note,json=decisionNote,proto3" json:"decision_note,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Topic) Reset() {
-	*x = Topic{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[33]
+func (x *GradeAmendment) Reset() {
+	*x = GradeAmendment{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Topic) String() string {
+func (x *GradeAmendment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Topic) ProtoMessage() {}
+func (*GradeAmendment) ProtoMessage() {}
 
-func (x *Topic) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[33]
+func (x *GradeAmendment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2333,116 +11656,143 @@ func (x *Topic) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Topic.ProtoReflect.Descriptor instead.
-func (*Topic) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use GradeAmendment.ProtoReflect.Descriptor instead.
+func (*GradeAmendment) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *GradeAmendment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GradeAmendment) GetTarget() GradeAmendmentTarget {
+	if x != nil {
+		return x.Target
+	}
+	return GradeAmendmentTarget_AMEND_SUPERVISOR_GRADE
 }
 
-func (x *Topic) GetId() string {
+func (x *GradeAmendment) GetTargetCode() string {
 	if x != nil {
-		return x.Id
+		return x.TargetCode
 	}
 	return ""
 }
 
-func (x *Topic) GetTitle() string {
+func (x *GradeAmendment) GetEnrollmentCode() string {
 	if x != nil {
-		return x.Title
+		return x.EnrollmentCode
 	}
 	return ""
 }
 
-func (x *Topic) GetMajorCode() string {
+func (x *GradeAmendment) GetSemesterCode() string {
 	if x != nil {
-		return x.MajorCode
+		return x.SemesterCode
 	}
 	return ""
 }
 
-func (x *Topic) GetSemesterCode() string {
+func (x *GradeAmendment) GetOldValue() float64 {
+	if x != nil && x.OldValue != nil {
+		return *x.OldValue
+	}
+	return 0
+}
+
+func (x *GradeAmendment) GetNewValue() float64 {
 	if x != nil {
-		return x.SemesterCode
+		return x.NewValue
+	}
+	return 0
+}
+
+func (x *GradeAmendment) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Topic) GetStatus() TopicStatus {
+func (x *GradeAmendment) GetStatus() GradeAmendmentStatus {
 	if x != nil {
 		return x.Status
 	}
-	return TopicStatus_SUBMIT
+	return GradeAmendmentStatus_AMENDMENT_PENDING
 }
 
-func (x *Topic) GetCreatedAt() *timestamppb.Timestamp {
+func (x *GradeAmendment) GetRequestedBy() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.RequestedBy
 	}
-	return nil
+	return ""
 }
 
-func (x *Topic) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *GradeAmendment) GetDecidedBy() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.DecidedBy
 	}
-	return nil
+	return ""
 }
 
-func (x *Topic) GetPercentStage_1() int32 {
-	if x != nil && x.PercentStage_1 != nil {
-		return *x.PercentStage_1
+func (x *GradeAmendment) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *Topic) GetPercentStage_2() int32 {
-	if x != nil && x.PercentStage_2 != nil {
-		return *x.PercentStage_2
+func (x *GradeAmendment) GetDecisionNote() string {
+	if x != nil {
+		return x.DecisionNote
 	}
-	return 0
+	return ""
 }
 
-func (x *Topic) GetCreatedBy() string {
+func (x *GradeAmendment) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedBy
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *Topic) GetUpdatedBy() string {
+func (x *GradeAmendment) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdatedBy
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-type CreateTopicRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Title          string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	MajorCode      string                 `protobuf:"bytes,2,opt,name=major_code,json=majorCode,proto3" json:"major_code,omitempty"`
-	SemesterCode   string                 `protobuf:"bytes,3,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
-	Status         TopicStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=thesis.TopicStatus" json:"status,omitempty"`
-	PercentStage_1 *int32                 `protobuf:"varint,5,opt,name=percent_stage_1,json=percentStage1,proto3,oneof" json:"percent_stage_1,omitempty"`
-	PercentStage_2 *int32                 `protobuf:"varint,6,opt,name=percent_stage_2,json=percentStage2,proto3,oneof" json:"percent_stage_2,omitempty"`
-	CreatedBy      string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// The gateway checks the requester sits on the enrollment's council
+type RequestGradeAmendmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        GradeAmendmentTarget   `protobuf:"varint,1,opt,name=target,proto3,enum=thesis.GradeAmendmentTarget" json:"target,omitempty"`
+	TargetCode    string                 `protobuf:"bytes,2,opt,name=target_code,json=targetCode,proto3" json:"target_code,omitempty"`
+	NewValue      float64                `protobuf:"fixed64,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,5,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTopicRequest) Reset() {
-	*x = CreateTopicRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[34]
+func (x *RequestGradeAmendmentRequest) Reset() {
+	*x = RequestGradeAmendmentRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTopicRequest) String() string {
+func (x *RequestGradeAmendmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTopicRequest) ProtoMessage() {}
+func (*RequestGradeAmendmentRequest) ProtoMessage() {}
 
-func (x *CreateTopicRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[34]
+func (x *RequestGradeAmendmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2453,82 +11803,68 @@ func (x *CreateTopicRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTopicRequest.ProtoReflect.Descriptor instead.
-func (*CreateTopicRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *CreateTopicRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use RequestGradeAmendmentRequest.ProtoReflect.Descriptor instead.
+func (*RequestGradeAmendmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *CreateTopicRequest) GetMajorCode() string {
+func (x *RequestGradeAmendmentRequest) GetTarget() GradeAmendmentTarget {
 	if x != nil {
-		return x.MajorCode
+		return x.Target
 	}
-	return ""
+	return GradeAmendmentTarget_AMEND_SUPERVISOR_GRADE
 }
 
-func (x *CreateTopicRequest) GetSemesterCode() string {
+func (x *RequestGradeAmendmentRequest) GetTargetCode() string {
 	if x != nil {
-		return x.SemesterCode
+		return x.TargetCode
 	}
 	return ""
 }
 
-func (x *CreateTopicRequest) GetStatus() TopicStatus {
+func (x *RequestGradeAmendmentRequest) GetNewValue() float64 {
 	if x != nil {
-		return x.Status
-	}
-	return TopicStatus_SUBMIT
-}
-
-func (x *CreateTopicRequest) GetPercentStage_1() int32 {
-	if x != nil && x.PercentStage_1 != nil {
-		return *x.PercentStage_1
+		return x.NewValue
 	}
 	return 0
 }
 
-func (x *CreateTopicRequest) GetPercentStage_2() int32 {
-	if x != nil && x.PercentStage_2 != nil {
-		return *x.PercentStage_2
+func (x *RequestGradeAmendmentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateTopicRequest) GetCreatedBy() string {
+func (x *RequestGradeAmendmentRequest) GetRequestedBy() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.RequestedBy
 	}
 	return ""
 }
 
-type CreateTopicResponse struct {
+type RequestGradeAmendmentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Topic         *Topic                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Amendment     *GradeAmendment        `protobuf:"bytes,1,opt,name=amendment,proto3" json:"amendment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTopicResponse) Reset() {
-	*x = CreateTopicResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[35]
+func (x *RequestGradeAmendmentResponse) Reset() {
+	*x = RequestGradeAmendmentResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTopicResponse) String() string {
+func (x *RequestGradeAmendmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTopicResponse) ProtoMessage() {}
+func (*RequestGradeAmendmentResponse) ProtoMessage() {}
 
-func (x *CreateTopicResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[35]
+func (x *RequestGradeAmendmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2539,40 +11875,47 @@ func (x *CreateTopicResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTopicResponse.ProtoReflect.Descriptor instead.
-func (*CreateTopicResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use RequestGradeAmendmentResponse.ProtoReflect.Descriptor instead.
+func (*RequestGradeAmendmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *CreateTopicResponse) GetTopic() *Topic {
+func (x *RequestGradeAmendmentResponse) GetAmendment() *GradeAmendment {
 	if x != nil {
-		return x.Topic
+		return x.Amendment
 	}
 	return nil
 }
 
-type GetTopicRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// Approving applies new_value to the locked grade
+type DecideGradeAmendmentRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approve   bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	Note      string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	DecidedBy string                 `protobuf:"bytes,4,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	// Scores of the enrollment's council, which the final grade is recomputed
+	// from when an approved supervisor or review grade changes a graded Final
+	CouncilScores []*CouncilMemberScore `protobuf:"bytes,5,rep,name=council_scores,json=councilScores,proto3" json:"council_scores,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTopicRequest) Reset() {
-	*x = GetTopicRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[36]
+func (x *DecideGradeAmendmentRequest) Reset() {
+	*x = DecideGradeAmendmentRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTopicRequest) String() string {
+func (x *DecideGradeAmendmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopicRequest) ProtoMessage() {}
+func (*DecideGradeAmendmentRequest) ProtoMessage() {}
 
-func (x *GetTopicRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[36]
+func (x *DecideGradeAmendmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2583,40 +11926,68 @@ func (x *GetTopicRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopicRequest.ProtoReflect.Descriptor instead.
-func (*GetTopicRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use DecideGradeAmendmentRequest.ProtoReflect.Descriptor instead.
+func (*DecideGradeAmendmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *GetTopicRequest) GetId() string {
+func (x *DecideGradeAmendmentRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetTopicResponse struct {
+func (x *DecideGradeAmendmentRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *DecideGradeAmendmentRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *DecideGradeAmendmentRequest) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *DecideGradeAmendmentRequest) GetCouncilScores() []*CouncilMemberScore {
+	if x != nil {
+		return x.CouncilScores
+	}
+	return nil
+}
+
+type DecideGradeAmendmentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Topic         *Topic                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Amendment     *GradeAmendment        `protobuf:"bytes,1,opt,name=amendment,proto3" json:"amendment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTopicResponse) Reset() {
-	*x = GetTopicResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[37]
+func (x *DecideGradeAmendmentResponse) Reset() {
+	*x = DecideGradeAmendmentResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTopicResponse) String() string {
+func (x *DecideGradeAmendmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopicResponse) ProtoMessage() {}
+func (*DecideGradeAmendmentResponse) ProtoMessage() {}
 
-func (x *GetTopicResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[37]
+func (x *DecideGradeAmendmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2627,47 +11998,40 @@ func (x *GetTopicResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopicResponse.ProtoReflect.Descriptor instead.
-func (*GetTopicResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use DecideGradeAmendmentResponse.ProtoReflect.Descriptor instead.
+func (*DecideGradeAmendmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *GetTopicResponse) GetTopic() *Topic {
+func (x *DecideGradeAmendmentResponse) GetAmendment() *GradeAmendment {
 	if x != nil {
-		return x.Topic
+		return x.Amendment
 	}
 	return nil
 }
 
-type UpdateTopicRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title          *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	MajorCode      *string                `protobuf:"bytes,3,opt,name=major_code,json=majorCode,proto3,oneof" json:"major_code,omitempty"`
-	SemesterCode   *string                `protobuf:"bytes,4,opt,name=semester_code,json=semesterCode,proto3,oneof" json:"semester_code,omitempty"`
-	Status         *TopicStatus           `protobuf:"varint,5,opt,name=status,proto3,enum=thesis.TopicStatus,oneof" json:"status,omitempty"`
-	PercentStage_1 *int32                 `protobuf:"varint,6,opt,name=percent_stage_1,json=percentStage1,proto3,oneof" json:"percent_stage_1,omitempty"`
-	PercentStage_2 *int32                 `protobuf:"varint,7,opt,name=percent_stage_2,json=percentStage2,proto3,oneof" json:"percent_stage_2,omitempty"`
-	UpdatedBy      string                 `protobuf:"bytes,8,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetGradeAmendmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTopicRequest) Reset() {
-	*x = UpdateTopicRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[38]
+func (x *GetGradeAmendmentRequest) Reset() {
+	*x = GetGradeAmendmentRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTopicRequest) String() string {
+func (x *GetGradeAmendmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTopicRequest) ProtoMessage() {}
+func (*GetGradeAmendmentRequest) ProtoMessage() {}
 
-func (x *UpdateTopicRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[38]
+func (x *GetGradeAmendmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2678,89 +12042,40 @@ func (x *UpdateTopicRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTopicRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTopicRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GetGradeAmendmentRequest.ProtoReflect.Descriptor instead.
+func (*GetGradeAmendmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{174}
 }
 
-func (x *UpdateTopicRequest) GetId() string {
+func (x *GetGradeAmendmentRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateTopicRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
-	}
-	return ""
-}
-
-func (x *UpdateTopicRequest) GetMajorCode() string {
-	if x != nil && x.MajorCode != nil {
-		return *x.MajorCode
-	}
-	return ""
-}
-
-func (x *UpdateTopicRequest) GetSemesterCode() string {
-	if x != nil && x.SemesterCode != nil {
-		return *x.SemesterCode
-	}
-	return ""
-}
-
-func (x *UpdateTopicRequest) GetStatus() TopicStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return TopicStatus_SUBMIT
-}
-
-func (x *UpdateTopicRequest) GetPercentStage_1() int32 {
-	if x != nil && x.PercentStage_1 != nil {
-		return *x.PercentStage_1
-	}
-	return 0
-}
-
-func (x *UpdateTopicRequest) GetPercentStage_2() int32 {
-	if x != nil && x.PercentStage_2 != nil {
-		return *x.PercentStage_2
-	}
-	return 0
-}
-
-func (x *UpdateTopicRequest) GetUpdatedBy() string {
-	if x != nil {
-		return x.UpdatedBy
-	}
-	return ""
-}
-
-type UpdateTopicResponse struct {
+type GetGradeAmendmentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Topic         *Topic                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Amendment     *GradeAmendment        `protobuf:"bytes,1,opt,name=amendment,proto3" json:"amendment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTopicResponse) Reset() {
-	*x = UpdateTopicResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[39]
+func (x *GetGradeAmendmentResponse) Reset() {
+	*x = GetGradeAmendmentResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTopicResponse) String() string {
+func (x *GetGradeAmendmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTopicResponse) ProtoMessage() {}
-
-func (x *UpdateTopicResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[39]
+func (*GetGradeAmendmentResponse) ProtoMessage() {}
+
+func (x *GetGradeAmendmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2771,40 +12086,41 @@ func (x *UpdateTopicResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTopicResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTopicResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use GetGradeAmendmentResponse.ProtoReflect.Descriptor instead.
+func (*GetGradeAmendmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{175}
 }
 
-func (x *UpdateTopicResponse) GetTopic() *Topic {
+func (x *GetGradeAmendmentResponse) GetAmendment() *GradeAmendment {
 	if x != nil {
-		return x.Topic
+		return x.Amendment
 	}
 	return nil
 }
 
-type DeleteTopicRequest struct {
+type ListGradeAmendmentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SemesterCode  *string                `protobuf:"bytes,1,opt,name=semester_code,json=semesterCode,proto3,oneof" json:"semester_code,omitempty"`
+	Status        *GradeAmendmentStatus  `protobuf:"varint,2,opt,name=status,proto3,enum=thesis.GradeAmendmentStatus,oneof" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTopicRequest) Reset() {
-	*x = DeleteTopicRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[40]
+func (x *ListGradeAmendmentsRequest) Reset() {
+	*x = ListGradeAmendmentsRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTopicRequest) String() string {
+func (x *ListGradeAmendmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTopicRequest) ProtoMessage() {}
+func (*ListGradeAmendmentsRequest) ProtoMessage() {}
 
-func (x *DeleteTopicRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[40]
+func (x *ListGradeAmendmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2815,40 +12131,47 @@ func (x *DeleteTopicRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTopicRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTopicRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ListGradeAmendmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListGradeAmendmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{176}
 }
 
-func (x *DeleteTopicRequest) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *ListGradeAmendmentsRequest) GetSemesterCode() string {
+	if x != nil && x.SemesterCode != nil {
+		return *x.SemesterCode
 	}
 	return ""
 }
 
-type DeleteTopicResponse struct {
+func (x *ListGradeAmendmentsRequest) GetStatus() GradeAmendmentStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return GradeAmendmentStatus_AMENDMENT_PENDING
+}
+
+type ListGradeAmendmentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Amendments    []*GradeAmendment      `protobuf:"bytes,1,rep,name=amendments,proto3" json:"amendments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTopicResponse) Reset() {
-	*x = DeleteTopicResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[41]
+func (x *ListGradeAmendmentsResponse) Reset() {
+	*x = ListGradeAmendmentsResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTopicResponse) String() string {
+func (x *ListGradeAmendmentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTopicResponse) ProtoMessage() {}
+func (*ListGradeAmendmentsResponse) ProtoMessage() {}
 
-func (x *DeleteTopicResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[41]
+func (x *ListGradeAmendmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2859,40 +12182,43 @@ func (x *DeleteTopicResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTopicResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTopicResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ListGradeAmendmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListGradeAmendmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{177}
 }
 
-func (x *DeleteTopicResponse) GetSuccess() bool {
+func (x *ListGradeAmendmentsResponse) GetAmendments() []*GradeAmendment {
 	if x != nil {
-		return x.Success
+		return x.Amendments
 	}
-	return false
+	return nil
 }
 
-type ListTopicsRequest struct {
+type GradeAppealEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	Status        GradeAppealStatus      `protobuf:"varint,1,opt,name=status,proto3,enum=thesis.GradeAppealStatus" json:"status,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTopicsRequest) Reset() {
-	*x = ListTopicsRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[42]
+func (x *GradeAppealEvent) Reset() {
+	*x = GradeAppealEvent{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTopicsRequest) String() string {
+func (x *GradeAppealEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTopicsRequest) ProtoMessage() {}
+func (*GradeAppealEvent) ProtoMessage() {}
 
-func (x *ListTopicsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[42]
+func (x *GradeAppealEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2903,43 +12229,79 @@ func (x *ListTopicsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTopicsRequest.ProtoReflect.Descriptor instead.
-func (*ListTopicsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use GradeAppealEvent.ProtoReflect.Descriptor instead.
+func (*GradeAppealEvent) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{178}
 }
 
-func (x *ListTopicsRequest) GetSearch() *common.SearchRequest {
+func (x *GradeAppealEvent) GetStatus() GradeAppealStatus {
 	if x != nil {
-		return x.Search
+		return x.Status
+	}
+	return GradeAppealStatus_APPEAL_SUBMITTED
+}
+
+func (x *GradeAppealEvent) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *GradeAppealEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *GradeAppealEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type ListTopicsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Topics        []*Topic               `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+type GradeAppeal struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EnrollmentCode   string                 `protobuf:"bytes,2,opt,name=enrollment_code,json=enrollmentCode,proto3" json:"enrollment_code,omitempty"`
+	StudentCode      string                 `protobuf:"bytes,3,opt,name=student_code,json=studentCode,proto3" json:"student_code,omitempty"`
+	SemesterCode     string                 `protobuf:"bytes,4,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Component        GradeAppealComponent   `protobuf:"varint,5,opt,name=component,proto3,enum=thesis.GradeAppealComponent" json:"component,omitempty"`
+	Reason           string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	EvidenceFileCode string                 `protobuf:"bytes,7,opt,name=evidence_file_code,json=evidenceFileCode,proto3" json:"evidence_file_code,omitempty"`
+	Status           GradeAppealStatus      `protobuf:"varint,8,opt,name=status,proto3,enum=thesis.GradeAppealStatus" json:"status,omitempty"`
+	ReviewerCode     string                 `protobuf:"bytes,9,opt,name=reviewer_code,json=reviewerCode,proto3" json:"reviewer_code,omitempty"`
+	ResolutionNote   string                 `protobuf:"bytes,10,opt,name=resolution_note,json=resolutionNote,proto3" json:"resolution_note,omitempty"`
+	// GradeAmendment or GradeDefenceAmendment requested for the appeal, pending
+	// while the appeal is assigned
+	AmendmentCode string                 `protobuf:"bytes,11,opt,name=amendment_code,json=amendmentCode,proto3" json:"amendment_code,omitempty"`
+	ResolvedBy    string                 `protobuf:"bytes,12,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	ResolvedAt    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Oldest first
+	History       []*GradeAppealEvent `protobuf:"bytes,16,rep,name=history,proto3" json:"history,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTopicsResponse) Reset() {
-	*x = ListTopicsResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[43]
+func (x *GradeAppeal) Reset() {
+	*x = GradeAppeal{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTopicsResponse) String() string {
+func (x *GradeAppeal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTopicsResponse) ProtoMessage() {}
+func (*GradeAppeal) ProtoMessage() {}
 
-func (x *ListTopicsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[43]
+func (x *GradeAppeal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2950,72 +12312,147 @@ func (x *ListTopicsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTopicsResponse.ProtoReflect.Descriptor instead.
-func (*ListTopicsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use GradeAppeal.ProtoReflect.Descriptor instead.
+func (*GradeAppeal) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{179}
 }
 
-func (x *ListTopicsResponse) GetTopics() []*Topic {
+func (x *GradeAppeal) GetId() string {
 	if x != nil {
-		return x.Topics
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetEnrollmentCode() string {
+	if x != nil {
+		return x.EnrollmentCode
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetStudentCode() string {
+	if x != nil {
+		return x.StudentCode
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetSemesterCode() string {
+	if x != nil {
+		return x.SemesterCode
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetComponent() GradeAppealComponent {
+	if x != nil {
+		return x.Component
+	}
+	return GradeAppealComponent_APPEAL_SUPERVISOR_GRADE
+}
+
+func (x *GradeAppeal) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetEvidenceFileCode() string {
+	if x != nil {
+		return x.EvidenceFileCode
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetStatus() GradeAppealStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GradeAppealStatus_APPEAL_SUBMITTED
+}
+
+func (x *GradeAppeal) GetReviewerCode() string {
+	if x != nil {
+		return x.ReviewerCode
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetResolutionNote() string {
+	if x != nil {
+		return x.ResolutionNote
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetAmendmentCode() string {
+	if x != nil {
+		return x.AmendmentCode
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+func (x *GradeAppeal) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
 	}
 	return nil
 }
 
-func (x *ListTopicsResponse) GetTotal() int32 {
+func (x *GradeAppeal) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Total
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *ListTopicsResponse) GetPage() int32 {
+func (x *GradeAppeal) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Page
+		return x.UpdatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *ListTopicsResponse) GetPageSize() int32 {
+func (x *GradeAppeal) GetHistory() []*GradeAppealEvent {
 	if x != nil {
-		return x.PageSize
+		return x.History
 	}
-	return 0
+	return nil
 }
 
-// ============= TopicCouncil =============
-type TopicCouncil struct {
+type SetGradeAppealWindowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Stage         TopicStage             `protobuf:"varint,3,opt,name=stage,proto3,enum=thesis.TopicStage" json:"stage,omitempty"`
-	TopicCode     string                 `protobuf:"bytes,4,opt,name=topic_code,json=topicCode,proto3" json:"topic_code,omitempty"`
-	CouncilCode   *string                `protobuf:"bytes,5,opt,name=council_code,json=councilCode,proto3,oneof" json:"council_code,omitempty"`
-	TimeStart     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
-	TimeEnd       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedBy     string                 `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	SemesterCode  string                 `protobuf:"bytes,1,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TopicCouncil) Reset() {
-	*x = TopicCouncil{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[44]
+func (x *SetGradeAppealWindowRequest) Reset() {
+	*x = SetGradeAppealWindowRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TopicCouncil) String() string {
+func (x *SetGradeAppealWindowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopicCouncil) ProtoMessage() {}
+func (*SetGradeAppealWindowRequest) ProtoMessage() {}
 
-func (x *TopicCouncil) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[44]
+func (x *SetGradeAppealWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3026,116 +12463,174 @@ func (x *TopicCouncil) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopicCouncil.ProtoReflect.Descriptor instead.
-func (*TopicCouncil) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use SetGradeAppealWindowRequest.ProtoReflect.Descriptor instead.
+func (*SetGradeAppealWindowRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{180}
 }
 
-func (x *TopicCouncil) GetId() string {
+func (x *SetGradeAppealWindowRequest) GetSemesterCode() string {
 	if x != nil {
-		return x.Id
+		return x.SemesterCode
 	}
 	return ""
 }
 
-func (x *TopicCouncil) GetTitle() string {
+func (x *SetGradeAppealWindowRequest) GetDays() int32 {
 	if x != nil {
-		return x.Title
+		return x.Days
+	}
+	return 0
+}
+
+func (x *SetGradeAppealWindowRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
 	}
 	return ""
 }
 
-func (x *TopicCouncil) GetStage() TopicStage {
+type SetGradeAppealWindowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *SemesterGradeStatus   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGradeAppealWindowResponse) Reset() {
+	*x = SetGradeAppealWindowResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGradeAppealWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGradeAppealWindowResponse) ProtoMessage() {}
+
+func (x *SetGradeAppealWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[181]
 	if x != nil {
-		return x.Stage
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return TopicStage_STAGE_DACN
+	return mi.MessageOf(x)
 }
 
-func (x *TopicCouncil) GetTopicCode() string {
+// Deprecated: Use SetGradeAppealWindowResponse.ProtoReflect.Descriptor instead.
+func (*SetGradeAppealWindowResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *SetGradeAppealWindowResponse) GetStatus() *SemesterGradeStatus {
 	if x != nil {
-		return x.TopicCode
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-func (x *TopicCouncil) GetCouncilCode() string {
-	if x != nil && x.CouncilCode != nil {
-		return *x.CouncilCode
+type FileGradeAppealRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EnrollmentCode   string                 `protobuf:"bytes,1,opt,name=enrollment_code,json=enrollmentCode,proto3" json:"enrollment_code,omitempty"`
+	StudentCode      string                 `protobuf:"bytes,2,opt,name=student_code,json=studentCode,proto3" json:"student_code,omitempty"`
+	Component        GradeAppealComponent   `protobuf:"varint,3,opt,name=component,proto3,enum=thesis.GradeAppealComponent" json:"component,omitempty"`
+	Reason           string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	EvidenceFileCode *string                `protobuf:"bytes,5,opt,name=evidence_file_code,json=evidenceFileCode,proto3,oneof" json:"evidence_file_code,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FileGradeAppealRequest) Reset() {
+	*x = FileGradeAppealRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileGradeAppealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileGradeAppealRequest) ProtoMessage() {}
+
+func (x *FileGradeAppealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *TopicCouncil) GetTimeStart() *timestamppb.Timestamp {
-	if x != nil {
-		return x.TimeStart
-	}
-	return nil
+// Deprecated: Use FileGradeAppealRequest.ProtoReflect.Descriptor instead.
+func (*FileGradeAppealRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{182}
 }
 
-func (x *TopicCouncil) GetTimeEnd() *timestamppb.Timestamp {
+func (x *FileGradeAppealRequest) GetEnrollmentCode() string {
 	if x != nil {
-		return x.TimeEnd
+		return x.EnrollmentCode
 	}
-	return nil
+	return ""
 }
 
-func (x *TopicCouncil) GetCreatedAt() *timestamppb.Timestamp {
+func (x *FileGradeAppealRequest) GetStudentCode() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.StudentCode
 	}
-	return nil
+	return ""
 }
 
-func (x *TopicCouncil) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *FileGradeAppealRequest) GetComponent() GradeAppealComponent {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Component
 	}
-	return nil
+	return GradeAppealComponent_APPEAL_SUPERVISOR_GRADE
 }
 
-func (x *TopicCouncil) GetCreatedBy() string {
+func (x *FileGradeAppealRequest) GetReason() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *TopicCouncil) GetUpdatedBy() string {
-	if x != nil {
-		return x.UpdatedBy
+func (x *FileGradeAppealRequest) GetEvidenceFileCode() string {
+	if x != nil && x.EvidenceFileCode != nil {
+		return *x.EvidenceFileCode
 	}
 	return ""
 }
 
-type CreateTopicCouncilRequest struct {
+type FileGradeAppealResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Stage         TopicStage             `protobuf:"varint,2,opt,name=stage,proto3,enum=thesis.TopicStage" json:"stage,omitempty"`
-	TopicCode     string                 `protobuf:"bytes,3,opt,name=topic_code,json=topicCode,proto3" json:"topic_code,omitempty"`
-	CouncilCode   *string                `protobuf:"bytes,4,opt,name=council_code,json=councilCode,proto3,oneof" json:"council_code,omitempty"`
-	TimeStart     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
-	TimeEnd       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Appeal        *GradeAppeal           `protobuf:"bytes,1,opt,name=appeal,proto3" json:"appeal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTopicCouncilRequest) Reset() {
-	*x = CreateTopicCouncilRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[45]
+func (x *FileGradeAppealResponse) Reset() {
+	*x = FileGradeAppealResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTopicCouncilRequest) String() string {
+func (x *FileGradeAppealResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTopicCouncilRequest) ProtoMessage() {}
+func (*FileGradeAppealResponse) ProtoMessage() {}
 
-func (x *CreateTopicCouncilRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[45]
+func (x *FileGradeAppealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3146,82 +12641,100 @@ func (x *CreateTopicCouncilRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTopicCouncilRequest.ProtoReflect.Descriptor instead.
-func (*CreateTopicCouncilRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use FileGradeAppealResponse.ProtoReflect.Descriptor instead.
+func (*FileGradeAppealResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{183}
 }
 
-func (x *CreateTopicCouncilRequest) GetTitle() string {
+func (x *FileGradeAppealResponse) GetAppeal() *GradeAppeal {
 	if x != nil {
-		return x.Title
+		return x.Appeal
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateTopicCouncilRequest) GetStage() TopicStage {
-	if x != nil {
-		return x.Stage
-	}
-	return TopicStage_STAGE_DACN
+type AssignGradeAppealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewerCode  string                 `protobuf:"bytes,2,opt,name=reviewer_code,json=reviewerCode,proto3" json:"reviewer_code,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTopicCouncilRequest) GetTopicCode() string {
+func (x *AssignGradeAppealRequest) Reset() {
+	*x = AssignGradeAppealRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignGradeAppealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignGradeAppealRequest) ProtoMessage() {}
+
+func (x *AssignGradeAppealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[184]
 	if x != nil {
-		return x.TopicCode
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateTopicCouncilRequest) GetCouncilCode() string {
-	if x != nil && x.CouncilCode != nil {
-		return *x.CouncilCode
-	}
-	return ""
+// Deprecated: Use AssignGradeAppealRequest.ProtoReflect.Descriptor instead.
+func (*AssignGradeAppealRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{184}
 }
 
-func (x *CreateTopicCouncilRequest) GetTimeStart() *timestamppb.Timestamp {
+func (x *AssignGradeAppealRequest) GetId() string {
 	if x != nil {
-		return x.TimeStart
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateTopicCouncilRequest) GetTimeEnd() *timestamppb.Timestamp {
+func (x *AssignGradeAppealRequest) GetReviewerCode() string {
 	if x != nil {
-		return x.TimeEnd
+		return x.ReviewerCode
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateTopicCouncilRequest) GetCreatedBy() string {
+func (x *AssignGradeAppealRequest) GetActor() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.Actor
 	}
 	return ""
 }
 
-type CreateTopicCouncilResponse struct {
+type AssignGradeAppealResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TopicCouncil  *TopicCouncil          `protobuf:"bytes,1,opt,name=topic_council,json=topicCouncil,proto3" json:"topic_council,omitempty"`
+	Appeal        *GradeAppeal           `protobuf:"bytes,1,opt,name=appeal,proto3" json:"appeal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTopicCouncilResponse) Reset() {
-	*x = CreateTopicCouncilResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[46]
+func (x *AssignGradeAppealResponse) Reset() {
+	*x = AssignGradeAppealResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTopicCouncilResponse) String() string {
+func (x *AssignGradeAppealResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTopicCouncilResponse) ProtoMessage() {}
+func (*AssignGradeAppealResponse) ProtoMessage() {}
 
-func (x *CreateTopicCouncilResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[46]
+func (x *AssignGradeAppealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3232,40 +12745,44 @@ func (x *CreateTopicCouncilResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTopicCouncilResponse.ProtoReflect.Descriptor instead.
-func (*CreateTopicCouncilResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use AssignGradeAppealResponse.ProtoReflect.Descriptor instead.
+func (*AssignGradeAppealResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{185}
 }
 
-func (x *CreateTopicCouncilResponse) GetTopicCouncil() *TopicCouncil {
+func (x *AssignGradeAppealResponse) GetAppeal() *GradeAppeal {
 	if x != nil {
-		return x.TopicCouncil
+		return x.Appeal
 	}
 	return nil
 }
 
-type GetTopicCouncilRequest struct {
+// Only the assigned reviewer resolves; amend requires the amendment's id
+// Confirms the grade; amending goes through RequestGradeAppealAmendment
+type ResolveGradeAppealRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	ResolvedBy    string                 `protobuf:"bytes,5,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTopicCouncilRequest) Reset() {
-	*x = GetTopicCouncilRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[47]
+func (x *ResolveGradeAppealRequest) Reset() {
+	*x = ResolveGradeAppealRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTopicCouncilRequest) String() string {
+func (x *ResolveGradeAppealRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopicCouncilRequest) ProtoMessage() {}
+func (*ResolveGradeAppealRequest) ProtoMessage() {}
 
-func (x *GetTopicCouncilRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[47]
+func (x *ResolveGradeAppealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3276,40 +12793,54 @@ func (x *GetTopicCouncilRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopicCouncilRequest.ProtoReflect.Descriptor instead.
-func (*GetTopicCouncilRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ResolveGradeAppealRequest.ProtoReflect.Descriptor instead.
+func (*ResolveGradeAppealRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{186}
 }
 
-func (x *GetTopicCouncilRequest) GetId() string {
+func (x *ResolveGradeAppealRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetTopicCouncilResponse struct {
+func (x *ResolveGradeAppealRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ResolveGradeAppealRequest) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+type ResolveGradeAppealResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TopicCouncil  *TopicCouncil          `protobuf:"bytes,1,opt,name=topic_council,json=topicCouncil,proto3" json:"topic_council,omitempty"`
+	Appeal        *GradeAppeal           `protobuf:"bytes,1,opt,name=appeal,proto3" json:"appeal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTopicCouncilResponse) Reset() {
-	*x = GetTopicCouncilResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[48]
+func (x *ResolveGradeAppealResponse) Reset() {
+	*x = ResolveGradeAppealResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTopicCouncilResponse) String() string {
+func (x *ResolveGradeAppealResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopicCouncilResponse) ProtoMessage() {}
+func (*ResolveGradeAppealResponse) ProtoMessage() {}
 
-func (x *GetTopicCouncilResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[48]
+func (x *ResolveGradeAppealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3320,47 +12851,48 @@ func (x *GetTopicCouncilResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopicCouncilResponse.ProtoReflect.Descriptor instead.
-func (*GetTopicCouncilResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use ResolveGradeAppealResponse.ProtoReflect.Descriptor instead.
+func (*ResolveGradeAppealResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{187}
 }
 
-func (x *GetTopicCouncilResponse) GetTopicCouncil() *TopicCouncil {
+func (x *ResolveGradeAppealResponse) GetAppeal() *GradeAppeal {
 	if x != nil {
-		return x.TopicCouncil
+		return x.Appeal
 	}
 	return nil
 }
 
-type UpdateTopicCouncilRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Stage         *TopicStage            `protobuf:"varint,3,opt,name=stage,proto3,enum=thesis.TopicStage,oneof" json:"stage,omitempty"`
-	TopicCode     *string                `protobuf:"bytes,4,opt,name=topic_code,json=topicCode,proto3,oneof" json:"topic_code,omitempty"`
-	CouncilCode   *string                `protobuf:"bytes,5,opt,name=council_code,json=councilCode,proto3,oneof" json:"council_code,omitempty"`
-	TimeStart     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time_start,json=timeStart,proto3,oneof" json:"time_start,omitempty"`
-	TimeEnd       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=time_end,json=timeEnd,proto3,oneof" json:"time_end,omitempty"`
-	UpdatedBy     string                 `protobuf:"bytes,8,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateTopicCouncilRequest) Reset() {
-	*x = UpdateTopicCouncilRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[49]
+// Records the amendment of an assigned appeal. Thesis-side components get
+// their GradeAmendment created in the same transaction; the defence takes
+// the council GradeDefenceAmendment the reviewer requested, checked with
+// the council service.
+type RequestGradeAppealAmendmentRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NewValue             float64                `protobuf:"fixed64,2,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	DefenceAmendmentCode *string                `protobuf:"bytes,3,opt,name=defence_amendment_code,json=defenceAmendmentCode,proto3,oneof" json:"defence_amendment_code,omitempty"`
+	Note                 string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	RequestedBy          string                 `protobuf:"bytes,5,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RequestGradeAppealAmendmentRequest) Reset() {
+	*x = RequestGradeAppealAmendmentRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTopicCouncilRequest) String() string {
+func (x *RequestGradeAppealAmendmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTopicCouncilRequest) ProtoMessage() {}
+func (*RequestGradeAppealAmendmentRequest) ProtoMessage() {}
 
-func (x *UpdateTopicCouncilRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[49]
+func (x *RequestGradeAppealAmendmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3371,89 +12903,115 @@ func (x *UpdateTopicCouncilRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTopicCouncilRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTopicCouncilRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use RequestGradeAppealAmendmentRequest.ProtoReflect.Descriptor instead.
+func (*RequestGradeAppealAmendmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{188}
 }
 
-func (x *UpdateTopicCouncilRequest) GetId() string {
+func (x *RequestGradeAppealAmendmentRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateTopicCouncilRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+func (x *RequestGradeAppealAmendmentRequest) GetNewValue() float64 {
+	if x != nil {
+		return x.NewValue
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateTopicCouncilRequest) GetStage() TopicStage {
-	if x != nil && x.Stage != nil {
-		return *x.Stage
+func (x *RequestGradeAppealAmendmentRequest) GetDefenceAmendmentCode() string {
+	if x != nil && x.DefenceAmendmentCode != nil {
+		return *x.DefenceAmendmentCode
 	}
-	return TopicStage_STAGE_DACN
+	return ""
 }
 
-func (x *UpdateTopicCouncilRequest) GetTopicCode() string {
-	if x != nil && x.TopicCode != nil {
-		return *x.TopicCode
+func (x *RequestGradeAppealAmendmentRequest) GetNote() string {
+	if x != nil {
+		return x.Note
 	}
 	return ""
 }
 
-func (x *UpdateTopicCouncilRequest) GetCouncilCode() string {
-	if x != nil && x.CouncilCode != nil {
-		return *x.CouncilCode
+func (x *RequestGradeAppealAmendmentRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
 	}
 	return ""
 }
 
-func (x *UpdateTopicCouncilRequest) GetTimeStart() *timestamppb.Timestamp {
-	if x != nil {
-		return x.TimeStart
-	}
-	return nil
+type RequestGradeAppealAmendmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appeal        *GradeAppeal           `protobuf:"bytes,1,opt,name=appeal,proto3" json:"appeal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTopicCouncilRequest) GetTimeEnd() *timestamppb.Timestamp {
+func (x *RequestGradeAppealAmendmentResponse) Reset() {
+	*x = RequestGradeAppealAmendmentResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestGradeAppealAmendmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestGradeAppealAmendmentResponse) ProtoMessage() {}
+
+func (x *RequestGradeAppealAmendmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[189]
 	if x != nil {
-		return x.TimeEnd
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateTopicCouncilRequest) GetUpdatedBy() string {
+// Deprecated: Use RequestGradeAppealAmendmentResponse.ProtoReflect.Descriptor instead.
+func (*RequestGradeAppealAmendmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *RequestGradeAppealAmendmentResponse) GetAppeal() *GradeAppeal {
 	if x != nil {
-		return x.UpdatedBy
+		return x.Appeal
 	}
-	return ""
+	return nil
 }
 
-type UpdateTopicCouncilResponse struct {
+// Settles the appeal waiting on a council GradeDefenceAmendment once it is
+// decided, read back from the council service; GradeAmendment decisions
+// settle their appeal themselves
+type SettleGradeAppealAmendmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TopicCouncil  *TopicCouncil          `protobuf:"bytes,1,opt,name=topic_council,json=topicCouncil,proto3" json:"topic_council,omitempty"`
+	AmendmentCode string                 `protobuf:"bytes,1,opt,name=amendment_code,json=amendmentCode,proto3" json:"amendment_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTopicCouncilResponse) Reset() {
-	*x = UpdateTopicCouncilResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[50]
+func (x *SettleGradeAppealAmendmentRequest) Reset() {
+	*x = SettleGradeAppealAmendmentRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTopicCouncilResponse) String() string {
+func (x *SettleGradeAppealAmendmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTopicCouncilResponse) ProtoMessage() {}
+func (*SettleGradeAppealAmendmentRequest) ProtoMessage() {}
 
-func (x *UpdateTopicCouncilResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[50]
+func (x *SettleGradeAppealAmendmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3464,40 +13022,41 @@ func (x *UpdateTopicCouncilResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTopicCouncilResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTopicCouncilResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use SettleGradeAppealAmendmentRequest.ProtoReflect.Descriptor instead.
+func (*SettleGradeAppealAmendmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{190}
 }
 
-func (x *UpdateTopicCouncilResponse) GetTopicCouncil() *TopicCouncil {
+func (x *SettleGradeAppealAmendmentRequest) GetAmendmentCode() string {
 	if x != nil {
-		return x.TopicCouncil
+		return x.AmendmentCode
 	}
-	return nil
+	return ""
 }
 
-type DeleteTopicCouncilRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type SettleGradeAppealAmendmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unset when no appeal waits on the amendment
+	Appeal        *GradeAppeal `protobuf:"bytes,1,opt,name=appeal,proto3" json:"appeal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTopicCouncilRequest) Reset() {
-	*x = DeleteTopicCouncilRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[51]
+func (x *SettleGradeAppealAmendmentResponse) Reset() {
+	*x = SettleGradeAppealAmendmentResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTopicCouncilRequest) String() string {
+func (x *SettleGradeAppealAmendmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTopicCouncilRequest) ProtoMessage() {}
+func (*SettleGradeAppealAmendmentResponse) ProtoMessage() {}
 
-func (x *DeleteTopicCouncilRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[51]
+func (x *SettleGradeAppealAmendmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3508,40 +13067,40 @@ func (x *DeleteTopicCouncilRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTopicCouncilRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTopicCouncilRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use SettleGradeAppealAmendmentResponse.ProtoReflect.Descriptor instead.
+func (*SettleGradeAppealAmendmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{191}
 }
 
-func (x *DeleteTopicCouncilRequest) GetId() string {
+func (x *SettleGradeAppealAmendmentResponse) GetAppeal() *GradeAppeal {
 	if x != nil {
-		return x.Id
+		return x.Appeal
 	}
-	return ""
+	return nil
 }
 
-type DeleteTopicCouncilResponse struct {
+type GetGradeAppealRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTopicCouncilResponse) Reset() {
-	*x = DeleteTopicCouncilResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[52]
+func (x *GetGradeAppealRequest) Reset() {
+	*x = GetGradeAppealRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTopicCouncilResponse) String() string {
+func (x *GetGradeAppealRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTopicCouncilResponse) ProtoMessage() {}
+func (*GetGradeAppealRequest) ProtoMessage() {}
 
-func (x *DeleteTopicCouncilResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[52]
+func (x *GetGradeAppealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3552,40 +13111,40 @@ func (x *DeleteTopicCouncilResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTopicCouncilResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTopicCouncilResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use GetGradeAppealRequest.ProtoReflect.Descriptor instead.
+func (*GetGradeAppealRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{192}
 }
 
-func (x *DeleteTopicCouncilResponse) GetSuccess() bool {
+func (x *GetGradeAppealRequest) GetId() string {
 	if x != nil {
-		return x.Success
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-type ListTopicCouncilsRequest struct {
+type GetGradeAppealResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	Appeal        *GradeAppeal           `protobuf:"bytes,1,opt,name=appeal,proto3" json:"appeal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTopicCouncilsRequest) Reset() {
-	*x = ListTopicCouncilsRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[53]
+func (x *GetGradeAppealResponse) Reset() {
+	*x = GetGradeAppealResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTopicCouncilsRequest) String() string {
+func (x *GetGradeAppealResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTopicCouncilsRequest) ProtoMessage() {}
+func (*GetGradeAppealResponse) ProtoMessage() {}
 
-func (x *ListTopicCouncilsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[53]
+func (x *GetGradeAppealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3596,43 +13155,43 @@ func (x *ListTopicCouncilsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTopicCouncilsRequest.ProtoReflect.Descriptor instead.
-func (*ListTopicCouncilsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use GetGradeAppealResponse.ProtoReflect.Descriptor instead.
+func (*GetGradeAppealResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{193}
 }
 
-func (x *ListTopicCouncilsRequest) GetSearch() *common.SearchRequest {
+func (x *GetGradeAppealResponse) GetAppeal() *GradeAppeal {
 	if x != nil {
-		return x.Search
+		return x.Appeal
 	}
 	return nil
 }
 
-type ListTopicCouncilsResponse struct {
+type ListGradeAppealsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TopicCouncils []*TopicCouncil        `protobuf:"bytes,1,rep,name=topic_councils,json=topicCouncils,proto3" json:"topic_councils,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	SemesterCode  *string                `protobuf:"bytes,1,opt,name=semester_code,json=semesterCode,proto3,oneof" json:"semester_code,omitempty"`
+	StudentCode   *string                `protobuf:"bytes,2,opt,name=student_code,json=studentCode,proto3,oneof" json:"student_code,omitempty"`
+	ReviewerCode  *string                `protobuf:"bytes,3,opt,name=reviewer_code,json=reviewerCode,proto3,oneof" json:"reviewer_code,omitempty"`
+	Status        *GradeAppealStatus     `protobuf:"varint,4,opt,name=status,proto3,enum=thesis.GradeAppealStatus,oneof" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTopicCouncilsResponse) Reset() {
-	*x = ListTopicCouncilsResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[54]
+func (x *ListGradeAppealsRequest) Reset() {
+	*x = ListGradeAppealsRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTopicCouncilsResponse) String() string {
+func (x *ListGradeAppealsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTopicCouncilsResponse) ProtoMessage() {}
+func (*ListGradeAppealsRequest) ProtoMessage() {}
 
-func (x *ListTopicCouncilsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[54]
+func (x *ListGradeAppealsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3643,68 +13202,61 @@ func (x *ListTopicCouncilsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTopicCouncilsResponse.ProtoReflect.Descriptor instead.
-func (*ListTopicCouncilsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use ListGradeAppealsRequest.ProtoReflect.Descriptor instead.
+func (*ListGradeAppealsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{194}
 }
 
-func (x *ListTopicCouncilsResponse) GetTopicCouncils() []*TopicCouncil {
-	if x != nil {
-		return x.TopicCouncils
+func (x *ListGradeAppealsRequest) GetSemesterCode() string {
+	if x != nil && x.SemesterCode != nil {
+		return *x.SemesterCode
 	}
-	return nil
+	return ""
 }
 
-func (x *ListTopicCouncilsResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
+func (x *ListGradeAppealsRequest) GetStudentCode() string {
+	if x != nil && x.StudentCode != nil {
+		return *x.StudentCode
 	}
-	return 0
+	return ""
 }
 
-func (x *ListTopicCouncilsResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
+func (x *ListGradeAppealsRequest) GetReviewerCode() string {
+	if x != nil && x.ReviewerCode != nil {
+		return *x.ReviewerCode
 	}
-	return 0
+	return ""
 }
 
-func (x *ListTopicCouncilsResponse) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
+func (x *ListGradeAppealsRequest) GetStatus() GradeAppealStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
 	}
-	return 0
+	return GradeAppealStatus_APPEAL_SUBMITTED
 }
 
-// ============= TopicCouncilSupervisor =============
-type TopicCouncilSupervisor struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TeacherSupervisorCode string                 `protobuf:"bytes,2,opt,name=teacher_supervisor_code,json=teacherSupervisorCode,proto3" json:"teacher_supervisor_code,omitempty"`
-	TopicCouncilCode      string                 `protobuf:"bytes,3,opt,name=topic_council_code,json=topicCouncilCode,proto3" json:"topic_council_code,omitempty"`
-	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedBy             string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedBy             string                 `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type ListGradeAppealsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appeals       []*GradeAppeal         `protobuf:"bytes,1,rep,name=appeals,proto3" json:"appeals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TopicCouncilSupervisor) Reset() {
-	*x = TopicCouncilSupervisor{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[55]
+func (x *ListGradeAppealsResponse) Reset() {
+	*x = ListGradeAppealsResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TopicCouncilSupervisor) String() string {
+func (x *ListGradeAppealsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopicCouncilSupervisor) ProtoMessage() {}
+func (*ListGradeAppealsResponse) ProtoMessage() {}
 
-func (x *TopicCouncilSupervisor) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[55]
+func (x *ListGradeAppealsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3715,142 +13267,179 @@ func (x *TopicCouncilSupervisor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopicCouncilSupervisor.ProtoReflect.Descriptor instead.
-func (*TopicCouncilSupervisor) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use ListGradeAppealsResponse.ProtoReflect.Descriptor instead.
+func (*ListGradeAppealsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{195}
 }
 
-func (x *TopicCouncilSupervisor) GetId() string {
+func (x *ListGradeAppealsResponse) GetAppeals() []*GradeAppeal {
 	if x != nil {
-		return x.Id
+		return x.Appeals
 	}
-	return ""
+	return nil
 }
 
-func (x *TopicCouncilSupervisor) GetTeacherSupervisorCode() string {
+type MidtermMilestone struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SemesterCode string                 `protobuf:"bytes,2,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Stage        TopicStage             `protobuf:"varint,3,opt,name=stage,proto3,enum=thesis.TopicStage" json:"stage,omitempty"`
+	Title        string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description  string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// Order of the milestone within its semester and stage
+	Sequence      int32                  `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       int32                  `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"` // bumped by every update
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MidtermMilestone) Reset() {
+	*x = MidtermMilestone{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MidtermMilestone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MidtermMilestone) ProtoMessage() {}
+
+func (x *MidtermMilestone) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[196]
 	if x != nil {
-		return x.TeacherSupervisorCode
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *TopicCouncilSupervisor) GetTopicCouncilCode() string {
+// Deprecated: Use MidtermMilestone.ProtoReflect.Descriptor instead.
+func (*MidtermMilestone) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *MidtermMilestone) GetId() string {
 	if x != nil {
-		return x.TopicCouncilCode
+		return x.Id
 	}
 	return ""
 }
 
-func (x *TopicCouncilSupervisor) GetCreatedAt() *timestamppb.Timestamp {
+func (x *MidtermMilestone) GetSemesterCode() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.SemesterCode
 	}
-	return nil
+	return ""
 }
 
-func (x *TopicCouncilSupervisor) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *MidtermMilestone) GetStage() TopicStage {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Stage
 	}
-	return nil
+	return TopicStage_STAGE_DACN
 }
 
-func (x *TopicCouncilSupervisor) GetCreatedBy() string {
+func (x *MidtermMilestone) GetTitle() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.Title
 	}
 	return ""
 }
 
-func (x *TopicCouncilSupervisor) GetUpdatedBy() string {
+func (x *MidtermMilestone) GetDescription() string {
 	if x != nil {
-		return x.UpdatedBy
+		return x.Description
 	}
 	return ""
 }
 
-type CreateTopicCouncilSupervisorRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	TeacherSupervisorCode string                 `protobuf:"bytes,1,opt,name=teacher_supervisor_code,json=teacherSupervisorCode,proto3" json:"teacher_supervisor_code,omitempty"`
-	TopicCouncilCode      string                 `protobuf:"bytes,2,opt,name=topic_council_code,json=topicCouncilCode,proto3" json:"topic_council_code,omitempty"`
-	CreatedBy             string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
-}
-
-func (x *CreateTopicCouncilSupervisorRequest) Reset() {
-	*x = CreateTopicCouncilSupervisorRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[56]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *MidtermMilestone) GetSequence() int32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
 }
 
-func (x *CreateTopicCouncilSupervisorRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MidtermMilestone) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
 }
 
-func (*CreateTopicCouncilSupervisorRequest) ProtoMessage() {}
-
-func (x *CreateTopicCouncilSupervisorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[56]
+func (x *MidtermMilestone) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CreatedAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CreateTopicCouncilSupervisorRequest.ProtoReflect.Descriptor instead.
-func (*CreateTopicCouncilSupervisorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{56}
+func (x *MidtermMilestone) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
 }
 
-func (x *CreateTopicCouncilSupervisorRequest) GetTeacherSupervisorCode() string {
+func (x *MidtermMilestone) GetCreatedBy() string {
 	if x != nil {
-		return x.TeacherSupervisorCode
+		return x.CreatedBy
 	}
 	return ""
 }
 
-func (x *CreateTopicCouncilSupervisorRequest) GetTopicCouncilCode() string {
+func (x *MidtermMilestone) GetUpdatedBy() string {
 	if x != nil {
-		return x.TopicCouncilCode
+		return x.UpdatedBy
 	}
 	return ""
 }
 
-func (x *CreateTopicCouncilSupervisorRequest) GetCreatedBy() string {
+func (x *MidtermMilestone) GetVersion() int32 {
 	if x != nil {
-		return x.CreatedBy
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-type CreateTopicCouncilSupervisorResponse struct {
-	state                  protoimpl.MessageState  `protogen:"open.v1"`
-	TopicCouncilSupervisor *TopicCouncilSupervisor `protobuf:"bytes,1,opt,name=topic_council_supervisor,json=topicCouncilSupervisor,proto3" json:"topic_council_supervisor,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type CreateMidtermMilestoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SemesterCode  string                 `protobuf:"bytes,1,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Stage         TopicStage             `protobuf:"varint,2,opt,name=stage,proto3,enum=thesis.TopicStage" json:"stage,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Sequence      int32                  `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTopicCouncilSupervisorResponse) Reset() {
-	*x = CreateTopicCouncilSupervisorResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[57]
+func (x *CreateMidtermMilestoneRequest) Reset() {
+	*x = CreateMidtermMilestoneRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTopicCouncilSupervisorResponse) String() string {
+func (x *CreateMidtermMilestoneRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTopicCouncilSupervisorResponse) ProtoMessage() {}
+func (*CreateMidtermMilestoneRequest) ProtoMessage() {}
 
-func (x *CreateTopicCouncilSupervisorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[57]
+func (x *CreateMidtermMilestoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3861,84 +13450,82 @@ func (x *CreateTopicCouncilSupervisorResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTopicCouncilSupervisorResponse.ProtoReflect.Descriptor instead.
-func (*CreateTopicCouncilSupervisorResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use CreateMidtermMilestoneRequest.ProtoReflect.Descriptor instead.
+func (*CreateMidtermMilestoneRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{197}
 }
 
-func (x *CreateTopicCouncilSupervisorResponse) GetTopicCouncilSupervisor() *TopicCouncilSupervisor {
+func (x *CreateMidtermMilestoneRequest) GetSemesterCode() string {
 	if x != nil {
-		return x.TopicCouncilSupervisor
+		return x.SemesterCode
 	}
-	return nil
+	return ""
 }
 
-type GetTopicCouncilSupervisorRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreateMidtermMilestoneRequest) GetStage() TopicStage {
+	if x != nil {
+		return x.Stage
+	}
+	return TopicStage_STAGE_DACN
 }
 
-func (x *GetTopicCouncilSupervisorRequest) Reset() {
-	*x = GetTopicCouncilSupervisorRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[58]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CreateMidtermMilestoneRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
 }
 
-func (x *GetTopicCouncilSupervisorRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateMidtermMilestoneRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (*GetTopicCouncilSupervisorRequest) ProtoMessage() {}
-
-func (x *GetTopicCouncilSupervisorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[58]
+func (x *CreateMidtermMilestoneRequest) GetSequence() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Sequence
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetTopicCouncilSupervisorRequest.ProtoReflect.Descriptor instead.
-func (*GetTopicCouncilSupervisorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{58}
+func (x *CreateMidtermMilestoneRequest) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
 }
 
-func (x *GetTopicCouncilSupervisorRequest) GetId() string {
+func (x *CreateMidtermMilestoneRequest) GetCreatedBy() string {
 	if x != nil {
-		return x.Id
+		return x.CreatedBy
 	}
 	return ""
 }
 
-type GetTopicCouncilSupervisorResponse struct {
-	state                  protoimpl.MessageState  `protogen:"open.v1"`
-	TopicCouncilSupervisor *TopicCouncilSupervisor `protobuf:"bytes,1,opt,name=topic_council_supervisor,json=topicCouncilSupervisor,proto3" json:"topic_council_supervisor,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type CreateMidtermMilestoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Milestone     *MidtermMilestone      `protobuf:"bytes,1,opt,name=milestone,proto3" json:"milestone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTopicCouncilSupervisorResponse) Reset() {
-	*x = GetTopicCouncilSupervisorResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[59]
+func (x *CreateMidtermMilestoneResponse) Reset() {
+	*x = CreateMidtermMilestoneResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTopicCouncilSupervisorResponse) String() string {
+func (x *CreateMidtermMilestoneResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopicCouncilSupervisorResponse) ProtoMessage() {}
+func (*CreateMidtermMilestoneResponse) ProtoMessage() {}
 
-func (x *GetTopicCouncilSupervisorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[59]
+func (x *CreateMidtermMilestoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3949,43 +13536,46 @@ func (x *GetTopicCouncilSupervisorResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopicCouncilSupervisorResponse.ProtoReflect.Descriptor instead.
-func (*GetTopicCouncilSupervisorResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use CreateMidtermMilestoneResponse.ProtoReflect.Descriptor instead.
+func (*CreateMidtermMilestoneResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{198}
 }
 
-func (x *GetTopicCouncilSupervisorResponse) GetTopicCouncilSupervisor() *TopicCouncilSupervisor {
+func (x *CreateMidtermMilestoneResponse) GetMilestone() *MidtermMilestone {
 	if x != nil {
-		return x.TopicCouncilSupervisor
+		return x.Milestone
 	}
 	return nil
 }
 
-type UpdateTopicCouncilSupervisorRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TeacherSupervisorCode *string                `protobuf:"bytes,2,opt,name=teacher_supervisor_code,json=teacherSupervisorCode,proto3,oneof" json:"teacher_supervisor_code,omitempty"`
-	TopicCouncilCode      *string                `protobuf:"bytes,3,opt,name=topic_council_code,json=topicCouncilCode,proto3,oneof" json:"topic_council_code,omitempty"`
-	UpdatedBy             string                 `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type UpdateMidtermMilestoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Sequence      *int32                 `protobuf:"varint,4,opt,name=sequence,proto3,oneof" json:"sequence,omitempty"`
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       *int32                 `protobuf:"varint,7,opt,name=version,proto3,oneof" json:"version,omitempty"` // expected version; a stale one fails with ABORTED
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTopicCouncilSupervisorRequest) Reset() {
-	*x = UpdateTopicCouncilSupervisorRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[60]
+func (x *UpdateMidtermMilestoneRequest) Reset() {
+	*x = UpdateMidtermMilestoneRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTopicCouncilSupervisorRequest) String() string {
+func (x *UpdateMidtermMilestoneRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTopicCouncilSupervisorRequest) ProtoMessage() {}
+func (*UpdateMidtermMilestoneRequest) ProtoMessage() {}
 
-func (x *UpdateTopicCouncilSupervisorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[60]
+func (x *UpdateMidtermMilestoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3996,61 +13586,82 @@ func (x *UpdateTopicCouncilSupervisorRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTopicCouncilSupervisorRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTopicCouncilSupervisorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use UpdateMidtermMilestoneRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMidtermMilestoneRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{199}
 }
 
-func (x *UpdateTopicCouncilSupervisorRequest) GetId() string {
+func (x *UpdateMidtermMilestoneRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateTopicCouncilSupervisorRequest) GetTeacherSupervisorCode() string {
-	if x != nil && x.TeacherSupervisorCode != nil {
-		return *x.TeacherSupervisorCode
+func (x *UpdateMidtermMilestoneRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
 	}
 	return ""
 }
 
-func (x *UpdateTopicCouncilSupervisorRequest) GetTopicCouncilCode() string {
-	if x != nil && x.TopicCouncilCode != nil {
-		return *x.TopicCouncilCode
+func (x *UpdateMidtermMilestoneRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
 	return ""
 }
 
-func (x *UpdateTopicCouncilSupervisorRequest) GetUpdatedBy() string {
+func (x *UpdateMidtermMilestoneRequest) GetSequence() int32 {
+	if x != nil && x.Sequence != nil {
+		return *x.Sequence
+	}
+	return 0
+}
+
+func (x *UpdateMidtermMilestoneRequest) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *UpdateMidtermMilestoneRequest) GetUpdatedBy() string {
 	if x != nil {
 		return x.UpdatedBy
 	}
 	return ""
 }
 
-type UpdateTopicCouncilSupervisorResponse struct {
-	state                  protoimpl.MessageState  `protogen:"open.v1"`
-	TopicCouncilSupervisor *TopicCouncilSupervisor `protobuf:"bytes,1,opt,name=topic_council_supervisor,json=topicCouncilSupervisor,proto3" json:"topic_council_supervisor,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *UpdateMidtermMilestoneRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
 }
 
-func (x *UpdateTopicCouncilSupervisorResponse) Reset() {
-	*x = UpdateTopicCouncilSupervisorResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[61]
+type UpdateMidtermMilestoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Milestone     *MidtermMilestone      `protobuf:"bytes,1,opt,name=milestone,proto3" json:"milestone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMidtermMilestoneResponse) Reset() {
+	*x = UpdateMidtermMilestoneResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTopicCouncilSupervisorResponse) String() string {
+func (x *UpdateMidtermMilestoneResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTopicCouncilSupervisorResponse) ProtoMessage() {}
+func (*UpdateMidtermMilestoneResponse) ProtoMessage() {}
 
-func (x *UpdateTopicCouncilSupervisorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[61]
+func (x *UpdateMidtermMilestoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4061,40 +13672,42 @@ func (x *UpdateTopicCouncilSupervisorResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTopicCouncilSupervisorResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTopicCouncilSupervisorResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use UpdateMidtermMilestoneResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMidtermMilestoneResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{200}
 }
 
-func (x *UpdateTopicCouncilSupervisorResponse) GetTopicCouncilSupervisor() *TopicCouncilSupervisor {
+func (x *UpdateMidtermMilestoneResponse) GetMilestone() *MidtermMilestone {
 	if x != nil {
-		return x.TopicCouncilSupervisor
+		return x.Milestone
 	}
 	return nil
 }
 
-type DeleteTopicCouncilSupervisorRequest struct {
+// DeleteMidtermMilestone refuses a milestone that already has check-ins
+type DeleteMidtermMilestoneRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTopicCouncilSupervisorRequest) Reset() {
-	*x = DeleteTopicCouncilSupervisorRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[62]
+func (x *DeleteMidtermMilestoneRequest) Reset() {
+	*x = DeleteMidtermMilestoneRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTopicCouncilSupervisorRequest) String() string {
+func (x *DeleteMidtermMilestoneRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTopicCouncilSupervisorRequest) ProtoMessage() {}
+func (*DeleteMidtermMilestoneRequest) ProtoMessage() {}
 
-func (x *DeleteTopicCouncilSupervisorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[62]
+func (x *DeleteMidtermMilestoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4105,40 +13718,47 @@ func (x *DeleteTopicCouncilSupervisorRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTopicCouncilSupervisorRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTopicCouncilSupervisorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use DeleteMidtermMilestoneRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMidtermMilestoneRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{201}
 }
 
-func (x *DeleteTopicCouncilSupervisorRequest) GetId() string {
+func (x *DeleteMidtermMilestoneRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteTopicCouncilSupervisorResponse struct {
+func (x *DeleteMidtermMilestoneRequest) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type DeleteMidtermMilestoneResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTopicCouncilSupervisorResponse) Reset() {
-	*x = DeleteTopicCouncilSupervisorResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[63]
+func (x *DeleteMidtermMilestoneResponse) Reset() {
+	*x = DeleteMidtermMilestoneResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTopicCouncilSupervisorResponse) String() string {
+func (x *DeleteMidtermMilestoneResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTopicCouncilSupervisorResponse) ProtoMessage() {}
+func (*DeleteMidtermMilestoneResponse) ProtoMessage() {}
 
-func (x *DeleteTopicCouncilSupervisorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[63]
+func (x *DeleteMidtermMilestoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4149,40 +13769,41 @@ func (x *DeleteTopicCouncilSupervisorResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTopicCouncilSupervisorResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTopicCouncilSupervisorResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use DeleteMidtermMilestoneResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMidtermMilestoneResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{202}
 }
 
-func (x *DeleteTopicCouncilSupervisorResponse) GetSuccess() bool {
+func (x *DeleteMidtermMilestoneResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type ListTopicCouncilSupervisorsRequest struct {
+type ListMidtermMilestonesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	SemesterCode  string                 `protobuf:"bytes,1,opt,name=semester_code,json=semesterCode,proto3" json:"semester_code,omitempty"`
+	Stage         *TopicStage            `protobuf:"varint,2,opt,name=stage,proto3,enum=thesis.TopicStage,oneof" json:"stage,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTopicCouncilSupervisorsRequest) Reset() {
-	*x = ListTopicCouncilSupervisorsRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[64]
+func (x *ListMidtermMilestonesRequest) Reset() {
+	*x = ListMidtermMilestonesRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTopicCouncilSupervisorsRequest) String() string {
+func (x *ListMidtermMilestonesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTopicCouncilSupervisorsRequest) ProtoMessage() {}
+func (*ListMidtermMilestonesRequest) ProtoMessage() {}
 
-func (x *ListTopicCouncilSupervisorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[64]
+func (x *ListMidtermMilestonesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4193,43 +13814,47 @@ func (x *ListTopicCouncilSupervisorsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTopicCouncilSupervisorsRequest.ProtoReflect.Descriptor instead.
-func (*ListTopicCouncilSupervisorsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use ListMidtermMilestonesRequest.ProtoReflect.Descriptor instead.
+func (*ListMidtermMilestonesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{203}
 }
 
-func (x *ListTopicCouncilSupervisorsRequest) GetSearch() *common.SearchRequest {
+func (x *ListMidtermMilestonesRequest) GetSemesterCode() string {
 	if x != nil {
-		return x.Search
+		return x.SemesterCode
 	}
-	return nil
+	return ""
 }
 
-type ListTopicCouncilSupervisorsResponse struct {
-	state                   protoimpl.MessageState    `protogen:"open.v1"`
-	TopicCouncilSupervisors []*TopicCouncilSupervisor `protobuf:"bytes,1,rep,name=topic_council_supervisors,json=topicCouncilSupervisors,proto3" json:"topic_council_supervisors,omitempty"`
-	Total                   int32                     `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page                    int32                     `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize                int32                     `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+func (x *ListMidtermMilestonesRequest) GetStage() TopicStage {
+	if x != nil && x.Stage != nil {
+		return *x.Stage
+	}
+	return TopicStage_STAGE_DACN
 }
 
-func (x *ListTopicCouncilSupervisorsResponse) Reset() {
-	*x = ListTopicCouncilSupervisorsResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[65]
+type ListMidtermMilestonesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Milestones    []*MidtermMilestone    `protobuf:"bytes,1,rep,name=milestones,proto3" json:"milestones,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMidtermMilestonesResponse) Reset() {
+	*x = ListMidtermMilestonesResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTopicCouncilSupervisorsResponse) String() string {
+func (x *ListMidtermMilestonesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTopicCouncilSupervisorsResponse) ProtoMessage() {}
+func (*ListMidtermMilestonesResponse) ProtoMessage() {}
 
-func (x *ListTopicCouncilSupervisorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[65]
+func (x *ListMidtermMilestonesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4240,72 +13865,50 @@ func (x *ListTopicCouncilSupervisorsResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTopicCouncilSupervisorsResponse.ProtoReflect.Descriptor instead.
-func (*ListTopicCouncilSupervisorsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use ListMidtermMilestonesResponse.ProtoReflect.Descriptor instead.
+func (*ListMidtermMilestonesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{204}
 }
 
-func (x *ListTopicCouncilSupervisorsResponse) GetTopicCouncilSupervisors() []*TopicCouncilSupervisor {
+func (x *ListMidtermMilestonesResponse) GetMilestones() []*MidtermMilestone {
 	if x != nil {
-		return x.TopicCouncilSupervisors
+		return x.Milestones
 	}
 	return nil
 }
 
-func (x *ListTopicCouncilSupervisorsResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *ListTopicCouncilSupervisorsResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListTopicCouncilSupervisorsResponse) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-// ============= GradeReview =============
-type GradeReview struct {
+type MilestoneCheckin struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	ReviewGrade    *int32                 `protobuf:"varint,3,opt,name=review_grade,json=reviewGrade,proto3,oneof" json:"review_grade,omitempty"`
-	TeacherCode    string                 `protobuf:"bytes,4,opt,name=teacher_code,json=teacherCode,proto3" json:"teacher_code,omitempty"`
-	Status         FinalStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=thesis.FinalStatus" json:"status,omitempty"`
-	Notes          *string                `protobuf:"bytes,6,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
-	CompletionDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completion_date,json=completionDate,proto3,oneof" json:"completion_date,omitempty"`
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedBy      string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedBy      string                 `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	MilestoneCode  string                 `protobuf:"bytes,2,opt,name=milestone_code,json=milestoneCode,proto3" json:"milestone_code,omitempty"`
+	EnrollmentCode string                 `protobuf:"bytes,3,opt,name=enrollment_code,json=enrollmentCode,proto3" json:"enrollment_code,omitempty"`
+	Report         string                 `protobuf:"bytes,4,opt,name=report,proto3" json:"report,omitempty"`
+	FileCode       string                 `protobuf:"bytes,5,opt,name=file_code,json=fileCode,proto3" json:"file_code,omitempty"`
+	Result         MilestoneResult        `protobuf:"varint,6,opt,name=result,proto3,enum=thesis.MilestoneResult" json:"result,omitempty"`
+	Comment        string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	ReviewedBy     string                 `protobuf:"bytes,8,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	SubmittedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GradeReview) Reset() {
-	*x = GradeReview{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[66]
+func (x *MilestoneCheckin) Reset() {
+	*x = MilestoneCheckin{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GradeReview) String() string {
+func (x *MilestoneCheckin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GradeReview) ProtoMessage() {}
+func (*MilestoneCheckin) ProtoMessage() {}
 
-func (x *GradeReview) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[66]
+func (x *MilestoneCheckin) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4316,116 +13919,190 @@ func (x *GradeReview) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GradeReview.ProtoReflect.Descriptor instead.
-func (*GradeReview) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use MilestoneCheckin.ProtoReflect.Descriptor instead.
+func (*MilestoneCheckin) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{205}
 }
 
-func (x *GradeReview) GetId() string {
+func (x *MilestoneCheckin) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GradeReview) GetTitle() string {
+func (x *MilestoneCheckin) GetMilestoneCode() string {
 	if x != nil {
-		return x.Title
+		return x.MilestoneCode
 	}
 	return ""
 }
 
-func (x *GradeReview) GetReviewGrade() int32 {
-	if x != nil && x.ReviewGrade != nil {
-		return *x.ReviewGrade
+func (x *MilestoneCheckin) GetEnrollmentCode() string {
+	if x != nil {
+		return x.EnrollmentCode
 	}
-	return 0
+	return ""
 }
 
-func (x *GradeReview) GetTeacherCode() string {
+func (x *MilestoneCheckin) GetReport() string {
 	if x != nil {
-		return x.TeacherCode
+		return x.Report
 	}
 	return ""
 }
 
-func (x *GradeReview) GetStatus() FinalStatus {
+func (x *MilestoneCheckin) GetFileCode() string {
+	if x != nil {
+		return x.FileCode
+	}
+	return ""
+}
+
+func (x *MilestoneCheckin) GetResult() MilestoneResult {
+	if x != nil {
+		return x.Result
+	}
+	return MilestoneResult_MILESTONE_PENDING
+}
+
+func (x *MilestoneCheckin) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *MilestoneCheckin) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *MilestoneCheckin) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *MilestoneCheckin) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *MilestoneCheckin) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// SubmitMilestoneCheckin creates the enrollment's check-in or, while it is not
+// passed, replaces it and resets its result; the enrollment's Midterm is
+// created on the first check-in
+type SubmitMilestoneCheckinRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MilestoneCode  string                 `protobuf:"bytes,1,opt,name=milestone_code,json=milestoneCode,proto3" json:"milestone_code,omitempty"`
+	EnrollmentCode string                 `protobuf:"bytes,2,opt,name=enrollment_code,json=enrollmentCode,proto3" json:"enrollment_code,omitempty"`
+	StudentCode    string                 `protobuf:"bytes,3,opt,name=student_code,json=studentCode,proto3" json:"student_code,omitempty"`
+	Report         string                 `protobuf:"bytes,4,opt,name=report,proto3" json:"report,omitempty"`
+	FileCode       *string                `protobuf:"bytes,5,opt,name=file_code,json=fileCode,proto3,oneof" json:"file_code,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubmitMilestoneCheckinRequest) Reset() {
+	*x = SubmitMilestoneCheckinRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[206]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitMilestoneCheckinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitMilestoneCheckinRequest) ProtoMessage() {}
+
+func (x *SubmitMilestoneCheckinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[206]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return FinalStatus_PENDING
+	return mi.MessageOf(x)
 }
 
-func (x *GradeReview) GetNotes() string {
-	if x != nil && x.Notes != nil {
-		return *x.Notes
-	}
-	return ""
+// Deprecated: Use SubmitMilestoneCheckinRequest.ProtoReflect.Descriptor instead.
+func (*SubmitMilestoneCheckinRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{206}
 }
 
-func (x *GradeReview) GetCompletionDate() *timestamppb.Timestamp {
+func (x *SubmitMilestoneCheckinRequest) GetMilestoneCode() string {
 	if x != nil {
-		return x.CompletionDate
+		return x.MilestoneCode
 	}
-	return nil
+	return ""
 }
 
-func (x *GradeReview) GetCreatedAt() *timestamppb.Timestamp {
+func (x *SubmitMilestoneCheckinRequest) GetEnrollmentCode() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.EnrollmentCode
 	}
-	return nil
+	return ""
 }
 
-func (x *GradeReview) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *SubmitMilestoneCheckinRequest) GetStudentCode() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.StudentCode
 	}
-	return nil
+	return ""
 }
 
-func (x *GradeReview) GetCreatedBy() string {
+func (x *SubmitMilestoneCheckinRequest) GetReport() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.Report
 	}
 	return ""
 }
 
-func (x *GradeReview) GetUpdatedBy() string {
-	if x != nil {
-		return x.UpdatedBy
+func (x *SubmitMilestoneCheckinRequest) GetFileCode() string {
+	if x != nil && x.FileCode != nil {
+		return *x.FileCode
 	}
 	return ""
 }
 
-type CreateGradeReviewRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Title          string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	ReviewGrade    *int32                 `protobuf:"varint,2,opt,name=review_grade,json=reviewGrade,proto3,oneof" json:"review_grade,omitempty"`
-	TeacherCode    string                 `protobuf:"bytes,3,opt,name=teacher_code,json=teacherCode,proto3" json:"teacher_code,omitempty"`
-	Status         FinalStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=thesis.FinalStatus" json:"status,omitempty"`
-	Notes          *string                `protobuf:"bytes,5,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
-	CompletionDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completion_date,json=completionDate,proto3,oneof" json:"completion_date,omitempty"`
-	CreatedBy      string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type SubmitMilestoneCheckinResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checkin       *MilestoneCheckin      `protobuf:"bytes,1,opt,name=checkin,proto3" json:"checkin,omitempty"`
+	Midterm       *Midterm               `protobuf:"bytes,2,opt,name=midterm,proto3" json:"midterm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGradeReviewRequest) Reset() {
-	*x = CreateGradeReviewRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[67]
+func (x *SubmitMilestoneCheckinResponse) Reset() {
+	*x = SubmitMilestoneCheckinResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGradeReviewRequest) String() string {
+func (x *SubmitMilestoneCheckinResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGradeReviewRequest) ProtoMessage() {}
+func (*SubmitMilestoneCheckinResponse) ProtoMessage() {}
 
-func (x *CreateGradeReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[67]
+func (x *SubmitMilestoneCheckinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4436,82 +14113,116 @@ func (x *CreateGradeReviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGradeReviewRequest.ProtoReflect.Descriptor instead.
-func (*CreateGradeReviewRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use SubmitMilestoneCheckinResponse.ProtoReflect.Descriptor instead.
+func (*SubmitMilestoneCheckinResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{207}
 }
 
-func (x *CreateGradeReviewRequest) GetTitle() string {
+func (x *SubmitMilestoneCheckinResponse) GetCheckin() *MilestoneCheckin {
 	if x != nil {
-		return x.Title
+		return x.Checkin
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateGradeReviewRequest) GetReviewGrade() int32 {
-	if x != nil && x.ReviewGrade != nil {
-		return *x.ReviewGrade
+func (x *SubmitMilestoneCheckinResponse) GetMidterm() *Midterm {
+	if x != nil {
+		return x.Midterm
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateGradeReviewRequest) GetTeacherCode() string {
+type ReviewMilestoneCheckinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pass          bool                   `protobuf:"varint,2,opt,name=pass,proto3" json:"pass,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	ReviewedBy    string                 `protobuf:"bytes,4,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewMilestoneCheckinRequest) Reset() {
+	*x = ReviewMilestoneCheckinRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[208]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewMilestoneCheckinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewMilestoneCheckinRequest) ProtoMessage() {}
+
+func (x *ReviewMilestoneCheckinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[208]
 	if x != nil {
-		return x.TeacherCode
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateGradeReviewRequest) GetStatus() FinalStatus {
+// Deprecated: Use ReviewMilestoneCheckinRequest.ProtoReflect.Descriptor instead.
+func (*ReviewMilestoneCheckinRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{208}
+}
+
+func (x *ReviewMilestoneCheckinRequest) GetId() string {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
-	return FinalStatus_PENDING
+	return ""
 }
 
-func (x *CreateGradeReviewRequest) GetNotes() string {
-	if x != nil && x.Notes != nil {
-		return *x.Notes
+func (x *ReviewMilestoneCheckinRequest) GetPass() bool {
+	if x != nil {
+		return x.Pass
 	}
-	return ""
+	return false
 }
 
-func (x *CreateGradeReviewRequest) GetCompletionDate() *timestamppb.Timestamp {
+func (x *ReviewMilestoneCheckinRequest) GetComment() string {
 	if x != nil {
-		return x.CompletionDate
+		return x.Comment
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateGradeReviewRequest) GetCreatedBy() string {
+func (x *ReviewMilestoneCheckinRequest) GetReviewedBy() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.ReviewedBy
 	}
 	return ""
 }
 
-type CreateGradeReviewResponse struct {
+type ReviewMilestoneCheckinResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GradeReview   *GradeReview           `protobuf:"bytes,1,opt,name=grade_review,json=gradeReview,proto3" json:"grade_review,omitempty"`
+	Checkin       *MilestoneCheckin      `protobuf:"bytes,1,opt,name=checkin,proto3" json:"checkin,omitempty"`
+	Midterm       *Midterm               `protobuf:"bytes,2,opt,name=midterm,proto3" json:"midterm,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGradeReviewResponse) Reset() {
-	*x = CreateGradeReviewResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[68]
+func (x *ReviewMilestoneCheckinResponse) Reset() {
+	*x = ReviewMilestoneCheckinResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGradeReviewResponse) String() string {
+func (x *ReviewMilestoneCheckinResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGradeReviewResponse) ProtoMessage() {}
+func (*ReviewMilestoneCheckinResponse) ProtoMessage() {}
 
-func (x *CreateGradeReviewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[68]
+func (x *ReviewMilestoneCheckinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4522,40 +14233,47 @@ func (x *CreateGradeReviewResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGradeReviewResponse.ProtoReflect.Descriptor instead.
-func (*CreateGradeReviewResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use ReviewMilestoneCheckinResponse.ProtoReflect.Descriptor instead.
+func (*ReviewMilestoneCheckinResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{209}
 }
 
-func (x *CreateGradeReviewResponse) GetGradeReview() *GradeReview {
+func (x *ReviewMilestoneCheckinResponse) GetCheckin() *MilestoneCheckin {
 	if x != nil {
-		return x.GradeReview
+		return x.Checkin
 	}
 	return nil
 }
 
-type GetGradeReviewRequest struct {
+func (x *ReviewMilestoneCheckinResponse) GetMidterm() *Midterm {
+	if x != nil {
+		return x.Midterm
+	}
+	return nil
+}
+
+type GetMilestoneCheckinRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGradeReviewRequest) Reset() {
-	*x = GetGradeReviewRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[69]
+func (x *GetMilestoneCheckinRequest) Reset() {
+	*x = GetMilestoneCheckinRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGradeReviewRequest) String() string {
+func (x *GetMilestoneCheckinRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGradeReviewRequest) ProtoMessage() {}
+func (*GetMilestoneCheckinRequest) ProtoMessage() {}
 
-func (x *GetGradeReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[69]
+func (x *GetMilestoneCheckinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4566,40 +14284,40 @@ func (x *GetGradeReviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGradeReviewRequest.ProtoReflect.Descriptor instead.
-func (*GetGradeReviewRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use GetMilestoneCheckinRequest.ProtoReflect.Descriptor instead.
+func (*GetMilestoneCheckinRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{210}
 }
 
-func (x *GetGradeReviewRequest) GetId() string {
+func (x *GetMilestoneCheckinRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetGradeReviewResponse struct {
+type GetMilestoneCheckinResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GradeReview   *GradeReview           `protobuf:"bytes,1,opt,name=grade_review,json=gradeReview,proto3" json:"grade_review,omitempty"`
+	Checkin       *MilestoneCheckin      `protobuf:"bytes,1,opt,name=checkin,proto3" json:"checkin,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGradeReviewResponse) Reset() {
-	*x = GetGradeReviewResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[70]
+func (x *GetMilestoneCheckinResponse) Reset() {
+	*x = GetMilestoneCheckinResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGradeReviewResponse) String() string {
+func (x *GetMilestoneCheckinResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGradeReviewResponse) ProtoMessage() {}
+func (*GetMilestoneCheckinResponse) ProtoMessage() {}
 
-func (x *GetGradeReviewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[70]
+func (x *GetMilestoneCheckinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4610,47 +14328,40 @@ func (x *GetGradeReviewResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGradeReviewResponse.ProtoReflect.Descriptor instead.
-func (*GetGradeReviewResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use GetMilestoneCheckinResponse.ProtoReflect.Descriptor instead.
+func (*GetMilestoneCheckinResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{211}
 }
 
-func (x *GetGradeReviewResponse) GetGradeReview() *GradeReview {
+func (x *GetMilestoneCheckinResponse) GetCheckin() *MilestoneCheckin {
 	if x != nil {
-		return x.GradeReview
+		return x.Checkin
 	}
 	return nil
 }
 
-type UpdateGradeReviewRequest struct {
+type ListMilestoneCheckinsRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title          *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	ReviewGrade    *int32                 `protobuf:"varint,3,opt,name=review_grade,json=reviewGrade,proto3,oneof" json:"review_grade,omitempty"`
-	TeacherCode    *string                `protobuf:"bytes,4,opt,name=teacher_code,json=teacherCode,proto3,oneof" json:"teacher_code,omitempty"`
-	Status         *FinalStatus           `protobuf:"varint,5,opt,name=status,proto3,enum=thesis.FinalStatus,oneof" json:"status,omitempty"`
-	Notes          *string                `protobuf:"bytes,6,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
-	CompletionDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completion_date,json=completionDate,proto3,oneof" json:"completion_date,omitempty"`
-	UpdatedBy      string                 `protobuf:"bytes,8,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	EnrollmentCode string                 `protobuf:"bytes,1,opt,name=enrollment_code,json=enrollmentCode,proto3" json:"enrollment_code,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateGradeReviewRequest) Reset() {
-	*x = UpdateGradeReviewRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[71]
+func (x *ListMilestoneCheckinsRequest) Reset() {
+	*x = ListMilestoneCheckinsRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGradeReviewRequest) String() string {
+func (x *ListMilestoneCheckinsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGradeReviewRequest) ProtoMessage() {}
+func (*ListMilestoneCheckinsRequest) ProtoMessage() {}
 
-func (x *UpdateGradeReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[71]
+func (x *ListMilestoneCheckinsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4661,89 +14372,90 @@ func (x *UpdateGradeReviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGradeReviewRequest.ProtoReflect.Descriptor instead.
-func (*UpdateGradeReviewRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use ListMilestoneCheckinsRequest.ProtoReflect.Descriptor instead.
+func (*ListMilestoneCheckinsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{212}
 }
 
-func (x *UpdateGradeReviewRequest) GetId() string {
+func (x *ListMilestoneCheckinsRequest) GetEnrollmentCode() string {
 	if x != nil {
-		return x.Id
+		return x.EnrollmentCode
 	}
 	return ""
 }
 
-func (x *UpdateGradeReviewRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
-	}
-	return ""
+type ListMilestoneCheckinsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checkins      []*MilestoneCheckin    `protobuf:"bytes,1,rep,name=checkins,proto3" json:"checkins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateGradeReviewRequest) GetReviewGrade() int32 {
-	if x != nil && x.ReviewGrade != nil {
-		return *x.ReviewGrade
-	}
-	return 0
+func (x *ListMilestoneCheckinsResponse) Reset() {
+	*x = ListMilestoneCheckinsResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[213]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGradeReviewRequest) GetTeacherCode() string {
-	if x != nil && x.TeacherCode != nil {
-		return *x.TeacherCode
-	}
-	return ""
+func (x *ListMilestoneCheckinsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateGradeReviewRequest) GetStatus() FinalStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return FinalStatus_PENDING
-}
+func (*ListMilestoneCheckinsResponse) ProtoMessage() {}
 
-func (x *UpdateGradeReviewRequest) GetNotes() string {
-	if x != nil && x.Notes != nil {
-		return *x.Notes
+func (x *ListMilestoneCheckinsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[213]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateGradeReviewRequest) GetCompletionDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CompletionDate
-	}
-	return nil
+// Deprecated: Use ListMilestoneCheckinsResponse.ProtoReflect.Descriptor instead.
+func (*ListMilestoneCheckinsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{213}
 }
 
-func (x *UpdateGradeReviewRequest) GetUpdatedBy() string {
+func (x *ListMilestoneCheckinsResponse) GetCheckins() []*MilestoneCheckin {
 	if x != nil {
-		return x.UpdatedBy
+		return x.Checkins
 	}
-	return ""
+	return nil
 }
 
-type UpdateGradeReviewResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GradeReview   *GradeReview           `protobuf:"bytes,1,opt,name=grade_review,json=gradeReview,proto3" json:"grade_review,omitempty"`
+// ============= Full-text search =============
+type SearchTopicsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Query string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Visibility: when statuses or created_by are set, only topics in one of
+	// the statuses or created by created_by are returned
+	Statuses      []TopicStatus `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=thesis.TopicStatus" json:"statuses,omitempty"`
+	CreatedBy     *string       `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateGradeReviewResponse) Reset() {
-	*x = UpdateGradeReviewResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[72]
+func (x *SearchTopicsRequest) Reset() {
+	*x = SearchTopicsRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGradeReviewResponse) String() string {
+func (x *SearchTopicsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGradeReviewResponse) ProtoMessage() {}
+func (*SearchTopicsRequest) ProtoMessage() {}
 
-func (x *UpdateGradeReviewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[72]
+func (x *SearchTopicsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4754,40 +14466,61 @@ func (x *UpdateGradeReviewResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGradeReviewResponse.ProtoReflect.Descriptor instead.
-func (*UpdateGradeReviewResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use SearchTopicsRequest.ProtoReflect.Descriptor instead.
+func (*SearchTopicsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{214}
 }
 
-func (x *UpdateGradeReviewResponse) GetGradeReview() *GradeReview {
+func (x *SearchTopicsRequest) GetQuery() string {
 	if x != nil {
-		return x.GradeReview
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchTopicsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchTopicsRequest) GetStatuses() []TopicStatus {
+	if x != nil {
+		return x.Statuses
 	}
 	return nil
 }
 
-type DeleteGradeReviewRequest struct {
+func (x *SearchTopicsRequest) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
+}
+
+type SearchTopicsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hits          []*common.SearchHit    `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"` // best first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGradeReviewRequest) Reset() {
-	*x = DeleteGradeReviewRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[73]
+func (x *SearchTopicsResponse) Reset() {
+	*x = SearchTopicsResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGradeReviewRequest) String() string {
+func (x *SearchTopicsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGradeReviewRequest) ProtoMessage() {}
+func (*SearchTopicsResponse) ProtoMessage() {}
 
-func (x *DeleteGradeReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[73]
+func (x *SearchTopicsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4798,40 +14531,45 @@ func (x *DeleteGradeReviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGradeReviewRequest.ProtoReflect.Descriptor instead.
-func (*DeleteGradeReviewRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use SearchTopicsResponse.ProtoReflect.Descriptor instead.
+func (*SearchTopicsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{215}
 }
 
-func (x *DeleteGradeReviewRequest) GetId() string {
+func (x *SearchTopicsResponse) GetHits() []*common.SearchHit {
 	if x != nil {
-		return x.Id
+		return x.Hits
 	}
-	return ""
+	return nil
 }
 
-type DeleteGradeReviewResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ============= Council conflict data =============
+// The supervisors and reviewers of the topic councils a council judges, which
+// the council service checks its members against
+type ListCouncilTopicsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CouncilCode string                 `protobuf:"bytes,1,opt,name=council_code,json=councilCode,proto3" json:"council_code,omitempty"`
+	// Topic councils about to be assigned to the council, listed along
+	TopicCouncilCodes []string `protobuf:"bytes,2,rep,name=topic_council_codes,json=topicCouncilCodes,proto3" json:"topic_council_codes,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *DeleteGradeReviewResponse) Reset() {
-	*x = DeleteGradeReviewResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[74]
+func (x *ListCouncilTopicsRequest) Reset() {
+	*x = ListCouncilTopicsRequest{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGradeReviewResponse) String() string {
+func (x *ListCouncilTopicsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGradeReviewResponse) ProtoMessage() {}
+func (*ListCouncilTopicsRequest) ProtoMessage() {}
 
-func (x *DeleteGradeReviewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[74]
+func (x *ListCouncilTopicsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4842,40 +14580,49 @@ func (x *DeleteGradeReviewResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGradeReviewResponse.ProtoReflect.Descriptor instead.
-func (*DeleteGradeReviewResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use ListCouncilTopicsRequest.ProtoReflect.Descriptor instead.
+func (*ListCouncilTopicsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{216}
 }
 
-func (x *DeleteGradeReviewResponse) GetSuccess() bool {
+func (x *ListCouncilTopicsRequest) GetCouncilCode() string {
 	if x != nil {
-		return x.Success
+		return x.CouncilCode
 	}
-	return false
+	return ""
 }
 
-type ListGradeReviewsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListCouncilTopicsRequest) GetTopicCouncilCodes() []string {
+	if x != nil {
+		return x.TopicCouncilCodes
+	}
+	return nil
 }
 
-func (x *ListGradeReviewsRequest) Reset() {
-	*x = ListGradeReviewsRequest{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[75]
+type CouncilTopicStaff struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TopicCouncilCode string                 `protobuf:"bytes,1,opt,name=topic_council_code,json=topicCouncilCode,proto3" json:"topic_council_code,omitempty"`
+	SupervisorCodes  []string               `protobuf:"bytes,2,rep,name=supervisor_codes,json=supervisorCodes,proto3" json:"supervisor_codes,omitempty"`
+	ReviewerCodes    []string               `protobuf:"bytes,3,rep,name=reviewer_codes,json=reviewerCodes,proto3" json:"reviewer_codes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CouncilTopicStaff) Reset() {
+	*x = CouncilTopicStaff{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGradeReviewsRequest) String() string {
+func (x *CouncilTopicStaff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGradeReviewsRequest) ProtoMessage() {}
+func (*CouncilTopicStaff) ProtoMessage() {}
 
-func (x *ListGradeReviewsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[75]
+func (x *CouncilTopicStaff) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4886,43 +14633,54 @@ func (x *ListGradeReviewsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGradeReviewsRequest.ProtoReflect.Descriptor instead.
-func (*ListGradeReviewsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use CouncilTopicStaff.ProtoReflect.Descriptor instead.
+func (*CouncilTopicStaff) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{217}
 }
 
-func (x *ListGradeReviewsRequest) GetSearch() *common.SearchRequest {
+func (x *CouncilTopicStaff) GetTopicCouncilCode() string {
 	if x != nil {
-		return x.Search
+		return x.TopicCouncilCode
+	}
+	return ""
+}
+
+func (x *CouncilTopicStaff) GetSupervisorCodes() []string {
+	if x != nil {
+		return x.SupervisorCodes
 	}
 	return nil
 }
 
-type ListGradeReviewsResponse struct {
+func (x *CouncilTopicStaff) GetReviewerCodes() []string {
+	if x != nil {
+		return x.ReviewerCodes
+	}
+	return nil
+}
+
+type ListCouncilTopicsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GradeReviews  []*GradeReview         `protobuf:"bytes,1,rep,name=grade_reviews,json=gradeReviews,proto3" json:"grade_reviews,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Topics        []*CouncilTopicStaff   `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListGradeReviewsResponse) Reset() {
-	*x = ListGradeReviewsResponse{}
-	mi := &file_proto_thesis_thesis_proto_msgTypes[76]
+func (x *ListCouncilTopicsResponse) Reset() {
+	*x = ListCouncilTopicsResponse{}
+	mi := &file_proto_thesis_thesis_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGradeReviewsResponse) String() string {
+func (x *ListCouncilTopicsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGradeReviewsResponse) ProtoMessage() {}
+func (*ListCouncilTopicsResponse) ProtoMessage() {}
 
-func (x *ListGradeReviewsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thesis_thesis_proto_msgTypes[76]
+func (x *ListCouncilTopicsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thesis_thesis_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4933,44 +14691,23 @@ func (x *ListGradeReviewsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGradeReviewsResponse.ProtoReflect.Descriptor instead.
-func (*ListGradeReviewsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use ListCouncilTopicsResponse.ProtoReflect.Descriptor instead.
+func (*ListCouncilTopicsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thesis_thesis_proto_rawDescGZIP(), []int{218}
 }
 
-func (x *ListGradeReviewsResponse) GetGradeReviews() []*GradeReview {
+func (x *ListCouncilTopicsResponse) GetTopics() []*CouncilTopicStaff {
 	if x != nil {
-		return x.GradeReviews
+		return x.Topics
 	}
 	return nil
 }
 
-func (x *ListGradeReviewsResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *ListGradeReviewsResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListGradeReviewsResponse) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
 var File_proto_thesis_thesis_proto protoreflect.FileDescriptor
 
 const file_proto_thesis_thesis_proto_rawDesc = "" +
 	"\n" +
-	"\x19proto/thesis/thesis.proto\x12\x06thesis\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19proto/common/common.proto\"\xc4\x02\n" +
+	"\x19proto/thesis/thesis.proto\x12\x06thesis\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19proto/common/common.proto\"\xb8\x03\n" +
 	"\aMidterm\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
@@ -4984,7 +14721,13 @@ const file_proto_thesis_thesis_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\b \x01(\tR\tcreatedBy\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\t \x01(\tR\tupdatedBy\"\xcd\x01\n" +
+	"updated_by\x18\t \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\f \x01(\tR\tdeletedBy\"\xcd\x01\n" +
 	"\x14CreateMidtermRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x19\n" +
 	"\x05grade\x18\x02 \x01(\x05H\x00R\x05grade\x88\x01\x01\x12-\n" +
@@ -4995,11 +14738,12 @@ const file_proto_thesis_thesis_proto_rawDesc = "" +
 	"\x06_gradeB\v\n" +
 	"\t_feedback\"B\n" +
 	"\x15CreateMidtermResponse\x12)\n" +
-	"\amidterm\x18\x01 \x01(\v2\x0f.thesis.MidtermR\amidterm\"#\n" +
+	"\amidterm\x18\x01 \x01(\v2\x0f.thesis.MidtermR\amidterm\"L\n" +
 	"\x11GetMidtermRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"?\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"?\n" +
 	"\x12GetMidtermResponse\x12)\n" +
-	"\amidterm\x18\x01 \x01(\v2\x0f.thesis.MidtermR\amidterm\"\xfc\x01\n" +
+	"\amidterm\x18\x01 \x01(\v2\x0f.thesis.MidtermR\amidterm\"\xa7\x02\n" +
 	"\x14UpdateMidtermRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x19\n" +
@@ -5007,31 +14751,43 @@ const file_proto_thesis_thesis_proto_rawDesc = "" +
 	"\x06status\x18\x04 \x01(\x0e2\x15.thesis.MidtermStatusH\x02R\x06status\x88\x01\x01\x12\x1f\n" +
 	"\bfeedback\x18\x05 \x01(\tH\x03R\bfeedback\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\x06 \x01(\tR\tupdatedByB\b\n" +
+	"updated_by\x18\x06 \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\aversion\x18\a \x01(\x05H\x04R\aversion\x88\x01\x01B\b\n" +
 	"\x06_titleB\b\n" +
 	"\x06_gradeB\t\n" +
 	"\a_statusB\v\n" +
-	"\t_feedback\"B\n" +
+	"\t_feedbackB\n" +
+	"\n" +
+	"\b_version\"B\n" +
 	"\x15UpdateMidtermResponse\x12)\n" +
-	"\amidterm\x18\x01 \x01(\v2\x0f.thesis.MidtermR\amidterm\"&\n" +
+	"\amidterm\x18\x01 \x01(\v2\x0f.thesis.MidtermR\amidterm\"E\n" +
 	"\x14DeleteMidtermRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\"1\n" +
 	"\x15DeleteMidtermResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"D\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"H\n" +
+	"\x15RestoreMidtermRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrestored_by\x18\x02 \x01(\tR\n" +
+	"restoredBy\"C\n" +
+	"\x16RestoreMidtermResponse\x12)\n" +
+	"\amidterm\x18\x01 \x01(\v2\x0f.thesis.MidtermR\amidterm\"m\n" +
 	"\x13ListMidtermsRequest\x12-\n" +
-	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\"\x8a\x01\n" +
+	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"\x8a\x01\n" +
 	"\x14ListMidtermsResponse\x12+\n" +
 	"\bmidterms\x18\x01 \x03(\v2\x0f.thesis.MidtermR\bmidterms\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xe0\x03\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x9d\x05\n" +
 	"\x05Final\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12)\n" +
-	"\x10supervisor_grade\x18\x03 \x01(\x05R\x0fsupervisorGrade\x12)\n" +
-	"\x10department_grade\x18\x04 \x01(\x05R\x0fdepartmentGrade\x12\x1f\n" +
-	"\vfinal_grade\x18\x05 \x01(\x05R\n" +
-	"finalGrade\x12+\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12.\n" +
+	"\x10supervisor_grade\x18\x03 \x01(\x05H\x00R\x0fsupervisorGrade\x88\x01\x01\x12.\n" +
+	"\x10department_grade\x18\x04 \x01(\x05H\x01R\x0fdepartmentGrade\x88\x01\x01\x12$\n" +
+	"\vfinal_grade\x18\x05 \x01(\x01H\x02R\n" +
+	"finalGrade\x88\x01\x01\x12+\n" +
 	"\x06status\x18\x06 \x01(\x0e2\x13.thesis.FinalStatusR\x06status\x12\x14\n" +
 	"\x05notes\x18\a \x01(\tR\x05notes\x12C\n" +
 	"\x0fcompletion_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0ecompletionDate\x129\n" +
@@ -5043,61 +14799,77 @@ const file_proto_thesis_thesis_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\v \x01(\tR\tcreatedBy\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\f \x01(\tR\tupdatedBy\"\xb9\x03\n" +
+	"updated_by\x18\f \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\r \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x0f \x01(\tR\tdeletedByB\x13\n" +
+	"\x11_supervisor_gradeB\x13\n" +
+	"\x11_department_gradeB\x0e\n" +
+	"\f_final_grade\"\x89\x03\n" +
 	"\x12CreateFinalRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12.\n" +
 	"\x10supervisor_grade\x18\x02 \x01(\x05H\x00R\x0fsupervisorGrade\x88\x01\x01\x12.\n" +
-	"\x10department_grade\x18\x03 \x01(\x05H\x01R\x0fdepartmentGrade\x88\x01\x01\x12$\n" +
-	"\vfinal_grade\x18\x04 \x01(\x05H\x02R\n" +
-	"finalGrade\x88\x01\x01\x12+\n" +
+	"\x10department_grade\x18\x03 \x01(\x05H\x01R\x0fdepartmentGrade\x88\x01\x01\x12+\n" +
 	"\x06status\x18\x05 \x01(\x0e2\x13.thesis.FinalStatusR\x06status\x12\x19\n" +
-	"\x05notes\x18\x06 \x01(\tH\x03R\x05notes\x88\x01\x01\x12H\n" +
-	"\x0fcompletion_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x04R\x0ecompletionDate\x88\x01\x01\x12\x1d\n" +
+	"\x05notes\x18\x06 \x01(\tH\x02R\x05notes\x88\x01\x01\x12H\n" +
+	"\x0fcompletion_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x03R\x0ecompletionDate\x88\x01\x01\x12\x1d\n" +
 	"\n" +
 	"created_by\x18\b \x01(\tR\tcreatedByB\x13\n" +
 	"\x11_supervisor_gradeB\x13\n" +
-	"\x11_department_gradeB\x0e\n" +
-	"\f_final_gradeB\b\n" +
+	"\x11_department_gradeB\b\n" +
 	"\x06_notesB\x12\n" +
-	"\x10_completion_date\":\n" +
+	"\x10_completion_dateJ\x04\b\x04\x10\x05\":\n" +
 	"\x13CreateFinalResponse\x12#\n" +
-	"\x05final\x18\x01 \x01(\v2\r.thesis.FinalR\x05final\"!\n" +
+	"\x05final\x18\x01 \x01(\v2\r.thesis.FinalR\x05final\"J\n" +
 	"\x0fGetFinalRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"7\n" +
 	"\x10GetFinalResponse\x12#\n" +
-	"\x05final\x18\x01 \x01(\v2\r.thesis.FinalR\x05final\"\xe8\x03\n" +
+	"\x05final\x18\x01 \x01(\v2\r.thesis.FinalR\x05final\"\xe3\x03\n" +
 	"\x12UpdateFinalRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12.\n" +
 	"\x10supervisor_grade\x18\x03 \x01(\x05H\x01R\x0fsupervisorGrade\x88\x01\x01\x12.\n" +
-	"\x10department_grade\x18\x04 \x01(\x05H\x02R\x0fdepartmentGrade\x88\x01\x01\x12$\n" +
-	"\vfinal_grade\x18\x05 \x01(\x05H\x03R\n" +
-	"finalGrade\x88\x01\x01\x120\n" +
-	"\x06status\x18\x06 \x01(\x0e2\x13.thesis.FinalStatusH\x04R\x06status\x88\x01\x01\x12\x19\n" +
-	"\x05notes\x18\a \x01(\tH\x05R\x05notes\x88\x01\x01\x12H\n" +
-	"\x0fcompletion_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x06R\x0ecompletionDate\x88\x01\x01\x12\x1d\n" +
-	"\n" +
-	"updated_by\x18\t \x01(\tR\tupdatedByB\b\n" +
+	"\x10department_grade\x18\x04 \x01(\x05H\x02R\x0fdepartmentGrade\x88\x01\x01\x120\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x13.thesis.FinalStatusH\x03R\x06status\x88\x01\x01\x12\x19\n" +
+	"\x05notes\x18\a \x01(\tH\x04R\x05notes\x88\x01\x01\x12H\n" +
+	"\x0fcompletion_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x05R\x0ecompletionDate\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\t \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\aversion\x18\n" +
+	" \x01(\x05H\x06R\aversion\x88\x01\x01B\b\n" +
 	"\x06_titleB\x13\n" +
 	"\x11_supervisor_gradeB\x13\n" +
-	"\x11_department_gradeB\x0e\n" +
-	"\f_final_gradeB\t\n" +
+	"\x11_department_gradeB\t\n" +
 	"\a_statusB\b\n" +
 	"\x06_notesB\x12\n" +
-	"\x10_completion_date\":\n" +
+	"\x10_completion_dateB\n" +
+	"\n" +
+	"\b_versionJ\x04\b\x05\x10\x06\":\n" +
 	"\x13UpdateFinalResponse\x12#\n" +
-	"\x05final\x18\x01 \x01(\v2\r.thesis.FinalR\x05final\"$\n" +
+	"\x05final\x18\x01 \x01(\v2\r.thesis.FinalR\x05final\"C\n" +
 	"\x12DeleteFinalRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\"/\n" +
 	"\x13DeleteFinalResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"B\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"F\n" +
+	"\x13RestoreFinalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrestored_by\x18\x02 \x01(\tR\n" +
+	"restoredBy\";\n" +
+	"\x14RestoreFinalResponse\x12#\n" +
+	"\x05final\x18\x01 \x01(\v2\r.thesis.FinalR\x05final\"k\n" +
 	"\x11ListFinalsRequest\x12-\n" +
-	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\"\x82\x01\n" +
+	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"\x82\x01\n" +
 	"\x12ListFinalsResponse\x12%\n" +
 	"\x06finals\x18\x01 \x03(\v2\r.thesis.FinalR\x06finals\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xea\x03\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xde\x04\n" +
 	"\n" +
 	"Enrollment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
@@ -5116,7 +14888,12 @@ const file_proto_thesis_thesis_proto_rawDesc = "" +
 	"created_by\x18\n" +
 	" \x01(\tR\tcreatedBy\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\v \x01(\tR\tupdatedByB\r\n" +
+	"updated_by\x18\v \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\f \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x0e \x01(\tR\tdeletedByB\r\n" +
 	"\v_final_codeB\x14\n" +
 	"\x12_grade_review_codeB\x0f\n" +
 	"\r_midterm_code\"\xd2\x02\n" +
@@ -5136,13 +14913,14 @@ const file_proto_thesis_thesis_proto_rawDesc = "" +
 	"\x18CreateEnrollmentResponse\x122\n" +
 	"\n" +
 	"enrollment\x18\x01 \x01(\v2\x12.thesis.EnrollmentR\n" +
-	"enrollment\"&\n" +
+	"enrollment\"O\n" +
 	"\x14GetEnrollmentRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"K\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"K\n" +
 	"\x15GetEnrollmentResponse\x122\n" +
 	"\n" +
 	"enrollment\x18\x01 \x01(\v2\x12.thesis.EnrollmentR\n" +
-	"enrollment\"\xa3\x03\n" +
+	"enrollment\"\xce\x03\n" +
 	"\x17UpdateEnrollmentRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12&\n" +
@@ -5153,28 +14931,72 @@ const file_proto_thesis_thesis_proto_rawDesc = "" +
 	"\x11grade_review_code\x18\x06 \x01(\tH\x04R\x0fgradeReviewCode\x88\x01\x01\x12&\n" +
 	"\fmidterm_code\x18\a \x01(\tH\x05R\vmidtermCode\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\b \x01(\tR\tupdatedByB\b\n" +
+	"updated_by\x18\b \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\aversion\x18\t \x01(\x05H\x06R\aversion\x88\x01\x01B\b\n" +
 	"\x06_titleB\x0f\n" +
 	"\r_student_codeB\x15\n" +
 	"\x13_topic_council_codeB\r\n" +
 	"\v_final_codeB\x14\n" +
 	"\x12_grade_review_codeB\x0f\n" +
-	"\r_midterm_code\"N\n" +
+	"\r_midterm_codeB\n" +
+	"\n" +
+	"\b_version\"N\n" +
 	"\x18UpdateEnrollmentResponse\x122\n" +
 	"\n" +
 	"enrollment\x18\x01 \x01(\v2\x12.thesis.EnrollmentR\n" +
-	"enrollment\")\n" +
+	"enrollment\"H\n" +
 	"\x17DeleteEnrollmentRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\"4\n" +
 	"\x18DeleteEnrollmentResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"G\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"K\n" +
+	"\x18RestoreEnrollmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrestored_by\x18\x02 \x01(\tR\n" +
+	"restoredBy\"O\n" +
+	"\x19RestoreEnrollmentResponse\x122\n" +
+	"\n" +
+	"enrollment\x18\x01 \x01(\v2\x12.thesis.EnrollmentR\n" +
+	"enrollment\"p\n" +
 	"\x16ListEnrollmentsRequest\x12-\n" +
-	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\"\x96\x01\n" +
+	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"\x96\x01\n" +
 	"\x17ListEnrollmentsResponse\x124\n" +
 	"\venrollments\x18\x01 \x03(\v2\x12.thesis.EnrollmentR\venrollments\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xd4\x03\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xd4\x02\n" +
+	"\x1dCreateEnrollmentBundleRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12!\n" +
+	"\fstudent_code\x18\x02 \x01(\tR\vstudentCode\x12,\n" +
+	"\x12topic_council_code\x18\x03 \x01(\tR\x10topicCouncilCode\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x126\n" +
+	"\amidterm\x18\x05 \x01(\v2\x1c.thesis.CreateMidtermRequestR\amidterm\x120\n" +
+	"\x05final\x18\x06 \x01(\v2\x1a.thesis.CreateFinalRequestR\x05final\x12C\n" +
+	"\fgrade_review\x18\a \x01(\v2 .thesis.CreateGradeReviewRequestR\vgradeReview\"\xf2\x01\n" +
+	"\x1eCreateEnrollmentBundleResponse\x122\n" +
+	"\n" +
+	"enrollment\x18\x01 \x01(\v2\x12.thesis.EnrollmentR\n" +
+	"enrollment\x12)\n" +
+	"\amidterm\x18\x02 \x01(\v2\x0f.thesis.MidtermR\amidterm\x12#\n" +
+	"\x05final\x18\x03 \x01(\v2\r.thesis.FinalR\x05final\x12;\n" +
+	"\fgrade_review\x18\x04 \x01(\v2\x13.thesis.GradeReviewH\x00R\vgradeReview\x88\x01\x01B\x0f\n" +
+	"\r_grade_review\"O\n" +
+	"\x1eDeleteEnrollmentCascadeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\"\xee\x01\n" +
+	"\x1fDeleteEnrollmentCascadeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12&\n" +
+	"\fmidterm_code\x18\x02 \x01(\tH\x00R\vmidtermCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"final_code\x18\x03 \x01(\tH\x01R\tfinalCode\x88\x01\x01\x12/\n" +
+	"\x11grade_review_code\x18\x04 \x01(\tH\x02R\x0fgradeReviewCode\x88\x01\x01B\x0f\n" +
+	"\r_midterm_codeB\r\n" +
+	"\v_final_codeB\x14\n" +
+	"\x12_grade_review_code\"\x94\x05\n" +
 	"\x05Topic\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
@@ -5192,7 +15014,14 @@ const file_proto_thesis_thesis_proto_rawDesc = "" +
 	"created_by\x18\n" +
 	" \x01(\tR\tcreatedBy\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\v \x01(\tR\tupdatedByB\x12\n" +
+	"updated_by\x18\v \x01(\tR\tupdatedBy\x12!\n" +
+	"\fmax_students\x18\f \x01(\x05R\vmaxStudents\x12'\n" +
+	"\x0frequired_skills\x18\r \x03(\tR\x0erequiredSkills\x12\x18\n" +
+	"\aversion\x18\x0e \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x10 \x01(\tR\tdeletedByB\x12\n" +
 	"\x10_percent_stage_1B\x12\n" +
 	"\x10_percent_stage_2\"\xbc\x02\n" +
 	"\x12CreateTopicRequest\x12\x14\n" +
@@ -5208,11 +15037,12 @@ const file_proto_thesis_thesis_proto_rawDesc = "" +
 	"\x10_percent_stage_1B\x12\n" +
 	"\x10_percent_stage_2\":\n" +
 	"\x13CreateTopicResponse\x12#\n" +
-	"\x05topic\x18\x01 \x01(\v2\r.thesis.TopicR\x05topic\"!\n" +
+	"\x05topic\x18\x01 \x01(\v2\r.thesis.TopicR\x05topic\"J\n" +
 	"\x0fGetTopicRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"7\n" +
 	"\x10GetTopicResponse\x12#\n" +
-	"\x05topic\x18\x01 \x01(\v2\r.thesis.TopicR\x05topic\"\x96\x03\n" +
+	"\x05topic\x18\x01 \x01(\v2\r.thesis.TopicR\x05topic\"\xa3\x04\n" +
 	"\x12UpdateTopicRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\"\n" +
@@ -5223,26 +15053,252 @@ const file_proto_thesis_thesis_proto_rawDesc = "" +
 	"\x0fpercent_stage_1\x18\x06 \x01(\x05H\x04R\rpercentStage1\x88\x01\x01\x12+\n" +
 	"\x0fpercent_stage_2\x18\a \x01(\x05H\x05R\rpercentStage2\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\b \x01(\tR\tupdatedByB\b\n" +
+	"updated_by\x18\b \x01(\tR\tupdatedBy\x12&\n" +
+	"\fmax_students\x18\t \x01(\x05H\x06R\vmaxStudents\x88\x01\x01\x12'\n" +
+	"\x0frequired_skills\x18\n" +
+	" \x03(\tR\x0erequiredSkills\x12\x1d\n" +
+	"\aversion\x18\v \x01(\x05H\aR\aversion\x88\x01\x01B\b\n" +
 	"\x06_titleB\r\n" +
 	"\v_major_codeB\x10\n" +
 	"\x0e_semester_codeB\t\n" +
 	"\a_statusB\x12\n" +
 	"\x10_percent_stage_1B\x12\n" +
-	"\x10_percent_stage_2\":\n" +
+	"\x10_percent_stage_2B\x0f\n" +
+	"\r_max_studentsB\n" +
+	"\n" +
+	"\b_version\":\n" +
 	"\x13UpdateTopicResponse\x12#\n" +
-	"\x05topic\x18\x01 \x01(\v2\r.thesis.TopicR\x05topic\"$\n" +
+	"\x05topic\x18\x01 \x01(\v2\r.thesis.TopicR\x05topic\"C\n" +
 	"\x12DeleteTopicRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\"/\n" +
 	"\x13DeleteTopicResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"B\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"F\n" +
+	"\x13RestoreTopicRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrestored_by\x18\x02 \x01(\tR\n" +
+	"restoredBy\";\n" +
+	"\x14RestoreTopicResponse\x12#\n" +
+	"\x05topic\x18\x01 \x01(\v2\r.thesis.TopicR\x05topic\"k\n" +
 	"\x11ListTopicsRequest\x12-\n" +
-	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\"\x82\x01\n" +
+	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"\x82\x01\n" +
 	"\x12ListTopicsResponse\x12%\n" +
 	"\x06topics\x18\x01 \x03(\v2\r.thesis.TopicR\x06topics\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xdc\x03\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xb2\x02\n" +
+	"\x12TopicStatusHistory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"topic_code\x18\x02 \x01(\tR\ttopicCode\x129\n" +
+	"\vfrom_status\x18\x03 \x01(\x0e2\x13.thesis.TopicStatusH\x00R\n" +
+	"fromStatus\x88\x01\x01\x120\n" +
+	"\tto_status\x18\x04 \x01(\x0e2\x13.thesis.TopicStatusR\btoStatus\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0e\n" +
+	"\f_from_status\":\n" +
+	"\x12SubmitTopicRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\"p\n" +
+	"\x13SubmitTopicResponse\x12#\n" +
+	"\x05topic\x18\x01 \x01(\v2\r.thesis.TopicR\x05topic\x124\n" +
+	"\ahistory\x18\x02 \x01(\v2\x1a.thesis.TopicStatusHistoryR\ahistory\"e\n" +
+	"\x13ApproveTopicRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05stage\x18\x02 \x01(\x05R\x05stage\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"q\n" +
+	"\x14ApproveTopicResponse\x12#\n" +
+	"\x05topic\x18\x01 \x01(\v2\r.thesis.TopicR\x05topic\x124\n" +
+	"\ahistory\x18\x02 \x01(\v2\x1a.thesis.TopicStatusHistoryR\ahistory\"h\n" +
+	"\x12RejectTopicRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x14\n" +
+	"\x05stage\x18\x04 \x01(\x05R\x05stage\"p\n" +
+	"\x13RejectTopicResponse\x12#\n" +
+	"\x05topic\x18\x01 \x01(\v2\r.thesis.TopicR\x05topic\x124\n" +
+	"\ahistory\x18\x02 \x01(\v2\x1a.thesis.TopicStatusHistoryR\ahistory\"9\n" +
+	"\x11StartTopicRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\"o\n" +
+	"\x12StartTopicResponse\x12#\n" +
+	"\x05topic\x18\x01 \x01(\v2\r.thesis.TopicR\x05topic\x124\n" +
+	"\ahistory\x18\x02 \x01(\v2\x1a.thesis.TopicStatusHistoryR\ahistory\"<\n" +
+	"\x14CompleteTopicRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\"r\n" +
+	"\x15CompleteTopicResponse\x12#\n" +
+	"\x05topic\x18\x01 \x01(\v2\r.thesis.TopicR\x05topic\x124\n" +
+	"\ahistory\x18\x02 \x01(\v2\x1a.thesis.TopicStatusHistoryR\ahistory\":\n" +
+	"\x1dListTopicStatusHistoryRequest\x12\x19\n" +
+	"\btopic_id\x18\x01 \x01(\tR\atopicId\"V\n" +
+	"\x1eListTopicStatusHistoryResponse\x124\n" +
+	"\ahistory\x18\x01 \x03(\v2\x1a.thesis.TopicStatusHistoryR\ahistory\"\xa8\x03\n" +
+	"\x13ProposeTopicRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"major_code\x18\x02 \x01(\tR\tmajorCode\x12#\n" +
+	"\rsemester_code\x18\x03 \x01(\tR\fsemesterCode\x12(\n" +
+	"\x05stage\x18\x04 \x01(\x0e2\x12.thesis.TopicStageR\x05stage\x12!\n" +
+	"\fmax_students\x18\x05 \x01(\x05R\vmaxStudents\x12'\n" +
+	"\x0frequired_skills\x18\x06 \x03(\tR\x0erequiredSkills\x12.\n" +
+	"\x13co_supervisor_codes\x18\a \x03(\tR\x11coSupervisorCodes\x129\n" +
+	"\n" +
+	"time_start\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\ttimeStart\x125\n" +
+	"\btime_end\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\atimeEnd\x12\x1f\n" +
+	"\vproposed_by\x18\n" +
+	" \x01(\tR\n" +
+	"proposedBy\"\x95\x01\n" +
+	"\x14ProposeTopicResponse\x12#\n" +
+	"\x05topic\x18\x01 \x01(\v2\r.thesis.TopicR\x05topic\x12(\n" +
+	"\x10topic_council_id\x18\x02 \x01(\tR\x0etopicCouncilId\x12.\n" +
+	"\bco_signs\x18\x03 \x03(\v2\x13.thesis.TopicCoSignR\acoSigns\"\x83\x02\n" +
+	"\vTopicCoSign\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"topic_code\x18\x02 \x01(\tR\ttopicCode\x12!\n" +
+	"\fteacher_code\x18\x03 \x01(\tR\vteacherCode\x12,\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x14.thesis.CoSignStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"j\n" +
+	"\x12CoSignTopicRequest\x12\x19\n" +
+	"\btopic_id\x18\x01 \x01(\tR\atopicId\x12!\n" +
+	"\fteacher_code\x18\x02 \x01(\tR\vteacherCode\x12\x16\n" +
+	"\x06accept\x18\x03 \x01(\bR\x06accept\"C\n" +
+	"\x13CoSignTopicResponse\x12,\n" +
+	"\aco_sign\x18\x01 \x01(\v2\x13.thesis.TopicCoSignR\x06coSign\"4\n" +
+	"\x17ListTopicCoSignsRequest\x12\x19\n" +
+	"\btopic_id\x18\x01 \x01(\tR\atopicId\"J\n" +
+	"\x18ListTopicCoSignsResponse\x12.\n" +
+	"\bco_signs\x18\x01 \x03(\v2\x13.thesis.TopicCoSignR\acoSigns\"\x8e\x03\n" +
+	"\x12RegistrationWindow\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rsemester_code\x18\x02 \x01(\tR\fsemesterCode\x125\n" +
+	"\bopens_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aopensAt\x127\n" +
+	"\tcloses_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bclosesAt\x12\x1f\n" +
+	"\vmax_choices\x18\x05 \x01(\x05R\n" +
+	"maxChoices\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\t \x01(\tR\tupdatedBy\"\xf3\x01\n" +
+	"\x1cSetRegistrationWindowRequest\x12#\n" +
+	"\rsemester_code\x18\x01 \x01(\tR\fsemesterCode\x125\n" +
+	"\bopens_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aopensAt\x127\n" +
+	"\tcloses_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bclosesAt\x12\x1f\n" +
+	"\vmax_choices\x18\x04 \x01(\x05R\n" +
+	"maxChoices\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\"S\n" +
+	"\x1dSetRegistrationWindowResponse\x122\n" +
+	"\x06window\x18\x01 \x01(\v2\x1a.thesis.RegistrationWindowR\x06window\"C\n" +
+	"\x1cGetRegistrationWindowRequest\x12#\n" +
+	"\rsemester_code\x18\x01 \x01(\tR\fsemesterCode\"S\n" +
+	"\x1dGetRegistrationWindowResponse\x122\n" +
+	"\x06window\x18\x01 \x01(\v2\x1a.thesis.RegistrationWindowR\x06window\"\xe3\x03\n" +
+	"\x11TopicRegistration\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"topic_code\x18\x02 \x01(\tR\ttopicCode\x12!\n" +
+	"\fstudent_code\x18\x03 \x01(\tR\vstudentCode\x12#\n" +
+	"\rsemester_code\x18\x04 \x01(\tR\fsemesterCode\x12\x12\n" +
+	"\x04rank\x18\x05 \x01(\x05R\x04rank\x122\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1a.thesis.RegistrationStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\b \x01(\tR\tdecidedBy\x129\n" +
+	"\n" +
+	"decided_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\x12'\n" +
+	"\x0fenrollment_code\x18\n" +
+	" \x01(\tR\x0eenrollmentCode\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8a\x01\n" +
+	"\x1fRegisterTopicPreferencesRequest\x12!\n" +
+	"\fstudent_code\x18\x01 \x01(\tR\vstudentCode\x12#\n" +
+	"\rsemester_code\x18\x02 \x01(\tR\fsemesterCode\x12\x1f\n" +
+	"\vtopic_codes\x18\x03 \x03(\tR\n" +
+	"topicCodes\"c\n" +
+	" RegisterTopicPreferencesResponse\x12?\n" +
+	"\rregistrations\x18\x01 \x03(\v2\x19.thesis.TopicRegistrationR\rregistrations\"\x86\x01\n" +
+	"\x1dListTopicRegistrationsRequest\x12\x1d\n" +
+	"\n" +
+	"topic_code\x18\x01 \x01(\tR\ttopicCode\x12!\n" +
+	"\fstudent_code\x18\x02 \x01(\tR\vstudentCode\x12#\n" +
+	"\rsemester_code\x18\x03 \x01(\tR\fsemesterCode\"a\n" +
+	"\x1eListTopicRegistrationsResponse\x12?\n" +
+	"\rregistrations\x18\x01 \x03(\v2\x19.thesis.TopicRegistrationR\rregistrations\"\xa2\x01\n" +
+	"\x1eDecideTopicRegistrationRequest\x12'\n" +
+	"\x0fregistration_id\x18\x01 \x01(\tR\x0eregistrationId\x12'\n" +
+	"\x0fsupervisor_code\x18\x02 \x01(\tR\x0esupervisorCode\x12\x16\n" +
+	"\x06accept\x18\x03 \x01(\bR\x06accept\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x94\x01\n" +
+	"\x1fDecideTopicRegistrationResponse\x12=\n" +
+	"\fregistration\x18\x01 \x01(\v2\x19.thesis.TopicRegistrationR\fregistration\x122\n" +
+	"\n" +
+	"enrollment\x18\x02 \x01(\v2\x12.thesis.EnrollmentR\n" +
+	"enrollment\"\xa9\x01\n" +
+	"\x1aSetApplicantRankingRequest\x12\x1d\n" +
+	"\n" +
+	"topic_code\x18\x01 \x01(\tR\ttopicCode\x12'\n" +
+	"\x0fsupervisor_code\x18\x02 \x01(\tR\x0esupervisorCode\x12#\n" +
+	"\rstudent_codes\x18\x03 \x03(\tR\fstudentCodes\x12\x1e\n" +
+	"\n" +
+	"restricted\x18\x04 \x01(\bR\n" +
+	"restricted\"\x81\x01\n" +
+	"\x1bSetApplicantRankingResponse\x12\x1d\n" +
+	"\n" +
+	"topic_code\x18\x01 \x01(\tR\ttopicCode\x12#\n" +
+	"\rstudent_codes\x18\x02 \x03(\tR\fstudentCodes\x12\x1e\n" +
+	"\n" +
+	"restricted\x18\x03 \x01(\bR\n" +
+	"restricted\"\xeb\x01\n" +
+	"\x0fMatchAssignment\x12!\n" +
+	"\fstudent_code\x18\x01 \x01(\tR\vstudentCode\x12\x1d\n" +
+	"\n" +
+	"topic_code\x18\x02 \x01(\tR\ttopicCode\x12,\n" +
+	"\x12topic_council_code\x18\x03 \x01(\tR\x10topicCouncilCode\x12\x16\n" +
+	"\x06choice\x18\x04 \x01(\x05R\x06choice\x12'\n" +
+	"\x0fregistration_id\x18\x05 \x01(\tR\x0eregistrationId\x12'\n" +
+	"\x0fenrollment_code\x18\x06 \x01(\tR\x0eenrollmentCode\"}\n" +
+	"\x0eMatchRejection\x12\x1d\n" +
+	"\n" +
+	"topic_code\x18\x01 \x01(\tR\ttopicCode\x12\x16\n" +
+	"\x06choice\x18\x02 \x01(\x05R\x06choice\x124\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x1c.thesis.MatchRejectionReasonR\x06reason\"m\n" +
+	"\x10UnmatchedStudent\x12!\n" +
+	"\fstudent_code\x18\x01 \x01(\tR\vstudentCode\x126\n" +
+	"\n" +
+	"rejections\x18\x02 \x03(\v2\x16.thesis.MatchRejectionR\n" +
+	"rejections\"\xf9\x01\n" +
+	"\x13TopicMatchingResult\x12#\n" +
+	"\rsemester_code\x18\x01 \x01(\tR\fsemesterCode\x12%\n" +
+	"\x0estudents_total\x18\x02 \x01(\x05R\rstudentsTotal\x12#\n" +
+	"\rmatched_total\x18\x03 \x01(\x05R\fmatchedTotal\x129\n" +
+	"\vassignments\x18\x04 \x03(\v2\x17.thesis.MatchAssignmentR\vassignments\x126\n" +
+	"\tunmatched\x18\x05 \x03(\v2\x18.thesis.UnmatchedStudentR\tunmatched\"B\n" +
+	"\x1bPreviewTopicMatchingRequest\x12#\n" +
+	"\rsemester_code\x18\x01 \x01(\tR\fsemesterCode\"S\n" +
+	"\x1cPreviewTopicMatchingResponse\x123\n" +
+	"\x06result\x18\x01 \x01(\v2\x1b.thesis.TopicMatchingResultR\x06result\"d\n" +
+	"\x1aCommitTopicMatchingRequest\x12#\n" +
+	"\rsemester_code\x18\x01 \x01(\tR\fsemesterCode\x12!\n" +
+	"\fcommitted_by\x18\x02 \x01(\tR\vcommittedBy\"R\n" +
+	"\x1bCommitTopicMatchingResponse\x123\n" +
+	"\x06result\x18\x01 \x01(\v2\x1b.thesis.TopicMatchingResultR\x06result\"\xd0\x04\n" +
 	"\fTopicCouncil\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12(\n" +
@@ -5261,7 +15317,12 @@ const file_proto_thesis_thesis_proto_rawDesc = "" +
 	"created_by\x18\n" +
 	" \x01(\tR\tcreatedBy\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\v \x01(\tR\tupdatedByB\x0f\n" +
+	"updated_by\x18\v \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\f \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x0e \x01(\tR\tdeletedByB\x0f\n" +
 	"\r_council_code\"\xc4\x02\n" +
 	"\x19CreateTopicCouncilRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12(\n" +
@@ -5276,11 +15337,12 @@ const file_proto_thesis_thesis_proto_rawDesc = "" +
 	"created_by\x18\a \x01(\tR\tcreatedByB\x0f\n" +
 	"\r_council_code\"W\n" +
 	"\x1aCreateTopicCouncilResponse\x129\n" +
-	"\rtopic_council\x18\x01 \x01(\v2\x14.thesis.TopicCouncilR\ftopicCouncil\"(\n" +
+	"\rtopic_council\x18\x01 \x01(\v2\x14.thesis.TopicCouncilR\ftopicCouncil\"Q\n" +
 	"\x16GetTopicCouncilRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"T\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"T\n" +
 	"\x17GetTopicCouncilResponse\x129\n" +
-	"\rtopic_council\x18\x01 \x01(\v2\x14.thesis.TopicCouncilR\ftopicCouncil\"\xac\x03\n" +
+	"\rtopic_council\x18\x01 \x01(\v2\x14.thesis.TopicCouncilR\ftopicCouncil\"\x99\x04\n" +
 	"\x19UpdateTopicCouncilRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12-\n" +
@@ -5292,26 +15354,56 @@ const file_proto_thesis_thesis_proto_rawDesc = "" +
 	"time_start\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\ttimeStart\x88\x01\x01\x12:\n" +
 	"\btime_end\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x05R\atimeEnd\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\b \x01(\tR\tupdatedByB\b\n" +
+	"updated_by\x18\b \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\aversion\x18\t \x01(\x05H\x06R\aversion\x88\x01\x01\x12,\n" +
+	"\x0foverride_reason\x18\n" +
+	" \x01(\tH\aR\x0eoverrideReason\x88\x01\x01B\b\n" +
 	"\x06_titleB\b\n" +
 	"\x06_stageB\r\n" +
 	"\v_topic_codeB\x0f\n" +
 	"\r_council_codeB\r\n" +
 	"\v_time_startB\v\n" +
-	"\t_time_end\"W\n" +
+	"\t_time_endB\n" +
+	"\n" +
+	"\b_versionB\x12\n" +
+	"\x10_override_reason\"W\n" +
 	"\x1aUpdateTopicCouncilResponse\x129\n" +
-	"\rtopic_council\x18\x01 \x01(\v2\x14.thesis.TopicCouncilR\ftopicCouncil\"+\n" +
+	"\rtopic_council\x18\x01 \x01(\v2\x14.thesis.TopicCouncilR\ftopicCouncil\"\xe2\x01\n" +
+	"\x10TopicCouncilSlot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fcouncil_code\x18\x02 \x01(\tR\vcouncilCode\x129\n" +
+	"\n" +
+	"time_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimeStart\x125\n" +
+	"\btime_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\atimeEnd\x12\x1d\n" +
+	"\aversion\x18\x05 \x01(\x05H\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"~\n" +
+	"\x1cScheduleTopicCouncilsRequest\x12?\n" +
+	"\x0etopic_councils\x18\x01 \x03(\v2\x18.thesis.TopicCouncilSlotR\rtopicCouncils\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x02 \x01(\tR\tupdatedBy\"\\\n" +
+	"\x1dScheduleTopicCouncilsResponse\x12;\n" +
+	"\x0etopic_councils\x18\x01 \x03(\v2\x14.thesis.TopicCouncilR\rtopicCouncils\"J\n" +
 	"\x19DeleteTopicCouncilRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\"6\n" +
 	"\x1aDeleteTopicCouncilResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"I\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"M\n" +
+	"\x1aRestoreTopicCouncilRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrestored_by\x18\x02 \x01(\tR\n" +
+	"restoredBy\"X\n" +
+	"\x1bRestoreTopicCouncilResponse\x129\n" +
+	"\rtopic_council\x18\x01 \x01(\v2\x14.thesis.TopicCouncilR\ftopicCouncil\"r\n" +
 	"\x18ListTopicCouncilsRequest\x12-\n" +
-	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\"\x9f\x01\n" +
+	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"\x9f\x01\n" +
 	"\x19ListTopicCouncilsResponse\x12;\n" +
 	"\x0etopic_councils\x18\x01 \x03(\v2\x14.thesis.TopicCouncilR\rtopicCouncils\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xc2\x02\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xb6\x03\n" +
 	"\x16TopicCouncilSupervisor\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x126\n" +
 	"\x17teacher_supervisor_code\x18\x02 \x01(\tR\x15teacherSupervisorCode\x12,\n" +
@@ -5323,39 +15415,58 @@ const file_proto_thesis_thesis_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\x06 \x01(\tR\tcreatedBy\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\a \x01(\tR\tupdatedBy\"\xaa\x01\n" +
+	"updated_by\x18\a \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\b \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\n" +
+	" \x01(\tR\tdeletedBy\"\xaa\x01\n" +
 	"#CreateTopicCouncilSupervisorRequest\x126\n" +
 	"\x17teacher_supervisor_code\x18\x01 \x01(\tR\x15teacherSupervisorCode\x12,\n" +
 	"\x12topic_council_code\x18\x02 \x01(\tR\x10topicCouncilCode\x12\x1d\n" +
 	"\n" +
 	"created_by\x18\x03 \x01(\tR\tcreatedBy\"\x80\x01\n" +
 	"$CreateTopicCouncilSupervisorResponse\x12X\n" +
-	"\x18topic_council_supervisor\x18\x01 \x01(\v2\x1e.thesis.TopicCouncilSupervisorR\x16topicCouncilSupervisor\"2\n" +
+	"\x18topic_council_supervisor\x18\x01 \x01(\v2\x1e.thesis.TopicCouncilSupervisorR\x16topicCouncilSupervisor\"[\n" +
 	" GetTopicCouncilSupervisorRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"}\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"}\n" +
 	"!GetTopicCouncilSupervisorResponse\x12X\n" +
-	"\x18topic_council_supervisor\x18\x01 \x01(\v2\x1e.thesis.TopicCouncilSupervisorR\x16topicCouncilSupervisor\"\xf7\x01\n" +
+	"\x18topic_council_supervisor\x18\x01 \x01(\v2\x1e.thesis.TopicCouncilSupervisorR\x16topicCouncilSupervisor\"\xa2\x02\n" +
 	"#UpdateTopicCouncilSupervisorRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
 	"\x17teacher_supervisor_code\x18\x02 \x01(\tH\x00R\x15teacherSupervisorCode\x88\x01\x01\x121\n" +
 	"\x12topic_council_code\x18\x03 \x01(\tH\x01R\x10topicCouncilCode\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\x04 \x01(\tR\tupdatedByB\x1a\n" +
+	"updated_by\x18\x04 \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\aversion\x18\x05 \x01(\x05H\x02R\aversion\x88\x01\x01B\x1a\n" +
 	"\x18_teacher_supervisor_codeB\x15\n" +
-	"\x13_topic_council_code\"\x80\x01\n" +
+	"\x13_topic_council_codeB\n" +
+	"\n" +
+	"\b_version\"\x80\x01\n" +
 	"$UpdateTopicCouncilSupervisorResponse\x12X\n" +
-	"\x18topic_council_supervisor\x18\x01 \x01(\v2\x1e.thesis.TopicCouncilSupervisorR\x16topicCouncilSupervisor\"5\n" +
+	"\x18topic_council_supervisor\x18\x01 \x01(\v2\x1e.thesis.TopicCouncilSupervisorR\x16topicCouncilSupervisor\"T\n" +
 	"#DeleteTopicCouncilSupervisorRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\"@\n" +
 	"$DeleteTopicCouncilSupervisorResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"S\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"W\n" +
+	"$RestoreTopicCouncilSupervisorRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrestored_by\x18\x02 \x01(\tR\n" +
+	"restoredBy\"\x81\x01\n" +
+	"%RestoreTopicCouncilSupervisorResponse\x12X\n" +
+	"\x18topic_council_supervisor\x18\x01 \x01(\v2\x1e.thesis.TopicCouncilSupervisorR\x16topicCouncilSupervisor\"|\n" +
 	"\"ListTopicCouncilSupervisorsRequest\x12-\n" +
-	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\"\xc8\x01\n" +
+	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"\xc8\x01\n" +
 	"#ListTopicCouncilSupervisorsResponse\x12Z\n" +
 	"\x19topic_council_supervisors\x18\x01 \x03(\v2\x1e.thesis.TopicCouncilSupervisorR\x17topicCouncilSupervisors\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xf3\x03\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xe7\x04\n" +
 	"\vGradeReview\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12&\n" +
@@ -5372,7 +15483,12 @@ const file_proto_thesis_thesis_proto_rawDesc = "" +
 	"created_by\x18\n" +
 	" \x01(\tR\tcreatedBy\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\v \x01(\tR\tupdatedByB\x0f\n" +
+	"updated_by\x18\v \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\f \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x0e \x01(\tR\tdeletedByB\x0f\n" +
 	"\r_review_gradeB\b\n" +
 	"\x06_notesB\x12\n" +
 	"\x10_completion_date\"\xdb\x02\n" +
@@ -5389,11 +15505,12 @@ const file_proto_thesis_thesis_proto_rawDesc = "" +
 	"\x06_notesB\x12\n" +
 	"\x10_completion_date\"S\n" +
 	"\x19CreateGradeReviewResponse\x126\n" +
-	"\fgrade_review\x18\x01 \x01(\v2\x13.thesis.GradeReviewR\vgradeReview\"'\n" +
+	"\fgrade_review\x18\x01 \x01(\v2\x13.thesis.GradeReviewR\vgradeReview\"P\n" +
 	"\x15GetGradeReviewRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"P\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"P\n" +
 	"\x16GetGradeReviewResponse\x126\n" +
-	"\fgrade_review\x18\x01 \x01(\v2\x13.thesis.GradeReviewR\vgradeReview\"\xa0\x03\n" +
+	"\fgrade_review\x18\x01 \x01(\v2\x13.thesis.GradeReviewR\vgradeReview\"\xcb\x03\n" +
 	"\x18UpdateGradeReviewRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12&\n" +
@@ -5403,26 +15520,479 @@ const file_proto_thesis_thesis_proto_rawDesc = "" +
 	"\x05notes\x18\x06 \x01(\tH\x04R\x05notes\x88\x01\x01\x12H\n" +
 	"\x0fcompletion_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x05R\x0ecompletionDate\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\b \x01(\tR\tupdatedByB\b\n" +
+	"updated_by\x18\b \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\aversion\x18\t \x01(\x05H\x06R\aversion\x88\x01\x01B\b\n" +
 	"\x06_titleB\x0f\n" +
 	"\r_review_gradeB\x0f\n" +
 	"\r_teacher_codeB\t\n" +
 	"\a_statusB\b\n" +
 	"\x06_notesB\x12\n" +
-	"\x10_completion_date\"S\n" +
+	"\x10_completion_dateB\n" +
+	"\n" +
+	"\b_version\"S\n" +
 	"\x19UpdateGradeReviewResponse\x126\n" +
-	"\fgrade_review\x18\x01 \x01(\v2\x13.thesis.GradeReviewR\vgradeReview\"*\n" +
+	"\fgrade_review\x18\x01 \x01(\v2\x13.thesis.GradeReviewR\vgradeReview\"I\n" +
 	"\x18DeleteGradeReviewRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"5\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\"5\n" +
 	"\x19DeleteGradeReviewResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"H\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"L\n" +
+	"\x19RestoreGradeReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrestored_by\x18\x02 \x01(\tR\n" +
+	"restoredBy\"T\n" +
+	"\x1aRestoreGradeReviewResponse\x126\n" +
+	"\fgrade_review\x18\x01 \x01(\v2\x13.thesis.GradeReviewR\vgradeReview\"q\n" +
 	"\x17ListGradeReviewsRequest\x12-\n" +
-	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\"\x9b\x01\n" +
+	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"\x9b\x01\n" +
 	"\x18ListGradeReviewsResponse\x128\n" +
 	"\rgrade_reviews\x18\x01 \x03(\v2\x13.thesis.GradeReviewR\fgradeReviews\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize*E\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xe2\x03\n" +
+	"\x12SubmissionDeadline\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rsemester_code\x18\x02 \x01(\tR\fsemesterCode\x12(\n" +
+	"\x05stage\x18\x03 \x01(\x0e2\x12.thesis.TopicStageR\x05stage\x12*\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x16.thesis.SubmissionKindR\x04kind\x125\n" +
+	"\bopens_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aopensAt\x121\n" +
+	"\x06due_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12#\n" +
+	"\rgrace_minutes\x18\a \x01(\x05R\fgraceMinutes\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\v \x01(\tR\tupdatedBy\"\xc7\x02\n" +
+	"\x1cSetSubmissionDeadlineRequest\x12#\n" +
+	"\rsemester_code\x18\x01 \x01(\tR\fsemesterCode\x12(\n" +
+	"\x05stage\x18\x02 \x01(\x0e2\x12.thesis.TopicStageR\x05stage\x12*\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x16.thesis.SubmissionKindR\x04kind\x125\n" +
+	"\bopens_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aopensAt\x121\n" +
+	"\x06due_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12#\n" +
+	"\rgrace_minutes\x18\x06 \x01(\x05R\fgraceMinutes\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\"W\n" +
+	"\x1dSetSubmissionDeadlineResponse\x126\n" +
+	"\bdeadline\x18\x01 \x01(\v2\x1a.thesis.SubmissionDeadlineR\bdeadline\"E\n" +
+	"\x1eListSubmissionDeadlinesRequest\x12#\n" +
+	"\rsemester_code\x18\x01 \x01(\tR\fsemesterCode\"[\n" +
+	"\x1fListSubmissionDeadlinesResponse\x128\n" +
+	"\tdeadlines\x18\x01 \x03(\v2\x1a.thesis.SubmissionDeadlineR\tdeadlines\"\xc0\x03\n" +
+	"\x11DeadlineExtension\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rsemester_code\x18\x02 \x01(\tR\fsemesterCode\x12(\n" +
+	"\x05stage\x18\x03 \x01(\x0e2\x12.thesis.TopicStageR\x05stage\x12*\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x16.thesis.SubmissionKindR\x04kind\x12!\n" +
+	"\fstudent_code\x18\x05 \x01(\tR\vstudentCode\x121\n" +
+	"\x06due_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\v \x01(\tR\tupdatedBy\"\xfd\x01\n" +
+	"\x1dGrantDeadlineExtensionRequest\x12#\n" +
+	"\rsemester_code\x18\x01 \x01(\tR\fsemesterCode\x12*\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x16.thesis.SubmissionKindR\x04kind\x12!\n" +
+	"\fstudent_code\x18\x03 \x01(\tR\vstudentCode\x121\n" +
+	"\x06due_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"granted_by\x18\x06 \x01(\tR\tgrantedBy\"Y\n" +
+	"\x1eGrantDeadlineExtensionResponse\x127\n" +
+	"\textension\x18\x01 \x01(\v2\x19.thesis.DeadlineExtensionR\textension\"\x92\x01\n" +
+	"\x1cCheckSubmissionWindowRequest\x12#\n" +
+	"\rsemester_code\x18\x01 \x01(\tR\fsemesterCode\x12*\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x16.thesis.SubmissionKindR\x04kind\x12!\n" +
+	"\fstudent_code\x18\x03 \x01(\tR\vstudentCode\"\xe9\x02\n" +
+	"\x1dCheckSubmissionWindowResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x12\n" +
+	"\x04late\x18\x02 \x01(\bR\x04late\x12\x1a\n" +
+	"\bextended\x18\x03 \x01(\bR\bextended\x12(\n" +
+	"\x05stage\x18\x04 \x01(\x0e2\x12.thesis.TopicStageR\x05stage\x125\n" +
+	"\bopens_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aopensAt\x121\n" +
+	"\x06due_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x127\n" +
+	"\tcloses_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bclosesAt\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12\x19\n" +
+	"\btable_id\x18\t \x01(\tR\atableId\"\xeb\x04\n" +
+	"\rGradingPolicy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"major_code\x18\x02 \x01(\tR\tmajorCode\x12#\n" +
+	"\rsemester_code\x18\x03 \x01(\tR\fsemesterCode\x12(\n" +
+	"\x05stage\x18\x04 \x01(\x0e2\x12.thesis.TopicStageR\x05stage\x12+\n" +
+	"\x11supervisor_weight\x18\x05 \x01(\x01R\x10supervisorWeight\x12'\n" +
+	"\x0freviewer_weight\x18\x06 \x01(\x01R\x0ereviewerWeight\x12%\n" +
+	"\x0ecouncil_weight\x18\a \x01(\x01R\rcouncilWeight\x121\n" +
+	"\brounding\x18\b \x01(\x0e2\x15.thesis.GradeRoundingR\brounding\x12#\n" +
+	"\rrounding_step\x18\t \x01(\x01R\froundingStep\x12%\n" +
+	"\x0epass_threshold\x18\n" +
+	" \x01(\x01R\rpassThreshold\x12,\n" +
+	"\x12max_council_spread\x18\v \x01(\x01R\x10maxCouncilSpread\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x0e \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x0f \x01(\tR\tupdatedBy\"\xd0\x03\n" +
+	"\x17SetGradingPolicyRequest\x12\x1d\n" +
+	"\n" +
+	"major_code\x18\x01 \x01(\tR\tmajorCode\x12#\n" +
+	"\rsemester_code\x18\x02 \x01(\tR\fsemesterCode\x12(\n" +
+	"\x05stage\x18\x03 \x01(\x0e2\x12.thesis.TopicStageR\x05stage\x12+\n" +
+	"\x11supervisor_weight\x18\x04 \x01(\x01R\x10supervisorWeight\x12'\n" +
+	"\x0freviewer_weight\x18\x05 \x01(\x01R\x0ereviewerWeight\x12%\n" +
+	"\x0ecouncil_weight\x18\x06 \x01(\x01R\rcouncilWeight\x121\n" +
+	"\brounding\x18\a \x01(\x0e2\x15.thesis.GradeRoundingR\brounding\x12#\n" +
+	"\rrounding_step\x18\b \x01(\x01R\froundingStep\x12%\n" +
+	"\x0epass_threshold\x18\t \x01(\x01R\rpassThreshold\x12,\n" +
+	"\x12max_council_spread\x18\n" +
+	" \x01(\x01R\x10maxCouncilSpread\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\v \x01(\tR\tcreatedBy\"I\n" +
+	"\x18SetGradingPolicyResponse\x12-\n" +
+	"\x06policy\x18\x01 \x01(\v2\x15.thesis.GradingPolicyR\x06policy\"A\n" +
+	"\x1aListGradingPoliciesRequest\x12#\n" +
+	"\rsemester_code\x18\x01 \x01(\tR\fsemesterCode\"P\n" +
+	"\x1bListGradingPoliciesResponse\x121\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x15.thesis.GradingPolicyR\bpolicies\",\n" +
+	"\x1aDeleteGradingPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x1bDeleteGradingPolicyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"m\n" +
+	"\x12CouncilMemberScore\x12!\n" +
+	"\fteacher_code\x18\x01 \x01(\tR\vteacherCode\x12$\n" +
+	"\vtotal_score\x18\x02 \x01(\x01H\x00R\n" +
+	"totalScore\x88\x01\x01B\x0e\n" +
+	"\f_total_score\"\xb8\x01\n" +
+	"\x18ComputeFinalGradeRequest\x12'\n" +
+	"\x0fenrollment_code\x18\x01 \x01(\tR\x0eenrollmentCode\x12A\n" +
+	"\x0ecouncil_scores\x18\x02 \x03(\v2\x1a.thesis.CouncilMemberScoreR\rcouncilScores\x12\x1a\n" +
+	"\bfinalize\x18\x03 \x01(\bR\bfinalize\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\"a\n" +
+	"\x0eGradeComponent\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\x05score\x18\x02 \x01(\x01H\x00R\x05score\x88\x01\x01\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x01R\x06weightB\b\n" +
+	"\x06_score\"\xa3\x03\n" +
+	"\x13FinalGradeBreakdown\x12\x1f\n" +
+	"\vpolicy_code\x18\x01 \x01(\tR\n" +
+	"policyCode\x126\n" +
+	"\n" +
+	"components\x18\x02 \x03(\v2\x16.thesis.GradeComponentR\n" +
+	"components\x12*\n" +
+	"\x0ecouncil_spread\x18\x03 \x01(\x01H\x00R\rcouncilSpread\x88\x01\x01\x121\n" +
+	"\x14needs_reconciliation\x18\x04 \x01(\bR\x13needsReconciliation\x12\x18\n" +
+	"\amissing\x18\x05 \x03(\tR\amissing\x12 \n" +
+	"\traw_grade\x18\x06 \x01(\x01H\x01R\brawGrade\x88\x01\x01\x12$\n" +
+	"\vfinal_grade\x18\a \x01(\x01H\x02R\n" +
+	"finalGrade\x88\x01\x01\x12+\n" +
+	"\x06status\x18\b \x01(\x0e2\x13.thesis.FinalStatusR\x06status\x12\x14\n" +
+	"\x05steps\x18\t \x03(\tR\x05stepsB\x11\n" +
+	"\x0f_council_spreadB\f\n" +
+	"\n" +
+	"_raw_gradeB\x0e\n" +
+	"\f_final_grade\"\x99\x01\n" +
+	"\x19ComputeFinalGradeResponse\x129\n" +
+	"\tbreakdown\x18\x01 \x01(\v2\x1b.thesis.FinalGradeBreakdownR\tbreakdown\x12\x1c\n" +
+	"\tfinalized\x18\x02 \x01(\bR\tfinalized\x12#\n" +
+	"\x05final\x18\x03 \x01(\v2\r.thesis.FinalR\x05final\"\x9b\x03\n" +
+	"\x13SemesterGradeStatus\x12#\n" +
+	"\rsemester_code\x18\x01 \x01(\tR\fsemesterCode\x12\x16\n" +
+	"\x06locked\x18\x02 \x01(\bR\x06locked\x127\n" +
+	"\tlocked_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\blockedAt\x12\x1b\n" +
+	"\tlocked_by\x18\x04 \x01(\tR\blockedBy\x12\x1c\n" +
+	"\tpublished\x18\x05 \x01(\bR\tpublished\x12=\n" +
+	"\fpublished_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x12!\n" +
+	"\fpublished_by\x18\a \x01(\tR\vpublishedBy\x12,\n" +
+	"\x12appeal_window_days\x18\b \x01(\x05R\x10appealWindowDays\x12C\n" +
+	"\x0fappeal_deadline\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0eappealDeadline\"V\n" +
+	"\x19LockSemesterGradesRequest\x12#\n" +
+	"\rsemester_code\x18\x01 \x01(\tR\fsemesterCode\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\"Q\n" +
+	"\x1aLockSemesterGradesResponse\x123\n" +
+	"\x06status\x18\x01 \x01(\v2\x1b.thesis.SemesterGradeStatusR\x06status\"Y\n" +
+	"\x1cPublishSemesterGradesRequest\x12#\n" +
+	"\rsemester_code\x18\x01 \x01(\tR\fsemesterCode\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\"T\n" +
+	"\x1dPublishSemesterGradesResponse\x123\n" +
+	"\x06status\x18\x01 \x01(\v2\x1b.thesis.SemesterGradeStatusR\x06status\"D\n" +
+	"\x1dGetSemesterGradeStatusRequest\x12#\n" +
+	"\rsemester_code\x18\x01 \x01(\tR\fsemesterCode\"U\n" +
+	"\x1eGetSemesterGradeStatusResponse\x123\n" +
+	"\x06status\x18\x01 \x01(\v2\x1b.thesis.SemesterGradeStatusR\x06status\"\xf8\x04\n" +
+	"\x0eGradeAmendment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x124\n" +
+	"\x06target\x18\x02 \x01(\x0e2\x1c.thesis.GradeAmendmentTargetR\x06target\x12\x1f\n" +
+	"\vtarget_code\x18\x03 \x01(\tR\n" +
+	"targetCode\x12'\n" +
+	"\x0fenrollment_code\x18\x04 \x01(\tR\x0eenrollmentCode\x12#\n" +
+	"\rsemester_code\x18\x05 \x01(\tR\fsemesterCode\x12 \n" +
+	"\told_value\x18\x06 \x01(\x01H\x00R\boldValue\x88\x01\x01\x12\x1b\n" +
+	"\tnew_value\x18\a \x01(\x01R\bnewValue\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x124\n" +
+	"\x06status\x18\t \x01(\x0e2\x1c.thesis.GradeAmendmentStatusR\x06status\x12!\n" +
+	"\frequested_by\x18\n" +
+	" \x01(\tR\vrequestedBy\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\v \x01(\tR\tdecidedBy\x129\n" +
+	"\n" +
+	"decided_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\x12#\n" +
+	"\rdecision_note\x18\r \x01(\tR\fdecisionNote\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\f\n" +
+	"\n" +
+	"_old_value\"\xcd\x01\n" +
+	"\x1cRequestGradeAmendmentRequest\x124\n" +
+	"\x06target\x18\x01 \x01(\x0e2\x1c.thesis.GradeAmendmentTargetR\x06target\x12\x1f\n" +
+	"\vtarget_code\x18\x02 \x01(\tR\n" +
+	"targetCode\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\x01R\bnewValue\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12!\n" +
+	"\frequested_by\x18\x05 \x01(\tR\vrequestedBy\"U\n" +
+	"\x1dRequestGradeAmendmentResponse\x124\n" +
+	"\tamendment\x18\x01 \x01(\v2\x16.thesis.GradeAmendmentR\tamendment\"\xbd\x01\n" +
+	"\x1bDecideGradeAmendmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\x04 \x01(\tR\tdecidedBy\x12A\n" +
+	"\x0ecouncil_scores\x18\x05 \x03(\v2\x1a.thesis.CouncilMemberScoreR\rcouncilScores\"T\n" +
+	"\x1cDecideGradeAmendmentResponse\x124\n" +
+	"\tamendment\x18\x01 \x01(\v2\x16.thesis.GradeAmendmentR\tamendment\"*\n" +
+	"\x18GetGradeAmendmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Q\n" +
+	"\x19GetGradeAmendmentResponse\x124\n" +
+	"\tamendment\x18\x01 \x01(\v2\x16.thesis.GradeAmendmentR\tamendment\"\x9e\x01\n" +
+	"\x1aListGradeAmendmentsRequest\x12(\n" +
+	"\rsemester_code\x18\x01 \x01(\tH\x00R\fsemesterCode\x88\x01\x01\x129\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1c.thesis.GradeAmendmentStatusH\x01R\x06status\x88\x01\x01B\x10\n" +
+	"\x0e_semester_codeB\t\n" +
+	"\a_status\"U\n" +
+	"\x1bListGradeAmendmentsResponse\x126\n" +
+	"\n" +
+	"amendments\x18\x01 \x03(\v2\x16.thesis.GradeAmendmentR\n" +
+	"amendments\"\xaa\x01\n" +
+	"\x10GradeAppealEvent\x121\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x19.thesis.GradeAppealStatusR\x06status\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc0\x05\n" +
+	"\vGradeAppeal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fenrollment_code\x18\x02 \x01(\tR\x0eenrollmentCode\x12!\n" +
+	"\fstudent_code\x18\x03 \x01(\tR\vstudentCode\x12#\n" +
+	"\rsemester_code\x18\x04 \x01(\tR\fsemesterCode\x12:\n" +
+	"\tcomponent\x18\x05 \x01(\x0e2\x1c.thesis.GradeAppealComponentR\tcomponent\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12,\n" +
+	"\x12evidence_file_code\x18\a \x01(\tR\x10evidenceFileCode\x121\n" +
+	"\x06status\x18\b \x01(\x0e2\x19.thesis.GradeAppealStatusR\x06status\x12#\n" +
+	"\rreviewer_code\x18\t \x01(\tR\freviewerCode\x12'\n" +
+	"\x0fresolution_note\x18\n" +
+	" \x01(\tR\x0eresolutionNote\x12%\n" +
+	"\x0eamendment_code\x18\v \x01(\tR\ramendmentCode\x12\x1f\n" +
+	"\vresolved_by\x18\f \x01(\tR\n" +
+	"resolvedBy\x12;\n" +
+	"\vresolved_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x122\n" +
+	"\ahistory\x18\x10 \x03(\v2\x18.thesis.GradeAppealEventR\ahistory\"l\n" +
+	"\x1bSetGradeAppealWindowRequest\x12#\n" +
+	"\rsemester_code\x18\x01 \x01(\tR\fsemesterCode\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\"S\n" +
+	"\x1cSetGradeAppealWindowResponse\x123\n" +
+	"\x06status\x18\x01 \x01(\v2\x1b.thesis.SemesterGradeStatusR\x06status\"\x82\x02\n" +
+	"\x16FileGradeAppealRequest\x12'\n" +
+	"\x0fenrollment_code\x18\x01 \x01(\tR\x0eenrollmentCode\x12!\n" +
+	"\fstudent_code\x18\x02 \x01(\tR\vstudentCode\x12:\n" +
+	"\tcomponent\x18\x03 \x01(\x0e2\x1c.thesis.GradeAppealComponentR\tcomponent\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x121\n" +
+	"\x12evidence_file_code\x18\x05 \x01(\tH\x00R\x10evidenceFileCode\x88\x01\x01B\x15\n" +
+	"\x13_evidence_file_code\"F\n" +
+	"\x17FileGradeAppealResponse\x12+\n" +
+	"\x06appeal\x18\x01 \x01(\v2\x13.thesis.GradeAppealR\x06appeal\"e\n" +
+	"\x18AssignGradeAppealRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rreviewer_code\x18\x02 \x01(\tR\freviewerCode\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\"H\n" +
+	"\x19AssignGradeAppealResponse\x12+\n" +
+	"\x06appeal\x18\x01 \x01(\v2\x13.thesis.GradeAppealR\x06appeal\"l\n" +
+	"\x19ResolveGradeAppealRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12\x1f\n" +
+	"\vresolved_by\x18\x05 \x01(\tR\n" +
+	"resolvedByJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04\"I\n" +
+	"\x1aResolveGradeAppealResponse\x12+\n" +
+	"\x06appeal\x18\x01 \x01(\v2\x13.thesis.GradeAppealR\x06appeal\"\xde\x01\n" +
+	"\"RequestGradeAppealAmendmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tnew_value\x18\x02 \x01(\x01R\bnewValue\x129\n" +
+	"\x16defence_amendment_code\x18\x03 \x01(\tH\x00R\x14defenceAmendmentCode\x88\x01\x01\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12!\n" +
+	"\frequested_by\x18\x05 \x01(\tR\vrequestedByB\x19\n" +
+	"\x17_defence_amendment_code\"R\n" +
+	"#RequestGradeAppealAmendmentResponse\x12+\n" +
+	"\x06appeal\x18\x01 \x01(\v2\x13.thesis.GradeAppealR\x06appeal\"J\n" +
+	"!SettleGradeAppealAmendmentRequest\x12%\n" +
+	"\x0eamendment_code\x18\x01 \x01(\tR\ramendmentCode\"Q\n" +
+	"\"SettleGradeAppealAmendmentResponse\x12+\n" +
+	"\x06appeal\x18\x01 \x01(\v2\x13.thesis.GradeAppealR\x06appeal\"'\n" +
+	"\x15GetGradeAppealRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"E\n" +
+	"\x16GetGradeAppealResponse\x12+\n" +
+	"\x06appeal\x18\x01 \x01(\v2\x13.thesis.GradeAppealR\x06appeal\"\x8d\x02\n" +
+	"\x17ListGradeAppealsRequest\x12(\n" +
+	"\rsemester_code\x18\x01 \x01(\tH\x00R\fsemesterCode\x88\x01\x01\x12&\n" +
+	"\fstudent_code\x18\x02 \x01(\tH\x01R\vstudentCode\x88\x01\x01\x12(\n" +
+	"\rreviewer_code\x18\x03 \x01(\tH\x02R\freviewerCode\x88\x01\x01\x126\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x19.thesis.GradeAppealStatusH\x03R\x06status\x88\x01\x01B\x10\n" +
+	"\x0e_semester_codeB\x0f\n" +
+	"\r_student_codeB\x10\n" +
+	"\x0e_reviewer_codeB\t\n" +
+	"\a_status\"I\n" +
+	"\x18ListGradeAppealsResponse\x12-\n" +
+	"\aappeals\x18\x01 \x03(\v2\x13.thesis.GradeAppealR\aappeals\"\xc6\x03\n" +
+	"\x10MidtermMilestone\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rsemester_code\x18\x02 \x01(\tR\fsemesterCode\x12(\n" +
+	"\x05stage\x18\x03 \x01(\x0e2\x12.thesis.TopicStageR\x05stage\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bsequence\x18\x06 \x01(\x05R\bsequence\x121\n" +
+	"\x06due_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\v \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\f \x01(\x05R\aversion\"\x94\x02\n" +
+	"\x1dCreateMidtermMilestoneRequest\x12#\n" +
+	"\rsemester_code\x18\x01 \x01(\tR\fsemesterCode\x12(\n" +
+	"\x05stage\x18\x02 \x01(\x0e2\x12.thesis.TopicStageR\x05stage\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bsequence\x18\x05 \x01(\x05R\bsequence\x121\n" +
+	"\x06due_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\"X\n" +
+	"\x1eCreateMidtermMilestoneResponse\x126\n" +
+	"\tmilestone\x18\x01 \x01(\v2\x18.thesis.MidtermMilestoneR\tmilestone\"\xb6\x02\n" +
+	"\x1dUpdateMidtermMilestoneRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1f\n" +
+	"\bsequence\x18\x04 \x01(\x05H\x02R\bsequence\x88\x01\x01\x121\n" +
+	"\x06due_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x06 \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\aversion\x18\a \x01(\x05H\x03R\aversion\x88\x01\x01B\b\n" +
+	"\x06_titleB\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_sequenceB\n" +
+	"\n" +
+	"\b_version\"X\n" +
+	"\x1eUpdateMidtermMilestoneResponse\x126\n" +
+	"\tmilestone\x18\x01 \x01(\v2\x18.thesis.MidtermMilestoneR\tmilestone\"N\n" +
+	"\x1dDeleteMidtermMilestoneRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\":\n" +
+	"\x1eDeleteMidtermMilestoneResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"|\n" +
+	"\x1cListMidtermMilestonesRequest\x12#\n" +
+	"\rsemester_code\x18\x01 \x01(\tR\fsemesterCode\x12-\n" +
+	"\x05stage\x18\x02 \x01(\x0e2\x12.thesis.TopicStageH\x00R\x05stage\x88\x01\x01B\b\n" +
+	"\x06_stage\"Y\n" +
+	"\x1dListMidtermMilestonesResponse\x128\n" +
+	"\n" +
+	"milestones\x18\x01 \x03(\v2\x18.thesis.MidtermMilestoneR\n" +
+	"milestones\"\xca\x03\n" +
+	"\x10MilestoneCheckin\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0emilestone_code\x18\x02 \x01(\tR\rmilestoneCode\x12'\n" +
+	"\x0fenrollment_code\x18\x03 \x01(\tR\x0eenrollmentCode\x12\x16\n" +
+	"\x06report\x18\x04 \x01(\tR\x06report\x12\x1b\n" +
+	"\tfile_code\x18\x05 \x01(\tR\bfileCode\x12/\n" +
+	"\x06result\x18\x06 \x01(\x0e2\x17.thesis.MilestoneResultR\x06result\x12\x18\n" +
+	"\acomment\x18\a \x01(\tR\acomment\x12\x1f\n" +
+	"\vreviewed_by\x18\b \x01(\tR\n" +
+	"reviewedBy\x12;\n" +
+	"\vreviewed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x12=\n" +
+	"\fsubmitted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xda\x01\n" +
+	"\x1dSubmitMilestoneCheckinRequest\x12%\n" +
+	"\x0emilestone_code\x18\x01 \x01(\tR\rmilestoneCode\x12'\n" +
+	"\x0fenrollment_code\x18\x02 \x01(\tR\x0eenrollmentCode\x12!\n" +
+	"\fstudent_code\x18\x03 \x01(\tR\vstudentCode\x12\x16\n" +
+	"\x06report\x18\x04 \x01(\tR\x06report\x12 \n" +
+	"\tfile_code\x18\x05 \x01(\tH\x00R\bfileCode\x88\x01\x01B\f\n" +
+	"\n" +
+	"_file_code\"\x7f\n" +
+	"\x1eSubmitMilestoneCheckinResponse\x122\n" +
+	"\acheckin\x18\x01 \x01(\v2\x18.thesis.MilestoneCheckinR\acheckin\x12)\n" +
+	"\amidterm\x18\x02 \x01(\v2\x0f.thesis.MidtermR\amidterm\"~\n" +
+	"\x1dReviewMilestoneCheckinRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04pass\x18\x02 \x01(\bR\x04pass\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x12\x1f\n" +
+	"\vreviewed_by\x18\x04 \x01(\tR\n" +
+	"reviewedBy\"\x7f\n" +
+	"\x1eReviewMilestoneCheckinResponse\x122\n" +
+	"\acheckin\x18\x01 \x01(\v2\x18.thesis.MilestoneCheckinR\acheckin\x12)\n" +
+	"\amidterm\x18\x02 \x01(\v2\x0f.thesis.MidtermR\amidterm\",\n" +
+	"\x1aGetMilestoneCheckinRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Q\n" +
+	"\x1bGetMilestoneCheckinResponse\x122\n" +
+	"\acheckin\x18\x01 \x01(\v2\x18.thesis.MilestoneCheckinR\acheckin\"G\n" +
+	"\x1cListMilestoneCheckinsRequest\x12'\n" +
+	"\x0fenrollment_code\x18\x01 \x01(\tR\x0eenrollmentCode\"U\n" +
+	"\x1dListMilestoneCheckinsResponse\x124\n" +
+	"\bcheckins\x18\x01 \x03(\v2\x18.thesis.MilestoneCheckinR\bcheckins\"\xa5\x01\n" +
+	"\x13SearchTopicsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12/\n" +
+	"\bstatuses\x18\x03 \x03(\x0e2\x13.thesis.TopicStatusR\bstatuses\x12\"\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tH\x00R\tcreatedBy\x88\x01\x01B\r\n" +
+	"\v_created_by\"=\n" +
+	"\x14SearchTopicsResponse\x12%\n" +
+	"\x04hits\x18\x01 \x03(\v2\x11.common.SearchHitR\x04hits\"m\n" +
+	"\x18ListCouncilTopicsRequest\x12!\n" +
+	"\fcouncil_code\x18\x01 \x01(\tR\vcouncilCode\x12.\n" +
+	"\x13topic_council_codes\x18\x02 \x03(\tR\x11topicCouncilCodes\"\x93\x01\n" +
+	"\x11CouncilTopicStaff\x12,\n" +
+	"\x12topic_council_code\x18\x01 \x01(\tR\x10topicCouncilCode\x12)\n" +
+	"\x10supervisor_codes\x18\x02 \x03(\tR\x0fsupervisorCodes\x12%\n" +
+	"\x0ereviewer_codes\x18\x03 \x03(\tR\rreviewerCodes\"N\n" +
+	"\x19ListCouncilTopicsResponse\x121\n" +
+	"\x06topics\x18\x01 \x03(\v2\x19.thesis.CouncilTopicStaffR\x06topics*E\n" +
 	"\rMidtermStatus\x12\x11\n" +
 	"\rNOT_SUBMITTED\x10\x00\x12\r\n" +
 	"\tSUBMITTED\x10\x01\x12\b\n" +
@@ -5445,52 +16015,160 @@ const file_proto_thesis_thesis_proto_rawDesc = "" +
 	"APPROVED_2\x10\x03\x12\x0f\n" +
 	"\vIN_PROGRESS\x10\x04\x12\x13\n" +
 	"\x0fTOPIC_COMPLETED\x10\x05\x12\f\n" +
-	"\bREJECTED\x10\x06*,\n" +
+	"\bREJECTED\x10\x06*J\n" +
+	"\fCoSignStatus\x12\x12\n" +
+	"\x0eCOSIGN_PENDING\x10\x00\x12\x11\n" +
+	"\rCOSIGN_SIGNED\x10\x01\x12\x13\n" +
+	"\x0fCOSIGN_DECLINED\x10\x02*\x80\x01\n" +
+	"\x12RegistrationStatus\x12\x18\n" +
+	"\x14REGISTRATION_PENDING\x10\x00\x12\x19\n" +
+	"\x15REGISTRATION_ACCEPTED\x10\x01\x12\x19\n" +
+	"\x15REGISTRATION_DECLINED\x10\x02\x12\x1a\n" +
+	"\x16REGISTRATION_WITHDRAWN\x10\x03*t\n" +
+	"\x14MatchRejectionReason\x12\x13\n" +
+	"\x0fMATCH_OUTRANKED\x10\x00\x12\x18\n" +
+	"\x14MATCH_NOT_ACCEPTABLE\x10\x01\x12\x15\n" +
+	"\x11MATCH_NO_CAPACITY\x10\x02\x12\x16\n" +
+	"\x12MATCH_TOPIC_CLOSED\x10\x03*,\n" +
 	"\n" +
 	"TopicStage\x12\x0e\n" +
 	"\n" +
 	"STAGE_DACN\x10\x00\x12\x0e\n" +
 	"\n" +
-	"STAGE_LVTN\x10\x012\xbe\x17\n" +
+	"STAGE_LVTN\x10\x01*>\n" +
+	"\x0eSubmissionKind\x12\x16\n" +
+	"\x12SUBMISSION_MIDTERM\x10\x00\x12\x14\n" +
+	"\x10SUBMISSION_FINAL\x10\x01*@\n" +
+	"\rGradeRounding\x12\x11\n" +
+	"\rROUND_HALF_UP\x10\x00\x12\x0e\n" +
+	"\n" +
+	"ROUND_DOWN\x10\x01\x12\f\n" +
+	"\bROUND_UP\x10\x02*z\n" +
+	"\x14GradeAmendmentTarget\x12\x1a\n" +
+	"\x16AMEND_SUPERVISOR_GRADE\x10\x00\x12\x16\n" +
+	"\x12AMEND_REVIEW_GRADE\x10\x01\x12\x17\n" +
+	"\x13AMEND_MIDTERM_GRADE\x10\x02\x12\x15\n" +
+	"\x11AMEND_FINAL_GRADE\x10\x03*]\n" +
+	"\x14GradeAmendmentStatus\x12\x15\n" +
+	"\x11AMENDMENT_PENDING\x10\x00\x12\x16\n" +
+	"\x12AMENDMENT_APPROVED\x10\x01\x12\x16\n" +
+	"\x12AMENDMENT_REJECTED\x10\x02*\x92\x01\n" +
+	"\x14GradeAppealComponent\x12\x1b\n" +
+	"\x17APPEAL_SUPERVISOR_GRADE\x10\x00\x12\x17\n" +
+	"\x13APPEAL_REVIEW_GRADE\x10\x01\x12\x18\n" +
+	"\x14APPEAL_MIDTERM_GRADE\x10\x02\x12\x16\n" +
+	"\x12APPEAL_FINAL_GRADE\x10\x03\x12\x12\n" +
+	"\x0eAPPEAL_DEFENCE\x10\x04*h\n" +
+	"\x11GradeAppealStatus\x12\x14\n" +
+	"\x10APPEAL_SUBMITTED\x10\x00\x12\x13\n" +
+	"\x0fAPPEAL_ASSIGNED\x10\x01\x12\x14\n" +
+	"\x10APPEAL_CONFIRMED\x10\x02\x12\x12\n" +
+	"\x0eAPPEAL_AMENDED\x10\x03*T\n" +
+	"\x0fMilestoneResult\x12\x15\n" +
+	"\x11MILESTONE_PENDING\x10\x00\x12\x14\n" +
+	"\x10MILESTONE_PASSED\x10\x01\x12\x14\n" +
+	"\x10MILESTONE_FAILED\x10\x022\xddD\n" +
 	"\rThesisService\x12L\n" +
 	"\rCreateMidterm\x12\x1c.thesis.CreateMidtermRequest\x1a\x1d.thesis.CreateMidtermResponse\x12C\n" +
 	"\n" +
 	"GetMidterm\x12\x19.thesis.GetMidtermRequest\x1a\x1a.thesis.GetMidtermResponse\x12L\n" +
 	"\rUpdateMidterm\x12\x1c.thesis.UpdateMidtermRequest\x1a\x1d.thesis.UpdateMidtermResponse\x12L\n" +
-	"\rDeleteMidterm\x12\x1c.thesis.DeleteMidtermRequest\x1a\x1d.thesis.DeleteMidtermResponse\x12I\n" +
-	"\fListMidterms\x12\x1b.thesis.ListMidtermsRequest\x1a\x1c.thesis.ListMidtermsResponse\x12F\n" +
+	"\rDeleteMidterm\x12\x1c.thesis.DeleteMidtermRequest\x1a\x1d.thesis.DeleteMidtermResponse\x12O\n" +
+	"\x0eRestoreMidterm\x12\x1d.thesis.RestoreMidtermRequest\x1a\x1e.thesis.RestoreMidtermResponse\x12I\n" +
+	"\fListMidterms\x12\x1b.thesis.ListMidtermsRequest\x1a\x1c.thesis.ListMidtermsResponse\x12g\n" +
+	"\x16CreateMidtermMilestone\x12%.thesis.CreateMidtermMilestoneRequest\x1a&.thesis.CreateMidtermMilestoneResponse\x12g\n" +
+	"\x16UpdateMidtermMilestone\x12%.thesis.UpdateMidtermMilestoneRequest\x1a&.thesis.UpdateMidtermMilestoneResponse\x12g\n" +
+	"\x16DeleteMidtermMilestone\x12%.thesis.DeleteMidtermMilestoneRequest\x1a&.thesis.DeleteMidtermMilestoneResponse\x12d\n" +
+	"\x15ListMidtermMilestones\x12$.thesis.ListMidtermMilestonesRequest\x1a%.thesis.ListMidtermMilestonesResponse\x12g\n" +
+	"\x16SubmitMilestoneCheckin\x12%.thesis.SubmitMilestoneCheckinRequest\x1a&.thesis.SubmitMilestoneCheckinResponse\x12g\n" +
+	"\x16ReviewMilestoneCheckin\x12%.thesis.ReviewMilestoneCheckinRequest\x1a&.thesis.ReviewMilestoneCheckinResponse\x12^\n" +
+	"\x13GetMilestoneCheckin\x12\".thesis.GetMilestoneCheckinRequest\x1a#.thesis.GetMilestoneCheckinResponse\x12d\n" +
+	"\x15ListMilestoneCheckins\x12$.thesis.ListMilestoneCheckinsRequest\x1a%.thesis.ListMilestoneCheckinsResponse\x12F\n" +
 	"\vCreateFinal\x12\x1a.thesis.CreateFinalRequest\x1a\x1b.thesis.CreateFinalResponse\x12=\n" +
 	"\bGetFinal\x12\x17.thesis.GetFinalRequest\x1a\x18.thesis.GetFinalResponse\x12F\n" +
 	"\vUpdateFinal\x12\x1a.thesis.UpdateFinalRequest\x1a\x1b.thesis.UpdateFinalResponse\x12F\n" +
-	"\vDeleteFinal\x12\x1a.thesis.DeleteFinalRequest\x1a\x1b.thesis.DeleteFinalResponse\x12C\n" +
+	"\vDeleteFinal\x12\x1a.thesis.DeleteFinalRequest\x1a\x1b.thesis.DeleteFinalResponse\x12I\n" +
+	"\fRestoreFinal\x12\x1b.thesis.RestoreFinalRequest\x1a\x1c.thesis.RestoreFinalResponse\x12C\n" +
 	"\n" +
 	"ListFinals\x12\x19.thesis.ListFinalsRequest\x1a\x1a.thesis.ListFinalsResponse\x12U\n" +
 	"\x10CreateEnrollment\x12\x1f.thesis.CreateEnrollmentRequest\x1a .thesis.CreateEnrollmentResponse\x12L\n" +
 	"\rGetEnrollment\x12\x1c.thesis.GetEnrollmentRequest\x1a\x1d.thesis.GetEnrollmentResponse\x12U\n" +
 	"\x10UpdateEnrollment\x12\x1f.thesis.UpdateEnrollmentRequest\x1a .thesis.UpdateEnrollmentResponse\x12U\n" +
-	"\x10DeleteEnrollment\x12\x1f.thesis.DeleteEnrollmentRequest\x1a .thesis.DeleteEnrollmentResponse\x12R\n" +
-	"\x0fListEnrollments\x12\x1e.thesis.ListEnrollmentsRequest\x1a\x1f.thesis.ListEnrollmentsResponse\x12F\n" +
+	"\x10DeleteEnrollment\x12\x1f.thesis.DeleteEnrollmentRequest\x1a .thesis.DeleteEnrollmentResponse\x12X\n" +
+	"\x11RestoreEnrollment\x12 .thesis.RestoreEnrollmentRequest\x1a!.thesis.RestoreEnrollmentResponse\x12R\n" +
+	"\x0fListEnrollments\x12\x1e.thesis.ListEnrollmentsRequest\x1a\x1f.thesis.ListEnrollmentsResponse\x12g\n" +
+	"\x16CreateEnrollmentBundle\x12%.thesis.CreateEnrollmentBundleRequest\x1a&.thesis.CreateEnrollmentBundleResponse\x12j\n" +
+	"\x17DeleteEnrollmentCascade\x12&.thesis.DeleteEnrollmentCascadeRequest\x1a'.thesis.DeleteEnrollmentCascadeResponse\x12F\n" +
 	"\vCreateTopic\x12\x1a.thesis.CreateTopicRequest\x1a\x1b.thesis.CreateTopicResponse\x12=\n" +
 	"\bGetTopic\x12\x17.thesis.GetTopicRequest\x1a\x18.thesis.GetTopicResponse\x12F\n" +
 	"\vUpdateTopic\x12\x1a.thesis.UpdateTopicRequest\x1a\x1b.thesis.UpdateTopicResponse\x12F\n" +
-	"\vDeleteTopic\x12\x1a.thesis.DeleteTopicRequest\x1a\x1b.thesis.DeleteTopicResponse\x12C\n" +
+	"\vDeleteTopic\x12\x1a.thesis.DeleteTopicRequest\x1a\x1b.thesis.DeleteTopicResponse\x12I\n" +
+	"\fRestoreTopic\x12\x1b.thesis.RestoreTopicRequest\x1a\x1c.thesis.RestoreTopicResponse\x12C\n" +
+	"\n" +
+	"ListTopics\x12\x19.thesis.ListTopicsRequest\x1a\x1a.thesis.ListTopicsResponse\x12I\n" +
+	"\fSearchTopics\x12\x1b.thesis.SearchTopicsRequest\x1a\x1c.thesis.SearchTopicsResponse\x12F\n" +
+	"\vSubmitTopic\x12\x1a.thesis.SubmitTopicRequest\x1a\x1b.thesis.SubmitTopicResponse\x12I\n" +
+	"\fApproveTopic\x12\x1b.thesis.ApproveTopicRequest\x1a\x1c.thesis.ApproveTopicResponse\x12F\n" +
+	"\vRejectTopic\x12\x1a.thesis.RejectTopicRequest\x1a\x1b.thesis.RejectTopicResponse\x12C\n" +
 	"\n" +
-	"ListTopics\x12\x19.thesis.ListTopicsRequest\x1a\x1a.thesis.ListTopicsResponse\x12[\n" +
+	"StartTopic\x12\x19.thesis.StartTopicRequest\x1a\x1a.thesis.StartTopicResponse\x12L\n" +
+	"\rCompleteTopic\x12\x1c.thesis.CompleteTopicRequest\x1a\x1d.thesis.CompleteTopicResponse\x12g\n" +
+	"\x16ListTopicStatusHistory\x12%.thesis.ListTopicStatusHistoryRequest\x1a&.thesis.ListTopicStatusHistoryResponse\x12I\n" +
+	"\fProposeTopic\x12\x1b.thesis.ProposeTopicRequest\x1a\x1c.thesis.ProposeTopicResponse\x12F\n" +
+	"\vCoSignTopic\x12\x1a.thesis.CoSignTopicRequest\x1a\x1b.thesis.CoSignTopicResponse\x12U\n" +
+	"\x10ListTopicCoSigns\x12\x1f.thesis.ListTopicCoSignsRequest\x1a .thesis.ListTopicCoSignsResponse\x12d\n" +
+	"\x15SetRegistrationWindow\x12$.thesis.SetRegistrationWindowRequest\x1a%.thesis.SetRegistrationWindowResponse\x12d\n" +
+	"\x15GetRegistrationWindow\x12$.thesis.GetRegistrationWindowRequest\x1a%.thesis.GetRegistrationWindowResponse\x12m\n" +
+	"\x18RegisterTopicPreferences\x12'.thesis.RegisterTopicPreferencesRequest\x1a(.thesis.RegisterTopicPreferencesResponse\x12g\n" +
+	"\x16ListTopicRegistrations\x12%.thesis.ListTopicRegistrationsRequest\x1a&.thesis.ListTopicRegistrationsResponse\x12j\n" +
+	"\x17DecideTopicRegistration\x12&.thesis.DecideTopicRegistrationRequest\x1a'.thesis.DecideTopicRegistrationResponse\x12^\n" +
+	"\x13SetApplicantRanking\x12\".thesis.SetApplicantRankingRequest\x1a#.thesis.SetApplicantRankingResponse\x12a\n" +
+	"\x14PreviewTopicMatching\x12#.thesis.PreviewTopicMatchingRequest\x1a$.thesis.PreviewTopicMatchingResponse\x12^\n" +
+	"\x13CommitTopicMatching\x12\".thesis.CommitTopicMatchingRequest\x1a#.thesis.CommitTopicMatchingResponse\x12[\n" +
 	"\x12CreateTopicCouncil\x12!.thesis.CreateTopicCouncilRequest\x1a\".thesis.CreateTopicCouncilResponse\x12R\n" +
 	"\x0fGetTopicCouncil\x12\x1e.thesis.GetTopicCouncilRequest\x1a\x1f.thesis.GetTopicCouncilResponse\x12[\n" +
-	"\x12UpdateTopicCouncil\x12!.thesis.UpdateTopicCouncilRequest\x1a\".thesis.UpdateTopicCouncilResponse\x12[\n" +
-	"\x12DeleteTopicCouncil\x12!.thesis.DeleteTopicCouncilRequest\x1a\".thesis.DeleteTopicCouncilResponse\x12X\n" +
+	"\x12UpdateTopicCouncil\x12!.thesis.UpdateTopicCouncilRequest\x1a\".thesis.UpdateTopicCouncilResponse\x12d\n" +
+	"\x15ScheduleTopicCouncils\x12$.thesis.ScheduleTopicCouncilsRequest\x1a%.thesis.ScheduleTopicCouncilsResponse\x12[\n" +
+	"\x12DeleteTopicCouncil\x12!.thesis.DeleteTopicCouncilRequest\x1a\".thesis.DeleteTopicCouncilResponse\x12^\n" +
+	"\x13RestoreTopicCouncil\x12\".thesis.RestoreTopicCouncilRequest\x1a#.thesis.RestoreTopicCouncilResponse\x12X\n" +
 	"\x11ListTopicCouncils\x12 .thesis.ListTopicCouncilsRequest\x1a!.thesis.ListTopicCouncilsResponse\x12y\n" +
 	"\x1cCreateTopicCouncilSupervisor\x12+.thesis.CreateTopicCouncilSupervisorRequest\x1a,.thesis.CreateTopicCouncilSupervisorResponse\x12p\n" +
 	"\x19GetTopicCouncilSupervisor\x12(.thesis.GetTopicCouncilSupervisorRequest\x1a).thesis.GetTopicCouncilSupervisorResponse\x12y\n" +
 	"\x1cUpdateTopicCouncilSupervisor\x12+.thesis.UpdateTopicCouncilSupervisorRequest\x1a,.thesis.UpdateTopicCouncilSupervisorResponse\x12y\n" +
-	"\x1cDeleteTopicCouncilSupervisor\x12+.thesis.DeleteTopicCouncilSupervisorRequest\x1a,.thesis.DeleteTopicCouncilSupervisorResponse\x12v\n" +
+	"\x1cDeleteTopicCouncilSupervisor\x12+.thesis.DeleteTopicCouncilSupervisorRequest\x1a,.thesis.DeleteTopicCouncilSupervisorResponse\x12|\n" +
+	"\x1dRestoreTopicCouncilSupervisor\x12,.thesis.RestoreTopicCouncilSupervisorRequest\x1a-.thesis.RestoreTopicCouncilSupervisorResponse\x12v\n" +
 	"\x1bListTopicCouncilSupervisors\x12*.thesis.ListTopicCouncilSupervisorsRequest\x1a+.thesis.ListTopicCouncilSupervisorsResponse\x12X\n" +
 	"\x11CreateGradeReview\x12 .thesis.CreateGradeReviewRequest\x1a!.thesis.CreateGradeReviewResponse\x12O\n" +
 	"\x0eGetGradeReview\x12\x1d.thesis.GetGradeReviewRequest\x1a\x1e.thesis.GetGradeReviewResponse\x12X\n" +
 	"\x11UpdateGradeReview\x12 .thesis.UpdateGradeReviewRequest\x1a!.thesis.UpdateGradeReviewResponse\x12X\n" +
-	"\x11DeleteGradeReview\x12 .thesis.DeleteGradeReviewRequest\x1a!.thesis.DeleteGradeReviewResponse\x12U\n" +
-	"\x10ListGradeReviews\x12\x1f.thesis.ListGradeReviewsRequest\x1a .thesis.ListGradeReviewsResponseB\n" +
+	"\x11DeleteGradeReview\x12 .thesis.DeleteGradeReviewRequest\x1a!.thesis.DeleteGradeReviewResponse\x12[\n" +
+	"\x12RestoreGradeReview\x12!.thesis.RestoreGradeReviewRequest\x1a\".thesis.RestoreGradeReviewResponse\x12U\n" +
+	"\x10ListGradeReviews\x12\x1f.thesis.ListGradeReviewsRequest\x1a .thesis.ListGradeReviewsResponse\x12d\n" +
+	"\x15SetSubmissionDeadline\x12$.thesis.SetSubmissionDeadlineRequest\x1a%.thesis.SetSubmissionDeadlineResponse\x12j\n" +
+	"\x17ListSubmissionDeadlines\x12&.thesis.ListSubmissionDeadlinesRequest\x1a'.thesis.ListSubmissionDeadlinesResponse\x12g\n" +
+	"\x16GrantDeadlineExtension\x12%.thesis.GrantDeadlineExtensionRequest\x1a&.thesis.GrantDeadlineExtensionResponse\x12d\n" +
+	"\x15CheckSubmissionWindow\x12$.thesis.CheckSubmissionWindowRequest\x1a%.thesis.CheckSubmissionWindowResponse\x12U\n" +
+	"\x10SetGradingPolicy\x12\x1f.thesis.SetGradingPolicyRequest\x1a .thesis.SetGradingPolicyResponse\x12^\n" +
+	"\x13ListGradingPolicies\x12\".thesis.ListGradingPoliciesRequest\x1a#.thesis.ListGradingPoliciesResponse\x12^\n" +
+	"\x13DeleteGradingPolicy\x12\".thesis.DeleteGradingPolicyRequest\x1a#.thesis.DeleteGradingPolicyResponse\x12X\n" +
+	"\x11ComputeFinalGrade\x12 .thesis.ComputeFinalGradeRequest\x1a!.thesis.ComputeFinalGradeResponse\x12[\n" +
+	"\x12LockSemesterGrades\x12!.thesis.LockSemesterGradesRequest\x1a\".thesis.LockSemesterGradesResponse\x12d\n" +
+	"\x15PublishSemesterGrades\x12$.thesis.PublishSemesterGradesRequest\x1a%.thesis.PublishSemesterGradesResponse\x12g\n" +
+	"\x16GetSemesterGradeStatus\x12%.thesis.GetSemesterGradeStatusRequest\x1a&.thesis.GetSemesterGradeStatusResponse\x12d\n" +
+	"\x15RequestGradeAmendment\x12$.thesis.RequestGradeAmendmentRequest\x1a%.thesis.RequestGradeAmendmentResponse\x12a\n" +
+	"\x14DecideGradeAmendment\x12#.thesis.DecideGradeAmendmentRequest\x1a$.thesis.DecideGradeAmendmentResponse\x12X\n" +
+	"\x11GetGradeAmendment\x12 .thesis.GetGradeAmendmentRequest\x1a!.thesis.GetGradeAmendmentResponse\x12^\n" +
+	"\x13ListGradeAmendments\x12\".thesis.ListGradeAmendmentsRequest\x1a#.thesis.ListGradeAmendmentsResponse\x12a\n" +
+	"\x14SetGradeAppealWindow\x12#.thesis.SetGradeAppealWindowRequest\x1a$.thesis.SetGradeAppealWindowResponse\x12R\n" +
+	"\x0fFileGradeAppeal\x12\x1e.thesis.FileGradeAppealRequest\x1a\x1f.thesis.FileGradeAppealResponse\x12X\n" +
+	"\x11AssignGradeAppeal\x12 .thesis.AssignGradeAppealRequest\x1a!.thesis.AssignGradeAppealResponse\x12[\n" +
+	"\x12ResolveGradeAppeal\x12!.thesis.ResolveGradeAppealRequest\x1a\".thesis.ResolveGradeAppealResponse\x12v\n" +
+	"\x1bRequestGradeAppealAmendment\x12*.thesis.RequestGradeAppealAmendmentRequest\x1a+.thesis.RequestGradeAppealAmendmentResponse\x12s\n" +
+	"\x1aSettleGradeAppealAmendment\x12).thesis.SettleGradeAppealAmendmentRequest\x1a*.thesis.SettleGradeAppealAmendmentResponse\x12O\n" +
+	"\x0eGetGradeAppeal\x12\x1d.thesis.GetGradeAppealRequest\x1a\x1e.thesis.GetGradeAppealResponse\x12U\n" +
+	"\x10ListGradeAppeals\x12\x1f.thesis.ListGradeAppealsRequest\x1a .thesis.ListGradeAppealsResponse\x12X\n" +
+	"\x11ListCouncilTopics\x12 .thesis.ListCouncilTopicsRequest\x1a!.thesis.ListCouncilTopicsResponse\x12P\n" +
+	"\x0fCheckReferences\x12\x1d.common.ReferenceCheckRequest\x1a\x1e.common.ReferenceCheckResponseB\n" +
 	"Z\b./thesisb\x06proto3"
 
 var (
@@ -5505,245 +16183,691 @@ func file_proto_thesis_thesis_proto_rawDescGZIP() []byte {
 	return file_proto_thesis_thesis_proto_rawDescData
 }
 
-var file_proto_thesis_thesis_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_proto_thesis_thesis_proto_msgTypes = make([]protoimpl.MessageInfo, 77)
+var file_proto_thesis_thesis_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
+var file_proto_thesis_thesis_proto_msgTypes = make([]protoimpl.MessageInfo, 219)
 var file_proto_thesis_thesis_proto_goTypes = []any{
-	(MidtermStatus)(0),                           // 0: thesis.MidtermStatus
-	(FinalStatus)(0),                             // 1: thesis.FinalStatus
-	(TopicStatus)(0),                             // 2: thesis.TopicStatus
-	(TopicStage)(0),                              // 3: thesis.TopicStage
-	(*Midterm)(nil),                              // 4: thesis.Midterm
-	(*CreateMidtermRequest)(nil),                 // 5: thesis.CreateMidtermRequest
-	(*CreateMidtermResponse)(nil),                // 6: thesis.CreateMidtermResponse
-	(*GetMidtermRequest)(nil),                    // 7: thesis.GetMidtermRequest
-	(*GetMidtermResponse)(nil),                   // 8: thesis.GetMidtermResponse
-	(*UpdateMidtermRequest)(nil),                 // 9: thesis.UpdateMidtermRequest
-	(*UpdateMidtermResponse)(nil),                // 10: thesis.UpdateMidtermResponse
-	(*DeleteMidtermRequest)(nil),                 // 11: thesis.DeleteMidtermRequest
-	(*DeleteMidtermResponse)(nil),                // 12: thesis.DeleteMidtermResponse
-	(*ListMidtermsRequest)(nil),                  // 13: thesis.ListMidtermsRequest
-	(*ListMidtermsResponse)(nil),                 // 14: thesis.ListMidtermsResponse
-	(*Final)(nil),                                // 15: thesis.Final
-	(*CreateFinalRequest)(nil),                   // 16: thesis.CreateFinalRequest
-	(*CreateFinalResponse)(nil),                  // 17: thesis.CreateFinalResponse
-	(*GetFinalRequest)(nil),                      // 18: thesis.GetFinalRequest
-	(*GetFinalResponse)(nil),                     // 19: thesis.GetFinalResponse
-	(*UpdateFinalRequest)(nil),                   // 20: thesis.UpdateFinalRequest
-	(*UpdateFinalResponse)(nil),                  // 21: thesis.UpdateFinalResponse
-	(*DeleteFinalRequest)(nil),                   // 22: thesis.DeleteFinalRequest
-	(*DeleteFinalResponse)(nil),                  // 23: thesis.DeleteFinalResponse
-	(*ListFinalsRequest)(nil),                    // 24: thesis.ListFinalsRequest
-	(*ListFinalsResponse)(nil),                   // 25: thesis.ListFinalsResponse
-	(*Enrollment)(nil),                           // 26: thesis.Enrollment
-	(*CreateEnrollmentRequest)(nil),              // 27: thesis.CreateEnrollmentRequest
-	(*CreateEnrollmentResponse)(nil),             // 28: thesis.CreateEnrollmentResponse
-	(*GetEnrollmentRequest)(nil),                 // 29: thesis.GetEnrollmentRequest
-	(*GetEnrollmentResponse)(nil),                // 30: thesis.GetEnrollmentResponse
-	(*UpdateEnrollmentRequest)(nil),              // 31: thesis.UpdateEnrollmentRequest
-	(*UpdateEnrollmentResponse)(nil),             // 32: thesis.UpdateEnrollmentResponse
-	(*DeleteEnrollmentRequest)(nil),              // 33: thesis.DeleteEnrollmentRequest
-	(*DeleteEnrollmentResponse)(nil),             // 34: thesis.DeleteEnrollmentResponse
-	(*ListEnrollmentsRequest)(nil),               // 35: thesis.ListEnrollmentsRequest
-	(*ListEnrollmentsResponse)(nil),              // 36: thesis.ListEnrollmentsResponse
-	(*Topic)(nil),                                // 37: thesis.Topic
-	(*CreateTopicRequest)(nil),                   // 38: thesis.CreateTopicRequest
-	(*CreateTopicResponse)(nil),                  // 39: thesis.CreateTopicResponse
-	(*GetTopicRequest)(nil),                      // 40: thesis.GetTopicRequest
-	(*GetTopicResponse)(nil),                     // 41: thesis.GetTopicResponse
-	(*UpdateTopicRequest)(nil),                   // 42: thesis.UpdateTopicRequest
-	(*UpdateTopicResponse)(nil),                  // 43: thesis.UpdateTopicResponse
-	(*DeleteTopicRequest)(nil),                   // 44: thesis.DeleteTopicRequest
-	(*DeleteTopicResponse)(nil),                  // 45: thesis.DeleteTopicResponse
-	(*ListTopicsRequest)(nil),                    // 46: thesis.ListTopicsRequest
-	(*ListTopicsResponse)(nil),                   // 47: thesis.ListTopicsResponse
-	(*TopicCouncil)(nil),                         // 48: thesis.TopicCouncil
-	(*CreateTopicCouncilRequest)(nil),            // 49: thesis.CreateTopicCouncilRequest
-	(*CreateTopicCouncilResponse)(nil),           // 50: thesis.CreateTopicCouncilResponse
-	(*GetTopicCouncilRequest)(nil),               // 51: thesis.GetTopicCouncilRequest
-	(*GetTopicCouncilResponse)(nil),              // 52: thesis.GetTopicCouncilResponse
-	(*UpdateTopicCouncilRequest)(nil),            // 53: thesis.UpdateTopicCouncilRequest
-	(*UpdateTopicCouncilResponse)(nil),           // 54: thesis.UpdateTopicCouncilResponse
-	(*DeleteTopicCouncilRequest)(nil),            // 55: thesis.DeleteTopicCouncilRequest
-	(*DeleteTopicCouncilResponse)(nil),           // 56: thesis.DeleteTopicCouncilResponse
-	(*ListTopicCouncilsRequest)(nil),             // 57: thesis.ListTopicCouncilsRequest
-	(*ListTopicCouncilsResponse)(nil),            // 58: thesis.ListTopicCouncilsResponse
-	(*TopicCouncilSupervisor)(nil),               // 59: thesis.TopicCouncilSupervisor
-	(*CreateTopicCouncilSupervisorRequest)(nil),  // 60: thesis.CreateTopicCouncilSupervisorRequest
-	(*CreateTopicCouncilSupervisorResponse)(nil), // 61: thesis.CreateTopicCouncilSupervisorResponse
-	(*GetTopicCouncilSupervisorRequest)(nil),     // 62: thesis.GetTopicCouncilSupervisorRequest
-	(*GetTopicCouncilSupervisorResponse)(nil),    // 63: thesis.GetTopicCouncilSupervisorResponse
-	(*UpdateTopicCouncilSupervisorRequest)(nil),  // 64: thesis.UpdateTopicCouncilSupervisorRequest
-	(*UpdateTopicCouncilSupervisorResponse)(nil), // 65: thesis.UpdateTopicCouncilSupervisorResponse
-	(*DeleteTopicCouncilSupervisorRequest)(nil),  // 66: thesis.DeleteTopicCouncilSupervisorRequest
-	(*DeleteTopicCouncilSupervisorResponse)(nil), // 67: thesis.DeleteTopicCouncilSupervisorResponse
-	(*ListTopicCouncilSupervisorsRequest)(nil),   // 68: thesis.ListTopicCouncilSupervisorsRequest
-	(*ListTopicCouncilSupervisorsResponse)(nil),  // 69: thesis.ListTopicCouncilSupervisorsResponse
-	(*GradeReview)(nil),                          // 70: thesis.GradeReview
-	(*CreateGradeReviewRequest)(nil),             // 71: thesis.CreateGradeReviewRequest
-	(*CreateGradeReviewResponse)(nil),            // 72: thesis.CreateGradeReviewResponse
-	(*GetGradeReviewRequest)(nil),                // 73: thesis.GetGradeReviewRequest
-	(*GetGradeReviewResponse)(nil),               // 74: thesis.GetGradeReviewResponse
-	(*UpdateGradeReviewRequest)(nil),             // 75: thesis.UpdateGradeReviewRequest
-	(*UpdateGradeReviewResponse)(nil),            // 76: thesis.UpdateGradeReviewResponse
-	(*DeleteGradeReviewRequest)(nil),             // 77: thesis.DeleteGradeReviewRequest
-	(*DeleteGradeReviewResponse)(nil),            // 78: thesis.DeleteGradeReviewResponse
-	(*ListGradeReviewsRequest)(nil),              // 79: thesis.ListGradeReviewsRequest
-	(*ListGradeReviewsResponse)(nil),             // 80: thesis.ListGradeReviewsResponse
-	(*timestamppb.Timestamp)(nil),                // 81: google.protobuf.Timestamp
-	(*common.SearchRequest)(nil),                 // 82: common.SearchRequest
+	(MidtermStatus)(0),                            // 0: thesis.MidtermStatus
+	(FinalStatus)(0),                              // 1: thesis.FinalStatus
+	(TopicStatus)(0),                              // 2: thesis.TopicStatus
+	(CoSignStatus)(0),                             // 3: thesis.CoSignStatus
+	(RegistrationStatus)(0),                       // 4: thesis.RegistrationStatus
+	(MatchRejectionReason)(0),                     // 5: thesis.MatchRejectionReason
+	(TopicStage)(0),                               // 6: thesis.TopicStage
+	(SubmissionKind)(0),                           // 7: thesis.SubmissionKind
+	(GradeRounding)(0),                            // 8: thesis.GradeRounding
+	(GradeAmendmentTarget)(0),                     // 9: thesis.GradeAmendmentTarget
+	(GradeAmendmentStatus)(0),                     // 10: thesis.GradeAmendmentStatus
+	(GradeAppealComponent)(0),                     // 11: thesis.GradeAppealComponent
+	(GradeAppealStatus)(0),                        // 12: thesis.GradeAppealStatus
+	(MilestoneResult)(0),                          // 13: thesis.MilestoneResult
+	(*Midterm)(nil),                               // 14: thesis.Midterm
+	(*CreateMidtermRequest)(nil),                  // 15: thesis.CreateMidtermRequest
+	(*CreateMidtermResponse)(nil),                 // 16: thesis.CreateMidtermResponse
+	(*GetMidtermRequest)(nil),                     // 17: thesis.GetMidtermRequest
+	(*GetMidtermResponse)(nil),                    // 18: thesis.GetMidtermResponse
+	(*UpdateMidtermRequest)(nil),                  // 19: thesis.UpdateMidtermRequest
+	(*UpdateMidtermResponse)(nil),                 // 20: thesis.UpdateMidtermResponse
+	(*DeleteMidtermRequest)(nil),                  // 21: thesis.DeleteMidtermRequest
+	(*DeleteMidtermResponse)(nil),                 // 22: thesis.DeleteMidtermResponse
+	(*RestoreMidtermRequest)(nil),                 // 23: thesis.RestoreMidtermRequest
+	(*RestoreMidtermResponse)(nil),                // 24: thesis.RestoreMidtermResponse
+	(*ListMidtermsRequest)(nil),                   // 25: thesis.ListMidtermsRequest
+	(*ListMidtermsResponse)(nil),                  // 26: thesis.ListMidtermsResponse
+	(*Final)(nil),                                 // 27: thesis.Final
+	(*CreateFinalRequest)(nil),                    // 28: thesis.CreateFinalRequest
+	(*CreateFinalResponse)(nil),                   // 29: thesis.CreateFinalResponse
+	(*GetFinalRequest)(nil),                       // 30: thesis.GetFinalRequest
+	(*GetFinalResponse)(nil),                      // 31: thesis.GetFinalResponse
+	(*UpdateFinalRequest)(nil),                    // 32: thesis.UpdateFinalRequest
+	(*UpdateFinalResponse)(nil),                   // 33: thesis.UpdateFinalResponse
+	(*DeleteFinalRequest)(nil),                    // 34: thesis.DeleteFinalRequest
+	(*DeleteFinalResponse)(nil),                   // 35: thesis.DeleteFinalResponse
+	(*RestoreFinalRequest)(nil),                   // 36: thesis.RestoreFinalRequest
+	(*RestoreFinalResponse)(nil),                  // 37: thesis.RestoreFinalResponse
+	(*ListFinalsRequest)(nil),                     // 38: thesis.ListFinalsRequest
+	(*ListFinalsResponse)(nil),                    // 39: thesis.ListFinalsResponse
+	(*Enrollment)(nil),                            // 40: thesis.Enrollment
+	(*CreateEnrollmentRequest)(nil),               // 41: thesis.CreateEnrollmentRequest
+	(*CreateEnrollmentResponse)(nil),              // 42: thesis.CreateEnrollmentResponse
+	(*GetEnrollmentRequest)(nil),                  // 43: thesis.GetEnrollmentRequest
+	(*GetEnrollmentResponse)(nil),                 // 44: thesis.GetEnrollmentResponse
+	(*UpdateEnrollmentRequest)(nil),               // 45: thesis.UpdateEnrollmentRequest
+	(*UpdateEnrollmentResponse)(nil),              // 46: thesis.UpdateEnrollmentResponse
+	(*DeleteEnrollmentRequest)(nil),               // 47: thesis.DeleteEnrollmentRequest
+	(*DeleteEnrollmentResponse)(nil),              // 48: thesis.DeleteEnrollmentResponse
+	(*RestoreEnrollmentRequest)(nil),              // 49: thesis.RestoreEnrollmentRequest
+	(*RestoreEnrollmentResponse)(nil),             // 50: thesis.RestoreEnrollmentResponse
+	(*ListEnrollmentsRequest)(nil),                // 51: thesis.ListEnrollmentsRequest
+	(*ListEnrollmentsResponse)(nil),               // 52: thesis.ListEnrollmentsResponse
+	(*CreateEnrollmentBundleRequest)(nil),         // 53: thesis.CreateEnrollmentBundleRequest
+	(*CreateEnrollmentBundleResponse)(nil),        // 54: thesis.CreateEnrollmentBundleResponse
+	(*DeleteEnrollmentCascadeRequest)(nil),        // 55: thesis.DeleteEnrollmentCascadeRequest
+	(*DeleteEnrollmentCascadeResponse)(nil),       // 56: thesis.DeleteEnrollmentCascadeResponse
+	(*Topic)(nil),                                 // 57: thesis.Topic
+	(*CreateTopicRequest)(nil),                    // 58: thesis.CreateTopicRequest
+	(*CreateTopicResponse)(nil),                   // 59: thesis.CreateTopicResponse
+	(*GetTopicRequest)(nil),                       // 60: thesis.GetTopicRequest
+	(*GetTopicResponse)(nil),                      // 61: thesis.GetTopicResponse
+	(*UpdateTopicRequest)(nil),                    // 62: thesis.UpdateTopicRequest
+	(*UpdateTopicResponse)(nil),                   // 63: thesis.UpdateTopicResponse
+	(*DeleteTopicRequest)(nil),                    // 64: thesis.DeleteTopicRequest
+	(*DeleteTopicResponse)(nil),                   // 65: thesis.DeleteTopicResponse
+	(*RestoreTopicRequest)(nil),                   // 66: thesis.RestoreTopicRequest
+	(*RestoreTopicResponse)(nil),                  // 67: thesis.RestoreTopicResponse
+	(*ListTopicsRequest)(nil),                     // 68: thesis.ListTopicsRequest
+	(*ListTopicsResponse)(nil),                    // 69: thesis.ListTopicsResponse
+	(*TopicStatusHistory)(nil),                    // 70: thesis.TopicStatusHistory
+	(*SubmitTopicRequest)(nil),                    // 71: thesis.SubmitTopicRequest
+	(*SubmitTopicResponse)(nil),                   // 72: thesis.SubmitTopicResponse
+	(*ApproveTopicRequest)(nil),                   // 73: thesis.ApproveTopicRequest
+	(*ApproveTopicResponse)(nil),                  // 74: thesis.ApproveTopicResponse
+	(*RejectTopicRequest)(nil),                    // 75: thesis.RejectTopicRequest
+	(*RejectTopicResponse)(nil),                   // 76: thesis.RejectTopicResponse
+	(*StartTopicRequest)(nil),                     // 77: thesis.StartTopicRequest
+	(*StartTopicResponse)(nil),                    // 78: thesis.StartTopicResponse
+	(*CompleteTopicRequest)(nil),                  // 79: thesis.CompleteTopicRequest
+	(*CompleteTopicResponse)(nil),                 // 80: thesis.CompleteTopicResponse
+	(*ListTopicStatusHistoryRequest)(nil),         // 81: thesis.ListTopicStatusHistoryRequest
+	(*ListTopicStatusHistoryResponse)(nil),        // 82: thesis.ListTopicStatusHistoryResponse
+	(*ProposeTopicRequest)(nil),                   // 83: thesis.ProposeTopicRequest
+	(*ProposeTopicResponse)(nil),                  // 84: thesis.ProposeTopicResponse
+	(*TopicCoSign)(nil),                           // 85: thesis.TopicCoSign
+	(*CoSignTopicRequest)(nil),                    // 86: thesis.CoSignTopicRequest
+	(*CoSignTopicResponse)(nil),                   // 87: thesis.CoSignTopicResponse
+	(*ListTopicCoSignsRequest)(nil),               // 88: thesis.ListTopicCoSignsRequest
+	(*ListTopicCoSignsResponse)(nil),              // 89: thesis.ListTopicCoSignsResponse
+	(*RegistrationWindow)(nil),                    // 90: thesis.RegistrationWindow
+	(*SetRegistrationWindowRequest)(nil),          // 91: thesis.SetRegistrationWindowRequest
+	(*SetRegistrationWindowResponse)(nil),         // 92: thesis.SetRegistrationWindowResponse
+	(*GetRegistrationWindowRequest)(nil),          // 93: thesis.GetRegistrationWindowRequest
+	(*GetRegistrationWindowResponse)(nil),         // 94: thesis.GetRegistrationWindowResponse
+	(*TopicRegistration)(nil),                     // 95: thesis.TopicRegistration
+	(*RegisterTopicPreferencesRequest)(nil),       // 96: thesis.RegisterTopicPreferencesRequest
+	(*RegisterTopicPreferencesResponse)(nil),      // 97: thesis.RegisterTopicPreferencesResponse
+	(*ListTopicRegistrationsRequest)(nil),         // 98: thesis.ListTopicRegistrationsRequest
+	(*ListTopicRegistrationsResponse)(nil),        // 99: thesis.ListTopicRegistrationsResponse
+	(*DecideTopicRegistrationRequest)(nil),        // 100: thesis.DecideTopicRegistrationRequest
+	(*DecideTopicRegistrationResponse)(nil),       // 101: thesis.DecideTopicRegistrationResponse
+	(*SetApplicantRankingRequest)(nil),            // 102: thesis.SetApplicantRankingRequest
+	(*SetApplicantRankingResponse)(nil),           // 103: thesis.SetApplicantRankingResponse
+	(*MatchAssignment)(nil),                       // 104: thesis.MatchAssignment
+	(*MatchRejection)(nil),                        // 105: thesis.MatchRejection
+	(*UnmatchedStudent)(nil),                      // 106: thesis.UnmatchedStudent
+	(*TopicMatchingResult)(nil),                   // 107: thesis.TopicMatchingResult
+	(*PreviewTopicMatchingRequest)(nil),           // 108: thesis.PreviewTopicMatchingRequest
+	(*PreviewTopicMatchingResponse)(nil),          // 109: thesis.PreviewTopicMatchingResponse
+	(*CommitTopicMatchingRequest)(nil),            // 110: thesis.CommitTopicMatchingRequest
+	(*CommitTopicMatchingResponse)(nil),           // 111: thesis.CommitTopicMatchingResponse
+	(*TopicCouncil)(nil),                          // 112: thesis.TopicCouncil
+	(*CreateTopicCouncilRequest)(nil),             // 113: thesis.CreateTopicCouncilRequest
+	(*CreateTopicCouncilResponse)(nil),            // 114: thesis.CreateTopicCouncilResponse
+	(*GetTopicCouncilRequest)(nil),                // 115: thesis.GetTopicCouncilRequest
+	(*GetTopicCouncilResponse)(nil),               // 116: thesis.GetTopicCouncilResponse
+	(*UpdateTopicCouncilRequest)(nil),             // 117: thesis.UpdateTopicCouncilRequest
+	(*UpdateTopicCouncilResponse)(nil),            // 118: thesis.UpdateTopicCouncilResponse
+	(*TopicCouncilSlot)(nil),                      // 119: thesis.TopicCouncilSlot
+	(*ScheduleTopicCouncilsRequest)(nil),          // 120: thesis.ScheduleTopicCouncilsRequest
+	(*ScheduleTopicCouncilsResponse)(nil),         // 121: thesis.ScheduleTopicCouncilsResponse
+	(*DeleteTopicCouncilRequest)(nil),             // 122: thesis.DeleteTopicCouncilRequest
+	(*DeleteTopicCouncilResponse)(nil),            // 123: thesis.DeleteTopicCouncilResponse
+	(*RestoreTopicCouncilRequest)(nil),            // 124: thesis.RestoreTopicCouncilRequest
+	(*RestoreTopicCouncilResponse)(nil),           // 125: thesis.RestoreTopicCouncilResponse
+	(*ListTopicCouncilsRequest)(nil),              // 126: thesis.ListTopicCouncilsRequest
+	(*ListTopicCouncilsResponse)(nil),             // 127: thesis.ListTopicCouncilsResponse
+	(*TopicCouncilSupervisor)(nil),                // 128: thesis.TopicCouncilSupervisor
+	(*CreateTopicCouncilSupervisorRequest)(nil),   // 129: thesis.CreateTopicCouncilSupervisorRequest
+	(*CreateTopicCouncilSupervisorResponse)(nil),  // 130: thesis.CreateTopicCouncilSupervisorResponse
+	(*GetTopicCouncilSupervisorRequest)(nil),      // 131: thesis.GetTopicCouncilSupervisorRequest
+	(*GetTopicCouncilSupervisorResponse)(nil),     // 132: thesis.GetTopicCouncilSupervisorResponse
+	(*UpdateTopicCouncilSupervisorRequest)(nil),   // 133: thesis.UpdateTopicCouncilSupervisorRequest
+	(*UpdateTopicCouncilSupervisorResponse)(nil),  // 134: thesis.UpdateTopicCouncilSupervisorResponse
+	(*DeleteTopicCouncilSupervisorRequest)(nil),   // 135: thesis.DeleteTopicCouncilSupervisorRequest
+	(*DeleteTopicCouncilSupervisorResponse)(nil),  // 136: thesis.DeleteTopicCouncilSupervisorResponse
+	(*RestoreTopicCouncilSupervisorRequest)(nil),  // 137: thesis.RestoreTopicCouncilSupervisorRequest
+	(*RestoreTopicCouncilSupervisorResponse)(nil), // 138: thesis.RestoreTopicCouncilSupervisorResponse
+	(*ListTopicCouncilSupervisorsRequest)(nil),    // 139: thesis.ListTopicCouncilSupervisorsRequest
+	(*ListTopicCouncilSupervisorsResponse)(nil),   // 140: thesis.ListTopicCouncilSupervisorsResponse
+	(*GradeReview)(nil),                           // 141: thesis.GradeReview
+	(*CreateGradeReviewRequest)(nil),              // 142: thesis.CreateGradeReviewRequest
+	(*CreateGradeReviewResponse)(nil),             // 143: thesis.CreateGradeReviewResponse
+	(*GetGradeReviewRequest)(nil),                 // 144: thesis.GetGradeReviewRequest
+	(*GetGradeReviewResponse)(nil),                // 145: thesis.GetGradeReviewResponse
+	(*UpdateGradeReviewRequest)(nil),              // 146: thesis.UpdateGradeReviewRequest
+	(*UpdateGradeReviewResponse)(nil),             // 147: thesis.UpdateGradeReviewResponse
+	(*DeleteGradeReviewRequest)(nil),              // 148: thesis.DeleteGradeReviewRequest
+	(*DeleteGradeReviewResponse)(nil),             // 149: thesis.DeleteGradeReviewResponse
+	(*RestoreGradeReviewRequest)(nil),             // 150: thesis.RestoreGradeReviewRequest
+	(*RestoreGradeReviewResponse)(nil),            // 151: thesis.RestoreGradeReviewResponse
+	(*ListGradeReviewsRequest)(nil),               // 152: thesis.ListGradeReviewsRequest
+	(*ListGradeReviewsResponse)(nil),              // 153: thesis.ListGradeReviewsResponse
+	(*SubmissionDeadline)(nil),                    // 154: thesis.SubmissionDeadline
+	(*SetSubmissionDeadlineRequest)(nil),          // 155: thesis.SetSubmissionDeadlineRequest
+	(*SetSubmissionDeadlineResponse)(nil),         // 156: thesis.SetSubmissionDeadlineResponse
+	(*ListSubmissionDeadlinesRequest)(nil),        // 157: thesis.ListSubmissionDeadlinesRequest
+	(*ListSubmissionDeadlinesResponse)(nil),       // 158: thesis.ListSubmissionDeadlinesResponse
+	(*DeadlineExtension)(nil),                     // 159: thesis.DeadlineExtension
+	(*GrantDeadlineExtensionRequest)(nil),         // 160: thesis.GrantDeadlineExtensionRequest
+	(*GrantDeadlineExtensionResponse)(nil),        // 161: thesis.GrantDeadlineExtensionResponse
+	(*CheckSubmissionWindowRequest)(nil),          // 162: thesis.CheckSubmissionWindowRequest
+	(*CheckSubmissionWindowResponse)(nil),         // 163: thesis.CheckSubmissionWindowResponse
+	(*GradingPolicy)(nil),                         // 164: thesis.GradingPolicy
+	(*SetGradingPolicyRequest)(nil),               // 165: thesis.SetGradingPolicyRequest
+	(*SetGradingPolicyResponse)(nil),              // 166: thesis.SetGradingPolicyResponse
+	(*ListGradingPoliciesRequest)(nil),            // 167: thesis.ListGradingPoliciesRequest
+	(*ListGradingPoliciesResponse)(nil),           // 168: thesis.ListGradingPoliciesResponse
+	(*DeleteGradingPolicyRequest)(nil),            // 169: thesis.DeleteGradingPolicyRequest
+	(*DeleteGradingPolicyResponse)(nil),           // 170: thesis.DeleteGradingPolicyResponse
+	(*CouncilMemberScore)(nil),                    // 171: thesis.CouncilMemberScore
+	(*ComputeFinalGradeRequest)(nil),              // 172: thesis.ComputeFinalGradeRequest
+	(*GradeComponent)(nil),                        // 173: thesis.GradeComponent
+	(*FinalGradeBreakdown)(nil),                   // 174: thesis.FinalGradeBreakdown
+	(*ComputeFinalGradeResponse)(nil),             // 175: thesis.ComputeFinalGradeResponse
+	(*SemesterGradeStatus)(nil),                   // 176: thesis.SemesterGradeStatus
+	(*LockSemesterGradesRequest)(nil),             // 177: thesis.LockSemesterGradesRequest
+	(*LockSemesterGradesResponse)(nil),            // 178: thesis.LockSemesterGradesResponse
+	(*PublishSemesterGradesRequest)(nil),          // 179: thesis.PublishSemesterGradesRequest
+	(*PublishSemesterGradesResponse)(nil),         // 180: thesis.PublishSemesterGradesResponse
+	(*GetSemesterGradeStatusRequest)(nil),         // 181: thesis.GetSemesterGradeStatusRequest
+	(*GetSemesterGradeStatusResponse)(nil),        // 182: thesis.GetSemesterGradeStatusResponse
+	(*GradeAmendment)(nil),                        // 183: thesis.GradeAmendment
+	(*RequestGradeAmendmentRequest)(nil),          // 184: thesis.RequestGradeAmendmentRequest
+	(*RequestGradeAmendmentResponse)(nil),         // 185: thesis.RequestGradeAmendmentResponse
+	(*DecideGradeAmendmentRequest)(nil),           // 186: thesis.DecideGradeAmendmentRequest
+	(*DecideGradeAmendmentResponse)(nil),          // 187: thesis.DecideGradeAmendmentResponse
+	(*GetGradeAmendmentRequest)(nil),              // 188: thesis.GetGradeAmendmentRequest
+	(*GetGradeAmendmentResponse)(nil),             // 189: thesis.GetGradeAmendmentResponse
+	(*ListGradeAmendmentsRequest)(nil),            // 190: thesis.ListGradeAmendmentsRequest
+	(*ListGradeAmendmentsResponse)(nil),           // 191: thesis.ListGradeAmendmentsResponse
+	(*GradeAppealEvent)(nil),                      // 192: thesis.GradeAppealEvent
+	(*GradeAppeal)(nil),                           // 193: thesis.GradeAppeal
+	(*SetGradeAppealWindowRequest)(nil),           // 194: thesis.SetGradeAppealWindowRequest
+	(*SetGradeAppealWindowResponse)(nil),          // 195: thesis.SetGradeAppealWindowResponse
+	(*FileGradeAppealRequest)(nil),                // 196: thesis.FileGradeAppealRequest
+	(*FileGradeAppealResponse)(nil),               // 197: thesis.FileGradeAppealResponse
+	(*AssignGradeAppealRequest)(nil),              // 198: thesis.AssignGradeAppealRequest
+	(*AssignGradeAppealResponse)(nil),             // 199: thesis.AssignGradeAppealResponse
+	(*ResolveGradeAppealRequest)(nil),             // 200: thesis.ResolveGradeAppealRequest
+	(*ResolveGradeAppealResponse)(nil),            // 201: thesis.ResolveGradeAppealResponse
+	(*RequestGradeAppealAmendmentRequest)(nil),    // 202: thesis.RequestGradeAppealAmendmentRequest
+	(*RequestGradeAppealAmendmentResponse)(nil),   // 203: thesis.RequestGradeAppealAmendmentResponse
+	(*SettleGradeAppealAmendmentRequest)(nil),     // 204: thesis.SettleGradeAppealAmendmentRequest
+	(*SettleGradeAppealAmendmentResponse)(nil),    // 205: thesis.SettleGradeAppealAmendmentResponse
+	(*GetGradeAppealRequest)(nil),                 // 206: thesis.GetGradeAppealRequest
+	(*GetGradeAppealResponse)(nil),                // 207: thesis.GetGradeAppealResponse
+	(*ListGradeAppealsRequest)(nil),               // 208: thesis.ListGradeAppealsRequest
+	(*ListGradeAppealsResponse)(nil),              // 209: thesis.ListGradeAppealsResponse
+	(*MidtermMilestone)(nil),                      // 210: thesis.MidtermMilestone
+	(*CreateMidtermMilestoneRequest)(nil),         // 211: thesis.CreateMidtermMilestoneRequest
+	(*CreateMidtermMilestoneResponse)(nil),        // 212: thesis.CreateMidtermMilestoneResponse
+	(*UpdateMidtermMilestoneRequest)(nil),         // 213: thesis.UpdateMidtermMilestoneRequest
+	(*UpdateMidtermMilestoneResponse)(nil),        // 214: thesis.UpdateMidtermMilestoneResponse
+	(*DeleteMidtermMilestoneRequest)(nil),         // 215: thesis.DeleteMidtermMilestoneRequest
+	(*DeleteMidtermMilestoneResponse)(nil),        // 216: thesis.DeleteMidtermMilestoneResponse
+	(*ListMidtermMilestonesRequest)(nil),          // 217: thesis.ListMidtermMilestonesRequest
+	(*ListMidtermMilestonesResponse)(nil),         // 218: thesis.ListMidtermMilestonesResponse
+	(*MilestoneCheckin)(nil),                      // 219: thesis.MilestoneCheckin
+	(*SubmitMilestoneCheckinRequest)(nil),         // 220: thesis.SubmitMilestoneCheckinRequest
+	(*SubmitMilestoneCheckinResponse)(nil),        // 221: thesis.SubmitMilestoneCheckinResponse
+	(*ReviewMilestoneCheckinRequest)(nil),         // 222: thesis.ReviewMilestoneCheckinRequest
+	(*ReviewMilestoneCheckinResponse)(nil),        // 223: thesis.ReviewMilestoneCheckinResponse
+	(*GetMilestoneCheckinRequest)(nil),            // 224: thesis.GetMilestoneCheckinRequest
+	(*GetMilestoneCheckinResponse)(nil),           // 225: thesis.GetMilestoneCheckinResponse
+	(*ListMilestoneCheckinsRequest)(nil),          // 226: thesis.ListMilestoneCheckinsRequest
+	(*ListMilestoneCheckinsResponse)(nil),         // 227: thesis.ListMilestoneCheckinsResponse
+	(*SearchTopicsRequest)(nil),                   // 228: thesis.SearchTopicsRequest
+	(*SearchTopicsResponse)(nil),                  // 229: thesis.SearchTopicsResponse
+	(*ListCouncilTopicsRequest)(nil),              // 230: thesis.ListCouncilTopicsRequest
+	(*CouncilTopicStaff)(nil),                     // 231: thesis.CouncilTopicStaff
+	(*ListCouncilTopicsResponse)(nil),             // 232: thesis.ListCouncilTopicsResponse
+	(*timestamppb.Timestamp)(nil),                 // 233: google.protobuf.Timestamp
+	(*common.SearchRequest)(nil),                  // 234: common.SearchRequest
+	(*common.SearchHit)(nil),                      // 235: common.SearchHit
+	(*common.ReferenceCheckRequest)(nil),          // 236: common.ReferenceCheckRequest
+	(*common.ReferenceCheckResponse)(nil),         // 237: common.ReferenceCheckResponse
 }
 var file_proto_thesis_thesis_proto_depIdxs = []int32{
 	0,   // 0: thesis.Midterm.status:type_name -> thesis.MidtermStatus
-	81,  // 1: thesis.Midterm.created_at:type_name -> google.protobuf.Timestamp
-	81,  // 2: thesis.Midterm.updated_at:type_name -> google.protobuf.Timestamp
-	0,   // 3: thesis.CreateMidtermRequest.status:type_name -> thesis.MidtermStatus
-	4,   // 4: thesis.CreateMidtermResponse.midterm:type_name -> thesis.Midterm
-	4,   // 5: thesis.GetMidtermResponse.midterm:type_name -> thesis.Midterm
-	0,   // 6: thesis.UpdateMidtermRequest.status:type_name -> thesis.MidtermStatus
-	4,   // 7: thesis.UpdateMidtermResponse.midterm:type_name -> thesis.Midterm
-	82,  // 8: thesis.ListMidtermsRequest.search:type_name -> common.SearchRequest
-	4,   // 9: thesis.ListMidtermsResponse.midterms:type_name -> thesis.Midterm
-	1,   // 10: thesis.Final.status:type_name -> thesis.FinalStatus
-	81,  // 11: thesis.Final.completion_date:type_name -> google.protobuf.Timestamp
-	81,  // 12: thesis.Final.created_at:type_name -> google.protobuf.Timestamp
-	81,  // 13: thesis.Final.updated_at:type_name -> google.protobuf.Timestamp
-	1,   // 14: thesis.CreateFinalRequest.status:type_name -> thesis.FinalStatus
-	81,  // 15: thesis.CreateFinalRequest.completion_date:type_name -> google.protobuf.Timestamp
-	15,  // 16: thesis.CreateFinalResponse.final:type_name -> thesis.Final
-	15,  // 17: thesis.GetFinalResponse.final:type_name -> thesis.Final
-	1,   // 18: thesis.UpdateFinalRequest.status:type_name -> thesis.FinalStatus
-	81,  // 19: thesis.UpdateFinalRequest.completion_date:type_name -> google.protobuf.Timestamp
-	15,  // 20: thesis.UpdateFinalResponse.final:type_name -> thesis.Final
-	82,  // 21: thesis.ListFinalsRequest.search:type_name -> common.SearchRequest
-	15,  // 22: thesis.ListFinalsResponse.finals:type_name -> thesis.Final
-	81,  // 23: thesis.Enrollment.created_at:type_name -> google.protobuf.Timestamp
-	81,  // 24: thesis.Enrollment.updated_at:type_name -> google.protobuf.Timestamp
-	26,  // 25: thesis.CreateEnrollmentResponse.enrollment:type_name -> thesis.Enrollment
-	26,  // 26: thesis.GetEnrollmentResponse.enrollment:type_name -> thesis.Enrollment
-	26,  // 27: thesis.UpdateEnrollmentResponse.enrollment:type_name -> thesis.Enrollment
-	82,  // 28: thesis.ListEnrollmentsRequest.search:type_name -> common.SearchRequest
-	26,  // 29: thesis.ListEnrollmentsResponse.enrollments:type_name -> thesis.Enrollment
-	2,   // 30: thesis.Topic.status:type_name -> thesis.TopicStatus
-	81,  // 31: thesis.Topic.created_at:type_name -> google.protobuf.Timestamp
-	81,  // 32: thesis.Topic.updated_at:type_name -> google.protobuf.Timestamp
-	2,   // 33: thesis.CreateTopicRequest.status:type_name -> thesis.TopicStatus
-	37,  // 34: thesis.CreateTopicResponse.topic:type_name -> thesis.Topic
-	37,  // 35: thesis.GetTopicResponse.topic:type_name -> thesis.Topic
-	2,   // 36: thesis.UpdateTopicRequest.status:type_name -> thesis.TopicStatus
-	37,  // 37: thesis.UpdateTopicResponse.topic:type_name -> thesis.Topic
-	82,  // 38: thesis.ListTopicsRequest.search:type_name -> common.SearchRequest
-	37,  // 39: thesis.ListTopicsResponse.topics:type_name -> thesis.Topic
-	3,   // 40: thesis.TopicCouncil.stage:type_name -> thesis.TopicStage
-	81,  // 41: thesis.TopicCouncil.time_start:type_name -> google.protobuf.Timestamp
-	81,  // 42: thesis.TopicCouncil.time_end:type_name -> google.protobuf.Timestamp
-	81,  // 43: thesis.TopicCouncil.created_at:type_name -> google.protobuf.Timestamp
-	81,  // 44: thesis.TopicCouncil.updated_at:type_name -> google.protobuf.Timestamp
-	3,   // 45: thesis.CreateTopicCouncilRequest.stage:type_name -> thesis.TopicStage
-	81,  // 46: thesis.CreateTopicCouncilRequest.time_start:type_name -> google.protobuf.Timestamp
-	81,  // 47: thesis.CreateTopicCouncilRequest.time_end:type_name -> google.protobuf.Timestamp
-	48,  // 48: thesis.CreateTopicCouncilResponse.topic_council:type_name -> thesis.TopicCouncil
-	48,  // 49: thesis.GetTopicCouncilResponse.topic_council:type_name -> thesis.TopicCouncil
-	3,   // 50: thesis.UpdateTopicCouncilRequest.stage:type_name -> thesis.TopicStage
-	81,  // 51: thesis.UpdateTopicCouncilRequest.time_start:type_name -> google.protobuf.Timestamp
-	81,  // 52: thesis.UpdateTopicCouncilRequest.time_end:type_name -> google.protobuf.Timestamp
-	48,  // 53: thesis.UpdateTopicCouncilResponse.topic_council:type_name -> thesis.TopicCouncil
-	82,  // 54: thesis.ListTopicCouncilsRequest.search:type_name -> common.SearchRequest
-	48,  // 55: thesis.ListTopicCouncilsResponse.topic_councils:type_name -> thesis.TopicCouncil
-	81,  // 56: thesis.TopicCouncilSupervisor.created_at:type_name -> google.protobuf.Timestamp
-	81,  // 57: thesis.TopicCouncilSupervisor.updated_at:type_name -> google.protobuf.Timestamp
-	59,  // 58: thesis.CreateTopicCouncilSupervisorResponse.topic_council_supervisor:type_name -> thesis.TopicCouncilSupervisor
-	59,  // 59: thesis.GetTopicCouncilSupervisorResponse.topic_council_supervisor:type_name -> thesis.TopicCouncilSupervisor
-	59,  // 60: thesis.UpdateTopicCouncilSupervisorResponse.topic_council_supervisor:type_name -> thesis.TopicCouncilSupervisor
-	82,  // 61: thesis.ListTopicCouncilSupervisorsRequest.search:type_name -> common.SearchRequest
-	59,  // 62: thesis.ListTopicCouncilSupervisorsResponse.topic_council_supervisors:type_name -> thesis.TopicCouncilSupervisor
-	1,   // 63: thesis.GradeReview.status:type_name -> thesis.FinalStatus
-	81,  // 64: thesis.GradeReview.completion_date:type_name -> google.protobuf.Timestamp
-	81,  // 65: thesis.GradeReview.created_at:type_name -> google.protobuf.Timestamp
-	81,  // 66: thesis.GradeReview.updated_at:type_name -> google.protobuf.Timestamp
-	1,   // 67: thesis.CreateGradeReviewRequest.status:type_name -> thesis.FinalStatus
-	81,  // 68: thesis.CreateGradeReviewRequest.completion_date:type_name -> google.protobuf.Timestamp
-	70,  // 69: thesis.CreateGradeReviewResponse.grade_review:type_name -> thesis.GradeReview
-	70,  // 70: thesis.GetGradeReviewResponse.grade_review:type_name -> thesis.GradeReview
-	1,   // 71: thesis.UpdateGradeReviewRequest.status:type_name -> thesis.FinalStatus
-	81,  // 72: thesis.UpdateGradeReviewRequest.completion_date:type_name -> google.protobuf.Timestamp
-	70,  // 73: thesis.UpdateGradeReviewResponse.grade_review:type_name -> thesis.GradeReview
-	82,  // 74: thesis.ListGradeReviewsRequest.search:type_name -> common.SearchRequest
-	70,  // 75: thesis.ListGradeReviewsResponse.grade_reviews:type_name -> thesis.GradeReview
-	5,   // 76: thesis.ThesisService.CreateMidterm:input_type -> thesis.CreateMidtermRequest
-	7,   // 77: thesis.ThesisService.GetMidterm:input_type -> thesis.GetMidtermRequest
-	9,   // 78: thesis.ThesisService.UpdateMidterm:input_type -> thesis.UpdateMidtermRequest
-	11,  // 79: thesis.ThesisService.DeleteMidterm:input_type -> thesis.DeleteMidtermRequest
-	13,  // 80: thesis.ThesisService.ListMidterms:input_type -> thesis.ListMidtermsRequest
-	16,  // 81: thesis.ThesisService.CreateFinal:input_type -> thesis.CreateFinalRequest
-	18,  // 82: thesis.ThesisService.GetFinal:input_type -> thesis.GetFinalRequest
-	20,  // 83: thesis.ThesisService.UpdateFinal:input_type -> thesis.UpdateFinalRequest
-	22,  // 84: thesis.ThesisService.DeleteFinal:input_type -> thesis.DeleteFinalRequest
-	24,  // 85: thesis.ThesisService.ListFinals:input_type -> thesis.ListFinalsRequest
-	27,  // 86: thesis.ThesisService.CreateEnrollment:input_type -> thesis.CreateEnrollmentRequest
-	29,  // 87: thesis.ThesisService.GetEnrollment:input_type -> thesis.GetEnrollmentRequest
-	31,  // 88: thesis.ThesisService.UpdateEnrollment:input_type -> thesis.UpdateEnrollmentRequest
-	33,  // 89: thesis.ThesisService.DeleteEnrollment:input_type -> thesis.DeleteEnrollmentRequest
-	35,  // 90: thesis.ThesisService.ListEnrollments:input_type -> thesis.ListEnrollmentsRequest
-	38,  // 91: thesis.ThesisService.CreateTopic:input_type -> thesis.CreateTopicRequest
-	40,  // 92: thesis.ThesisService.GetTopic:input_type -> thesis.GetTopicRequest
-	42,  // 93: thesis.ThesisService.UpdateTopic:input_type -> thesis.UpdateTopicRequest
-	44,  // 94: thesis.ThesisService.DeleteTopic:input_type -> thesis.DeleteTopicRequest
-	46,  // 95: thesis.ThesisService.ListTopics:input_type -> thesis.ListTopicsRequest
-	49,  // 96: thesis.ThesisService.CreateTopicCouncil:input_type -> thesis.CreateTopicCouncilRequest
-	51,  // 97: thesis.ThesisService.GetTopicCouncil:input_type -> thesis.GetTopicCouncilRequest
-	53,  // 98: thesis.ThesisService.UpdateTopicCouncil:input_type -> thesis.UpdateTopicCouncilRequest
-	55,  // 99: thesis.ThesisService.DeleteTopicCouncil:input_type -> thesis.DeleteTopicCouncilRequest
-	57,  // 100: thesis.ThesisService.ListTopicCouncils:input_type -> thesis.ListTopicCouncilsRequest
-	60,  // 101: thesis.ThesisService.CreateTopicCouncilSupervisor:input_type -> thesis.CreateTopicCouncilSupervisorRequest
-	62,  // 102: thesis.ThesisService.GetTopicCouncilSupervisor:input_type -> thesis.GetTopicCouncilSupervisorRequest
-	64,  // 103: thesis.ThesisService.UpdateTopicCouncilSupervisor:input_type -> thesis.UpdateTopicCouncilSupervisorRequest
-	66,  // 104: thesis.ThesisService.DeleteTopicCouncilSupervisor:input_type -> thesis.DeleteTopicCouncilSupervisorRequest
-	68,  // 105: thesis.ThesisService.ListTopicCouncilSupervisors:input_type -> thesis.ListTopicCouncilSupervisorsRequest
-	71,  // 106: thesis.ThesisService.CreateGradeReview:input_type -> thesis.CreateGradeReviewRequest
-	73,  // 107: thesis.ThesisService.GetGradeReview:input_type -> thesis.GetGradeReviewRequest
-	75,  // 108: thesis.ThesisService.UpdateGradeReview:input_type -> thesis.UpdateGradeReviewRequest
-	77,  // 109: thesis.ThesisService.DeleteGradeReview:input_type -> thesis.DeleteGradeReviewRequest
-	79,  // 110: thesis.ThesisService.ListGradeReviews:input_type -> thesis.ListGradeReviewsRequest
-	6,   // 111: thesis.ThesisService.CreateMidterm:output_type -> thesis.CreateMidtermResponse
-	8,   // 112: thesis.ThesisService.GetMidterm:output_type -> thesis.GetMidtermResponse
-	10,  // 113: thesis.ThesisService.UpdateMidterm:output_type -> thesis.UpdateMidtermResponse
-	12,  // 114: thesis.ThesisService.DeleteMidterm:output_type -> thesis.DeleteMidtermResponse
-	14,  // 115: thesis.ThesisService.ListMidterms:output_type -> thesis.ListMidtermsResponse
-	17,  // 116: thesis.ThesisService.CreateFinal:output_type -> thesis.CreateFinalResponse
-	19,  // 117: thesis.ThesisService.GetFinal:output_type -> thesis.GetFinalResponse
-	21,  // 118: thesis.ThesisService.UpdateFinal:output_type -> thesis.UpdateFinalResponse
-	23,  // 119: thesis.ThesisService.DeleteFinal:output_type -> thesis.DeleteFinalResponse
-	25,  // 120: thesis.ThesisService.ListFinals:output_type -> thesis.ListFinalsResponse
-	28,  // 121: thesis.ThesisService.CreateEnrollment:output_type -> thesis.CreateEnrollmentResponse
-	30,  // 122: thesis.ThesisService.GetEnrollment:output_type -> thesis.GetEnrollmentResponse
-	32,  // 123: thesis.ThesisService.UpdateEnrollment:output_type -> thesis.UpdateEnrollmentResponse
-	34,  // 124: thesis.ThesisService.DeleteEnrollment:output_type -> thesis.DeleteEnrollmentResponse
-	36,  // 125: thesis.ThesisService.ListEnrollments:output_type -> thesis.ListEnrollmentsResponse
-	39,  // 126: thesis.ThesisService.CreateTopic:output_type -> thesis.CreateTopicResponse
-	41,  // 127: thesis.ThesisService.GetTopic:output_type -> thesis.GetTopicResponse
-	43,  // 128: thesis.ThesisService.UpdateTopic:output_type -> thesis.UpdateTopicResponse
-	45,  // 129: thesis.ThesisService.DeleteTopic:output_type -> thesis.DeleteTopicResponse
-	47,  // 130: thesis.ThesisService.ListTopics:output_type -> thesis.ListTopicsResponse
-	50,  // 131: thesis.ThesisService.CreateTopicCouncil:output_type -> thesis.CreateTopicCouncilResponse
-	52,  // 132: thesis.ThesisService.GetTopicCouncil:output_type -> thesis.GetTopicCouncilResponse
-	54,  // 133: thesis.ThesisService.UpdateTopicCouncil:output_type -> thesis.UpdateTopicCouncilResponse
-	56,  // 134: thesis.ThesisService.DeleteTopicCouncil:output_type -> thesis.DeleteTopicCouncilResponse
-	58,  // 135: thesis.ThesisService.ListTopicCouncils:output_type -> thesis.ListTopicCouncilsResponse
-	61,  // 136: thesis.ThesisService.CreateTopicCouncilSupervisor:output_type -> thesis.CreateTopicCouncilSupervisorResponse
-	63,  // 137: thesis.ThesisService.GetTopicCouncilSupervisor:output_type -> thesis.GetTopicCouncilSupervisorResponse
-	65,  // 138: thesis.ThesisService.UpdateTopicCouncilSupervisor:output_type -> thesis.UpdateTopicCouncilSupervisorResponse
-	67,  // 139: thesis.ThesisService.DeleteTopicCouncilSupervisor:output_type -> thesis.DeleteTopicCouncilSupervisorResponse
-	69,  // 140: thesis.ThesisService.ListTopicCouncilSupervisors:output_type -> thesis.ListTopicCouncilSupervisorsResponse
-	72,  // 141: thesis.ThesisService.CreateGradeReview:output_type -> thesis.CreateGradeReviewResponse
-	74,  // 142: thesis.ThesisService.GetGradeReview:output_type -> thesis.GetGradeReviewResponse
-	76,  // 143: thesis.ThesisService.UpdateGradeReview:output_type -> thesis.UpdateGradeReviewResponse
-	78,  // 144: thesis.ThesisService.DeleteGradeReview:output_type -> thesis.DeleteGradeReviewResponse
-	80,  // 145: thesis.ThesisService.ListGradeReviews:output_type -> thesis.ListGradeReviewsResponse
-	111, // [111:146] is the sub-list for method output_type
-	76,  // [76:111] is the sub-list for method input_type
-	76,  // [76:76] is the sub-list for extension type_name
-	76,  // [76:76] is the sub-list for extension extendee
-	0,   // [0:76] is the sub-list for field type_name
+	233, // 1: thesis.Midterm.created_at:type_name -> google.protobuf.Timestamp
+	233, // 2: thesis.Midterm.updated_at:type_name -> google.protobuf.Timestamp
+	233, // 3: thesis.Midterm.deleted_at:type_name -> google.protobuf.Timestamp
+	0,   // 4: thesis.CreateMidtermRequest.status:type_name -> thesis.MidtermStatus
+	14,  // 5: thesis.CreateMidtermResponse.midterm:type_name -> thesis.Midterm
+	14,  // 6: thesis.GetMidtermResponse.midterm:type_name -> thesis.Midterm
+	0,   // 7: thesis.UpdateMidtermRequest.status:type_name -> thesis.MidtermStatus
+	14,  // 8: thesis.UpdateMidtermResponse.midterm:type_name -> thesis.Midterm
+	14,  // 9: thesis.RestoreMidtermResponse.midterm:type_name -> thesis.Midterm
+	234, // 10: thesis.ListMidtermsRequest.search:type_name -> common.SearchRequest
+	14,  // 11: thesis.ListMidtermsResponse.midterms:type_name -> thesis.Midterm
+	1,   // 12: thesis.Final.status:type_name -> thesis.FinalStatus
+	233, // 13: thesis.Final.completion_date:type_name -> google.protobuf.Timestamp
+	233, // 14: thesis.Final.created_at:type_name -> google.protobuf.Timestamp
+	233, // 15: thesis.Final.updated_at:type_name -> google.protobuf.Timestamp
+	233, // 16: thesis.Final.deleted_at:type_name -> google.protobuf.Timestamp
+	1,   // 17: thesis.CreateFinalRequest.status:type_name -> thesis.FinalStatus
+	233, // 18: thesis.CreateFinalRequest.completion_date:type_name -> google.protobuf.Timestamp
+	27,  // 19: thesis.CreateFinalResponse.final:type_name -> thesis.Final
+	27,  // 20: thesis.GetFinalResponse.final:type_name -> thesis.Final
+	1,   // 21: thesis.UpdateFinalRequest.status:type_name -> thesis.FinalStatus
+	233, // 22: thesis.UpdateFinalRequest.completion_date:type_name -> google.protobuf.Timestamp
+	27,  // 23: thesis.UpdateFinalResponse.final:type_name -> thesis.Final
+	27,  // 24: thesis.RestoreFinalResponse.final:type_name -> thesis.Final
+	234, // 25: thesis.ListFinalsRequest.search:type_name -> common.SearchRequest
+	27,  // 26: thesis.ListFinalsResponse.finals:type_name -> thesis.Final
+	233, // 27: thesis.Enrollment.created_at:type_name -> google.protobuf.Timestamp
+	233, // 28: thesis.Enrollment.updated_at:type_name -> google.protobuf.Timestamp
+	233, // 29: thesis.Enrollment.deleted_at:type_name -> google.protobuf.Timestamp
+	40,  // 30: thesis.CreateEnrollmentResponse.enrollment:type_name -> thesis.Enrollment
+	40,  // 31: thesis.GetEnrollmentResponse.enrollment:type_name -> thesis.Enrollment
+	40,  // 32: thesis.UpdateEnrollmentResponse.enrollment:type_name -> thesis.Enrollment
+	40,  // 33: thesis.RestoreEnrollmentResponse.enrollment:type_name -> thesis.Enrollment
+	234, // 34: thesis.ListEnrollmentsRequest.search:type_name -> common.SearchRequest
+	40,  // 35: thesis.ListEnrollmentsResponse.enrollments:type_name -> thesis.Enrollment
+	15,  // 36: thesis.CreateEnrollmentBundleRequest.midterm:type_name -> thesis.CreateMidtermRequest
+	28,  // 37: thesis.CreateEnrollmentBundleRequest.final:type_name -> thesis.CreateFinalRequest
+	142, // 38: thesis.CreateEnrollmentBundleRequest.grade_review:type_name -> thesis.CreateGradeReviewRequest
+	40,  // 39: thesis.CreateEnrollmentBundleResponse.enrollment:type_name -> thesis.Enrollment
+	14,  // 40: thesis.CreateEnrollmentBundleResponse.midterm:type_name -> thesis.Midterm
+	27,  // 41: thesis.CreateEnrollmentBundleResponse.final:type_name -> thesis.Final
+	141, // 42: thesis.CreateEnrollmentBundleResponse.grade_review:type_name -> thesis.GradeReview
+	2,   // 43: thesis.Topic.status:type_name -> thesis.TopicStatus
+	233, // 44: thesis.Topic.created_at:type_name -> google.protobuf.Timestamp
+	233, // 45: thesis.Topic.updated_at:type_name -> google.protobuf.Timestamp
+	233, // 46: thesis.Topic.deleted_at:type_name -> google.protobuf.Timestamp
+	2,   // 47: thesis.CreateTopicRequest.status:type_name -> thesis.TopicStatus
+	57,  // 48: thesis.CreateTopicResponse.topic:type_name -> thesis.Topic
+	57,  // 49: thesis.GetTopicResponse.topic:type_name -> thesis.Topic
+	2,   // 50: thesis.UpdateTopicRequest.status:type_name -> thesis.TopicStatus
+	57,  // 51: thesis.UpdateTopicResponse.topic:type_name -> thesis.Topic
+	57,  // 52: thesis.RestoreTopicResponse.topic:type_name -> thesis.Topic
+	234, // 53: thesis.ListTopicsRequest.search:type_name -> common.SearchRequest
+	57,  // 54: thesis.ListTopicsResponse.topics:type_name -> thesis.Topic
+	2,   // 55: thesis.TopicStatusHistory.from_status:type_name -> thesis.TopicStatus
+	2,   // 56: thesis.TopicStatusHistory.to_status:type_name -> thesis.TopicStatus
+	233, // 57: thesis.TopicStatusHistory.created_at:type_name -> google.protobuf.Timestamp
+	57,  // 58: thesis.SubmitTopicResponse.topic:type_name -> thesis.Topic
+	70,  // 59: thesis.SubmitTopicResponse.history:type_name -> thesis.TopicStatusHistory
+	57,  // 60: thesis.ApproveTopicResponse.topic:type_name -> thesis.Topic
+	70,  // 61: thesis.ApproveTopicResponse.history:type_name -> thesis.TopicStatusHistory
+	57,  // 62: thesis.RejectTopicResponse.topic:type_name -> thesis.Topic
+	70,  // 63: thesis.RejectTopicResponse.history:type_name -> thesis.TopicStatusHistory
+	57,  // 64: thesis.StartTopicResponse.topic:type_name -> thesis.Topic
+	70,  // 65: thesis.StartTopicResponse.history:type_name -> thesis.TopicStatusHistory
+	57,  // 66: thesis.CompleteTopicResponse.topic:type_name -> thesis.Topic
+	70,  // 67: thesis.CompleteTopicResponse.history:type_name -> thesis.TopicStatusHistory
+	70,  // 68: thesis.ListTopicStatusHistoryResponse.history:type_name -> thesis.TopicStatusHistory
+	6,   // 69: thesis.ProposeTopicRequest.stage:type_name -> thesis.TopicStage
+	233, // 70: thesis.ProposeTopicRequest.time_start:type_name -> google.protobuf.Timestamp
+	233, // 71: thesis.ProposeTopicRequest.time_end:type_name -> google.protobuf.Timestamp
+	57,  // 72: thesis.ProposeTopicResponse.topic:type_name -> thesis.Topic
+	85,  // 73: thesis.ProposeTopicResponse.co_signs:type_name -> thesis.TopicCoSign
+	3,   // 74: thesis.TopicCoSign.status:type_name -> thesis.CoSignStatus
+	233, // 75: thesis.TopicCoSign.created_at:type_name -> google.protobuf.Timestamp
+	233, // 76: thesis.TopicCoSign.updated_at:type_name -> google.protobuf.Timestamp
+	85,  // 77: thesis.CoSignTopicResponse.co_sign:type_name -> thesis.TopicCoSign
+	85,  // 78: thesis.ListTopicCoSignsResponse.co_signs:type_name -> thesis.TopicCoSign
+	233, // 79: thesis.RegistrationWindow.opens_at:type_name -> google.protobuf.Timestamp
+	233, // 80: thesis.RegistrationWindow.closes_at:type_name -> google.protobuf.Timestamp
+	233, // 81: thesis.RegistrationWindow.created_at:type_name -> google.protobuf.Timestamp
+	233, // 82: thesis.RegistrationWindow.updated_at:type_name -> google.protobuf.Timestamp
+	233, // 83: thesis.SetRegistrationWindowRequest.opens_at:type_name -> google.protobuf.Timestamp
+	233, // 84: thesis.SetRegistrationWindowRequest.closes_at:type_name -> google.protobuf.Timestamp
+	90,  // 85: thesis.SetRegistrationWindowResponse.window:type_name -> thesis.RegistrationWindow
+	90,  // 86: thesis.GetRegistrationWindowResponse.window:type_name -> thesis.RegistrationWindow
+	4,   // 87: thesis.TopicRegistration.status:type_name -> thesis.RegistrationStatus
+	233, // 88: thesis.TopicRegistration.decided_at:type_name -> google.protobuf.Timestamp
+	233, // 89: thesis.TopicRegistration.created_at:type_name -> google.protobuf.Timestamp
+	233, // 90: thesis.TopicRegistration.updated_at:type_name -> google.protobuf.Timestamp
+	95,  // 91: thesis.RegisterTopicPreferencesResponse.registrations:type_name -> thesis.TopicRegistration
+	95,  // 92: thesis.ListTopicRegistrationsResponse.registrations:type_name -> thesis.TopicRegistration
+	95,  // 93: thesis.DecideTopicRegistrationResponse.registration:type_name -> thesis.TopicRegistration
+	40,  // 94: thesis.DecideTopicRegistrationResponse.enrollment:type_name -> thesis.Enrollment
+	5,   // 95: thesis.MatchRejection.reason:type_name -> thesis.MatchRejectionReason
+	105, // 96: thesis.UnmatchedStudent.rejections:type_name -> thesis.MatchRejection
+	104, // 97: thesis.TopicMatchingResult.assignments:type_name -> thesis.MatchAssignment
+	106, // 98: thesis.TopicMatchingResult.unmatched:type_name -> thesis.UnmatchedStudent
+	107, // 99: thesis.PreviewTopicMatchingResponse.result:type_name -> thesis.TopicMatchingResult
+	107, // 100: thesis.CommitTopicMatchingResponse.result:type_name -> thesis.TopicMatchingResult
+	6,   // 101: thesis.TopicCouncil.stage:type_name -> thesis.TopicStage
+	233, // 102: thesis.TopicCouncil.time_start:type_name -> google.protobuf.Timestamp
+	233, // 103: thesis.TopicCouncil.time_end:type_name -> google.protobuf.Timestamp
+	233, // 104: thesis.TopicCouncil.created_at:type_name -> google.protobuf.Timestamp
+	233, // 105: thesis.TopicCouncil.updated_at:type_name -> google.protobuf.Timestamp
+	233, // 106: thesis.TopicCouncil.deleted_at:type_name -> google.protobuf.Timestamp
+	6,   // 107: thesis.CreateTopicCouncilRequest.stage:type_name -> thesis.TopicStage
+	233, // 108: thesis.CreateTopicCouncilRequest.time_start:type_name -> google.protobuf.Timestamp
+	233, // 109: thesis.CreateTopicCouncilRequest.time_end:type_name -> google.protobuf.Timestamp
+	112, // 110: thesis.CreateTopicCouncilResponse.topic_council:type_name -> thesis.TopicCouncil
+	112, // 111: thesis.GetTopicCouncilResponse.topic_council:type_name -> thesis.TopicCouncil
+	6,   // 112: thesis.UpdateTopicCouncilRequest.stage:type_name -> thesis.TopicStage
+	233, // 113: thesis.UpdateTopicCouncilRequest.time_start:type_name -> google.protobuf.Timestamp
+	233, // 114: thesis.UpdateTopicCouncilRequest.time_end:type_name -> google.protobuf.Timestamp
+	112, // 115: thesis.UpdateTopicCouncilResponse.topic_council:type_name -> thesis.TopicCouncil
+	233, // 116: thesis.TopicCouncilSlot.time_start:type_name -> google.protobuf.Timestamp
+	233, // 117: thesis.TopicCouncilSlot.time_end:type_name -> google.protobuf.Timestamp
+	119, // 118: thesis.ScheduleTopicCouncilsRequest.topic_councils:type_name -> thesis.TopicCouncilSlot
+	112, // 119: thesis.ScheduleTopicCouncilsResponse.topic_councils:type_name -> thesis.TopicCouncil
+	112, // 120: thesis.RestoreTopicCouncilResponse.topic_council:type_name -> thesis.TopicCouncil
+	234, // 121: thesis.ListTopicCouncilsRequest.search:type_name -> common.SearchRequest
+	112, // 122: thesis.ListTopicCouncilsResponse.topic_councils:type_name -> thesis.TopicCouncil
+	233, // 123: thesis.TopicCouncilSupervisor.created_at:type_name -> google.protobuf.Timestamp
+	233, // 124: thesis.TopicCouncilSupervisor.updated_at:type_name -> google.protobuf.Timestamp
+	233, // 125: thesis.TopicCouncilSupervisor.deleted_at:type_name -> google.protobuf.Timestamp
+	128, // 126: thesis.CreateTopicCouncilSupervisorResponse.topic_council_supervisor:type_name -> thesis.TopicCouncilSupervisor
+	128, // 127: thesis.GetTopicCouncilSupervisorResponse.topic_council_supervisor:type_name -> thesis.TopicCouncilSupervisor
+	128, // 128: thesis.UpdateTopicCouncilSupervisorResponse.topic_council_supervisor:type_name -> thesis.TopicCouncilSupervisor
+	128, // 129: thesis.RestoreTopicCouncilSupervisorResponse.topic_council_supervisor:type_name -> thesis.TopicCouncilSupervisor
+	234, // 130: thesis.ListTopicCouncilSupervisorsRequest.search:type_name -> common.SearchRequest
+	128, // 131: thesis.ListTopicCouncilSupervisorsResponse.topic_council_supervisors:type_name -> thesis.TopicCouncilSupervisor
+	1,   // 132: thesis.GradeReview.status:type_name -> thesis.FinalStatus
+	233, // 133: thesis.GradeReview.completion_date:type_name -> google.protobuf.Timestamp
+	233, // 134: thesis.GradeReview.created_at:type_name -> google.protobuf.Timestamp
+	233, // 135: thesis.GradeReview.updated_at:type_name -> google.protobuf.Timestamp
+	233, // 136: thesis.GradeReview.deleted_at:type_name -> google.protobuf.Timestamp
+	1,   // 137: thesis.CreateGradeReviewRequest.status:type_name -> thesis.FinalStatus
+	233, // 138: thesis.CreateGradeReviewRequest.completion_date:type_name -> google.protobuf.Timestamp
+	141, // 139: thesis.CreateGradeReviewResponse.grade_review:type_name -> thesis.GradeReview
+	141, // 140: thesis.GetGradeReviewResponse.grade_review:type_name -> thesis.GradeReview
+	1,   // 141: thesis.UpdateGradeReviewRequest.status:type_name -> thesis.FinalStatus
+	233, // 142: thesis.UpdateGradeReviewRequest.completion_date:type_name -> google.protobuf.Timestamp
+	141, // 143: thesis.UpdateGradeReviewResponse.grade_review:type_name -> thesis.GradeReview
+	141, // 144: thesis.RestoreGradeReviewResponse.grade_review:type_name -> thesis.GradeReview
+	234, // 145: thesis.ListGradeReviewsRequest.search:type_name -> common.SearchRequest
+	141, // 146: thesis.ListGradeReviewsResponse.grade_reviews:type_name -> thesis.GradeReview
+	6,   // 147: thesis.SubmissionDeadline.stage:type_name -> thesis.TopicStage
+	7,   // 148: thesis.SubmissionDeadline.kind:type_name -> thesis.SubmissionKind
+	233, // 149: thesis.SubmissionDeadline.opens_at:type_name -> google.protobuf.Timestamp
+	233, // 150: thesis.SubmissionDeadline.due_at:type_name -> google.protobuf.Timestamp
+	233, // 151: thesis.SubmissionDeadline.created_at:type_name -> google.protobuf.Timestamp
+	233, // 152: thesis.SubmissionDeadline.updated_at:type_name -> google.protobuf.Timestamp
+	6,   // 153: thesis.SetSubmissionDeadlineRequest.stage:type_name -> thesis.TopicStage
+	7,   // 154: thesis.SetSubmissionDeadlineRequest.kind:type_name -> thesis.SubmissionKind
+	233, // 155: thesis.SetSubmissionDeadlineRequest.opens_at:type_name -> google.protobuf.Timestamp
+	233, // 156: thesis.SetSubmissionDeadlineRequest.due_at:type_name -> google.protobuf.Timestamp
+	154, // 157: thesis.SetSubmissionDeadlineResponse.deadline:type_name -> thesis.SubmissionDeadline
+	154, // 158: thesis.ListSubmissionDeadlinesResponse.deadlines:type_name -> thesis.SubmissionDeadline
+	6,   // 159: thesis.DeadlineExtension.stage:type_name -> thesis.TopicStage
+	7,   // 160: thesis.DeadlineExtension.kind:type_name -> thesis.SubmissionKind
+	233, // 161: thesis.DeadlineExtension.due_at:type_name -> google.protobuf.Timestamp
+	233, // 162: thesis.DeadlineExtension.created_at:type_name -> google.protobuf.Timestamp
+	233, // 163: thesis.DeadlineExtension.updated_at:type_name -> google.protobuf.Timestamp
+	7,   // 164: thesis.GrantDeadlineExtensionRequest.kind:type_name -> thesis.SubmissionKind
+	233, // 165: thesis.GrantDeadlineExtensionRequest.due_at:type_name -> google.protobuf.Timestamp
+	159, // 166: thesis.GrantDeadlineExtensionResponse.extension:type_name -> thesis.DeadlineExtension
+	7,   // 167: thesis.CheckSubmissionWindowRequest.kind:type_name -> thesis.SubmissionKind
+	6,   // 168: thesis.CheckSubmissionWindowResponse.stage:type_name -> thesis.TopicStage
+	233, // 169: thesis.CheckSubmissionWindowResponse.opens_at:type_name -> google.protobuf.Timestamp
+	233, // 170: thesis.CheckSubmissionWindowResponse.due_at:type_name -> google.protobuf.Timestamp
+	233, // 171: thesis.CheckSubmissionWindowResponse.closes_at:type_name -> google.protobuf.Timestamp
+	6,   // 172: thesis.GradingPolicy.stage:type_name -> thesis.TopicStage
+	8,   // 173: thesis.GradingPolicy.rounding:type_name -> thesis.GradeRounding
+	233, // 174: thesis.GradingPolicy.created_at:type_name -> google.protobuf.Timestamp
+	233, // 175: thesis.GradingPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	6,   // 176: thesis.SetGradingPolicyRequest.stage:type_name -> thesis.TopicStage
+	8,   // 177: thesis.SetGradingPolicyRequest.rounding:type_name -> thesis.GradeRounding
+	164, // 178: thesis.SetGradingPolicyResponse.policy:type_name -> thesis.GradingPolicy
+	164, // 179: thesis.ListGradingPoliciesResponse.policies:type_name -> thesis.GradingPolicy
+	171, // 180: thesis.ComputeFinalGradeRequest.council_scores:type_name -> thesis.CouncilMemberScore
+	173, // 181: thesis.FinalGradeBreakdown.components:type_name -> thesis.GradeComponent
+	1,   // 182: thesis.FinalGradeBreakdown.status:type_name -> thesis.FinalStatus
+	174, // 183: thesis.ComputeFinalGradeResponse.breakdown:type_name -> thesis.FinalGradeBreakdown
+	27,  // 184: thesis.ComputeFinalGradeResponse.final:type_name -> thesis.Final
+	233, // 185: thesis.SemesterGradeStatus.locked_at:type_name -> google.protobuf.Timestamp
+	233, // 186: thesis.SemesterGradeStatus.published_at:type_name -> google.protobuf.Timestamp
+	233, // 187: thesis.SemesterGradeStatus.appeal_deadline:type_name -> google.protobuf.Timestamp
+	176, // 188: thesis.LockSemesterGradesResponse.status:type_name -> thesis.SemesterGradeStatus
+	176, // 189: thesis.PublishSemesterGradesResponse.status:type_name -> thesis.SemesterGradeStatus
+	176, // 190: thesis.GetSemesterGradeStatusResponse.status:type_name -> thesis.SemesterGradeStatus
+	9,   // 191: thesis.GradeAmendment.target:type_name -> thesis.GradeAmendmentTarget
+	10,  // 192: thesis.GradeAmendment.status:type_name -> thesis.GradeAmendmentStatus
+	233, // 193: thesis.GradeAmendment.decided_at:type_name -> google.protobuf.Timestamp
+	233, // 194: thesis.GradeAmendment.created_at:type_name -> google.protobuf.Timestamp
+	233, // 195: thesis.GradeAmendment.updated_at:type_name -> google.protobuf.Timestamp
+	9,   // 196: thesis.RequestGradeAmendmentRequest.target:type_name -> thesis.GradeAmendmentTarget
+	183, // 197: thesis.RequestGradeAmendmentResponse.amendment:type_name -> thesis.GradeAmendment
+	171, // 198: thesis.DecideGradeAmendmentRequest.council_scores:type_name -> thesis.CouncilMemberScore
+	183, // 199: thesis.DecideGradeAmendmentResponse.amendment:type_name -> thesis.GradeAmendment
+	183, // 200: thesis.GetGradeAmendmentResponse.amendment:type_name -> thesis.GradeAmendment
+	10,  // 201: thesis.ListGradeAmendmentsRequest.status:type_name -> thesis.GradeAmendmentStatus
+	183, // 202: thesis.ListGradeAmendmentsResponse.amendments:type_name -> thesis.GradeAmendment
+	12,  // 203: thesis.GradeAppealEvent.status:type_name -> thesis.GradeAppealStatus
+	233, // 204: thesis.GradeAppealEvent.created_at:type_name -> google.protobuf.Timestamp
+	11,  // 205: thesis.GradeAppeal.component:type_name -> thesis.GradeAppealComponent
+	12,  // 206: thesis.GradeAppeal.status:type_name -> thesis.GradeAppealStatus
+	233, // 207: thesis.GradeAppeal.resolved_at:type_name -> google.protobuf.Timestamp
+	233, // 208: thesis.GradeAppeal.created_at:type_name -> google.protobuf.Timestamp
+	233, // 209: thesis.GradeAppeal.updated_at:type_name -> google.protobuf.Timestamp
+	192, // 210: thesis.GradeAppeal.history:type_name -> thesis.GradeAppealEvent
+	176, // 211: thesis.SetGradeAppealWindowResponse.status:type_name -> thesis.SemesterGradeStatus
+	11,  // 212: thesis.FileGradeAppealRequest.component:type_name -> thesis.GradeAppealComponent
+	193, // 213: thesis.FileGradeAppealResponse.appeal:type_name -> thesis.GradeAppeal
+	193, // 214: thesis.AssignGradeAppealResponse.appeal:type_name -> thesis.GradeAppeal
+	193, // 215: thesis.ResolveGradeAppealResponse.appeal:type_name -> thesis.GradeAppeal
+	193, // 216: thesis.RequestGradeAppealAmendmentResponse.appeal:type_name -> thesis.GradeAppeal
+	193, // 217: thesis.SettleGradeAppealAmendmentResponse.appeal:type_name -> thesis.GradeAppeal
+	193, // 218: thesis.GetGradeAppealResponse.appeal:type_name -> thesis.GradeAppeal
+	12,  // 219: thesis.ListGradeAppealsRequest.status:type_name -> thesis.GradeAppealStatus
+	193, // 220: thesis.ListGradeAppealsResponse.appeals:type_name -> thesis.GradeAppeal
+	6,   // 221: thesis.MidtermMilestone.stage:type_name -> thesis.TopicStage
+	233, // 222: thesis.MidtermMilestone.due_at:type_name -> google.protobuf.Timestamp
+	233, // 223: thesis.MidtermMilestone.created_at:type_name -> google.protobuf.Timestamp
+	233, // 224: thesis.MidtermMilestone.updated_at:type_name -> google.protobuf.Timestamp
+	6,   // 225: thesis.CreateMidtermMilestoneRequest.stage:type_name -> thesis.TopicStage
+	233, // 226: thesis.CreateMidtermMilestoneRequest.due_at:type_name -> google.protobuf.Timestamp
+	210, // 227: thesis.CreateMidtermMilestoneResponse.milestone:type_name -> thesis.MidtermMilestone
+	233, // 228: thesis.UpdateMidtermMilestoneRequest.due_at:type_name -> google.protobuf.Timestamp
+	210, // 229: thesis.UpdateMidtermMilestoneResponse.milestone:type_name -> thesis.MidtermMilestone
+	6,   // 230: thesis.ListMidtermMilestonesRequest.stage:type_name -> thesis.TopicStage
+	210, // 231: thesis.ListMidtermMilestonesResponse.milestones:type_name -> thesis.MidtermMilestone
+	13,  // 232: thesis.MilestoneCheckin.result:type_name -> thesis.MilestoneResult
+	233, // 233: thesis.MilestoneCheckin.reviewed_at:type_name -> google.protobuf.Timestamp
+	233, // 234: thesis.MilestoneCheckin.submitted_at:type_name -> google.protobuf.Timestamp
+	233, // 235: thesis.MilestoneCheckin.updated_at:type_name -> google.protobuf.Timestamp
+	219, // 236: thesis.SubmitMilestoneCheckinResponse.checkin:type_name -> thesis.MilestoneCheckin
+	14,  // 237: thesis.SubmitMilestoneCheckinResponse.midterm:type_name -> thesis.Midterm
+	219, // 238: thesis.ReviewMilestoneCheckinResponse.checkin:type_name -> thesis.MilestoneCheckin
+	14,  // 239: thesis.ReviewMilestoneCheckinResponse.midterm:type_name -> thesis.Midterm
+	219, // 240: thesis.GetMilestoneCheckinResponse.checkin:type_name -> thesis.MilestoneCheckin
+	219, // 241: thesis.ListMilestoneCheckinsResponse.checkins:type_name -> thesis.MilestoneCheckin
+	2,   // 242: thesis.SearchTopicsRequest.statuses:type_name -> thesis.TopicStatus
+	235, // 243: thesis.SearchTopicsResponse.hits:type_name -> common.SearchHit
+	231, // 244: thesis.ListCouncilTopicsResponse.topics:type_name -> thesis.CouncilTopicStaff
+	15,  // 245: thesis.ThesisService.CreateMidterm:input_type -> thesis.CreateMidtermRequest
+	17,  // 246: thesis.ThesisService.GetMidterm:input_type -> thesis.GetMidtermRequest
+	19,  // 247: thesis.ThesisService.UpdateMidterm:input_type -> thesis.UpdateMidtermRequest
+	21,  // 248: thesis.ThesisService.DeleteMidterm:input_type -> thesis.DeleteMidtermRequest
+	23,  // 249: thesis.ThesisService.RestoreMidterm:input_type -> thesis.RestoreMidtermRequest
+	25,  // 250: thesis.ThesisService.ListMidterms:input_type -> thesis.ListMidtermsRequest
+	211, // 251: thesis.ThesisService.CreateMidtermMilestone:input_type -> thesis.CreateMidtermMilestoneRequest
+	213, // 252: thesis.ThesisService.UpdateMidtermMilestone:input_type -> thesis.UpdateMidtermMilestoneRequest
+	215, // 253: thesis.ThesisService.DeleteMidtermMilestone:input_type -> thesis.DeleteMidtermMilestoneRequest
+	217, // 254: thesis.ThesisService.ListMidtermMilestones:input_type -> thesis.ListMidtermMilestonesRequest
+	220, // 255: thesis.ThesisService.SubmitMilestoneCheckin:input_type -> thesis.SubmitMilestoneCheckinRequest
+	222, // 256: thesis.ThesisService.ReviewMilestoneCheckin:input_type -> thesis.ReviewMilestoneCheckinRequest
+	224, // 257: thesis.ThesisService.GetMilestoneCheckin:input_type -> thesis.GetMilestoneCheckinRequest
+	226, // 258: thesis.ThesisService.ListMilestoneCheckins:input_type -> thesis.ListMilestoneCheckinsRequest
+	28,  // 259: thesis.ThesisService.CreateFinal:input_type -> thesis.CreateFinalRequest
+	30,  // 260: thesis.ThesisService.GetFinal:input_type -> thesis.GetFinalRequest
+	32,  // 261: thesis.ThesisService.UpdateFinal:input_type -> thesis.UpdateFinalRequest
+	34,  // 262: thesis.ThesisService.DeleteFinal:input_type -> thesis.DeleteFinalRequest
+	36,  // 263: thesis.ThesisService.RestoreFinal:input_type -> thesis.RestoreFinalRequest
+	38,  // 264: thesis.ThesisService.ListFinals:input_type -> thesis.ListFinalsRequest
+	41,  // 265: thesis.ThesisService.CreateEnrollment:input_type -> thesis.CreateEnrollmentRequest
+	43,  // 266: thesis.ThesisService.GetEnrollment:input_type -> thesis.GetEnrollmentRequest
+	45,  // 267: thesis.ThesisService.UpdateEnrollment:input_type -> thesis.UpdateEnrollmentRequest
+	47,  // 268: thesis.ThesisService.DeleteEnrollment:input_type -> thesis.DeleteEnrollmentRequest
+	49,  // 269: thesis.ThesisService.RestoreEnrollment:input_type -> thesis.RestoreEnrollmentRequest
+	51,  // 270: thesis.ThesisService.ListEnrollments:input_type -> thesis.ListEnrollmentsRequest
+	53,  // 271: thesis.ThesisService.CreateEnrollmentBundle:input_type -> thesis.CreateEnrollmentBundleRequest
+	55,  // 272: thesis.ThesisService.DeleteEnrollmentCascade:input_type -> thesis.DeleteEnrollmentCascadeRequest
+	58,  // 273: thesis.ThesisService.CreateTopic:input_type -> thesis.CreateTopicRequest
+	60,  // 274: thesis.ThesisService.GetTopic:input_type -> thesis.GetTopicRequest
+	62,  // 275: thesis.ThesisService.UpdateTopic:input_type -> thesis.UpdateTopicRequest
+	64,  // 276: thesis.ThesisService.DeleteTopic:input_type -> thesis.DeleteTopicRequest
+	66,  // 277: thesis.ThesisService.RestoreTopic:input_type -> thesis.RestoreTopicRequest
+	68,  // 278: thesis.ThesisService.ListTopics:input_type -> thesis.ListTopicsRequest
+	228, // 279: thesis.ThesisService.SearchTopics:input_type -> thesis.SearchTopicsRequest
+	71,  // 280: thesis.ThesisService.SubmitTopic:input_type -> thesis.SubmitTopicRequest
+	73,  // 281: thesis.ThesisService.ApproveTopic:input_type -> thesis.ApproveTopicRequest
+	75,  // 282: thesis.ThesisService.RejectTopic:input_type -> thesis.RejectTopicRequest
+	77,  // 283: thesis.ThesisService.StartTopic:input_type -> thesis.StartTopicRequest
+	79,  // 284: thesis.ThesisService.CompleteTopic:input_type -> thesis.CompleteTopicRequest
+	81,  // 285: thesis.ThesisService.ListTopicStatusHistory:input_type -> thesis.ListTopicStatusHistoryRequest
+	83,  // 286: thesis.ThesisService.ProposeTopic:input_type -> thesis.ProposeTopicRequest
+	86,  // 287: thesis.ThesisService.CoSignTopic:input_type -> thesis.CoSignTopicRequest
+	88,  // 288: thesis.ThesisService.ListTopicCoSigns:input_type -> thesis.ListTopicCoSignsRequest
+	91,  // 289: thesis.ThesisService.SetRegistrationWindow:input_type -> thesis.SetRegistrationWindowRequest
+	93,  // 290: thesis.ThesisService.GetRegistrationWindow:input_type -> thesis.GetRegistrationWindowRequest
+	96,  // 291: thesis.ThesisService.RegisterTopicPreferences:input_type -> thesis.RegisterTopicPreferencesRequest
+	98,  // 292: thesis.ThesisService.ListTopicRegistrations:input_type -> thesis.ListTopicRegistrationsRequest
+	100, // 293: thesis.ThesisService.DecideTopicRegistration:input_type -> thesis.DecideTopicRegistrationRequest
+	102, // 294: thesis.ThesisService.SetApplicantRanking:input_type -> thesis.SetApplicantRankingRequest
+	108, // 295: thesis.ThesisService.PreviewTopicMatching:input_type -> thesis.PreviewTopicMatchingRequest
+	110, // 296: thesis.ThesisService.CommitTopicMatching:input_type -> thesis.CommitTopicMatchingRequest
+	113, // 297: thesis.ThesisService.CreateTopicCouncil:input_type -> thesis.CreateTopicCouncilRequest
+	115, // 298: thesis.ThesisService.GetTopicCouncil:input_type -> thesis.GetTopicCouncilRequest
+	117, // 299: thesis.ThesisService.UpdateTopicCouncil:input_type -> thesis.UpdateTopicCouncilRequest
+	120, // 300: thesis.ThesisService.ScheduleTopicCouncils:input_type -> thesis.ScheduleTopicCouncilsRequest
+	122, // 301: thesis.ThesisService.DeleteTopicCouncil:input_type -> thesis.DeleteTopicCouncilRequest
+	124, // 302: thesis.ThesisService.RestoreTopicCouncil:input_type -> thesis.RestoreTopicCouncilRequest
+	126, // 303: thesis.ThesisService.ListTopicCouncils:input_type -> thesis.ListTopicCouncilsRequest
+	129, // 304: thesis.ThesisService.CreateTopicCouncilSupervisor:input_type -> thesis.CreateTopicCouncilSupervisorRequest
+	131, // 305: thesis.ThesisService.GetTopicCouncilSupervisor:input_type -> thesis.GetTopicCouncilSupervisorRequest
+	133, // 306: thesis.ThesisService.UpdateTopicCouncilSupervisor:input_type -> thesis.UpdateTopicCouncilSupervisorRequest
+	135, // 307: thesis.ThesisService.DeleteTopicCouncilSupervisor:input_type -> thesis.DeleteTopicCouncilSupervisorRequest
+	137, // 308: thesis.ThesisService.RestoreTopicCouncilSupervisor:input_type -> thesis.RestoreTopicCouncilSupervisorRequest
+	139, // 309: thesis.ThesisService.ListTopicCouncilSupervisors:input_type -> thesis.ListTopicCouncilSupervisorsRequest
+	142, // 310: thesis.ThesisService.CreateGradeReview:input_type -> thesis.CreateGradeReviewRequest
+	144, // 311: thesis.ThesisService.GetGradeReview:input_type -> thesis.GetGradeReviewRequest
+	146, // 312: thesis.ThesisService.UpdateGradeReview:input_type -> thesis.UpdateGradeReviewRequest
+	148, // 313: thesis.ThesisService.DeleteGradeReview:input_type -> thesis.DeleteGradeReviewRequest
+	150, // 314: thesis.ThesisService.RestoreGradeReview:input_type -> thesis.RestoreGradeReviewRequest
+	152, // 315: thesis.ThesisService.ListGradeReviews:input_type -> thesis.ListGradeReviewsRequest
+	155, // 316: thesis.ThesisService.SetSubmissionDeadline:input_type -> thesis.SetSubmissionDeadlineRequest
+	157, // 317: thesis.ThesisService.ListSubmissionDeadlines:input_type -> thesis.ListSubmissionDeadlinesRequest
+	160, // 318: thesis.ThesisService.GrantDeadlineExtension:input_type -> thesis.GrantDeadlineExtensionRequest
+	162, // 319: thesis.ThesisService.CheckSubmissionWindow:input_type -> thesis.CheckSubmissionWindowRequest
+	165, // 320: thesis.ThesisService.SetGradingPolicy:input_type -> thesis.SetGradingPolicyRequest
+	167, // 321: thesis.ThesisService.ListGradingPolicies:input_type -> thesis.ListGradingPoliciesRequest
+	169, // 322: thesis.ThesisService.DeleteGradingPolicy:input_type -> thesis.DeleteGradingPolicyRequest
+	172, // 323: thesis.ThesisService.ComputeFinalGrade:input_type -> thesis.ComputeFinalGradeRequest
+	177, // 324: thesis.ThesisService.LockSemesterGrades:input_type -> thesis.LockSemesterGradesRequest
+	179, // 325: thesis.ThesisService.PublishSemesterGrades:input_type -> thesis.PublishSemesterGradesRequest
+	181, // 326: thesis.ThesisService.GetSemesterGradeStatus:input_type -> thesis.GetSemesterGradeStatusRequest
+	184, // 327: thesis.ThesisService.RequestGradeAmendment:input_type -> thesis.RequestGradeAmendmentRequest
+	186, // 328: thesis.ThesisService.DecideGradeAmendment:input_type -> thesis.DecideGradeAmendmentRequest
+	188, // 329: thesis.ThesisService.GetGradeAmendment:input_type -> thesis.GetGradeAmendmentRequest
+	190, // 330: thesis.ThesisService.ListGradeAmendments:input_type -> thesis.ListGradeAmendmentsRequest
+	194, // 331: thesis.ThesisService.SetGradeAppealWindow:input_type -> thesis.SetGradeAppealWindowRequest
+	196, // 332: thesis.ThesisService.FileGradeAppeal:input_type -> thesis.FileGradeAppealRequest
+	198, // 333: thesis.ThesisService.AssignGradeAppeal:input_type -> thesis.AssignGradeAppealRequest
+	200, // 334: thesis.ThesisService.ResolveGradeAppeal:input_type -> thesis.ResolveGradeAppealRequest
+	202, // 335: thesis.ThesisService.RequestGradeAppealAmendment:input_type -> thesis.RequestGradeAppealAmendmentRequest
+	204, // 336: thesis.ThesisService.SettleGradeAppealAmendment:input_type -> thesis.SettleGradeAppealAmendmentRequest
+	206, // 337: thesis.ThesisService.GetGradeAppeal:input_type -> thesis.GetGradeAppealRequest
+	208, // 338: thesis.ThesisService.ListGradeAppeals:input_type -> thesis.ListGradeAppealsRequest
+	230, // 339: thesis.ThesisService.ListCouncilTopics:input_type -> thesis.ListCouncilTopicsRequest
+	236, // 340: thesis.ThesisService.CheckReferences:input_type -> common.ReferenceCheckRequest
+	16,  // 341: thesis.ThesisService.CreateMidterm:output_type -> thesis.CreateMidtermResponse
+	18,  // 342: thesis.ThesisService.GetMidterm:output_type -> thesis.GetMidtermResponse
+	20,  // 343: thesis.ThesisService.UpdateMidterm:output_type -> thesis.UpdateMidtermResponse
+	22,  // 344: thesis.ThesisService.DeleteMidterm:output_type -> thesis.DeleteMidtermResponse
+	24,  // 345: thesis.ThesisService.RestoreMidterm:output_type -> thesis.RestoreMidtermResponse
+	26,  // 346: thesis.ThesisService.ListMidterms:output_type -> thesis.ListMidtermsResponse
+	212, // 347: thesis.ThesisService.CreateMidtermMilestone:output_type -> thesis.CreateMidtermMilestoneResponse
+	214, // 348: thesis.ThesisService.UpdateMidtermMilestone:output_type -> thesis.UpdateMidtermMilestoneResponse
+	216, // 349: thesis.ThesisService.DeleteMidtermMilestone:output_type -> thesis.DeleteMidtermMilestoneResponse
+	218, // 350: thesis.ThesisService.ListMidtermMilestones:output_type -> thesis.ListMidtermMilestonesResponse
+	221, // 351: thesis.ThesisService.SubmitMilestoneCheckin:output_type -> thesis.SubmitMilestoneCheckinResponse
+	223, // 352: thesis.ThesisService.ReviewMilestoneCheckin:output_type -> thesis.ReviewMilestoneCheckinResponse
+	225, // 353: thesis.ThesisService.GetMilestoneCheckin:output_type -> thesis.GetMilestoneCheckinResponse
+	227, // 354: thesis.ThesisService.ListMilestoneCheckins:output_type -> thesis.ListMilestoneCheckinsResponse
+	29,  // 355: thesis.ThesisService.CreateFinal:output_type -> thesis.CreateFinalResponse
+	31,  // 356: thesis.ThesisService.GetFinal:output_type -> thesis.GetFinalResponse
+	33,  // 357: thesis.ThesisService.UpdateFinal:output_type -> thesis.UpdateFinalResponse
+	35,  // 358: thesis.ThesisService.DeleteFinal:output_type -> thesis.DeleteFinalResponse
+	37,  // 359: thesis.ThesisService.RestoreFinal:output_type -> thesis.RestoreFinalResponse
+	39,  // 360: thesis.ThesisService.ListFinals:output_type -> thesis.ListFinalsResponse
+	42,  // 361: thesis.ThesisService.CreateEnrollment:output_type -> thesis.CreateEnrollmentResponse
+	44,  // 362: thesis.ThesisService.GetEnrollment:output_type -> thesis.GetEnrollmentResponse
+	46,  // 363: thesis.ThesisService.UpdateEnrollment:output_type -> thesis.UpdateEnrollmentResponse
+	48,  // 364: thesis.ThesisService.DeleteEnrollment:output_type -> thesis.DeleteEnrollmentResponse
+	50,  // 365: thesis.ThesisService.RestoreEnrollment:output_type -> thesis.RestoreEnrollmentResponse
+	52,  // 366: thesis.ThesisService.ListEnrollments:output_type -> thesis.ListEnrollmentsResponse
+	54,  // 367: thesis.ThesisService.CreateEnrollmentBundle:output_type -> thesis.CreateEnrollmentBundleResponse
+	56,  // 368: thesis.ThesisService.DeleteEnrollmentCascade:output_type -> thesis.DeleteEnrollmentCascadeResponse
+	59,  // 369: thesis.ThesisService.CreateTopic:output_type -> thesis.CreateTopicResponse
+	61,  // 370: thesis.ThesisService.GetTopic:output_type -> thesis.GetTopicResponse
+	63,  // 371: thesis.ThesisService.UpdateTopic:output_type -> thesis.UpdateTopicResponse
+	65,  // 372: thesis.ThesisService.DeleteTopic:output_type -> thesis.DeleteTopicResponse
+	67,  // 373: thesis.ThesisService.RestoreTopic:output_type -> thesis.RestoreTopicResponse
+	69,  // 374: thesis.ThesisService.ListTopics:output_type -> thesis.ListTopicsResponse
+	229, // 375: thesis.ThesisService.SearchTopics:output_type -> thesis.SearchTopicsResponse
+	72,  // 376: thesis.ThesisService.SubmitTopic:output_type -> thesis.SubmitTopicResponse
+	74,  // 377: thesis.ThesisService.ApproveTopic:output_type -> thesis.ApproveTopicResponse
+	76,  // 378: thesis.ThesisService.RejectTopic:output_type -> thesis.RejectTopicResponse
+	78,  // 379: thesis.ThesisService.StartTopic:output_type -> thesis.StartTopicResponse
+	80,  // 380: thesis.ThesisService.CompleteTopic:output_type -> thesis.CompleteTopicResponse
+	82,  // 381: thesis.ThesisService.ListTopicStatusHistory:output_type -> thesis.ListTopicStatusHistoryResponse
+	84,  // 382: thesis.ThesisService.ProposeTopic:output_type -> thesis.ProposeTopicResponse
+	87,  // 383: thesis.ThesisService.CoSignTopic:output_type -> thesis.CoSignTopicResponse
+	89,  // 384: thesis.ThesisService.ListTopicCoSigns:output_type -> thesis.ListTopicCoSignsResponse
+	92,  // 385: thesis.ThesisService.SetRegistrationWindow:output_type -> thesis.SetRegistrationWindowResponse
+	94,  // 386: thesis.ThesisService.GetRegistrationWindow:output_type -> thesis.GetRegistrationWindowResponse
+	97,  // 387: thesis.ThesisService.RegisterTopicPreferences:output_type -> thesis.RegisterTopicPreferencesResponse
+	99,  // 388: thesis.ThesisService.ListTopicRegistrations:output_type -> thesis.ListTopicRegistrationsResponse
+	101, // 389: thesis.ThesisService.DecideTopicRegistration:output_type -> thesis.DecideTopicRegistrationResponse
+	103, // 390: thesis.ThesisService.SetApplicantRanking:output_type -> thesis.SetApplicantRankingResponse
+	109, // 391: thesis.ThesisService.PreviewTopicMatching:output_type -> thesis.PreviewTopicMatchingResponse
+	111, // 392: thesis.ThesisService.CommitTopicMatching:output_type -> thesis.CommitTopicMatchingResponse
+	114, // 393: thesis.ThesisService.CreateTopicCouncil:output_type -> thesis.CreateTopicCouncilResponse
+	116, // 394: thesis.ThesisService.GetTopicCouncil:output_type -> thesis.GetTopicCouncilResponse
+	118, // 395: thesis.ThesisService.UpdateTopicCouncil:output_type -> thesis.UpdateTopicCouncilResponse
+	121, // 396: thesis.ThesisService.ScheduleTopicCouncils:output_type -> thesis.ScheduleTopicCouncilsResponse
+	123, // 397: thesis.ThesisService.DeleteTopicCouncil:output_type -> thesis.DeleteTopicCouncilResponse
+	125, // 398: thesis.ThesisService.RestoreTopicCouncil:output_type -> thesis.RestoreTopicCouncilResponse
+	127, // 399: thesis.ThesisService.ListTopicCouncils:output_type -> thesis.ListTopicCouncilsResponse
+	130, // 400: thesis.ThesisService.CreateTopicCouncilSupervisor:output_type -> thesis.CreateTopicCouncilSupervisorResponse
+	132, // 401: thesis.ThesisService.GetTopicCouncilSupervisor:output_type -> thesis.GetTopicCouncilSupervisorResponse
+	134, // 402: thesis.ThesisService.UpdateTopicCouncilSupervisor:output_type -> thesis.UpdateTopicCouncilSupervisorResponse
+	136, // 403: thesis.ThesisService.DeleteTopicCouncilSupervisor:output_type -> thesis.DeleteTopicCouncilSupervisorResponse
+	138, // 404: thesis.ThesisService.RestoreTopicCouncilSupervisor:output_type -> thesis.RestoreTopicCouncilSupervisorResponse
+	140, // 405: thesis.ThesisService.ListTopicCouncilSupervisors:output_type -> thesis.ListTopicCouncilSupervisorsResponse
+	143, // 406: thesis.ThesisService.CreateGradeReview:output_type -> thesis.CreateGradeReviewResponse
+	145, // 407: thesis.ThesisService.GetGradeReview:output_type -> thesis.GetGradeReviewResponse
+	147, // 408: thesis.ThesisService.UpdateGradeReview:output_type -> thesis.UpdateGradeReviewResponse
+	149, // 409: thesis.ThesisService.DeleteGradeReview:output_type -> thesis.DeleteGradeReviewResponse
+	151, // 410: thesis.ThesisService.RestoreGradeReview:output_type -> thesis.RestoreGradeReviewResponse
+	153, // 411: thesis.ThesisService.ListGradeReviews:output_type -> thesis.ListGradeReviewsResponse
+	156, // 412: thesis.ThesisService.SetSubmissionDeadline:output_type -> thesis.SetSubmissionDeadlineResponse
+	158, // 413: thesis.ThesisService.ListSubmissionDeadlines:output_type -> thesis.ListSubmissionDeadlinesResponse
+	161, // 414: thesis.ThesisService.GrantDeadlineExtension:output_type -> thesis.GrantDeadlineExtensionResponse
+	163, // 415: thesis.ThesisService.CheckSubmissionWindow:output_type -> thesis.CheckSubmissionWindowResponse
+	166, // 416: thesis.ThesisService.SetGradingPolicy:output_type -> thesis.SetGradingPolicyResponse
+	168, // 417: thesis.ThesisService.ListGradingPolicies:output_type -> thesis.ListGradingPoliciesResponse
+	170, // 418: thesis.ThesisService.DeleteGradingPolicy:output_type -> thesis.DeleteGradingPolicyResponse
+	175, // 419: thesis.ThesisService.ComputeFinalGrade:output_type -> thesis.ComputeFinalGradeResponse
+	178, // 420: thesis.ThesisService.LockSemesterGrades:output_type -> thesis.LockSemesterGradesResponse
+	180, // 421: thesis.ThesisService.PublishSemesterGrades:output_type -> thesis.PublishSemesterGradesResponse
+	182, // 422: thesis.ThesisService.GetSemesterGradeStatus:output_type -> thesis.GetSemesterGradeStatusResponse
+	185, // 423: thesis.ThesisService.RequestGradeAmendment:output_type -> thesis.RequestGradeAmendmentResponse
+	187, // 424: thesis.ThesisService.DecideGradeAmendment:output_type -> thesis.DecideGradeAmendmentResponse
+	189, // 425: thesis.ThesisService.GetGradeAmendment:output_type -> thesis.GetGradeAmendmentResponse
+	191, // 426: thesis.ThesisService.ListGradeAmendments:output_type -> thesis.ListGradeAmendmentsResponse
+	195, // 427: thesis.ThesisService.SetGradeAppealWindow:output_type -> thesis.SetGradeAppealWindowResponse
+	197, // 428: thesis.ThesisService.FileGradeAppeal:output_type -> thesis.FileGradeAppealResponse
+	199, // 429: thesis.ThesisService.AssignGradeAppeal:output_type -> thesis.AssignGradeAppealResponse
+	201, // 430: thesis.ThesisService.ResolveGradeAppeal:output_type -> thesis.ResolveGradeAppealResponse
+	203, // 431: thesis.ThesisService.RequestGradeAppealAmendment:output_type -> thesis.RequestGradeAppealAmendmentResponse
+	205, // 432: thesis.ThesisService.SettleGradeAppealAmendment:output_type -> thesis.SettleGradeAppealAmendmentResponse
+	207, // 433: thesis.ThesisService.GetGradeAppeal:output_type -> thesis.GetGradeAppealResponse
+	209, // 434: thesis.ThesisService.ListGradeAppeals:output_type -> thesis.ListGradeAppealsResponse
+	232, // 435: thesis.ThesisService.ListCouncilTopics:output_type -> thesis.ListCouncilTopicsResponse
+	237, // 436: thesis.ThesisService.CheckReferences:output_type -> common.ReferenceCheckResponse
+	341, // [341:437] is the sub-list for method output_type
+	245, // [245:341] is the sub-list for method input_type
+	245, // [245:245] is the sub-list for extension type_name
+	245, // [245:245] is the sub-list for extension extendee
+	0,   // [0:245] is the sub-list for field type_name
 }
 
 func init() { file_proto_thesis_thesis_proto_init() }
@@ -5753,28 +16877,45 @@ func file_proto_thesis_thesis_proto_init() {
 	}
 	file_proto_thesis_thesis_proto_msgTypes[1].OneofWrappers = []any{}
 	file_proto_thesis_thesis_proto_msgTypes[5].OneofWrappers = []any{}
-	file_proto_thesis_thesis_proto_msgTypes[12].OneofWrappers = []any{}
-	file_proto_thesis_thesis_proto_msgTypes[16].OneofWrappers = []any{}
-	file_proto_thesis_thesis_proto_msgTypes[22].OneofWrappers = []any{}
-	file_proto_thesis_thesis_proto_msgTypes[23].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[13].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[14].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[18].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[26].OneofWrappers = []any{}
 	file_proto_thesis_thesis_proto_msgTypes[27].OneofWrappers = []any{}
-	file_proto_thesis_thesis_proto_msgTypes[33].OneofWrappers = []any{}
-	file_proto_thesis_thesis_proto_msgTypes[34].OneofWrappers = []any{}
-	file_proto_thesis_thesis_proto_msgTypes[38].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[31].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[40].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[42].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[43].OneofWrappers = []any{}
 	file_proto_thesis_thesis_proto_msgTypes[44].OneofWrappers = []any{}
-	file_proto_thesis_thesis_proto_msgTypes[45].OneofWrappers = []any{}
-	file_proto_thesis_thesis_proto_msgTypes[49].OneofWrappers = []any{}
-	file_proto_thesis_thesis_proto_msgTypes[60].OneofWrappers = []any{}
-	file_proto_thesis_thesis_proto_msgTypes[66].OneofWrappers = []any{}
-	file_proto_thesis_thesis_proto_msgTypes[67].OneofWrappers = []any{}
-	file_proto_thesis_thesis_proto_msgTypes[71].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[48].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[56].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[98].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[99].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[103].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[105].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[119].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[127].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[128].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[132].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[157].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[159].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[160].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[169].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[176].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[182].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[188].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[194].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[199].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[203].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[206].OneofWrappers = []any{}
+	file_proto_thesis_thesis_proto_msgTypes[214].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_thesis_thesis_proto_rawDesc), len(file_proto_thesis_thesis_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   77,
+			NumEnums:      14,
+			NumMessages:   219,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	common "thaily/proto/common"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,41 +20,102 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ThesisService_CreateMidterm_FullMethodName                = "/thesis.ThesisService/CreateMidterm"
-	ThesisService_GetMidterm_FullMethodName                   = "/thesis.ThesisService/GetMidterm"
-	ThesisService_UpdateMidterm_FullMethodName                = "/thesis.ThesisService/UpdateMidterm"
-	ThesisService_DeleteMidterm_FullMethodName                = "/thesis.ThesisService/DeleteMidterm"
-	ThesisService_ListMidterms_FullMethodName                 = "/thesis.ThesisService/ListMidterms"
-	ThesisService_CreateFinal_FullMethodName                  = "/thesis.ThesisService/CreateFinal"
-	ThesisService_GetFinal_FullMethodName                     = "/thesis.ThesisService/GetFinal"
-	ThesisService_UpdateFinal_FullMethodName                  = "/thesis.ThesisService/UpdateFinal"
-	ThesisService_DeleteFinal_FullMethodName                  = "/thesis.ThesisService/DeleteFinal"
-	ThesisService_ListFinals_FullMethodName                   = "/thesis.ThesisService/ListFinals"
-	ThesisService_CreateEnrollment_FullMethodName             = "/thesis.ThesisService/CreateEnrollment"
-	ThesisService_GetEnrollment_FullMethodName                = "/thesis.ThesisService/GetEnrollment"
-	ThesisService_UpdateEnrollment_FullMethodName             = "/thesis.ThesisService/UpdateEnrollment"
-	ThesisService_DeleteEnrollment_FullMethodName             = "/thesis.ThesisService/DeleteEnrollment"
-	ThesisService_ListEnrollments_FullMethodName              = "/thesis.ThesisService/ListEnrollments"
-	ThesisService_CreateTopic_FullMethodName                  = "/thesis.ThesisService/CreateTopic"
-	ThesisService_GetTopic_FullMethodName                     = "/thesis.ThesisService/GetTopic"
-	ThesisService_UpdateTopic_FullMethodName                  = "/thesis.ThesisService/UpdateTopic"
-	ThesisService_DeleteTopic_FullMethodName                  = "/thesis.ThesisService/DeleteTopic"
-	ThesisService_ListTopics_FullMethodName                   = "/thesis.ThesisService/ListTopics"
-	ThesisService_CreateTopicCouncil_FullMethodName           = "/thesis.ThesisService/CreateTopicCouncil"
-	ThesisService_GetTopicCouncil_FullMethodName              = "/thesis.ThesisService/GetTopicCouncil"
-	ThesisService_UpdateTopicCouncil_FullMethodName           = "/thesis.ThesisService/UpdateTopicCouncil"
-	ThesisService_DeleteTopicCouncil_FullMethodName           = "/thesis.ThesisService/DeleteTopicCouncil"
-	ThesisService_ListTopicCouncils_FullMethodName            = "/thesis.ThesisService/ListTopicCouncils"
-	ThesisService_CreateTopicCouncilSupervisor_FullMethodName = "/thesis.ThesisService/CreateTopicCouncilSupervisor"
-	ThesisService_GetTopicCouncilSupervisor_FullMethodName    = "/thesis.ThesisService/GetTopicCouncilSupervisor"
-	ThesisService_UpdateTopicCouncilSupervisor_FullMethodName = "/thesis.ThesisService/UpdateTopicCouncilSupervisor"
-	ThesisService_DeleteTopicCouncilSupervisor_FullMethodName = "/thesis.ThesisService/DeleteTopicCouncilSupervisor"
-	ThesisService_ListTopicCouncilSupervisors_FullMethodName  = "/thesis.ThesisService/ListTopicCouncilSupervisors"
-	ThesisService_CreateGradeReview_FullMethodName            = "/thesis.ThesisService/CreateGradeReview"
-	ThesisService_GetGradeReview_FullMethodName               = "/thesis.ThesisService/GetGradeReview"
-	ThesisService_UpdateGradeReview_FullMethodName            = "/thesis.ThesisService/UpdateGradeReview"
-	ThesisService_DeleteGradeReview_FullMethodName            = "/thesis.ThesisService/DeleteGradeReview"
-	ThesisService_ListGradeReviews_FullMethodName             = "/thesis.ThesisService/ListGradeReviews"
+	ThesisService_CreateMidterm_FullMethodName                 = "/thesis.ThesisService/CreateMidterm"
+	ThesisService_GetMidterm_FullMethodName                    = "/thesis.ThesisService/GetMidterm"
+	ThesisService_UpdateMidterm_FullMethodName                 = "/thesis.ThesisService/UpdateMidterm"
+	ThesisService_DeleteMidterm_FullMethodName                 = "/thesis.ThesisService/DeleteMidterm"
+	ThesisService_RestoreMidterm_FullMethodName                = "/thesis.ThesisService/RestoreMidterm"
+	ThesisService_ListMidterms_FullMethodName                  = "/thesis.ThesisService/ListMidterms"
+	ThesisService_CreateMidtermMilestone_FullMethodName        = "/thesis.ThesisService/CreateMidtermMilestone"
+	ThesisService_UpdateMidtermMilestone_FullMethodName        = "/thesis.ThesisService/UpdateMidtermMilestone"
+	ThesisService_DeleteMidtermMilestone_FullMethodName        = "/thesis.ThesisService/DeleteMidtermMilestone"
+	ThesisService_ListMidtermMilestones_FullMethodName         = "/thesis.ThesisService/ListMidtermMilestones"
+	ThesisService_SubmitMilestoneCheckin_FullMethodName        = "/thesis.ThesisService/SubmitMilestoneCheckin"
+	ThesisService_ReviewMilestoneCheckin_FullMethodName        = "/thesis.ThesisService/ReviewMilestoneCheckin"
+	ThesisService_GetMilestoneCheckin_FullMethodName           = "/thesis.ThesisService/GetMilestoneCheckin"
+	ThesisService_ListMilestoneCheckins_FullMethodName         = "/thesis.ThesisService/ListMilestoneCheckins"
+	ThesisService_CreateFinal_FullMethodName                   = "/thesis.ThesisService/CreateFinal"
+	ThesisService_GetFinal_FullMethodName                      = "/thesis.ThesisService/GetFinal"
+	ThesisService_UpdateFinal_FullMethodName                   = "/thesis.ThesisService/UpdateFinal"
+	ThesisService_DeleteFinal_FullMethodName                   = "/thesis.ThesisService/DeleteFinal"
+	ThesisService_RestoreFinal_FullMethodName                  = "/thesis.ThesisService/RestoreFinal"
+	ThesisService_ListFinals_FullMethodName                    = "/thesis.ThesisService/ListFinals"
+	ThesisService_CreateEnrollment_FullMethodName              = "/thesis.ThesisService/CreateEnrollment"
+	ThesisService_GetEnrollment_FullMethodName                 = "/thesis.ThesisService/GetEnrollment"
+	ThesisService_UpdateEnrollment_FullMethodName              = "/thesis.ThesisService/UpdateEnrollment"
+	ThesisService_DeleteEnrollment_FullMethodName              = "/thesis.ThesisService/DeleteEnrollment"
+	ThesisService_RestoreEnrollment_FullMethodName             = "/thesis.ThesisService/RestoreEnrollment"
+	ThesisService_ListEnrollments_FullMethodName               = "/thesis.ThesisService/ListEnrollments"
+	ThesisService_CreateEnrollmentBundle_FullMethodName        = "/thesis.ThesisService/CreateEnrollmentBundle"
+	ThesisService_DeleteEnrollmentCascade_FullMethodName       = "/thesis.ThesisService/DeleteEnrollmentCascade"
+	ThesisService_CreateTopic_FullMethodName                   = "/thesis.ThesisService/CreateTopic"
+	ThesisService_GetTopic_FullMethodName                      = "/thesis.ThesisService/GetTopic"
+	ThesisService_UpdateTopic_FullMethodName                   = "/thesis.ThesisService/UpdateTopic"
+	ThesisService_DeleteTopic_FullMethodName                   = "/thesis.ThesisService/DeleteTopic"
+	ThesisService_RestoreTopic_FullMethodName                  = "/thesis.ThesisService/RestoreTopic"
+	ThesisService_ListTopics_FullMethodName                    = "/thesis.ThesisService/ListTopics"
+	ThesisService_SearchTopics_FullMethodName                  = "/thesis.ThesisService/SearchTopics"
+	ThesisService_SubmitTopic_FullMethodName                   = "/thesis.ThesisService/SubmitTopic"
+	ThesisService_ApproveTopic_FullMethodName                  = "/thesis.ThesisService/ApproveTopic"
+	ThesisService_RejectTopic_FullMethodName                   = "/thesis.ThesisService/RejectTopic"
+	ThesisService_StartTopic_FullMethodName                    = "/thesis.ThesisService/StartTopic"
+	ThesisService_CompleteTopic_FullMethodName                 = "/thesis.ThesisService/CompleteTopic"
+	ThesisService_ListTopicStatusHistory_FullMethodName        = "/thesis.ThesisService/ListTopicStatusHistory"
+	ThesisService_ProposeTopic_FullMethodName                  = "/thesis.ThesisService/ProposeTopic"
+	ThesisService_CoSignTopic_FullMethodName                   = "/thesis.ThesisService/CoSignTopic"
+	ThesisService_ListTopicCoSigns_FullMethodName              = "/thesis.ThesisService/ListTopicCoSigns"
+	ThesisService_SetRegistrationWindow_FullMethodName         = "/thesis.ThesisService/SetRegistrationWindow"
+	ThesisService_GetRegistrationWindow_FullMethodName         = "/thesis.ThesisService/GetRegistrationWindow"
+	ThesisService_RegisterTopicPreferences_FullMethodName      = "/thesis.ThesisService/RegisterTopicPreferences"
+	ThesisService_ListTopicRegistrations_FullMethodName        = "/thesis.ThesisService/ListTopicRegistrations"
+	ThesisService_DecideTopicRegistration_FullMethodName       = "/thesis.ThesisService/DecideTopicRegistration"
+	ThesisService_SetApplicantRanking_FullMethodName           = "/thesis.ThesisService/SetApplicantRanking"
+	ThesisService_PreviewTopicMatching_FullMethodName          = "/thesis.ThesisService/PreviewTopicMatching"
+	ThesisService_CommitTopicMatching_FullMethodName           = "/thesis.ThesisService/CommitTopicMatching"
+	ThesisService_CreateTopicCouncil_FullMethodName            = "/thesis.ThesisService/CreateTopicCouncil"
+	ThesisService_GetTopicCouncil_FullMethodName               = "/thesis.ThesisService/GetTopicCouncil"
+	ThesisService_UpdateTopicCouncil_FullMethodName            = "/thesis.ThesisService/UpdateTopicCouncil"
+	ThesisService_ScheduleTopicCouncils_FullMethodName         = "/thesis.ThesisService/ScheduleTopicCouncils"
+	ThesisService_DeleteTopicCouncil_FullMethodName            = "/thesis.ThesisService/DeleteTopicCouncil"
+	ThesisService_RestoreTopicCouncil_FullMethodName           = "/thesis.ThesisService/RestoreTopicCouncil"
+	ThesisService_ListTopicCouncils_FullMethodName             = "/thesis.ThesisService/ListTopicCouncils"
+	ThesisService_CreateTopicCouncilSupervisor_FullMethodName  = "/thesis.ThesisService/CreateTopicCouncilSupervisor"
+	ThesisService_GetTopicCouncilSupervisor_FullMethodName     = "/thesis.ThesisService/GetTopicCouncilSupervisor"
+	ThesisService_UpdateTopicCouncilSupervisor_FullMethodName  = "/thesis.ThesisService/UpdateTopicCouncilSupervisor"
+	ThesisService_DeleteTopicCouncilSupervisor_FullMethodName  = "/thesis.ThesisService/DeleteTopicCouncilSupervisor"
+	ThesisService_RestoreTopicCouncilSupervisor_FullMethodName = "/thesis.ThesisService/RestoreTopicCouncilSupervisor"
+	ThesisService_ListTopicCouncilSupervisors_FullMethodName   = "/thesis.ThesisService/ListTopicCouncilSupervisors"
+	ThesisService_CreateGradeReview_FullMethodName             = "/thesis.ThesisService/CreateGradeReview"
+	ThesisService_GetGradeReview_FullMethodName                = "/thesis.ThesisService/GetGradeReview"
+	ThesisService_UpdateGradeReview_FullMethodName             = "/thesis.ThesisService/UpdateGradeReview"
+	ThesisService_DeleteGradeReview_FullMethodName             = "/thesis.ThesisService/DeleteGradeReview"
+	ThesisService_RestoreGradeReview_FullMethodName            = "/thesis.ThesisService/RestoreGradeReview"
+	ThesisService_ListGradeReviews_FullMethodName              = "/thesis.ThesisService/ListGradeReviews"
+	ThesisService_SetSubmissionDeadline_FullMethodName         = "/thesis.ThesisService/SetSubmissionDeadline"
+	ThesisService_ListSubmissionDeadlines_FullMethodName       = "/thesis.ThesisService/ListSubmissionDeadlines"
+	ThesisService_GrantDeadlineExtension_FullMethodName        = "/thesis.ThesisService/GrantDeadlineExtension"
+	ThesisService_CheckSubmissionWindow_FullMethodName         = "/thesis.ThesisService/CheckSubmissionWindow"
+	ThesisService_SetGradingPolicy_FullMethodName              = "/thesis.ThesisService/SetGradingPolicy"
+	ThesisService_ListGradingPolicies_FullMethodName           = "/thesis.ThesisService/ListGradingPolicies"
+	ThesisService_DeleteGradingPolicy_FullMethodName           = "/thesis.ThesisService/DeleteGradingPolicy"
+	ThesisService_ComputeFinalGrade_FullMethodName             = "/thesis.ThesisService/ComputeFinalGrade"
+	ThesisService_LockSemesterGrades_FullMethodName            = "/thesis.ThesisService/LockSemesterGrades"
+	ThesisService_PublishSemesterGrades_FullMethodName         = "/thesis.ThesisService/PublishSemesterGrades"
+	ThesisService_GetSemesterGradeStatus_FullMethodName        = "/thesis.ThesisService/GetSemesterGradeStatus"
+	ThesisService_RequestGradeAmendment_FullMethodName         = "/thesis.ThesisService/RequestGradeAmendment"
+	ThesisService_DecideGradeAmendment_FullMethodName          = "/thesis.ThesisService/DecideGradeAmendment"
+	ThesisService_GetGradeAmendment_FullMethodName             = "/thesis.ThesisService/GetGradeAmendment"
+	ThesisService_ListGradeAmendments_FullMethodName           = "/thesis.ThesisService/ListGradeAmendments"
+	ThesisService_SetGradeAppealWindow_FullMethodName          = "/thesis.ThesisService/SetGradeAppealWindow"
+	ThesisService_FileGradeAppeal_FullMethodName               = "/thesis.ThesisService/FileGradeAppeal"
+	ThesisService_AssignGradeAppeal_FullMethodName             = "/thesis.ThesisService/AssignGradeAppeal"
+	ThesisService_ResolveGradeAppeal_FullMethodName            = "/thesis.ThesisService/ResolveGradeAppeal"
+	ThesisService_RequestGradeAppealAmendment_FullMethodName   = "/thesis.ThesisService/RequestGradeAppealAmendment"
+	ThesisService_SettleGradeAppealAmendment_FullMethodName    = "/thesis.ThesisService/SettleGradeAppealAmendment"
+	ThesisService_GetGradeAppeal_FullMethodName                = "/thesis.ThesisService/GetGradeAppeal"
+	ThesisService_ListGradeAppeals_FullMethodName              = "/thesis.ThesisService/ListGradeAppeals"
+	ThesisService_ListCouncilTopics_FullMethodName             = "/thesis.ThesisService/ListCouncilTopics"
+	ThesisService_CheckReferences_FullMethodName               = "/thesis.ThesisService/CheckReferences"
 )
 
 // ThesisServiceClient is the client API for ThesisService service.
@@ -67,43 +129,114 @@ type ThesisServiceClient interface {
 	GetMidterm(ctx context.Context, in *GetMidtermRequest, opts ...grpc.CallOption) (*GetMidtermResponse, error)
 	UpdateMidterm(ctx context.Context, in *UpdateMidtermRequest, opts ...grpc.CallOption) (*UpdateMidtermResponse, error)
 	DeleteMidterm(ctx context.Context, in *DeleteMidtermRequest, opts ...grpc.CallOption) (*DeleteMidtermResponse, error)
+	RestoreMidterm(ctx context.Context, in *RestoreMidtermRequest, opts ...grpc.CallOption) (*RestoreMidtermResponse, error)
 	ListMidterms(ctx context.Context, in *ListMidtermsRequest, opts ...grpc.CallOption) (*ListMidtermsResponse, error)
+	// Midterm milestones
+	CreateMidtermMilestone(ctx context.Context, in *CreateMidtermMilestoneRequest, opts ...grpc.CallOption) (*CreateMidtermMilestoneResponse, error)
+	UpdateMidtermMilestone(ctx context.Context, in *UpdateMidtermMilestoneRequest, opts ...grpc.CallOption) (*UpdateMidtermMilestoneResponse, error)
+	DeleteMidtermMilestone(ctx context.Context, in *DeleteMidtermMilestoneRequest, opts ...grpc.CallOption) (*DeleteMidtermMilestoneResponse, error)
+	ListMidtermMilestones(ctx context.Context, in *ListMidtermMilestonesRequest, opts ...grpc.CallOption) (*ListMidtermMilestonesResponse, error)
+	SubmitMilestoneCheckin(ctx context.Context, in *SubmitMilestoneCheckinRequest, opts ...grpc.CallOption) (*SubmitMilestoneCheckinResponse, error)
+	ReviewMilestoneCheckin(ctx context.Context, in *ReviewMilestoneCheckinRequest, opts ...grpc.CallOption) (*ReviewMilestoneCheckinResponse, error)
+	GetMilestoneCheckin(ctx context.Context, in *GetMilestoneCheckinRequest, opts ...grpc.CallOption) (*GetMilestoneCheckinResponse, error)
+	ListMilestoneCheckins(ctx context.Context, in *ListMilestoneCheckinsRequest, opts ...grpc.CallOption) (*ListMilestoneCheckinsResponse, error)
 	// Final
 	CreateFinal(ctx context.Context, in *CreateFinalRequest, opts ...grpc.CallOption) (*CreateFinalResponse, error)
 	GetFinal(ctx context.Context, in *GetFinalRequest, opts ...grpc.CallOption) (*GetFinalResponse, error)
 	UpdateFinal(ctx context.Context, in *UpdateFinalRequest, opts ...grpc.CallOption) (*UpdateFinalResponse, error)
 	DeleteFinal(ctx context.Context, in *DeleteFinalRequest, opts ...grpc.CallOption) (*DeleteFinalResponse, error)
+	RestoreFinal(ctx context.Context, in *RestoreFinalRequest, opts ...grpc.CallOption) (*RestoreFinalResponse, error)
 	ListFinals(ctx context.Context, in *ListFinalsRequest, opts ...grpc.CallOption) (*ListFinalsResponse, error)
 	// Enrollment
 	CreateEnrollment(ctx context.Context, in *CreateEnrollmentRequest, opts ...grpc.CallOption) (*CreateEnrollmentResponse, error)
 	GetEnrollment(ctx context.Context, in *GetEnrollmentRequest, opts ...grpc.CallOption) (*GetEnrollmentResponse, error)
 	UpdateEnrollment(ctx context.Context, in *UpdateEnrollmentRequest, opts ...grpc.CallOption) (*UpdateEnrollmentResponse, error)
 	DeleteEnrollment(ctx context.Context, in *DeleteEnrollmentRequest, opts ...grpc.CallOption) (*DeleteEnrollmentResponse, error)
+	RestoreEnrollment(ctx context.Context, in *RestoreEnrollmentRequest, opts ...grpc.CallOption) (*RestoreEnrollmentResponse, error)
 	ListEnrollments(ctx context.Context, in *ListEnrollmentsRequest, opts ...grpc.CallOption) (*ListEnrollmentsResponse, error)
+	CreateEnrollmentBundle(ctx context.Context, in *CreateEnrollmentBundleRequest, opts ...grpc.CallOption) (*CreateEnrollmentBundleResponse, error)
+	DeleteEnrollmentCascade(ctx context.Context, in *DeleteEnrollmentCascadeRequest, opts ...grpc.CallOption) (*DeleteEnrollmentCascadeResponse, error)
 	// Topic
 	CreateTopic(ctx context.Context, in *CreateTopicRequest, opts ...grpc.CallOption) (*CreateTopicResponse, error)
 	GetTopic(ctx context.Context, in *GetTopicRequest, opts ...grpc.CallOption) (*GetTopicResponse, error)
 	UpdateTopic(ctx context.Context, in *UpdateTopicRequest, opts ...grpc.CallOption) (*UpdateTopicResponse, error)
 	DeleteTopic(ctx context.Context, in *DeleteTopicRequest, opts ...grpc.CallOption) (*DeleteTopicResponse, error)
+	RestoreTopic(ctx context.Context, in *RestoreTopicRequest, opts ...grpc.CallOption) (*RestoreTopicResponse, error)
 	ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error)
+	SearchTopics(ctx context.Context, in *SearchTopicsRequest, opts ...grpc.CallOption) (*SearchTopicsResponse, error)
+	// Topic lifecycle
+	SubmitTopic(ctx context.Context, in *SubmitTopicRequest, opts ...grpc.CallOption) (*SubmitTopicResponse, error)
+	ApproveTopic(ctx context.Context, in *ApproveTopicRequest, opts ...grpc.CallOption) (*ApproveTopicResponse, error)
+	RejectTopic(ctx context.Context, in *RejectTopicRequest, opts ...grpc.CallOption) (*RejectTopicResponse, error)
+	StartTopic(ctx context.Context, in *StartTopicRequest, opts ...grpc.CallOption) (*StartTopicResponse, error)
+	CompleteTopic(ctx context.Context, in *CompleteTopicRequest, opts ...grpc.CallOption) (*CompleteTopicResponse, error)
+	ListTopicStatusHistory(ctx context.Context, in *ListTopicStatusHistoryRequest, opts ...grpc.CallOption) (*ListTopicStatusHistoryResponse, error)
+	// Topic proposal & registration
+	ProposeTopic(ctx context.Context, in *ProposeTopicRequest, opts ...grpc.CallOption) (*ProposeTopicResponse, error)
+	CoSignTopic(ctx context.Context, in *CoSignTopicRequest, opts ...grpc.CallOption) (*CoSignTopicResponse, error)
+	ListTopicCoSigns(ctx context.Context, in *ListTopicCoSignsRequest, opts ...grpc.CallOption) (*ListTopicCoSignsResponse, error)
+	SetRegistrationWindow(ctx context.Context, in *SetRegistrationWindowRequest, opts ...grpc.CallOption) (*SetRegistrationWindowResponse, error)
+	GetRegistrationWindow(ctx context.Context, in *GetRegistrationWindowRequest, opts ...grpc.CallOption) (*GetRegistrationWindowResponse, error)
+	RegisterTopicPreferences(ctx context.Context, in *RegisterTopicPreferencesRequest, opts ...grpc.CallOption) (*RegisterTopicPreferencesResponse, error)
+	ListTopicRegistrations(ctx context.Context, in *ListTopicRegistrationsRequest, opts ...grpc.CallOption) (*ListTopicRegistrationsResponse, error)
+	DecideTopicRegistration(ctx context.Context, in *DecideTopicRegistrationRequest, opts ...grpc.CallOption) (*DecideTopicRegistrationResponse, error)
+	// Topic Matching
+	SetApplicantRanking(ctx context.Context, in *SetApplicantRankingRequest, opts ...grpc.CallOption) (*SetApplicantRankingResponse, error)
+	PreviewTopicMatching(ctx context.Context, in *PreviewTopicMatchingRequest, opts ...grpc.CallOption) (*PreviewTopicMatchingResponse, error)
+	CommitTopicMatching(ctx context.Context, in *CommitTopicMatchingRequest, opts ...grpc.CallOption) (*CommitTopicMatchingResponse, error)
 	// TopicCouncil
 	CreateTopicCouncil(ctx context.Context, in *CreateTopicCouncilRequest, opts ...grpc.CallOption) (*CreateTopicCouncilResponse, error)
 	GetTopicCouncil(ctx context.Context, in *GetTopicCouncilRequest, opts ...grpc.CallOption) (*GetTopicCouncilResponse, error)
 	UpdateTopicCouncil(ctx context.Context, in *UpdateTopicCouncilRequest, opts ...grpc.CallOption) (*UpdateTopicCouncilResponse, error)
+	ScheduleTopicCouncils(ctx context.Context, in *ScheduleTopicCouncilsRequest, opts ...grpc.CallOption) (*ScheduleTopicCouncilsResponse, error)
 	DeleteTopicCouncil(ctx context.Context, in *DeleteTopicCouncilRequest, opts ...grpc.CallOption) (*DeleteTopicCouncilResponse, error)
+	RestoreTopicCouncil(ctx context.Context, in *RestoreTopicCouncilRequest, opts ...grpc.CallOption) (*RestoreTopicCouncilResponse, error)
 	ListTopicCouncils(ctx context.Context, in *ListTopicCouncilsRequest, opts ...grpc.CallOption) (*ListTopicCouncilsResponse, error)
 	// TopicCouncilSupervisor
 	CreateTopicCouncilSupervisor(ctx context.Context, in *CreateTopicCouncilSupervisorRequest, opts ...grpc.CallOption) (*CreateTopicCouncilSupervisorResponse, error)
 	GetTopicCouncilSupervisor(ctx context.Context, in *GetTopicCouncilSupervisorRequest, opts ...grpc.CallOption) (*GetTopicCouncilSupervisorResponse, error)
 	UpdateTopicCouncilSupervisor(ctx context.Context, in *UpdateTopicCouncilSupervisorRequest, opts ...grpc.CallOption) (*UpdateTopicCouncilSupervisorResponse, error)
 	DeleteTopicCouncilSupervisor(ctx context.Context, in *DeleteTopicCouncilSupervisorRequest, opts ...grpc.CallOption) (*DeleteTopicCouncilSupervisorResponse, error)
+	RestoreTopicCouncilSupervisor(ctx context.Context, in *RestoreTopicCouncilSupervisorRequest, opts ...grpc.CallOption) (*RestoreTopicCouncilSupervisorResponse, error)
 	ListTopicCouncilSupervisors(ctx context.Context, in *ListTopicCouncilSupervisorsRequest, opts ...grpc.CallOption) (*ListTopicCouncilSupervisorsResponse, error)
 	// GradeReview
 	CreateGradeReview(ctx context.Context, in *CreateGradeReviewRequest, opts ...grpc.CallOption) (*CreateGradeReviewResponse, error)
 	GetGradeReview(ctx context.Context, in *GetGradeReviewRequest, opts ...grpc.CallOption) (*GetGradeReviewResponse, error)
 	UpdateGradeReview(ctx context.Context, in *UpdateGradeReviewRequest, opts ...grpc.CallOption) (*UpdateGradeReviewResponse, error)
 	DeleteGradeReview(ctx context.Context, in *DeleteGradeReviewRequest, opts ...grpc.CallOption) (*DeleteGradeReviewResponse, error)
+	RestoreGradeReview(ctx context.Context, in *RestoreGradeReviewRequest, opts ...grpc.CallOption) (*RestoreGradeReviewResponse, error)
 	ListGradeReviews(ctx context.Context, in *ListGradeReviewsRequest, opts ...grpc.CallOption) (*ListGradeReviewsResponse, error)
+	// SubmissionDeadline
+	SetSubmissionDeadline(ctx context.Context, in *SetSubmissionDeadlineRequest, opts ...grpc.CallOption) (*SetSubmissionDeadlineResponse, error)
+	ListSubmissionDeadlines(ctx context.Context, in *ListSubmissionDeadlinesRequest, opts ...grpc.CallOption) (*ListSubmissionDeadlinesResponse, error)
+	GrantDeadlineExtension(ctx context.Context, in *GrantDeadlineExtensionRequest, opts ...grpc.CallOption) (*GrantDeadlineExtensionResponse, error)
+	CheckSubmissionWindow(ctx context.Context, in *CheckSubmissionWindowRequest, opts ...grpc.CallOption) (*CheckSubmissionWindowResponse, error)
+	// Grading policy
+	SetGradingPolicy(ctx context.Context, in *SetGradingPolicyRequest, opts ...grpc.CallOption) (*SetGradingPolicyResponse, error)
+	ListGradingPolicies(ctx context.Context, in *ListGradingPoliciesRequest, opts ...grpc.CallOption) (*ListGradingPoliciesResponse, error)
+	DeleteGradingPolicy(ctx context.Context, in *DeleteGradingPolicyRequest, opts ...grpc.CallOption) (*DeleteGradingPolicyResponse, error)
+	ComputeFinalGrade(ctx context.Context, in *ComputeFinalGradeRequest, opts ...grpc.CallOption) (*ComputeFinalGradeResponse, error)
+	// Grade lock, publication & amendments
+	LockSemesterGrades(ctx context.Context, in *LockSemesterGradesRequest, opts ...grpc.CallOption) (*LockSemesterGradesResponse, error)
+	PublishSemesterGrades(ctx context.Context, in *PublishSemesterGradesRequest, opts ...grpc.CallOption) (*PublishSemesterGradesResponse, error)
+	GetSemesterGradeStatus(ctx context.Context, in *GetSemesterGradeStatusRequest, opts ...grpc.CallOption) (*GetSemesterGradeStatusResponse, error)
+	RequestGradeAmendment(ctx context.Context, in *RequestGradeAmendmentRequest, opts ...grpc.CallOption) (*RequestGradeAmendmentResponse, error)
+	DecideGradeAmendment(ctx context.Context, in *DecideGradeAmendmentRequest, opts ...grpc.CallOption) (*DecideGradeAmendmentResponse, error)
+	GetGradeAmendment(ctx context.Context, in *GetGradeAmendmentRequest, opts ...grpc.CallOption) (*GetGradeAmendmentResponse, error)
+	ListGradeAmendments(ctx context.Context, in *ListGradeAmendmentsRequest, opts ...grpc.CallOption) (*ListGradeAmendmentsResponse, error)
+	// Grade appeals
+	SetGradeAppealWindow(ctx context.Context, in *SetGradeAppealWindowRequest, opts ...grpc.CallOption) (*SetGradeAppealWindowResponse, error)
+	FileGradeAppeal(ctx context.Context, in *FileGradeAppealRequest, opts ...grpc.CallOption) (*FileGradeAppealResponse, error)
+	AssignGradeAppeal(ctx context.Context, in *AssignGradeAppealRequest, opts ...grpc.CallOption) (*AssignGradeAppealResponse, error)
+	ResolveGradeAppeal(ctx context.Context, in *ResolveGradeAppealRequest, opts ...grpc.CallOption) (*ResolveGradeAppealResponse, error)
+	RequestGradeAppealAmendment(ctx context.Context, in *RequestGradeAppealAmendmentRequest, opts ...grpc.CallOption) (*RequestGradeAppealAmendmentResponse, error)
+	SettleGradeAppealAmendment(ctx context.Context, in *SettleGradeAppealAmendmentRequest, opts ...grpc.CallOption) (*SettleGradeAppealAmendmentResponse, error)
+	GetGradeAppeal(ctx context.Context, in *GetGradeAppealRequest, opts ...grpc.CallOption) (*GetGradeAppealResponse, error)
+	ListGradeAppeals(ctx context.Context, in *ListGradeAppealsRequest, opts ...grpc.CallOption) (*ListGradeAppealsResponse, error)
+	// Council conflict data
+	ListCouncilTopics(ctx context.Context, in *ListCouncilTopicsRequest, opts ...grpc.CallOption) (*ListCouncilTopicsResponse, error)
+	// Cross-service reference validation
+	CheckReferences(ctx context.Context, in *common.ReferenceCheckRequest, opts ...grpc.CallOption) (*common.ReferenceCheckResponse, error)
 }
 
 type thesisServiceClient struct {
@@ -154,6 +287,16 @@ func (c *thesisServiceClient) DeleteMidterm(ctx context.Context, in *DeleteMidte
 	return out, nil
 }
 
+func (c *thesisServiceClient) RestoreMidterm(ctx context.Context, in *RestoreMidtermRequest, opts ...grpc.CallOption) (*RestoreMidtermResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreMidtermResponse)
+	err := c.cc.Invoke(ctx, ThesisService_RestoreMidterm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *thesisServiceClient) ListMidterms(ctx context.Context, in *ListMidtermsRequest, opts ...grpc.CallOption) (*ListMidtermsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListMidtermsResponse)
@@ -164,6 +307,86 @@ func (c *thesisServiceClient) ListMidterms(ctx context.Context, in *ListMidterms
 	return out, nil
 }
 
+func (c *thesisServiceClient) CreateMidtermMilestone(ctx context.Context, in *CreateMidtermMilestoneRequest, opts ...grpc.CallOption) (*CreateMidtermMilestoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMidtermMilestoneResponse)
+	err := c.cc.Invoke(ctx, ThesisService_CreateMidtermMilestone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) UpdateMidtermMilestone(ctx context.Context, in *UpdateMidtermMilestoneRequest, opts ...grpc.CallOption) (*UpdateMidtermMilestoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMidtermMilestoneResponse)
+	err := c.cc.Invoke(ctx, ThesisService_UpdateMidtermMilestone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) DeleteMidtermMilestone(ctx context.Context, in *DeleteMidtermMilestoneRequest, opts ...grpc.CallOption) (*DeleteMidtermMilestoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMidtermMilestoneResponse)
+	err := c.cc.Invoke(ctx, ThesisService_DeleteMidtermMilestone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) ListMidtermMilestones(ctx context.Context, in *ListMidtermMilestonesRequest, opts ...grpc.CallOption) (*ListMidtermMilestonesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMidtermMilestonesResponse)
+	err := c.cc.Invoke(ctx, ThesisService_ListMidtermMilestones_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) SubmitMilestoneCheckin(ctx context.Context, in *SubmitMilestoneCheckinRequest, opts ...grpc.CallOption) (*SubmitMilestoneCheckinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitMilestoneCheckinResponse)
+	err := c.cc.Invoke(ctx, ThesisService_SubmitMilestoneCheckin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) ReviewMilestoneCheckin(ctx context.Context, in *ReviewMilestoneCheckinRequest, opts ...grpc.CallOption) (*ReviewMilestoneCheckinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewMilestoneCheckinResponse)
+	err := c.cc.Invoke(ctx, ThesisService_ReviewMilestoneCheckin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) GetMilestoneCheckin(ctx context.Context, in *GetMilestoneCheckinRequest, opts ...grpc.CallOption) (*GetMilestoneCheckinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMilestoneCheckinResponse)
+	err := c.cc.Invoke(ctx, ThesisService_GetMilestoneCheckin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) ListMilestoneCheckins(ctx context.Context, in *ListMilestoneCheckinsRequest, opts ...grpc.CallOption) (*ListMilestoneCheckinsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMilestoneCheckinsResponse)
+	err := c.cc.Invoke(ctx, ThesisService_ListMilestoneCheckins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *thesisServiceClient) CreateFinal(ctx context.Context, in *CreateFinalRequest, opts ...grpc.CallOption) (*CreateFinalResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateFinalResponse)
@@ -204,6 +427,16 @@ func (c *thesisServiceClient) DeleteFinal(ctx context.Context, in *DeleteFinalRe
 	return out, nil
 }
 
+func (c *thesisServiceClient) RestoreFinal(ctx context.Context, in *RestoreFinalRequest, opts ...grpc.CallOption) (*RestoreFinalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreFinalResponse)
+	err := c.cc.Invoke(ctx, ThesisService_RestoreFinal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *thesisServiceClient) ListFinals(ctx context.Context, in *ListFinalsRequest, opts ...grpc.CallOption) (*ListFinalsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListFinalsResponse)
@@ -254,6 +487,16 @@ func (c *thesisServiceClient) DeleteEnrollment(ctx context.Context, in *DeleteEn
 	return out, nil
 }
 
+func (c *thesisServiceClient) RestoreEnrollment(ctx context.Context, in *RestoreEnrollmentRequest, opts ...grpc.CallOption) (*RestoreEnrollmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreEnrollmentResponse)
+	err := c.cc.Invoke(ctx, ThesisService_RestoreEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *thesisServiceClient) ListEnrollments(ctx context.Context, in *ListEnrollmentsRequest, opts ...grpc.CallOption) (*ListEnrollmentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListEnrollmentsResponse)
@@ -264,6 +507,26 @@ func (c *thesisServiceClient) ListEnrollments(ctx context.Context, in *ListEnrol
 	return out, nil
 }
 
+func (c *thesisServiceClient) CreateEnrollmentBundle(ctx context.Context, in *CreateEnrollmentBundleRequest, opts ...grpc.CallOption) (*CreateEnrollmentBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEnrollmentBundleResponse)
+	err := c.cc.Invoke(ctx, ThesisService_CreateEnrollmentBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) DeleteEnrollmentCascade(ctx context.Context, in *DeleteEnrollmentCascadeRequest, opts ...grpc.CallOption) (*DeleteEnrollmentCascadeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEnrollmentCascadeResponse)
+	err := c.cc.Invoke(ctx, ThesisService_DeleteEnrollmentCascade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *thesisServiceClient) CreateTopic(ctx context.Context, in *CreateTopicRequest, opts ...grpc.CallOption) (*CreateTopicResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateTopicResponse)
@@ -304,6 +567,16 @@ func (c *thesisServiceClient) DeleteTopic(ctx context.Context, in *DeleteTopicRe
 	return out, nil
 }
 
+func (c *thesisServiceClient) RestoreTopic(ctx context.Context, in *RestoreTopicRequest, opts ...grpc.CallOption) (*RestoreTopicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreTopicResponse)
+	err := c.cc.Invoke(ctx, ThesisService_RestoreTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *thesisServiceClient) ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListTopicsResponse)
@@ -314,6 +587,186 @@ func (c *thesisServiceClient) ListTopics(ctx context.Context, in *ListTopicsRequ
 	return out, nil
 }
 
+func (c *thesisServiceClient) SearchTopics(ctx context.Context, in *SearchTopicsRequest, opts ...grpc.CallOption) (*SearchTopicsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchTopicsResponse)
+	err := c.cc.Invoke(ctx, ThesisService_SearchTopics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) SubmitTopic(ctx context.Context, in *SubmitTopicRequest, opts ...grpc.CallOption) (*SubmitTopicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitTopicResponse)
+	err := c.cc.Invoke(ctx, ThesisService_SubmitTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) ApproveTopic(ctx context.Context, in *ApproveTopicRequest, opts ...grpc.CallOption) (*ApproveTopicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveTopicResponse)
+	err := c.cc.Invoke(ctx, ThesisService_ApproveTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) RejectTopic(ctx context.Context, in *RejectTopicRequest, opts ...grpc.CallOption) (*RejectTopicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectTopicResponse)
+	err := c.cc.Invoke(ctx, ThesisService_RejectTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) StartTopic(ctx context.Context, in *StartTopicRequest, opts ...grpc.CallOption) (*StartTopicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartTopicResponse)
+	err := c.cc.Invoke(ctx, ThesisService_StartTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) CompleteTopic(ctx context.Context, in *CompleteTopicRequest, opts ...grpc.CallOption) (*CompleteTopicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteTopicResponse)
+	err := c.cc.Invoke(ctx, ThesisService_CompleteTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) ListTopicStatusHistory(ctx context.Context, in *ListTopicStatusHistoryRequest, opts ...grpc.CallOption) (*ListTopicStatusHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTopicStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, ThesisService_ListTopicStatusHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) ProposeTopic(ctx context.Context, in *ProposeTopicRequest, opts ...grpc.CallOption) (*ProposeTopicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProposeTopicResponse)
+	err := c.cc.Invoke(ctx, ThesisService_ProposeTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) CoSignTopic(ctx context.Context, in *CoSignTopicRequest, opts ...grpc.CallOption) (*CoSignTopicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CoSignTopicResponse)
+	err := c.cc.Invoke(ctx, ThesisService_CoSignTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) ListTopicCoSigns(ctx context.Context, in *ListTopicCoSignsRequest, opts ...grpc.CallOption) (*ListTopicCoSignsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTopicCoSignsResponse)
+	err := c.cc.Invoke(ctx, ThesisService_ListTopicCoSigns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) SetRegistrationWindow(ctx context.Context, in *SetRegistrationWindowRequest, opts ...grpc.CallOption) (*SetRegistrationWindowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRegistrationWindowResponse)
+	err := c.cc.Invoke(ctx, ThesisService_SetRegistrationWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) GetRegistrationWindow(ctx context.Context, in *GetRegistrationWindowRequest, opts ...grpc.CallOption) (*GetRegistrationWindowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRegistrationWindowResponse)
+	err := c.cc.Invoke(ctx, ThesisService_GetRegistrationWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) RegisterTopicPreferences(ctx context.Context, in *RegisterTopicPreferencesRequest, opts ...grpc.CallOption) (*RegisterTopicPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterTopicPreferencesResponse)
+	err := c.cc.Invoke(ctx, ThesisService_RegisterTopicPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) ListTopicRegistrations(ctx context.Context, in *ListTopicRegistrationsRequest, opts ...grpc.CallOption) (*ListTopicRegistrationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTopicRegistrationsResponse)
+	err := c.cc.Invoke(ctx, ThesisService_ListTopicRegistrations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) DecideTopicRegistration(ctx context.Context, in *DecideTopicRegistrationRequest, opts ...grpc.CallOption) (*DecideTopicRegistrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecideTopicRegistrationResponse)
+	err := c.cc.Invoke(ctx, ThesisService_DecideTopicRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) SetApplicantRanking(ctx context.Context, in *SetApplicantRankingRequest, opts ...grpc.CallOption) (*SetApplicantRankingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetApplicantRankingResponse)
+	err := c.cc.Invoke(ctx, ThesisService_SetApplicantRanking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) PreviewTopicMatching(ctx context.Context, in *PreviewTopicMatchingRequest, opts ...grpc.CallOption) (*PreviewTopicMatchingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewTopicMatchingResponse)
+	err := c.cc.Invoke(ctx, ThesisService_PreviewTopicMatching_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) CommitTopicMatching(ctx context.Context, in *CommitTopicMatchingRequest, opts ...grpc.CallOption) (*CommitTopicMatchingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitTopicMatchingResponse)
+	err := c.cc.Invoke(ctx, ThesisService_CommitTopicMatching_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *thesisServiceClient) CreateTopicCouncil(ctx context.Context, in *CreateTopicCouncilRequest, opts ...grpc.CallOption) (*CreateTopicCouncilResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateTopicCouncilResponse)
@@ -344,6 +797,16 @@ func (c *thesisServiceClient) UpdateTopicCouncil(ctx context.Context, in *Update
 	return out, nil
 }
 
+func (c *thesisServiceClient) ScheduleTopicCouncils(ctx context.Context, in *ScheduleTopicCouncilsRequest, opts ...grpc.CallOption) (*ScheduleTopicCouncilsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleTopicCouncilsResponse)
+	err := c.cc.Invoke(ctx, ThesisService_ScheduleTopicCouncils_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *thesisServiceClient) DeleteTopicCouncil(ctx context.Context, in *DeleteTopicCouncilRequest, opts ...grpc.CallOption) (*DeleteTopicCouncilResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteTopicCouncilResponse)
@@ -354,6 +817,16 @@ func (c *thesisServiceClient) DeleteTopicCouncil(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *thesisServiceClient) RestoreTopicCouncil(ctx context.Context, in *RestoreTopicCouncilRequest, opts ...grpc.CallOption) (*RestoreTopicCouncilResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreTopicCouncilResponse)
+	err := c.cc.Invoke(ctx, ThesisService_RestoreTopicCouncil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *thesisServiceClient) ListTopicCouncils(ctx context.Context, in *ListTopicCouncilsRequest, opts ...grpc.CallOption) (*ListTopicCouncilsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListTopicCouncilsResponse)
@@ -404,6 +877,16 @@ func (c *thesisServiceClient) DeleteTopicCouncilSupervisor(ctx context.Context,
 	return out, nil
 }
 
+func (c *thesisServiceClient) RestoreTopicCouncilSupervisor(ctx context.Context, in *RestoreTopicCouncilSupervisorRequest, opts ...grpc.CallOption) (*RestoreTopicCouncilSupervisorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreTopicCouncilSupervisorResponse)
+	err := c.cc.Invoke(ctx, ThesisService_RestoreTopicCouncilSupervisor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *thesisServiceClient) ListTopicCouncilSupervisors(ctx context.Context, in *ListTopicCouncilSupervisorsRequest, opts ...grpc.CallOption) (*ListTopicCouncilSupervisorsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListTopicCouncilSupervisorsResponse)
@@ -454,6 +937,16 @@ func (c *thesisServiceClient) DeleteGradeReview(ctx context.Context, in *DeleteG
 	return out, nil
 }
 
+func (c *thesisServiceClient) RestoreGradeReview(ctx context.Context, in *RestoreGradeReviewRequest, opts ...grpc.CallOption) (*RestoreGradeReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreGradeReviewResponse)
+	err := c.cc.Invoke(ctx, ThesisService_RestoreGradeReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *thesisServiceClient) ListGradeReviews(ctx context.Context, in *ListGradeReviewsRequest, opts ...grpc.CallOption) (*ListGradeReviewsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListGradeReviewsResponse)
@@ -464,10 +957,260 @@ func (c *thesisServiceClient) ListGradeReviews(ctx context.Context, in *ListGrad
 	return out, nil
 }
 
-// ThesisServiceServer is the server API for ThesisService service.
-// All implementations must embed UnimplementedThesisServiceServer
-// for forward compatibility.
-//
+func (c *thesisServiceClient) SetSubmissionDeadline(ctx context.Context, in *SetSubmissionDeadlineRequest, opts ...grpc.CallOption) (*SetSubmissionDeadlineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSubmissionDeadlineResponse)
+	err := c.cc.Invoke(ctx, ThesisService_SetSubmissionDeadline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) ListSubmissionDeadlines(ctx context.Context, in *ListSubmissionDeadlinesRequest, opts ...grpc.CallOption) (*ListSubmissionDeadlinesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubmissionDeadlinesResponse)
+	err := c.cc.Invoke(ctx, ThesisService_ListSubmissionDeadlines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) GrantDeadlineExtension(ctx context.Context, in *GrantDeadlineExtensionRequest, opts ...grpc.CallOption) (*GrantDeadlineExtensionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantDeadlineExtensionResponse)
+	err := c.cc.Invoke(ctx, ThesisService_GrantDeadlineExtension_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) CheckSubmissionWindow(ctx context.Context, in *CheckSubmissionWindowRequest, opts ...grpc.CallOption) (*CheckSubmissionWindowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckSubmissionWindowResponse)
+	err := c.cc.Invoke(ctx, ThesisService_CheckSubmissionWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) SetGradingPolicy(ctx context.Context, in *SetGradingPolicyRequest, opts ...grpc.CallOption) (*SetGradingPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGradingPolicyResponse)
+	err := c.cc.Invoke(ctx, ThesisService_SetGradingPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) ListGradingPolicies(ctx context.Context, in *ListGradingPoliciesRequest, opts ...grpc.CallOption) (*ListGradingPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGradingPoliciesResponse)
+	err := c.cc.Invoke(ctx, ThesisService_ListGradingPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) DeleteGradingPolicy(ctx context.Context, in *DeleteGradingPolicyRequest, opts ...grpc.CallOption) (*DeleteGradingPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGradingPolicyResponse)
+	err := c.cc.Invoke(ctx, ThesisService_DeleteGradingPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) ComputeFinalGrade(ctx context.Context, in *ComputeFinalGradeRequest, opts ...grpc.CallOption) (*ComputeFinalGradeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ComputeFinalGradeResponse)
+	err := c.cc.Invoke(ctx, ThesisService_ComputeFinalGrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) LockSemesterGrades(ctx context.Context, in *LockSemesterGradesRequest, opts ...grpc.CallOption) (*LockSemesterGradesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LockSemesterGradesResponse)
+	err := c.cc.Invoke(ctx, ThesisService_LockSemesterGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) PublishSemesterGrades(ctx context.Context, in *PublishSemesterGradesRequest, opts ...grpc.CallOption) (*PublishSemesterGradesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishSemesterGradesResponse)
+	err := c.cc.Invoke(ctx, ThesisService_PublishSemesterGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) GetSemesterGradeStatus(ctx context.Context, in *GetSemesterGradeStatusRequest, opts ...grpc.CallOption) (*GetSemesterGradeStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSemesterGradeStatusResponse)
+	err := c.cc.Invoke(ctx, ThesisService_GetSemesterGradeStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) RequestGradeAmendment(ctx context.Context, in *RequestGradeAmendmentRequest, opts ...grpc.CallOption) (*RequestGradeAmendmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestGradeAmendmentResponse)
+	err := c.cc.Invoke(ctx, ThesisService_RequestGradeAmendment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) DecideGradeAmendment(ctx context.Context, in *DecideGradeAmendmentRequest, opts ...grpc.CallOption) (*DecideGradeAmendmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecideGradeAmendmentResponse)
+	err := c.cc.Invoke(ctx, ThesisService_DecideGradeAmendment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) GetGradeAmendment(ctx context.Context, in *GetGradeAmendmentRequest, opts ...grpc.CallOption) (*GetGradeAmendmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGradeAmendmentResponse)
+	err := c.cc.Invoke(ctx, ThesisService_GetGradeAmendment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) ListGradeAmendments(ctx context.Context, in *ListGradeAmendmentsRequest, opts ...grpc.CallOption) (*ListGradeAmendmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGradeAmendmentsResponse)
+	err := c.cc.Invoke(ctx, ThesisService_ListGradeAmendments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) SetGradeAppealWindow(ctx context.Context, in *SetGradeAppealWindowRequest, opts ...grpc.CallOption) (*SetGradeAppealWindowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGradeAppealWindowResponse)
+	err := c.cc.Invoke(ctx, ThesisService_SetGradeAppealWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) FileGradeAppeal(ctx context.Context, in *FileGradeAppealRequest, opts ...grpc.CallOption) (*FileGradeAppealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileGradeAppealResponse)
+	err := c.cc.Invoke(ctx, ThesisService_FileGradeAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) AssignGradeAppeal(ctx context.Context, in *AssignGradeAppealRequest, opts ...grpc.CallOption) (*AssignGradeAppealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignGradeAppealResponse)
+	err := c.cc.Invoke(ctx, ThesisService_AssignGradeAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) ResolveGradeAppeal(ctx context.Context, in *ResolveGradeAppealRequest, opts ...grpc.CallOption) (*ResolveGradeAppealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveGradeAppealResponse)
+	err := c.cc.Invoke(ctx, ThesisService_ResolveGradeAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) RequestGradeAppealAmendment(ctx context.Context, in *RequestGradeAppealAmendmentRequest, opts ...grpc.CallOption) (*RequestGradeAppealAmendmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestGradeAppealAmendmentResponse)
+	err := c.cc.Invoke(ctx, ThesisService_RequestGradeAppealAmendment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) SettleGradeAppealAmendment(ctx context.Context, in *SettleGradeAppealAmendmentRequest, opts ...grpc.CallOption) (*SettleGradeAppealAmendmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettleGradeAppealAmendmentResponse)
+	err := c.cc.Invoke(ctx, ThesisService_SettleGradeAppealAmendment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) GetGradeAppeal(ctx context.Context, in *GetGradeAppealRequest, opts ...grpc.CallOption) (*GetGradeAppealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGradeAppealResponse)
+	err := c.cc.Invoke(ctx, ThesisService_GetGradeAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) ListGradeAppeals(ctx context.Context, in *ListGradeAppealsRequest, opts ...grpc.CallOption) (*ListGradeAppealsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGradeAppealsResponse)
+	err := c.cc.Invoke(ctx, ThesisService_ListGradeAppeals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) ListCouncilTopics(ctx context.Context, in *ListCouncilTopicsRequest, opts ...grpc.CallOption) (*ListCouncilTopicsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCouncilTopicsResponse)
+	err := c.cc.Invoke(ctx, ThesisService_ListCouncilTopics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thesisServiceClient) CheckReferences(ctx context.Context, in *common.ReferenceCheckRequest, opts ...grpc.CallOption) (*common.ReferenceCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.ReferenceCheckResponse)
+	err := c.cc.Invoke(ctx, ThesisService_CheckReferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ThesisServiceServer is the server API for ThesisService service.
+// All implementations must embed UnimplementedThesisServiceServer
+// for forward compatibility.
+//
 // ============= Service =============
 type ThesisServiceServer interface {
 	// Midterm
@@ -475,43 +1218,114 @@ type ThesisServiceServer interface {
 	GetMidterm(context.Context, *GetMidtermRequest) (*GetMidtermResponse, error)
 	UpdateMidterm(context.Context, *UpdateMidtermRequest) (*UpdateMidtermResponse, error)
 	DeleteMidterm(context.Context, *DeleteMidtermRequest) (*DeleteMidtermResponse, error)
+	RestoreMidterm(context.Context, *RestoreMidtermRequest) (*RestoreMidtermResponse, error)
 	ListMidterms(context.Context, *ListMidtermsRequest) (*ListMidtermsResponse, error)
+	// Midterm milestones
+	CreateMidtermMilestone(context.Context, *CreateMidtermMilestoneRequest) (*CreateMidtermMilestoneResponse, error)
+	UpdateMidtermMilestone(context.Context, *UpdateMidtermMilestoneRequest) (*UpdateMidtermMilestoneResponse, error)
+	DeleteMidtermMilestone(context.Context, *DeleteMidtermMilestoneRequest) (*DeleteMidtermMilestoneResponse, error)
+	ListMidtermMilestones(context.Context, *ListMidtermMilestonesRequest) (*ListMidtermMilestonesResponse, error)
+	SubmitMilestoneCheckin(context.Context, *SubmitMilestoneCheckinRequest) (*SubmitMilestoneCheckinResponse, error)
+	ReviewMilestoneCheckin(context.Context, *ReviewMilestoneCheckinRequest) (*ReviewMilestoneCheckinResponse, error)
+	GetMilestoneCheckin(context.Context, *GetMilestoneCheckinRequest) (*GetMilestoneCheckinResponse, error)
+	ListMilestoneCheckins(context.Context, *ListMilestoneCheckinsRequest) (*ListMilestoneCheckinsResponse, error)
 	// Final
 	CreateFinal(context.Context, *CreateFinalRequest) (*CreateFinalResponse, error)
 	GetFinal(context.Context, *GetFinalRequest) (*GetFinalResponse, error)
 	UpdateFinal(context.Context, *UpdateFinalRequest) (*UpdateFinalResponse, error)
 	DeleteFinal(context.Context, *DeleteFinalRequest) (*DeleteFinalResponse, error)
+	RestoreFinal(context.Context, *RestoreFinalRequest) (*RestoreFinalResponse, error)
 	ListFinals(context.Context, *ListFinalsRequest) (*ListFinalsResponse, error)
 	// Enrollment
 	CreateEnrollment(context.Context, *CreateEnrollmentRequest) (*CreateEnrollmentResponse, error)
 	GetEnrollment(context.Context, *GetEnrollmentRequest) (*GetEnrollmentResponse, error)
 	UpdateEnrollment(context.Context, *UpdateEnrollmentRequest) (*UpdateEnrollmentResponse, error)
 	DeleteEnrollment(context.Context, *DeleteEnrollmentRequest) (*DeleteEnrollmentResponse, error)
+	RestoreEnrollment(context.Context, *RestoreEnrollmentRequest) (*RestoreEnrollmentResponse, error)
 	ListEnrollments(context.Context, *ListEnrollmentsRequest) (*ListEnrollmentsResponse, error)
+	CreateEnrollmentBundle(context.Context, *CreateEnrollmentBundleRequest) (*CreateEnrollmentBundleResponse, error)
+	DeleteEnrollmentCascade(context.Context, *DeleteEnrollmentCascadeRequest) (*DeleteEnrollmentCascadeResponse, error)
 	// Topic
 	CreateTopic(context.Context, *CreateTopicRequest) (*CreateTopicResponse, error)
 	GetTopic(context.Context, *GetTopicRequest) (*GetTopicResponse, error)
 	UpdateTopic(context.Context, *UpdateTopicRequest) (*UpdateTopicResponse, error)
 	DeleteTopic(context.Context, *DeleteTopicRequest) (*DeleteTopicResponse, error)
+	RestoreTopic(context.Context, *RestoreTopicRequest) (*RestoreTopicResponse, error)
 	ListTopics(context.Context, *ListTopicsRequest) (*ListTopicsResponse, error)
+	SearchTopics(context.Context, *SearchTopicsRequest) (*SearchTopicsResponse, error)
+	// Topic lifecycle
+	SubmitTopic(context.Context, *SubmitTopicRequest) (*SubmitTopicResponse, error)
+	ApproveTopic(context.Context, *ApproveTopicRequest) (*ApproveTopicResponse, error)
+	RejectTopic(context.Context, *RejectTopicRequest) (*RejectTopicResponse, error)
+	StartTopic(context.Context, *StartTopicRequest) (*StartTopicResponse, error)
+	CompleteTopic(context.Context, *CompleteTopicRequest) (*CompleteTopicResponse, error)
+	ListTopicStatusHistory(context.Context, *ListTopicStatusHistoryRequest) (*ListTopicStatusHistoryResponse, error)
+	// Topic proposal & registration
+	ProposeTopic(context.Context, *ProposeTopicRequest) (*ProposeTopicResponse, error)
+	CoSignTopic(context.Context, *CoSignTopicRequest) (*CoSignTopicResponse, error)
+	ListTopicCoSigns(context.Context, *ListTopicCoSignsRequest) (*ListTopicCoSignsResponse, error)
+	SetRegistrationWindow(context.Context, *SetRegistrationWindowRequest) (*SetRegistrationWindowResponse, error)
+	GetRegistrationWindow(context.Context, *GetRegistrationWindowRequest) (*GetRegistrationWindowResponse, error)
+	RegisterTopicPreferences(context.Context, *RegisterTopicPreferencesRequest) (*RegisterTopicPreferencesResponse, error)
+	ListTopicRegistrations(context.Context, *ListTopicRegistrationsRequest) (*ListTopicRegistrationsResponse, error)
+	DecideTopicRegistration(context.Context, *DecideTopicRegistrationRequest) (*DecideTopicRegistrationResponse, error)
+	// Topic Matching
+	SetApplicantRanking(context.Context, *SetApplicantRankingRequest) (*SetApplicantRankingResponse, error)
+	PreviewTopicMatching(context.Context, *PreviewTopicMatchingRequest) (*PreviewTopicMatchingResponse, error)
+	CommitTopicMatching(context.Context, *CommitTopicMatchingRequest) (*CommitTopicMatchingResponse, error)
 	// TopicCouncil
 	CreateTopicCouncil(context.Context, *CreateTopicCouncilRequest) (*CreateTopicCouncilResponse, error)
 	GetTopicCouncil(context.Context, *GetTopicCouncilRequest) (*GetTopicCouncilResponse, error)
 	UpdateTopicCouncil(context.Context, *UpdateTopicCouncilRequest) (*UpdateTopicCouncilResponse, error)
+	ScheduleTopicCouncils(context.Context, *ScheduleTopicCouncilsRequest) (*ScheduleTopicCouncilsResponse, error)
 	DeleteTopicCouncil(context.Context, *DeleteTopicCouncilRequest) (*DeleteTopicCouncilResponse, error)
+	RestoreTopicCouncil(context.Context, *RestoreTopicCouncilRequest) (*RestoreTopicCouncilResponse, error)
 	ListTopicCouncils(context.Context, *ListTopicCouncilsRequest) (*ListTopicCouncilsResponse, error)
 	// TopicCouncilSupervisor
 	CreateTopicCouncilSupervisor(context.Context, *CreateTopicCouncilSupervisorRequest) (*CreateTopicCouncilSupervisorResponse, error)
 	GetTopicCouncilSupervisor(context.Context, *GetTopicCouncilSupervisorRequest) (*GetTopicCouncilSupervisorResponse, error)
 	UpdateTopicCouncilSupervisor(context.Context, *UpdateTopicCouncilSupervisorRequest) (*UpdateTopicCouncilSupervisorResponse, error)
 	DeleteTopicCouncilSupervisor(context.Context, *DeleteTopicCouncilSupervisorRequest) (*DeleteTopicCouncilSupervisorResponse, error)
+	RestoreTopicCouncilSupervisor(context.Context, *RestoreTopicCouncilSupervisorRequest) (*RestoreTopicCouncilSupervisorResponse, error)
 	ListTopicCouncilSupervisors(context.Context, *ListTopicCouncilSupervisorsRequest) (*ListTopicCouncilSupervisorsResponse, error)
 	// GradeReview
 	CreateGradeReview(context.Context, *CreateGradeReviewRequest) (*CreateGradeReviewResponse, error)
 	GetGradeReview(context.Context, *GetGradeReviewRequest) (*GetGradeReviewResponse, error)
 	UpdateGradeReview(context.Context, *UpdateGradeReviewRequest) (*UpdateGradeReviewResponse, error)
 	DeleteGradeReview(context.Context, *DeleteGradeReviewRequest) (*DeleteGradeReviewResponse, error)
+	RestoreGradeReview(context.Context, *RestoreGradeReviewRequest) (*RestoreGradeReviewResponse, error)
 	ListGradeReviews(context.Context, *ListGradeReviewsRequest) (*ListGradeReviewsResponse, error)
+	// SubmissionDeadline
+	SetSubmissionDeadline(context.Context, *SetSubmissionDeadlineRequest) (*SetSubmissionDeadlineResponse, error)
+	ListSubmissionDeadlines(context.Context, *ListSubmissionDeadlinesRequest) (*ListSubmissionDeadlinesResponse, error)
+	GrantDeadlineExtension(context.Context, *GrantDeadlineExtensionRequest) (*GrantDeadlineExtensionResponse, error)
+	CheckSubmissionWindow(context.Context, *CheckSubmissionWindowRequest) (*CheckSubmissionWindowResponse, error)
+	// Grading policy
+	SetGradingPolicy(context.Context, *SetGradingPolicyRequest) (*SetGradingPolicyResponse, error)
+	ListGradingPolicies(context.Context, *ListGradingPoliciesRequest) (*ListGradingPoliciesResponse, error)
+	DeleteGradingPolicy(context.Context, *DeleteGradingPolicyRequest) (*DeleteGradingPolicyResponse, error)
+	ComputeFinalGrade(context.Context, *ComputeFinalGradeRequest) (*ComputeFinalGradeResponse, error)
+	// Grade lock, publication & amendments
+	LockSemesterGrades(context.Context, *LockSemesterGradesRequest) (*LockSemesterGradesResponse, error)
+	PublishSemesterGrades(context.Context, *PublishSemesterGradesRequest) (*PublishSemesterGradesResponse, error)
+	GetSemesterGradeStatus(context.Context, *GetSemesterGradeStatusRequest) (*GetSemesterGradeStatusResponse, error)
+	RequestGradeAmendment(context.Context, *RequestGradeAmendmentRequest) (*RequestGradeAmendmentResponse, error)
+	DecideGradeAmendment(context.Context, *DecideGradeAmendmentRequest) (*DecideGradeAmendmentResponse, error)
+	GetGradeAmendment(context.Context, *GetGradeAmendmentRequest) (*GetGradeAmendmentResponse, error)
+	ListGradeAmendments(context.Context, *ListGradeAmendmentsRequest) (*ListGradeAmendmentsResponse, error)
+	// Grade appeals
+	SetGradeAppealWindow(context.Context, *SetGradeAppealWindowRequest) (*SetGradeAppealWindowResponse, error)
+	FileGradeAppeal(context.Context, *FileGradeAppealRequest) (*FileGradeAppealResponse, error)
+	AssignGradeAppeal(context.Context, *AssignGradeAppealRequest) (*AssignGradeAppealResponse, error)
+	ResolveGradeAppeal(context.Context, *ResolveGradeAppealRequest) (*ResolveGradeAppealResponse, error)
+	RequestGradeAppealAmendment(context.Context, *RequestGradeAppealAmendmentRequest) (*RequestGradeAppealAmendmentResponse, error)
+	SettleGradeAppealAmendment(context.Context, *SettleGradeAppealAmendmentRequest) (*SettleGradeAppealAmendmentResponse, error)
+	GetGradeAppeal(context.Context, *GetGradeAppealRequest) (*GetGradeAppealResponse, error)
+	ListGradeAppeals(context.Context, *ListGradeAppealsRequest) (*ListGradeAppealsResponse, error)
+	// Council conflict data
+	ListCouncilTopics(context.Context, *ListCouncilTopicsRequest) (*ListCouncilTopicsResponse, error)
+	// Cross-service reference validation
+	CheckReferences(context.Context, *common.ReferenceCheckRequest) (*common.ReferenceCheckResponse, error)
 	mustEmbedUnimplementedThesisServiceServer()
 }
 
@@ -534,9 +1348,36 @@ func (UnimplementedThesisServiceServer) UpdateMidterm(context.Context, *UpdateMi
 func (UnimplementedThesisServiceServer) DeleteMidterm(context.Context, *DeleteMidtermRequest) (*DeleteMidtermResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMidterm not implemented")
 }
+func (UnimplementedThesisServiceServer) RestoreMidterm(context.Context, *RestoreMidtermRequest) (*RestoreMidtermResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMidterm not implemented")
+}
 func (UnimplementedThesisServiceServer) ListMidterms(context.Context, *ListMidtermsRequest) (*ListMidtermsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMidterms not implemented")
 }
+func (UnimplementedThesisServiceServer) CreateMidtermMilestone(context.Context, *CreateMidtermMilestoneRequest) (*CreateMidtermMilestoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMidtermMilestone not implemented")
+}
+func (UnimplementedThesisServiceServer) UpdateMidtermMilestone(context.Context, *UpdateMidtermMilestoneRequest) (*UpdateMidtermMilestoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMidtermMilestone not implemented")
+}
+func (UnimplementedThesisServiceServer) DeleteMidtermMilestone(context.Context, *DeleteMidtermMilestoneRequest) (*DeleteMidtermMilestoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMidtermMilestone not implemented")
+}
+func (UnimplementedThesisServiceServer) ListMidtermMilestones(context.Context, *ListMidtermMilestonesRequest) (*ListMidtermMilestonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMidtermMilestones not implemented")
+}
+func (UnimplementedThesisServiceServer) SubmitMilestoneCheckin(context.Context, *SubmitMilestoneCheckinRequest) (*SubmitMilestoneCheckinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitMilestoneCheckin not implemented")
+}
+func (UnimplementedThesisServiceServer) ReviewMilestoneCheckin(context.Context, *ReviewMilestoneCheckinRequest) (*ReviewMilestoneCheckinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewMilestoneCheckin not implemented")
+}
+func (UnimplementedThesisServiceServer) GetMilestoneCheckin(context.Context, *GetMilestoneCheckinRequest) (*GetMilestoneCheckinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMilestoneCheckin not implemented")
+}
+func (UnimplementedThesisServiceServer) ListMilestoneCheckins(context.Context, *ListMilestoneCheckinsRequest) (*ListMilestoneCheckinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMilestoneCheckins not implemented")
+}
 func (UnimplementedThesisServiceServer) CreateFinal(context.Context, *CreateFinalRequest) (*CreateFinalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateFinal not implemented")
 }
@@ -549,6 +1390,9 @@ func (UnimplementedThesisServiceServer) UpdateFinal(context.Context, *UpdateFina
 func (UnimplementedThesisServiceServer) DeleteFinal(context.Context, *DeleteFinalRequest) (*DeleteFinalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFinal not implemented")
 }
+func (UnimplementedThesisServiceServer) RestoreFinal(context.Context, *RestoreFinalRequest) (*RestoreFinalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreFinal not implemented")
+}
 func (UnimplementedThesisServiceServer) ListFinals(context.Context, *ListFinalsRequest) (*ListFinalsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFinals not implemented")
 }
@@ -564,9 +1408,18 @@ func (UnimplementedThesisServiceServer) UpdateEnrollment(context.Context, *Updat
 func (UnimplementedThesisServiceServer) DeleteEnrollment(context.Context, *DeleteEnrollmentRequest) (*DeleteEnrollmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEnrollment not implemented")
 }
+func (UnimplementedThesisServiceServer) RestoreEnrollment(context.Context, *RestoreEnrollmentRequest) (*RestoreEnrollmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreEnrollment not implemented")
+}
 func (UnimplementedThesisServiceServer) ListEnrollments(context.Context, *ListEnrollmentsRequest) (*ListEnrollmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEnrollments not implemented")
 }
+func (UnimplementedThesisServiceServer) CreateEnrollmentBundle(context.Context, *CreateEnrollmentBundleRequest) (*CreateEnrollmentBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEnrollmentBundle not implemented")
+}
+func (UnimplementedThesisServiceServer) DeleteEnrollmentCascade(context.Context, *DeleteEnrollmentCascadeRequest) (*DeleteEnrollmentCascadeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEnrollmentCascade not implemented")
+}
 func (UnimplementedThesisServiceServer) CreateTopic(context.Context, *CreateTopicRequest) (*CreateTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTopic not implemented")
 }
@@ -579,9 +1432,66 @@ func (UnimplementedThesisServiceServer) UpdateTopic(context.Context, *UpdateTopi
 func (UnimplementedThesisServiceServer) DeleteTopic(context.Context, *DeleteTopicRequest) (*DeleteTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTopic not implemented")
 }
+func (UnimplementedThesisServiceServer) RestoreTopic(context.Context, *RestoreTopicRequest) (*RestoreTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTopic not implemented")
+}
 func (UnimplementedThesisServiceServer) ListTopics(context.Context, *ListTopicsRequest) (*ListTopicsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTopics not implemented")
 }
+func (UnimplementedThesisServiceServer) SearchTopics(context.Context, *SearchTopicsRequest) (*SearchTopicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTopics not implemented")
+}
+func (UnimplementedThesisServiceServer) SubmitTopic(context.Context, *SubmitTopicRequest) (*SubmitTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTopic not implemented")
+}
+func (UnimplementedThesisServiceServer) ApproveTopic(context.Context, *ApproveTopicRequest) (*ApproveTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveTopic not implemented")
+}
+func (UnimplementedThesisServiceServer) RejectTopic(context.Context, *RejectTopicRequest) (*RejectTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectTopic not implemented")
+}
+func (UnimplementedThesisServiceServer) StartTopic(context.Context, *StartTopicRequest) (*StartTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTopic not implemented")
+}
+func (UnimplementedThesisServiceServer) CompleteTopic(context.Context, *CompleteTopicRequest) (*CompleteTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteTopic not implemented")
+}
+func (UnimplementedThesisServiceServer) ListTopicStatusHistory(context.Context, *ListTopicStatusHistoryRequest) (*ListTopicStatusHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopicStatusHistory not implemented")
+}
+func (UnimplementedThesisServiceServer) ProposeTopic(context.Context, *ProposeTopicRequest) (*ProposeTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeTopic not implemented")
+}
+func (UnimplementedThesisServiceServer) CoSignTopic(context.Context, *CoSignTopicRequest) (*CoSignTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CoSignTopic not implemented")
+}
+func (UnimplementedThesisServiceServer) ListTopicCoSigns(context.Context, *ListTopicCoSignsRequest) (*ListTopicCoSignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopicCoSigns not implemented")
+}
+func (UnimplementedThesisServiceServer) SetRegistrationWindow(context.Context, *SetRegistrationWindowRequest) (*SetRegistrationWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRegistrationWindow not implemented")
+}
+func (UnimplementedThesisServiceServer) GetRegistrationWindow(context.Context, *GetRegistrationWindowRequest) (*GetRegistrationWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegistrationWindow not implemented")
+}
+func (UnimplementedThesisServiceServer) RegisterTopicPreferences(context.Context, *RegisterTopicPreferencesRequest) (*RegisterTopicPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterTopicPreferences not implemented")
+}
+func (UnimplementedThesisServiceServer) ListTopicRegistrations(context.Context, *ListTopicRegistrationsRequest) (*ListTopicRegistrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopicRegistrations not implemented")
+}
+func (UnimplementedThesisServiceServer) DecideTopicRegistration(context.Context, *DecideTopicRegistrationRequest) (*DecideTopicRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecideTopicRegistration not implemented")
+}
+func (UnimplementedThesisServiceServer) SetApplicantRanking(context.Context, *SetApplicantRankingRequest) (*SetApplicantRankingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetApplicantRanking not implemented")
+}
+func (UnimplementedThesisServiceServer) PreviewTopicMatching(context.Context, *PreviewTopicMatchingRequest) (*PreviewTopicMatchingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewTopicMatching not implemented")
+}
+func (UnimplementedThesisServiceServer) CommitTopicMatching(context.Context, *CommitTopicMatchingRequest) (*CommitTopicMatchingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitTopicMatching not implemented")
+}
 func (UnimplementedThesisServiceServer) CreateTopicCouncil(context.Context, *CreateTopicCouncilRequest) (*CreateTopicCouncilResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTopicCouncil not implemented")
 }
@@ -591,9 +1501,15 @@ func (UnimplementedThesisServiceServer) GetTopicCouncil(context.Context, *GetTop
 func (UnimplementedThesisServiceServer) UpdateTopicCouncil(context.Context, *UpdateTopicCouncilRequest) (*UpdateTopicCouncilResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTopicCouncil not implemented")
 }
+func (UnimplementedThesisServiceServer) ScheduleTopicCouncils(context.Context, *ScheduleTopicCouncilsRequest) (*ScheduleTopicCouncilsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleTopicCouncils not implemented")
+}
 func (UnimplementedThesisServiceServer) DeleteTopicCouncil(context.Context, *DeleteTopicCouncilRequest) (*DeleteTopicCouncilResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTopicCouncil not implemented")
 }
+func (UnimplementedThesisServiceServer) RestoreTopicCouncil(context.Context, *RestoreTopicCouncilRequest) (*RestoreTopicCouncilResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTopicCouncil not implemented")
+}
 func (UnimplementedThesisServiceServer) ListTopicCouncils(context.Context, *ListTopicCouncilsRequest) (*ListTopicCouncilsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTopicCouncils not implemented")
 }
@@ -609,6 +1525,9 @@ func (UnimplementedThesisServiceServer) UpdateTopicCouncilSupervisor(context.Con
 func (UnimplementedThesisServiceServer) DeleteTopicCouncilSupervisor(context.Context, *DeleteTopicCouncilSupervisorRequest) (*DeleteTopicCouncilSupervisorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTopicCouncilSupervisor not implemented")
 }
+func (UnimplementedThesisServiceServer) RestoreTopicCouncilSupervisor(context.Context, *RestoreTopicCouncilSupervisorRequest) (*RestoreTopicCouncilSupervisorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTopicCouncilSupervisor not implemented")
+}
 func (UnimplementedThesisServiceServer) ListTopicCouncilSupervisors(context.Context, *ListTopicCouncilSupervisorsRequest) (*ListTopicCouncilSupervisorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTopicCouncilSupervisors not implemented")
 }
@@ -624,9 +1543,87 @@ func (UnimplementedThesisServiceServer) UpdateGradeReview(context.Context, *Upda
 func (UnimplementedThesisServiceServer) DeleteGradeReview(context.Context, *DeleteGradeReviewRequest) (*DeleteGradeReviewResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteGradeReview not implemented")
 }
+func (UnimplementedThesisServiceServer) RestoreGradeReview(context.Context, *RestoreGradeReviewRequest) (*RestoreGradeReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreGradeReview not implemented")
+}
 func (UnimplementedThesisServiceServer) ListGradeReviews(context.Context, *ListGradeReviewsRequest) (*ListGradeReviewsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListGradeReviews not implemented")
 }
+func (UnimplementedThesisServiceServer) SetSubmissionDeadline(context.Context, *SetSubmissionDeadlineRequest) (*SetSubmissionDeadlineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSubmissionDeadline not implemented")
+}
+func (UnimplementedThesisServiceServer) ListSubmissionDeadlines(context.Context, *ListSubmissionDeadlinesRequest) (*ListSubmissionDeadlinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubmissionDeadlines not implemented")
+}
+func (UnimplementedThesisServiceServer) GrantDeadlineExtension(context.Context, *GrantDeadlineExtensionRequest) (*GrantDeadlineExtensionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantDeadlineExtension not implemented")
+}
+func (UnimplementedThesisServiceServer) CheckSubmissionWindow(context.Context, *CheckSubmissionWindowRequest) (*CheckSubmissionWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckSubmissionWindow not implemented")
+}
+func (UnimplementedThesisServiceServer) SetGradingPolicy(context.Context, *SetGradingPolicyRequest) (*SetGradingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGradingPolicy not implemented")
+}
+func (UnimplementedThesisServiceServer) ListGradingPolicies(context.Context, *ListGradingPoliciesRequest) (*ListGradingPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGradingPolicies not implemented")
+}
+func (UnimplementedThesisServiceServer) DeleteGradingPolicy(context.Context, *DeleteGradingPolicyRequest) (*DeleteGradingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGradingPolicy not implemented")
+}
+func (UnimplementedThesisServiceServer) ComputeFinalGrade(context.Context, *ComputeFinalGradeRequest) (*ComputeFinalGradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComputeFinalGrade not implemented")
+}
+func (UnimplementedThesisServiceServer) LockSemesterGrades(context.Context, *LockSemesterGradesRequest) (*LockSemesterGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockSemesterGrades not implemented")
+}
+func (UnimplementedThesisServiceServer) PublishSemesterGrades(context.Context, *PublishSemesterGradesRequest) (*PublishSemesterGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishSemesterGrades not implemented")
+}
+func (UnimplementedThesisServiceServer) GetSemesterGradeStatus(context.Context, *GetSemesterGradeStatusRequest) (*GetSemesterGradeStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSemesterGradeStatus not implemented")
+}
+func (UnimplementedThesisServiceServer) RequestGradeAmendment(context.Context, *RequestGradeAmendmentRequest) (*RequestGradeAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestGradeAmendment not implemented")
+}
+func (UnimplementedThesisServiceServer) DecideGradeAmendment(context.Context, *DecideGradeAmendmentRequest) (*DecideGradeAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecideGradeAmendment not implemented")
+}
+func (UnimplementedThesisServiceServer) GetGradeAmendment(context.Context, *GetGradeAmendmentRequest) (*GetGradeAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradeAmendment not implemented")
+}
+func (UnimplementedThesisServiceServer) ListGradeAmendments(context.Context, *ListGradeAmendmentsRequest) (*ListGradeAmendmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGradeAmendments not implemented")
+}
+func (UnimplementedThesisServiceServer) SetGradeAppealWindow(context.Context, *SetGradeAppealWindowRequest) (*SetGradeAppealWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGradeAppealWindow not implemented")
+}
+func (UnimplementedThesisServiceServer) FileGradeAppeal(context.Context, *FileGradeAppealRequest) (*FileGradeAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FileGradeAppeal not implemented")
+}
+func (UnimplementedThesisServiceServer) AssignGradeAppeal(context.Context, *AssignGradeAppealRequest) (*AssignGradeAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignGradeAppeal not implemented")
+}
+func (UnimplementedThesisServiceServer) ResolveGradeAppeal(context.Context, *ResolveGradeAppealRequest) (*ResolveGradeAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveGradeAppeal not implemented")
+}
+func (UnimplementedThesisServiceServer) RequestGradeAppealAmendment(context.Context, *RequestGradeAppealAmendmentRequest) (*RequestGradeAppealAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestGradeAppealAmendment not implemented")
+}
+func (UnimplementedThesisServiceServer) SettleGradeAppealAmendment(context.Context, *SettleGradeAppealAmendmentRequest) (*SettleGradeAppealAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleGradeAppealAmendment not implemented")
+}
+func (UnimplementedThesisServiceServer) GetGradeAppeal(context.Context, *GetGradeAppealRequest) (*GetGradeAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradeAppeal not implemented")
+}
+func (UnimplementedThesisServiceServer) ListGradeAppeals(context.Context, *ListGradeAppealsRequest) (*ListGradeAppealsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGradeAppeals not implemented")
+}
+func (UnimplementedThesisServiceServer) ListCouncilTopics(context.Context, *ListCouncilTopicsRequest) (*ListCouncilTopicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCouncilTopics not implemented")
+}
+func (UnimplementedThesisServiceServer) CheckReferences(context.Context, *common.ReferenceCheckRequest) (*common.ReferenceCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckReferences not implemented")
+}
 func (UnimplementedThesisServiceServer) mustEmbedUnimplementedThesisServiceServer() {}
 func (UnimplementedThesisServiceServer) testEmbeddedByValue()                       {}
 
@@ -720,43 +1717,205 @@ func _ThesisService_DeleteMidterm_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_ListMidterms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMidtermsRequest)
+func _ThesisService_RestoreMidterm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMidtermRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).ListMidterms(ctx, in)
+		return srv.(ThesisServiceServer).RestoreMidterm(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_ListMidterms_FullMethodName,
+		FullMethod: ThesisService_RestoreMidterm_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).ListMidterms(ctx, req.(*ListMidtermsRequest))
+		return srv.(ThesisServiceServer).RestoreMidterm(ctx, req.(*RestoreMidtermRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_CreateFinal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateFinalRequest)
+func _ThesisService_ListMidterms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMidtermsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).CreateFinal(ctx, in)
+		return srv.(ThesisServiceServer).ListMidterms(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_CreateFinal_FullMethodName,
+		FullMethod: ThesisService_ListMidterms_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).CreateFinal(ctx, req.(*CreateFinalRequest))
+		return srv.(ThesisServiceServer).ListMidterms(ctx, req.(*ListMidtermsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_GetFinal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ThesisService_CreateMidtermMilestone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMidtermMilestoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).CreateMidtermMilestone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_CreateMidtermMilestone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).CreateMidtermMilestone(ctx, req.(*CreateMidtermMilestoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_UpdateMidtermMilestone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMidtermMilestoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).UpdateMidtermMilestone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_UpdateMidtermMilestone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).UpdateMidtermMilestone(ctx, req.(*UpdateMidtermMilestoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_DeleteMidtermMilestone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMidtermMilestoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).DeleteMidtermMilestone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_DeleteMidtermMilestone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).DeleteMidtermMilestone(ctx, req.(*DeleteMidtermMilestoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_ListMidtermMilestones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMidtermMilestonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).ListMidtermMilestones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_ListMidtermMilestones_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).ListMidtermMilestones(ctx, req.(*ListMidtermMilestonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_SubmitMilestoneCheckin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitMilestoneCheckinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).SubmitMilestoneCheckin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_SubmitMilestoneCheckin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).SubmitMilestoneCheckin(ctx, req.(*SubmitMilestoneCheckinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_ReviewMilestoneCheckin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewMilestoneCheckinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).ReviewMilestoneCheckin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_ReviewMilestoneCheckin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).ReviewMilestoneCheckin(ctx, req.(*ReviewMilestoneCheckinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_GetMilestoneCheckin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMilestoneCheckinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).GetMilestoneCheckin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_GetMilestoneCheckin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).GetMilestoneCheckin(ctx, req.(*GetMilestoneCheckinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_ListMilestoneCheckins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMilestoneCheckinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).ListMilestoneCheckins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_ListMilestoneCheckins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).ListMilestoneCheckins(ctx, req.(*ListMilestoneCheckinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_CreateFinal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFinalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).CreateFinal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_CreateFinal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).CreateFinal(ctx, req.(*CreateFinalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_GetFinal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetFinalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -810,470 +1969,1406 @@ func _ThesisService_DeleteFinal_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ThesisService_RestoreFinal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreFinalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).RestoreFinal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_RestoreFinal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).RestoreFinal(ctx, req.(*RestoreFinalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ThesisService_ListFinals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListFinalsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).ListFinals(ctx, in)
+		return srv.(ThesisServiceServer).ListFinals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_ListFinals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).ListFinals(ctx, req.(*ListFinalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_CreateEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).CreateEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_CreateEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).CreateEnrollment(ctx, req.(*CreateEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_GetEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).GetEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_GetEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).GetEnrollment(ctx, req.(*GetEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_UpdateEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).UpdateEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_UpdateEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).UpdateEnrollment(ctx, req.(*UpdateEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_DeleteEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).DeleteEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_DeleteEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).DeleteEnrollment(ctx, req.(*DeleteEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_RestoreEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).RestoreEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_RestoreEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).RestoreEnrollment(ctx, req.(*RestoreEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_ListEnrollments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEnrollmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).ListEnrollments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_ListEnrollments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).ListEnrollments(ctx, req.(*ListEnrollmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_CreateEnrollmentBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEnrollmentBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).CreateEnrollmentBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_CreateEnrollmentBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).CreateEnrollmentBundle(ctx, req.(*CreateEnrollmentBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_DeleteEnrollmentCascade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEnrollmentCascadeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).DeleteEnrollmentCascade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_DeleteEnrollmentCascade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).DeleteEnrollmentCascade(ctx, req.(*DeleteEnrollmentCascadeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_CreateTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).CreateTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_CreateTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).CreateTopic(ctx, req.(*CreateTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_GetTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).GetTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_GetTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).GetTopic(ctx, req.(*GetTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_UpdateTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).UpdateTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_UpdateTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).UpdateTopic(ctx, req.(*UpdateTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_DeleteTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).DeleteTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_DeleteTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).DeleteTopic(ctx, req.(*DeleteTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_RestoreTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).RestoreTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_RestoreTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).RestoreTopic(ctx, req.(*RestoreTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_ListTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).ListTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_ListTopics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).ListTopics(ctx, req.(*ListTopicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_SearchTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTopicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).SearchTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_SearchTopics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).SearchTopics(ctx, req.(*SearchTopicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_SubmitTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).SubmitTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_SubmitTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).SubmitTopic(ctx, req.(*SubmitTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_ApproveTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).ApproveTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_ApproveTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).ApproveTopic(ctx, req.(*ApproveTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_RejectTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).RejectTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_RejectTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).RejectTopic(ctx, req.(*RejectTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_StartTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).StartTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_StartTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).StartTopic(ctx, req.(*StartTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_CompleteTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).CompleteTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_CompleteTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).CompleteTopic(ctx, req.(*CompleteTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_ListTopicStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopicStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).ListTopicStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_ListTopicStatusHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).ListTopicStatusHistory(ctx, req.(*ListTopicStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_ProposeTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposeTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).ProposeTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_ProposeTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).ProposeTopic(ctx, req.(*ProposeTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_CoSignTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CoSignTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).CoSignTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_CoSignTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).CoSignTopic(ctx, req.(*CoSignTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_ListTopicCoSigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopicCoSignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).ListTopicCoSigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_ListTopicCoSigns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).ListTopicCoSigns(ctx, req.(*ListTopicCoSignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_SetRegistrationWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRegistrationWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).SetRegistrationWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_SetRegistrationWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).SetRegistrationWindow(ctx, req.(*SetRegistrationWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_GetRegistrationWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegistrationWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).GetRegistrationWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_GetRegistrationWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).GetRegistrationWindow(ctx, req.(*GetRegistrationWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_RegisterTopicPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterTopicPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).RegisterTopicPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_RegisterTopicPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).RegisterTopicPreferences(ctx, req.(*RegisterTopicPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_ListTopicRegistrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopicRegistrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).ListTopicRegistrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_ListTopicRegistrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).ListTopicRegistrations(ctx, req.(*ListTopicRegistrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_DecideTopicRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideTopicRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).DecideTopicRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_DecideTopicRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).DecideTopicRegistration(ctx, req.(*DecideTopicRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_SetApplicantRanking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetApplicantRankingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).SetApplicantRanking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_SetApplicantRanking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).SetApplicantRanking(ctx, req.(*SetApplicantRankingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_PreviewTopicMatching_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewTopicMatchingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).PreviewTopicMatching(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_PreviewTopicMatching_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).PreviewTopicMatching(ctx, req.(*PreviewTopicMatchingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_CommitTopicMatching_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitTopicMatchingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).CommitTopicMatching(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_CommitTopicMatching_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).CommitTopicMatching(ctx, req.(*CommitTopicMatchingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_CreateTopicCouncil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTopicCouncilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).CreateTopicCouncil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_CreateTopicCouncil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).CreateTopicCouncil(ctx, req.(*CreateTopicCouncilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_GetTopicCouncil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicCouncilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).GetTopicCouncil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_GetTopicCouncil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).GetTopicCouncil(ctx, req.(*GetTopicCouncilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_UpdateTopicCouncil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTopicCouncilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).UpdateTopicCouncil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_UpdateTopicCouncil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).UpdateTopicCouncil(ctx, req.(*UpdateTopicCouncilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_ScheduleTopicCouncils_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleTopicCouncilsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).ScheduleTopicCouncils(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_ScheduleTopicCouncils_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).ScheduleTopicCouncils(ctx, req.(*ScheduleTopicCouncilsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_DeleteTopicCouncil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTopicCouncilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).DeleteTopicCouncil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_DeleteTopicCouncil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).DeleteTopicCouncil(ctx, req.(*DeleteTopicCouncilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_RestoreTopicCouncil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTopicCouncilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).RestoreTopicCouncil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_RestoreTopicCouncil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).RestoreTopicCouncil(ctx, req.(*RestoreTopicCouncilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_ListTopicCouncils_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopicCouncilsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).ListTopicCouncils(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_ListTopicCouncils_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).ListTopicCouncils(ctx, req.(*ListTopicCouncilsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_CreateTopicCouncilSupervisor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTopicCouncilSupervisorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).CreateTopicCouncilSupervisor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_CreateTopicCouncilSupervisor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).CreateTopicCouncilSupervisor(ctx, req.(*CreateTopicCouncilSupervisorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_GetTopicCouncilSupervisor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicCouncilSupervisorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).GetTopicCouncilSupervisor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_GetTopicCouncilSupervisor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).GetTopicCouncilSupervisor(ctx, req.(*GetTopicCouncilSupervisorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_UpdateTopicCouncilSupervisor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTopicCouncilSupervisorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).UpdateTopicCouncilSupervisor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_UpdateTopicCouncilSupervisor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).UpdateTopicCouncilSupervisor(ctx, req.(*UpdateTopicCouncilSupervisorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_DeleteTopicCouncilSupervisor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTopicCouncilSupervisorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).DeleteTopicCouncilSupervisor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_DeleteTopicCouncilSupervisor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).DeleteTopicCouncilSupervisor(ctx, req.(*DeleteTopicCouncilSupervisorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_RestoreTopicCouncilSupervisor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTopicCouncilSupervisorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).RestoreTopicCouncilSupervisor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_RestoreTopicCouncilSupervisor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).RestoreTopicCouncilSupervisor(ctx, req.(*RestoreTopicCouncilSupervisorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_ListTopicCouncilSupervisors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopicCouncilSupervisorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).ListTopicCouncilSupervisors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_ListTopicCouncilSupervisors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).ListTopicCouncilSupervisors(ctx, req.(*ListTopicCouncilSupervisorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_CreateGradeReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGradeReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).CreateGradeReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_CreateGradeReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).CreateGradeReview(ctx, req.(*CreateGradeReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_GetGradeReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradeReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).GetGradeReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_GetGradeReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).GetGradeReview(ctx, req.(*GetGradeReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_UpdateGradeReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGradeReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).UpdateGradeReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_UpdateGradeReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).UpdateGradeReview(ctx, req.(*UpdateGradeReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_DeleteGradeReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGradeReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).DeleteGradeReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_DeleteGradeReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).DeleteGradeReview(ctx, req.(*DeleteGradeReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_RestoreGradeReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreGradeReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).RestoreGradeReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThesisService_RestoreGradeReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThesisServiceServer).RestoreGradeReview(ctx, req.(*RestoreGradeReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThesisService_ListGradeReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGradeReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThesisServiceServer).ListGradeReviews(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_ListFinals_FullMethodName,
+		FullMethod: ThesisService_ListGradeReviews_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).ListFinals(ctx, req.(*ListFinalsRequest))
+		return srv.(ThesisServiceServer).ListGradeReviews(ctx, req.(*ListGradeReviewsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_CreateEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateEnrollmentRequest)
+func _ThesisService_SetSubmissionDeadline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSubmissionDeadlineRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).CreateEnrollment(ctx, in)
+		return srv.(ThesisServiceServer).SetSubmissionDeadline(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_CreateEnrollment_FullMethodName,
+		FullMethod: ThesisService_SetSubmissionDeadline_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).CreateEnrollment(ctx, req.(*CreateEnrollmentRequest))
+		return srv.(ThesisServiceServer).SetSubmissionDeadline(ctx, req.(*SetSubmissionDeadlineRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_GetEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEnrollmentRequest)
+func _ThesisService_ListSubmissionDeadlines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubmissionDeadlinesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).GetEnrollment(ctx, in)
+		return srv.(ThesisServiceServer).ListSubmissionDeadlines(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_GetEnrollment_FullMethodName,
+		FullMethod: ThesisService_ListSubmissionDeadlines_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).GetEnrollment(ctx, req.(*GetEnrollmentRequest))
+		return srv.(ThesisServiceServer).ListSubmissionDeadlines(ctx, req.(*ListSubmissionDeadlinesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_UpdateEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateEnrollmentRequest)
+func _ThesisService_GrantDeadlineExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantDeadlineExtensionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).UpdateEnrollment(ctx, in)
+		return srv.(ThesisServiceServer).GrantDeadlineExtension(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_UpdateEnrollment_FullMethodName,
+		FullMethod: ThesisService_GrantDeadlineExtension_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).UpdateEnrollment(ctx, req.(*UpdateEnrollmentRequest))
+		return srv.(ThesisServiceServer).GrantDeadlineExtension(ctx, req.(*GrantDeadlineExtensionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_DeleteEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteEnrollmentRequest)
+func _ThesisService_CheckSubmissionWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckSubmissionWindowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).DeleteEnrollment(ctx, in)
+		return srv.(ThesisServiceServer).CheckSubmissionWindow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_DeleteEnrollment_FullMethodName,
+		FullMethod: ThesisService_CheckSubmissionWindow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).DeleteEnrollment(ctx, req.(*DeleteEnrollmentRequest))
+		return srv.(ThesisServiceServer).CheckSubmissionWindow(ctx, req.(*CheckSubmissionWindowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_ListEnrollments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListEnrollmentsRequest)
+func _ThesisService_SetGradingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGradingPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).ListEnrollments(ctx, in)
+		return srv.(ThesisServiceServer).SetGradingPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_ListEnrollments_FullMethodName,
+		FullMethod: ThesisService_SetGradingPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).ListEnrollments(ctx, req.(*ListEnrollmentsRequest))
+		return srv.(ThesisServiceServer).SetGradingPolicy(ctx, req.(*SetGradingPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_CreateTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTopicRequest)
+func _ThesisService_ListGradingPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGradingPoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).CreateTopic(ctx, in)
+		return srv.(ThesisServiceServer).ListGradingPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_CreateTopic_FullMethodName,
+		FullMethod: ThesisService_ListGradingPolicies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).CreateTopic(ctx, req.(*CreateTopicRequest))
+		return srv.(ThesisServiceServer).ListGradingPolicies(ctx, req.(*ListGradingPoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_GetTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTopicRequest)
+func _ThesisService_DeleteGradingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGradingPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).GetTopic(ctx, in)
+		return srv.(ThesisServiceServer).DeleteGradingPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_GetTopic_FullMethodName,
+		FullMethod: ThesisService_DeleteGradingPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).GetTopic(ctx, req.(*GetTopicRequest))
+		return srv.(ThesisServiceServer).DeleteGradingPolicy(ctx, req.(*DeleteGradingPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_UpdateTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTopicRequest)
+func _ThesisService_ComputeFinalGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComputeFinalGradeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).UpdateTopic(ctx, in)
+		return srv.(ThesisServiceServer).ComputeFinalGrade(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_UpdateTopic_FullMethodName,
+		FullMethod: ThesisService_ComputeFinalGrade_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).UpdateTopic(ctx, req.(*UpdateTopicRequest))
+		return srv.(ThesisServiceServer).ComputeFinalGrade(ctx, req.(*ComputeFinalGradeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_DeleteTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTopicRequest)
+func _ThesisService_LockSemesterGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockSemesterGradesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).DeleteTopic(ctx, in)
+		return srv.(ThesisServiceServer).LockSemesterGrades(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_DeleteTopic_FullMethodName,
+		FullMethod: ThesisService_LockSemesterGrades_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).DeleteTopic(ctx, req.(*DeleteTopicRequest))
+		return srv.(ThesisServiceServer).LockSemesterGrades(ctx, req.(*LockSemesterGradesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_ListTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTopicsRequest)
+func _ThesisService_PublishSemesterGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishSemesterGradesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).ListTopics(ctx, in)
+		return srv.(ThesisServiceServer).PublishSemesterGrades(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_ListTopics_FullMethodName,
+		FullMethod: ThesisService_PublishSemesterGrades_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).ListTopics(ctx, req.(*ListTopicsRequest))
+		return srv.(ThesisServiceServer).PublishSemesterGrades(ctx, req.(*PublishSemesterGradesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_CreateTopicCouncil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTopicCouncilRequest)
+func _ThesisService_GetSemesterGradeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSemesterGradeStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).CreateTopicCouncil(ctx, in)
+		return srv.(ThesisServiceServer).GetSemesterGradeStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_CreateTopicCouncil_FullMethodName,
+		FullMethod: ThesisService_GetSemesterGradeStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).CreateTopicCouncil(ctx, req.(*CreateTopicCouncilRequest))
+		return srv.(ThesisServiceServer).GetSemesterGradeStatus(ctx, req.(*GetSemesterGradeStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_GetTopicCouncil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTopicCouncilRequest)
+func _ThesisService_RequestGradeAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestGradeAmendmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).GetTopicCouncil(ctx, in)
+		return srv.(ThesisServiceServer).RequestGradeAmendment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_GetTopicCouncil_FullMethodName,
+		FullMethod: ThesisService_RequestGradeAmendment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).GetTopicCouncil(ctx, req.(*GetTopicCouncilRequest))
+		return srv.(ThesisServiceServer).RequestGradeAmendment(ctx, req.(*RequestGradeAmendmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_UpdateTopicCouncil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTopicCouncilRequest)
+func _ThesisService_DecideGradeAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideGradeAmendmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).UpdateTopicCouncil(ctx, in)
+		return srv.(ThesisServiceServer).DecideGradeAmendment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_UpdateTopicCouncil_FullMethodName,
+		FullMethod: ThesisService_DecideGradeAmendment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).UpdateTopicCouncil(ctx, req.(*UpdateTopicCouncilRequest))
+		return srv.(ThesisServiceServer).DecideGradeAmendment(ctx, req.(*DecideGradeAmendmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_DeleteTopicCouncil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTopicCouncilRequest)
+func _ThesisService_GetGradeAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradeAmendmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).DeleteTopicCouncil(ctx, in)
+		return srv.(ThesisServiceServer).GetGradeAmendment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_DeleteTopicCouncil_FullMethodName,
+		FullMethod: ThesisService_GetGradeAmendment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).DeleteTopicCouncil(ctx, req.(*DeleteTopicCouncilRequest))
+		return srv.(ThesisServiceServer).GetGradeAmendment(ctx, req.(*GetGradeAmendmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_ListTopicCouncils_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTopicCouncilsRequest)
+func _ThesisService_ListGradeAmendments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGradeAmendmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).ListTopicCouncils(ctx, in)
+		return srv.(ThesisServiceServer).ListGradeAmendments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_ListTopicCouncils_FullMethodName,
+		FullMethod: ThesisService_ListGradeAmendments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).ListTopicCouncils(ctx, req.(*ListTopicCouncilsRequest))
+		return srv.(ThesisServiceServer).ListGradeAmendments(ctx, req.(*ListGradeAmendmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_CreateTopicCouncilSupervisor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTopicCouncilSupervisorRequest)
+func _ThesisService_SetGradeAppealWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGradeAppealWindowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).CreateTopicCouncilSupervisor(ctx, in)
+		return srv.(ThesisServiceServer).SetGradeAppealWindow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_CreateTopicCouncilSupervisor_FullMethodName,
+		FullMethod: ThesisService_SetGradeAppealWindow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).CreateTopicCouncilSupervisor(ctx, req.(*CreateTopicCouncilSupervisorRequest))
+		return srv.(ThesisServiceServer).SetGradeAppealWindow(ctx, req.(*SetGradeAppealWindowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_GetTopicCouncilSupervisor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTopicCouncilSupervisorRequest)
+func _ThesisService_FileGradeAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileGradeAppealRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).GetTopicCouncilSupervisor(ctx, in)
+		return srv.(ThesisServiceServer).FileGradeAppeal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_GetTopicCouncilSupervisor_FullMethodName,
+		FullMethod: ThesisService_FileGradeAppeal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).GetTopicCouncilSupervisor(ctx, req.(*GetTopicCouncilSupervisorRequest))
+		return srv.(ThesisServiceServer).FileGradeAppeal(ctx, req.(*FileGradeAppealRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_UpdateTopicCouncilSupervisor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTopicCouncilSupervisorRequest)
+func _ThesisService_AssignGradeAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignGradeAppealRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).UpdateTopicCouncilSupervisor(ctx, in)
+		return srv.(ThesisServiceServer).AssignGradeAppeal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_UpdateTopicCouncilSupervisor_FullMethodName,
+		FullMethod: ThesisService_AssignGradeAppeal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).UpdateTopicCouncilSupervisor(ctx, req.(*UpdateTopicCouncilSupervisorRequest))
+		return srv.(ThesisServiceServer).AssignGradeAppeal(ctx, req.(*AssignGradeAppealRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_DeleteTopicCouncilSupervisor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTopicCouncilSupervisorRequest)
+func _ThesisService_ResolveGradeAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveGradeAppealRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).DeleteTopicCouncilSupervisor(ctx, in)
+		return srv.(ThesisServiceServer).ResolveGradeAppeal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_DeleteTopicCouncilSupervisor_FullMethodName,
+		FullMethod: ThesisService_ResolveGradeAppeal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).DeleteTopicCouncilSupervisor(ctx, req.(*DeleteTopicCouncilSupervisorRequest))
+		return srv.(ThesisServiceServer).ResolveGradeAppeal(ctx, req.(*ResolveGradeAppealRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_ListTopicCouncilSupervisors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTopicCouncilSupervisorsRequest)
+func _ThesisService_RequestGradeAppealAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestGradeAppealAmendmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).ListTopicCouncilSupervisors(ctx, in)
+		return srv.(ThesisServiceServer).RequestGradeAppealAmendment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_ListTopicCouncilSupervisors_FullMethodName,
+		FullMethod: ThesisService_RequestGradeAppealAmendment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).ListTopicCouncilSupervisors(ctx, req.(*ListTopicCouncilSupervisorsRequest))
+		return srv.(ThesisServiceServer).RequestGradeAppealAmendment(ctx, req.(*RequestGradeAppealAmendmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_CreateGradeReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateGradeReviewRequest)
+func _ThesisService_SettleGradeAppealAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleGradeAppealAmendmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).CreateGradeReview(ctx, in)
+		return srv.(ThesisServiceServer).SettleGradeAppealAmendment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_CreateGradeReview_FullMethodName,
+		FullMethod: ThesisService_SettleGradeAppealAmendment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).CreateGradeReview(ctx, req.(*CreateGradeReviewRequest))
+		return srv.(ThesisServiceServer).SettleGradeAppealAmendment(ctx, req.(*SettleGradeAppealAmendmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_GetGradeReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGradeReviewRequest)
+func _ThesisService_GetGradeAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradeAppealRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).GetGradeReview(ctx, in)
+		return srv.(ThesisServiceServer).GetGradeAppeal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_GetGradeReview_FullMethodName,
+		FullMethod: ThesisService_GetGradeAppeal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).GetGradeReview(ctx, req.(*GetGradeReviewRequest))
+		return srv.(ThesisServiceServer).GetGradeAppeal(ctx, req.(*GetGradeAppealRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_UpdateGradeReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateGradeReviewRequest)
+func _ThesisService_ListGradeAppeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGradeAppealsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).UpdateGradeReview(ctx, in)
+		return srv.(ThesisServiceServer).ListGradeAppeals(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_UpdateGradeReview_FullMethodName,
+		FullMethod: ThesisService_ListGradeAppeals_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).UpdateGradeReview(ctx, req.(*UpdateGradeReviewRequest))
+		return srv.(ThesisServiceServer).ListGradeAppeals(ctx, req.(*ListGradeAppealsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_DeleteGradeReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteGradeReviewRequest)
+func _ThesisService_ListCouncilTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCouncilTopicsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).DeleteGradeReview(ctx, in)
+		return srv.(ThesisServiceServer).ListCouncilTopics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_DeleteGradeReview_FullMethodName,
+		FullMethod: ThesisService_ListCouncilTopics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).DeleteGradeReview(ctx, req.(*DeleteGradeReviewRequest))
+		return srv.(ThesisServiceServer).ListCouncilTopics(ctx, req.(*ListCouncilTopicsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThesisService_ListGradeReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListGradeReviewsRequest)
+func _ThesisService_CheckReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.ReferenceCheckRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThesisServiceServer).ListGradeReviews(ctx, in)
+		return srv.(ThesisServiceServer).CheckReferences(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThesisService_ListGradeReviews_FullMethodName,
+		FullMethod: ThesisService_CheckReferences_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThesisServiceServer).ListGradeReviews(ctx, req.(*ListGradeReviewsRequest))
+		return srv.(ThesisServiceServer).CheckReferences(ctx, req.(*common.ReferenceCheckRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1301,10 +3396,46 @@ var ThesisService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMidterm",
 			Handler:    _ThesisService_DeleteMidterm_Handler,
 		},
+		{
+			MethodName: "RestoreMidterm",
+			Handler:    _ThesisService_RestoreMidterm_Handler,
+		},
 		{
 			MethodName: "ListMidterms",
 			Handler:    _ThesisService_ListMidterms_Handler,
 		},
+		{
+			MethodName: "CreateMidtermMilestone",
+			Handler:    _ThesisService_CreateMidtermMilestone_Handler,
+		},
+		{
+			MethodName: "UpdateMidtermMilestone",
+			Handler:    _ThesisService_UpdateMidtermMilestone_Handler,
+		},
+		{
+			MethodName: "DeleteMidtermMilestone",
+			Handler:    _ThesisService_DeleteMidtermMilestone_Handler,
+		},
+		{
+			MethodName: "ListMidtermMilestones",
+			Handler:    _ThesisService_ListMidtermMilestones_Handler,
+		},
+		{
+			MethodName: "SubmitMilestoneCheckin",
+			Handler:    _ThesisService_SubmitMilestoneCheckin_Handler,
+		},
+		{
+			MethodName: "ReviewMilestoneCheckin",
+			Handler:    _ThesisService_ReviewMilestoneCheckin_Handler,
+		},
+		{
+			MethodName: "GetMilestoneCheckin",
+			Handler:    _ThesisService_GetMilestoneCheckin_Handler,
+		},
+		{
+			MethodName: "ListMilestoneCheckins",
+			Handler:    _ThesisService_ListMilestoneCheckins_Handler,
+		},
 		{
 			MethodName: "CreateFinal",
 			Handler:    _ThesisService_CreateFinal_Handler,
@@ -1321,6 +3452,10 @@ var ThesisService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFinal",
 			Handler:    _ThesisService_DeleteFinal_Handler,
 		},
+		{
+			MethodName: "RestoreFinal",
+			Handler:    _ThesisService_RestoreFinal_Handler,
+		},
 		{
 			MethodName: "ListFinals",
 			Handler:    _ThesisService_ListFinals_Handler,
@@ -1341,10 +3476,22 @@ var ThesisService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEnrollment",
 			Handler:    _ThesisService_DeleteEnrollment_Handler,
 		},
+		{
+			MethodName: "RestoreEnrollment",
+			Handler:    _ThesisService_RestoreEnrollment_Handler,
+		},
 		{
 			MethodName: "ListEnrollments",
 			Handler:    _ThesisService_ListEnrollments_Handler,
 		},
+		{
+			MethodName: "CreateEnrollmentBundle",
+			Handler:    _ThesisService_CreateEnrollmentBundle_Handler,
+		},
+		{
+			MethodName: "DeleteEnrollmentCascade",
+			Handler:    _ThesisService_DeleteEnrollmentCascade_Handler,
+		},
 		{
 			MethodName: "CreateTopic",
 			Handler:    _ThesisService_CreateTopic_Handler,
@@ -1361,10 +3508,86 @@ var ThesisService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTopic",
 			Handler:    _ThesisService_DeleteTopic_Handler,
 		},
+		{
+			MethodName: "RestoreTopic",
+			Handler:    _ThesisService_RestoreTopic_Handler,
+		},
 		{
 			MethodName: "ListTopics",
 			Handler:    _ThesisService_ListTopics_Handler,
 		},
+		{
+			MethodName: "SearchTopics",
+			Handler:    _ThesisService_SearchTopics_Handler,
+		},
+		{
+			MethodName: "SubmitTopic",
+			Handler:    _ThesisService_SubmitTopic_Handler,
+		},
+		{
+			MethodName: "ApproveTopic",
+			Handler:    _ThesisService_ApproveTopic_Handler,
+		},
+		{
+			MethodName: "RejectTopic",
+			Handler:    _ThesisService_RejectTopic_Handler,
+		},
+		{
+			MethodName: "StartTopic",
+			Handler:    _ThesisService_StartTopic_Handler,
+		},
+		{
+			MethodName: "CompleteTopic",
+			Handler:    _ThesisService_CompleteTopic_Handler,
+		},
+		{
+			MethodName: "ListTopicStatusHistory",
+			Handler:    _ThesisService_ListTopicStatusHistory_Handler,
+		},
+		{
+			MethodName: "ProposeTopic",
+			Handler:    _ThesisService_ProposeTopic_Handler,
+		},
+		{
+			MethodName: "CoSignTopic",
+			Handler:    _ThesisService_CoSignTopic_Handler,
+		},
+		{
+			MethodName: "ListTopicCoSigns",
+			Handler:    _ThesisService_ListTopicCoSigns_Handler,
+		},
+		{
+			MethodName: "SetRegistrationWindow",
+			Handler:    _ThesisService_SetRegistrationWindow_Handler,
+		},
+		{
+			MethodName: "GetRegistrationWindow",
+			Handler:    _ThesisService_GetRegistrationWindow_Handler,
+		},
+		{
+			MethodName: "RegisterTopicPreferences",
+			Handler:    _ThesisService_RegisterTopicPreferences_Handler,
+		},
+		{
+			MethodName: "ListTopicRegistrations",
+			Handler:    _ThesisService_ListTopicRegistrations_Handler,
+		},
+		{
+			MethodName: "DecideTopicRegistration",
+			Handler:    _ThesisService_DecideTopicRegistration_Handler,
+		},
+		{
+			MethodName: "SetApplicantRanking",
+			Handler:    _ThesisService_SetApplicantRanking_Handler,
+		},
+		{
+			MethodName: "PreviewTopicMatching",
+			Handler:    _ThesisService_PreviewTopicMatching_Handler,
+		},
+		{
+			MethodName: "CommitTopicMatching",
+			Handler:    _ThesisService_CommitTopicMatching_Handler,
+		},
 		{
 			MethodName: "CreateTopicCouncil",
 			Handler:    _ThesisService_CreateTopicCouncil_Handler,
@@ -1377,10 +3600,18 @@ var ThesisService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateTopicCouncil",
 			Handler:    _ThesisService_UpdateTopicCouncil_Handler,
 		},
+		{
+			MethodName: "ScheduleTopicCouncils",
+			Handler:    _ThesisService_ScheduleTopicCouncils_Handler,
+		},
 		{
 			MethodName: "DeleteTopicCouncil",
 			Handler:    _ThesisService_DeleteTopicCouncil_Handler,
 		},
+		{
+			MethodName: "RestoreTopicCouncil",
+			Handler:    _ThesisService_RestoreTopicCouncil_Handler,
+		},
 		{
 			MethodName: "ListTopicCouncils",
 			Handler:    _ThesisService_ListTopicCouncils_Handler,
@@ -1401,6 +3632,10 @@ var ThesisService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTopicCouncilSupervisor",
 			Handler:    _ThesisService_DeleteTopicCouncilSupervisor_Handler,
 		},
+		{
+			MethodName: "RestoreTopicCouncilSupervisor",
+			Handler:    _ThesisService_RestoreTopicCouncilSupervisor_Handler,
+		},
 		{
 			MethodName: "ListTopicCouncilSupervisors",
 			Handler:    _ThesisService_ListTopicCouncilSupervisors_Handler,
@@ -1421,10 +3656,114 @@ var ThesisService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteGradeReview",
 			Handler:    _ThesisService_DeleteGradeReview_Handler,
 		},
+		{
+			MethodName: "RestoreGradeReview",
+			Handler:    _ThesisService_RestoreGradeReview_Handler,
+		},
 		{
 			MethodName: "ListGradeReviews",
 			Handler:    _ThesisService_ListGradeReviews_Handler,
 		},
+		{
+			MethodName: "SetSubmissionDeadline",
+			Handler:    _ThesisService_SetSubmissionDeadline_Handler,
+		},
+		{
+			MethodName: "ListSubmissionDeadlines",
+			Handler:    _ThesisService_ListSubmissionDeadlines_Handler,
+		},
+		{
+			MethodName: "GrantDeadlineExtension",
+			Handler:    _ThesisService_GrantDeadlineExtension_Handler,
+		},
+		{
+			MethodName: "CheckSubmissionWindow",
+			Handler:    _ThesisService_CheckSubmissionWindow_Handler,
+		},
+		{
+			MethodName: "SetGradingPolicy",
+			Handler:    _ThesisService_SetGradingPolicy_Handler,
+		},
+		{
+			MethodName: "ListGradingPolicies",
+			Handler:    _ThesisService_ListGradingPolicies_Handler,
+		},
+		{
+			MethodName: "DeleteGradingPolicy",
+			Handler:    _ThesisService_DeleteGradingPolicy_Handler,
+		},
+		{
+			MethodName: "ComputeFinalGrade",
+			Handler:    _ThesisService_ComputeFinalGrade_Handler,
+		},
+		{
+			MethodName: "LockSemesterGrades",
+			Handler:    _ThesisService_LockSemesterGrades_Handler,
+		},
+		{
+			MethodName: "PublishSemesterGrades",
+			Handler:    _ThesisService_PublishSemesterGrades_Handler,
+		},
+		{
+			MethodName: "GetSemesterGradeStatus",
+			Handler:    _ThesisService_GetSemesterGradeStatus_Handler,
+		},
+		{
+			MethodName: "RequestGradeAmendment",
+			Handler:    _ThesisService_RequestGradeAmendment_Handler,
+		},
+		{
+			MethodName: "DecideGradeAmendment",
+			Handler:    _ThesisService_DecideGradeAmendment_Handler,
+		},
+		{
+			MethodName: "GetGradeAmendment",
+			Handler:    _ThesisService_GetGradeAmendment_Handler,
+		},
+		{
+			MethodName: "ListGradeAmendments",
+			Handler:    _ThesisService_ListGradeAmendments_Handler,
+		},
+		{
+			MethodName: "SetGradeAppealWindow",
+			Handler:    _ThesisService_SetGradeAppealWindow_Handler,
+		},
+		{
+			MethodName: "FileGradeAppeal",
+			Handler:    _ThesisService_FileGradeAppeal_Handler,
+		},
+		{
+			MethodName: "AssignGradeAppeal",
+			Handler:    _ThesisService_AssignGradeAppeal_Handler,
+		},
+		{
+			MethodName: "ResolveGradeAppeal",
+			Handler:    _ThesisService_ResolveGradeAppeal_Handler,
+		},
+		{
+			MethodName: "RequestGradeAppealAmendment",
+			Handler:    _ThesisService_RequestGradeAppealAmendment_Handler,
+		},
+		{
+			MethodName: "SettleGradeAppealAmendment",
+			Handler:    _ThesisService_SettleGradeAppealAmendment_Handler,
+		},
+		{
+			MethodName: "GetGradeAppeal",
+			Handler:    _ThesisService_GetGradeAppeal_Handler,
+		},
+		{
+			MethodName: "ListGradeAppeals",
+			Handler:    _ThesisService_ListGradeAppeals_Handler,
+		},
+		{
+			MethodName: "ListCouncilTopics",
+			Handler:    _ThesisService_ListCouncilTopics_Handler,
+		},
+		{
+			MethodName: "CheckReferences",
+			Handler:    _ThesisService_CheckReferences_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/thesis/thesis.proto",
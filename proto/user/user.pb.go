@@ -87,6 +87,9 @@ type Student struct {
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	CreatedBy     string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	UpdatedBy     string                 `protobuf:"bytes,12,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       int32                  `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`                     // bumped by every update
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // set while the record is soft-deleted
+	DeletedBy     string                 `protobuf:"bytes,15,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -205,6 +208,27 @@ func (x *Student) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *Student) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Student) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Student) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
 type CreateStudentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
@@ -350,10 +374,11 @@ func (x *CreateStudentResponse) GetStudent() *Student {
 }
 
 type GetStudentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also find a soft-deleted record
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetStudentRequest) Reset() {
@@ -393,6 +418,13 @@ func (x *GetStudentRequest) GetId() string {
 	return ""
 }
 
+func (x *GetStudentRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type GetStudentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Student       *Student               `protobuf:"bytes,1,opt,name=student,proto3" json:"student,omitempty"`
@@ -448,6 +480,7 @@ type UpdateStudentRequest struct {
 	ClassCode     *string                `protobuf:"bytes,7,opt,name=class_code,json=classCode,proto3,oneof" json:"class_code,omitempty"`
 	SemesterCode  *string                `protobuf:"bytes,8,opt,name=semester_code,json=semesterCode,proto3,oneof" json:"semester_code,omitempty"`
 	UpdatedBy     string                 `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       *int32                 `protobuf:"varint,10,opt,name=version,proto3,oneof" json:"version,omitempty"` // expected version; a stale one fails with ABORTED
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -545,6 +578,13 @@ func (x *UpdateStudentRequest) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *UpdateStudentRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
 type UpdateStudentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Student       *Student               `protobuf:"bytes,1,opt,name=student,proto3" json:"student,omitempty"`
@@ -592,6 +632,7 @@ func (x *UpdateStudentResponse) GetStudent() *Student {
 type DeleteStudentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -633,6 +674,13 @@ func (x *DeleteStudentRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteStudentRequest) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
 type DeleteStudentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -677,16 +725,113 @@ func (x *DeleteStudentResponse) GetSuccess() bool {
 	return false
 }
 
-type ListStudentsRequest struct {
+type RestoreStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RestoredBy    string                 `protobuf:"bytes,2,opt,name=restored_by,json=restoredBy,proto3" json:"restored_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreStudentRequest) Reset() {
+	*x = RestoreStudentRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreStudentRequest) ProtoMessage() {}
+
+func (x *RestoreStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreStudentRequest.ProtoReflect.Descriptor instead.
+func (*RestoreStudentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RestoreStudentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreStudentRequest) GetRestoredBy() string {
+	if x != nil {
+		return x.RestoredBy
+	}
+	return ""
+}
+
+type RestoreStudentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	Student       *Student               `protobuf:"bytes,1,opt,name=student,proto3" json:"student,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *RestoreStudentResponse) Reset() {
+	*x = RestoreStudentResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreStudentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreStudentResponse) ProtoMessage() {}
+
+func (x *RestoreStudentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreStudentResponse.ProtoReflect.Descriptor instead.
+func (*RestoreStudentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RestoreStudentResponse) GetStudent() *Student {
+	if x != nil {
+		return x.Student
+	}
+	return nil
+}
+
+type ListStudentsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Search         *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also list soft-deleted records
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *ListStudentsRequest) Reset() {
 	*x = ListStudentsRequest{}
-	mi := &file_proto_user_user_proto_msgTypes[9]
+	mi := &file_proto_user_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -698,7 +843,7 @@ func (x *ListStudentsRequest) String() string {
 func (*ListStudentsRequest) ProtoMessage() {}
 
 func (x *ListStudentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[9]
+	mi := &file_proto_user_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -711,7 +856,7 @@ func (x *ListStudentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListStudentsRequest.ProtoReflect.Descriptor instead.
 func (*ListStudentsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{9}
+	return file_proto_user_user_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListStudentsRequest) GetSearch() *common.SearchRequest {
@@ -721,6 +866,13 @@ func (x *ListStudentsRequest) GetSearch() *common.SearchRequest {
 	return nil
 }
 
+func (x *ListStudentsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type ListStudentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Students      []*Student             `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
@@ -733,7 +885,7 @@ type ListStudentsResponse struct {
 
 func (x *ListStudentsResponse) Reset() {
 	*x = ListStudentsResponse{}
-	mi := &file_proto_user_user_proto_msgTypes[10]
+	mi := &file_proto_user_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -745,7 +897,7 @@ func (x *ListStudentsResponse) String() string {
 func (*ListStudentsResponse) ProtoMessage() {}
 
 func (x *ListStudentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[10]
+	mi := &file_proto_user_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,7 +910,7 @@ func (x *ListStudentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListStudentsResponse.ProtoReflect.Descriptor instead.
 func (*ListStudentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{10}
+	return file_proto_user_user_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListStudentsResponse) GetStudents() []*Student {
@@ -802,13 +954,16 @@ type Teacher struct {
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	CreatedBy     string                 `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	UpdatedBy     string                 `protobuf:"bytes,10,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       int32                  `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`                     // bumped by every update
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // set while the record is soft-deleted
+	DeletedBy     string                 `protobuf:"bytes,13,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Teacher) Reset() {
 	*x = Teacher{}
-	mi := &file_proto_user_user_proto_msgTypes[11]
+	mi := &file_proto_user_user_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -820,7 +975,7 @@ func (x *Teacher) String() string {
 func (*Teacher) ProtoMessage() {}
 
 func (x *Teacher) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[11]
+	mi := &file_proto_user_user_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -833,7 +988,7 @@ func (x *Teacher) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Teacher.ProtoReflect.Descriptor instead.
 func (*Teacher) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{11}
+	return file_proto_user_user_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Teacher) GetId() string {
@@ -906,6 +1061,27 @@ func (x *Teacher) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *Teacher) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Teacher) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Teacher) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
 type CreateTeacherRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
@@ -920,7 +1096,7 @@ type CreateTeacherRequest struct {
 
 func (x *CreateTeacherRequest) Reset() {
 	*x = CreateTeacherRequest{}
-	mi := &file_proto_user_user_proto_msgTypes[12]
+	mi := &file_proto_user_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -932,7 +1108,7 @@ func (x *CreateTeacherRequest) String() string {
 func (*CreateTeacherRequest) ProtoMessage() {}
 
 func (x *CreateTeacherRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[12]
+	mi := &file_proto_user_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -945,7 +1121,7 @@ func (x *CreateTeacherRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTeacherRequest.ProtoReflect.Descriptor instead.
 func (*CreateTeacherRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{12}
+	return file_proto_user_user_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CreateTeacherRequest) GetEmail() string {
@@ -999,7 +1175,7 @@ type CreateTeacherResponse struct {
 
 func (x *CreateTeacherResponse) Reset() {
 	*x = CreateTeacherResponse{}
-	mi := &file_proto_user_user_proto_msgTypes[13]
+	mi := &file_proto_user_user_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1011,7 +1187,7 @@ func (x *CreateTeacherResponse) String() string {
 func (*CreateTeacherResponse) ProtoMessage() {}
 
 func (x *CreateTeacherResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[13]
+	mi := &file_proto_user_user_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1024,7 +1200,7 @@ func (x *CreateTeacherResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTeacherResponse.ProtoReflect.Descriptor instead.
 func (*CreateTeacherResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{13}
+	return file_proto_user_user_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CreateTeacherResponse) GetTeacher() *Teacher {
@@ -1035,15 +1211,16 @@ func (x *CreateTeacherResponse) GetTeacher() *Teacher {
 }
 
 type GetTeacherRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also find a soft-deleted record
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetTeacherRequest) Reset() {
 	*x = GetTeacherRequest{}
-	mi := &file_proto_user_user_proto_msgTypes[14]
+	mi := &file_proto_user_user_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1055,7 +1232,7 @@ func (x *GetTeacherRequest) String() string {
 func (*GetTeacherRequest) ProtoMessage() {}
 
 func (x *GetTeacherRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[14]
+	mi := &file_proto_user_user_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,7 +1245,7 @@ func (x *GetTeacherRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTeacherRequest.ProtoReflect.Descriptor instead.
 func (*GetTeacherRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{14}
+	return file_proto_user_user_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetTeacherRequest) GetId() string {
@@ -1078,6 +1255,13 @@ func (x *GetTeacherRequest) GetId() string {
 	return ""
 }
 
+func (x *GetTeacherRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type GetTeacherResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Teacher       *Teacher               `protobuf:"bytes,1,opt,name=teacher,proto3" json:"teacher,omitempty"`
@@ -1087,7 +1271,7 @@ type GetTeacherResponse struct {
 
 func (x *GetTeacherResponse) Reset() {
 	*x = GetTeacherResponse{}
-	mi := &file_proto_user_user_proto_msgTypes[15]
+	mi := &file_proto_user_user_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1099,7 +1283,7 @@ func (x *GetTeacherResponse) String() string {
 func (*GetTeacherResponse) ProtoMessage() {}
 
 func (x *GetTeacherResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[15]
+	mi := &file_proto_user_user_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1112,7 +1296,7 @@ func (x *GetTeacherResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTeacherResponse.ProtoReflect.Descriptor instead.
 func (*GetTeacherResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{15}
+	return file_proto_user_user_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetTeacherResponse) GetTeacher() *Teacher {
@@ -1131,13 +1315,14 @@ type UpdateTeacherRequest struct {
 	MajorCode     *string                `protobuf:"bytes,5,opt,name=major_code,json=majorCode,proto3,oneof" json:"major_code,omitempty"`
 	SemesterCode  *string                `protobuf:"bytes,6,opt,name=semester_code,json=semesterCode,proto3,oneof" json:"semester_code,omitempty"`
 	UpdatedBy     string                 `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       *int32                 `protobuf:"varint,8,opt,name=version,proto3,oneof" json:"version,omitempty"` // expected version; a stale one fails with ABORTED
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateTeacherRequest) Reset() {
 	*x = UpdateTeacherRequest{}
-	mi := &file_proto_user_user_proto_msgTypes[16]
+	mi := &file_proto_user_user_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1149,7 +1334,7 @@ func (x *UpdateTeacherRequest) String() string {
 func (*UpdateTeacherRequest) ProtoMessage() {}
 
 func (x *UpdateTeacherRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[16]
+	mi := &file_proto_user_user_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1162,7 +1347,7 @@ func (x *UpdateTeacherRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTeacherRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTeacherRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{16}
+	return file_proto_user_user_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdateTeacherRequest) GetId() string {
@@ -1214,6 +1399,13 @@ func (x *UpdateTeacherRequest) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *UpdateTeacherRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
 type UpdateTeacherResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Teacher       *Teacher               `protobuf:"bytes,1,opt,name=teacher,proto3" json:"teacher,omitempty"`
@@ -1223,7 +1415,7 @@ type UpdateTeacherResponse struct {
 
 func (x *UpdateTeacherResponse) Reset() {
 	*x = UpdateTeacherResponse{}
-	mi := &file_proto_user_user_proto_msgTypes[17]
+	mi := &file_proto_user_user_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1235,7 +1427,7 @@ func (x *UpdateTeacherResponse) String() string {
 func (*UpdateTeacherResponse) ProtoMessage() {}
 
 func (x *UpdateTeacherResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[17]
+	mi := &file_proto_user_user_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1248,7 +1440,7 @@ func (x *UpdateTeacherResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTeacherResponse.ProtoReflect.Descriptor instead.
 func (*UpdateTeacherResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{17}
+	return file_proto_user_user_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UpdateTeacherResponse) GetTeacher() *Teacher {
@@ -1261,13 +1453,14 @@ func (x *UpdateTeacherResponse) GetTeacher() *Teacher {
 type DeleteTeacherRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteTeacherRequest) Reset() {
 	*x = DeleteTeacherRequest{}
-	mi := &file_proto_user_user_proto_msgTypes[18]
+	mi := &file_proto_user_user_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1279,7 +1472,7 @@ func (x *DeleteTeacherRequest) String() string {
 func (*DeleteTeacherRequest) ProtoMessage() {}
 
 func (x *DeleteTeacherRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[18]
+	mi := &file_proto_user_user_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1292,7 +1485,7 @@ func (x *DeleteTeacherRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTeacherRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTeacherRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{18}
+	return file_proto_user_user_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DeleteTeacherRequest) GetId() string {
@@ -1302,6 +1495,13 @@ func (x *DeleteTeacherRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteTeacherRequest) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
 type DeleteTeacherResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -1311,7 +1511,7 @@ type DeleteTeacherResponse struct {
 
 func (x *DeleteTeacherResponse) Reset() {
 	*x = DeleteTeacherResponse{}
-	mi := &file_proto_user_user_proto_msgTypes[19]
+	mi := &file_proto_user_user_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1323,7 +1523,7 @@ func (x *DeleteTeacherResponse) String() string {
 func (*DeleteTeacherResponse) ProtoMessage() {}
 
 func (x *DeleteTeacherResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[19]
+	mi := &file_proto_user_user_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1336,7 +1536,7 @@ func (x *DeleteTeacherResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTeacherResponse.ProtoReflect.Descriptor instead.
 func (*DeleteTeacherResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{19}
+	return file_proto_user_user_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DeleteTeacherResponse) GetSuccess() bool {
@@ -1346,16 +1546,113 @@ func (x *DeleteTeacherResponse) GetSuccess() bool {
 	return false
 }
 
-type ListTeachersRequest struct {
+type RestoreTeacherRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RestoredBy    string                 `protobuf:"bytes,2,opt,name=restored_by,json=restoredBy,proto3" json:"restored_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTeacherRequest) Reset() {
+	*x = RestoreTeacherRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTeacherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTeacherRequest) ProtoMessage() {}
+
+func (x *RestoreTeacherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTeacherRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTeacherRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RestoreTeacherRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreTeacherRequest) GetRestoredBy() string {
+	if x != nil {
+		return x.RestoredBy
+	}
+	return ""
+}
+
+type RestoreTeacherResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Search        *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	Teacher       *Teacher               `protobuf:"bytes,1,opt,name=teacher,proto3" json:"teacher,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *RestoreTeacherResponse) Reset() {
+	*x = RestoreTeacherResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTeacherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTeacherResponse) ProtoMessage() {}
+
+func (x *RestoreTeacherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTeacherResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTeacherResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RestoreTeacherResponse) GetTeacher() *Teacher {
+	if x != nil {
+		return x.Teacher
+	}
+	return nil
+}
+
+type ListTeachersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Search         *common.SearchRequest  `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // staff only: also list soft-deleted records
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *ListTeachersRequest) Reset() {
 	*x = ListTeachersRequest{}
-	mi := &file_proto_user_user_proto_msgTypes[20]
+	mi := &file_proto_user_user_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1367,7 +1664,7 @@ func (x *ListTeachersRequest) String() string {
 func (*ListTeachersRequest) ProtoMessage() {}
 
 func (x *ListTeachersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[20]
+	mi := &file_proto_user_user_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1380,7 +1677,7 @@ func (x *ListTeachersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTeachersRequest.ProtoReflect.Descriptor instead.
 func (*ListTeachersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{20}
+	return file_proto_user_user_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListTeachersRequest) GetSearch() *common.SearchRequest {
@@ -1390,6 +1687,13 @@ func (x *ListTeachersRequest) GetSearch() *common.SearchRequest {
 	return nil
 }
 
+func (x *ListTeachersRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type ListTeachersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Teachers      []*Teacher             `protobuf:"bytes,1,rep,name=teachers,proto3" json:"teachers,omitempty"`
@@ -1402,7 +1706,7 @@ type ListTeachersResponse struct {
 
 func (x *ListTeachersResponse) Reset() {
 	*x = ListTeachersResponse{}
-	mi := &file_proto_user_user_proto_msgTypes[21]
+	mi := &file_proto_user_user_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1414,7 +1718,7 @@ func (x *ListTeachersResponse) String() string {
 func (*ListTeachersResponse) ProtoMessage() {}
 
 func (x *ListTeachersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[21]
+	mi := &file_proto_user_user_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1427,7 +1731,7 @@ func (x *ListTeachersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTeachersResponse.ProtoReflect.Descriptor instead.
 func (*ListTeachersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{21}
+	return file_proto_user_user_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ListTeachersResponse) GetTeachers() []*Teacher {
@@ -1458,11 +1762,124 @@ func (x *ListTeachersResponse) GetPageSize() int32 {
 	return 0
 }
 
+// ============= Full-text search =============
+type SearchUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Students      bool                   `protobuf:"varint,3,opt,name=students,proto3" json:"students,omitempty"` // search students
+	Teachers      bool                   `protobuf:"varint,4,opt,name=teachers,proto3" json:"teachers,omitempty"` // search teachers
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRequest) ProtoMessage() {}
+
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SearchUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetStudents() bool {
+	if x != nil {
+		return x.Students
+	}
+	return false
+}
+
+func (x *SearchUsersRequest) GetTeachers() bool {
+	if x != nil {
+		return x.Teachers
+	}
+	return false
+}
+
+type SearchUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*common.SearchHit    `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"` // best first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SearchUsersResponse) GetHits() []*common.SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
 var File_proto_user_user_proto protoreflect.FileDescriptor
 
 const file_proto_user_user_proto_rawDesc = "" +
 	"\n" +
-	"\x15proto/user/user.proto\x12\x04user\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19proto/common/common.proto\"\x9e\x03\n" +
+	"\x15proto/user/user.proto\x12\x04user\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19proto/common/common.proto\"\x92\x04\n" +
 	"\aStudent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
@@ -1482,7 +1899,12 @@ const file_proto_user_user_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\v \x01(\tR\tcreatedBy\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\f \x01(\tR\tupdatedBy\"\xa5\x02\n" +
+	"updated_by\x18\f \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\r \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x0f \x01(\tR\tdeletedBy\"\xa5\x02\n" +
 	"\x14CreateStudentRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x19\n" +
 	"\x05phone\x18\x02 \x01(\tH\x00R\x05phone\x88\x01\x01\x12\x1a\n" +
@@ -1498,11 +1920,12 @@ const file_proto_user_user_proto_rawDesc = "" +
 	"\x06_phoneB\t\n" +
 	"\a_gender\"@\n" +
 	"\x15CreateStudentResponse\x12'\n" +
-	"\astudent\x18\x01 \x01(\v2\r.user.StudentR\astudent\"#\n" +
+	"\astudent\x18\x01 \x01(\v2\r.user.StudentR\astudent\"L\n" +
 	"\x11GetStudentRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"=\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"=\n" +
 	"\x12GetStudentResponse\x12'\n" +
-	"\astudent\x18\x01 \x01(\v2\r.user.StudentR\astudent\"\x95\x03\n" +
+	"\astudent\x18\x01 \x01(\v2\r.user.StudentR\astudent\"\xc0\x03\n" +
 	"\x14UpdateStudentRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05email\x18\x02 \x01(\tH\x00R\x05email\x88\x01\x01\x12\x19\n" +
@@ -1515,27 +1938,40 @@ const file_proto_user_user_proto_rawDesc = "" +
 	"class_code\x18\a \x01(\tH\x05R\tclassCode\x88\x01\x01\x12(\n" +
 	"\rsemester_code\x18\b \x01(\tH\x06R\fsemesterCode\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\t \x01(\tR\tupdatedByB\b\n" +
+	"updated_by\x18\t \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\aversion\x18\n" +
+	" \x01(\x05H\aR\aversion\x88\x01\x01B\b\n" +
 	"\x06_emailB\b\n" +
 	"\x06_phoneB\v\n" +
 	"\t_usernameB\t\n" +
 	"\a_genderB\r\n" +
 	"\v_major_codeB\r\n" +
 	"\v_class_codeB\x10\n" +
-	"\x0e_semester_code\"@\n" +
+	"\x0e_semester_codeB\n" +
+	"\n" +
+	"\b_version\"@\n" +
 	"\x15UpdateStudentResponse\x12'\n" +
-	"\astudent\x18\x01 \x01(\v2\r.user.StudentR\astudent\"&\n" +
+	"\astudent\x18\x01 \x01(\v2\r.user.StudentR\astudent\"E\n" +
 	"\x14DeleteStudentRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\"1\n" +
 	"\x15DeleteStudentResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"D\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"H\n" +
+	"\x15RestoreStudentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrestored_by\x18\x02 \x01(\tR\n" +
+	"restoredBy\"A\n" +
+	"\x16RestoreStudentResponse\x12'\n" +
+	"\astudent\x18\x01 \x01(\v2\r.user.StudentR\astudent\"m\n" +
 	"\x13ListStudentsRequest\x12-\n" +
-	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\"\x88\x01\n" +
+	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"\x88\x01\n" +
 	"\x14ListStudentsResponse\x12)\n" +
 	"\bstudents\x18\x01 \x03(\v2\r.user.StudentR\bstudents\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xe9\x02\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xdd\x03\n" +
 	"\aTeacher\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
@@ -1552,7 +1988,12 @@ const file_proto_user_user_proto_rawDesc = "" +
 	"created_by\x18\t \x01(\tR\tcreatedBy\x12\x1d\n" +
 	"\n" +
 	"updated_by\x18\n" +
-	" \x01(\tR\tupdatedBy\"\xd1\x01\n" +
+	" \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\v \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\r \x01(\tR\tdeletedBy\"\xd1\x01\n" +
 	"\x14CreateTeacherRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12$\n" +
@@ -1563,11 +2004,12 @@ const file_proto_user_user_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\x06 \x01(\tR\tcreatedBy\"@\n" +
 	"\x15CreateTeacherResponse\x12'\n" +
-	"\ateacher\x18\x01 \x01(\v2\r.user.TeacherR\ateacher\"#\n" +
+	"\ateacher\x18\x01 \x01(\v2\r.user.TeacherR\ateacher\"L\n" +
 	"\x11GetTeacherRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"=\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"=\n" +
 	"\x12GetTeacherResponse\x12'\n" +
-	"\ateacher\x18\x01 \x01(\v2\r.user.TeacherR\ateacher\"\xbd\x02\n" +
+	"\ateacher\x18\x01 \x01(\v2\r.user.TeacherR\ateacher\"\xe8\x02\n" +
 	"\x14UpdateTeacherRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05email\x18\x02 \x01(\tH\x00R\x05email\x88\x01\x01\x12\x1f\n" +
@@ -1577,43 +2019,66 @@ const file_proto_user_user_proto_rawDesc = "" +
 	"major_code\x18\x05 \x01(\tH\x03R\tmajorCode\x88\x01\x01\x12(\n" +
 	"\rsemester_code\x18\x06 \x01(\tH\x04R\fsemesterCode\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\a \x01(\tR\tupdatedByB\b\n" +
+	"updated_by\x18\a \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\aversion\x18\b \x01(\x05H\x05R\aversion\x88\x01\x01B\b\n" +
 	"\x06_emailB\v\n" +
 	"\t_usernameB\t\n" +
 	"\a_genderB\r\n" +
 	"\v_major_codeB\x10\n" +
-	"\x0e_semester_code\"@\n" +
+	"\x0e_semester_codeB\n" +
+	"\n" +
+	"\b_version\"@\n" +
 	"\x15UpdateTeacherResponse\x12'\n" +
-	"\ateacher\x18\x01 \x01(\v2\r.user.TeacherR\ateacher\"&\n" +
+	"\ateacher\x18\x01 \x01(\v2\r.user.TeacherR\ateacher\"E\n" +
 	"\x14DeleteTeacherRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\"1\n" +
 	"\x15DeleteTeacherResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"D\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"H\n" +
+	"\x15RestoreTeacherRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrestored_by\x18\x02 \x01(\tR\n" +
+	"restoredBy\"A\n" +
+	"\x16RestoreTeacherResponse\x12'\n" +
+	"\ateacher\x18\x01 \x01(\v2\r.user.TeacherR\ateacher\"m\n" +
 	"\x13ListTeachersRequest\x12-\n" +
-	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\"\x88\x01\n" +
+	"\x06search\x18\x01 \x01(\v2\x15.common.SearchRequestR\x06search\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"\x88\x01\n" +
 	"\x14ListTeachersResponse\x12)\n" +
 	"\bteachers\x18\x01 \x03(\v2\r.user.TeacherR\bteachers\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize*)\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"x\n" +
+	"\x12SearchUsersRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\bstudents\x18\x03 \x01(\bR\bstudents\x12\x1a\n" +
+	"\bteachers\x18\x04 \x01(\bR\bteachers\"<\n" +
+	"\x13SearchUsersResponse\x12%\n" +
+	"\x04hits\x18\x01 \x03(\v2\x11.common.SearchHitR\x04hits*)\n" +
 	"\x06Gender\x12\b\n" +
 	"\x04MALE\x10\x00\x12\n" +
 	"\n" +
 	"\x06FEMALE\x10\x01\x12\t\n" +
-	"\x05OTHER\x10\x022\xd9\x05\n" +
+	"\x05OTHER\x10\x022\x89\b\n" +
 	"\vUserService\x12H\n" +
 	"\rCreateStudent\x12\x1a.user.CreateStudentRequest\x1a\x1b.user.CreateStudentResponse\x12?\n" +
 	"\n" +
 	"GetStudent\x12\x17.user.GetStudentRequest\x1a\x18.user.GetStudentResponse\x12H\n" +
 	"\rUpdateStudent\x12\x1a.user.UpdateStudentRequest\x1a\x1b.user.UpdateStudentResponse\x12H\n" +
-	"\rDeleteStudent\x12\x1a.user.DeleteStudentRequest\x1a\x1b.user.DeleteStudentResponse\x12E\n" +
+	"\rDeleteStudent\x12\x1a.user.DeleteStudentRequest\x1a\x1b.user.DeleteStudentResponse\x12K\n" +
+	"\x0eRestoreStudent\x12\x1b.user.RestoreStudentRequest\x1a\x1c.user.RestoreStudentResponse\x12E\n" +
 	"\fListStudents\x12\x19.user.ListStudentsRequest\x1a\x1a.user.ListStudentsResponse\x12H\n" +
 	"\rCreateTeacher\x12\x1a.user.CreateTeacherRequest\x1a\x1b.user.CreateTeacherResponse\x12?\n" +
 	"\n" +
 	"GetTeacher\x12\x17.user.GetTeacherRequest\x1a\x18.user.GetTeacherResponse\x12H\n" +
 	"\rUpdateTeacher\x12\x1a.user.UpdateTeacherRequest\x1a\x1b.user.UpdateTeacherResponse\x12H\n" +
-	"\rDeleteTeacher\x12\x1a.user.DeleteTeacherRequest\x1a\x1b.user.DeleteTeacherResponse\x12E\n" +
-	"\fListTeachers\x12\x19.user.ListTeachersRequest\x1a\x1a.user.ListTeachersResponseB\bZ\x06./userb\x06proto3"
+	"\rDeleteTeacher\x12\x1a.user.DeleteTeacherRequest\x1a\x1b.user.DeleteTeacherResponse\x12K\n" +
+	"\x0eRestoreTeacher\x12\x1b.user.RestoreTeacherRequest\x1a\x1c.user.RestoreTeacherResponse\x12E\n" +
+	"\fListTeachers\x12\x19.user.ListTeachersRequest\x1a\x1a.user.ListTeachersResponse\x12B\n" +
+	"\vSearchUsers\x12\x18.user.SearchUsersRequest\x1a\x19.user.SearchUsersResponse\x12P\n" +
+	"\x0fCheckReferences\x12\x1d.common.ReferenceCheckRequest\x1a\x1e.common.ReferenceCheckResponseB\bZ\x06./userb\x06proto3"
 
 var (
 	file_proto_user_user_proto_rawDescOnce sync.Once
@@ -1628,80 +2093,102 @@ func file_proto_user_user_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_user_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_user_user_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_proto_user_user_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_proto_user_user_proto_goTypes = []any{
-	(Gender)(0),                   // 0: user.Gender
-	(*Student)(nil),               // 1: user.Student
-	(*CreateStudentRequest)(nil),  // 2: user.CreateStudentRequest
-	(*CreateStudentResponse)(nil), // 3: user.CreateStudentResponse
-	(*GetStudentRequest)(nil),     // 4: user.GetStudentRequest
-	(*GetStudentResponse)(nil),    // 5: user.GetStudentResponse
-	(*UpdateStudentRequest)(nil),  // 6: user.UpdateStudentRequest
-	(*UpdateStudentResponse)(nil), // 7: user.UpdateStudentResponse
-	(*DeleteStudentRequest)(nil),  // 8: user.DeleteStudentRequest
-	(*DeleteStudentResponse)(nil), // 9: user.DeleteStudentResponse
-	(*ListStudentsRequest)(nil),   // 10: user.ListStudentsRequest
-	(*ListStudentsResponse)(nil),  // 11: user.ListStudentsResponse
-	(*Teacher)(nil),               // 12: user.Teacher
-	(*CreateTeacherRequest)(nil),  // 13: user.CreateTeacherRequest
-	(*CreateTeacherResponse)(nil), // 14: user.CreateTeacherResponse
-	(*GetTeacherRequest)(nil),     // 15: user.GetTeacherRequest
-	(*GetTeacherResponse)(nil),    // 16: user.GetTeacherResponse
-	(*UpdateTeacherRequest)(nil),  // 17: user.UpdateTeacherRequest
-	(*UpdateTeacherResponse)(nil), // 18: user.UpdateTeacherResponse
-	(*DeleteTeacherRequest)(nil),  // 19: user.DeleteTeacherRequest
-	(*DeleteTeacherResponse)(nil), // 20: user.DeleteTeacherResponse
-	(*ListTeachersRequest)(nil),   // 21: user.ListTeachersRequest
-	(*ListTeachersResponse)(nil),  // 22: user.ListTeachersResponse
-	(*timestamppb.Timestamp)(nil), // 23: google.protobuf.Timestamp
-	(*common.SearchRequest)(nil),  // 24: common.SearchRequest
+	(Gender)(0),                           // 0: user.Gender
+	(*Student)(nil),                       // 1: user.Student
+	(*CreateStudentRequest)(nil),          // 2: user.CreateStudentRequest
+	(*CreateStudentResponse)(nil),         // 3: user.CreateStudentResponse
+	(*GetStudentRequest)(nil),             // 4: user.GetStudentRequest
+	(*GetStudentResponse)(nil),            // 5: user.GetStudentResponse
+	(*UpdateStudentRequest)(nil),          // 6: user.UpdateStudentRequest
+	(*UpdateStudentResponse)(nil),         // 7: user.UpdateStudentResponse
+	(*DeleteStudentRequest)(nil),          // 8: user.DeleteStudentRequest
+	(*DeleteStudentResponse)(nil),         // 9: user.DeleteStudentResponse
+	(*RestoreStudentRequest)(nil),         // 10: user.RestoreStudentRequest
+	(*RestoreStudentResponse)(nil),        // 11: user.RestoreStudentResponse
+	(*ListStudentsRequest)(nil),           // 12: user.ListStudentsRequest
+	(*ListStudentsResponse)(nil),          // 13: user.ListStudentsResponse
+	(*Teacher)(nil),                       // 14: user.Teacher
+	(*CreateTeacherRequest)(nil),          // 15: user.CreateTeacherRequest
+	(*CreateTeacherResponse)(nil),         // 16: user.CreateTeacherResponse
+	(*GetTeacherRequest)(nil),             // 17: user.GetTeacherRequest
+	(*GetTeacherResponse)(nil),            // 18: user.GetTeacherResponse
+	(*UpdateTeacherRequest)(nil),          // 19: user.UpdateTeacherRequest
+	(*UpdateTeacherResponse)(nil),         // 20: user.UpdateTeacherResponse
+	(*DeleteTeacherRequest)(nil),          // 21: user.DeleteTeacherRequest
+	(*DeleteTeacherResponse)(nil),         // 22: user.DeleteTeacherResponse
+	(*RestoreTeacherRequest)(nil),         // 23: user.RestoreTeacherRequest
+	(*RestoreTeacherResponse)(nil),        // 24: user.RestoreTeacherResponse
+	(*ListTeachersRequest)(nil),           // 25: user.ListTeachersRequest
+	(*ListTeachersResponse)(nil),          // 26: user.ListTeachersResponse
+	(*SearchUsersRequest)(nil),            // 27: user.SearchUsersRequest
+	(*SearchUsersResponse)(nil),           // 28: user.SearchUsersResponse
+	(*timestamppb.Timestamp)(nil),         // 29: google.protobuf.Timestamp
+	(*common.SearchRequest)(nil),          // 30: common.SearchRequest
+	(*common.SearchHit)(nil),              // 31: common.SearchHit
+	(*common.ReferenceCheckRequest)(nil),  // 32: common.ReferenceCheckRequest
+	(*common.ReferenceCheckResponse)(nil), // 33: common.ReferenceCheckResponse
 }
 var file_proto_user_user_proto_depIdxs = []int32{
 	0,  // 0: user.Student.gender:type_name -> user.Gender
-	23, // 1: user.Student.created_at:type_name -> google.protobuf.Timestamp
-	23, // 2: user.Student.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: user.CreateStudentRequest.gender:type_name -> user.Gender
-	1,  // 4: user.CreateStudentResponse.student:type_name -> user.Student
-	1,  // 5: user.GetStudentResponse.student:type_name -> user.Student
-	0,  // 6: user.UpdateStudentRequest.gender:type_name -> user.Gender
-	1,  // 7: user.UpdateStudentResponse.student:type_name -> user.Student
-	24, // 8: user.ListStudentsRequest.search:type_name -> common.SearchRequest
-	1,  // 9: user.ListStudentsResponse.students:type_name -> user.Student
-	0,  // 10: user.Teacher.gender:type_name -> user.Gender
-	23, // 11: user.Teacher.created_at:type_name -> google.protobuf.Timestamp
-	23, // 12: user.Teacher.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 13: user.CreateTeacherRequest.gender:type_name -> user.Gender
-	12, // 14: user.CreateTeacherResponse.teacher:type_name -> user.Teacher
-	12, // 15: user.GetTeacherResponse.teacher:type_name -> user.Teacher
-	0,  // 16: user.UpdateTeacherRequest.gender:type_name -> user.Gender
-	12, // 17: user.UpdateTeacherResponse.teacher:type_name -> user.Teacher
-	24, // 18: user.ListTeachersRequest.search:type_name -> common.SearchRequest
-	12, // 19: user.ListTeachersResponse.teachers:type_name -> user.Teacher
-	2,  // 20: user.UserService.CreateStudent:input_type -> user.CreateStudentRequest
-	4,  // 21: user.UserService.GetStudent:input_type -> user.GetStudentRequest
-	6,  // 22: user.UserService.UpdateStudent:input_type -> user.UpdateStudentRequest
-	8,  // 23: user.UserService.DeleteStudent:input_type -> user.DeleteStudentRequest
-	10, // 24: user.UserService.ListStudents:input_type -> user.ListStudentsRequest
-	13, // 25: user.UserService.CreateTeacher:input_type -> user.CreateTeacherRequest
-	15, // 26: user.UserService.GetTeacher:input_type -> user.GetTeacherRequest
-	17, // 27: user.UserService.UpdateTeacher:input_type -> user.UpdateTeacherRequest
-	19, // 28: user.UserService.DeleteTeacher:input_type -> user.DeleteTeacherRequest
-	21, // 29: user.UserService.ListTeachers:input_type -> user.ListTeachersRequest
-	3,  // 30: user.UserService.CreateStudent:output_type -> user.CreateStudentResponse
-	5,  // 31: user.UserService.GetStudent:output_type -> user.GetStudentResponse
-	7,  // 32: user.UserService.UpdateStudent:output_type -> user.UpdateStudentResponse
-	9,  // 33: user.UserService.DeleteStudent:output_type -> user.DeleteStudentResponse
-	11, // 34: user.UserService.ListStudents:output_type -> user.ListStudentsResponse
-	14, // 35: user.UserService.CreateTeacher:output_type -> user.CreateTeacherResponse
-	16, // 36: user.UserService.GetTeacher:output_type -> user.GetTeacherResponse
-	18, // 37: user.UserService.UpdateTeacher:output_type -> user.UpdateTeacherResponse
-	20, // 38: user.UserService.DeleteTeacher:output_type -> user.DeleteTeacherResponse
-	22, // 39: user.UserService.ListTeachers:output_type -> user.ListTeachersResponse
-	30, // [30:40] is the sub-list for method output_type
-	20, // [20:30] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	29, // 1: user.Student.created_at:type_name -> google.protobuf.Timestamp
+	29, // 2: user.Student.updated_at:type_name -> google.protobuf.Timestamp
+	29, // 3: user.Student.deleted_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: user.CreateStudentRequest.gender:type_name -> user.Gender
+	1,  // 5: user.CreateStudentResponse.student:type_name -> user.Student
+	1,  // 6: user.GetStudentResponse.student:type_name -> user.Student
+	0,  // 7: user.UpdateStudentRequest.gender:type_name -> user.Gender
+	1,  // 8: user.UpdateStudentResponse.student:type_name -> user.Student
+	1,  // 9: user.RestoreStudentResponse.student:type_name -> user.Student
+	30, // 10: user.ListStudentsRequest.search:type_name -> common.SearchRequest
+	1,  // 11: user.ListStudentsResponse.students:type_name -> user.Student
+	0,  // 12: user.Teacher.gender:type_name -> user.Gender
+	29, // 13: user.Teacher.created_at:type_name -> google.protobuf.Timestamp
+	29, // 14: user.Teacher.updated_at:type_name -> google.protobuf.Timestamp
+	29, // 15: user.Teacher.deleted_at:type_name -> google.protobuf.Timestamp
+	0,  // 16: user.CreateTeacherRequest.gender:type_name -> user.Gender
+	14, // 17: user.CreateTeacherResponse.teacher:type_name -> user.Teacher
+	14, // 18: user.GetTeacherResponse.teacher:type_name -> user.Teacher
+	0,  // 19: user.UpdateTeacherRequest.gender:type_name -> user.Gender
+	14, // 20: user.UpdateTeacherResponse.teacher:type_name -> user.Teacher
+	14, // 21: user.RestoreTeacherResponse.teacher:type_name -> user.Teacher
+	30, // 22: user.ListTeachersRequest.search:type_name -> common.SearchRequest
+	14, // 23: user.ListTeachersResponse.teachers:type_name -> user.Teacher
+	31, // 24: user.SearchUsersResponse.hits:type_name -> common.SearchHit
+	2,  // 25: user.UserService.CreateStudent:input_type -> user.CreateStudentRequest
+	4,  // 26: user.UserService.GetStudent:input_type -> user.GetStudentRequest
+	6,  // 27: user.UserService.UpdateStudent:input_type -> user.UpdateStudentRequest
+	8,  // 28: user.UserService.DeleteStudent:input_type -> user.DeleteStudentRequest
+	10, // 29: user.UserService.RestoreStudent:input_type -> user.RestoreStudentRequest
+	12, // 30: user.UserService.ListStudents:input_type -> user.ListStudentsRequest
+	15, // 31: user.UserService.CreateTeacher:input_type -> user.CreateTeacherRequest
+	17, // 32: user.UserService.GetTeacher:input_type -> user.GetTeacherRequest
+	19, // 33: user.UserService.UpdateTeacher:input_type -> user.UpdateTeacherRequest
+	21, // 34: user.UserService.DeleteTeacher:input_type -> user.DeleteTeacherRequest
+	23, // 35: user.UserService.RestoreTeacher:input_type -> user.RestoreTeacherRequest
+	25, // 36: user.UserService.ListTeachers:input_type -> user.ListTeachersRequest
+	27, // 37: user.UserService.SearchUsers:input_type -> user.SearchUsersRequest
+	32, // 38: user.UserService.CheckReferences:input_type -> common.ReferenceCheckRequest
+	3,  // 39: user.UserService.CreateStudent:output_type -> user.CreateStudentResponse
+	5,  // 40: user.UserService.GetStudent:output_type -> user.GetStudentResponse
+	7,  // 41: user.UserService.UpdateStudent:output_type -> user.UpdateStudentResponse
+	9,  // 42: user.UserService.DeleteStudent:output_type -> user.DeleteStudentResponse
+	11, // 43: user.UserService.RestoreStudent:output_type -> user.RestoreStudentResponse
+	13, // 44: user.UserService.ListStudents:output_type -> user.ListStudentsResponse
+	16, // 45: user.UserService.CreateTeacher:output_type -> user.CreateTeacherResponse
+	18, // 46: user.UserService.GetTeacher:output_type -> user.GetTeacherResponse
+	20, // 47: user.UserService.UpdateTeacher:output_type -> user.UpdateTeacherResponse
+	22, // 48: user.UserService.DeleteTeacher:output_type -> user.DeleteTeacherResponse
+	24, // 49: user.UserService.RestoreTeacher:output_type -> user.RestoreTeacherResponse
+	26, // 50: user.UserService.ListTeachers:output_type -> user.ListTeachersResponse
+	28, // 51: user.UserService.SearchUsers:output_type -> user.SearchUsersResponse
+	33, // 52: user.UserService.CheckReferences:output_type -> common.ReferenceCheckResponse
+	39, // [39:53] is the sub-list for method output_type
+	25, // [25:39] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_proto_user_user_proto_init() }
@@ -1711,14 +2198,14 @@ func file_proto_user_user_proto_init() {
 	}
 	file_proto_user_user_proto_msgTypes[1].OneofWrappers = []any{}
 	file_proto_user_user_proto_msgTypes[5].OneofWrappers = []any{}
-	file_proto_user_user_proto_msgTypes[16].OneofWrappers = []any{}
+	file_proto_user_user_proto_msgTypes[18].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_user_user_proto_rawDesc), len(file_proto_user_user_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   22,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
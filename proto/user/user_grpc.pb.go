@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	common "thaily/proto/common"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,16 +20,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_CreateStudent_FullMethodName = "/user.UserService/CreateStudent"
-	UserService_GetStudent_FullMethodName    = "/user.UserService/GetStudent"
-	UserService_UpdateStudent_FullMethodName = "/user.UserService/UpdateStudent"
-	UserService_DeleteStudent_FullMethodName = "/user.UserService/DeleteStudent"
-	UserService_ListStudents_FullMethodName  = "/user.UserService/ListStudents"
-	UserService_CreateTeacher_FullMethodName = "/user.UserService/CreateTeacher"
-	UserService_GetTeacher_FullMethodName    = "/user.UserService/GetTeacher"
-	UserService_UpdateTeacher_FullMethodName = "/user.UserService/UpdateTeacher"
-	UserService_DeleteTeacher_FullMethodName = "/user.UserService/DeleteTeacher"
-	UserService_ListTeachers_FullMethodName  = "/user.UserService/ListTeachers"
+	UserService_CreateStudent_FullMethodName   = "/user.UserService/CreateStudent"
+	UserService_GetStudent_FullMethodName      = "/user.UserService/GetStudent"
+	UserService_UpdateStudent_FullMethodName   = "/user.UserService/UpdateStudent"
+	UserService_DeleteStudent_FullMethodName   = "/user.UserService/DeleteStudent"
+	UserService_RestoreStudent_FullMethodName  = "/user.UserService/RestoreStudent"
+	UserService_ListStudents_FullMethodName    = "/user.UserService/ListStudents"
+	UserService_CreateTeacher_FullMethodName   = "/user.UserService/CreateTeacher"
+	UserService_GetTeacher_FullMethodName      = "/user.UserService/GetTeacher"
+	UserService_UpdateTeacher_FullMethodName   = "/user.UserService/UpdateTeacher"
+	UserService_DeleteTeacher_FullMethodName   = "/user.UserService/DeleteTeacher"
+	UserService_RestoreTeacher_FullMethodName  = "/user.UserService/RestoreTeacher"
+	UserService_ListTeachers_FullMethodName    = "/user.UserService/ListTeachers"
+	UserService_SearchUsers_FullMethodName     = "/user.UserService/SearchUsers"
+	UserService_CheckReferences_FullMethodName = "/user.UserService/CheckReferences"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -42,13 +47,19 @@ type UserServiceClient interface {
 	GetStudent(ctx context.Context, in *GetStudentRequest, opts ...grpc.CallOption) (*GetStudentResponse, error)
 	UpdateStudent(ctx context.Context, in *UpdateStudentRequest, opts ...grpc.CallOption) (*UpdateStudentResponse, error)
 	DeleteStudent(ctx context.Context, in *DeleteStudentRequest, opts ...grpc.CallOption) (*DeleteStudentResponse, error)
+	RestoreStudent(ctx context.Context, in *RestoreStudentRequest, opts ...grpc.CallOption) (*RestoreStudentResponse, error)
 	ListStudents(ctx context.Context, in *ListStudentsRequest, opts ...grpc.CallOption) (*ListStudentsResponse, error)
 	// Teacher
 	CreateTeacher(ctx context.Context, in *CreateTeacherRequest, opts ...grpc.CallOption) (*CreateTeacherResponse, error)
 	GetTeacher(ctx context.Context, in *GetTeacherRequest, opts ...grpc.CallOption) (*GetTeacherResponse, error)
 	UpdateTeacher(ctx context.Context, in *UpdateTeacherRequest, opts ...grpc.CallOption) (*UpdateTeacherResponse, error)
 	DeleteTeacher(ctx context.Context, in *DeleteTeacherRequest, opts ...grpc.CallOption) (*DeleteTeacherResponse, error)
+	RestoreTeacher(ctx context.Context, in *RestoreTeacherRequest, opts ...grpc.CallOption) (*RestoreTeacherResponse, error)
 	ListTeachers(ctx context.Context, in *ListTeachersRequest, opts ...grpc.CallOption) (*ListTeachersResponse, error)
+	// Search
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
+	// Cross-service reference validation
+	CheckReferences(ctx context.Context, in *common.ReferenceCheckRequest, opts ...grpc.CallOption) (*common.ReferenceCheckResponse, error)
 }
 
 type userServiceClient struct {
@@ -99,6 +110,16 @@ func (c *userServiceClient) DeleteStudent(ctx context.Context, in *DeleteStudent
 	return out, nil
 }
 
+func (c *userServiceClient) RestoreStudent(ctx context.Context, in *RestoreStudentRequest, opts ...grpc.CallOption) (*RestoreStudentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreStudentResponse)
+	err := c.cc.Invoke(ctx, UserService_RestoreStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ListStudents(ctx context.Context, in *ListStudentsRequest, opts ...grpc.CallOption) (*ListStudentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListStudentsResponse)
@@ -149,6 +170,16 @@ func (c *userServiceClient) DeleteTeacher(ctx context.Context, in *DeleteTeacher
 	return out, nil
 }
 
+func (c *userServiceClient) RestoreTeacher(ctx context.Context, in *RestoreTeacherRequest, opts ...grpc.CallOption) (*RestoreTeacherResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreTeacherResponse)
+	err := c.cc.Invoke(ctx, UserService_RestoreTeacher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ListTeachers(ctx context.Context, in *ListTeachersRequest, opts ...grpc.CallOption) (*ListTeachersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListTeachersResponse)
@@ -159,6 +190,26 @@ func (c *userServiceClient) ListTeachers(ctx context.Context, in *ListTeachersRe
 	return out, nil
 }
 
+func (c *userServiceClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_SearchUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CheckReferences(ctx context.Context, in *common.ReferenceCheckRequest, opts ...grpc.CallOption) (*common.ReferenceCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.ReferenceCheckResponse)
+	err := c.cc.Invoke(ctx, UserService_CheckReferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -170,13 +221,19 @@ type UserServiceServer interface {
 	GetStudent(context.Context, *GetStudentRequest) (*GetStudentResponse, error)
 	UpdateStudent(context.Context, *UpdateStudentRequest) (*UpdateStudentResponse, error)
 	DeleteStudent(context.Context, *DeleteStudentRequest) (*DeleteStudentResponse, error)
+	RestoreStudent(context.Context, *RestoreStudentRequest) (*RestoreStudentResponse, error)
 	ListStudents(context.Context, *ListStudentsRequest) (*ListStudentsResponse, error)
 	// Teacher
 	CreateTeacher(context.Context, *CreateTeacherRequest) (*CreateTeacherResponse, error)
 	GetTeacher(context.Context, *GetTeacherRequest) (*GetTeacherResponse, error)
 	UpdateTeacher(context.Context, *UpdateTeacherRequest) (*UpdateTeacherResponse, error)
 	DeleteTeacher(context.Context, *DeleteTeacherRequest) (*DeleteTeacherResponse, error)
+	RestoreTeacher(context.Context, *RestoreTeacherRequest) (*RestoreTeacherResponse, error)
 	ListTeachers(context.Context, *ListTeachersRequest) (*ListTeachersResponse, error)
+	// Search
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
+	// Cross-service reference validation
+	CheckReferences(context.Context, *common.ReferenceCheckRequest) (*common.ReferenceCheckResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -199,6 +256,9 @@ func (UnimplementedUserServiceServer) UpdateStudent(context.Context, *UpdateStud
 func (UnimplementedUserServiceServer) DeleteStudent(context.Context, *DeleteStudentRequest) (*DeleteStudentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteStudent not implemented")
 }
+func (UnimplementedUserServiceServer) RestoreStudent(context.Context, *RestoreStudentRequest) (*RestoreStudentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreStudent not implemented")
+}
 func (UnimplementedUserServiceServer) ListStudents(context.Context, *ListStudentsRequest) (*ListStudentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListStudents not implemented")
 }
@@ -214,9 +274,18 @@ func (UnimplementedUserServiceServer) UpdateTeacher(context.Context, *UpdateTeac
 func (UnimplementedUserServiceServer) DeleteTeacher(context.Context, *DeleteTeacherRequest) (*DeleteTeacherResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeacher not implemented")
 }
+func (UnimplementedUserServiceServer) RestoreTeacher(context.Context, *RestoreTeacherRequest) (*RestoreTeacherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTeacher not implemented")
+}
 func (UnimplementedUserServiceServer) ListTeachers(context.Context, *ListTeachersRequest) (*ListTeachersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTeachers not implemented")
 }
+func (UnimplementedUserServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
+func (UnimplementedUserServiceServer) CheckReferences(context.Context, *common.ReferenceCheckRequest) (*common.ReferenceCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckReferences not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -310,6 +379,24 @@ func _UserService_DeleteStudent_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_RestoreStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RestoreStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RestoreStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RestoreStudent(ctx, req.(*RestoreStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_ListStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListStudentsRequest)
 	if err := dec(in); err != nil {
@@ -400,6 +487,24 @@ func _UserService_DeleteTeacher_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_RestoreTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTeacherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RestoreTeacher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RestoreTeacher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RestoreTeacher(ctx, req.(*RestoreTeacherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_ListTeachers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListTeachersRequest)
 	if err := dec(in); err != nil {
@@ -418,6 +523,42 @@ func _UserService_ListTeachers_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SearchUsers(ctx, req.(*SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CheckReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.ReferenceCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CheckReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CheckReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CheckReferences(ctx, req.(*common.ReferenceCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -441,6 +582,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteStudent",
 			Handler:    _UserService_DeleteStudent_Handler,
 		},
+		{
+			MethodName: "RestoreStudent",
+			Handler:    _UserService_RestoreStudent_Handler,
+		},
 		{
 			MethodName: "ListStudents",
 			Handler:    _UserService_ListStudents_Handler,
@@ -461,10 +606,22 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTeacher",
 			Handler:    _UserService_DeleteTeacher_Handler,
 		},
+		{
+			MethodName: "RestoreTeacher",
+			Handler:    _UserService_RestoreTeacher_Handler,
+		},
 		{
 			MethodName: "ListTeachers",
 			Handler:    _UserService_ListTeachers_Handler,
 		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _UserService_SearchUsers_Handler,
+		},
+		{
+			MethodName: "CheckReferences",
+			Handler:    _UserService_CheckReferences_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/user/user.proto",
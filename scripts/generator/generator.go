@@ -79,7 +79,7 @@ func GenerateCRUDHandler(handlerDir, packagePath, entityName string, methods []t
 	enumFields := []types.Field{}
 	createFields := []types.Field{}
 	updateFields := []types.Field{}
-	filterableFields := []string{}
+	filterableFields := []types.Field{}
 	scanFields := []string{}
 
 	var enumType string
@@ -107,7 +107,7 @@ func GenerateCRUDHandler(handlerDir, packagePath, entityName string, methods []t
 		}
 
 		if !field.IsTimestamp {
-			filterableFields = append(filterableFields, field.DBField)
+			filterableFields = append(filterableFields, *field)
 		}
 
 		// Mark field as optional if it's in optionalFieldsMap (MUST DO THIS FIRST)
@@ -159,7 +159,7 @@ func GenerateCRUDHandler(handlerDir, packagePath, entityName string, methods []t
 	}
 
 	selectFields = append([]string{"id"}, createFieldNames...)
-	selectFields = append(selectFields, "created_at", "updated_at", "created_by", "updated_by")
+	selectFields = append(selectFields, "created_at", "updated_at", "created_by", "updated_by", "version", "deleted_at", "deleted_by")
 
 	data := types.CRUDHandlerData{
 		PackagePath:        packagePath,
@@ -183,6 +183,20 @@ func GenerateCRUDHandler(handlerDir, packagePath, entityName string, methods []t
 	funcMap := template.FuncMap{
 		"lower":     strings.ToLower,
 		"hasPrefix": strings.HasPrefix,
+		"filterType": func(f types.Field) string {
+			// Filter value coercion for a non-enum field
+			switch f.Type {
+			case "int32", "int64", "uint32", "uint64":
+				return "{Type: helper.FieldInt}"
+			case "float", "double":
+				return "{Type: helper.FieldFloat}"
+			case "bool":
+				return "{Type: helper.FieldBool}"
+			case "google.protobuf.Timestamp":
+				return "{Type: helper.FieldTime}"
+			}
+			return "{}"
+		},
 		"pluralize": func(s string) string {
 			// Pluralize keeping first letter uppercase (Go proto convention)
 			if len(s) == 0 {
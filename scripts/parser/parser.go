@@ -180,7 +180,8 @@ func ParseEntityFields(filename string, enums map[string][]string) (map[string][
 
 				// Skip system fields
 				if fieldName == "id" || fieldName == "created_at" || fieldName == "updated_at" ||
-					fieldName == "created_by" || fieldName == "updated_by" {
+					fieldName == "created_by" || fieldName == "updated_by" || fieldName == "version" ||
+					fieldName == "deleted_at" || fieldName == "deleted_by" {
 					continue
 				}
 
@@ -243,7 +244,7 @@ func GroupMethodsByEntity(methods []types.Method) map[string][]types.Method {
 	for _, method := range methods {
 		// Extract entity name from method name
 		var entityName string
-		for _, prefix := range []string{"Create", "Get", "Update", "Delete", "List"} {
+		for _, prefix := range []string{"Create", "Get", "Update", "Delete", "Restore", "List"} {
 			if strings.HasPrefix(method.Name, prefix) {
 				entityName = strings.TrimPrefix(method.Name, prefix)
 				break
@@ -50,7 +50,7 @@ type CRUDHandlerData struct {
 	RequiredFields     []Field
 	OptionalFields     []Field
 	EnumFields         []Field
-	FilterableFields   []string
+	FilterableFields   []Field
 	CreateFields       []Field
 	CreateFieldsSQL    string
 	CreatePlaceholders string
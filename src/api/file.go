@@ -4,6 +4,8 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"io"
+	"net/http"
 	"path/filepath"
 	"strings"
 	"thaily/src/graph/helper"
@@ -11,10 +13,13 @@ import (
 	"time"
 
 	pb "thaily/proto/file"
+	pbThesis "thaily/proto/thesis"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // FileUploadType represents different upload destinations
@@ -285,6 +290,208 @@ func generateObjectPath(uploadType FileUploadType, userInfo *UserInfo, filename
 	return uniqueName
 }
 
+// isRoleAllowed checks whether the user's role is in the allowed list
+func isRoleAllowed(userInfo *UserInfo, allowedRoles []string) bool {
+	for _, role := range allowedRoles {
+		if userInfo.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTableType converts the table_type form value to TableType (defaults to TOPIC)
+func parseTableType(tableTypeStr string) pb.TableType {
+	switch tableTypeStr {
+	case "MIDTERM":
+		return pb.TableType_MIDTERM
+	case "FINAL":
+		return pb.TableType_FINAL
+	case "ORDER":
+		return pb.TableType_ORDER
+	case "GRADE_APPEAL":
+		return pb.TableType_GRADE_APPEAL
+	case "MILESTONE_CHECKIN":
+		return pb.TableType_MILESTONE_CHECKIN
+	default:
+		return pb.TableType_TOPIC
+	}
+}
+
+// defaultOption returns the File.option used when the client does not send one
+func defaultOption(uploadType FileUploadType) string {
+	switch uploadType {
+	case UploadTypeTemplate:
+		return "template"
+	case UploadTypeListStudent:
+		return "student_list"
+	case UploadTypeListTeacher:
+		return "teacher_list"
+	case UploadTypeFinal:
+		return "final_document"
+	default:
+		return "general"
+	}
+}
+
+// maxUploadSize returns the configured size limit in bytes for an upload type (0 = unlimited)
+func (h *APIHandler) maxUploadSize(uploadType FileUploadType) int64 {
+	if h.Config == nil || h.Config.Upload.MaxSizeByType == nil {
+		return 0
+	}
+	return h.Config.Upload.MaxSizeByType[string(uploadType)]
+}
+
+// validateFileSize checks the declared size against the configured limit
+func (h *APIHandler) validateFileSize(size int64, uploadType FileUploadType) error {
+	if size <= 0 {
+		return fmt.Errorf("file is empty")
+	}
+	if limit := h.maxUploadSize(uploadType); limit > 0 && size > limit {
+		return fmt.Errorf("file exceeds maximum size of %d MB for %s uploads", limit>>20, uploadType)
+	}
+	return nil
+}
+
+// fileMetadata holds the optional form fields attached to an uploaded file
+type fileMetadata struct {
+	Title     string `json:"title"`
+	TableType string `json:"table_type"`
+	TableID   string `json:"table_id"`
+	Option    string `json:"option"`
+	Late      bool   `json:"late"`
+}
+
+// storedObject describes an object already written to MinIO
+type storedObject struct {
+	Path        string
+	URL         string
+	Filename    string
+	Size        int64
+	Checksum    string
+	ContentType string
+	Quarantined bool
+	ScanResult  string
+}
+
+// saveFileMetadata stores the File row for an object already in MinIO.
+// The file service assigns the next version for (table, table_id, option).
+// The object is removed again if the row cannot be created.
+func (h *APIHandler) saveFileMetadata(c *gin.Context, uploadType FileUploadType, userInfo *UserInfo, object storedObject, meta fileMetadata) (*pb.File, error) {
+	title := meta.Title
+	if title == "" {
+		title = object.Filename
+	}
+
+	option := meta.Option
+	if option == "" {
+		option = defaultOption(uploadType)
+	}
+
+	tableID := meta.TableID
+	if tableID == "" {
+		tableID = "system"
+	}
+
+	// Save file metadata to database via gRPC
+	createResp, err := h.FileClient.CreateFile(c.Request.Context(), &pb.CreateFileRequest{
+		Title:       title,
+		File:        object.URL,
+		Status:      pb.FileStatus_FILE_PENDING,
+		Table:       parseTableType(meta.TableType),
+		Option:      option,
+		TableId:     tableID,
+		Size:        object.Size,
+		Checksum:    object.Checksum,
+		ContentType: object.ContentType,
+		Late:        meta.Late,
+		Quarantined: object.Quarantined,
+		ScanResult:  object.ScanResult,
+		CreatedBy:   userInfo.UserID,
+	})
+	if err != nil {
+		// If database save fails, try to delete from MinIO
+		_ = h.MimIo.DeleteFile(c.Request.Context(), object.Path)
+		return nil, err
+	}
+
+	return createResp.File, nil
+}
+
+// submissionKind returns the deadline an upload is subject to.
+// Only student submissions (midterm/final documents) have a submission window.
+func submissionKind(uploadType FileUploadType, tableType string) (pbThesis.SubmissionKind, bool) {
+	if uploadType != UploadTypeFinal {
+		return 0, false
+	}
+	if parseTableType(tableType) == pb.TableType_MIDTERM {
+		return pbThesis.SubmissionKind_SUBMISSION_MIDTERM, true
+	}
+	return pbThesis.SubmissionKind_SUBMISSION_FINAL, true
+}
+
+// submissionTableType returns the table a submission is attached to: the
+// student's Midterm or Final, the Final when table_type is not sent
+func submissionTableType(tableType string) (string, error) {
+	switch tableType {
+	case "":
+		return "FINAL", nil
+	case "MIDTERM", "FINAL":
+		return tableType, nil
+	default:
+		return "", fmt.Errorf("table_type of a submission must be MIDTERM or FINAL, got %s", tableType)
+	}
+}
+
+// checkSubmissionWindow enforces the student's submission window for the upload.
+// A submission is attached to the student's own Midterm or Final, which
+// meta.TableType and meta.TableID default to and may not differ from;
+// meta.Late is set when the upload falls inside the grace period. It writes
+// the error response and returns false when the upload is refused.
+func (h *APIHandler) checkSubmissionWindow(c *gin.Context, uploadType FileUploadType, semester, studentID string, meta *fileMetadata) bool {
+	if uploadType == UploadTypeFinal {
+		tableType, err := submissionTableType(meta.TableType)
+		if err != nil {
+			response.BadRequest(c, err.Error())
+			return false
+		}
+		meta.TableType = tableType
+	}
+	kind, subject := submissionKind(uploadType, meta.TableType)
+	if !subject {
+		return true
+	}
+	if h.ThesisClient == nil {
+		response.InternalError(c, "Thesis service not available")
+		return false
+	}
+
+	window, err := h.ThesisClient.CheckSubmissionWindow(c.Request.Context(), semester, studentID, kind)
+	if err != nil {
+		if st, isStatus := status.FromError(err); isStatus && st.Code() == codes.FailedPrecondition {
+			response.Forbidden(c, st.Message())
+			return false
+		}
+		response.InternalError(c, fmt.Sprintf("failed to check submission window: %v", err))
+		return false
+	}
+	if !window.Allowed {
+		response.Forbidden(c, window.Reason)
+		return false
+	}
+	if window.TableId == "" {
+		response.Forbidden(c, "your enrollment has no record to attach this submission to")
+		return false
+	}
+	if meta.TableID != "" && meta.TableID != window.TableId {
+		response.Forbidden(c, "table_id is not your own submission")
+		return false
+	}
+	meta.TableID = window.TableId
+	meta.Late = window.Late
+	return true
+}
+
 // uploadFileHandler handles file upload with validation
 func (h *APIHandler) uploadFileHandler(c *gin.Context, uploadType FileUploadType, allowedRoles []string) {
 	// Extract user info
@@ -295,14 +502,7 @@ func (h *APIHandler) uploadFileHandler(c *gin.Context, uploadType FileUploadType
 	}
 
 	// Check role permission
-	roleAllowed := false
-	for _, role := range allowedRoles {
-		if userInfo.Role == role {
-			roleAllowed = true
-			break
-		}
-	}
-	if !roleAllowed {
+	if !isRoleAllowed(userInfo, allowedRoles) {
 		response.Forbidden(c, fmt.Sprintf("role %s is not allowed to upload this type of file", userInfo.Role))
 		return
 	}
@@ -326,6 +526,23 @@ func (h *APIHandler) uploadFileHandler(c *gin.Context, uploadType FileUploadType
 		return
 	}
 
+	// Validate file size
+	if err := h.validateFileSize(fileHeader.Size, uploadType); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	// Check submission deadline and ownership
+	meta := fileMetadata{
+		Title:     c.PostForm("title"),
+		TableType: c.PostForm("table_type"),
+		TableID:   c.PostForm("table_id"),
+		Option:    c.PostForm("option"),
+	}
+	if !h.checkSubmissionWindow(c, uploadType, userInfo.Semester, userInfo.UserID, &meta) {
+		return
+	}
+
 	// Open file
 	file, err := fileHeader.Open()
 	if err != nil {
@@ -334,72 +551,39 @@ func (h *APIHandler) uploadFileHandler(c *gin.Context, uploadType FileUploadType
 	}
 	defer file.Close()
 
-	// Generate object path
-	objectPath := generateObjectPath(uploadType, userInfo, fileHeader.Filename)
-	contentType := getContentType(fileHeader.Filename)
-
-	// Upload to MinIO
-	fileURL, err := h.MimIo.UploadFile(c.Request.Context(), objectPath, file, fileHeader.Size, contentType)
+	// Check the real content and scan for malware before storing anything
+	verdict, err := h.screenUpload(c.Request.Context(), file, fileHeader.Size, fileHeader.Filename)
 	if err != nil {
-		response.InternalError(c, fmt.Sprintf("failed to upload file: %v", err))
+		response.BadRequest(c, err.Error())
 		return
 	}
 
-	// Get optional fields
-	title := c.PostForm("title")
-	if title == "" {
-		title = fileHeader.Filename
-	}
-
-	tableType := pb.TableType_TOPIC
-	tableTypeStr := c.PostForm("table_type")
-	switch tableTypeStr {
-	case "TOPIC":
-		tableType = pb.TableType_TOPIC
-	case "MIDTERM":
-		tableType = pb.TableType_MIDTERM
-	case "FINAL":
-		tableType = pb.TableType_FINAL
-	case "ORDER":
-		tableType = pb.TableType_ORDER
-	}
-
-	// Get option with default value based on upload type
-	option := c.PostForm("option")
-	if option == "" {
-		switch uploadType {
-		case UploadTypeTemplate:
-			option = "template"
-		case UploadTypeListStudent:
-			option = "student_list"
-		case UploadTypeListTeacher:
-			option = "teacher_list"
-		case UploadTypeFinal:
-			option = "final_document"
-		default:
-			option = "general"
-		}
+	// Generate object path (suspicious files go under the quarantine prefix)
+	objectPath := generateObjectPath(uploadType, userInfo, fileHeader.Filename)
+	if verdict.Suspicious {
+		objectPath = h.quarantinePath(objectPath)
 	}
+	contentType := getContentType(fileHeader.Filename)
 
-	tableID := c.PostForm("table_id")
-	if tableID == "" {
-		tableID = "system"
+	// Upload to MinIO, hashing the content on the way through
+	hasher := sha256.New()
+	fileURL, err := h.MimIo.UploadFile(c.Request.Context(), objectPath, io.TeeReader(file, hasher), fileHeader.Size, contentType)
+	if err != nil {
+		response.InternalError(c, fmt.Sprintf("failed to upload file: %v", err))
+		return
 	}
 
-	// Save file metadata to database via gRPC
-	createResp, err := h.FileClient.CreateFile(c.Request.Context(), &pb.CreateFileRequest{
-		Title:     title,
-		File:      fileURL,
-		Status:    pb.FileStatus_REJECTED,
-		Table:     tableType,
-		Option:    option,
-		TableId:   tableID,
-		CreatedBy: userInfo.UserID,
-	})
-
+	created, err := h.saveFileMetadata(c, uploadType, userInfo, storedObject{
+		Path:        objectPath,
+		URL:         fileURL,
+		Filename:    fileHeader.Filename,
+		Size:        fileHeader.Size,
+		Checksum:    hex.EncodeToString(hasher.Sum(nil)),
+		ContentType: contentType,
+		Quarantined: verdict.Suspicious,
+		ScanResult:  verdict.Reason,
+	}, meta)
 	if err != nil {
-		// If database save fails, try to delete from MinIO
-		_ = h.MimIo.DeleteFile(c.Request.Context(), objectPath)
 		response.InternalError(c, fmt.Sprintf("failed to save file metadata: %v", err))
 		return
 	}
@@ -407,8 +591,16 @@ func (h *APIHandler) uploadFileHandler(c *gin.Context, uploadType FileUploadType
 	// Invalidate file cache
 	//_ = h.FileClient.InvalidateAllFileCache(c.Request.Context())
 
+	// Fingerprint final submissions for the similarity report
+	h.indexSubmission(created, objectPath)
+
 	response.SuccessWithMessage(c, "File uploaded successfully", gin.H{
-		"file_id":       createResp.File.Id,
+		"file_id":       created.Id,
+		"version":       created.Version,
+		"checksum":      created.Checksum,
+		"late":          created.Late,
+		"quarantined":   created.Quarantined,
+		"scan_result":   created.ScanResult,
 		"filename":      fileHeader.Filename,
 		"size":          fileHeader.Size,
 		"url":           fileURL,
@@ -489,6 +681,11 @@ func (h *APIHandler) GetFileURL(c *gin.Context) {
 		return
 	}
 
+	if fileResp.File.Quarantined {
+		response.Forbidden(c, "File is quarantined pending review")
+		return
+	}
+
 	// Extract object path from file URL
 	fileURL := fileResp.File.File
 	parts := strings.Split(fileURL, "/")
@@ -517,10 +714,11 @@ func (h *APIHandler) GetFileURL(c *gin.Context) {
 	})
 }
 
-// DeleteFile deletes a file
+// DeleteFile soft-deletes a file. The object stays in MinIO so the file can
+// be restored; the retention job removes both once the period is over.
 // DELETE /api/files/:id
 func (h *APIHandler) DeleteFile(c *gin.Context) {
-	if h.FileClient == nil || h.MimIo == nil {
+	if h.FileClient == nil {
 		response.InternalError(c, "File service not available")
 		return
 	}
@@ -551,26 +749,142 @@ func (h *APIHandler) DeleteFile(c *gin.Context) {
 		return
 	}
 
-	// Extract object path from file URL
+	// Reviewed versions are immutable and the file service refuses to delete them
+	if _, err := h.FileClient.DeleteFile(c.Request.Context(), fileID, userInfo.UserID); err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			response.Error(c, http.StatusConflict, st.Message())
+			return
+		}
+		response.InternalError(c, fmt.Sprintf("Failed to delete file: %v", err))
+		return
+	}
+
+	response.SuccessWithMessage(c, "File deleted successfully", gin.H{
+		"file_id": fileID,
+	})
+}
+
+// RestoreFile brings back a file its owner deleted, until the retention job
+// purges it
+// POST /api/files/:id/restore
+func (h *APIHandler) RestoreFile(c *gin.Context) {
+	if h.FileClient == nil {
+		response.InternalError(c, "File service not available")
+		return
+	}
+
+	fileID := c.Param("id")
+	if fileID == "" {
+		response.BadRequest(c, "File ID required")
+		return
+	}
+
+	// Extract user info for authorization
+	userInfo, err := h.extractUserInfo(c)
+	if err != nil {
+		response.Unauthorized(c, err.Error())
+		return
+	}
+
+	// Get file metadata, deleted or not
+	fileResp, err := h.FileClient.GetFileIncludingDeleted(c.Request.Context(), fileID)
+	if err != nil {
+		response.NotFound(c, fmt.Sprintf("File not found: %v", err))
+		return
+	}
+
+	// Check if user is the owner
+	if fileResp.File.CreatedBy != userInfo.UserID {
+		response.Forbidden(c, "You can only restore your own files")
+		return
+	}
+
+	restored, err := h.FileClient.RestoreFile(c.Request.Context(), fileID, userInfo.UserID)
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			response.Error(c, http.StatusConflict, st.Message())
+			return
+		}
+		response.InternalError(c, fmt.Sprintf("Failed to restore file: %v", err))
+		return
+	}
+
+	response.SuccessWithMessage(c, "File restored successfully", gin.H{
+		"file_id":  fileID,
+		"filename": restored.File.Title,
+	})
+}
+
+// ReleaseFile clears a quarantined file: the object is moved out of the
+// quarantine prefix and the file becomes available for review
+// POST /api/files/:id/release
+func (h *APIHandler) ReleaseFile(c *gin.Context) {
+	if h.FileClient == nil || h.MimIo == nil {
+		response.InternalError(c, "File service not available")
+		return
+	}
+
+	fileID := c.Param("id")
+	if fileID == "" {
+		response.BadRequest(c, "File ID required")
+		return
+	}
+
+	userInfo, err := h.extractUserInfo(c)
+	if err != nil {
+		response.Unauthorized(c, err.Error())
+		return
+	}
+	if !isRoleAllowed(userInfo, []string{"teacher"}) {
+		response.Forbidden(c, "Only teachers can release quarantined files")
+		return
+	}
+
+	fileResp, err := h.FileClient.GetFileById(c.Request.Context(), fileID)
+	if err != nil {
+		response.NotFound(c, fmt.Sprintf("File not found: %v", err))
+		return
+	}
+	if !fileResp.File.Quarantined {
+		response.BadRequest(c, "File is not quarantined")
+		return
+	}
+
+	// URL format: http://host:port/bucket/path/to/file
+	parts := strings.Split(fileResp.File.File, "/")
+	if len(parts) < 5 {
+		response.InternalError(c, "Invalid file URL format")
+		return
+	}
+	objectName := strings.Join(parts[4:], "/")
+	releasedName := strings.TrimPrefix(objectName, h.quarantinePath(""))
+
 	fileURL := fileResp.File.File
-	parts := strings.Split(fileURL, "/")
-	if len(parts) >= 5 {
-		// URL format: http://host:port/bucket/path/to/file
-		// parts: [http:, , host:port, bucket, path, to, file]
-		// We need everything after bucket (index 4 onwards)
-		objectName := strings.Join(parts[4:], "/")
-		// Delete from MinIO (ignore error if file doesn't exist)
-		_ = h.MimIo.DeleteFile(c.Request.Context(), objectName)
+	if releasedName != objectName {
+		fileURL, err = h.MimIo.MoveObject(c.Request.Context(), objectName, releasedName)
+		if err != nil {
+			response.InternalError(c, fmt.Sprintf("Failed to release file: %v", err))
+			return
+		}
 	}
 
-	// Delete from database (implement DeleteFile in client if not exists)
-	// For now, we can update status to deleted or implement DeleteFile
+	quarantined := false
+	updated, err := h.FileClient.UpdateFile(c.Request.Context(), &pb.UpdateFileRequest{
+		Id:          fileID,
+		File:        &fileURL,
+		Quarantined: &quarantined,
+		UpdatedBy:   userInfo.UserID,
+	})
+	if err != nil {
+		response.InternalError(c, fmt.Sprintf("Failed to update file: %v", err))
+		return
+	}
 
-	// Invalidate cache
-	//_ = h.FileClient.InvalidateFileCache(c.Request.Context(), fileID)
+	// Released final submissions were skipped by indexing on upload
+	h.indexSubmission(updated.File, releasedName)
 
-	response.SuccessWithMessage(c, "File deleted successfully", gin.H{
-		"file_id": fileID,
+	response.SuccessWithMessage(c, "File released from quarantine", gin.H{
+		"file": updated.File,
 	})
 }
 
@@ -635,6 +949,11 @@ func (h *APIHandler) GetBlobURL(c *gin.Context) {
 		return
 	}
 
+	if fileResp.File.Quarantined {
+		response.Forbidden(c, "File is quarantined pending review")
+		return
+	}
+
 	// Generate blob token (bound to current browser session)
 	token, err := h.generateBlobToken(c, fileID, userInfo)
 	if err != nil {
@@ -697,6 +1016,11 @@ func (h *APIHandler) GetFileBlob(c *gin.Context) {
 		return
 	}
 
+	if fileResp.File.Quarantined {
+		response.Forbidden(c, "File is quarantined pending review")
+		return
+	}
+
 	// Extract object path from file URL
 	fileURL := fileResp.File.File
 	parts := strings.Split(fileURL, "/")
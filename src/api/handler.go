@@ -13,9 +13,11 @@ type APIHandler struct {
 	UserClient     *client.GRPCUser
 	AcademicClient *client.GRPCAcadamicClient
 	FileClient     *client.GRPCfile
+	ThesisClient   *client.GRPCthesis
 	Redis          *client.RedisClient
 	Mongodb        *client.MongoClient
 	MimIo          *client.ServiceMinIo
+	Scanner        client.Scanner
 	// Thêm các client khác nếu cần
 }
 
@@ -55,6 +57,13 @@ func WithFileClient(client *client.GRPCfile) ClientOption {
 	}
 }
 
+// WithThesisClient inject thesis client
+func WithThesisClient(client *client.GRPCthesis) ClientOption {
+	return func(h *APIHandler) {
+		h.ThesisClient = client
+	}
+}
+
 func WithRedisClient(client *client.RedisClient) ClientOption {
 	return func(h *APIHandler) {
 		h.Redis = client
@@ -74,6 +83,13 @@ func WithMimIo(client *client.ServiceMinIo) ClientOption {
 	}
 }
 
+// WithScanner inject malware scanner for uploads
+func WithScanner(scanner client.Scanner) ClientOption {
+	return func(h *APIHandler) {
+		h.Scanner = scanner
+	}
+}
+
 func WithConfig(cfg *config.Config) ClientOption {
 	return func(h *APIHandler) {
 		h.Config = cfg
@@ -100,6 +116,13 @@ func (h *APIHandler) RegisterRoutes(r *gin.RouterGroup) {
 		files.POST("/upload/list-teacher", AuthMiddleware(h.Config.JWT), h.UploadListTeacherFile)
 		files.POST("/upload/final", AuthMiddleware(h.Config.JWT), h.UploadFinalFile)
 
+		// Resumable (chunked) uploads for large files
+		files.POST("/uploads", AuthMiddleware(h.Config.JWT), h.InitUpload)
+		files.GET("/uploads/:uploadId", AuthMiddleware(h.Config.JWT), h.GetUploadStatus)
+		files.PUT("/uploads/:uploadId/parts/:partNumber", AuthMiddleware(h.Config.JWT), h.UploadPart)
+		files.POST("/uploads/:uploadId/complete", AuthMiddleware(h.Config.JWT), h.CompleteUpload)
+		files.DELETE("/uploads/:uploadId", AuthMiddleware(h.Config.JWT), h.AbortUpload)
+
 		// Get file info
 		files.GET("/:id", AuthMiddleware(h.Config.JWT), h.GetFile)
 		// Get presigned download URL
@@ -108,6 +131,10 @@ func (h *APIHandler) RegisterRoutes(r *gin.RouterGroup) {
 		files.GET("/:id/blob-url", AuthMiddleware(h.Config.JWT), h.GetBlobURL)
 		// Delete file
 		files.DELETE("/:id", AuthMiddleware(h.Config.JWT), h.DeleteFile)
+		// Restore a deleted file before the retention job purges it
+		files.POST("/:id/restore", AuthMiddleware(h.Config.JWT), h.RestoreFile)
+		// Release a quarantined file after review
+		files.POST("/:id/release", AuthMiddleware(h.Config.JWT), h.ReleaseFile)
 		// List files
 		files.GET("", AuthMiddleware(h.Config.JWT), h.ListFiles)
 
@@ -0,0 +1,255 @@
+package api
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"unicode/utf16"
+)
+
+// Upload content screening. The extension is only trusted once the bytes agree
+// with it; broken or encrypted documents are rejected outright. Content that is
+// merely suspicious (macros, scanner hits, scanner unavailable) is stored under
+// the quarantine prefix with status pending until someone clears it.
+
+const (
+	// mimeOLE is reported for OLE2 compound files (.doc, .xls)
+	mimeOLE = "application/x-ole-storage"
+
+	// pdfWindow is how much of the head/tail of a PDF is searched for trailer keys
+	pdfWindow = 64 << 10
+)
+
+var (
+	oleMagic = []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+
+	// Directory entry names are UTF-16LE in OLE2 files; every VBA project has one
+	oleVBAProject = utf16LE("_VBA_PROJECT")
+
+	// sniffedTypeByExt is the sniffed content type each accepted extension must have
+	sniffedTypeByExt = map[string]string{
+		".pdf":  "application/pdf",
+		".docx": "application/zip",
+		".xlsx": "application/zip",
+		".doc":  mimeOLE,
+		".xls":  mimeOLE,
+	}
+)
+
+// contentVerdict is the outcome of screening an upload that was not rejected
+type contentVerdict struct {
+	Suspicious bool
+	Reason     string
+}
+
+func (v *contentVerdict) flag(reason string) {
+	v.Suspicious = true
+	if v.Reason != "" {
+		v.Reason += "; "
+	}
+	v.Reason += reason
+}
+
+func utf16LE(s string) []byte {
+	units := utf16.Encode([]rune(s))
+	out := make([]byte, 0, len(units)*2)
+	for _, u := range units {
+		out = append(out, byte(u), byte(u>>8))
+	}
+	return out
+}
+
+// sniffContentType detects the content type from the first bytes of a file
+func sniffContentType(head []byte) string {
+	if bytes.HasPrefix(head, oleMagic) {
+		return mimeOLE
+	}
+	return http.DetectContentType(head)
+}
+
+// readWindow reads up to n bytes at offset, tolerating a short read at EOF
+func readWindow(r io.ReaderAt, offset, n int64) ([]byte, error) {
+	buf := make([]byte, n)
+	read, err := r.ReadAt(buf, offset)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return buf[:read], nil
+}
+
+// inspectContent checks that the bytes match the extension and that the
+// document is structurally sound. An error means the upload must be rejected.
+func inspectContent(r io.ReaderAt, size int64, filename string) (*contentVerdict, error) {
+	ext := strings.ToLower(filepath.Ext(filename))
+
+	head, err := readWindow(r, 0, 512)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	sniffed := sniffContentType(head)
+	if want, ok := sniffedTypeByExt[ext]; ok && !strings.HasPrefix(sniffed, want) {
+		return nil, fmt.Errorf("file content (%s) does not match the %s extension", sniffed, ext)
+	}
+
+	verdict := &contentVerdict{}
+	switch ext {
+	case ".pdf":
+		err = checkPDF(r, size)
+	case ".docx", ".xlsx":
+		err = checkOOXML(r, size, verdict)
+	case ".doc", ".xls":
+		err = checkOLE(r, size, verdict)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return verdict, nil
+}
+
+// checkPDF verifies the trailer is present and the document is not encrypted
+func checkPDF(r io.ReaderAt, size int64) error {
+	tailStart := size - pdfWindow
+	if tailStart < 0 {
+		tailStart = 0
+	}
+	tail, err := readWindow(r, tailStart, size-tailStart)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	end := tail
+	if len(end) > 1024 {
+		end = end[len(end)-1024:]
+	}
+	if !bytes.Contains(end, []byte("%%EOF")) {
+		return fmt.Errorf("PDF is truncated or corrupted (missing %%%%EOF)")
+	}
+	if !bytes.Contains(tail, []byte("startxref")) {
+		return fmt.Errorf("PDF is corrupted (missing cross-reference table)")
+	}
+
+	// Linearized PDFs carry a trailer near the start as well
+	head, err := readWindow(r, 0, pdfWindow)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+	if bytes.Contains(tail, []byte("/Encrypt")) || bytes.Contains(head, []byte("/Encrypt")) {
+		return fmt.Errorf("encrypted or password-protected PDFs are not accepted")
+	}
+	return nil
+}
+
+// checkOOXML opens a .docx/.xlsx package and flags embedded VBA projects
+func checkOOXML(r io.ReaderAt, size int64, verdict *contentVerdict) error {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return fmt.Errorf("Office document is corrupted: %v", err)
+	}
+
+	hasContentTypes := false
+	for _, f := range zr.File {
+		name := strings.ToLower(f.Name)
+		if name == "[content_types].xml" {
+			hasContentTypes = true
+			rc, err := f.Open()
+			if err != nil {
+				return fmt.Errorf("Office document is corrupted: %v", err)
+			}
+			types, err := io.ReadAll(io.LimitReader(rc, 1<<20))
+			rc.Close()
+			if err != nil {
+				return fmt.Errorf("Office document is corrupted: %v", err)
+			}
+			if bytes.Contains(bytes.ToLower(types), []byte("macroenabled")) {
+				verdict.flag("macro-enabled Office document")
+			}
+		}
+		if strings.HasSuffix(name, "vbaproject.bin") {
+			verdict.flag("document contains VBA macros")
+		}
+	}
+
+	if !hasContentTypes {
+		return fmt.Errorf("not a valid Office document")
+	}
+	return nil
+}
+
+// checkOLE flags legacy .doc/.xls files that carry a VBA project
+func checkOLE(r io.ReaderAt, size int64, verdict *contentVerdict) error {
+	found, err := streamContains(io.NewSectionReader(r, 0, size), oleVBAProject)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+	if found {
+		verdict.flag("document contains VBA macros")
+	}
+	return nil
+}
+
+// streamContains searches a stream for needle without loading it into memory
+func streamContains(r io.Reader, needle []byte) (bool, error) {
+	buf := make([]byte, 64<<10+len(needle))
+	keep := 0
+	for {
+		n, err := r.Read(buf[keep:])
+		window := buf[:keep+n]
+		if bytes.Contains(window, needle) {
+			return true, nil
+		}
+		// Keep the tail so a match spanning two reads is not missed
+		keep = len(needle) - 1
+		if keep > len(window) {
+			keep = len(window)
+		}
+		copy(buf, window[len(window)-keep:])
+
+		if err == io.EOF {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+}
+
+// screenUpload inspects the content and runs the malware scanner on it.
+// An error rejects the upload; a suspicious verdict sends it to quarantine.
+func (h *APIHandler) screenUpload(ctx context.Context, r io.ReaderAt, size int64, filename string) (*contentVerdict, error) {
+	verdict, err := inspectContent(r, size, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	if h.Scanner == nil {
+		return verdict, nil
+	}
+
+	result, err := h.Scanner.Scan(ctx, io.NewSectionReader(r, 0, size))
+	if err != nil {
+		// Fail closed: keep the file but hold it until it can be cleared
+		log.Printf("malware scan failed for %s: %v", filename, err)
+		verdict.flag("malware scan unavailable")
+		return verdict, nil
+	}
+	if !result.Clean {
+		verdict.flag("malware detected: " + result.Signature)
+	}
+	return verdict, nil
+}
+
+// quarantinePath returns where a suspicious object is stored
+func (h *APIHandler) quarantinePath(objectPath string) string {
+	prefix := "quarantine/"
+	if h.Config != nil && h.Config.Upload.QuarantinePrefix != "" {
+		prefix = h.Config.Upload.QuarantinePrefix
+	}
+	return prefix + objectPath
+}
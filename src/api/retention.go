@@ -0,0 +1,77 @@
+package api
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"thaily/src/pkg/retention"
+)
+
+// retentionBatch bounds the files purged per call to the file service
+const retentionBatch = 100
+
+// RunFileRetention purges files soft-deleted longer than the retention
+// period, every retention interval until ctx is done. The file service cannot
+// reach MinIO, so the object is removed here: the row is first claimed, which
+// stops it from being restored, then its object removed and the row purged.
+// A claimed row whose object could not be removed is kept for the next run.
+func (h *APIHandler) RunFileRetention(ctx context.Context) {
+	period, interval := retention.Period(), retention.Interval()
+	if h.FileClient == nil || h.MimIo == nil || period <= 0 || interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if purged := h.purgeExpiredFiles(ctx, period); purged > 0 {
+			log.Printf("Retention: purged %d file(s) deleted more than %v ago", purged, period)
+		}
+	}
+}
+
+// purgeExpiredFiles runs one retention pass and returns the files purged
+func (h *APIHandler) purgeExpiredFiles(ctx context.Context, period time.Duration) int {
+	purged := 0
+	for {
+		resp, err := h.FileClient.ListExpiredFiles(ctx, period, retentionBatch)
+		if err != nil {
+			log.Printf("Retention: failed to list expired files: %v", err)
+			return purged
+		}
+
+		batch := 0
+		for _, file := range resp.Files {
+			if _, err := h.FileClient.ClaimFilePurge(ctx, file.Id, period); err != nil {
+				log.Printf("Retention: keeping file %s: %v", file.Id, err)
+				continue
+			}
+			// URL format: http://host:port/bucket/path/to/file
+			if parts := strings.Split(file.File, "/"); len(parts) >= 5 {
+				if err := h.MimIo.DeleteFile(ctx, strings.Join(parts[4:], "/")); err != nil {
+					log.Printf("Retention: keeping file %s: %v", file.Id, err)
+					continue
+				}
+			}
+			if _, err := h.FileClient.PurgeFile(ctx, file.Id, period); err != nil {
+				log.Printf("Retention: failed to purge file %s: %v", file.Id, err)
+				continue
+			}
+			batch++
+		}
+		purged += batch
+
+		// A short page is the last one; a page of failures would come back
+		// unchanged, so leave it for the next run
+		if len(resp.Files) < retentionBatch || batch == 0 {
+			return purged
+		}
+	}
+}
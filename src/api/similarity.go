@@ -0,0 +1,60 @@
+package api
+
+import (
+	"context"
+	"log"
+	"path/filepath"
+	"strings"
+	"time"
+
+	pb "thaily/proto/file"
+	"thaily/src/pkg/similarity"
+)
+
+// indexTimeout bounds text extraction and indexing of one submission
+const indexTimeout = 2 * time.Minute
+
+// indexSubmission extracts the text of a final thesis submission and stores
+// its similarity fingerprint. It runs in the background so the upload response
+// is not held up; a failure only means the file has no similarity report yet.
+func (h *APIHandler) indexSubmission(file *pb.File, objectPath string) {
+	// Midterm reports go through the same upload path but are not checked
+	if file == nil || file.Table == pb.TableType_MIDTERM || file.Quarantined {
+		return
+	}
+	if h.FileClient == nil || h.MimIo == nil {
+		return
+	}
+
+	// Object path: final/{semester}/{student_id}/{name}
+	parts := strings.Split(objectPath, "/")
+	if len(parts) < 4 || parts[0] != string(UploadTypeFinal) {
+		return
+	}
+	semester := parts[1]
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), indexTimeout)
+		defer cancel()
+
+		object, info, err := h.MimIo.GetFileBlob(ctx, objectPath)
+		if err != nil {
+			log.Printf("similarity: failed to fetch %s: %v", objectPath, err)
+			return
+		}
+		defer object.Close()
+
+		text, err := similarity.ExtractText(object, info.Size, filepath.Base(objectPath))
+		if err != nil {
+			log.Printf("similarity: failed to extract text of %s: %v", file.Id, err)
+			return
+		}
+
+		resp, err := h.FileClient.IndexFileContent(ctx, file.Id, semester, text)
+		if err != nil {
+			log.Printf("similarity: failed to index %s: %v", file.Id, err)
+			return
+		}
+		log.Printf("similarity: indexed %s (%d words)", file.Id, resp.WordCount)
+	}()
+}
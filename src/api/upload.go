@@ -0,0 +1,521 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"thaily/src/pkg/response"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+)
+
+// Resumable upload protocol (backed by MinIO multipart uploads):
+//
+//	POST   /api/v1/files/uploads                               -> init, returns upload_id + chunk_size
+//	PUT    /api/v1/files/uploads/:uploadId/parts/:partNumber   -> upload one part (raw body, X-Part-Checksum = sha256 hex)
+//	GET    /api/v1/files/uploads/:uploadId                     -> uploaded/missing parts, used to resume
+//	POST   /api/v1/files/uploads/:uploadId/complete            -> assemble object and create File row
+//	DELETE /api/v1/files/uploads/:uploadId                     -> abort and discard parts
+//
+// Session state lives in Redis: upload_session:{id} holds the session JSON,
+// upload_parts:{id} is a hash of part number -> uploadedPart JSON and
+// upload_minio:{minio upload id} names the session of a MinIO upload. The
+// sweep aborts MinIO uploads left without a session.
+
+const (
+	uploadSessionPrefix = "upload_session:"
+	uploadPartsPrefix   = "upload_parts:"
+	uploadMinioPrefix   = "upload_minio:"
+
+	// PartChecksumHeader carries the sha256 (hex) of the part body
+	PartChecksumHeader = "X-Part-Checksum"
+
+	// MinIO requires every part except the last to be at least 5MB
+	minChunkSize = 5 << 20
+	maxParts     = 10000
+)
+
+// resumableUploadRoles lists the roles allowed to start a resumable upload per type
+var resumableUploadRoles = map[FileUploadType][]string{
+	UploadTypeTemplate:    {"teacher"},
+	UploadTypeListStudent: {"teacher"},
+	UploadTypeListTeacher: {"teacher"},
+	UploadTypeFinal:       {"student"},
+}
+
+// uploadSession is the Redis-backed state of a resumable upload
+type uploadSession struct {
+	ID            string         `json:"id"`
+	Type          FileUploadType `json:"type"`
+	UserID        string         `json:"user_id"`
+	Role          string         `json:"role"`
+	Semester      string         `json:"semester"`
+	Filename      string         `json:"filename"`
+	ContentType   string         `json:"content_type"`
+	ObjectPath    string         `json:"object_path"`
+	MinioUploadID string         `json:"minio_upload_id"`
+	Size          int64          `json:"size"`
+	ChunkSize     int64          `json:"chunk_size"`
+	TotalParts    int            `json:"total_parts"`
+	Meta          fileMetadata   `json:"meta"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
+
+// uploadedPart is the record kept for each part accepted by MinIO
+type uploadedPart struct {
+	PartNumber int    `json:"part_number"`
+	ETag       string `json:"etag"`
+	Size       int64  `json:"size"`
+	Checksum   string `json:"checksum"`
+}
+
+// InitUploadRequest starts a resumable upload
+type InitUploadRequest struct {
+	Type      string `json:"type" binding:"required"` // template | list_student | list_teacher | final
+	Filename  string `json:"filename" binding:"required"`
+	Size      int64  `json:"size" binding:"required"`
+	Title     string `json:"title"`
+	TableType string `json:"table_type"`
+	TableID   string `json:"table_id"`
+	Option    string `json:"option"`
+}
+
+// partSize returns the expected byte size of a part (the last part may be shorter)
+func (s *uploadSession) partSize(partNumber int) int64 {
+	if partNumber == s.TotalParts {
+		return s.Size - int64(s.TotalParts-1)*s.ChunkSize
+	}
+	return s.ChunkSize
+}
+
+func (h *APIHandler) uploadChunkSize() int64 {
+	if h.Config == nil || h.Config.Upload.ChunkSize < minChunkSize {
+		return minChunkSize
+	}
+	return h.Config.Upload.ChunkSize
+}
+
+func (h *APIHandler) uploadSessionTTL() time.Duration {
+	if h.Config == nil || h.Config.Upload.SessionTTL <= 0 {
+		return 24 * time.Hour
+	}
+	return time.Duration(h.Config.Upload.SessionTTL) * time.Hour
+}
+
+// saveUploadSession stores the session and refreshes the TTL of its part hash
+func (h *APIHandler) saveUploadSession(c *gin.Context, session *uploadSession) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	ttl := h.uploadSessionTTL()
+	if err := h.Redis.Set(c.Request.Context(), uploadSessionPrefix+session.ID, data, ttl); err != nil {
+		return err
+	}
+	if err := h.Redis.Set(c.Request.Context(), uploadMinioPrefix+session.MinioUploadID, session.ID, ttl); err != nil {
+		return err
+	}
+	_ = h.Redis.Expire(c.Request.Context(), uploadPartsPrefix+session.ID, ttl)
+	return nil
+}
+
+// loadUploadSession loads the session from Redis and checks that the caller owns it
+func (h *APIHandler) loadUploadSession(c *gin.Context) (*uploadSession, *UserInfo, bool) {
+	userInfo, err := h.extractUserInfo(c)
+	if err != nil {
+		response.Unauthorized(c, err.Error())
+		return nil, nil, false
+	}
+
+	uploadID := c.Param("uploadId")
+	data, err := h.Redis.Get(c.Request.Context(), uploadSessionPrefix+uploadID)
+	if err != nil {
+		response.NotFound(c, "upload session not found or expired")
+		return nil, nil, false
+	}
+
+	var session uploadSession
+	if err := json.Unmarshal([]byte(data), &session); err != nil {
+		response.InternalError(c, "corrupted upload session")
+		return nil, nil, false
+	}
+
+	if session.UserID != userInfo.UserID || session.Role != userInfo.Role {
+		response.Forbidden(c, "You can only access your own uploads")
+		return nil, nil, false
+	}
+
+	return &session, userInfo, true
+}
+
+// loadUploadedParts returns the accepted parts keyed by part number
+func (h *APIHandler) loadUploadedParts(c *gin.Context, sessionID string) (map[int]uploadedPart, error) {
+	raw, err := h.Redis.HGetAll(c.Request.Context(), uploadPartsPrefix+sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := make(map[int]uploadedPart, len(raw))
+	for _, value := range raw {
+		var part uploadedPart
+		if err := json.Unmarshal([]byte(value), &part); err != nil {
+			return nil, err
+		}
+		parts[part.PartNumber] = part
+	}
+	return parts, nil
+}
+
+func (h *APIHandler) deleteUploadSession(c *gin.Context, session *uploadSession) {
+	_ = h.Redis.Del(c.Request.Context(),
+		uploadSessionPrefix+session.ID,
+		uploadPartsPrefix+session.ID,
+		uploadMinioPrefix+session.MinioUploadID,
+	)
+}
+
+// objectChecksum returns the sha256 (hex) of the whole object, the same
+// checksum the single-request upload stores
+func objectChecksum(r io.ReaderAt, size int64) (string, error) {
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, io.NewSectionReader(r, 0, size)); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// InitUpload starts a resumable upload
+// POST /api/v1/files/uploads
+func (h *APIHandler) InitUpload(c *gin.Context) {
+	if h.MimIo == nil || h.Redis == nil {
+		response.InternalError(c, "File service not available")
+		return
+	}
+
+	var req InitUploadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	userInfo, err := h.extractUserInfo(c)
+	if err != nil {
+		response.Unauthorized(c, err.Error())
+		return
+	}
+
+	uploadType := FileUploadType(req.Type)
+	allowedRoles, ok := resumableUploadRoles[uploadType]
+	if !ok {
+		response.BadRequest(c, fmt.Sprintf("unknown upload type %s", req.Type))
+		return
+	}
+	if !isRoleAllowed(userInfo, allowedRoles) {
+		response.Forbidden(c, fmt.Sprintf("role %s is not allowed to upload this type of file", userInfo.Role))
+		return
+	}
+	if uploadType != UploadTypeFinal && userInfo.Semester == "" {
+		response.BadRequest(c, "semester is required")
+		return
+	}
+	if err := validateFileType(req.Filename, uploadType); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	if err := h.validateFileSize(req.Size, uploadType); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	// Refuse early when the submission window is not open or the record is
+	// not the student's; both are checked again on completion
+	meta := fileMetadata{
+		Title:     req.Title,
+		TableType: req.TableType,
+		TableID:   req.TableID,
+		Option:    req.Option,
+	}
+	if !h.checkSubmissionWindow(c, uploadType, userInfo.Semester, userInfo.UserID, &meta) {
+		return
+	}
+
+	chunkSize := h.uploadChunkSize()
+	totalParts := int((req.Size + chunkSize - 1) / chunkSize)
+	if totalParts > maxParts {
+		response.BadRequest(c, fmt.Sprintf("file too large: more than %d parts of %d MB", maxParts, chunkSize>>20))
+		return
+	}
+
+	objectPath := generateObjectPath(uploadType, userInfo, req.Filename)
+	contentType := getContentType(req.Filename)
+
+	minioUploadID, err := h.MimIo.NewMultipartUpload(c.Request.Context(), objectPath, contentType)
+	if err != nil {
+		response.InternalError(c, err.Error())
+		return
+	}
+
+	session := &uploadSession{
+		ID:            uuid.New().String(),
+		Type:          uploadType,
+		UserID:        userInfo.UserID,
+		Role:          userInfo.Role,
+		Semester:      userInfo.Semester,
+		Filename:      req.Filename,
+		ContentType:   contentType,
+		ObjectPath:    objectPath,
+		MinioUploadID: minioUploadID,
+		Size:          req.Size,
+		ChunkSize:     chunkSize,
+		TotalParts:    totalParts,
+		Meta:          meta,
+		CreatedAt:     time.Now(),
+	}
+
+	if err := h.saveUploadSession(c, session); err != nil {
+		_ = h.MimIo.AbortMultipartUpload(c.Request.Context(), objectPath, minioUploadID)
+		response.InternalError(c, fmt.Sprintf("failed to store upload session: %v", err))
+		return
+	}
+
+	response.SuccessWithMessage(c, "Upload initialized", gin.H{
+		"upload_id":   session.ID,
+		"chunk_size":  session.ChunkSize,
+		"total_parts": session.TotalParts,
+		"expires_at":  session.CreatedAt.Add(h.uploadSessionTTL()),
+	})
+}
+
+// UploadPart uploads one part of a resumable upload
+// PUT /api/v1/files/uploads/:uploadId/parts/:partNumber
+func (h *APIHandler) UploadPart(c *gin.Context) {
+	session, _, ok := h.loadUploadSession(c)
+	if !ok {
+		return
+	}
+
+	partNumber, err := strconv.Atoi(c.Param("partNumber"))
+	if err != nil || partNumber < 1 || partNumber > session.TotalParts {
+		response.BadRequest(c, fmt.Sprintf("part number must be between 1 and %d", session.TotalParts))
+		return
+	}
+
+	checksum := strings.ToLower(c.GetHeader(PartChecksumHeader))
+	if checksum == "" {
+		response.BadRequest(c, PartChecksumHeader+" header is required")
+		return
+	}
+
+	expectedSize := session.partSize(partNumber)
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, expectedSize+1))
+	if err != nil {
+		response.BadRequest(c, "failed to read part body")
+		return
+	}
+	if int64(len(body)) != expectedSize {
+		response.BadRequest(c, fmt.Sprintf("part %d must be exactly %d bytes, got %d", partNumber, expectedSize, len(body)))
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	if hex.EncodeToString(sum[:]) != checksum {
+		response.BadRequest(c, fmt.Sprintf("checksum mismatch for part %d", partNumber))
+		return
+	}
+
+	etag, err := h.MimIo.UploadPart(c.Request.Context(), session.ObjectPath, session.MinioUploadID, partNumber, bytes.NewReader(body), expectedSize, checksum)
+	if err != nil {
+		response.InternalError(c, err.Error())
+		return
+	}
+
+	part := uploadedPart{
+		PartNumber: partNumber,
+		ETag:       etag,
+		Size:       expectedSize,
+		Checksum:   checksum,
+	}
+	data, _ := json.Marshal(part)
+	if err := h.Redis.HSet(c.Request.Context(), uploadPartsPrefix+session.ID, strconv.Itoa(partNumber), data); err != nil {
+		response.InternalError(c, fmt.Sprintf("failed to record part: %v", err))
+		return
+	}
+	_ = h.saveUploadSession(c, session)
+
+	response.Success(c, gin.H{
+		"upload_id":   session.ID,
+		"part_number": partNumber,
+		"etag":        etag,
+		"size":        expectedSize,
+	})
+}
+
+// GetUploadStatus returns uploaded and missing parts so the client can resume
+// GET /api/v1/files/uploads/:uploadId
+func (h *APIHandler) GetUploadStatus(c *gin.Context) {
+	session, _, ok := h.loadUploadSession(c)
+	if !ok {
+		return
+	}
+
+	parts, err := h.loadUploadedParts(c, session.ID)
+	if err != nil {
+		response.InternalError(c, fmt.Sprintf("failed to load parts: %v", err))
+		return
+	}
+
+	uploaded := make([]uploadedPart, 0, len(parts))
+	missing := []int{}
+	var uploadedBytes int64
+	for i := 1; i <= session.TotalParts; i++ {
+		if part, ok := parts[i]; ok {
+			uploaded = append(uploaded, part)
+			uploadedBytes += part.Size
+		} else {
+			missing = append(missing, i)
+		}
+	}
+
+	response.Success(c, gin.H{
+		"upload_id":      session.ID,
+		"filename":       session.Filename,
+		"size":           session.Size,
+		"chunk_size":     session.ChunkSize,
+		"total_parts":    session.TotalParts,
+		"uploaded_bytes": uploadedBytes,
+		"uploaded_parts": uploaded,
+		"missing_parts":  missing,
+	})
+}
+
+// CompleteUpload assembles the parts and stores the File metadata
+// POST /api/v1/files/uploads/:uploadId/complete
+func (h *APIHandler) CompleteUpload(c *gin.Context) {
+	session, userInfo, ok := h.loadUploadSession(c)
+	if !ok {
+		return
+	}
+
+	parts, err := h.loadUploadedParts(c, session.ID)
+	if err != nil {
+		response.InternalError(c, fmt.Sprintf("failed to load parts: %v", err))
+		return
+	}
+	if len(parts) != session.TotalParts {
+		response.BadRequest(c, fmt.Sprintf("upload incomplete: %d of %d parts received", len(parts), session.TotalParts))
+		return
+	}
+
+	if !h.checkSubmissionWindow(c, session.Type, session.Semester, session.UserID, &session.Meta) {
+		return
+	}
+
+	completeParts := make([]minio.CompletePart, 0, len(parts))
+	for _, part := range parts {
+		completeParts = append(completeParts, minio.CompletePart{PartNumber: part.PartNumber, ETag: part.ETag})
+	}
+	sort.Slice(completeParts, func(i, j int) bool {
+		return completeParts[i].PartNumber < completeParts[j].PartNumber
+	})
+
+	fileURL, err := h.MimIo.CompleteMultipartUpload(c.Request.Context(), session.ObjectPath, session.MinioUploadID, completeParts)
+	if err != nil {
+		response.InternalError(c, err.Error())
+		return
+	}
+
+	// The assembled object is screened in place; rejected content is removed
+	// and suspicious content moved under the quarantine prefix
+	object, _, err := h.MimIo.GetFileBlob(c.Request.Context(), session.ObjectPath)
+	if err != nil {
+		response.InternalError(c, err.Error())
+		return
+	}
+	checksum, err := objectChecksum(object, session.Size)
+	if err != nil {
+		object.Close()
+		response.InternalError(c, fmt.Sprintf("failed to hash uploaded object: %v", err))
+		return
+	}
+	verdict, err := h.screenUpload(c.Request.Context(), object, session.Size, session.Filename)
+	object.Close()
+	if err != nil {
+		_ = h.MimIo.DeleteFile(c.Request.Context(), session.ObjectPath)
+		h.deleteUploadSession(c, session)
+		response.BadRequest(c, err.Error())
+		return
+	}
+	if verdict.Suspicious {
+		quarantined := h.quarantinePath(session.ObjectPath)
+		fileURL, err = h.MimIo.MoveObject(c.Request.Context(), session.ObjectPath, quarantined)
+		if err != nil {
+			response.InternalError(c, err.Error())
+			return
+		}
+		session.ObjectPath = quarantined
+	}
+
+	created, err := h.saveFileMetadata(c, session.Type, userInfo, storedObject{
+		Path:        session.ObjectPath,
+		URL:         fileURL,
+		Filename:    session.Filename,
+		Size:        session.Size,
+		Checksum:    checksum,
+		ContentType: session.ContentType,
+		Quarantined: verdict.Suspicious,
+		ScanResult:  verdict.Reason,
+	}, session.Meta)
+	if err != nil {
+		response.InternalError(c, fmt.Sprintf("failed to save file metadata: %v", err))
+		return
+	}
+
+	h.deleteUploadSession(c, session)
+
+	// Fingerprint final submissions for the similarity report
+	h.indexSubmission(created, session.ObjectPath)
+
+	response.SuccessWithMessage(c, "File uploaded successfully", gin.H{
+		"file_id":       created.Id,
+		"version":       created.Version,
+		"checksum":      created.Checksum,
+		"late":          created.Late,
+		"quarantined":   created.Quarantined,
+		"scan_result":   created.ScanResult,
+		"filename":      session.Filename,
+		"size":          session.Size,
+		"url":           fileURL,
+		"object_path":   session.ObjectPath,
+		"uploaded_by":   userInfo.UserID,
+		"uploaded_role": userInfo.Role,
+	})
+}
+
+// AbortUpload cancels a resumable upload and discards its parts
+// DELETE /api/v1/files/uploads/:uploadId
+func (h *APIHandler) AbortUpload(c *gin.Context) {
+	session, _, ok := h.loadUploadSession(c)
+	if !ok {
+		return
+	}
+
+	if err := h.MimIo.AbortMultipartUpload(c.Request.Context(), session.ObjectPath, session.MinioUploadID); err != nil {
+		response.InternalError(c, err.Error())
+		return
+	}
+
+	h.deleteUploadSession(c, session)
+
+	response.SuccessWithMessage(c, "Upload aborted", gin.H{
+		"upload_id": session.ID,
+	})
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// RunUploadSweep aborts, every sweep interval until ctx is done, the MinIO
+// multipart uploads whose Redis session expired. A client that stops
+// uploading leaves its parts in the bucket; the session TTL only drops the
+// Redis side.
+func (h *APIHandler) RunUploadSweep(ctx context.Context) {
+	if h.MimIo == nil || h.Redis == nil || h.Config == nil || h.Config.Upload.SweepInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(time.Duration(h.Config.Upload.SweepInterval) * time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if aborted := h.sweepIncompleteUploads(ctx); aborted > 0 {
+			log.Printf("Upload sweep: aborted %d multipart upload(s) without a session", aborted)
+		}
+	}
+}
+
+// sweepIncompleteUploads runs one sweep and returns the uploads aborted. An
+// upload is only aborted once it is older than the session TTL, so one just
+// initiated is not taken for orphaned before its session is stored.
+func (h *APIHandler) sweepIncompleteUploads(ctx context.Context) int {
+	cutoff := time.Now().Add(-h.uploadSessionTTL())
+
+	aborted := 0
+	for upload := range h.MimIo.ListIncompleteUploads(ctx) {
+		if upload.Err != nil {
+			log.Printf("Upload sweep: failed to list incomplete uploads: %v", upload.Err)
+			return aborted
+		}
+		if upload.Initiated.After(cutoff) {
+			continue
+		}
+
+		live, err := h.Redis.Exists(ctx, uploadMinioPrefix+upload.UploadID)
+		if err != nil {
+			log.Printf("Upload sweep: failed to check session of %s: %v", upload.Key, err)
+			return aborted
+		}
+		if live > 0 {
+			continue
+		}
+
+		if err := h.MimIo.AbortMultipartUpload(ctx, upload.Key, upload.UploadID); err != nil {
+			log.Printf("Upload sweep: keeping upload of %s: %v", upload.Key, err)
+			continue
+		}
+		aborted++
+	}
+	return aborted
+}
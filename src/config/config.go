@@ -14,6 +14,7 @@ type Config struct {
 	Redis    RedisConfig
 	MongoDB  MongoConfig
 	JWT      JWTConfig
+	Upload   UploadConfig
 }
 
 type ServerConfig struct {
@@ -81,6 +82,18 @@ type JWTConfig struct {
 	RefreshTokenExpiry int // days
 }
 
+type UploadConfig struct {
+	ChunkSize     int64            // bytes per part for resumable uploads (MinIO requires >= 5MB except the last part)
+	SessionTTL    int              // hours a resumable upload session stays valid
+	SweepInterval int              // minutes between sweeps aborting multipart uploads of expired sessions (0 disables)
+	MaxSizeByType map[string]int64 // bytes, keyed by upload type (template, list_student, list_teacher, final)
+
+	Scanner          string // malware scanner: none | clamav
+	ClamAVAddress    string // clamd socket: tcp://host:3310 or unix:///path/to/clamd.sock
+	ScanTimeout      int    // seconds
+	QuarantinePrefix string // MinIO prefix for files held until cleared
+}
+
 func Load() (*Config, error) {
 	// Try multiple paths for .server.env
 	envPaths := []string{
@@ -174,6 +187,21 @@ func Load() (*Config, error) {
 			AccessTokenExpiry:  getEnvAsInt("JWT_ACCESS_EXPIRY", 15), // 15 minutes
 			RefreshTokenExpiry: getEnvAsInt("JWT_REFRESH_EXPIRY", 7), // 7 days
 		},
+		Upload: UploadConfig{
+			ChunkSize:     int64(getEnvAsInt("UPLOAD_CHUNK_SIZE_MB", 8)) << 20,
+			SessionTTL:    getEnvAsInt("UPLOAD_SESSION_TTL", 24),    // 24 hours
+			SweepInterval: getEnvAsInt("UPLOAD_SWEEP_INTERVAL", 60), // 60 minutes
+			MaxSizeByType: map[string]int64{
+				"template":     int64(getEnvAsInt("UPLOAD_MAX_SIZE_TEMPLATE_MB", 20)) << 20,
+				"list_student": int64(getEnvAsInt("UPLOAD_MAX_SIZE_LIST_STUDENT_MB", 20)) << 20,
+				"list_teacher": int64(getEnvAsInt("UPLOAD_MAX_SIZE_LIST_TEACHER_MB", 20)) << 20,
+				"final":        int64(getEnvAsInt("UPLOAD_MAX_SIZE_FINAL_MB", 1024)) << 20,
+			},
+			Scanner:          getEnv("UPLOAD_SCANNER", "none"),
+			ClamAVAddress:    getEnv("CLAMAV_ADDRESS", "tcp://localhost:3310"),
+			ScanTimeout:      getEnvAsInt("UPLOAD_SCAN_TIMEOUT", 60), // 60 seconds
+			QuarantinePrefix: getEnv("UPLOAD_QUARANTINE_PREFIX", "quarantine/"),
+		},
 	}
 
 	return cfg, nil
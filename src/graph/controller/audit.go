@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"thaily/src/graph/convert"
+	"thaily/src/graph/model"
+	"thaily/src/pkg/audit"
+)
+
+// AuditLog reads the services' audit log, newest first. Grade disputes are
+// settled from it, so it is for academic affairs only.
+func (c *Controller) AuditLog(ctx context.Context, entity, entityID, actor *string, from, to *time.Time) ([]*model.AuditLogEntry, error) {
+	if _, err := c.requireAcademicAffairs(ctx); err != nil {
+		return nil, err
+	}
+
+	filter := audit.Filter{From: from, To: to}
+	if entity != nil {
+		filter.Entity = *entity
+	}
+	if entityID != nil {
+		filter.EntityID = *entityID
+	}
+	if actor != nil {
+		filter.Actor = *actor
+	}
+
+	entries, err := c.audit.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return convert.AuditEntriesToModel(entries), nil
+}
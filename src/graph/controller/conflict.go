@@ -0,0 +1,89 @@
+package controller
+
+import (
+	pbAcademic "thaily/proto/academic"
+	pbCouncil "thaily/proto/council"
+	pbFile "thaily/proto/file"
+	pbRole "thaily/proto/role"
+	pbThesis "thaily/proto/thesis"
+	pbUser "thaily/proto/user"
+	"thaily/src/graph/convert"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Optimistic concurrency. Every mutable record carries a version that the
+// services bump on each update; an update sent with the version the client
+// read fails with ABORTED once someone else changed the record, and the
+// status carries the record as it is now.
+
+// conflictError turns an ABORTED update into a CONFLICT error whose
+// extensions hold the current record, so the client can merge and retry.
+// Any other error is returned unchanged.
+func conflictError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Aborted {
+		return err
+	}
+
+	extensions := map[string]interface{}{"code": "CONFLICT"}
+	for _, detail := range st.Details() {
+		if current := currentRecord(detail); current != nil {
+			extensions["current"] = current
+			break
+		}
+	}
+	return &gqlerror.Error{
+		Message:    st.Message(),
+		Extensions: extensions,
+	}
+}
+
+// currentRecord converts the record of a conflict to its GraphQL model
+func currentRecord(detail interface{}) interface{} {
+	switch pb := detail.(type) {
+	case *pbAcademic.Faculty:
+		return convert.PbFacultyToModel(pb)
+	case *pbAcademic.Major:
+		return convert.PbMajorToModel(pb)
+	case *pbAcademic.Semester:
+		return convert.PbSemesterToModel(pb)
+	case *pbCouncil.Council:
+		return convert.PbCouncilToModel(pb)
+	case *pbCouncil.Defence:
+		return convert.PbDefenceToModel(pb)
+	case *pbCouncil.GradeDefence:
+		return convert.PbGradeDefenceToModel(pb)
+	case *pbCouncil.GradeDefenceCriterion:
+		return convert.PbGradeDefenceCriterionToModel(pb)
+	case *pbCouncil.RubricTemplate:
+		return convert.PbRubricTemplateToModel(pb)
+	case *pbFile.File:
+		return convert.PbFileToModel(pb)
+	case *pbRole.RoleSystem:
+		return convert.PbRoleSystemToModel(pb)
+	case *pbThesis.Enrollment:
+		return convert.PbEnrollmentToModel(pb)
+	case *pbThesis.Final:
+		return convert.PbFinalToModel(pb)
+	case *pbThesis.GradeReview:
+		return convert.PbGradeReviewToModel(pb)
+	case *pbThesis.Midterm:
+		return convert.PbMidtermToModel(pb)
+	case *pbThesis.MidtermMilestone:
+		return convert.PbMidtermMilestoneToModel(pb)
+	case *pbThesis.Topic:
+		return convert.PbTopicToModel(pb)
+	case *pbThesis.TopicCouncil:
+		return convert.PbTopicCouncilToModel(pb)
+	case *pbThesis.TopicCouncilSupervisor:
+		return convert.PbTopicCouncilSupervisorToModel(pb)
+	case *pbUser.Student:
+		return convert.PbStudentToModel(pb)
+	case *pbUser.Teacher:
+		return convert.PbTeacherToModel(pb)
+	}
+	return nil
+}
@@ -1,9 +1,17 @@
 package controller
 
 import (
+	"context"
+	"fmt"
+	"strings"
 	pb "thaily/proto/common"
+	pbRole "thaily/proto/role"
+	"thaily/src/graph/helper"
 	"thaily/src/graph/model"
+	"thaily/src/pkg/audit"
 	"thaily/src/server/client"
+
+	"github.com/golang-jwt/jwt/v5"
 )
 
 type Controller struct {
@@ -13,10 +21,11 @@ type Controller struct {
 	role     *client.GRPCRole
 	thesis   *client.GRPCthesis
 	user     *client.GRPCUser
+	audit    *audit.Store
 }
 
 // Constructor function
-func NewController(academic *client.GRPCAcadamicClient, council *client.GRPCCouncil, file *client.GRPCfile, role *client.GRPCRole, thesis *client.GRPCthesis, user *client.GRPCUser) *Controller {
+func NewController(academic *client.GRPCAcadamicClient, council *client.GRPCCouncil, file *client.GRPCfile, role *client.GRPCRole, thesis *client.GRPCthesis, user *client.GRPCUser, audit *audit.Store) *Controller {
 	return &Controller{
 		academic: academic,
 		council:  council,
@@ -24,7 +33,78 @@ func NewController(academic *client.GRPCAcadamicClient, council *client.GRPCCoun
 		role:     role,
 		thesis:   thesis,
 		user:     user,
+		audit:    audit,
+	}
+}
+
+// currentUser returns the role, semester and user id of the caller. Without a
+// semester in the context the first "semester-id" pair of the token is used.
+func (c *Controller) currentUser(ctx context.Context) (role, semester, myId string, err error) {
+	claims, ok := ctx.Value(helper.Auth).(jwt.MapClaims)
+	if !ok {
+		return "", "", "", fmt.Errorf("not authorized")
+	}
+	role, ok = claims["role"].(string)
+	if !ok {
+		return "", "", "", fmt.Errorf("not authorized")
+	}
+	ids, _ := claims["ids"].(string)
+	semester, _ = ctx.Value("semester").(string)
+
+	for _, id := range strings.Split(ids, ",") {
+		parts := strings.Split(id, "-")
+		if len(parts) != 2 {
+			continue
+		}
+		if semester == "" || parts[0] == semester {
+			semester, myId = parts[0], parts[1]
+			break
+		}
 	}
+	if myId == "" {
+		return "", "", "", fmt.Errorf("no user found for semester %s", semester)
+	}
+	return role, semester, myId, nil
+}
+
+// requireTeacherRole allows only teachers holding one of the given system roles
+// and returns the caller's teacher id
+func (c *Controller) requireTeacherRole(ctx context.Context, roles ...pbRole.RoleType) (string, error) {
+	role, _, myId, err := c.currentUser(ctx)
+	if err != nil {
+		return "", err
+	}
+	if role != "teacher" {
+		return "", fmt.Errorf("role %s not allowed", role)
+	}
+	ok, err := c.hasRole(ctx, myId, roles...)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		names := make([]string, len(roles))
+		for i, r := range roles {
+			names[i] = r.String()
+		}
+		return "", fmt.Errorf("%s role required", strings.Join(names, " or "))
+	}
+	return myId, nil
+}
+
+// hasRole reports whether the teacher holds one of the given system roles
+func (c *Controller) hasRole(ctx context.Context, teacherId string, roles ...pbRole.RoleType) (bool, error) {
+	permissions, err := c.role.GetAllRoleByTeacherId(ctx, teacherId)
+	if err != nil {
+		return false, err
+	}
+	for _, permission := range permissions.GetRoleSystems() {
+		for _, role := range roles {
+			if permission.Role == role {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
 }
 
 // ConvertSearchRequestToPB converts GraphQL SearchRequestInput to Protobuf SearchRequest
@@ -77,6 +157,17 @@ func convertPaginationToPB(input *model.PaginationInput) *pb.Pagination {
 		pagination.Descending = *input.Descending
 	}
 
+	for _, sort := range input.Sort {
+		if sort == nil {
+			continue
+		}
+		spec := &pb.SortSpec{Field: sort.Field}
+		if sort.Direction != nil && *sort.Direction == model.SortDirectionDesc {
+			spec.Direction = pb.SortDirection_DESC
+		}
+		pagination.Sort = append(pagination.Sort, spec)
+	}
+
 	return pagination
 }
 
@@ -165,6 +256,8 @@ func convertFilterOperatorToPB(op model.FilterOperator) pb.FilterOperator {
 		return pb.FilterOperator_IS_NOT_NULL
 	case model.FilterOperatorBetween:
 		return pb.FilterOperator_BETWEEN
+	case model.FilterOperatorSearch:
+		return pb.FilterOperator_SEARCH
 	default:
 		return pb.FilterOperator_EQUAL
 	}
@@ -0,0 +1,146 @@
+package controller
+
+import (
+	"context"
+
+	pbCouncil "thaily/proto/council"
+	pbRole "thaily/proto/role"
+	pb "thaily/proto/thesis"
+	"thaily/src/graph/convert"
+	"thaily/src/graph/model"
+)
+
+// Conflict-of-interest checks on councils. The council service owns Council
+// and Defence and runs the checks, reading who supervises and reviews each
+// topic council from the thesis service; the gateway adds what the user
+// service knows, the major of every member.
+
+// ValidateCouncil reports the conflicts of interest of a council
+func (c *Controller) ValidateCouncil(ctx context.Context, councilId string) (*model.CouncilValidation, error) {
+	myId, err := c.requireTeacherRole(ctx, pbRole.RoleType_DEPARTMENT_LECTURER)
+	if err != nil {
+		return nil, err
+	}
+	return c.validateCouncil(ctx, councilId, nil, myId)
+}
+
+// OverrideCouncilConflicts records an override, with its reason, of every open conflict of a council
+func (c *Controller) OverrideCouncilConflicts(ctx context.Context, councilId string, reason string) (*model.CouncilValidation, error) {
+	myId, err := c.requireTeacherRole(ctx, pbRole.RoleType_DEPARTMENT_LECTURER)
+	if err != nil {
+		return nil, err
+	}
+	return c.validateCouncil(ctx, councilId, &reason, myId)
+}
+
+func (c *Controller) GetCouncilConflictOverrides(ctx context.Context, councilId string) ([]*model.CouncilConflictOverride, error) {
+	if _, err := c.requireTeacherRole(ctx, pbRole.RoleType_DEPARTMENT_LECTURER); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.council.ListCouncilConflictOverrides(ctx, councilId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbCouncilConflictOverridesToModel(resp.GetOverrides()), nil
+}
+
+// AddDefenceToCouncil adds a member to a council; the council service refuses
+// members with a conflict of interest unless an override reason is given
+func (c *Controller) AddDefenceToCouncil(ctx context.Context, input model.CreateDefenceInput) (*model.Defence, error) {
+	myId, err := c.requireTeacherRole(ctx, pbRole.RoleType_DEPARTMENT_LECTURER)
+	if err != nil {
+		return nil, err
+	}
+
+	validation, err := c.councilValidationContext(ctx, input.CouncilCode, input.TeacherCode)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.council.CreateDefence(ctx, &pbCouncil.CreateDefenceRequest{
+		Title:          input.Title,
+		CouncilCode:    input.CouncilCode,
+		TeacherCode:    input.TeacherCode,
+		Position:       convert.ModelDefencePositionToPb(input.Position),
+		CreatedBy:      myId,
+		Validation:     validation,
+		OverrideReason: input.OverrideReason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbDefenceToModel(resp.GetDefence()), nil
+}
+
+// AssignTopicToCouncil moves a topic council to a council. The thesis service
+// has the council service check the conflicts the topic council brings, the
+// rest of the council aside, and record the overrides only with the move.
+func (c *Controller) AssignTopicToCouncil(ctx context.Context, topicCouncilId string, councilId string, overrideReason *string) (*model.TopicCouncil, error) {
+	myId, err := c.requireTeacherRole(ctx, pbRole.RoleType_DEPARTMENT_LECTURER)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.thesis.UpdateTopicCouncil(ctx, &pb.UpdateTopicCouncilRequest{
+		Id:             topicCouncilId,
+		CouncilCode:    &councilId,
+		UpdatedBy:      myId,
+		OverrideReason: overrideReason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbTopicCouncilToModel(resp.GetTopicCouncil()), nil
+}
+
+func (c *Controller) validateCouncil(ctx context.Context, councilId string, overrideReason *string, actor string) (*model.CouncilValidation, error) {
+	validation, err := c.councilValidationContext(ctx, councilId, "")
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.council.ValidateCouncil(ctx, &pbCouncil.ValidateCouncilRequest{
+		CouncilCode:    councilId,
+		Validation:     validation,
+		OverrideReason: overrideReason,
+		Actor:          actor,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &model.CouncilValidation{
+		CouncilCode: councilId,
+		Valid:       resp.GetValid(),
+		Conflicts:   convert.PbCouncilConflictsToModel(resp.GetConflicts()),
+	}, nil
+}
+
+// councilValidationContext gathers the majors of a council's members (plus
+// extraTeacher, about to join), which the user service knows. The council
+// service reads the supervisors and reviewers of the topic councils from the
+// thesis service.
+func (c *Controller) councilValidationContext(ctx context.Context, councilId, extraTeacher string) (*pbCouncil.CouncilValidationContext, error) {
+	defences, err := c.council.GetDefencesByCouncilCode(ctx, councilId)
+	if err != nil {
+		return nil, err
+	}
+	teacherIds := []string{}
+	for _, defence := range defences.GetDefences() {
+		teacherIds = append(teacherIds, defence.TeacherCode)
+	}
+	if extraTeacher != "" {
+		teacherIds = append(teacherIds, extraTeacher)
+	}
+
+	validation := &pbCouncil.CouncilValidationContext{}
+	teachers, err := c.user.GetTeachersByIds(ctx, teacherIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, teacher := range teachers.GetTeachers() {
+		validation.MemberMajors = append(validation.MemberMajors, &pbCouncil.CouncilMemberMajor{
+			TeacherCode: teacher.Id,
+			MajorCode:   teacher.MajorCode,
+		})
+	}
+	return validation, nil
+}
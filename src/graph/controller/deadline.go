@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	pbCommon "thaily/proto/common"
+	pbFile "thaily/proto/file"
+	pbRole "thaily/proto/role"
+	pb "thaily/proto/thesis"
+	"thaily/src/graph/convert"
+	"thaily/src/graph/model"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// requireAcademicAffairs allows only teachers holding the academic affairs staff role
+func (c *Controller) requireAcademicAffairs(ctx context.Context) (string, error) {
+	return c.requireTeacherRole(ctx, pbRole.RoleType_ACADEMIC_AFFAIRS_STAFF)
+}
+
+func (c *Controller) GetSubmissionDeadlines(ctx context.Context, semesterCode string) ([]*model.SubmissionDeadline, error) {
+	if _, err := c.requireAcademicAffairs(ctx); err != nil {
+		return nil, err
+	}
+	resp, err := c.thesis.ListSubmissionDeadlines(ctx, semesterCode)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbSubmissionDeadlinesToModel(resp.GetDeadlines()), nil
+}
+
+func (c *Controller) SetSubmissionDeadline(ctx context.Context, input model.SetSubmissionDeadlineInput) (*model.SubmissionDeadline, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var grace int32
+	if input.GraceMinutes != nil {
+		grace = *input.GraceMinutes
+	}
+	resp, err := c.thesis.SetSubmissionDeadline(ctx, &pb.SetSubmissionDeadlineRequest{
+		SemesterCode: input.SemesterCode,
+		Stage:        convert.ModelTopicStageToPb(input.Stage),
+		Kind:         convert.ModelSubmissionKindToPb(input.Kind),
+		OpensAt:      timestamppb.New(input.OpensAt),
+		DueAt:        timestamppb.New(input.DueAt),
+		GraceMinutes: grace,
+		CreatedBy:    myId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbSubmissionDeadlineToModel(resp.GetDeadline()), nil
+}
+
+// GrantDeadlineExtension lets a supervisor extend the due date of one student;
+// the thesis service verifies the supervision through Topic_council_supervisor
+func (c *Controller) GrantDeadlineExtension(ctx context.Context, input model.GrantDeadlineExtensionInput) (*model.DeadlineExtension, error) {
+	role, semester, myId, err := c.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if role != "teacher" {
+		return nil, fmt.Errorf("role %s not allowed", role)
+	}
+	req := &pb.GrantDeadlineExtensionRequest{
+		SemesterCode: semester,
+		Kind:         convert.ModelSubmissionKindToPb(input.Kind),
+		StudentCode:  input.StudentCode,
+		DueAt:        timestamppb.New(input.DueAt),
+		GrantedBy:    myId,
+	}
+	if input.Reason != nil {
+		req.Reason = *input.Reason
+	}
+	resp, err := c.thesis.GrantDeadlineExtension(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbDeadlineExtensionToModel(resp.GetExtension()), nil
+}
+
+func (c *Controller) GetMySubmissionWindow(ctx context.Context, kind model.SubmissionKind) (*model.SubmissionWindow, error) {
+	role, semester, myId, err := c.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if role != "student" {
+		return nil, fmt.Errorf("role %s not allowed", role)
+	}
+	resp, err := c.thesis.CheckSubmissionWindow(ctx, semester, myId, convert.ModelSubmissionKindToPb(kind))
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbSubmissionWindowToModel(resp), nil
+}
+
+// UploadSubmissionFile attaches a document the student uploaded through the
+// upload endpoints as their midterm/final submission. Those endpoints screen
+// the content and record its size and checksum, so only a File they created
+// on the student's own Midterm or Final is accepted; the window is checked
+// again and the title set from the input.
+func (c *Controller) UploadSubmissionFile(ctx context.Context, input model.UploadFileInput, kind model.SubmissionKind) (*model.File, error) {
+	role, semester, myId, err := c.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if role != "student" {
+		return nil, fmt.Errorf("role %s not allowed", role)
+	}
+
+	window, err := c.thesis.CheckSubmissionWindow(ctx, semester, myId, convert.ModelSubmissionKindToPb(kind))
+	if err != nil {
+		return nil, err
+	}
+	if !window.Allowed {
+		return nil, fmt.Errorf("%s", window.Reason)
+	}
+	if window.TableId == "" {
+		return nil, fmt.Errorf("your enrollment has no record to attach this submission to")
+	}
+	if input.TableID != window.TableId {
+		return nil, fmt.Errorf("tableId is not your own submission")
+	}
+
+	table := pbFile.TableType_MIDTERM
+	if kind == model.SubmissionKindFinal {
+		table = pbFile.TableType_FINAL
+	}
+
+	resp, err := c.file.GetFileBySearch(ctx, &pbCommon.SearchRequest{
+		Pagination: &pbCommon.Pagination{Page: 1, PageSize: 1, SortBy: "created_at", Descending: true},
+		Filters: []*pbCommon.FilterCriteria{
+			{Criteria: &pbCommon.FilterCriteria_Condition{Condition: &pbCommon.FilterCondition{
+				Field: "file", Operator: pbCommon.FilterOperator_EQUAL, Values: []string{input.File},
+			}}},
+			{Criteria: &pbCommon.FilterCriteria_Condition{Condition: &pbCommon.FilterCondition{
+				Field: "table_id", Operator: pbCommon.FilterOperator_EQUAL, Values: []string{window.TableId},
+			}}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	files := resp.GetFiles()
+	if len(files) == 0 || files[0].GetCreatedBy() != myId || files[0].GetTable() != table {
+		return nil, fmt.Errorf("file was not uploaded by you for this submission; upload it through /api/v1/files first")
+	}
+	file := files[0]
+	if input.Option != nil && *input.Option != "" && *input.Option != file.GetOption() {
+		return nil, fmt.Errorf("option is set by the upload and cannot be changed")
+	}
+
+	if input.Title != "" && input.Title != file.GetTitle() {
+		updated, err := c.file.UpdateFile(ctx, &pbFile.UpdateFileRequest{
+			Id:        file.GetId(),
+			Title:     &input.Title,
+			UpdatedBy: myId,
+			Revision:  &file.Revision,
+		})
+		if err != nil {
+			return nil, err
+		}
+		file = updated.GetFile()
+	}
+	return convert.PbFileToModel(file), nil
+}
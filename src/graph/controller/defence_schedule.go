@@ -0,0 +1,309 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pbCommon "thaily/proto/common"
+	pbCouncil "thaily/proto/council"
+	pb "thaily/proto/thesis"
+	"thaily/src/graph/model"
+	"thaily/src/pkg/schedule"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defenceSchedulePageSize is the page size the members, topic councils and
+// supervisors of a semester are read in
+const defenceSchedulePageSize = 500
+
+// PreviewDefenceSchedule generates the semester's defence timetable without saving it
+func (c *Controller) PreviewDefenceSchedule(ctx context.Context, input model.DefenceScheduleInput) (*model.DefenceSchedule, error) {
+	if _, err := c.requireAcademicAffairs(ctx); err != nil {
+		return nil, err
+	}
+
+	result, _, err := c.generateDefenceSchedule(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return defenceScheduleToModel(input.SemesterCode, result, false)
+}
+
+// CommitDefenceSchedule generates the timetable and writes it back: room and
+// time_start of every council, council_code and time_start/time_end of every
+// topic council. Nothing is written unless everything could be scheduled and
+// every topic council moved to another council passes its conflict checks,
+// which the thesis service has run as it writes the topic councils.
+// Each service writes its part in one transaction, against the versions the
+// timetable was generated from; should the topic councils fail, the councils
+// are put back as they were.
+func (c *Controller) CommitDefenceSchedule(ctx context.Context, input model.DefenceScheduleInput) (*model.DefenceSchedule, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, loaded, err := c.generateDefenceSchedule(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if !result.Complete() {
+		return nil, fmt.Errorf("schedule is incomplete: %d topic councils unassigned, %d councils unplaced; preview it to see why",
+			len(result.Unassigned), len(result.Unplaced))
+	}
+
+	councilSlots := []*pbCouncil.CouncilSlot{}
+	previousSlots := []*pbCouncil.CouncilSlot{}
+	topicCouncilSlots := []*pb.TopicCouncilSlot{}
+	for _, sitting := range result.Sittings {
+		council := loaded.councils[sitting.CouncilCode]
+		councilSlots = append(councilSlots, &pbCouncil.CouncilSlot{
+			Id:        sitting.CouncilCode,
+			Room:      sitting.Room,
+			TimeStart: timestamppb.New(sitting.Start),
+			Version:   &council.Version,
+		})
+		previousSlots = append(previousSlots, &pbCouncil.CouncilSlot{
+			Id:        council.Id,
+			Room:      council.Room,
+			TimeStart: council.TimeStart,
+		})
+
+		for _, session := range sitting.Sessions {
+			tc := loaded.topicCouncils[session.TopicCouncilCode]
+			topicCouncilSlots = append(topicCouncilSlots, &pb.TopicCouncilSlot{
+				Id:          session.TopicCouncilCode,
+				CouncilCode: sitting.CouncilCode,
+				TimeStart:   timestamppb.New(session.Start),
+				TimeEnd:     timestamppb.New(session.End),
+				Version:     &tc.Version,
+			})
+		}
+	}
+
+	placed, err := c.council.ScheduleCouncils(ctx, &pbCouncil.ScheduleCouncilsRequest{
+		Councils:  councilSlots,
+		UpdatedBy: myId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to place councils: %w", err)
+	}
+	_, err = c.thesis.ScheduleTopicCouncils(ctx, &pb.ScheduleTopicCouncilsRequest{
+		TopicCouncils: topicCouncilSlots,
+		UpdatedBy:     myId,
+	})
+	if err != nil {
+		// Put the councils back, unless they changed again since
+		for i, council := range placed.GetCouncils() {
+			previousSlots[i].Version = &council.Version
+		}
+		_, undoErr := c.council.ScheduleCouncils(ctx, &pbCouncil.ScheduleCouncilsRequest{
+			Councils:  previousSlots,
+			UpdatedBy: myId,
+		})
+		if undoErr != nil {
+			return nil, fmt.Errorf("failed to place topic councils: %w; the councils keep their new rooms and times: %v", err, undoErr)
+		}
+		return nil, fmt.Errorf("failed to place topic councils, nothing was changed: %w", err)
+	}
+
+	return defenceScheduleToModel(input.SemesterCode, result, true)
+}
+
+// defenceScheduleData is what a timetable was generated from, by id
+type defenceScheduleData struct {
+	councils      map[string]*pbCouncil.Council
+	topicCouncils map[string]*pb.TopicCouncil
+}
+
+// generateDefenceSchedule loads the semester's councils, their Defence
+// members, the topic councils assigned to them and their supervisors, every
+// page of them, and runs the scheduler
+func (c *Controller) generateDefenceSchedule(ctx context.Context, input model.DefenceScheduleInput) (*schedule.Schedule, *defenceScheduleData, error) {
+	if input.SlotMinutes <= 0 {
+		return nil, nil, fmt.Errorf("slotMinutes must be positive")
+	}
+
+	councils, err := c.semesterCouncils(ctx, input.SemesterCode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	in := schedule.Input{
+		Rooms:       input.Rooms,
+		SlotLength:  time.Duration(input.SlotMinutes) * time.Minute,
+		Unavailable: map[string][]schedule.Window{},
+	}
+	for _, day := range input.Days {
+		in.Days = append(in.Days, schedule.Window{Start: day.Start, End: day.End})
+	}
+	for _, u := range input.Unavailability {
+		in.Unavailable[u.TeacherCode] = append(in.Unavailable[u.TeacherCode], schedule.Window{Start: u.Start, End: u.End})
+	}
+
+	loaded := &defenceScheduleData{
+		councils:      map[string]*pbCouncil.Council{},
+		topicCouncils: map[string]*pb.TopicCouncil{},
+	}
+	if len(councils) == 0 {
+		result, err := schedule.Generate(in)
+		return result, loaded, err
+	}
+
+	councilCodes := make([]string, 0, len(councils))
+	for _, council := range councils {
+		councilCodes = append(councilCodes, council.Id)
+		loaded.councils[council.Id] = council
+	}
+
+	defences, err := c.councilDefences(ctx, councilCodes)
+	if err != nil {
+		return nil, nil, err
+	}
+	members := map[string][]string{}
+	for _, defence := range defences {
+		members[defence.CouncilCode] = append(members[defence.CouncilCode], defence.TeacherCode)
+	}
+	for _, council := range councils {
+		in.Councils = append(in.Councils, schedule.Council{
+			Code:      council.Id,
+			MajorCode: council.MajorCode,
+			Members:   members[council.Id],
+		})
+	}
+
+	topicCouncils, err := c.councilTopicCouncils(ctx, councilCodes)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(topicCouncils) == 0 {
+		result, err := schedule.Generate(in)
+		return result, loaded, err
+	}
+
+	topicCouncilCodes := make([]string, 0, len(topicCouncils))
+	for _, tc := range topicCouncils {
+		topicCouncilCodes = append(topicCouncilCodes, tc.Id)
+		loaded.topicCouncils[tc.Id] = tc
+	}
+	supervisors, err := c.topicCouncilSupervisors(ctx, topicCouncilCodes)
+	if err != nil {
+		return nil, nil, err
+	}
+	supervisorsOf := map[string][]string{}
+	for _, s := range supervisors {
+		supervisorsOf[s.TopicCouncilCode] = append(supervisorsOf[s.TopicCouncilCode], s.TeacherSupervisorCode)
+	}
+	for _, tc := range topicCouncils {
+		in.TopicCouncils = append(in.TopicCouncils, schedule.TopicCouncil{
+			Code:        tc.Id,
+			CouncilCode: tc.GetCouncilCode(),
+			Supervisors: supervisorsOf[tc.Id],
+		})
+	}
+
+	result, err := schedule.Generate(in)
+	return result, loaded, err
+}
+
+// schedulePage is one page of a search by a single field
+func schedulePage(page int32, field string, op pbCommon.FilterOperator, values []string) *pbCommon.SearchRequest {
+	return &pbCommon.SearchRequest{
+		Pagination: &pbCommon.Pagination{Page: page, PageSize: defenceSchedulePageSize, SortBy: "id"},
+		Filters: []*pbCommon.FilterCriteria{
+			{Criteria: &pbCommon.FilterCriteria_Condition{Condition: &pbCommon.FilterCondition{
+				Field: field, Operator: op, Values: values,
+			}}},
+		},
+	}
+}
+
+// councilDefences lists the members of the councils, every page of them
+func (c *Controller) councilDefences(ctx context.Context, councilCodes []string) ([]*pbCouncil.Defence, error) {
+	defences := []*pbCouncil.Defence{}
+	for page := int32(1); ; page++ {
+		resp, err := c.council.GetDefencesBySearch(ctx, schedulePage(page, "council_code", pbCommon.FilterOperator_IN, councilCodes))
+		if err != nil {
+			return nil, err
+		}
+		defences = append(defences, resp.GetDefences()...)
+		if len(resp.GetDefences()) == 0 || int32(len(defences)) >= resp.GetTotal() {
+			return defences, nil
+		}
+	}
+}
+
+// councilTopicCouncils lists the topic councils assigned to the councils,
+// every page of them
+func (c *Controller) councilTopicCouncils(ctx context.Context, councilCodes []string) ([]*pb.TopicCouncil, error) {
+	topicCouncils := []*pb.TopicCouncil{}
+	for page := int32(1); ; page++ {
+		resp, err := c.thesis.GetTopicCouncilBySearch(ctx, schedulePage(page, "council_code", pbCommon.FilterOperator_IN, councilCodes))
+		if err != nil {
+			return nil, err
+		}
+		topicCouncils = append(topicCouncils, resp.GetTopicCouncils()...)
+		if len(resp.GetTopicCouncils()) == 0 || int32(len(topicCouncils)) >= resp.GetTotal() {
+			return topicCouncils, nil
+		}
+	}
+}
+
+// topicCouncilSupervisors lists the supervisors of the topic councils, every
+// page of them
+func (c *Controller) topicCouncilSupervisors(ctx context.Context, topicCouncilCodes []string) ([]*pb.TopicCouncilSupervisor, error) {
+	supervisors := []*pb.TopicCouncilSupervisor{}
+	for page := int32(1); ; page++ {
+		resp, err := c.thesis.GetTopicCouncilSupervisorBySearch(ctx, schedulePage(page, "topic_council_code", pbCommon.FilterOperator_IN, topicCouncilCodes))
+		if err != nil {
+			return nil, err
+		}
+		supervisors = append(supervisors, resp.GetTopicCouncilSupervisors()...)
+		if len(resp.GetTopicCouncilSupervisors()) == 0 || int32(len(supervisors)) >= resp.GetTotal() {
+			return supervisors, nil
+		}
+	}
+}
+
+func defenceScheduleToModel(semesterCode string, s *schedule.Schedule, committed bool) (*model.DefenceSchedule, error) {
+	csv, err := s.CSV()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.DefenceSchedule{
+		SemesterCode: semesterCode,
+		Complete:     s.Complete(),
+		Committed:    committed,
+		Sittings:     make([]*model.DefenceSitting, 0, len(s.Sittings)),
+		Unassigned:   make([]*model.DefenceScheduleProblem, 0, len(s.Unassigned)),
+		Unplaced:     make([]*model.DefenceScheduleProblem, 0, len(s.Unplaced)),
+		CSV:          csv,
+	}
+	for _, sitting := range s.Sittings {
+		m := &model.DefenceSitting{
+			CouncilCode: sitting.CouncilCode,
+			Room:        sitting.Room,
+			Start:       sitting.Start,
+			End:         sitting.End,
+			Sessions:    make([]*model.DefenceSession, 0, len(sitting.Sessions)),
+		}
+		for _, session := range sitting.Sessions {
+			m.Sessions = append(m.Sessions, &model.DefenceSession{
+				TopicCouncilCode: session.TopicCouncilCode,
+				Start:            session.Start,
+				End:              session.End,
+			})
+		}
+		result.Sittings = append(result.Sittings, m)
+	}
+	for _, p := range s.Unassigned {
+		result.Unassigned = append(result.Unassigned, &model.DefenceScheduleProblem{Code: p.Code, Reason: p.Reason})
+	}
+	for _, p := range s.Unplaced {
+		result.Unplaced = append(result.Unplaced, &model.DefenceScheduleProblem{Code: p.Code, Reason: p.Reason})
+	}
+	return result, nil
+}
@@ -0,0 +1,33 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"thaily/src/graph/convert"
+	"thaily/src/graph/helper"
+	"thaily/src/graph/model"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// GetFileVersions returns every submitted version sharing the file's
+// (table, tableId, option), newest first
+func (c *Controller) GetFileVersions(ctx context.Context, file *model.File) ([]*model.File, error) {
+	if _, ok := ctx.Value(helper.Auth).(jwt.MapClaims); !ok {
+		return nil, fmt.Errorf("not authorized")
+	}
+	if file == nil {
+		return nil, nil
+	}
+
+	option := ""
+	if file.Option != nil {
+		option = *file.Option
+	}
+
+	resp, err := c.file.ListFileVersions(ctx, convert.ModelTableTypeToPb(file.Table), file.TableID, option)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbFilesToModel(resp.GetFiles()), nil
+}
@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"context"
+
+	pbCommon "thaily/proto/common"
+	pb "thaily/proto/thesis"
+	"thaily/src/graph/convert"
+	"thaily/src/graph/model"
+)
+
+// Final grades. The thesis service owns Final, Grade_review and the grading
+// policies and computes the grade; the council members' GradeDefence totals
+// live in the council service and are gathered here.
+
+// gradeDefencePageSize bounds the grade defences loaded for one enrollment
+const gradeDefencePageSize = 100
+
+func (c *Controller) GetGradingPolicies(ctx context.Context, semesterCode string) ([]*model.GradingPolicy, error) {
+	if _, err := c.requireAcademicAffairs(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.thesis.ListGradingPolicies(ctx, semesterCode)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbGradingPoliciesToModel(resp.GetPolicies()), nil
+}
+
+func (c *Controller) SetGradingPolicy(ctx context.Context, input model.SetGradingPolicyInput) (*model.GradingPolicy, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pb.SetGradingPolicyRequest{
+		MajorCode:        input.MajorCode,
+		SemesterCode:     input.SemesterCode,
+		Stage:            convert.ModelTopicStageToPb(input.Stage),
+		SupervisorWeight: input.SupervisorWeight,
+		ReviewerWeight:   input.ReviewerWeight,
+		CouncilWeight:    input.CouncilWeight,
+		RoundingStep:     0.1,
+		PassThreshold:    5,
+		CreatedBy:        myId,
+	}
+	if input.Rounding != nil {
+		req.Rounding = convert.ModelGradeRoundingToPb(*input.Rounding)
+	}
+	if input.RoundingStep != nil {
+		req.RoundingStep = *input.RoundingStep
+	}
+	if input.PassThreshold != nil {
+		req.PassThreshold = *input.PassThreshold
+	}
+	if input.MaxCouncilSpread != nil {
+		req.MaxCouncilSpread = *input.MaxCouncilSpread
+	}
+
+	resp, err := c.thesis.SetGradingPolicy(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbGradingPolicyToModel(resp.GetPolicy()), nil
+}
+
+func (c *Controller) DeleteGradingPolicy(ctx context.Context, id string) (bool, error) {
+	if _, err := c.requireAcademicAffairs(ctx); err != nil {
+		return false, err
+	}
+
+	resp, err := c.thesis.DeleteGradingPolicy(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return resp.GetSuccess(), nil
+}
+
+// PreviewFinalGrade explains an enrollment's final grade without saving it
+func (c *Controller) PreviewFinalGrade(ctx context.Context, enrollmentId string) (*model.FinalGradeBreakdown, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.computeFinalGrade(ctx, enrollmentId, false, myId)
+}
+
+// FinalizeFinalGrade writes the computed final grade and status to the
+// enrollment's Final; the thesis service refuses while inputs are missing
+func (c *Controller) FinalizeFinalGrade(ctx context.Context, enrollmentId string) (*model.FinalGradeBreakdown, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.computeFinalGrade(ctx, enrollmentId, true, myId)
+}
+
+func (c *Controller) computeFinalGrade(ctx context.Context, enrollmentId string, finalize bool, actor string) (*model.FinalGradeBreakdown, error) {
+	scores, err := c.councilMemberScores(ctx, enrollmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.thesis.ComputeFinalGrade(ctx, &pb.ComputeFinalGradeRequest{
+		EnrollmentCode: enrollmentId,
+		CouncilScores:  scores,
+		Finalize:       finalize,
+		Actor:          actor,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbComputeFinalGradeToModel(enrollmentId, resp), nil
+}
+
+// councilMemberScores returns, for every member of the council judging the
+// enrollment, the total_score of their GradeDefence (unset until graded)
+func (c *Controller) councilMemberScores(ctx context.Context, enrollmentId string) ([]*pb.CouncilMemberScore, error) {
+	enrollment, err := c.thesis.GetEnrollmentById(ctx, enrollmentId)
+	if err != nil {
+		return nil, err
+	}
+	topicCouncil, err := c.thesis.GetTopicCouncilById(ctx, enrollment.GetEnrollment().GetTopicCouncilCode())
+	if err != nil {
+		return nil, err
+	}
+	councilCode := topicCouncil.GetTopicCouncil().GetCouncilCode()
+	if councilCode == "" {
+		return nil, nil
+	}
+
+	defences, err := c.council.GetDefencesByCouncilCode(ctx, councilCode)
+	if err != nil {
+		return nil, err
+	}
+	gradeDefences, err := c.council.GetGradeDefenceBySearch(ctx, &pbCommon.SearchRequest{
+		Pagination: &pbCommon.Pagination{Page: 1, PageSize: gradeDefencePageSize, SortBy: "id"},
+		Filters: []*pbCommon.FilterCriteria{
+			{Criteria: &pbCommon.FilterCriteria_Condition{Condition: &pbCommon.FilterCondition{
+				Field: "enrollment_code", Operator: pbCommon.FilterOperator_EQUAL, Values: []string{enrollmentId},
+			}}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	totals := map[string]*float64{}
+	for _, gradeDefence := range gradeDefences.GetGradeDefences() {
+		totals[gradeDefence.DefenceCode] = gradeDefence.TotalScore
+	}
+
+	scores := make([]*pb.CouncilMemberScore, 0, len(defences.GetDefences()))
+	for _, defence := range defences.GetDefences() {
+		scores = append(scores, &pb.CouncilMemberScore{
+			TeacherCode: defence.TeacherCode,
+			TotalScore:  totals[defence.Id],
+		})
+	}
+	return scores, nil
+}
@@ -0,0 +1,240 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	pbCouncil "thaily/proto/council"
+	pbFile "thaily/proto/file"
+	pb "thaily/proto/thesis"
+	"thaily/src/graph/convert"
+	"thaily/src/graph/model"
+)
+
+// Grade appeals. A student appeals a published grade within the semester's
+// appeal window, academic affairs assigns the appeal to a reviewer, and the
+// reviewer either confirms the grade or amends it. An amendment goes through
+// the locked-grade amendment path, so academic affairs still approves the
+// new value; the appeal keeps the amendment it led to and is amended when
+// that amendment is approved.
+
+func (c *Controller) FileGradeAppeal(ctx context.Context, input model.FileGradeAppealInput) (*model.GradeAppeal, error) {
+	myId, err := c.requireStudent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pb.FileGradeAppealRequest{
+		EnrollmentCode: input.EnrollmentID,
+		StudentCode:    myId,
+		Component:      convert.ModelGradeAppealComponentToPb(input.Component),
+		Reason:         input.Reason,
+	}
+
+	// The evidence is stored first and removed again if the appeal is refused
+	var evidenceId string
+	if input.Evidence != nil {
+		file, err := c.file.CreateFile(ctx, &pbFile.CreateFileRequest{
+			Title:     input.Evidence.Title,
+			File:      input.Evidence.File,
+			Status:    pbFile.FileStatus_FILE_PENDING,
+			Table:     pbFile.TableType_GRADE_APPEAL,
+			Option:    "appeal_evidence",
+			TableId:   input.EnrollmentID,
+			CreatedBy: myId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		evidenceId = file.GetFile().GetId()
+		req.EvidenceFileCode = &evidenceId
+	}
+
+	resp, err := c.thesis.FileGradeAppeal(ctx, req)
+	if err != nil {
+		if evidenceId != "" {
+			c.file.DeleteFile(ctx, evidenceId, myId)
+		}
+		return nil, err
+	}
+	return convert.PbGradeAppealToModel(resp.GetAppeal()), nil
+}
+
+func (c *Controller) GetMyGradeAppeals(ctx context.Context) ([]*model.GradeAppeal, error) {
+	myId, err := c.requireStudent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.thesis.ListGradeAppeals(ctx, &pb.ListGradeAppealsRequest{StudentCode: &myId})
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbGradeAppealsToModel(resp.GetAppeals()), nil
+}
+
+func (c *Controller) GetGradeAppeals(ctx context.Context, semesterCode string, status *model.GradeAppealStatus) ([]*model.GradeAppeal, error) {
+	if _, err := c.requireAcademicAffairs(ctx); err != nil {
+		return nil, err
+	}
+
+	req := &pb.ListGradeAppealsRequest{SemesterCode: &semesterCode}
+	if status != nil {
+		s := convert.ModelGradeAppealStatusToPb(*status)
+		req.Status = &s
+	}
+	resp, err := c.thesis.ListGradeAppeals(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbGradeAppealsToModel(resp.GetAppeals()), nil
+}
+
+func (c *Controller) SetGradeAppealWindow(ctx context.Context, semesterCode string, days int32) (*model.SemesterGradeStatus, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.thesis.SetGradeAppealWindow(ctx, semesterCode, days, myId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbSemesterGradeStatusToModel(resp.GetStatus()), nil
+}
+
+func (c *Controller) AssignGradeAppeal(ctx context.Context, id, reviewerId string) (*model.GradeAppeal, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.user.GetTeacherById(ctx, reviewerId); err != nil {
+		return nil, fmt.Errorf("reviewer %s is not a teacher: %w", reviewerId, err)
+	}
+
+	resp, err := c.thesis.AssignGradeAppeal(ctx, id, reviewerId, myId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbGradeAppealToModel(resp.GetAppeal()), nil
+}
+
+func (c *Controller) GetMyAssignedGradeAppeals(ctx context.Context, status *model.GradeAppealStatus) ([]*model.GradeAppeal, error) {
+	myId, err := c.requireTeacher(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pb.ListGradeAppealsRequest{ReviewerCode: &myId}
+	if status != nil {
+		s := convert.ModelGradeAppealStatusToPb(*status)
+		req.Status = &s
+	}
+	resp, err := c.thesis.ListGradeAppeals(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbGradeAppealsToModel(resp.GetAppeals()), nil
+}
+
+// ResolveGradeAppeal confirms the appealed grade or, when amending, requests
+// the amendment on the reviewer's behalf and records it on the appeal, which
+// is amended once academic affairs approves the amendment. Being assigned
+// the appeal is what entitles the reviewer to request it.
+func (c *Controller) ResolveGradeAppeal(ctx context.Context, input model.ResolveGradeAppealInput) (*model.GradeAppeal, error) {
+	myId, err := c.requireTeacher(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	appeal, err := c.thesis.GetGradeAppeal(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	if appeal.GetAppeal().GetReviewerCode() != myId {
+		return nil, fmt.Errorf("grade appeal %s is not assigned to you", input.ID)
+	}
+	if appeal.GetAppeal().GetStatus() != pb.GradeAppealStatus_APPEAL_ASSIGNED {
+		return nil, fmt.Errorf("grade appeal %s is already resolved", input.ID)
+	}
+	if code := appeal.GetAppeal().GetAmendmentCode(); code != "" {
+		return nil, fmt.Errorf("amendment %s of grade appeal %s is awaiting a decision", code, input.ID)
+	}
+
+	if input.Amend {
+		if input.NewValue == nil {
+			return nil, fmt.Errorf("newValue is required to amend")
+		}
+		return c.requestAppealAmendment(ctx, appeal.GetAppeal(), input, myId)
+	}
+
+	resp, err := c.thesis.ResolveGradeAppeal(ctx, &pb.ResolveGradeAppealRequest{
+		Id:         input.ID,
+		Note:       input.Note,
+		ResolvedBy: myId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbGradeAppealToModel(resp.GetAppeal()), nil
+}
+
+// requestAppealAmendment records the amendment of the appealed grade on the
+// appeal. The thesis service requests a thesis-side amendment itself; a
+// defence amendment is requested from the council first, and rejected again
+// if the appeal does not take it, so it does not block a retry.
+func (c *Controller) requestAppealAmendment(ctx context.Context, appeal *pb.GradeAppeal, input model.ResolveGradeAppealInput, myId string) (*model.GradeAppeal, error) {
+	req := &pb.RequestGradeAppealAmendmentRequest{
+		Id:          appeal.Id,
+		NewValue:    *input.NewValue,
+		Note:        input.Note,
+		RequestedBy: myId,
+	}
+
+	if appeal.Component != pb.GradeAppealComponent_APPEAL_DEFENCE {
+		resp, err := c.thesis.RequestGradeAppealAmendment(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return convert.PbGradeAppealToModel(resp.GetAppeal()), nil
+	}
+
+	if input.CriterionID == nil {
+		return nil, fmt.Errorf("criterionId is required to amend a defence appeal")
+	}
+	criterion, err := c.council.GetGradeDefenceCriterionById(ctx, *input.CriterionID)
+	if err != nil {
+		return nil, err
+	}
+	gradeDefences, err := c.council.GetGradeDefencesByIds(ctx, []string{criterion.GetGradeDefenceCriterion().GetGradeDefenceCode()})
+	if err != nil {
+		return nil, err
+	}
+	if len(gradeDefences.GetGradeDefences()) == 0 || gradeDefences.GetGradeDefences()[0].GetEnrollmentCode() != appeal.EnrollmentCode {
+		return nil, fmt.Errorf("criterion %s is not a defence grade of the appealed enrollment", *input.CriterionID)
+	}
+
+	amendment, err := c.council.RequestGradeDefenceAmendment(ctx, &pbCouncil.RequestGradeDefenceAmendmentRequest{
+		CriterionCode: *input.CriterionID,
+		NewScore:      *input.NewValue,
+		Reason:        fmt.Sprintf("grade appeal %s: %s", appeal.Id, input.Note),
+		RequestedBy:   myId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	amendmentId := amendment.GetAmendment().GetId()
+	req.DefenceAmendmentCode = &amendmentId
+
+	resp, err := c.thesis.RequestGradeAppealAmendment(ctx, req)
+	if err != nil {
+		c.council.DecideGradeDefenceAmendment(ctx, &pbCouncil.DecideGradeDefenceAmendmentRequest{
+			Id:        amendmentId,
+			Note:      "withdrawn: the grade appeal did not record it",
+			DecidedBy: myId,
+		})
+		return nil, err
+	}
+	return convert.PbGradeAppealToModel(resp.GetAppeal()), nil
+}
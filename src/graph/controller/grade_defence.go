@@ -0,0 +1,269 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	pbCommon "thaily/proto/common"
+	pbCouncil "thaily/proto/council"
+	"thaily/src/graph/convert"
+	"thaily/src/graph/model"
+)
+
+// Defence grading. A council member grades an enrollment through a
+// GradeDefence tied to their own Defence row; its criteria are copied from
+// the rubric template of the council's major and the topic's stage, and the
+// council service computes total_score from them.
+
+// gradeDefenceCriteriaPageSize bounds the criteria loaded for one grade defence
+const gradeDefenceCriteriaPageSize = 100
+
+// CreateGradeDefence opens the grading of an enrollment by one of the caller's Defence rows
+func (c *Controller) CreateGradeDefence(ctx context.Context, input model.CreateGradeDefenceInput) (*model.GradeDefence, error) {
+	myId, err := c.requireTeacher(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.requireOwnDefence(ctx, input.DefenceCode, myId); err != nil {
+		return nil, err
+	}
+
+	// The stage of the enrollment's topic council picks the rubric template
+	enrollment, err := c.thesis.GetEnrollmentById(ctx, input.EnrollmentCode)
+	if err != nil {
+		return nil, err
+	}
+	topicCouncil, err := c.thesis.GetTopicCouncilById(ctx, enrollment.GetEnrollment().GetTopicCouncilCode())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.council.CreateGradeDefence(ctx, &pbCouncil.CreateGradeDefenceRequest{
+		DefenceCode:    input.DefenceCode,
+		EnrollmentCode: input.EnrollmentCode,
+		Note:           input.Note,
+		CreatedBy:      myId,
+		Stage:          convert.PbTopicStageToRubricStage(topicCouncil.GetTopicCouncil().GetStage()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbGradeDefenceToModel(resp.GetGradeDefence()), nil
+}
+
+func (c *Controller) UpdateGradeDefence(ctx context.Context, id string, input model.UpdateGradeDefenceInput) (*model.GradeDefence, error) {
+	myId, err := c.requireTeacher(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.requireOwnGradeDefence(ctx, id, myId); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.council.UpdateGradeDefence(ctx, &pbCouncil.UpdateGradeDefenceRequest{
+		Id:        id,
+		Note:      input.Note,
+		UpdatedBy: myId,
+		Version:   input.Version,
+	})
+	if err != nil {
+		return nil, conflictError(err)
+	}
+	return convert.PbGradeDefenceToModel(resp.GetGradeDefence()), nil
+}
+
+// AddGradeDefenceCriterion adds a criterion to one of the caller's grade
+// defences; the council service refuses it on a grade defence with a rubric
+func (c *Controller) AddGradeDefenceCriterion(ctx context.Context, input model.CreateGradeDefenceCriterionInput) (*model.GradeDefenceCriterion, error) {
+	myId, err := c.requireTeacher(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.requireOwnGradeDefence(ctx, input.GradeDefenceCode, myId); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.council.CreateGradeDefenceCriterion(ctx, &pbCouncil.CreateGradeDefenceCriterionRequest{
+		GradeDefenceCode: input.GradeDefenceCode,
+		Name:             &input.Name,
+		Description:      input.Description,
+		Score:            input.Score,
+		MaxScore:         &input.MaxScore,
+		Weight:           input.Weight,
+		CreatedBy:        &myId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbGradeDefenceCriterionToModel(resp.GetGradeDefenceCriterion()), nil
+}
+
+// UpdateGradeDefenceCriterion scores or edits a criterion; the council
+// service only lets rubric criteria change their score
+func (c *Controller) UpdateGradeDefenceCriterion(ctx context.Context, id string, input model.UpdateGradeDefenceCriterionInput) (*model.GradeDefenceCriterion, error) {
+	myId, err := c.requireTeacher(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.requireOwnCriterion(ctx, id, myId); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.council.UpdateGradeDefenceCriterion(ctx, &pbCouncil.UpdateGradeDefenceCriterionRequest{
+		Id:          id,
+		Name:        input.Name,
+		Description: input.Description,
+		Score:       input.Score,
+		MaxScore:    input.MaxScore,
+		Weight:      input.Weight,
+		UpdatedBy:   &myId,
+		Version:     input.Version,
+	})
+	if err != nil {
+		return nil, conflictError(err)
+	}
+	return convert.PbGradeDefenceCriterionToModel(resp.GetGradeDefenceCriterion()), nil
+}
+
+func (c *Controller) DeleteGradeDefenceCriterion(ctx context.Context, id string) (bool, error) {
+	myId, err := c.requireTeacher(ctx)
+	if err != nil {
+		return false, err
+	}
+	if err := c.requireOwnCriterion(ctx, id, myId); err != nil {
+		return false, err
+	}
+
+	resp, err := c.council.DeleteGradeDefenceCriterion(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return resp.GetSuccess(), nil
+}
+
+// GetGradeDefenceCriteria lists the criteria of a grade defence
+func (c *Controller) GetGradeDefenceCriteria(ctx context.Context, gradeDefenceCode string) ([]*model.GradeDefenceCriterion, error) {
+	resp, err := c.council.GetGradeDefenceCriteriaBySearch(ctx, &pbCommon.SearchRequest{
+		Pagination: &pbCommon.Pagination{Page: 1, PageSize: gradeDefenceCriteriaPageSize, SortBy: "created_at"},
+		Filters: []*pbCommon.FilterCriteria{
+			{Criteria: &pbCommon.FilterCriteria_Condition{Condition: &pbCommon.FilterCondition{
+				Field: "grade_defence_code", Operator: pbCommon.FilterOperator_EQUAL, Values: []string{gradeDefenceCode},
+			}}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbGradeDefenceCriteriaToModel(resp.GetGradeDefenceCriteria()), nil
+}
+
+func (c *Controller) requireTeacher(ctx context.Context) (string, error) {
+	role, _, myId, err := c.currentUser(ctx)
+	if err != nil {
+		return "", err
+	}
+	if role != "teacher" {
+		return "", fmt.Errorf("role %s not allowed", role)
+	}
+	return myId, nil
+}
+
+func (c *Controller) requireOwnDefence(ctx context.Context, defenceCode, myId string) error {
+	defence, err := c.council.GetDefenceById(ctx, defenceCode)
+	if err != nil {
+		return err
+	}
+	if defence.GetDefence().GetTeacherCode() != myId {
+		return fmt.Errorf("defence %s is not yours", defenceCode)
+	}
+	return nil
+}
+
+func (c *Controller) requireOwnGradeDefence(ctx context.Context, gradeDefenceCode, myId string) error {
+	gradeDefence, err := c.council.GetGradeById(ctx, gradeDefenceCode)
+	if err != nil {
+		return err
+	}
+	return c.requireOwnDefence(ctx, gradeDefence.GetGradeDefence().GetDefenceCode(), myId)
+}
+
+func (c *Controller) requireOwnCriterion(ctx context.Context, criterionId, myId string) error {
+	criterion, err := c.council.GetGradeDefenceCriterionById(ctx, criterionId)
+	if err != nil {
+		return err
+	}
+	return c.requireOwnGradeDefence(ctx, criterion.GetGradeDefenceCriterion().GetGradeDefenceCode(), myId)
+}
+
+// ============================================
+// RUBRIC TEMPLATES
+// ============================================
+
+func (c *Controller) GetRubricTemplates(ctx context.Context, majorCode *string, stage *model.TopicStage) ([]*model.RubricTemplate, error) {
+	if _, err := c.requireAcademicAffairs(ctx); err != nil {
+		return nil, err
+	}
+
+	req := &pbCouncil.ListRubricTemplatesRequest{MajorCode: majorCode}
+	if stage != nil {
+		s := convert.ModelTopicStageToRubricStage(*stage)
+		req.Stage = &s
+	}
+	resp, err := c.council.ListRubricTemplates(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbRubricTemplatesToModel(resp.GetRubricTemplates()), nil
+}
+
+func (c *Controller) CreateRubricTemplate(ctx context.Context, input model.CreateRubricTemplateInput) (*model.RubricTemplate, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.council.CreateRubricTemplate(ctx, &pbCouncil.CreateRubricTemplateRequest{
+		Title:     input.Title,
+		MajorCode: input.MajorCode,
+		Stage:     convert.ModelTopicStageToRubricStage(input.Stage),
+		Criteria:  convert.ModelRubricCriteriaToPb(input.Criteria),
+		CreatedBy: myId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbRubricTemplateToModel(resp.GetRubricTemplate()), nil
+}
+
+// UpdateRubricTemplate renames a template or replaces its criteria; grade
+// defences already opened keep the criteria they were created with
+func (c *Controller) UpdateRubricTemplate(ctx context.Context, id string, input model.UpdateRubricTemplateInput) (*model.RubricTemplate, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.council.UpdateRubricTemplate(ctx, &pbCouncil.UpdateRubricTemplateRequest{
+		Id:        id,
+		Title:     input.Title,
+		Criteria:  convert.ModelRubricCriteriaToPb(input.Criteria),
+		UpdatedBy: myId,
+		Version:   input.Version,
+	})
+	if err != nil {
+		return nil, conflictError(err)
+	}
+	return convert.PbRubricTemplateToModel(resp.GetRubricTemplate()), nil
+}
+
+func (c *Controller) DeleteRubricTemplate(ctx context.Context, id string) (bool, error) {
+	if _, err := c.requireAcademicAffairs(ctx); err != nil {
+		return false, err
+	}
+
+	resp, err := c.council.DeleteRubricTemplate(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return resp.GetSuccess(), nil
+}
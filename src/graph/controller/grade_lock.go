@@ -0,0 +1,501 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	pbCommon "thaily/proto/common"
+	pbCouncil "thaily/proto/council"
+	pb "thaily/proto/thesis"
+	"thaily/src/graph/convert"
+	"thaily/src/graph/model"
+)
+
+// Grade locking and publication. The thesis service locks and publishes a
+// semester's Final, Grade_review and Midterm grades; the council service
+// locks and publishes each council's grade defences. Locking or publishing a
+// semester does both. Once locked, a grade only changes through an amendment
+// requested by a council member and approved by academic affairs.
+
+// councilPageSize bounds the councils loaded per page when locking a semester
+const councilPageSize = 100
+
+func (c *Controller) GetSemesterGradeStatus(ctx context.Context, semesterCode string) (*model.SemesterGradeStatus, error) {
+	if _, err := c.requireAcademicAffairs(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.thesis.GetSemesterGradeStatus(ctx, semesterCode)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbSemesterGradeStatusToModel(resp.GetStatus()), nil
+}
+
+// LockSemesterGrades locks every council of the semester, then the semester itself
+func (c *Controller) LockSemesterGrades(ctx context.Context, semesterCode string) (*model.SemesterGradeStatus, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	councils, err := c.semesterCouncils(ctx, semesterCode)
+	if err != nil {
+		return nil, err
+	}
+	for _, council := range councils {
+		if council.GradesLocked {
+			continue
+		}
+		if _, err := c.council.LockCouncilGrades(ctx, council.Id, myId); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := c.thesis.LockSemesterGrades(ctx, semesterCode, myId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbSemesterGradeStatusToModel(resp.GetStatus()), nil
+}
+
+// PublishSemesterGrades publishes the semester, then every council of it;
+// the thesis service refuses an unlocked semester
+func (c *Controller) PublishSemesterGrades(ctx context.Context, semesterCode string) (*model.SemesterGradeStatus, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.thesis.PublishSemesterGrades(ctx, semesterCode, myId)
+	if err != nil {
+		return nil, err
+	}
+
+	councils, err := c.semesterCouncils(ctx, semesterCode)
+	if err != nil {
+		return nil, err
+	}
+	for _, council := range councils {
+		if council.GradesPublished {
+			continue
+		}
+		if _, err := c.council.PublishCouncilGrades(ctx, council.Id, myId); err != nil {
+			return nil, err
+		}
+	}
+	return convert.PbSemesterGradeStatusToModel(resp.GetStatus()), nil
+}
+
+func (c *Controller) LockCouncilGrades(ctx context.Context, councilId string) (*model.Council, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.council.LockCouncilGrades(ctx, councilId, myId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbCouncilToModel(resp.GetCouncil()), nil
+}
+
+func (c *Controller) PublishCouncilGrades(ctx context.Context, councilId string) (*model.Council, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.council.PublishCouncilGrades(ctx, councilId, myId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbCouncilToModel(resp.GetCouncil()), nil
+}
+
+func (c *Controller) semesterCouncils(ctx context.Context, semesterCode string) ([]*pbCouncil.Council, error) {
+	councils := []*pbCouncil.Council{}
+	for page := int32(1); ; page++ {
+		resp, err := c.council.GetCouncilBySearch(ctx, &pbCommon.SearchRequest{
+			Pagination: &pbCommon.Pagination{Page: page, PageSize: councilPageSize, SortBy: "id"},
+			Filters: []*pbCommon.FilterCriteria{
+				{Criteria: &pbCommon.FilterCriteria_Condition{Condition: &pbCommon.FilterCondition{
+					Field: "semester_code", Operator: pbCommon.FilterOperator_EQUAL, Values: []string{semesterCode},
+				}}},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		councils = append(councils, resp.GetCouncils()...)
+		if len(resp.GetCouncils()) < councilPageSize || int32(len(councils)) >= resp.GetTotal() {
+			return councils, nil
+		}
+	}
+}
+
+// ============================================
+// AMENDMENTS
+// ============================================
+
+// GetGradeAmendments lists the semester's amendments of both services, newest first per service
+func (c *Controller) GetGradeAmendments(ctx context.Context, semesterCode string, status *model.GradeAmendmentStatus) ([]*model.GradeAmendment, error) {
+	if _, err := c.requireAcademicAffairs(ctx); err != nil {
+		return nil, err
+	}
+
+	thesisReq := &pb.ListGradeAmendmentsRequest{SemesterCode: &semesterCode}
+	councilReq := &pbCouncil.ListGradeDefenceAmendmentsRequest{SemesterCode: &semesterCode}
+	if status != nil {
+		thesisStatus := convert.ModelGradeAmendmentStatusToPb(*status)
+		thesisReq.Status = &thesisStatus
+		councilStatus := convert.ModelGradeAmendmentStatusToDefencePb(*status)
+		councilReq.Status = &councilStatus
+	}
+
+	thesisResp, err := c.thesis.ListGradeAmendments(ctx, thesisReq)
+	if err != nil {
+		return nil, err
+	}
+	councilResp, err := c.council.ListGradeDefenceAmendments(ctx, councilReq)
+	if err != nil {
+		return nil, err
+	}
+	return append(
+		convert.PbGradeAmendmentsToModel(thesisResp.GetAmendments()),
+		convert.PbGradeDefenceAmendmentsToModel(councilResp.GetAmendments())...,
+	), nil
+}
+
+// RequestGradeAmendment asks to change a locked grade. A criterion score may
+// only be amended by the owner of its grade defence; the other grades by a
+// member of the council judging the enrollment.
+func (c *Controller) RequestGradeAmendment(ctx context.Context, input model.RequestGradeAmendmentInput) (*model.GradeAmendment, error) {
+	myId, err := c.requireTeacher(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Target == model.GradeAmendmentTargetDefenceCriterion {
+		if err := c.requireOwnCriterion(ctx, input.TargetID, myId); err != nil {
+			return nil, err
+		}
+		resp, err := c.council.RequestGradeDefenceAmendment(ctx, &pbCouncil.RequestGradeDefenceAmendmentRequest{
+			CriterionCode: input.TargetID,
+			NewScore:      input.NewValue,
+			Reason:        input.Reason,
+			RequestedBy:   myId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return convert.PbGradeDefenceAmendmentToModel(resp.GetAmendment()), nil
+	}
+
+	target, ok := convert.ModelGradeAmendmentTargetToPb(input.Target)
+	if !ok {
+		return nil, fmt.Errorf("unknown amendment target %s", input.Target)
+	}
+	if err := c.requireCouncilMemberOfGrade(ctx, target, input.TargetID, myId); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.thesis.RequestGradeAmendment(ctx, &pb.RequestGradeAmendmentRequest{
+		Target:      target,
+		TargetCode:  input.TargetID,
+		NewValue:    input.NewValue,
+		Reason:      input.Reason,
+		RequestedBy: myId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbGradeAmendmentToModel(resp.GetAmendment()), nil
+}
+
+// requireCouncilMemberOfGrade checks the teacher holds a Defence in the
+// council judging the enrollment a Final, Grade_review or Midterm belongs to
+func (c *Controller) requireCouncilMemberOfGrade(ctx context.Context, target pb.GradeAmendmentTarget, targetCode, myId string) error {
+	field := "final_code"
+	switch target {
+	case pb.GradeAmendmentTarget_AMEND_REVIEW_GRADE:
+		field = "grade_review_code"
+	case pb.GradeAmendmentTarget_AMEND_MIDTERM_GRADE:
+		field = "midterm_code"
+	}
+
+	enrollments, err := c.thesis.GetEnrollmentBySearch(ctx, &pbCommon.SearchRequest{
+		Pagination: &pbCommon.Pagination{Page: 1, PageSize: 1, SortBy: "id"},
+		Filters: []*pbCommon.FilterCriteria{
+			{Criteria: &pbCommon.FilterCriteria_Condition{Condition: &pbCommon.FilterCondition{
+				Field: field, Operator: pbCommon.FilterOperator_EQUAL, Values: []string{targetCode},
+			}}},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if len(enrollments.GetEnrollments()) == 0 {
+		return fmt.Errorf("grade %s is not attached to an enrollment", targetCode)
+	}
+
+	topicCouncil, err := c.thesis.GetTopicCouncilById(ctx, enrollments.GetEnrollments()[0].GetTopicCouncilCode())
+	if err != nil {
+		return err
+	}
+	councilCode := topicCouncil.GetTopicCouncil().GetCouncilCode()
+	if councilCode != "" {
+		defences, err := c.council.GetDefencesByCouncilCode(ctx, councilCode)
+		if err != nil {
+			return err
+		}
+		for _, defence := range defences.GetDefences() {
+			if defence.TeacherCode == myId {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("only a member of the enrollment's council may request an amendment")
+}
+
+// DecideGradeAmendment routes the decision to the service owning the target
+func (c *Controller) DecideGradeAmendment(ctx context.Context, input model.DecideGradeAmendmentInput) (*model.GradeAmendment, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	note := ""
+	if input.Note != nil {
+		note = *input.Note
+	}
+
+	if input.Target == model.GradeAmendmentTargetDefenceCriterion {
+		resp, err := c.council.DecideGradeDefenceAmendment(ctx, &pbCouncil.DecideGradeDefenceAmendmentRequest{
+			Id:        input.ID,
+			Approve:   input.Approve,
+			Note:      note,
+			DecidedBy: myId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		// The appeal waiting on the amendment is settled by the thesis service
+		if _, err := c.thesis.SettleGradeAppealAmendment(ctx, input.ID); err != nil {
+			return nil, fmt.Errorf("amendment %s is decided but its grade appeal was not settled: %w", input.ID, err)
+		}
+		return convert.PbGradeDefenceAmendmentToModel(resp.GetAmendment()), nil
+	}
+
+	// A new supervisor or review grade recomputes a graded Final, which
+	// takes the council's scores
+	var scores []*pb.CouncilMemberScore
+	if input.Approve {
+		resp, err := c.thesis.GetGradeAmendment(ctx, input.ID)
+		if err != nil {
+			return nil, err
+		}
+		amendment := resp.GetAmendment()
+		if amendment.Target == pb.GradeAmendmentTarget_AMEND_SUPERVISOR_GRADE || amendment.Target == pb.GradeAmendmentTarget_AMEND_REVIEW_GRADE {
+			scores, err = c.councilMemberScores(ctx, amendment.EnrollmentCode)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	resp, err := c.thesis.DecideGradeAmendment(ctx, &pb.DecideGradeAmendmentRequest{
+		Id:            input.ID,
+		Approve:       input.Approve,
+		Note:          note,
+		DecidedBy:     myId,
+		CouncilScores: scores,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbGradeAmendmentToModel(resp.GetAmendment()), nil
+}
+
+// ============================================
+// STUDENT VIEW
+// ============================================
+
+// GetMyEnrollments lists the caller's own enrollments
+func (c *Controller) GetMyEnrollments(ctx context.Context, search *model.SearchRequestInput) (*model.StudentEnrollmentListResponse, error) {
+	myId, err := c.requireStudent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pbCommon.SearchRequest{}
+	if search != nil {
+		if converted := c.ConvertSearchRequestToPB(*search); converted != nil {
+			req = converted
+		}
+	}
+	req.Filters = append([]*pbCommon.FilterCriteria{
+		{Criteria: &pbCommon.FilterCriteria_Condition{Condition: &pbCommon.FilterCondition{
+			Field: "student_code", Operator: pbCommon.FilterOperator_EQUAL, Values: []string{myId},
+		}}},
+	}, req.Filters...)
+
+	resp, err := c.thesis.GetEnrollmentBySearch(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	enrollments := make([]*model.StudentEnrollment, 0, len(resp.GetEnrollments()))
+	for _, enrollment := range resp.GetEnrollments() {
+		enrollments = append(enrollments, convert.PbEnrollmentToStudentEnrollment(enrollment))
+	}
+	return convert.CreateStudentEnrollmentListResponse(enrollments, resp.GetTotal()), nil
+}
+
+func (c *Controller) GetMyEnrollmentDetail(ctx context.Context, id string) (*model.StudentEnrollment, error) {
+	myId, err := c.requireStudent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.thesis.GetEnrollmentById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetEnrollment().GetStudentCode() != myId {
+		return nil, fmt.Errorf("enrollment %s is not yours", id)
+	}
+	return convert.PbEnrollmentToStudentEnrollment(resp.GetEnrollment()), nil
+}
+
+// GetStudentMidterm returns the enrollment's midterm; its grade and verdict
+// stay hidden until the semester's grades are published
+func (c *Controller) GetStudentMidterm(ctx context.Context, enrollment *model.StudentEnrollment) (*model.Midterm, error) {
+	if enrollment.MidtermCode == nil {
+		return nil, nil
+	}
+	resp, err := c.thesis.GetMidtermById(ctx, *enrollment.MidtermCode)
+	if err != nil {
+		return nil, err
+	}
+	midterm := convert.PbMidtermToModel(resp.GetMidterm())
+
+	published, err := c.semesterGradesPublished(ctx, enrollment.TopicCouncilCode)
+	if err != nil {
+		return nil, err
+	}
+	if !published {
+		midterm.Grade = nil
+		if midterm.Status == model.MidtermStatusPass || midterm.Status == model.MidtermStatusFail {
+			midterm.Status = model.MidtermStatusSubmitted
+		}
+	}
+	return midterm, nil
+}
+
+// GetStudentFinal returns the enrollment's final; its grades and verdict stay
+// hidden until the semester's grades are published
+func (c *Controller) GetStudentFinal(ctx context.Context, enrollment *model.StudentEnrollment) (*model.Final, error) {
+	if enrollment.FinalCode == nil {
+		return nil, nil
+	}
+	resp, err := c.thesis.GetFinalById(ctx, *enrollment.FinalCode)
+	if err != nil {
+		return nil, err
+	}
+	final := convert.PbFinalToModel(resp.GetFinal())
+
+	published, err := c.semesterGradesPublished(ctx, enrollment.TopicCouncilCode)
+	if err != nil {
+		return nil, err
+	}
+	if !published {
+		final.SupervisorGrade = nil
+		final.DepartmentGrade = nil
+		final.FinalGrade = nil
+		final.Status = model.FinalStatusPending
+	}
+	return final, nil
+}
+
+// GetStudentGradeReview returns the enrollment's review; its grade and
+// verdict stay hidden until the semester's grades are published
+func (c *Controller) GetStudentGradeReview(ctx context.Context, enrollment *model.StudentEnrollment) (*model.GradeReview, error) {
+	if enrollment.GradeReviewCode == nil {
+		return nil, nil
+	}
+	resp, err := c.thesis.GetGradeReviewById(ctx, *enrollment.GradeReviewCode)
+	if err != nil {
+		return nil, err
+	}
+	review := convert.PbGradeReviewToModel(resp.GetGradeReview())
+
+	published, err := c.semesterGradesPublished(ctx, enrollment.TopicCouncilCode)
+	if err != nil {
+		return nil, err
+	}
+	if !published {
+		review.ReviewGrade = nil
+		review.Status = model.FinalStatusPending
+	}
+	return review, nil
+}
+
+// GetStudentGradeDefences returns the council members' grading of the
+// enrollment once its council's grades are published, and nothing before
+func (c *Controller) GetStudentGradeDefences(ctx context.Context, enrollment *model.StudentEnrollment) ([]*model.StudentGradeDefence, error) {
+	topicCouncil, err := c.thesis.GetTopicCouncilById(ctx, enrollment.TopicCouncilCode)
+	if err != nil {
+		return nil, err
+	}
+	councilCode := topicCouncil.GetTopicCouncil().GetCouncilCode()
+	if councilCode == "" {
+		return []*model.StudentGradeDefence{}, nil
+	}
+	council, err := c.council.GetCouncilById(ctx, councilCode)
+	if err != nil {
+		return nil, err
+	}
+	if !council.GetCouncil().GetGradesPublished() {
+		return []*model.StudentGradeDefence{}, nil
+	}
+
+	resp, err := c.council.GetGradeDefenceBySearch(ctx, &pbCommon.SearchRequest{
+		Pagination: &pbCommon.Pagination{Page: 1, PageSize: gradeDefencePageSize, SortBy: "id"},
+		Filters: []*pbCommon.FilterCriteria{
+			{Criteria: &pbCommon.FilterCriteria_Condition{Condition: &pbCommon.FilterCondition{
+				Field: "enrollment_code", Operator: pbCommon.FilterOperator_EQUAL, Values: []string{enrollment.ID},
+			}}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbGradeDefencesToStudentGradeDefences(resp.GetGradeDefences()), nil
+}
+
+// semesterGradesPublished reports whether the semester of a topic council has published its grades
+func (c *Controller) semesterGradesPublished(ctx context.Context, topicCouncilCode string) (bool, error) {
+	topicCouncil, err := c.thesis.GetTopicCouncilById(ctx, topicCouncilCode)
+	if err != nil {
+		return false, err
+	}
+	topic, err := c.thesis.GetTopicById(ctx, topicCouncil.GetTopicCouncil().GetTopicCode())
+	if err != nil {
+		return false, err
+	}
+	resp, err := c.thesis.GetSemesterGradeStatus(ctx, topic.GetTopic().GetSemesterCode())
+	if err != nil {
+		return false, err
+	}
+	return resp.GetStatus().GetPublished(), nil
+}
+
+func (c *Controller) requireStudent(ctx context.Context) (string, error) {
+	role, _, myId, err := c.currentUser(ctx)
+	if err != nil {
+		return "", err
+	}
+	if role != "student" {
+		return "", fmt.Errorf("role %s not allowed", role)
+	}
+	return myId, nil
+}
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	pb "thaily/proto/thesis"
+	"thaily/src/graph/convert"
+	"thaily/src/graph/model"
+)
+
+// RankTopicApplicants stores the caller's ranking of a topic's applicants for
+// automatic matching; the thesis service verifies the caller supervises it
+func (c *Controller) RankTopicApplicants(ctx context.Context, topicId string, studentIds []string, restricted *bool) (*model.ApplicantRanking, error) {
+	role, _, myId, err := c.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if role != "teacher" {
+		return nil, fmt.Errorf("role %s not allowed", role)
+	}
+
+	req := &pb.SetApplicantRankingRequest{
+		TopicCode:      topicId,
+		SupervisorCode: myId,
+		StudentCodes:   studentIds,
+	}
+	if restricted != nil {
+		req.Restricted = *restricted
+	}
+	resp, err := c.thesis.SetApplicantRanking(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	studentCodes := resp.GetStudentCodes()
+	if studentCodes == nil {
+		studentCodes = []string{}
+	}
+	return &model.ApplicantRanking{
+		TopicCode:    resp.GetTopicCode(),
+		StudentCodes: studentCodes,
+		Restricted:   resp.GetRestricted(),
+	}, nil
+}
+
+// PreviewTopicMatching is a dry run of the matching round
+func (c *Controller) PreviewTopicMatching(ctx context.Context, semesterCode string) (*model.TopicMatchingResult, error) {
+	if _, err := c.requireAcademicAffairs(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.thesis.PreviewTopicMatching(ctx, semesterCode)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbTopicMatchingResultToModel(resp.GetResult()), nil
+}
+
+// CommitTopicMatching runs the matching round and creates the enrollments
+func (c *Controller) CommitTopicMatching(ctx context.Context, semesterCode string) (*model.TopicMatchingResult, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.thesis.CommitTopicMatching(ctx, semesterCode, myId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbTopicMatchingResultToModel(resp.GetResult()), nil
+}
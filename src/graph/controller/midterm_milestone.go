@@ -0,0 +1,270 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	pbCommon "thaily/proto/common"
+	pbFile "thaily/proto/file"
+	pb "thaily/proto/thesis"
+	"thaily/src/graph/convert"
+	"thaily/src/graph/model"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Midterm milestones. Academic affairs defines the milestones of a semester
+// and stage, students submit a check-in per milestone and the enrollment's
+// supervisors pass or fail it. Midterm.status follows from the results.
+
+func (c *Controller) GetMidtermMilestones(ctx context.Context, semesterCode string, stage *model.TopicStage) ([]*model.MidtermMilestone, error) {
+	if _, err := c.requireAcademicAffairs(ctx); err != nil {
+		return nil, err
+	}
+
+	req := &pb.ListMidtermMilestonesRequest{SemesterCode: semesterCode}
+	if stage != nil {
+		s := convert.ModelTopicStageToPb(*stage)
+		req.Stage = &s
+	}
+	resp, err := c.thesis.ListMidtermMilestones(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbMidtermMilestonesToModel(resp.GetMilestones()), nil
+}
+
+func (c *Controller) CreateMidtermMilestone(ctx context.Context, input model.CreateMidtermMilestoneInput) (*model.MidtermMilestone, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pb.CreateMidtermMilestoneRequest{
+		SemesterCode: input.SemesterCode,
+		Stage:        convert.ModelTopicStageToPb(input.Stage),
+		Title:        input.Title,
+		Sequence:     input.Sequence,
+		CreatedBy:    myId,
+	}
+	if input.Description != nil {
+		req.Description = *input.Description
+	}
+	if input.DueAt != nil {
+		req.DueAt = timestamppb.New(*input.DueAt)
+	}
+	resp, err := c.thesis.CreateMidtermMilestone(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbMidtermMilestoneToModel(resp.GetMilestone()), nil
+}
+
+func (c *Controller) UpdateMidtermMilestone(ctx context.Context, id string, input model.UpdateMidtermMilestoneInput) (*model.MidtermMilestone, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pb.UpdateMidtermMilestoneRequest{
+		Id:          id,
+		Title:       input.Title,
+		Description: input.Description,
+		Sequence:    input.Sequence,
+		UpdatedBy:   myId,
+		Version:     input.Version,
+	}
+	if input.DueAt != nil {
+		req.DueAt = timestamppb.New(*input.DueAt)
+	}
+	resp, err := c.thesis.UpdateMidtermMilestone(ctx, req)
+	if err != nil {
+		return nil, conflictError(err)
+	}
+	return convert.PbMidtermMilestoneToModel(resp.GetMilestone()), nil
+}
+
+func (c *Controller) DeleteMidtermMilestone(ctx context.Context, id string) (bool, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := c.thesis.DeleteMidtermMilestone(ctx, id, myId)
+	if err != nil {
+		return false, err
+	}
+	return resp.GetSuccess(), nil
+}
+
+// myEnrollment loads an enrollment of the current student
+func (c *Controller) myEnrollment(ctx context.Context, enrollmentId, myId string) (*pb.Enrollment, error) {
+	resp, err := c.thesis.GetEnrollmentById(ctx, enrollmentId)
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetEnrollment().GetStudentCode() != myId {
+		return nil, fmt.Errorf("enrollment %s is not yours", enrollmentId)
+	}
+	return resp.GetEnrollment(), nil
+}
+
+func (c *Controller) GetMyMidtermMilestones(ctx context.Context, enrollmentId string) ([]*model.MidtermMilestone, error) {
+	myId, err := c.requireStudent(ctx)
+	if err != nil {
+		return nil, err
+	}
+	enrollment, err := c.myEnrollment(ctx, enrollmentId, myId)
+	if err != nil {
+		return nil, err
+	}
+
+	topicCouncil, err := c.thesis.GetTopicCouncilById(ctx, enrollment.GetTopicCouncilCode())
+	if err != nil {
+		return nil, err
+	}
+	topic, err := c.thesis.GetTopicById(ctx, topicCouncil.GetTopicCouncil().GetTopicCode())
+	if err != nil {
+		return nil, err
+	}
+
+	stage := topicCouncil.GetTopicCouncil().GetStage()
+	resp, err := c.thesis.ListMidtermMilestones(ctx, &pb.ListMidtermMilestonesRequest{
+		SemesterCode: topic.GetTopic().GetSemesterCode(),
+		Stage:        &stage,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbMidtermMilestonesToModel(resp.GetMilestones()), nil
+}
+
+func (c *Controller) GetMyMilestoneCheckins(ctx context.Context, enrollmentId string) ([]*model.MilestoneCheckin, error) {
+	myId, err := c.requireStudent(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.myEnrollment(ctx, enrollmentId, myId); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.thesis.ListMilestoneCheckins(ctx, enrollmentId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbMilestoneCheckinsToModel(resp.GetCheckins()), nil
+}
+
+func (c *Controller) SubmitMilestoneCheckin(ctx context.Context, input model.SubmitMilestoneCheckinInput) (*model.MilestoneCheckin, error) {
+	myId, err := c.requireStudent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pb.SubmitMilestoneCheckinRequest{
+		MilestoneCode:  input.MilestoneID,
+		EnrollmentCode: input.EnrollmentID,
+		StudentCode:    myId,
+		Report:         input.Report,
+	}
+
+	// The attachment is stored first and removed again if the check-in is refused
+	var fileId string
+	if input.Attachment != nil {
+		file, err := c.file.CreateFile(ctx, &pbFile.CreateFileRequest{
+			Title:     input.Attachment.Title,
+			File:      input.Attachment.File,
+			Status:    pbFile.FileStatus_FILE_PENDING,
+			Table:     pbFile.TableType_MILESTONE_CHECKIN,
+			Option:    input.MilestoneID,
+			TableId:   input.EnrollmentID,
+			CreatedBy: myId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		fileId = file.GetFile().GetId()
+		req.FileCode = &fileId
+	}
+
+	resp, err := c.thesis.SubmitMilestoneCheckin(ctx, req)
+	if err != nil {
+		if fileId != "" {
+			c.file.DeleteFile(ctx, fileId, myId)
+		}
+		return nil, err
+	}
+	return convert.PbMilestoneCheckinToModel(resp.GetCheckin()), nil
+}
+
+// requireEnrollmentSupervisor checks the teacher supervises the enrollment's topic council
+func (c *Controller) requireEnrollmentSupervisor(ctx context.Context, enrollmentId, myId string) error {
+	enrollment, err := c.thesis.GetEnrollmentById(ctx, enrollmentId)
+	if err != nil {
+		return err
+	}
+
+	supervisors, err := c.thesis.GetTopicCouncilSupervisorBySearch(ctx, &pbCommon.SearchRequest{
+		Pagination: &pbCommon.Pagination{Page: 1, PageSize: 1, SortBy: "id"},
+		Filters: []*pbCommon.FilterCriteria{
+			{Criteria: &pbCommon.FilterCriteria_Condition{Condition: &pbCommon.FilterCondition{
+				Field: "topic_council_code", Operator: pbCommon.FilterOperator_EQUAL, Values: []string{enrollment.GetEnrollment().GetTopicCouncilCode()},
+			}}},
+			{Criteria: &pbCommon.FilterCriteria_Condition{Condition: &pbCommon.FilterCondition{
+				Field: "teacher_supervisor_code", Operator: pbCommon.FilterOperator_EQUAL, Values: []string{myId},
+			}}},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if len(supervisors.GetTopicCouncilSupervisors()) == 0 {
+		return fmt.Errorf("you do not supervise enrollment %s", enrollmentId)
+	}
+	return nil
+}
+
+func (c *Controller) GetEnrollmentMilestoneCheckins(ctx context.Context, enrollmentId string) ([]*model.MilestoneCheckin, error) {
+	myId, err := c.requireTeacher(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.requireEnrollmentSupervisor(ctx, enrollmentId, myId); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.thesis.ListMilestoneCheckins(ctx, enrollmentId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbMilestoneCheckinsToModel(resp.GetCheckins()), nil
+}
+
+func (c *Controller) ReviewMilestoneCheckin(ctx context.Context, id string, pass bool, comment *string) (*model.MilestoneCheckin, error) {
+	myId, err := c.requireTeacher(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	checkin, err := c.thesis.GetMilestoneCheckin(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.requireEnrollmentSupervisor(ctx, checkin.GetCheckin().GetEnrollmentCode(), myId); err != nil {
+		return nil, err
+	}
+
+	req := &pb.ReviewMilestoneCheckinRequest{
+		Id:         id,
+		Pass:       pass,
+		ReviewedBy: myId,
+	}
+	if comment != nil {
+		req.Comment = *comment
+	}
+	resp, err := c.thesis.ReviewMilestoneCheckin(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbMilestoneCheckinToModel(resp.GetCheckin()), nil
+}
@@ -0,0 +1,310 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	pbCommon "thaily/proto/common"
+	pb "thaily/proto/thesis"
+	"thaily/src/graph/convert"
+	"thaily/src/graph/model"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Topic proposal and registration. The thesis service creates the Topic,
+// Topic_council, Topic_council_supervisor and Enrollment rows; the gateway
+// only decides who may ask for each step.
+
+// registrableTopicsPageSize bounds the approved topics listed to a student
+const registrableTopicsPageSize = 500
+
+// ProposeTopic creates a topic in the caller's semester with the caller as supervisor
+func (c *Controller) ProposeTopic(ctx context.Context, input model.ProposeTopicInput) (*model.ProposeTopicResult, error) {
+	role, semester, myId, err := c.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if role != "teacher" {
+		return nil, fmt.Errorf("role %s not allowed", role)
+	}
+
+	resp, err := c.thesis.ProposeTopic(ctx, &pb.ProposeTopicRequest{
+		Title:             input.Title,
+		MajorCode:         input.MajorCode,
+		SemesterCode:      semester,
+		Stage:             convert.ModelTopicStageToPb(input.Stage),
+		MaxStudents:       input.MaxStudents,
+		RequiredSkills:    input.RequiredSkills,
+		CoSupervisorCodes: input.CoSupervisorCodes,
+		TimeStart:         timestamppb.New(input.TimeStart),
+		TimeEnd:           timestamppb.New(input.TimeEnd),
+		ProposedBy:        myId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &model.ProposeTopicResult{
+		Topic:          convert.PbTopicToModel(resp.GetTopic()),
+		TopicCouncilID: resp.GetTopicCouncilId(),
+		CoSigns:        convert.PbTopicCoSignsToModel(resp.GetCoSigns()),
+	}, nil
+}
+
+// CoSignTopic answers the caller's invitation to co-supervise a topic
+func (c *Controller) CoSignTopic(ctx context.Context, topicId string, accept bool) (*model.TopicCoSign, error) {
+	role, _, myId, err := c.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if role != "teacher" {
+		return nil, fmt.Errorf("role %s not allowed", role)
+	}
+
+	resp, err := c.thesis.CoSignTopic(ctx, topicId, myId, accept)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbTopicCoSignToModel(resp.GetCoSign()), nil
+}
+
+func (c *Controller) GetTopicCoSigns(ctx context.Context, topicId string) ([]*model.TopicCoSign, error) {
+	role, _, _, err := c.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if role != "teacher" {
+		return nil, fmt.Errorf("role %s not allowed", role)
+	}
+
+	resp, err := c.thesis.ListTopicCoSigns(ctx, topicId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbTopicCoSignsToModel(resp.GetCoSigns()), nil
+}
+
+// GetMyTopicApplicants lists the registrations of a topic the caller supervises
+func (c *Controller) GetMyTopicApplicants(ctx context.Context, topicId string) ([]*model.TopicRegistration, error) {
+	role, _, myId, err := c.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if role != "teacher" {
+		return nil, fmt.Errorf("role %s not allowed", role)
+	}
+
+	ok, err := c.isTopicSupervisor(ctx, topicId, myId)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("permission denied: you do not supervise this topic")
+	}
+
+	resp, err := c.thesis.ListTopicRegistrations(ctx, &pb.ListTopicRegistrationsRequest{TopicCode: topicId})
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbTopicRegistrationsToModel(resp.GetRegistrations()), nil
+}
+
+// DecideTopicRegistration accepts or declines an applicant; the thesis service
+// verifies the caller supervises the topic and creates the Enrollment
+func (c *Controller) DecideTopicRegistration(ctx context.Context, registrationId string, accept bool, reason *string) (*model.TopicRegistration, error) {
+	role, _, myId, err := c.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if role != "teacher" {
+		return nil, fmt.Errorf("role %s not allowed", role)
+	}
+
+	req := &pb.DecideTopicRegistrationRequest{
+		RegistrationId: registrationId,
+		SupervisorCode: myId,
+		Accept:         accept,
+	}
+	if reason != nil {
+		req.Reason = *reason
+	}
+	resp, err := c.thesis.DecideTopicRegistration(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbTopicRegistrationToModel(resp.GetRegistration()), nil
+}
+
+func (c *Controller) GetMyRegistrationWindow(ctx context.Context) (*model.RegistrationWindow, error) {
+	role, semester, _, err := c.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if role != "student" {
+		return nil, fmt.Errorf("role %s not allowed", role)
+	}
+	return c.registrationWindow(ctx, semester)
+}
+
+// GetRegistrableTopics lists the fully approved topics of the student's semester
+func (c *Controller) GetRegistrableTopics(ctx context.Context) ([]*model.StudentTopic, error) {
+	role, semester, _, err := c.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if role != "student" {
+		return nil, fmt.Errorf("role %s not allowed", role)
+	}
+
+	resp, err := c.thesis.GetTopicBySearch(ctx, &pbCommon.SearchRequest{
+		Pagination: &pbCommon.Pagination{
+			Page:     1,
+			PageSize: registrableTopicsPageSize,
+			SortBy:   "title",
+		},
+		Filters: []*pbCommon.FilterCriteria{
+			{Criteria: &pbCommon.FilterCriteria_Condition{Condition: &pbCommon.FilterCondition{
+				Field: "semester_code", Operator: pbCommon.FilterOperator_EQUAL, Values: []string{semester},
+			}}},
+			{Criteria: &pbCommon.FilterCriteria_Condition{Condition: &pbCommon.FilterCondition{
+				Field: "status", Operator: pbCommon.FilterOperator_EQUAL, Values: []string{"approved_2"},
+			}}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	topics := make([]*model.StudentTopic, 0, len(resp.GetTopics()))
+	for _, topic := range resp.GetTopics() {
+		topics = append(topics, convert.PbTopicToStudentTopic(topic))
+	}
+	return topics, nil
+}
+
+func (c *Controller) GetMyTopicRegistrations(ctx context.Context) ([]*model.TopicRegistration, error) {
+	role, semester, myId, err := c.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if role != "student" {
+		return nil, fmt.Errorf("role %s not allowed", role)
+	}
+
+	resp, err := c.thesis.ListTopicRegistrations(ctx, &pb.ListTopicRegistrationsRequest{
+		StudentCode:  myId,
+		SemesterCode: semester,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbTopicRegistrationsToModel(resp.GetRegistrations()), nil
+}
+
+// RegisterTopicPreferences replaces the student's pending choices, most preferred first
+func (c *Controller) RegisterTopicPreferences(ctx context.Context, topicIds []string) ([]*model.TopicRegistration, error) {
+	role, semester, myId, err := c.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if role != "student" {
+		return nil, fmt.Errorf("role %s not allowed", role)
+	}
+
+	resp, err := c.thesis.RegisterTopicPreferences(ctx, myId, semester, topicIds)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbTopicRegistrationsToModel(resp.GetRegistrations()), nil
+}
+
+func (c *Controller) SetRegistrationWindow(ctx context.Context, input model.SetRegistrationWindowInput) (*model.RegistrationWindow, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var maxChoices int32
+	if input.MaxChoices != nil {
+		maxChoices = *input.MaxChoices
+	}
+	resp, err := c.thesis.SetRegistrationWindow(ctx, &pb.SetRegistrationWindowRequest{
+		SemesterCode: input.SemesterCode,
+		OpensAt:      timestamppb.New(input.OpensAt),
+		ClosesAt:     timestamppb.New(input.ClosesAt),
+		MaxChoices:   maxChoices,
+		CreatedBy:    myId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbRegistrationWindowToModel(resp.GetWindow()), nil
+}
+
+func (c *Controller) GetRegistrationWindow(ctx context.Context, semesterCode string) (*model.RegistrationWindow, error) {
+	if _, err := c.requireAcademicAffairs(ctx); err != nil {
+		return nil, err
+	}
+	return c.registrationWindow(ctx, semesterCode)
+}
+
+func (c *Controller) GetTopicRegistrations(ctx context.Context, semesterCode string) ([]*model.TopicRegistration, error) {
+	if _, err := c.requireAcademicAffairs(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.thesis.ListTopicRegistrations(ctx, &pb.ListTopicRegistrationsRequest{SemesterCode: semesterCode})
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbTopicRegistrationsToModel(resp.GetRegistrations()), nil
+}
+
+// registrationWindow returns nil when no window is configured for the semester
+func (c *Controller) registrationWindow(ctx context.Context, semesterCode string) (*model.RegistrationWindow, error) {
+	resp, err := c.thesis.GetRegistrationWindow(ctx, semesterCode)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbRegistrationWindowToModel(resp.GetWindow()), nil
+}
+
+// isTopicSupervisor reports whether the teacher supervises one of the topic's councils
+func (c *Controller) isTopicSupervisor(ctx context.Context, topicId, teacherId string) (bool, error) {
+	councils, err := c.thesis.GetTopicCouncilBySearch(ctx, &pbCommon.SearchRequest{
+		Filters: []*pbCommon.FilterCriteria{
+			{Criteria: &pbCommon.FilterCriteria_Condition{Condition: &pbCommon.FilterCondition{
+				Field: "topic_code", Operator: pbCommon.FilterOperator_EQUAL, Values: []string{topicId},
+			}}},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	if len(councils.GetTopicCouncils()) == 0 {
+		return false, nil
+	}
+
+	councilIds := make([]string, 0, len(councils.GetTopicCouncils()))
+	for _, council := range councils.GetTopicCouncils() {
+		councilIds = append(councilIds, council.Id)
+	}
+	supervisors, err := c.thesis.GetTopicCouncilSupervisorBySearch(ctx, &pbCommon.SearchRequest{
+		Filters: []*pbCommon.FilterCriteria{
+			{Criteria: &pbCommon.FilterCriteria_Condition{Condition: &pbCommon.FilterCondition{
+				Field: "topic_council_code", Operator: pbCommon.FilterOperator_IN, Values: councilIds,
+			}}},
+			{Criteria: &pbCommon.FilterCriteria_Condition{Condition: &pbCommon.FilterCondition{
+				Field: "teacher_supervisor_code", Operator: pbCommon.FilterOperator_EQUAL, Values: []string{teacherId},
+			}}},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(supervisors.GetTopicCouncilSupervisors()) > 0, nil
+}
@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	pbCommon "thaily/proto/common"
+	pbFile "thaily/proto/file"
+	pbRole "thaily/proto/role"
+	pb "thaily/proto/thesis"
+	pbUser "thaily/proto/user"
+	"thaily/src/graph/convert"
+	"thaily/src/graph/model"
+	"thaily/src/pkg/helper"
+)
+
+// studentVisibleTopicStatuses are the topics a student may find besides their own
+var studentVisibleTopicStatuses = []pb.TopicStatus{
+	pb.TopicStatus_APPROVED_2,
+	pb.TopicStatus_IN_PROGRESS,
+	pb.TopicStatus_TOPIC_COMPLETED,
+}
+
+// GlobalSearch runs a full-text search over the requested kinds of records
+// and merges the hits best first. What the caller may find depends on the
+// role: students see approved topics and their own, teachers but not other
+// students, and only their own files; teachers see every topic and user but
+// only their own files; academic affairs sees everything.
+func (c *Controller) GlobalSearch(ctx context.Context, query string, types []model.SearchType, limit *int32) ([]*model.SearchHit, error) {
+	role, _, myId, err := c.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if helper.FullTextQuery(query) == "" {
+		return nil, fmt.Errorf("query must contain some words")
+	}
+
+	if len(types) == 0 {
+		types = model.AllSearchType
+	}
+	wanted := map[model.SearchType]bool{}
+	for _, t := range types {
+		wanted[t] = true
+	}
+
+	academicAffairs := false
+	if role == "teacher" {
+		if academicAffairs, err = c.hasRole(ctx, myId, pbRole.RoleType_ACADEMIC_AFFAIRS_STAFF); err != nil {
+			return nil, err
+		}
+	}
+
+	var n int32
+	if limit != nil {
+		n = *limit
+	}
+	n = helper.SearchLimit(n)
+
+	hits := []*pbCommon.SearchHit{}
+
+	if wanted[model.SearchTypeTopic] {
+		req := &pb.SearchTopicsRequest{Query: query, Limit: n}
+		if role == "student" {
+			req.Statuses = studentVisibleTopicStatuses
+			req.CreatedBy = &myId
+		}
+		resp, err := c.thesis.SearchTopics(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		hits = append(hits, resp.GetHits()...)
+	}
+
+	if wanted[model.SearchTypeStudent] || wanted[model.SearchTypeTeacher] {
+		req := &pbUser.SearchUsersRequest{
+			Query:    query,
+			Limit:    n,
+			Students: wanted[model.SearchTypeStudent] && role == "teacher",
+			Teachers: wanted[model.SearchTypeTeacher],
+		}
+		if req.Students || req.Teachers {
+			resp, err := c.user.SearchUsers(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			hits = append(hits, resp.GetHits()...)
+		}
+	}
+
+	if wanted[model.SearchTypeFile] {
+		req := &pbFile.SearchFilesRequest{Query: query, Limit: n}
+		if !academicAffairs {
+			req.CreatedBy = &myId
+		}
+		resp, err := c.file.SearchFiles(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		hits = append(hits, resp.GetHits()...)
+	}
+
+	helper.SortSearchHits(hits)
+	if int32(len(hits)) > n {
+		hits = hits[:n]
+	}
+	return convert.PbSearchHitsToModel(hits), nil
+}
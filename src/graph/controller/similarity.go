@@ -0,0 +1,63 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"thaily/src/graph/convert"
+	"thaily/src/graph/model"
+
+	pb "thaily/proto/common"
+)
+
+// GetFinalSimilarityReport returns the similarity report of the latest file
+// submitted for an enrollment's final. Only the supervisor and reviewer views
+// expose it, so the caller has already been scoped to their own enrollments.
+func (c *Controller) GetFinalSimilarityReport(ctx context.Context, finalCode *string, topN *int32) (*model.SimilarityReport, error) {
+	role, _, _, err := c.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if role != "teacher" {
+		return nil, fmt.Errorf("permission denied: only teachers can view similarity reports")
+	}
+	if finalCode == nil || *finalCode == "" {
+		return nil, nil
+	}
+
+	files, err := c.file.GetFileBySearch(ctx, &pb.SearchRequest{
+		Pagination: &pb.Pagination{
+			Page:       1,
+			PageSize:   1,
+			SortBy:     "created_at",
+			Descending: true,
+		},
+		Filters: []*pb.FilterCriteria{
+			{Criteria: &pb.FilterCriteria_Condition{Condition: &pb.FilterCondition{
+				Field: "table", Operator: pb.FilterOperator_EQUAL, Values: []string{"final"},
+			}}},
+			{Criteria: &pb.FilterCriteria_Condition{Condition: &pb.FilterCondition{
+				Field: "table_id", Operator: pb.FilterOperator_EQUAL, Values: []string{*finalCode},
+			}}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(files.GetFiles()) == 0 {
+		return nil, nil
+	}
+	latest := files.GetFiles()[0]
+
+	n := int32(0)
+	if topN != nil {
+		n = *topN
+	}
+	report, err := c.file.GetSimilarityReport(ctx, latest.Id, n)
+	if err != nil {
+		return nil, err
+	}
+
+	result := convert.PbSimilarityReportToModel(report)
+	result.File = convert.PbFileToModel(latest)
+	return result, nil
+}
@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"context"
+
+	"thaily/src/graph/convert"
+	"thaily/src/graph/model"
+)
+
+// Soft delete. Deleting a record only marks it deleted, so academic affairs
+// can restore it until the services' retention job purges it for good.
+
+func (c *Controller) DeleteTeacher(ctx context.Context, id string) (bool, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := c.user.DeleteTeacher(ctx, id, myId)
+	if err != nil {
+		return false, err
+	}
+	return resp.GetSuccess(), nil
+}
+
+func (c *Controller) RestoreTeacher(ctx context.Context, id string) (*model.Teacher, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.user.RestoreTeacher(ctx, id, myId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbTeacherToModel(resp.GetTeacher()), nil
+}
+
+func (c *Controller) DeleteStudent(ctx context.Context, id string) (bool, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := c.user.DeleteStudent(ctx, id, myId)
+	if err != nil {
+		return false, err
+	}
+	return resp.GetSuccess(), nil
+}
+
+func (c *Controller) RestoreStudent(ctx context.Context, id string) (*model.Student, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.user.RestoreStudent(ctx, id, myId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbStudentToModel(resp.GetStudent()), nil
+}
+
+func (c *Controller) DeleteSemester(ctx context.Context, id string) (bool, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := c.academic.DeleteSemester(ctx, id, myId)
+	if err != nil {
+		return false, err
+	}
+	return resp.GetSuccess(), nil
+}
+
+func (c *Controller) RestoreSemester(ctx context.Context, id string) (*model.Semester, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.academic.RestoreSemester(ctx, id, myId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbSemesterToModel(resp.GetSemester()), nil
+}
+
+func (c *Controller) DeleteMajor(ctx context.Context, id string) (bool, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := c.academic.DeleteMajor(ctx, id, myId)
+	if err != nil {
+		return false, err
+	}
+	return resp.GetSuccess(), nil
+}
+
+func (c *Controller) RestoreMajor(ctx context.Context, id string) (*model.Major, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.academic.RestoreMajor(ctx, id, myId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbMajorToModel(resp.GetMajor()), nil
+}
+
+func (c *Controller) DeleteCouncil(ctx context.Context, id string) (bool, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := c.council.DeleteCouncil(ctx, id, myId)
+	if err != nil {
+		return false, err
+	}
+	return resp.GetSuccess(), nil
+}
+
+func (c *Controller) RestoreCouncil(ctx context.Context, id string) (*model.Council, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.council.RestoreCouncil(ctx, id, myId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbCouncilToModel(resp.GetCouncil()), nil
+}
+
+func (c *Controller) DeleteTopic(ctx context.Context, id string) (bool, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := c.thesis.DeleteTopic(ctx, id, myId)
+	if err != nil {
+		return false, err
+	}
+	return resp.GetSuccess(), nil
+}
+
+func (c *Controller) RestoreTopic(ctx context.Context, id string) (*model.Topic, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.thesis.RestoreTopic(ctx, id, myId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbTopicToModel(resp.GetTopic()), nil
+}
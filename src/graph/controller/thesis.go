@@ -215,7 +215,6 @@ func (c *Controller) pbFinalToModel(resp *pb.GetFinalResponse) *model.Final {
 		return nil
 	}
 	final := resp.GetFinal()
-	var supervisorGrade, finalGrade *int32
 	var notes, createdBy, updatedBy *string
 	var status model.FinalStatus
 	var createdAt, updatedAt *time.Time
@@ -232,12 +231,6 @@ func (c *Controller) pbFinalToModel(resp *pb.GetFinalResponse) *model.Final {
 		status = model.FinalStatusPending
 
 	}
-	if final.GetSupervisorGrade() != -1 {
-		supervisorGrade = &final.SupervisorGrade
-	}
-	if final.GetFinalGrade() != -1 {
-		finalGrade = &final.FinalGrade
-	}
 	if final.GetNotes() != "" {
 		notes = &final.Notes
 	}
@@ -260,8 +253,8 @@ func (c *Controller) pbFinalToModel(resp *pb.GetFinalResponse) *model.Final {
 		ID:              final.GetId(),
 		Title:           final.GetTitle(),
 		Status:          status,
-		SupervisorGrade: supervisorGrade,
-		FinalGrade:      finalGrade,
+		SupervisorGrade: final.SupervisorGrade,
+		FinalGrade:      final.FinalGrade,
 		Notes:           notes,
 		CreatedBy:       createdBy,
 		UpdatedBy:       updatedBy,
@@ -377,10 +370,8 @@ func (c *Controller) GetTopicById(ctx context.Context, id *string) (*model.Topic
 			return nil, err
 		}
 		return c.pbTopicToModel(topic), nil
-	} else {
-		return nil, fmt.Errorf("no teacher found for student role %s", role)
 	}
-	return nil, nil
+	return nil, fmt.Errorf("no teacher found for student role %s", role)
 }
 
 func (c *Controller) GetTopicByIdForSchedule(ctx context.Context, id *string) (*model.Topic, error) {
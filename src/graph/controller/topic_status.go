@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	pbRole "thaily/proto/role"
+	"thaily/src/graph/convert"
+	"thaily/src/graph/model"
+)
+
+// Topic lifecycle mutations. The thesis service enforces which transitions are
+// allowed; the gateway only decides who may request each one.
+
+// SubmitTopic sends a topic for approval; only its author may submit it
+func (c *Controller) SubmitTopic(ctx context.Context, id string) (*model.Topic, error) {
+	role, _, myId, err := c.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if role != "teacher" {
+		return nil, fmt.Errorf("role %s not allowed", role)
+	}
+
+	topic, err := c.thesis.GetTopicById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if topic.GetTopic().GetCreatedBy() != myId {
+		return nil, fmt.Errorf("permission denied: only the author can submit this topic")
+	}
+
+	resp, err := c.thesis.SubmitTopic(ctx, id, myId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbTopicToModel(resp.GetTopic()), nil
+}
+
+// ApproveTopic records an approval: stage 1 by a department lecturer,
+// stage 2 by academic affairs staff
+func (c *Controller) ApproveTopic(ctx context.Context, id string, stage int32, note *string) (*model.Topic, error) {
+	myId, err := c.requireTeacherRole(ctx, approverRole(stage))
+	if err != nil {
+		return nil, err
+	}
+
+	n := ""
+	if note != nil {
+		n = *note
+	}
+	resp, err := c.thesis.ApproveTopic(ctx, id, stage, myId, n)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbTopicToModel(resp.GetTopic()), nil
+}
+
+// RejectTopic rejects a topic at the given approval stage
+func (c *Controller) RejectTopic(ctx context.Context, id string, stage int32, reason string) (*model.Topic, error) {
+	myId, err := c.requireTeacherRole(ctx, approverRole(stage))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.thesis.RejectTopic(ctx, id, stage, reason, myId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbTopicToModel(resp.GetTopic()), nil
+}
+
+func (c *Controller) StartTopic(ctx context.Context, id string) (*model.Topic, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.thesis.StartTopic(ctx, id, myId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbTopicToModel(resp.GetTopic()), nil
+}
+
+func (c *Controller) CompleteTopic(ctx context.Context, id string) (*model.Topic, error) {
+	myId, err := c.requireAcademicAffairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.thesis.CompleteTopic(ctx, id, myId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbTopicToModel(resp.GetTopic()), nil
+}
+
+func (c *Controller) GetTopicStatusHistory(ctx context.Context, topicId string) ([]*model.TopicStatusHistory, error) {
+	if _, _, _, err := c.currentUser(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.thesis.ListTopicStatusHistory(ctx, topicId)
+	if err != nil {
+		return nil, err
+	}
+	return convert.PbTopicStatusHistoriesToModel(resp.GetHistory()), nil
+}
+
+// approverRole is the system role that decides an approval stage
+func approverRole(stage int32) pbRole.RoleType {
+	if stage == 1 {
+		return pbRole.RoleType_DEPARTMENT_LECTURER
+	}
+	return pbRole.RoleType_ACADEMIC_AFFAIRS_STAFF
+}
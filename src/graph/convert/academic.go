@@ -12,8 +12,9 @@ func PbSemesterToModel(pb *academic.Semester) *model.Semester {
 	}
 
 	result := &model.Semester{
-		ID:    pb.Id,
-		Title: pb.Title,
+		ID:      pb.Id,
+		Title:   pb.Title,
+		Version: pb.Version,
 	}
 
 	// Handle timestamps
@@ -33,6 +34,11 @@ func PbSemesterToModel(pb *academic.Semester) *model.Semester {
 	if pb.UpdatedBy != "" {
 		result.UpdatedBy = &pb.UpdatedBy
 	}
+	if pb.DeletedAt != nil {
+		t := pb.DeletedAt.AsTime()
+		result.DeletedAt = &t
+		result.DeletedBy = &pb.DeletedBy
+	}
 
 	return result
 }
@@ -59,6 +65,7 @@ func PbMajorToModel(pb *academic.Major) *model.Major {
 		ID:          pb.Id,
 		Title:       pb.Title,
 		FacultyCode: pb.FacultyCode,
+		Version:     pb.Version,
 	}
 
 	// Handle timestamps
@@ -78,6 +85,11 @@ func PbMajorToModel(pb *academic.Major) *model.Major {
 	if pb.UpdatedBy != "" {
 		result.UpdatedBy = &pb.UpdatedBy
 	}
+	if pb.DeletedAt != nil {
+		t := pb.DeletedAt.AsTime()
+		result.DeletedAt = &t
+		result.DeletedBy = &pb.DeletedBy
+	}
 
 	return result
 }
@@ -132,8 +144,9 @@ func PbFacultyToModel(pb *academic.Faculty) *model.Faculty {
 	}
 
 	result := &model.Faculty{
-		ID:    pb.Id,
-		Title: pb.Title,
+		ID:      pb.Id,
+		Title:   pb.Title,
+		Version: pb.Version,
 	}
 
 	// Handle timestamps
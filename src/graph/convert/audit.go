@@ -0,0 +1,77 @@
+package convert
+
+import (
+	"fmt"
+	"time"
+
+	"thaily/src/graph/model"
+	"thaily/src/pkg/audit"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AuditEntryToModel converts an audit log entry to GraphQL AuditLogEntry
+func AuditEntryToModel(entry *audit.Entry) *model.AuditLogEntry {
+	if entry == nil {
+		return nil
+	}
+
+	result := &model.AuditLogEntry{
+		ID:      entry.ID.Hex(),
+		At:      entry.At,
+		Service: entry.Service,
+		Method:  entry.Method,
+		Entity:  entry.Entity,
+		Changes: make([]*model.AuditChange, 0, len(entry.Changes)),
+	}
+	if entry.EntityID != "" {
+		result.EntityID = &entry.EntityID
+	}
+	if entry.Actor != "" {
+		result.Actor = &entry.Actor
+	}
+	if entry.Role != "" {
+		result.Role = &entry.Role
+	}
+	if entry.Semester != "" {
+		result.Semester = &entry.Semester
+	}
+	if entry.RequestID != "" {
+		result.RequestID = &entry.RequestID
+	}
+	if entry.ClientIP != "" {
+		result.ClientIP = &entry.ClientIP
+	}
+	for _, change := range entry.Changes {
+		result.Changes = append(result.Changes, &model.AuditChange{
+			Field:  change.Field,
+			Before: auditValue(change.Before),
+			After:  auditValue(change.After),
+		})
+	}
+	return result
+}
+
+func AuditEntriesToModel(entries []*audit.Entry) []*model.AuditLogEntry {
+	result := make([]*model.AuditLogEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, AuditEntryToModel(entry))
+	}
+	return result
+}
+
+// auditValue renders a column value; NULL stays null
+func auditValue(value interface{}) *string {
+	var s string
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case primitive.DateTime:
+		s = v.Time().UTC().Format(time.RFC3339)
+	case time.Time:
+		s = v.UTC().Format(time.RFC3339)
+	default:
+		s = fmt.Sprint(v)
+	}
+	return &s
+}
@@ -17,6 +17,7 @@ func PbCouncilToModel(pb *council.Council) *model.Council {
 		Title:        pb.Title,
 		MajorCode:    pb.MajorCode,
 		SemesterCode: pb.SemesterCode,
+		Version:      pb.Version,
 	}
 
 	// Handle optional TimeStart
@@ -25,6 +26,22 @@ func PbCouncilToModel(pb *council.Council) *model.Council {
 		result.TimeStart = &t
 	}
 
+	if pb.Room != "" {
+		result.Room = &pb.Room
+	}
+
+	// Grade lock and publication
+	result.GradesLocked = pb.GradesLocked
+	if pb.GradesLockedAt != nil {
+		t := pb.GradesLockedAt.AsTime()
+		result.GradesLockedAt = &t
+	}
+	result.GradesPublished = pb.GradesPublished
+	if pb.GradesPublishedAt != nil {
+		t := pb.GradesPublishedAt.AsTime()
+		result.GradesPublishedAt = &t
+	}
+
 	// Handle timestamps
 	if pb.CreatedAt != nil {
 		t := pb.CreatedAt.AsTime()
@@ -42,6 +59,11 @@ func PbCouncilToModel(pb *council.Council) *model.Council {
 	if pb.UpdatedBy != "" {
 		result.UpdatedBy = &pb.UpdatedBy
 	}
+	if pb.DeletedAt != nil {
+		t := pb.DeletedAt.AsTime()
+		result.DeletedAt = &t
+		result.DeletedBy = &pb.DeletedBy
+	}
 
 	return result
 }
@@ -58,6 +80,7 @@ func PbDefenceToModel(pb *council.Defence) *model.Defence {
 		CouncilCode: pb.CouncilCode,
 		TeacherCode: pb.TeacherCode,
 		Position:    PbDefencePositionToModel(pb.Position),
+		Version:     pb.Version,
 	}
 
 	// Handle timestamps
@@ -91,6 +114,7 @@ func PbGradeDefenceToModel(pb *council.GradeDefence) *model.GradeDefence {
 		ID:             pb.Id,
 		DefenceCode:    pb.DefenceCode,
 		EnrollmentCode: pb.EnrollmentCode,
+		Version:        pb.Version,
 	}
 
 	// Handle optional Note
@@ -98,9 +122,10 @@ func PbGradeDefenceToModel(pb *council.GradeDefence) *model.GradeDefence {
 		result.Note = &pb.Note
 	}
 
-	// Handle optional TotalScore
-	if pb.TotalScore != 0 {
-		result.TotalScore = &pb.TotalScore
+	// TotalScore is computed by the council service, nil until fully graded
+	result.TotalScore = pb.TotalScore
+	if pb.RubricTemplateCode != "" {
+		result.RubricTemplateCode = &pb.RubricTemplateCode
 	}
 
 	// Handle timestamps
@@ -133,21 +158,21 @@ func PbGradeDefenceCriterionToModel(pb *council.GradeDefenceCriterion) *model.Gr
 	result := &model.GradeDefenceCriterion{
 		ID:               pb.Id,
 		GradeDefenceCode: pb.GradeDefenceCode,
+		Score:            pb.Score,
+		MaxScore:         pb.MaxScore,
+		Weight:           pb.Weight,
+		Version:          pb.Version,
 	}
 
 	// Handle optional Name
 	if pb.Name != "" {
 		result.Name = &pb.Name
 	}
-
-	// Handle optional Score
-	if pb.Score != "" {
-		result.Score = &pb.Score
+	if pb.Description != "" {
+		result.Description = &pb.Description
 	}
-
-	// Handle optional MaxScore
-	if pb.MaxScore != "" {
-		result.MaxScore = &pb.MaxScore
+	if pb.RubricCriterionCode != "" {
+		result.RubricCriterionCode = &pb.RubricCriterionCode
 	}
 
 	// Handle timestamps
@@ -187,6 +212,20 @@ func PbDefencePositionToModel(pb council.DefencePosition) model.DefencePosition
 	}
 }
 
+// ModelDefencePositionToPb converts GraphQL DefencePosition to protobuf
+func ModelDefencePositionToPb(position model.DefencePosition) council.DefencePosition {
+	switch position {
+	case model.DefencePositionSecretary:
+		return council.DefencePosition_SECRETARY
+	case model.DefencePositionReviewer:
+		return council.DefencePosition_REVIEWER
+	case model.DefencePositionMember:
+		return council.DefencePosition_MEMBER
+	default:
+		return council.DefencePosition_PRESIDENT
+	}
+}
+
 // PbCouncilsToModel converts array of protobuf Councils to GraphQL Councils
 func PbCouncilsToModel(pbs []*council.Council) []*model.Council {
 	if pbs == nil {
@@ -468,10 +507,8 @@ func PbGradeDefenceToStudentGradeDefence(pb *council.GradeDefence) *model.Studen
 		result.Note = &pb.Note
 	}
 
-	// Handle optional TotalScore
-	if pb.TotalScore != 0 {
-		result.TotalScore = &pb.TotalScore
-	}
+	// TotalScore is computed by the council service, nil until fully graded
+	result.TotalScore = pb.TotalScore
 
 	// Handle timestamps
 	if pb.CreatedAt != nil {
@@ -560,3 +597,252 @@ func CreateCouncilTopicCouncilListResponse(topicCouncils []*model.CouncilTopicCo
 		Total: total,
 	}
 }
+
+// PbCouncilConflictKindToModel converts protobuf CouncilConflictKind to GraphQL CouncilConflictKind
+func PbCouncilConflictKindToModel(pb council.CouncilConflictKind) model.CouncilConflictKind {
+	switch pb {
+	case council.CouncilConflictKind_REVIEWER_IS_SUPERVISOR:
+		return model.CouncilConflictKindReviewerIsSupervisor
+	case council.CouncilConflictKind_DUPLICATE_POSITION:
+		return model.CouncilConflictKindDuplicatePosition
+	case council.CouncilConflictKind_DUPLICATE_MEMBER:
+		return model.CouncilConflictKindDuplicateMember
+	case council.CouncilConflictKind_MISSING_PRESIDENT:
+		return model.CouncilConflictKindMissingPresident
+	case council.CouncilConflictKind_MISSING_SECRETARY:
+		return model.CouncilConflictKindMissingSecretary
+	case council.CouncilConflictKind_CROSS_MAJOR_MEMBER:
+		return model.CouncilConflictKindCrossMajorMember
+	default:
+		return model.CouncilConflictKindSupervisorOnCouncil
+	}
+}
+
+// PbCouncilConflictsToModel converts protobuf CouncilConflicts to GraphQL CouncilConflicts
+func PbCouncilConflictsToModel(pbs []*council.CouncilConflict) []*model.CouncilConflict {
+	result := make([]*model.CouncilConflict, 0, len(pbs))
+	for _, pb := range pbs {
+		if pb == nil {
+			continue
+		}
+		conflict := &model.CouncilConflict{
+			Kind:       PbCouncilConflictKindToModel(pb.Kind),
+			Message:    pb.Message,
+			Overridden: pb.Overridden,
+		}
+		if pb.TeacherCode != "" {
+			conflict.TeacherCode = &pb.TeacherCode
+		}
+		if pb.TopicCouncilCode != "" {
+			conflict.TopicCouncilCode = &pb.TopicCouncilCode
+		}
+		result = append(result, conflict)
+	}
+	return result
+}
+
+// PbCouncilConflictOverridesToModel converts protobuf CouncilConflictOverrides to GraphQL CouncilConflictOverrides
+func PbCouncilConflictOverridesToModel(pbs []*council.CouncilConflictOverride) []*model.CouncilConflictOverride {
+	result := make([]*model.CouncilConflictOverride, 0, len(pbs))
+	for _, pb := range pbs {
+		if pb == nil {
+			continue
+		}
+		override := &model.CouncilConflictOverride{
+			ID:          pb.Id,
+			CouncilCode: pb.CouncilCode,
+			Kind:        PbCouncilConflictKindToModel(pb.Kind),
+			Message:     pb.Message,
+			Reason:      pb.Reason,
+			CreatedBy:   pb.CreatedBy,
+		}
+		if pb.TeacherCode != "" {
+			override.TeacherCode = &pb.TeacherCode
+		}
+		if pb.TopicCouncilCode != "" {
+			override.TopicCouncilCode = &pb.TopicCouncilCode
+		}
+		if pb.CreatedAt != nil {
+			t := pb.CreatedAt.AsTime()
+			override.CreatedAt = &t
+		}
+		result = append(result, override)
+	}
+	return result
+}
+
+// PbRubricStageToModel maps a rubric stage onto the topic stage it grades
+func PbRubricStageToModel(pb council.RubricStage) model.TopicStage {
+	if pb == council.RubricStage_RUBRIC_STAGE_LVTN {
+		return model.TopicStageStageLvtn
+	}
+	return model.TopicStageStageDacn
+}
+
+// ModelTopicStageToRubricStage converts a GraphQL TopicStage to the rubric stage
+func ModelTopicStageToRubricStage(stage model.TopicStage) council.RubricStage {
+	if stage == model.TopicStageStageLvtn {
+		return council.RubricStage_RUBRIC_STAGE_LVTN
+	}
+	return council.RubricStage_RUBRIC_STAGE_DACN
+}
+
+// PbTopicStageToRubricStage picks the rubric stage grading a thesis TopicStage
+func PbTopicStageToRubricStage(stage thesis.TopicStage) council.RubricStage {
+	if stage == thesis.TopicStage_STAGE_LVTN {
+		return council.RubricStage_RUBRIC_STAGE_LVTN
+	}
+	return council.RubricStage_RUBRIC_STAGE_DACN
+}
+
+// PbRubricTemplateToModel converts protobuf RubricTemplate to GraphQL RubricTemplate
+func PbRubricTemplateToModel(pb *council.RubricTemplate) *model.RubricTemplate {
+	if pb == nil {
+		return nil
+	}
+
+	result := &model.RubricTemplate{
+		ID:        pb.Id,
+		Title:     pb.Title,
+		MajorCode: pb.MajorCode,
+		Stage:     PbRubricStageToModel(pb.Stage),
+		Criteria:  make([]*model.RubricCriterion, 0, len(pb.Criteria)),
+		Version:   pb.Version,
+	}
+	for _, criterion := range pb.Criteria {
+		m := &model.RubricCriterion{
+			ID:        criterion.Id,
+			Name:      criterion.Name,
+			MaxScore:  criterion.MaxScore,
+			Weight:    criterion.Weight,
+			SortOrder: criterion.SortOrder,
+		}
+		if criterion.Description != "" {
+			m.Description = &criterion.Description
+		}
+		result.Criteria = append(result.Criteria, m)
+	}
+
+	// Handle timestamps
+	if pb.CreatedAt != nil {
+		t := pb.CreatedAt.AsTime()
+		result.CreatedAt = &t
+	}
+	if pb.UpdatedAt != nil {
+		t := pb.UpdatedAt.AsTime()
+		result.UpdatedAt = &t
+	}
+
+	// Handle CreatedBy/UpdatedBy
+	if pb.CreatedBy != "" {
+		result.CreatedBy = &pb.CreatedBy
+	}
+	if pb.UpdatedBy != "" {
+		result.UpdatedBy = &pb.UpdatedBy
+	}
+
+	return result
+}
+
+// PbRubricTemplatesToModel converts array of protobuf RubricTemplates to GraphQL RubricTemplates
+func PbRubricTemplatesToModel(pbs []*council.RubricTemplate) []*model.RubricTemplate {
+	result := make([]*model.RubricTemplate, 0, len(pbs))
+	for _, pb := range pbs {
+		if pb != nil {
+			result = append(result, PbRubricTemplateToModel(pb))
+		}
+	}
+	return result
+}
+
+// ModelRubricCriteriaToPb converts GraphQL RubricCriterionInputs to protobuf
+func ModelRubricCriteriaToPb(inputs []*model.RubricCriterionInput) []*council.RubricCriterionInput {
+	result := make([]*council.RubricCriterionInput, 0, len(inputs))
+	for _, input := range inputs {
+		criterion := &council.RubricCriterionInput{
+			Name:     input.Name,
+			MaxScore: input.MaxScore,
+			Weight:   input.Weight,
+		}
+		if input.Description != nil {
+			criterion.Description = *input.Description
+		}
+		result = append(result, criterion)
+	}
+	return result
+}
+
+func PbGradeDefenceAmendmentStatusToModel(pb council.GradeDefenceAmendmentStatus) model.GradeAmendmentStatus {
+	switch pb {
+	case council.GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_APPROVED:
+		return model.GradeAmendmentStatusApproved
+	case council.GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_REJECTED:
+		return model.GradeAmendmentStatusRejected
+	default:
+		return model.GradeAmendmentStatusPending
+	}
+}
+
+func ModelGradeAmendmentStatusToDefencePb(status model.GradeAmendmentStatus) council.GradeDefenceAmendmentStatus {
+	switch status {
+	case model.GradeAmendmentStatusApproved:
+		return council.GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_APPROVED
+	case model.GradeAmendmentStatusRejected:
+		return council.GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_REJECTED
+	default:
+		return council.GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_PENDING
+	}
+}
+
+// PbGradeDefenceAmendmentToModel converts protobuf GradeDefenceAmendment to a
+// GraphQL GradeAmendment targeting DEFENCE_CRITERION
+func PbGradeDefenceAmendmentToModel(pb *council.GradeDefenceAmendment) *model.GradeAmendment {
+	if pb == nil {
+		return nil
+	}
+
+	result := &model.GradeAmendment{
+		ID:             pb.Id,
+		Target:         model.GradeAmendmentTargetDefenceCriterion,
+		TargetID:       pb.CriterionCode,
+		EnrollmentCode: pb.EnrollmentCode,
+		CouncilCode:    &pb.CouncilCode,
+		OldValue:       pb.OldScore,
+		NewValue:       pb.NewScore,
+		Reason:         pb.Reason,
+		Status:         PbGradeDefenceAmendmentStatusToModel(pb.Status),
+		RequestedBy:    pb.RequestedBy,
+	}
+	if pb.DecidedBy != "" {
+		result.DecidedBy = &pb.DecidedBy
+	}
+	if pb.DecidedAt != nil {
+		t := pb.DecidedAt.AsTime()
+		result.DecidedAt = &t
+	}
+	if pb.DecisionNote != "" {
+		result.DecisionNote = &pb.DecisionNote
+	}
+
+	// Handle timestamps
+	if pb.CreatedAt != nil {
+		t := pb.CreatedAt.AsTime()
+		result.CreatedAt = &t
+	}
+	if pb.UpdatedAt != nil {
+		t := pb.UpdatedAt.AsTime()
+		result.UpdatedAt = &t
+	}
+	return result
+}
+
+// PbGradeDefenceAmendmentsToModel converts array of protobuf GradeDefenceAmendments to GraphQL GradeAmendments
+func PbGradeDefenceAmendmentsToModel(pbs []*council.GradeDefenceAmendment) []*model.GradeAmendment {
+	result := make([]*model.GradeAmendment, 0, len(pbs))
+	for _, pb := range pbs {
+		if pb != nil {
+			result = append(result, PbGradeDefenceAmendmentToModel(pb))
+		}
+	}
+	return result
+}
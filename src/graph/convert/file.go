@@ -12,11 +12,15 @@ func PbFileToModel(pb *pbFile.File) *model.File {
 	}
 
 	result := &model.File{
-		ID:      pb.Id,
-		Title:   pb.Title,
-		Status:  PbFileStatusToModel(pb.Status),
-		Table:   PbTableTypeToModel(pb.Table),
-		TableID: pb.TableId,
+		ID:          pb.Id,
+		Title:       pb.Title,
+		Status:      PbFileStatusToModel(pb.Status),
+		Table:       PbTableTypeToModel(pb.Table),
+		TableID:     pb.TableId,
+		Version:     pb.Version,
+		Late:        pb.Late,
+		Quarantined: pb.Quarantined,
+		Revision:    pb.Revision,
 	}
 
 	// Handle optional File field
@@ -29,6 +33,21 @@ func PbFileToModel(pb *pbFile.File) *model.File {
 		result.Option = &pb.Option
 	}
 
+	// Handle optional version metadata
+	if pb.Size != 0 {
+		size := int(pb.Size)
+		result.Size = &size
+	}
+	if pb.Checksum != "" {
+		result.Checksum = &pb.Checksum
+	}
+	if pb.ContentType != "" {
+		result.ContentType = &pb.ContentType
+	}
+	if pb.ScanResult != "" {
+		result.ScanResult = &pb.ScanResult
+	}
+
 	// Handle timestamps
 	if pb.CreatedAt != nil {
 		t := pb.CreatedAt.AsTime()
@@ -75,11 +94,35 @@ func PbTableTypeToModel(pb pbFile.TableType) model.FileTable {
 		return model.FileTableFinal
 	case pbFile.TableType_ORDER:
 		return model.FileTableOrder
+	case pbFile.TableType_GRADE_APPEAL:
+		return model.FileTableGradeAppeal
+	case pbFile.TableType_MILESTONE_CHECKIN:
+		return model.FileTableMilestoneCheckin
 	default:
 		return model.FileTableTopic
 	}
 }
 
+// ModelTableTypeToPb converts GraphQL FileTable enum to protobuf TableType enum
+func ModelTableTypeToPb(table model.FileTable) pbFile.TableType {
+	switch table {
+	case model.FileTableTopic:
+		return pbFile.TableType_TOPIC
+	case model.FileTableMidterm:
+		return pbFile.TableType_MIDTERM
+	case model.FileTableFinal:
+		return pbFile.TableType_FINAL
+	case model.FileTableOrder:
+		return pbFile.TableType_ORDER
+	case model.FileTableGradeAppeal:
+		return pbFile.TableType_GRADE_APPEAL
+	case model.FileTableMilestoneCheckin:
+		return pbFile.TableType_MILESTONE_CHECKIN
+	default:
+		return pbFile.TableType_TOPIC
+	}
+}
+
 // PbFilesToModel converts array of protobuf Files to GraphQL Files
 func PbFilesToModel(pbs []*pbFile.File) []*model.File {
 	if pbs == nil {
@@ -95,6 +138,46 @@ func PbFilesToModel(pbs []*pbFile.File) []*model.File {
 	return result
 }
 
+// PbSimilarityReportToModel converts a protobuf similarity report to GraphQL
+func PbSimilarityReportToModel(pb *pbFile.GetSimilarityReportResponse) *model.SimilarityReport {
+	if pb == nil {
+		return nil
+	}
+
+	result := &model.SimilarityReport{
+		Indexed:   pb.Indexed,
+		WordCount: pb.WordCount,
+		Matches:   make([]*model.SimilarityMatch, 0, len(pb.Matches)),
+	}
+	if pb.IndexedAt != nil {
+		t := pb.IndexedAt.AsTime()
+		result.IndexedAt = &t
+	}
+
+	for _, match := range pb.Matches {
+		if match == nil || match.File == nil {
+			continue
+		}
+		m := &model.SimilarityMatch{
+			File:           PbFileToModel(match.File),
+			SemesterCode:   match.SemesterCode,
+			Similarity:     match.Similarity,
+			OverlapPercent: match.OverlapPercent,
+			Passages:       make([]*model.MatchedPassage, 0, len(match.Passages)),
+		}
+		for _, p := range match.Passages {
+			m.Passages = append(m.Passages, &model.MatchedPassage{
+				Text:         p.Text,
+				Offset:       p.Offset,
+				SourceOffset: p.SourceOffset,
+				Words:        p.Words,
+			})
+		}
+		result.Matches = append(result.Matches, m)
+	}
+	return result
+}
+
 // ============================================
 // LIST RESPONSE FACTORY FUNCTIONS
 // ============================================
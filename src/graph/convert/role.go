@@ -17,6 +17,7 @@ func PbRoleSystemToModel(pb *role.RoleSystem) *model.RoleSystem {
 		Role:         PbRoleTypeToModel(pb.Role),
 		SemesterCode: pb.SemesterCode,
 		Activate:     pb.Activate,
+		Version:      pb.Version,
 	}
 
 	// Handle optional TeacherCode
@@ -0,0 +1,45 @@
+package convert
+
+import (
+	pbCommon "thaily/proto/common"
+	"thaily/src/graph/model"
+)
+
+// PbSearchHitToModel converts a protobuf SearchHit to GraphQL SearchHit
+func PbSearchHitToModel(pb *pbCommon.SearchHit) *model.SearchHit {
+	if pb == nil {
+		return nil
+	}
+
+	result := &model.SearchHit{
+		Type:  searchTypeFromPb(pb.Type),
+		ID:    pb.Id,
+		Title: pb.Title,
+		Score: pb.Score,
+	}
+	if pb.Subtitle != "" {
+		result.Subtitle = &pb.Subtitle
+	}
+	return result
+}
+
+func PbSearchHitsToModel(pbs []*pbCommon.SearchHit) []*model.SearchHit {
+	result := make([]*model.SearchHit, 0, len(pbs))
+	for _, pb := range pbs {
+		result = append(result, PbSearchHitToModel(pb))
+	}
+	return result
+}
+
+func searchTypeFromPb(t string) model.SearchType {
+	switch t {
+	case "student":
+		return model.SearchTypeStudent
+	case "teacher":
+		return model.SearchTypeTeacher
+	case "file":
+		return model.SearchTypeFile
+	default:
+		return model.SearchTypeTopic
+	}
+}
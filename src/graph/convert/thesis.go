@@ -13,9 +13,10 @@ func PbMidtermToModel(pb *thesis.Midterm) *model.Midterm {
 	}
 
 	result := &model.Midterm{
-		ID:     pb.Id,
-		Title:  pb.Title,
-		Status: PbMidtermStatusToModel(pb.Status),
+		ID:      pb.Id,
+		Title:   pb.Title,
+		Status:  PbMidtermStatusToModel(pb.Status),
+		Version: pb.Version,
 	}
 
 	// Handle optional Grade
@@ -56,21 +57,16 @@ func PbFinalToModel(pb *thesis.Final) *model.Final {
 	}
 
 	result := &model.Final{
-		ID:     pb.Id,
-		Title:  pb.Title,
-		Status: PbFinalStatusToModel(pb.Status),
+		ID:      pb.Id,
+		Title:   pb.Title,
+		Status:  PbFinalStatusToModel(pb.Status),
+		Version: pb.Version,
 	}
 
-	// Handle optional grades
-	if pb.SupervisorGrade != 0 {
-		result.SupervisorGrade = &pb.SupervisorGrade
-	}
-	if pb.DepartmentGrade != 0 {
-		result.DepartmentGrade = &pb.DepartmentGrade
-	}
-	if pb.FinalGrade != 0 {
-		result.FinalGrade = &pb.FinalGrade
-	}
+	// Grades stay nil until given; FinalGrade is set by ComputeFinalGrade
+	result.SupervisorGrade = pb.SupervisorGrade
+	result.DepartmentGrade = pb.DepartmentGrade
+	result.FinalGrade = pb.FinalGrade
 
 	// Handle optional Notes
 	if pb.Notes != "" {
@@ -109,11 +105,14 @@ func PbTopicToModel(pb *thesis.Topic) *model.Topic {
 	}
 
 	result := &model.Topic{
-		ID:           pb.Id,
-		Title:        pb.Title,
-		MajorCode:    pb.MajorCode,
-		SemesterCode: pb.SemesterCode,
-		Status:       PbTopicStatusToModel(pb.Status),
+		ID:             pb.Id,
+		Title:          pb.Title,
+		MajorCode:      pb.MajorCode,
+		SemesterCode:   pb.SemesterCode,
+		Status:         PbTopicStatusToModel(pb.Status),
+		MaxStudents:    pb.MaxStudents,
+		RequiredSkills: nonNilStrings(pb.RequiredSkills),
+		Version:        pb.Version,
 	}
 
 	// Handle optional PercentStage fields
@@ -141,6 +140,11 @@ func PbTopicToModel(pb *thesis.Topic) *model.Topic {
 	if pb.UpdatedBy != "" {
 		result.UpdatedBy = &pb.UpdatedBy
 	}
+	if pb.DeletedAt != nil {
+		t := pb.DeletedAt.AsTime()
+		result.DeletedAt = &t
+		result.DeletedBy = &pb.DeletedBy
+	}
 
 	return result
 }
@@ -267,6 +271,7 @@ func PbEnrollmentToModel(pb *thesis.Enrollment) *model.Enrollment {
 		Title:            pb.Title,
 		StudentCode:      pb.StudentCode,
 		TopicCouncilCode: pb.TopicCouncilCode,
+		Version:          pb.Version,
 	}
 
 	// Handle optional codes
@@ -327,6 +332,7 @@ func PbTopicCouncilToModel(pb *thesis.TopicCouncil) *model.TopicCouncil {
 		Title:     pb.Title,
 		Stage:     PbTopicStageToModel(pb.Stage),
 		TopicCode: pb.TopicCode,
+		Version:   pb.Version,
 	}
 
 	// Handle optional CouncilCode
@@ -390,6 +396,7 @@ func PbTopicCouncilSupervisorToModel(pb *thesis.TopicCouncilSupervisor) *model.T
 		ID:                    pb.Id,
 		TeacherSupervisorCode: pb.TeacherSupervisorCode,
 		TopicCouncilCode:      pb.TopicCouncilCode,
+		Version:               pb.Version,
 	}
 
 	// Handle timestamps
@@ -439,6 +446,7 @@ func PbGradeReviewToModel(pb *thesis.GradeReview) *model.GradeReview {
 		Title:       pb.Title,
 		TeacherCode: pb.TeacherCode,
 		Status:      PbFinalStatusToModel(pb.Status),
+		Version:     pb.Version,
 	}
 
 	// Handle optional ReviewGrade
@@ -586,11 +594,13 @@ func PbTopicToStudentTopic(pb *thesis.Topic) *model.StudentTopic {
 	}
 
 	result := &model.StudentTopic{
-		ID:           pb.Id,
-		Title:        pb.Title,
-		MajorCode:    pb.MajorCode,
-		SemesterCode: pb.SemesterCode,
-		Status:       PbTopicStatusToModel(pb.Status),
+		ID:             pb.Id,
+		Title:          pb.Title,
+		MajorCode:      pb.MajorCode,
+		SemesterCode:   pb.SemesterCode,
+		Status:         PbTopicStatusToModel(pb.Status),
+		MaxStudents:    pb.MaxStudents,
+		RequiredSkills: nonNilStrings(pb.RequiredSkills),
 	}
 
 	// Handle optional PercentStage fields
@@ -754,11 +764,13 @@ func PbTopicToSupervisorTopic(pb *thesis.Topic) *model.SupervisorTopic {
 	}
 
 	result := &model.SupervisorTopic{
-		ID:           pb.Id,
-		Title:        pb.Title,
-		MajorCode:    pb.MajorCode,
-		SemesterCode: pb.SemesterCode,
-		Status:       PbTopicStatusToModel(pb.Status),
+		ID:             pb.Id,
+		Title:          pb.Title,
+		MajorCode:      pb.MajorCode,
+		SemesterCode:   pb.SemesterCode,
+		Status:         PbTopicStatusToModel(pb.Status),
+		MaxStudents:    pb.MaxStudents,
+		RequiredSkills: nonNilStrings(pb.RequiredSkills),
 	}
 
 	// Handle optional PercentStage fields
@@ -1041,3 +1053,889 @@ func CreateReviewerGradeReviewListResponse(gradeReviews []*model.ReviewerGradeRe
 		Total: total,
 	}
 }
+
+// ============================================
+// SUBMISSION DEADLINE CONVERTERS
+// ============================================
+
+// PbSubmissionKindToModel converts protobuf SubmissionKind to GraphQL SubmissionKind
+func PbSubmissionKindToModel(pb thesis.SubmissionKind) model.SubmissionKind {
+	switch pb {
+	case thesis.SubmissionKind_SUBMISSION_MIDTERM:
+		return model.SubmissionKindMidterm
+	case thesis.SubmissionKind_SUBMISSION_FINAL:
+		return model.SubmissionKindFinal
+	default:
+		return model.SubmissionKindMidterm
+	}
+}
+
+// ModelSubmissionKindToPb converts GraphQL SubmissionKind to protobuf SubmissionKind
+func ModelSubmissionKindToPb(kind model.SubmissionKind) thesis.SubmissionKind {
+	switch kind {
+	case model.SubmissionKindFinal:
+		return thesis.SubmissionKind_SUBMISSION_FINAL
+	default:
+		return thesis.SubmissionKind_SUBMISSION_MIDTERM
+	}
+}
+
+// ModelTopicStageToPb converts GraphQL TopicStage to protobuf TopicStage
+func ModelTopicStageToPb(stage model.TopicStage) thesis.TopicStage {
+	switch stage {
+	case model.TopicStageStageLvtn:
+		return thesis.TopicStage_STAGE_LVTN
+	default:
+		return thesis.TopicStage_STAGE_DACN
+	}
+}
+
+// PbSubmissionDeadlineToModel converts protobuf SubmissionDeadline to GraphQL SubmissionDeadline
+func PbSubmissionDeadlineToModel(pb *thesis.SubmissionDeadline) *model.SubmissionDeadline {
+	if pb == nil {
+		return nil
+	}
+
+	result := &model.SubmissionDeadline{
+		ID:           pb.Id,
+		SemesterCode: pb.SemesterCode,
+		Stage:        PbTopicStageToModel(pb.Stage),
+		Kind:         PbSubmissionKindToModel(pb.Kind),
+		GraceMinutes: pb.GraceMinutes,
+	}
+
+	if pb.OpensAt != nil {
+		result.OpensAt = pb.OpensAt.AsTime()
+	}
+	if pb.DueAt != nil {
+		result.DueAt = pb.DueAt.AsTime()
+	}
+	if pb.CreatedAt != nil {
+		t := pb.CreatedAt.AsTime()
+		result.CreatedAt = &t
+	}
+	if pb.UpdatedAt != nil {
+		t := pb.UpdatedAt.AsTime()
+		result.UpdatedAt = &t
+	}
+	if pb.CreatedBy != "" {
+		result.CreatedBy = &pb.CreatedBy
+	}
+	if pb.UpdatedBy != "" {
+		result.UpdatedBy = &pb.UpdatedBy
+	}
+
+	return result
+}
+
+// PbSubmissionDeadlinesToModel converts array of protobuf SubmissionDeadlines to GraphQL SubmissionDeadlines
+func PbSubmissionDeadlinesToModel(pbs []*thesis.SubmissionDeadline) []*model.SubmissionDeadline {
+	result := make([]*model.SubmissionDeadline, 0, len(pbs))
+	for _, pb := range pbs {
+		if pb != nil {
+			result = append(result, PbSubmissionDeadlineToModel(pb))
+		}
+	}
+	return result
+}
+
+// PbDeadlineExtensionToModel converts protobuf DeadlineExtension to GraphQL DeadlineExtension
+func PbDeadlineExtensionToModel(pb *thesis.DeadlineExtension) *model.DeadlineExtension {
+	if pb == nil {
+		return nil
+	}
+
+	result := &model.DeadlineExtension{
+		ID:           pb.Id,
+		SemesterCode: pb.SemesterCode,
+		Stage:        PbTopicStageToModel(pb.Stage),
+		Kind:         PbSubmissionKindToModel(pb.Kind),
+		StudentCode:  pb.StudentCode,
+	}
+
+	if pb.DueAt != nil {
+		result.DueAt = pb.DueAt.AsTime()
+	}
+	if pb.Reason != "" {
+		result.Reason = &pb.Reason
+	}
+	if pb.CreatedAt != nil {
+		t := pb.CreatedAt.AsTime()
+		result.CreatedAt = &t
+	}
+	if pb.UpdatedAt != nil {
+		t := pb.UpdatedAt.AsTime()
+		result.UpdatedAt = &t
+	}
+	if pb.CreatedBy != "" {
+		result.CreatedBy = &pb.CreatedBy
+	}
+	if pb.UpdatedBy != "" {
+		result.UpdatedBy = &pb.UpdatedBy
+	}
+
+	return result
+}
+
+// PbSubmissionWindowToModel converts protobuf CheckSubmissionWindowResponse to GraphQL SubmissionWindow
+func PbSubmissionWindowToModel(pb *thesis.CheckSubmissionWindowResponse) *model.SubmissionWindow {
+	if pb == nil {
+		return nil
+	}
+
+	result := &model.SubmissionWindow{
+		Allowed:  pb.Allowed,
+		Late:     pb.Late,
+		Extended: pb.Extended,
+		Stage:    PbTopicStageToModel(pb.Stage),
+	}
+
+	if pb.OpensAt != nil {
+		t := pb.OpensAt.AsTime()
+		result.OpensAt = &t
+	}
+	if pb.DueAt != nil {
+		t := pb.DueAt.AsTime()
+		result.DueAt = &t
+	}
+	if pb.ClosesAt != nil {
+		t := pb.ClosesAt.AsTime()
+		result.ClosesAt = &t
+	}
+	if pb.Reason != "" {
+		result.Reason = &pb.Reason
+	}
+
+	return result
+}
+
+// PbTopicStatusHistoryToModel converts a protobuf topic lifecycle entry to GraphQL
+func PbTopicStatusHistoryToModel(pb *thesis.TopicStatusHistory) *model.TopicStatusHistory {
+	if pb == nil {
+		return nil
+	}
+
+	result := &model.TopicStatusHistory{
+		ID:        pb.Id,
+		TopicCode: pb.TopicCode,
+		ToStatus:  PbTopicStatusToModel(pb.ToStatus),
+		CreatedBy: pb.CreatedBy,
+	}
+	if pb.FromStatus != nil {
+		from := PbTopicStatusToModel(*pb.FromStatus)
+		result.FromStatus = &from
+	}
+	if pb.Reason != "" {
+		result.Reason = &pb.Reason
+	}
+	if pb.CreatedAt != nil {
+		result.CreatedAt = pb.CreatedAt.AsTime()
+	}
+	return result
+}
+
+// PbTopicStatusHistoriesToModel converts a topic's lifecycle entries to GraphQL
+func PbTopicStatusHistoriesToModel(pbs []*thesis.TopicStatusHistory) []*model.TopicStatusHistory {
+	result := make([]*model.TopicStatusHistory, 0, len(pbs))
+	for _, pb := range pbs {
+		if pb != nil {
+			result = append(result, PbTopicStatusHistoryToModel(pb))
+		}
+	}
+	return result
+}
+
+// nonNilStrings keeps non-null GraphQL lists from resolving to null
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// PbCoSignStatusToModel converts protobuf CoSignStatus to GraphQL CoSignStatus
+func PbCoSignStatusToModel(pb thesis.CoSignStatus) model.CoSignStatus {
+	switch pb {
+	case thesis.CoSignStatus_COSIGN_SIGNED:
+		return model.CoSignStatusCosignSigned
+	case thesis.CoSignStatus_COSIGN_DECLINED:
+		return model.CoSignStatusCosignDeclined
+	default:
+		return model.CoSignStatusCosignPending
+	}
+}
+
+// PbRegistrationStatusToModel converts protobuf RegistrationStatus to GraphQL RegistrationStatus
+func PbRegistrationStatusToModel(pb thesis.RegistrationStatus) model.RegistrationStatus {
+	switch pb {
+	case thesis.RegistrationStatus_REGISTRATION_ACCEPTED:
+		return model.RegistrationStatusRegistrationAccepted
+	case thesis.RegistrationStatus_REGISTRATION_DECLINED:
+		return model.RegistrationStatusRegistrationDeclined
+	case thesis.RegistrationStatus_REGISTRATION_WITHDRAWN:
+		return model.RegistrationStatusRegistrationWithdrawn
+	default:
+		return model.RegistrationStatusRegistrationPending
+	}
+}
+
+// PbTopicCoSignToModel converts protobuf TopicCoSign to GraphQL TopicCoSign
+func PbTopicCoSignToModel(pb *thesis.TopicCoSign) *model.TopicCoSign {
+	if pb == nil {
+		return nil
+	}
+
+	result := &model.TopicCoSign{
+		ID:          pb.Id,
+		TopicCode:   pb.TopicCode,
+		TeacherCode: pb.TeacherCode,
+		Status:      PbCoSignStatusToModel(pb.Status),
+	}
+	if pb.CreatedAt != nil {
+		t := pb.CreatedAt.AsTime()
+		result.CreatedAt = &t
+	}
+	if pb.UpdatedAt != nil {
+		t := pb.UpdatedAt.AsTime()
+		result.UpdatedAt = &t
+	}
+	return result
+}
+
+// PbTopicCoSignsToModel converts array of protobuf TopicCoSigns to GraphQL TopicCoSigns
+func PbTopicCoSignsToModel(pbs []*thesis.TopicCoSign) []*model.TopicCoSign {
+	result := make([]*model.TopicCoSign, 0, len(pbs))
+	for _, pb := range pbs {
+		if pb != nil {
+			result = append(result, PbTopicCoSignToModel(pb))
+		}
+	}
+	return result
+}
+
+// PbRegistrationWindowToModel converts protobuf RegistrationWindow to GraphQL RegistrationWindow
+func PbRegistrationWindowToModel(pb *thesis.RegistrationWindow) *model.RegistrationWindow {
+	if pb == nil {
+		return nil
+	}
+
+	result := &model.RegistrationWindow{
+		ID:           pb.Id,
+		SemesterCode: pb.SemesterCode,
+		MaxChoices:   pb.MaxChoices,
+	}
+	if pb.OpensAt != nil {
+		result.OpensAt = pb.OpensAt.AsTime()
+	}
+	if pb.ClosesAt != nil {
+		result.ClosesAt = pb.ClosesAt.AsTime()
+	}
+	if pb.CreatedAt != nil {
+		t := pb.CreatedAt.AsTime()
+		result.CreatedAt = &t
+	}
+	if pb.UpdatedAt != nil {
+		t := pb.UpdatedAt.AsTime()
+		result.UpdatedAt = &t
+	}
+	if pb.CreatedBy != "" {
+		result.CreatedBy = &pb.CreatedBy
+	}
+	if pb.UpdatedBy != "" {
+		result.UpdatedBy = &pb.UpdatedBy
+	}
+	return result
+}
+
+// PbTopicRegistrationToModel converts protobuf TopicRegistration to GraphQL TopicRegistration
+func PbTopicRegistrationToModel(pb *thesis.TopicRegistration) *model.TopicRegistration {
+	if pb == nil {
+		return nil
+	}
+
+	result := &model.TopicRegistration{
+		ID:           pb.Id,
+		TopicCode:    pb.TopicCode,
+		StudentCode:  pb.StudentCode,
+		SemesterCode: pb.SemesterCode,
+		Rank:         pb.Rank,
+		Status:       PbRegistrationStatusToModel(pb.Status),
+	}
+	if pb.Reason != "" {
+		result.Reason = &pb.Reason
+	}
+	if pb.DecidedBy != "" {
+		result.DecidedBy = &pb.DecidedBy
+	}
+	if pb.DecidedAt != nil {
+		t := pb.DecidedAt.AsTime()
+		result.DecidedAt = &t
+	}
+	if pb.EnrollmentCode != "" {
+		result.EnrollmentCode = &pb.EnrollmentCode
+	}
+	if pb.CreatedAt != nil {
+		t := pb.CreatedAt.AsTime()
+		result.CreatedAt = &t
+	}
+	if pb.UpdatedAt != nil {
+		t := pb.UpdatedAt.AsTime()
+		result.UpdatedAt = &t
+	}
+	return result
+}
+
+// PbTopicRegistrationsToModel converts array of protobuf TopicRegistrations to GraphQL TopicRegistrations
+func PbTopicRegistrationsToModel(pbs []*thesis.TopicRegistration) []*model.TopicRegistration {
+	result := make([]*model.TopicRegistration, 0, len(pbs))
+	for _, pb := range pbs {
+		if pb != nil {
+			result = append(result, PbTopicRegistrationToModel(pb))
+		}
+	}
+	return result
+}
+
+// PbMatchRejectionReasonToModel converts protobuf MatchRejectionReason to GraphQL MatchRejectionReason
+func PbMatchRejectionReasonToModel(pb thesis.MatchRejectionReason) model.MatchRejectionReason {
+	switch pb {
+	case thesis.MatchRejectionReason_MATCH_NOT_ACCEPTABLE:
+		return model.MatchRejectionReasonMatchNotAcceptable
+	case thesis.MatchRejectionReason_MATCH_NO_CAPACITY:
+		return model.MatchRejectionReasonMatchNoCapacity
+	case thesis.MatchRejectionReason_MATCH_TOPIC_CLOSED:
+		return model.MatchRejectionReasonMatchTopicClosed
+	default:
+		return model.MatchRejectionReasonMatchOutranked
+	}
+}
+
+// PbTopicMatchingResultToModel converts a protobuf matching run to GraphQL
+func PbTopicMatchingResultToModel(pb *thesis.TopicMatchingResult) *model.TopicMatchingResult {
+	if pb == nil {
+		return nil
+	}
+
+	result := &model.TopicMatchingResult{
+		SemesterCode:  pb.SemesterCode,
+		StudentsTotal: pb.StudentsTotal,
+		MatchedTotal:  pb.MatchedTotal,
+		Assignments:   make([]*model.MatchAssignment, 0, len(pb.Assignments)),
+		Unmatched:     make([]*model.UnmatchedStudent, 0, len(pb.Unmatched)),
+	}
+	for _, a := range pb.Assignments {
+		assignment := &model.MatchAssignment{
+			StudentCode:      a.StudentCode,
+			TopicCode:        a.TopicCode,
+			TopicCouncilCode: a.TopicCouncilCode,
+			Choice:           a.Choice,
+		}
+		if a.EnrollmentCode != "" {
+			assignment.EnrollmentCode = &a.EnrollmentCode
+		}
+		result.Assignments = append(result.Assignments, assignment)
+	}
+	for _, u := range pb.Unmatched {
+		unmatched := &model.UnmatchedStudent{
+			StudentCode: u.StudentCode,
+			Rejections:  make([]*model.MatchRejection, 0, len(u.Rejections)),
+		}
+		for _, r := range u.Rejections {
+			unmatched.Rejections = append(unmatched.Rejections, &model.MatchRejection{
+				TopicCode: r.TopicCode,
+				Choice:    r.Choice,
+				Reason:    PbMatchRejectionReasonToModel(r.Reason),
+			})
+		}
+		result.Unmatched = append(result.Unmatched, unmatched)
+	}
+	return result
+}
+
+// PbGradeRoundingToModel converts protobuf GradeRounding to GraphQL GradeRounding
+func PbGradeRoundingToModel(pb thesis.GradeRounding) model.GradeRounding {
+	switch pb {
+	case thesis.GradeRounding_ROUND_DOWN:
+		return model.GradeRoundingDown
+	case thesis.GradeRounding_ROUND_UP:
+		return model.GradeRoundingUp
+	default:
+		return model.GradeRoundingHalfUp
+	}
+}
+
+// ModelGradeRoundingToPb converts GraphQL GradeRounding to protobuf GradeRounding
+func ModelGradeRoundingToPb(rounding model.GradeRounding) thesis.GradeRounding {
+	switch rounding {
+	case model.GradeRoundingDown:
+		return thesis.GradeRounding_ROUND_DOWN
+	case model.GradeRoundingUp:
+		return thesis.GradeRounding_ROUND_UP
+	default:
+		return thesis.GradeRounding_ROUND_HALF_UP
+	}
+}
+
+// PbGradingPolicyToModel converts protobuf GradingPolicy to GraphQL GradingPolicy
+func PbGradingPolicyToModel(pb *thesis.GradingPolicy) *model.GradingPolicy {
+	if pb == nil {
+		return nil
+	}
+
+	result := &model.GradingPolicy{
+		ID:               pb.Id,
+		MajorCode:        pb.MajorCode,
+		SemesterCode:     pb.SemesterCode,
+		Stage:            PbTopicStageToModel(pb.Stage),
+		SupervisorWeight: pb.SupervisorWeight,
+		ReviewerWeight:   pb.ReviewerWeight,
+		CouncilWeight:    pb.CouncilWeight,
+		Rounding:         PbGradeRoundingToModel(pb.Rounding),
+		RoundingStep:     pb.RoundingStep,
+		PassThreshold:    pb.PassThreshold,
+		MaxCouncilSpread: pb.MaxCouncilSpread,
+	}
+
+	// Handle timestamps
+	if pb.CreatedAt != nil {
+		t := pb.CreatedAt.AsTime()
+		result.CreatedAt = &t
+	}
+	if pb.UpdatedAt != nil {
+		t := pb.UpdatedAt.AsTime()
+		result.UpdatedAt = &t
+	}
+
+	// Handle CreatedBy/UpdatedBy
+	if pb.CreatedBy != "" {
+		result.CreatedBy = &pb.CreatedBy
+	}
+	if pb.UpdatedBy != "" {
+		result.UpdatedBy = &pb.UpdatedBy
+	}
+
+	return result
+}
+
+// PbGradingPoliciesToModel converts array of protobuf GradingPolicies to GraphQL GradingPolicies
+func PbGradingPoliciesToModel(pbs []*thesis.GradingPolicy) []*model.GradingPolicy {
+	result := make([]*model.GradingPolicy, 0, len(pbs))
+	for _, pb := range pbs {
+		if pb != nil {
+			result = append(result, PbGradingPolicyToModel(pb))
+		}
+	}
+	return result
+}
+
+// PbComputeFinalGradeToModel converts a ComputeFinalGrade response to GraphQL FinalGradeBreakdown
+func PbComputeFinalGradeToModel(enrollmentCode string, pb *thesis.ComputeFinalGradeResponse) *model.FinalGradeBreakdown {
+	breakdown := pb.GetBreakdown()
+	result := &model.FinalGradeBreakdown{
+		EnrollmentCode:      enrollmentCode,
+		PolicyCode:          breakdown.GetPolicyCode(),
+		Components:          make([]*model.GradeComponent, 0, len(breakdown.GetComponents())),
+		CouncilSpread:       breakdown.CouncilSpread,
+		NeedsReconciliation: breakdown.GetNeedsReconciliation(),
+		Missing:             breakdown.GetMissing(),
+		RawGrade:            breakdown.RawGrade,
+		FinalGrade:          breakdown.FinalGrade,
+		Status:              PbFinalStatusToModel(breakdown.GetStatus()),
+		Steps:               breakdown.GetSteps(),
+		Finalized:           pb.GetFinalized(),
+		Final:               PbFinalToModel(pb.GetFinal()),
+	}
+	for _, c := range breakdown.GetComponents() {
+		result.Components = append(result.Components, &model.GradeComponent{
+			Name:   c.Name,
+			Score:  c.Score,
+			Weight: c.Weight,
+		})
+	}
+	if result.Missing == nil {
+		result.Missing = []string{}
+	}
+	if result.Steps == nil {
+		result.Steps = []string{}
+	}
+	return result
+}
+
+// PbSemesterGradeStatusToModel converts protobuf SemesterGradeStatus to GraphQL SemesterGradeStatus
+func PbSemesterGradeStatusToModel(pb *thesis.SemesterGradeStatus) *model.SemesterGradeStatus {
+	if pb == nil {
+		return nil
+	}
+
+	result := &model.SemesterGradeStatus{
+		SemesterCode: pb.SemesterCode,
+		Locked:       pb.Locked,
+		Published:    pb.Published,
+	}
+	if pb.LockedAt != nil {
+		t := pb.LockedAt.AsTime()
+		result.LockedAt = &t
+	}
+	if pb.LockedBy != "" {
+		result.LockedBy = &pb.LockedBy
+	}
+	if pb.PublishedAt != nil {
+		t := pb.PublishedAt.AsTime()
+		result.PublishedAt = &t
+	}
+	if pb.PublishedBy != "" {
+		result.PublishedBy = &pb.PublishedBy
+	}
+	result.AppealWindowDays = pb.AppealWindowDays
+	if pb.AppealDeadline != nil {
+		t := pb.AppealDeadline.AsTime()
+		result.AppealDeadline = &t
+	}
+	return result
+}
+
+func PbGradeAmendmentTargetToModel(pb thesis.GradeAmendmentTarget) model.GradeAmendmentTarget {
+	switch pb {
+	case thesis.GradeAmendmentTarget_AMEND_REVIEW_GRADE:
+		return model.GradeAmendmentTargetReviewGrade
+	case thesis.GradeAmendmentTarget_AMEND_MIDTERM_GRADE:
+		return model.GradeAmendmentTargetMidtermGrade
+	case thesis.GradeAmendmentTarget_AMEND_FINAL_GRADE:
+		return model.GradeAmendmentTargetFinalGrade
+	default:
+		return model.GradeAmendmentTargetSupervisorGrade
+	}
+}
+
+// ModelGradeAmendmentTargetToPb maps a thesis-side target; DEFENCE_CRITERION
+// belongs to the council service and is not accepted
+func ModelGradeAmendmentTargetToPb(target model.GradeAmendmentTarget) (thesis.GradeAmendmentTarget, bool) {
+	switch target {
+	case model.GradeAmendmentTargetSupervisorGrade:
+		return thesis.GradeAmendmentTarget_AMEND_SUPERVISOR_GRADE, true
+	case model.GradeAmendmentTargetReviewGrade:
+		return thesis.GradeAmendmentTarget_AMEND_REVIEW_GRADE, true
+	case model.GradeAmendmentTargetMidtermGrade:
+		return thesis.GradeAmendmentTarget_AMEND_MIDTERM_GRADE, true
+	case model.GradeAmendmentTargetFinalGrade:
+		return thesis.GradeAmendmentTarget_AMEND_FINAL_GRADE, true
+	default:
+		return 0, false
+	}
+}
+
+func PbGradeAmendmentStatusToModel(pb thesis.GradeAmendmentStatus) model.GradeAmendmentStatus {
+	switch pb {
+	case thesis.GradeAmendmentStatus_AMENDMENT_APPROVED:
+		return model.GradeAmendmentStatusApproved
+	case thesis.GradeAmendmentStatus_AMENDMENT_REJECTED:
+		return model.GradeAmendmentStatusRejected
+	default:
+		return model.GradeAmendmentStatusPending
+	}
+}
+
+func ModelGradeAmendmentStatusToPb(status model.GradeAmendmentStatus) thesis.GradeAmendmentStatus {
+	switch status {
+	case model.GradeAmendmentStatusApproved:
+		return thesis.GradeAmendmentStatus_AMENDMENT_APPROVED
+	case model.GradeAmendmentStatusRejected:
+		return thesis.GradeAmendmentStatus_AMENDMENT_REJECTED
+	default:
+		return thesis.GradeAmendmentStatus_AMENDMENT_PENDING
+	}
+}
+
+// PbGradeAmendmentToModel converts protobuf GradeAmendment to GraphQL GradeAmendment
+func PbGradeAmendmentToModel(pb *thesis.GradeAmendment) *model.GradeAmendment {
+	if pb == nil {
+		return nil
+	}
+
+	result := &model.GradeAmendment{
+		ID:             pb.Id,
+		Target:         PbGradeAmendmentTargetToModel(pb.Target),
+		TargetID:       pb.TargetCode,
+		EnrollmentCode: pb.EnrollmentCode,
+		OldValue:       pb.OldValue,
+		NewValue:       pb.NewValue,
+		Reason:         pb.Reason,
+		Status:         PbGradeAmendmentStatusToModel(pb.Status),
+		RequestedBy:    pb.RequestedBy,
+	}
+	if pb.SemesterCode != "" {
+		result.SemesterCode = &pb.SemesterCode
+	}
+	if pb.DecidedBy != "" {
+		result.DecidedBy = &pb.DecidedBy
+	}
+	if pb.DecidedAt != nil {
+		t := pb.DecidedAt.AsTime()
+		result.DecidedAt = &t
+	}
+	if pb.DecisionNote != "" {
+		result.DecisionNote = &pb.DecisionNote
+	}
+
+	// Handle timestamps
+	if pb.CreatedAt != nil {
+		t := pb.CreatedAt.AsTime()
+		result.CreatedAt = &t
+	}
+	if pb.UpdatedAt != nil {
+		t := pb.UpdatedAt.AsTime()
+		result.UpdatedAt = &t
+	}
+	return result
+}
+
+// PbGradeAmendmentsToModel converts array of protobuf GradeAmendments to GraphQL GradeAmendments
+func PbGradeAmendmentsToModel(pbs []*thesis.GradeAmendment) []*model.GradeAmendment {
+	result := make([]*model.GradeAmendment, 0, len(pbs))
+	for _, pb := range pbs {
+		if pb != nil {
+			result = append(result, PbGradeAmendmentToModel(pb))
+		}
+	}
+	return result
+}
+
+func PbGradeAppealComponentToModel(pb thesis.GradeAppealComponent) model.GradeAppealComponent {
+	switch pb {
+	case thesis.GradeAppealComponent_APPEAL_REVIEW_GRADE:
+		return model.GradeAppealComponentReviewGrade
+	case thesis.GradeAppealComponent_APPEAL_MIDTERM_GRADE:
+		return model.GradeAppealComponentMidtermGrade
+	case thesis.GradeAppealComponent_APPEAL_FINAL_GRADE:
+		return model.GradeAppealComponentFinalGrade
+	case thesis.GradeAppealComponent_APPEAL_DEFENCE:
+		return model.GradeAppealComponentDefence
+	default:
+		return model.GradeAppealComponentSupervisorGrade
+	}
+}
+
+func ModelGradeAppealComponentToPb(component model.GradeAppealComponent) thesis.GradeAppealComponent {
+	switch component {
+	case model.GradeAppealComponentReviewGrade:
+		return thesis.GradeAppealComponent_APPEAL_REVIEW_GRADE
+	case model.GradeAppealComponentMidtermGrade:
+		return thesis.GradeAppealComponent_APPEAL_MIDTERM_GRADE
+	case model.GradeAppealComponentFinalGrade:
+		return thesis.GradeAppealComponent_APPEAL_FINAL_GRADE
+	case model.GradeAppealComponentDefence:
+		return thesis.GradeAppealComponent_APPEAL_DEFENCE
+	default:
+		return thesis.GradeAppealComponent_APPEAL_SUPERVISOR_GRADE
+	}
+}
+
+func PbGradeAppealStatusToModel(pb thesis.GradeAppealStatus) model.GradeAppealStatus {
+	switch pb {
+	case thesis.GradeAppealStatus_APPEAL_ASSIGNED:
+		return model.GradeAppealStatusAssigned
+	case thesis.GradeAppealStatus_APPEAL_CONFIRMED:
+		return model.GradeAppealStatusConfirmed
+	case thesis.GradeAppealStatus_APPEAL_AMENDED:
+		return model.GradeAppealStatusAmended
+	default:
+		return model.GradeAppealStatusSubmitted
+	}
+}
+
+func ModelGradeAppealStatusToPb(status model.GradeAppealStatus) thesis.GradeAppealStatus {
+	switch status {
+	case model.GradeAppealStatusAssigned:
+		return thesis.GradeAppealStatus_APPEAL_ASSIGNED
+	case model.GradeAppealStatusConfirmed:
+		return thesis.GradeAppealStatus_APPEAL_CONFIRMED
+	case model.GradeAppealStatusAmended:
+		return thesis.GradeAppealStatus_APPEAL_AMENDED
+	default:
+		return thesis.GradeAppealStatus_APPEAL_SUBMITTED
+	}
+}
+
+// PbGradeAppealToModel converts protobuf GradeAppeal to GraphQL GradeAppeal
+func PbGradeAppealToModel(pb *thesis.GradeAppeal) *model.GradeAppeal {
+	if pb == nil {
+		return nil
+	}
+
+	result := &model.GradeAppeal{
+		ID:             pb.Id,
+		EnrollmentCode: pb.EnrollmentCode,
+		StudentCode:    pb.StudentCode,
+		SemesterCode:   pb.SemesterCode,
+		Component:      PbGradeAppealComponentToModel(pb.Component),
+		Reason:         pb.Reason,
+		Status:         PbGradeAppealStatusToModel(pb.Status),
+		History:        make([]*model.GradeAppealEvent, 0, len(pb.History)),
+	}
+	if pb.EvidenceFileCode != "" {
+		result.EvidenceFileCode = &pb.EvidenceFileCode
+	}
+	if pb.ReviewerCode != "" {
+		result.ReviewerCode = &pb.ReviewerCode
+	}
+	if pb.ResolutionNote != "" {
+		result.ResolutionNote = &pb.ResolutionNote
+	}
+	if pb.AmendmentCode != "" {
+		result.AmendmentCode = &pb.AmendmentCode
+	}
+	if pb.ResolvedBy != "" {
+		result.ResolvedBy = &pb.ResolvedBy
+	}
+	if pb.ResolvedAt != nil {
+		t := pb.ResolvedAt.AsTime()
+		result.ResolvedAt = &t
+	}
+	for _, event := range pb.History {
+		if event == nil {
+			continue
+		}
+		e := &model.GradeAppealEvent{
+			Status: PbGradeAppealStatusToModel(event.Status),
+			Actor:  event.Actor,
+		}
+		if event.Note != "" {
+			e.Note = &event.Note
+		}
+		if event.CreatedAt != nil {
+			t := event.CreatedAt.AsTime()
+			e.CreatedAt = &t
+		}
+		result.History = append(result.History, e)
+	}
+
+	// Handle timestamps
+	if pb.CreatedAt != nil {
+		t := pb.CreatedAt.AsTime()
+		result.CreatedAt = &t
+	}
+	if pb.UpdatedAt != nil {
+		t := pb.UpdatedAt.AsTime()
+		result.UpdatedAt = &t
+	}
+	return result
+}
+
+// PbGradeAppealsToModel converts array of protobuf GradeAppeals to GraphQL GradeAppeals
+func PbGradeAppealsToModel(pbs []*thesis.GradeAppeal) []*model.GradeAppeal {
+	result := make([]*model.GradeAppeal, 0, len(pbs))
+	for _, pb := range pbs {
+		if pb != nil {
+			result = append(result, PbGradeAppealToModel(pb))
+		}
+	}
+	return result
+}
+
+// PbMidtermMilestoneToModel converts protobuf MidtermMilestone to GraphQL MidtermMilestone
+func PbMidtermMilestoneToModel(pb *thesis.MidtermMilestone) *model.MidtermMilestone {
+	if pb == nil {
+		return nil
+	}
+
+	result := &model.MidtermMilestone{
+		ID:           pb.Id,
+		SemesterCode: pb.SemesterCode,
+		Stage:        PbTopicStageToModel(pb.Stage),
+		Title:        pb.Title,
+		Sequence:     pb.Sequence,
+		Version:      pb.Version,
+	}
+	if pb.Description != "" {
+		result.Description = &pb.Description
+	}
+	if pb.DueAt != nil {
+		t := pb.DueAt.AsTime()
+		result.DueAt = &t
+	}
+	if pb.CreatedAt != nil {
+		t := pb.CreatedAt.AsTime()
+		result.CreatedAt = &t
+	}
+	if pb.UpdatedAt != nil {
+		t := pb.UpdatedAt.AsTime()
+		result.UpdatedAt = &t
+	}
+	if pb.CreatedBy != "" {
+		result.CreatedBy = &pb.CreatedBy
+	}
+	if pb.UpdatedBy != "" {
+		result.UpdatedBy = &pb.UpdatedBy
+	}
+	return result
+}
+
+// PbMidtermMilestonesToModel converts array of protobuf MidtermMilestones to GraphQL MidtermMilestones
+func PbMidtermMilestonesToModel(pbs []*thesis.MidtermMilestone) []*model.MidtermMilestone {
+	result := make([]*model.MidtermMilestone, 0, len(pbs))
+	for _, pb := range pbs {
+		if pb != nil {
+			result = append(result, PbMidtermMilestoneToModel(pb))
+		}
+	}
+	return result
+}
+
+func PbMilestoneResultToModel(pb thesis.MilestoneResult) model.MilestoneResult {
+	switch pb {
+	case thesis.MilestoneResult_MILESTONE_PASSED:
+		return model.MilestoneResultPassed
+	case thesis.MilestoneResult_MILESTONE_FAILED:
+		return model.MilestoneResultFailed
+	default:
+		return model.MilestoneResultPending
+	}
+}
+
+// PbMilestoneCheckinToModel converts protobuf MilestoneCheckin to GraphQL MilestoneCheckin
+func PbMilestoneCheckinToModel(pb *thesis.MilestoneCheckin) *model.MilestoneCheckin {
+	if pb == nil {
+		return nil
+	}
+
+	result := &model.MilestoneCheckin{
+		ID:             pb.Id,
+		MilestoneCode:  pb.MilestoneCode,
+		EnrollmentCode: pb.EnrollmentCode,
+		Report:         pb.Report,
+		Result:         PbMilestoneResultToModel(pb.Result),
+	}
+	if pb.FileCode != "" {
+		result.FileCode = &pb.FileCode
+	}
+	if pb.Comment != "" {
+		result.Comment = &pb.Comment
+	}
+	if pb.ReviewedBy != "" {
+		result.ReviewedBy = &pb.ReviewedBy
+	}
+	if pb.ReviewedAt != nil {
+		t := pb.ReviewedAt.AsTime()
+		result.ReviewedAt = &t
+	}
+	if pb.SubmittedAt != nil {
+		t := pb.SubmittedAt.AsTime()
+		result.SubmittedAt = &t
+	}
+	if pb.UpdatedAt != nil {
+		t := pb.UpdatedAt.AsTime()
+		result.UpdatedAt = &t
+	}
+	return result
+}
+
+// PbMilestoneCheckinsToModel converts array of protobuf MilestoneCheckins to GraphQL MilestoneCheckins
+func PbMilestoneCheckinsToModel(pbs []*thesis.MilestoneCheckin) []*model.MilestoneCheckin {
+	result := make([]*model.MilestoneCheckin, 0, len(pbs))
+	for _, pb := range pbs {
+		if pb != nil {
+			result = append(result, PbMilestoneCheckinToModel(pb))
+		}
+	}
+	return result
+}
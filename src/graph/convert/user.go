@@ -18,6 +18,7 @@ func PbStudentToModel(pb *user.Student) *model.Student {
 		Username:     pb.Username,
 		MajorCode:    pb.MajorCode,
 		SemesterCode: pb.SemesterCode,
+		Version:      pb.Version,
 	}
 
 	// Handle optional ClassCode
@@ -42,6 +43,11 @@ func PbStudentToModel(pb *user.Student) *model.Student {
 	if pb.UpdatedBy != "" {
 		result.UpdatedBy = &pb.UpdatedBy
 	}
+	if pb.DeletedAt != nil {
+		t := pb.DeletedAt.AsTime()
+		result.DeletedAt = &t
+		result.DeletedBy = &pb.DeletedBy
+	}
 
 	// Handle Gender enum
 	gender := PbGenderToModel(pb.Gender)
@@ -62,6 +68,7 @@ func PbTeacherToModel(pb *user.Teacher) *model.Teacher {
 		Username:     pb.Username,
 		MajorCode:    pb.MajorCode,
 		SemesterCode: pb.SemesterCode,
+		Version:      pb.Version,
 	}
 
 	// Handle timestamps
@@ -81,6 +88,11 @@ func PbTeacherToModel(pb *user.Teacher) *model.Teacher {
 	if pb.UpdatedBy != "" {
 		result.UpdatedBy = &pb.UpdatedBy
 	}
+	if pb.DeletedAt != nil {
+		t := pb.DeletedAt.AsTime()
+		result.DeletedAt = &t
+		result.DeletedBy = &pb.DeletedBy
+	}
 
 	// Handle Gender enum
 	gender := PbGenderToModel(pb.Gender)
@@ -225,14 +225,8 @@ func convertPbFinalToModel(pbFinal *pb.Final) *model.Final {
 	}
 
 	// Handle optional fields
-	if pbFinal.SupervisorGrade != 0 {
-		grade := pbFinal.SupervisorGrade
-		modelFinal.SupervisorGrade = &grade
-	}
-	if pbFinal.FinalGrade != 0 {
-		grade := pbFinal.FinalGrade
-		modelFinal.FinalGrade = &grade
-	}
+	modelFinal.SupervisorGrade = pbFinal.SupervisorGrade
+	modelFinal.FinalGrade = pbFinal.FinalGrade
 	if pbFinal.Notes != "" {
 		notes := pbFinal.Notes
 		modelFinal.Notes = &notes
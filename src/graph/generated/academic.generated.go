@@ -215,6 +215,35 @@ func (ec *executionContext) fieldContext_Faculty_updatedBy(_ context.Context, fi
 	return fc, nil
 }
 
+func (ec *executionContext) _Faculty_version(ctx context.Context, field graphql.CollectedField, obj *model.Faculty) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Faculty_version,
+		func(ctx context.Context) (any, error) {
+			return obj.Version, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Faculty_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Faculty",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Faculty_majors(ctx context.Context, field graphql.CollectedField, obj *model.Faculty) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -253,6 +282,12 @@ func (ec *executionContext) fieldContext_Faculty_majors(_ context.Context, field
 				return ec.fieldContext_Major_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Major_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Major_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Major_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Major_deletedBy(ctx, field)
 			case "topics":
 				return ec.fieldContext_Major_topics(ctx, field)
 			}
@@ -465,6 +500,93 @@ func (ec *executionContext) fieldContext_Major_updatedBy(_ context.Context, fiel
 	return fc, nil
 }
 
+func (ec *executionContext) _Major_version(ctx context.Context, field graphql.CollectedField, obj *model.Major) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Major_version,
+		func(ctx context.Context) (any, error) {
+			return obj.Version, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Major_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Major",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Major_deletedAt(ctx context.Context, field graphql.CollectedField, obj *model.Major) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Major_deletedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.DeletedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Major_deletedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Major",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Major_deletedBy(ctx context.Context, field graphql.CollectedField, obj *model.Major) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Major_deletedBy,
+		func(ctx context.Context) (any, error) {
+			return obj.DeletedBy, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Major_deletedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Major",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Major_topics(ctx context.Context, field graphql.CollectedField, obj *model.Major) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -505,6 +627,10 @@ func (ec *executionContext) fieldContext_Major_topics(_ context.Context, field g
 				return ec.fieldContext_Topic_percentStage1(ctx, field)
 			case "percentStage2":
 				return ec.fieldContext_Topic_percentStage2(ctx, field)
+			case "maxStudents":
+				return ec.fieldContext_Topic_maxStudents(ctx, field)
+			case "requiredSkills":
+				return ec.fieldContext_Topic_requiredSkills(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_Topic_createdAt(ctx, field)
 			case "updatedAt":
@@ -513,10 +639,18 @@ func (ec *executionContext) fieldContext_Major_topics(_ context.Context, field g
 				return ec.fieldContext_Topic_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Topic_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Topic_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Topic_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Topic_deletedBy(ctx, field)
 			case "files":
 				return ec.fieldContext_Topic_files(ctx, field)
 			case "topicCouncils":
 				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Topic_statusHistory(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
 		},
@@ -698,6 +832,93 @@ func (ec *executionContext) fieldContext_Semester_updatedBy(_ context.Context, f
 	return fc, nil
 }
 
+func (ec *executionContext) _Semester_version(ctx context.Context, field graphql.CollectedField, obj *model.Semester) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Semester_version,
+		func(ctx context.Context) (any, error) {
+			return obj.Version, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Semester_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Semester",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Semester_deletedAt(ctx context.Context, field graphql.CollectedField, obj *model.Semester) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Semester_deletedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.DeletedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Semester_deletedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Semester",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Semester_deletedBy(ctx context.Context, field graphql.CollectedField, obj *model.Semester) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Semester_deletedBy,
+		func(ctx context.Context) (any, error) {
+			return obj.DeletedBy, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Semester_deletedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Semester",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Semester_students(ctx context.Context, field graphql.CollectedField, obj *model.Semester) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -746,6 +967,12 @@ func (ec *executionContext) fieldContext_Semester_students(_ context.Context, fi
 				return ec.fieldContext_Student_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Student_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Student_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Student_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Student_deletedBy(ctx, field)
 			case "enrollments":
 				return ec.fieldContext_Student_enrollments(ctx, field)
 			}
@@ -799,6 +1026,12 @@ func (ec *executionContext) fieldContext_Semester_teachers(_ context.Context, fi
 				return ec.fieldContext_Teacher_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Teacher_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Teacher_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Teacher_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Teacher_deletedBy(ctx, field)
 			case "roles":
 				return ec.fieldContext_Teacher_roles(ctx, field)
 			}
@@ -848,6 +1081,10 @@ func (ec *executionContext) fieldContext_Semester_topics(_ context.Context, fiel
 				return ec.fieldContext_Topic_percentStage1(ctx, field)
 			case "percentStage2":
 				return ec.fieldContext_Topic_percentStage2(ctx, field)
+			case "maxStudents":
+				return ec.fieldContext_Topic_maxStudents(ctx, field)
+			case "requiredSkills":
+				return ec.fieldContext_Topic_requiredSkills(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_Topic_createdAt(ctx, field)
 			case "updatedAt":
@@ -856,10 +1093,18 @@ func (ec *executionContext) fieldContext_Semester_topics(_ context.Context, fiel
 				return ec.fieldContext_Topic_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Topic_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Topic_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Topic_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Topic_deletedBy(ctx, field)
 			case "files":
 				return ec.fieldContext_Topic_files(ctx, field)
 			case "topicCouncils":
 				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Topic_statusHistory(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
 		},
@@ -908,6 +1153,11 @@ func (ec *executionContext) _Faculty(ctx context.Context, sel ast.SelectionSet,
 			out.Values[i] = ec._Faculty_createdBy(ctx, field, obj)
 		case "updatedBy":
 			out.Values[i] = ec._Faculty_updatedBy(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._Faculty_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
 		case "majors":
 			field := field
 
@@ -1001,6 +1251,15 @@ func (ec *executionContext) _Major(ctx context.Context, sel ast.SelectionSet, ob
 			out.Values[i] = ec._Major_createdBy(ctx, field, obj)
 		case "updatedBy":
 			out.Values[i] = ec._Major_updatedBy(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._Major_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "deletedAt":
+			out.Values[i] = ec._Major_deletedAt(ctx, field, obj)
+		case "deletedBy":
+			out.Values[i] = ec._Major_deletedBy(ctx, field, obj)
 		case "topics":
 			field := field
 
@@ -1086,6 +1345,15 @@ func (ec *executionContext) _Semester(ctx context.Context, sel ast.SelectionSet,
 			out.Values[i] = ec._Semester_createdBy(ctx, field, obj)
 		case "updatedBy":
 			out.Values[i] = ec._Semester_updatedBy(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._Semester_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "deletedAt":
+			out.Values[i] = ec._Semester_deletedAt(ctx, field, obj)
+		case "deletedBy":
+			out.Values[i] = ec._Semester_deletedBy(ctx, field, obj)
 		case "students":
 			field := field
 
@@ -102,6 +102,116 @@ func (ec *executionContext) unmarshalInputCreateMajorInput(ctx context.Context,
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputCreateMidtermMilestoneInput(ctx context.Context, obj any) (model.CreateMidtermMilestoneInput, error) {
+	var it model.CreateMidtermMilestoneInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"semesterCode", "stage", "title", "description", "sequence", "dueAt"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "semesterCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("semesterCode"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SemesterCode = data
+		case "stage":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stage"))
+			data, err := ec.unmarshalNTopicStage2thailyᚋsrcᚋgraphᚋmodelᚐTopicStage(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Stage = data
+		case "title":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Title = data
+		case "description":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Description = data
+		case "sequence":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sequence"))
+			data, err := ec.unmarshalNInt2int32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Sequence = data
+		case "dueAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dueAt"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DueAt = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateRubricTemplateInput(ctx context.Context, obj any) (model.CreateRubricTemplateInput, error) {
+	var it model.CreateRubricTemplateInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"title", "majorCode", "stage", "criteria"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "title":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Title = data
+		case "majorCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("majorCode"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MajorCode = data
+		case "stage":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stage"))
+			data, err := ec.unmarshalNTopicStage2thailyᚋsrcᚋgraphᚋmodelᚐTopicStage(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Stage = data
+		case "criteria":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("criteria"))
+			data, err := ec.unmarshalNRubricCriterionInput2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐRubricCriterionInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Criteria = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputCreateSemesterInput(ctx context.Context, obj any) (model.CreateSemesterInput, error) {
 	var it model.CreateSemesterInput
 	asMap := map[string]any{}
@@ -274,6 +384,432 @@ func (ec *executionContext) unmarshalInputCreateTeacherInput(ctx context.Context
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputDecideGradeAmendmentInput(ctx context.Context, obj any) (model.DecideGradeAmendmentInput, error) {
+	var it model.DecideGradeAmendmentInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"id", "target", "approve", "note"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ID = data
+		case "target":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("target"))
+			data, err := ec.unmarshalNGradeAmendmentTarget2thailyᚋsrcᚋgraphᚋmodelᚐGradeAmendmentTarget(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Target = data
+		case "approve":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("approve"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Approve = data
+		case "note":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("note"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Note = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDefenceScheduleInput(ctx context.Context, obj any) (model.DefenceScheduleInput, error) {
+	var it model.DefenceScheduleInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"semesterCode", "rooms", "days", "slotMinutes", "unavailability"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "semesterCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("semesterCode"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SemesterCode = data
+		case "rooms":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rooms"))
+			data, err := ec.unmarshalNString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Rooms = data
+		case "days":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("days"))
+			data, err := ec.unmarshalNTimeRangeInput2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTimeRangeInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Days = data
+		case "slotMinutes":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slotMinutes"))
+			data, err := ec.unmarshalNInt2int32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SlotMinutes = data
+		case "unavailability":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("unavailability"))
+			data, err := ec.unmarshalOTeacherUnavailabilityInput2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacherUnavailabilityInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Unavailability = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRubricCriterionInput(ctx context.Context, obj any) (model.RubricCriterionInput, error) {
+	var it model.RubricCriterionInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "description", "maxScore", "weight"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "description":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Description = data
+		case "maxScore":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxScore"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MaxScore = data
+		case "weight":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("weight"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Weight = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSetGradingPolicyInput(ctx context.Context, obj any) (model.SetGradingPolicyInput, error) {
+	var it model.SetGradingPolicyInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"majorCode", "semesterCode", "stage", "supervisorWeight", "reviewerWeight", "councilWeight", "rounding", "roundingStep", "passThreshold", "maxCouncilSpread"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "majorCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("majorCode"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MajorCode = data
+		case "semesterCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("semesterCode"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SemesterCode = data
+		case "stage":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stage"))
+			data, err := ec.unmarshalNTopicStage2thailyᚋsrcᚋgraphᚋmodelᚐTopicStage(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Stage = data
+		case "supervisorWeight":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("supervisorWeight"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SupervisorWeight = data
+		case "reviewerWeight":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reviewerWeight"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ReviewerWeight = data
+		case "councilWeight":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("councilWeight"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CouncilWeight = data
+		case "rounding":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rounding"))
+			data, err := ec.unmarshalOGradeRounding2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeRounding(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Rounding = data
+		case "roundingStep":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("roundingStep"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RoundingStep = data
+		case "passThreshold":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("passThreshold"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PassThreshold = data
+		case "maxCouncilSpread":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxCouncilSpread"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MaxCouncilSpread = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSetRegistrationWindowInput(ctx context.Context, obj any) (model.SetRegistrationWindowInput, error) {
+	var it model.SetRegistrationWindowInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"semesterCode", "opensAt", "closesAt", "maxChoices"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "semesterCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("semesterCode"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SemesterCode = data
+		case "opensAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("opensAt"))
+			data, err := ec.unmarshalNTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OpensAt = data
+		case "closesAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("closesAt"))
+			data, err := ec.unmarshalNTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ClosesAt = data
+		case "maxChoices":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxChoices"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MaxChoices = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSetSubmissionDeadlineInput(ctx context.Context, obj any) (model.SetSubmissionDeadlineInput, error) {
+	var it model.SetSubmissionDeadlineInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"semesterCode", "stage", "kind", "opensAt", "dueAt", "graceMinutes"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "semesterCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("semesterCode"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SemesterCode = data
+		case "stage":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stage"))
+			data, err := ec.unmarshalNTopicStage2thailyᚋsrcᚋgraphᚋmodelᚐTopicStage(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Stage = data
+		case "kind":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("kind"))
+			data, err := ec.unmarshalNSubmissionKind2thailyᚋsrcᚋgraphᚋmodelᚐSubmissionKind(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Kind = data
+		case "opensAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("opensAt"))
+			data, err := ec.unmarshalNTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OpensAt = data
+		case "dueAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dueAt"))
+			data, err := ec.unmarshalNTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DueAt = data
+		case "graceMinutes":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("graceMinutes"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GraceMinutes = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputTeacherUnavailabilityInput(ctx context.Context, obj any) (model.TeacherUnavailabilityInput, error) {
+	var it model.TeacherUnavailabilityInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"teacherCode", "start", "end"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "teacherCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("teacherCode"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TeacherCode = data
+		case "start":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("start"))
+			data, err := ec.unmarshalNTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Start = data
+		case "end":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("end"))
+			data, err := ec.unmarshalNTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.End = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputTimeRangeInput(ctx context.Context, obj any) (model.TimeRangeInput, error) {
+	var it model.TimeRangeInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"start", "end"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "start":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("start"))
+			data, err := ec.unmarshalNTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Start = data
+		case "end":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("end"))
+			data, err := ec.unmarshalNTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.End = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputUpdateCouncilInput(ctx context.Context, obj any) (model.UpdateCouncilInput, error) {
 	var it model.UpdateCouncilInput
 	asMap := map[string]any{}
@@ -369,6 +905,102 @@ func (ec *executionContext) unmarshalInputUpdateMajorInput(ctx context.Context,
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputUpdateMidtermMilestoneInput(ctx context.Context, obj any) (model.UpdateMidtermMilestoneInput, error) {
+	var it model.UpdateMidtermMilestoneInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"title", "description", "sequence", "dueAt", "version"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "title":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Title = data
+		case "description":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Description = data
+		case "sequence":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sequence"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Sequence = data
+		case "dueAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dueAt"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DueAt = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateRubricTemplateInput(ctx context.Context, obj any) (model.UpdateRubricTemplateInput, error) {
+	var it model.UpdateRubricTemplateInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"title", "criteria", "version"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "title":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Title = data
+		case "criteria":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("criteria"))
+			data, err := ec.unmarshalORubricCriterionInput2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐRubricCriterionInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Criteria = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputUpdateSemesterInput(ctx context.Context, obj any) (model.UpdateSemesterInput, error) {
 	var it model.UpdateSemesterInput
 	asMap := map[string]any{}
@@ -527,7 +1159,7 @@ func (ec *executionContext) unmarshalInputUpdateTopicInput(ctx context.Context,
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"title", "status", "percentStage1", "percentStage2"}
+	fieldsInOrder := [...]string{"title", "percentStage1", "percentStage2"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -541,13 +1173,6 @@ func (ec *executionContext) unmarshalInputUpdateTopicInput(ctx context.Context,
 				return it, err
 			}
 			it.Title = data
-		case "status":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
-			data, err := ec.unmarshalOTopicStatus2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicStatus(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Status = data
 		case "percentStage1":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("percentStage1"))
 			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
@@ -590,6 +1215,16 @@ func (ec *executionContext) unmarshalNCreateMajorInput2thailyᚋsrcᚋgraphᚋmo
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNCreateMidtermMilestoneInput2thailyᚋsrcᚋgraphᚋmodelᚐCreateMidtermMilestoneInput(ctx context.Context, v any) (model.CreateMidtermMilestoneInput, error) {
+	res, err := ec.unmarshalInputCreateMidtermMilestoneInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCreateRubricTemplateInput2thailyᚋsrcᚋgraphᚋmodelᚐCreateRubricTemplateInput(ctx context.Context, v any) (model.CreateRubricTemplateInput, error) {
+	res, err := ec.unmarshalInputCreateRubricTemplateInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNCreateSemesterInput2thailyᚋsrcᚋgraphᚋmodelᚐCreateSemesterInput(ctx context.Context, v any) (model.CreateSemesterInput, error) {
 	res, err := ec.unmarshalInputCreateSemesterInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -605,6 +1240,76 @@ func (ec *executionContext) unmarshalNCreateTeacherInput2thailyᚋsrcᚋgraphᚋ
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNDecideGradeAmendmentInput2thailyᚋsrcᚋgraphᚋmodelᚐDecideGradeAmendmentInput(ctx context.Context, v any) (model.DecideGradeAmendmentInput, error) {
+	res, err := ec.unmarshalInputDecideGradeAmendmentInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNDefenceScheduleInput2thailyᚋsrcᚋgraphᚋmodelᚐDefenceScheduleInput(ctx context.Context, v any) (model.DefenceScheduleInput, error) {
+	res, err := ec.unmarshalInputDefenceScheduleInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNRubricCriterionInput2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐRubricCriterionInputᚄ(ctx context.Context, v any) ([]*model.RubricCriterionInput, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.RubricCriterionInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNRubricCriterionInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐRubricCriterionInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNRubricCriterionInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐRubricCriterionInput(ctx context.Context, v any) (*model.RubricCriterionInput, error) {
+	res, err := ec.unmarshalInputRubricCriterionInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNSetGradingPolicyInput2thailyᚋsrcᚋgraphᚋmodelᚐSetGradingPolicyInput(ctx context.Context, v any) (model.SetGradingPolicyInput, error) {
+	res, err := ec.unmarshalInputSetGradingPolicyInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNSetRegistrationWindowInput2thailyᚋsrcᚋgraphᚋmodelᚐSetRegistrationWindowInput(ctx context.Context, v any) (model.SetRegistrationWindowInput, error) {
+	res, err := ec.unmarshalInputSetRegistrationWindowInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNSetSubmissionDeadlineInput2thailyᚋsrcᚋgraphᚋmodelᚐSetSubmissionDeadlineInput(ctx context.Context, v any) (model.SetSubmissionDeadlineInput, error) {
+	res, err := ec.unmarshalInputSetSubmissionDeadlineInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNTeacherUnavailabilityInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacherUnavailabilityInput(ctx context.Context, v any) (*model.TeacherUnavailabilityInput, error) {
+	res, err := ec.unmarshalInputTeacherUnavailabilityInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNTimeRangeInput2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTimeRangeInputᚄ(ctx context.Context, v any) ([]*model.TimeRangeInput, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.TimeRangeInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNTimeRangeInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTimeRangeInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNTimeRangeInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTimeRangeInput(ctx context.Context, v any) (*model.TimeRangeInput, error) {
+	res, err := ec.unmarshalInputTimeRangeInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNUpdateCouncilInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateCouncilInput(ctx context.Context, v any) (model.UpdateCouncilInput, error) {
 	res, err := ec.unmarshalInputUpdateCouncilInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -620,6 +1325,16 @@ func (ec *executionContext) unmarshalNUpdateMajorInput2thailyᚋsrcᚋgraphᚋmo
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNUpdateMidtermMilestoneInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateMidtermMilestoneInput(ctx context.Context, v any) (model.UpdateMidtermMilestoneInput, error) {
+	res, err := ec.unmarshalInputUpdateMidtermMilestoneInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNUpdateRubricTemplateInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateRubricTemplateInput(ctx context.Context, v any) (model.UpdateRubricTemplateInput, error) {
+	res, err := ec.unmarshalInputUpdateRubricTemplateInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNUpdateSemesterInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateSemesterInput(ctx context.Context, v any) (model.UpdateSemesterInput, error) {
 	res, err := ec.unmarshalInputUpdateSemesterInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -640,4 +1355,40 @@ func (ec *executionContext) unmarshalNUpdateTopicInput2thailyᚋsrcᚋgraphᚋmo
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalORubricCriterionInput2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐRubricCriterionInputᚄ(ctx context.Context, v any) ([]*model.RubricCriterionInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.RubricCriterionInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNRubricCriterionInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐRubricCriterionInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalOTeacherUnavailabilityInput2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacherUnavailabilityInputᚄ(ctx context.Context, v any) ([]*model.TeacherUnavailabilityInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.TeacherUnavailabilityInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNTeacherUnavailabilityInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacherUnavailabilityInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 // endregion ***************************** type.gotpl *****************************
@@ -221,6 +221,151 @@ func (ec *executionContext) fieldContext_Council_timeStart(_ context.Context, fi
 	return fc, nil
 }
 
+func (ec *executionContext) _Council_room(ctx context.Context, field graphql.CollectedField, obj *model.Council) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Council_room,
+		func(ctx context.Context) (any, error) {
+			return obj.Room, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Council_room(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Council",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Council_gradesLocked(ctx context.Context, field graphql.CollectedField, obj *model.Council) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Council_gradesLocked,
+		func(ctx context.Context) (any, error) {
+			return obj.GradesLocked, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Council_gradesLocked(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Council",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Council_gradesLockedAt(ctx context.Context, field graphql.CollectedField, obj *model.Council) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Council_gradesLockedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.GradesLockedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Council_gradesLockedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Council",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Council_gradesPublished(ctx context.Context, field graphql.CollectedField, obj *model.Council) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Council_gradesPublished,
+		func(ctx context.Context) (any, error) {
+			return obj.GradesPublished, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Council_gradesPublished(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Council",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Council_gradesPublishedAt(ctx context.Context, field graphql.CollectedField, obj *model.Council) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Council_gradesPublishedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.GradesPublishedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Council_gradesPublishedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Council",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Council_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Council) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -337,6 +482,93 @@ func (ec *executionContext) fieldContext_Council_updatedBy(_ context.Context, fi
 	return fc, nil
 }
 
+func (ec *executionContext) _Council_version(ctx context.Context, field graphql.CollectedField, obj *model.Council) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Council_version,
+		func(ctx context.Context) (any, error) {
+			return obj.Version, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Council_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Council",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Council_deletedAt(ctx context.Context, field graphql.CollectedField, obj *model.Council) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Council_deletedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.DeletedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Council_deletedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Council",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Council_deletedBy(ctx context.Context, field graphql.CollectedField, obj *model.Council) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Council_deletedBy,
+		func(ctx context.Context) (any, error) {
+			return obj.DeletedBy, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Council_deletedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Council",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Council_defences(ctx context.Context, field graphql.CollectedField, obj *model.Council) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -379,6 +611,8 @@ func (ec *executionContext) fieldContext_Council_defences(_ context.Context, fie
 				return ec.fieldContext_Defence_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Defence_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Defence_version(ctx, field)
 			case "council":
 				return ec.fieldContext_Defence_council(ctx, field)
 			case "teacher":
@@ -438,6 +672,8 @@ func (ec *executionContext) fieldContext_Council_topicCouncils(_ context.Context
 				return ec.fieldContext_TopicCouncil_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_TopicCouncil_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_TopicCouncil_version(ctx, field)
 			case "topic":
 				return ec.fieldContext_TopicCouncil_topic(ctx, field)
 			case "council":
@@ -453,54 +689,54 @@ func (ec *executionContext) fieldContext_Council_topicCouncils(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _Defence_id(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilConflict_kind(ctx context.Context, field graphql.CollectedField, obj *model.CouncilConflict) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Defence_id,
+		ec.fieldContext_CouncilConflict_kind,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.Kind, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNCouncilConflictKind2thailyᚋsrcᚋgraphᚋmodelᚐCouncilConflictKind,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Defence_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilConflict_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Defence",
+		Object:     "CouncilConflict",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type CouncilConflictKind does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Defence_title(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilConflict_teacherCode(ctx context.Context, field graphql.CollectedField, obj *model.CouncilConflict) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Defence_title,
+		ec.fieldContext_CouncilConflict_teacherCode,
 		func(ctx context.Context) (any, error) {
-			return obj.Title, nil
+			return obj.TeacherCode, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Defence_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilConflict_teacherCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Defence",
+		Object:     "CouncilConflict",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -511,25 +747,25 @@ func (ec *executionContext) fieldContext_Defence_title(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Defence_councilCode(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilConflict_topicCouncilCode(ctx context.Context, field graphql.CollectedField, obj *model.CouncilConflict) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Defence_councilCode,
+		ec.fieldContext_CouncilConflict_topicCouncilCode,
 		func(ctx context.Context) (any, error) {
-			return obj.CouncilCode, nil
+			return obj.TopicCouncilCode, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Defence_councilCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilConflict_topicCouncilCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Defence",
+		Object:     "CouncilConflict",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -540,14 +776,14 @@ func (ec *executionContext) fieldContext_Defence_councilCode(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Defence_teacherCode(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilConflict_message(ctx context.Context, field graphql.CollectedField, obj *model.CouncilConflict) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Defence_teacherCode,
+		ec.fieldContext_CouncilConflict_message,
 		func(ctx context.Context) (any, error) {
-			return obj.TeacherCode, nil
+			return obj.Message, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -556,9 +792,9 @@ func (ec *executionContext) _Defence_teacherCode(ctx context.Context, field grap
 	)
 }
 
-func (ec *executionContext) fieldContext_Defence_teacherCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilConflict_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Defence",
+		Object:     "CouncilConflict",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -569,130 +805,130 @@ func (ec *executionContext) fieldContext_Defence_teacherCode(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Defence_position(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilConflict_overridden(ctx context.Context, field graphql.CollectedField, obj *model.CouncilConflict) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Defence_position,
+		ec.fieldContext_CouncilConflict_overridden,
 		func(ctx context.Context) (any, error) {
-			return obj.Position, nil
+			return obj.Overridden, nil
 		},
 		nil,
-		ec.marshalNDefencePosition2thailyᚋsrcᚋgraphᚋmodelᚐDefencePosition,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Defence_position(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilConflict_overridden(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Defence",
+		Object:     "CouncilConflict",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DefencePosition does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Defence_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilConflictOverride_id(ctx context.Context, field graphql.CollectedField, obj *model.CouncilConflictOverride) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Defence_createdAt,
+		ec.fieldContext_CouncilConflictOverride_id,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalOTime2ᚖtimeᚐTime,
+		ec.marshalNID2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Defence_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilConflictOverride_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Defence",
+		Object:     "CouncilConflictOverride",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Defence_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilConflictOverride_councilCode(ctx context.Context, field graphql.CollectedField, obj *model.CouncilConflictOverride) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Defence_updatedAt,
+		ec.fieldContext_CouncilConflictOverride_councilCode,
 		func(ctx context.Context) (any, error) {
-			return obj.UpdatedAt, nil
+			return obj.CouncilCode, nil
 		},
 		nil,
-		ec.marshalOTime2ᚖtimeᚐTime,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Defence_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilConflictOverride_councilCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Defence",
+		Object:     "CouncilConflictOverride",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Defence_createdBy(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilConflictOverride_kind(ctx context.Context, field graphql.CollectedField, obj *model.CouncilConflictOverride) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Defence_createdBy,
+		ec.fieldContext_CouncilConflictOverride_kind,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedBy, nil
+			return obj.Kind, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNCouncilConflictKind2thailyᚋsrcᚋgraphᚋmodelᚐCouncilConflictKind,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Defence_createdBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilConflictOverride_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Defence",
+		Object:     "CouncilConflictOverride",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type CouncilConflictKind does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Defence_updatedBy(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilConflictOverride_teacherCode(ctx context.Context, field graphql.CollectedField, obj *model.CouncilConflictOverride) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Defence_updatedBy,
+		ec.fieldContext_CouncilConflictOverride_teacherCode,
 		func(ctx context.Context) (any, error) {
-			return obj.UpdatedBy, nil
+			return obj.TeacherCode, nil
 		},
 		nil,
 		ec.marshalOString2ᚖstring,
@@ -701,9 +937,9 @@ func (ec *executionContext) _Defence_updatedBy(ctx context.Context, field graphq
 	)
 }
 
-func (ec *executionContext) fieldContext_Defence_updatedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilConflictOverride_teacherCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Defence",
+		Object:     "CouncilConflictOverride",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -714,206 +950,130 @@ func (ec *executionContext) fieldContext_Defence_updatedBy(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _Defence_council(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilConflictOverride_topicCouncilCode(ctx context.Context, field graphql.CollectedField, obj *model.CouncilConflictOverride) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Defence_council,
+		ec.fieldContext_CouncilConflictOverride_topicCouncilCode,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Defence().Council(ctx, obj)
+			return obj.TopicCouncilCode, nil
 		},
 		nil,
-		ec.marshalOCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil,
+		ec.marshalOString2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Defence_council(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilConflictOverride_topicCouncilCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Defence",
+		Object:     "CouncilConflictOverride",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "total":
-				return ec.fieldContext_Council_total(ctx, field)
-			case "id":
-				return ec.fieldContext_Council_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Council_title(ctx, field)
-			case "majorCode":
-				return ec.fieldContext_Council_majorCode(ctx, field)
-			case "semesterCode":
-				return ec.fieldContext_Council_semesterCode(ctx, field)
-			case "timeStart":
-				return ec.fieldContext_Council_timeStart(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Council_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Council_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Council_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Council_updatedBy(ctx, field)
-			case "defences":
-				return ec.fieldContext_Council_defences(ctx, field)
-			case "topicCouncils":
-				return ec.fieldContext_Council_topicCouncils(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Council", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Defence_teacher(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilConflictOverride_message(ctx context.Context, field graphql.CollectedField, obj *model.CouncilConflictOverride) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Defence_teacher,
+		ec.fieldContext_CouncilConflictOverride_message,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Defence().Teacher(ctx, obj)
+			return obj.Message, nil
 		},
 		nil,
-		ec.marshalOTeacher2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacher,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Defence_teacher(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilConflictOverride_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Defence",
+		Object:     "CouncilConflictOverride",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Teacher_id(ctx, field)
-			case "email":
-				return ec.fieldContext_Teacher_email(ctx, field)
-			case "username":
-				return ec.fieldContext_Teacher_username(ctx, field)
-			case "gender":
-				return ec.fieldContext_Teacher_gender(ctx, field)
-			case "majorCode":
-				return ec.fieldContext_Teacher_majorCode(ctx, field)
-			case "semesterCode":
-				return ec.fieldContext_Teacher_semesterCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Teacher_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Teacher_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Teacher_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Teacher_updatedBy(ctx, field)
-			case "roles":
-				return ec.fieldContext_Teacher_roles(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Teacher", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Defence_gradeDefences(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilConflictOverride_reason(ctx context.Context, field graphql.CollectedField, obj *model.CouncilConflictOverride) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Defence_gradeDefences,
+		ec.fieldContext_CouncilConflictOverride_reason,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Defence().GradeDefences(ctx, obj)
+			return obj.Reason, nil
 		},
 		nil,
-		ec.marshalOGradeDefence2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefenceᚄ,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Defence_gradeDefences(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilConflictOverride_reason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Defence",
+		Object:     "CouncilConflictOverride",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_GradeDefence_id(ctx, field)
-			case "defenceCode":
-				return ec.fieldContext_GradeDefence_defenceCode(ctx, field)
-			case "enrollmentCode":
-				return ec.fieldContext_GradeDefence_enrollmentCode(ctx, field)
-			case "note":
-				return ec.fieldContext_GradeDefence_note(ctx, field)
-			case "totalScore":
-				return ec.fieldContext_GradeDefence_totalScore(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_GradeDefence_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_GradeDefence_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_GradeDefence_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_GradeDefence_updatedBy(ctx, field)
-			case "defence":
-				return ec.fieldContext_GradeDefence_defence(ctx, field)
-			case "enrollment":
-				return ec.fieldContext_GradeDefence_enrollment(ctx, field)
-			case "criteria":
-				return ec.fieldContext_GradeDefence_criteria(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type GradeDefence", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefence_id(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilConflictOverride_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.CouncilConflictOverride) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefence_id,
+		ec.fieldContext_CouncilConflictOverride_createdAt,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalNID2string,
-		true,
+		ec.marshalOTime2ᚖtimeᚐTime,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefence_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilConflictOverride_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefence",
+		Object:     "CouncilConflictOverride",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefence_defenceCode(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilConflictOverride_createdBy(ctx context.Context, field graphql.CollectedField, obj *model.CouncilConflictOverride) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefence_defenceCode,
+		ec.fieldContext_CouncilConflictOverride_createdBy,
 		func(ctx context.Context) (any, error) {
-			return obj.DefenceCode, nil
+			return obj.CreatedBy, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -922,9 +1082,9 @@ func (ec *executionContext) _GradeDefence_defenceCode(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefence_defenceCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilConflictOverride_createdBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefence",
+		Object:     "CouncilConflictOverride",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -935,14 +1095,14 @@ func (ec *executionContext) fieldContext_GradeDefence_defenceCode(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefence_enrollmentCode(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilValidation_councilCode(ctx context.Context, field graphql.CollectedField, obj *model.CouncilValidation) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefence_enrollmentCode,
+		ec.fieldContext_CouncilValidation_councilCode,
 		func(ctx context.Context) (any, error) {
-			return obj.EnrollmentCode, nil
+			return obj.CouncilCode, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -951,9 +1111,9 @@ func (ec *executionContext) _GradeDefence_enrollmentCode(ctx context.Context, fi
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefence_enrollmentCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilValidation_councilCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefence",
+		Object:     "CouncilValidation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -964,141 +1124,153 @@ func (ec *executionContext) fieldContext_GradeDefence_enrollmentCode(_ context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefence_note(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilValidation_valid(ctx context.Context, field graphql.CollectedField, obj *model.CouncilValidation) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefence_note,
+		ec.fieldContext_CouncilValidation_valid,
 		func(ctx context.Context) (any, error) {
-			return obj.Note, nil
+			return obj.Valid, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefence_note(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilValidation_valid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefence",
+		Object:     "CouncilValidation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefence_totalScore(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilValidation_conflicts(ctx context.Context, field graphql.CollectedField, obj *model.CouncilValidation) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefence_totalScore,
+		ec.fieldContext_CouncilValidation_conflicts,
 		func(ctx context.Context) (any, error) {
-			return obj.TotalScore, nil
+			return obj.Conflicts, nil
 		},
 		nil,
-		ec.marshalOInt2ᚖint32,
+		ec.marshalNCouncilConflict2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilConflictᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefence_totalScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilValidation_conflicts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefence",
+		Object:     "CouncilValidation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext_CouncilConflict_kind(ctx, field)
+			case "teacherCode":
+				return ec.fieldContext_CouncilConflict_teacherCode(ctx, field)
+			case "topicCouncilCode":
+				return ec.fieldContext_CouncilConflict_topicCouncilCode(ctx, field)
+			case "message":
+				return ec.fieldContext_CouncilConflict_message(ctx, field)
+			case "overridden":
+				return ec.fieldContext_CouncilConflict_overridden(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CouncilConflict", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefence_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+func (ec *executionContext) _Defence_id(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefence_createdAt,
+		ec.fieldContext_Defence_id,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalOTime2ᚖtimeᚐTime,
+		ec.marshalNID2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefence_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Defence_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefence",
+		Object:     "Defence",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefence_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+func (ec *executionContext) _Defence_title(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefence_updatedAt,
+		ec.fieldContext_Defence_title,
 		func(ctx context.Context) (any, error) {
-			return obj.UpdatedAt, nil
+			return obj.Title, nil
 		},
 		nil,
-		ec.marshalOTime2ᚖtimeᚐTime,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefence_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Defence_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefence",
+		Object:     "Defence",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefence_createdBy(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+func (ec *executionContext) _Defence_councilCode(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefence_createdBy,
+		ec.fieldContext_Defence_councilCode,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedBy, nil
+			return obj.CouncilCode, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefence_createdBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Defence_councilCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefence",
+		Object:     "Defence",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1109,25 +1281,25 @@ func (ec *executionContext) fieldContext_GradeDefence_createdBy(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefence_updatedBy(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+func (ec *executionContext) _Defence_teacherCode(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefence_updatedBy,
+		ec.fieldContext_Defence_teacherCode,
 		func(ctx context.Context) (any, error) {
-			return obj.UpdatedBy, nil
+			return obj.TeacherCode, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefence_updatedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Defence_teacherCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefence",
+		Object:     "Defence",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1138,225 +1310,141 @@ func (ec *executionContext) fieldContext_GradeDefence_updatedBy(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefence_defence(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+func (ec *executionContext) _Defence_position(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefence_defence,
+		ec.fieldContext_Defence_position,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.GradeDefence().Defence(ctx, obj)
+			return obj.Position, nil
 		},
 		nil,
-		ec.marshalODefence2ᚖthailyᚋsrcᚋgraphᚋmodelᚐDefence,
+		ec.marshalNDefencePosition2thailyᚋsrcᚋgraphᚋmodelᚐDefencePosition,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefence_defence(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Defence_position(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefence",
+		Object:     "Defence",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Defence_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Defence_title(ctx, field)
-			case "councilCode":
-				return ec.fieldContext_Defence_councilCode(ctx, field)
-			case "teacherCode":
-				return ec.fieldContext_Defence_teacherCode(ctx, field)
-			case "position":
-				return ec.fieldContext_Defence_position(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Defence_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Defence_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Defence_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Defence_updatedBy(ctx, field)
-			case "council":
-				return ec.fieldContext_Defence_council(ctx, field)
-			case "teacher":
-				return ec.fieldContext_Defence_teacher(ctx, field)
-			case "gradeDefences":
-				return ec.fieldContext_Defence_gradeDefences(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Defence", field.Name)
+			return nil, errors.New("field of type DefencePosition does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefence_enrollment(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+func (ec *executionContext) _Defence_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefence_enrollment,
+		ec.fieldContext_Defence_createdAt,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.GradeDefence().Enrollment(ctx, obj)
+			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalOEnrollment2ᚖthailyᚋsrcᚋgraphᚋmodelᚐEnrollment,
+		ec.marshalOTime2ᚖtimeᚐTime,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefence_enrollment(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Defence_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefence",
+		Object:     "Defence",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Enrollment_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Enrollment_title(ctx, field)
-			case "studentCode":
-				return ec.fieldContext_Enrollment_studentCode(ctx, field)
-			case "topicCouncilCode":
-				return ec.fieldContext_Enrollment_topicCouncilCode(ctx, field)
-			case "finalCode":
-				return ec.fieldContext_Enrollment_finalCode(ctx, field)
-			case "gradeReviewCode":
-				return ec.fieldContext_Enrollment_gradeReviewCode(ctx, field)
-			case "midtermCode":
-				return ec.fieldContext_Enrollment_midtermCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Enrollment_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Enrollment_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Enrollment_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Enrollment_updatedBy(ctx, field)
-			case "student":
-				return ec.fieldContext_Enrollment_student(ctx, field)
-			case "midterm":
-				return ec.fieldContext_Enrollment_midterm(ctx, field)
-			case "final":
-				return ec.fieldContext_Enrollment_final(ctx, field)
-			case "topicCouncil":
-				return ec.fieldContext_Enrollment_topicCouncil(ctx, field)
-			case "gradeReview":
-				return ec.fieldContext_Enrollment_gradeReview(ctx, field)
-			case "gradeDefences":
-				return ec.fieldContext_Enrollment_gradeDefences(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Enrollment", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefence_criteria(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+func (ec *executionContext) _Defence_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefence_criteria,
+		ec.fieldContext_Defence_updatedAt,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.GradeDefence().Criteria(ctx, obj)
+			return obj.UpdatedAt, nil
 		},
 		nil,
-		ec.marshalOGradeDefenceCriterion2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefenceCriterionᚄ,
+		ec.marshalOTime2ᚖtimeᚐTime,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefence_criteria(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Defence_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefence",
+		Object:     "Defence",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_GradeDefenceCriterion_id(ctx, field)
-			case "gradeDefenceCode":
-				return ec.fieldContext_GradeDefenceCriterion_gradeDefenceCode(ctx, field)
-			case "name":
-				return ec.fieldContext_GradeDefenceCriterion_name(ctx, field)
-			case "score":
-				return ec.fieldContext_GradeDefenceCriterion_score(ctx, field)
-			case "maxScore":
-				return ec.fieldContext_GradeDefenceCriterion_maxScore(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_GradeDefenceCriterion_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_GradeDefenceCriterion_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_GradeDefenceCriterion_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_GradeDefenceCriterion_updatedBy(ctx, field)
-			case "gradeDefence":
-				return ec.fieldContext_GradeDefenceCriterion_gradeDefence(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type GradeDefenceCriterion", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefenceCriterion_id(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+func (ec *executionContext) _Defence_createdBy(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefenceCriterion_id,
+		ec.fieldContext_Defence_createdBy,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.CreatedBy, nil
 		},
 		nil,
-		ec.marshalNID2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefenceCriterion_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Defence_createdBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefenceCriterion",
+		Object:     "Defence",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefenceCriterion_gradeDefenceCode(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+func (ec *executionContext) _Defence_updatedBy(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefenceCriterion_gradeDefenceCode,
+		ec.fieldContext_Defence_updatedBy,
 		func(ctx context.Context) (any, error) {
-			return obj.GradeDefenceCode, nil
+			return obj.UpdatedBy, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefenceCriterion_gradeDefenceCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Defence_updatedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefenceCriterion",
+		Object:     "Defence",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1367,320 +1455,2556 @@ func (ec *executionContext) fieldContext_GradeDefenceCriterion_gradeDefenceCode(
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefenceCriterion_name(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+func (ec *executionContext) _Defence_version(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefenceCriterion_name,
+		ec.fieldContext_Defence_version,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.Version, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefenceCriterion_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Defence_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefenceCriterion",
+		Object:     "Defence",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefenceCriterion_score(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+func (ec *executionContext) _Defence_council(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefenceCriterion_score,
+		ec.fieldContext_Defence_council,
 		func(ctx context.Context) (any, error) {
-			return obj.Score, nil
+			return ec.resolvers.Defence().Council(ctx, obj)
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefenceCriterion_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Defence_council(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefenceCriterion",
+		Object:     "Defence",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_Council_total(ctx, field)
+			case "id":
+				return ec.fieldContext_Council_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Council_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Council_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Council_semesterCode(ctx, field)
+			case "timeStart":
+				return ec.fieldContext_Council_timeStart(ctx, field)
+			case "room":
+				return ec.fieldContext_Council_room(ctx, field)
+			case "gradesLocked":
+				return ec.fieldContext_Council_gradesLocked(ctx, field)
+			case "gradesLockedAt":
+				return ec.fieldContext_Council_gradesLockedAt(ctx, field)
+			case "gradesPublished":
+				return ec.fieldContext_Council_gradesPublished(ctx, field)
+			case "gradesPublishedAt":
+				return ec.fieldContext_Council_gradesPublishedAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Council_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Council_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Council_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Council_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Council_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Council_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Council_deletedBy(ctx, field)
+			case "defences":
+				return ec.fieldContext_Council_defences(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Council_topicCouncils(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Council", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefenceCriterion_maxScore(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+func (ec *executionContext) _Defence_teacher(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefenceCriterion_maxScore,
+		ec.fieldContext_Defence_teacher,
 		func(ctx context.Context) (any, error) {
-			return obj.MaxScore, nil
+			return ec.resolvers.Defence().Teacher(ctx, obj)
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOTeacher2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacher,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefenceCriterion_maxScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Defence_teacher(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefenceCriterion",
+		Object:     "Defence",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Teacher_id(ctx, field)
+			case "email":
+				return ec.fieldContext_Teacher_email(ctx, field)
+			case "username":
+				return ec.fieldContext_Teacher_username(ctx, field)
+			case "gender":
+				return ec.fieldContext_Teacher_gender(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Teacher_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Teacher_semesterCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Teacher_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Teacher_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Teacher_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Teacher_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Teacher_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Teacher_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Teacher_deletedBy(ctx, field)
+			case "roles":
+				return ec.fieldContext_Teacher_roles(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Teacher", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefenceCriterion_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+func (ec *executionContext) _Defence_gradeDefences(ctx context.Context, field graphql.CollectedField, obj *model.Defence) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefenceCriterion_createdAt,
+		ec.fieldContext_Defence_gradeDefences,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return ec.resolvers.Defence().GradeDefences(ctx, obj)
 		},
 		nil,
-		ec.marshalOTime2ᚖtimeᚐTime,
+		ec.marshalOGradeDefence2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefenceᚄ,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefenceCriterion_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Defence_gradeDefences(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefenceCriterion",
+		Object:     "Defence",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_GradeDefence_id(ctx, field)
+			case "defenceCode":
+				return ec.fieldContext_GradeDefence_defenceCode(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_GradeDefence_enrollmentCode(ctx, field)
+			case "note":
+				return ec.fieldContext_GradeDefence_note(ctx, field)
+			case "totalScore":
+				return ec.fieldContext_GradeDefence_totalScore(ctx, field)
+			case "rubricTemplateCode":
+				return ec.fieldContext_GradeDefence_rubricTemplateCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_GradeDefence_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_GradeDefence_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_GradeDefence_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_GradeDefence_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_GradeDefence_version(ctx, field)
+			case "defence":
+				return ec.fieldContext_GradeDefence_defence(ctx, field)
+			case "enrollment":
+				return ec.fieldContext_GradeDefence_enrollment(ctx, field)
+			case "criteria":
+				return ec.fieldContext_GradeDefence_criteria(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GradeDefence", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DefenceSchedule_semesterCode(ctx context.Context, field graphql.CollectedField, obj *model.DefenceSchedule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DefenceSchedule_semesterCode,
+		func(ctx context.Context) (any, error) {
+			return obj.SemesterCode, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DefenceSchedule_semesterCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DefenceSchedule",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefenceCriterion_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+func (ec *executionContext) _DefenceSchedule_complete(ctx context.Context, field graphql.CollectedField, obj *model.DefenceSchedule) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefenceCriterion_updatedAt,
+		ec.fieldContext_DefenceSchedule_complete,
 		func(ctx context.Context) (any, error) {
-			return obj.UpdatedAt, nil
+			return obj.Complete, nil
 		},
 		nil,
-		ec.marshalOTime2ᚖtimeᚐTime,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefenceCriterion_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DefenceSchedule_complete(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefenceCriterion",
+		Object:     "DefenceSchedule",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefenceCriterion_createdBy(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+func (ec *executionContext) _DefenceSchedule_committed(ctx context.Context, field graphql.CollectedField, obj *model.DefenceSchedule) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefenceCriterion_createdBy,
+		ec.fieldContext_DefenceSchedule_committed,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedBy, nil
+			return obj.Committed, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefenceCriterion_createdBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DefenceSchedule_committed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefenceCriterion",
+		Object:     "DefenceSchedule",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefenceCriterion_updatedBy(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+func (ec *executionContext) _DefenceSchedule_sittings(ctx context.Context, field graphql.CollectedField, obj *model.DefenceSchedule) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefenceCriterion_updatedBy,
+		ec.fieldContext_DefenceSchedule_sittings,
 		func(ctx context.Context) (any, error) {
-			return obj.UpdatedBy, nil
+			return obj.Sittings, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNDefenceSitting2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceSittingᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefenceCriterion_updatedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DefenceSchedule_sittings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefenceCriterion",
+		Object:     "DefenceSchedule",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "councilCode":
+				return ec.fieldContext_DefenceSitting_councilCode(ctx, field)
+			case "room":
+				return ec.fieldContext_DefenceSitting_room(ctx, field)
+			case "start":
+				return ec.fieldContext_DefenceSitting_start(ctx, field)
+			case "end":
+				return ec.fieldContext_DefenceSitting_end(ctx, field)
+			case "sessions":
+				return ec.fieldContext_DefenceSitting_sessions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DefenceSitting", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefenceCriterion_gradeDefence(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+func (ec *executionContext) _DefenceSchedule_unassigned(ctx context.Context, field graphql.CollectedField, obj *model.DefenceSchedule) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefenceCriterion_gradeDefence,
+		ec.fieldContext_DefenceSchedule_unassigned,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.GradeDefenceCriterion().GradeDefence(ctx, obj)
+			return obj.Unassigned, nil
 		},
 		nil,
-		ec.marshalOGradeDefence2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefence,
+		ec.marshalNDefenceScheduleProblem2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceScheduleProblemᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefenceCriterion_gradeDefence(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DefenceSchedule_unassigned(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefenceCriterion",
+		Object:     "DefenceSchedule",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_GradeDefence_id(ctx, field)
-			case "defenceCode":
-				return ec.fieldContext_GradeDefence_defenceCode(ctx, field)
-			case "enrollmentCode":
-				return ec.fieldContext_GradeDefence_enrollmentCode(ctx, field)
-			case "note":
-				return ec.fieldContext_GradeDefence_note(ctx, field)
-			case "totalScore":
-				return ec.fieldContext_GradeDefence_totalScore(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_GradeDefence_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_GradeDefence_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_GradeDefence_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_GradeDefence_updatedBy(ctx, field)
-			case "defence":
-				return ec.fieldContext_GradeDefence_defence(ctx, field)
-			case "enrollment":
-				return ec.fieldContext_GradeDefence_enrollment(ctx, field)
-			case "criteria":
-				return ec.fieldContext_GradeDefence_criteria(ctx, field)
+			case "code":
+				return ec.fieldContext_DefenceScheduleProblem_code(ctx, field)
+			case "reason":
+				return ec.fieldContext_DefenceScheduleProblem_reason(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type GradeDefence", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type DefenceScheduleProblem", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-// endregion **************************** field.gotpl *****************************
+func (ec *executionContext) _DefenceSchedule_unplaced(ctx context.Context, field graphql.CollectedField, obj *model.DefenceSchedule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DefenceSchedule_unplaced,
+		func(ctx context.Context) (any, error) {
+			return obj.Unplaced, nil
+		},
+		nil,
+		ec.marshalNDefenceScheduleProblem2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceScheduleProblemᚄ,
+		true,
+		true,
+	)
+}
 
-// region    **************************** input.gotpl *****************************
+func (ec *executionContext) fieldContext_DefenceSchedule_unplaced(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DefenceSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_DefenceScheduleProblem_code(ctx, field)
+			case "reason":
+				return ec.fieldContext_DefenceScheduleProblem_reason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DefenceScheduleProblem", field.Name)
+		},
+	}
+	return fc, nil
+}
 
-// endregion **************************** input.gotpl *****************************
+func (ec *executionContext) _DefenceSchedule_csv(ctx context.Context, field graphql.CollectedField, obj *model.DefenceSchedule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DefenceSchedule_csv,
+		func(ctx context.Context) (any, error) {
+			return obj.CSV, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
 
-// region    ************************** interface.gotpl ***************************
+func (ec *executionContext) fieldContext_DefenceSchedule_csv(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DefenceSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
 
-// endregion ************************** interface.gotpl ***************************
+func (ec *executionContext) _DefenceScheduleProblem_code(ctx context.Context, field graphql.CollectedField, obj *model.DefenceScheduleProblem) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DefenceScheduleProblem_code,
+		func(ctx context.Context) (any, error) {
+			return obj.Code, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
 
-// region    **************************** object.gotpl ****************************
+func (ec *executionContext) fieldContext_DefenceScheduleProblem_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DefenceScheduleProblem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
 
-var councilImplementors = []string{"Council"}
+func (ec *executionContext) _DefenceScheduleProblem_reason(ctx context.Context, field graphql.CollectedField, obj *model.DefenceScheduleProblem) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DefenceScheduleProblem_reason,
+		func(ctx context.Context) (any, error) {
+			return obj.Reason, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
 
-func (ec *executionContext) _Council(ctx context.Context, sel ast.SelectionSet, obj *model.Council) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, councilImplementors)
+func (ec *executionContext) fieldContext_DefenceScheduleProblem_reason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DefenceScheduleProblem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Council")
-		case "total":
-			out.Values[i] = ec._Council_total(ctx, field, obj)
-		case "id":
-			out.Values[i] = ec._Council_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "title":
-			out.Values[i] = ec._Council_title(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "majorCode":
-			out.Values[i] = ec._Council_majorCode(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "semesterCode":
-			out.Values[i] = ec._Council_semesterCode(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "timeStart":
-			out.Values[i] = ec._Council_timeStart(ctx, field, obj)
-		case "createdAt":
+func (ec *executionContext) _DefenceSession_topicCouncilCode(ctx context.Context, field graphql.CollectedField, obj *model.DefenceSession) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DefenceSession_topicCouncilCode,
+		func(ctx context.Context) (any, error) {
+			return obj.TopicCouncilCode, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DefenceSession_topicCouncilCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DefenceSession",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DefenceSession_start(ctx context.Context, field graphql.CollectedField, obj *model.DefenceSession) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DefenceSession_start,
+		func(ctx context.Context) (any, error) {
+			return obj.Start, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DefenceSession_start(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DefenceSession",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DefenceSession_end(ctx context.Context, field graphql.CollectedField, obj *model.DefenceSession) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DefenceSession_end,
+		func(ctx context.Context) (any, error) {
+			return obj.End, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DefenceSession_end(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DefenceSession",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DefenceSitting_councilCode(ctx context.Context, field graphql.CollectedField, obj *model.DefenceSitting) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DefenceSitting_councilCode,
+		func(ctx context.Context) (any, error) {
+			return obj.CouncilCode, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DefenceSitting_councilCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DefenceSitting",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DefenceSitting_room(ctx context.Context, field graphql.CollectedField, obj *model.DefenceSitting) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DefenceSitting_room,
+		func(ctx context.Context) (any, error) {
+			return obj.Room, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DefenceSitting_room(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DefenceSitting",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DefenceSitting_start(ctx context.Context, field graphql.CollectedField, obj *model.DefenceSitting) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DefenceSitting_start,
+		func(ctx context.Context) (any, error) {
+			return obj.Start, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DefenceSitting_start(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DefenceSitting",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DefenceSitting_end(ctx context.Context, field graphql.CollectedField, obj *model.DefenceSitting) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DefenceSitting_end,
+		func(ctx context.Context) (any, error) {
+			return obj.End, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DefenceSitting_end(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DefenceSitting",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DefenceSitting_sessions(ctx context.Context, field graphql.CollectedField, obj *model.DefenceSitting) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DefenceSitting_sessions,
+		func(ctx context.Context) (any, error) {
+			return obj.Sessions, nil
+		},
+		nil,
+		ec.marshalNDefenceSession2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceSessionᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DefenceSitting_sessions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DefenceSitting",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "topicCouncilCode":
+				return ec.fieldContext_DefenceSession_topicCouncilCode(ctx, field)
+			case "start":
+				return ec.fieldContext_DefenceSession_start(ctx, field)
+			case "end":
+				return ec.fieldContext_DefenceSession_end(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DefenceSession", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefence_id(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefence_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefence_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefence_defenceCode(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefence_defenceCode,
+		func(ctx context.Context) (any, error) {
+			return obj.DefenceCode, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefence_defenceCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefence_enrollmentCode(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefence_enrollmentCode,
+		func(ctx context.Context) (any, error) {
+			return obj.EnrollmentCode, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefence_enrollmentCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefence_note(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefence_note,
+		func(ctx context.Context) (any, error) {
+			return obj.Note, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefence_note(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefence_totalScore(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefence_totalScore,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalScore, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefence_totalScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefence_rubricTemplateCode(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefence_rubricTemplateCode,
+		func(ctx context.Context) (any, error) {
+			return obj.RubricTemplateCode, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefence_rubricTemplateCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefence_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefence_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefence_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefence_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefence_updatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefence_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefence_createdBy(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefence_createdBy,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedBy, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefence_createdBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefence_updatedBy(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefence_updatedBy,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedBy, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefence_updatedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefence_version(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefence_version,
+		func(ctx context.Context) (any, error) {
+			return obj.Version, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefence_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefence_defence(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefence_defence,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.GradeDefence().Defence(ctx, obj)
+		},
+		nil,
+		ec.marshalODefence2ᚖthailyᚋsrcᚋgraphᚋmodelᚐDefence,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefence_defence(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefence",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Defence_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Defence_title(ctx, field)
+			case "councilCode":
+				return ec.fieldContext_Defence_councilCode(ctx, field)
+			case "teacherCode":
+				return ec.fieldContext_Defence_teacherCode(ctx, field)
+			case "position":
+				return ec.fieldContext_Defence_position(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Defence_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Defence_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Defence_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Defence_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Defence_version(ctx, field)
+			case "council":
+				return ec.fieldContext_Defence_council(ctx, field)
+			case "teacher":
+				return ec.fieldContext_Defence_teacher(ctx, field)
+			case "gradeDefences":
+				return ec.fieldContext_Defence_gradeDefences(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Defence", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefence_enrollment(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefence_enrollment,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.GradeDefence().Enrollment(ctx, obj)
+		},
+		nil,
+		ec.marshalOEnrollment2ᚖthailyᚋsrcᚋgraphᚋmodelᚐEnrollment,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefence_enrollment(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefence",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Enrollment_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Enrollment_title(ctx, field)
+			case "studentCode":
+				return ec.fieldContext_Enrollment_studentCode(ctx, field)
+			case "topicCouncilCode":
+				return ec.fieldContext_Enrollment_topicCouncilCode(ctx, field)
+			case "finalCode":
+				return ec.fieldContext_Enrollment_finalCode(ctx, field)
+			case "gradeReviewCode":
+				return ec.fieldContext_Enrollment_gradeReviewCode(ctx, field)
+			case "midtermCode":
+				return ec.fieldContext_Enrollment_midtermCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Enrollment_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Enrollment_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Enrollment_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Enrollment_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Enrollment_version(ctx, field)
+			case "student":
+				return ec.fieldContext_Enrollment_student(ctx, field)
+			case "midterm":
+				return ec.fieldContext_Enrollment_midterm(ctx, field)
+			case "final":
+				return ec.fieldContext_Enrollment_final(ctx, field)
+			case "topicCouncil":
+				return ec.fieldContext_Enrollment_topicCouncil(ctx, field)
+			case "gradeReview":
+				return ec.fieldContext_Enrollment_gradeReview(ctx, field)
+			case "gradeDefences":
+				return ec.fieldContext_Enrollment_gradeDefences(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Enrollment", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefence_criteria(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefence_criteria,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.GradeDefence().Criteria(ctx, obj)
+		},
+		nil,
+		ec.marshalOGradeDefenceCriterion2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefenceCriterionᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefence_criteria(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefence",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_GradeDefenceCriterion_id(ctx, field)
+			case "gradeDefenceCode":
+				return ec.fieldContext_GradeDefenceCriterion_gradeDefenceCode(ctx, field)
+			case "name":
+				return ec.fieldContext_GradeDefenceCriterion_name(ctx, field)
+			case "description":
+				return ec.fieldContext_GradeDefenceCriterion_description(ctx, field)
+			case "score":
+				return ec.fieldContext_GradeDefenceCriterion_score(ctx, field)
+			case "maxScore":
+				return ec.fieldContext_GradeDefenceCriterion_maxScore(ctx, field)
+			case "weight":
+				return ec.fieldContext_GradeDefenceCriterion_weight(ctx, field)
+			case "rubricCriterionCode":
+				return ec.fieldContext_GradeDefenceCriterion_rubricCriterionCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_GradeDefenceCriterion_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_GradeDefenceCriterion_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_GradeDefenceCriterion_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_GradeDefenceCriterion_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_GradeDefenceCriterion_version(ctx, field)
+			case "gradeDefence":
+				return ec.fieldContext_GradeDefenceCriterion_gradeDefence(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GradeDefenceCriterion", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefenceCriterion_id(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefenceCriterion_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefenceCriterion_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefenceCriterion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefenceCriterion_gradeDefenceCode(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefenceCriterion_gradeDefenceCode,
+		func(ctx context.Context) (any, error) {
+			return obj.GradeDefenceCode, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefenceCriterion_gradeDefenceCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefenceCriterion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefenceCriterion_name(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefenceCriterion_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefenceCriterion_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefenceCriterion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefenceCriterion_description(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefenceCriterion_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefenceCriterion_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefenceCriterion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefenceCriterion_score(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefenceCriterion_score,
+		func(ctx context.Context) (any, error) {
+			return obj.Score, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefenceCriterion_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefenceCriterion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefenceCriterion_maxScore(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefenceCriterion_maxScore,
+		func(ctx context.Context) (any, error) {
+			return obj.MaxScore, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefenceCriterion_maxScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefenceCriterion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefenceCriterion_weight(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefenceCriterion_weight,
+		func(ctx context.Context) (any, error) {
+			return obj.Weight, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefenceCriterion_weight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefenceCriterion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefenceCriterion_rubricCriterionCode(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefenceCriterion_rubricCriterionCode,
+		func(ctx context.Context) (any, error) {
+			return obj.RubricCriterionCode, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefenceCriterion_rubricCriterionCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefenceCriterion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefenceCriterion_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefenceCriterion_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefenceCriterion_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefenceCriterion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefenceCriterion_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefenceCriterion_updatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefenceCriterion_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefenceCriterion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefenceCriterion_createdBy(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefenceCriterion_createdBy,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedBy, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefenceCriterion_createdBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefenceCriterion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefenceCriterion_updatedBy(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefenceCriterion_updatedBy,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedBy, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefenceCriterion_updatedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefenceCriterion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefenceCriterion_version(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefenceCriterion_version,
+		func(ctx context.Context) (any, error) {
+			return obj.Version, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefenceCriterion_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefenceCriterion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeDefenceCriterion_gradeDefence(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeDefenceCriterion_gradeDefence,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.GradeDefenceCriterion().GradeDefence(ctx, obj)
+		},
+		nil,
+		ec.marshalOGradeDefence2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefence,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeDefenceCriterion_gradeDefence(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeDefenceCriterion",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_GradeDefence_id(ctx, field)
+			case "defenceCode":
+				return ec.fieldContext_GradeDefence_defenceCode(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_GradeDefence_enrollmentCode(ctx, field)
+			case "note":
+				return ec.fieldContext_GradeDefence_note(ctx, field)
+			case "totalScore":
+				return ec.fieldContext_GradeDefence_totalScore(ctx, field)
+			case "rubricTemplateCode":
+				return ec.fieldContext_GradeDefence_rubricTemplateCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_GradeDefence_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_GradeDefence_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_GradeDefence_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_GradeDefence_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_GradeDefence_version(ctx, field)
+			case "defence":
+				return ec.fieldContext_GradeDefence_defence(ctx, field)
+			case "enrollment":
+				return ec.fieldContext_GradeDefence_enrollment(ctx, field)
+			case "criteria":
+				return ec.fieldContext_GradeDefence_criteria(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GradeDefence", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RubricCriterion_id(ctx context.Context, field graphql.CollectedField, obj *model.RubricCriterion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RubricCriterion_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RubricCriterion_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RubricCriterion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RubricCriterion_name(ctx context.Context, field graphql.CollectedField, obj *model.RubricCriterion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RubricCriterion_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RubricCriterion_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RubricCriterion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RubricCriterion_description(ctx context.Context, field graphql.CollectedField, obj *model.RubricCriterion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RubricCriterion_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RubricCriterion_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RubricCriterion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RubricCriterion_maxScore(ctx context.Context, field graphql.CollectedField, obj *model.RubricCriterion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RubricCriterion_maxScore,
+		func(ctx context.Context) (any, error) {
+			return obj.MaxScore, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RubricCriterion_maxScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RubricCriterion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RubricCriterion_weight(ctx context.Context, field graphql.CollectedField, obj *model.RubricCriterion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RubricCriterion_weight,
+		func(ctx context.Context) (any, error) {
+			return obj.Weight, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RubricCriterion_weight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RubricCriterion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RubricCriterion_sortOrder(ctx context.Context, field graphql.CollectedField, obj *model.RubricCriterion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RubricCriterion_sortOrder,
+		func(ctx context.Context) (any, error) {
+			return obj.SortOrder, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RubricCriterion_sortOrder(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RubricCriterion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RubricTemplate_id(ctx context.Context, field graphql.CollectedField, obj *model.RubricTemplate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RubricTemplate_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RubricTemplate_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RubricTemplate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RubricTemplate_title(ctx context.Context, field graphql.CollectedField, obj *model.RubricTemplate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RubricTemplate_title,
+		func(ctx context.Context) (any, error) {
+			return obj.Title, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RubricTemplate_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RubricTemplate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RubricTemplate_majorCode(ctx context.Context, field graphql.CollectedField, obj *model.RubricTemplate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RubricTemplate_majorCode,
+		func(ctx context.Context) (any, error) {
+			return obj.MajorCode, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RubricTemplate_majorCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RubricTemplate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RubricTemplate_stage(ctx context.Context, field graphql.CollectedField, obj *model.RubricTemplate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RubricTemplate_stage,
+		func(ctx context.Context) (any, error) {
+			return obj.Stage, nil
+		},
+		nil,
+		ec.marshalNTopicStage2thailyᚋsrcᚋgraphᚋmodelᚐTopicStage,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RubricTemplate_stage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RubricTemplate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type TopicStage does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RubricTemplate_criteria(ctx context.Context, field graphql.CollectedField, obj *model.RubricTemplate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RubricTemplate_criteria,
+		func(ctx context.Context) (any, error) {
+			return obj.Criteria, nil
+		},
+		nil,
+		ec.marshalNRubricCriterion2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐRubricCriterionᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RubricTemplate_criteria(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RubricTemplate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RubricCriterion_id(ctx, field)
+			case "name":
+				return ec.fieldContext_RubricCriterion_name(ctx, field)
+			case "description":
+				return ec.fieldContext_RubricCriterion_description(ctx, field)
+			case "maxScore":
+				return ec.fieldContext_RubricCriterion_maxScore(ctx, field)
+			case "weight":
+				return ec.fieldContext_RubricCriterion_weight(ctx, field)
+			case "sortOrder":
+				return ec.fieldContext_RubricCriterion_sortOrder(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RubricCriterion", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RubricTemplate_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.RubricTemplate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RubricTemplate_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RubricTemplate_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RubricTemplate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RubricTemplate_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.RubricTemplate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RubricTemplate_updatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RubricTemplate_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RubricTemplate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RubricTemplate_createdBy(ctx context.Context, field graphql.CollectedField, obj *model.RubricTemplate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RubricTemplate_createdBy,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedBy, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RubricTemplate_createdBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RubricTemplate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RubricTemplate_updatedBy(ctx context.Context, field graphql.CollectedField, obj *model.RubricTemplate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RubricTemplate_updatedBy,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedBy, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RubricTemplate_updatedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RubricTemplate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RubricTemplate_version(ctx context.Context, field graphql.CollectedField, obj *model.RubricTemplate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RubricTemplate_version,
+		func(ctx context.Context) (any, error) {
+			return obj.Version, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RubricTemplate_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RubricTemplate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var councilImplementors = []string{"Council"}
+
+func (ec *executionContext) _Council(ctx context.Context, sel ast.SelectionSet, obj *model.Council) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, councilImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Council")
+		case "total":
+			out.Values[i] = ec._Council_total(ctx, field, obj)
+		case "id":
+			out.Values[i] = ec._Council_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "title":
+			out.Values[i] = ec._Council_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "majorCode":
+			out.Values[i] = ec._Council_majorCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "semesterCode":
+			out.Values[i] = ec._Council_semesterCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "timeStart":
+			out.Values[i] = ec._Council_timeStart(ctx, field, obj)
+		case "room":
+			out.Values[i] = ec._Council_room(ctx, field, obj)
+		case "gradesLocked":
+			out.Values[i] = ec._Council_gradesLocked(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "gradesLockedAt":
+			out.Values[i] = ec._Council_gradesLockedAt(ctx, field, obj)
+		case "gradesPublished":
+			out.Values[i] = ec._Council_gradesPublished(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "gradesPublishedAt":
+			out.Values[i] = ec._Council_gradesPublishedAt(ctx, field, obj)
+		case "createdAt":
 			out.Values[i] = ec._Council_createdAt(ctx, field, obj)
 		case "updatedAt":
-			out.Values[i] = ec._Council_updatedAt(ctx, field, obj)
+			out.Values[i] = ec._Council_updatedAt(ctx, field, obj)
+		case "createdBy":
+			out.Values[i] = ec._Council_createdBy(ctx, field, obj)
+		case "updatedBy":
+			out.Values[i] = ec._Council_updatedBy(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._Council_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "deletedAt":
+			out.Values[i] = ec._Council_deletedAt(ctx, field, obj)
+		case "deletedBy":
+			out.Values[i] = ec._Council_deletedBy(ctx, field, obj)
+		case "defences":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Council_defences(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "topicCouncils":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Council_topicCouncils(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var councilConflictImplementors = []string{"CouncilConflict"}
+
+func (ec *executionContext) _CouncilConflict(ctx context.Context, sel ast.SelectionSet, obj *model.CouncilConflict) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, councilConflictImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CouncilConflict")
+		case "kind":
+			out.Values[i] = ec._CouncilConflict_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "teacherCode":
+			out.Values[i] = ec._CouncilConflict_teacherCode(ctx, field, obj)
+		case "topicCouncilCode":
+			out.Values[i] = ec._CouncilConflict_topicCouncilCode(ctx, field, obj)
+		case "message":
+			out.Values[i] = ec._CouncilConflict_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "overridden":
+			out.Values[i] = ec._CouncilConflict_overridden(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var councilConflictOverrideImplementors = []string{"CouncilConflictOverride"}
+
+func (ec *executionContext) _CouncilConflictOverride(ctx context.Context, sel ast.SelectionSet, obj *model.CouncilConflictOverride) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, councilConflictOverrideImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CouncilConflictOverride")
+		case "id":
+			out.Values[i] = ec._CouncilConflictOverride_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "councilCode":
+			out.Values[i] = ec._CouncilConflictOverride_councilCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "kind":
+			out.Values[i] = ec._CouncilConflictOverride_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "teacherCode":
+			out.Values[i] = ec._CouncilConflictOverride_teacherCode(ctx, field, obj)
+		case "topicCouncilCode":
+			out.Values[i] = ec._CouncilConflictOverride_topicCouncilCode(ctx, field, obj)
+		case "message":
+			out.Values[i] = ec._CouncilConflictOverride_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reason":
+			out.Values[i] = ec._CouncilConflictOverride_reason(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._CouncilConflictOverride_createdAt(ctx, field, obj)
+		case "createdBy":
+			out.Values[i] = ec._CouncilConflictOverride_createdBy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var councilValidationImplementors = []string{"CouncilValidation"}
+
+func (ec *executionContext) _CouncilValidation(ctx context.Context, sel ast.SelectionSet, obj *model.CouncilValidation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, councilValidationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CouncilValidation")
+		case "councilCode":
+			out.Values[i] = ec._CouncilValidation_councilCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "valid":
+			out.Values[i] = ec._CouncilValidation_valid(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "conflicts":
+			out.Values[i] = ec._CouncilValidation_conflicts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var defenceImplementors = []string{"Defence"}
+
+func (ec *executionContext) _Defence(ctx context.Context, sel ast.SelectionSet, obj *model.Defence) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, defenceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Defence")
+		case "id":
+			out.Values[i] = ec._Defence_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "title":
+			out.Values[i] = ec._Defence_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "councilCode":
+			out.Values[i] = ec._Defence_councilCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "teacherCode":
+			out.Values[i] = ec._Defence_teacherCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "position":
+			out.Values[i] = ec._Defence_position(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "createdAt":
+			out.Values[i] = ec._Defence_createdAt(ctx, field, obj)
+		case "updatedAt":
+			out.Values[i] = ec._Defence_updatedAt(ctx, field, obj)
 		case "createdBy":
-			out.Values[i] = ec._Council_createdBy(ctx, field, obj)
+			out.Values[i] = ec._Defence_createdBy(ctx, field, obj)
 		case "updatedBy":
-			out.Values[i] = ec._Council_updatedBy(ctx, field, obj)
-		case "defences":
+			out.Values[i] = ec._Defence_updatedBy(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._Defence_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "council":
 			field := field
 
 			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
@@ -1689,7 +4013,73 @@ func (ec *executionContext) _Council(ctx context.Context, sel ast.SelectionSet,
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Council_defences(ctx, field, obj)
+				res = ec._Defence_council(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "teacher":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Defence_teacher(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "gradeDefences":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Defence_gradeDefences(ctx, field, obj)
 				return res
 			}
 
@@ -1707,45 +4097,174 @@ func (ec *executionContext) _Council(ctx context.Context, sel ast.SelectionSet,
 					return innerFunc(ctx, dfs)
 				})
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var defenceScheduleImplementors = []string{"DefenceSchedule"}
+
+func (ec *executionContext) _DefenceSchedule(ctx context.Context, sel ast.SelectionSet, obj *model.DefenceSchedule) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, defenceScheduleImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DefenceSchedule")
+		case "semesterCode":
+			out.Values[i] = ec._DefenceSchedule_semesterCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "complete":
+			out.Values[i] = ec._DefenceSchedule_complete(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "committed":
+			out.Values[i] = ec._DefenceSchedule_committed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sittings":
+			out.Values[i] = ec._DefenceSchedule_sittings(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "unassigned":
+			out.Values[i] = ec._DefenceSchedule_unassigned(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "unplaced":
+			out.Values[i] = ec._DefenceSchedule_unplaced(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "csv":
+			out.Values[i] = ec._DefenceSchedule_csv(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var defenceScheduleProblemImplementors = []string{"DefenceScheduleProblem"}
+
+func (ec *executionContext) _DefenceScheduleProblem(ctx context.Context, sel ast.SelectionSet, obj *model.DefenceScheduleProblem) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, defenceScheduleProblemImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DefenceScheduleProblem")
+		case "code":
+			out.Values[i] = ec._DefenceScheduleProblem_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reason":
+			out.Values[i] = ec._DefenceScheduleProblem_reason(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "topicCouncils":
-			field := field
+	return out
+}
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Council_topicCouncils(ctx, field, obj)
-				return res
-			}
+var defenceSessionImplementors = []string{"DefenceSession"}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+func (ec *executionContext) _DefenceSession(ctx context.Context, sel ast.SelectionSet, obj *model.DefenceSession) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, defenceSessionImplementors)
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DefenceSession")
+		case "topicCouncilCode":
+			out.Values[i] = ec._DefenceSession_topicCouncilCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "start":
+			out.Values[i] = ec._DefenceSession_start(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "end":
+			out.Values[i] = ec._DefenceSession_end(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -1769,51 +4288,111 @@ func (ec *executionContext) _Council(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var defenceImplementors = []string{"Defence"}
+var defenceSittingImplementors = []string{"DefenceSitting"}
 
-func (ec *executionContext) _Defence(ctx context.Context, sel ast.SelectionSet, obj *model.Defence) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, defenceImplementors)
+func (ec *executionContext) _DefenceSitting(ctx context.Context, sel ast.SelectionSet, obj *model.DefenceSitting) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, defenceSittingImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Defence")
-		case "id":
-			out.Values[i] = ec._Defence_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("DefenceSitting")
+		case "councilCode":
+			out.Values[i] = ec._DefenceSitting_councilCode(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "title":
-			out.Values[i] = ec._Defence_title(ctx, field, obj)
+		case "room":
+			out.Values[i] = ec._DefenceSitting_room(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "councilCode":
-			out.Values[i] = ec._Defence_councilCode(ctx, field, obj)
+		case "start":
+			out.Values[i] = ec._DefenceSitting_start(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "end":
+			out.Values[i] = ec._DefenceSitting_end(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sessions":
+			out.Values[i] = ec._DefenceSitting_sessions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var gradeDefenceImplementors = []string{"GradeDefence"}
+
+func (ec *executionContext) _GradeDefence(ctx context.Context, sel ast.SelectionSet, obj *model.GradeDefence) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, gradeDefenceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GradeDefence")
+		case "id":
+			out.Values[i] = ec._GradeDefence_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "teacherCode":
-			out.Values[i] = ec._Defence_teacherCode(ctx, field, obj)
+		case "defenceCode":
+			out.Values[i] = ec._GradeDefence_defenceCode(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "position":
-			out.Values[i] = ec._Defence_position(ctx, field, obj)
+		case "enrollmentCode":
+			out.Values[i] = ec._GradeDefence_enrollmentCode(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
+		case "note":
+			out.Values[i] = ec._GradeDefence_note(ctx, field, obj)
+		case "totalScore":
+			out.Values[i] = ec._GradeDefence_totalScore(ctx, field, obj)
+		case "rubricTemplateCode":
+			out.Values[i] = ec._GradeDefence_rubricTemplateCode(ctx, field, obj)
 		case "createdAt":
-			out.Values[i] = ec._Defence_createdAt(ctx, field, obj)
+			out.Values[i] = ec._GradeDefence_createdAt(ctx, field, obj)
 		case "updatedAt":
-			out.Values[i] = ec._Defence_updatedAt(ctx, field, obj)
+			out.Values[i] = ec._GradeDefence_updatedAt(ctx, field, obj)
 		case "createdBy":
-			out.Values[i] = ec._Defence_createdBy(ctx, field, obj)
+			out.Values[i] = ec._GradeDefence_createdBy(ctx, field, obj)
 		case "updatedBy":
-			out.Values[i] = ec._Defence_updatedBy(ctx, field, obj)
-		case "council":
+			out.Values[i] = ec._GradeDefence_updatedBy(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._GradeDefence_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "defence":
 			field := field
 
 			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
@@ -1822,7 +4401,7 @@ func (ec *executionContext) _Defence(ctx context.Context, sel ast.SelectionSet,
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Defence_council(ctx, field, obj)
+				res = ec._GradeDefence_defence(ctx, field, obj)
 				return res
 			}
 
@@ -1846,7 +4425,7 @@ func (ec *executionContext) _Defence(ctx context.Context, sel ast.SelectionSet,
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "teacher":
+		case "enrollment":
 			field := field
 
 			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
@@ -1855,7 +4434,7 @@ func (ec *executionContext) _Defence(ctx context.Context, sel ast.SelectionSet,
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Defence_teacher(ctx, field, obj)
+				res = ec._GradeDefence_enrollment(ctx, field, obj)
 				return res
 			}
 
@@ -1879,7 +4458,7 @@ func (ec *executionContext) _Defence(ctx context.Context, sel ast.SelectionSet,
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "gradeDefences":
+		case "criteria":
 			field := field
 
 			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
@@ -1888,7 +4467,7 @@ func (ec *executionContext) _Defence(ctx context.Context, sel ast.SelectionSet,
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Defence_gradeDefences(ctx, field, obj)
+				res = ec._GradeDefence_criteria(ctx, field, obj)
 				return res
 			}
 
@@ -1935,78 +4514,59 @@ func (ec *executionContext) _Defence(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var gradeDefenceImplementors = []string{"GradeDefence"}
+var gradeDefenceCriterionImplementors = []string{"GradeDefenceCriterion"}
 
-func (ec *executionContext) _GradeDefence(ctx context.Context, sel ast.SelectionSet, obj *model.GradeDefence) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, gradeDefenceImplementors)
+func (ec *executionContext) _GradeDefenceCriterion(ctx context.Context, sel ast.SelectionSet, obj *model.GradeDefenceCriterion) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, gradeDefenceCriterionImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("GradeDefence")
+			out.Values[i] = graphql.MarshalString("GradeDefenceCriterion")
 		case "id":
-			out.Values[i] = ec._GradeDefence_id(ctx, field, obj)
+			out.Values[i] = ec._GradeDefenceCriterion_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "defenceCode":
-			out.Values[i] = ec._GradeDefence_defenceCode(ctx, field, obj)
+		case "gradeDefenceCode":
+			out.Values[i] = ec._GradeDefenceCriterion_gradeDefenceCode(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "enrollmentCode":
-			out.Values[i] = ec._GradeDefence_enrollmentCode(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._GradeDefenceCriterion_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec._GradeDefenceCriterion_description(ctx, field, obj)
+		case "score":
+			out.Values[i] = ec._GradeDefenceCriterion_score(ctx, field, obj)
+		case "maxScore":
+			out.Values[i] = ec._GradeDefenceCriterion_maxScore(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "note":
-			out.Values[i] = ec._GradeDefence_note(ctx, field, obj)
-		case "totalScore":
-			out.Values[i] = ec._GradeDefence_totalScore(ctx, field, obj)
+		case "weight":
+			out.Values[i] = ec._GradeDefenceCriterion_weight(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "rubricCriterionCode":
+			out.Values[i] = ec._GradeDefenceCriterion_rubricCriterionCode(ctx, field, obj)
 		case "createdAt":
-			out.Values[i] = ec._GradeDefence_createdAt(ctx, field, obj)
+			out.Values[i] = ec._GradeDefenceCriterion_createdAt(ctx, field, obj)
 		case "updatedAt":
-			out.Values[i] = ec._GradeDefence_updatedAt(ctx, field, obj)
+			out.Values[i] = ec._GradeDefenceCriterion_updatedAt(ctx, field, obj)
 		case "createdBy":
-			out.Values[i] = ec._GradeDefence_createdBy(ctx, field, obj)
+			out.Values[i] = ec._GradeDefenceCriterion_createdBy(ctx, field, obj)
 		case "updatedBy":
-			out.Values[i] = ec._GradeDefence_updatedBy(ctx, field, obj)
-		case "defence":
-			field := field
-
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._GradeDefence_defence(ctx, field, obj)
-				return res
-			}
-
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+			out.Values[i] = ec._GradeDefenceCriterion_updatedBy(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._GradeDefenceCriterion_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "enrollment":
+		case "gradeDefence":
 			field := field
 
 			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
@@ -2015,7 +4575,7 @@ func (ec *executionContext) _GradeDefence(ctx context.Context, sel ast.Selection
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._GradeDefence_enrollment(ctx, field, obj)
+				res = ec._GradeDefenceCriterion_gradeDefence(ctx, field, obj)
 				return res
 			}
 
@@ -2039,39 +4599,67 @@ func (ec *executionContext) _GradeDefence(ctx context.Context, sel ast.Selection
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "criteria":
-			field := field
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._GradeDefence_criteria(ctx, field, obj)
-				return res
-			}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+	return out
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+var rubricCriterionImplementors = []string{"RubricCriterion"}
+
+func (ec *executionContext) _RubricCriterion(ctx context.Context, sel ast.SelectionSet, obj *model.RubricCriterion) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, rubricCriterionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RubricCriterion")
+		case "id":
+			out.Values[i] = ec._RubricCriterion_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._RubricCriterion_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec._RubricCriterion_description(ctx, field, obj)
+		case "maxScore":
+			out.Values[i] = ec._RubricCriterion_maxScore(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "weight":
+			out.Values[i] = ec._RubricCriterion_weight(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sortOrder":
+			out.Values[i] = ec._RubricCriterion_sortOrder(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -2095,106 +4683,345 @@ func (ec *executionContext) _GradeDefence(ctx context.Context, sel ast.Selection
 	return out
 }
 
-var gradeDefenceCriterionImplementors = []string{"GradeDefenceCriterion"}
+var rubricTemplateImplementors = []string{"RubricTemplate"}
 
-func (ec *executionContext) _GradeDefenceCriterion(ctx context.Context, sel ast.SelectionSet, obj *model.GradeDefenceCriterion) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, gradeDefenceCriterionImplementors)
+func (ec *executionContext) _RubricTemplate(ctx context.Context, sel ast.SelectionSet, obj *model.RubricTemplate) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, rubricTemplateImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("GradeDefenceCriterion")
+			out.Values[i] = graphql.MarshalString("RubricTemplate")
 		case "id":
-			out.Values[i] = ec._GradeDefenceCriterion_id(ctx, field, obj)
+			out.Values[i] = ec._RubricTemplate_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "gradeDefenceCode":
-			out.Values[i] = ec._GradeDefenceCriterion_gradeDefenceCode(ctx, field, obj)
+		case "title":
+			out.Values[i] = ec._RubricTemplate_title(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
+			}
+		case "majorCode":
+			out.Values[i] = ec._RubricTemplate_majorCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "stage":
+			out.Values[i] = ec._RubricTemplate_stage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "criteria":
+			out.Values[i] = ec._RubricTemplate_criteria(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-		case "name":
-			out.Values[i] = ec._GradeDefenceCriterion_name(ctx, field, obj)
-		case "score":
-			out.Values[i] = ec._GradeDefenceCriterion_score(ctx, field, obj)
-		case "maxScore":
-			out.Values[i] = ec._GradeDefenceCriterion_maxScore(ctx, field, obj)
 		case "createdAt":
-			out.Values[i] = ec._GradeDefenceCriterion_createdAt(ctx, field, obj)
+			out.Values[i] = ec._RubricTemplate_createdAt(ctx, field, obj)
 		case "updatedAt":
-			out.Values[i] = ec._GradeDefenceCriterion_updatedAt(ctx, field, obj)
+			out.Values[i] = ec._RubricTemplate_updatedAt(ctx, field, obj)
 		case "createdBy":
-			out.Values[i] = ec._GradeDefenceCriterion_createdBy(ctx, field, obj)
+			out.Values[i] = ec._RubricTemplate_createdBy(ctx, field, obj)
 		case "updatedBy":
-			out.Values[i] = ec._GradeDefenceCriterion_updatedBy(ctx, field, obj)
-		case "gradeDefence":
-			field := field
+			out.Values[i] = ec._RubricTemplate_updatedBy(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._RubricTemplate_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._GradeDefenceCriterion_gradeDefence(ctx, field, obj)
-				return res
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) marshalNCouncil2thailyᚋsrcᚋgraphᚋmodelᚐCouncil(ctx context.Context, sel ast.SelectionSet, v model.Council) graphql.Marshaler {
+	return ec._Council(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCouncil2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Council) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil(ctx context.Context, sel ast.SelectionSet, v *model.Council) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Council(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCouncilConflict2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilConflictᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.CouncilConflict) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNCouncilConflict2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilConflict(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNCouncilConflict2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilConflict(ctx context.Context, sel ast.SelectionSet, v *model.CouncilConflict) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CouncilConflict(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNCouncilConflictKind2thailyᚋsrcᚋgraphᚋmodelᚐCouncilConflictKind(ctx context.Context, v any) (model.CouncilConflictKind, error) {
+	var res model.CouncilConflictKind
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCouncilConflictKind2thailyᚋsrcᚋgraphᚋmodelᚐCouncilConflictKind(ctx context.Context, sel ast.SelectionSet, v model.CouncilConflictKind) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNCouncilConflictOverride2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilConflictOverrideᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.CouncilConflictOverride) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCouncilConflictOverride2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilConflictOverride(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+	return ret
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNCouncilConflictOverride2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilConflictOverride(ctx context.Context, sel ast.SelectionSet, v *model.CouncilConflictOverride) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	return ec._CouncilConflictOverride(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCouncilValidation2thailyᚋsrcᚋgraphᚋmodelᚐCouncilValidation(ctx context.Context, sel ast.SelectionSet, v model.CouncilValidation) graphql.Marshaler {
+	return ec._CouncilValidation(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCouncilValidation2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilValidation(ctx context.Context, sel ast.SelectionSet, v *model.CouncilValidation) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec._CouncilValidation(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNDefence2thailyᚋsrcᚋgraphᚋmodelᚐDefence(ctx context.Context, sel ast.SelectionSet, v model.Defence) graphql.Marshaler {
+	return ec._Defence(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNDefence2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Defence) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNDefence2ᚖthailyᚋsrcᚋgraphᚋmodelᚐDefence(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
 	}
+	wg.Wait()
 
-	return out
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-// endregion **************************** object.gotpl ****************************
+func (ec *executionContext) marshalNDefence2ᚖthailyᚋsrcᚋgraphᚋmodelᚐDefence(ctx context.Context, sel ast.SelectionSet, v *model.Defence) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Defence(ctx, sel, v)
+}
 
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) marshalNDefenceSchedule2thailyᚋsrcᚋgraphᚋmodelᚐDefenceSchedule(ctx context.Context, sel ast.SelectionSet, v model.DefenceSchedule) graphql.Marshaler {
+	return ec._DefenceSchedule(ctx, sel, &v)
+}
 
-func (ec *executionContext) marshalNCouncil2thailyᚋsrcᚋgraphᚋmodelᚐCouncil(ctx context.Context, sel ast.SelectionSet, v model.Council) graphql.Marshaler {
-	return ec._Council(ctx, sel, &v)
+func (ec *executionContext) marshalNDefenceSchedule2ᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceSchedule(ctx context.Context, sel ast.SelectionSet, v *model.DefenceSchedule) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DefenceSchedule(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNCouncil2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Council) graphql.Marshaler {
+func (ec *executionContext) marshalNDefenceScheduleProblem2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceScheduleProblemᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DefenceScheduleProblem) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -2218,7 +5045,7 @@ func (ec *executionContext) marshalNCouncil2ᚕᚖthailyᚋsrcᚋgraphᚋmodel
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil(ctx, sel, v[i])
+			ret[i] = ec.marshalNDefenceScheduleProblem2ᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceScheduleProblem(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -2238,21 +5065,71 @@ func (ec *executionContext) marshalNCouncil2ᚕᚖthailyᚋsrcᚋgraphᚋmodel
 	return ret
 }
 
-func (ec *executionContext) marshalNCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil(ctx context.Context, sel ast.SelectionSet, v *model.Council) graphql.Marshaler {
+func (ec *executionContext) marshalNDefenceScheduleProblem2ᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceScheduleProblem(ctx context.Context, sel ast.SelectionSet, v *model.DefenceScheduleProblem) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Council(ctx, sel, v)
+	return ec._DefenceScheduleProblem(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNDefence2thailyᚋsrcᚋgraphᚋmodelᚐDefence(ctx context.Context, sel ast.SelectionSet, v model.Defence) graphql.Marshaler {
-	return ec._Defence(ctx, sel, &v)
+func (ec *executionContext) marshalNDefenceSession2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceSessionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DefenceSession) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNDefenceSession2ᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceSession(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalNDefence2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Defence) graphql.Marshaler {
+func (ec *executionContext) marshalNDefenceSession2ᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceSession(ctx context.Context, sel ast.SelectionSet, v *model.DefenceSession) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DefenceSession(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNDefenceSitting2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceSittingᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DefenceSitting) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -2276,7 +5153,7 @@ func (ec *executionContext) marshalNDefence2ᚕᚖthailyᚋsrcᚋgraphᚋmodel
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNDefence2ᚖthailyᚋsrcᚋgraphᚋmodelᚐDefence(ctx, sel, v[i])
+			ret[i] = ec.marshalNDefenceSitting2ᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceSitting(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -2296,14 +5173,14 @@ func (ec *executionContext) marshalNDefence2ᚕᚖthailyᚋsrcᚋgraphᚋmodel
 	return ret
 }
 
-func (ec *executionContext) marshalNDefence2ᚖthailyᚋsrcᚋgraphᚋmodelᚐDefence(ctx context.Context, sel ast.SelectionSet, v *model.Defence) graphql.Marshaler {
+func (ec *executionContext) marshalNDefenceSitting2ᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceSitting(ctx context.Context, sel ast.SelectionSet, v *model.DefenceSitting) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Defence(ctx, sel, v)
+	return ec._DefenceSitting(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalNGradeDefence2thailyᚋsrcᚋgraphᚋmodelᚐGradeDefence(ctx context.Context, sel ast.SelectionSet, v model.GradeDefence) graphql.Marshaler {
@@ -2422,6 +5299,118 @@ func (ec *executionContext) marshalNGradeDefenceCriterion2ᚖthailyᚋsrcᚋgrap
 	return ec._GradeDefenceCriterion(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNRubricCriterion2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐRubricCriterionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RubricCriterion) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRubricCriterion2ᚖthailyᚋsrcᚋgraphᚋmodelᚐRubricCriterion(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNRubricCriterion2ᚖthailyᚋsrcᚋgraphᚋmodelᚐRubricCriterion(ctx context.Context, sel ast.SelectionSet, v *model.RubricCriterion) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RubricCriterion(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRubricTemplate2thailyᚋsrcᚋgraphᚋmodelᚐRubricTemplate(ctx context.Context, sel ast.SelectionSet, v model.RubricTemplate) graphql.Marshaler {
+	return ec._RubricTemplate(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRubricTemplate2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐRubricTemplateᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RubricTemplate) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRubricTemplate2ᚖthailyᚋsrcᚋgraphᚋmodelᚐRubricTemplate(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNRubricTemplate2ᚖthailyᚋsrcᚋgraphᚋmodelᚐRubricTemplate(ctx context.Context, sel ast.SelectionSet, v *model.RubricTemplate) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RubricTemplate(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil(ctx context.Context, sel ast.SelectionSet, v *model.Council) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -75,7 +75,7 @@ func (ec *executionContext) unmarshalInputCreateDefenceInput(ctx context.Context
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"title", "councilCode", "teacherCode", "position"}
+	fieldsInOrder := [...]string{"title", "councilCode", "teacherCode", "position", "overrideReason"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -110,6 +110,13 @@ func (ec *executionContext) unmarshalInputCreateDefenceInput(ctx context.Context
 				return it, err
 			}
 			it.Position = data
+		case "overrideReason":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("overrideReason"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OverrideReason = data
 		}
 	}
 
@@ -324,6 +324,35 @@ func (ec *executionContext) fieldContext_RoleSystem_updatedBy(_ context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _RoleSystem_version(ctx context.Context, field graphql.CollectedField, obj *model.RoleSystem) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoleSystem_version,
+		func(ctx context.Context) (any, error) {
+			return obj.Version, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoleSystem_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoleSystem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _RoleSystem_teacher(ctx context.Context, field graphql.CollectedField, obj *model.RoleSystem) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -368,6 +397,12 @@ func (ec *executionContext) fieldContext_RoleSystem_teacher(_ context.Context, f
 				return ec.fieldContext_Teacher_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Teacher_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Teacher_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Teacher_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Teacher_deletedBy(ctx, field)
 			case "roles":
 				return ec.fieldContext_Teacher_roles(ctx, field)
 			}
@@ -413,6 +448,12 @@ func (ec *executionContext) fieldContext_RoleSystem_semester(_ context.Context,
 				return ec.fieldContext_Semester_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Semester_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Semester_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Semester_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Semester_deletedBy(ctx, field)
 			case "students":
 				return ec.fieldContext_Semester_students(ctx, field)
 			case "teachers":
@@ -484,6 +525,11 @@ func (ec *executionContext) _RoleSystem(ctx context.Context, sel ast.SelectionSe
 			out.Values[i] = ec._RoleSystem_createdBy(ctx, field, obj)
 		case "updatedBy":
 			out.Values[i] = ec._RoleSystem_updatedBy(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._RoleSystem_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
 		case "teacher":
 			field := field
 
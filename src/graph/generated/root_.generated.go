@@ -42,6 +42,7 @@ type ResolverRoot interface {
 	Defence() DefenceResolver
 	Enrollment() EnrollmentResolver
 	Faculty() FacultyResolver
+	File() FileResolver
 	GradeDefence() GradeDefenceResolver
 	GradeDefenceCriterion() GradeDefenceCriterionResolver
 	GradeReview() GradeReviewResolver
@@ -77,19 +78,74 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	ApplicantRanking struct {
+		Restricted   func(childComplexity int) int
+		StudentCodes func(childComplexity int) int
+		TopicCode    func(childComplexity int) int
+	}
+
+	AuditChange struct {
+		After  func(childComplexity int) int
+		Before func(childComplexity int) int
+		Field  func(childComplexity int) int
+	}
+
+	AuditLogEntry struct {
+		Actor     func(childComplexity int) int
+		At        func(childComplexity int) int
+		Changes   func(childComplexity int) int
+		ClientIP  func(childComplexity int) int
+		Entity    func(childComplexity int) int
+		EntityID  func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Method    func(childComplexity int) int
+		RequestID func(childComplexity int) int
+		Role      func(childComplexity int) int
+		Semester  func(childComplexity int) int
+		Service   func(childComplexity int) int
+	}
+
 	Council struct {
-		CreatedAt     func(childComplexity int) int
-		CreatedBy     func(childComplexity int) int
-		Defences      func(childComplexity int) int
-		ID            func(childComplexity int) int
-		MajorCode     func(childComplexity int) int
-		SemesterCode  func(childComplexity int) int
-		TimeStart     func(childComplexity int) int
-		Title         func(childComplexity int) int
-		TopicCouncils func(childComplexity int) int
-		Total         func(childComplexity int) int
-		UpdatedAt     func(childComplexity int) int
-		UpdatedBy     func(childComplexity int) int
+		CreatedAt         func(childComplexity int) int
+		CreatedBy         func(childComplexity int) int
+		Defences          func(childComplexity int) int
+		DeletedAt         func(childComplexity int) int
+		DeletedBy         func(childComplexity int) int
+		GradesLocked      func(childComplexity int) int
+		GradesLockedAt    func(childComplexity int) int
+		GradesPublished   func(childComplexity int) int
+		GradesPublishedAt func(childComplexity int) int
+		ID                func(childComplexity int) int
+		MajorCode         func(childComplexity int) int
+		Room              func(childComplexity int) int
+		SemesterCode      func(childComplexity int) int
+		TimeStart         func(childComplexity int) int
+		Title             func(childComplexity int) int
+		TopicCouncils     func(childComplexity int) int
+		Total             func(childComplexity int) int
+		UpdatedAt         func(childComplexity int) int
+		UpdatedBy         func(childComplexity int) int
+		Version           func(childComplexity int) int
+	}
+
+	CouncilConflict struct {
+		Kind             func(childComplexity int) int
+		Message          func(childComplexity int) int
+		Overridden       func(childComplexity int) int
+		TeacherCode      func(childComplexity int) int
+		TopicCouncilCode func(childComplexity int) int
+	}
+
+	CouncilConflictOverride struct {
+		CouncilCode      func(childComplexity int) int
+		CreatedAt        func(childComplexity int) int
+		CreatedBy        func(childComplexity int) int
+		ID               func(childComplexity int) int
+		Kind             func(childComplexity int) int
+		Message          func(childComplexity int) int
+		Reason           func(childComplexity int) int
+		TeacherCode      func(childComplexity int) int
+		TopicCouncilCode func(childComplexity int) int
 	}
 
 	CouncilDefence struct {
@@ -175,6 +231,26 @@ type ComplexityRoot struct {
 		Total func(childComplexity int) int
 	}
 
+	CouncilValidation struct {
+		Conflicts   func(childComplexity int) int
+		CouncilCode func(childComplexity int) int
+		Valid       func(childComplexity int) int
+	}
+
+	DeadlineExtension struct {
+		CreatedAt    func(childComplexity int) int
+		CreatedBy    func(childComplexity int) int
+		DueAt        func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Kind         func(childComplexity int) int
+		Reason       func(childComplexity int) int
+		SemesterCode func(childComplexity int) int
+		Stage        func(childComplexity int) int
+		StudentCode  func(childComplexity int) int
+		UpdatedAt    func(childComplexity int) int
+		UpdatedBy    func(childComplexity int) int
+	}
+
 	Defence struct {
 		Council       func(childComplexity int) int
 		CouncilCode   func(childComplexity int) int
@@ -188,6 +264,7 @@ type ComplexityRoot struct {
 		Title         func(childComplexity int) int
 		UpdatedAt     func(childComplexity int) int
 		UpdatedBy     func(childComplexity int) int
+		Version       func(childComplexity int) int
 	}
 
 	DefenceListResponse struct {
@@ -195,6 +272,35 @@ type ComplexityRoot struct {
 		Total func(childComplexity int) int
 	}
 
+	DefenceSchedule struct {
+		CSV          func(childComplexity int) int
+		Committed    func(childComplexity int) int
+		Complete     func(childComplexity int) int
+		SemesterCode func(childComplexity int) int
+		Sittings     func(childComplexity int) int
+		Unassigned   func(childComplexity int) int
+		Unplaced     func(childComplexity int) int
+	}
+
+	DefenceScheduleProblem struct {
+		Code   func(childComplexity int) int
+		Reason func(childComplexity int) int
+	}
+
+	DefenceSession struct {
+		End              func(childComplexity int) int
+		Start            func(childComplexity int) int
+		TopicCouncilCode func(childComplexity int) int
+	}
+
+	DefenceSitting struct {
+		CouncilCode func(childComplexity int) int
+		End         func(childComplexity int) int
+		Room        func(childComplexity int) int
+		Sessions    func(childComplexity int) int
+		Start       func(childComplexity int) int
+	}
+
 	Enrollment struct {
 		CreatedAt        func(childComplexity int) int
 		CreatedBy        func(childComplexity int) int
@@ -213,6 +319,7 @@ type ComplexityRoot struct {
 		TopicCouncilCode func(childComplexity int) int
 		UpdatedAt        func(childComplexity int) int
 		UpdatedBy        func(childComplexity int) int
+		Version          func(childComplexity int) int
 	}
 
 	EnrollmentListResponse struct {
@@ -228,6 +335,7 @@ type ComplexityRoot struct {
 		Title     func(childComplexity int) int
 		UpdatedAt func(childComplexity int) int
 		UpdatedBy func(childComplexity int) int
+		Version   func(childComplexity int) int
 	}
 
 	FacultyListResponse struct {
@@ -236,17 +344,26 @@ type ComplexityRoot struct {
 	}
 
 	File struct {
-		CreatedAt func(childComplexity int) int
-		CreatedBy func(childComplexity int) int
-		File      func(childComplexity int) int
-		ID        func(childComplexity int) int
-		Option    func(childComplexity int) int
-		Status    func(childComplexity int) int
-		Table     func(childComplexity int) int
-		TableID   func(childComplexity int) int
-		Title     func(childComplexity int) int
-		UpdatedAt func(childComplexity int) int
-		UpdatedBy func(childComplexity int) int
+		Checksum    func(childComplexity int) int
+		ContentType func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		CreatedBy   func(childComplexity int) int
+		File        func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Late        func(childComplexity int) int
+		Option      func(childComplexity int) int
+		Quarantined func(childComplexity int) int
+		Revision    func(childComplexity int) int
+		ScanResult  func(childComplexity int) int
+		Size        func(childComplexity int) int
+		Status      func(childComplexity int) int
+		Table       func(childComplexity int) int
+		TableID     func(childComplexity int) int
+		Title       func(childComplexity int) int
+		UpdatedAt   func(childComplexity int) int
+		UpdatedBy   func(childComplexity int) int
+		Version     func(childComplexity int) int
+		Versions    func(childComplexity int) int
 	}
 
 	FileListResponse struct {
@@ -267,6 +384,22 @@ type ComplexityRoot struct {
 		Title           func(childComplexity int) int
 		UpdatedAt       func(childComplexity int) int
 		UpdatedBy       func(childComplexity int) int
+		Version         func(childComplexity int) int
+	}
+
+	FinalGradeBreakdown struct {
+		Components          func(childComplexity int) int
+		CouncilSpread       func(childComplexity int) int
+		EnrollmentCode      func(childComplexity int) int
+		Final               func(childComplexity int) int
+		FinalGrade          func(childComplexity int) int
+		Finalized           func(childComplexity int) int
+		Missing             func(childComplexity int) int
+		NeedsReconciliation func(childComplexity int) int
+		PolicyCode          func(childComplexity int) int
+		RawGrade            func(childComplexity int) int
+		Status              func(childComplexity int) int
+		Steps               func(childComplexity int) int
 	}
 
 	FinalListResponse struct {
@@ -274,32 +407,89 @@ type ComplexityRoot struct {
 		Total func(childComplexity int) int
 	}
 
-	GradeDefence struct {
+	GradeAmendment struct {
+		CouncilCode    func(childComplexity int) int
 		CreatedAt      func(childComplexity int) int
-		CreatedBy      func(childComplexity int) int
-		Criteria       func(childComplexity int) int
-		Defence        func(childComplexity int) int
-		DefenceCode    func(childComplexity int) int
-		Enrollment     func(childComplexity int) int
+		DecidedAt      func(childComplexity int) int
+		DecidedBy      func(childComplexity int) int
+		DecisionNote   func(childComplexity int) int
 		EnrollmentCode func(childComplexity int) int
 		ID             func(childComplexity int) int
-		Note           func(childComplexity int) int
-		TotalScore     func(childComplexity int) int
+		NewValue       func(childComplexity int) int
+		OldValue       func(childComplexity int) int
+		Reason         func(childComplexity int) int
+		RequestedBy    func(childComplexity int) int
+		SemesterCode   func(childComplexity int) int
+		Status         func(childComplexity int) int
+		Target         func(childComplexity int) int
+		TargetID       func(childComplexity int) int
 		UpdatedAt      func(childComplexity int) int
-		UpdatedBy      func(childComplexity int) int
 	}
 
-	GradeDefenceCriterion struct {
+	GradeAppeal struct {
+		AmendmentCode    func(childComplexity int) int
+		Component        func(childComplexity int) int
 		CreatedAt        func(childComplexity int) int
-		CreatedBy        func(childComplexity int) int
-		GradeDefence     func(childComplexity int) int
-		GradeDefenceCode func(childComplexity int) int
+		EnrollmentCode   func(childComplexity int) int
+		EvidenceFileCode func(childComplexity int) int
+		History          func(childComplexity int) int
 		ID               func(childComplexity int) int
-		MaxScore         func(childComplexity int) int
-		Name             func(childComplexity int) int
-		Score            func(childComplexity int) int
+		Reason           func(childComplexity int) int
+		ResolutionNote   func(childComplexity int) int
+		ResolvedAt       func(childComplexity int) int
+		ResolvedBy       func(childComplexity int) int
+		ReviewerCode     func(childComplexity int) int
+		SemesterCode     func(childComplexity int) int
+		Status           func(childComplexity int) int
+		StudentCode      func(childComplexity int) int
 		UpdatedAt        func(childComplexity int) int
-		UpdatedBy        func(childComplexity int) int
+	}
+
+	GradeAppealEvent struct {
+		Actor     func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		Note      func(childComplexity int) int
+		Status    func(childComplexity int) int
+	}
+
+	GradeComponent struct {
+		Name   func(childComplexity int) int
+		Score  func(childComplexity int) int
+		Weight func(childComplexity int) int
+	}
+
+	GradeDefence struct {
+		CreatedAt          func(childComplexity int) int
+		CreatedBy          func(childComplexity int) int
+		Criteria           func(childComplexity int) int
+		Defence            func(childComplexity int) int
+		DefenceCode        func(childComplexity int) int
+		Enrollment         func(childComplexity int) int
+		EnrollmentCode     func(childComplexity int) int
+		ID                 func(childComplexity int) int
+		Note               func(childComplexity int) int
+		RubricTemplateCode func(childComplexity int) int
+		TotalScore         func(childComplexity int) int
+		UpdatedAt          func(childComplexity int) int
+		UpdatedBy          func(childComplexity int) int
+		Version            func(childComplexity int) int
+	}
+
+	GradeDefenceCriterion struct {
+		CreatedAt           func(childComplexity int) int
+		CreatedBy           func(childComplexity int) int
+		Description         func(childComplexity int) int
+		GradeDefence        func(childComplexity int) int
+		GradeDefenceCode    func(childComplexity int) int
+		ID                  func(childComplexity int) int
+		MaxScore            func(childComplexity int) int
+		Name                func(childComplexity int) int
+		RubricCriterionCode func(childComplexity int) int
+		Score               func(childComplexity int) int
+		UpdatedAt           func(childComplexity int) int
+		UpdatedBy           func(childComplexity int) int
+		Version             func(childComplexity int) int
+		Weight              func(childComplexity int) int
 	}
 
 	GradeDefenceCriterionListResponse struct {
@@ -325,6 +515,7 @@ type ComplexityRoot struct {
 		Title          func(childComplexity int) int
 		UpdatedAt      func(childComplexity int) int
 		UpdatedBy      func(childComplexity int) int
+		Version        func(childComplexity int) int
 	}
 
 	GradeReviewListResponse struct {
@@ -332,15 +523,36 @@ type ComplexityRoot struct {
 		Total func(childComplexity int) int
 	}
 
+	GradingPolicy struct {
+		CouncilWeight    func(childComplexity int) int
+		CreatedAt        func(childComplexity int) int
+		CreatedBy        func(childComplexity int) int
+		ID               func(childComplexity int) int
+		MajorCode        func(childComplexity int) int
+		MaxCouncilSpread func(childComplexity int) int
+		PassThreshold    func(childComplexity int) int
+		ReviewerWeight   func(childComplexity int) int
+		Rounding         func(childComplexity int) int
+		RoundingStep     func(childComplexity int) int
+		SemesterCode     func(childComplexity int) int
+		Stage            func(childComplexity int) int
+		SupervisorWeight func(childComplexity int) int
+		UpdatedAt        func(childComplexity int) int
+		UpdatedBy        func(childComplexity int) int
+	}
+
 	Major struct {
 		CreatedAt   func(childComplexity int) int
 		CreatedBy   func(childComplexity int) int
+		DeletedAt   func(childComplexity int) int
+		DeletedBy   func(childComplexity int) int
 		FacultyCode func(childComplexity int) int
 		ID          func(childComplexity int) int
 		Title       func(childComplexity int) int
 		Topics      func(childComplexity int) int
 		UpdatedAt   func(childComplexity int) int
 		UpdatedBy   func(childComplexity int) int
+		Version     func(childComplexity int) int
 	}
 
 	MajorInfo struct {
@@ -354,6 +566,27 @@ type ComplexityRoot struct {
 		Total func(childComplexity int) int
 	}
 
+	MatchAssignment struct {
+		Choice           func(childComplexity int) int
+		EnrollmentCode   func(childComplexity int) int
+		StudentCode      func(childComplexity int) int
+		TopicCode        func(childComplexity int) int
+		TopicCouncilCode func(childComplexity int) int
+	}
+
+	MatchRejection struct {
+		Choice    func(childComplexity int) int
+		Reason    func(childComplexity int) int
+		TopicCode func(childComplexity int) int
+	}
+
+	MatchedPassage struct {
+		Offset       func(childComplexity int) int
+		SourceOffset func(childComplexity int) int
+		Text         func(childComplexity int) int
+		Words        func(childComplexity int) int
+	}
+
 	Midterm struct {
 		CreatedAt func(childComplexity int) int
 		CreatedBy func(childComplexity int) int
@@ -364,6 +597,7 @@ type ComplexityRoot struct {
 		Title     func(childComplexity int) int
 		UpdatedAt func(childComplexity int) int
 		UpdatedBy func(childComplexity int) int
+		Version   func(childComplexity int) int
 	}
 
 	MidtermListResponse struct {
@@ -371,41 +605,107 @@ type ComplexityRoot struct {
 		Total func(childComplexity int) int
 	}
 
+	MidtermMilestone struct {
+		CreatedAt    func(childComplexity int) int
+		CreatedBy    func(childComplexity int) int
+		Description  func(childComplexity int) int
+		DueAt        func(childComplexity int) int
+		ID           func(childComplexity int) int
+		SemesterCode func(childComplexity int) int
+		Sequence     func(childComplexity int) int
+		Stage        func(childComplexity int) int
+		Title        func(childComplexity int) int
+		UpdatedAt    func(childComplexity int) int
+		UpdatedBy    func(childComplexity int) int
+		Version      func(childComplexity int) int
+	}
+
+	MilestoneCheckin struct {
+		Comment        func(childComplexity int) int
+		EnrollmentCode func(childComplexity int) int
+		FileCode       func(childComplexity int) int
+		ID             func(childComplexity int) int
+		MilestoneCode  func(childComplexity int) int
+		Report         func(childComplexity int) int
+		Result         func(childComplexity int) int
+		ReviewedAt     func(childComplexity int) int
+		ReviewedBy     func(childComplexity int) int
+		SubmittedAt    func(childComplexity int) int
+		UpdatedAt      func(childComplexity int) int
+	}
+
 	Mutation struct {
 		AddDefenceToCouncil         func(childComplexity int, input model.CreateDefenceInput) int
 		AddGradeDefenceCriterion    func(childComplexity int, input model.CreateGradeDefenceCriterionInput) int
 		ApproveCouncil              func(childComplexity int, id string, timeStart time.Time) int
 		ApproveFinalFile            func(childComplexity int, fileID string) int
 		ApproveMidtermFile          func(childComplexity int, fileID string) int
-		ApproveTopic                func(childComplexity int, id string) int
-		ApproveTopicStage1          func(childComplexity int, id string) int
-		AssignTopicToCouncil        func(childComplexity int, topicCouncilID string, councilID string) int
+		ApproveTopic                func(childComplexity int, id string, note *string) int
+		ApproveTopicStage1          func(childComplexity int, id string, note *string) int
+		AssignGradeAppeal           func(childComplexity int, id string, reviewerID string) int
+		AssignTopicToCouncil        func(childComplexity int, topicCouncilID string, councilID string, overrideReason *string) int
+		CoSignTopic                 func(childComplexity int, topicID string, accept bool) int
+		CommitDefenceSchedule       func(childComplexity int, input model.DefenceScheduleInput) int
+		CommitTopicMatching         func(childComplexity int, semesterCode string) int
 		CompleteGradeReview         func(childComplexity int, id string) int
+		CompleteTopic               func(childComplexity int, id string) int
 		CreateCouncil               func(childComplexity int, input model.CreateCouncilInput) int
 		CreateFaculty               func(childComplexity int, input model.CreateFacultyInput) int
 		CreateGradeDefence          func(childComplexity int, input model.CreateGradeDefenceInput) int
 		CreateMajor                 func(childComplexity int, input model.CreateMajorInput) int
+		CreateMidtermMilestone      func(childComplexity int, input model.CreateMidtermMilestoneInput) int
+		CreateRubricTemplate        func(childComplexity int, input model.CreateRubricTemplateInput) int
 		CreateSemester              func(childComplexity int, input model.CreateSemesterInput) int
 		CreateStudent               func(childComplexity int, input model.CreateStudentInput) int
 		CreateTeacher               func(childComplexity int, input model.CreateTeacherInput) int
+		DecideGradeAmendment        func(childComplexity int, input model.DecideGradeAmendmentInput) int
+		DecideTopicRegistration     func(childComplexity int, registrationID string, accept bool, reason *string) int
 		DeleteCouncil               func(childComplexity int, id string) int
 		DeleteFaculty               func(childComplexity int, id string) int
 		DeleteGradeDefenceCriterion func(childComplexity int, id string) int
+		DeleteGradingPolicy         func(childComplexity int, id string) int
 		DeleteMajor                 func(childComplexity int, id string) int
+		DeleteMidtermMilestone      func(childComplexity int, id string) int
+		DeleteRubricTemplate        func(childComplexity int, id string) int
 		DeleteSemester              func(childComplexity int, id string) int
 		DeleteStudent               func(childComplexity int, id string) int
 		DeleteTeacher               func(childComplexity int, id string) int
 		DeleteTopic                 func(childComplexity int, id string) int
 		Empty                       func(childComplexity int) int
 		FeedbackFinal               func(childComplexity int, finalID string, notes string) int
-		FeedbackMidterm             func(childComplexity int, midtermID string, feedback string) int
+		FileGradeAppeal             func(childComplexity int, input model.FileGradeAppealInput) int
+		FinalizeFinalGrade          func(childComplexity int, enrollmentID string) int
 		GradeFinal                  func(childComplexity int, enrollmentID string, input model.GradeFinalInput) int
-		GradeMidterm                func(childComplexity int, enrollmentID string, input model.GradeMidtermInput) int
+		GrantDeadlineExtension      func(childComplexity int, input model.GrantDeadlineExtensionInput) int
+		LockCouncilGrades           func(childComplexity int, councilID string) int
+		LockSemesterGrades          func(childComplexity int, semesterCode string) int
+		OverrideCouncilConflicts    func(childComplexity int, councilID string, reason string) int
+		ProposeTopic                func(childComplexity int, input model.ProposeTopicInput) int
+		PublishCouncilGrades        func(childComplexity int, councilID string) int
+		PublishSemesterGrades       func(childComplexity int, semesterCode string) int
+		RankTopicApplicants         func(childComplexity int, topicID string, studentIds []string, restricted *bool) int
+		RegisterTopicPreferences    func(childComplexity int, topicIds []string) int
 		RejectFinalFile             func(childComplexity int, fileID string, reason *string) int
 		RejectMidtermFile           func(childComplexity int, fileID string, reason *string) int
-		RejectTopic                 func(childComplexity int, id string, reason *string) int
-		RejectTopicStage1           func(childComplexity int, id string, reason *string) int
+		RejectTopic                 func(childComplexity int, id string, reason string) int
+		RejectTopicStage1           func(childComplexity int, id string, reason string) int
 		RemoveDefenceFromCouncil    func(childComplexity int, id string) int
+		RequestGradeAmendment       func(childComplexity int, input model.RequestGradeAmendmentInput) int
+		ResolveGradeAppeal          func(childComplexity int, input model.ResolveGradeAppealInput) int
+		RestoreCouncil              func(childComplexity int, id string) int
+		RestoreMajor                func(childComplexity int, id string) int
+		RestoreSemester             func(childComplexity int, id string) int
+		RestoreStudent              func(childComplexity int, id string) int
+		RestoreTeacher              func(childComplexity int, id string) int
+		RestoreTopic                func(childComplexity int, id string) int
+		ReviewMilestoneCheckin      func(childComplexity int, id string, pass bool, comment *string) int
+		SetGradeAppealWindow        func(childComplexity int, semesterCode string, days int32) int
+		SetGradingPolicy            func(childComplexity int, input model.SetGradingPolicyInput) int
+		SetRegistrationWindow       func(childComplexity int, input model.SetRegistrationWindowInput) int
+		SetSubmissionDeadline       func(childComplexity int, input model.SetSubmissionDeadlineInput) int
+		StartTopic                  func(childComplexity int, id string) int
+		SubmitMilestoneCheckin      func(childComplexity int, input model.SubmitMilestoneCheckinInput) int
+		SubmitTopic                 func(childComplexity int, id string) int
 		UpdateCouncil               func(childComplexity int, id string, input model.UpdateCouncilInput) int
 		UpdateDepartmentCouncil     func(childComplexity int, id string, input model.UpdateCouncilInput) int
 		UpdateFaculty               func(childComplexity int, id string, input model.UpdateFacultyInput) int
@@ -413,8 +713,10 @@ type ComplexityRoot struct {
 		UpdateGradeDefenceCriterion func(childComplexity int, id string, input model.UpdateGradeDefenceCriterionInput) int
 		UpdateGradeReview           func(childComplexity int, id string, input model.UpdateGradeReviewInput) int
 		UpdateMajor                 func(childComplexity int, id string, input model.UpdateMajorInput) int
+		UpdateMidtermMilestone      func(childComplexity int, id string, input model.UpdateMidtermMilestoneInput) int
 		UpdateMyProfile             func(childComplexity int, input model.UpdateStudentProfileInput) int
 		UpdateMyTeacherProfile      func(childComplexity int, input model.UpdateTeacherProfileInput) int
+		UpdateRubricTemplate        func(childComplexity int, id string, input model.UpdateRubricTemplateInput) int
 		UpdateSemester              func(childComplexity int, id string, input model.UpdateSemesterInput) int
 		UpdateStudent               func(childComplexity int, id string, input model.UpdateStudentInput) int
 		UpdateTeacher               func(childComplexity int, id string, input model.UpdateTeacherInput) int
@@ -423,7 +725,14 @@ type ComplexityRoot struct {
 		UploadMidtermFile           func(childComplexity int, input model.UploadFileInput) int
 	}
 
+	ProposeTopicResult struct {
+		CoSigns        func(childComplexity int) int
+		Topic          func(childComplexity int) int
+		TopicCouncilID func(childComplexity int) int
+	}
+
 	Query struct {
+		AuditLog                          func(childComplexity int, entity *string, entityID *string, actor *string, from *time.Time, to *time.Time) int
 		Empty                             func(childComplexity int) int
 		GetAllCouncils                    func(childComplexity int, search model.SearchRequestInput) int
 		GetAllEnrollments                 func(childComplexity int, search model.SearchRequestInput) int
@@ -432,6 +741,7 @@ type ComplexityRoot struct {
 		GetAllMajors                      func(childComplexity int, search model.SearchRequestInput) int
 		GetAllSemesters                   func(childComplexity int, search model.SearchRequestInput) int
 		GetAllTopics                      func(childComplexity int, search model.SearchRequestInput) int
+		GetCouncilConflictOverrides       func(childComplexity int, councilID string) int
 		GetCouncilDetail                  func(childComplexity int, id string) int
 		GetDefencesByCouncil              func(childComplexity int, councilID string) int
 		GetDepartmentCouncilDetail        func(childComplexity int, id string) int
@@ -448,22 +758,59 @@ type ComplexityRoot struct {
 		GetDepartmentTopicDetail          func(childComplexity int, id string) int
 		GetDepartmentTopics               func(childComplexity int, search model.SearchRequestInput) int
 		GetEnrollmentDetail               func(childComplexity int, id string) int
+		GetEnrollmentMilestoneCheckins    func(childComplexity int, enrollmentID string) int
+		GetGradeAmendments                func(childComplexity int, semesterCode string, status *model.GradeAmendmentStatus) int
+		GetGradeAppeals                   func(childComplexity int, semesterCode string, status *model.GradeAppealStatus) int
+		GetGradingPolicies                func(childComplexity int, semesterCode string) int
 		GetListStudents                   func(childComplexity int, search model.SearchRequestInput) int
 		GetListTeachers                   func(childComplexity int, search model.SearchRequestInput) int
+		GetMidtermMilestones              func(childComplexity int, semesterCode string, stage *model.TopicStage) int
+		GetMyAssignedGradeAppeals         func(childComplexity int, status *model.GradeAppealStatus) int
 		GetMyDefenceDetail                func(childComplexity int, id string) int
 		GetMyDefences                     func(childComplexity int, search *model.SearchRequestInput) int
 		GetMyEnrollmentDetail             func(childComplexity int, id string) int
 		GetMyEnrollments                  func(childComplexity int, search *model.SearchRequestInput) int
+		GetMyGradeAppeals                 func(childComplexity int) int
 		GetMyGradeReviewDetail            func(childComplexity int, id string) int
 		GetMyGradeReviews                 func(childComplexity int, search *model.SearchRequestInput) int
+		GetMyMidtermMilestones            func(childComplexity int, enrollmentID string) int
+		GetMyMilestoneCheckins            func(childComplexity int, enrollmentID string) int
 		GetMyProfile                      func(childComplexity int) int
+		GetMyRegistrationWindow           func(childComplexity int) int
 		GetMySemesters                    func(childComplexity int, search *model.SearchRequestInput) int
+		GetMySubmissionWindow             func(childComplexity int, kind model.SubmissionKind) int
 		GetMySupervisedTopicCouncilDetail func(childComplexity int, id string) int
 		GetMySupervisedTopicCouncils      func(childComplexity int, search *model.SearchRequestInput) int
 		GetMyTeacherProfile               func(childComplexity int) int
+		GetMyTopicApplicants              func(childComplexity int, topicID string) int
+		GetMyTopicRegistrations           func(childComplexity int) int
+		GetRegistrableTopics              func(childComplexity int) int
+		GetRegistrationWindow             func(childComplexity int, semesterCode string) int
+		GetRubricTemplates                func(childComplexity int, majorCode *string, stage *model.TopicStage) int
+		GetSemesterGradeStatus            func(childComplexity int, semesterCode string) int
 		GetStudentDetail                  func(childComplexity int, id string) int
+		GetSubmissionDeadlines            func(childComplexity int, semesterCode string) int
 		GetTeacherDetail                  func(childComplexity int, id string) int
+		GetTopicCoSigns                   func(childComplexity int, topicID string) int
 		GetTopicDetail                    func(childComplexity int, id string) int
+		GetTopicRegistrations             func(childComplexity int, semesterCode string) int
+		GlobalSearch                      func(childComplexity int, query string, types []model.SearchType, limit *int32) int
+		PreviewDefenceSchedule            func(childComplexity int, input model.DefenceScheduleInput) int
+		PreviewFinalGrade                 func(childComplexity int, enrollmentID string) int
+		PreviewTopicMatching              func(childComplexity int, semesterCode string) int
+		ValidateCouncil                   func(childComplexity int, councilID string) int
+	}
+
+	RegistrationWindow struct {
+		ClosesAt     func(childComplexity int) int
+		CreatedAt    func(childComplexity int) int
+		CreatedBy    func(childComplexity int) int
+		ID           func(childComplexity int) int
+		MaxChoices   func(childComplexity int) int
+		OpensAt      func(childComplexity int) int
+		SemesterCode func(childComplexity int) int
+		UpdatedAt    func(childComplexity int) int
+		UpdatedBy    func(childComplexity int) int
 	}
 
 	ReviewerEnrollment struct {
@@ -475,6 +822,7 @@ type ComplexityRoot struct {
 		ID               func(childComplexity int) int
 		Midterm          func(childComplexity int) int
 		MidtermCode      func(childComplexity int) int
+		SimilarityReport func(childComplexity int, topN *int32) int
 		Student          func(childComplexity int) int
 		StudentCode      func(childComplexity int) int
 		Title            func(childComplexity int) int
@@ -534,6 +882,7 @@ type ComplexityRoot struct {
 		Title        func(childComplexity int) int
 		UpdatedAt    func(childComplexity int) int
 		UpdatedBy    func(childComplexity int) int
+		Version      func(childComplexity int) int
 	}
 
 	RoleSystemListResponse struct {
@@ -541,9 +890,41 @@ type ComplexityRoot struct {
 		Total func(childComplexity int) int
 	}
 
+	RubricCriterion struct {
+		Description func(childComplexity int) int
+		ID          func(childComplexity int) int
+		MaxScore    func(childComplexity int) int
+		Name        func(childComplexity int) int
+		SortOrder   func(childComplexity int) int
+		Weight      func(childComplexity int) int
+	}
+
+	RubricTemplate struct {
+		CreatedAt func(childComplexity int) int
+		CreatedBy func(childComplexity int) int
+		Criteria  func(childComplexity int) int
+		ID        func(childComplexity int) int
+		MajorCode func(childComplexity int) int
+		Stage     func(childComplexity int) int
+		Title     func(childComplexity int) int
+		UpdatedAt func(childComplexity int) int
+		UpdatedBy func(childComplexity int) int
+		Version   func(childComplexity int) int
+	}
+
+	SearchHit struct {
+		ID       func(childComplexity int) int
+		Score    func(childComplexity int) int
+		Subtitle func(childComplexity int) int
+		Title    func(childComplexity int) int
+		Type     func(childComplexity int) int
+	}
+
 	Semester struct {
 		CreatedAt func(childComplexity int) int
 		CreatedBy func(childComplexity int) int
+		DeletedAt func(childComplexity int) int
+		DeletedBy func(childComplexity int) int
 		ID        func(childComplexity int) int
 		Students  func(childComplexity int) int
 		Teachers  func(childComplexity int) int
@@ -551,6 +932,19 @@ type ComplexityRoot struct {
 		Topics    func(childComplexity int) int
 		UpdatedAt func(childComplexity int) int
 		UpdatedBy func(childComplexity int) int
+		Version   func(childComplexity int) int
+	}
+
+	SemesterGradeStatus struct {
+		AppealDeadline   func(childComplexity int) int
+		AppealWindowDays func(childComplexity int) int
+		Locked           func(childComplexity int) int
+		LockedAt         func(childComplexity int) int
+		LockedBy         func(childComplexity int) int
+		Published        func(childComplexity int) int
+		PublishedAt      func(childComplexity int) int
+		PublishedBy      func(childComplexity int) int
+		SemesterCode     func(childComplexity int) int
 	}
 
 	SemesterInfo struct {
@@ -563,10 +957,28 @@ type ComplexityRoot struct {
 		Total func(childComplexity int) int
 	}
 
+	SimilarityMatch struct {
+		File           func(childComplexity int) int
+		OverlapPercent func(childComplexity int) int
+		Passages       func(childComplexity int) int
+		SemesterCode   func(childComplexity int) int
+		Similarity     func(childComplexity int) int
+	}
+
+	SimilarityReport struct {
+		File      func(childComplexity int) int
+		Indexed   func(childComplexity int) int
+		IndexedAt func(childComplexity int) int
+		Matches   func(childComplexity int) int
+		WordCount func(childComplexity int) int
+	}
+
 	Student struct {
 		ClassCode    func(childComplexity int) int
 		CreatedAt    func(childComplexity int) int
 		CreatedBy    func(childComplexity int) int
+		DeletedAt    func(childComplexity int) int
+		DeletedBy    func(childComplexity int) int
 		Email        func(childComplexity int) int
 		Enrollments  func(childComplexity int) int
 		Gender       func(childComplexity int) int
@@ -577,6 +989,7 @@ type ComplexityRoot struct {
 		UpdatedAt    func(childComplexity int) int
 		UpdatedBy    func(childComplexity int) int
 		Username     func(childComplexity int) int
+		Version      func(childComplexity int) int
 	}
 
 	StudentCouncil struct {
@@ -661,18 +1074,20 @@ type ComplexityRoot struct {
 	}
 
 	StudentTopic struct {
-		CreatedAt     func(childComplexity int) int
-		Files         func(childComplexity int) int
-		ID            func(childComplexity int) int
-		Major         func(childComplexity int) int
-		MajorCode     func(childComplexity int) int
-		PercentStage1 func(childComplexity int) int
-		PercentStage2 func(childComplexity int) int
-		Semester      func(childComplexity int) int
-		SemesterCode  func(childComplexity int) int
-		Status        func(childComplexity int) int
-		Title         func(childComplexity int) int
-		UpdatedAt     func(childComplexity int) int
+		CreatedAt      func(childComplexity int) int
+		Files          func(childComplexity int) int
+		ID             func(childComplexity int) int
+		Major          func(childComplexity int) int
+		MajorCode      func(childComplexity int) int
+		MaxStudents    func(childComplexity int) int
+		PercentStage1  func(childComplexity int) int
+		PercentStage2  func(childComplexity int) int
+		RequiredSkills func(childComplexity int) int
+		Semester       func(childComplexity int) int
+		SemesterCode   func(childComplexity int) int
+		Status         func(childComplexity int) int
+		Title          func(childComplexity int) int
+		UpdatedAt      func(childComplexity int) int
 	}
 
 	StudentTopicCouncil struct {
@@ -702,6 +1117,31 @@ type ComplexityRoot struct {
 		Total func(childComplexity int) int
 	}
 
+	SubmissionDeadline struct {
+		CreatedAt    func(childComplexity int) int
+		CreatedBy    func(childComplexity int) int
+		DueAt        func(childComplexity int) int
+		GraceMinutes func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Kind         func(childComplexity int) int
+		OpensAt      func(childComplexity int) int
+		SemesterCode func(childComplexity int) int
+		Stage        func(childComplexity int) int
+		UpdatedAt    func(childComplexity int) int
+		UpdatedBy    func(childComplexity int) int
+	}
+
+	SubmissionWindow struct {
+		Allowed  func(childComplexity int) int
+		ClosesAt func(childComplexity int) int
+		DueAt    func(childComplexity int) int
+		Extended func(childComplexity int) int
+		Late     func(childComplexity int) int
+		OpensAt  func(childComplexity int) int
+		Reason   func(childComplexity int) int
+		Stage    func(childComplexity int) int
+	}
+
 	Subscription struct {
 		Empty func(childComplexity int) int
 	}
@@ -717,6 +1157,7 @@ type ComplexityRoot struct {
 		ID               func(childComplexity int) int
 		Midterm          func(childComplexity int) int
 		MidtermCode      func(childComplexity int) int
+		SimilarityReport func(childComplexity int, topN *int32) int
 		Student          func(childComplexity int) int
 		StudentCode      func(childComplexity int) int
 		Title            func(childComplexity int) int
@@ -732,21 +1173,24 @@ type ComplexityRoot struct {
 	}
 
 	SupervisorTopic struct {
-		CreatedAt     func(childComplexity int) int
-		CreatedBy     func(childComplexity int) int
-		Files         func(childComplexity int) int
-		ID            func(childComplexity int) int
-		Major         func(childComplexity int) int
-		MajorCode     func(childComplexity int) int
-		PercentStage1 func(childComplexity int) int
-		PercentStage2 func(childComplexity int) int
-		Semester      func(childComplexity int) int
-		SemesterCode  func(childComplexity int) int
-		Status        func(childComplexity int) int
-		Title         func(childComplexity int) int
-		TopicCouncils func(childComplexity int) int
-		UpdatedAt     func(childComplexity int) int
-		UpdatedBy     func(childComplexity int) int
+		CreatedAt      func(childComplexity int) int
+		CreatedBy      func(childComplexity int) int
+		Files          func(childComplexity int) int
+		ID             func(childComplexity int) int
+		Major          func(childComplexity int) int
+		MajorCode      func(childComplexity int) int
+		MaxStudents    func(childComplexity int) int
+		PercentStage1  func(childComplexity int) int
+		PercentStage2  func(childComplexity int) int
+		RequiredSkills func(childComplexity int) int
+		Semester       func(childComplexity int) int
+		SemesterCode   func(childComplexity int) int
+		Status         func(childComplexity int) int
+		StatusHistory  func(childComplexity int) int
+		Title          func(childComplexity int) int
+		TopicCouncils  func(childComplexity int) int
+		UpdatedAt      func(childComplexity int) int
+		UpdatedBy      func(childComplexity int) int
 	}
 
 	SupervisorTopicCouncil struct {
@@ -787,6 +1231,8 @@ type ComplexityRoot struct {
 	Teacher struct {
 		CreatedAt    func(childComplexity int) int
 		CreatedBy    func(childComplexity int) int
+		DeletedAt    func(childComplexity int) int
+		DeletedBy    func(childComplexity int) int
 		Email        func(childComplexity int) int
 		Gender       func(childComplexity int) int
 		ID           func(childComplexity int) int
@@ -796,6 +1242,7 @@ type ComplexityRoot struct {
 		UpdatedAt    func(childComplexity int) int
 		UpdatedBy    func(childComplexity int) int
 		Username     func(childComplexity int) int
+		Version      func(childComplexity int) int
 	}
 
 	TeacherListResponse struct {
@@ -804,20 +1251,35 @@ type ComplexityRoot struct {
 	}
 
 	Topic struct {
-		CreatedAt     func(childComplexity int) int
-		CreatedBy     func(childComplexity int) int
-		Files         func(childComplexity int) int
-		ID            func(childComplexity int) int
-		MajorCode     func(childComplexity int) int
-		PercentStage1 func(childComplexity int) int
-		PercentStage2 func(childComplexity int) int
-		SemesterCode  func(childComplexity int) int
-		Status        func(childComplexity int) int
-		Title         func(childComplexity int) int
-		TopicCouncils func(childComplexity int) int
-		Total         func(childComplexity int) int
-		UpdatedAt     func(childComplexity int) int
-		UpdatedBy     func(childComplexity int) int
+		CreatedAt      func(childComplexity int) int
+		CreatedBy      func(childComplexity int) int
+		DeletedAt      func(childComplexity int) int
+		DeletedBy      func(childComplexity int) int
+		Files          func(childComplexity int) int
+		ID             func(childComplexity int) int
+		MajorCode      func(childComplexity int) int
+		MaxStudents    func(childComplexity int) int
+		PercentStage1  func(childComplexity int) int
+		PercentStage2  func(childComplexity int) int
+		RequiredSkills func(childComplexity int) int
+		SemesterCode   func(childComplexity int) int
+		Status         func(childComplexity int) int
+		StatusHistory  func(childComplexity int) int
+		Title          func(childComplexity int) int
+		TopicCouncils  func(childComplexity int) int
+		Total          func(childComplexity int) int
+		UpdatedAt      func(childComplexity int) int
+		UpdatedBy      func(childComplexity int) int
+		Version        func(childComplexity int) int
+	}
+
+	TopicCoSign struct {
+		CreatedAt   func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Status      func(childComplexity int) int
+		TeacherCode func(childComplexity int) int
+		TopicCode   func(childComplexity int) int
+		UpdatedAt   func(childComplexity int) int
 	}
 
 	TopicCouncil struct {
@@ -836,6 +1298,7 @@ type ComplexityRoot struct {
 		TopicCode   func(childComplexity int) int
 		UpdatedAt   func(childComplexity int) int
 		UpdatedBy   func(childComplexity int) int
+		Version     func(childComplexity int) int
 	}
 
 	TopicCouncilListResponse struct {
@@ -853,6 +1316,7 @@ type ComplexityRoot struct {
 		TopicCouncilCode      func(childComplexity int) int
 		UpdatedAt             func(childComplexity int) int
 		UpdatedBy             func(childComplexity int) int
+		Version               func(childComplexity int) int
 	}
 
 	TopicCouncilSupervisorListResponse struct {
@@ -864,6 +1328,44 @@ type ComplexityRoot struct {
 		Data  func(childComplexity int) int
 		Total func(childComplexity int) int
 	}
+
+	TopicMatchingResult struct {
+		Assignments   func(childComplexity int) int
+		MatchedTotal  func(childComplexity int) int
+		SemesterCode  func(childComplexity int) int
+		StudentsTotal func(childComplexity int) int
+		Unmatched     func(childComplexity int) int
+	}
+
+	TopicRegistration struct {
+		CreatedAt      func(childComplexity int) int
+		DecidedAt      func(childComplexity int) int
+		DecidedBy      func(childComplexity int) int
+		EnrollmentCode func(childComplexity int) int
+		ID             func(childComplexity int) int
+		Rank           func(childComplexity int) int
+		Reason         func(childComplexity int) int
+		SemesterCode   func(childComplexity int) int
+		Status         func(childComplexity int) int
+		StudentCode    func(childComplexity int) int
+		TopicCode      func(childComplexity int) int
+		UpdatedAt      func(childComplexity int) int
+	}
+
+	TopicStatusHistory struct {
+		CreatedAt  func(childComplexity int) int
+		CreatedBy  func(childComplexity int) int
+		FromStatus func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Reason     func(childComplexity int) int
+		ToStatus   func(childComplexity int) int
+		TopicCode  func(childComplexity int) int
+	}
+
+	UnmatchedStudent struct {
+		Rejections  func(childComplexity int) int
+		StudentCode func(childComplexity int) int
+	}
 }
 
 type executableSchema struct {
@@ -885,70 +1387,245 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 	_ = ec
 	switch typeName + "." + field {
 
-	case "Council.createdAt":
-		if e.complexity.Council.CreatedAt == nil {
+	case "ApplicantRanking.restricted":
+		if e.complexity.ApplicantRanking.Restricted == nil {
 			break
 		}
 
-		return e.complexity.Council.CreatedAt(childComplexity), true
+		return e.complexity.ApplicantRanking.Restricted(childComplexity), true
 
-	case "Council.createdBy":
-		if e.complexity.Council.CreatedBy == nil {
+	case "ApplicantRanking.studentCodes":
+		if e.complexity.ApplicantRanking.StudentCodes == nil {
 			break
 		}
 
-		return e.complexity.Council.CreatedBy(childComplexity), true
+		return e.complexity.ApplicantRanking.StudentCodes(childComplexity), true
 
-	case "Council.defences":
-		if e.complexity.Council.Defences == nil {
+	case "ApplicantRanking.topicCode":
+		if e.complexity.ApplicantRanking.TopicCode == nil {
 			break
 		}
 
-		return e.complexity.Council.Defences(childComplexity), true
+		return e.complexity.ApplicantRanking.TopicCode(childComplexity), true
 
-	case "Council.id":
-		if e.complexity.Council.ID == nil {
+	case "AuditChange.after":
+		if e.complexity.AuditChange.After == nil {
 			break
 		}
 
-		return e.complexity.Council.ID(childComplexity), true
+		return e.complexity.AuditChange.After(childComplexity), true
 
-	case "Council.majorCode":
-		if e.complexity.Council.MajorCode == nil {
+	case "AuditChange.before":
+		if e.complexity.AuditChange.Before == nil {
 			break
 		}
 
-		return e.complexity.Council.MajorCode(childComplexity), true
+		return e.complexity.AuditChange.Before(childComplexity), true
 
-	case "Council.semesterCode":
-		if e.complexity.Council.SemesterCode == nil {
+	case "AuditChange.field":
+		if e.complexity.AuditChange.Field == nil {
 			break
 		}
 
-		return e.complexity.Council.SemesterCode(childComplexity), true
+		return e.complexity.AuditChange.Field(childComplexity), true
 
-	case "Council.timeStart":
-		if e.complexity.Council.TimeStart == nil {
+	case "AuditLogEntry.actor":
+		if e.complexity.AuditLogEntry.Actor == nil {
 			break
 		}
 
-		return e.complexity.Council.TimeStart(childComplexity), true
+		return e.complexity.AuditLogEntry.Actor(childComplexity), true
 
-	case "Council.title":
-		if e.complexity.Council.Title == nil {
+	case "AuditLogEntry.at":
+		if e.complexity.AuditLogEntry.At == nil {
 			break
 		}
 
-		return e.complexity.Council.Title(childComplexity), true
+		return e.complexity.AuditLogEntry.At(childComplexity), true
 
-	case "Council.topicCouncils":
-		if e.complexity.Council.TopicCouncils == nil {
+	case "AuditLogEntry.changes":
+		if e.complexity.AuditLogEntry.Changes == nil {
 			break
 		}
 
-		return e.complexity.Council.TopicCouncils(childComplexity), true
+		return e.complexity.AuditLogEntry.Changes(childComplexity), true
 
-	case "Council.total":
+	case "AuditLogEntry.clientIp":
+		if e.complexity.AuditLogEntry.ClientIP == nil {
+			break
+		}
+
+		return e.complexity.AuditLogEntry.ClientIP(childComplexity), true
+
+	case "AuditLogEntry.entity":
+		if e.complexity.AuditLogEntry.Entity == nil {
+			break
+		}
+
+		return e.complexity.AuditLogEntry.Entity(childComplexity), true
+
+	case "AuditLogEntry.entityId":
+		if e.complexity.AuditLogEntry.EntityID == nil {
+			break
+		}
+
+		return e.complexity.AuditLogEntry.EntityID(childComplexity), true
+
+	case "AuditLogEntry.id":
+		if e.complexity.AuditLogEntry.ID == nil {
+			break
+		}
+
+		return e.complexity.AuditLogEntry.ID(childComplexity), true
+
+	case "AuditLogEntry.method":
+		if e.complexity.AuditLogEntry.Method == nil {
+			break
+		}
+
+		return e.complexity.AuditLogEntry.Method(childComplexity), true
+
+	case "AuditLogEntry.requestId":
+		if e.complexity.AuditLogEntry.RequestID == nil {
+			break
+		}
+
+		return e.complexity.AuditLogEntry.RequestID(childComplexity), true
+
+	case "AuditLogEntry.role":
+		if e.complexity.AuditLogEntry.Role == nil {
+			break
+		}
+
+		return e.complexity.AuditLogEntry.Role(childComplexity), true
+
+	case "AuditLogEntry.semester":
+		if e.complexity.AuditLogEntry.Semester == nil {
+			break
+		}
+
+		return e.complexity.AuditLogEntry.Semester(childComplexity), true
+
+	case "AuditLogEntry.service":
+		if e.complexity.AuditLogEntry.Service == nil {
+			break
+		}
+
+		return e.complexity.AuditLogEntry.Service(childComplexity), true
+
+	case "Council.createdAt":
+		if e.complexity.Council.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Council.CreatedAt(childComplexity), true
+
+	case "Council.createdBy":
+		if e.complexity.Council.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.Council.CreatedBy(childComplexity), true
+
+	case "Council.defences":
+		if e.complexity.Council.Defences == nil {
+			break
+		}
+
+		return e.complexity.Council.Defences(childComplexity), true
+
+	case "Council.deletedAt":
+		if e.complexity.Council.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.Council.DeletedAt(childComplexity), true
+
+	case "Council.deletedBy":
+		if e.complexity.Council.DeletedBy == nil {
+			break
+		}
+
+		return e.complexity.Council.DeletedBy(childComplexity), true
+
+	case "Council.gradesLocked":
+		if e.complexity.Council.GradesLocked == nil {
+			break
+		}
+
+		return e.complexity.Council.GradesLocked(childComplexity), true
+
+	case "Council.gradesLockedAt":
+		if e.complexity.Council.GradesLockedAt == nil {
+			break
+		}
+
+		return e.complexity.Council.GradesLockedAt(childComplexity), true
+
+	case "Council.gradesPublished":
+		if e.complexity.Council.GradesPublished == nil {
+			break
+		}
+
+		return e.complexity.Council.GradesPublished(childComplexity), true
+
+	case "Council.gradesPublishedAt":
+		if e.complexity.Council.GradesPublishedAt == nil {
+			break
+		}
+
+		return e.complexity.Council.GradesPublishedAt(childComplexity), true
+
+	case "Council.id":
+		if e.complexity.Council.ID == nil {
+			break
+		}
+
+		return e.complexity.Council.ID(childComplexity), true
+
+	case "Council.majorCode":
+		if e.complexity.Council.MajorCode == nil {
+			break
+		}
+
+		return e.complexity.Council.MajorCode(childComplexity), true
+
+	case "Council.room":
+		if e.complexity.Council.Room == nil {
+			break
+		}
+
+		return e.complexity.Council.Room(childComplexity), true
+
+	case "Council.semesterCode":
+		if e.complexity.Council.SemesterCode == nil {
+			break
+		}
+
+		return e.complexity.Council.SemesterCode(childComplexity), true
+
+	case "Council.timeStart":
+		if e.complexity.Council.TimeStart == nil {
+			break
+		}
+
+		return e.complexity.Council.TimeStart(childComplexity), true
+
+	case "Council.title":
+		if e.complexity.Council.Title == nil {
+			break
+		}
+
+		return e.complexity.Council.Title(childComplexity), true
+
+	case "Council.topicCouncils":
+		if e.complexity.Council.TopicCouncils == nil {
+			break
+		}
+
+		return e.complexity.Council.TopicCouncils(childComplexity), true
+
+	case "Council.total":
 		if e.complexity.Council.Total == nil {
 			break
 		}
@@ -969,6 +1646,111 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Council.UpdatedBy(childComplexity), true
 
+	case "Council.version":
+		if e.complexity.Council.Version == nil {
+			break
+		}
+
+		return e.complexity.Council.Version(childComplexity), true
+
+	case "CouncilConflict.kind":
+		if e.complexity.CouncilConflict.Kind == nil {
+			break
+		}
+
+		return e.complexity.CouncilConflict.Kind(childComplexity), true
+
+	case "CouncilConflict.message":
+		if e.complexity.CouncilConflict.Message == nil {
+			break
+		}
+
+		return e.complexity.CouncilConflict.Message(childComplexity), true
+
+	case "CouncilConflict.overridden":
+		if e.complexity.CouncilConflict.Overridden == nil {
+			break
+		}
+
+		return e.complexity.CouncilConflict.Overridden(childComplexity), true
+
+	case "CouncilConflict.teacherCode":
+		if e.complexity.CouncilConflict.TeacherCode == nil {
+			break
+		}
+
+		return e.complexity.CouncilConflict.TeacherCode(childComplexity), true
+
+	case "CouncilConflict.topicCouncilCode":
+		if e.complexity.CouncilConflict.TopicCouncilCode == nil {
+			break
+		}
+
+		return e.complexity.CouncilConflict.TopicCouncilCode(childComplexity), true
+
+	case "CouncilConflictOverride.councilCode":
+		if e.complexity.CouncilConflictOverride.CouncilCode == nil {
+			break
+		}
+
+		return e.complexity.CouncilConflictOverride.CouncilCode(childComplexity), true
+
+	case "CouncilConflictOverride.createdAt":
+		if e.complexity.CouncilConflictOverride.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.CouncilConflictOverride.CreatedAt(childComplexity), true
+
+	case "CouncilConflictOverride.createdBy":
+		if e.complexity.CouncilConflictOverride.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.CouncilConflictOverride.CreatedBy(childComplexity), true
+
+	case "CouncilConflictOverride.id":
+		if e.complexity.CouncilConflictOverride.ID == nil {
+			break
+		}
+
+		return e.complexity.CouncilConflictOverride.ID(childComplexity), true
+
+	case "CouncilConflictOverride.kind":
+		if e.complexity.CouncilConflictOverride.Kind == nil {
+			break
+		}
+
+		return e.complexity.CouncilConflictOverride.Kind(childComplexity), true
+
+	case "CouncilConflictOverride.message":
+		if e.complexity.CouncilConflictOverride.Message == nil {
+			break
+		}
+
+		return e.complexity.CouncilConflictOverride.Message(childComplexity), true
+
+	case "CouncilConflictOverride.reason":
+		if e.complexity.CouncilConflictOverride.Reason == nil {
+			break
+		}
+
+		return e.complexity.CouncilConflictOverride.Reason(childComplexity), true
+
+	case "CouncilConflictOverride.teacherCode":
+		if e.complexity.CouncilConflictOverride.TeacherCode == nil {
+			break
+		}
+
+		return e.complexity.CouncilConflictOverride.TeacherCode(childComplexity), true
+
+	case "CouncilConflictOverride.topicCouncilCode":
+		if e.complexity.CouncilConflictOverride.TopicCouncilCode == nil {
+			break
+		}
+
+		return e.complexity.CouncilConflictOverride.TopicCouncilCode(childComplexity), true
+
 	case "CouncilDefence.council":
 		if e.complexity.CouncilDefence.Council == nil {
 			break
@@ -1382,6 +2164,104 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.CouncilTopicCouncilListResponse.Total(childComplexity), true
 
+	case "CouncilValidation.conflicts":
+		if e.complexity.CouncilValidation.Conflicts == nil {
+			break
+		}
+
+		return e.complexity.CouncilValidation.Conflicts(childComplexity), true
+
+	case "CouncilValidation.councilCode":
+		if e.complexity.CouncilValidation.CouncilCode == nil {
+			break
+		}
+
+		return e.complexity.CouncilValidation.CouncilCode(childComplexity), true
+
+	case "CouncilValidation.valid":
+		if e.complexity.CouncilValidation.Valid == nil {
+			break
+		}
+
+		return e.complexity.CouncilValidation.Valid(childComplexity), true
+
+	case "DeadlineExtension.createdAt":
+		if e.complexity.DeadlineExtension.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.DeadlineExtension.CreatedAt(childComplexity), true
+
+	case "DeadlineExtension.createdBy":
+		if e.complexity.DeadlineExtension.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.DeadlineExtension.CreatedBy(childComplexity), true
+
+	case "DeadlineExtension.dueAt":
+		if e.complexity.DeadlineExtension.DueAt == nil {
+			break
+		}
+
+		return e.complexity.DeadlineExtension.DueAt(childComplexity), true
+
+	case "DeadlineExtension.id":
+		if e.complexity.DeadlineExtension.ID == nil {
+			break
+		}
+
+		return e.complexity.DeadlineExtension.ID(childComplexity), true
+
+	case "DeadlineExtension.kind":
+		if e.complexity.DeadlineExtension.Kind == nil {
+			break
+		}
+
+		return e.complexity.DeadlineExtension.Kind(childComplexity), true
+
+	case "DeadlineExtension.reason":
+		if e.complexity.DeadlineExtension.Reason == nil {
+			break
+		}
+
+		return e.complexity.DeadlineExtension.Reason(childComplexity), true
+
+	case "DeadlineExtension.semesterCode":
+		if e.complexity.DeadlineExtension.SemesterCode == nil {
+			break
+		}
+
+		return e.complexity.DeadlineExtension.SemesterCode(childComplexity), true
+
+	case "DeadlineExtension.stage":
+		if e.complexity.DeadlineExtension.Stage == nil {
+			break
+		}
+
+		return e.complexity.DeadlineExtension.Stage(childComplexity), true
+
+	case "DeadlineExtension.studentCode":
+		if e.complexity.DeadlineExtension.StudentCode == nil {
+			break
+		}
+
+		return e.complexity.DeadlineExtension.StudentCode(childComplexity), true
+
+	case "DeadlineExtension.updatedAt":
+		if e.complexity.DeadlineExtension.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.DeadlineExtension.UpdatedAt(childComplexity), true
+
+	case "DeadlineExtension.updatedBy":
+		if e.complexity.DeadlineExtension.UpdatedBy == nil {
+			break
+		}
+
+		return e.complexity.DeadlineExtension.UpdatedBy(childComplexity), true
+
 	case "Defence.council":
 		if e.complexity.Defence.Council == nil {
 			break
@@ -1466,6 +2346,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Defence.UpdatedBy(childComplexity), true
 
+	case "Defence.version":
+		if e.complexity.Defence.Version == nil {
+			break
+		}
+
+		return e.complexity.Defence.Version(childComplexity), true
+
 	case "DefenceListResponse.data":
 		if e.complexity.DefenceListResponse.Data == nil {
 			break
@@ -1480,22 +2367,141 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.DefenceListResponse.Total(childComplexity), true
 
-	case "Enrollment.createdAt":
-		if e.complexity.Enrollment.CreatedAt == nil {
+	case "DefenceSchedule.csv":
+		if e.complexity.DefenceSchedule.CSV == nil {
 			break
 		}
 
-		return e.complexity.Enrollment.CreatedAt(childComplexity), true
+		return e.complexity.DefenceSchedule.CSV(childComplexity), true
 
-	case "Enrollment.createdBy":
-		if e.complexity.Enrollment.CreatedBy == nil {
+	case "DefenceSchedule.committed":
+		if e.complexity.DefenceSchedule.Committed == nil {
 			break
 		}
 
-		return e.complexity.Enrollment.CreatedBy(childComplexity), true
+		return e.complexity.DefenceSchedule.Committed(childComplexity), true
 
-	case "Enrollment.final":
-		if e.complexity.Enrollment.Final == nil {
+	case "DefenceSchedule.complete":
+		if e.complexity.DefenceSchedule.Complete == nil {
+			break
+		}
+
+		return e.complexity.DefenceSchedule.Complete(childComplexity), true
+
+	case "DefenceSchedule.semesterCode":
+		if e.complexity.DefenceSchedule.SemesterCode == nil {
+			break
+		}
+
+		return e.complexity.DefenceSchedule.SemesterCode(childComplexity), true
+
+	case "DefenceSchedule.sittings":
+		if e.complexity.DefenceSchedule.Sittings == nil {
+			break
+		}
+
+		return e.complexity.DefenceSchedule.Sittings(childComplexity), true
+
+	case "DefenceSchedule.unassigned":
+		if e.complexity.DefenceSchedule.Unassigned == nil {
+			break
+		}
+
+		return e.complexity.DefenceSchedule.Unassigned(childComplexity), true
+
+	case "DefenceSchedule.unplaced":
+		if e.complexity.DefenceSchedule.Unplaced == nil {
+			break
+		}
+
+		return e.complexity.DefenceSchedule.Unplaced(childComplexity), true
+
+	case "DefenceScheduleProblem.code":
+		if e.complexity.DefenceScheduleProblem.Code == nil {
+			break
+		}
+
+		return e.complexity.DefenceScheduleProblem.Code(childComplexity), true
+
+	case "DefenceScheduleProblem.reason":
+		if e.complexity.DefenceScheduleProblem.Reason == nil {
+			break
+		}
+
+		return e.complexity.DefenceScheduleProblem.Reason(childComplexity), true
+
+	case "DefenceSession.end":
+		if e.complexity.DefenceSession.End == nil {
+			break
+		}
+
+		return e.complexity.DefenceSession.End(childComplexity), true
+
+	case "DefenceSession.start":
+		if e.complexity.DefenceSession.Start == nil {
+			break
+		}
+
+		return e.complexity.DefenceSession.Start(childComplexity), true
+
+	case "DefenceSession.topicCouncilCode":
+		if e.complexity.DefenceSession.TopicCouncilCode == nil {
+			break
+		}
+
+		return e.complexity.DefenceSession.TopicCouncilCode(childComplexity), true
+
+	case "DefenceSitting.councilCode":
+		if e.complexity.DefenceSitting.CouncilCode == nil {
+			break
+		}
+
+		return e.complexity.DefenceSitting.CouncilCode(childComplexity), true
+
+	case "DefenceSitting.end":
+		if e.complexity.DefenceSitting.End == nil {
+			break
+		}
+
+		return e.complexity.DefenceSitting.End(childComplexity), true
+
+	case "DefenceSitting.room":
+		if e.complexity.DefenceSitting.Room == nil {
+			break
+		}
+
+		return e.complexity.DefenceSitting.Room(childComplexity), true
+
+	case "DefenceSitting.sessions":
+		if e.complexity.DefenceSitting.Sessions == nil {
+			break
+		}
+
+		return e.complexity.DefenceSitting.Sessions(childComplexity), true
+
+	case "DefenceSitting.start":
+		if e.complexity.DefenceSitting.Start == nil {
+			break
+		}
+
+		return e.complexity.DefenceSitting.Start(childComplexity), true
+
+	case "Enrollment.createdAt":
+		if e.complexity.Enrollment.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Enrollment.CreatedAt(childComplexity), true
+
+	case "Enrollment.createdBy":
+		if e.complexity.Enrollment.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.Enrollment.CreatedBy(childComplexity), true
+
+	case "Enrollment.final":
+		if e.complexity.Enrollment.Final == nil {
 			break
 		}
 
@@ -1599,6 +2605,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Enrollment.UpdatedBy(childComplexity), true
 
+	case "Enrollment.version":
+		if e.complexity.Enrollment.Version == nil {
+			break
+		}
+
+		return e.complexity.Enrollment.Version(childComplexity), true
+
 	case "EnrollmentListResponse.data":
 		if e.complexity.EnrollmentListResponse.Data == nil {
 			break
@@ -1662,6 +2675,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Faculty.UpdatedBy(childComplexity), true
 
+	case "Faculty.version":
+		if e.complexity.Faculty.Version == nil {
+			break
+		}
+
+		return e.complexity.Faculty.Version(childComplexity), true
+
 	case "FacultyListResponse.data":
 		if e.complexity.FacultyListResponse.Data == nil {
 			break
@@ -1676,6 +2696,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.FacultyListResponse.Total(childComplexity), true
 
+	case "File.checksum":
+		if e.complexity.File.Checksum == nil {
+			break
+		}
+
+		return e.complexity.File.Checksum(childComplexity), true
+
+	case "File.contentType":
+		if e.complexity.File.ContentType == nil {
+			break
+		}
+
+		return e.complexity.File.ContentType(childComplexity), true
+
 	case "File.createdAt":
 		if e.complexity.File.CreatedAt == nil {
 			break
@@ -1704,6 +2738,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.File.ID(childComplexity), true
 
+	case "File.late":
+		if e.complexity.File.Late == nil {
+			break
+		}
+
+		return e.complexity.File.Late(childComplexity), true
+
 	case "File.option":
 		if e.complexity.File.Option == nil {
 			break
@@ -1711,6 +2752,34 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.File.Option(childComplexity), true
 
+	case "File.quarantined":
+		if e.complexity.File.Quarantined == nil {
+			break
+		}
+
+		return e.complexity.File.Quarantined(childComplexity), true
+
+	case "File.revision":
+		if e.complexity.File.Revision == nil {
+			break
+		}
+
+		return e.complexity.File.Revision(childComplexity), true
+
+	case "File.scanResult":
+		if e.complexity.File.ScanResult == nil {
+			break
+		}
+
+		return e.complexity.File.ScanResult(childComplexity), true
+
+	case "File.size":
+		if e.complexity.File.Size == nil {
+			break
+		}
+
+		return e.complexity.File.Size(childComplexity), true
+
 	case "File.status":
 		if e.complexity.File.Status == nil {
 			break
@@ -1753,6 +2822,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.File.UpdatedBy(childComplexity), true
 
+	case "File.version":
+		if e.complexity.File.Version == nil {
+			break
+		}
+
+		return e.complexity.File.Version(childComplexity), true
+
+	case "File.versions":
+		if e.complexity.File.Versions == nil {
+			break
+		}
+
+		return e.complexity.File.Versions(childComplexity), true
+
 	case "FileListResponse.data":
 		if e.complexity.FileListResponse.Data == nil {
 			break
@@ -1851,870 +2934,2105 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Final.UpdatedBy(childComplexity), true
 
-	case "FinalListResponse.data":
-		if e.complexity.FinalListResponse.Data == nil {
+	case "Final.version":
+		if e.complexity.Final.Version == nil {
 			break
 		}
 
-		return e.complexity.FinalListResponse.Data(childComplexity), true
+		return e.complexity.Final.Version(childComplexity), true
 
-	case "FinalListResponse.total":
-		if e.complexity.FinalListResponse.Total == nil {
+	case "FinalGradeBreakdown.components":
+		if e.complexity.FinalGradeBreakdown.Components == nil {
 			break
 		}
 
-		return e.complexity.FinalListResponse.Total(childComplexity), true
+		return e.complexity.FinalGradeBreakdown.Components(childComplexity), true
 
-	case "GradeDefence.createdAt":
-		if e.complexity.GradeDefence.CreatedAt == nil {
+	case "FinalGradeBreakdown.councilSpread":
+		if e.complexity.FinalGradeBreakdown.CouncilSpread == nil {
 			break
 		}
 
-		return e.complexity.GradeDefence.CreatedAt(childComplexity), true
+		return e.complexity.FinalGradeBreakdown.CouncilSpread(childComplexity), true
 
-	case "GradeDefence.createdBy":
-		if e.complexity.GradeDefence.CreatedBy == nil {
+	case "FinalGradeBreakdown.enrollmentCode":
+		if e.complexity.FinalGradeBreakdown.EnrollmentCode == nil {
 			break
 		}
 
-		return e.complexity.GradeDefence.CreatedBy(childComplexity), true
+		return e.complexity.FinalGradeBreakdown.EnrollmentCode(childComplexity), true
 
-	case "GradeDefence.criteria":
-		if e.complexity.GradeDefence.Criteria == nil {
+	case "FinalGradeBreakdown.final":
+		if e.complexity.FinalGradeBreakdown.Final == nil {
 			break
 		}
 
-		return e.complexity.GradeDefence.Criteria(childComplexity), true
+		return e.complexity.FinalGradeBreakdown.Final(childComplexity), true
 
-	case "GradeDefence.defence":
-		if e.complexity.GradeDefence.Defence == nil {
+	case "FinalGradeBreakdown.finalGrade":
+		if e.complexity.FinalGradeBreakdown.FinalGrade == nil {
 			break
 		}
 
-		return e.complexity.GradeDefence.Defence(childComplexity), true
+		return e.complexity.FinalGradeBreakdown.FinalGrade(childComplexity), true
 
-	case "GradeDefence.defenceCode":
-		if e.complexity.GradeDefence.DefenceCode == nil {
+	case "FinalGradeBreakdown.finalized":
+		if e.complexity.FinalGradeBreakdown.Finalized == nil {
 			break
 		}
 
-		return e.complexity.GradeDefence.DefenceCode(childComplexity), true
+		return e.complexity.FinalGradeBreakdown.Finalized(childComplexity), true
 
-	case "GradeDefence.enrollment":
-		if e.complexity.GradeDefence.Enrollment == nil {
+	case "FinalGradeBreakdown.missing":
+		if e.complexity.FinalGradeBreakdown.Missing == nil {
 			break
 		}
 
-		return e.complexity.GradeDefence.Enrollment(childComplexity), true
+		return e.complexity.FinalGradeBreakdown.Missing(childComplexity), true
 
-	case "GradeDefence.enrollmentCode":
-		if e.complexity.GradeDefence.EnrollmentCode == nil {
+	case "FinalGradeBreakdown.needsReconciliation":
+		if e.complexity.FinalGradeBreakdown.NeedsReconciliation == nil {
 			break
 		}
 
-		return e.complexity.GradeDefence.EnrollmentCode(childComplexity), true
+		return e.complexity.FinalGradeBreakdown.NeedsReconciliation(childComplexity), true
 
-	case "GradeDefence.id":
-		if e.complexity.GradeDefence.ID == nil {
+	case "FinalGradeBreakdown.policyCode":
+		if e.complexity.FinalGradeBreakdown.PolicyCode == nil {
 			break
 		}
 
-		return e.complexity.GradeDefence.ID(childComplexity), true
+		return e.complexity.FinalGradeBreakdown.PolicyCode(childComplexity), true
 
-	case "GradeDefence.note":
-		if e.complexity.GradeDefence.Note == nil {
+	case "FinalGradeBreakdown.rawGrade":
+		if e.complexity.FinalGradeBreakdown.RawGrade == nil {
 			break
 		}
 
-		return e.complexity.GradeDefence.Note(childComplexity), true
+		return e.complexity.FinalGradeBreakdown.RawGrade(childComplexity), true
 
-	case "GradeDefence.totalScore":
-		if e.complexity.GradeDefence.TotalScore == nil {
+	case "FinalGradeBreakdown.status":
+		if e.complexity.FinalGradeBreakdown.Status == nil {
 			break
 		}
 
-		return e.complexity.GradeDefence.TotalScore(childComplexity), true
+		return e.complexity.FinalGradeBreakdown.Status(childComplexity), true
 
-	case "GradeDefence.updatedAt":
-		if e.complexity.GradeDefence.UpdatedAt == nil {
+	case "FinalGradeBreakdown.steps":
+		if e.complexity.FinalGradeBreakdown.Steps == nil {
 			break
 		}
 
-		return e.complexity.GradeDefence.UpdatedAt(childComplexity), true
+		return e.complexity.FinalGradeBreakdown.Steps(childComplexity), true
 
-	case "GradeDefence.updatedBy":
-		if e.complexity.GradeDefence.UpdatedBy == nil {
+	case "FinalListResponse.data":
+		if e.complexity.FinalListResponse.Data == nil {
 			break
 		}
 
-		return e.complexity.GradeDefence.UpdatedBy(childComplexity), true
+		return e.complexity.FinalListResponse.Data(childComplexity), true
 
-	case "GradeDefenceCriterion.createdAt":
-		if e.complexity.GradeDefenceCriterion.CreatedAt == nil {
+	case "FinalListResponse.total":
+		if e.complexity.FinalListResponse.Total == nil {
 			break
 		}
 
-		return e.complexity.GradeDefenceCriterion.CreatedAt(childComplexity), true
+		return e.complexity.FinalListResponse.Total(childComplexity), true
 
-	case "GradeDefenceCriterion.createdBy":
-		if e.complexity.GradeDefenceCriterion.CreatedBy == nil {
+	case "GradeAmendment.councilCode":
+		if e.complexity.GradeAmendment.CouncilCode == nil {
 			break
 		}
 
-		return e.complexity.GradeDefenceCriterion.CreatedBy(childComplexity), true
+		return e.complexity.GradeAmendment.CouncilCode(childComplexity), true
 
-	case "GradeDefenceCriterion.gradeDefence":
-		if e.complexity.GradeDefenceCriterion.GradeDefence == nil {
+	case "GradeAmendment.createdAt":
+		if e.complexity.GradeAmendment.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.GradeDefenceCriterion.GradeDefence(childComplexity), true
+		return e.complexity.GradeAmendment.CreatedAt(childComplexity), true
 
-	case "GradeDefenceCriterion.gradeDefenceCode":
-		if e.complexity.GradeDefenceCriterion.GradeDefenceCode == nil {
+	case "GradeAmendment.decidedAt":
+		if e.complexity.GradeAmendment.DecidedAt == nil {
 			break
 		}
 
-		return e.complexity.GradeDefenceCriterion.GradeDefenceCode(childComplexity), true
+		return e.complexity.GradeAmendment.DecidedAt(childComplexity), true
 
-	case "GradeDefenceCriterion.id":
-		if e.complexity.GradeDefenceCriterion.ID == nil {
+	case "GradeAmendment.decidedBy":
+		if e.complexity.GradeAmendment.DecidedBy == nil {
 			break
 		}
 
-		return e.complexity.GradeDefenceCriterion.ID(childComplexity), true
+		return e.complexity.GradeAmendment.DecidedBy(childComplexity), true
 
-	case "GradeDefenceCriterion.maxScore":
-		if e.complexity.GradeDefenceCriterion.MaxScore == nil {
+	case "GradeAmendment.decisionNote":
+		if e.complexity.GradeAmendment.DecisionNote == nil {
 			break
 		}
 
-		return e.complexity.GradeDefenceCriterion.MaxScore(childComplexity), true
+		return e.complexity.GradeAmendment.DecisionNote(childComplexity), true
 
-	case "GradeDefenceCriterion.name":
-		if e.complexity.GradeDefenceCriterion.Name == nil {
+	case "GradeAmendment.enrollmentCode":
+		if e.complexity.GradeAmendment.EnrollmentCode == nil {
 			break
 		}
 
-		return e.complexity.GradeDefenceCriterion.Name(childComplexity), true
+		return e.complexity.GradeAmendment.EnrollmentCode(childComplexity), true
 
-	case "GradeDefenceCriterion.score":
-		if e.complexity.GradeDefenceCriterion.Score == nil {
+	case "GradeAmendment.id":
+		if e.complexity.GradeAmendment.ID == nil {
 			break
 		}
 
-		return e.complexity.GradeDefenceCriterion.Score(childComplexity), true
+		return e.complexity.GradeAmendment.ID(childComplexity), true
 
-	case "GradeDefenceCriterion.updatedAt":
-		if e.complexity.GradeDefenceCriterion.UpdatedAt == nil {
+	case "GradeAmendment.newValue":
+		if e.complexity.GradeAmendment.NewValue == nil {
 			break
 		}
 
-		return e.complexity.GradeDefenceCriterion.UpdatedAt(childComplexity), true
+		return e.complexity.GradeAmendment.NewValue(childComplexity), true
 
-	case "GradeDefenceCriterion.updatedBy":
-		if e.complexity.GradeDefenceCriterion.UpdatedBy == nil {
+	case "GradeAmendment.oldValue":
+		if e.complexity.GradeAmendment.OldValue == nil {
 			break
 		}
 
-		return e.complexity.GradeDefenceCriterion.UpdatedBy(childComplexity), true
+		return e.complexity.GradeAmendment.OldValue(childComplexity), true
 
-	case "GradeDefenceCriterionListResponse.data":
-		if e.complexity.GradeDefenceCriterionListResponse.Data == nil {
+	case "GradeAmendment.reason":
+		if e.complexity.GradeAmendment.Reason == nil {
 			break
 		}
 
-		return e.complexity.GradeDefenceCriterionListResponse.Data(childComplexity), true
+		return e.complexity.GradeAmendment.Reason(childComplexity), true
 
-	case "GradeDefenceCriterionListResponse.total":
-		if e.complexity.GradeDefenceCriterionListResponse.Total == nil {
+	case "GradeAmendment.requestedBy":
+		if e.complexity.GradeAmendment.RequestedBy == nil {
 			break
 		}
 
-		return e.complexity.GradeDefenceCriterionListResponse.Total(childComplexity), true
+		return e.complexity.GradeAmendment.RequestedBy(childComplexity), true
 
-	case "GradeDefenceListResponse.data":
-		if e.complexity.GradeDefenceListResponse.Data == nil {
+	case "GradeAmendment.semesterCode":
+		if e.complexity.GradeAmendment.SemesterCode == nil {
 			break
 		}
 
-		return e.complexity.GradeDefenceListResponse.Data(childComplexity), true
+		return e.complexity.GradeAmendment.SemesterCode(childComplexity), true
 
-	case "GradeDefenceListResponse.total":
-		if e.complexity.GradeDefenceListResponse.Total == nil {
+	case "GradeAmendment.status":
+		if e.complexity.GradeAmendment.Status == nil {
 			break
 		}
 
-		return e.complexity.GradeDefenceListResponse.Total(childComplexity), true
+		return e.complexity.GradeAmendment.Status(childComplexity), true
 
-	case "GradeReview.completionDate":
-		if e.complexity.GradeReview.CompletionDate == nil {
+	case "GradeAmendment.target":
+		if e.complexity.GradeAmendment.Target == nil {
 			break
 		}
 
-		return e.complexity.GradeReview.CompletionDate(childComplexity), true
+		return e.complexity.GradeAmendment.Target(childComplexity), true
 
-	case "GradeReview.createdAt":
-		if e.complexity.GradeReview.CreatedAt == nil {
+	case "GradeAmendment.targetId":
+		if e.complexity.GradeAmendment.TargetID == nil {
 			break
 		}
 
-		return e.complexity.GradeReview.CreatedAt(childComplexity), true
+		return e.complexity.GradeAmendment.TargetID(childComplexity), true
 
-	case "GradeReview.createdBy":
-		if e.complexity.GradeReview.CreatedBy == nil {
+	case "GradeAmendment.updatedAt":
+		if e.complexity.GradeAmendment.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.GradeReview.CreatedBy(childComplexity), true
+		return e.complexity.GradeAmendment.UpdatedAt(childComplexity), true
 
-	case "GradeReview.id":
-		if e.complexity.GradeReview.ID == nil {
+	case "GradeAppeal.amendmentCode":
+		if e.complexity.GradeAppeal.AmendmentCode == nil {
 			break
 		}
 
-		return e.complexity.GradeReview.ID(childComplexity), true
+		return e.complexity.GradeAppeal.AmendmentCode(childComplexity), true
 
-	case "GradeReview.notes":
-		if e.complexity.GradeReview.Notes == nil {
+	case "GradeAppeal.component":
+		if e.complexity.GradeAppeal.Component == nil {
 			break
 		}
 
-		return e.complexity.GradeReview.Notes(childComplexity), true
+		return e.complexity.GradeAppeal.Component(childComplexity), true
 
-	case "GradeReview.reviewGrade":
-		if e.complexity.GradeReview.ReviewGrade == nil {
+	case "GradeAppeal.createdAt":
+		if e.complexity.GradeAppeal.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.GradeReview.ReviewGrade(childComplexity), true
+		return e.complexity.GradeAppeal.CreatedAt(childComplexity), true
 
-	case "GradeReview.status":
-		if e.complexity.GradeReview.Status == nil {
+	case "GradeAppeal.enrollmentCode":
+		if e.complexity.GradeAppeal.EnrollmentCode == nil {
 			break
 		}
 
-		return e.complexity.GradeReview.Status(childComplexity), true
+		return e.complexity.GradeAppeal.EnrollmentCode(childComplexity), true
 
-	case "GradeReview.teacher":
-		if e.complexity.GradeReview.Teacher == nil {
+	case "GradeAppeal.evidenceFileCode":
+		if e.complexity.GradeAppeal.EvidenceFileCode == nil {
 			break
 		}
 
-		return e.complexity.GradeReview.Teacher(childComplexity), true
+		return e.complexity.GradeAppeal.EvidenceFileCode(childComplexity), true
 
-	case "GradeReview.teacherCode":
-		if e.complexity.GradeReview.TeacherCode == nil {
+	case "GradeAppeal.history":
+		if e.complexity.GradeAppeal.History == nil {
 			break
 		}
 
-		return e.complexity.GradeReview.TeacherCode(childComplexity), true
+		return e.complexity.GradeAppeal.History(childComplexity), true
 
-	case "GradeReview.title":
-		if e.complexity.GradeReview.Title == nil {
+	case "GradeAppeal.id":
+		if e.complexity.GradeAppeal.ID == nil {
 			break
 		}
 
-		return e.complexity.GradeReview.Title(childComplexity), true
+		return e.complexity.GradeAppeal.ID(childComplexity), true
 
-	case "GradeReview.updatedAt":
-		if e.complexity.GradeReview.UpdatedAt == nil {
+	case "GradeAppeal.reason":
+		if e.complexity.GradeAppeal.Reason == nil {
 			break
 		}
 
-		return e.complexity.GradeReview.UpdatedAt(childComplexity), true
+		return e.complexity.GradeAppeal.Reason(childComplexity), true
 
-	case "GradeReview.updatedBy":
-		if e.complexity.GradeReview.UpdatedBy == nil {
+	case "GradeAppeal.resolutionNote":
+		if e.complexity.GradeAppeal.ResolutionNote == nil {
 			break
 		}
 
-		return e.complexity.GradeReview.UpdatedBy(childComplexity), true
+		return e.complexity.GradeAppeal.ResolutionNote(childComplexity), true
 
-	case "GradeReviewListResponse.data":
-		if e.complexity.GradeReviewListResponse.Data == nil {
+	case "GradeAppeal.resolvedAt":
+		if e.complexity.GradeAppeal.ResolvedAt == nil {
 			break
 		}
 
-		return e.complexity.GradeReviewListResponse.Data(childComplexity), true
+		return e.complexity.GradeAppeal.ResolvedAt(childComplexity), true
 
-	case "GradeReviewListResponse.total":
-		if e.complexity.GradeReviewListResponse.Total == nil {
+	case "GradeAppeal.resolvedBy":
+		if e.complexity.GradeAppeal.ResolvedBy == nil {
 			break
 		}
 
-		return e.complexity.GradeReviewListResponse.Total(childComplexity), true
+		return e.complexity.GradeAppeal.ResolvedBy(childComplexity), true
 
-	case "Major.createdAt":
-		if e.complexity.Major.CreatedAt == nil {
+	case "GradeAppeal.reviewerCode":
+		if e.complexity.GradeAppeal.ReviewerCode == nil {
 			break
 		}
 
-		return e.complexity.Major.CreatedAt(childComplexity), true
+		return e.complexity.GradeAppeal.ReviewerCode(childComplexity), true
 
-	case "Major.createdBy":
+	case "GradeAppeal.semesterCode":
+		if e.complexity.GradeAppeal.SemesterCode == nil {
+			break
+		}
+
+		return e.complexity.GradeAppeal.SemesterCode(childComplexity), true
+
+	case "GradeAppeal.status":
+		if e.complexity.GradeAppeal.Status == nil {
+			break
+		}
+
+		return e.complexity.GradeAppeal.Status(childComplexity), true
+
+	case "GradeAppeal.studentCode":
+		if e.complexity.GradeAppeal.StudentCode == nil {
+			break
+		}
+
+		return e.complexity.GradeAppeal.StudentCode(childComplexity), true
+
+	case "GradeAppeal.updatedAt":
+		if e.complexity.GradeAppeal.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.GradeAppeal.UpdatedAt(childComplexity), true
+
+	case "GradeAppealEvent.actor":
+		if e.complexity.GradeAppealEvent.Actor == nil {
+			break
+		}
+
+		return e.complexity.GradeAppealEvent.Actor(childComplexity), true
+
+	case "GradeAppealEvent.createdAt":
+		if e.complexity.GradeAppealEvent.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.GradeAppealEvent.CreatedAt(childComplexity), true
+
+	case "GradeAppealEvent.note":
+		if e.complexity.GradeAppealEvent.Note == nil {
+			break
+		}
+
+		return e.complexity.GradeAppealEvent.Note(childComplexity), true
+
+	case "GradeAppealEvent.status":
+		if e.complexity.GradeAppealEvent.Status == nil {
+			break
+		}
+
+		return e.complexity.GradeAppealEvent.Status(childComplexity), true
+
+	case "GradeComponent.name":
+		if e.complexity.GradeComponent.Name == nil {
+			break
+		}
+
+		return e.complexity.GradeComponent.Name(childComplexity), true
+
+	case "GradeComponent.score":
+		if e.complexity.GradeComponent.Score == nil {
+			break
+		}
+
+		return e.complexity.GradeComponent.Score(childComplexity), true
+
+	case "GradeComponent.weight":
+		if e.complexity.GradeComponent.Weight == nil {
+			break
+		}
+
+		return e.complexity.GradeComponent.Weight(childComplexity), true
+
+	case "GradeDefence.createdAt":
+		if e.complexity.GradeDefence.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.GradeDefence.CreatedAt(childComplexity), true
+
+	case "GradeDefence.createdBy":
+		if e.complexity.GradeDefence.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.GradeDefence.CreatedBy(childComplexity), true
+
+	case "GradeDefence.criteria":
+		if e.complexity.GradeDefence.Criteria == nil {
+			break
+		}
+
+		return e.complexity.GradeDefence.Criteria(childComplexity), true
+
+	case "GradeDefence.defence":
+		if e.complexity.GradeDefence.Defence == nil {
+			break
+		}
+
+		return e.complexity.GradeDefence.Defence(childComplexity), true
+
+	case "GradeDefence.defenceCode":
+		if e.complexity.GradeDefence.DefenceCode == nil {
+			break
+		}
+
+		return e.complexity.GradeDefence.DefenceCode(childComplexity), true
+
+	case "GradeDefence.enrollment":
+		if e.complexity.GradeDefence.Enrollment == nil {
+			break
+		}
+
+		return e.complexity.GradeDefence.Enrollment(childComplexity), true
+
+	case "GradeDefence.enrollmentCode":
+		if e.complexity.GradeDefence.EnrollmentCode == nil {
+			break
+		}
+
+		return e.complexity.GradeDefence.EnrollmentCode(childComplexity), true
+
+	case "GradeDefence.id":
+		if e.complexity.GradeDefence.ID == nil {
+			break
+		}
+
+		return e.complexity.GradeDefence.ID(childComplexity), true
+
+	case "GradeDefence.note":
+		if e.complexity.GradeDefence.Note == nil {
+			break
+		}
+
+		return e.complexity.GradeDefence.Note(childComplexity), true
+
+	case "GradeDefence.rubricTemplateCode":
+		if e.complexity.GradeDefence.RubricTemplateCode == nil {
+			break
+		}
+
+		return e.complexity.GradeDefence.RubricTemplateCode(childComplexity), true
+
+	case "GradeDefence.totalScore":
+		if e.complexity.GradeDefence.TotalScore == nil {
+			break
+		}
+
+		return e.complexity.GradeDefence.TotalScore(childComplexity), true
+
+	case "GradeDefence.updatedAt":
+		if e.complexity.GradeDefence.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.GradeDefence.UpdatedAt(childComplexity), true
+
+	case "GradeDefence.updatedBy":
+		if e.complexity.GradeDefence.UpdatedBy == nil {
+			break
+		}
+
+		return e.complexity.GradeDefence.UpdatedBy(childComplexity), true
+
+	case "GradeDefence.version":
+		if e.complexity.GradeDefence.Version == nil {
+			break
+		}
+
+		return e.complexity.GradeDefence.Version(childComplexity), true
+
+	case "GradeDefenceCriterion.createdAt":
+		if e.complexity.GradeDefenceCriterion.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.GradeDefenceCriterion.CreatedAt(childComplexity), true
+
+	case "GradeDefenceCriterion.createdBy":
+		if e.complexity.GradeDefenceCriterion.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.GradeDefenceCriterion.CreatedBy(childComplexity), true
+
+	case "GradeDefenceCriterion.description":
+		if e.complexity.GradeDefenceCriterion.Description == nil {
+			break
+		}
+
+		return e.complexity.GradeDefenceCriterion.Description(childComplexity), true
+
+	case "GradeDefenceCriterion.gradeDefence":
+		if e.complexity.GradeDefenceCriterion.GradeDefence == nil {
+			break
+		}
+
+		return e.complexity.GradeDefenceCriterion.GradeDefence(childComplexity), true
+
+	case "GradeDefenceCriterion.gradeDefenceCode":
+		if e.complexity.GradeDefenceCriterion.GradeDefenceCode == nil {
+			break
+		}
+
+		return e.complexity.GradeDefenceCriterion.GradeDefenceCode(childComplexity), true
+
+	case "GradeDefenceCriterion.id":
+		if e.complexity.GradeDefenceCriterion.ID == nil {
+			break
+		}
+
+		return e.complexity.GradeDefenceCriterion.ID(childComplexity), true
+
+	case "GradeDefenceCriterion.maxScore":
+		if e.complexity.GradeDefenceCriterion.MaxScore == nil {
+			break
+		}
+
+		return e.complexity.GradeDefenceCriterion.MaxScore(childComplexity), true
+
+	case "GradeDefenceCriterion.name":
+		if e.complexity.GradeDefenceCriterion.Name == nil {
+			break
+		}
+
+		return e.complexity.GradeDefenceCriterion.Name(childComplexity), true
+
+	case "GradeDefenceCriterion.rubricCriterionCode":
+		if e.complexity.GradeDefenceCriterion.RubricCriterionCode == nil {
+			break
+		}
+
+		return e.complexity.GradeDefenceCriterion.RubricCriterionCode(childComplexity), true
+
+	case "GradeDefenceCriterion.score":
+		if e.complexity.GradeDefenceCriterion.Score == nil {
+			break
+		}
+
+		return e.complexity.GradeDefenceCriterion.Score(childComplexity), true
+
+	case "GradeDefenceCriterion.updatedAt":
+		if e.complexity.GradeDefenceCriterion.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.GradeDefenceCriterion.UpdatedAt(childComplexity), true
+
+	case "GradeDefenceCriterion.updatedBy":
+		if e.complexity.GradeDefenceCriterion.UpdatedBy == nil {
+			break
+		}
+
+		return e.complexity.GradeDefenceCriterion.UpdatedBy(childComplexity), true
+
+	case "GradeDefenceCriterion.version":
+		if e.complexity.GradeDefenceCriterion.Version == nil {
+			break
+		}
+
+		return e.complexity.GradeDefenceCriterion.Version(childComplexity), true
+
+	case "GradeDefenceCriterion.weight":
+		if e.complexity.GradeDefenceCriterion.Weight == nil {
+			break
+		}
+
+		return e.complexity.GradeDefenceCriterion.Weight(childComplexity), true
+
+	case "GradeDefenceCriterionListResponse.data":
+		if e.complexity.GradeDefenceCriterionListResponse.Data == nil {
+			break
+		}
+
+		return e.complexity.GradeDefenceCriterionListResponse.Data(childComplexity), true
+
+	case "GradeDefenceCriterionListResponse.total":
+		if e.complexity.GradeDefenceCriterionListResponse.Total == nil {
+			break
+		}
+
+		return e.complexity.GradeDefenceCriterionListResponse.Total(childComplexity), true
+
+	case "GradeDefenceListResponse.data":
+		if e.complexity.GradeDefenceListResponse.Data == nil {
+			break
+		}
+
+		return e.complexity.GradeDefenceListResponse.Data(childComplexity), true
+
+	case "GradeDefenceListResponse.total":
+		if e.complexity.GradeDefenceListResponse.Total == nil {
+			break
+		}
+
+		return e.complexity.GradeDefenceListResponse.Total(childComplexity), true
+
+	case "GradeReview.completionDate":
+		if e.complexity.GradeReview.CompletionDate == nil {
+			break
+		}
+
+		return e.complexity.GradeReview.CompletionDate(childComplexity), true
+
+	case "GradeReview.createdAt":
+		if e.complexity.GradeReview.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.GradeReview.CreatedAt(childComplexity), true
+
+	case "GradeReview.createdBy":
+		if e.complexity.GradeReview.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.GradeReview.CreatedBy(childComplexity), true
+
+	case "GradeReview.id":
+		if e.complexity.GradeReview.ID == nil {
+			break
+		}
+
+		return e.complexity.GradeReview.ID(childComplexity), true
+
+	case "GradeReview.notes":
+		if e.complexity.GradeReview.Notes == nil {
+			break
+		}
+
+		return e.complexity.GradeReview.Notes(childComplexity), true
+
+	case "GradeReview.reviewGrade":
+		if e.complexity.GradeReview.ReviewGrade == nil {
+			break
+		}
+
+		return e.complexity.GradeReview.ReviewGrade(childComplexity), true
+
+	case "GradeReview.status":
+		if e.complexity.GradeReview.Status == nil {
+			break
+		}
+
+		return e.complexity.GradeReview.Status(childComplexity), true
+
+	case "GradeReview.teacher":
+		if e.complexity.GradeReview.Teacher == nil {
+			break
+		}
+
+		return e.complexity.GradeReview.Teacher(childComplexity), true
+
+	case "GradeReview.teacherCode":
+		if e.complexity.GradeReview.TeacherCode == nil {
+			break
+		}
+
+		return e.complexity.GradeReview.TeacherCode(childComplexity), true
+
+	case "GradeReview.title":
+		if e.complexity.GradeReview.Title == nil {
+			break
+		}
+
+		return e.complexity.GradeReview.Title(childComplexity), true
+
+	case "GradeReview.updatedAt":
+		if e.complexity.GradeReview.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.GradeReview.UpdatedAt(childComplexity), true
+
+	case "GradeReview.updatedBy":
+		if e.complexity.GradeReview.UpdatedBy == nil {
+			break
+		}
+
+		return e.complexity.GradeReview.UpdatedBy(childComplexity), true
+
+	case "GradeReview.version":
+		if e.complexity.GradeReview.Version == nil {
+			break
+		}
+
+		return e.complexity.GradeReview.Version(childComplexity), true
+
+	case "GradeReviewListResponse.data":
+		if e.complexity.GradeReviewListResponse.Data == nil {
+			break
+		}
+
+		return e.complexity.GradeReviewListResponse.Data(childComplexity), true
+
+	case "GradeReviewListResponse.total":
+		if e.complexity.GradeReviewListResponse.Total == nil {
+			break
+		}
+
+		return e.complexity.GradeReviewListResponse.Total(childComplexity), true
+
+	case "GradingPolicy.councilWeight":
+		if e.complexity.GradingPolicy.CouncilWeight == nil {
+			break
+		}
+
+		return e.complexity.GradingPolicy.CouncilWeight(childComplexity), true
+
+	case "GradingPolicy.createdAt":
+		if e.complexity.GradingPolicy.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.GradingPolicy.CreatedAt(childComplexity), true
+
+	case "GradingPolicy.createdBy":
+		if e.complexity.GradingPolicy.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.GradingPolicy.CreatedBy(childComplexity), true
+
+	case "GradingPolicy.id":
+		if e.complexity.GradingPolicy.ID == nil {
+			break
+		}
+
+		return e.complexity.GradingPolicy.ID(childComplexity), true
+
+	case "GradingPolicy.majorCode":
+		if e.complexity.GradingPolicy.MajorCode == nil {
+			break
+		}
+
+		return e.complexity.GradingPolicy.MajorCode(childComplexity), true
+
+	case "GradingPolicy.maxCouncilSpread":
+		if e.complexity.GradingPolicy.MaxCouncilSpread == nil {
+			break
+		}
+
+		return e.complexity.GradingPolicy.MaxCouncilSpread(childComplexity), true
+
+	case "GradingPolicy.passThreshold":
+		if e.complexity.GradingPolicy.PassThreshold == nil {
+			break
+		}
+
+		return e.complexity.GradingPolicy.PassThreshold(childComplexity), true
+
+	case "GradingPolicy.reviewerWeight":
+		if e.complexity.GradingPolicy.ReviewerWeight == nil {
+			break
+		}
+
+		return e.complexity.GradingPolicy.ReviewerWeight(childComplexity), true
+
+	case "GradingPolicy.rounding":
+		if e.complexity.GradingPolicy.Rounding == nil {
+			break
+		}
+
+		return e.complexity.GradingPolicy.Rounding(childComplexity), true
+
+	case "GradingPolicy.roundingStep":
+		if e.complexity.GradingPolicy.RoundingStep == nil {
+			break
+		}
+
+		return e.complexity.GradingPolicy.RoundingStep(childComplexity), true
+
+	case "GradingPolicy.semesterCode":
+		if e.complexity.GradingPolicy.SemesterCode == nil {
+			break
+		}
+
+		return e.complexity.GradingPolicy.SemesterCode(childComplexity), true
+
+	case "GradingPolicy.stage":
+		if e.complexity.GradingPolicy.Stage == nil {
+			break
+		}
+
+		return e.complexity.GradingPolicy.Stage(childComplexity), true
+
+	case "GradingPolicy.supervisorWeight":
+		if e.complexity.GradingPolicy.SupervisorWeight == nil {
+			break
+		}
+
+		return e.complexity.GradingPolicy.SupervisorWeight(childComplexity), true
+
+	case "GradingPolicy.updatedAt":
+		if e.complexity.GradingPolicy.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.GradingPolicy.UpdatedAt(childComplexity), true
+
+	case "GradingPolicy.updatedBy":
+		if e.complexity.GradingPolicy.UpdatedBy == nil {
+			break
+		}
+
+		return e.complexity.GradingPolicy.UpdatedBy(childComplexity), true
+
+	case "Major.createdAt":
+		if e.complexity.Major.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Major.CreatedAt(childComplexity), true
+
+	case "Major.createdBy":
 		if e.complexity.Major.CreatedBy == nil {
 			break
 		}
 
-		return e.complexity.Major.CreatedBy(childComplexity), true
+		return e.complexity.Major.CreatedBy(childComplexity), true
+
+	case "Major.deletedAt":
+		if e.complexity.Major.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.Major.DeletedAt(childComplexity), true
+
+	case "Major.deletedBy":
+		if e.complexity.Major.DeletedBy == nil {
+			break
+		}
+
+		return e.complexity.Major.DeletedBy(childComplexity), true
+
+	case "Major.facultyCode":
+		if e.complexity.Major.FacultyCode == nil {
+			break
+		}
+
+		return e.complexity.Major.FacultyCode(childComplexity), true
+
+	case "Major.id":
+		if e.complexity.Major.ID == nil {
+			break
+		}
+
+		return e.complexity.Major.ID(childComplexity), true
+
+	case "Major.title":
+		if e.complexity.Major.Title == nil {
+			break
+		}
+
+		return e.complexity.Major.Title(childComplexity), true
+
+	case "Major.topics":
+		if e.complexity.Major.Topics == nil {
+			break
+		}
+
+		return e.complexity.Major.Topics(childComplexity), true
+
+	case "Major.updatedAt":
+		if e.complexity.Major.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Major.UpdatedAt(childComplexity), true
+
+	case "Major.updatedBy":
+		if e.complexity.Major.UpdatedBy == nil {
+			break
+		}
+
+		return e.complexity.Major.UpdatedBy(childComplexity), true
+
+	case "Major.version":
+		if e.complexity.Major.Version == nil {
+			break
+		}
+
+		return e.complexity.Major.Version(childComplexity), true
+
+	case "MajorInfo.facultyCode":
+		if e.complexity.MajorInfo.FacultyCode == nil {
+			break
+		}
+
+		return e.complexity.MajorInfo.FacultyCode(childComplexity), true
+
+	case "MajorInfo.id":
+		if e.complexity.MajorInfo.ID == nil {
+			break
+		}
+
+		return e.complexity.MajorInfo.ID(childComplexity), true
+
+	case "MajorInfo.title":
+		if e.complexity.MajorInfo.Title == nil {
+			break
+		}
+
+		return e.complexity.MajorInfo.Title(childComplexity), true
+
+	case "MajorListResponse.data":
+		if e.complexity.MajorListResponse.Data == nil {
+			break
+		}
+
+		return e.complexity.MajorListResponse.Data(childComplexity), true
+
+	case "MajorListResponse.total":
+		if e.complexity.MajorListResponse.Total == nil {
+			break
+		}
+
+		return e.complexity.MajorListResponse.Total(childComplexity), true
+
+	case "MatchAssignment.choice":
+		if e.complexity.MatchAssignment.Choice == nil {
+			break
+		}
+
+		return e.complexity.MatchAssignment.Choice(childComplexity), true
+
+	case "MatchAssignment.enrollmentCode":
+		if e.complexity.MatchAssignment.EnrollmentCode == nil {
+			break
+		}
+
+		return e.complexity.MatchAssignment.EnrollmentCode(childComplexity), true
+
+	case "MatchAssignment.studentCode":
+		if e.complexity.MatchAssignment.StudentCode == nil {
+			break
+		}
+
+		return e.complexity.MatchAssignment.StudentCode(childComplexity), true
+
+	case "MatchAssignment.topicCode":
+		if e.complexity.MatchAssignment.TopicCode == nil {
+			break
+		}
+
+		return e.complexity.MatchAssignment.TopicCode(childComplexity), true
+
+	case "MatchAssignment.topicCouncilCode":
+		if e.complexity.MatchAssignment.TopicCouncilCode == nil {
+			break
+		}
+
+		return e.complexity.MatchAssignment.TopicCouncilCode(childComplexity), true
+
+	case "MatchRejection.choice":
+		if e.complexity.MatchRejection.Choice == nil {
+			break
+		}
+
+		return e.complexity.MatchRejection.Choice(childComplexity), true
+
+	case "MatchRejection.reason":
+		if e.complexity.MatchRejection.Reason == nil {
+			break
+		}
+
+		return e.complexity.MatchRejection.Reason(childComplexity), true
+
+	case "MatchRejection.topicCode":
+		if e.complexity.MatchRejection.TopicCode == nil {
+			break
+		}
+
+		return e.complexity.MatchRejection.TopicCode(childComplexity), true
+
+	case "MatchedPassage.offset":
+		if e.complexity.MatchedPassage.Offset == nil {
+			break
+		}
+
+		return e.complexity.MatchedPassage.Offset(childComplexity), true
+
+	case "MatchedPassage.sourceOffset":
+		if e.complexity.MatchedPassage.SourceOffset == nil {
+			break
+		}
+
+		return e.complexity.MatchedPassage.SourceOffset(childComplexity), true
+
+	case "MatchedPassage.text":
+		if e.complexity.MatchedPassage.Text == nil {
+			break
+		}
+
+		return e.complexity.MatchedPassage.Text(childComplexity), true
+
+	case "MatchedPassage.words":
+		if e.complexity.MatchedPassage.Words == nil {
+			break
+		}
+
+		return e.complexity.MatchedPassage.Words(childComplexity), true
+
+	case "Midterm.createdAt":
+		if e.complexity.Midterm.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Midterm.CreatedAt(childComplexity), true
+
+	case "Midterm.createdBy":
+		if e.complexity.Midterm.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.Midterm.CreatedBy(childComplexity), true
+
+	case "Midterm.feedback":
+		if e.complexity.Midterm.Feedback == nil {
+			break
+		}
+
+		return e.complexity.Midterm.Feedback(childComplexity), true
+
+	case "Midterm.grade":
+		if e.complexity.Midterm.Grade == nil {
+			break
+		}
+
+		return e.complexity.Midterm.Grade(childComplexity), true
+
+	case "Midterm.id":
+		if e.complexity.Midterm.ID == nil {
+			break
+		}
+
+		return e.complexity.Midterm.ID(childComplexity), true
+
+	case "Midterm.status":
+		if e.complexity.Midterm.Status == nil {
+			break
+		}
+
+		return e.complexity.Midterm.Status(childComplexity), true
+
+	case "Midterm.title":
+		if e.complexity.Midterm.Title == nil {
+			break
+		}
+
+		return e.complexity.Midterm.Title(childComplexity), true
+
+	case "Midterm.updatedAt":
+		if e.complexity.Midterm.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Midterm.UpdatedAt(childComplexity), true
+
+	case "Midterm.updatedBy":
+		if e.complexity.Midterm.UpdatedBy == nil {
+			break
+		}
+
+		return e.complexity.Midterm.UpdatedBy(childComplexity), true
+
+	case "Midterm.version":
+		if e.complexity.Midterm.Version == nil {
+			break
+		}
+
+		return e.complexity.Midterm.Version(childComplexity), true
+
+	case "MidtermListResponse.data":
+		if e.complexity.MidtermListResponse.Data == nil {
+			break
+		}
+
+		return e.complexity.MidtermListResponse.Data(childComplexity), true
+
+	case "MidtermListResponse.total":
+		if e.complexity.MidtermListResponse.Total == nil {
+			break
+		}
+
+		return e.complexity.MidtermListResponse.Total(childComplexity), true
+
+	case "MidtermMilestone.createdAt":
+		if e.complexity.MidtermMilestone.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.MidtermMilestone.CreatedAt(childComplexity), true
+
+	case "MidtermMilestone.createdBy":
+		if e.complexity.MidtermMilestone.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.MidtermMilestone.CreatedBy(childComplexity), true
+
+	case "MidtermMilestone.description":
+		if e.complexity.MidtermMilestone.Description == nil {
+			break
+		}
+
+		return e.complexity.MidtermMilestone.Description(childComplexity), true
+
+	case "MidtermMilestone.dueAt":
+		if e.complexity.MidtermMilestone.DueAt == nil {
+			break
+		}
+
+		return e.complexity.MidtermMilestone.DueAt(childComplexity), true
+
+	case "MidtermMilestone.id":
+		if e.complexity.MidtermMilestone.ID == nil {
+			break
+		}
+
+		return e.complexity.MidtermMilestone.ID(childComplexity), true
+
+	case "MidtermMilestone.semesterCode":
+		if e.complexity.MidtermMilestone.SemesterCode == nil {
+			break
+		}
+
+		return e.complexity.MidtermMilestone.SemesterCode(childComplexity), true
+
+	case "MidtermMilestone.sequence":
+		if e.complexity.MidtermMilestone.Sequence == nil {
+			break
+		}
+
+		return e.complexity.MidtermMilestone.Sequence(childComplexity), true
+
+	case "MidtermMilestone.stage":
+		if e.complexity.MidtermMilestone.Stage == nil {
+			break
+		}
+
+		return e.complexity.MidtermMilestone.Stage(childComplexity), true
+
+	case "MidtermMilestone.title":
+		if e.complexity.MidtermMilestone.Title == nil {
+			break
+		}
+
+		return e.complexity.MidtermMilestone.Title(childComplexity), true
+
+	case "MidtermMilestone.updatedAt":
+		if e.complexity.MidtermMilestone.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.MidtermMilestone.UpdatedAt(childComplexity), true
+
+	case "MidtermMilestone.updatedBy":
+		if e.complexity.MidtermMilestone.UpdatedBy == nil {
+			break
+		}
+
+		return e.complexity.MidtermMilestone.UpdatedBy(childComplexity), true
+
+	case "MidtermMilestone.version":
+		if e.complexity.MidtermMilestone.Version == nil {
+			break
+		}
+
+		return e.complexity.MidtermMilestone.Version(childComplexity), true
+
+	case "MilestoneCheckin.comment":
+		if e.complexity.MilestoneCheckin.Comment == nil {
+			break
+		}
+
+		return e.complexity.MilestoneCheckin.Comment(childComplexity), true
+
+	case "MilestoneCheckin.enrollmentCode":
+		if e.complexity.MilestoneCheckin.EnrollmentCode == nil {
+			break
+		}
+
+		return e.complexity.MilestoneCheckin.EnrollmentCode(childComplexity), true
+
+	case "MilestoneCheckin.fileCode":
+		if e.complexity.MilestoneCheckin.FileCode == nil {
+			break
+		}
+
+		return e.complexity.MilestoneCheckin.FileCode(childComplexity), true
+
+	case "MilestoneCheckin.id":
+		if e.complexity.MilestoneCheckin.ID == nil {
+			break
+		}
+
+		return e.complexity.MilestoneCheckin.ID(childComplexity), true
+
+	case "MilestoneCheckin.milestoneCode":
+		if e.complexity.MilestoneCheckin.MilestoneCode == nil {
+			break
+		}
+
+		return e.complexity.MilestoneCheckin.MilestoneCode(childComplexity), true
+
+	case "MilestoneCheckin.report":
+		if e.complexity.MilestoneCheckin.Report == nil {
+			break
+		}
+
+		return e.complexity.MilestoneCheckin.Report(childComplexity), true
+
+	case "MilestoneCheckin.result":
+		if e.complexity.MilestoneCheckin.Result == nil {
+			break
+		}
+
+		return e.complexity.MilestoneCheckin.Result(childComplexity), true
+
+	case "MilestoneCheckin.reviewedAt":
+		if e.complexity.MilestoneCheckin.ReviewedAt == nil {
+			break
+		}
+
+		return e.complexity.MilestoneCheckin.ReviewedAt(childComplexity), true
+
+	case "MilestoneCheckin.reviewedBy":
+		if e.complexity.MilestoneCheckin.ReviewedBy == nil {
+			break
+		}
+
+		return e.complexity.MilestoneCheckin.ReviewedBy(childComplexity), true
+
+	case "MilestoneCheckin.submittedAt":
+		if e.complexity.MilestoneCheckin.SubmittedAt == nil {
+			break
+		}
+
+		return e.complexity.MilestoneCheckin.SubmittedAt(childComplexity), true
+
+	case "MilestoneCheckin.updatedAt":
+		if e.complexity.MilestoneCheckin.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.MilestoneCheckin.UpdatedAt(childComplexity), true
+
+	case "Mutation.addDefenceToCouncil":
+		if e.complexity.Mutation.AddDefenceToCouncil == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addDefenceToCouncil_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddDefenceToCouncil(childComplexity, args["input"].(model.CreateDefenceInput)), true
+
+	case "Mutation.addGradeDefenceCriterion":
+		if e.complexity.Mutation.AddGradeDefenceCriterion == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addGradeDefenceCriterion_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddGradeDefenceCriterion(childComplexity, args["input"].(model.CreateGradeDefenceCriterionInput)), true
+
+	case "Mutation.approveCouncil":
+		if e.complexity.Mutation.ApproveCouncil == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_approveCouncil_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ApproveCouncil(childComplexity, args["id"].(string), args["timeStart"].(time.Time)), true
+
+	case "Mutation.approveFinalFile":
+		if e.complexity.Mutation.ApproveFinalFile == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_approveFinalFile_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ApproveFinalFile(childComplexity, args["fileId"].(string)), true
+
+	case "Mutation.approveMidtermFile":
+		if e.complexity.Mutation.ApproveMidtermFile == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_approveMidtermFile_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Major.facultyCode":
-		if e.complexity.Major.FacultyCode == nil {
+		return e.complexity.Mutation.ApproveMidtermFile(childComplexity, args["fileId"].(string)), true
+
+	case "Mutation.approveTopic":
+		if e.complexity.Mutation.ApproveTopic == nil {
 			break
 		}
 
-		return e.complexity.Major.FacultyCode(childComplexity), true
+		args, err := ec.field_Mutation_approveTopic_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Major.id":
-		if e.complexity.Major.ID == nil {
+		return e.complexity.Mutation.ApproveTopic(childComplexity, args["id"].(string), args["note"].(*string)), true
+
+	case "Mutation.approveTopicStage1":
+		if e.complexity.Mutation.ApproveTopicStage1 == nil {
 			break
 		}
 
-		return e.complexity.Major.ID(childComplexity), true
+		args, err := ec.field_Mutation_approveTopicStage1_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Major.title":
-		if e.complexity.Major.Title == nil {
+		return e.complexity.Mutation.ApproveTopicStage1(childComplexity, args["id"].(string), args["note"].(*string)), true
+
+	case "Mutation.assignGradeAppeal":
+		if e.complexity.Mutation.AssignGradeAppeal == nil {
 			break
 		}
 
-		return e.complexity.Major.Title(childComplexity), true
+		args, err := ec.field_Mutation_assignGradeAppeal_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Major.topics":
-		if e.complexity.Major.Topics == nil {
+		return e.complexity.Mutation.AssignGradeAppeal(childComplexity, args["id"].(string), args["reviewerId"].(string)), true
+
+	case "Mutation.assignTopicToCouncil":
+		if e.complexity.Mutation.AssignTopicToCouncil == nil {
 			break
 		}
 
-		return e.complexity.Major.Topics(childComplexity), true
+		args, err := ec.field_Mutation_assignTopicToCouncil_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Major.updatedAt":
-		if e.complexity.Major.UpdatedAt == nil {
+		return e.complexity.Mutation.AssignTopicToCouncil(childComplexity, args["topicCouncilId"].(string), args["councilId"].(string), args["overrideReason"].(*string)), true
+
+	case "Mutation.coSignTopic":
+		if e.complexity.Mutation.CoSignTopic == nil {
 			break
 		}
 
-		return e.complexity.Major.UpdatedAt(childComplexity), true
+		args, err := ec.field_Mutation_coSignTopic_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Major.updatedBy":
-		if e.complexity.Major.UpdatedBy == nil {
+		return e.complexity.Mutation.CoSignTopic(childComplexity, args["topicId"].(string), args["accept"].(bool)), true
+
+	case "Mutation.commitDefenceSchedule":
+		if e.complexity.Mutation.CommitDefenceSchedule == nil {
 			break
 		}
 
-		return e.complexity.Major.UpdatedBy(childComplexity), true
+		args, err := ec.field_Mutation_commitDefenceSchedule_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "MajorInfo.facultyCode":
-		if e.complexity.MajorInfo.FacultyCode == nil {
+		return e.complexity.Mutation.CommitDefenceSchedule(childComplexity, args["input"].(model.DefenceScheduleInput)), true
+
+	case "Mutation.commitTopicMatching":
+		if e.complexity.Mutation.CommitTopicMatching == nil {
 			break
 		}
 
-		return e.complexity.MajorInfo.FacultyCode(childComplexity), true
+		args, err := ec.field_Mutation_commitTopicMatching_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "MajorInfo.id":
-		if e.complexity.MajorInfo.ID == nil {
+		return e.complexity.Mutation.CommitTopicMatching(childComplexity, args["semesterCode"].(string)), true
+
+	case "Mutation.completeGradeReview":
+		if e.complexity.Mutation.CompleteGradeReview == nil {
 			break
 		}
 
-		return e.complexity.MajorInfo.ID(childComplexity), true
+		args, err := ec.field_Mutation_completeGradeReview_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "MajorInfo.title":
-		if e.complexity.MajorInfo.Title == nil {
+		return e.complexity.Mutation.CompleteGradeReview(childComplexity, args["id"].(string)), true
+
+	case "Mutation.completeTopic":
+		if e.complexity.Mutation.CompleteTopic == nil {
 			break
 		}
 
-		return e.complexity.MajorInfo.Title(childComplexity), true
+		args, err := ec.field_Mutation_completeTopic_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "MajorListResponse.data":
-		if e.complexity.MajorListResponse.Data == nil {
+		return e.complexity.Mutation.CompleteTopic(childComplexity, args["id"].(string)), true
+
+	case "Mutation.createCouncil":
+		if e.complexity.Mutation.CreateCouncil == nil {
 			break
 		}
 
-		return e.complexity.MajorListResponse.Data(childComplexity), true
+		args, err := ec.field_Mutation_createCouncil_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "MajorListResponse.total":
-		if e.complexity.MajorListResponse.Total == nil {
+		return e.complexity.Mutation.CreateCouncil(childComplexity, args["input"].(model.CreateCouncilInput)), true
+
+	case "Mutation.createFaculty":
+		if e.complexity.Mutation.CreateFaculty == nil {
 			break
 		}
 
-		return e.complexity.MajorListResponse.Total(childComplexity), true
+		args, err := ec.field_Mutation_createFaculty_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Midterm.createdAt":
-		if e.complexity.Midterm.CreatedAt == nil {
+		return e.complexity.Mutation.CreateFaculty(childComplexity, args["input"].(model.CreateFacultyInput)), true
+
+	case "Mutation.createGradeDefence":
+		if e.complexity.Mutation.CreateGradeDefence == nil {
 			break
 		}
 
-		return e.complexity.Midterm.CreatedAt(childComplexity), true
+		args, err := ec.field_Mutation_createGradeDefence_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Midterm.createdBy":
-		if e.complexity.Midterm.CreatedBy == nil {
+		return e.complexity.Mutation.CreateGradeDefence(childComplexity, args["input"].(model.CreateGradeDefenceInput)), true
+
+	case "Mutation.createMajor":
+		if e.complexity.Mutation.CreateMajor == nil {
 			break
 		}
 
-		return e.complexity.Midterm.CreatedBy(childComplexity), true
+		args, err := ec.field_Mutation_createMajor_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Midterm.feedback":
-		if e.complexity.Midterm.Feedback == nil {
+		return e.complexity.Mutation.CreateMajor(childComplexity, args["input"].(model.CreateMajorInput)), true
+
+	case "Mutation.createMidtermMilestone":
+		if e.complexity.Mutation.CreateMidtermMilestone == nil {
 			break
 		}
 
-		return e.complexity.Midterm.Feedback(childComplexity), true
+		args, err := ec.field_Mutation_createMidtermMilestone_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Midterm.grade":
-		if e.complexity.Midterm.Grade == nil {
+		return e.complexity.Mutation.CreateMidtermMilestone(childComplexity, args["input"].(model.CreateMidtermMilestoneInput)), true
+
+	case "Mutation.createRubricTemplate":
+		if e.complexity.Mutation.CreateRubricTemplate == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createRubricTemplate_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateRubricTemplate(childComplexity, args["input"].(model.CreateRubricTemplateInput)), true
+
+	case "Mutation.createSemester":
+		if e.complexity.Mutation.CreateSemester == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createSemester_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateSemester(childComplexity, args["input"].(model.CreateSemesterInput)), true
+
+	case "Mutation.createStudent":
+		if e.complexity.Mutation.CreateStudent == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createStudent_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateStudent(childComplexity, args["input"].(model.CreateStudentInput)), true
+
+	case "Mutation.createTeacher":
+		if e.complexity.Mutation.CreateTeacher == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createTeacher_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateTeacher(childComplexity, args["input"].(model.CreateTeacherInput)), true
+
+	case "Mutation.decideGradeAmendment":
+		if e.complexity.Mutation.DecideGradeAmendment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_decideGradeAmendment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DecideGradeAmendment(childComplexity, args["input"].(model.DecideGradeAmendmentInput)), true
+
+	case "Mutation.decideTopicRegistration":
+		if e.complexity.Mutation.DecideTopicRegistration == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_decideTopicRegistration_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DecideTopicRegistration(childComplexity, args["registrationId"].(string), args["accept"].(bool), args["reason"].(*string)), true
+
+	case "Mutation.deleteCouncil":
+		if e.complexity.Mutation.DeleteCouncil == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteCouncil_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteCouncil(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteFaculty":
+		if e.complexity.Mutation.DeleteFaculty == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteFaculty_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteFaculty(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteGradeDefenceCriterion":
+		if e.complexity.Mutation.DeleteGradeDefenceCriterion == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteGradeDefenceCriterion_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteGradeDefenceCriterion(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteGradingPolicy":
+		if e.complexity.Mutation.DeleteGradingPolicy == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteGradingPolicy_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteGradingPolicy(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteMajor":
+		if e.complexity.Mutation.DeleteMajor == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteMajor_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteMajor(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteMidtermMilestone":
+		if e.complexity.Mutation.DeleteMidtermMilestone == nil {
 			break
 		}
 
-		return e.complexity.Midterm.Grade(childComplexity), true
+		args, err := ec.field_Mutation_deleteMidtermMilestone_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteMidtermMilestone(childComplexity, args["id"].(string)), true
 
-	case "Midterm.id":
-		if e.complexity.Midterm.ID == nil {
+	case "Mutation.deleteRubricTemplate":
+		if e.complexity.Mutation.DeleteRubricTemplate == nil {
 			break
 		}
 
-		return e.complexity.Midterm.ID(childComplexity), true
-
-	case "Midterm.status":
-		if e.complexity.Midterm.Status == nil {
-			break
+		args, err := ec.field_Mutation_deleteRubricTemplate_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.Midterm.Status(childComplexity), true
+		return e.complexity.Mutation.DeleteRubricTemplate(childComplexity, args["id"].(string)), true
 
-	case "Midterm.title":
-		if e.complexity.Midterm.Title == nil {
+	case "Mutation.deleteSemester":
+		if e.complexity.Mutation.DeleteSemester == nil {
 			break
 		}
 
-		return e.complexity.Midterm.Title(childComplexity), true
+		args, err := ec.field_Mutation_deleteSemester_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Midterm.updatedAt":
-		if e.complexity.Midterm.UpdatedAt == nil {
+		return e.complexity.Mutation.DeleteSemester(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteStudent":
+		if e.complexity.Mutation.DeleteStudent == nil {
 			break
 		}
 
-		return e.complexity.Midterm.UpdatedAt(childComplexity), true
+		args, err := ec.field_Mutation_deleteStudent_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Midterm.updatedBy":
-		if e.complexity.Midterm.UpdatedBy == nil {
+		return e.complexity.Mutation.DeleteStudent(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteTeacher":
+		if e.complexity.Mutation.DeleteTeacher == nil {
 			break
 		}
 
-		return e.complexity.Midterm.UpdatedBy(childComplexity), true
+		args, err := ec.field_Mutation_deleteTeacher_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "MidtermListResponse.data":
-		if e.complexity.MidtermListResponse.Data == nil {
+		return e.complexity.Mutation.DeleteTeacher(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteTopic":
+		if e.complexity.Mutation.DeleteTopic == nil {
 			break
 		}
 
-		return e.complexity.MidtermListResponse.Data(childComplexity), true
+		args, err := ec.field_Mutation_deleteTopic_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "MidtermListResponse.total":
-		if e.complexity.MidtermListResponse.Total == nil {
+		return e.complexity.Mutation.DeleteTopic(childComplexity, args["id"].(string)), true
+
+	case "Mutation._empty":
+		if e.complexity.Mutation.Empty == nil {
 			break
 		}
 
-		return e.complexity.MidtermListResponse.Total(childComplexity), true
+		return e.complexity.Mutation.Empty(childComplexity), true
 
-	case "Mutation.addDefenceToCouncil":
-		if e.complexity.Mutation.AddDefenceToCouncil == nil {
+	case "Mutation.feedbackFinal":
+		if e.complexity.Mutation.FeedbackFinal == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addDefenceToCouncil_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_feedbackFinal_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.AddDefenceToCouncil(childComplexity, args["input"].(model.CreateDefenceInput)), true
+		return e.complexity.Mutation.FeedbackFinal(childComplexity, args["finalId"].(string), args["notes"].(string)), true
 
-	case "Mutation.addGradeDefenceCriterion":
-		if e.complexity.Mutation.AddGradeDefenceCriterion == nil {
+	case "Mutation.fileGradeAppeal":
+		if e.complexity.Mutation.FileGradeAppeal == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addGradeDefenceCriterion_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_fileGradeAppeal_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.AddGradeDefenceCriterion(childComplexity, args["input"].(model.CreateGradeDefenceCriterionInput)), true
+		return e.complexity.Mutation.FileGradeAppeal(childComplexity, args["input"].(model.FileGradeAppealInput)), true
 
-	case "Mutation.approveCouncil":
-		if e.complexity.Mutation.ApproveCouncil == nil {
+	case "Mutation.finalizeFinalGrade":
+		if e.complexity.Mutation.FinalizeFinalGrade == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_approveCouncil_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_finalizeFinalGrade_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.ApproveCouncil(childComplexity, args["id"].(string), args["timeStart"].(time.Time)), true
+		return e.complexity.Mutation.FinalizeFinalGrade(childComplexity, args["enrollmentId"].(string)), true
 
-	case "Mutation.approveFinalFile":
-		if e.complexity.Mutation.ApproveFinalFile == nil {
+	case "Mutation.gradeFinal":
+		if e.complexity.Mutation.GradeFinal == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_approveFinalFile_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_gradeFinal_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.ApproveFinalFile(childComplexity, args["fileId"].(string)), true
+		return e.complexity.Mutation.GradeFinal(childComplexity, args["enrollmentId"].(string), args["input"].(model.GradeFinalInput)), true
 
-	case "Mutation.approveMidtermFile":
-		if e.complexity.Mutation.ApproveMidtermFile == nil {
+	case "Mutation.grantDeadlineExtension":
+		if e.complexity.Mutation.GrantDeadlineExtension == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_approveMidtermFile_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_grantDeadlineExtension_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.ApproveMidtermFile(childComplexity, args["fileId"].(string)), true
+		return e.complexity.Mutation.GrantDeadlineExtension(childComplexity, args["input"].(model.GrantDeadlineExtensionInput)), true
 
-	case "Mutation.approveTopic":
-		if e.complexity.Mutation.ApproveTopic == nil {
+	case "Mutation.lockCouncilGrades":
+		if e.complexity.Mutation.LockCouncilGrades == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_approveTopic_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_lockCouncilGrades_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.ApproveTopic(childComplexity, args["id"].(string)), true
+		return e.complexity.Mutation.LockCouncilGrades(childComplexity, args["councilId"].(string)), true
 
-	case "Mutation.approveTopicStage1":
-		if e.complexity.Mutation.ApproveTopicStage1 == nil {
+	case "Mutation.lockSemesterGrades":
+		if e.complexity.Mutation.LockSemesterGrades == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_approveTopicStage1_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_lockSemesterGrades_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.ApproveTopicStage1(childComplexity, args["id"].(string)), true
+		return e.complexity.Mutation.LockSemesterGrades(childComplexity, args["semesterCode"].(string)), true
 
-	case "Mutation.assignTopicToCouncil":
-		if e.complexity.Mutation.AssignTopicToCouncil == nil {
+	case "Mutation.overrideCouncilConflicts":
+		if e.complexity.Mutation.OverrideCouncilConflicts == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_assignTopicToCouncil_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_overrideCouncilConflicts_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.AssignTopicToCouncil(childComplexity, args["topicCouncilId"].(string), args["councilId"].(string)), true
+		return e.complexity.Mutation.OverrideCouncilConflicts(childComplexity, args["councilId"].(string), args["reason"].(string)), true
 
-	case "Mutation.completeGradeReview":
-		if e.complexity.Mutation.CompleteGradeReview == nil {
+	case "Mutation.proposeTopic":
+		if e.complexity.Mutation.ProposeTopic == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_completeGradeReview_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_proposeTopic_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.CompleteGradeReview(childComplexity, args["id"].(string)), true
+		return e.complexity.Mutation.ProposeTopic(childComplexity, args["input"].(model.ProposeTopicInput)), true
 
-	case "Mutation.createCouncil":
-		if e.complexity.Mutation.CreateCouncil == nil {
+	case "Mutation.publishCouncilGrades":
+		if e.complexity.Mutation.PublishCouncilGrades == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createCouncil_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_publishCouncilGrades_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.CreateCouncil(childComplexity, args["input"].(model.CreateCouncilInput)), true
+		return e.complexity.Mutation.PublishCouncilGrades(childComplexity, args["councilId"].(string)), true
 
-	case "Mutation.createFaculty":
-		if e.complexity.Mutation.CreateFaculty == nil {
+	case "Mutation.publishSemesterGrades":
+		if e.complexity.Mutation.PublishSemesterGrades == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createFaculty_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_publishSemesterGrades_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.CreateFaculty(childComplexity, args["input"].(model.CreateFacultyInput)), true
+		return e.complexity.Mutation.PublishSemesterGrades(childComplexity, args["semesterCode"].(string)), true
 
-	case "Mutation.createGradeDefence":
-		if e.complexity.Mutation.CreateGradeDefence == nil {
+	case "Mutation.rankTopicApplicants":
+		if e.complexity.Mutation.RankTopicApplicants == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createGradeDefence_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_rankTopicApplicants_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.CreateGradeDefence(childComplexity, args["input"].(model.CreateGradeDefenceInput)), true
+		return e.complexity.Mutation.RankTopicApplicants(childComplexity, args["topicId"].(string), args["studentIds"].([]string), args["restricted"].(*bool)), true
 
-	case "Mutation.createMajor":
-		if e.complexity.Mutation.CreateMajor == nil {
+	case "Mutation.registerTopicPreferences":
+		if e.complexity.Mutation.RegisterTopicPreferences == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createMajor_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_registerTopicPreferences_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.CreateMajor(childComplexity, args["input"].(model.CreateMajorInput)), true
+		return e.complexity.Mutation.RegisterTopicPreferences(childComplexity, args["topicIds"].([]string)), true
 
-	case "Mutation.createSemester":
-		if e.complexity.Mutation.CreateSemester == nil {
+	case "Mutation.rejectFinalFile":
+		if e.complexity.Mutation.RejectFinalFile == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createSemester_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_rejectFinalFile_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.CreateSemester(childComplexity, args["input"].(model.CreateSemesterInput)), true
+		return e.complexity.Mutation.RejectFinalFile(childComplexity, args["fileId"].(string), args["reason"].(*string)), true
 
-	case "Mutation.createStudent":
-		if e.complexity.Mutation.CreateStudent == nil {
+	case "Mutation.rejectMidtermFile":
+		if e.complexity.Mutation.RejectMidtermFile == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createStudent_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_rejectMidtermFile_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.CreateStudent(childComplexity, args["input"].(model.CreateStudentInput)), true
+		return e.complexity.Mutation.RejectMidtermFile(childComplexity, args["fileId"].(string), args["reason"].(*string)), true
 
-	case "Mutation.createTeacher":
-		if e.complexity.Mutation.CreateTeacher == nil {
+	case "Mutation.rejectTopic":
+		if e.complexity.Mutation.RejectTopic == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createTeacher_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_rejectTopic_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.CreateTeacher(childComplexity, args["input"].(model.CreateTeacherInput)), true
+		return e.complexity.Mutation.RejectTopic(childComplexity, args["id"].(string), args["reason"].(string)), true
 
-	case "Mutation.deleteCouncil":
-		if e.complexity.Mutation.DeleteCouncil == nil {
+	case "Mutation.rejectTopicStage1":
+		if e.complexity.Mutation.RejectTopicStage1 == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteCouncil_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_rejectTopicStage1_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.DeleteCouncil(childComplexity, args["id"].(string)), true
+		return e.complexity.Mutation.RejectTopicStage1(childComplexity, args["id"].(string), args["reason"].(string)), true
 
-	case "Mutation.deleteFaculty":
-		if e.complexity.Mutation.DeleteFaculty == nil {
+	case "Mutation.removeDefenceFromCouncil":
+		if e.complexity.Mutation.RemoveDefenceFromCouncil == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteFaculty_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_removeDefenceFromCouncil_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.DeleteFaculty(childComplexity, args["id"].(string)), true
+		return e.complexity.Mutation.RemoveDefenceFromCouncil(childComplexity, args["id"].(string)), true
 
-	case "Mutation.deleteGradeDefenceCriterion":
-		if e.complexity.Mutation.DeleteGradeDefenceCriterion == nil {
+	case "Mutation.requestGradeAmendment":
+		if e.complexity.Mutation.RequestGradeAmendment == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteGradeDefenceCriterion_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_requestGradeAmendment_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.DeleteGradeDefenceCriterion(childComplexity, args["id"].(string)), true
+		return e.complexity.Mutation.RequestGradeAmendment(childComplexity, args["input"].(model.RequestGradeAmendmentInput)), true
 
-	case "Mutation.deleteMajor":
-		if e.complexity.Mutation.DeleteMajor == nil {
+	case "Mutation.resolveGradeAppeal":
+		if e.complexity.Mutation.ResolveGradeAppeal == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteMajor_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_resolveGradeAppeal_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.DeleteMajor(childComplexity, args["id"].(string)), true
+		return e.complexity.Mutation.ResolveGradeAppeal(childComplexity, args["input"].(model.ResolveGradeAppealInput)), true
 
-	case "Mutation.deleteSemester":
-		if e.complexity.Mutation.DeleteSemester == nil {
+	case "Mutation.restoreCouncil":
+		if e.complexity.Mutation.RestoreCouncil == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteSemester_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_restoreCouncil_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.DeleteSemester(childComplexity, args["id"].(string)), true
+		return e.complexity.Mutation.RestoreCouncil(childComplexity, args["id"].(string)), true
 
-	case "Mutation.deleteStudent":
-		if e.complexity.Mutation.DeleteStudent == nil {
+	case "Mutation.restoreMajor":
+		if e.complexity.Mutation.RestoreMajor == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteStudent_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_restoreMajor_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.DeleteStudent(childComplexity, args["id"].(string)), true
+		return e.complexity.Mutation.RestoreMajor(childComplexity, args["id"].(string)), true
 
-	case "Mutation.deleteTeacher":
-		if e.complexity.Mutation.DeleteTeacher == nil {
+	case "Mutation.restoreSemester":
+		if e.complexity.Mutation.RestoreSemester == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteTeacher_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_restoreSemester_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.DeleteTeacher(childComplexity, args["id"].(string)), true
+		return e.complexity.Mutation.RestoreSemester(childComplexity, args["id"].(string)), true
 
-	case "Mutation.deleteTopic":
-		if e.complexity.Mutation.DeleteTopic == nil {
+	case "Mutation.restoreStudent":
+		if e.complexity.Mutation.RestoreStudent == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteTopic_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_restoreStudent_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.DeleteTopic(childComplexity, args["id"].(string)), true
+		return e.complexity.Mutation.RestoreStudent(childComplexity, args["id"].(string)), true
 
-	case "Mutation._empty":
-		if e.complexity.Mutation.Empty == nil {
+	case "Mutation.restoreTeacher":
+		if e.complexity.Mutation.RestoreTeacher == nil {
 			break
 		}
 
-		return e.complexity.Mutation.Empty(childComplexity), true
+		args, err := ec.field_Mutation_restoreTeacher_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Mutation.feedbackFinal":
-		if e.complexity.Mutation.FeedbackFinal == nil {
+		return e.complexity.Mutation.RestoreTeacher(childComplexity, args["id"].(string)), true
+
+	case "Mutation.restoreTopic":
+		if e.complexity.Mutation.RestoreTopic == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_feedbackFinal_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_restoreTopic_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.FeedbackFinal(childComplexity, args["finalId"].(string), args["notes"].(string)), true
+		return e.complexity.Mutation.RestoreTopic(childComplexity, args["id"].(string)), true
 
-	case "Mutation.feedbackMidterm":
-		if e.complexity.Mutation.FeedbackMidterm == nil {
+	case "Mutation.reviewMilestoneCheckin":
+		if e.complexity.Mutation.ReviewMilestoneCheckin == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_feedbackMidterm_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_reviewMilestoneCheckin_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.FeedbackMidterm(childComplexity, args["midtermId"].(string), args["feedback"].(string)), true
+		return e.complexity.Mutation.ReviewMilestoneCheckin(childComplexity, args["id"].(string), args["pass"].(bool), args["comment"].(*string)), true
 
-	case "Mutation.gradeFinal":
-		if e.complexity.Mutation.GradeFinal == nil {
+	case "Mutation.setGradeAppealWindow":
+		if e.complexity.Mutation.SetGradeAppealWindow == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_gradeFinal_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_setGradeAppealWindow_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.GradeFinal(childComplexity, args["enrollmentId"].(string), args["input"].(model.GradeFinalInput)), true
+		return e.complexity.Mutation.SetGradeAppealWindow(childComplexity, args["semesterCode"].(string), args["days"].(int32)), true
 
-	case "Mutation.gradeMidterm":
-		if e.complexity.Mutation.GradeMidterm == nil {
+	case "Mutation.setGradingPolicy":
+		if e.complexity.Mutation.SetGradingPolicy == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_gradeMidterm_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_setGradingPolicy_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.GradeMidterm(childComplexity, args["enrollmentId"].(string), args["input"].(model.GradeMidtermInput)), true
+		return e.complexity.Mutation.SetGradingPolicy(childComplexity, args["input"].(model.SetGradingPolicyInput)), true
 
-	case "Mutation.rejectFinalFile":
-		if e.complexity.Mutation.RejectFinalFile == nil {
+	case "Mutation.setRegistrationWindow":
+		if e.complexity.Mutation.SetRegistrationWindow == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_rejectFinalFile_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_setRegistrationWindow_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.RejectFinalFile(childComplexity, args["fileId"].(string), args["reason"].(*string)), true
+		return e.complexity.Mutation.SetRegistrationWindow(childComplexity, args["input"].(model.SetRegistrationWindowInput)), true
 
-	case "Mutation.rejectMidtermFile":
-		if e.complexity.Mutation.RejectMidtermFile == nil {
+	case "Mutation.setSubmissionDeadline":
+		if e.complexity.Mutation.SetSubmissionDeadline == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_rejectMidtermFile_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_setSubmissionDeadline_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.RejectMidtermFile(childComplexity, args["fileId"].(string), args["reason"].(*string)), true
+		return e.complexity.Mutation.SetSubmissionDeadline(childComplexity, args["input"].(model.SetSubmissionDeadlineInput)), true
 
-	case "Mutation.rejectTopic":
-		if e.complexity.Mutation.RejectTopic == nil {
+	case "Mutation.startTopic":
+		if e.complexity.Mutation.StartTopic == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_rejectTopic_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_startTopic_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.RejectTopic(childComplexity, args["id"].(string), args["reason"].(*string)), true
+		return e.complexity.Mutation.StartTopic(childComplexity, args["id"].(string)), true
 
-	case "Mutation.rejectTopicStage1":
-		if e.complexity.Mutation.RejectTopicStage1 == nil {
+	case "Mutation.submitMilestoneCheckin":
+		if e.complexity.Mutation.SubmitMilestoneCheckin == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_rejectTopicStage1_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_submitMilestoneCheckin_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.RejectTopicStage1(childComplexity, args["id"].(string), args["reason"].(*string)), true
+		return e.complexity.Mutation.SubmitMilestoneCheckin(childComplexity, args["input"].(model.SubmitMilestoneCheckinInput)), true
 
-	case "Mutation.removeDefenceFromCouncil":
-		if e.complexity.Mutation.RemoveDefenceFromCouncil == nil {
+	case "Mutation.submitTopic":
+		if e.complexity.Mutation.SubmitTopic == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_removeDefenceFromCouncil_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_submitTopic_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.RemoveDefenceFromCouncil(childComplexity, args["id"].(string)), true
+		return e.complexity.Mutation.SubmitTopic(childComplexity, args["id"].(string)), true
 
 	case "Mutation.updateCouncil":
 		if e.complexity.Mutation.UpdateCouncil == nil {
@@ -2800,6 +5118,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.UpdateMajor(childComplexity, args["id"].(string), args["input"].(model.UpdateMajorInput)), true
 
+	case "Mutation.updateMidtermMilestone":
+		if e.complexity.Mutation.UpdateMidtermMilestone == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateMidtermMilestone_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateMidtermMilestone(childComplexity, args["id"].(string), args["input"].(model.UpdateMidtermMilestoneInput)), true
+
 	case "Mutation.updateMyProfile":
 		if e.complexity.Mutation.UpdateMyProfile == nil {
 			break
@@ -2824,6 +5154,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.UpdateMyTeacherProfile(childComplexity, args["input"].(model.UpdateTeacherProfileInput)), true
 
+	case "Mutation.updateRubricTemplate":
+		if e.complexity.Mutation.UpdateRubricTemplate == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateRubricTemplate_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateRubricTemplate(childComplexity, args["id"].(string), args["input"].(model.UpdateRubricTemplateInput)), true
+
 	case "Mutation.updateSemester":
 		if e.complexity.Mutation.UpdateSemester == nil {
 			break
@@ -2877,24 +5219,57 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			break
 		}
 
-		args, err := ec.field_Mutation_uploadFinalFile_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		args, err := ec.field_Mutation_uploadFinalFile_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UploadFinalFile(childComplexity, args["input"].(model.UploadFileInput)), true
+
+	case "Mutation.uploadMidtermFile":
+		if e.complexity.Mutation.UploadMidtermFile == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_uploadMidtermFile_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UploadMidtermFile(childComplexity, args["input"].(model.UploadFileInput)), true
+
+	case "ProposeTopicResult.coSigns":
+		if e.complexity.ProposeTopicResult.CoSigns == nil {
+			break
+		}
+
+		return e.complexity.ProposeTopicResult.CoSigns(childComplexity), true
+
+	case "ProposeTopicResult.topic":
+		if e.complexity.ProposeTopicResult.Topic == nil {
+			break
+		}
+
+		return e.complexity.ProposeTopicResult.Topic(childComplexity), true
+
+	case "ProposeTopicResult.topicCouncilId":
+		if e.complexity.ProposeTopicResult.TopicCouncilID == nil {
+			break
 		}
 
-		return e.complexity.Mutation.UploadFinalFile(childComplexity, args["input"].(model.UploadFileInput)), true
+		return e.complexity.ProposeTopicResult.TopicCouncilID(childComplexity), true
 
-	case "Mutation.uploadMidtermFile":
-		if e.complexity.Mutation.UploadMidtermFile == nil {
+	case "Query.auditLog":
+		if e.complexity.Query.AuditLog == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_uploadMidtermFile_args(ctx, rawArgs)
+		args, err := ec.field_Query_auditLog_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.UploadMidtermFile(childComplexity, args["input"].(model.UploadFileInput)), true
+		return e.complexity.Query.AuditLog(childComplexity, args["entity"].(*string), args["entityId"].(*string), args["actor"].(*string), args["from"].(*time.Time), args["to"].(*time.Time)), true
 
 	case "Query._empty":
 		if e.complexity.Query.Empty == nil {
@@ -2987,6 +5362,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.GetAllTopics(childComplexity, args["search"].(model.SearchRequestInput)), true
 
+	case "Query.getCouncilConflictOverrides":
+		if e.complexity.Query.GetCouncilConflictOverrides == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getCouncilConflictOverrides_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetCouncilConflictOverrides(childComplexity, args["councilId"].(string)), true
+
 	case "Query.getCouncilDetail":
 		if e.complexity.Query.GetCouncilDetail == nil {
 			break
@@ -3179,6 +5566,54 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.GetEnrollmentDetail(childComplexity, args["id"].(string)), true
 
+	case "Query.getEnrollmentMilestoneCheckins":
+		if e.complexity.Query.GetEnrollmentMilestoneCheckins == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getEnrollmentMilestoneCheckins_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetEnrollmentMilestoneCheckins(childComplexity, args["enrollmentId"].(string)), true
+
+	case "Query.getGradeAmendments":
+		if e.complexity.Query.GetGradeAmendments == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getGradeAmendments_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetGradeAmendments(childComplexity, args["semesterCode"].(string), args["status"].(*model.GradeAmendmentStatus)), true
+
+	case "Query.getGradeAppeals":
+		if e.complexity.Query.GetGradeAppeals == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getGradeAppeals_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetGradeAppeals(childComplexity, args["semesterCode"].(string), args["status"].(*model.GradeAppealStatus)), true
+
+	case "Query.getGradingPolicies":
+		if e.complexity.Query.GetGradingPolicies == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getGradingPolicies_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetGradingPolicies(childComplexity, args["semesterCode"].(string)), true
+
 	case "Query.getListStudents":
 		if e.complexity.Query.GetListStudents == nil {
 			break
@@ -3203,6 +5638,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.GetListTeachers(childComplexity, args["search"].(model.SearchRequestInput)), true
 
+	case "Query.getMidtermMilestones":
+		if e.complexity.Query.GetMidtermMilestones == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getMidtermMilestones_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetMidtermMilestones(childComplexity, args["semesterCode"].(string), args["stage"].(*model.TopicStage)), true
+
+	case "Query.getMyAssignedGradeAppeals":
+		if e.complexity.Query.GetMyAssignedGradeAppeals == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getMyAssignedGradeAppeals_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetMyAssignedGradeAppeals(childComplexity, args["status"].(*model.GradeAppealStatus)), true
+
 	case "Query.getMyDefenceDetail":
 		if e.complexity.Query.GetMyDefenceDetail == nil {
 			break
@@ -3251,6 +5710,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.GetMyEnrollments(childComplexity, args["search"].(*model.SearchRequestInput)), true
 
+	case "Query.getMyGradeAppeals":
+		if e.complexity.Query.GetMyGradeAppeals == nil {
+			break
+		}
+
+		return e.complexity.Query.GetMyGradeAppeals(childComplexity), true
+
 	case "Query.getMyGradeReviewDetail":
 		if e.complexity.Query.GetMyGradeReviewDetail == nil {
 			break
@@ -3275,6 +5741,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.GetMyGradeReviews(childComplexity, args["search"].(*model.SearchRequestInput)), true
 
+	case "Query.getMyMidtermMilestones":
+		if e.complexity.Query.GetMyMidtermMilestones == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getMyMidtermMilestones_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetMyMidtermMilestones(childComplexity, args["enrollmentId"].(string)), true
+
+	case "Query.getMyMilestoneCheckins":
+		if e.complexity.Query.GetMyMilestoneCheckins == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getMyMilestoneCheckins_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetMyMilestoneCheckins(childComplexity, args["enrollmentId"].(string)), true
+
 	case "Query.getMyProfile":
 		if e.complexity.Query.GetMyProfile == nil {
 			break
@@ -3282,6 +5772,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.GetMyProfile(childComplexity), true
 
+	case "Query.getMyRegistrationWindow":
+		if e.complexity.Query.GetMyRegistrationWindow == nil {
+			break
+		}
+
+		return e.complexity.Query.GetMyRegistrationWindow(childComplexity), true
+
 	case "Query.getMySemesters":
 		if e.complexity.Query.GetMySemesters == nil {
 			break
@@ -3294,6 +5791,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.GetMySemesters(childComplexity, args["search"].(*model.SearchRequestInput)), true
 
+	case "Query.getMySubmissionWindow":
+		if e.complexity.Query.GetMySubmissionWindow == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getMySubmissionWindow_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetMySubmissionWindow(childComplexity, args["kind"].(model.SubmissionKind)), true
+
 	case "Query.getMySupervisedTopicCouncilDetail":
 		if e.complexity.Query.GetMySupervisedTopicCouncilDetail == nil {
 			break
@@ -3306,60 +5815,281 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.GetMySupervisedTopicCouncilDetail(childComplexity, args["id"].(string)), true
 
-	case "Query.getMySupervisedTopicCouncils":
-		if e.complexity.Query.GetMySupervisedTopicCouncils == nil {
+	case "Query.getMySupervisedTopicCouncils":
+		if e.complexity.Query.GetMySupervisedTopicCouncils == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getMySupervisedTopicCouncils_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetMySupervisedTopicCouncils(childComplexity, args["search"].(*model.SearchRequestInput)), true
+
+	case "Query.getMyTeacherProfile":
+		if e.complexity.Query.GetMyTeacherProfile == nil {
+			break
+		}
+
+		return e.complexity.Query.GetMyTeacherProfile(childComplexity), true
+
+	case "Query.getMyTopicApplicants":
+		if e.complexity.Query.GetMyTopicApplicants == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getMyTopicApplicants_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetMyTopicApplicants(childComplexity, args["topicId"].(string)), true
+
+	case "Query.getMyTopicRegistrations":
+		if e.complexity.Query.GetMyTopicRegistrations == nil {
+			break
+		}
+
+		return e.complexity.Query.GetMyTopicRegistrations(childComplexity), true
+
+	case "Query.getRegistrableTopics":
+		if e.complexity.Query.GetRegistrableTopics == nil {
+			break
+		}
+
+		return e.complexity.Query.GetRegistrableTopics(childComplexity), true
+
+	case "Query.getRegistrationWindow":
+		if e.complexity.Query.GetRegistrationWindow == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getRegistrationWindow_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetRegistrationWindow(childComplexity, args["semesterCode"].(string)), true
+
+	case "Query.getRubricTemplates":
+		if e.complexity.Query.GetRubricTemplates == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getRubricTemplates_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetRubricTemplates(childComplexity, args["majorCode"].(*string), args["stage"].(*model.TopicStage)), true
+
+	case "Query.getSemesterGradeStatus":
+		if e.complexity.Query.GetSemesterGradeStatus == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getSemesterGradeStatus_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetSemesterGradeStatus(childComplexity, args["semesterCode"].(string)), true
+
+	case "Query.getStudentDetail":
+		if e.complexity.Query.GetStudentDetail == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getStudentDetail_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetStudentDetail(childComplexity, args["id"].(string)), true
+
+	case "Query.getSubmissionDeadlines":
+		if e.complexity.Query.GetSubmissionDeadlines == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getSubmissionDeadlines_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetSubmissionDeadlines(childComplexity, args["semesterCode"].(string)), true
+
+	case "Query.getTeacherDetail":
+		if e.complexity.Query.GetTeacherDetail == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getTeacherDetail_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetTeacherDetail(childComplexity, args["id"].(string)), true
+
+	case "Query.getTopicCoSigns":
+		if e.complexity.Query.GetTopicCoSigns == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getTopicCoSigns_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetTopicCoSigns(childComplexity, args["topicId"].(string)), true
+
+	case "Query.getTopicDetail":
+		if e.complexity.Query.GetTopicDetail == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getTopicDetail_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetTopicDetail(childComplexity, args["id"].(string)), true
+
+	case "Query.getTopicRegistrations":
+		if e.complexity.Query.GetTopicRegistrations == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getTopicRegistrations_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetTopicRegistrations(childComplexity, args["semesterCode"].(string)), true
+
+	case "Query.globalSearch":
+		if e.complexity.Query.GlobalSearch == nil {
+			break
+		}
+
+		args, err := ec.field_Query_globalSearch_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GlobalSearch(childComplexity, args["query"].(string), args["types"].([]model.SearchType), args["limit"].(*int32)), true
+
+	case "Query.previewDefenceSchedule":
+		if e.complexity.Query.PreviewDefenceSchedule == nil {
+			break
+		}
+
+		args, err := ec.field_Query_previewDefenceSchedule_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PreviewDefenceSchedule(childComplexity, args["input"].(model.DefenceScheduleInput)), true
+
+	case "Query.previewFinalGrade":
+		if e.complexity.Query.PreviewFinalGrade == nil {
+			break
+		}
+
+		args, err := ec.field_Query_previewFinalGrade_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PreviewFinalGrade(childComplexity, args["enrollmentId"].(string)), true
+
+	case "Query.previewTopicMatching":
+		if e.complexity.Query.PreviewTopicMatching == nil {
+			break
+		}
+
+		args, err := ec.field_Query_previewTopicMatching_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PreviewTopicMatching(childComplexity, args["semesterCode"].(string)), true
+
+	case "Query.validateCouncil":
+		if e.complexity.Query.ValidateCouncil == nil {
+			break
+		}
+
+		args, err := ec.field_Query_validateCouncil_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ValidateCouncil(childComplexity, args["councilId"].(string)), true
+
+	case "RegistrationWindow.closesAt":
+		if e.complexity.RegistrationWindow.ClosesAt == nil {
 			break
 		}
 
-		args, err := ec.field_Query_getMySupervisedTopicCouncils_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.RegistrationWindow.ClosesAt(childComplexity), true
+
+	case "RegistrationWindow.createdAt":
+		if e.complexity.RegistrationWindow.CreatedAt == nil {
+			break
 		}
 
-		return e.complexity.Query.GetMySupervisedTopicCouncils(childComplexity, args["search"].(*model.SearchRequestInput)), true
+		return e.complexity.RegistrationWindow.CreatedAt(childComplexity), true
 
-	case "Query.getMyTeacherProfile":
-		if e.complexity.Query.GetMyTeacherProfile == nil {
+	case "RegistrationWindow.createdBy":
+		if e.complexity.RegistrationWindow.CreatedBy == nil {
 			break
 		}
 
-		return e.complexity.Query.GetMyTeacherProfile(childComplexity), true
+		return e.complexity.RegistrationWindow.CreatedBy(childComplexity), true
 
-	case "Query.getStudentDetail":
-		if e.complexity.Query.GetStudentDetail == nil {
+	case "RegistrationWindow.id":
+		if e.complexity.RegistrationWindow.ID == nil {
 			break
 		}
 
-		args, err := ec.field_Query_getStudentDetail_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.RegistrationWindow.ID(childComplexity), true
+
+	case "RegistrationWindow.maxChoices":
+		if e.complexity.RegistrationWindow.MaxChoices == nil {
+			break
 		}
 
-		return e.complexity.Query.GetStudentDetail(childComplexity, args["id"].(string)), true
+		return e.complexity.RegistrationWindow.MaxChoices(childComplexity), true
 
-	case "Query.getTeacherDetail":
-		if e.complexity.Query.GetTeacherDetail == nil {
+	case "RegistrationWindow.opensAt":
+		if e.complexity.RegistrationWindow.OpensAt == nil {
 			break
 		}
 
-		args, err := ec.field_Query_getTeacherDetail_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.RegistrationWindow.OpensAt(childComplexity), true
+
+	case "RegistrationWindow.semesterCode":
+		if e.complexity.RegistrationWindow.SemesterCode == nil {
+			break
 		}
 
-		return e.complexity.Query.GetTeacherDetail(childComplexity, args["id"].(string)), true
+		return e.complexity.RegistrationWindow.SemesterCode(childComplexity), true
 
-	case "Query.getTopicDetail":
-		if e.complexity.Query.GetTopicDetail == nil {
+	case "RegistrationWindow.updatedAt":
+		if e.complexity.RegistrationWindow.UpdatedAt == nil {
 			break
 		}
 
-		args, err := ec.field_Query_getTopicDetail_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.RegistrationWindow.UpdatedAt(childComplexity), true
+
+	case "RegistrationWindow.updatedBy":
+		if e.complexity.RegistrationWindow.UpdatedBy == nil {
+			break
 		}
 
-		return e.complexity.Query.GetTopicDetail(childComplexity, args["id"].(string)), true
+		return e.complexity.RegistrationWindow.UpdatedBy(childComplexity), true
 
 	case "ReviewerEnrollment.createdAt":
 		if e.complexity.ReviewerEnrollment.CreatedAt == nil {
@@ -3417,6 +6147,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.ReviewerEnrollment.MidtermCode(childComplexity), true
 
+	case "ReviewerEnrollment.similarityReport":
+		if e.complexity.ReviewerEnrollment.SimilarityReport == nil {
+			break
+		}
+
+		args, err := ec.field_ReviewerEnrollment_similarityReport_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.ReviewerEnrollment.SimilarityReport(childComplexity, args["topN"].(*int32)), true
+
 	case "ReviewerEnrollment.student":
 		if e.complexity.ReviewerEnrollment.Student == nil {
 			break
@@ -3716,119 +6458,427 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			break
 		}
 
-		return e.complexity.RoleSystem.UpdatedAt(childComplexity), true
+		return e.complexity.RoleSystem.UpdatedAt(childComplexity), true
+
+	case "RoleSystem.updatedBy":
+		if e.complexity.RoleSystem.UpdatedBy == nil {
+			break
+		}
+
+		return e.complexity.RoleSystem.UpdatedBy(childComplexity), true
+
+	case "RoleSystem.version":
+		if e.complexity.RoleSystem.Version == nil {
+			break
+		}
+
+		return e.complexity.RoleSystem.Version(childComplexity), true
+
+	case "RoleSystemListResponse.data":
+		if e.complexity.RoleSystemListResponse.Data == nil {
+			break
+		}
+
+		return e.complexity.RoleSystemListResponse.Data(childComplexity), true
+
+	case "RoleSystemListResponse.total":
+		if e.complexity.RoleSystemListResponse.Total == nil {
+			break
+		}
+
+		return e.complexity.RoleSystemListResponse.Total(childComplexity), true
+
+	case "RubricCriterion.description":
+		if e.complexity.RubricCriterion.Description == nil {
+			break
+		}
+
+		return e.complexity.RubricCriterion.Description(childComplexity), true
+
+	case "RubricCriterion.id":
+		if e.complexity.RubricCriterion.ID == nil {
+			break
+		}
+
+		return e.complexity.RubricCriterion.ID(childComplexity), true
+
+	case "RubricCriterion.maxScore":
+		if e.complexity.RubricCriterion.MaxScore == nil {
+			break
+		}
+
+		return e.complexity.RubricCriterion.MaxScore(childComplexity), true
+
+	case "RubricCriterion.name":
+		if e.complexity.RubricCriterion.Name == nil {
+			break
+		}
+
+		return e.complexity.RubricCriterion.Name(childComplexity), true
+
+	case "RubricCriterion.sortOrder":
+		if e.complexity.RubricCriterion.SortOrder == nil {
+			break
+		}
+
+		return e.complexity.RubricCriterion.SortOrder(childComplexity), true
+
+	case "RubricCriterion.weight":
+		if e.complexity.RubricCriterion.Weight == nil {
+			break
+		}
+
+		return e.complexity.RubricCriterion.Weight(childComplexity), true
+
+	case "RubricTemplate.createdAt":
+		if e.complexity.RubricTemplate.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.RubricTemplate.CreatedAt(childComplexity), true
+
+	case "RubricTemplate.createdBy":
+		if e.complexity.RubricTemplate.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.RubricTemplate.CreatedBy(childComplexity), true
+
+	case "RubricTemplate.criteria":
+		if e.complexity.RubricTemplate.Criteria == nil {
+			break
+		}
+
+		return e.complexity.RubricTemplate.Criteria(childComplexity), true
+
+	case "RubricTemplate.id":
+		if e.complexity.RubricTemplate.ID == nil {
+			break
+		}
+
+		return e.complexity.RubricTemplate.ID(childComplexity), true
+
+	case "RubricTemplate.majorCode":
+		if e.complexity.RubricTemplate.MajorCode == nil {
+			break
+		}
+
+		return e.complexity.RubricTemplate.MajorCode(childComplexity), true
+
+	case "RubricTemplate.stage":
+		if e.complexity.RubricTemplate.Stage == nil {
+			break
+		}
+
+		return e.complexity.RubricTemplate.Stage(childComplexity), true
+
+	case "RubricTemplate.title":
+		if e.complexity.RubricTemplate.Title == nil {
+			break
+		}
+
+		return e.complexity.RubricTemplate.Title(childComplexity), true
+
+	case "RubricTemplate.updatedAt":
+		if e.complexity.RubricTemplate.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.RubricTemplate.UpdatedAt(childComplexity), true
+
+	case "RubricTemplate.updatedBy":
+		if e.complexity.RubricTemplate.UpdatedBy == nil {
+			break
+		}
+
+		return e.complexity.RubricTemplate.UpdatedBy(childComplexity), true
+
+	case "RubricTemplate.version":
+		if e.complexity.RubricTemplate.Version == nil {
+			break
+		}
+
+		return e.complexity.RubricTemplate.Version(childComplexity), true
+
+	case "SearchHit.id":
+		if e.complexity.SearchHit.ID == nil {
+			break
+		}
+
+		return e.complexity.SearchHit.ID(childComplexity), true
+
+	case "SearchHit.score":
+		if e.complexity.SearchHit.Score == nil {
+			break
+		}
+
+		return e.complexity.SearchHit.Score(childComplexity), true
+
+	case "SearchHit.subtitle":
+		if e.complexity.SearchHit.Subtitle == nil {
+			break
+		}
+
+		return e.complexity.SearchHit.Subtitle(childComplexity), true
+
+	case "SearchHit.title":
+		if e.complexity.SearchHit.Title == nil {
+			break
+		}
+
+		return e.complexity.SearchHit.Title(childComplexity), true
+
+	case "SearchHit.type":
+		if e.complexity.SearchHit.Type == nil {
+			break
+		}
+
+		return e.complexity.SearchHit.Type(childComplexity), true
+
+	case "Semester.createdAt":
+		if e.complexity.Semester.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Semester.CreatedAt(childComplexity), true
+
+	case "Semester.createdBy":
+		if e.complexity.Semester.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.Semester.CreatedBy(childComplexity), true
+
+	case "Semester.deletedAt":
+		if e.complexity.Semester.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.Semester.DeletedAt(childComplexity), true
+
+	case "Semester.deletedBy":
+		if e.complexity.Semester.DeletedBy == nil {
+			break
+		}
+
+		return e.complexity.Semester.DeletedBy(childComplexity), true
+
+	case "Semester.id":
+		if e.complexity.Semester.ID == nil {
+			break
+		}
+
+		return e.complexity.Semester.ID(childComplexity), true
+
+	case "Semester.students":
+		if e.complexity.Semester.Students == nil {
+			break
+		}
+
+		return e.complexity.Semester.Students(childComplexity), true
+
+	case "Semester.teachers":
+		if e.complexity.Semester.Teachers == nil {
+			break
+		}
+
+		return e.complexity.Semester.Teachers(childComplexity), true
+
+	case "Semester.title":
+		if e.complexity.Semester.Title == nil {
+			break
+		}
+
+		return e.complexity.Semester.Title(childComplexity), true
+
+	case "Semester.topics":
+		if e.complexity.Semester.Topics == nil {
+			break
+		}
+
+		return e.complexity.Semester.Topics(childComplexity), true
+
+	case "Semester.updatedAt":
+		if e.complexity.Semester.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Semester.UpdatedAt(childComplexity), true
+
+	case "Semester.updatedBy":
+		if e.complexity.Semester.UpdatedBy == nil {
+			break
+		}
+
+		return e.complexity.Semester.UpdatedBy(childComplexity), true
+
+	case "Semester.version":
+		if e.complexity.Semester.Version == nil {
+			break
+		}
+
+		return e.complexity.Semester.Version(childComplexity), true
+
+	case "SemesterGradeStatus.appealDeadline":
+		if e.complexity.SemesterGradeStatus.AppealDeadline == nil {
+			break
+		}
+
+		return e.complexity.SemesterGradeStatus.AppealDeadline(childComplexity), true
+
+	case "SemesterGradeStatus.appealWindowDays":
+		if e.complexity.SemesterGradeStatus.AppealWindowDays == nil {
+			break
+		}
+
+		return e.complexity.SemesterGradeStatus.AppealWindowDays(childComplexity), true
+
+	case "SemesterGradeStatus.locked":
+		if e.complexity.SemesterGradeStatus.Locked == nil {
+			break
+		}
+
+		return e.complexity.SemesterGradeStatus.Locked(childComplexity), true
+
+	case "SemesterGradeStatus.lockedAt":
+		if e.complexity.SemesterGradeStatus.LockedAt == nil {
+			break
+		}
+
+		return e.complexity.SemesterGradeStatus.LockedAt(childComplexity), true
+
+	case "SemesterGradeStatus.lockedBy":
+		if e.complexity.SemesterGradeStatus.LockedBy == nil {
+			break
+		}
+
+		return e.complexity.SemesterGradeStatus.LockedBy(childComplexity), true
+
+	case "SemesterGradeStatus.published":
+		if e.complexity.SemesterGradeStatus.Published == nil {
+			break
+		}
+
+		return e.complexity.SemesterGradeStatus.Published(childComplexity), true
+
+	case "SemesterGradeStatus.publishedAt":
+		if e.complexity.SemesterGradeStatus.PublishedAt == nil {
+			break
+		}
+
+		return e.complexity.SemesterGradeStatus.PublishedAt(childComplexity), true
 
-	case "RoleSystem.updatedBy":
-		if e.complexity.RoleSystem.UpdatedBy == nil {
+	case "SemesterGradeStatus.publishedBy":
+		if e.complexity.SemesterGradeStatus.PublishedBy == nil {
 			break
 		}
 
-		return e.complexity.RoleSystem.UpdatedBy(childComplexity), true
+		return e.complexity.SemesterGradeStatus.PublishedBy(childComplexity), true
 
-	case "RoleSystemListResponse.data":
-		if e.complexity.RoleSystemListResponse.Data == nil {
+	case "SemesterGradeStatus.semesterCode":
+		if e.complexity.SemesterGradeStatus.SemesterCode == nil {
 			break
 		}
 
-		return e.complexity.RoleSystemListResponse.Data(childComplexity), true
+		return e.complexity.SemesterGradeStatus.SemesterCode(childComplexity), true
 
-	case "RoleSystemListResponse.total":
-		if e.complexity.RoleSystemListResponse.Total == nil {
+	case "SemesterInfo.id":
+		if e.complexity.SemesterInfo.ID == nil {
 			break
 		}
 
-		return e.complexity.RoleSystemListResponse.Total(childComplexity), true
+		return e.complexity.SemesterInfo.ID(childComplexity), true
 
-	case "Semester.createdAt":
-		if e.complexity.Semester.CreatedAt == nil {
+	case "SemesterInfo.title":
+		if e.complexity.SemesterInfo.Title == nil {
 			break
 		}
 
-		return e.complexity.Semester.CreatedAt(childComplexity), true
+		return e.complexity.SemesterInfo.Title(childComplexity), true
 
-	case "Semester.createdBy":
-		if e.complexity.Semester.CreatedBy == nil {
+	case "SemesterListResponse.data":
+		if e.complexity.SemesterListResponse.Data == nil {
 			break
 		}
 
-		return e.complexity.Semester.CreatedBy(childComplexity), true
+		return e.complexity.SemesterListResponse.Data(childComplexity), true
 
-	case "Semester.id":
-		if e.complexity.Semester.ID == nil {
+	case "SemesterListResponse.total":
+		if e.complexity.SemesterListResponse.Total == nil {
 			break
 		}
 
-		return e.complexity.Semester.ID(childComplexity), true
+		return e.complexity.SemesterListResponse.Total(childComplexity), true
 
-	case "Semester.students":
-		if e.complexity.Semester.Students == nil {
+	case "SimilarityMatch.file":
+		if e.complexity.SimilarityMatch.File == nil {
 			break
 		}
 
-		return e.complexity.Semester.Students(childComplexity), true
+		return e.complexity.SimilarityMatch.File(childComplexity), true
 
-	case "Semester.teachers":
-		if e.complexity.Semester.Teachers == nil {
+	case "SimilarityMatch.overlapPercent":
+		if e.complexity.SimilarityMatch.OverlapPercent == nil {
 			break
 		}
 
-		return e.complexity.Semester.Teachers(childComplexity), true
+		return e.complexity.SimilarityMatch.OverlapPercent(childComplexity), true
 
-	case "Semester.title":
-		if e.complexity.Semester.Title == nil {
+	case "SimilarityMatch.passages":
+		if e.complexity.SimilarityMatch.Passages == nil {
 			break
 		}
 
-		return e.complexity.Semester.Title(childComplexity), true
+		return e.complexity.SimilarityMatch.Passages(childComplexity), true
 
-	case "Semester.topics":
-		if e.complexity.Semester.Topics == nil {
+	case "SimilarityMatch.semesterCode":
+		if e.complexity.SimilarityMatch.SemesterCode == nil {
 			break
 		}
 
-		return e.complexity.Semester.Topics(childComplexity), true
+		return e.complexity.SimilarityMatch.SemesterCode(childComplexity), true
 
-	case "Semester.updatedAt":
-		if e.complexity.Semester.UpdatedAt == nil {
+	case "SimilarityMatch.similarity":
+		if e.complexity.SimilarityMatch.Similarity == nil {
 			break
 		}
 
-		return e.complexity.Semester.UpdatedAt(childComplexity), true
+		return e.complexity.SimilarityMatch.Similarity(childComplexity), true
 
-	case "Semester.updatedBy":
-		if e.complexity.Semester.UpdatedBy == nil {
+	case "SimilarityReport.file":
+		if e.complexity.SimilarityReport.File == nil {
 			break
 		}
 
-		return e.complexity.Semester.UpdatedBy(childComplexity), true
+		return e.complexity.SimilarityReport.File(childComplexity), true
 
-	case "SemesterInfo.id":
-		if e.complexity.SemesterInfo.ID == nil {
+	case "SimilarityReport.indexed":
+		if e.complexity.SimilarityReport.Indexed == nil {
 			break
 		}
 
-		return e.complexity.SemesterInfo.ID(childComplexity), true
+		return e.complexity.SimilarityReport.Indexed(childComplexity), true
 
-	case "SemesterInfo.title":
-		if e.complexity.SemesterInfo.Title == nil {
+	case "SimilarityReport.indexedAt":
+		if e.complexity.SimilarityReport.IndexedAt == nil {
 			break
 		}
 
-		return e.complexity.SemesterInfo.Title(childComplexity), true
+		return e.complexity.SimilarityReport.IndexedAt(childComplexity), true
 
-	case "SemesterListResponse.data":
-		if e.complexity.SemesterListResponse.Data == nil {
+	case "SimilarityReport.matches":
+		if e.complexity.SimilarityReport.Matches == nil {
 			break
 		}
 
-		return e.complexity.SemesterListResponse.Data(childComplexity), true
+		return e.complexity.SimilarityReport.Matches(childComplexity), true
 
-	case "SemesterListResponse.total":
-		if e.complexity.SemesterListResponse.Total == nil {
+	case "SimilarityReport.wordCount":
+		if e.complexity.SimilarityReport.WordCount == nil {
 			break
 		}
 
-		return e.complexity.SemesterListResponse.Total(childComplexity), true
+		return e.complexity.SimilarityReport.WordCount(childComplexity), true
 
 	case "Student.classCode":
 		if e.complexity.Student.ClassCode == nil {
@@ -3851,6 +6901,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Student.CreatedBy(childComplexity), true
 
+	case "Student.deletedAt":
+		if e.complexity.Student.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.Student.DeletedAt(childComplexity), true
+
+	case "Student.deletedBy":
+		if e.complexity.Student.DeletedBy == nil {
+			break
+		}
+
+		return e.complexity.Student.DeletedBy(childComplexity), true
+
 	case "Student.email":
 		if e.complexity.Student.Email == nil {
 			break
@@ -3921,6 +6985,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Student.Username(childComplexity), true
 
+	case "Student.version":
+		if e.complexity.Student.Version == nil {
+			break
+		}
+
+		return e.complexity.Student.Version(childComplexity), true
+
 	case "StudentCouncil.createdAt":
 		if e.complexity.StudentCouncil.CreatedAt == nil {
 			break
@@ -4334,6 +7405,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.StudentTopic.MajorCode(childComplexity), true
 
+	case "StudentTopic.maxStudents":
+		if e.complexity.StudentTopic.MaxStudents == nil {
+			break
+		}
+
+		return e.complexity.StudentTopic.MaxStudents(childComplexity), true
+
 	case "StudentTopic.percentStage1":
 		if e.complexity.StudentTopic.PercentStage1 == nil {
 			break
@@ -4348,6 +7426,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.StudentTopic.PercentStage2(childComplexity), true
 
+	case "StudentTopic.requiredSkills":
+		if e.complexity.StudentTopic.RequiredSkills == nil {
+			break
+		}
+
+		return e.complexity.StudentTopic.RequiredSkills(childComplexity), true
+
 	case "StudentTopic.semester":
 		if e.complexity.StudentTopic.Semester == nil {
 			break
@@ -4509,6 +7594,139 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.StudentTopicSupervisorListResponse.Total(childComplexity), true
 
+	case "SubmissionDeadline.createdAt":
+		if e.complexity.SubmissionDeadline.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.SubmissionDeadline.CreatedAt(childComplexity), true
+
+	case "SubmissionDeadline.createdBy":
+		if e.complexity.SubmissionDeadline.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.SubmissionDeadline.CreatedBy(childComplexity), true
+
+	case "SubmissionDeadline.dueAt":
+		if e.complexity.SubmissionDeadline.DueAt == nil {
+			break
+		}
+
+		return e.complexity.SubmissionDeadline.DueAt(childComplexity), true
+
+	case "SubmissionDeadline.graceMinutes":
+		if e.complexity.SubmissionDeadline.GraceMinutes == nil {
+			break
+		}
+
+		return e.complexity.SubmissionDeadline.GraceMinutes(childComplexity), true
+
+	case "SubmissionDeadline.id":
+		if e.complexity.SubmissionDeadline.ID == nil {
+			break
+		}
+
+		return e.complexity.SubmissionDeadline.ID(childComplexity), true
+
+	case "SubmissionDeadline.kind":
+		if e.complexity.SubmissionDeadline.Kind == nil {
+			break
+		}
+
+		return e.complexity.SubmissionDeadline.Kind(childComplexity), true
+
+	case "SubmissionDeadline.opensAt":
+		if e.complexity.SubmissionDeadline.OpensAt == nil {
+			break
+		}
+
+		return e.complexity.SubmissionDeadline.OpensAt(childComplexity), true
+
+	case "SubmissionDeadline.semesterCode":
+		if e.complexity.SubmissionDeadline.SemesterCode == nil {
+			break
+		}
+
+		return e.complexity.SubmissionDeadline.SemesterCode(childComplexity), true
+
+	case "SubmissionDeadline.stage":
+		if e.complexity.SubmissionDeadline.Stage == nil {
+			break
+		}
+
+		return e.complexity.SubmissionDeadline.Stage(childComplexity), true
+
+	case "SubmissionDeadline.updatedAt":
+		if e.complexity.SubmissionDeadline.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.SubmissionDeadline.UpdatedAt(childComplexity), true
+
+	case "SubmissionDeadline.updatedBy":
+		if e.complexity.SubmissionDeadline.UpdatedBy == nil {
+			break
+		}
+
+		return e.complexity.SubmissionDeadline.UpdatedBy(childComplexity), true
+
+	case "SubmissionWindow.allowed":
+		if e.complexity.SubmissionWindow.Allowed == nil {
+			break
+		}
+
+		return e.complexity.SubmissionWindow.Allowed(childComplexity), true
+
+	case "SubmissionWindow.closesAt":
+		if e.complexity.SubmissionWindow.ClosesAt == nil {
+			break
+		}
+
+		return e.complexity.SubmissionWindow.ClosesAt(childComplexity), true
+
+	case "SubmissionWindow.dueAt":
+		if e.complexity.SubmissionWindow.DueAt == nil {
+			break
+		}
+
+		return e.complexity.SubmissionWindow.DueAt(childComplexity), true
+
+	case "SubmissionWindow.extended":
+		if e.complexity.SubmissionWindow.Extended == nil {
+			break
+		}
+
+		return e.complexity.SubmissionWindow.Extended(childComplexity), true
+
+	case "SubmissionWindow.late":
+		if e.complexity.SubmissionWindow.Late == nil {
+			break
+		}
+
+		return e.complexity.SubmissionWindow.Late(childComplexity), true
+
+	case "SubmissionWindow.opensAt":
+		if e.complexity.SubmissionWindow.OpensAt == nil {
+			break
+		}
+
+		return e.complexity.SubmissionWindow.OpensAt(childComplexity), true
+
+	case "SubmissionWindow.reason":
+		if e.complexity.SubmissionWindow.Reason == nil {
+			break
+		}
+
+		return e.complexity.SubmissionWindow.Reason(childComplexity), true
+
+	case "SubmissionWindow.stage":
+		if e.complexity.SubmissionWindow.Stage == nil {
+			break
+		}
+
+		return e.complexity.SubmissionWindow.Stage(childComplexity), true
+
 	case "Subscription._empty":
 		if e.complexity.Subscription.Empty == nil {
 			break
@@ -4586,6 +7804,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.SupervisorEnrollment.MidtermCode(childComplexity), true
 
+	case "SupervisorEnrollment.similarityReport":
+		if e.complexity.SupervisorEnrollment.SimilarityReport == nil {
+			break
+		}
+
+		args, err := ec.field_SupervisorEnrollment_similarityReport_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.SupervisorEnrollment.SimilarityReport(childComplexity, args["topN"].(*int32)), true
+
 	case "SupervisorEnrollment.student":
 		if e.complexity.SupervisorEnrollment.Student == nil {
 			break
@@ -4691,6 +7921,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.SupervisorTopic.MajorCode(childComplexity), true
 
+	case "SupervisorTopic.maxStudents":
+		if e.complexity.SupervisorTopic.MaxStudents == nil {
+			break
+		}
+
+		return e.complexity.SupervisorTopic.MaxStudents(childComplexity), true
+
 	case "SupervisorTopic.percentStage1":
 		if e.complexity.SupervisorTopic.PercentStage1 == nil {
 			break
@@ -4705,6 +7942,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.SupervisorTopic.PercentStage2(childComplexity), true
 
+	case "SupervisorTopic.requiredSkills":
+		if e.complexity.SupervisorTopic.RequiredSkills == nil {
+			break
+		}
+
+		return e.complexity.SupervisorTopic.RequiredSkills(childComplexity), true
+
 	case "SupervisorTopic.semester":
 		if e.complexity.SupervisorTopic.Semester == nil {
 			break
@@ -4726,6 +7970,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.SupervisorTopic.Status(childComplexity), true
 
+	case "SupervisorTopic.statusHistory":
+		if e.complexity.SupervisorTopic.StatusHistory == nil {
+			break
+		}
+
+		return e.complexity.SupervisorTopic.StatusHistory(childComplexity), true
+
 	case "SupervisorTopic.title":
 		if e.complexity.SupervisorTopic.Title == nil {
 			break
@@ -4929,6 +8180,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Teacher.CreatedBy(childComplexity), true
 
+	case "Teacher.deletedAt":
+		if e.complexity.Teacher.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.Teacher.DeletedAt(childComplexity), true
+
+	case "Teacher.deletedBy":
+		if e.complexity.Teacher.DeletedBy == nil {
+			break
+		}
+
+		return e.complexity.Teacher.DeletedBy(childComplexity), true
+
 	case "Teacher.email":
 		if e.complexity.Teacher.Email == nil {
 			break
@@ -4992,6 +8257,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Teacher.Username(childComplexity), true
 
+	case "Teacher.version":
+		if e.complexity.Teacher.Version == nil {
+			break
+		}
+
+		return e.complexity.Teacher.Version(childComplexity), true
+
 	case "TeacherListResponse.data":
 		if e.complexity.TeacherListResponse.Data == nil {
 			break
@@ -5020,6 +8292,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Topic.CreatedBy(childComplexity), true
 
+	case "Topic.deletedAt":
+		if e.complexity.Topic.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.Topic.DeletedAt(childComplexity), true
+
+	case "Topic.deletedBy":
+		if e.complexity.Topic.DeletedBy == nil {
+			break
+		}
+
+		return e.complexity.Topic.DeletedBy(childComplexity), true
+
 	case "Topic.files":
 		if e.complexity.Topic.Files == nil {
 			break
@@ -5041,6 +8327,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Topic.MajorCode(childComplexity), true
 
+	case "Topic.maxStudents":
+		if e.complexity.Topic.MaxStudents == nil {
+			break
+		}
+
+		return e.complexity.Topic.MaxStudents(childComplexity), true
+
 	case "Topic.percentStage1":
 		if e.complexity.Topic.PercentStage1 == nil {
 			break
@@ -5053,56 +8346,119 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			break
 		}
 
-		return e.complexity.Topic.PercentStage2(childComplexity), true
+		return e.complexity.Topic.PercentStage2(childComplexity), true
+
+	case "Topic.requiredSkills":
+		if e.complexity.Topic.RequiredSkills == nil {
+			break
+		}
+
+		return e.complexity.Topic.RequiredSkills(childComplexity), true
+
+	case "Topic.semesterCode":
+		if e.complexity.Topic.SemesterCode == nil {
+			break
+		}
+
+		return e.complexity.Topic.SemesterCode(childComplexity), true
+
+	case "Topic.status":
+		if e.complexity.Topic.Status == nil {
+			break
+		}
+
+		return e.complexity.Topic.Status(childComplexity), true
+
+	case "Topic.statusHistory":
+		if e.complexity.Topic.StatusHistory == nil {
+			break
+		}
+
+		return e.complexity.Topic.StatusHistory(childComplexity), true
+
+	case "Topic.title":
+		if e.complexity.Topic.Title == nil {
+			break
+		}
+
+		return e.complexity.Topic.Title(childComplexity), true
+
+	case "Topic.topicCouncils":
+		if e.complexity.Topic.TopicCouncils == nil {
+			break
+		}
+
+		return e.complexity.Topic.TopicCouncils(childComplexity), true
+
+	case "Topic.total":
+		if e.complexity.Topic.Total == nil {
+			break
+		}
+
+		return e.complexity.Topic.Total(childComplexity), true
+
+	case "Topic.updatedAt":
+		if e.complexity.Topic.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Topic.UpdatedAt(childComplexity), true
+
+	case "Topic.updatedBy":
+		if e.complexity.Topic.UpdatedBy == nil {
+			break
+		}
+
+		return e.complexity.Topic.UpdatedBy(childComplexity), true
 
-	case "Topic.semesterCode":
-		if e.complexity.Topic.SemesterCode == nil {
+	case "Topic.version":
+		if e.complexity.Topic.Version == nil {
 			break
 		}
 
-		return e.complexity.Topic.SemesterCode(childComplexity), true
+		return e.complexity.Topic.Version(childComplexity), true
 
-	case "Topic.status":
-		if e.complexity.Topic.Status == nil {
+	case "TopicCoSign.createdAt":
+		if e.complexity.TopicCoSign.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.Topic.Status(childComplexity), true
+		return e.complexity.TopicCoSign.CreatedAt(childComplexity), true
 
-	case "Topic.title":
-		if e.complexity.Topic.Title == nil {
+	case "TopicCoSign.id":
+		if e.complexity.TopicCoSign.ID == nil {
 			break
 		}
 
-		return e.complexity.Topic.Title(childComplexity), true
+		return e.complexity.TopicCoSign.ID(childComplexity), true
 
-	case "Topic.topicCouncils":
-		if e.complexity.Topic.TopicCouncils == nil {
+	case "TopicCoSign.status":
+		if e.complexity.TopicCoSign.Status == nil {
 			break
 		}
 
-		return e.complexity.Topic.TopicCouncils(childComplexity), true
+		return e.complexity.TopicCoSign.Status(childComplexity), true
 
-	case "Topic.total":
-		if e.complexity.Topic.Total == nil {
+	case "TopicCoSign.teacherCode":
+		if e.complexity.TopicCoSign.TeacherCode == nil {
 			break
 		}
 
-		return e.complexity.Topic.Total(childComplexity), true
+		return e.complexity.TopicCoSign.TeacherCode(childComplexity), true
 
-	case "Topic.updatedAt":
-		if e.complexity.Topic.UpdatedAt == nil {
+	case "TopicCoSign.topicCode":
+		if e.complexity.TopicCoSign.TopicCode == nil {
 			break
 		}
 
-		return e.complexity.Topic.UpdatedAt(childComplexity), true
+		return e.complexity.TopicCoSign.TopicCode(childComplexity), true
 
-	case "Topic.updatedBy":
-		if e.complexity.Topic.UpdatedBy == nil {
+	case "TopicCoSign.updatedAt":
+		if e.complexity.TopicCoSign.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.Topic.UpdatedBy(childComplexity), true
+		return e.complexity.TopicCoSign.UpdatedAt(childComplexity), true
 
 	case "TopicCouncil.council":
 		if e.complexity.TopicCouncil.Council == nil {
@@ -5209,6 +8565,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TopicCouncil.UpdatedBy(childComplexity), true
 
+	case "TopicCouncil.version":
+		if e.complexity.TopicCouncil.Version == nil {
+			break
+		}
+
+		return e.complexity.TopicCouncil.Version(childComplexity), true
+
 	case "TopicCouncilListResponse.data":
 		if e.complexity.TopicCouncilListResponse.Data == nil {
 			break
@@ -5286,6 +8649,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TopicCouncilSupervisor.UpdatedBy(childComplexity), true
 
+	case "TopicCouncilSupervisor.version":
+		if e.complexity.TopicCouncilSupervisor.Version == nil {
+			break
+		}
+
+		return e.complexity.TopicCouncilSupervisor.Version(childComplexity), true
+
 	case "TopicCouncilSupervisorListResponse.data":
 		if e.complexity.TopicCouncilSupervisorListResponse.Data == nil {
 			break
@@ -5314,6 +8684,188 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TopicListResponse.Total(childComplexity), true
 
+	case "TopicMatchingResult.assignments":
+		if e.complexity.TopicMatchingResult.Assignments == nil {
+			break
+		}
+
+		return e.complexity.TopicMatchingResult.Assignments(childComplexity), true
+
+	case "TopicMatchingResult.matchedTotal":
+		if e.complexity.TopicMatchingResult.MatchedTotal == nil {
+			break
+		}
+
+		return e.complexity.TopicMatchingResult.MatchedTotal(childComplexity), true
+
+	case "TopicMatchingResult.semesterCode":
+		if e.complexity.TopicMatchingResult.SemesterCode == nil {
+			break
+		}
+
+		return e.complexity.TopicMatchingResult.SemesterCode(childComplexity), true
+
+	case "TopicMatchingResult.studentsTotal":
+		if e.complexity.TopicMatchingResult.StudentsTotal == nil {
+			break
+		}
+
+		return e.complexity.TopicMatchingResult.StudentsTotal(childComplexity), true
+
+	case "TopicMatchingResult.unmatched":
+		if e.complexity.TopicMatchingResult.Unmatched == nil {
+			break
+		}
+
+		return e.complexity.TopicMatchingResult.Unmatched(childComplexity), true
+
+	case "TopicRegistration.createdAt":
+		if e.complexity.TopicRegistration.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.TopicRegistration.CreatedAt(childComplexity), true
+
+	case "TopicRegistration.decidedAt":
+		if e.complexity.TopicRegistration.DecidedAt == nil {
+			break
+		}
+
+		return e.complexity.TopicRegistration.DecidedAt(childComplexity), true
+
+	case "TopicRegistration.decidedBy":
+		if e.complexity.TopicRegistration.DecidedBy == nil {
+			break
+		}
+
+		return e.complexity.TopicRegistration.DecidedBy(childComplexity), true
+
+	case "TopicRegistration.enrollmentCode":
+		if e.complexity.TopicRegistration.EnrollmentCode == nil {
+			break
+		}
+
+		return e.complexity.TopicRegistration.EnrollmentCode(childComplexity), true
+
+	case "TopicRegistration.id":
+		if e.complexity.TopicRegistration.ID == nil {
+			break
+		}
+
+		return e.complexity.TopicRegistration.ID(childComplexity), true
+
+	case "TopicRegistration.rank":
+		if e.complexity.TopicRegistration.Rank == nil {
+			break
+		}
+
+		return e.complexity.TopicRegistration.Rank(childComplexity), true
+
+	case "TopicRegistration.reason":
+		if e.complexity.TopicRegistration.Reason == nil {
+			break
+		}
+
+		return e.complexity.TopicRegistration.Reason(childComplexity), true
+
+	case "TopicRegistration.semesterCode":
+		if e.complexity.TopicRegistration.SemesterCode == nil {
+			break
+		}
+
+		return e.complexity.TopicRegistration.SemesterCode(childComplexity), true
+
+	case "TopicRegistration.status":
+		if e.complexity.TopicRegistration.Status == nil {
+			break
+		}
+
+		return e.complexity.TopicRegistration.Status(childComplexity), true
+
+	case "TopicRegistration.studentCode":
+		if e.complexity.TopicRegistration.StudentCode == nil {
+			break
+		}
+
+		return e.complexity.TopicRegistration.StudentCode(childComplexity), true
+
+	case "TopicRegistration.topicCode":
+		if e.complexity.TopicRegistration.TopicCode == nil {
+			break
+		}
+
+		return e.complexity.TopicRegistration.TopicCode(childComplexity), true
+
+	case "TopicRegistration.updatedAt":
+		if e.complexity.TopicRegistration.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.TopicRegistration.UpdatedAt(childComplexity), true
+
+	case "TopicStatusHistory.createdAt":
+		if e.complexity.TopicStatusHistory.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.TopicStatusHistory.CreatedAt(childComplexity), true
+
+	case "TopicStatusHistory.createdBy":
+		if e.complexity.TopicStatusHistory.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.TopicStatusHistory.CreatedBy(childComplexity), true
+
+	case "TopicStatusHistory.fromStatus":
+		if e.complexity.TopicStatusHistory.FromStatus == nil {
+			break
+		}
+
+		return e.complexity.TopicStatusHistory.FromStatus(childComplexity), true
+
+	case "TopicStatusHistory.id":
+		if e.complexity.TopicStatusHistory.ID == nil {
+			break
+		}
+
+		return e.complexity.TopicStatusHistory.ID(childComplexity), true
+
+	case "TopicStatusHistory.reason":
+		if e.complexity.TopicStatusHistory.Reason == nil {
+			break
+		}
+
+		return e.complexity.TopicStatusHistory.Reason(childComplexity), true
+
+	case "TopicStatusHistory.toStatus":
+		if e.complexity.TopicStatusHistory.ToStatus == nil {
+			break
+		}
+
+		return e.complexity.TopicStatusHistory.ToStatus(childComplexity), true
+
+	case "TopicStatusHistory.topicCode":
+		if e.complexity.TopicStatusHistory.TopicCode == nil {
+			break
+		}
+
+		return e.complexity.TopicStatusHistory.TopicCode(childComplexity), true
+
+	case "UnmatchedStudent.rejections":
+		if e.complexity.UnmatchedStudent.Rejections == nil {
+			break
+		}
+
+		return e.complexity.UnmatchedStudent.Rejections(childComplexity), true
+
+	case "UnmatchedStudent.studentCode":
+		if e.complexity.UnmatchedStudent.StudentCode == nil {
+			break
+		}
+
+		return e.complexity.UnmatchedStudent.StudentCode(childComplexity), true
+
 	}
 	return 0, false
 }
@@ -5328,22 +8880,42 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputCreateGradeDefenceCriterionInput,
 		ec.unmarshalInputCreateGradeDefenceInput,
 		ec.unmarshalInputCreateMajorInput,
+		ec.unmarshalInputCreateMidtermMilestoneInput,
+		ec.unmarshalInputCreateRubricTemplateInput,
 		ec.unmarshalInputCreateSemesterInput,
 		ec.unmarshalInputCreateStudentInput,
 		ec.unmarshalInputCreateTeacherInput,
+		ec.unmarshalInputDecideGradeAmendmentInput,
+		ec.unmarshalInputDefenceScheduleInput,
+		ec.unmarshalInputFileGradeAppealInput,
 		ec.unmarshalInputFilterConditionInput,
 		ec.unmarshalInputFilterCriteriaInput,
 		ec.unmarshalInputFilterGroupInput,
+		ec.unmarshalInputGradeAppealEvidenceInput,
 		ec.unmarshalInputGradeFinalInput,
-		ec.unmarshalInputGradeMidtermInput,
+		ec.unmarshalInputGrantDeadlineExtensionInput,
+		ec.unmarshalInputMilestoneAttachmentInput,
 		ec.unmarshalInputPaginationInput,
+		ec.unmarshalInputProposeTopicInput,
+		ec.unmarshalInputRequestGradeAmendmentInput,
+		ec.unmarshalInputResolveGradeAppealInput,
+		ec.unmarshalInputRubricCriterionInput,
 		ec.unmarshalInputSearchRequestInput,
+		ec.unmarshalInputSetGradingPolicyInput,
+		ec.unmarshalInputSetRegistrationWindowInput,
+		ec.unmarshalInputSetSubmissionDeadlineInput,
+		ec.unmarshalInputSortInput,
+		ec.unmarshalInputSubmitMilestoneCheckinInput,
+		ec.unmarshalInputTeacherUnavailabilityInput,
+		ec.unmarshalInputTimeRangeInput,
 		ec.unmarshalInputUpdateCouncilInput,
 		ec.unmarshalInputUpdateFacultyInput,
 		ec.unmarshalInputUpdateGradeDefenceCriterionInput,
 		ec.unmarshalInputUpdateGradeDefenceInput,
 		ec.unmarshalInputUpdateGradeReviewInput,
 		ec.unmarshalInputUpdateMajorInput,
+		ec.unmarshalInputUpdateMidtermMilestoneInput,
+		ec.unmarshalInputUpdateRubricTemplateInput,
 		ec.unmarshalInputUpdateSemesterInput,
 		ec.unmarshalInputUpdateStudentInput,
 		ec.unmarshalInputUpdateStudentProfileInput,
@@ -5472,6 +9044,7 @@ var sources = []*ast.Source{
     updatedAt: Time
     createdBy: String
     updatedBy: String
+    version: Int!
 
     majors: [Major!]!
 }
@@ -5485,6 +9058,9 @@ type Major {
     updatedAt: Time
     createdBy: String
     updatedBy: String
+    version: Int!
+    deletedAt: Time  # Khác null khi đã bị xóa (còn khôi phục được)
+    deletedBy: String
 
     # KHÔNG có faculty - tránh circular query: Major → Faculty → Majors → ...
     topics: [Topic!]
@@ -5498,6 +9074,9 @@ type Semester {
     updatedAt: Time
     createdBy: String
     updatedBy: String
+    version: Int!
+    deletedAt: Time  # Khác null khi đã bị xóa (còn khôi phục được)
+    deletedBy: String
 
     students: [Student!]
     teachers: [Teacher!]
@@ -5552,6 +9131,45 @@ extend type Query {
 
     """Lấy danh sách grade defence"""
     getAllGradeDefences(search: SearchRequestInput!): GradeDefenceListResponse!
+
+    """Lấy các khung nộp bài của học kỳ"""
+    getSubmissionDeadlines(semesterCode: ID!): [SubmissionDeadline!]!
+
+    """Các mốc tiến độ midterm của học kỳ"""
+    getMidtermMilestones(semesterCode: ID!, stage: TopicStage): [MidtermMilestone!]!
+
+    """Lấy khung đăng ký đề tài của học kỳ"""
+    getRegistrationWindow(semesterCode: ID!): RegistrationWindow
+
+    """Danh sách đăng ký đề tài của học kỳ"""
+    getTopicRegistrations(semesterCode: ID!): [TopicRegistration!]!
+
+    """Chạy thử ghép sinh viên - đề tài, không thay đổi dữ liệu"""
+    previewTopicMatching(semesterCode: ID!): TopicMatchingResult!
+
+    """Chạy thử xếp lịch bảo vệ, không thay đổi dữ liệu"""
+    previewDefenceSchedule(input: DefenceScheduleInput!): DefenceSchedule!
+
+    """Danh sách rubric template, lọc theo ngành / giai đoạn"""
+    getRubricTemplates(majorCode: String, stage: TopicStage): [RubricTemplate!]!
+
+    """Grading policy của học kỳ"""
+    getGradingPolicies(semesterCode: ID!): [GradingPolicy!]!
+
+    """Tính thử điểm tổng kết của một enrollment, không thay đổi dữ liệu"""
+    previewFinalGrade(enrollmentId: ID!): FinalGradeBreakdown!
+
+    """Trạng thái khóa / công bố điểm của học kỳ"""
+    getSemesterGradeStatus(semesterCode: ID!): SemesterGradeStatus!
+
+    """Yêu cầu sửa điểm của học kỳ (điểm thesis và điểm hội đồng)"""
+    getGradeAmendments(semesterCode: ID!, status: GradeAmendmentStatus): [GradeAmendment!]!
+
+    """Đơn phúc khảo của học kỳ"""
+    getGradeAppeals(semesterCode: ID!, status: GradeAppealStatus): [GradeAppeal!]!
+
+    """Nhật ký thay đổi dữ liệu, mới nhất trước (tối đa 500 dòng)"""
+    auditLog(entity: String, entityId: ID, actor: String, from: Time, to: Time): [AuditLogEntry!]!
 }
 
 extend type Mutation {
@@ -5564,6 +9182,9 @@ extend type Mutation {
     """Xóa giáo viên"""
     deleteTeacher(id: ID!): Boolean!
 
+    """Khôi phục giáo viên đã xóa (trước khi hết thời hạn lưu)"""
+    restoreTeacher(id: ID!): Teacher!
+
     """Tạo mới sinh viên"""
     createStudent(input: CreateStudentInput!): Student!
 
@@ -5573,6 +9194,9 @@ extend type Mutation {
     """Xóa sinh viên"""
     deleteStudent(id: ID!): Boolean!
 
+    """Khôi phục sinh viên đã xóa (trước khi hết thời hạn lưu)"""
+    restoreStudent(id: ID!): Student!
+
     """Tạo semester mới"""
     createSemester(input: CreateSemesterInput!): Semester!
 
@@ -5582,6 +9206,9 @@ extend type Mutation {
     """Xóa semester"""
     deleteSemester(id: ID!): Boolean!
 
+    """Khôi phục semester đã xóa (trước khi hết thời hạn lưu)"""
+    restoreSemester(id: ID!): Semester!
+
     """Tạo major mới"""
     createMajor(input: CreateMajorInput!): Major!
 
@@ -5591,6 +9218,9 @@ extend type Mutation {
     """Xóa major"""
     deleteMajor(id: ID!): Boolean!
 
+    """Khôi phục major đã xóa (trước khi hết thời hạn lưu)"""
+    restoreMajor(id: ID!): Major!
+
     """Tạo faculty mới"""
     createFaculty(input: CreateFacultyInput!): Faculty!
 
@@ -5609,20 +9239,195 @@ extend type Mutation {
     """Xóa council"""
     deleteCouncil(id: ID!): Boolean!
 
-    """Phê duyệt topic lần 2 (approved_2)"""
-    approveTopic(id: ID!): Topic!
+    """Khôi phục council đã xóa (trước khi hết thời hạn lưu)"""
+    restoreCouncil(id: ID!): Council!
+
+    """Phê duyệt topic lần 2 (approved_1 → approved_2)"""
+    approveTopic(id: ID!, note: String): Topic!
+
+    """Từ chối topic (bắt buộc nêu lý do)"""
+    rejectTopic(id: ID!, reason: String!): Topic!
 
-    """Từ chối topic"""
-    rejectTopic(id: ID!, reason: String): Topic!
+    """Bắt đầu thực hiện topic đã duyệt (approved_2 → in_progress)"""
+    startTopic(id: ID!): Topic!
+
+    """Kết thúc topic (in_progress → topic_completed)"""
+    completeTopic(id: ID!): Topic!
 
     """Cập nhật topic"""
     updateTopic(id: ID!, input: UpdateTopicInput!): Topic!
 
     """Xóa topic"""
     deleteTopic(id: ID!): Boolean!
+
+    """Khôi phục topic đã xóa (trước khi hết thời hạn lưu)"""
+    restoreTopic(id: ID!): Topic!
+
+    """Tạo hoặc thay thế khung nộp bài (học kỳ + giai đoạn + loại bài nộp)"""
+    setSubmissionDeadline(input: SetSubmissionDeadlineInput!): SubmissionDeadline!
+
+    """Tạo mốc tiến độ midterm"""
+    createMidtermMilestone(input: CreateMidtermMilestoneInput!): MidtermMilestone!
+
+    """Cập nhật mốc tiến độ midterm"""
+    updateMidtermMilestone(id: ID!, input: UpdateMidtermMilestoneInput!): MidtermMilestone!
+
+    """Xóa mốc tiến độ chưa có báo cáo nào"""
+    deleteMidtermMilestone(id: ID!): Boolean!
+
+    """Mở hoặc dời khung đăng ký đề tài của học kỳ"""
+    setRegistrationWindow(input: SetRegistrationWindowInput!): RegistrationWindow!
+
+    """Ghép sinh viên - đề tài và tạo Enrollment (một transaction)"""
+    commitTopicMatching(semesterCode: ID!): TopicMatchingResult!
+
+    """Xếp lịch bảo vệ và ghi thời gian, phòng vào Council / Topic_council"""
+    commitDefenceSchedule(input: DefenceScheduleInput!): DefenceSchedule!
+
+    """Tạo rubric template cho một ngành và giai đoạn"""
+    createRubricTemplate(input: CreateRubricTemplateInput!): RubricTemplate!
+
+    """Cập nhật rubric template (grade defence đã tạo giữ tiêu chí cũ)"""
+    updateRubricTemplate(id: ID!, input: UpdateRubricTemplateInput!): RubricTemplate!
+
+    """Xóa rubric template"""
+    deleteRubricTemplate(id: ID!): Boolean!
+
+    """Tạo hoặc thay thế grading policy (ngành + học kỳ + giai đoạn)"""
+    setGradingPolicy(input: SetGradingPolicyInput!): GradingPolicy!
+
+    """Xóa grading policy"""
+    deleteGradingPolicy(id: ID!): Boolean!
+
+    """Tính và ghi điểm tổng kết, trạng thái vào Final; từ chối khi còn thiếu điểm"""
+    finalizeFinalGrade(enrollmentId: ID!): FinalGradeBreakdown!
+
+    """Khóa điểm của học kỳ và mọi hội đồng trong học kỳ"""
+    lockSemesterGrades(semesterCode: ID!): SemesterGradeStatus!
+
+    """Công bố điểm của học kỳ và mọi hội đồng trong học kỳ (phải khóa trước)"""
+    publishSemesterGrades(semesterCode: ID!): SemesterGradeStatus!
+
+    """Khóa điểm của một hội đồng"""
+    lockCouncilGrades(councilId: ID!): Council!
+
+    """Công bố điểm của một hội đồng (phải khóa trước)"""
+    publishCouncilGrades(councilId: ID!): Council!
+
+    """Duyệt (ghi điểm mới) hoặc từ chối yêu cầu sửa điểm"""
+    decideGradeAmendment(input: DecideGradeAmendmentInput!): GradeAmendment!
+
+    """Đặt số ngày phúc khảo sau khi công bố điểm (mặc định 14)"""
+    setGradeAppealWindow(semesterCode: ID!, days: Int!): SemesterGradeStatus!
+
+    """Giao (hoặc giao lại) đơn phúc khảo chưa xử lý cho một giảng viên"""
+    assignGradeAppeal(id: ID!, reviewerId: ID!): GradeAppeal!
 }
 
 # Input types for mutations
+input SetSubmissionDeadlineInput {
+    semesterCode: ID!
+    stage: TopicStage!
+    kind: SubmissionKind!
+    opensAt: Time!
+    dueAt: Time!
+    graceMinutes: Int
+}
+
+input CreateMidtermMilestoneInput {
+    semesterCode: ID!
+    stage: TopicStage!
+    title: String!
+    description: String
+    sequence: Int!
+    dueAt: Time
+}
+
+input UpdateMidtermMilestoneInput {
+    title: String
+    description: String
+    sequence: Int
+    dueAt: Time
+    """Phiên bản đã đọc; nếu bản ghi đã bị sửa thì trả lỗi CONFLICT"""
+    version: Int
+}
+
+input SetRegistrationWindowInput {
+    semesterCode: ID!
+    opensAt: Time!
+    closesAt: Time!
+    """Mặc định 3"""
+    maxChoices: Int
+}
+
+input SetGradingPolicyInput {
+    majorCode: String!
+    semesterCode: ID!
+    stage: TopicStage!
+    supervisorWeight: Float!
+    reviewerWeight: Float!
+    councilWeight: Float!
+    """Mặc định HALF_UP"""
+    rounding: GradeRounding
+    """Mặc định 0.1"""
+    roundingStep: Float
+    """Mặc định 5"""
+    passThreshold: Float
+    """Mặc định 0 (không kiểm tra)"""
+    maxCouncilSpread: Float
+}
+
+input DecideGradeAmendmentInput {
+    id: ID!
+    target: GradeAmendmentTarget!
+    approve: Boolean!
+    note: String
+}
+
+input RubricCriterionInput {
+    name: String!
+    description: String
+    maxScore: Float!
+    weight: Float!
+}
+
+input CreateRubricTemplateInput {
+    title: String!
+    majorCode: String!
+    stage: TopicStage!
+    criteria: [RubricCriterionInput!]!
+}
+
+input UpdateRubricTemplateInput {
+    title: String
+    """Thay toàn bộ tiêu chí khi có giá trị"""
+    criteria: [RubricCriterionInput!]
+    """Phiên bản đã đọc; nếu bản ghi đã bị sửa thì trả lỗi CONFLICT"""
+    version: Int
+}
+
+input DefenceScheduleInput {
+    semesterCode: ID!
+    rooms: [String!]!
+    """Các khoảng thời gian có thể tổ chức bảo vệ, ví dụ 08:00-17:00 mỗi ngày"""
+    days: [TimeRangeInput!]!
+    """Thời lượng bảo vệ một đề tài (phút)"""
+    slotMinutes: Int!
+    """Thời gian giáo viên bận"""
+    unavailability: [TeacherUnavailabilityInput!]
+}
+
+input TimeRangeInput {
+    start: Time!
+    end: Time!
+}
+
+input TeacherUnavailabilityInput {
+    teacherCode: String!
+    start: Time!
+    end: Time!
+}
+
 input CreateTeacherInput {
     id: ID!
     email: String!
@@ -5695,9 +9500,9 @@ input UpdateCouncilInput {
     timeStart: Time
 }
 
+# status không cập nhật ở đây: dùng submitTopic/approveTopic/rejectTopic/startTopic/completeTopic
 input UpdateTopicInput {
     title: String
-    status: TopicStatus
     percentStage1: Int
     percentStage2: Int
 }
@@ -5709,10 +9514,20 @@ input UpdateTopicInput {
     majorCode: String!  # Chỉ có code, KHÔNG có major relationship (tránh circular)
     semesterCode: String!  # Chỉ có code, KHÔNG có semester relationship (tránh circular)
     timeStart: Time
+    room: String
+    """Đã khóa điểm: chỉ sửa qua yêu cầu sửa điểm được duyệt"""
+    gradesLocked: Boolean!
+    gradesLockedAt: Time
+    """Đã công bố điểm cho sinh viên"""
+    gradesPublished: Boolean!
+    gradesPublishedAt: Time
     createdAt: Time
     updatedAt: Time
     createdBy: String
     updatedBy: String
+    version: Int!
+    deletedAt: Time  # Khác null khi đã bị xóa (còn khôi phục được)
+    deletedBy: String
 
     # KHÔNG có major - tránh circular query
     # KHÔNG có semester - tránh circular query
@@ -5730,40 +9545,153 @@ type Defence {
     updatedAt: Time
     createdBy: String
     updatedBy: String
+    version: Int!
 
     council: Council # all
     teacher: Teacher
     gradeDefences: [GradeDefence!]
 }
 
-type GradeDefence {
+type GradeDefence {
+    id: ID!
+    defenceCode: String!
+    enrollmentCode: String!
+    note: String
+    """Tính từ các tiêu chí theo trọng số (thang 10), null khi chưa chấm đủ"""
+    totalScore: Float
+    """Rubric template dùng để tạo các tiêu chí"""
+    rubricTemplateCode: String
+    createdAt: Time
+    updatedAt: Time
+    createdBy: String
+    updatedBy: String
+    version: Int!
+
+    defence: Defence # all
+    enrollment: Enrollment # gv
+    criteria: [GradeDefenceCriterion!] # all
+}
+
+type GradeDefenceCriterion {
+    id: ID!
+    gradeDefenceCode: String!
+    name: String
+    description: String
+    score: Float
+    maxScore: Float!
+    weight: Float!
+    """Có giá trị khi tiêu chí lấy từ rubric template: chỉ được sửa điểm"""
+    rubricCriterionCode: String
+    createdAt: Time
+    updatedAt: Time
+    createdBy: String
+    updatedBy: String
+    version: Int!
+
+    gradeDefence: GradeDefence
+}
+
+"""Lịch bảo vệ sinh ra cho một học kỳ"""
+type DefenceSchedule {
+    semesterCode: String!
+    """Mọi hội đồng và topic council đều đã được xếp"""
+    complete: Boolean!
+    """Đã ghi lịch vào Council / Topic_council"""
+    committed: Boolean!
+    sittings: [DefenceSitting!]!
+    """Topic council không có hội đồng nào tránh được người hướng dẫn"""
+    unassigned: [DefenceScheduleProblem!]!
+    """Hội đồng không tìm được phòng và thời gian"""
+    unplaced: [DefenceScheduleProblem!]!
+    """Lịch dạng CSV: council, room, topic_council, start, end"""
+    csv: String!
+}
+
+"""Một buổi họp của hội đồng trong một phòng"""
+type DefenceSitting {
+    councilCode: String!
+    room: String!
+    start: Time!
+    end: Time!
+    sessions: [DefenceSession!]!
+}
+
+type DefenceSession {
+    topicCouncilCode: String!
+    start: Time!
+    end: Time!
+}
+
+type DefenceScheduleProblem {
+    code: String!
+    reason: String!
+}
+
+"""Loại xung đột lợi ích trong council"""
+enum CouncilConflictKind {
+    """Người hướng dẫn ngồi trong council chấm đề tài của mình"""
+    SUPERVISOR_ON_COUNCIL
+    """Người phản biện cũng là người hướng dẫn"""
+    REVIEWER_IS_SUPERVISOR
+    """Trùng chủ tịch hoặc thư ký"""
+    DUPLICATE_POSITION
+    """Một giáo viên giữ nhiều vị trí"""
+    DUPLICATE_MEMBER
+    MISSING_PRESIDENT
+    MISSING_SECRETARY
+    """Thành viên khác ngành với council"""
+    CROSS_MAJOR_MEMBER
+}
+
+type CouncilConflict {
+    kind: CouncilConflictKind!
+    teacherCode: String
+    topicCouncilCode: String
+    message: String!
+    """Đã được bỏ qua kèm lý do"""
+    overridden: Boolean!
+}
+
+type CouncilValidation {
+    councilCode: String!
+    """Không còn xung đột nào chưa được bỏ qua"""
+    valid: Boolean!
+    conflicts: [CouncilConflict!]!
+}
+
+type CouncilConflictOverride {
     id: ID!
-    defenceCode: String!
-    enrollmentCode: String!
-    note: String
-    totalScore: Int
+    councilCode: String!
+    kind: CouncilConflictKind!
+    teacherCode: String
+    topicCouncilCode: String
+    message: String!
+    reason: String!
     createdAt: Time
-    updatedAt: Time
-    createdBy: String
-    updatedBy: String
-
-    defence: Defence # all
-    enrollment: Enrollment # gv
-    criteria: [GradeDefenceCriterion!] # all
+    createdBy: String!
 }
 
-type GradeDefenceCriterion {
+"""Rubric chấm bảo vệ theo ngành và giai đoạn"""
+type RubricTemplate {
     id: ID!
-    gradeDefenceCode: String!
-    name: String
-    score: String
-    maxScore: String
+    title: String!
+    majorCode: String!
+    stage: TopicStage!
+    criteria: [RubricCriterion!]!
     createdAt: Time
     updatedAt: Time
     createdBy: String
     updatedBy: String
+    version: Int!
+}
 
-    gradeDefence: GradeDefence
+type RubricCriterion {
+    id: ID!
+    name: String!
+    description: String
+    maxScore: Float!
+    weight: Float!
+    sortOrder: Int!
 }
 `, BuiltIn: false},
 	{Name: "../schema/department_lecturer.graphqls", Input: `# Schema dành riêng cho GIÁO VIÊN BỘ MÔN (Department Lecturer)
@@ -5808,6 +9736,12 @@ extend type Query {
 
     """Lấy danh sách grade defence"""
     getDepartmentGradeDefences(search: SearchRequestInput!): [GradeDefence!]!
+
+    """Kiểm tra xung đột lợi ích của council"""
+    validateCouncil(councilId: ID!): CouncilValidation!
+
+    """Các lần bỏ qua xung đột đã ghi nhận của council"""
+    getCouncilConflictOverrides(councilId: ID!): [CouncilConflictOverride!]!
 }
 
 extend type Mutation {
@@ -5817,20 +9751,23 @@ extend type Mutation {
     """Cập nhật council"""
     updateDepartmentCouncil(id: ID!, input: UpdateCouncilInput!): Council!
 
-    """Thêm thành viên vào council"""
+    """Thêm thành viên vào council (bị từ chối nếu có xung đột, trừ khi nêu overrideReason)"""
     addDefenceToCouncil(input: CreateDefenceInput!): Defence!
 
     """Xóa thành viên khỏi council"""
     removeDefenceFromCouncil(id: ID!): Boolean!
 
-    """Phê duyệt topic lần 1 (approved_1)"""
-    approveTopicStage1(id: ID!): Topic!
+    """Phê duyệt topic lần 1 (topic_pending → approved_1)"""
+    approveTopicStage1(id: ID!, note: String): Topic!
+
+    """Từ chối topic (bắt buộc nêu lý do)"""
+    rejectTopicStage1(id: ID!, reason: String!): Topic!
 
-    """Từ chối topic"""
-    rejectTopicStage1(id: ID!, reason: String): Topic!
+    """Gán topic vào council (bị từ chối nếu có xung đột, trừ khi nêu overrideReason)"""
+    assignTopicToCouncil(topicCouncilId: ID!, councilId: ID!, overrideReason: String): TopicCouncil!
 
-    """Gán topic vào council"""
-    assignTopicToCouncil(topicCouncilId: ID!, councilId: ID!): TopicCouncil!
+    """Bỏ qua mọi xung đột hiện có của council (bắt buộc nêu lý do, được ghi lại)"""
+    overrideCouncilConflicts(councilId: ID!, reason: String!): CouncilValidation!
 }
 
 # Input để tạo council
@@ -5846,6 +9783,8 @@ input CreateDefenceInput {
     councilCode: String!
     teacherCode: String!
     position: DefencePosition!
+    """Lý do thêm thành viên dù có xung đột lợi ích"""
+    overrideReason: String
 }
 `, BuiltIn: false},
 	{Name: "../schema/file.graphqls", Input: `type File {
@@ -5856,10 +9795,59 @@ input CreateDefenceInput {
     table: FileTable!
     option: String
     tableId: String!
+    """Phiên bản nộp (tăng dần theo table + tableId + option)"""
+    version: Int!
+    size: Int64
+    """sha256 hex; upload nhiều phần có dạng <sha256>-<số phần>"""
+    checksum: String
+    contentType: String
+    """Nộp trong thời gian gia hạn (sau hạn chót)"""
+    late: Boolean!
+    """File nghi ngờ (macro, mã độc, không quét được) bị cách ly cho đến khi được duyệt"""
+    quarantined: Boolean!
+    scanResult: String
     createdAt: Time
     updatedAt: Time
     createdBy: String
     updatedBy: String
+    revision: Int!
+    """Toàn bộ lịch sử nộp của cùng table + tableId + option, mới nhất trước"""
+    versions: [File!]!
+}
+
+"""
+Báo cáo trùng lặp nội dung của bài nộp cuối kỳ.
+So sánh với bài của sinh viên khác cùng học kỳ và các bài đã nộp ở học kỳ trước.
+"""
+type SimilarityReport {
+    """Bài nộp được kiểm tra (phiên bản mới nhất)"""
+    file: File
+    """false khi chưa trích xuất được văn bản (đang xử lý, file bị cách ly, PDF dạng ảnh)"""
+    indexed: Boolean!
+    wordCount: Int!
+    indexedAt: Time
+    """Các bài giống nhất, sắp theo tỉ lệ trùng lặp giảm dần"""
+    matches: [SimilarityMatch!]!
+}
+
+type SimilarityMatch {
+    file: File!
+    semesterCode: String!
+    """Độ tương đồng Jaccard ước lượng bằng MinHash (0-1)"""
+    similarity: Float!
+    """Tỉ lệ số từ của bài được kiểm tra xuất hiện trong bài này (0-100)"""
+    overlapPercent: Float!
+    """Các đoạn trùng dài nhất"""
+    passages: [MatchedPassage!]!
+}
+
+type MatchedPassage {
+    text: String!
+    """Vị trí (theo từ) trong bài được kiểm tra"""
+    offset: Int!
+    """Vị trí (theo từ) trong bài trùng"""
+    sourceOffset: Int!
+    words: Int!
 }
 `, BuiltIn: false},
 	{Name: "../schema/role.graphqls", Input: `
@@ -5874,12 +9862,14 @@ type RoleSystem {
     updatedAt: Time
     createdBy: String
     updatedBy: String
+    version: Int!
 
     teacher: Teacher
     semester: Semester
 }
 `, BuiltIn: false},
 	{Name: "../schema/schema.graphqls", Input: `scalar Time
+scalar Int64
 
 """Giới tính"""
 enum Gender {
@@ -5901,6 +9891,8 @@ enum FileTable {
     MIDTERM
     FINAL
     ORDER
+    GRADE_APPEAL
+    MILESTONE_CHECKIN
 }
 
 """
@@ -5935,6 +9927,95 @@ enum TopicStatus {
     REJECTED
 }
 
+"""Loại bài nộp có hạn nộp"""
+enum SubmissionKind {
+    MIDTERM
+    FINAL
+}
+
+"""Cách làm tròn điểm tổng kết"""
+enum GradeRounding {
+    HALF_UP
+    DOWN
+    UP
+}
+
+"""Điểm được yêu cầu sửa sau khi đã khóa"""
+enum GradeAmendmentTarget {
+    """Final.supervisorGrade"""
+    SUPERVISOR_GRADE
+    """GradeReview.reviewGrade"""
+    REVIEW_GRADE
+    """Midterm.grade"""
+    MIDTERM_GRADE
+    """Final.finalGrade, trạng thái tính lại theo ngưỡng đạt"""
+    FINAL_GRADE
+    """GradeDefenceCriterion.score, totalScore tính lại"""
+    DEFENCE_CRITERION
+}
+
+enum GradeAmendmentStatus {
+    PENDING
+    APPROVED
+    REJECTED
+}
+
+"""Kết quả một mốc tiến độ midterm"""
+enum MilestoneResult {
+    """Chờ giáo viên hướng dẫn đánh giá"""
+    PENDING
+    PASSED
+    FAILED
+}
+
+"""Thành phần điểm sinh viên phúc khảo"""
+enum GradeAppealComponent {
+    SUPERVISOR_GRADE
+    REVIEW_GRADE
+    MIDTERM_GRADE
+    FINAL_GRADE
+    """Điểm bảo vệ trước hội đồng"""
+    DEFENCE
+}
+
+"""Trạng thái phúc khảo"""
+enum GradeAppealStatus {
+    SUBMITTED
+    """Đã giao cho người xem xét"""
+    ASSIGNED
+    """Giữ nguyên điểm"""
+    CONFIRMED
+    """Sửa điểm qua yêu cầu sửa điểm"""
+    AMENDED
+}
+
+"""Trạng thái xác nhận đồng hướng dẫn"""
+enum CoSignStatus {
+    COSIGN_PENDING
+    COSIGN_SIGNED
+    COSIGN_DECLINED
+}
+
+"""Trạng thái đăng ký đề tài của sinh viên"""
+enum RegistrationStatus {
+    REGISTRATION_PENDING
+    REGISTRATION_ACCEPTED
+    REGISTRATION_DECLINED
+    REGISTRATION_WITHDRAWN
+}
+
+"""Lý do sinh viên không được ghép vào một nguyện vọng"""
+enum MatchRejectionReason {
+    """Đề tài đã đủ sinh viên được giáo viên xếp hạng cao hơn"""
+    MATCH_OUTRANKED
+    """Không nằm trong danh sách chấp nhận của giáo viên"""
+    MATCH_NOT_ACCEPTABLE
+    """Đề tài không còn chỗ"""
+    MATCH_NO_CAPACITY
+    """Đề tài không mở đăng ký"""
+    MATCH_TOPIC_CLOSED
+}
+
 """Giai đoạn đề tài"""
 enum TopicStage {
     STAGE_DACN
@@ -5975,6 +10056,8 @@ enum FilterOperator {
     IS_NULL
     IS_NOT_NULL
     BETWEEN
+    """Tìm kiếm toàn văn, chỉ dùng được trên các trường có chỉ mục FULLTEXT"""
+    SEARCH
 }
 
 enum LogicalCondition {
@@ -6176,11 +10259,23 @@ input FilterCriteriaInput {
     group: FilterGroupInput
 }
 
+enum SortDirection {
+    ASC
+    DESC
+}
+
+input SortInput {
+    field: String!
+    direction: SortDirection = ASC
+}
+
 input PaginationInput {
     page: Int = 1
     pageSize: Int = 20
     sortBy: String
     descending: Boolean = false
+    """Sắp xếp theo nhiều trường, theo thứ tự ưu tiên; khi có thì bỏ qua sortBy/descending"""
+    sort: [SortInput!]
 }
 
 input SearchRequestInput {
@@ -6190,8 +10285,54 @@ input SearchRequestInput {
 
 
 
+"""Loại kết quả tìm kiếm"""
+enum SearchType {
+    TOPIC
+    STUDENT
+    TEACHER
+    FILE
+}
+
+"""Một kết quả tìm kiếm toàn văn"""
+type SearchHit {
+    type: SearchType!
+    id: ID!
+    title: String!
+    subtitle: String
+    score: Float!
+}
+
+"""Một thao tác thay đổi dữ liệu đã được ghi vào nhật ký (không sửa, không xóa được)"""
+type AuditLogEntry {
+    id: ID!
+    at: Time!
+    service: String!
+    """Tên RPC, vd. UpdateGradeDefence"""
+    method: String!
+    entity: String!
+    entityId: ID
+    """Người thực hiện (id của người dùng trong học kỳ)"""
+    actor: String
+    role: String
+    semester: String
+    requestId: String
+    clientIp: String
+    """Các cột đã thay đổi, giá trị trước và sau"""
+    changes: [AuditChange!]!
+}
+
+"""Giá trị trước và sau của một cột; null khi bản ghi chưa có / đã bị xóa hẳn"""
+type AuditChange {
+    field: String!
+    before: String
+    after: String
+}
+
 type Query {
     _empty: String
+
+    """Tìm kiếm toàn văn đề tài, sinh viên, giảng viên và file theo quyền của người dùng; kết quả xếp theo độ phù hợp"""
+    globalSearch(query: String!, types: [SearchType!], limit: Int): [SearchHit!]!
 }
 
 type Mutation {
@@ -6218,17 +10359,47 @@ extend type Query {
 
     """Lấy danh sách học kỳ của sinh viên"""
     getMySemesters(search: SearchRequestInput): SemesterListResponse!
+
+    """Khung nộp bài hiện tại của sinh viên (có tính gia hạn)"""
+    getMySubmissionWindow(kind: SubmissionKind!): SubmissionWindow!
+
+    """Khung đăng ký đề tài của học kỳ hiện tại (null nếu chưa mở)"""
+    getMyRegistrationWindow: RegistrationWindow
+
+    """Các đề tài đã duyệt (APPROVED_2) của học kỳ hiện tại, có thể đăng ký"""
+    getRegistrableTopics: [StudentTopic!]!
+
+    """Các nguyện vọng đăng ký đề tài của sinh viên"""
+    getMyTopicRegistrations: [TopicRegistration!]!
+
+    """Các mốc tiến độ midterm áp dụng cho enrollment"""
+    getMyMidtermMilestones(enrollmentId: ID!): [MidtermMilestone!]!
+
+    """Các báo cáo mốc tiến độ của enrollment, kèm nhận xét"""
+    getMyMilestoneCheckins(enrollmentId: ID!): [MilestoneCheckin!]!
+
+    """Các đơn phúc khảo của sinh viên, kèm lịch sử xử lý"""
+    getMyGradeAppeals: [GradeAppeal!]!
 }
 
 extend type Mutation {
     """Cập nhật thông tin cá nhân sinh viên"""
     updateMyProfile(input: UpdateStudentProfileInput!): Student!
 
-    """Upload file midterm (chỉ sinh viên mới upload được)"""
+    """Upload file midterm (chỉ sinh viên mới upload được); file là URL do /api/v1/files trả về cho midterm của chính sinh viên"""
     uploadMidtermFile(input: UploadFileInput!): File!
 
-    """Upload file final (chỉ sinh viên mới upload được)"""
+    """Upload file final (chỉ sinh viên mới upload được); file là URL do /api/v1/files trả về cho final của chính sinh viên"""
     uploadFinalFile(input: UploadFileInput!): File!
+
+    """Đăng ký nguyện vọng đề tài (thứ tự ưu tiên giảm dần); thay thế các nguyện vọng đang chờ"""
+    registerTopicPreferences(topicIds: [ID!]!): [TopicRegistration!]!
+
+    """Nộp (hoặc nộp lại khi chưa đạt) báo cáo mốc tiến độ"""
+    submitMilestoneCheckin(input: SubmitMilestoneCheckinInput!): MilestoneCheckin!
+
+    """Phúc khảo điểm đã công bố, trong thời hạn phúc khảo của học kỳ"""
+    fileGradeAppeal(input: FileGradeAppealInput!): GradeAppeal!
 }
 
 # ============================================
@@ -6296,6 +10467,8 @@ type StudentTopic {
     status: TopicStatus!
     percentStage1: Int
     percentStage2: Int
+    maxStudents: Int!
+    requiredSkills: [String!]!
     createdAt: Time
     updatedAt: Time
 
@@ -6343,7 +10516,7 @@ type StudentGradeDefence {
     defenceCode: String!
     enrollmentCode: String!
     note: String
-    totalScore: Int
+    totalScore: Float
     createdAt: Time
     updatedAt: Time
 
@@ -6397,6 +10570,30 @@ input UpdateStudentProfileInput {
     username: String
 }
 
+input SubmitMilestoneCheckinInput {
+    enrollmentId: ID!
+    milestoneId: ID!
+    report: String!
+    attachment: MilestoneAttachmentInput
+}
+
+input MilestoneAttachmentInput {
+    title: String!
+    file: String!
+}
+
+input FileGradeAppealInput {
+    enrollmentId: ID!
+    component: GradeAppealComponent!
+    reason: String!
+    evidence: GradeAppealEvidenceInput
+}
+
+input GradeAppealEvidenceInput {
+    title: String!
+    file: String!
+}
+
 input UploadFileInput {
     title: String!
     file: String!
@@ -6421,6 +10618,15 @@ extend type Query {
     """Lấy chi tiết topic council mà giáo viên hướng dẫn"""
     getMySupervisedTopicCouncilDetail(id: ID!): SupervisorTopicCouncilAssignment
 
+    """Danh sách sinh viên đăng ký topic mình hướng dẫn (theo nguyện vọng)"""
+    getMyTopicApplicants(topicId: ID!): [TopicRegistration!]!
+
+    """Danh sách lời mời đồng hướng dẫn của topic"""
+    getTopicCoSigns(topicId: ID!): [TopicCoSign!]!
+
+    """Báo cáo mốc tiến độ của sinh viên mình hướng dẫn"""
+    getEnrollmentMilestoneCheckins(enrollmentId: ID!): [MilestoneCheckin!]!
+
     # === COUNCIL MEMBER QUERIES (role động qua Defence) ===
     # Query từ bảng nguồn: Defence WHERE teacher_code = current_user
 
@@ -6438,6 +10644,9 @@ extend type Query {
 
     """Lấy chi tiết grade review assignment"""
     getMyGradeReviewDetail(id: ID!): ReviewerGradeReview
+
+    """Đơn phúc khảo được giao cho giáo viên"""
+    getMyAssignedGradeAppeals(status: GradeAppealStatus): [GradeAppeal!]!
 }
 
 # ============================================
@@ -6471,6 +10680,8 @@ type SupervisorTopic {
     status: TopicStatus!
     percentStage1: Int
     percentStage2: Int
+    maxStudents: Int!
+    requiredSkills: [String!]!
     createdAt: Time
     updatedAt: Time
     createdBy: String
@@ -6484,6 +10695,7 @@ type SupervisorTopic {
     # Muốn lấy enrollments hoặc supervisors phải qua topicCouncils[]
     files: [File!]
     topicCouncils: [SupervisorTopicCouncil!]
+    statusHistory: [TopicStatusHistory!]!
 }
 
 """
@@ -6510,6 +10722,9 @@ type SupervisorEnrollment {
     final: Final
     gradeReview: GradeReview
     gradeDefences: [GradeDefence!]
+
+    """Báo cáo trùng lặp của bài nộp cuối kỳ (mặc định 5 bài giống nhất, tối đa 20)"""
+    similarityReport(topN: Int): SimilarityReport
 }
 
 """
@@ -6665,6 +10880,9 @@ type ReviewerEnrollment {
     midterm: Midterm
     final: Final
     gradeReview: GradeReview
+
+    """Báo cáo trùng lặp của bài nộp cuối kỳ (mặc định 5 bài giống nhất, tối đa 20)"""
+    similarityReport(topN: Int): SimilarityReport
 }
 
 """
@@ -6705,11 +10923,23 @@ extend type Mutation {
     # === SUPERVISOR MUTATIONS ===
     # Chỉ được chấm enrollment của topic council mình hướng dẫn
 
-    """Cập nhật điểm midterm cho sinh viên (verify qua Topic_council_supervisor)"""
-    gradeMidterm(enrollmentId: ID!, input: GradeMidtermInput!): Midterm!
+    """Đề xuất topic: tạo topic, topic council, người đề xuất là supervisor và mời đồng hướng dẫn"""
+    proposeTopic(input: ProposeTopicInput!): ProposeTopicResult!
+
+    """Đồng ý hoặc từ chối đồng hướng dẫn topic được mời"""
+    coSignTopic(topicId: ID!, accept: Boolean!): TopicCoSign!
+
+    """Gửi topic để duyệt (hoặc gửi lại sau khi bị từ chối); chỉ người tạo topic, sau khi mọi đồng hướng dẫn đã trả lời"""
+    submitTopic(id: ID!): Topic!
+
+    """Chấp nhận (tự tạo Enrollment) hoặc từ chối (bắt buộc reason) sinh viên đăng ký"""
+    decideTopicRegistration(registrationId: ID!, accept: Boolean!, reason: String): TopicRegistration!
 
-    """Phản hồi midterm cho sinh viên"""
-    feedbackMidterm(midtermId: ID!, feedback: String!): Midterm!
+    """Xếp hạng sinh viên đăng ký topic cho vòng ghép tự động; restricted = chỉ nhận sinh viên trong danh sách"""
+    rankTopicApplicants(topicId: ID!, studentIds: [ID!]!, restricted: Boolean): ApplicantRanking!
+
+    """Đánh giá đạt / không đạt báo cáo mốc tiến độ (verify qua Topic_council_supervisor); trạng thái midterm tính lại"""
+    reviewMilestoneCheckin(id: ID!, pass: Boolean!, comment: String): MilestoneCheckin!
 
     """Cập nhật điểm final cho sinh viên (verify qua Topic_council_supervisor)"""
     gradeFinal(enrollmentId: ID!, input: GradeFinalInput!): Final!
@@ -6729,16 +10959,19 @@ extend type Mutation {
     """Từ chối file final của sinh viên"""
     rejectFinalFile(fileId: ID!, reason: String): File!
 
+    """Gia hạn nộp bài cho sinh viên (verify qua Topic_council_supervisor)"""
+    grantDeadlineExtension(input: GrantDeadlineExtensionInput!): DeadlineExtension!
+
     # === COUNCIL MEMBER MUTATIONS ===
     # Chỉ được chấm grade defence cho defence assignment của mình
 
-    """Tạo grade defence (verify qua Defence)"""
+    """Tạo grade defence (verify qua Defence), tiêu chí lấy từ rubric template của ngành"""
     createGradeDefence(input: CreateGradeDefenceInput!): GradeDefence!
 
     """Cập nhật grade defence"""
     updateGradeDefence(id: ID!, input: UpdateGradeDefenceInput!): GradeDefence!
 
-    """Thêm criterion vào grade defence"""
+    """Thêm criterion vào grade defence không theo rubric (bị từ chối nếu grade defence đã theo rubric template)"""
     addGradeDefenceCriterion(input: CreateGradeDefenceCriterionInput!): GradeDefenceCriterion!
 
     """Cập nhật criterion"""
@@ -6747,6 +10980,12 @@ extend type Mutation {
     """Xóa criterion"""
     deleteGradeDefenceCriterion(id: ID!): Boolean!
 
+    """Yêu cầu sửa điểm đã khóa (thành viên hội đồng của enrollment, bắt buộc lý do)"""
+    requestGradeAmendment(input: RequestGradeAmendmentInput!): GradeAmendment!
+
+    """Xử lý đơn phúc khảo được giao: giữ nguyên điểm, hoặc tạo yêu cầu sửa điểm; đơn chuyển sang AMENDED khi yêu cầu được duyệt"""
+    resolveGradeAppeal(input: ResolveGradeAppealInput!): GradeAppeal!
+
     # === REVIEWER MUTATIONS ===
     # Chỉ được chấm grade review của mình
 
@@ -6763,59 +11002,104 @@ input UpdateTeacherProfileInput {
     username: String
 }
 
-input GradeMidtermInput {
-    grade: Int!
-    status: MidtermStatus!
-    feedback: String
-}
-
 input GradeFinalInput {
     supervisorGrade: Int!
     status: FinalStatus!
     notes: String
 }
 
+# totalScore do server tính từ các tiêu chí
 input CreateGradeDefenceInput {
     defenceCode: String!
     enrollmentCode: String!
     note: String
-    totalScore: Int
 }
 
 input UpdateGradeDefenceInput {
     note: String
-    totalScore: Int
+    """Phiên bản đã đọc; nếu bản ghi đã bị sửa thì trả lỗi CONFLICT"""
+    version: Int
+}
+
+input RequestGradeAmendmentInput {
+    target: GradeAmendmentTarget!
+    """Mã Final, GradeReview, Midterm hoặc GradeDefenceCriterion"""
+    targetId: ID!
+    newValue: Float!
+    reason: String!
+}
+
+input ResolveGradeAppealInput {
+    id: ID!
+    """false = giữ nguyên điểm"""
+    amend: Boolean!
+    note: String!
+    """Bắt buộc khi amend"""
+    newValue: Float
+    """Tiêu chí cần sửa, bắt buộc khi amend đơn DEFENCE"""
+    criterionId: ID
 }
 
 input CreateGradeDefenceCriterionInput {
     gradeDefenceCode: String!
     name: String!
-    score: String!
-    maxScore: String!
+    description: String
+    score: Float
+    maxScore: Float!
+    """Mặc định 1"""
+    weight: Float
 }
 
+# Tiêu chí lấy từ rubric chỉ được sửa score
 input UpdateGradeDefenceCriterionInput {
     name: String
-    score: String
-    maxScore: String
+    description: String
+    score: Float
+    maxScore: Float
+    weight: Float
+    """Phiên bản đã đọc; nếu bản ghi đã bị sửa thì trả lỗi CONFLICT"""
+    version: Int
 }
 
 input UpdateGradeReviewInput {
     reviewGrade: Int
     status: FinalStatus
     notes: String
+    """Phiên bản đã đọc; nếu bản ghi đã bị sửa thì trả lỗi CONFLICT"""
+    version: Int
+}
+
+input GrantDeadlineExtensionInput {
+    studentCode: ID!
+    kind: SubmissionKind!
+    dueAt: Time!
+    reason: String
+}
+
+input ProposeTopicInput {
+    title: String!
+    majorCode: ID!
+    stage: TopicStage!
+    maxStudents: Int!
+    requiredSkills: [String!]
+    """Giáo viên được mời đồng hướng dẫn"""
+    coSupervisorCodes: [ID!]
+    timeStart: Time!
+    timeEnd: Time!
 }
 `, BuiltIn: false},
 	{Name: "../schema/thesis.graphqls", Input: `type Midterm {
     id: ID!
     title: String!
     grade: Int
+    """Tính từ kết quả các mốc tiến độ"""
     status: MidtermStatus!
     feedback: String
     createdAt: Time
     updatedAt: Time
     createdBy: String
     updatedBy: String
+    version: Int!
 }
 
 type Enrollment {
@@ -6830,6 +11114,7 @@ type Enrollment {
     updatedAt: Time
     createdBy: String
     updatedBy: String
+    version: Int!
 
     student: Student # gv
     midterm: Midterm # all
@@ -6848,10 +11133,16 @@ type Topic {
     status: TopicStatus!
     percentStage1: Int
     percentStage2: Int
+    """Số sinh viên tối đa"""
+    maxStudents: Int!
+    requiredSkills: [String!]!
     createdAt: Time
     updatedAt: Time
     createdBy: String
     updatedBy: String
+    version: Int!
+    deletedAt: Time  # Khác null khi đã bị xóa (còn khôi phục được)
+    deletedBy: String
 
     # KHÔNG có major - tránh circular query: Topic → Major → Topics → ...
     # KHÔNG có semester - tránh circular query: Topic → Semester → Topics → ...
@@ -6860,6 +11151,24 @@ type Topic {
     # Muốn lấy enrollments hoặc supervisors phải qua topicCouncils[]
     files: [File!]
     topicCouncils: [TopicCouncil!]
+    """Lịch sử chuyển trạng thái (cũ nhất trước)"""
+    statusHistory: [TopicStatusHistory!]!
+}
+
+"""
+Một lần chuyển trạng thái topic: ai, khi nào, vì sao.
+Vòng đời: SUBMIT → TOPIC_PENDING → APPROVED_1 → APPROVED_2 → IN_PROGRESS → TOPIC_COMPLETED;
+TOPIC_PENDING/APPROVED_1 có thể bị REJECTED, topic bị từ chối được gửi lại về TOPIC_PENDING.
+"""
+type TopicStatusHistory {
+    id: ID!
+    topicCode: String!
+    """null với bản ghi tạo topic"""
+    fromStatus: TopicStatus
+    toStatus: TopicStatus!
+    reason: String
+    createdBy: String!
+    createdAt: Time!
 }
 
 type TopicCouncil {
@@ -6874,6 +11183,7 @@ type TopicCouncil {
     updatedAt: Time
     createdBy: String
     updatedBy: String
+    version: Int!
 
     topic: Topic
     council: Council
@@ -6889,6 +11199,7 @@ type TopicCouncilSupervisor {
     updatedAt: Time
     createdBy: String
     updatedBy: String
+    version: Int!
 
     teacher: Teacher
     topicCouncil: TopicCouncil
@@ -6899,7 +11210,8 @@ type Final {
     title: String!
     supervisorGrade: Int
     departmentGrade: Int
-    finalGrade: Int
+    """Tính theo grading policy (thang 10)"""
+    finalGrade: Float
     status: FinalStatus!
     notes: String
     completionDate: Time
@@ -6907,6 +11219,7 @@ type Final {
     updatedAt: Time
     createdBy: String
     updatedBy: String
+    version: Int!
 }
 
 type GradeReview {
@@ -6921,10 +11234,295 @@ type GradeReview {
     updatedAt: Time
     createdBy: String
     updatedBy: String
+    version: Int!
 
     teacher: Teacher
 }
 
+"""Khung thời gian nộp bài theo học kỳ + giai đoạn + loại bài nộp"""
+type SubmissionDeadline {
+    id: ID!
+    semesterCode: String!
+    stage: TopicStage!
+    kind: SubmissionKind!
+    opensAt: Time!
+    dueAt: Time!
+    """Số phút cho phép nộp trễ sau dueAt (file bị đánh dấu late)"""
+    graceMinutes: Int!
+    createdAt: Time
+    updatedAt: Time
+    createdBy: String
+    updatedBy: String
+}
+
+"""Gia hạn nộp bài cho một sinh viên (do giáo viên hướng dẫn cấp)"""
+type DeadlineExtension {
+    id: ID!
+    semesterCode: String!
+    stage: TopicStage!
+    kind: SubmissionKind!
+    studentCode: String!
+    dueAt: Time!
+    reason: String
+    createdAt: Time
+    updatedAt: Time
+    createdBy: String
+    updatedBy: String
+}
+
+"""Trạng thái khung nộp bài hiện tại của sinh viên"""
+type SubmissionWindow {
+    allowed: Boolean!
+    late: Boolean!
+    extended: Boolean!
+    stage: TopicStage!
+    opensAt: Time
+    dueAt: Time
+    closesAt: Time
+    reason: String
+}
+
+"""Lời mời đồng hướng dẫn một đề tài đề xuất"""
+type TopicCoSign {
+    id: ID!
+    topicCode: String!
+    teacherCode: String!
+    status: CoSignStatus!
+    createdAt: Time
+    updatedAt: Time
+}
+
+"""Kết quả đề xuất đề tài: topic, topic council và các lời mời đồng hướng dẫn"""
+type ProposeTopicResult {
+    topic: Topic!
+    topicCouncilId: ID!
+    coSigns: [TopicCoSign!]!
+}
+
+"""Khung thời gian đăng ký đề tài của học kỳ"""
+type RegistrationWindow {
+    id: ID!
+    semesterCode: String!
+    opensAt: Time!
+    closesAt: Time!
+    """Số nguyện vọng tối đa mỗi sinh viên"""
+    maxChoices: Int!
+    createdAt: Time
+    updatedAt: Time
+    createdBy: String
+    updatedBy: String
+}
+
+"""Một nguyện vọng đăng ký đề tài của sinh viên"""
+type TopicRegistration {
+    id: ID!
+    topicCode: String!
+    studentCode: String!
+    semesterCode: String!
+    """1 = nguyện vọng cao nhất"""
+    rank: Int!
+    status: RegistrationStatus!
+    """Lý do giáo viên từ chối"""
+    reason: String
+    decidedBy: String
+    decidedAt: Time
+    """Enrollment được tạo khi giáo viên chấp nhận"""
+    enrollmentCode: String
+    createdAt: Time
+    updatedAt: Time
+}
+
+"""Kết quả ghép sinh viên - đề tài (deferred acceptance, sinh viên đề nghị)"""
+type TopicMatchingResult {
+    semesterCode: String!
+    studentsTotal: Int!
+    matchedTotal: Int!
+    assignments: [MatchAssignment!]!
+    unmatched: [UnmatchedStudent!]!
+}
+
+type MatchAssignment {
+    studentCode: String!
+    topicCode: String!
+    topicCouncilCode: String!
+    """Thứ hạng nguyện vọng của sinh viên cho đề tài này"""
+    choice: Int!
+    """Enrollment được tạo khi commit"""
+    enrollmentCode: String
+}
+
+"""Sinh viên chưa được ghép, kèm lý do cho từng nguyện vọng"""
+type UnmatchedStudent {
+    studentCode: String!
+    rejections: [MatchRejection!]!
+}
+
+type MatchRejection {
+    topicCode: String!
+    choice: Int!
+    reason: MatchRejectionReason!
+}
+
+"""Xếp hạng sinh viên đăng ký của một đề tài"""
+type ApplicantRanking {
+    topicCode: String!
+    studentCodes: [String!]!
+    """Chỉ sinh viên trong danh sách mới được ghép"""
+    restricted: Boolean!
+}
+
+"""Mốc tiến độ midterm của học kỳ + giai đoạn (đề cương, tổng quan tài liệu, prototype, bản nháp...)"""
+type MidtermMilestone {
+    id: ID!
+    semesterCode: String!
+    stage: TopicStage!
+    title: String!
+    description: String
+    """Thứ tự trong học kỳ + giai đoạn"""
+    sequence: Int!
+    """Hạn nộp báo cáo, null = không giới hạn"""
+    dueAt: Time
+    createdAt: Time
+    updatedAt: Time
+    createdBy: String
+    updatedBy: String
+    version: Int!
+}
+
+"""Báo cáo của một enrollment cho một mốc tiến độ"""
+type MilestoneCheckin {
+    id: ID!
+    milestoneCode: String!
+    enrollmentCode: String!
+    report: String!
+    """File đính kèm (table MILESTONE_CHECKIN)"""
+    fileCode: String
+    result: MilestoneResult!
+    """Nhận xét của giáo viên hướng dẫn"""
+    comment: String
+    reviewedBy: String
+    reviewedAt: Time
+    submittedAt: Time
+    updatedAt: Time
+}
+
+"""Trạng thái khóa / công bố điểm của học kỳ"""
+type SemesterGradeStatus {
+    semesterCode: String!
+    """Đã khóa: chỉ sửa điểm qua yêu cầu sửa điểm được duyệt"""
+    locked: Boolean!
+    lockedAt: Time
+    lockedBy: String
+    """Đã công bố: sinh viên xem được điểm"""
+    published: Boolean!
+    publishedAt: Time
+    publishedBy: String
+    """Số ngày sau khi công bố sinh viên được phúc khảo"""
+    appealWindowDays: Int!
+    """Hạn phúc khảo, có khi đã công bố"""
+    appealDeadline: Time
+}
+
+"""Yêu cầu sửa điểm đã khóa"""
+type GradeAmendment {
+    id: ID!
+    target: GradeAmendmentTarget!
+    """Mã Final, GradeReview, Midterm hoặc GradeDefenceCriterion"""
+    targetId: String!
+    enrollmentCode: String!
+    """Có với điểm của thesis service"""
+    semesterCode: String
+    """Có với điểm hội đồng"""
+    councilCode: String
+    oldValue: Float
+    newValue: Float!
+    reason: String!
+    status: GradeAmendmentStatus!
+    requestedBy: String!
+    decidedBy: String
+    decidedAt: Time
+    decisionNote: String
+    createdAt: Time
+    updatedAt: Time
+}
+
+"""Đơn phúc khảo điểm của sinh viên"""
+type GradeAppeal {
+    id: ID!
+    enrollmentCode: String!
+    studentCode: String!
+    semesterCode: String!
+    component: GradeAppealComponent!
+    reason: String!
+    """File minh chứng (table GRADE_APPEAL)"""
+    evidenceFileCode: String
+    status: GradeAppealStatus!
+    reviewerCode: String
+    resolutionNote: String
+    """Yêu cầu sửa điểm tạo khi phúc khảo được chấp nhận"""
+    amendmentCode: String
+    resolvedBy: String
+    resolvedAt: Time
+    createdAt: Time
+    updatedAt: Time
+    """Lịch sử xử lý, cũ nhất trước"""
+    history: [GradeAppealEvent!]!
+}
+
+type GradeAppealEvent {
+    status: GradeAppealStatus!
+    note: String
+    actor: String!
+    createdAt: Time
+}
+
+"""Cách tính điểm tổng kết theo ngành + học kỳ + giai đoạn"""
+type GradingPolicy {
+    id: ID!
+    majorCode: String!
+    semesterCode: String!
+    stage: TopicStage!
+    """Trọng số tương đối, 0 = không dùng thành phần này"""
+    supervisorWeight: Float!
+    reviewerWeight: Float!
+    councilWeight: Float!
+    rounding: GradeRounding!
+    """Bước làm tròn, ví dụ 0.5 hoặc 0.1"""
+    roundingStep: Float!
+    passThreshold: Float!
+    """Chênh lệch tối đa giữa điểm các thành viên hội đồng, 0 = không kiểm tra"""
+    maxCouncilSpread: Float!
+    createdAt: Time
+    updatedAt: Time
+    createdBy: String
+    updatedBy: String
+}
+
+type GradeComponent {
+    """supervisor, reviewer hoặc council"""
+    name: String!
+    score: Float
+    weight: Float!
+}
+
+"""Chi tiết cách tính điểm tổng kết"""
+type FinalGradeBreakdown {
+    enrollmentCode: String!
+    policyCode: String!
+    components: [GradeComponent!]!
+    councilSpread: Float
+    """Điểm hội đồng chênh lệch quá mức cho phép"""
+    needsReconciliation: Boolean!
+    """Thành phần còn thiếu: supervisor, reviewer, council hoặc council:<mã giáo viên>"""
+    missing: [String!]!
+    rawGrade: Float
+    finalGrade: Float
+    status: FinalStatus!
+    """Giải thích từng bước tính"""
+    steps: [String!]!
+    finalized: Boolean!
+    final: Final
+}
 `, BuiltIn: false},
 	{Name: "../schema/user.graphqls", Input: `
 type Student {
@@ -6940,6 +11538,9 @@ type Student {
     updatedAt: Time
     createdBy: String
     updatedBy: String
+    version: Int!
+    deletedAt: Time  # Khác null khi đã bị xóa (còn khôi phục được)
+    deletedBy: String
 
     # KHÔNG có major - tránh circular query: Student → Major → Topics → ... → Student
     # KHÔNG có semester - tránh circular query: Student → Semester → Students → ...
@@ -6958,6 +11559,9 @@ type Teacher {
     updatedAt: Time
     createdBy: String
     updatedBy: String
+    version: Int!
+    deletedAt: Time  # Khác null khi đã bị xóa (còn khôi phục được)
+    deletedBy: String
 
     # KHÔNG có major - tránh circular query: Teacher → Major → Topics → ...
     # KHÔNG có semester - tránh circular query: Teacher → Semester → Teachers → ...
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"sync"
 	"sync/atomic"
 	"thaily/src/graph/model"
 	"time"
@@ -22,54 +23,94 @@ type MutationResolver interface {
 	CreateTeacher(ctx context.Context, input model.CreateTeacherInput) (*model.Teacher, error)
 	UpdateTeacher(ctx context.Context, id string, input model.UpdateTeacherInput) (*model.Teacher, error)
 	DeleteTeacher(ctx context.Context, id string) (bool, error)
+	RestoreTeacher(ctx context.Context, id string) (*model.Teacher, error)
 	CreateStudent(ctx context.Context, input model.CreateStudentInput) (*model.Student, error)
 	UpdateStudent(ctx context.Context, id string, input model.UpdateStudentInput) (*model.Student, error)
 	DeleteStudent(ctx context.Context, id string) (bool, error)
+	RestoreStudent(ctx context.Context, id string) (*model.Student, error)
 	CreateSemester(ctx context.Context, input model.CreateSemesterInput) (*model.Semester, error)
 	UpdateSemester(ctx context.Context, id string, input model.UpdateSemesterInput) (*model.Semester, error)
 	DeleteSemester(ctx context.Context, id string) (bool, error)
+	RestoreSemester(ctx context.Context, id string) (*model.Semester, error)
 	CreateMajor(ctx context.Context, input model.CreateMajorInput) (*model.Major, error)
 	UpdateMajor(ctx context.Context, id string, input model.UpdateMajorInput) (*model.Major, error)
 	DeleteMajor(ctx context.Context, id string) (bool, error)
+	RestoreMajor(ctx context.Context, id string) (*model.Major, error)
 	CreateFaculty(ctx context.Context, input model.CreateFacultyInput) (*model.Faculty, error)
 	UpdateFaculty(ctx context.Context, id string, input model.UpdateFacultyInput) (*model.Faculty, error)
 	DeleteFaculty(ctx context.Context, id string) (bool, error)
 	ApproveCouncil(ctx context.Context, id string, timeStart time.Time) (*model.Council, error)
 	UpdateCouncil(ctx context.Context, id string, input model.UpdateCouncilInput) (*model.Council, error)
 	DeleteCouncil(ctx context.Context, id string) (bool, error)
-	ApproveTopic(ctx context.Context, id string) (*model.Topic, error)
-	RejectTopic(ctx context.Context, id string, reason *string) (*model.Topic, error)
+	RestoreCouncil(ctx context.Context, id string) (*model.Council, error)
+	ApproveTopic(ctx context.Context, id string, note *string) (*model.Topic, error)
+	RejectTopic(ctx context.Context, id string, reason string) (*model.Topic, error)
+	StartTopic(ctx context.Context, id string) (*model.Topic, error)
+	CompleteTopic(ctx context.Context, id string) (*model.Topic, error)
 	UpdateTopic(ctx context.Context, id string, input model.UpdateTopicInput) (*model.Topic, error)
 	DeleteTopic(ctx context.Context, id string) (bool, error)
+	RestoreTopic(ctx context.Context, id string) (*model.Topic, error)
+	SetSubmissionDeadline(ctx context.Context, input model.SetSubmissionDeadlineInput) (*model.SubmissionDeadline, error)
+	CreateMidtermMilestone(ctx context.Context, input model.CreateMidtermMilestoneInput) (*model.MidtermMilestone, error)
+	UpdateMidtermMilestone(ctx context.Context, id string, input model.UpdateMidtermMilestoneInput) (*model.MidtermMilestone, error)
+	DeleteMidtermMilestone(ctx context.Context, id string) (bool, error)
+	SetRegistrationWindow(ctx context.Context, input model.SetRegistrationWindowInput) (*model.RegistrationWindow, error)
+	CommitTopicMatching(ctx context.Context, semesterCode string) (*model.TopicMatchingResult, error)
+	CommitDefenceSchedule(ctx context.Context, input model.DefenceScheduleInput) (*model.DefenceSchedule, error)
+	CreateRubricTemplate(ctx context.Context, input model.CreateRubricTemplateInput) (*model.RubricTemplate, error)
+	UpdateRubricTemplate(ctx context.Context, id string, input model.UpdateRubricTemplateInput) (*model.RubricTemplate, error)
+	DeleteRubricTemplate(ctx context.Context, id string) (bool, error)
+	SetGradingPolicy(ctx context.Context, input model.SetGradingPolicyInput) (*model.GradingPolicy, error)
+	DeleteGradingPolicy(ctx context.Context, id string) (bool, error)
+	FinalizeFinalGrade(ctx context.Context, enrollmentID string) (*model.FinalGradeBreakdown, error)
+	LockSemesterGrades(ctx context.Context, semesterCode string) (*model.SemesterGradeStatus, error)
+	PublishSemesterGrades(ctx context.Context, semesterCode string) (*model.SemesterGradeStatus, error)
+	LockCouncilGrades(ctx context.Context, councilID string) (*model.Council, error)
+	PublishCouncilGrades(ctx context.Context, councilID string) (*model.Council, error)
+	DecideGradeAmendment(ctx context.Context, input model.DecideGradeAmendmentInput) (*model.GradeAmendment, error)
+	SetGradeAppealWindow(ctx context.Context, semesterCode string, days int32) (*model.SemesterGradeStatus, error)
+	AssignGradeAppeal(ctx context.Context, id string, reviewerID string) (*model.GradeAppeal, error)
 	CreateCouncil(ctx context.Context, input model.CreateCouncilInput) (*model.Council, error)
 	UpdateDepartmentCouncil(ctx context.Context, id string, input model.UpdateCouncilInput) (*model.Council, error)
 	AddDefenceToCouncil(ctx context.Context, input model.CreateDefenceInput) (*model.Defence, error)
 	RemoveDefenceFromCouncil(ctx context.Context, id string) (bool, error)
-	ApproveTopicStage1(ctx context.Context, id string) (*model.Topic, error)
-	RejectTopicStage1(ctx context.Context, id string, reason *string) (*model.Topic, error)
-	AssignTopicToCouncil(ctx context.Context, topicCouncilID string, councilID string) (*model.TopicCouncil, error)
+	ApproveTopicStage1(ctx context.Context, id string, note *string) (*model.Topic, error)
+	RejectTopicStage1(ctx context.Context, id string, reason string) (*model.Topic, error)
+	AssignTopicToCouncil(ctx context.Context, topicCouncilID string, councilID string, overrideReason *string) (*model.TopicCouncil, error)
+	OverrideCouncilConflicts(ctx context.Context, councilID string, reason string) (*model.CouncilValidation, error)
 	UpdateMyProfile(ctx context.Context, input model.UpdateStudentProfileInput) (*model.Student, error)
 	UploadMidtermFile(ctx context.Context, input model.UploadFileInput) (*model.File, error)
 	UploadFinalFile(ctx context.Context, input model.UploadFileInput) (*model.File, error)
+	RegisterTopicPreferences(ctx context.Context, topicIds []string) ([]*model.TopicRegistration, error)
+	SubmitMilestoneCheckin(ctx context.Context, input model.SubmitMilestoneCheckinInput) (*model.MilestoneCheckin, error)
+	FileGradeAppeal(ctx context.Context, input model.FileGradeAppealInput) (*model.GradeAppeal, error)
 	UpdateMyTeacherProfile(ctx context.Context, input model.UpdateTeacherProfileInput) (*model.Teacher, error)
-	GradeMidterm(ctx context.Context, enrollmentID string, input model.GradeMidtermInput) (*model.Midterm, error)
-	FeedbackMidterm(ctx context.Context, midtermID string, feedback string) (*model.Midterm, error)
+	ProposeTopic(ctx context.Context, input model.ProposeTopicInput) (*model.ProposeTopicResult, error)
+	CoSignTopic(ctx context.Context, topicID string, accept bool) (*model.TopicCoSign, error)
+	SubmitTopic(ctx context.Context, id string) (*model.Topic, error)
+	DecideTopicRegistration(ctx context.Context, registrationID string, accept bool, reason *string) (*model.TopicRegistration, error)
+	RankTopicApplicants(ctx context.Context, topicID string, studentIds []string, restricted *bool) (*model.ApplicantRanking, error)
+	ReviewMilestoneCheckin(ctx context.Context, id string, pass bool, comment *string) (*model.MilestoneCheckin, error)
 	GradeFinal(ctx context.Context, enrollmentID string, input model.GradeFinalInput) (*model.Final, error)
 	FeedbackFinal(ctx context.Context, finalID string, notes string) (*model.Final, error)
 	ApproveMidtermFile(ctx context.Context, fileID string) (*model.File, error)
 	RejectMidtermFile(ctx context.Context, fileID string, reason *string) (*model.File, error)
 	ApproveFinalFile(ctx context.Context, fileID string) (*model.File, error)
 	RejectFinalFile(ctx context.Context, fileID string, reason *string) (*model.File, error)
+	GrantDeadlineExtension(ctx context.Context, input model.GrantDeadlineExtensionInput) (*model.DeadlineExtension, error)
 	CreateGradeDefence(ctx context.Context, input model.CreateGradeDefenceInput) (*model.GradeDefence, error)
 	UpdateGradeDefence(ctx context.Context, id string, input model.UpdateGradeDefenceInput) (*model.GradeDefence, error)
 	AddGradeDefenceCriterion(ctx context.Context, input model.CreateGradeDefenceCriterionInput) (*model.GradeDefenceCriterion, error)
 	UpdateGradeDefenceCriterion(ctx context.Context, id string, input model.UpdateGradeDefenceCriterionInput) (*model.GradeDefenceCriterion, error)
 	DeleteGradeDefenceCriterion(ctx context.Context, id string) (bool, error)
+	RequestGradeAmendment(ctx context.Context, input model.RequestGradeAmendmentInput) (*model.GradeAmendment, error)
+	ResolveGradeAppeal(ctx context.Context, input model.ResolveGradeAppealInput) (*model.GradeAppeal, error)
 	UpdateGradeReview(ctx context.Context, id string, input model.UpdateGradeReviewInput) (*model.ReviewerGradeReview, error)
 	CompleteGradeReview(ctx context.Context, id string) (*model.ReviewerGradeReview, error)
 }
 type QueryResolver interface {
 	Empty(ctx context.Context) (*string, error)
+	GlobalSearch(ctx context.Context, query string, types []model.SearchType, limit *int32) ([]*model.SearchHit, error)
 	GetListTeachers(ctx context.Context, search model.SearchRequestInput) (*model.TeacherListResponse, error)
 	GetListStudents(ctx context.Context, search model.SearchRequestInput) (*model.StudentListResponse, error)
 	GetStudentDetail(ctx context.Context, id string) (*model.Student, error)
@@ -85,6 +126,19 @@ type QueryResolver interface {
 	GetCouncilDetail(ctx context.Context, id string) (*model.Council, error)
 	GetDefencesByCouncil(ctx context.Context, councilID string) (*model.DefenceListResponse, error)
 	GetAllGradeDefences(ctx context.Context, search model.SearchRequestInput) (*model.GradeDefenceListResponse, error)
+	GetSubmissionDeadlines(ctx context.Context, semesterCode string) ([]*model.SubmissionDeadline, error)
+	GetMidtermMilestones(ctx context.Context, semesterCode string, stage *model.TopicStage) ([]*model.MidtermMilestone, error)
+	GetRegistrationWindow(ctx context.Context, semesterCode string) (*model.RegistrationWindow, error)
+	GetTopicRegistrations(ctx context.Context, semesterCode string) ([]*model.TopicRegistration, error)
+	PreviewTopicMatching(ctx context.Context, semesterCode string) (*model.TopicMatchingResult, error)
+	PreviewDefenceSchedule(ctx context.Context, input model.DefenceScheduleInput) (*model.DefenceSchedule, error)
+	GetRubricTemplates(ctx context.Context, majorCode *string, stage *model.TopicStage) ([]*model.RubricTemplate, error)
+	GetGradingPolicies(ctx context.Context, semesterCode string) ([]*model.GradingPolicy, error)
+	PreviewFinalGrade(ctx context.Context, enrollmentID string) (*model.FinalGradeBreakdown, error)
+	GetSemesterGradeStatus(ctx context.Context, semesterCode string) (*model.SemesterGradeStatus, error)
+	GetGradeAmendments(ctx context.Context, semesterCode string, status *model.GradeAmendmentStatus) ([]*model.GradeAmendment, error)
+	GetGradeAppeals(ctx context.Context, semesterCode string, status *model.GradeAppealStatus) ([]*model.GradeAppeal, error)
+	AuditLog(ctx context.Context, entity *string, entityID *string, actor *string, from *time.Time, to *time.Time) ([]*model.AuditLogEntry, error)
 	GetDepartmentTeachers(ctx context.Context, search model.SearchRequestInput) ([]*model.Teacher, error)
 	GetDepartmentStudents(ctx context.Context, search model.SearchRequestInput) ([]*model.Student, error)
 	GetDepartmentSemesters(ctx context.Context, search model.SearchRequestInput) ([]*model.Semester, error)
@@ -98,17 +152,30 @@ type QueryResolver interface {
 	GetDepartmentCouncilDetail(ctx context.Context, id string) (*model.Council, error)
 	GetDepartmentDefences(ctx context.Context, councilID string) ([]*model.Defence, error)
 	GetDepartmentGradeDefences(ctx context.Context, search model.SearchRequestInput) ([]*model.GradeDefence, error)
+	ValidateCouncil(ctx context.Context, councilID string) (*model.CouncilValidation, error)
+	GetCouncilConflictOverrides(ctx context.Context, councilID string) ([]*model.CouncilConflictOverride, error)
 	GetMyProfile(ctx context.Context) (*model.Student, error)
 	GetMyEnrollments(ctx context.Context, search *model.SearchRequestInput) (*model.StudentEnrollmentListResponse, error)
 	GetMyEnrollmentDetail(ctx context.Context, id string) (*model.StudentEnrollment, error)
 	GetMySemesters(ctx context.Context, search *model.SearchRequestInput) (*model.SemesterListResponse, error)
+	GetMySubmissionWindow(ctx context.Context, kind model.SubmissionKind) (*model.SubmissionWindow, error)
+	GetMyRegistrationWindow(ctx context.Context) (*model.RegistrationWindow, error)
+	GetRegistrableTopics(ctx context.Context) ([]*model.StudentTopic, error)
+	GetMyTopicRegistrations(ctx context.Context) ([]*model.TopicRegistration, error)
+	GetMyMidtermMilestones(ctx context.Context, enrollmentID string) ([]*model.MidtermMilestone, error)
+	GetMyMilestoneCheckins(ctx context.Context, enrollmentID string) ([]*model.MilestoneCheckin, error)
+	GetMyGradeAppeals(ctx context.Context) ([]*model.GradeAppeal, error)
 	GetMyTeacherProfile(ctx context.Context) (*model.Teacher, error)
 	GetMySupervisedTopicCouncils(ctx context.Context, search *model.SearchRequestInput) (*model.SupervisorTopicCouncilAssignmentListResponse, error)
 	GetMySupervisedTopicCouncilDetail(ctx context.Context, id string) (*model.SupervisorTopicCouncilAssignment, error)
+	GetMyTopicApplicants(ctx context.Context, topicID string) ([]*model.TopicRegistration, error)
+	GetTopicCoSigns(ctx context.Context, topicID string) ([]*model.TopicCoSign, error)
+	GetEnrollmentMilestoneCheckins(ctx context.Context, enrollmentID string) ([]*model.MilestoneCheckin, error)
 	GetMyDefences(ctx context.Context, search *model.SearchRequestInput) (*model.CouncilDefenceListResponse, error)
 	GetMyDefenceDetail(ctx context.Context, id string) (*model.CouncilDefence, error)
 	GetMyGradeReviews(ctx context.Context, search *model.SearchRequestInput) (*model.ReviewerGradeReviewListResponse, error)
 	GetMyGradeReviewDetail(ctx context.Context, id string) (*model.ReviewerGradeReview, error)
+	GetMyAssignedGradeAppeals(ctx context.Context, status *model.GradeAppealStatus) ([]*model.GradeAppeal, error)
 }
 type SubscriptionResolver interface {
 	Empty(ctx context.Context) (<-chan *string, error)
@@ -186,6 +253,11 @@ func (ec *executionContext) field_Mutation_approveTopicStage1_args(ctx context.C
 		return nil, err
 	}
 	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "note", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["note"] = arg1
 	return args, nil
 }
 
@@ -197,6 +269,27 @@ func (ec *executionContext) field_Mutation_approveTopic_args(ctx context.Context
 		return nil, err
 	}
 	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "note", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["note"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_assignGradeAppeal_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "reviewerId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["reviewerId"] = arg1
 	return args, nil
 }
 
@@ -213,6 +306,49 @@ func (ec *executionContext) field_Mutation_assignTopicToCouncil_args(ctx context
 		return nil, err
 	}
 	args["councilId"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "overrideReason", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["overrideReason"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_coSignTopic_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "topicId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["topicId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "accept", ec.unmarshalNBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["accept"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_commitDefenceSchedule_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNDefenceScheduleInput2thailyᚋsrcᚋgraphᚋmodelᚐDefenceScheduleInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_commitTopicMatching_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "semesterCode", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["semesterCode"] = arg0
 	return args, nil
 }
 
@@ -227,6 +363,17 @@ func (ec *executionContext) field_Mutation_completeGradeReview_args(ctx context.
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_completeTopic_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_createCouncil_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -271,6 +418,28 @@ func (ec *executionContext) field_Mutation_createMajor_args(ctx context.Context,
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_createMidtermMilestone_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreateMidtermMilestoneInput2thailyᚋsrcᚋgraphᚋmodelᚐCreateMidtermMilestoneInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_createRubricTemplate_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreateRubricTemplateInput2thailyᚋsrcᚋgraphᚋmodelᚐCreateRubricTemplateInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_createSemester_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -304,6 +473,38 @@ func (ec *executionContext) field_Mutation_createTeacher_args(ctx context.Contex
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_decideGradeAmendment_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNDecideGradeAmendmentInput2thailyᚋsrcᚋgraphᚋmodelᚐDecideGradeAmendmentInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_decideTopicRegistration_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "registrationId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["registrationId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "accept", ec.unmarshalNBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["accept"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "reason", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["reason"] = arg2
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_deleteCouncil_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -337,6 +538,17 @@ func (ec *executionContext) field_Mutation_deleteGradeDefenceCriterion_args(ctx
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_deleteGradingPolicy_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_deleteMajor_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -348,6 +560,28 @@ func (ec *executionContext) field_Mutation_deleteMajor_args(ctx context.Context,
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_deleteMidtermMilestone_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_deleteRubricTemplate_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_deleteSemester_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -408,23 +642,18 @@ func (ec *executionContext) field_Mutation_feedbackFinal_args(ctx context.Contex
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_feedbackMidterm_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_fileGradeAppeal_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "midtermId", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["midtermId"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "feedback", ec.unmarshalNString2string)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNFileGradeAppealInput2thailyᚋsrcᚋgraphᚋmodelᚐFileGradeAppealInput)
 	if err != nil {
 		return nil, err
 	}
-	args["feedback"] = arg1
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_gradeFinal_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_finalizeFinalGrade_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "enrollmentId", ec.unmarshalNID2string)
@@ -432,15 +661,10 @@ func (ec *executionContext) field_Mutation_gradeFinal_args(ctx context.Context,
 		return nil, err
 	}
 	args["enrollmentId"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNGradeFinalInput2thailyᚋsrcᚋgraphᚋmodelᚐGradeFinalInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_gradeMidterm_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_gradeFinal_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "enrollmentId", ec.unmarshalNID2string)
@@ -448,7 +672,7 @@ func (ec *executionContext) field_Mutation_gradeMidterm_args(ctx context.Context
 		return nil, err
 	}
 	args["enrollmentId"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNGradeMidtermInput2thailyᚋsrcᚋgraphᚋmodelᚐGradeMidtermInput)
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNGradeFinalInput2thailyᚋsrcᚋgraphᚋmodelᚐGradeFinalInput)
 	if err != nil {
 		return nil, err
 	}
@@ -456,47 +680,48 @@ func (ec *executionContext) field_Mutation_gradeMidterm_args(ctx context.Context
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_rejectFinalFile_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_grantDeadlineExtension_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "fileId", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["fileId"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "reason", ec.unmarshalOString2ᚖstring)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNGrantDeadlineExtensionInput2thailyᚋsrcᚋgraphᚋmodelᚐGrantDeadlineExtensionInput)
 	if err != nil {
 		return nil, err
 	}
-	args["reason"] = arg1
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_rejectMidtermFile_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_lockCouncilGrades_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "fileId", ec.unmarshalNID2string)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "councilId", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["fileId"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "reason", ec.unmarshalOString2ᚖstring)
+	args["councilId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_lockSemesterGrades_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "semesterCode", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["reason"] = arg1
+	args["semesterCode"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_rejectTopicStage1_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_overrideCouncilConflicts_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "councilId", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["id"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "reason", ec.unmarshalOString2ᚖstring)
+	args["councilId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "reason", ec.unmarshalNString2string)
 	if err != nil {
 		return nil, err
 	}
@@ -504,98 +729,104 @@ func (ec *executionContext) field_Mutation_rejectTopicStage1_args(ctx context.Co
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_rejectTopic_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_proposeTopic_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNProposeTopicInput2thailyᚋsrcᚋgraphᚋmodelᚐProposeTopicInput)
 	if err != nil {
 		return nil, err
 	}
-	args["id"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "reason", ec.unmarshalOString2ᚖstring)
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_publishCouncilGrades_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "councilId", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["reason"] = arg1
+	args["councilId"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_removeDefenceFromCouncil_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_publishSemesterGrades_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "semesterCode", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["id"] = arg0
+	args["semesterCode"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_updateCouncil_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_rankTopicApplicants_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "topicId", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["id"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateCouncilInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateCouncilInput)
+	args["topicId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "studentIds", ec.unmarshalNID2ᚕstringᚄ)
 	if err != nil {
 		return nil, err
 	}
-	args["input"] = arg1
+	args["studentIds"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "restricted", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["restricted"] = arg2
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_updateDepartmentCouncil_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_registerTopicPreferences_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateCouncilInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateCouncilInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "topicIds", ec.unmarshalNID2ᚕstringᚄ)
 	if err != nil {
 		return nil, err
 	}
-	args["input"] = arg1
+	args["topicIds"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_updateFaculty_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_rejectFinalFile_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "fileId", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["id"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateFacultyInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateFacultyInput)
+	args["fileId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "reason", ec.unmarshalOString2ᚖstring)
 	if err != nil {
 		return nil, err
 	}
-	args["input"] = arg1
+	args["reason"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_updateGradeDefenceCriterion_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_rejectMidtermFile_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "fileId", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["id"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateGradeDefenceCriterionInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateGradeDefenceCriterionInput)
+	args["fileId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "reason", ec.unmarshalOString2ᚖstring)
 	if err != nil {
 		return nil, err
 	}
-	args["input"] = arg1
+	args["reason"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_updateGradeDefence_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_rejectTopicStage1_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
@@ -603,15 +834,15 @@ func (ec *executionContext) field_Mutation_updateGradeDefence_args(ctx context.C
 		return nil, err
 	}
 	args["id"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateGradeDefenceInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateGradeDefenceInput)
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "reason", ec.unmarshalNString2string)
 	if err != nil {
 		return nil, err
 	}
-	args["input"] = arg1
+	args["reason"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_updateGradeReview_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_rejectTopic_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
@@ -619,15 +850,15 @@ func (ec *executionContext) field_Mutation_updateGradeReview_args(ctx context.Co
 		return nil, err
 	}
 	args["id"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateGradeReviewInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateGradeReviewInput)
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "reason", ec.unmarshalNString2string)
 	if err != nil {
 		return nil, err
 	}
-	args["input"] = arg1
+	args["reason"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_updateMajor_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_removeDefenceFromCouncil_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
@@ -635,18 +866,13 @@ func (ec *executionContext) field_Mutation_updateMajor_args(ctx context.Context,
 		return nil, err
 	}
 	args["id"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateMajorInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateMajorInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_updateMyProfile_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_requestGradeAmendment_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateStudentProfileInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateStudentProfileInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNRequestGradeAmendmentInput2thailyᚋsrcᚋgraphᚋmodelᚐRequestGradeAmendmentInput)
 	if err != nil {
 		return nil, err
 	}
@@ -654,10 +880,10 @@ func (ec *executionContext) field_Mutation_updateMyProfile_args(ctx context.Cont
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_updateMyTeacherProfile_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_resolveGradeAppeal_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateTeacherProfileInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateTeacherProfileInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNResolveGradeAppealInput2thailyᚋsrcᚋgraphᚋmodelᚐResolveGradeAppealInput)
 	if err != nil {
 		return nil, err
 	}
@@ -665,7 +891,7 @@ func (ec *executionContext) field_Mutation_updateMyTeacherProfile_args(ctx conte
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_updateSemester_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_restoreCouncil_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
@@ -673,15 +899,10 @@ func (ec *executionContext) field_Mutation_updateSemester_args(ctx context.Conte
 		return nil, err
 	}
 	args["id"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateSemesterInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateSemesterInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_updateStudent_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_restoreMajor_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
@@ -689,15 +910,10 @@ func (ec *executionContext) field_Mutation_updateStudent_args(ctx context.Contex
 		return nil, err
 	}
 	args["id"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateStudentInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateStudentInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_updateTeacher_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_restoreSemester_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
@@ -705,15 +921,10 @@ func (ec *executionContext) field_Mutation_updateTeacher_args(ctx context.Contex
 		return nil, err
 	}
 	args["id"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateTeacherInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateTeacherInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_updateTopic_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_restoreStudent_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
@@ -721,125 +932,124 @@ func (ec *executionContext) field_Mutation_updateTopic_args(ctx context.Context,
 		return nil, err
 	}
 	args["id"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateTopicInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateTopicInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_uploadFinalFile_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_restoreTeacher_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUploadFileInput2thailyᚋsrcᚋgraphᚋmodelᚐUploadFileInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["input"] = arg0
+	args["id"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_uploadMidtermFile_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_restoreTopic_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUploadFileInput2thailyᚋsrcᚋgraphᚋmodelᚐUploadFileInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["input"] = arg0
+	args["id"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_reviewMilestoneCheckin_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["name"] = arg0
-	return args, nil
-}
-
-func (ec *executionContext) field_Query_getAllCouncils_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "pass", ec.unmarshalNBoolean2bool)
 	if err != nil {
 		return nil, err
 	}
-	args["search"] = arg0
+	args["pass"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "comment", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["comment"] = arg2
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getAllEnrollments_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_setGradeAppealWindow_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "semesterCode", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["search"] = arg0
+	args["semesterCode"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "days", ec.unmarshalNInt2int32)
+	if err != nil {
+		return nil, err
+	}
+	args["days"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getAllFaculties_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_setGradingPolicy_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNSetGradingPolicyInput2thailyᚋsrcᚋgraphᚋmodelᚐSetGradingPolicyInput)
 	if err != nil {
 		return nil, err
 	}
-	args["search"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getAllGradeDefences_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_setRegistrationWindow_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNSetRegistrationWindowInput2thailyᚋsrcᚋgraphᚋmodelᚐSetRegistrationWindowInput)
 	if err != nil {
 		return nil, err
 	}
-	args["search"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getAllMajors_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_setSubmissionDeadline_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNSetSubmissionDeadlineInput2thailyᚋsrcᚋgraphᚋmodelᚐSetSubmissionDeadlineInput)
 	if err != nil {
 		return nil, err
 	}
-	args["search"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getAllSemesters_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_startTopic_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["search"] = arg0
+	args["id"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getAllTopics_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_submitMilestoneCheckin_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNSubmitMilestoneCheckinInput2thailyᚋsrcᚋgraphᚋmodelᚐSubmitMilestoneCheckinInput)
 	if err != nil {
 		return nil, err
 	}
-	args["search"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getCouncilDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_submitTopic_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
@@ -850,18 +1060,23 @@ func (ec *executionContext) field_Query_getCouncilDetail_args(ctx context.Contex
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getDefencesByCouncil_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_updateCouncil_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "councilId", ec.unmarshalNID2string)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["councilId"] = arg0
+	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateCouncilInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateCouncilInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getDepartmentCouncilDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_updateDepartmentCouncil_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
@@ -869,32 +1084,31 @@ func (ec *executionContext) field_Query_getDepartmentCouncilDetail_args(ctx cont
 		return nil, err
 	}
 	args["id"] = arg0
-	return args, nil
-}
-
-func (ec *executionContext) field_Query_getDepartmentCouncils_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateCouncilInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateCouncilInput)
 	if err != nil {
 		return nil, err
 	}
-	args["search"] = arg0
+	args["input"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getDepartmentDefences_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_updateFaculty_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "councilId", ec.unmarshalNID2string)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["councilId"] = arg0
+	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateFacultyInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateFacultyInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getDepartmentEnrollmentDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_updateGradeDefenceCriterion_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
@@ -902,87 +1116,117 @@ func (ec *executionContext) field_Query_getDepartmentEnrollmentDetail_args(ctx c
 		return nil, err
 	}
 	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateGradeDefenceCriterionInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateGradeDefenceCriterionInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getDepartmentEnrollments_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_updateGradeDefence_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["search"] = arg0
+	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateGradeDefenceInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateGradeDefenceInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getDepartmentFaculties_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_updateGradeReview_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["search"] = arg0
+	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateGradeReviewInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateGradeReviewInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getDepartmentGradeDefences_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_updateMajor_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["search"] = arg0
+	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateMajorInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateMajorInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getDepartmentMajors_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_updateMidtermMilestone_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["search"] = arg0
+	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateMidtermMilestoneInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateMidtermMilestoneInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getDepartmentSemesters_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_updateMyProfile_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateStudentProfileInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateStudentProfileInput)
 	if err != nil {
 		return nil, err
 	}
-	args["search"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getDepartmentStudents_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_updateMyTeacherProfile_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateTeacherProfileInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateTeacherProfileInput)
 	if err != nil {
 		return nil, err
 	}
-	args["search"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getDepartmentTeachers_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_updateRubricTemplate_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["search"] = arg0
+	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateRubricTemplateInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateRubricTemplateInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getDepartmentTopicDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_updateSemester_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
@@ -990,21 +1234,31 @@ func (ec *executionContext) field_Query_getDepartmentTopicDetail_args(ctx contex
 		return nil, err
 	}
 	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateSemesterInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateSemesterInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getDepartmentTopics_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_updateStudent_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["search"] = arg0
+	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateStudentInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateStudentInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getEnrollmentDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_updateTeacher_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
@@ -1012,68 +1266,98 @@ func (ec *executionContext) field_Query_getEnrollmentDetail_args(ctx context.Con
 		return nil, err
 	}
 	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateTeacherInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateTeacherInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getListStudents_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_updateTopic_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
 	if err != nil {
 		return nil, err
 	}
-	args["search"] = arg0
+	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateTopicInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateTopicInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getListTeachers_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_uploadFinalFile_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUploadFileInput2thailyᚋsrcᚋgraphᚋmodelᚐUploadFileInput)
 	if err != nil {
 		return nil, err
 	}
-	args["search"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getMyDefenceDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_uploadMidtermFile_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUploadFileInput2thailyᚋsrcᚋgraphᚋmodelᚐUploadFileInput)
 	if err != nil {
 		return nil, err
 	}
-	args["id"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getMyDefences_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalOSearchRequestInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
 	if err != nil {
 		return nil, err
 	}
-	args["search"] = arg0
+	args["name"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getMyEnrollmentDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Query_auditLog_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "entity", ec.unmarshalOString2ᚖstring)
 	if err != nil {
 		return nil, err
 	}
-	args["id"] = arg0
+	args["entity"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "entityId", ec.unmarshalOID2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["entityId"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "actor", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["actor"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "from", ec.unmarshalOTime2ᚖtimeᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "to", ec.unmarshalOTime2ᚖtimeᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg4
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getMyEnrollments_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Query_getAllCouncils_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalOSearchRequestInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
 	if err != nil {
 		return nil, err
 	}
@@ -1081,21 +1365,21 @@ func (ec *executionContext) field_Query_getMyEnrollments_args(ctx context.Contex
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getMyGradeReviewDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Query_getAllEnrollments_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
 	if err != nil {
 		return nil, err
 	}
-	args["id"] = arg0
+	args["search"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getMyGradeReviews_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Query_getAllFaculties_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalOSearchRequestInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
 	if err != nil {
 		return nil, err
 	}
@@ -1103,10 +1387,10 @@ func (ec *executionContext) field_Query_getMyGradeReviews_args(ctx context.Conte
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getMySemesters_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Query_getAllGradeDefences_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalOSearchRequestInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
 	if err != nil {
 		return nil, err
 	}
@@ -1114,21 +1398,21 @@ func (ec *executionContext) field_Query_getMySemesters_args(ctx context.Context,
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getMySupervisedTopicCouncilDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Query_getAllMajors_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
 	if err != nil {
 		return nil, err
 	}
-	args["id"] = arg0
+	args["search"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getMySupervisedTopicCouncils_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Query_getAllSemesters_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalOSearchRequestInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
 	if err != nil {
 		return nil, err
 	}
@@ -1136,18 +1420,29 @@ func (ec *executionContext) field_Query_getMySupervisedTopicCouncils_args(ctx co
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getStudentDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Query_getAllTopics_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
 	if err != nil {
 		return nil, err
 	}
-	args["id"] = arg0
+	args["search"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getTeacherDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Query_getCouncilConflictOverrides_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "councilId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["councilId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getCouncilDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
@@ -1158,7 +1453,18 @@ func (ec *executionContext) field_Query_getTeacherDetail_args(ctx context.Contex
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_getTopicDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Query_getDefencesByCouncil_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "councilId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["councilId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getDepartmentCouncilDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
@@ -1169,1068 +1475,939 @@ func (ec *executionContext) field_Query_getTopicDetail_args(ctx context.Context,
 	return args, nil
 }
 
-// endregion ***************************** args.gotpl *****************************
+func (ec *executionContext) field_Query_getDepartmentCouncils_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	if err != nil {
+		return nil, err
+	}
+	args["search"] = arg0
+	return args, nil
+}
 
-// region    ************************** directives.gotpl **************************
+func (ec *executionContext) field_Query_getDepartmentDefences_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "councilId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["councilId"] = arg0
+	return args, nil
+}
 
-// endregion ************************** directives.gotpl **************************
+func (ec *executionContext) field_Query_getDepartmentEnrollmentDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
 
-// region    **************************** field.gotpl *****************************
+func (ec *executionContext) field_Query_getDepartmentEnrollments_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	if err != nil {
+		return nil, err
+	}
+	args["search"] = arg0
+	return args, nil
+}
 
-func (ec *executionContext) _CouncilDefenceListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.CouncilDefenceListResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CouncilDefenceListResponse_total,
-		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
-		},
-		nil,
-		ec.marshalNInt2int32,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Query_getDepartmentFaculties_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	if err != nil {
+		return nil, err
+	}
+	args["search"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_CouncilDefenceListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CouncilDefenceListResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+func (ec *executionContext) field_Query_getDepartmentGradeDefences_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["search"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _CouncilDefenceListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.CouncilDefenceListResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CouncilDefenceListResponse_data,
-		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
-		},
-		nil,
-		ec.marshalNCouncilDefence2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilDefenceᚄ,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Query_getDepartmentMajors_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	if err != nil {
+		return nil, err
+	}
+	args["search"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_CouncilDefenceListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CouncilDefenceListResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_CouncilDefence_id(ctx, field)
-			case "title":
-				return ec.fieldContext_CouncilDefence_title(ctx, field)
-			case "councilCode":
-				return ec.fieldContext_CouncilDefence_councilCode(ctx, field)
-			case "teacherCode":
-				return ec.fieldContext_CouncilDefence_teacherCode(ctx, field)
-			case "position":
-				return ec.fieldContext_CouncilDefence_position(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_CouncilDefence_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_CouncilDefence_updatedAt(ctx, field)
-			case "council":
-				return ec.fieldContext_CouncilDefence_council(ctx, field)
-			case "teacher":
-				return ec.fieldContext_CouncilDefence_teacher(ctx, field)
-			case "gradeDefences":
-				return ec.fieldContext_CouncilDefence_gradeDefences(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CouncilDefence", field.Name)
-		},
+func (ec *executionContext) field_Query_getDepartmentSemesters_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["search"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _CouncilEnrollmentListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.CouncilEnrollmentListResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CouncilEnrollmentListResponse_total,
-		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
-		},
-		nil,
-		ec.marshalNInt2int32,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Query_getDepartmentStudents_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	if err != nil {
+		return nil, err
+	}
+	args["search"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_CouncilEnrollmentListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CouncilEnrollmentListResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+func (ec *executionContext) field_Query_getDepartmentTeachers_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["search"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _CouncilEnrollmentListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.CouncilEnrollmentListResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CouncilEnrollmentListResponse_data,
-		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
-		},
-		nil,
-		ec.marshalNCouncilEnrollment2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilEnrollmentᚄ,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Query_getDepartmentTopicDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_CouncilEnrollmentListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CouncilEnrollmentListResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_CouncilEnrollment_id(ctx, field)
-			case "title":
-				return ec.fieldContext_CouncilEnrollment_title(ctx, field)
-			case "studentCode":
-				return ec.fieldContext_CouncilEnrollment_studentCode(ctx, field)
-			case "topicCouncilCode":
-				return ec.fieldContext_CouncilEnrollment_topicCouncilCode(ctx, field)
-			case "finalCode":
-				return ec.fieldContext_CouncilEnrollment_finalCode(ctx, field)
-			case "gradeReviewCode":
-				return ec.fieldContext_CouncilEnrollment_gradeReviewCode(ctx, field)
-			case "midtermCode":
-				return ec.fieldContext_CouncilEnrollment_midtermCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_CouncilEnrollment_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_CouncilEnrollment_updatedAt(ctx, field)
-			case "student":
-				return ec.fieldContext_CouncilEnrollment_student(ctx, field)
-			case "topicCouncil":
-				return ec.fieldContext_CouncilEnrollment_topicCouncil(ctx, field)
-			case "midterm":
-				return ec.fieldContext_CouncilEnrollment_midterm(ctx, field)
-			case "final":
-				return ec.fieldContext_CouncilEnrollment_final(ctx, field)
-			case "gradeReview":
-				return ec.fieldContext_CouncilEnrollment_gradeReview(ctx, field)
-			case "gradeDefences":
-				return ec.fieldContext_CouncilEnrollment_gradeDefences(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CouncilEnrollment", field.Name)
-		},
+func (ec *executionContext) field_Query_getDepartmentTopics_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) _CouncilListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.CouncilListResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CouncilListResponse_total,
-		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
-		},
-		nil,
-		ec.marshalNInt2int32,
-		true,
-		true,
-	)
+	args["search"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_CouncilListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CouncilListResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+func (ec *executionContext) field_Query_getEnrollmentDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["id"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _CouncilListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.CouncilListResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CouncilListResponse_data,
-		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
-		},
-		nil,
-		ec.marshalNCouncil2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilᚄ,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Query_getEnrollmentMilestoneCheckins_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "enrollmentId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["enrollmentId"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_CouncilListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CouncilListResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "total":
-				return ec.fieldContext_Council_total(ctx, field)
-			case "id":
-				return ec.fieldContext_Council_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Council_title(ctx, field)
-			case "majorCode":
-				return ec.fieldContext_Council_majorCode(ctx, field)
-			case "semesterCode":
-				return ec.fieldContext_Council_semesterCode(ctx, field)
-			case "timeStart":
-				return ec.fieldContext_Council_timeStart(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Council_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Council_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Council_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Council_updatedBy(ctx, field)
-			case "defences":
-				return ec.fieldContext_Council_defences(ctx, field)
-			case "topicCouncils":
-				return ec.fieldContext_Council_topicCouncils(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Council", field.Name)
-		},
+func (ec *executionContext) field_Query_getGradeAmendments_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "semesterCode", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["semesterCode"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "status", ec.unmarshalOGradeAmendmentStatus2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeAmendmentStatus)
+	if err != nil {
+		return nil, err
+	}
+	args["status"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) _CouncilTopicCouncilListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.CouncilTopicCouncilListResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CouncilTopicCouncilListResponse_total,
-		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
-		},
-		nil,
-		ec.marshalNInt2int32,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Query_getGradeAppeals_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "semesterCode", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["semesterCode"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "status", ec.unmarshalOGradeAppealStatus2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeAppealStatus)
+	if err != nil {
+		return nil, err
+	}
+	args["status"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_CouncilTopicCouncilListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CouncilTopicCouncilListResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+func (ec *executionContext) field_Query_getGradingPolicies_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "semesterCode", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["semesterCode"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _CouncilTopicCouncilListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.CouncilTopicCouncilListResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CouncilTopicCouncilListResponse_data,
-		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
-		},
-		nil,
-		ec.marshalNCouncilTopicCouncil2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilTopicCouncilᚄ,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Query_getListStudents_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	if err != nil {
+		return nil, err
+	}
+	args["search"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_CouncilTopicCouncilListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CouncilTopicCouncilListResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_CouncilTopicCouncil_id(ctx, field)
-			case "title":
-				return ec.fieldContext_CouncilTopicCouncil_title(ctx, field)
-			case "stage":
-				return ec.fieldContext_CouncilTopicCouncil_stage(ctx, field)
-			case "topicCode":
-				return ec.fieldContext_CouncilTopicCouncil_topicCode(ctx, field)
-			case "councilCode":
-				return ec.fieldContext_CouncilTopicCouncil_councilCode(ctx, field)
-			case "timeStart":
-				return ec.fieldContext_CouncilTopicCouncil_timeStart(ctx, field)
-			case "timeEnd":
-				return ec.fieldContext_CouncilTopicCouncil_timeEnd(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_CouncilTopicCouncil_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_CouncilTopicCouncil_updatedAt(ctx, field)
-			case "topic":
-				return ec.fieldContext_CouncilTopicCouncil_topic(ctx, field)
-			case "council":
-				return ec.fieldContext_CouncilTopicCouncil_council(ctx, field)
-			case "enrollments":
-				return ec.fieldContext_CouncilTopicCouncil_enrollments(ctx, field)
-			case "supervisors":
-				return ec.fieldContext_CouncilTopicCouncil_supervisors(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CouncilTopicCouncil", field.Name)
-		},
+func (ec *executionContext) field_Query_getListTeachers_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["search"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _DefenceListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.DefenceListResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_DefenceListResponse_total,
-		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
-		},
-		nil,
-		ec.marshalNInt2int32,
-		true,
-		true,
-	)
-}
-
-func (ec *executionContext) fieldContext_DefenceListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DefenceListResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+func (ec *executionContext) field_Query_getMidtermMilestones_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "semesterCode", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["semesterCode"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "stage", ec.unmarshalOTopicStage2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicStage)
+	if err != nil {
+		return nil, err
+	}
+	args["stage"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) _DefenceListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.DefenceListResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_DefenceListResponse_data,
-		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
-		},
-		nil,
-		ec.marshalNDefence2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceᚄ,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Query_getMyAssignedGradeAppeals_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "status", ec.unmarshalOGradeAppealStatus2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeAppealStatus)
+	if err != nil {
+		return nil, err
+	}
+	args["status"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_DefenceListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DefenceListResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Defence_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Defence_title(ctx, field)
-			case "councilCode":
-				return ec.fieldContext_Defence_councilCode(ctx, field)
-			case "teacherCode":
-				return ec.fieldContext_Defence_teacherCode(ctx, field)
-			case "position":
-				return ec.fieldContext_Defence_position(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Defence_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Defence_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Defence_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Defence_updatedBy(ctx, field)
-			case "council":
-				return ec.fieldContext_Defence_council(ctx, field)
-			case "teacher":
-				return ec.fieldContext_Defence_teacher(ctx, field)
-			case "gradeDefences":
-				return ec.fieldContext_Defence_gradeDefences(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Defence", field.Name)
-		},
+func (ec *executionContext) field_Query_getMyDefenceDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["id"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _EnrollmentListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.EnrollmentListResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_EnrollmentListResponse_total,
-		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
-		},
-		nil,
-		ec.marshalNInt2int32,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Query_getMyDefences_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalOSearchRequestInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	if err != nil {
+		return nil, err
+	}
+	args["search"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_EnrollmentListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "EnrollmentListResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+func (ec *executionContext) field_Query_getMyEnrollmentDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["id"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _EnrollmentListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.EnrollmentListResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_EnrollmentListResponse_data,
-		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
-		},
-		nil,
-		ec.marshalNEnrollment2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐEnrollmentᚄ,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Query_getMyEnrollments_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalOSearchRequestInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	if err != nil {
+		return nil, err
+	}
+	args["search"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_EnrollmentListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "EnrollmentListResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Enrollment_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Enrollment_title(ctx, field)
-			case "studentCode":
-				return ec.fieldContext_Enrollment_studentCode(ctx, field)
-			case "topicCouncilCode":
-				return ec.fieldContext_Enrollment_topicCouncilCode(ctx, field)
-			case "finalCode":
-				return ec.fieldContext_Enrollment_finalCode(ctx, field)
-			case "gradeReviewCode":
-				return ec.fieldContext_Enrollment_gradeReviewCode(ctx, field)
-			case "midtermCode":
-				return ec.fieldContext_Enrollment_midtermCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Enrollment_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Enrollment_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Enrollment_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Enrollment_updatedBy(ctx, field)
-			case "student":
-				return ec.fieldContext_Enrollment_student(ctx, field)
-			case "midterm":
-				return ec.fieldContext_Enrollment_midterm(ctx, field)
-			case "final":
-				return ec.fieldContext_Enrollment_final(ctx, field)
-			case "topicCouncil":
-				return ec.fieldContext_Enrollment_topicCouncil(ctx, field)
-			case "gradeReview":
-				return ec.fieldContext_Enrollment_gradeReview(ctx, field)
-			case "gradeDefences":
-				return ec.fieldContext_Enrollment_gradeDefences(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Enrollment", field.Name)
-		},
+func (ec *executionContext) field_Query_getMyGradeReviewDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["id"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _FacultyListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.FacultyListResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FacultyListResponse_total,
-		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
-		},
-		nil,
-		ec.marshalNInt2int32,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Query_getMyGradeReviews_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalOSearchRequestInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	if err != nil {
+		return nil, err
+	}
+	args["search"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_FacultyListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FacultyListResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+func (ec *executionContext) field_Query_getMyMidtermMilestones_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "enrollmentId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["enrollmentId"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _FacultyListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.FacultyListResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
+func (ec *executionContext) field_Query_getMyMilestoneCheckins_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "enrollmentId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["enrollmentId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getMySemesters_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalOSearchRequestInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	if err != nil {
+		return nil, err
+	}
+	args["search"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getMySubmissionWindow_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "kind", ec.unmarshalNSubmissionKind2thailyᚋsrcᚋgraphᚋmodelᚐSubmissionKind)
+	if err != nil {
+		return nil, err
+	}
+	args["kind"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getMySupervisedTopicCouncilDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getMySupervisedTopicCouncils_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "search", ec.unmarshalOSearchRequestInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput)
+	if err != nil {
+		return nil, err
+	}
+	args["search"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getMyTopicApplicants_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "topicId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["topicId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getRegistrationWindow_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "semesterCode", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["semesterCode"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getRubricTemplates_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "majorCode", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["majorCode"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "stage", ec.unmarshalOTopicStage2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicStage)
+	if err != nil {
+		return nil, err
+	}
+	args["stage"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getSemesterGradeStatus_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "semesterCode", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["semesterCode"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getStudentDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getSubmissionDeadlines_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "semesterCode", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["semesterCode"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getTeacherDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getTopicCoSigns_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "topicId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["topicId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getTopicDetail_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getTopicRegistrations_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "semesterCode", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["semesterCode"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_globalSearch_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "query", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["query"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "types", ec.unmarshalOSearchType2ᚕthailyᚋsrcᚋgraphᚋmodelᚐSearchTypeᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["types"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint32)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_previewDefenceSchedule_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNDefenceScheduleInput2thailyᚋsrcᚋgraphᚋmodelᚐDefenceScheduleInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_previewFinalGrade_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "enrollmentId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["enrollmentId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_previewTopicMatching_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "semesterCode", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["semesterCode"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_validateCouncil_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "councilId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["councilId"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _AuditChange_field(ctx context.Context, field graphql.CollectedField, obj *model.AuditChange) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_FacultyListResponse_data,
+		ec.fieldContext_AuditChange_field,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			return obj.Field, nil
 		},
 		nil,
-		ec.marshalNFaculty2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐFacultyᚄ,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_FacultyListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditChange_field(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FacultyListResponse",
+		Object:     "AuditChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Faculty_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Faculty_title(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Faculty_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Faculty_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Faculty_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Faculty_updatedBy(ctx, field)
-			case "majors":
-				return ec.fieldContext_Faculty_majors(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Faculty", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FileListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.FileListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AuditChange_before(ctx context.Context, field graphql.CollectedField, obj *model.AuditChange) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_FileListResponse_total,
+		ec.fieldContext_AuditChange_before,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			return obj.Before, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_FileListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditChange_before(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileListResponse",
+		Object:     "AuditChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FileListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.FileListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AuditChange_after(ctx context.Context, field graphql.CollectedField, obj *model.AuditChange) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_FileListResponse_data,
+		ec.fieldContext_AuditChange_after,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			return obj.After, nil
 		},
 		nil,
-		ec.marshalNFile2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐFileᚄ,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_FileListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditChange_after(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FileListResponse",
+		Object:     "AuditChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_File_id(ctx, field)
-			case "title":
-				return ec.fieldContext_File_title(ctx, field)
-			case "file":
-				return ec.fieldContext_File_file(ctx, field)
-			case "status":
-				return ec.fieldContext_File_status(ctx, field)
-			case "table":
-				return ec.fieldContext_File_table(ctx, field)
-			case "option":
-				return ec.fieldContext_File_option(ctx, field)
-			case "tableId":
-				return ec.fieldContext_File_tableId(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_File_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_File_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_File_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_File_updatedBy(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FinalListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.FinalListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AuditLogEntry_id(ctx context.Context, field graphql.CollectedField, obj *model.AuditLogEntry) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_FinalListResponse_total,
+		ec.fieldContext_AuditLogEntry_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_FinalListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditLogEntry_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FinalListResponse",
+		Object:     "AuditLogEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FinalListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.FinalListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AuditLogEntry_at(ctx context.Context, field graphql.CollectedField, obj *model.AuditLogEntry) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_FinalListResponse_data,
+		ec.fieldContext_AuditLogEntry_at,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			return obj.At, nil
 		},
 		nil,
-		ec.marshalNFinal2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐFinalᚄ,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_FinalListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditLogEntry_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FinalListResponse",
+		Object:     "AuditLogEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Final_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Final_title(ctx, field)
-			case "supervisorGrade":
-				return ec.fieldContext_Final_supervisorGrade(ctx, field)
-			case "departmentGrade":
-				return ec.fieldContext_Final_departmentGrade(ctx, field)
-			case "finalGrade":
-				return ec.fieldContext_Final_finalGrade(ctx, field)
-			case "status":
-				return ec.fieldContext_Final_status(ctx, field)
-			case "notes":
-				return ec.fieldContext_Final_notes(ctx, field)
-			case "completionDate":
-				return ec.fieldContext_Final_completionDate(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Final_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Final_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Final_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Final_updatedBy(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Final", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefenceCriterionListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterionListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AuditLogEntry_service(ctx context.Context, field graphql.CollectedField, obj *model.AuditLogEntry) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefenceCriterionListResponse_total,
+		ec.fieldContext_AuditLogEntry_service,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			return obj.Service, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefenceCriterionListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditLogEntry_service(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefenceCriterionListResponse",
+		Object:     "AuditLogEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefenceCriterionListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterionListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AuditLogEntry_method(ctx context.Context, field graphql.CollectedField, obj *model.AuditLogEntry) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefenceCriterionListResponse_data,
+		ec.fieldContext_AuditLogEntry_method,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			return obj.Method, nil
 		},
 		nil,
-		ec.marshalNGradeDefenceCriterion2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefenceCriterionᚄ,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefenceCriterionListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditLogEntry_method(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefenceCriterionListResponse",
+		Object:     "AuditLogEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_GradeDefenceCriterion_id(ctx, field)
-			case "gradeDefenceCode":
-				return ec.fieldContext_GradeDefenceCriterion_gradeDefenceCode(ctx, field)
-			case "name":
-				return ec.fieldContext_GradeDefenceCriterion_name(ctx, field)
-			case "score":
-				return ec.fieldContext_GradeDefenceCriterion_score(ctx, field)
-			case "maxScore":
-				return ec.fieldContext_GradeDefenceCriterion_maxScore(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_GradeDefenceCriterion_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_GradeDefenceCriterion_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_GradeDefenceCriterion_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_GradeDefenceCriterion_updatedBy(ctx, field)
-			case "gradeDefence":
-				return ec.fieldContext_GradeDefenceCriterion_gradeDefence(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type GradeDefenceCriterion", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefenceListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AuditLogEntry_entity(ctx context.Context, field graphql.CollectedField, obj *model.AuditLogEntry) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefenceListResponse_total,
+		ec.fieldContext_AuditLogEntry_entity,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			return obj.Entity, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefenceListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditLogEntry_entity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefenceListResponse",
+		Object:     "AuditLogEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeDefenceListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AuditLogEntry_entityId(ctx context.Context, field graphql.CollectedField, obj *model.AuditLogEntry) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeDefenceListResponse_data,
+		ec.fieldContext_AuditLogEntry_entityId,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			return obj.EntityID, nil
 		},
 		nil,
-		ec.marshalNGradeDefence2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefenceᚄ,
-		true,
+		ec.marshalOID2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeDefenceListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditLogEntry_entityId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeDefenceListResponse",
+		Object:     "AuditLogEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_GradeDefence_id(ctx, field)
-			case "defenceCode":
-				return ec.fieldContext_GradeDefence_defenceCode(ctx, field)
-			case "enrollmentCode":
-				return ec.fieldContext_GradeDefence_enrollmentCode(ctx, field)
-			case "note":
-				return ec.fieldContext_GradeDefence_note(ctx, field)
-			case "totalScore":
-				return ec.fieldContext_GradeDefence_totalScore(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_GradeDefence_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_GradeDefence_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_GradeDefence_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_GradeDefence_updatedBy(ctx, field)
-			case "defence":
-				return ec.fieldContext_GradeDefence_defence(ctx, field)
-			case "enrollment":
-				return ec.fieldContext_GradeDefence_enrollment(ctx, field)
-			case "criteria":
-				return ec.fieldContext_GradeDefence_criteria(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type GradeDefence", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeReviewListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.GradeReviewListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AuditLogEntry_actor(ctx context.Context, field graphql.CollectedField, obj *model.AuditLogEntry) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeReviewListResponse_total,
+		ec.fieldContext_AuditLogEntry_actor,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			return obj.Actor, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeReviewListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditLogEntry_actor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeReviewListResponse",
+		Object:     "AuditLogEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GradeReviewListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.GradeReviewListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AuditLogEntry_role(ctx context.Context, field graphql.CollectedField, obj *model.AuditLogEntry) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GradeReviewListResponse_data,
+		ec.fieldContext_AuditLogEntry_role,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			return obj.Role, nil
 		},
 		nil,
-		ec.marshalNGradeReview2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeReviewᚄ,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GradeReviewListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditLogEntry_role(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GradeReviewListResponse",
+		Object:     "AuditLogEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_GradeReview_id(ctx, field)
-			case "title":
-				return ec.fieldContext_GradeReview_title(ctx, field)
-			case "reviewGrade":
-				return ec.fieldContext_GradeReview_reviewGrade(ctx, field)
-			case "teacherCode":
-				return ec.fieldContext_GradeReview_teacherCode(ctx, field)
-			case "status":
-				return ec.fieldContext_GradeReview_status(ctx, field)
-			case "notes":
-				return ec.fieldContext_GradeReview_notes(ctx, field)
-			case "completionDate":
-				return ec.fieldContext_GradeReview_completionDate(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_GradeReview_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_GradeReview_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_GradeReview_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_GradeReview_updatedBy(ctx, field)
-			case "teacher":
-				return ec.fieldContext_GradeReview_teacher(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type GradeReview", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MajorInfo_id(ctx context.Context, field graphql.CollectedField, obj *model.MajorInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _AuditLogEntry_semester(ctx context.Context, field graphql.CollectedField, obj *model.AuditLogEntry) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_MajorInfo_id,
+		ec.fieldContext_AuditLogEntry_semester,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.Semester, nil
 		},
 		nil,
-		ec.marshalNID2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_MajorInfo_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditLogEntry_semester(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MajorInfo",
+		Object:     "AuditLogEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MajorInfo_title(ctx context.Context, field graphql.CollectedField, obj *model.MajorInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _AuditLogEntry_requestId(ctx context.Context, field graphql.CollectedField, obj *model.AuditLogEntry) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_MajorInfo_title,
+		ec.fieldContext_AuditLogEntry_requestId,
 		func(ctx context.Context) (any, error) {
-			return obj.Title, nil
+			return obj.RequestID, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_MajorInfo_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditLogEntry_requestId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MajorInfo",
+		Object:     "AuditLogEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2241,25 +2418,25 @@ func (ec *executionContext) fieldContext_MajorInfo_title(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _MajorInfo_facultyCode(ctx context.Context, field graphql.CollectedField, obj *model.MajorInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _AuditLogEntry_clientIp(ctx context.Context, field graphql.CollectedField, obj *model.AuditLogEntry) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_MajorInfo_facultyCode,
+		ec.fieldContext_AuditLogEntry_clientIp,
 		func(ctx context.Context) (any, error) {
-			return obj.FacultyCode, nil
+			return obj.ClientIP, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_MajorInfo_facultyCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditLogEntry_clientIp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MajorInfo",
+		Object:     "AuditLogEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2270,88 +2447,129 @@ func (ec *executionContext) fieldContext_MajorInfo_facultyCode(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _MajorListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.MajorListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AuditLogEntry_changes(ctx context.Context, field graphql.CollectedField, obj *model.AuditLogEntry) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_MajorListResponse_total,
+		ec.fieldContext_AuditLogEntry_changes,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			return obj.Changes, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNAuditChange2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐAuditChangeᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_MajorListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditLogEntry_changes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MajorListResponse",
+		Object:     "AuditLogEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_AuditChange_field(ctx, field)
+			case "before":
+				return ec.fieldContext_AuditChange_before(ctx, field)
+			case "after":
+				return ec.fieldContext_AuditChange_after(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuditChange", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MajorListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.MajorListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilDefenceListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.CouncilDefenceListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_MajorListResponse_data,
+		ec.fieldContext_CouncilDefenceListResponse_total,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			return obj.Total, nil
 		},
 		nil,
-		ec.marshalNMajor2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐMajorᚄ,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_MajorListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilDefenceListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MajorListResponse",
+		Object:     "CouncilDefenceListResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Major_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Major_title(ctx, field)
-			case "facultyCode":
-				return ec.fieldContext_Major_facultyCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Major_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Major_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Major_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Major_updatedBy(ctx, field)
-			case "topics":
-				return ec.fieldContext_Major_topics(ctx, field)
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CouncilDefenceListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.CouncilDefenceListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CouncilDefenceListResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalNCouncilDefence2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilDefenceᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CouncilDefenceListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CouncilDefenceListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_CouncilDefence_id(ctx, field)
+			case "title":
+				return ec.fieldContext_CouncilDefence_title(ctx, field)
+			case "councilCode":
+				return ec.fieldContext_CouncilDefence_councilCode(ctx, field)
+			case "teacherCode":
+				return ec.fieldContext_CouncilDefence_teacherCode(ctx, field)
+			case "position":
+				return ec.fieldContext_CouncilDefence_position(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_CouncilDefence_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_CouncilDefence_updatedAt(ctx, field)
+			case "council":
+				return ec.fieldContext_CouncilDefence_council(ctx, field)
+			case "teacher":
+				return ec.fieldContext_CouncilDefence_teacher(ctx, field)
+			case "gradeDefences":
+				return ec.fieldContext_CouncilDefence_gradeDefences(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Major", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type CouncilDefence", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MidtermListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.MidtermListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilEnrollmentListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.CouncilEnrollmentListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_MidtermListResponse_total,
+		ec.fieldContext_CouncilEnrollmentListResponse_total,
 		func(ctx context.Context) (any, error) {
 			return obj.Total, nil
 		},
@@ -2362,9 +2580,9 @@ func (ec *executionContext) _MidtermListResponse_total(ctx context.Context, fiel
 	)
 }
 
-func (ec *executionContext) fieldContext_MidtermListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilEnrollmentListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MidtermListResponse",
+		Object:     "CouncilEnrollmentListResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2375,1425 +2593,1255 @@ func (ec *executionContext) fieldContext_MidtermListResponse_total(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _MidtermListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.MidtermListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilEnrollmentListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.CouncilEnrollmentListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_MidtermListResponse_data,
+		ec.fieldContext_CouncilEnrollmentListResponse_data,
 		func(ctx context.Context) (any, error) {
 			return obj.Data, nil
 		},
 		nil,
-		ec.marshalNMidterm2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐMidtermᚄ,
+		ec.marshalNCouncilEnrollment2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilEnrollmentᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_MidtermListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilEnrollmentListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MidtermListResponse",
+		Object:     "CouncilEnrollmentListResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Midterm_id(ctx, field)
+				return ec.fieldContext_CouncilEnrollment_id(ctx, field)
 			case "title":
-				return ec.fieldContext_Midterm_title(ctx, field)
-			case "grade":
-				return ec.fieldContext_Midterm_grade(ctx, field)
-			case "status":
-				return ec.fieldContext_Midterm_status(ctx, field)
-			case "feedback":
-				return ec.fieldContext_Midterm_feedback(ctx, field)
+				return ec.fieldContext_CouncilEnrollment_title(ctx, field)
+			case "studentCode":
+				return ec.fieldContext_CouncilEnrollment_studentCode(ctx, field)
+			case "topicCouncilCode":
+				return ec.fieldContext_CouncilEnrollment_topicCouncilCode(ctx, field)
+			case "finalCode":
+				return ec.fieldContext_CouncilEnrollment_finalCode(ctx, field)
+			case "gradeReviewCode":
+				return ec.fieldContext_CouncilEnrollment_gradeReviewCode(ctx, field)
+			case "midtermCode":
+				return ec.fieldContext_CouncilEnrollment_midtermCode(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Midterm_createdAt(ctx, field)
+				return ec.fieldContext_CouncilEnrollment_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Midterm_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Midterm_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Midterm_updatedBy(ctx, field)
+				return ec.fieldContext_CouncilEnrollment_updatedAt(ctx, field)
+			case "student":
+				return ec.fieldContext_CouncilEnrollment_student(ctx, field)
+			case "topicCouncil":
+				return ec.fieldContext_CouncilEnrollment_topicCouncil(ctx, field)
+			case "midterm":
+				return ec.fieldContext_CouncilEnrollment_midterm(ctx, field)
+			case "final":
+				return ec.fieldContext_CouncilEnrollment_final(ctx, field)
+			case "gradeReview":
+				return ec.fieldContext_CouncilEnrollment_gradeReview(ctx, field)
+			case "gradeDefences":
+				return ec.fieldContext_CouncilEnrollment_gradeDefences(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Midterm", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type CouncilEnrollment", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation__empty(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.CouncilListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation__empty,
+		ec.fieldContext_CouncilListResponse_total,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Mutation().Empty(ctx)
+			return obj.Total, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation__empty(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "CouncilListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createTeacher(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.CouncilListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_createTeacher,
+		ec.fieldContext_CouncilListResponse_data,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreateTeacher(ctx, fc.Args["input"].(model.CreateTeacherInput))
+			return obj.Data, nil
 		},
 		nil,
-		ec.marshalNTeacher2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacher,
+		ec.marshalNCouncil2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createTeacher(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "CouncilListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "total":
+				return ec.fieldContext_Council_total(ctx, field)
 			case "id":
-				return ec.fieldContext_Teacher_id(ctx, field)
-			case "email":
-				return ec.fieldContext_Teacher_email(ctx, field)
-			case "username":
-				return ec.fieldContext_Teacher_username(ctx, field)
-			case "gender":
-				return ec.fieldContext_Teacher_gender(ctx, field)
+				return ec.fieldContext_Council_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Council_title(ctx, field)
 			case "majorCode":
-				return ec.fieldContext_Teacher_majorCode(ctx, field)
+				return ec.fieldContext_Council_majorCode(ctx, field)
 			case "semesterCode":
-				return ec.fieldContext_Teacher_semesterCode(ctx, field)
+				return ec.fieldContext_Council_semesterCode(ctx, field)
+			case "timeStart":
+				return ec.fieldContext_Council_timeStart(ctx, field)
+			case "room":
+				return ec.fieldContext_Council_room(ctx, field)
+			case "gradesLocked":
+				return ec.fieldContext_Council_gradesLocked(ctx, field)
+			case "gradesLockedAt":
+				return ec.fieldContext_Council_gradesLockedAt(ctx, field)
+			case "gradesPublished":
+				return ec.fieldContext_Council_gradesPublished(ctx, field)
+			case "gradesPublishedAt":
+				return ec.fieldContext_Council_gradesPublishedAt(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Teacher_createdAt(ctx, field)
+				return ec.fieldContext_Council_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Teacher_updatedAt(ctx, field)
+				return ec.fieldContext_Council_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Teacher_createdBy(ctx, field)
+				return ec.fieldContext_Council_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Teacher_updatedBy(ctx, field)
-			case "roles":
-				return ec.fieldContext_Teacher_roles(ctx, field)
+				return ec.fieldContext_Council_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Council_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Council_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Council_deletedBy(ctx, field)
+			case "defences":
+				return ec.fieldContext_Council_defences(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Council_topicCouncils(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Teacher", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Council", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createTeacher_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateTeacher(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilTopicCouncilListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.CouncilTopicCouncilListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_updateTeacher,
+		ec.fieldContext_CouncilTopicCouncilListResponse_total,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdateTeacher(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateTeacherInput))
+			return obj.Total, nil
 		},
 		nil,
-		ec.marshalNTeacher2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacher,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateTeacher(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilTopicCouncilListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "CouncilTopicCouncilListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Teacher_id(ctx, field)
-			case "email":
-				return ec.fieldContext_Teacher_email(ctx, field)
-			case "username":
-				return ec.fieldContext_Teacher_username(ctx, field)
-			case "gender":
-				return ec.fieldContext_Teacher_gender(ctx, field)
-			case "majorCode":
-				return ec.fieldContext_Teacher_majorCode(ctx, field)
-			case "semesterCode":
-				return ec.fieldContext_Teacher_semesterCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Teacher_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Teacher_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Teacher_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Teacher_updatedBy(ctx, field)
-			case "roles":
-				return ec.fieldContext_Teacher_roles(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Teacher", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateTeacher_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteTeacher(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _CouncilTopicCouncilListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.CouncilTopicCouncilListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_deleteTeacher,
+		ec.fieldContext_CouncilTopicCouncilListResponse_data,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().DeleteTeacher(ctx, fc.Args["id"].(string))
+			return obj.Data, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNCouncilTopicCouncil2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilTopicCouncilᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteTeacher(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CouncilTopicCouncilListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "CouncilTopicCouncilListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_CouncilTopicCouncil_id(ctx, field)
+			case "title":
+				return ec.fieldContext_CouncilTopicCouncil_title(ctx, field)
+			case "stage":
+				return ec.fieldContext_CouncilTopicCouncil_stage(ctx, field)
+			case "topicCode":
+				return ec.fieldContext_CouncilTopicCouncil_topicCode(ctx, field)
+			case "councilCode":
+				return ec.fieldContext_CouncilTopicCouncil_councilCode(ctx, field)
+			case "timeStart":
+				return ec.fieldContext_CouncilTopicCouncil_timeStart(ctx, field)
+			case "timeEnd":
+				return ec.fieldContext_CouncilTopicCouncil_timeEnd(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_CouncilTopicCouncil_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_CouncilTopicCouncil_updatedAt(ctx, field)
+			case "topic":
+				return ec.fieldContext_CouncilTopicCouncil_topic(ctx, field)
+			case "council":
+				return ec.fieldContext_CouncilTopicCouncil_council(ctx, field)
+			case "enrollments":
+				return ec.fieldContext_CouncilTopicCouncil_enrollments(ctx, field)
+			case "supervisors":
+				return ec.fieldContext_CouncilTopicCouncil_supervisors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CouncilTopicCouncil", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteTeacher_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createStudent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DefenceListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.DefenceListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_createStudent,
+		ec.fieldContext_DefenceListResponse_total,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreateStudent(ctx, fc.Args["input"].(model.CreateStudentInput))
+			return obj.Total, nil
 		},
 		nil,
-		ec.marshalNStudent2ᚖthailyᚋsrcᚋgraphᚋmodelᚐStudent,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createStudent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DefenceListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "DefenceListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Student_id(ctx, field)
-			case "email":
-				return ec.fieldContext_Student_email(ctx, field)
-			case "phone":
-				return ec.fieldContext_Student_phone(ctx, field)
-			case "username":
-				return ec.fieldContext_Student_username(ctx, field)
-			case "gender":
-				return ec.fieldContext_Student_gender(ctx, field)
-			case "majorCode":
-				return ec.fieldContext_Student_majorCode(ctx, field)
-			case "classCode":
-				return ec.fieldContext_Student_classCode(ctx, field)
-			case "semesterCode":
-				return ec.fieldContext_Student_semesterCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Student_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Student_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Student_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Student_updatedBy(ctx, field)
-			case "enrollments":
-				return ec.fieldContext_Student_enrollments(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Student", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createStudent_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateStudent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DefenceListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.DefenceListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_updateStudent,
+		ec.fieldContext_DefenceListResponse_data,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdateStudent(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateStudentInput))
+			return obj.Data, nil
 		},
 		nil,
-		ec.marshalNStudent2ᚖthailyᚋsrcᚋgraphᚋmodelᚐStudent,
+		ec.marshalNDefence2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateStudent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DefenceListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "DefenceListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Student_id(ctx, field)
-			case "email":
-				return ec.fieldContext_Student_email(ctx, field)
-			case "phone":
-				return ec.fieldContext_Student_phone(ctx, field)
-			case "username":
-				return ec.fieldContext_Student_username(ctx, field)
-			case "gender":
-				return ec.fieldContext_Student_gender(ctx, field)
-			case "majorCode":
-				return ec.fieldContext_Student_majorCode(ctx, field)
-			case "classCode":
-				return ec.fieldContext_Student_classCode(ctx, field)
-			case "semesterCode":
-				return ec.fieldContext_Student_semesterCode(ctx, field)
+				return ec.fieldContext_Defence_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Defence_title(ctx, field)
+			case "councilCode":
+				return ec.fieldContext_Defence_councilCode(ctx, field)
+			case "teacherCode":
+				return ec.fieldContext_Defence_teacherCode(ctx, field)
+			case "position":
+				return ec.fieldContext_Defence_position(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Student_createdAt(ctx, field)
+				return ec.fieldContext_Defence_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Student_updatedAt(ctx, field)
+				return ec.fieldContext_Defence_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Student_createdBy(ctx, field)
+				return ec.fieldContext_Defence_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Student_updatedBy(ctx, field)
-			case "enrollments":
-				return ec.fieldContext_Student_enrollments(ctx, field)
+				return ec.fieldContext_Defence_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Defence_version(ctx, field)
+			case "council":
+				return ec.fieldContext_Defence_council(ctx, field)
+			case "teacher":
+				return ec.fieldContext_Defence_teacher(ctx, field)
+			case "gradeDefences":
+				return ec.fieldContext_Defence_gradeDefences(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Student", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Defence", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateStudent_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteStudent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _EnrollmentListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.EnrollmentListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_deleteStudent,
+		ec.fieldContext_EnrollmentListResponse_total,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().DeleteStudent(ctx, fc.Args["id"].(string))
+			return obj.Total, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteStudent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EnrollmentListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "EnrollmentListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteStudent_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createSemester(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _EnrollmentListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.EnrollmentListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_createSemester,
+		ec.fieldContext_EnrollmentListResponse_data,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreateSemester(ctx, fc.Args["input"].(model.CreateSemesterInput))
+			return obj.Data, nil
 		},
 		nil,
-		ec.marshalNSemester2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSemester,
+		ec.marshalNEnrollment2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐEnrollmentᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createSemester(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EnrollmentListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "EnrollmentListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Semester_id(ctx, field)
+				return ec.fieldContext_Enrollment_id(ctx, field)
 			case "title":
-				return ec.fieldContext_Semester_title(ctx, field)
+				return ec.fieldContext_Enrollment_title(ctx, field)
+			case "studentCode":
+				return ec.fieldContext_Enrollment_studentCode(ctx, field)
+			case "topicCouncilCode":
+				return ec.fieldContext_Enrollment_topicCouncilCode(ctx, field)
+			case "finalCode":
+				return ec.fieldContext_Enrollment_finalCode(ctx, field)
+			case "gradeReviewCode":
+				return ec.fieldContext_Enrollment_gradeReviewCode(ctx, field)
+			case "midtermCode":
+				return ec.fieldContext_Enrollment_midtermCode(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Semester_createdAt(ctx, field)
+				return ec.fieldContext_Enrollment_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Semester_updatedAt(ctx, field)
+				return ec.fieldContext_Enrollment_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Semester_createdBy(ctx, field)
+				return ec.fieldContext_Enrollment_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Semester_updatedBy(ctx, field)
-			case "students":
-				return ec.fieldContext_Semester_students(ctx, field)
-			case "teachers":
-				return ec.fieldContext_Semester_teachers(ctx, field)
-			case "topics":
-				return ec.fieldContext_Semester_topics(ctx, field)
+				return ec.fieldContext_Enrollment_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Enrollment_version(ctx, field)
+			case "student":
+				return ec.fieldContext_Enrollment_student(ctx, field)
+			case "midterm":
+				return ec.fieldContext_Enrollment_midterm(ctx, field)
+			case "final":
+				return ec.fieldContext_Enrollment_final(ctx, field)
+			case "topicCouncil":
+				return ec.fieldContext_Enrollment_topicCouncil(ctx, field)
+			case "gradeReview":
+				return ec.fieldContext_Enrollment_gradeReview(ctx, field)
+			case "gradeDefences":
+				return ec.fieldContext_Enrollment_gradeDefences(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Semester", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Enrollment", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createSemester_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
+	return fc, nil
+}
+
+func (ec *executionContext) _FacultyListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.FacultyListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FacultyListResponse_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FacultyListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FacultyListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateSemester(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _FacultyListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.FacultyListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_updateSemester,
+		ec.fieldContext_FacultyListResponse_data,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdateSemester(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateSemesterInput))
+			return obj.Data, nil
 		},
 		nil,
-		ec.marshalNSemester2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSemester,
+		ec.marshalNFaculty2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐFacultyᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateSemester(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FacultyListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "FacultyListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Semester_id(ctx, field)
+				return ec.fieldContext_Faculty_id(ctx, field)
 			case "title":
-				return ec.fieldContext_Semester_title(ctx, field)
+				return ec.fieldContext_Faculty_title(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Semester_createdAt(ctx, field)
+				return ec.fieldContext_Faculty_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Semester_updatedAt(ctx, field)
+				return ec.fieldContext_Faculty_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Semester_createdBy(ctx, field)
+				return ec.fieldContext_Faculty_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Semester_updatedBy(ctx, field)
-			case "students":
-				return ec.fieldContext_Semester_students(ctx, field)
-			case "teachers":
-				return ec.fieldContext_Semester_teachers(ctx, field)
-			case "topics":
-				return ec.fieldContext_Semester_topics(ctx, field)
+				return ec.fieldContext_Faculty_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Faculty_version(ctx, field)
+			case "majors":
+				return ec.fieldContext_Faculty_majors(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Semester", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Faculty", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateSemester_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteSemester(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _FileListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.FileListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_deleteSemester,
+		ec.fieldContext_FileListResponse_total,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().DeleteSemester(ctx, fc.Args["id"].(string))
+			return obj.Total, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteSemester(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FileListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "FileListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteSemester_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createMajor(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _FileListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.FileListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_createMajor,
+		ec.fieldContext_FileListResponse_data,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreateMajor(ctx, fc.Args["input"].(model.CreateMajorInput))
+			return obj.Data, nil
 		},
 		nil,
-		ec.marshalNMajor2ᚖthailyᚋsrcᚋgraphᚋmodelᚐMajor,
+		ec.marshalNFile2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐFileᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createMajor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FileListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "FileListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Major_id(ctx, field)
+				return ec.fieldContext_File_id(ctx, field)
 			case "title":
-				return ec.fieldContext_Major_title(ctx, field)
-			case "facultyCode":
-				return ec.fieldContext_Major_facultyCode(ctx, field)
+				return ec.fieldContext_File_title(ctx, field)
+			case "file":
+				return ec.fieldContext_File_file(ctx, field)
+			case "status":
+				return ec.fieldContext_File_status(ctx, field)
+			case "table":
+				return ec.fieldContext_File_table(ctx, field)
+			case "option":
+				return ec.fieldContext_File_option(ctx, field)
+			case "tableId":
+				return ec.fieldContext_File_tableId(ctx, field)
+			case "version":
+				return ec.fieldContext_File_version(ctx, field)
+			case "size":
+				return ec.fieldContext_File_size(ctx, field)
+			case "checksum":
+				return ec.fieldContext_File_checksum(ctx, field)
+			case "contentType":
+				return ec.fieldContext_File_contentType(ctx, field)
+			case "late":
+				return ec.fieldContext_File_late(ctx, field)
+			case "quarantined":
+				return ec.fieldContext_File_quarantined(ctx, field)
+			case "scanResult":
+				return ec.fieldContext_File_scanResult(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Major_createdAt(ctx, field)
+				return ec.fieldContext_File_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Major_updatedAt(ctx, field)
+				return ec.fieldContext_File_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Major_createdBy(ctx, field)
+				return ec.fieldContext_File_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Major_updatedBy(ctx, field)
-			case "topics":
-				return ec.fieldContext_Major_topics(ctx, field)
+				return ec.fieldContext_File_updatedBy(ctx, field)
+			case "revision":
+				return ec.fieldContext_File_revision(ctx, field)
+			case "versions":
+				return ec.fieldContext_File_versions(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Major", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createMajor_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateMajor(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _FinalListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.FinalListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_updateMajor,
+		ec.fieldContext_FinalListResponse_total,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdateMajor(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateMajorInput))
+			return obj.Total, nil
 		},
 		nil,
-		ec.marshalNMajor2ᚖthailyᚋsrcᚋgraphᚋmodelᚐMajor,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateMajor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FinalListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "FinalListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Major_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Major_title(ctx, field)
-			case "facultyCode":
-				return ec.fieldContext_Major_facultyCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Major_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Major_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Major_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Major_updatedBy(ctx, field)
-			case "topics":
-				return ec.fieldContext_Major_topics(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Major", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateMajor_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteMajor(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _FinalListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.FinalListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_deleteMajor,
+		ec.fieldContext_FinalListResponse_data,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().DeleteMajor(ctx, fc.Args["id"].(string))
+			return obj.Data, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNFinal2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐFinalᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteMajor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FinalListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "FinalListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteMajor_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Final_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Final_title(ctx, field)
+			case "supervisorGrade":
+				return ec.fieldContext_Final_supervisorGrade(ctx, field)
+			case "departmentGrade":
+				return ec.fieldContext_Final_departmentGrade(ctx, field)
+			case "finalGrade":
+				return ec.fieldContext_Final_finalGrade(ctx, field)
+			case "status":
+				return ec.fieldContext_Final_status(ctx, field)
+			case "notes":
+				return ec.fieldContext_Final_notes(ctx, field)
+			case "completionDate":
+				return ec.fieldContext_Final_completionDate(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Final_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Final_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Final_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Final_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Final_version(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Final", field.Name)
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createFaculty(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _GradeDefenceCriterionListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterionListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_createFaculty,
+		ec.fieldContext_GradeDefenceCriterionListResponse_total,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreateFaculty(ctx, fc.Args["input"].(model.CreateFacultyInput))
+			return obj.Total, nil
 		},
 		nil,
-		ec.marshalNFaculty2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFaculty,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createFaculty(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GradeDefenceCriterionListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "GradeDefenceCriterionListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Faculty_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Faculty_title(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Faculty_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Faculty_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Faculty_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Faculty_updatedBy(ctx, field)
-			case "majors":
-				return ec.fieldContext_Faculty_majors(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Faculty", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createFaculty_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateFaculty(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _GradeDefenceCriterionListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceCriterionListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_updateFaculty,
+		ec.fieldContext_GradeDefenceCriterionListResponse_data,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdateFaculty(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateFacultyInput))
+			return obj.Data, nil
 		},
 		nil,
-		ec.marshalNFaculty2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFaculty,
+		ec.marshalNGradeDefenceCriterion2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefenceCriterionᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateFaculty(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GradeDefenceCriterionListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "GradeDefenceCriterionListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Faculty_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Faculty_title(ctx, field)
+				return ec.fieldContext_GradeDefenceCriterion_id(ctx, field)
+			case "gradeDefenceCode":
+				return ec.fieldContext_GradeDefenceCriterion_gradeDefenceCode(ctx, field)
+			case "name":
+				return ec.fieldContext_GradeDefenceCriterion_name(ctx, field)
+			case "description":
+				return ec.fieldContext_GradeDefenceCriterion_description(ctx, field)
+			case "score":
+				return ec.fieldContext_GradeDefenceCriterion_score(ctx, field)
+			case "maxScore":
+				return ec.fieldContext_GradeDefenceCriterion_maxScore(ctx, field)
+			case "weight":
+				return ec.fieldContext_GradeDefenceCriterion_weight(ctx, field)
+			case "rubricCriterionCode":
+				return ec.fieldContext_GradeDefenceCriterion_rubricCriterionCode(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Faculty_createdAt(ctx, field)
+				return ec.fieldContext_GradeDefenceCriterion_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Faculty_updatedAt(ctx, field)
+				return ec.fieldContext_GradeDefenceCriterion_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Faculty_createdBy(ctx, field)
+				return ec.fieldContext_GradeDefenceCriterion_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Faculty_updatedBy(ctx, field)
-			case "majors":
-				return ec.fieldContext_Faculty_majors(ctx, field)
+				return ec.fieldContext_GradeDefenceCriterion_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_GradeDefenceCriterion_version(ctx, field)
+			case "gradeDefence":
+				return ec.fieldContext_GradeDefenceCriterion_gradeDefence(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Faculty", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type GradeDefenceCriterion", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateFaculty_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteFaculty(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _GradeDefenceListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_deleteFaculty,
+		ec.fieldContext_GradeDefenceListResponse_total,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().DeleteFaculty(ctx, fc.Args["id"].(string))
+			return obj.Total, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteFaculty(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GradeDefenceListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "GradeDefenceListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteFaculty_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_approveCouncil(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _GradeDefenceListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.GradeDefenceListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_approveCouncil,
+		ec.fieldContext_GradeDefenceListResponse_data,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().ApproveCouncil(ctx, fc.Args["id"].(string), fc.Args["timeStart"].(time.Time))
+			return obj.Data, nil
 		},
 		nil,
-		ec.marshalNCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil,
+		ec.marshalNGradeDefence2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefenceᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_approveCouncil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GradeDefenceListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "GradeDefenceListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "total":
-				return ec.fieldContext_Council_total(ctx, field)
 			case "id":
-				return ec.fieldContext_Council_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Council_title(ctx, field)
-			case "majorCode":
-				return ec.fieldContext_Council_majorCode(ctx, field)
-			case "semesterCode":
-				return ec.fieldContext_Council_semesterCode(ctx, field)
-			case "timeStart":
-				return ec.fieldContext_Council_timeStart(ctx, field)
+				return ec.fieldContext_GradeDefence_id(ctx, field)
+			case "defenceCode":
+				return ec.fieldContext_GradeDefence_defenceCode(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_GradeDefence_enrollmentCode(ctx, field)
+			case "note":
+				return ec.fieldContext_GradeDefence_note(ctx, field)
+			case "totalScore":
+				return ec.fieldContext_GradeDefence_totalScore(ctx, field)
+			case "rubricTemplateCode":
+				return ec.fieldContext_GradeDefence_rubricTemplateCode(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Council_createdAt(ctx, field)
+				return ec.fieldContext_GradeDefence_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Council_updatedAt(ctx, field)
+				return ec.fieldContext_GradeDefence_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Council_createdBy(ctx, field)
+				return ec.fieldContext_GradeDefence_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Council_updatedBy(ctx, field)
-			case "defences":
-				return ec.fieldContext_Council_defences(ctx, field)
-			case "topicCouncils":
-				return ec.fieldContext_Council_topicCouncils(ctx, field)
+				return ec.fieldContext_GradeDefence_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_GradeDefence_version(ctx, field)
+			case "defence":
+				return ec.fieldContext_GradeDefence_defence(ctx, field)
+			case "enrollment":
+				return ec.fieldContext_GradeDefence_enrollment(ctx, field)
+			case "criteria":
+				return ec.fieldContext_GradeDefence_criteria(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Council", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type GradeDefence", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_approveCouncil_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateCouncil(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _GradeReviewListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.GradeReviewListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_updateCouncil,
+		ec.fieldContext_GradeReviewListResponse_total,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdateCouncil(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateCouncilInput))
+			return obj.Total, nil
 		},
 		nil,
-		ec.marshalNCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateCouncil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GradeReviewListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "GradeReviewListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "total":
-				return ec.fieldContext_Council_total(ctx, field)
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GradeReviewListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.GradeReviewListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GradeReviewListResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalNGradeReview2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeReviewᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GradeReviewListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GradeReviewListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
 			case "id":
-				return ec.fieldContext_Council_id(ctx, field)
+				return ec.fieldContext_GradeReview_id(ctx, field)
 			case "title":
-				return ec.fieldContext_Council_title(ctx, field)
-			case "majorCode":
-				return ec.fieldContext_Council_majorCode(ctx, field)
-			case "semesterCode":
-				return ec.fieldContext_Council_semesterCode(ctx, field)
-			case "timeStart":
-				return ec.fieldContext_Council_timeStart(ctx, field)
+				return ec.fieldContext_GradeReview_title(ctx, field)
+			case "reviewGrade":
+				return ec.fieldContext_GradeReview_reviewGrade(ctx, field)
+			case "teacherCode":
+				return ec.fieldContext_GradeReview_teacherCode(ctx, field)
+			case "status":
+				return ec.fieldContext_GradeReview_status(ctx, field)
+			case "notes":
+				return ec.fieldContext_GradeReview_notes(ctx, field)
+			case "completionDate":
+				return ec.fieldContext_GradeReview_completionDate(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Council_createdAt(ctx, field)
+				return ec.fieldContext_GradeReview_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Council_updatedAt(ctx, field)
+				return ec.fieldContext_GradeReview_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Council_createdBy(ctx, field)
+				return ec.fieldContext_GradeReview_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Council_updatedBy(ctx, field)
-			case "defences":
-				return ec.fieldContext_Council_defences(ctx, field)
-			case "topicCouncils":
-				return ec.fieldContext_Council_topicCouncils(ctx, field)
+				return ec.fieldContext_GradeReview_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_GradeReview_version(ctx, field)
+			case "teacher":
+				return ec.fieldContext_GradeReview_teacher(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Council", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type GradeReview", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateCouncil_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteCouncil(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _MajorInfo_id(ctx context.Context, field graphql.CollectedField, obj *model.MajorInfo) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_deleteCouncil,
+		ec.fieldContext_MajorInfo_id,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().DeleteCouncil(ctx, fc.Args["id"].(string))
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteCouncil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MajorInfo_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MajorInfo",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteCouncil_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_approveTopic(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _MajorInfo_title(ctx context.Context, field graphql.CollectedField, obj *model.MajorInfo) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_approveTopic,
+		ec.fieldContext_MajorInfo_title,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().ApproveTopic(ctx, fc.Args["id"].(string))
+			return obj.Title, nil
 		},
 		nil,
-		ec.marshalNTopic2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopic,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_approveTopic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MajorInfo_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MajorInfo",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "total":
-				return ec.fieldContext_Topic_total(ctx, field)
-			case "id":
-				return ec.fieldContext_Topic_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Topic_title(ctx, field)
-			case "majorCode":
-				return ec.fieldContext_Topic_majorCode(ctx, field)
-			case "semesterCode":
-				return ec.fieldContext_Topic_semesterCode(ctx, field)
-			case "status":
-				return ec.fieldContext_Topic_status(ctx, field)
-			case "percentStage1":
-				return ec.fieldContext_Topic_percentStage1(ctx, field)
-			case "percentStage2":
-				return ec.fieldContext_Topic_percentStage2(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Topic_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Topic_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Topic_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Topic_updatedBy(ctx, field)
-			case "files":
-				return ec.fieldContext_Topic_files(ctx, field)
-			case "topicCouncils":
-				return ec.fieldContext_Topic_topicCouncils(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_approveTopic_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_rejectTopic(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _MajorInfo_facultyCode(ctx context.Context, field graphql.CollectedField, obj *model.MajorInfo) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_rejectTopic,
+		ec.fieldContext_MajorInfo_facultyCode,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().RejectTopic(ctx, fc.Args["id"].(string), fc.Args["reason"].(*string))
+			return obj.FacultyCode, nil
 		},
 		nil,
-		ec.marshalNTopic2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopic,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_rejectTopic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MajorInfo_facultyCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MajorInfo",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "total":
-				return ec.fieldContext_Topic_total(ctx, field)
-			case "id":
-				return ec.fieldContext_Topic_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Topic_title(ctx, field)
-			case "majorCode":
-				return ec.fieldContext_Topic_majorCode(ctx, field)
-			case "semesterCode":
-				return ec.fieldContext_Topic_semesterCode(ctx, field)
-			case "status":
-				return ec.fieldContext_Topic_status(ctx, field)
-			case "percentStage1":
-				return ec.fieldContext_Topic_percentStage1(ctx, field)
-			case "percentStage2":
-				return ec.fieldContext_Topic_percentStage2(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Topic_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Topic_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Topic_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Topic_updatedBy(ctx, field)
-			case "files":
-				return ec.fieldContext_Topic_files(ctx, field)
-			case "topicCouncils":
-				return ec.fieldContext_Topic_topicCouncils(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_rejectTopic_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateTopic(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _MajorListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.MajorListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_updateTopic,
+		ec.fieldContext_MajorListResponse_total,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdateTopic(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateTopicInput))
+			return obj.Total, nil
 		},
 		nil,
-		ec.marshalNTopic2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopic,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateTopic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MajorListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MajorListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "total":
-				return ec.fieldContext_Topic_total(ctx, field)
-			case "id":
-				return ec.fieldContext_Topic_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Topic_title(ctx, field)
-			case "majorCode":
-				return ec.fieldContext_Topic_majorCode(ctx, field)
-			case "semesterCode":
-				return ec.fieldContext_Topic_semesterCode(ctx, field)
-			case "status":
-				return ec.fieldContext_Topic_status(ctx, field)
-			case "percentStage1":
-				return ec.fieldContext_Topic_percentStage1(ctx, field)
-			case "percentStage2":
-				return ec.fieldContext_Topic_percentStage2(ctx, field)
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MajorListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.MajorListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MajorListResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalNMajor2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐMajorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_MajorListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MajorListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Major_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Major_title(ctx, field)
+			case "facultyCode":
+				return ec.fieldContext_Major_facultyCode(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Topic_createdAt(ctx, field)
+				return ec.fieldContext_Major_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Topic_updatedAt(ctx, field)
+				return ec.fieldContext_Major_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Topic_createdBy(ctx, field)
+				return ec.fieldContext_Major_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Topic_updatedBy(ctx, field)
-			case "files":
-				return ec.fieldContext_Topic_files(ctx, field)
-			case "topicCouncils":
-				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+				return ec.fieldContext_Major_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Major_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Major_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Major_deletedBy(ctx, field)
+			case "topics":
+				return ec.fieldContext_Major_topics(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Major", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateTopic_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteTopic(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _MidtermListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.MidtermListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_deleteTopic,
+		ec.fieldContext_MidtermListResponse_total,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().DeleteTopic(ctx, fc.Args["id"].(string))
+			return obj.Total, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteTopic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MidtermListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MidtermListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteTopic_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createCouncil(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _MidtermListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.MidtermListResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_createCouncil,
+		ec.fieldContext_MidtermListResponse_data,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreateCouncil(ctx, fc.Args["input"].(model.CreateCouncilInput))
+			return obj.Data, nil
 		},
 		nil,
-		ec.marshalNCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil,
+		ec.marshalNMidterm2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐMidtermᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createCouncil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MidtermListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MidtermListResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "total":
-				return ec.fieldContext_Council_total(ctx, field)
 			case "id":
-				return ec.fieldContext_Council_id(ctx, field)
+				return ec.fieldContext_Midterm_id(ctx, field)
 			case "title":
-				return ec.fieldContext_Council_title(ctx, field)
-			case "majorCode":
-				return ec.fieldContext_Council_majorCode(ctx, field)
-			case "semesterCode":
-				return ec.fieldContext_Council_semesterCode(ctx, field)
-			case "timeStart":
-				return ec.fieldContext_Council_timeStart(ctx, field)
+				return ec.fieldContext_Midterm_title(ctx, field)
+			case "grade":
+				return ec.fieldContext_Midterm_grade(ctx, field)
+			case "status":
+				return ec.fieldContext_Midterm_status(ctx, field)
+			case "feedback":
+				return ec.fieldContext_Midterm_feedback(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Council_createdAt(ctx, field)
+				return ec.fieldContext_Midterm_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Council_updatedAt(ctx, field)
+				return ec.fieldContext_Midterm_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Council_createdBy(ctx, field)
+				return ec.fieldContext_Midterm_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Council_updatedBy(ctx, field)
-			case "defences":
-				return ec.fieldContext_Council_defences(ctx, field)
-			case "topicCouncils":
-				return ec.fieldContext_Council_topicCouncils(ctx, field)
+				return ec.fieldContext_Midterm_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Midterm_version(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Council", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Midterm", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createCouncil_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation__empty(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation__empty,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Mutation().Empty(ctx)
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation__empty(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateDepartmentCouncil(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createTeacher(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_updateDepartmentCouncil,
+		ec.fieldContext_Mutation_createTeacher,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdateDepartmentCouncil(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateCouncilInput))
+			return ec.resolvers.Mutation().CreateTeacher(ctx, fc.Args["input"].(model.CreateTeacherInput))
 		},
 		nil,
-		ec.marshalNCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil,
+		ec.marshalNTeacher2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacher,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateDepartmentCouncil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createTeacher(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -3801,32 +3849,36 @@ func (ec *executionContext) fieldContext_Mutation_updateDepartmentCouncil(ctx co
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "total":
-				return ec.fieldContext_Council_total(ctx, field)
 			case "id":
-				return ec.fieldContext_Council_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Council_title(ctx, field)
+				return ec.fieldContext_Teacher_id(ctx, field)
+			case "email":
+				return ec.fieldContext_Teacher_email(ctx, field)
+			case "username":
+				return ec.fieldContext_Teacher_username(ctx, field)
+			case "gender":
+				return ec.fieldContext_Teacher_gender(ctx, field)
 			case "majorCode":
-				return ec.fieldContext_Council_majorCode(ctx, field)
+				return ec.fieldContext_Teacher_majorCode(ctx, field)
 			case "semesterCode":
-				return ec.fieldContext_Council_semesterCode(ctx, field)
-			case "timeStart":
-				return ec.fieldContext_Council_timeStart(ctx, field)
+				return ec.fieldContext_Teacher_semesterCode(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Council_createdAt(ctx, field)
+				return ec.fieldContext_Teacher_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Council_updatedAt(ctx, field)
+				return ec.fieldContext_Teacher_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Council_createdBy(ctx, field)
+				return ec.fieldContext_Teacher_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Council_updatedBy(ctx, field)
-			case "defences":
-				return ec.fieldContext_Council_defences(ctx, field)
-			case "topicCouncils":
-				return ec.fieldContext_Council_topicCouncils(ctx, field)
+				return ec.fieldContext_Teacher_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Teacher_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Teacher_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Teacher_deletedBy(ctx, field)
+			case "roles":
+				return ec.fieldContext_Teacher_roles(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Council", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Teacher", field.Name)
 		},
 	}
 	defer func() {
@@ -3836,31 +3888,31 @@ func (ec *executionContext) fieldContext_Mutation_updateDepartmentCouncil(ctx co
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateDepartmentCouncil_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_createTeacher_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_addDefenceToCouncil(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateTeacher(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_addDefenceToCouncil,
+		ec.fieldContext_Mutation_updateTeacher,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().AddDefenceToCouncil(ctx, fc.Args["input"].(model.CreateDefenceInput))
+			return ec.resolvers.Mutation().UpdateTeacher(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateTeacherInput))
 		},
 		nil,
-		ec.marshalNDefence2ᚖthailyᚋsrcᚋgraphᚋmodelᚐDefence,
+		ec.marshalNTeacher2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacher,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_addDefenceToCouncil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updateTeacher(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -3869,31 +3921,35 @@ func (ec *executionContext) fieldContext_Mutation_addDefenceToCouncil(ctx contex
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Defence_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Defence_title(ctx, field)
-			case "councilCode":
-				return ec.fieldContext_Defence_councilCode(ctx, field)
-			case "teacherCode":
-				return ec.fieldContext_Defence_teacherCode(ctx, field)
-			case "position":
-				return ec.fieldContext_Defence_position(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Defence_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Defence_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Defence_createdBy(ctx, field)
+				return ec.fieldContext_Teacher_id(ctx, field)
+			case "email":
+				return ec.fieldContext_Teacher_email(ctx, field)
+			case "username":
+				return ec.fieldContext_Teacher_username(ctx, field)
+			case "gender":
+				return ec.fieldContext_Teacher_gender(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Teacher_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Teacher_semesterCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Teacher_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Teacher_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Teacher_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Defence_updatedBy(ctx, field)
-			case "council":
-				return ec.fieldContext_Defence_council(ctx, field)
-			case "teacher":
-				return ec.fieldContext_Defence_teacher(ctx, field)
-			case "gradeDefences":
-				return ec.fieldContext_Defence_gradeDefences(ctx, field)
+				return ec.fieldContext_Teacher_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Teacher_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Teacher_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Teacher_deletedBy(ctx, field)
+			case "roles":
+				return ec.fieldContext_Teacher_roles(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Defence", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Teacher", field.Name)
 		},
 	}
 	defer func() {
@@ -3903,22 +3959,22 @@ func (ec *executionContext) fieldContext_Mutation_addDefenceToCouncil(ctx contex
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_addDefenceToCouncil_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_updateTeacher_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_removeDefenceFromCouncil(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_deleteTeacher(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_removeDefenceFromCouncil,
+		ec.fieldContext_Mutation_deleteTeacher,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().RemoveDefenceFromCouncil(ctx, fc.Args["id"].(string))
+			return ec.resolvers.Mutation().DeleteTeacher(ctx, fc.Args["id"].(string))
 		},
 		nil,
 		ec.marshalNBoolean2bool,
@@ -3927,7 +3983,7 @@ func (ec *executionContext) _Mutation_removeDefenceFromCouncil(ctx context.Conte
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_removeDefenceFromCouncil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deleteTeacher(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -3944,31 +4000,31 @@ func (ec *executionContext) fieldContext_Mutation_removeDefenceFromCouncil(ctx c
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_removeDefenceFromCouncil_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_deleteTeacher_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_approveTopicStage1(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_restoreTeacher(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_approveTopicStage1,
+		ec.fieldContext_Mutation_restoreTeacher,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().ApproveTopicStage1(ctx, fc.Args["id"].(string))
+			return ec.resolvers.Mutation().RestoreTeacher(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalNTopic2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopic,
+		ec.marshalNTeacher2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacher,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_approveTopicStage1(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_restoreTeacher(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -3976,36 +4032,36 @@ func (ec *executionContext) fieldContext_Mutation_approveTopicStage1(ctx context
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "total":
-				return ec.fieldContext_Topic_total(ctx, field)
 			case "id":
-				return ec.fieldContext_Topic_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Topic_title(ctx, field)
+				return ec.fieldContext_Teacher_id(ctx, field)
+			case "email":
+				return ec.fieldContext_Teacher_email(ctx, field)
+			case "username":
+				return ec.fieldContext_Teacher_username(ctx, field)
+			case "gender":
+				return ec.fieldContext_Teacher_gender(ctx, field)
 			case "majorCode":
-				return ec.fieldContext_Topic_majorCode(ctx, field)
+				return ec.fieldContext_Teacher_majorCode(ctx, field)
 			case "semesterCode":
-				return ec.fieldContext_Topic_semesterCode(ctx, field)
-			case "status":
-				return ec.fieldContext_Topic_status(ctx, field)
-			case "percentStage1":
-				return ec.fieldContext_Topic_percentStage1(ctx, field)
-			case "percentStage2":
-				return ec.fieldContext_Topic_percentStage2(ctx, field)
+				return ec.fieldContext_Teacher_semesterCode(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Topic_createdAt(ctx, field)
+				return ec.fieldContext_Teacher_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Topic_updatedAt(ctx, field)
+				return ec.fieldContext_Teacher_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Topic_createdBy(ctx, field)
+				return ec.fieldContext_Teacher_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Topic_updatedBy(ctx, field)
-			case "files":
-				return ec.fieldContext_Topic_files(ctx, field)
-			case "topicCouncils":
-				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+				return ec.fieldContext_Teacher_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Teacher_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Teacher_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Teacher_deletedBy(ctx, field)
+			case "roles":
+				return ec.fieldContext_Teacher_roles(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Teacher", field.Name)
 		},
 	}
 	defer func() {
@@ -4015,31 +4071,31 @@ func (ec *executionContext) fieldContext_Mutation_approveTopicStage1(ctx context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_approveTopicStage1_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_restoreTeacher_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_rejectTopicStage1(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createStudent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_rejectTopicStage1,
+		ec.fieldContext_Mutation_createStudent,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().RejectTopicStage1(ctx, fc.Args["id"].(string), fc.Args["reason"].(*string))
+			return ec.resolvers.Mutation().CreateStudent(ctx, fc.Args["input"].(model.CreateStudentInput))
 		},
 		nil,
-		ec.marshalNTopic2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopic,
+		ec.marshalNStudent2ᚖthailyᚋsrcᚋgraphᚋmodelᚐStudent,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_rejectTopicStage1(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createStudent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -4047,36 +4103,40 @@ func (ec *executionContext) fieldContext_Mutation_rejectTopicStage1(ctx context.
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "total":
-				return ec.fieldContext_Topic_total(ctx, field)
 			case "id":
-				return ec.fieldContext_Topic_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Topic_title(ctx, field)
+				return ec.fieldContext_Student_id(ctx, field)
+			case "email":
+				return ec.fieldContext_Student_email(ctx, field)
+			case "phone":
+				return ec.fieldContext_Student_phone(ctx, field)
+			case "username":
+				return ec.fieldContext_Student_username(ctx, field)
+			case "gender":
+				return ec.fieldContext_Student_gender(ctx, field)
 			case "majorCode":
-				return ec.fieldContext_Topic_majorCode(ctx, field)
+				return ec.fieldContext_Student_majorCode(ctx, field)
+			case "classCode":
+				return ec.fieldContext_Student_classCode(ctx, field)
 			case "semesterCode":
-				return ec.fieldContext_Topic_semesterCode(ctx, field)
-			case "status":
-				return ec.fieldContext_Topic_status(ctx, field)
-			case "percentStage1":
-				return ec.fieldContext_Topic_percentStage1(ctx, field)
-			case "percentStage2":
-				return ec.fieldContext_Topic_percentStage2(ctx, field)
+				return ec.fieldContext_Student_semesterCode(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Topic_createdAt(ctx, field)
+				return ec.fieldContext_Student_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Topic_updatedAt(ctx, field)
+				return ec.fieldContext_Student_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Topic_createdBy(ctx, field)
+				return ec.fieldContext_Student_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Topic_updatedBy(ctx, field)
-			case "files":
-				return ec.fieldContext_Topic_files(ctx, field)
-			case "topicCouncils":
-				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+				return ec.fieldContext_Student_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Student_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Student_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Student_deletedBy(ctx, field)
+			case "enrollments":
+				return ec.fieldContext_Student_enrollments(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Student", field.Name)
 		},
 	}
 	defer func() {
@@ -4086,31 +4146,31 @@ func (ec *executionContext) fieldContext_Mutation_rejectTopicStage1(ctx context.
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_rejectTopicStage1_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_createStudent_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_assignTopicToCouncil(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateStudent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_assignTopicToCouncil,
+		ec.fieldContext_Mutation_updateStudent,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().AssignTopicToCouncil(ctx, fc.Args["topicCouncilId"].(string), fc.Args["councilId"].(string))
+			return ec.resolvers.Mutation().UpdateStudent(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateStudentInput))
 		},
 		nil,
-		ec.marshalNTopicCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicCouncil,
+		ec.marshalNStudent2ᚖthailyᚋsrcᚋgraphᚋmodelᚐStudent,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_assignTopicToCouncil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updateStudent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -4119,37 +4179,39 @@ func (ec *executionContext) fieldContext_Mutation_assignTopicToCouncil(ctx conte
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_TopicCouncil_id(ctx, field)
-			case "title":
-				return ec.fieldContext_TopicCouncil_title(ctx, field)
-			case "stage":
-				return ec.fieldContext_TopicCouncil_stage(ctx, field)
-			case "topicCode":
-				return ec.fieldContext_TopicCouncil_topicCode(ctx, field)
-			case "councilCode":
-				return ec.fieldContext_TopicCouncil_councilCode(ctx, field)
-			case "timeStart":
-				return ec.fieldContext_TopicCouncil_timeStart(ctx, field)
-			case "timeEnd":
-				return ec.fieldContext_TopicCouncil_timeEnd(ctx, field)
+				return ec.fieldContext_Student_id(ctx, field)
+			case "email":
+				return ec.fieldContext_Student_email(ctx, field)
+			case "phone":
+				return ec.fieldContext_Student_phone(ctx, field)
+			case "username":
+				return ec.fieldContext_Student_username(ctx, field)
+			case "gender":
+				return ec.fieldContext_Student_gender(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Student_majorCode(ctx, field)
+			case "classCode":
+				return ec.fieldContext_Student_classCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Student_semesterCode(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_TopicCouncil_createdAt(ctx, field)
+				return ec.fieldContext_Student_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_TopicCouncil_updatedAt(ctx, field)
+				return ec.fieldContext_Student_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_TopicCouncil_createdBy(ctx, field)
+				return ec.fieldContext_Student_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_TopicCouncil_updatedBy(ctx, field)
-			case "topic":
-				return ec.fieldContext_TopicCouncil_topic(ctx, field)
-			case "council":
-				return ec.fieldContext_TopicCouncil_council(ctx, field)
+				return ec.fieldContext_Student_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Student_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Student_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Student_deletedBy(ctx, field)
 			case "enrollments":
-				return ec.fieldContext_TopicCouncil_enrollments(ctx, field)
-			case "supervisors":
-				return ec.fieldContext_TopicCouncil_supervisors(ctx, field)
+				return ec.fieldContext_Student_enrollments(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type TopicCouncil", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Student", field.Name)
 		},
 	}
 	defer func() {
@@ -4159,22 +4221,63 @@ func (ec *executionContext) fieldContext_Mutation_assignTopicToCouncil(ctx conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_assignTopicToCouncil_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_updateStudent_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateMyProfile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_deleteStudent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_updateMyProfile,
+		ec.fieldContext_Mutation_deleteStudent,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdateMyProfile(ctx, fc.Args["input"].(model.UpdateStudentProfileInput))
+			return ec.resolvers.Mutation().DeleteStudent(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_deleteStudent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteStudent_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_restoreStudent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_restoreStudent,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RestoreStudent(ctx, fc.Args["id"].(string))
 		},
 		nil,
 		ec.marshalNStudent2ᚖthailyᚋsrcᚋgraphᚋmodelᚐStudent,
@@ -4183,7 +4286,7 @@ func (ec *executionContext) _Mutation_updateMyProfile(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateMyProfile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_restoreStudent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -4215,6 +4318,12 @@ func (ec *executionContext) fieldContext_Mutation_updateMyProfile(ctx context.Co
 				return ec.fieldContext_Student_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Student_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Student_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Student_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Student_deletedBy(ctx, field)
 			case "enrollments":
 				return ec.fieldContext_Student_enrollments(ctx, field)
 			}
@@ -4228,31 +4337,31 @@ func (ec *executionContext) fieldContext_Mutation_updateMyProfile(ctx context.Co
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateMyProfile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_restoreStudent_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_uploadMidtermFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createSemester(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_uploadMidtermFile,
+		ec.fieldContext_Mutation_createSemester,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UploadMidtermFile(ctx, fc.Args["input"].(model.UploadFileInput))
+			return ec.resolvers.Mutation().CreateSemester(ctx, fc.Args["input"].(model.CreateSemesterInput))
 		},
 		nil,
-		ec.marshalNFile2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFile,
+		ec.marshalNSemester2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSemester,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_uploadMidtermFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createSemester(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -4261,29 +4370,31 @@ func (ec *executionContext) fieldContext_Mutation_uploadMidtermFile(ctx context.
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_File_id(ctx, field)
+				return ec.fieldContext_Semester_id(ctx, field)
 			case "title":
-				return ec.fieldContext_File_title(ctx, field)
-			case "file":
-				return ec.fieldContext_File_file(ctx, field)
-			case "status":
-				return ec.fieldContext_File_status(ctx, field)
-			case "table":
-				return ec.fieldContext_File_table(ctx, field)
-			case "option":
-				return ec.fieldContext_File_option(ctx, field)
-			case "tableId":
-				return ec.fieldContext_File_tableId(ctx, field)
+				return ec.fieldContext_Semester_title(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_File_createdAt(ctx, field)
+				return ec.fieldContext_Semester_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_File_updatedAt(ctx, field)
+				return ec.fieldContext_Semester_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_File_createdBy(ctx, field)
+				return ec.fieldContext_Semester_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_File_updatedBy(ctx, field)
+				return ec.fieldContext_Semester_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Semester_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Semester_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Semester_deletedBy(ctx, field)
+			case "students":
+				return ec.fieldContext_Semester_students(ctx, field)
+			case "teachers":
+				return ec.fieldContext_Semester_teachers(ctx, field)
+			case "topics":
+				return ec.fieldContext_Semester_topics(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Semester", field.Name)
 		},
 	}
 	defer func() {
@@ -4293,31 +4404,31 @@ func (ec *executionContext) fieldContext_Mutation_uploadMidtermFile(ctx context.
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_uploadMidtermFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_createSemester_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_uploadFinalFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateSemester(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_uploadFinalFile,
+		ec.fieldContext_Mutation_updateSemester,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UploadFinalFile(ctx, fc.Args["input"].(model.UploadFileInput))
+			return ec.resolvers.Mutation().UpdateSemester(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateSemesterInput))
 		},
 		nil,
-		ec.marshalNFile2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFile,
+		ec.marshalNSemester2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSemester,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_uploadFinalFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updateSemester(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -4326,29 +4437,31 @@ func (ec *executionContext) fieldContext_Mutation_uploadFinalFile(ctx context.Co
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_File_id(ctx, field)
+				return ec.fieldContext_Semester_id(ctx, field)
 			case "title":
-				return ec.fieldContext_File_title(ctx, field)
-			case "file":
-				return ec.fieldContext_File_file(ctx, field)
-			case "status":
-				return ec.fieldContext_File_status(ctx, field)
-			case "table":
-				return ec.fieldContext_File_table(ctx, field)
-			case "option":
-				return ec.fieldContext_File_option(ctx, field)
-			case "tableId":
-				return ec.fieldContext_File_tableId(ctx, field)
+				return ec.fieldContext_Semester_title(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_File_createdAt(ctx, field)
+				return ec.fieldContext_Semester_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_File_updatedAt(ctx, field)
+				return ec.fieldContext_Semester_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_File_createdBy(ctx, field)
+				return ec.fieldContext_Semester_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_File_updatedBy(ctx, field)
+				return ec.fieldContext_Semester_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Semester_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Semester_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Semester_deletedBy(ctx, field)
+			case "students":
+				return ec.fieldContext_Semester_students(ctx, field)
+			case "teachers":
+				return ec.fieldContext_Semester_teachers(ctx, field)
+			case "topics":
+				return ec.fieldContext_Semester_topics(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Semester", field.Name)
 		},
 	}
 	defer func() {
@@ -4358,62 +4471,38 @@ func (ec *executionContext) fieldContext_Mutation_uploadFinalFile(ctx context.Co
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_uploadFinalFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_updateSemester_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateMyTeacherProfile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_deleteSemester(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_updateMyTeacherProfile,
+		ec.fieldContext_Mutation_deleteSemester,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdateMyTeacherProfile(ctx, fc.Args["input"].(model.UpdateTeacherProfileInput))
+			return ec.resolvers.Mutation().DeleteSemester(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalNTeacher2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacher,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateMyTeacherProfile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deleteSemester(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Teacher_id(ctx, field)
-			case "email":
-				return ec.fieldContext_Teacher_email(ctx, field)
-			case "username":
-				return ec.fieldContext_Teacher_username(ctx, field)
-			case "gender":
-				return ec.fieldContext_Teacher_gender(ctx, field)
-			case "majorCode":
-				return ec.fieldContext_Teacher_majorCode(ctx, field)
-			case "semesterCode":
-				return ec.fieldContext_Teacher_semesterCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Teacher_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Teacher_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Teacher_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Teacher_updatedBy(ctx, field)
-			case "roles":
-				return ec.fieldContext_Teacher_roles(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Teacher", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	defer func() {
@@ -4423,31 +4512,31 @@ func (ec *executionContext) fieldContext_Mutation_updateMyTeacherProfile(ctx con
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateMyTeacherProfile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_deleteSemester_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_gradeMidterm(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_restoreSemester(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_gradeMidterm,
+		ec.fieldContext_Mutation_restoreSemester,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().GradeMidterm(ctx, fc.Args["enrollmentId"].(string), fc.Args["input"].(model.GradeMidtermInput))
+			return ec.resolvers.Mutation().RestoreSemester(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalNMidterm2ᚖthailyᚋsrcᚋgraphᚋmodelᚐMidterm,
+		ec.marshalNSemester2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSemester,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_gradeMidterm(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_restoreSemester(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -4456,25 +4545,31 @@ func (ec *executionContext) fieldContext_Mutation_gradeMidterm(ctx context.Conte
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Midterm_id(ctx, field)
+				return ec.fieldContext_Semester_id(ctx, field)
 			case "title":
-				return ec.fieldContext_Midterm_title(ctx, field)
-			case "grade":
-				return ec.fieldContext_Midterm_grade(ctx, field)
-			case "status":
-				return ec.fieldContext_Midterm_status(ctx, field)
-			case "feedback":
-				return ec.fieldContext_Midterm_feedback(ctx, field)
+				return ec.fieldContext_Semester_title(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Midterm_createdAt(ctx, field)
+				return ec.fieldContext_Semester_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Midterm_updatedAt(ctx, field)
+				return ec.fieldContext_Semester_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Midterm_createdBy(ctx, field)
+				return ec.fieldContext_Semester_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Midterm_updatedBy(ctx, field)
+				return ec.fieldContext_Semester_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Semester_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Semester_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Semester_deletedBy(ctx, field)
+			case "students":
+				return ec.fieldContext_Semester_students(ctx, field)
+			case "teachers":
+				return ec.fieldContext_Semester_teachers(ctx, field)
+			case "topics":
+				return ec.fieldContext_Semester_topics(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Midterm", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Semester", field.Name)
 		},
 	}
 	defer func() {
@@ -4484,31 +4579,31 @@ func (ec *executionContext) fieldContext_Mutation_gradeMidterm(ctx context.Conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_gradeMidterm_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_restoreSemester_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_feedbackMidterm(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createMajor(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_feedbackMidterm,
+		ec.fieldContext_Mutation_createMajor,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().FeedbackMidterm(ctx, fc.Args["midtermId"].(string), fc.Args["feedback"].(string))
+			return ec.resolvers.Mutation().CreateMajor(ctx, fc.Args["input"].(model.CreateMajorInput))
 		},
 		nil,
-		ec.marshalNMidterm2ᚖthailyᚋsrcᚋgraphᚋmodelᚐMidterm,
+		ec.marshalNMajor2ᚖthailyᚋsrcᚋgraphᚋmodelᚐMajor,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_feedbackMidterm(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createMajor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -4517,25 +4612,29 @@ func (ec *executionContext) fieldContext_Mutation_feedbackMidterm(ctx context.Co
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Midterm_id(ctx, field)
+				return ec.fieldContext_Major_id(ctx, field)
 			case "title":
-				return ec.fieldContext_Midterm_title(ctx, field)
-			case "grade":
-				return ec.fieldContext_Midterm_grade(ctx, field)
-			case "status":
-				return ec.fieldContext_Midterm_status(ctx, field)
-			case "feedback":
-				return ec.fieldContext_Midterm_feedback(ctx, field)
+				return ec.fieldContext_Major_title(ctx, field)
+			case "facultyCode":
+				return ec.fieldContext_Major_facultyCode(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Midterm_createdAt(ctx, field)
+				return ec.fieldContext_Major_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Midterm_updatedAt(ctx, field)
+				return ec.fieldContext_Major_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Midterm_createdBy(ctx, field)
+				return ec.fieldContext_Major_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Midterm_updatedBy(ctx, field)
+				return ec.fieldContext_Major_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Major_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Major_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Major_deletedBy(ctx, field)
+			case "topics":
+				return ec.fieldContext_Major_topics(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Midterm", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Major", field.Name)
 		},
 	}
 	defer func() {
@@ -4545,31 +4644,31 @@ func (ec *executionContext) fieldContext_Mutation_feedbackMidterm(ctx context.Co
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_feedbackMidterm_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_createMajor_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_gradeFinal(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateMajor(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_gradeFinal,
+		ec.fieldContext_Mutation_updateMajor,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().GradeFinal(ctx, fc.Args["enrollmentId"].(string), fc.Args["input"].(model.GradeFinalInput))
+			return ec.resolvers.Mutation().UpdateMajor(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateMajorInput))
 		},
 		nil,
-		ec.marshalNFinal2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFinal,
+		ec.marshalNMajor2ᚖthailyᚋsrcᚋgraphᚋmodelᚐMajor,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_gradeFinal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updateMajor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -4578,31 +4677,29 @@ func (ec *executionContext) fieldContext_Mutation_gradeFinal(ctx context.Context
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Final_id(ctx, field)
+				return ec.fieldContext_Major_id(ctx, field)
 			case "title":
-				return ec.fieldContext_Final_title(ctx, field)
-			case "supervisorGrade":
-				return ec.fieldContext_Final_supervisorGrade(ctx, field)
-			case "departmentGrade":
-				return ec.fieldContext_Final_departmentGrade(ctx, field)
-			case "finalGrade":
-				return ec.fieldContext_Final_finalGrade(ctx, field)
-			case "status":
-				return ec.fieldContext_Final_status(ctx, field)
-			case "notes":
-				return ec.fieldContext_Final_notes(ctx, field)
-			case "completionDate":
-				return ec.fieldContext_Final_completionDate(ctx, field)
+				return ec.fieldContext_Major_title(ctx, field)
+			case "facultyCode":
+				return ec.fieldContext_Major_facultyCode(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Final_createdAt(ctx, field)
+				return ec.fieldContext_Major_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Final_updatedAt(ctx, field)
+				return ec.fieldContext_Major_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Final_createdBy(ctx, field)
+				return ec.fieldContext_Major_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Final_updatedBy(ctx, field)
+				return ec.fieldContext_Major_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Major_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Major_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Major_deletedBy(ctx, field)
+			case "topics":
+				return ec.fieldContext_Major_topics(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Final", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Major", field.Name)
 		},
 	}
 	defer func() {
@@ -4612,64 +4709,38 @@ func (ec *executionContext) fieldContext_Mutation_gradeFinal(ctx context.Context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_gradeFinal_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_updateMajor_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_feedbackFinal(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_deleteMajor(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_feedbackFinal,
+		ec.fieldContext_Mutation_deleteMajor,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().FeedbackFinal(ctx, fc.Args["finalId"].(string), fc.Args["notes"].(string))
+			return ec.resolvers.Mutation().DeleteMajor(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalNFinal2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFinal,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_feedbackFinal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deleteMajor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Final_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Final_title(ctx, field)
-			case "supervisorGrade":
-				return ec.fieldContext_Final_supervisorGrade(ctx, field)
-			case "departmentGrade":
-				return ec.fieldContext_Final_departmentGrade(ctx, field)
-			case "finalGrade":
-				return ec.fieldContext_Final_finalGrade(ctx, field)
-			case "status":
-				return ec.fieldContext_Final_status(ctx, field)
-			case "notes":
-				return ec.fieldContext_Final_notes(ctx, field)
-			case "completionDate":
-				return ec.fieldContext_Final_completionDate(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Final_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Final_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Final_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Final_updatedBy(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Final", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	defer func() {
@@ -4679,31 +4750,31 @@ func (ec *executionContext) fieldContext_Mutation_feedbackFinal(ctx context.Cont
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_feedbackFinal_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_deleteMajor_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_approveMidtermFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_restoreMajor(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_approveMidtermFile,
+		ec.fieldContext_Mutation_restoreMajor,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().ApproveMidtermFile(ctx, fc.Args["fileId"].(string))
+			return ec.resolvers.Mutation().RestoreMajor(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalNFile2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFile,
+		ec.marshalNMajor2ᚖthailyᚋsrcᚋgraphᚋmodelᚐMajor,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_approveMidtermFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_restoreMajor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -4712,29 +4783,29 @@ func (ec *executionContext) fieldContext_Mutation_approveMidtermFile(ctx context
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_File_id(ctx, field)
+				return ec.fieldContext_Major_id(ctx, field)
 			case "title":
-				return ec.fieldContext_File_title(ctx, field)
-			case "file":
-				return ec.fieldContext_File_file(ctx, field)
-			case "status":
-				return ec.fieldContext_File_status(ctx, field)
-			case "table":
-				return ec.fieldContext_File_table(ctx, field)
-			case "option":
-				return ec.fieldContext_File_option(ctx, field)
-			case "tableId":
-				return ec.fieldContext_File_tableId(ctx, field)
+				return ec.fieldContext_Major_title(ctx, field)
+			case "facultyCode":
+				return ec.fieldContext_Major_facultyCode(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_File_createdAt(ctx, field)
+				return ec.fieldContext_Major_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_File_updatedAt(ctx, field)
+				return ec.fieldContext_Major_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_File_createdBy(ctx, field)
+				return ec.fieldContext_Major_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_File_updatedBy(ctx, field)
+				return ec.fieldContext_Major_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Major_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Major_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Major_deletedBy(ctx, field)
+			case "topics":
+				return ec.fieldContext_Major_topics(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Major", field.Name)
 		},
 	}
 	defer func() {
@@ -4744,31 +4815,31 @@ func (ec *executionContext) fieldContext_Mutation_approveMidtermFile(ctx context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_approveMidtermFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_restoreMajor_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_rejectMidtermFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createFaculty(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_rejectMidtermFile,
+		ec.fieldContext_Mutation_createFaculty,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().RejectMidtermFile(ctx, fc.Args["fileId"].(string), fc.Args["reason"].(*string))
+			return ec.resolvers.Mutation().CreateFaculty(ctx, fc.Args["input"].(model.CreateFacultyInput))
 		},
 		nil,
-		ec.marshalNFile2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFile,
+		ec.marshalNFaculty2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFaculty,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_rejectMidtermFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createFaculty(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -4777,29 +4848,23 @@ func (ec *executionContext) fieldContext_Mutation_rejectMidtermFile(ctx context.
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_File_id(ctx, field)
+				return ec.fieldContext_Faculty_id(ctx, field)
 			case "title":
-				return ec.fieldContext_File_title(ctx, field)
-			case "file":
-				return ec.fieldContext_File_file(ctx, field)
-			case "status":
-				return ec.fieldContext_File_status(ctx, field)
-			case "table":
-				return ec.fieldContext_File_table(ctx, field)
-			case "option":
-				return ec.fieldContext_File_option(ctx, field)
-			case "tableId":
-				return ec.fieldContext_File_tableId(ctx, field)
+				return ec.fieldContext_Faculty_title(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_File_createdAt(ctx, field)
+				return ec.fieldContext_Faculty_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_File_updatedAt(ctx, field)
+				return ec.fieldContext_Faculty_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_File_createdBy(ctx, field)
+				return ec.fieldContext_Faculty_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_File_updatedBy(ctx, field)
+				return ec.fieldContext_Faculty_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Faculty_version(ctx, field)
+			case "majors":
+				return ec.fieldContext_Faculty_majors(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Faculty", field.Name)
 		},
 	}
 	defer func() {
@@ -4809,31 +4874,31 @@ func (ec *executionContext) fieldContext_Mutation_rejectMidtermFile(ctx context.
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_rejectMidtermFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_createFaculty_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_approveFinalFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateFaculty(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_approveFinalFile,
+		ec.fieldContext_Mutation_updateFaculty,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().ApproveFinalFile(ctx, fc.Args["fileId"].(string))
+			return ec.resolvers.Mutation().UpdateFaculty(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateFacultyInput))
 		},
 		nil,
-		ec.marshalNFile2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFile,
+		ec.marshalNFaculty2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFaculty,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_approveFinalFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updateFaculty(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -4842,29 +4907,23 @@ func (ec *executionContext) fieldContext_Mutation_approveFinalFile(ctx context.C
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_File_id(ctx, field)
+				return ec.fieldContext_Faculty_id(ctx, field)
 			case "title":
-				return ec.fieldContext_File_title(ctx, field)
-			case "file":
-				return ec.fieldContext_File_file(ctx, field)
-			case "status":
-				return ec.fieldContext_File_status(ctx, field)
-			case "table":
-				return ec.fieldContext_File_table(ctx, field)
-			case "option":
-				return ec.fieldContext_File_option(ctx, field)
-			case "tableId":
-				return ec.fieldContext_File_tableId(ctx, field)
+				return ec.fieldContext_Faculty_title(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_File_createdAt(ctx, field)
+				return ec.fieldContext_Faculty_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_File_updatedAt(ctx, field)
+				return ec.fieldContext_Faculty_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_File_createdBy(ctx, field)
+				return ec.fieldContext_Faculty_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_File_updatedBy(ctx, field)
+				return ec.fieldContext_Faculty_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Faculty_version(ctx, field)
+			case "majors":
+				return ec.fieldContext_Faculty_majors(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Faculty", field.Name)
 		},
 	}
 	defer func() {
@@ -4874,62 +4933,38 @@ func (ec *executionContext) fieldContext_Mutation_approveFinalFile(ctx context.C
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_approveFinalFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_updateFaculty_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_rejectFinalFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_deleteFaculty(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_rejectFinalFile,
+		ec.fieldContext_Mutation_deleteFaculty,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().RejectFinalFile(ctx, fc.Args["fileId"].(string), fc.Args["reason"].(*string))
+			return ec.resolvers.Mutation().DeleteFaculty(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalNFile2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFile,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_rejectFinalFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deleteFaculty(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_File_id(ctx, field)
-			case "title":
-				return ec.fieldContext_File_title(ctx, field)
-			case "file":
-				return ec.fieldContext_File_file(ctx, field)
-			case "status":
-				return ec.fieldContext_File_status(ctx, field)
-			case "table":
-				return ec.fieldContext_File_table(ctx, field)
-			case "option":
-				return ec.fieldContext_File_option(ctx, field)
-			case "tableId":
-				return ec.fieldContext_File_tableId(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_File_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_File_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_File_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_File_updatedBy(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	defer func() {
@@ -4939,31 +4974,31 @@ func (ec *executionContext) fieldContext_Mutation_rejectFinalFile(ctx context.Co
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_rejectFinalFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_deleteFaculty_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createGradeDefence(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_approveCouncil(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_createGradeDefence,
+		ec.fieldContext_Mutation_approveCouncil,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreateGradeDefence(ctx, fc.Args["input"].(model.CreateGradeDefenceInput))
+			return ec.resolvers.Mutation().ApproveCouncil(ctx, fc.Args["id"].(string), fc.Args["timeStart"].(time.Time))
 		},
 		nil,
-		ec.marshalNGradeDefence2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefence,
+		ec.marshalNCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createGradeDefence(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_approveCouncil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -4971,32 +5006,48 @@ func (ec *executionContext) fieldContext_Mutation_createGradeDefence(ctx context
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "total":
+				return ec.fieldContext_Council_total(ctx, field)
 			case "id":
-				return ec.fieldContext_GradeDefence_id(ctx, field)
-			case "defenceCode":
-				return ec.fieldContext_GradeDefence_defenceCode(ctx, field)
-			case "enrollmentCode":
-				return ec.fieldContext_GradeDefence_enrollmentCode(ctx, field)
-			case "note":
-				return ec.fieldContext_GradeDefence_note(ctx, field)
-			case "totalScore":
-				return ec.fieldContext_GradeDefence_totalScore(ctx, field)
+				return ec.fieldContext_Council_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Council_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Council_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Council_semesterCode(ctx, field)
+			case "timeStart":
+				return ec.fieldContext_Council_timeStart(ctx, field)
+			case "room":
+				return ec.fieldContext_Council_room(ctx, field)
+			case "gradesLocked":
+				return ec.fieldContext_Council_gradesLocked(ctx, field)
+			case "gradesLockedAt":
+				return ec.fieldContext_Council_gradesLockedAt(ctx, field)
+			case "gradesPublished":
+				return ec.fieldContext_Council_gradesPublished(ctx, field)
+			case "gradesPublishedAt":
+				return ec.fieldContext_Council_gradesPublishedAt(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_GradeDefence_createdAt(ctx, field)
+				return ec.fieldContext_Council_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_GradeDefence_updatedAt(ctx, field)
+				return ec.fieldContext_Council_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_GradeDefence_createdBy(ctx, field)
+				return ec.fieldContext_Council_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_GradeDefence_updatedBy(ctx, field)
-			case "defence":
-				return ec.fieldContext_GradeDefence_defence(ctx, field)
-			case "enrollment":
-				return ec.fieldContext_GradeDefence_enrollment(ctx, field)
-			case "criteria":
-				return ec.fieldContext_GradeDefence_criteria(ctx, field)
+				return ec.fieldContext_Council_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Council_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Council_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Council_deletedBy(ctx, field)
+			case "defences":
+				return ec.fieldContext_Council_defences(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Council_topicCouncils(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type GradeDefence", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Council", field.Name)
 		},
 	}
 	defer func() {
@@ -5006,31 +5057,31 @@ func (ec *executionContext) fieldContext_Mutation_createGradeDefence(ctx context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createGradeDefence_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_approveCouncil_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateGradeDefence(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateCouncil(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_updateGradeDefence,
+		ec.fieldContext_Mutation_updateCouncil,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdateGradeDefence(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateGradeDefenceInput))
+			return ec.resolvers.Mutation().UpdateCouncil(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateCouncilInput))
 		},
 		nil,
-		ec.marshalNGradeDefence2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefence,
+		ec.marshalNCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateGradeDefence(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updateCouncil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -5038,32 +5089,48 @@ func (ec *executionContext) fieldContext_Mutation_updateGradeDefence(ctx context
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "total":
+				return ec.fieldContext_Council_total(ctx, field)
 			case "id":
-				return ec.fieldContext_GradeDefence_id(ctx, field)
-			case "defenceCode":
-				return ec.fieldContext_GradeDefence_defenceCode(ctx, field)
-			case "enrollmentCode":
-				return ec.fieldContext_GradeDefence_enrollmentCode(ctx, field)
-			case "note":
-				return ec.fieldContext_GradeDefence_note(ctx, field)
-			case "totalScore":
-				return ec.fieldContext_GradeDefence_totalScore(ctx, field)
+				return ec.fieldContext_Council_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Council_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Council_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Council_semesterCode(ctx, field)
+			case "timeStart":
+				return ec.fieldContext_Council_timeStart(ctx, field)
+			case "room":
+				return ec.fieldContext_Council_room(ctx, field)
+			case "gradesLocked":
+				return ec.fieldContext_Council_gradesLocked(ctx, field)
+			case "gradesLockedAt":
+				return ec.fieldContext_Council_gradesLockedAt(ctx, field)
+			case "gradesPublished":
+				return ec.fieldContext_Council_gradesPublished(ctx, field)
+			case "gradesPublishedAt":
+				return ec.fieldContext_Council_gradesPublishedAt(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_GradeDefence_createdAt(ctx, field)
+				return ec.fieldContext_Council_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_GradeDefence_updatedAt(ctx, field)
+				return ec.fieldContext_Council_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_GradeDefence_createdBy(ctx, field)
+				return ec.fieldContext_Council_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_GradeDefence_updatedBy(ctx, field)
-			case "defence":
-				return ec.fieldContext_GradeDefence_defence(ctx, field)
-			case "enrollment":
-				return ec.fieldContext_GradeDefence_enrollment(ctx, field)
-			case "criteria":
-				return ec.fieldContext_GradeDefence_criteria(ctx, field)
+				return ec.fieldContext_Council_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Council_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Council_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Council_deletedBy(ctx, field)
+			case "defences":
+				return ec.fieldContext_Council_defences(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Council_topicCouncils(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type GradeDefence", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Council", field.Name)
 		},
 	}
 	defer func() {
@@ -5073,60 +5140,38 @@ func (ec *executionContext) fieldContext_Mutation_updateGradeDefence(ctx context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateGradeDefence_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_updateCouncil_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_addGradeDefenceCriterion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_deleteCouncil(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_addGradeDefenceCriterion,
+		ec.fieldContext_Mutation_deleteCouncil,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().AddGradeDefenceCriterion(ctx, fc.Args["input"].(model.CreateGradeDefenceCriterionInput))
+			return ec.resolvers.Mutation().DeleteCouncil(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalNGradeDefenceCriterion2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefenceCriterion,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_addGradeDefenceCriterion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deleteCouncil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_GradeDefenceCriterion_id(ctx, field)
-			case "gradeDefenceCode":
-				return ec.fieldContext_GradeDefenceCriterion_gradeDefenceCode(ctx, field)
-			case "name":
-				return ec.fieldContext_GradeDefenceCriterion_name(ctx, field)
-			case "score":
-				return ec.fieldContext_GradeDefenceCriterion_score(ctx, field)
-			case "maxScore":
-				return ec.fieldContext_GradeDefenceCriterion_maxScore(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_GradeDefenceCriterion_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_GradeDefenceCriterion_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_GradeDefenceCriterion_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_GradeDefenceCriterion_updatedBy(ctx, field)
-			case "gradeDefence":
-				return ec.fieldContext_GradeDefenceCriterion_gradeDefence(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type GradeDefenceCriterion", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	defer func() {
@@ -5136,31 +5181,31 @@ func (ec *executionContext) fieldContext_Mutation_addGradeDefenceCriterion(ctx c
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_addGradeDefenceCriterion_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_deleteCouncil_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateGradeDefenceCriterion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_restoreCouncil(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_updateGradeDefenceCriterion,
+		ec.fieldContext_Mutation_restoreCouncil,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdateGradeDefenceCriterion(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateGradeDefenceCriterionInput))
+			return ec.resolvers.Mutation().RestoreCouncil(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalNGradeDefenceCriterion2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefenceCriterion,
+		ec.marshalNCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateGradeDefenceCriterion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_restoreCouncil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -5168,28 +5213,48 @@ func (ec *executionContext) fieldContext_Mutation_updateGradeDefenceCriterion(ct
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "total":
+				return ec.fieldContext_Council_total(ctx, field)
 			case "id":
-				return ec.fieldContext_GradeDefenceCriterion_id(ctx, field)
-			case "gradeDefenceCode":
-				return ec.fieldContext_GradeDefenceCriterion_gradeDefenceCode(ctx, field)
-			case "name":
-				return ec.fieldContext_GradeDefenceCriterion_name(ctx, field)
-			case "score":
-				return ec.fieldContext_GradeDefenceCriterion_score(ctx, field)
-			case "maxScore":
-				return ec.fieldContext_GradeDefenceCriterion_maxScore(ctx, field)
+				return ec.fieldContext_Council_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Council_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Council_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Council_semesterCode(ctx, field)
+			case "timeStart":
+				return ec.fieldContext_Council_timeStart(ctx, field)
+			case "room":
+				return ec.fieldContext_Council_room(ctx, field)
+			case "gradesLocked":
+				return ec.fieldContext_Council_gradesLocked(ctx, field)
+			case "gradesLockedAt":
+				return ec.fieldContext_Council_gradesLockedAt(ctx, field)
+			case "gradesPublished":
+				return ec.fieldContext_Council_gradesPublished(ctx, field)
+			case "gradesPublishedAt":
+				return ec.fieldContext_Council_gradesPublishedAt(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_GradeDefenceCriterion_createdAt(ctx, field)
+				return ec.fieldContext_Council_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_GradeDefenceCriterion_updatedAt(ctx, field)
+				return ec.fieldContext_Council_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_GradeDefenceCriterion_createdBy(ctx, field)
+				return ec.fieldContext_Council_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_GradeDefenceCriterion_updatedBy(ctx, field)
-			case "gradeDefence":
-				return ec.fieldContext_GradeDefenceCriterion_gradeDefence(ctx, field)
+				return ec.fieldContext_Council_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Council_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Council_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Council_deletedBy(ctx, field)
+			case "defences":
+				return ec.fieldContext_Council_defences(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Council_topicCouncils(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type GradeDefenceCriterion", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Council", field.Name)
 		},
 	}
 	defer func() {
@@ -5199,38 +5264,80 @@ func (ec *executionContext) fieldContext_Mutation_updateGradeDefenceCriterion(ct
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateGradeDefenceCriterion_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_restoreCouncil_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteGradeDefenceCriterion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_approveTopic(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_deleteGradeDefenceCriterion,
+		ec.fieldContext_Mutation_approveTopic,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().DeleteGradeDefenceCriterion(ctx, fc.Args["id"].(string))
+			return ec.resolvers.Mutation().ApproveTopic(ctx, fc.Args["id"].(string), fc.Args["note"].(*string))
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNTopic2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopic,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteGradeDefenceCriterion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_approveTopic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_Topic_total(ctx, field)
+			case "id":
+				return ec.fieldContext_Topic_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Topic_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Topic_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Topic_semesterCode(ctx, field)
+			case "status":
+				return ec.fieldContext_Topic_status(ctx, field)
+			case "percentStage1":
+				return ec.fieldContext_Topic_percentStage1(ctx, field)
+			case "percentStage2":
+				return ec.fieldContext_Topic_percentStage2(ctx, field)
+			case "maxStudents":
+				return ec.fieldContext_Topic_maxStudents(ctx, field)
+			case "requiredSkills":
+				return ec.fieldContext_Topic_requiredSkills(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Topic_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Topic_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Topic_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Topic_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Topic_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Topic_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Topic_deletedBy(ctx, field)
+			case "files":
+				return ec.fieldContext_Topic_files(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Topic_statusHistory(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
 		},
 	}
 	defer func() {
@@ -5240,31 +5347,31 @@ func (ec *executionContext) fieldContext_Mutation_deleteGradeDefenceCriterion(ct
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteGradeDefenceCriterion_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_approveTopic_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateGradeReview(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_rejectTopic(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_updateGradeReview,
+		ec.fieldContext_Mutation_rejectTopic,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdateGradeReview(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateGradeReviewInput))
+			return ec.resolvers.Mutation().RejectTopic(ctx, fc.Args["id"].(string), fc.Args["reason"].(string))
 		},
 		nil,
-		ec.marshalNReviewerGradeReview2ᚖthailyᚋsrcᚋgraphᚋmodelᚐReviewerGradeReview,
+		ec.marshalNTopic2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopic,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateGradeReview(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_rejectTopic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -5272,28 +5379,48 @@ func (ec *executionContext) fieldContext_Mutation_updateGradeReview(ctx context.
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "total":
+				return ec.fieldContext_Topic_total(ctx, field)
 			case "id":
-				return ec.fieldContext_ReviewerGradeReview_id(ctx, field)
+				return ec.fieldContext_Topic_id(ctx, field)
 			case "title":
-				return ec.fieldContext_ReviewerGradeReview_title(ctx, field)
-			case "teacherCode":
-				return ec.fieldContext_ReviewerGradeReview_teacherCode(ctx, field)
-			case "reviewGrade":
-				return ec.fieldContext_ReviewerGradeReview_reviewGrade(ctx, field)
+				return ec.fieldContext_Topic_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Topic_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Topic_semesterCode(ctx, field)
 			case "status":
-				return ec.fieldContext_ReviewerGradeReview_status(ctx, field)
-			case "notes":
-				return ec.fieldContext_ReviewerGradeReview_notes(ctx, field)
-			case "completionDate":
-				return ec.fieldContext_ReviewerGradeReview_completionDate(ctx, field)
+				return ec.fieldContext_Topic_status(ctx, field)
+			case "percentStage1":
+				return ec.fieldContext_Topic_percentStage1(ctx, field)
+			case "percentStage2":
+				return ec.fieldContext_Topic_percentStage2(ctx, field)
+			case "maxStudents":
+				return ec.fieldContext_Topic_maxStudents(ctx, field)
+			case "requiredSkills":
+				return ec.fieldContext_Topic_requiredSkills(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_ReviewerGradeReview_createdAt(ctx, field)
+				return ec.fieldContext_Topic_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_ReviewerGradeReview_updatedAt(ctx, field)
-			case "enrollment":
-				return ec.fieldContext_ReviewerGradeReview_enrollment(ctx, field)
+				return ec.fieldContext_Topic_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Topic_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Topic_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Topic_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Topic_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Topic_deletedBy(ctx, field)
+			case "files":
+				return ec.fieldContext_Topic_files(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Topic_statusHistory(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ReviewerGradeReview", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
 		},
 	}
 	defer func() {
@@ -5303,31 +5430,31 @@ func (ec *executionContext) fieldContext_Mutation_updateGradeReview(ctx context.
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateGradeReview_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_rejectTopic_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_completeGradeReview(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_startTopic(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_completeGradeReview,
+		ec.fieldContext_Mutation_startTopic,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CompleteGradeReview(ctx, fc.Args["id"].(string))
+			return ec.resolvers.Mutation().StartTopic(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalNReviewerGradeReview2ᚖthailyᚋsrcᚋgraphᚋmodelᚐReviewerGradeReview,
+		ec.marshalNTopic2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopic,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_completeGradeReview(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_startTopic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -5335,28 +5462,48 @@ func (ec *executionContext) fieldContext_Mutation_completeGradeReview(ctx contex
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "total":
+				return ec.fieldContext_Topic_total(ctx, field)
 			case "id":
-				return ec.fieldContext_ReviewerGradeReview_id(ctx, field)
+				return ec.fieldContext_Topic_id(ctx, field)
 			case "title":
-				return ec.fieldContext_ReviewerGradeReview_title(ctx, field)
-			case "teacherCode":
-				return ec.fieldContext_ReviewerGradeReview_teacherCode(ctx, field)
-			case "reviewGrade":
-				return ec.fieldContext_ReviewerGradeReview_reviewGrade(ctx, field)
+				return ec.fieldContext_Topic_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Topic_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Topic_semesterCode(ctx, field)
 			case "status":
-				return ec.fieldContext_ReviewerGradeReview_status(ctx, field)
-			case "notes":
-				return ec.fieldContext_ReviewerGradeReview_notes(ctx, field)
-			case "completionDate":
-				return ec.fieldContext_ReviewerGradeReview_completionDate(ctx, field)
+				return ec.fieldContext_Topic_status(ctx, field)
+			case "percentStage1":
+				return ec.fieldContext_Topic_percentStage1(ctx, field)
+			case "percentStage2":
+				return ec.fieldContext_Topic_percentStage2(ctx, field)
+			case "maxStudents":
+				return ec.fieldContext_Topic_maxStudents(ctx, field)
+			case "requiredSkills":
+				return ec.fieldContext_Topic_requiredSkills(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_ReviewerGradeReview_createdAt(ctx, field)
+				return ec.fieldContext_Topic_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_ReviewerGradeReview_updatedAt(ctx, field)
-			case "enrollment":
-				return ec.fieldContext_ReviewerGradeReview_enrollment(ctx, field)
+				return ec.fieldContext_Topic_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Topic_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Topic_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Topic_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Topic_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Topic_deletedBy(ctx, field)
+			case "files":
+				return ec.fieldContext_Topic_files(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Topic_statusHistory(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ReviewerGradeReview", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
 		},
 	}
 	defer func() {
@@ -5366,120 +5513,163 @@ func (ec *executionContext) fieldContext_Mutation_completeGradeReview(ctx contex
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_completeGradeReview_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_startTopic_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query__empty(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query__empty,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().Empty(ctx)
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
-
-func (ec *executionContext) fieldContext_Query__empty(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _Query_getListTeachers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_completeTopic(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getListTeachers,
+		ec.fieldContext_Mutation_completeTopic,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetListTeachers(ctx, fc.Args["search"].(model.SearchRequestInput))
+			return ec.resolvers.Mutation().CompleteTopic(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalNTeacherListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacherListResponse,
+		ec.marshalNTopic2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopic,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getListTeachers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_completeTopic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "total":
-				return ec.fieldContext_TeacherListResponse_total(ctx, field)
-			case "data":
-				return ec.fieldContext_TeacherListResponse_data(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TeacherListResponse", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
+				return ec.fieldContext_Topic_total(ctx, field)
+			case "id":
+				return ec.fieldContext_Topic_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Topic_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Topic_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Topic_semesterCode(ctx, field)
+			case "status":
+				return ec.fieldContext_Topic_status(ctx, field)
+			case "percentStage1":
+				return ec.fieldContext_Topic_percentStage1(ctx, field)
+			case "percentStage2":
+				return ec.fieldContext_Topic_percentStage2(ctx, field)
+			case "maxStudents":
+				return ec.fieldContext_Topic_maxStudents(ctx, field)
+			case "requiredSkills":
+				return ec.fieldContext_Topic_requiredSkills(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Topic_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Topic_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Topic_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Topic_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Topic_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Topic_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Topic_deletedBy(ctx, field)
+			case "files":
+				return ec.fieldContext_Topic_files(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Topic_statusHistory(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getListTeachers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_completeTopic_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getListStudents(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateTopic(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getListStudents,
+		ec.fieldContext_Mutation_updateTopic,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetListStudents(ctx, fc.Args["search"].(model.SearchRequestInput))
+			return ec.resolvers.Mutation().UpdateTopic(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateTopicInput))
 		},
 		nil,
-		ec.marshalNStudentListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentListResponse,
+		ec.marshalNTopic2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopic,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getListStudents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updateTopic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "total":
-				return ec.fieldContext_StudentListResponse_total(ctx, field)
-			case "data":
-				return ec.fieldContext_StudentListResponse_data(ctx, field)
+				return ec.fieldContext_Topic_total(ctx, field)
+			case "id":
+				return ec.fieldContext_Topic_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Topic_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Topic_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Topic_semesterCode(ctx, field)
+			case "status":
+				return ec.fieldContext_Topic_status(ctx, field)
+			case "percentStage1":
+				return ec.fieldContext_Topic_percentStage1(ctx, field)
+			case "percentStage2":
+				return ec.fieldContext_Topic_percentStage2(ctx, field)
+			case "maxStudents":
+				return ec.fieldContext_Topic_maxStudents(ctx, field)
+			case "requiredSkills":
+				return ec.fieldContext_Topic_requiredSkills(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Topic_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Topic_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Topic_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Topic_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Topic_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Topic_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Topic_deletedBy(ctx, field)
+			case "files":
+				return ec.fieldContext_Topic_files(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Topic_statusHistory(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type StudentListResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
 		},
 	}
 	defer func() {
@@ -5489,66 +5679,38 @@ func (ec *executionContext) fieldContext_Query_getListStudents(ctx context.Conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getListStudents_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_updateTopic_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getStudentDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_deleteTopic(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getStudentDetail,
+		ec.fieldContext_Mutation_deleteTopic,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetStudentDetail(ctx, fc.Args["id"].(string))
+			return ec.resolvers.Mutation().DeleteTopic(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalOStudent2ᚖthailyᚋsrcᚋgraphᚋmodelᚐStudent,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getStudentDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deleteTopic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Student_id(ctx, field)
-			case "email":
-				return ec.fieldContext_Student_email(ctx, field)
-			case "phone":
-				return ec.fieldContext_Student_phone(ctx, field)
-			case "username":
-				return ec.fieldContext_Student_username(ctx, field)
-			case "gender":
-				return ec.fieldContext_Student_gender(ctx, field)
-			case "majorCode":
-				return ec.fieldContext_Student_majorCode(ctx, field)
-			case "classCode":
-				return ec.fieldContext_Student_classCode(ctx, field)
-			case "semesterCode":
-				return ec.fieldContext_Student_semesterCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Student_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Student_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Student_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Student_updatedBy(ctx, field)
-			case "enrollments":
-				return ec.fieldContext_Student_enrollments(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Student", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	defer func() {
@@ -5558,62 +5720,80 @@ func (ec *executionContext) fieldContext_Query_getStudentDetail(ctx context.Cont
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getStudentDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_deleteTopic_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getTeacherDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_restoreTopic(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getTeacherDetail,
+		ec.fieldContext_Mutation_restoreTopic,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetTeacherDetail(ctx, fc.Args["id"].(string))
+			return ec.resolvers.Mutation().RestoreTopic(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalOTeacher2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacher,
+		ec.marshalNTopic2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopic,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getTeacherDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_restoreTopic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "total":
+				return ec.fieldContext_Topic_total(ctx, field)
 			case "id":
-				return ec.fieldContext_Teacher_id(ctx, field)
-			case "email":
-				return ec.fieldContext_Teacher_email(ctx, field)
-			case "username":
-				return ec.fieldContext_Teacher_username(ctx, field)
-			case "gender":
-				return ec.fieldContext_Teacher_gender(ctx, field)
+				return ec.fieldContext_Topic_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Topic_title(ctx, field)
 			case "majorCode":
-				return ec.fieldContext_Teacher_majorCode(ctx, field)
+				return ec.fieldContext_Topic_majorCode(ctx, field)
 			case "semesterCode":
-				return ec.fieldContext_Teacher_semesterCode(ctx, field)
+				return ec.fieldContext_Topic_semesterCode(ctx, field)
+			case "status":
+				return ec.fieldContext_Topic_status(ctx, field)
+			case "percentStage1":
+				return ec.fieldContext_Topic_percentStage1(ctx, field)
+			case "percentStage2":
+				return ec.fieldContext_Topic_percentStage2(ctx, field)
+			case "maxStudents":
+				return ec.fieldContext_Topic_maxStudents(ctx, field)
+			case "requiredSkills":
+				return ec.fieldContext_Topic_requiredSkills(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Teacher_createdAt(ctx, field)
+				return ec.fieldContext_Topic_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Teacher_updatedAt(ctx, field)
+				return ec.fieldContext_Topic_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Teacher_createdBy(ctx, field)
+				return ec.fieldContext_Topic_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Teacher_updatedBy(ctx, field)
-			case "roles":
-				return ec.fieldContext_Teacher_roles(ctx, field)
+				return ec.fieldContext_Topic_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Topic_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Topic_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Topic_deletedBy(ctx, field)
+			case "files":
+				return ec.fieldContext_Topic_files(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Topic_statusHistory(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Teacher", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
 		},
 	}
 	defer func() {
@@ -5623,44 +5803,62 @@ func (ec *executionContext) fieldContext_Query_getTeacherDetail(ctx context.Cont
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getTeacherDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_restoreTopic_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getAllSemesters(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_setSubmissionDeadline(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getAllSemesters,
+		ec.fieldContext_Mutation_setSubmissionDeadline,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetAllSemesters(ctx, fc.Args["search"].(model.SearchRequestInput))
+			return ec.resolvers.Mutation().SetSubmissionDeadline(ctx, fc.Args["input"].(model.SetSubmissionDeadlineInput))
 		},
 		nil,
-		ec.marshalNSemesterListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSemesterListResponse,
+		ec.marshalNSubmissionDeadline2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSubmissionDeadline,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getAllSemesters(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setSubmissionDeadline(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "total":
-				return ec.fieldContext_SemesterListResponse_total(ctx, field)
-			case "data":
-				return ec.fieldContext_SemesterListResponse_data(ctx, field)
+			case "id":
+				return ec.fieldContext_SubmissionDeadline_id(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_SubmissionDeadline_semesterCode(ctx, field)
+			case "stage":
+				return ec.fieldContext_SubmissionDeadline_stage(ctx, field)
+			case "kind":
+				return ec.fieldContext_SubmissionDeadline_kind(ctx, field)
+			case "opensAt":
+				return ec.fieldContext_SubmissionDeadline_opensAt(ctx, field)
+			case "dueAt":
+				return ec.fieldContext_SubmissionDeadline_dueAt(ctx, field)
+			case "graceMinutes":
+				return ec.fieldContext_SubmissionDeadline_graceMinutes(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_SubmissionDeadline_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_SubmissionDeadline_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_SubmissionDeadline_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_SubmissionDeadline_updatedBy(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SemesterListResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type SubmissionDeadline", field.Name)
 		},
 	}
 	defer func() {
@@ -5670,44 +5868,64 @@ func (ec *executionContext) fieldContext_Query_getAllSemesters(ctx context.Conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getAllSemesters_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_setSubmissionDeadline_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getAllMajors(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createMidtermMilestone(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getAllMajors,
+		ec.fieldContext_Mutation_createMidtermMilestone,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetAllMajors(ctx, fc.Args["search"].(model.SearchRequestInput))
+			return ec.resolvers.Mutation().CreateMidtermMilestone(ctx, fc.Args["input"].(model.CreateMidtermMilestoneInput))
 		},
 		nil,
-		ec.marshalNMajorListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐMajorListResponse,
+		ec.marshalNMidtermMilestone2ᚖthailyᚋsrcᚋgraphᚋmodelᚐMidtermMilestone,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getAllMajors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createMidtermMilestone(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "total":
-				return ec.fieldContext_MajorListResponse_total(ctx, field)
-			case "data":
-				return ec.fieldContext_MajorListResponse_data(ctx, field)
+			case "id":
+				return ec.fieldContext_MidtermMilestone_id(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_MidtermMilestone_semesterCode(ctx, field)
+			case "stage":
+				return ec.fieldContext_MidtermMilestone_stage(ctx, field)
+			case "title":
+				return ec.fieldContext_MidtermMilestone_title(ctx, field)
+			case "description":
+				return ec.fieldContext_MidtermMilestone_description(ctx, field)
+			case "sequence":
+				return ec.fieldContext_MidtermMilestone_sequence(ctx, field)
+			case "dueAt":
+				return ec.fieldContext_MidtermMilestone_dueAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_MidtermMilestone_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_MidtermMilestone_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_MidtermMilestone_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_MidtermMilestone_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_MidtermMilestone_version(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type MajorListResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type MidtermMilestone", field.Name)
 		},
 	}
 	defer func() {
@@ -5717,44 +5935,64 @@ func (ec *executionContext) fieldContext_Query_getAllMajors(ctx context.Context,
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getAllMajors_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_createMidtermMilestone_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getAllFaculties(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateMidtermMilestone(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getAllFaculties,
+		ec.fieldContext_Mutation_updateMidtermMilestone,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetAllFaculties(ctx, fc.Args["search"].(model.SearchRequestInput))
+			return ec.resolvers.Mutation().UpdateMidtermMilestone(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateMidtermMilestoneInput))
 		},
 		nil,
-		ec.marshalNFacultyListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFacultyListResponse,
+		ec.marshalNMidtermMilestone2ᚖthailyᚋsrcᚋgraphᚋmodelᚐMidtermMilestone,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getAllFaculties(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updateMidtermMilestone(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "total":
-				return ec.fieldContext_FacultyListResponse_total(ctx, field)
-			case "data":
-				return ec.fieldContext_FacultyListResponse_data(ctx, field)
+			case "id":
+				return ec.fieldContext_MidtermMilestone_id(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_MidtermMilestone_semesterCode(ctx, field)
+			case "stage":
+				return ec.fieldContext_MidtermMilestone_stage(ctx, field)
+			case "title":
+				return ec.fieldContext_MidtermMilestone_title(ctx, field)
+			case "description":
+				return ec.fieldContext_MidtermMilestone_description(ctx, field)
+			case "sequence":
+				return ec.fieldContext_MidtermMilestone_sequence(ctx, field)
+			case "dueAt":
+				return ec.fieldContext_MidtermMilestone_dueAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_MidtermMilestone_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_MidtermMilestone_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_MidtermMilestone_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_MidtermMilestone_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_MidtermMilestone_version(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type FacultyListResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type MidtermMilestone", field.Name)
 		},
 	}
 	defer func() {
@@ -5764,44 +6002,38 @@ func (ec *executionContext) fieldContext_Query_getAllFaculties(ctx context.Conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getAllFaculties_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_updateMidtermMilestone_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getAllTopics(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_deleteMidtermMilestone(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getAllTopics,
+		ec.fieldContext_Mutation_deleteMidtermMilestone,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetAllTopics(ctx, fc.Args["search"].(model.SearchRequestInput))
+			return ec.resolvers.Mutation().DeleteMidtermMilestone(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalNTopicListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicListResponse,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getAllTopics(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deleteMidtermMilestone(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "total":
-				return ec.fieldContext_TopicListResponse_total(ctx, field)
-			case "data":
-				return ec.fieldContext_TopicListResponse_data(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TopicListResponse", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	defer func() {
@@ -5811,68 +6043,58 @@ func (ec *executionContext) fieldContext_Query_getAllTopics(ctx context.Context,
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getAllTopics_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_deleteMidtermMilestone_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getTopicDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_setRegistrationWindow(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getTopicDetail,
+		ec.fieldContext_Mutation_setRegistrationWindow,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetTopicDetail(ctx, fc.Args["id"].(string))
+			return ec.resolvers.Mutation().SetRegistrationWindow(ctx, fc.Args["input"].(model.SetRegistrationWindowInput))
 		},
 		nil,
-		ec.marshalOTopic2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopic,
+		ec.marshalNRegistrationWindow2ᚖthailyᚋsrcᚋgraphᚋmodelᚐRegistrationWindow,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getTopicDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setRegistrationWindow(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "total":
-				return ec.fieldContext_Topic_total(ctx, field)
 			case "id":
-				return ec.fieldContext_Topic_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Topic_title(ctx, field)
-			case "majorCode":
-				return ec.fieldContext_Topic_majorCode(ctx, field)
+				return ec.fieldContext_RegistrationWindow_id(ctx, field)
 			case "semesterCode":
-				return ec.fieldContext_Topic_semesterCode(ctx, field)
-			case "status":
-				return ec.fieldContext_Topic_status(ctx, field)
-			case "percentStage1":
-				return ec.fieldContext_Topic_percentStage1(ctx, field)
-			case "percentStage2":
-				return ec.fieldContext_Topic_percentStage2(ctx, field)
+				return ec.fieldContext_RegistrationWindow_semesterCode(ctx, field)
+			case "opensAt":
+				return ec.fieldContext_RegistrationWindow_opensAt(ctx, field)
+			case "closesAt":
+				return ec.fieldContext_RegistrationWindow_closesAt(ctx, field)
+			case "maxChoices":
+				return ec.fieldContext_RegistrationWindow_maxChoices(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Topic_createdAt(ctx, field)
+				return ec.fieldContext_RegistrationWindow_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Topic_updatedAt(ctx, field)
+				return ec.fieldContext_RegistrationWindow_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Topic_createdBy(ctx, field)
+				return ec.fieldContext_RegistrationWindow_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Topic_updatedBy(ctx, field)
-			case "files":
-				return ec.fieldContext_Topic_files(ctx, field)
-			case "topicCouncils":
-				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+				return ec.fieldContext_RegistrationWindow_updatedBy(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type RegistrationWindow", field.Name)
 		},
 	}
 	defer func() {
@@ -5882,44 +6104,50 @@ func (ec *executionContext) fieldContext_Query_getTopicDetail(ctx context.Contex
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getTopicDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_setRegistrationWindow_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getAllEnrollments(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_commitTopicMatching(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getAllEnrollments,
+		ec.fieldContext_Mutation_commitTopicMatching,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetAllEnrollments(ctx, fc.Args["search"].(model.SearchRequestInput))
+			return ec.resolvers.Mutation().CommitTopicMatching(ctx, fc.Args["semesterCode"].(string))
 		},
 		nil,
-		ec.marshalNEnrollmentListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐEnrollmentListResponse,
+		ec.marshalNTopicMatchingResult2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicMatchingResult,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getAllEnrollments(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_commitTopicMatching(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "total":
-				return ec.fieldContext_EnrollmentListResponse_total(ctx, field)
-			case "data":
-				return ec.fieldContext_EnrollmentListResponse_data(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_TopicMatchingResult_semesterCode(ctx, field)
+			case "studentsTotal":
+				return ec.fieldContext_TopicMatchingResult_studentsTotal(ctx, field)
+			case "matchedTotal":
+				return ec.fieldContext_TopicMatchingResult_matchedTotal(ctx, field)
+			case "assignments":
+				return ec.fieldContext_TopicMatchingResult_assignments(ctx, field)
+			case "unmatched":
+				return ec.fieldContext_TopicMatchingResult_unmatched(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type EnrollmentListResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TopicMatchingResult", field.Name)
 		},
 	}
 	defer func() {
@@ -5929,74 +6157,54 @@ func (ec *executionContext) fieldContext_Query_getAllEnrollments(ctx context.Con
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getAllEnrollments_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_commitTopicMatching_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getEnrollmentDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_commitDefenceSchedule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getEnrollmentDetail,
+		ec.fieldContext_Mutation_commitDefenceSchedule,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetEnrollmentDetail(ctx, fc.Args["id"].(string))
+			return ec.resolvers.Mutation().CommitDefenceSchedule(ctx, fc.Args["input"].(model.DefenceScheduleInput))
 		},
 		nil,
-		ec.marshalOEnrollment2ᚖthailyᚋsrcᚋgraphᚋmodelᚐEnrollment,
+		ec.marshalNDefenceSchedule2ᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceSchedule,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getEnrollmentDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_commitDefenceSchedule(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Enrollment_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Enrollment_title(ctx, field)
-			case "studentCode":
-				return ec.fieldContext_Enrollment_studentCode(ctx, field)
-			case "topicCouncilCode":
-				return ec.fieldContext_Enrollment_topicCouncilCode(ctx, field)
-			case "finalCode":
-				return ec.fieldContext_Enrollment_finalCode(ctx, field)
-			case "gradeReviewCode":
-				return ec.fieldContext_Enrollment_gradeReviewCode(ctx, field)
-			case "midtermCode":
-				return ec.fieldContext_Enrollment_midtermCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Enrollment_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Enrollment_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Enrollment_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Enrollment_updatedBy(ctx, field)
-			case "student":
-				return ec.fieldContext_Enrollment_student(ctx, field)
-			case "midterm":
-				return ec.fieldContext_Enrollment_midterm(ctx, field)
-			case "final":
-				return ec.fieldContext_Enrollment_final(ctx, field)
-			case "topicCouncil":
-				return ec.fieldContext_Enrollment_topicCouncil(ctx, field)
-			case "gradeReview":
-				return ec.fieldContext_Enrollment_gradeReview(ctx, field)
-			case "gradeDefences":
-				return ec.fieldContext_Enrollment_gradeDefences(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Enrollment", field.Name)
+			case "semesterCode":
+				return ec.fieldContext_DefenceSchedule_semesterCode(ctx, field)
+			case "complete":
+				return ec.fieldContext_DefenceSchedule_complete(ctx, field)
+			case "committed":
+				return ec.fieldContext_DefenceSchedule_committed(ctx, field)
+			case "sittings":
+				return ec.fieldContext_DefenceSchedule_sittings(ctx, field)
+			case "unassigned":
+				return ec.fieldContext_DefenceSchedule_unassigned(ctx, field)
+			case "unplaced":
+				return ec.fieldContext_DefenceSchedule_unplaced(ctx, field)
+			case "csv":
+				return ec.fieldContext_DefenceSchedule_csv(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DefenceSchedule", field.Name)
 		},
 	}
 	defer func() {
@@ -6006,44 +6214,60 @@ func (ec *executionContext) fieldContext_Query_getEnrollmentDetail(ctx context.C
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getEnrollmentDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_commitDefenceSchedule_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getAllCouncils(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createRubricTemplate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getAllCouncils,
+		ec.fieldContext_Mutation_createRubricTemplate,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetAllCouncils(ctx, fc.Args["search"].(model.SearchRequestInput))
+			return ec.resolvers.Mutation().CreateRubricTemplate(ctx, fc.Args["input"].(model.CreateRubricTemplateInput))
 		},
 		nil,
-		ec.marshalNCouncilListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilListResponse,
+		ec.marshalNRubricTemplate2ᚖthailyᚋsrcᚋgraphᚋmodelᚐRubricTemplate,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getAllCouncils(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createRubricTemplate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "total":
-				return ec.fieldContext_CouncilListResponse_total(ctx, field)
-			case "data":
-				return ec.fieldContext_CouncilListResponse_data(ctx, field)
+			case "id":
+				return ec.fieldContext_RubricTemplate_id(ctx, field)
+			case "title":
+				return ec.fieldContext_RubricTemplate_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_RubricTemplate_majorCode(ctx, field)
+			case "stage":
+				return ec.fieldContext_RubricTemplate_stage(ctx, field)
+			case "criteria":
+				return ec.fieldContext_RubricTemplate_criteria(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RubricTemplate_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_RubricTemplate_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_RubricTemplate_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_RubricTemplate_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_RubricTemplate_version(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type CouncilListResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type RubricTemplate", field.Name)
 		},
 	}
 	defer func() {
@@ -6053,64 +6277,60 @@ func (ec *executionContext) fieldContext_Query_getAllCouncils(ctx context.Contex
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getAllCouncils_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_createRubricTemplate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getCouncilDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateRubricTemplate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getCouncilDetail,
+		ec.fieldContext_Mutation_updateRubricTemplate,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetCouncilDetail(ctx, fc.Args["id"].(string))
+			return ec.resolvers.Mutation().UpdateRubricTemplate(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateRubricTemplateInput))
 		},
 		nil,
-		ec.marshalOCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil,
+		ec.marshalNRubricTemplate2ᚖthailyᚋsrcᚋgraphᚋmodelᚐRubricTemplate,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getCouncilDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updateRubricTemplate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "total":
-				return ec.fieldContext_Council_total(ctx, field)
 			case "id":
-				return ec.fieldContext_Council_id(ctx, field)
+				return ec.fieldContext_RubricTemplate_id(ctx, field)
 			case "title":
-				return ec.fieldContext_Council_title(ctx, field)
+				return ec.fieldContext_RubricTemplate_title(ctx, field)
 			case "majorCode":
-				return ec.fieldContext_Council_majorCode(ctx, field)
-			case "semesterCode":
-				return ec.fieldContext_Council_semesterCode(ctx, field)
-			case "timeStart":
-				return ec.fieldContext_Council_timeStart(ctx, field)
+				return ec.fieldContext_RubricTemplate_majorCode(ctx, field)
+			case "stage":
+				return ec.fieldContext_RubricTemplate_stage(ctx, field)
+			case "criteria":
+				return ec.fieldContext_RubricTemplate_criteria(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Council_createdAt(ctx, field)
+				return ec.fieldContext_RubricTemplate_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Council_updatedAt(ctx, field)
+				return ec.fieldContext_RubricTemplate_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Council_createdBy(ctx, field)
+				return ec.fieldContext_RubricTemplate_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Council_updatedBy(ctx, field)
-			case "defences":
-				return ec.fieldContext_Council_defences(ctx, field)
-			case "topicCouncils":
-				return ec.fieldContext_Council_topicCouncils(ctx, field)
+				return ec.fieldContext_RubricTemplate_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_RubricTemplate_version(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Council", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type RubricTemplate", field.Name)
 		},
 	}
 	defer func() {
@@ -6120,44 +6340,38 @@ func (ec *executionContext) fieldContext_Query_getCouncilDetail(ctx context.Cont
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getCouncilDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_updateRubricTemplate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getDefencesByCouncil(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_deleteRubricTemplate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getDefencesByCouncil,
+		ec.fieldContext_Mutation_deleteRubricTemplate,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetDefencesByCouncil(ctx, fc.Args["councilId"].(string))
+			return ec.resolvers.Mutation().DeleteRubricTemplate(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalNDefenceListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceListResponse,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getDefencesByCouncil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deleteRubricTemplate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "total":
-				return ec.fieldContext_DefenceListResponse_total(ctx, field)
-			case "data":
-				return ec.fieldContext_DefenceListResponse_data(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type DefenceListResponse", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	defer func() {
@@ -6167,44 +6381,70 @@ func (ec *executionContext) fieldContext_Query_getDefencesByCouncil(ctx context.
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getDefencesByCouncil_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_deleteRubricTemplate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getAllGradeDefences(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_setGradingPolicy(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getAllGradeDefences,
+		ec.fieldContext_Mutation_setGradingPolicy,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetAllGradeDefences(ctx, fc.Args["search"].(model.SearchRequestInput))
+			return ec.resolvers.Mutation().SetGradingPolicy(ctx, fc.Args["input"].(model.SetGradingPolicyInput))
 		},
 		nil,
-		ec.marshalNGradeDefenceListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefenceListResponse,
+		ec.marshalNGradingPolicy2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradingPolicy,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getAllGradeDefences(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setGradingPolicy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "total":
-				return ec.fieldContext_GradeDefenceListResponse_total(ctx, field)
-			case "data":
-				return ec.fieldContext_GradeDefenceListResponse_data(ctx, field)
+			case "id":
+				return ec.fieldContext_GradingPolicy_id(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_GradingPolicy_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_GradingPolicy_semesterCode(ctx, field)
+			case "stage":
+				return ec.fieldContext_GradingPolicy_stage(ctx, field)
+			case "supervisorWeight":
+				return ec.fieldContext_GradingPolicy_supervisorWeight(ctx, field)
+			case "reviewerWeight":
+				return ec.fieldContext_GradingPolicy_reviewerWeight(ctx, field)
+			case "councilWeight":
+				return ec.fieldContext_GradingPolicy_councilWeight(ctx, field)
+			case "rounding":
+				return ec.fieldContext_GradingPolicy_rounding(ctx, field)
+			case "roundingStep":
+				return ec.fieldContext_GradingPolicy_roundingStep(ctx, field)
+			case "passThreshold":
+				return ec.fieldContext_GradingPolicy_passThreshold(ctx, field)
+			case "maxCouncilSpread":
+				return ec.fieldContext_GradingPolicy_maxCouncilSpread(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_GradingPolicy_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_GradingPolicy_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_GradingPolicy_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_GradingPolicy_updatedBy(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type GradeDefenceListResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type GradingPolicy", field.Name)
 		},
 	}
 	defer func() {
@@ -6214,62 +6454,38 @@ func (ec *executionContext) fieldContext_Query_getAllGradeDefences(ctx context.C
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getAllGradeDefences_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_setGradingPolicy_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getDepartmentTeachers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_deleteGradingPolicy(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getDepartmentTeachers,
+		ec.fieldContext_Mutation_deleteGradingPolicy,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetDepartmentTeachers(ctx, fc.Args["search"].(model.SearchRequestInput))
+			return ec.resolvers.Mutation().DeleteGradingPolicy(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalNTeacher2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacherᚄ,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getDepartmentTeachers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deleteGradingPolicy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Teacher_id(ctx, field)
-			case "email":
-				return ec.fieldContext_Teacher_email(ctx, field)
-			case "username":
-				return ec.fieldContext_Teacher_username(ctx, field)
-			case "gender":
-				return ec.fieldContext_Teacher_gender(ctx, field)
-			case "majorCode":
-				return ec.fieldContext_Teacher_majorCode(ctx, field)
-			case "semesterCode":
-				return ec.fieldContext_Teacher_semesterCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Teacher_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Teacher_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Teacher_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Teacher_updatedBy(ctx, field)
-			case "roles":
-				return ec.fieldContext_Teacher_roles(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Teacher", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	defer func() {
@@ -6279,66 +6495,64 @@ func (ec *executionContext) fieldContext_Query_getDepartmentTeachers(ctx context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getDepartmentTeachers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_deleteGradingPolicy_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getDepartmentStudents(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_finalizeFinalGrade(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getDepartmentStudents,
+		ec.fieldContext_Mutation_finalizeFinalGrade,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetDepartmentStudents(ctx, fc.Args["search"].(model.SearchRequestInput))
+			return ec.resolvers.Mutation().FinalizeFinalGrade(ctx, fc.Args["enrollmentId"].(string))
 		},
 		nil,
-		ec.marshalNStudent2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentᚄ,
+		ec.marshalNFinalGradeBreakdown2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFinalGradeBreakdown,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getDepartmentStudents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_finalizeFinalGrade(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Student_id(ctx, field)
-			case "email":
-				return ec.fieldContext_Student_email(ctx, field)
-			case "phone":
-				return ec.fieldContext_Student_phone(ctx, field)
-			case "username":
-				return ec.fieldContext_Student_username(ctx, field)
-			case "gender":
-				return ec.fieldContext_Student_gender(ctx, field)
-			case "majorCode":
-				return ec.fieldContext_Student_majorCode(ctx, field)
-			case "classCode":
-				return ec.fieldContext_Student_classCode(ctx, field)
-			case "semesterCode":
-				return ec.fieldContext_Student_semesterCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Student_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Student_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Student_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Student_updatedBy(ctx, field)
-			case "enrollments":
-				return ec.fieldContext_Student_enrollments(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_FinalGradeBreakdown_enrollmentCode(ctx, field)
+			case "policyCode":
+				return ec.fieldContext_FinalGradeBreakdown_policyCode(ctx, field)
+			case "components":
+				return ec.fieldContext_FinalGradeBreakdown_components(ctx, field)
+			case "councilSpread":
+				return ec.fieldContext_FinalGradeBreakdown_councilSpread(ctx, field)
+			case "needsReconciliation":
+				return ec.fieldContext_FinalGradeBreakdown_needsReconciliation(ctx, field)
+			case "missing":
+				return ec.fieldContext_FinalGradeBreakdown_missing(ctx, field)
+			case "rawGrade":
+				return ec.fieldContext_FinalGradeBreakdown_rawGrade(ctx, field)
+			case "finalGrade":
+				return ec.fieldContext_FinalGradeBreakdown_finalGrade(ctx, field)
+			case "status":
+				return ec.fieldContext_FinalGradeBreakdown_status(ctx, field)
+			case "steps":
+				return ec.fieldContext_FinalGradeBreakdown_steps(ctx, field)
+			case "finalized":
+				return ec.fieldContext_FinalGradeBreakdown_finalized(ctx, field)
+			case "final":
+				return ec.fieldContext_FinalGradeBreakdown_final(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Student", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type FinalGradeBreakdown", field.Name)
 		},
 	}
 	defer func() {
@@ -6348,58 +6562,58 @@ func (ec *executionContext) fieldContext_Query_getDepartmentStudents(ctx context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getDepartmentStudents_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_finalizeFinalGrade_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getDepartmentSemesters(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_lockSemesterGrades(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getDepartmentSemesters,
+		ec.fieldContext_Mutation_lockSemesterGrades,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetDepartmentSemesters(ctx, fc.Args["search"].(model.SearchRequestInput))
+			return ec.resolvers.Mutation().LockSemesterGrades(ctx, fc.Args["semesterCode"].(string))
 		},
 		nil,
-		ec.marshalNSemester2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐSemesterᚄ,
+		ec.marshalNSemesterGradeStatus2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSemesterGradeStatus,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getDepartmentSemesters(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_lockSemesterGrades(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Semester_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Semester_title(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Semester_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Semester_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Semester_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Semester_updatedBy(ctx, field)
-			case "students":
-				return ec.fieldContext_Semester_students(ctx, field)
-			case "teachers":
-				return ec.fieldContext_Semester_teachers(ctx, field)
-			case "topics":
-				return ec.fieldContext_Semester_topics(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Semester", field.Name)
+			case "semesterCode":
+				return ec.fieldContext_SemesterGradeStatus_semesterCode(ctx, field)
+			case "locked":
+				return ec.fieldContext_SemesterGradeStatus_locked(ctx, field)
+			case "lockedAt":
+				return ec.fieldContext_SemesterGradeStatus_lockedAt(ctx, field)
+			case "lockedBy":
+				return ec.fieldContext_SemesterGradeStatus_lockedBy(ctx, field)
+			case "published":
+				return ec.fieldContext_SemesterGradeStatus_published(ctx, field)
+			case "publishedAt":
+				return ec.fieldContext_SemesterGradeStatus_publishedAt(ctx, field)
+			case "publishedBy":
+				return ec.fieldContext_SemesterGradeStatus_publishedBy(ctx, field)
+			case "appealWindowDays":
+				return ec.fieldContext_SemesterGradeStatus_appealWindowDays(ctx, field)
+			case "appealDeadline":
+				return ec.fieldContext_SemesterGradeStatus_appealDeadline(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SemesterGradeStatus", field.Name)
 		},
 	}
 	defer func() {
@@ -6409,56 +6623,58 @@ func (ec *executionContext) fieldContext_Query_getDepartmentSemesters(ctx contex
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getDepartmentSemesters_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_lockSemesterGrades_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getDepartmentMajors(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_publishSemesterGrades(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getDepartmentMajors,
+		ec.fieldContext_Mutation_publishSemesterGrades,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetDepartmentMajors(ctx, fc.Args["search"].(model.SearchRequestInput))
+			return ec.resolvers.Mutation().PublishSemesterGrades(ctx, fc.Args["semesterCode"].(string))
 		},
 		nil,
-		ec.marshalNMajor2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐMajorᚄ,
+		ec.marshalNSemesterGradeStatus2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSemesterGradeStatus,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getDepartmentMajors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_publishSemesterGrades(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Major_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Major_title(ctx, field)
-			case "facultyCode":
-				return ec.fieldContext_Major_facultyCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Major_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Major_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Major_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Major_updatedBy(ctx, field)
-			case "topics":
-				return ec.fieldContext_Major_topics(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Major", field.Name)
+			case "semesterCode":
+				return ec.fieldContext_SemesterGradeStatus_semesterCode(ctx, field)
+			case "locked":
+				return ec.fieldContext_SemesterGradeStatus_locked(ctx, field)
+			case "lockedAt":
+				return ec.fieldContext_SemesterGradeStatus_lockedAt(ctx, field)
+			case "lockedBy":
+				return ec.fieldContext_SemesterGradeStatus_lockedBy(ctx, field)
+			case "published":
+				return ec.fieldContext_SemesterGradeStatus_published(ctx, field)
+			case "publishedAt":
+				return ec.fieldContext_SemesterGradeStatus_publishedAt(ctx, field)
+			case "publishedBy":
+				return ec.fieldContext_SemesterGradeStatus_publishedBy(ctx, field)
+			case "appealWindowDays":
+				return ec.fieldContext_SemesterGradeStatus_appealWindowDays(ctx, field)
+			case "appealDeadline":
+				return ec.fieldContext_SemesterGradeStatus_appealDeadline(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SemesterGradeStatus", field.Name)
 		},
 	}
 	defer func() {
@@ -6468,54 +6684,80 @@ func (ec *executionContext) fieldContext_Query_getDepartmentMajors(ctx context.C
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getDepartmentMajors_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_publishSemesterGrades_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getDepartmentFaculties(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_lockCouncilGrades(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getDepartmentFaculties,
+		ec.fieldContext_Mutation_lockCouncilGrades,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetDepartmentFaculties(ctx, fc.Args["search"].(model.SearchRequestInput))
+			return ec.resolvers.Mutation().LockCouncilGrades(ctx, fc.Args["councilId"].(string))
 		},
 		nil,
-		ec.marshalNFaculty2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐFacultyᚄ,
+		ec.marshalNCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getDepartmentFaculties(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_lockCouncilGrades(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "total":
+				return ec.fieldContext_Council_total(ctx, field)
 			case "id":
-				return ec.fieldContext_Faculty_id(ctx, field)
+				return ec.fieldContext_Council_id(ctx, field)
 			case "title":
-				return ec.fieldContext_Faculty_title(ctx, field)
+				return ec.fieldContext_Council_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Council_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Council_semesterCode(ctx, field)
+			case "timeStart":
+				return ec.fieldContext_Council_timeStart(ctx, field)
+			case "room":
+				return ec.fieldContext_Council_room(ctx, field)
+			case "gradesLocked":
+				return ec.fieldContext_Council_gradesLocked(ctx, field)
+			case "gradesLockedAt":
+				return ec.fieldContext_Council_gradesLockedAt(ctx, field)
+			case "gradesPublished":
+				return ec.fieldContext_Council_gradesPublished(ctx, field)
+			case "gradesPublishedAt":
+				return ec.fieldContext_Council_gradesPublishedAt(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Faculty_createdAt(ctx, field)
+				return ec.fieldContext_Council_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Faculty_updatedAt(ctx, field)
+				return ec.fieldContext_Council_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Faculty_createdBy(ctx, field)
+				return ec.fieldContext_Council_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Faculty_updatedBy(ctx, field)
-			case "majors":
-				return ec.fieldContext_Faculty_majors(ctx, field)
+				return ec.fieldContext_Council_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Council_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Council_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Council_deletedBy(ctx, field)
+			case "defences":
+				return ec.fieldContext_Council_defences(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Council_topicCouncils(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Faculty", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Council", field.Name)
 		},
 	}
 	defer func() {
@@ -6525,68 +6767,80 @@ func (ec *executionContext) fieldContext_Query_getDepartmentFaculties(ctx contex
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getDepartmentFaculties_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_lockCouncilGrades_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getDepartmentTopics(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_publishCouncilGrades(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getDepartmentTopics,
+		ec.fieldContext_Mutation_publishCouncilGrades,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetDepartmentTopics(ctx, fc.Args["search"].(model.SearchRequestInput))
+			return ec.resolvers.Mutation().PublishCouncilGrades(ctx, fc.Args["councilId"].(string))
 		},
 		nil,
-		ec.marshalNTopic2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicᚄ,
+		ec.marshalNCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getDepartmentTopics(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_publishCouncilGrades(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "total":
-				return ec.fieldContext_Topic_total(ctx, field)
+				return ec.fieldContext_Council_total(ctx, field)
 			case "id":
-				return ec.fieldContext_Topic_id(ctx, field)
+				return ec.fieldContext_Council_id(ctx, field)
 			case "title":
-				return ec.fieldContext_Topic_title(ctx, field)
+				return ec.fieldContext_Council_title(ctx, field)
 			case "majorCode":
-				return ec.fieldContext_Topic_majorCode(ctx, field)
+				return ec.fieldContext_Council_majorCode(ctx, field)
 			case "semesterCode":
-				return ec.fieldContext_Topic_semesterCode(ctx, field)
-			case "status":
-				return ec.fieldContext_Topic_status(ctx, field)
-			case "percentStage1":
-				return ec.fieldContext_Topic_percentStage1(ctx, field)
-			case "percentStage2":
-				return ec.fieldContext_Topic_percentStage2(ctx, field)
+				return ec.fieldContext_Council_semesterCode(ctx, field)
+			case "timeStart":
+				return ec.fieldContext_Council_timeStart(ctx, field)
+			case "room":
+				return ec.fieldContext_Council_room(ctx, field)
+			case "gradesLocked":
+				return ec.fieldContext_Council_gradesLocked(ctx, field)
+			case "gradesLockedAt":
+				return ec.fieldContext_Council_gradesLockedAt(ctx, field)
+			case "gradesPublished":
+				return ec.fieldContext_Council_gradesPublished(ctx, field)
+			case "gradesPublishedAt":
+				return ec.fieldContext_Council_gradesPublishedAt(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Topic_createdAt(ctx, field)
+				return ec.fieldContext_Council_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Topic_updatedAt(ctx, field)
+				return ec.fieldContext_Council_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Topic_createdBy(ctx, field)
+				return ec.fieldContext_Council_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Topic_updatedBy(ctx, field)
-			case "files":
-				return ec.fieldContext_Topic_files(ctx, field)
+				return ec.fieldContext_Council_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Council_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Council_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Council_deletedBy(ctx, field)
+			case "defences":
+				return ec.fieldContext_Council_defences(ctx, field)
 			case "topicCouncils":
-				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+				return ec.fieldContext_Council_topicCouncils(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Council", field.Name)
 		},
 	}
 	defer func() {
@@ -6596,68 +6850,72 @@ func (ec *executionContext) fieldContext_Query_getDepartmentTopics(ctx context.C
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getDepartmentTopics_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_publishCouncilGrades_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getDepartmentTopicDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_decideGradeAmendment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getDepartmentTopicDetail,
+		ec.fieldContext_Mutation_decideGradeAmendment,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetDepartmentTopicDetail(ctx, fc.Args["id"].(string))
+			return ec.resolvers.Mutation().DecideGradeAmendment(ctx, fc.Args["input"].(model.DecideGradeAmendmentInput))
 		},
 		nil,
-		ec.marshalOTopic2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopic,
+		ec.marshalNGradeAmendment2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeAmendment,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getDepartmentTopicDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_decideGradeAmendment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "total":
-				return ec.fieldContext_Topic_total(ctx, field)
 			case "id":
-				return ec.fieldContext_Topic_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Topic_title(ctx, field)
-			case "majorCode":
-				return ec.fieldContext_Topic_majorCode(ctx, field)
+				return ec.fieldContext_GradeAmendment_id(ctx, field)
+			case "target":
+				return ec.fieldContext_GradeAmendment_target(ctx, field)
+			case "targetId":
+				return ec.fieldContext_GradeAmendment_targetId(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_GradeAmendment_enrollmentCode(ctx, field)
 			case "semesterCode":
-				return ec.fieldContext_Topic_semesterCode(ctx, field)
+				return ec.fieldContext_GradeAmendment_semesterCode(ctx, field)
+			case "councilCode":
+				return ec.fieldContext_GradeAmendment_councilCode(ctx, field)
+			case "oldValue":
+				return ec.fieldContext_GradeAmendment_oldValue(ctx, field)
+			case "newValue":
+				return ec.fieldContext_GradeAmendment_newValue(ctx, field)
+			case "reason":
+				return ec.fieldContext_GradeAmendment_reason(ctx, field)
 			case "status":
-				return ec.fieldContext_Topic_status(ctx, field)
-			case "percentStage1":
-				return ec.fieldContext_Topic_percentStage1(ctx, field)
-			case "percentStage2":
-				return ec.fieldContext_Topic_percentStage2(ctx, field)
+				return ec.fieldContext_GradeAmendment_status(ctx, field)
+			case "requestedBy":
+				return ec.fieldContext_GradeAmendment_requestedBy(ctx, field)
+			case "decidedBy":
+				return ec.fieldContext_GradeAmendment_decidedBy(ctx, field)
+			case "decidedAt":
+				return ec.fieldContext_GradeAmendment_decidedAt(ctx, field)
+			case "decisionNote":
+				return ec.fieldContext_GradeAmendment_decisionNote(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Topic_createdAt(ctx, field)
+				return ec.fieldContext_GradeAmendment_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Topic_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Topic_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Topic_updatedBy(ctx, field)
-			case "files":
-				return ec.fieldContext_Topic_files(ctx, field)
-			case "topicCouncils":
-				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+				return ec.fieldContext_GradeAmendment_updatedAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type GradeAmendment", field.Name)
 		},
 	}
 	defer func() {
@@ -6667,74 +6925,58 @@ func (ec *executionContext) fieldContext_Query_getDepartmentTopicDetail(ctx cont
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getDepartmentTopicDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_decideGradeAmendment_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getDepartmentEnrollments(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_setGradeAppealWindow(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getDepartmentEnrollments,
+		ec.fieldContext_Mutation_setGradeAppealWindow,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetDepartmentEnrollments(ctx, fc.Args["search"].(model.SearchRequestInput))
+			return ec.resolvers.Mutation().SetGradeAppealWindow(ctx, fc.Args["semesterCode"].(string), fc.Args["days"].(int32))
 		},
 		nil,
-		ec.marshalNEnrollment2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐEnrollmentᚄ,
+		ec.marshalNSemesterGradeStatus2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSemesterGradeStatus,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getDepartmentEnrollments(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setGradeAppealWindow(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Enrollment_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Enrollment_title(ctx, field)
-			case "studentCode":
-				return ec.fieldContext_Enrollment_studentCode(ctx, field)
-			case "topicCouncilCode":
-				return ec.fieldContext_Enrollment_topicCouncilCode(ctx, field)
-			case "finalCode":
-				return ec.fieldContext_Enrollment_finalCode(ctx, field)
-			case "gradeReviewCode":
-				return ec.fieldContext_Enrollment_gradeReviewCode(ctx, field)
-			case "midtermCode":
-				return ec.fieldContext_Enrollment_midtermCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Enrollment_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Enrollment_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Enrollment_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Enrollment_updatedBy(ctx, field)
-			case "student":
-				return ec.fieldContext_Enrollment_student(ctx, field)
-			case "midterm":
-				return ec.fieldContext_Enrollment_midterm(ctx, field)
-			case "final":
-				return ec.fieldContext_Enrollment_final(ctx, field)
-			case "topicCouncil":
-				return ec.fieldContext_Enrollment_topicCouncil(ctx, field)
-			case "gradeReview":
-				return ec.fieldContext_Enrollment_gradeReview(ctx, field)
-			case "gradeDefences":
-				return ec.fieldContext_Enrollment_gradeDefences(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Enrollment", field.Name)
+			case "semesterCode":
+				return ec.fieldContext_SemesterGradeStatus_semesterCode(ctx, field)
+			case "locked":
+				return ec.fieldContext_SemesterGradeStatus_locked(ctx, field)
+			case "lockedAt":
+				return ec.fieldContext_SemesterGradeStatus_lockedAt(ctx, field)
+			case "lockedBy":
+				return ec.fieldContext_SemesterGradeStatus_lockedBy(ctx, field)
+			case "published":
+				return ec.fieldContext_SemesterGradeStatus_published(ctx, field)
+			case "publishedAt":
+				return ec.fieldContext_SemesterGradeStatus_publishedAt(ctx, field)
+			case "publishedBy":
+				return ec.fieldContext_SemesterGradeStatus_publishedBy(ctx, field)
+			case "appealWindowDays":
+				return ec.fieldContext_SemesterGradeStatus_appealWindowDays(ctx, field)
+			case "appealDeadline":
+				return ec.fieldContext_SemesterGradeStatus_appealDeadline(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SemesterGradeStatus", field.Name)
 		},
 	}
 	defer func() {
@@ -6744,74 +6986,72 @@ func (ec *executionContext) fieldContext_Query_getDepartmentEnrollments(ctx cont
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getDepartmentEnrollments_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_setGradeAppealWindow_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getDepartmentEnrollmentDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_assignGradeAppeal(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getDepartmentEnrollmentDetail,
+		ec.fieldContext_Mutation_assignGradeAppeal,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetDepartmentEnrollmentDetail(ctx, fc.Args["id"].(string))
+			return ec.resolvers.Mutation().AssignGradeAppeal(ctx, fc.Args["id"].(string), fc.Args["reviewerId"].(string))
 		},
 		nil,
-		ec.marshalOEnrollment2ᚖthailyᚋsrcᚋgraphᚋmodelᚐEnrollment,
+		ec.marshalNGradeAppeal2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeAppeal,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getDepartmentEnrollmentDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_assignGradeAppeal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Enrollment_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Enrollment_title(ctx, field)
+				return ec.fieldContext_GradeAppeal_id(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_GradeAppeal_enrollmentCode(ctx, field)
 			case "studentCode":
-				return ec.fieldContext_Enrollment_studentCode(ctx, field)
-			case "topicCouncilCode":
-				return ec.fieldContext_Enrollment_topicCouncilCode(ctx, field)
-			case "finalCode":
-				return ec.fieldContext_Enrollment_finalCode(ctx, field)
-			case "gradeReviewCode":
-				return ec.fieldContext_Enrollment_gradeReviewCode(ctx, field)
-			case "midtermCode":
-				return ec.fieldContext_Enrollment_midtermCode(ctx, field)
+				return ec.fieldContext_GradeAppeal_studentCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_GradeAppeal_semesterCode(ctx, field)
+			case "component":
+				return ec.fieldContext_GradeAppeal_component(ctx, field)
+			case "reason":
+				return ec.fieldContext_GradeAppeal_reason(ctx, field)
+			case "evidenceFileCode":
+				return ec.fieldContext_GradeAppeal_evidenceFileCode(ctx, field)
+			case "status":
+				return ec.fieldContext_GradeAppeal_status(ctx, field)
+			case "reviewerCode":
+				return ec.fieldContext_GradeAppeal_reviewerCode(ctx, field)
+			case "resolutionNote":
+				return ec.fieldContext_GradeAppeal_resolutionNote(ctx, field)
+			case "amendmentCode":
+				return ec.fieldContext_GradeAppeal_amendmentCode(ctx, field)
+			case "resolvedBy":
+				return ec.fieldContext_GradeAppeal_resolvedBy(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_GradeAppeal_resolvedAt(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Enrollment_createdAt(ctx, field)
+				return ec.fieldContext_GradeAppeal_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Enrollment_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Enrollment_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_Enrollment_updatedBy(ctx, field)
-			case "student":
-				return ec.fieldContext_Enrollment_student(ctx, field)
-			case "midterm":
-				return ec.fieldContext_Enrollment_midterm(ctx, field)
-			case "final":
-				return ec.fieldContext_Enrollment_final(ctx, field)
-			case "topicCouncil":
-				return ec.fieldContext_Enrollment_topicCouncil(ctx, field)
-			case "gradeReview":
-				return ec.fieldContext_Enrollment_gradeReview(ctx, field)
-			case "gradeDefences":
-				return ec.fieldContext_Enrollment_gradeDefences(ctx, field)
+				return ec.fieldContext_GradeAppeal_updatedAt(ctx, field)
+			case "history":
+				return ec.fieldContext_GradeAppeal_history(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Enrollment", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type GradeAppeal", field.Name)
 		},
 	}
 	defer func() {
@@ -6821,33 +7061,33 @@ func (ec *executionContext) fieldContext_Query_getDepartmentEnrollmentDetail(ctx
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getDepartmentEnrollmentDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_assignGradeAppeal_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getDepartmentCouncils(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createCouncil(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getDepartmentCouncils,
+		ec.fieldContext_Mutation_createCouncil,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetDepartmentCouncils(ctx, fc.Args["search"].(model.SearchRequestInput))
+			return ec.resolvers.Mutation().CreateCouncil(ctx, fc.Args["input"].(model.CreateCouncilInput))
 		},
 		nil,
-		ec.marshalNCouncil2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilᚄ,
+		ec.marshalNCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getDepartmentCouncils(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createCouncil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
@@ -6865,6 +7105,16 @@ func (ec *executionContext) fieldContext_Query_getDepartmentCouncils(ctx context
 				return ec.fieldContext_Council_semesterCode(ctx, field)
 			case "timeStart":
 				return ec.fieldContext_Council_timeStart(ctx, field)
+			case "room":
+				return ec.fieldContext_Council_room(ctx, field)
+			case "gradesLocked":
+				return ec.fieldContext_Council_gradesLocked(ctx, field)
+			case "gradesLockedAt":
+				return ec.fieldContext_Council_gradesLockedAt(ctx, field)
+			case "gradesPublished":
+				return ec.fieldContext_Council_gradesPublished(ctx, field)
+			case "gradesPublishedAt":
+				return ec.fieldContext_Council_gradesPublishedAt(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_Council_createdAt(ctx, field)
 			case "updatedAt":
@@ -6873,6 +7123,12 @@ func (ec *executionContext) fieldContext_Query_getDepartmentCouncils(ctx context
 				return ec.fieldContext_Council_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Council_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Council_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Council_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Council_deletedBy(ctx, field)
 			case "defences":
 				return ec.fieldContext_Council_defences(ctx, field)
 			case "topicCouncils":
@@ -6888,33 +7144,33 @@ func (ec *executionContext) fieldContext_Query_getDepartmentCouncils(ctx context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getDepartmentCouncils_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_createCouncil_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getDepartmentCouncilDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateDepartmentCouncil(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getDepartmentCouncilDetail,
+		ec.fieldContext_Mutation_updateDepartmentCouncil,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetDepartmentCouncilDetail(ctx, fc.Args["id"].(string))
+			return ec.resolvers.Mutation().UpdateDepartmentCouncil(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateCouncilInput))
 		},
 		nil,
-		ec.marshalOCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil,
+		ec.marshalNCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getDepartmentCouncilDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updateDepartmentCouncil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
@@ -6932,6 +7188,16 @@ func (ec *executionContext) fieldContext_Query_getDepartmentCouncilDetail(ctx co
 				return ec.fieldContext_Council_semesterCode(ctx, field)
 			case "timeStart":
 				return ec.fieldContext_Council_timeStart(ctx, field)
+			case "room":
+				return ec.fieldContext_Council_room(ctx, field)
+			case "gradesLocked":
+				return ec.fieldContext_Council_gradesLocked(ctx, field)
+			case "gradesLockedAt":
+				return ec.fieldContext_Council_gradesLockedAt(ctx, field)
+			case "gradesPublished":
+				return ec.fieldContext_Council_gradesPublished(ctx, field)
+			case "gradesPublishedAt":
+				return ec.fieldContext_Council_gradesPublishedAt(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_Council_createdAt(ctx, field)
 			case "updatedAt":
@@ -6940,6 +7206,12 @@ func (ec *executionContext) fieldContext_Query_getDepartmentCouncilDetail(ctx co
 				return ec.fieldContext_Council_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Council_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Council_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Council_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Council_deletedBy(ctx, field)
 			case "defences":
 				return ec.fieldContext_Council_defences(ctx, field)
 			case "topicCouncils":
@@ -6955,33 +7227,33 @@ func (ec *executionContext) fieldContext_Query_getDepartmentCouncilDetail(ctx co
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getDepartmentCouncilDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_updateDepartmentCouncil_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getDepartmentDefences(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_addDefenceToCouncil(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getDepartmentDefences,
+		ec.fieldContext_Mutation_addDefenceToCouncil,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetDepartmentDefences(ctx, fc.Args["councilId"].(string))
+			return ec.resolvers.Mutation().AddDefenceToCouncil(ctx, fc.Args["input"].(model.CreateDefenceInput))
 		},
 		nil,
-		ec.marshalNDefence2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceᚄ,
+		ec.marshalNDefence2ᚖthailyᚋsrcᚋgraphᚋmodelᚐDefence,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getDepartmentDefences(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_addDefenceToCouncil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
@@ -7005,6 +7277,8 @@ func (ec *executionContext) fieldContext_Query_getDepartmentDefences(ctx context
 				return ec.fieldContext_Defence_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Defence_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Defence_version(ctx, field)
 			case "council":
 				return ec.fieldContext_Defence_council(ctx, field)
 			case "teacher":
@@ -7022,64 +7296,38 @@ func (ec *executionContext) fieldContext_Query_getDepartmentDefences(ctx context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getDepartmentDefences_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_addDefenceToCouncil_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getDepartmentGradeDefences(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_removeDefenceFromCouncil(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getDepartmentGradeDefences,
+		ec.fieldContext_Mutation_removeDefenceFromCouncil,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetDepartmentGradeDefences(ctx, fc.Args["search"].(model.SearchRequestInput))
+			return ec.resolvers.Mutation().RemoveDefenceFromCouncil(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalNGradeDefence2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefenceᚄ,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getDepartmentGradeDefences(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_removeDefenceFromCouncil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_GradeDefence_id(ctx, field)
-			case "defenceCode":
-				return ec.fieldContext_GradeDefence_defenceCode(ctx, field)
-			case "enrollmentCode":
-				return ec.fieldContext_GradeDefence_enrollmentCode(ctx, field)
-			case "note":
-				return ec.fieldContext_GradeDefence_note(ctx, field)
-			case "totalScore":
-				return ec.fieldContext_GradeDefence_totalScore(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_GradeDefence_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_GradeDefence_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_GradeDefence_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_GradeDefence_updatedBy(ctx, field)
-			case "defence":
-				return ec.fieldContext_GradeDefence_defence(ctx, field)
-			case "enrollment":
-				return ec.fieldContext_GradeDefence_enrollment(ctx, field)
-			case "criteria":
-				return ec.fieldContext_GradeDefence_criteria(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type GradeDefence", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	defer func() {
@@ -7089,101 +7337,163 @@ func (ec *executionContext) fieldContext_Query_getDepartmentGradeDefences(ctx co
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getDepartmentGradeDefences_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_removeDefenceFromCouncil_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getMyProfile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_approveTopicStage1(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getMyProfile,
+		ec.fieldContext_Mutation_approveTopicStage1,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().GetMyProfile(ctx)
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ApproveTopicStage1(ctx, fc.Args["id"].(string), fc.Args["note"].(*string))
 		},
 		nil,
-		ec.marshalNStudent2ᚖthailyᚋsrcᚋgraphᚋmodelᚐStudent,
+		ec.marshalNTopic2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopic,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getMyProfile(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_approveTopicStage1(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "total":
+				return ec.fieldContext_Topic_total(ctx, field)
 			case "id":
-				return ec.fieldContext_Student_id(ctx, field)
-			case "email":
-				return ec.fieldContext_Student_email(ctx, field)
-			case "phone":
-				return ec.fieldContext_Student_phone(ctx, field)
-			case "username":
-				return ec.fieldContext_Student_username(ctx, field)
-			case "gender":
-				return ec.fieldContext_Student_gender(ctx, field)
+				return ec.fieldContext_Topic_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Topic_title(ctx, field)
 			case "majorCode":
-				return ec.fieldContext_Student_majorCode(ctx, field)
-			case "classCode":
-				return ec.fieldContext_Student_classCode(ctx, field)
+				return ec.fieldContext_Topic_majorCode(ctx, field)
 			case "semesterCode":
-				return ec.fieldContext_Student_semesterCode(ctx, field)
+				return ec.fieldContext_Topic_semesterCode(ctx, field)
+			case "status":
+				return ec.fieldContext_Topic_status(ctx, field)
+			case "percentStage1":
+				return ec.fieldContext_Topic_percentStage1(ctx, field)
+			case "percentStage2":
+				return ec.fieldContext_Topic_percentStage2(ctx, field)
+			case "maxStudents":
+				return ec.fieldContext_Topic_maxStudents(ctx, field)
+			case "requiredSkills":
+				return ec.fieldContext_Topic_requiredSkills(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Student_createdAt(ctx, field)
+				return ec.fieldContext_Topic_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Student_updatedAt(ctx, field)
+				return ec.fieldContext_Topic_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Student_createdBy(ctx, field)
+				return ec.fieldContext_Topic_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Student_updatedBy(ctx, field)
-			case "enrollments":
-				return ec.fieldContext_Student_enrollments(ctx, field)
+				return ec.fieldContext_Topic_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Topic_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Topic_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Topic_deletedBy(ctx, field)
+			case "files":
+				return ec.fieldContext_Topic_files(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Topic_statusHistory(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Student", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_approveTopicStage1_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getMyEnrollments(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_rejectTopicStage1(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getMyEnrollments,
+		ec.fieldContext_Mutation_rejectTopicStage1,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetMyEnrollments(ctx, fc.Args["search"].(*model.SearchRequestInput))
+			return ec.resolvers.Mutation().RejectTopicStage1(ctx, fc.Args["id"].(string), fc.Args["reason"].(string))
 		},
 		nil,
-		ec.marshalNStudentEnrollmentListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentEnrollmentListResponse,
+		ec.marshalNTopic2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopic,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getMyEnrollments(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_rejectTopicStage1(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "total":
-				return ec.fieldContext_StudentEnrollmentListResponse_total(ctx, field)
-			case "data":
-				return ec.fieldContext_StudentEnrollmentListResponse_data(ctx, field)
+				return ec.fieldContext_Topic_total(ctx, field)
+			case "id":
+				return ec.fieldContext_Topic_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Topic_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Topic_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Topic_semesterCode(ctx, field)
+			case "status":
+				return ec.fieldContext_Topic_status(ctx, field)
+			case "percentStage1":
+				return ec.fieldContext_Topic_percentStage1(ctx, field)
+			case "percentStage2":
+				return ec.fieldContext_Topic_percentStage2(ctx, field)
+			case "maxStudents":
+				return ec.fieldContext_Topic_maxStudents(ctx, field)
+			case "requiredSkills":
+				return ec.fieldContext_Topic_requiredSkills(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Topic_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Topic_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Topic_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Topic_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Topic_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Topic_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Topic_deletedBy(ctx, field)
+			case "files":
+				return ec.fieldContext_Topic_files(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Topic_statusHistory(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type StudentEnrollmentListResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
 		},
 	}
 	defer func() {
@@ -7193,72 +7503,72 @@ func (ec *executionContext) fieldContext_Query_getMyEnrollments(ctx context.Cont
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getMyEnrollments_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_rejectTopicStage1_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getMyEnrollmentDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_assignTopicToCouncil(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getMyEnrollmentDetail,
+		ec.fieldContext_Mutation_assignTopicToCouncil,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetMyEnrollmentDetail(ctx, fc.Args["id"].(string))
+			return ec.resolvers.Mutation().AssignTopicToCouncil(ctx, fc.Args["topicCouncilId"].(string), fc.Args["councilId"].(string), fc.Args["overrideReason"].(*string))
 		},
 		nil,
-		ec.marshalOStudentEnrollment2ᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentEnrollment,
+		ec.marshalNTopicCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicCouncil,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getMyEnrollmentDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_assignTopicToCouncil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_StudentEnrollment_id(ctx, field)
+				return ec.fieldContext_TopicCouncil_id(ctx, field)
 			case "title":
-				return ec.fieldContext_StudentEnrollment_title(ctx, field)
-			case "studentCode":
-				return ec.fieldContext_StudentEnrollment_studentCode(ctx, field)
-			case "topicCouncilCode":
-				return ec.fieldContext_StudentEnrollment_topicCouncilCode(ctx, field)
-			case "finalCode":
-				return ec.fieldContext_StudentEnrollment_finalCode(ctx, field)
-			case "gradeReviewCode":
-				return ec.fieldContext_StudentEnrollment_gradeReviewCode(ctx, field)
-			case "midtermCode":
-				return ec.fieldContext_StudentEnrollment_midtermCode(ctx, field)
+				return ec.fieldContext_TopicCouncil_title(ctx, field)
+			case "stage":
+				return ec.fieldContext_TopicCouncil_stage(ctx, field)
+			case "topicCode":
+				return ec.fieldContext_TopicCouncil_topicCode(ctx, field)
+			case "councilCode":
+				return ec.fieldContext_TopicCouncil_councilCode(ctx, field)
+			case "timeStart":
+				return ec.fieldContext_TopicCouncil_timeStart(ctx, field)
+			case "timeEnd":
+				return ec.fieldContext_TopicCouncil_timeEnd(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_StudentEnrollment_createdAt(ctx, field)
+				return ec.fieldContext_TopicCouncil_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_StudentEnrollment_updatedAt(ctx, field)
+				return ec.fieldContext_TopicCouncil_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_StudentEnrollment_createdBy(ctx, field)
+				return ec.fieldContext_TopicCouncil_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_StudentEnrollment_updatedBy(ctx, field)
-			case "topicCouncil":
-				return ec.fieldContext_StudentEnrollment_topicCouncil(ctx, field)
-			case "midterm":
-				return ec.fieldContext_StudentEnrollment_midterm(ctx, field)
-			case "final":
-				return ec.fieldContext_StudentEnrollment_final(ctx, field)
-			case "gradeReview":
-				return ec.fieldContext_StudentEnrollment_gradeReview(ctx, field)
-			case "gradeDefences":
-				return ec.fieldContext_StudentEnrollment_gradeDefences(ctx, field)
+				return ec.fieldContext_TopicCouncil_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_TopicCouncil_version(ctx, field)
+			case "topic":
+				return ec.fieldContext_TopicCouncil_topic(ctx, field)
+			case "council":
+				return ec.fieldContext_TopicCouncil_council(ctx, field)
+			case "enrollments":
+				return ec.fieldContext_TopicCouncil_enrollments(ctx, field)
+			case "supervisors":
+				return ec.fieldContext_TopicCouncil_supervisors(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type StudentEnrollment", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TopicCouncil", field.Name)
 		},
 	}
 	defer func() {
@@ -7268,44 +7578,46 @@ func (ec *executionContext) fieldContext_Query_getMyEnrollmentDetail(ctx context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getMyEnrollmentDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_assignTopicToCouncil_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getMySemesters(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_overrideCouncilConflicts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getMySemesters,
+		ec.fieldContext_Mutation_overrideCouncilConflicts,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetMySemesters(ctx, fc.Args["search"].(*model.SearchRequestInput))
+			return ec.resolvers.Mutation().OverrideCouncilConflicts(ctx, fc.Args["councilId"].(string), fc.Args["reason"].(string))
 		},
 		nil,
-		ec.marshalNSemesterListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSemesterListResponse,
+		ec.marshalNCouncilValidation2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilValidation,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getMySemesters(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_overrideCouncilConflicts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "total":
-				return ec.fieldContext_SemesterListResponse_total(ctx, field)
-			case "data":
-				return ec.fieldContext_SemesterListResponse_data(ctx, field)
+			case "councilCode":
+				return ec.fieldContext_CouncilValidation_councilCode(ctx, field)
+			case "valid":
+				return ec.fieldContext_CouncilValidation_valid(ctx, field)
+			case "conflicts":
+				return ec.fieldContext_CouncilValidation_conflicts(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SemesterListResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type CouncilValidation", field.Name)
 		},
 	}
 	defer func() {
@@ -7315,97 +7627,155 @@ func (ec *executionContext) fieldContext_Query_getMySemesters(ctx context.Contex
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getMySemesters_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_overrideCouncilConflicts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getMyTeacherProfile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateMyProfile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getMyTeacherProfile,
+		ec.fieldContext_Mutation_updateMyProfile,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().GetMyTeacherProfile(ctx)
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpdateMyProfile(ctx, fc.Args["input"].(model.UpdateStudentProfileInput))
 		},
 		nil,
-		ec.marshalNTeacher2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacher,
+		ec.marshalNStudent2ᚖthailyᚋsrcᚋgraphᚋmodelᚐStudent,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getMyTeacherProfile(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updateMyProfile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Teacher_id(ctx, field)
+				return ec.fieldContext_Student_id(ctx, field)
 			case "email":
-				return ec.fieldContext_Teacher_email(ctx, field)
+				return ec.fieldContext_Student_email(ctx, field)
+			case "phone":
+				return ec.fieldContext_Student_phone(ctx, field)
 			case "username":
-				return ec.fieldContext_Teacher_username(ctx, field)
+				return ec.fieldContext_Student_username(ctx, field)
 			case "gender":
-				return ec.fieldContext_Teacher_gender(ctx, field)
+				return ec.fieldContext_Student_gender(ctx, field)
 			case "majorCode":
-				return ec.fieldContext_Teacher_majorCode(ctx, field)
+				return ec.fieldContext_Student_majorCode(ctx, field)
+			case "classCode":
+				return ec.fieldContext_Student_classCode(ctx, field)
 			case "semesterCode":
-				return ec.fieldContext_Teacher_semesterCode(ctx, field)
+				return ec.fieldContext_Student_semesterCode(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Teacher_createdAt(ctx, field)
+				return ec.fieldContext_Student_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Teacher_updatedAt(ctx, field)
+				return ec.fieldContext_Student_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Teacher_createdBy(ctx, field)
+				return ec.fieldContext_Student_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Teacher_updatedBy(ctx, field)
-			case "roles":
-				return ec.fieldContext_Teacher_roles(ctx, field)
+				return ec.fieldContext_Student_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Student_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Student_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Student_deletedBy(ctx, field)
+			case "enrollments":
+				return ec.fieldContext_Student_enrollments(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Teacher", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Student", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateMyProfile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getMySupervisedTopicCouncils(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_uploadMidtermFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getMySupervisedTopicCouncils,
+		ec.fieldContext_Mutation_uploadMidtermFile,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetMySupervisedTopicCouncils(ctx, fc.Args["search"].(*model.SearchRequestInput))
+			return ec.resolvers.Mutation().UploadMidtermFile(ctx, fc.Args["input"].(model.UploadFileInput))
 		},
 		nil,
-		ec.marshalNSupervisorTopicCouncilAssignmentListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSupervisorTopicCouncilAssignmentListResponse,
+		ec.marshalNFile2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFile,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getMySupervisedTopicCouncils(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_uploadMidtermFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "total":
-				return ec.fieldContext_SupervisorTopicCouncilAssignmentListResponse_total(ctx, field)
-			case "data":
-				return ec.fieldContext_SupervisorTopicCouncilAssignmentListResponse_data(ctx, field)
+			case "id":
+				return ec.fieldContext_File_id(ctx, field)
+			case "title":
+				return ec.fieldContext_File_title(ctx, field)
+			case "file":
+				return ec.fieldContext_File_file(ctx, field)
+			case "status":
+				return ec.fieldContext_File_status(ctx, field)
+			case "table":
+				return ec.fieldContext_File_table(ctx, field)
+			case "option":
+				return ec.fieldContext_File_option(ctx, field)
+			case "tableId":
+				return ec.fieldContext_File_tableId(ctx, field)
+			case "version":
+				return ec.fieldContext_File_version(ctx, field)
+			case "size":
+				return ec.fieldContext_File_size(ctx, field)
+			case "checksum":
+				return ec.fieldContext_File_checksum(ctx, field)
+			case "contentType":
+				return ec.fieldContext_File_contentType(ctx, field)
+			case "late":
+				return ec.fieldContext_File_late(ctx, field)
+			case "quarantined":
+				return ec.fieldContext_File_quarantined(ctx, field)
+			case "scanResult":
+				return ec.fieldContext_File_scanResult(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_File_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_File_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_File_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_File_updatedBy(ctx, field)
+			case "revision":
+				return ec.fieldContext_File_revision(ctx, field)
+			case "versions":
+				return ec.fieldContext_File_versions(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SupervisorTopicCouncilAssignmentListResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
 		},
 	}
 	defer func() {
@@ -7415,52 +7785,80 @@ func (ec *executionContext) fieldContext_Query_getMySupervisedTopicCouncils(ctx
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getMySupervisedTopicCouncils_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_uploadMidtermFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getMySupervisedTopicCouncilDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_uploadFinalFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getMySupervisedTopicCouncilDetail,
+		ec.fieldContext_Mutation_uploadFinalFile,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetMySupervisedTopicCouncilDetail(ctx, fc.Args["id"].(string))
+			return ec.resolvers.Mutation().UploadFinalFile(ctx, fc.Args["input"].(model.UploadFileInput))
 		},
 		nil,
-		ec.marshalOSupervisorTopicCouncilAssignment2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSupervisorTopicCouncilAssignment,
+		ec.marshalNFile2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFile,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getMySupervisedTopicCouncilDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_uploadFinalFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_SupervisorTopicCouncilAssignment_id(ctx, field)
-			case "teacherSupervisorCode":
-				return ec.fieldContext_SupervisorTopicCouncilAssignment_teacherSupervisorCode(ctx, field)
-			case "topicCouncilCode":
-				return ec.fieldContext_SupervisorTopicCouncilAssignment_topicCouncilCode(ctx, field)
+				return ec.fieldContext_File_id(ctx, field)
+			case "title":
+				return ec.fieldContext_File_title(ctx, field)
+			case "file":
+				return ec.fieldContext_File_file(ctx, field)
+			case "status":
+				return ec.fieldContext_File_status(ctx, field)
+			case "table":
+				return ec.fieldContext_File_table(ctx, field)
+			case "option":
+				return ec.fieldContext_File_option(ctx, field)
+			case "tableId":
+				return ec.fieldContext_File_tableId(ctx, field)
+			case "version":
+				return ec.fieldContext_File_version(ctx, field)
+			case "size":
+				return ec.fieldContext_File_size(ctx, field)
+			case "checksum":
+				return ec.fieldContext_File_checksum(ctx, field)
+			case "contentType":
+				return ec.fieldContext_File_contentType(ctx, field)
+			case "late":
+				return ec.fieldContext_File_late(ctx, field)
+			case "quarantined":
+				return ec.fieldContext_File_quarantined(ctx, field)
+			case "scanResult":
+				return ec.fieldContext_File_scanResult(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_SupervisorTopicCouncilAssignment_createdAt(ctx, field)
+				return ec.fieldContext_File_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_SupervisorTopicCouncilAssignment_updatedAt(ctx, field)
-			case "topicCouncil":
-				return ec.fieldContext_SupervisorTopicCouncilAssignment_topicCouncil(ctx, field)
+				return ec.fieldContext_File_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_File_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_File_updatedBy(ctx, field)
+			case "revision":
+				return ec.fieldContext_File_revision(ctx, field)
+			case "versions":
+				return ec.fieldContext_File_versions(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SupervisorTopicCouncilAssignment", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
 		},
 	}
 	defer func() {
@@ -7470,44 +7868,64 @@ func (ec *executionContext) fieldContext_Query_getMySupervisedTopicCouncilDetail
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getMySupervisedTopicCouncilDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_uploadFinalFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getMyDefences(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_registerTopicPreferences(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getMyDefences,
+		ec.fieldContext_Mutation_registerTopicPreferences,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetMyDefences(ctx, fc.Args["search"].(*model.SearchRequestInput))
+			return ec.resolvers.Mutation().RegisterTopicPreferences(ctx, fc.Args["topicIds"].([]string))
 		},
 		nil,
-		ec.marshalNCouncilDefenceListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilDefenceListResponse,
+		ec.marshalNTopicRegistration2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicRegistrationᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getMyDefences(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_registerTopicPreferences(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "total":
-				return ec.fieldContext_CouncilDefenceListResponse_total(ctx, field)
-			case "data":
-				return ec.fieldContext_CouncilDefenceListResponse_data(ctx, field)
+			case "id":
+				return ec.fieldContext_TopicRegistration_id(ctx, field)
+			case "topicCode":
+				return ec.fieldContext_TopicRegistration_topicCode(ctx, field)
+			case "studentCode":
+				return ec.fieldContext_TopicRegistration_studentCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_TopicRegistration_semesterCode(ctx, field)
+			case "rank":
+				return ec.fieldContext_TopicRegistration_rank(ctx, field)
+			case "status":
+				return ec.fieldContext_TopicRegistration_status(ctx, field)
+			case "reason":
+				return ec.fieldContext_TopicRegistration_reason(ctx, field)
+			case "decidedBy":
+				return ec.fieldContext_TopicRegistration_decidedBy(ctx, field)
+			case "decidedAt":
+				return ec.fieldContext_TopicRegistration_decidedAt(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_TopicRegistration_enrollmentCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TopicRegistration_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TopicRegistration_updatedAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type CouncilDefenceListResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TopicRegistration", field.Name)
 		},
 	}
 	defer func() {
@@ -7517,60 +7935,62 @@ func (ec *executionContext) fieldContext_Query_getMyDefences(ctx context.Context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getMyDefences_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_registerTopicPreferences_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getMyDefenceDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_submitMilestoneCheckin(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getMyDefenceDetail,
+		ec.fieldContext_Mutation_submitMilestoneCheckin,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetMyDefenceDetail(ctx, fc.Args["id"].(string))
+			return ec.resolvers.Mutation().SubmitMilestoneCheckin(ctx, fc.Args["input"].(model.SubmitMilestoneCheckinInput))
 		},
 		nil,
-		ec.marshalOCouncilDefence2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilDefence,
+		ec.marshalNMilestoneCheckin2ᚖthailyᚋsrcᚋgraphᚋmodelᚐMilestoneCheckin,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getMyDefenceDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_submitMilestoneCheckin(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_CouncilDefence_id(ctx, field)
-			case "title":
-				return ec.fieldContext_CouncilDefence_title(ctx, field)
-			case "councilCode":
-				return ec.fieldContext_CouncilDefence_councilCode(ctx, field)
-			case "teacherCode":
-				return ec.fieldContext_CouncilDefence_teacherCode(ctx, field)
-			case "position":
-				return ec.fieldContext_CouncilDefence_position(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_CouncilDefence_createdAt(ctx, field)
+				return ec.fieldContext_MilestoneCheckin_id(ctx, field)
+			case "milestoneCode":
+				return ec.fieldContext_MilestoneCheckin_milestoneCode(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_MilestoneCheckin_enrollmentCode(ctx, field)
+			case "report":
+				return ec.fieldContext_MilestoneCheckin_report(ctx, field)
+			case "fileCode":
+				return ec.fieldContext_MilestoneCheckin_fileCode(ctx, field)
+			case "result":
+				return ec.fieldContext_MilestoneCheckin_result(ctx, field)
+			case "comment":
+				return ec.fieldContext_MilestoneCheckin_comment(ctx, field)
+			case "reviewedBy":
+				return ec.fieldContext_MilestoneCheckin_reviewedBy(ctx, field)
+			case "reviewedAt":
+				return ec.fieldContext_MilestoneCheckin_reviewedAt(ctx, field)
+			case "submittedAt":
+				return ec.fieldContext_MilestoneCheckin_submittedAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_CouncilDefence_updatedAt(ctx, field)
-			case "council":
-				return ec.fieldContext_CouncilDefence_council(ctx, field)
-			case "teacher":
-				return ec.fieldContext_CouncilDefence_teacher(ctx, field)
-			case "gradeDefences":
-				return ec.fieldContext_CouncilDefence_gradeDefences(ctx, field)
+				return ec.fieldContext_MilestoneCheckin_updatedAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type CouncilDefence", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type MilestoneCheckin", field.Name)
 		},
 	}
 	defer func() {
@@ -7580,44 +8000,72 @@ func (ec *executionContext) fieldContext_Query_getMyDefenceDetail(ctx context.Co
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getMyDefenceDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_submitMilestoneCheckin_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getMyGradeReviews(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_fileGradeAppeal(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getMyGradeReviews,
+		ec.fieldContext_Mutation_fileGradeAppeal,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetMyGradeReviews(ctx, fc.Args["search"].(*model.SearchRequestInput))
+			return ec.resolvers.Mutation().FileGradeAppeal(ctx, fc.Args["input"].(model.FileGradeAppealInput))
 		},
 		nil,
-		ec.marshalNReviewerGradeReviewListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐReviewerGradeReviewListResponse,
+		ec.marshalNGradeAppeal2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeAppeal,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getMyGradeReviews(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_fileGradeAppeal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "total":
-				return ec.fieldContext_ReviewerGradeReviewListResponse_total(ctx, field)
-			case "data":
-				return ec.fieldContext_ReviewerGradeReviewListResponse_data(ctx, field)
+			case "id":
+				return ec.fieldContext_GradeAppeal_id(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_GradeAppeal_enrollmentCode(ctx, field)
+			case "studentCode":
+				return ec.fieldContext_GradeAppeal_studentCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_GradeAppeal_semesterCode(ctx, field)
+			case "component":
+				return ec.fieldContext_GradeAppeal_component(ctx, field)
+			case "reason":
+				return ec.fieldContext_GradeAppeal_reason(ctx, field)
+			case "evidenceFileCode":
+				return ec.fieldContext_GradeAppeal_evidenceFileCode(ctx, field)
+			case "status":
+				return ec.fieldContext_GradeAppeal_status(ctx, field)
+			case "reviewerCode":
+				return ec.fieldContext_GradeAppeal_reviewerCode(ctx, field)
+			case "resolutionNote":
+				return ec.fieldContext_GradeAppeal_resolutionNote(ctx, field)
+			case "amendmentCode":
+				return ec.fieldContext_GradeAppeal_amendmentCode(ctx, field)
+			case "resolvedBy":
+				return ec.fieldContext_GradeAppeal_resolvedBy(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_GradeAppeal_resolvedAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_GradeAppeal_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_GradeAppeal_updatedAt(ctx, field)
+			case "history":
+				return ec.fieldContext_GradeAppeal_history(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ReviewerGradeReviewListResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type GradeAppeal", field.Name)
 		},
 	}
 	defer func() {
@@ -7627,60 +8075,68 @@ func (ec *executionContext) fieldContext_Query_getMyGradeReviews(ctx context.Con
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getMyGradeReviews_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_fileGradeAppeal_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getMyGradeReviewDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateMyTeacherProfile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getMyGradeReviewDetail,
+		ec.fieldContext_Mutation_updateMyTeacherProfile,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetMyGradeReviewDetail(ctx, fc.Args["id"].(string))
+			return ec.resolvers.Mutation().UpdateMyTeacherProfile(ctx, fc.Args["input"].(model.UpdateTeacherProfileInput))
 		},
 		nil,
-		ec.marshalOReviewerGradeReview2ᚖthailyᚋsrcᚋgraphᚋmodelᚐReviewerGradeReview,
+		ec.marshalNTeacher2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacher,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getMyGradeReviewDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updateMyTeacherProfile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_ReviewerGradeReview_id(ctx, field)
-			case "title":
-				return ec.fieldContext_ReviewerGradeReview_title(ctx, field)
-			case "teacherCode":
-				return ec.fieldContext_ReviewerGradeReview_teacherCode(ctx, field)
-			case "reviewGrade":
-				return ec.fieldContext_ReviewerGradeReview_reviewGrade(ctx, field)
-			case "status":
-				return ec.fieldContext_ReviewerGradeReview_status(ctx, field)
-			case "notes":
-				return ec.fieldContext_ReviewerGradeReview_notes(ctx, field)
-			case "completionDate":
-				return ec.fieldContext_ReviewerGradeReview_completionDate(ctx, field)
+				return ec.fieldContext_Teacher_id(ctx, field)
+			case "email":
+				return ec.fieldContext_Teacher_email(ctx, field)
+			case "username":
+				return ec.fieldContext_Teacher_username(ctx, field)
+			case "gender":
+				return ec.fieldContext_Teacher_gender(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Teacher_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Teacher_semesterCode(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_ReviewerGradeReview_createdAt(ctx, field)
+				return ec.fieldContext_Teacher_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_ReviewerGradeReview_updatedAt(ctx, field)
-			case "enrollment":
-				return ec.fieldContext_ReviewerGradeReview_enrollment(ctx, field)
+				return ec.fieldContext_Teacher_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Teacher_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Teacher_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Teacher_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Teacher_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Teacher_deletedBy(ctx, field)
+			case "roles":
+				return ec.fieldContext_Teacher_roles(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ReviewerGradeReview", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Teacher", field.Name)
 		},
 	}
 	defer func() {
@@ -7690,62 +8146,46 @@ func (ec *executionContext) fieldContext_Query_getMyGradeReviewDetail(ctx contex
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getMyGradeReviewDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_updateMyTeacherProfile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_proposeTopic(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query___type,
+		ec.fieldContext_Mutation_proposeTopic,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.introspectType(fc.Args["name"].(string))
+			return ec.resolvers.Mutation().ProposeTopic(ctx, fc.Args["input"].(model.ProposeTopicInput))
 		},
 		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNProposeTopicResult2ᚖthailyᚋsrcᚋgraphᚋmodelᚐProposeTopicResult,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_proposeTopic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			case "topic":
+				return ec.fieldContext_ProposeTopicResult_topic(ctx, field)
+			case "topicCouncilId":
+				return ec.fieldContext_ProposeTopicResult_topicCouncilId(ctx, field)
+			case "coSigns":
+				return ec.fieldContext_ProposeTopicResult_coSigns(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ProposeTopicResult", field.Name)
 		},
 	}
 	defer func() {
@@ -7755,1084 +8195,1742 @@ func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_proposeTopic_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_coSignTopic(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query___schema,
+		ec.fieldContext_Mutation_coSignTopic,
 		func(ctx context.Context) (any, error) {
-			return ec.introspectSchema()
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CoSignTopic(ctx, fc.Args["topicId"].(string), fc.Args["accept"].(bool))
 		},
 		nil,
-		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
+		ec.marshalNTopicCoSign2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicCoSign,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_coSignTopic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
+			case "id":
+				return ec.fieldContext_TopicCoSign_id(ctx, field)
+			case "topicCode":
+				return ec.fieldContext_TopicCoSign_topicCode(ctx, field)
+			case "teacherCode":
+				return ec.fieldContext_TopicCoSign_teacherCode(ctx, field)
+			case "status":
+				return ec.fieldContext_TopicCoSign_status(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TopicCoSign_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TopicCoSign_updatedAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TopicCoSign", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_coSignTopic_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReviewerGradeReviewListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.ReviewerGradeReviewListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_submitTopic(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ReviewerGradeReviewListResponse_total,
+		ec.fieldContext_Mutation_submitTopic,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().SubmitTopic(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNTopic2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopic,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ReviewerGradeReviewListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_submitTopic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReviewerGradeReviewListResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_Topic_total(ctx, field)
+			case "id":
+				return ec.fieldContext_Topic_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Topic_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Topic_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Topic_semesterCode(ctx, field)
+			case "status":
+				return ec.fieldContext_Topic_status(ctx, field)
+			case "percentStage1":
+				return ec.fieldContext_Topic_percentStage1(ctx, field)
+			case "percentStage2":
+				return ec.fieldContext_Topic_percentStage2(ctx, field)
+			case "maxStudents":
+				return ec.fieldContext_Topic_maxStudents(ctx, field)
+			case "requiredSkills":
+				return ec.fieldContext_Topic_requiredSkills(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Topic_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Topic_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Topic_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Topic_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Topic_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Topic_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Topic_deletedBy(ctx, field)
+			case "files":
+				return ec.fieldContext_Topic_files(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Topic_statusHistory(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_submitTopic_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReviewerGradeReviewListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.ReviewerGradeReviewListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_decideTopicRegistration(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ReviewerGradeReviewListResponse_data,
+		ec.fieldContext_Mutation_decideTopicRegistration,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DecideTopicRegistration(ctx, fc.Args["registrationId"].(string), fc.Args["accept"].(bool), fc.Args["reason"].(*string))
 		},
 		nil,
-		ec.marshalNReviewerGradeReview2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐReviewerGradeReviewᚄ,
+		ec.marshalNTopicRegistration2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicRegistration,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ReviewerGradeReviewListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_decideTopicRegistration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReviewerGradeReviewListResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_ReviewerGradeReview_id(ctx, field)
-			case "title":
-				return ec.fieldContext_ReviewerGradeReview_title(ctx, field)
-			case "teacherCode":
-				return ec.fieldContext_ReviewerGradeReview_teacherCode(ctx, field)
-			case "reviewGrade":
-				return ec.fieldContext_ReviewerGradeReview_reviewGrade(ctx, field)
+				return ec.fieldContext_TopicRegistration_id(ctx, field)
+			case "topicCode":
+				return ec.fieldContext_TopicRegistration_topicCode(ctx, field)
+			case "studentCode":
+				return ec.fieldContext_TopicRegistration_studentCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_TopicRegistration_semesterCode(ctx, field)
+			case "rank":
+				return ec.fieldContext_TopicRegistration_rank(ctx, field)
 			case "status":
-				return ec.fieldContext_ReviewerGradeReview_status(ctx, field)
-			case "notes":
-				return ec.fieldContext_ReviewerGradeReview_notes(ctx, field)
-			case "completionDate":
-				return ec.fieldContext_ReviewerGradeReview_completionDate(ctx, field)
+				return ec.fieldContext_TopicRegistration_status(ctx, field)
+			case "reason":
+				return ec.fieldContext_TopicRegistration_reason(ctx, field)
+			case "decidedBy":
+				return ec.fieldContext_TopicRegistration_decidedBy(ctx, field)
+			case "decidedAt":
+				return ec.fieldContext_TopicRegistration_decidedAt(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_TopicRegistration_enrollmentCode(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_ReviewerGradeReview_createdAt(ctx, field)
+				return ec.fieldContext_TopicRegistration_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_ReviewerGradeReview_updatedAt(ctx, field)
-			case "enrollment":
-				return ec.fieldContext_ReviewerGradeReview_enrollment(ctx, field)
+				return ec.fieldContext_TopicRegistration_updatedAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ReviewerGradeReview", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TopicRegistration", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_decideTopicRegistration_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RoleSystemListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.RoleSystemListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_rankTopicApplicants(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RoleSystemListResponse_total,
+		ec.fieldContext_Mutation_rankTopicApplicants,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RankTopicApplicants(ctx, fc.Args["topicId"].(string), fc.Args["studentIds"].([]string), fc.Args["restricted"].(*bool))
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNApplicantRanking2ᚖthailyᚋsrcᚋgraphᚋmodelᚐApplicantRanking,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RoleSystemListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_rankTopicApplicants(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RoleSystemListResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "topicCode":
+				return ec.fieldContext_ApplicantRanking_topicCode(ctx, field)
+			case "studentCodes":
+				return ec.fieldContext_ApplicantRanking_studentCodes(ctx, field)
+			case "restricted":
+				return ec.fieldContext_ApplicantRanking_restricted(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ApplicantRanking", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_rankTopicApplicants_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RoleSystemListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.RoleSystemListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_reviewMilestoneCheckin(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RoleSystemListResponse_data,
+		ec.fieldContext_Mutation_reviewMilestoneCheckin,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ReviewMilestoneCheckin(ctx, fc.Args["id"].(string), fc.Args["pass"].(bool), fc.Args["comment"].(*string))
 		},
 		nil,
-		ec.marshalNRoleSystem2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐRoleSystemᚄ,
+		ec.marshalNMilestoneCheckin2ᚖthailyᚋsrcᚋgraphᚋmodelᚐMilestoneCheckin,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RoleSystemListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_reviewMilestoneCheckin(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RoleSystemListResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_RoleSystem_id(ctx, field)
-			case "title":
-				return ec.fieldContext_RoleSystem_title(ctx, field)
-			case "teacherCode":
-				return ec.fieldContext_RoleSystem_teacherCode(ctx, field)
-			case "role":
-				return ec.fieldContext_RoleSystem_role(ctx, field)
-			case "semesterCode":
-				return ec.fieldContext_RoleSystem_semesterCode(ctx, field)
-			case "activate":
-				return ec.fieldContext_RoleSystem_activate(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_RoleSystem_createdAt(ctx, field)
+				return ec.fieldContext_MilestoneCheckin_id(ctx, field)
+			case "milestoneCode":
+				return ec.fieldContext_MilestoneCheckin_milestoneCode(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_MilestoneCheckin_enrollmentCode(ctx, field)
+			case "report":
+				return ec.fieldContext_MilestoneCheckin_report(ctx, field)
+			case "fileCode":
+				return ec.fieldContext_MilestoneCheckin_fileCode(ctx, field)
+			case "result":
+				return ec.fieldContext_MilestoneCheckin_result(ctx, field)
+			case "comment":
+				return ec.fieldContext_MilestoneCheckin_comment(ctx, field)
+			case "reviewedBy":
+				return ec.fieldContext_MilestoneCheckin_reviewedBy(ctx, field)
+			case "reviewedAt":
+				return ec.fieldContext_MilestoneCheckin_reviewedAt(ctx, field)
+			case "submittedAt":
+				return ec.fieldContext_MilestoneCheckin_submittedAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_RoleSystem_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_RoleSystem_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_RoleSystem_updatedBy(ctx, field)
-			case "teacher":
-				return ec.fieldContext_RoleSystem_teacher(ctx, field)
-			case "semester":
-				return ec.fieldContext_RoleSystem_semester(ctx, field)
+				return ec.fieldContext_MilestoneCheckin_updatedAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type RoleSystem", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type MilestoneCheckin", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_reviewMilestoneCheckin_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SemesterInfo_id(ctx context.Context, field graphql.CollectedField, obj *model.SemesterInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_gradeFinal(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SemesterInfo_id,
+		ec.fieldContext_Mutation_gradeFinal,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().GradeFinal(ctx, fc.Args["enrollmentId"].(string), fc.Args["input"].(model.GradeFinalInput))
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNFinal2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFinal,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SemesterInfo_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_gradeFinal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SemesterInfo",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Final_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Final_title(ctx, field)
+			case "supervisorGrade":
+				return ec.fieldContext_Final_supervisorGrade(ctx, field)
+			case "departmentGrade":
+				return ec.fieldContext_Final_departmentGrade(ctx, field)
+			case "finalGrade":
+				return ec.fieldContext_Final_finalGrade(ctx, field)
+			case "status":
+				return ec.fieldContext_Final_status(ctx, field)
+			case "notes":
+				return ec.fieldContext_Final_notes(ctx, field)
+			case "completionDate":
+				return ec.fieldContext_Final_completionDate(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Final_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Final_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Final_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Final_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Final_version(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Final", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_gradeFinal_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SemesterInfo_title(ctx context.Context, field graphql.CollectedField, obj *model.SemesterInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_feedbackFinal(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SemesterInfo_title,
+		ec.fieldContext_Mutation_feedbackFinal,
 		func(ctx context.Context) (any, error) {
-			return obj.Title, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().FeedbackFinal(ctx, fc.Args["finalId"].(string), fc.Args["notes"].(string))
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFinal2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFinal,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SemesterInfo_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_feedbackFinal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SemesterInfo",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Final_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Final_title(ctx, field)
+			case "supervisorGrade":
+				return ec.fieldContext_Final_supervisorGrade(ctx, field)
+			case "departmentGrade":
+				return ec.fieldContext_Final_departmentGrade(ctx, field)
+			case "finalGrade":
+				return ec.fieldContext_Final_finalGrade(ctx, field)
+			case "status":
+				return ec.fieldContext_Final_status(ctx, field)
+			case "notes":
+				return ec.fieldContext_Final_notes(ctx, field)
+			case "completionDate":
+				return ec.fieldContext_Final_completionDate(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Final_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Final_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Final_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Final_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Final_version(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Final", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_feedbackFinal_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SemesterListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.SemesterListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_approveMidtermFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SemesterListResponse_total,
+		ec.fieldContext_Mutation_approveMidtermFile,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ApproveMidtermFile(ctx, fc.Args["fileId"].(string))
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNFile2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFile,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SemesterListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_approveMidtermFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SemesterListResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_File_id(ctx, field)
+			case "title":
+				return ec.fieldContext_File_title(ctx, field)
+			case "file":
+				return ec.fieldContext_File_file(ctx, field)
+			case "status":
+				return ec.fieldContext_File_status(ctx, field)
+			case "table":
+				return ec.fieldContext_File_table(ctx, field)
+			case "option":
+				return ec.fieldContext_File_option(ctx, field)
+			case "tableId":
+				return ec.fieldContext_File_tableId(ctx, field)
+			case "version":
+				return ec.fieldContext_File_version(ctx, field)
+			case "size":
+				return ec.fieldContext_File_size(ctx, field)
+			case "checksum":
+				return ec.fieldContext_File_checksum(ctx, field)
+			case "contentType":
+				return ec.fieldContext_File_contentType(ctx, field)
+			case "late":
+				return ec.fieldContext_File_late(ctx, field)
+			case "quarantined":
+				return ec.fieldContext_File_quarantined(ctx, field)
+			case "scanResult":
+				return ec.fieldContext_File_scanResult(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_File_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_File_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_File_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_File_updatedBy(ctx, field)
+			case "revision":
+				return ec.fieldContext_File_revision(ctx, field)
+			case "versions":
+				return ec.fieldContext_File_versions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_approveMidtermFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SemesterListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.SemesterListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_rejectMidtermFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SemesterListResponse_data,
+		ec.fieldContext_Mutation_rejectMidtermFile,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RejectMidtermFile(ctx, fc.Args["fileId"].(string), fc.Args["reason"].(*string))
 		},
 		nil,
-		ec.marshalNSemester2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐSemesterᚄ,
+		ec.marshalNFile2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFile,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SemesterListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_rejectMidtermFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SemesterListResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Semester_id(ctx, field)
+				return ec.fieldContext_File_id(ctx, field)
 			case "title":
-				return ec.fieldContext_Semester_title(ctx, field)
+				return ec.fieldContext_File_title(ctx, field)
+			case "file":
+				return ec.fieldContext_File_file(ctx, field)
+			case "status":
+				return ec.fieldContext_File_status(ctx, field)
+			case "table":
+				return ec.fieldContext_File_table(ctx, field)
+			case "option":
+				return ec.fieldContext_File_option(ctx, field)
+			case "tableId":
+				return ec.fieldContext_File_tableId(ctx, field)
+			case "version":
+				return ec.fieldContext_File_version(ctx, field)
+			case "size":
+				return ec.fieldContext_File_size(ctx, field)
+			case "checksum":
+				return ec.fieldContext_File_checksum(ctx, field)
+			case "contentType":
+				return ec.fieldContext_File_contentType(ctx, field)
+			case "late":
+				return ec.fieldContext_File_late(ctx, field)
+			case "quarantined":
+				return ec.fieldContext_File_quarantined(ctx, field)
+			case "scanResult":
+				return ec.fieldContext_File_scanResult(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Semester_createdAt(ctx, field)
+				return ec.fieldContext_File_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Semester_updatedAt(ctx, field)
+				return ec.fieldContext_File_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Semester_createdBy(ctx, field)
+				return ec.fieldContext_File_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Semester_updatedBy(ctx, field)
-			case "students":
-				return ec.fieldContext_Semester_students(ctx, field)
-			case "teachers":
-				return ec.fieldContext_Semester_teachers(ctx, field)
-			case "topics":
-				return ec.fieldContext_Semester_topics(ctx, field)
+				return ec.fieldContext_File_updatedBy(ctx, field)
+			case "revision":
+				return ec.fieldContext_File_revision(ctx, field)
+			case "versions":
+				return ec.fieldContext_File_versions(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Semester", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
 		},
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) _StudentDefenceInfoListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.StudentDefenceInfoListResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_StudentDefenceInfoListResponse_total,
-		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
-		},
-		nil,
-		ec.marshalNInt2int32,
-		true,
-		true,
-	)
-}
-
-func (ec *executionContext) fieldContext_StudentDefenceInfoListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "StudentDefenceInfoListResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_rejectMidtermFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StudentDefenceInfoListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.StudentDefenceInfoListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_approveFinalFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_StudentDefenceInfoListResponse_data,
+		ec.fieldContext_Mutation_approveFinalFile,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ApproveFinalFile(ctx, fc.Args["fileId"].(string))
 		},
 		nil,
-		ec.marshalNStudentDefenceInfo2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentDefenceInfoᚄ,
+		ec.marshalNFile2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFile,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_StudentDefenceInfoListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_approveFinalFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StudentDefenceInfoListResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_StudentDefenceInfo_id(ctx, field)
+				return ec.fieldContext_File_id(ctx, field)
 			case "title":
-				return ec.fieldContext_StudentDefenceInfo_title(ctx, field)
-			case "position":
-				return ec.fieldContext_StudentDefenceInfo_position(ctx, field)
+				return ec.fieldContext_File_title(ctx, field)
+			case "file":
+				return ec.fieldContext_File_file(ctx, field)
+			case "status":
+				return ec.fieldContext_File_status(ctx, field)
+			case "table":
+				return ec.fieldContext_File_table(ctx, field)
+			case "option":
+				return ec.fieldContext_File_option(ctx, field)
+			case "tableId":
+				return ec.fieldContext_File_tableId(ctx, field)
+			case "version":
+				return ec.fieldContext_File_version(ctx, field)
+			case "size":
+				return ec.fieldContext_File_size(ctx, field)
+			case "checksum":
+				return ec.fieldContext_File_checksum(ctx, field)
+			case "contentType":
+				return ec.fieldContext_File_contentType(ctx, field)
+			case "late":
+				return ec.fieldContext_File_late(ctx, field)
+			case "quarantined":
+				return ec.fieldContext_File_quarantined(ctx, field)
+			case "scanResult":
+				return ec.fieldContext_File_scanResult(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_StudentDefenceInfo_createdAt(ctx, field)
+				return ec.fieldContext_File_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_StudentDefenceInfo_updatedAt(ctx, field)
-			case "teacher":
-				return ec.fieldContext_StudentDefenceInfo_teacher(ctx, field)
+				return ec.fieldContext_File_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_File_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_File_updatedBy(ctx, field)
+			case "revision":
+				return ec.fieldContext_File_revision(ctx, field)
+			case "versions":
+				return ec.fieldContext_File_versions(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type StudentDefenceInfo", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_approveFinalFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StudentEnrollmentListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.StudentEnrollmentListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_rejectFinalFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_StudentEnrollmentListResponse_total,
+		ec.fieldContext_Mutation_rejectFinalFile,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RejectFinalFile(ctx, fc.Args["fileId"].(string), fc.Args["reason"].(*string))
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNFile2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFile,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_StudentEnrollmentListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_rejectFinalFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StudentEnrollmentListResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_File_id(ctx, field)
+			case "title":
+				return ec.fieldContext_File_title(ctx, field)
+			case "file":
+				return ec.fieldContext_File_file(ctx, field)
+			case "status":
+				return ec.fieldContext_File_status(ctx, field)
+			case "table":
+				return ec.fieldContext_File_table(ctx, field)
+			case "option":
+				return ec.fieldContext_File_option(ctx, field)
+			case "tableId":
+				return ec.fieldContext_File_tableId(ctx, field)
+			case "version":
+				return ec.fieldContext_File_version(ctx, field)
+			case "size":
+				return ec.fieldContext_File_size(ctx, field)
+			case "checksum":
+				return ec.fieldContext_File_checksum(ctx, field)
+			case "contentType":
+				return ec.fieldContext_File_contentType(ctx, field)
+			case "late":
+				return ec.fieldContext_File_late(ctx, field)
+			case "quarantined":
+				return ec.fieldContext_File_quarantined(ctx, field)
+			case "scanResult":
+				return ec.fieldContext_File_scanResult(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_File_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_File_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_File_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_File_updatedBy(ctx, field)
+			case "revision":
+				return ec.fieldContext_File_revision(ctx, field)
+			case "versions":
+				return ec.fieldContext_File_versions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_rejectFinalFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StudentEnrollmentListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.StudentEnrollmentListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_grantDeadlineExtension(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_StudentEnrollmentListResponse_data,
+		ec.fieldContext_Mutation_grantDeadlineExtension,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().GrantDeadlineExtension(ctx, fc.Args["input"].(model.GrantDeadlineExtensionInput))
 		},
 		nil,
-		ec.marshalNStudentEnrollment2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentEnrollmentᚄ,
+		ec.marshalNDeadlineExtension2ᚖthailyᚋsrcᚋgraphᚋmodelᚐDeadlineExtension,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_StudentEnrollmentListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_grantDeadlineExtension(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StudentEnrollmentListResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_StudentEnrollment_id(ctx, field)
-			case "title":
-				return ec.fieldContext_StudentEnrollment_title(ctx, field)
+				return ec.fieldContext_DeadlineExtension_id(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_DeadlineExtension_semesterCode(ctx, field)
+			case "stage":
+				return ec.fieldContext_DeadlineExtension_stage(ctx, field)
+			case "kind":
+				return ec.fieldContext_DeadlineExtension_kind(ctx, field)
 			case "studentCode":
-				return ec.fieldContext_StudentEnrollment_studentCode(ctx, field)
-			case "topicCouncilCode":
-				return ec.fieldContext_StudentEnrollment_topicCouncilCode(ctx, field)
-			case "finalCode":
-				return ec.fieldContext_StudentEnrollment_finalCode(ctx, field)
-			case "gradeReviewCode":
-				return ec.fieldContext_StudentEnrollment_gradeReviewCode(ctx, field)
-			case "midtermCode":
-				return ec.fieldContext_StudentEnrollment_midtermCode(ctx, field)
+				return ec.fieldContext_DeadlineExtension_studentCode(ctx, field)
+			case "dueAt":
+				return ec.fieldContext_DeadlineExtension_dueAt(ctx, field)
+			case "reason":
+				return ec.fieldContext_DeadlineExtension_reason(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_StudentEnrollment_createdAt(ctx, field)
+				return ec.fieldContext_DeadlineExtension_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_StudentEnrollment_updatedAt(ctx, field)
+				return ec.fieldContext_DeadlineExtension_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_StudentEnrollment_createdBy(ctx, field)
+				return ec.fieldContext_DeadlineExtension_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_StudentEnrollment_updatedBy(ctx, field)
-			case "topicCouncil":
-				return ec.fieldContext_StudentEnrollment_topicCouncil(ctx, field)
-			case "midterm":
-				return ec.fieldContext_StudentEnrollment_midterm(ctx, field)
-			case "final":
-				return ec.fieldContext_StudentEnrollment_final(ctx, field)
-			case "gradeReview":
-				return ec.fieldContext_StudentEnrollment_gradeReview(ctx, field)
-			case "gradeDefences":
-				return ec.fieldContext_StudentEnrollment_gradeDefences(ctx, field)
+				return ec.fieldContext_DeadlineExtension_updatedBy(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type StudentEnrollment", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type DeadlineExtension", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_grantDeadlineExtension_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StudentGradeDefenceListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.StudentGradeDefenceListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createGradeDefence(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_StudentGradeDefenceListResponse_total,
+		ec.fieldContext_Mutation_createGradeDefence,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreateGradeDefence(ctx, fc.Args["input"].(model.CreateGradeDefenceInput))
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNGradeDefence2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefence,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_StudentGradeDefenceListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createGradeDefence(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StudentGradeDefenceListResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_GradeDefence_id(ctx, field)
+			case "defenceCode":
+				return ec.fieldContext_GradeDefence_defenceCode(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_GradeDefence_enrollmentCode(ctx, field)
+			case "note":
+				return ec.fieldContext_GradeDefence_note(ctx, field)
+			case "totalScore":
+				return ec.fieldContext_GradeDefence_totalScore(ctx, field)
+			case "rubricTemplateCode":
+				return ec.fieldContext_GradeDefence_rubricTemplateCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_GradeDefence_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_GradeDefence_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_GradeDefence_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_GradeDefence_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_GradeDefence_version(ctx, field)
+			case "defence":
+				return ec.fieldContext_GradeDefence_defence(ctx, field)
+			case "enrollment":
+				return ec.fieldContext_GradeDefence_enrollment(ctx, field)
+			case "criteria":
+				return ec.fieldContext_GradeDefence_criteria(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GradeDefence", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createGradeDefence_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StudentGradeDefenceListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.StudentGradeDefenceListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateGradeDefence(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_StudentGradeDefenceListResponse_data,
+		ec.fieldContext_Mutation_updateGradeDefence,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpdateGradeDefence(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateGradeDefenceInput))
 		},
 		nil,
-		ec.marshalNStudentGradeDefence2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentGradeDefenceᚄ,
+		ec.marshalNGradeDefence2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefence,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_StudentGradeDefenceListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updateGradeDefence(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StudentGradeDefenceListResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_StudentGradeDefence_id(ctx, field)
+				return ec.fieldContext_GradeDefence_id(ctx, field)
 			case "defenceCode":
-				return ec.fieldContext_StudentGradeDefence_defenceCode(ctx, field)
+				return ec.fieldContext_GradeDefence_defenceCode(ctx, field)
 			case "enrollmentCode":
-				return ec.fieldContext_StudentGradeDefence_enrollmentCode(ctx, field)
+				return ec.fieldContext_GradeDefence_enrollmentCode(ctx, field)
 			case "note":
-				return ec.fieldContext_StudentGradeDefence_note(ctx, field)
+				return ec.fieldContext_GradeDefence_note(ctx, field)
 			case "totalScore":
-				return ec.fieldContext_StudentGradeDefence_totalScore(ctx, field)
+				return ec.fieldContext_GradeDefence_totalScore(ctx, field)
+			case "rubricTemplateCode":
+				return ec.fieldContext_GradeDefence_rubricTemplateCode(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_StudentGradeDefence_createdAt(ctx, field)
+				return ec.fieldContext_GradeDefence_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_StudentGradeDefence_updatedAt(ctx, field)
-			case "criteria":
-				return ec.fieldContext_StudentGradeDefence_criteria(ctx, field)
+				return ec.fieldContext_GradeDefence_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_GradeDefence_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_GradeDefence_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_GradeDefence_version(ctx, field)
 			case "defence":
-				return ec.fieldContext_StudentGradeDefence_defence(ctx, field)
+				return ec.fieldContext_GradeDefence_defence(ctx, field)
+			case "enrollment":
+				return ec.fieldContext_GradeDefence_enrollment(ctx, field)
+			case "criteria":
+				return ec.fieldContext_GradeDefence_criteria(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type StudentGradeDefence", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type GradeDefence", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateGradeDefence_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StudentListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.StudentListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_addGradeDefenceCriterion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_StudentListResponse_total,
+		ec.fieldContext_Mutation_addGradeDefenceCriterion,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().AddGradeDefenceCriterion(ctx, fc.Args["input"].(model.CreateGradeDefenceCriterionInput))
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNGradeDefenceCriterion2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefenceCriterion,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_StudentListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_addGradeDefenceCriterion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StudentListResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_GradeDefenceCriterion_id(ctx, field)
+			case "gradeDefenceCode":
+				return ec.fieldContext_GradeDefenceCriterion_gradeDefenceCode(ctx, field)
+			case "name":
+				return ec.fieldContext_GradeDefenceCriterion_name(ctx, field)
+			case "description":
+				return ec.fieldContext_GradeDefenceCriterion_description(ctx, field)
+			case "score":
+				return ec.fieldContext_GradeDefenceCriterion_score(ctx, field)
+			case "maxScore":
+				return ec.fieldContext_GradeDefenceCriterion_maxScore(ctx, field)
+			case "weight":
+				return ec.fieldContext_GradeDefenceCriterion_weight(ctx, field)
+			case "rubricCriterionCode":
+				return ec.fieldContext_GradeDefenceCriterion_rubricCriterionCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_GradeDefenceCriterion_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_GradeDefenceCriterion_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_GradeDefenceCriterion_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_GradeDefenceCriterion_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_GradeDefenceCriterion_version(ctx, field)
+			case "gradeDefence":
+				return ec.fieldContext_GradeDefenceCriterion_gradeDefence(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GradeDefenceCriterion", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_addGradeDefenceCriterion_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StudentListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.StudentListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateGradeDefenceCriterion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_StudentListResponse_data,
+		ec.fieldContext_Mutation_updateGradeDefenceCriterion,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpdateGradeDefenceCriterion(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateGradeDefenceCriterionInput))
 		},
 		nil,
-		ec.marshalNStudent2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentᚄ,
+		ec.marshalNGradeDefenceCriterion2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefenceCriterion,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_StudentListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updateGradeDefenceCriterion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StudentListResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Student_id(ctx, field)
-			case "email":
-				return ec.fieldContext_Student_email(ctx, field)
-			case "phone":
-				return ec.fieldContext_Student_phone(ctx, field)
-			case "username":
-				return ec.fieldContext_Student_username(ctx, field)
-			case "gender":
-				return ec.fieldContext_Student_gender(ctx, field)
-			case "majorCode":
-				return ec.fieldContext_Student_majorCode(ctx, field)
-			case "classCode":
-				return ec.fieldContext_Student_classCode(ctx, field)
-			case "semesterCode":
-				return ec.fieldContext_Student_semesterCode(ctx, field)
+				return ec.fieldContext_GradeDefenceCriterion_id(ctx, field)
+			case "gradeDefenceCode":
+				return ec.fieldContext_GradeDefenceCriterion_gradeDefenceCode(ctx, field)
+			case "name":
+				return ec.fieldContext_GradeDefenceCriterion_name(ctx, field)
+			case "description":
+				return ec.fieldContext_GradeDefenceCriterion_description(ctx, field)
+			case "score":
+				return ec.fieldContext_GradeDefenceCriterion_score(ctx, field)
+			case "maxScore":
+				return ec.fieldContext_GradeDefenceCriterion_maxScore(ctx, field)
+			case "weight":
+				return ec.fieldContext_GradeDefenceCriterion_weight(ctx, field)
+			case "rubricCriterionCode":
+				return ec.fieldContext_GradeDefenceCriterion_rubricCriterionCode(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Student_createdAt(ctx, field)
+				return ec.fieldContext_GradeDefenceCriterion_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Student_updatedAt(ctx, field)
+				return ec.fieldContext_GradeDefenceCriterion_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_Student_createdBy(ctx, field)
+				return ec.fieldContext_GradeDefenceCriterion_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_Student_updatedBy(ctx, field)
-			case "enrollments":
-				return ec.fieldContext_Student_enrollments(ctx, field)
+				return ec.fieldContext_GradeDefenceCriterion_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_GradeDefenceCriterion_version(ctx, field)
+			case "gradeDefence":
+				return ec.fieldContext_GradeDefenceCriterion_gradeDefence(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Student", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type GradeDefenceCriterion", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateGradeDefenceCriterion_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StudentTopicSupervisorListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.StudentTopicSupervisorListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_deleteGradeDefenceCriterion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_StudentTopicSupervisorListResponse_total,
+		ec.fieldContext_Mutation_deleteGradeDefenceCriterion,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DeleteGradeDefenceCriterion(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_StudentTopicSupervisorListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deleteGradeDefenceCriterion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StudentTopicSupervisorListResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteGradeDefenceCriterion_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StudentTopicSupervisorListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.StudentTopicSupervisorListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_requestGradeAmendment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_StudentTopicSupervisorListResponse_data,
+		ec.fieldContext_Mutation_requestGradeAmendment,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RequestGradeAmendment(ctx, fc.Args["input"].(model.RequestGradeAmendmentInput))
 		},
 		nil,
-		ec.marshalNStudentTopicSupervisor2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentTopicSupervisorᚄ,
+		ec.marshalNGradeAmendment2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeAmendment,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_StudentTopicSupervisorListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_requestGradeAmendment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StudentTopicSupervisorListResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_StudentTopicSupervisor_id(ctx, field)
-			case "teacherSupervisorCode":
-				return ec.fieldContext_StudentTopicSupervisor_teacherSupervisorCode(ctx, field)
-			case "topicCouncilCode":
-				return ec.fieldContext_StudentTopicSupervisor_topicCouncilCode(ctx, field)
-			case "teacher":
-				return ec.fieldContext_StudentTopicSupervisor_teacher(ctx, field)
+				return ec.fieldContext_GradeAmendment_id(ctx, field)
+			case "target":
+				return ec.fieldContext_GradeAmendment_target(ctx, field)
+			case "targetId":
+				return ec.fieldContext_GradeAmendment_targetId(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_GradeAmendment_enrollmentCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_GradeAmendment_semesterCode(ctx, field)
+			case "councilCode":
+				return ec.fieldContext_GradeAmendment_councilCode(ctx, field)
+			case "oldValue":
+				return ec.fieldContext_GradeAmendment_oldValue(ctx, field)
+			case "newValue":
+				return ec.fieldContext_GradeAmendment_newValue(ctx, field)
+			case "reason":
+				return ec.fieldContext_GradeAmendment_reason(ctx, field)
+			case "status":
+				return ec.fieldContext_GradeAmendment_status(ctx, field)
+			case "requestedBy":
+				return ec.fieldContext_GradeAmendment_requestedBy(ctx, field)
+			case "decidedBy":
+				return ec.fieldContext_GradeAmendment_decidedBy(ctx, field)
+			case "decidedAt":
+				return ec.fieldContext_GradeAmendment_decidedAt(ctx, field)
+			case "decisionNote":
+				return ec.fieldContext_GradeAmendment_decisionNote(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_GradeAmendment_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_GradeAmendment_updatedAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type StudentTopicSupervisor", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type GradeAmendment", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_requestGradeAmendment_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription__empty(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	return graphql.ResolveFieldStream(
+func (ec *executionContext) _Mutation_resolveGradeAppeal(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Subscription__empty,
+		ec.fieldContext_Mutation_resolveGradeAppeal,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Subscription().Empty(ctx)
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ResolveGradeAppeal(ctx, fc.Args["input"].(model.ResolveGradeAppealInput))
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNGradeAppeal2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeAppeal,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Subscription__empty(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_resolveGradeAppeal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_GradeAppeal_id(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_GradeAppeal_enrollmentCode(ctx, field)
+			case "studentCode":
+				return ec.fieldContext_GradeAppeal_studentCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_GradeAppeal_semesterCode(ctx, field)
+			case "component":
+				return ec.fieldContext_GradeAppeal_component(ctx, field)
+			case "reason":
+				return ec.fieldContext_GradeAppeal_reason(ctx, field)
+			case "evidenceFileCode":
+				return ec.fieldContext_GradeAppeal_evidenceFileCode(ctx, field)
+			case "status":
+				return ec.fieldContext_GradeAppeal_status(ctx, field)
+			case "reviewerCode":
+				return ec.fieldContext_GradeAppeal_reviewerCode(ctx, field)
+			case "resolutionNote":
+				return ec.fieldContext_GradeAppeal_resolutionNote(ctx, field)
+			case "amendmentCode":
+				return ec.fieldContext_GradeAppeal_amendmentCode(ctx, field)
+			case "resolvedBy":
+				return ec.fieldContext_GradeAppeal_resolvedBy(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_GradeAppeal_resolvedAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_GradeAppeal_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_GradeAppeal_updatedAt(ctx, field)
+			case "history":
+				return ec.fieldContext_GradeAppeal_history(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GradeAppeal", field.Name)
 		},
 	}
-	return fc, nil
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_resolveGradeAppeal_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
 }
 
-func (ec *executionContext) _SupervisorEnrollmentListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.SupervisorEnrollmentListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateGradeReview(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SupervisorEnrollmentListResponse_total,
+		ec.fieldContext_Mutation_updateGradeReview,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpdateGradeReview(ctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateGradeReviewInput))
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNReviewerGradeReview2ᚖthailyᚋsrcᚋgraphᚋmodelᚐReviewerGradeReview,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SupervisorEnrollmentListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updateGradeReview(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SupervisorEnrollmentListResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ReviewerGradeReview_id(ctx, field)
+			case "title":
+				return ec.fieldContext_ReviewerGradeReview_title(ctx, field)
+			case "teacherCode":
+				return ec.fieldContext_ReviewerGradeReview_teacherCode(ctx, field)
+			case "reviewGrade":
+				return ec.fieldContext_ReviewerGradeReview_reviewGrade(ctx, field)
+			case "status":
+				return ec.fieldContext_ReviewerGradeReview_status(ctx, field)
+			case "notes":
+				return ec.fieldContext_ReviewerGradeReview_notes(ctx, field)
+			case "completionDate":
+				return ec.fieldContext_ReviewerGradeReview_completionDate(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_ReviewerGradeReview_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_ReviewerGradeReview_updatedAt(ctx, field)
+			case "enrollment":
+				return ec.fieldContext_ReviewerGradeReview_enrollment(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ReviewerGradeReview", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateGradeReview_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SupervisorEnrollmentListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.SupervisorEnrollmentListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_completeGradeReview(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SupervisorEnrollmentListResponse_data,
+		ec.fieldContext_Mutation_completeGradeReview,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CompleteGradeReview(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalNSupervisorEnrollment2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐSupervisorEnrollmentᚄ,
+		ec.marshalNReviewerGradeReview2ᚖthailyᚋsrcᚋgraphᚋmodelᚐReviewerGradeReview,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SupervisorEnrollmentListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_completeGradeReview(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SupervisorEnrollmentListResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_SupervisorEnrollment_id(ctx, field)
+				return ec.fieldContext_ReviewerGradeReview_id(ctx, field)
 			case "title":
-				return ec.fieldContext_SupervisorEnrollment_title(ctx, field)
-			case "studentCode":
-				return ec.fieldContext_SupervisorEnrollment_studentCode(ctx, field)
-			case "topicCouncilCode":
-				return ec.fieldContext_SupervisorEnrollment_topicCouncilCode(ctx, field)
-			case "finalCode":
-				return ec.fieldContext_SupervisorEnrollment_finalCode(ctx, field)
-			case "gradeReviewCode":
-				return ec.fieldContext_SupervisorEnrollment_gradeReviewCode(ctx, field)
-			case "midtermCode":
-				return ec.fieldContext_SupervisorEnrollment_midtermCode(ctx, field)
+				return ec.fieldContext_ReviewerGradeReview_title(ctx, field)
+			case "teacherCode":
+				return ec.fieldContext_ReviewerGradeReview_teacherCode(ctx, field)
+			case "reviewGrade":
+				return ec.fieldContext_ReviewerGradeReview_reviewGrade(ctx, field)
+			case "status":
+				return ec.fieldContext_ReviewerGradeReview_status(ctx, field)
+			case "notes":
+				return ec.fieldContext_ReviewerGradeReview_notes(ctx, field)
+			case "completionDate":
+				return ec.fieldContext_ReviewerGradeReview_completionDate(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_SupervisorEnrollment_createdAt(ctx, field)
+				return ec.fieldContext_ReviewerGradeReview_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_SupervisorEnrollment_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_SupervisorEnrollment_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_SupervisorEnrollment_updatedBy(ctx, field)
-			case "student":
-				return ec.fieldContext_SupervisorEnrollment_student(ctx, field)
-			case "topicCouncil":
-				return ec.fieldContext_SupervisorEnrollment_topicCouncil(ctx, field)
-			case "midterm":
-				return ec.fieldContext_SupervisorEnrollment_midterm(ctx, field)
-			case "final":
-				return ec.fieldContext_SupervisorEnrollment_final(ctx, field)
-			case "gradeReview":
-				return ec.fieldContext_SupervisorEnrollment_gradeReview(ctx, field)
-			case "gradeDefences":
-				return ec.fieldContext_SupervisorEnrollment_gradeDefences(ctx, field)
+				return ec.fieldContext_ReviewerGradeReview_updatedAt(ctx, field)
+			case "enrollment":
+				return ec.fieldContext_ReviewerGradeReview_enrollment(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SupervisorEnrollment", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ReviewerGradeReview", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_completeGradeReview_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SupervisorTopicCouncilAssignmentListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.SupervisorTopicCouncilAssignmentListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query__empty(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SupervisorTopicCouncilAssignmentListResponse_total,
+		ec.fieldContext_Query__empty,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			return ec.resolvers.Query().Empty(ctx)
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_SupervisorTopicCouncilAssignmentListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query__empty(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SupervisorTopicCouncilAssignmentListResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SupervisorTopicCouncilAssignmentListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.SupervisorTopicCouncilAssignmentListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_globalSearch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SupervisorTopicCouncilAssignmentListResponse_data,
+		ec.fieldContext_Query_globalSearch,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GlobalSearch(ctx, fc.Args["query"].(string), fc.Args["types"].([]model.SearchType), fc.Args["limit"].(*int32))
 		},
 		nil,
-		ec.marshalNSupervisorTopicCouncilAssignment2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐSupervisorTopicCouncilAssignmentᚄ,
+		ec.marshalNSearchHit2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐSearchHitᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SupervisorTopicCouncilAssignmentListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_globalSearch(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SupervisorTopicCouncilAssignmentListResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "type":
+				return ec.fieldContext_SearchHit_type(ctx, field)
 			case "id":
-				return ec.fieldContext_SupervisorTopicCouncilAssignment_id(ctx, field)
-			case "teacherSupervisorCode":
-				return ec.fieldContext_SupervisorTopicCouncilAssignment_teacherSupervisorCode(ctx, field)
-			case "topicCouncilCode":
-				return ec.fieldContext_SupervisorTopicCouncilAssignment_topicCouncilCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_SupervisorTopicCouncilAssignment_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_SupervisorTopicCouncilAssignment_updatedAt(ctx, field)
-			case "topicCouncil":
-				return ec.fieldContext_SupervisorTopicCouncilAssignment_topicCouncil(ctx, field)
+				return ec.fieldContext_SearchHit_id(ctx, field)
+			case "title":
+				return ec.fieldContext_SearchHit_title(ctx, field)
+			case "subtitle":
+				return ec.fieldContext_SearchHit_subtitle(ctx, field)
+			case "score":
+				return ec.fieldContext_SearchHit_score(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SupervisorTopicCouncilAssignment", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type SearchHit", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_globalSearch_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SupervisorTopicCouncilListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.SupervisorTopicCouncilListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_getListTeachers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SupervisorTopicCouncilListResponse_total,
+		ec.fieldContext_Query_getListTeachers,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetListTeachers(ctx, fc.Args["search"].(model.SearchRequestInput))
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNTeacherListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacherListResponse,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SupervisorTopicCouncilListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getListTeachers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SupervisorTopicCouncilListResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_TeacherListResponse_total(ctx, field)
+			case "data":
+				return ec.fieldContext_TeacherListResponse_data(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TeacherListResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getListTeachers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SupervisorTopicCouncilListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.SupervisorTopicCouncilListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_getListStudents(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SupervisorTopicCouncilListResponse_data,
+		ec.fieldContext_Query_getListStudents,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetListStudents(ctx, fc.Args["search"].(model.SearchRequestInput))
 		},
 		nil,
-		ec.marshalNSupervisorTopicCouncil2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐSupervisorTopicCouncilᚄ,
+		ec.marshalNStudentListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentListResponse,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SupervisorTopicCouncilListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getListStudents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SupervisorTopicCouncilListResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_SupervisorTopicCouncil_id(ctx, field)
-			case "title":
-				return ec.fieldContext_SupervisorTopicCouncil_title(ctx, field)
-			case "stage":
-				return ec.fieldContext_SupervisorTopicCouncil_stage(ctx, field)
-			case "topicCode":
-				return ec.fieldContext_SupervisorTopicCouncil_topicCode(ctx, field)
-			case "councilCode":
-				return ec.fieldContext_SupervisorTopicCouncil_councilCode(ctx, field)
-			case "timeStart":
-				return ec.fieldContext_SupervisorTopicCouncil_timeStart(ctx, field)
-			case "timeEnd":
-				return ec.fieldContext_SupervisorTopicCouncil_timeEnd(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_SupervisorTopicCouncil_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_SupervisorTopicCouncil_updatedAt(ctx, field)
-			case "topic":
-				return ec.fieldContext_SupervisorTopicCouncil_topic(ctx, field)
-			case "council":
-				return ec.fieldContext_SupervisorTopicCouncil_council(ctx, field)
-			case "enrollments":
-				return ec.fieldContext_SupervisorTopicCouncil_enrollments(ctx, field)
-			case "supervisors":
-				return ec.fieldContext_SupervisorTopicCouncil_supervisors(ctx, field)
+			case "total":
+				return ec.fieldContext_StudentListResponse_total(ctx, field)
+			case "data":
+				return ec.fieldContext_StudentListResponse_data(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SupervisorTopicCouncil", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type StudentListResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getListStudents_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TeacherListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.TeacherListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_getStudentDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_TeacherListResponse_total,
+		ec.fieldContext_Query_getStudentDetail,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetStudentDetail(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOStudent2ᚖthailyᚋsrcᚋgraphᚋmodelᚐStudent,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_TeacherListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getStudentDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TeacherListResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Student_id(ctx, field)
+			case "email":
+				return ec.fieldContext_Student_email(ctx, field)
+			case "phone":
+				return ec.fieldContext_Student_phone(ctx, field)
+			case "username":
+				return ec.fieldContext_Student_username(ctx, field)
+			case "gender":
+				return ec.fieldContext_Student_gender(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Student_majorCode(ctx, field)
+			case "classCode":
+				return ec.fieldContext_Student_classCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Student_semesterCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Student_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Student_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Student_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Student_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Student_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Student_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Student_deletedBy(ctx, field)
+			case "enrollments":
+				return ec.fieldContext_Student_enrollments(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Student", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getStudentDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TeacherListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.TeacherListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_getTeacherDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_TeacherListResponse_data,
+		ec.fieldContext_Query_getTeacherDetail,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetTeacherDetail(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalNTeacher2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacherᚄ,
-		true,
+		ec.marshalOTeacher2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacher,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_TeacherListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getTeacherDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TeacherListResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
@@ -8855,234 +9953,243 @@ func (ec *executionContext) fieldContext_TeacherListResponse_data(_ context.Cont
 				return ec.fieldContext_Teacher_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Teacher_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Teacher_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Teacher_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Teacher_deletedBy(ctx, field)
 			case "roles":
 				return ec.fieldContext_Teacher_roles(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Teacher", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getTeacherDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TopicCouncilListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.TopicCouncilListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_getAllSemesters(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_TopicCouncilListResponse_total,
+		ec.fieldContext_Query_getAllSemesters,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetAllSemesters(ctx, fc.Args["search"].(model.SearchRequestInput))
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNSemesterListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSemesterListResponse,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_TopicCouncilListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getAllSemesters(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TopicCouncilListResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_SemesterListResponse_total(ctx, field)
+			case "data":
+				return ec.fieldContext_SemesterListResponse_data(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SemesterListResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getAllSemesters_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TopicCouncilListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.TopicCouncilListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_getAllMajors(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_TopicCouncilListResponse_data,
+		ec.fieldContext_Query_getAllMajors,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetAllMajors(ctx, fc.Args["search"].(model.SearchRequestInput))
 		},
 		nil,
-		ec.marshalNTopicCouncil2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicCouncilᚄ,
+		ec.marshalNMajorListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐMajorListResponse,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_TopicCouncilListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getAllMajors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TopicCouncilListResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_TopicCouncil_id(ctx, field)
-			case "title":
-				return ec.fieldContext_TopicCouncil_title(ctx, field)
-			case "stage":
-				return ec.fieldContext_TopicCouncil_stage(ctx, field)
-			case "topicCode":
-				return ec.fieldContext_TopicCouncil_topicCode(ctx, field)
-			case "councilCode":
-				return ec.fieldContext_TopicCouncil_councilCode(ctx, field)
-			case "timeStart":
-				return ec.fieldContext_TopicCouncil_timeStart(ctx, field)
-			case "timeEnd":
-				return ec.fieldContext_TopicCouncil_timeEnd(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_TopicCouncil_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_TopicCouncil_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_TopicCouncil_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_TopicCouncil_updatedBy(ctx, field)
-			case "topic":
-				return ec.fieldContext_TopicCouncil_topic(ctx, field)
-			case "council":
-				return ec.fieldContext_TopicCouncil_council(ctx, field)
-			case "enrollments":
-				return ec.fieldContext_TopicCouncil_enrollments(ctx, field)
-			case "supervisors":
-				return ec.fieldContext_TopicCouncil_supervisors(ctx, field)
+			case "total":
+				return ec.fieldContext_MajorListResponse_total(ctx, field)
+			case "data":
+				return ec.fieldContext_MajorListResponse_data(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type TopicCouncil", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type MajorListResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getAllMajors_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TopicCouncilSupervisorListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.TopicCouncilSupervisorListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_getAllFaculties(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_TopicCouncilSupervisorListResponse_total,
+		ec.fieldContext_Query_getAllFaculties,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetAllFaculties(ctx, fc.Args["search"].(model.SearchRequestInput))
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNFacultyListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFacultyListResponse,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_TopicCouncilSupervisorListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getAllFaculties(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TopicCouncilSupervisorListResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_FacultyListResponse_total(ctx, field)
+			case "data":
+				return ec.fieldContext_FacultyListResponse_data(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FacultyListResponse", field.Name)
 		},
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) _TopicCouncilSupervisorListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.TopicCouncilSupervisorListResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_TopicCouncilSupervisorListResponse_data,
-		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
-		},
-		nil,
-		ec.marshalNTopicCouncilSupervisor2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicCouncilSupervisorᚄ,
-		true,
-		true,
-	)
-}
-
-func (ec *executionContext) fieldContext_TopicCouncilSupervisorListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "TopicCouncilSupervisorListResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_TopicCouncilSupervisor_id(ctx, field)
-			case "teacherSupervisorCode":
-				return ec.fieldContext_TopicCouncilSupervisor_teacherSupervisorCode(ctx, field)
-			case "topicCouncilCode":
-				return ec.fieldContext_TopicCouncilSupervisor_topicCouncilCode(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_TopicCouncilSupervisor_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_TopicCouncilSupervisor_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_TopicCouncilSupervisor_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_TopicCouncilSupervisor_updatedBy(ctx, field)
-			case "teacher":
-				return ec.fieldContext_TopicCouncilSupervisor_teacher(ctx, field)
-			case "topicCouncil":
-				return ec.fieldContext_TopicCouncilSupervisor_topicCouncil(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TopicCouncilSupervisor", field.Name)
-		},
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getAllFaculties_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TopicListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.TopicListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_getAllTopics(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_TopicListResponse_total,
+		ec.fieldContext_Query_getAllTopics,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetAllTopics(ctx, fc.Args["search"].(model.SearchRequestInput))
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNTopicListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicListResponse,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_TopicListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getAllTopics(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TopicListResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_TopicListResponse_total(ctx, field)
+			case "data":
+				return ec.fieldContext_TopicListResponse_data(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TopicListResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getAllTopics_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TopicListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.TopicListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_getTopicDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_TopicListResponse_data,
+		ec.fieldContext_Query_getTopicDetail,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetTopicDetail(ctx, fc.Args["id"].(string))
 		},
 		nil,
-		ec.marshalNTopic2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicᚄ,
-		true,
+		ec.marshalOTopic2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopic,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_TopicListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getTopicDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TopicListResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "total":
@@ -9101,6 +10208,10 @@ func (ec *executionContext) fieldContext_TopicListResponse_data(_ context.Contex
 				return ec.fieldContext_Topic_percentStage1(ctx, field)
 			case "percentStage2":
 				return ec.fieldContext_Topic_percentStage2(ctx, field)
+			case "maxStudents":
+				return ec.fieldContext_Topic_maxStudents(ctx, field)
+			case "requiredSkills":
+				return ec.fieldContext_Topic_requiredSkills(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_Topic_createdAt(ctx, field)
 			case "updatedAt":
@@ -9109,873 +10220,6887 @@ func (ec *executionContext) fieldContext_TopicListResponse_data(_ context.Contex
 				return ec.fieldContext_Topic_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Topic_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Topic_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Topic_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Topic_deletedBy(ctx, field)
 			case "files":
 				return ec.fieldContext_Topic_files(ctx, field)
 			case "topicCouncils":
 				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Topic_statusHistory(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
 		},
 	}
-	return fc, nil
-}
-
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
-
-func (ec *executionContext) unmarshalInputFilterConditionInput(ctx context.Context, obj any) (model.FilterConditionInput, error) {
-	var it model.FilterConditionInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
-
-	fieldsInOrder := [...]string{"field", "operator", "values"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "field":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Field = data
-		case "operator":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("operator"))
-			data, err := ec.unmarshalNFilterOperator2thailyᚋsrcᚋgraphᚋmodelᚐFilterOperator(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Operator = data
-		case "values":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("values"))
-			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Values = data
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
 		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getTopicDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
 	}
-
-	return it, nil
+	return fc, nil
 }
 
-func (ec *executionContext) unmarshalInputFilterCriteriaInput(ctx context.Context, obj any) (model.FilterCriteriaInput, error) {
-	var it model.FilterCriteriaInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+func (ec *executionContext) _Query_getAllEnrollments(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getAllEnrollments,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetAllEnrollments(ctx, fc.Args["search"].(model.SearchRequestInput))
+		},
+		nil,
+		ec.marshalNEnrollmentListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐEnrollmentListResponse,
+		true,
+		true,
+	)
+}
 
-	fieldsInOrder := [...]string{"condition", "group"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "condition":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("condition"))
-			data, err := ec.unmarshalOFilterConditionInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFilterConditionInput(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Condition = data
-		case "group":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("group"))
-			data, err := ec.unmarshalOFilterGroupInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFilterGroupInput(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) fieldContext_Query_getAllEnrollments(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_EnrollmentListResponse_total(ctx, field)
+			case "data":
+				return ec.fieldContext_EnrollmentListResponse_data(ctx, field)
 			}
-			it.Group = data
+			return nil, fmt.Errorf("no field named %q was found under type EnrollmentListResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
 		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getAllEnrollments_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
 	}
-
-	return it, nil
+	return fc, nil
 }
 
-func (ec *executionContext) unmarshalInputFilterGroupInput(ctx context.Context, obj any) (model.FilterGroupInput, error) {
-	var it model.FilterGroupInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
-
-	if _, present := asMap["logic"]; !present {
-		asMap["logic"] = "AND"
-	}
+func (ec *executionContext) _Query_getEnrollmentDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getEnrollmentDetail,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetEnrollmentDetail(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOEnrollment2ᚖthailyᚋsrcᚋgraphᚋmodelᚐEnrollment,
+		true,
+		false,
+	)
+}
 
-	fieldsInOrder := [...]string{"logic", "filters"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "logic":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("logic"))
-			data, err := ec.unmarshalOLogicalCondition2ᚖthailyᚋsrcᚋgraphᚋmodelᚐLogicalCondition(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Logic = data
-		case "filters":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filters"))
-			data, err := ec.unmarshalNFilterCriteriaInput2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐFilterCriteriaInputᚄ(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) fieldContext_Query_getEnrollmentDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Enrollment_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Enrollment_title(ctx, field)
+			case "studentCode":
+				return ec.fieldContext_Enrollment_studentCode(ctx, field)
+			case "topicCouncilCode":
+				return ec.fieldContext_Enrollment_topicCouncilCode(ctx, field)
+			case "finalCode":
+				return ec.fieldContext_Enrollment_finalCode(ctx, field)
+			case "gradeReviewCode":
+				return ec.fieldContext_Enrollment_gradeReviewCode(ctx, field)
+			case "midtermCode":
+				return ec.fieldContext_Enrollment_midtermCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Enrollment_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Enrollment_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Enrollment_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Enrollment_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Enrollment_version(ctx, field)
+			case "student":
+				return ec.fieldContext_Enrollment_student(ctx, field)
+			case "midterm":
+				return ec.fieldContext_Enrollment_midterm(ctx, field)
+			case "final":
+				return ec.fieldContext_Enrollment_final(ctx, field)
+			case "topicCouncil":
+				return ec.fieldContext_Enrollment_topicCouncil(ctx, field)
+			case "gradeReview":
+				return ec.fieldContext_Enrollment_gradeReview(ctx, field)
+			case "gradeDefences":
+				return ec.fieldContext_Enrollment_gradeDefences(ctx, field)
 			}
-			it.Filters = data
+			return nil, fmt.Errorf("no field named %q was found under type Enrollment", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
 		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getEnrollmentDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
 	}
-
-	return it, nil
+	return fc, nil
 }
 
-func (ec *executionContext) unmarshalInputPaginationInput(ctx context.Context, obj any) (model.PaginationInput, error) {
-	var it model.PaginationInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
-
-	if _, present := asMap["page"]; !present {
-		asMap["page"] = 1
-	}
-	if _, present := asMap["pageSize"]; !present {
-		asMap["pageSize"] = 20
-	}
-	if _, present := asMap["descending"]; !present {
-		asMap["descending"] = false
-	}
+func (ec *executionContext) _Query_getAllCouncils(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getAllCouncils,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetAllCouncils(ctx, fc.Args["search"].(model.SearchRequestInput))
+		},
+		nil,
+		ec.marshalNCouncilListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilListResponse,
+		true,
+		true,
+	)
+}
 
-	fieldsInOrder := [...]string{"page", "pageSize", "sortBy", "descending"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "page":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("page"))
-			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Page = data
-		case "pageSize":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pageSize"))
-			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.PageSize = data
-		case "sortBy":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sortBy"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.SortBy = data
-		case "descending":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("descending"))
-			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) fieldContext_Query_getAllCouncils(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_CouncilListResponse_total(ctx, field)
+			case "data":
+				return ec.fieldContext_CouncilListResponse_data(ctx, field)
 			}
-			it.Descending = data
+			return nil, fmt.Errorf("no field named %q was found under type CouncilListResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
 		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getAllCouncils_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
 	}
-
-	return it, nil
+	return fc, nil
 }
 
-func (ec *executionContext) unmarshalInputSearchRequestInput(ctx context.Context, obj any) (model.SearchRequestInput, error) {
-	var it model.SearchRequestInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+func (ec *executionContext) _Query_getCouncilDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getCouncilDetail,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetCouncilDetail(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil,
+		true,
+		false,
+	)
+}
 
-	fieldsInOrder := [...]string{"pagination", "filters"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "pagination":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pagination"))
-			data, err := ec.unmarshalOPaginationInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐPaginationInput(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Pagination = data
-		case "filters":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filters"))
-			data, err := ec.unmarshalOFilterCriteriaInput2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐFilterCriteriaInputᚄ(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) fieldContext_Query_getCouncilDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_Council_total(ctx, field)
+			case "id":
+				return ec.fieldContext_Council_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Council_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Council_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Council_semesterCode(ctx, field)
+			case "timeStart":
+				return ec.fieldContext_Council_timeStart(ctx, field)
+			case "room":
+				return ec.fieldContext_Council_room(ctx, field)
+			case "gradesLocked":
+				return ec.fieldContext_Council_gradesLocked(ctx, field)
+			case "gradesLockedAt":
+				return ec.fieldContext_Council_gradesLockedAt(ctx, field)
+			case "gradesPublished":
+				return ec.fieldContext_Council_gradesPublished(ctx, field)
+			case "gradesPublishedAt":
+				return ec.fieldContext_Council_gradesPublishedAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Council_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Council_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Council_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Council_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Council_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Council_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Council_deletedBy(ctx, field)
+			case "defences":
+				return ec.fieldContext_Council_defences(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Council_topicCouncils(ctx, field)
 			}
-			it.Filters = data
+			return nil, fmt.Errorf("no field named %q was found under type Council", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
 		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getCouncilDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
 	}
-
-	return it, nil
+	return fc, nil
 }
 
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
+func (ec *executionContext) _Query_getDefencesByCouncil(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getDefencesByCouncil,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetDefencesByCouncil(ctx, fc.Args["councilId"].(string))
+		},
+		nil,
+		ec.marshalNDefenceListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceListResponse,
+		true,
+		true,
+	)
+}
 
-// endregion ************************** interface.gotpl ***************************
+func (ec *executionContext) fieldContext_Query_getDefencesByCouncil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_DefenceListResponse_total(ctx, field)
+			case "data":
+				return ec.fieldContext_DefenceListResponse_data(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DefenceListResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getDefencesByCouncil_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
 
-// region    **************************** object.gotpl ****************************
+func (ec *executionContext) _Query_getAllGradeDefences(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getAllGradeDefences,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetAllGradeDefences(ctx, fc.Args["search"].(model.SearchRequestInput))
+		},
+		nil,
+		ec.marshalNGradeDefenceListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefenceListResponse,
+		true,
+		true,
+	)
+}
 
-var councilDefenceListResponseImplementors = []string{"CouncilDefenceListResponse"}
+func (ec *executionContext) fieldContext_Query_getAllGradeDefences(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_GradeDefenceListResponse_total(ctx, field)
+			case "data":
+				return ec.fieldContext_GradeDefenceListResponse_data(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GradeDefenceListResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getAllGradeDefences_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
 
-func (ec *executionContext) _CouncilDefenceListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CouncilDefenceListResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, councilDefenceListResponseImplementors)
+func (ec *executionContext) _Query_getSubmissionDeadlines(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getSubmissionDeadlines,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetSubmissionDeadlines(ctx, fc.Args["semesterCode"].(string))
+		},
+		nil,
+		ec.marshalNSubmissionDeadline2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐSubmissionDeadlineᚄ,
+		true,
+		true,
+	)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("CouncilDefenceListResponse")
-		case "total":
-			out.Values[i] = ec._CouncilDefenceListResponse_total(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "data":
-			out.Values[i] = ec._CouncilDefenceListResponse_data(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) fieldContext_Query_getSubmissionDeadlines(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SubmissionDeadline_id(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_SubmissionDeadline_semesterCode(ctx, field)
+			case "stage":
+				return ec.fieldContext_SubmissionDeadline_stage(ctx, field)
+			case "kind":
+				return ec.fieldContext_SubmissionDeadline_kind(ctx, field)
+			case "opensAt":
+				return ec.fieldContext_SubmissionDeadline_opensAt(ctx, field)
+			case "dueAt":
+				return ec.fieldContext_SubmissionDeadline_dueAt(ctx, field)
+			case "graceMinutes":
+				return ec.fieldContext_SubmissionDeadline_graceMinutes(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_SubmissionDeadline_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_SubmissionDeadline_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_SubmissionDeadline_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_SubmissionDeadline_updatedBy(ctx, field)
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
+			return nil, fmt.Errorf("no field named %q was found under type SubmissionDeadline", field.Name)
+		},
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getSubmissionDeadlines_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
 	}
+	return fc, nil
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) _Query_getMidtermMilestones(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getMidtermMilestones,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetMidtermMilestones(ctx, fc.Args["semesterCode"].(string), fc.Args["stage"].(*model.TopicStage))
+		},
+		nil,
+		ec.marshalNMidtermMilestone2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐMidtermMilestoneᚄ,
+		true,
+		true,
+	)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) fieldContext_Query_getMidtermMilestones(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_MidtermMilestone_id(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_MidtermMilestone_semesterCode(ctx, field)
+			case "stage":
+				return ec.fieldContext_MidtermMilestone_stage(ctx, field)
+			case "title":
+				return ec.fieldContext_MidtermMilestone_title(ctx, field)
+			case "description":
+				return ec.fieldContext_MidtermMilestone_description(ctx, field)
+			case "sequence":
+				return ec.fieldContext_MidtermMilestone_sequence(ctx, field)
+			case "dueAt":
+				return ec.fieldContext_MidtermMilestone_dueAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_MidtermMilestone_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_MidtermMilestone_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_MidtermMilestone_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_MidtermMilestone_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_MidtermMilestone_version(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MidtermMilestone", field.Name)
+		},
 	}
-
-	return out
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getMidtermMilestones_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
 }
 
-var councilEnrollmentListResponseImplementors = []string{"CouncilEnrollmentListResponse"}
+func (ec *executionContext) _Query_getRegistrationWindow(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getRegistrationWindow,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetRegistrationWindow(ctx, fc.Args["semesterCode"].(string))
+		},
+		nil,
+		ec.marshalORegistrationWindow2ᚖthailyᚋsrcᚋgraphᚋmodelᚐRegistrationWindow,
+		true,
+		false,
+	)
+}
 
-func (ec *executionContext) _CouncilEnrollmentListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CouncilEnrollmentListResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, councilEnrollmentListResponseImplementors)
+func (ec *executionContext) fieldContext_Query_getRegistrationWindow(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RegistrationWindow_id(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_RegistrationWindow_semesterCode(ctx, field)
+			case "opensAt":
+				return ec.fieldContext_RegistrationWindow_opensAt(ctx, field)
+			case "closesAt":
+				return ec.fieldContext_RegistrationWindow_closesAt(ctx, field)
+			case "maxChoices":
+				return ec.fieldContext_RegistrationWindow_maxChoices(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RegistrationWindow_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_RegistrationWindow_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_RegistrationWindow_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_RegistrationWindow_updatedBy(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RegistrationWindow", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getRegistrationWindow_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
+func (ec *executionContext) _Query_getTopicRegistrations(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getTopicRegistrations,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetTopicRegistrations(ctx, fc.Args["semesterCode"].(string))
+		},
+		nil,
+		ec.marshalNTopicRegistration2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicRegistrationᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getTopicRegistrations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TopicRegistration_id(ctx, field)
+			case "topicCode":
+				return ec.fieldContext_TopicRegistration_topicCode(ctx, field)
+			case "studentCode":
+				return ec.fieldContext_TopicRegistration_studentCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_TopicRegistration_semesterCode(ctx, field)
+			case "rank":
+				return ec.fieldContext_TopicRegistration_rank(ctx, field)
+			case "status":
+				return ec.fieldContext_TopicRegistration_status(ctx, field)
+			case "reason":
+				return ec.fieldContext_TopicRegistration_reason(ctx, field)
+			case "decidedBy":
+				return ec.fieldContext_TopicRegistration_decidedBy(ctx, field)
+			case "decidedAt":
+				return ec.fieldContext_TopicRegistration_decidedAt(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_TopicRegistration_enrollmentCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TopicRegistration_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TopicRegistration_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TopicRegistration", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getTopicRegistrations_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_previewTopicMatching(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_previewTopicMatching,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().PreviewTopicMatching(ctx, fc.Args["semesterCode"].(string))
+		},
+		nil,
+		ec.marshalNTopicMatchingResult2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicMatchingResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_previewTopicMatching(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "semesterCode":
+				return ec.fieldContext_TopicMatchingResult_semesterCode(ctx, field)
+			case "studentsTotal":
+				return ec.fieldContext_TopicMatchingResult_studentsTotal(ctx, field)
+			case "matchedTotal":
+				return ec.fieldContext_TopicMatchingResult_matchedTotal(ctx, field)
+			case "assignments":
+				return ec.fieldContext_TopicMatchingResult_assignments(ctx, field)
+			case "unmatched":
+				return ec.fieldContext_TopicMatchingResult_unmatched(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TopicMatchingResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_previewTopicMatching_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_previewDefenceSchedule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_previewDefenceSchedule,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().PreviewDefenceSchedule(ctx, fc.Args["input"].(model.DefenceScheduleInput))
+		},
+		nil,
+		ec.marshalNDefenceSchedule2ᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceSchedule,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_previewDefenceSchedule(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "semesterCode":
+				return ec.fieldContext_DefenceSchedule_semesterCode(ctx, field)
+			case "complete":
+				return ec.fieldContext_DefenceSchedule_complete(ctx, field)
+			case "committed":
+				return ec.fieldContext_DefenceSchedule_committed(ctx, field)
+			case "sittings":
+				return ec.fieldContext_DefenceSchedule_sittings(ctx, field)
+			case "unassigned":
+				return ec.fieldContext_DefenceSchedule_unassigned(ctx, field)
+			case "unplaced":
+				return ec.fieldContext_DefenceSchedule_unplaced(ctx, field)
+			case "csv":
+				return ec.fieldContext_DefenceSchedule_csv(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DefenceSchedule", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_previewDefenceSchedule_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getRubricTemplates(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getRubricTemplates,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetRubricTemplates(ctx, fc.Args["majorCode"].(*string), fc.Args["stage"].(*model.TopicStage))
+		},
+		nil,
+		ec.marshalNRubricTemplate2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐRubricTemplateᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getRubricTemplates(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RubricTemplate_id(ctx, field)
+			case "title":
+				return ec.fieldContext_RubricTemplate_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_RubricTemplate_majorCode(ctx, field)
+			case "stage":
+				return ec.fieldContext_RubricTemplate_stage(ctx, field)
+			case "criteria":
+				return ec.fieldContext_RubricTemplate_criteria(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RubricTemplate_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_RubricTemplate_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_RubricTemplate_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_RubricTemplate_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_RubricTemplate_version(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RubricTemplate", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getRubricTemplates_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getGradingPolicies(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getGradingPolicies,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetGradingPolicies(ctx, fc.Args["semesterCode"].(string))
+		},
+		nil,
+		ec.marshalNGradingPolicy2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐGradingPolicyᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getGradingPolicies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_GradingPolicy_id(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_GradingPolicy_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_GradingPolicy_semesterCode(ctx, field)
+			case "stage":
+				return ec.fieldContext_GradingPolicy_stage(ctx, field)
+			case "supervisorWeight":
+				return ec.fieldContext_GradingPolicy_supervisorWeight(ctx, field)
+			case "reviewerWeight":
+				return ec.fieldContext_GradingPolicy_reviewerWeight(ctx, field)
+			case "councilWeight":
+				return ec.fieldContext_GradingPolicy_councilWeight(ctx, field)
+			case "rounding":
+				return ec.fieldContext_GradingPolicy_rounding(ctx, field)
+			case "roundingStep":
+				return ec.fieldContext_GradingPolicy_roundingStep(ctx, field)
+			case "passThreshold":
+				return ec.fieldContext_GradingPolicy_passThreshold(ctx, field)
+			case "maxCouncilSpread":
+				return ec.fieldContext_GradingPolicy_maxCouncilSpread(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_GradingPolicy_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_GradingPolicy_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_GradingPolicy_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_GradingPolicy_updatedBy(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GradingPolicy", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getGradingPolicies_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_previewFinalGrade(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_previewFinalGrade,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().PreviewFinalGrade(ctx, fc.Args["enrollmentId"].(string))
+		},
+		nil,
+		ec.marshalNFinalGradeBreakdown2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFinalGradeBreakdown,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_previewFinalGrade(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "enrollmentCode":
+				return ec.fieldContext_FinalGradeBreakdown_enrollmentCode(ctx, field)
+			case "policyCode":
+				return ec.fieldContext_FinalGradeBreakdown_policyCode(ctx, field)
+			case "components":
+				return ec.fieldContext_FinalGradeBreakdown_components(ctx, field)
+			case "councilSpread":
+				return ec.fieldContext_FinalGradeBreakdown_councilSpread(ctx, field)
+			case "needsReconciliation":
+				return ec.fieldContext_FinalGradeBreakdown_needsReconciliation(ctx, field)
+			case "missing":
+				return ec.fieldContext_FinalGradeBreakdown_missing(ctx, field)
+			case "rawGrade":
+				return ec.fieldContext_FinalGradeBreakdown_rawGrade(ctx, field)
+			case "finalGrade":
+				return ec.fieldContext_FinalGradeBreakdown_finalGrade(ctx, field)
+			case "status":
+				return ec.fieldContext_FinalGradeBreakdown_status(ctx, field)
+			case "steps":
+				return ec.fieldContext_FinalGradeBreakdown_steps(ctx, field)
+			case "finalized":
+				return ec.fieldContext_FinalGradeBreakdown_finalized(ctx, field)
+			case "final":
+				return ec.fieldContext_FinalGradeBreakdown_final(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FinalGradeBreakdown", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_previewFinalGrade_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getSemesterGradeStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getSemesterGradeStatus,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetSemesterGradeStatus(ctx, fc.Args["semesterCode"].(string))
+		},
+		nil,
+		ec.marshalNSemesterGradeStatus2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSemesterGradeStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getSemesterGradeStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "semesterCode":
+				return ec.fieldContext_SemesterGradeStatus_semesterCode(ctx, field)
+			case "locked":
+				return ec.fieldContext_SemesterGradeStatus_locked(ctx, field)
+			case "lockedAt":
+				return ec.fieldContext_SemesterGradeStatus_lockedAt(ctx, field)
+			case "lockedBy":
+				return ec.fieldContext_SemesterGradeStatus_lockedBy(ctx, field)
+			case "published":
+				return ec.fieldContext_SemesterGradeStatus_published(ctx, field)
+			case "publishedAt":
+				return ec.fieldContext_SemesterGradeStatus_publishedAt(ctx, field)
+			case "publishedBy":
+				return ec.fieldContext_SemesterGradeStatus_publishedBy(ctx, field)
+			case "appealWindowDays":
+				return ec.fieldContext_SemesterGradeStatus_appealWindowDays(ctx, field)
+			case "appealDeadline":
+				return ec.fieldContext_SemesterGradeStatus_appealDeadline(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SemesterGradeStatus", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getSemesterGradeStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getGradeAmendments(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getGradeAmendments,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetGradeAmendments(ctx, fc.Args["semesterCode"].(string), fc.Args["status"].(*model.GradeAmendmentStatus))
+		},
+		nil,
+		ec.marshalNGradeAmendment2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeAmendmentᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getGradeAmendments(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_GradeAmendment_id(ctx, field)
+			case "target":
+				return ec.fieldContext_GradeAmendment_target(ctx, field)
+			case "targetId":
+				return ec.fieldContext_GradeAmendment_targetId(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_GradeAmendment_enrollmentCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_GradeAmendment_semesterCode(ctx, field)
+			case "councilCode":
+				return ec.fieldContext_GradeAmendment_councilCode(ctx, field)
+			case "oldValue":
+				return ec.fieldContext_GradeAmendment_oldValue(ctx, field)
+			case "newValue":
+				return ec.fieldContext_GradeAmendment_newValue(ctx, field)
+			case "reason":
+				return ec.fieldContext_GradeAmendment_reason(ctx, field)
+			case "status":
+				return ec.fieldContext_GradeAmendment_status(ctx, field)
+			case "requestedBy":
+				return ec.fieldContext_GradeAmendment_requestedBy(ctx, field)
+			case "decidedBy":
+				return ec.fieldContext_GradeAmendment_decidedBy(ctx, field)
+			case "decidedAt":
+				return ec.fieldContext_GradeAmendment_decidedAt(ctx, field)
+			case "decisionNote":
+				return ec.fieldContext_GradeAmendment_decisionNote(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_GradeAmendment_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_GradeAmendment_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GradeAmendment", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getGradeAmendments_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getGradeAppeals(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getGradeAppeals,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetGradeAppeals(ctx, fc.Args["semesterCode"].(string), fc.Args["status"].(*model.GradeAppealStatus))
+		},
+		nil,
+		ec.marshalNGradeAppeal2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeAppealᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getGradeAppeals(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_GradeAppeal_id(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_GradeAppeal_enrollmentCode(ctx, field)
+			case "studentCode":
+				return ec.fieldContext_GradeAppeal_studentCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_GradeAppeal_semesterCode(ctx, field)
+			case "component":
+				return ec.fieldContext_GradeAppeal_component(ctx, field)
+			case "reason":
+				return ec.fieldContext_GradeAppeal_reason(ctx, field)
+			case "evidenceFileCode":
+				return ec.fieldContext_GradeAppeal_evidenceFileCode(ctx, field)
+			case "status":
+				return ec.fieldContext_GradeAppeal_status(ctx, field)
+			case "reviewerCode":
+				return ec.fieldContext_GradeAppeal_reviewerCode(ctx, field)
+			case "resolutionNote":
+				return ec.fieldContext_GradeAppeal_resolutionNote(ctx, field)
+			case "amendmentCode":
+				return ec.fieldContext_GradeAppeal_amendmentCode(ctx, field)
+			case "resolvedBy":
+				return ec.fieldContext_GradeAppeal_resolvedBy(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_GradeAppeal_resolvedAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_GradeAppeal_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_GradeAppeal_updatedAt(ctx, field)
+			case "history":
+				return ec.fieldContext_GradeAppeal_history(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GradeAppeal", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getGradeAppeals_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_auditLog(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_auditLog,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().AuditLog(ctx, fc.Args["entity"].(*string), fc.Args["entityId"].(*string), fc.Args["actor"].(*string), fc.Args["from"].(*time.Time), fc.Args["to"].(*time.Time))
+		},
+		nil,
+		ec.marshalNAuditLogEntry2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐAuditLogEntryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_auditLog(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AuditLogEntry_id(ctx, field)
+			case "at":
+				return ec.fieldContext_AuditLogEntry_at(ctx, field)
+			case "service":
+				return ec.fieldContext_AuditLogEntry_service(ctx, field)
+			case "method":
+				return ec.fieldContext_AuditLogEntry_method(ctx, field)
+			case "entity":
+				return ec.fieldContext_AuditLogEntry_entity(ctx, field)
+			case "entityId":
+				return ec.fieldContext_AuditLogEntry_entityId(ctx, field)
+			case "actor":
+				return ec.fieldContext_AuditLogEntry_actor(ctx, field)
+			case "role":
+				return ec.fieldContext_AuditLogEntry_role(ctx, field)
+			case "semester":
+				return ec.fieldContext_AuditLogEntry_semester(ctx, field)
+			case "requestId":
+				return ec.fieldContext_AuditLogEntry_requestId(ctx, field)
+			case "clientIp":
+				return ec.fieldContext_AuditLogEntry_clientIp(ctx, field)
+			case "changes":
+				return ec.fieldContext_AuditLogEntry_changes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuditLogEntry", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_auditLog_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getDepartmentTeachers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getDepartmentTeachers,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetDepartmentTeachers(ctx, fc.Args["search"].(model.SearchRequestInput))
+		},
+		nil,
+		ec.marshalNTeacher2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacherᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getDepartmentTeachers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Teacher_id(ctx, field)
+			case "email":
+				return ec.fieldContext_Teacher_email(ctx, field)
+			case "username":
+				return ec.fieldContext_Teacher_username(ctx, field)
+			case "gender":
+				return ec.fieldContext_Teacher_gender(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Teacher_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Teacher_semesterCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Teacher_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Teacher_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Teacher_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Teacher_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Teacher_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Teacher_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Teacher_deletedBy(ctx, field)
+			case "roles":
+				return ec.fieldContext_Teacher_roles(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Teacher", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getDepartmentTeachers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getDepartmentStudents(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getDepartmentStudents,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetDepartmentStudents(ctx, fc.Args["search"].(model.SearchRequestInput))
+		},
+		nil,
+		ec.marshalNStudent2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getDepartmentStudents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Student_id(ctx, field)
+			case "email":
+				return ec.fieldContext_Student_email(ctx, field)
+			case "phone":
+				return ec.fieldContext_Student_phone(ctx, field)
+			case "username":
+				return ec.fieldContext_Student_username(ctx, field)
+			case "gender":
+				return ec.fieldContext_Student_gender(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Student_majorCode(ctx, field)
+			case "classCode":
+				return ec.fieldContext_Student_classCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Student_semesterCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Student_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Student_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Student_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Student_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Student_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Student_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Student_deletedBy(ctx, field)
+			case "enrollments":
+				return ec.fieldContext_Student_enrollments(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Student", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getDepartmentStudents_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getDepartmentSemesters(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getDepartmentSemesters,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetDepartmentSemesters(ctx, fc.Args["search"].(model.SearchRequestInput))
+		},
+		nil,
+		ec.marshalNSemester2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐSemesterᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getDepartmentSemesters(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Semester_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Semester_title(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Semester_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Semester_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Semester_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Semester_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Semester_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Semester_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Semester_deletedBy(ctx, field)
+			case "students":
+				return ec.fieldContext_Semester_students(ctx, field)
+			case "teachers":
+				return ec.fieldContext_Semester_teachers(ctx, field)
+			case "topics":
+				return ec.fieldContext_Semester_topics(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Semester", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getDepartmentSemesters_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getDepartmentMajors(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getDepartmentMajors,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetDepartmentMajors(ctx, fc.Args["search"].(model.SearchRequestInput))
+		},
+		nil,
+		ec.marshalNMajor2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐMajorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getDepartmentMajors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Major_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Major_title(ctx, field)
+			case "facultyCode":
+				return ec.fieldContext_Major_facultyCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Major_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Major_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Major_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Major_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Major_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Major_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Major_deletedBy(ctx, field)
+			case "topics":
+				return ec.fieldContext_Major_topics(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Major", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getDepartmentMajors_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getDepartmentFaculties(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getDepartmentFaculties,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetDepartmentFaculties(ctx, fc.Args["search"].(model.SearchRequestInput))
+		},
+		nil,
+		ec.marshalNFaculty2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐFacultyᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getDepartmentFaculties(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Faculty_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Faculty_title(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Faculty_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Faculty_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Faculty_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Faculty_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Faculty_version(ctx, field)
+			case "majors":
+				return ec.fieldContext_Faculty_majors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Faculty", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getDepartmentFaculties_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getDepartmentTopics(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getDepartmentTopics,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetDepartmentTopics(ctx, fc.Args["search"].(model.SearchRequestInput))
+		},
+		nil,
+		ec.marshalNTopic2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getDepartmentTopics(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_Topic_total(ctx, field)
+			case "id":
+				return ec.fieldContext_Topic_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Topic_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Topic_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Topic_semesterCode(ctx, field)
+			case "status":
+				return ec.fieldContext_Topic_status(ctx, field)
+			case "percentStage1":
+				return ec.fieldContext_Topic_percentStage1(ctx, field)
+			case "percentStage2":
+				return ec.fieldContext_Topic_percentStage2(ctx, field)
+			case "maxStudents":
+				return ec.fieldContext_Topic_maxStudents(ctx, field)
+			case "requiredSkills":
+				return ec.fieldContext_Topic_requiredSkills(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Topic_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Topic_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Topic_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Topic_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Topic_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Topic_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Topic_deletedBy(ctx, field)
+			case "files":
+				return ec.fieldContext_Topic_files(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Topic_statusHistory(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getDepartmentTopics_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getDepartmentTopicDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getDepartmentTopicDetail,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetDepartmentTopicDetail(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOTopic2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopic,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getDepartmentTopicDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_Topic_total(ctx, field)
+			case "id":
+				return ec.fieldContext_Topic_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Topic_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Topic_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Topic_semesterCode(ctx, field)
+			case "status":
+				return ec.fieldContext_Topic_status(ctx, field)
+			case "percentStage1":
+				return ec.fieldContext_Topic_percentStage1(ctx, field)
+			case "percentStage2":
+				return ec.fieldContext_Topic_percentStage2(ctx, field)
+			case "maxStudents":
+				return ec.fieldContext_Topic_maxStudents(ctx, field)
+			case "requiredSkills":
+				return ec.fieldContext_Topic_requiredSkills(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Topic_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Topic_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Topic_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Topic_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Topic_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Topic_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Topic_deletedBy(ctx, field)
+			case "files":
+				return ec.fieldContext_Topic_files(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Topic_statusHistory(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getDepartmentTopicDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getDepartmentEnrollments(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getDepartmentEnrollments,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetDepartmentEnrollments(ctx, fc.Args["search"].(model.SearchRequestInput))
+		},
+		nil,
+		ec.marshalNEnrollment2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐEnrollmentᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getDepartmentEnrollments(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Enrollment_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Enrollment_title(ctx, field)
+			case "studentCode":
+				return ec.fieldContext_Enrollment_studentCode(ctx, field)
+			case "topicCouncilCode":
+				return ec.fieldContext_Enrollment_topicCouncilCode(ctx, field)
+			case "finalCode":
+				return ec.fieldContext_Enrollment_finalCode(ctx, field)
+			case "gradeReviewCode":
+				return ec.fieldContext_Enrollment_gradeReviewCode(ctx, field)
+			case "midtermCode":
+				return ec.fieldContext_Enrollment_midtermCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Enrollment_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Enrollment_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Enrollment_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Enrollment_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Enrollment_version(ctx, field)
+			case "student":
+				return ec.fieldContext_Enrollment_student(ctx, field)
+			case "midterm":
+				return ec.fieldContext_Enrollment_midterm(ctx, field)
+			case "final":
+				return ec.fieldContext_Enrollment_final(ctx, field)
+			case "topicCouncil":
+				return ec.fieldContext_Enrollment_topicCouncil(ctx, field)
+			case "gradeReview":
+				return ec.fieldContext_Enrollment_gradeReview(ctx, field)
+			case "gradeDefences":
+				return ec.fieldContext_Enrollment_gradeDefences(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Enrollment", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getDepartmentEnrollments_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getDepartmentEnrollmentDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getDepartmentEnrollmentDetail,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetDepartmentEnrollmentDetail(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOEnrollment2ᚖthailyᚋsrcᚋgraphᚋmodelᚐEnrollment,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getDepartmentEnrollmentDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Enrollment_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Enrollment_title(ctx, field)
+			case "studentCode":
+				return ec.fieldContext_Enrollment_studentCode(ctx, field)
+			case "topicCouncilCode":
+				return ec.fieldContext_Enrollment_topicCouncilCode(ctx, field)
+			case "finalCode":
+				return ec.fieldContext_Enrollment_finalCode(ctx, field)
+			case "gradeReviewCode":
+				return ec.fieldContext_Enrollment_gradeReviewCode(ctx, field)
+			case "midtermCode":
+				return ec.fieldContext_Enrollment_midtermCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Enrollment_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Enrollment_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Enrollment_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Enrollment_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Enrollment_version(ctx, field)
+			case "student":
+				return ec.fieldContext_Enrollment_student(ctx, field)
+			case "midterm":
+				return ec.fieldContext_Enrollment_midterm(ctx, field)
+			case "final":
+				return ec.fieldContext_Enrollment_final(ctx, field)
+			case "topicCouncil":
+				return ec.fieldContext_Enrollment_topicCouncil(ctx, field)
+			case "gradeReview":
+				return ec.fieldContext_Enrollment_gradeReview(ctx, field)
+			case "gradeDefences":
+				return ec.fieldContext_Enrollment_gradeDefences(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Enrollment", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getDepartmentEnrollmentDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getDepartmentCouncils(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getDepartmentCouncils,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetDepartmentCouncils(ctx, fc.Args["search"].(model.SearchRequestInput))
+		},
+		nil,
+		ec.marshalNCouncil2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getDepartmentCouncils(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_Council_total(ctx, field)
+			case "id":
+				return ec.fieldContext_Council_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Council_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Council_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Council_semesterCode(ctx, field)
+			case "timeStart":
+				return ec.fieldContext_Council_timeStart(ctx, field)
+			case "room":
+				return ec.fieldContext_Council_room(ctx, field)
+			case "gradesLocked":
+				return ec.fieldContext_Council_gradesLocked(ctx, field)
+			case "gradesLockedAt":
+				return ec.fieldContext_Council_gradesLockedAt(ctx, field)
+			case "gradesPublished":
+				return ec.fieldContext_Council_gradesPublished(ctx, field)
+			case "gradesPublishedAt":
+				return ec.fieldContext_Council_gradesPublishedAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Council_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Council_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Council_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Council_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Council_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Council_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Council_deletedBy(ctx, field)
+			case "defences":
+				return ec.fieldContext_Council_defences(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Council_topicCouncils(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Council", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getDepartmentCouncils_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getDepartmentCouncilDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getDepartmentCouncilDetail,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetDepartmentCouncilDetail(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncil,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getDepartmentCouncilDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_Council_total(ctx, field)
+			case "id":
+				return ec.fieldContext_Council_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Council_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Council_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Council_semesterCode(ctx, field)
+			case "timeStart":
+				return ec.fieldContext_Council_timeStart(ctx, field)
+			case "room":
+				return ec.fieldContext_Council_room(ctx, field)
+			case "gradesLocked":
+				return ec.fieldContext_Council_gradesLocked(ctx, field)
+			case "gradesLockedAt":
+				return ec.fieldContext_Council_gradesLockedAt(ctx, field)
+			case "gradesPublished":
+				return ec.fieldContext_Council_gradesPublished(ctx, field)
+			case "gradesPublishedAt":
+				return ec.fieldContext_Council_gradesPublishedAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Council_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Council_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Council_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Council_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Council_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Council_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Council_deletedBy(ctx, field)
+			case "defences":
+				return ec.fieldContext_Council_defences(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Council_topicCouncils(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Council", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getDepartmentCouncilDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getDepartmentDefences(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getDepartmentDefences,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetDepartmentDefences(ctx, fc.Args["councilId"].(string))
+		},
+		nil,
+		ec.marshalNDefence2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐDefenceᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getDepartmentDefences(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Defence_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Defence_title(ctx, field)
+			case "councilCode":
+				return ec.fieldContext_Defence_councilCode(ctx, field)
+			case "teacherCode":
+				return ec.fieldContext_Defence_teacherCode(ctx, field)
+			case "position":
+				return ec.fieldContext_Defence_position(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Defence_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Defence_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Defence_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Defence_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Defence_version(ctx, field)
+			case "council":
+				return ec.fieldContext_Defence_council(ctx, field)
+			case "teacher":
+				return ec.fieldContext_Defence_teacher(ctx, field)
+			case "gradeDefences":
+				return ec.fieldContext_Defence_gradeDefences(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Defence", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getDepartmentDefences_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getDepartmentGradeDefences(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getDepartmentGradeDefences,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetDepartmentGradeDefences(ctx, fc.Args["search"].(model.SearchRequestInput))
+		},
+		nil,
+		ec.marshalNGradeDefence2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeDefenceᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getDepartmentGradeDefences(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_GradeDefence_id(ctx, field)
+			case "defenceCode":
+				return ec.fieldContext_GradeDefence_defenceCode(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_GradeDefence_enrollmentCode(ctx, field)
+			case "note":
+				return ec.fieldContext_GradeDefence_note(ctx, field)
+			case "totalScore":
+				return ec.fieldContext_GradeDefence_totalScore(ctx, field)
+			case "rubricTemplateCode":
+				return ec.fieldContext_GradeDefence_rubricTemplateCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_GradeDefence_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_GradeDefence_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_GradeDefence_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_GradeDefence_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_GradeDefence_version(ctx, field)
+			case "defence":
+				return ec.fieldContext_GradeDefence_defence(ctx, field)
+			case "enrollment":
+				return ec.fieldContext_GradeDefence_enrollment(ctx, field)
+			case "criteria":
+				return ec.fieldContext_GradeDefence_criteria(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GradeDefence", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getDepartmentGradeDefences_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_validateCouncil(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_validateCouncil,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().ValidateCouncil(ctx, fc.Args["councilId"].(string))
+		},
+		nil,
+		ec.marshalNCouncilValidation2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilValidation,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_validateCouncil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "councilCode":
+				return ec.fieldContext_CouncilValidation_councilCode(ctx, field)
+			case "valid":
+				return ec.fieldContext_CouncilValidation_valid(ctx, field)
+			case "conflicts":
+				return ec.fieldContext_CouncilValidation_conflicts(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CouncilValidation", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_validateCouncil_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getCouncilConflictOverrides(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getCouncilConflictOverrides,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetCouncilConflictOverrides(ctx, fc.Args["councilId"].(string))
+		},
+		nil,
+		ec.marshalNCouncilConflictOverride2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilConflictOverrideᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getCouncilConflictOverrides(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_CouncilConflictOverride_id(ctx, field)
+			case "councilCode":
+				return ec.fieldContext_CouncilConflictOverride_councilCode(ctx, field)
+			case "kind":
+				return ec.fieldContext_CouncilConflictOverride_kind(ctx, field)
+			case "teacherCode":
+				return ec.fieldContext_CouncilConflictOverride_teacherCode(ctx, field)
+			case "topicCouncilCode":
+				return ec.fieldContext_CouncilConflictOverride_topicCouncilCode(ctx, field)
+			case "message":
+				return ec.fieldContext_CouncilConflictOverride_message(ctx, field)
+			case "reason":
+				return ec.fieldContext_CouncilConflictOverride_reason(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_CouncilConflictOverride_createdAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_CouncilConflictOverride_createdBy(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CouncilConflictOverride", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getCouncilConflictOverrides_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMyProfile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getMyProfile,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().GetMyProfile(ctx)
+		},
+		nil,
+		ec.marshalNStudent2ᚖthailyᚋsrcᚋgraphᚋmodelᚐStudent,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getMyProfile(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Student_id(ctx, field)
+			case "email":
+				return ec.fieldContext_Student_email(ctx, field)
+			case "phone":
+				return ec.fieldContext_Student_phone(ctx, field)
+			case "username":
+				return ec.fieldContext_Student_username(ctx, field)
+			case "gender":
+				return ec.fieldContext_Student_gender(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Student_majorCode(ctx, field)
+			case "classCode":
+				return ec.fieldContext_Student_classCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Student_semesterCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Student_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Student_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Student_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Student_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Student_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Student_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Student_deletedBy(ctx, field)
+			case "enrollments":
+				return ec.fieldContext_Student_enrollments(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Student", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMyEnrollments(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getMyEnrollments,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetMyEnrollments(ctx, fc.Args["search"].(*model.SearchRequestInput))
+		},
+		nil,
+		ec.marshalNStudentEnrollmentListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentEnrollmentListResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getMyEnrollments(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_StudentEnrollmentListResponse_total(ctx, field)
+			case "data":
+				return ec.fieldContext_StudentEnrollmentListResponse_data(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StudentEnrollmentListResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getMyEnrollments_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMyEnrollmentDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getMyEnrollmentDetail,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetMyEnrollmentDetail(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOStudentEnrollment2ᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentEnrollment,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getMyEnrollmentDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_StudentEnrollment_id(ctx, field)
+			case "title":
+				return ec.fieldContext_StudentEnrollment_title(ctx, field)
+			case "studentCode":
+				return ec.fieldContext_StudentEnrollment_studentCode(ctx, field)
+			case "topicCouncilCode":
+				return ec.fieldContext_StudentEnrollment_topicCouncilCode(ctx, field)
+			case "finalCode":
+				return ec.fieldContext_StudentEnrollment_finalCode(ctx, field)
+			case "gradeReviewCode":
+				return ec.fieldContext_StudentEnrollment_gradeReviewCode(ctx, field)
+			case "midtermCode":
+				return ec.fieldContext_StudentEnrollment_midtermCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_StudentEnrollment_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_StudentEnrollment_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_StudentEnrollment_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_StudentEnrollment_updatedBy(ctx, field)
+			case "topicCouncil":
+				return ec.fieldContext_StudentEnrollment_topicCouncil(ctx, field)
+			case "midterm":
+				return ec.fieldContext_StudentEnrollment_midterm(ctx, field)
+			case "final":
+				return ec.fieldContext_StudentEnrollment_final(ctx, field)
+			case "gradeReview":
+				return ec.fieldContext_StudentEnrollment_gradeReview(ctx, field)
+			case "gradeDefences":
+				return ec.fieldContext_StudentEnrollment_gradeDefences(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StudentEnrollment", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getMyEnrollmentDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMySemesters(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getMySemesters,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetMySemesters(ctx, fc.Args["search"].(*model.SearchRequestInput))
+		},
+		nil,
+		ec.marshalNSemesterListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSemesterListResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getMySemesters(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_SemesterListResponse_total(ctx, field)
+			case "data":
+				return ec.fieldContext_SemesterListResponse_data(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SemesterListResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getMySemesters_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMySubmissionWindow(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getMySubmissionWindow,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetMySubmissionWindow(ctx, fc.Args["kind"].(model.SubmissionKind))
+		},
+		nil,
+		ec.marshalNSubmissionWindow2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSubmissionWindow,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getMySubmissionWindow(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "allowed":
+				return ec.fieldContext_SubmissionWindow_allowed(ctx, field)
+			case "late":
+				return ec.fieldContext_SubmissionWindow_late(ctx, field)
+			case "extended":
+				return ec.fieldContext_SubmissionWindow_extended(ctx, field)
+			case "stage":
+				return ec.fieldContext_SubmissionWindow_stage(ctx, field)
+			case "opensAt":
+				return ec.fieldContext_SubmissionWindow_opensAt(ctx, field)
+			case "dueAt":
+				return ec.fieldContext_SubmissionWindow_dueAt(ctx, field)
+			case "closesAt":
+				return ec.fieldContext_SubmissionWindow_closesAt(ctx, field)
+			case "reason":
+				return ec.fieldContext_SubmissionWindow_reason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SubmissionWindow", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getMySubmissionWindow_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMyRegistrationWindow(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getMyRegistrationWindow,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().GetMyRegistrationWindow(ctx)
+		},
+		nil,
+		ec.marshalORegistrationWindow2ᚖthailyᚋsrcᚋgraphᚋmodelᚐRegistrationWindow,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getMyRegistrationWindow(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RegistrationWindow_id(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_RegistrationWindow_semesterCode(ctx, field)
+			case "opensAt":
+				return ec.fieldContext_RegistrationWindow_opensAt(ctx, field)
+			case "closesAt":
+				return ec.fieldContext_RegistrationWindow_closesAt(ctx, field)
+			case "maxChoices":
+				return ec.fieldContext_RegistrationWindow_maxChoices(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RegistrationWindow_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_RegistrationWindow_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_RegistrationWindow_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_RegistrationWindow_updatedBy(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RegistrationWindow", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getRegistrableTopics(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getRegistrableTopics,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().GetRegistrableTopics(ctx)
+		},
+		nil,
+		ec.marshalNStudentTopic2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentTopicᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getRegistrableTopics(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_StudentTopic_id(ctx, field)
+			case "title":
+				return ec.fieldContext_StudentTopic_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_StudentTopic_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_StudentTopic_semesterCode(ctx, field)
+			case "status":
+				return ec.fieldContext_StudentTopic_status(ctx, field)
+			case "percentStage1":
+				return ec.fieldContext_StudentTopic_percentStage1(ctx, field)
+			case "percentStage2":
+				return ec.fieldContext_StudentTopic_percentStage2(ctx, field)
+			case "maxStudents":
+				return ec.fieldContext_StudentTopic_maxStudents(ctx, field)
+			case "requiredSkills":
+				return ec.fieldContext_StudentTopic_requiredSkills(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_StudentTopic_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_StudentTopic_updatedAt(ctx, field)
+			case "major":
+				return ec.fieldContext_StudentTopic_major(ctx, field)
+			case "semester":
+				return ec.fieldContext_StudentTopic_semester(ctx, field)
+			case "files":
+				return ec.fieldContext_StudentTopic_files(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StudentTopic", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMyTopicRegistrations(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getMyTopicRegistrations,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().GetMyTopicRegistrations(ctx)
+		},
+		nil,
+		ec.marshalNTopicRegistration2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicRegistrationᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getMyTopicRegistrations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TopicRegistration_id(ctx, field)
+			case "topicCode":
+				return ec.fieldContext_TopicRegistration_topicCode(ctx, field)
+			case "studentCode":
+				return ec.fieldContext_TopicRegistration_studentCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_TopicRegistration_semesterCode(ctx, field)
+			case "rank":
+				return ec.fieldContext_TopicRegistration_rank(ctx, field)
+			case "status":
+				return ec.fieldContext_TopicRegistration_status(ctx, field)
+			case "reason":
+				return ec.fieldContext_TopicRegistration_reason(ctx, field)
+			case "decidedBy":
+				return ec.fieldContext_TopicRegistration_decidedBy(ctx, field)
+			case "decidedAt":
+				return ec.fieldContext_TopicRegistration_decidedAt(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_TopicRegistration_enrollmentCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TopicRegistration_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TopicRegistration_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TopicRegistration", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMyMidtermMilestones(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getMyMidtermMilestones,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetMyMidtermMilestones(ctx, fc.Args["enrollmentId"].(string))
+		},
+		nil,
+		ec.marshalNMidtermMilestone2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐMidtermMilestoneᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getMyMidtermMilestones(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_MidtermMilestone_id(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_MidtermMilestone_semesterCode(ctx, field)
+			case "stage":
+				return ec.fieldContext_MidtermMilestone_stage(ctx, field)
+			case "title":
+				return ec.fieldContext_MidtermMilestone_title(ctx, field)
+			case "description":
+				return ec.fieldContext_MidtermMilestone_description(ctx, field)
+			case "sequence":
+				return ec.fieldContext_MidtermMilestone_sequence(ctx, field)
+			case "dueAt":
+				return ec.fieldContext_MidtermMilestone_dueAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_MidtermMilestone_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_MidtermMilestone_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_MidtermMilestone_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_MidtermMilestone_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_MidtermMilestone_version(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MidtermMilestone", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getMyMidtermMilestones_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMyMilestoneCheckins(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getMyMilestoneCheckins,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetMyMilestoneCheckins(ctx, fc.Args["enrollmentId"].(string))
+		},
+		nil,
+		ec.marshalNMilestoneCheckin2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐMilestoneCheckinᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getMyMilestoneCheckins(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_MilestoneCheckin_id(ctx, field)
+			case "milestoneCode":
+				return ec.fieldContext_MilestoneCheckin_milestoneCode(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_MilestoneCheckin_enrollmentCode(ctx, field)
+			case "report":
+				return ec.fieldContext_MilestoneCheckin_report(ctx, field)
+			case "fileCode":
+				return ec.fieldContext_MilestoneCheckin_fileCode(ctx, field)
+			case "result":
+				return ec.fieldContext_MilestoneCheckin_result(ctx, field)
+			case "comment":
+				return ec.fieldContext_MilestoneCheckin_comment(ctx, field)
+			case "reviewedBy":
+				return ec.fieldContext_MilestoneCheckin_reviewedBy(ctx, field)
+			case "reviewedAt":
+				return ec.fieldContext_MilestoneCheckin_reviewedAt(ctx, field)
+			case "submittedAt":
+				return ec.fieldContext_MilestoneCheckin_submittedAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_MilestoneCheckin_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MilestoneCheckin", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getMyMilestoneCheckins_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMyGradeAppeals(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getMyGradeAppeals,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().GetMyGradeAppeals(ctx)
+		},
+		nil,
+		ec.marshalNGradeAppeal2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeAppealᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getMyGradeAppeals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_GradeAppeal_id(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_GradeAppeal_enrollmentCode(ctx, field)
+			case "studentCode":
+				return ec.fieldContext_GradeAppeal_studentCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_GradeAppeal_semesterCode(ctx, field)
+			case "component":
+				return ec.fieldContext_GradeAppeal_component(ctx, field)
+			case "reason":
+				return ec.fieldContext_GradeAppeal_reason(ctx, field)
+			case "evidenceFileCode":
+				return ec.fieldContext_GradeAppeal_evidenceFileCode(ctx, field)
+			case "status":
+				return ec.fieldContext_GradeAppeal_status(ctx, field)
+			case "reviewerCode":
+				return ec.fieldContext_GradeAppeal_reviewerCode(ctx, field)
+			case "resolutionNote":
+				return ec.fieldContext_GradeAppeal_resolutionNote(ctx, field)
+			case "amendmentCode":
+				return ec.fieldContext_GradeAppeal_amendmentCode(ctx, field)
+			case "resolvedBy":
+				return ec.fieldContext_GradeAppeal_resolvedBy(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_GradeAppeal_resolvedAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_GradeAppeal_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_GradeAppeal_updatedAt(ctx, field)
+			case "history":
+				return ec.fieldContext_GradeAppeal_history(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GradeAppeal", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMyTeacherProfile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getMyTeacherProfile,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().GetMyTeacherProfile(ctx)
+		},
+		nil,
+		ec.marshalNTeacher2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacher,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getMyTeacherProfile(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Teacher_id(ctx, field)
+			case "email":
+				return ec.fieldContext_Teacher_email(ctx, field)
+			case "username":
+				return ec.fieldContext_Teacher_username(ctx, field)
+			case "gender":
+				return ec.fieldContext_Teacher_gender(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Teacher_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Teacher_semesterCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Teacher_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Teacher_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Teacher_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Teacher_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Teacher_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Teacher_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Teacher_deletedBy(ctx, field)
+			case "roles":
+				return ec.fieldContext_Teacher_roles(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Teacher", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMySupervisedTopicCouncils(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getMySupervisedTopicCouncils,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetMySupervisedTopicCouncils(ctx, fc.Args["search"].(*model.SearchRequestInput))
+		},
+		nil,
+		ec.marshalNSupervisorTopicCouncilAssignmentListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSupervisorTopicCouncilAssignmentListResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getMySupervisedTopicCouncils(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_SupervisorTopicCouncilAssignmentListResponse_total(ctx, field)
+			case "data":
+				return ec.fieldContext_SupervisorTopicCouncilAssignmentListResponse_data(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SupervisorTopicCouncilAssignmentListResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getMySupervisedTopicCouncils_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMySupervisedTopicCouncilDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getMySupervisedTopicCouncilDetail,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetMySupervisedTopicCouncilDetail(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOSupervisorTopicCouncilAssignment2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSupervisorTopicCouncilAssignment,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getMySupervisedTopicCouncilDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SupervisorTopicCouncilAssignment_id(ctx, field)
+			case "teacherSupervisorCode":
+				return ec.fieldContext_SupervisorTopicCouncilAssignment_teacherSupervisorCode(ctx, field)
+			case "topicCouncilCode":
+				return ec.fieldContext_SupervisorTopicCouncilAssignment_topicCouncilCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_SupervisorTopicCouncilAssignment_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_SupervisorTopicCouncilAssignment_updatedAt(ctx, field)
+			case "topicCouncil":
+				return ec.fieldContext_SupervisorTopicCouncilAssignment_topicCouncil(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SupervisorTopicCouncilAssignment", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getMySupervisedTopicCouncilDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMyTopicApplicants(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getMyTopicApplicants,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetMyTopicApplicants(ctx, fc.Args["topicId"].(string))
+		},
+		nil,
+		ec.marshalNTopicRegistration2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicRegistrationᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getMyTopicApplicants(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TopicRegistration_id(ctx, field)
+			case "topicCode":
+				return ec.fieldContext_TopicRegistration_topicCode(ctx, field)
+			case "studentCode":
+				return ec.fieldContext_TopicRegistration_studentCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_TopicRegistration_semesterCode(ctx, field)
+			case "rank":
+				return ec.fieldContext_TopicRegistration_rank(ctx, field)
+			case "status":
+				return ec.fieldContext_TopicRegistration_status(ctx, field)
+			case "reason":
+				return ec.fieldContext_TopicRegistration_reason(ctx, field)
+			case "decidedBy":
+				return ec.fieldContext_TopicRegistration_decidedBy(ctx, field)
+			case "decidedAt":
+				return ec.fieldContext_TopicRegistration_decidedAt(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_TopicRegistration_enrollmentCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TopicRegistration_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TopicRegistration_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TopicRegistration", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getMyTopicApplicants_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getTopicCoSigns(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getTopicCoSigns,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetTopicCoSigns(ctx, fc.Args["topicId"].(string))
+		},
+		nil,
+		ec.marshalNTopicCoSign2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicCoSignᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getTopicCoSigns(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TopicCoSign_id(ctx, field)
+			case "topicCode":
+				return ec.fieldContext_TopicCoSign_topicCode(ctx, field)
+			case "teacherCode":
+				return ec.fieldContext_TopicCoSign_teacherCode(ctx, field)
+			case "status":
+				return ec.fieldContext_TopicCoSign_status(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TopicCoSign_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TopicCoSign_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TopicCoSign", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getTopicCoSigns_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getEnrollmentMilestoneCheckins(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getEnrollmentMilestoneCheckins,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetEnrollmentMilestoneCheckins(ctx, fc.Args["enrollmentId"].(string))
+		},
+		nil,
+		ec.marshalNMilestoneCheckin2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐMilestoneCheckinᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getEnrollmentMilestoneCheckins(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_MilestoneCheckin_id(ctx, field)
+			case "milestoneCode":
+				return ec.fieldContext_MilestoneCheckin_milestoneCode(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_MilestoneCheckin_enrollmentCode(ctx, field)
+			case "report":
+				return ec.fieldContext_MilestoneCheckin_report(ctx, field)
+			case "fileCode":
+				return ec.fieldContext_MilestoneCheckin_fileCode(ctx, field)
+			case "result":
+				return ec.fieldContext_MilestoneCheckin_result(ctx, field)
+			case "comment":
+				return ec.fieldContext_MilestoneCheckin_comment(ctx, field)
+			case "reviewedBy":
+				return ec.fieldContext_MilestoneCheckin_reviewedBy(ctx, field)
+			case "reviewedAt":
+				return ec.fieldContext_MilestoneCheckin_reviewedAt(ctx, field)
+			case "submittedAt":
+				return ec.fieldContext_MilestoneCheckin_submittedAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_MilestoneCheckin_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MilestoneCheckin", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getEnrollmentMilestoneCheckins_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMyDefences(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getMyDefences,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetMyDefences(ctx, fc.Args["search"].(*model.SearchRequestInput))
+		},
+		nil,
+		ec.marshalNCouncilDefenceListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilDefenceListResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getMyDefences(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_CouncilDefenceListResponse_total(ctx, field)
+			case "data":
+				return ec.fieldContext_CouncilDefenceListResponse_data(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CouncilDefenceListResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getMyDefences_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMyDefenceDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getMyDefenceDetail,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetMyDefenceDetail(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOCouncilDefence2ᚖthailyᚋsrcᚋgraphᚋmodelᚐCouncilDefence,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getMyDefenceDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_CouncilDefence_id(ctx, field)
+			case "title":
+				return ec.fieldContext_CouncilDefence_title(ctx, field)
+			case "councilCode":
+				return ec.fieldContext_CouncilDefence_councilCode(ctx, field)
+			case "teacherCode":
+				return ec.fieldContext_CouncilDefence_teacherCode(ctx, field)
+			case "position":
+				return ec.fieldContext_CouncilDefence_position(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_CouncilDefence_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_CouncilDefence_updatedAt(ctx, field)
+			case "council":
+				return ec.fieldContext_CouncilDefence_council(ctx, field)
+			case "teacher":
+				return ec.fieldContext_CouncilDefence_teacher(ctx, field)
+			case "gradeDefences":
+				return ec.fieldContext_CouncilDefence_gradeDefences(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CouncilDefence", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getMyDefenceDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMyGradeReviews(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getMyGradeReviews,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetMyGradeReviews(ctx, fc.Args["search"].(*model.SearchRequestInput))
+		},
+		nil,
+		ec.marshalNReviewerGradeReviewListResponse2ᚖthailyᚋsrcᚋgraphᚋmodelᚐReviewerGradeReviewListResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getMyGradeReviews(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_ReviewerGradeReviewListResponse_total(ctx, field)
+			case "data":
+				return ec.fieldContext_ReviewerGradeReviewListResponse_data(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ReviewerGradeReviewListResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getMyGradeReviews_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMyGradeReviewDetail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getMyGradeReviewDetail,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetMyGradeReviewDetail(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOReviewerGradeReview2ᚖthailyᚋsrcᚋgraphᚋmodelᚐReviewerGradeReview,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getMyGradeReviewDetail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ReviewerGradeReview_id(ctx, field)
+			case "title":
+				return ec.fieldContext_ReviewerGradeReview_title(ctx, field)
+			case "teacherCode":
+				return ec.fieldContext_ReviewerGradeReview_teacherCode(ctx, field)
+			case "reviewGrade":
+				return ec.fieldContext_ReviewerGradeReview_reviewGrade(ctx, field)
+			case "status":
+				return ec.fieldContext_ReviewerGradeReview_status(ctx, field)
+			case "notes":
+				return ec.fieldContext_ReviewerGradeReview_notes(ctx, field)
+			case "completionDate":
+				return ec.fieldContext_ReviewerGradeReview_completionDate(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_ReviewerGradeReview_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_ReviewerGradeReview_updatedAt(ctx, field)
+			case "enrollment":
+				return ec.fieldContext_ReviewerGradeReview_enrollment(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ReviewerGradeReview", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getMyGradeReviewDetail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMyAssignedGradeAppeals(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getMyAssignedGradeAppeals,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetMyAssignedGradeAppeals(ctx, fc.Args["status"].(*model.GradeAppealStatus))
+		},
+		nil,
+		ec.marshalNGradeAppeal2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeAppealᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getMyAssignedGradeAppeals(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_GradeAppeal_id(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_GradeAppeal_enrollmentCode(ctx, field)
+			case "studentCode":
+				return ec.fieldContext_GradeAppeal_studentCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_GradeAppeal_semesterCode(ctx, field)
+			case "component":
+				return ec.fieldContext_GradeAppeal_component(ctx, field)
+			case "reason":
+				return ec.fieldContext_GradeAppeal_reason(ctx, field)
+			case "evidenceFileCode":
+				return ec.fieldContext_GradeAppeal_evidenceFileCode(ctx, field)
+			case "status":
+				return ec.fieldContext_GradeAppeal_status(ctx, field)
+			case "reviewerCode":
+				return ec.fieldContext_GradeAppeal_reviewerCode(ctx, field)
+			case "resolutionNote":
+				return ec.fieldContext_GradeAppeal_resolutionNote(ctx, field)
+			case "amendmentCode":
+				return ec.fieldContext_GradeAppeal_amendmentCode(ctx, field)
+			case "resolvedBy":
+				return ec.fieldContext_GradeAppeal_resolvedBy(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_GradeAppeal_resolvedAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_GradeAppeal_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_GradeAppeal_updatedAt(ctx, field)
+			case "history":
+				return ec.fieldContext_GradeAppeal_history(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GradeAppeal", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getMyAssignedGradeAppeals_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___type,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.introspectType(fc.Args["name"].(string))
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___schema,
+		func(ctx context.Context) (any, error) {
+			return ec.introspectSchema()
+		},
+		nil,
+		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReviewerGradeReviewListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.ReviewerGradeReviewListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ReviewerGradeReviewListResponse_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ReviewerGradeReviewListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReviewerGradeReviewListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReviewerGradeReviewListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.ReviewerGradeReviewListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ReviewerGradeReviewListResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalNReviewerGradeReview2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐReviewerGradeReviewᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ReviewerGradeReviewListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReviewerGradeReviewListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ReviewerGradeReview_id(ctx, field)
+			case "title":
+				return ec.fieldContext_ReviewerGradeReview_title(ctx, field)
+			case "teacherCode":
+				return ec.fieldContext_ReviewerGradeReview_teacherCode(ctx, field)
+			case "reviewGrade":
+				return ec.fieldContext_ReviewerGradeReview_reviewGrade(ctx, field)
+			case "status":
+				return ec.fieldContext_ReviewerGradeReview_status(ctx, field)
+			case "notes":
+				return ec.fieldContext_ReviewerGradeReview_notes(ctx, field)
+			case "completionDate":
+				return ec.fieldContext_ReviewerGradeReview_completionDate(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_ReviewerGradeReview_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_ReviewerGradeReview_updatedAt(ctx, field)
+			case "enrollment":
+				return ec.fieldContext_ReviewerGradeReview_enrollment(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ReviewerGradeReview", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoleSystemListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.RoleSystemListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoleSystemListResponse_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoleSystemListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoleSystemListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoleSystemListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.RoleSystemListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoleSystemListResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalNRoleSystem2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐRoleSystemᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoleSystemListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoleSystemListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RoleSystem_id(ctx, field)
+			case "title":
+				return ec.fieldContext_RoleSystem_title(ctx, field)
+			case "teacherCode":
+				return ec.fieldContext_RoleSystem_teacherCode(ctx, field)
+			case "role":
+				return ec.fieldContext_RoleSystem_role(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_RoleSystem_semesterCode(ctx, field)
+			case "activate":
+				return ec.fieldContext_RoleSystem_activate(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RoleSystem_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_RoleSystem_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_RoleSystem_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_RoleSystem_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_RoleSystem_version(ctx, field)
+			case "teacher":
+				return ec.fieldContext_RoleSystem_teacher(ctx, field)
+			case "semester":
+				return ec.fieldContext_RoleSystem_semester(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RoleSystem", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchHit_type(ctx context.Context, field graphql.CollectedField, obj *model.SearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchHit_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalNSearchType2thailyᚋsrcᚋgraphᚋmodelᚐSearchType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchHit_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchHit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type SearchType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchHit_id(ctx context.Context, field graphql.CollectedField, obj *model.SearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchHit_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchHit_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchHit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchHit_title(ctx context.Context, field graphql.CollectedField, obj *model.SearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchHit_title,
+		func(ctx context.Context) (any, error) {
+			return obj.Title, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchHit_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchHit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchHit_subtitle(ctx context.Context, field graphql.CollectedField, obj *model.SearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchHit_subtitle,
+		func(ctx context.Context) (any, error) {
+			return obj.Subtitle, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchHit_subtitle(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchHit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchHit_score(ctx context.Context, field graphql.CollectedField, obj *model.SearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchHit_score,
+		func(ctx context.Context) (any, error) {
+			return obj.Score, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchHit_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchHit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SemesterInfo_id(ctx context.Context, field graphql.CollectedField, obj *model.SemesterInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SemesterInfo_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SemesterInfo_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SemesterInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SemesterInfo_title(ctx context.Context, field graphql.CollectedField, obj *model.SemesterInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SemesterInfo_title,
+		func(ctx context.Context) (any, error) {
+			return obj.Title, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SemesterInfo_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SemesterInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SemesterListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.SemesterListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SemesterListResponse_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SemesterListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SemesterListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SemesterListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.SemesterListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SemesterListResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalNSemester2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐSemesterᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SemesterListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SemesterListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Semester_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Semester_title(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Semester_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Semester_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Semester_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Semester_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Semester_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Semester_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Semester_deletedBy(ctx, field)
+			case "students":
+				return ec.fieldContext_Semester_students(ctx, field)
+			case "teachers":
+				return ec.fieldContext_Semester_teachers(ctx, field)
+			case "topics":
+				return ec.fieldContext_Semester_topics(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Semester", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StudentDefenceInfoListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.StudentDefenceInfoListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StudentDefenceInfoListResponse_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_StudentDefenceInfoListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StudentDefenceInfoListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StudentDefenceInfoListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.StudentDefenceInfoListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StudentDefenceInfoListResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalNStudentDefenceInfo2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentDefenceInfoᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_StudentDefenceInfoListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StudentDefenceInfoListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_StudentDefenceInfo_id(ctx, field)
+			case "title":
+				return ec.fieldContext_StudentDefenceInfo_title(ctx, field)
+			case "position":
+				return ec.fieldContext_StudentDefenceInfo_position(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_StudentDefenceInfo_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_StudentDefenceInfo_updatedAt(ctx, field)
+			case "teacher":
+				return ec.fieldContext_StudentDefenceInfo_teacher(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StudentDefenceInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StudentEnrollmentListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.StudentEnrollmentListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StudentEnrollmentListResponse_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_StudentEnrollmentListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StudentEnrollmentListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StudentEnrollmentListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.StudentEnrollmentListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StudentEnrollmentListResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalNStudentEnrollment2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentEnrollmentᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_StudentEnrollmentListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StudentEnrollmentListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_StudentEnrollment_id(ctx, field)
+			case "title":
+				return ec.fieldContext_StudentEnrollment_title(ctx, field)
+			case "studentCode":
+				return ec.fieldContext_StudentEnrollment_studentCode(ctx, field)
+			case "topicCouncilCode":
+				return ec.fieldContext_StudentEnrollment_topicCouncilCode(ctx, field)
+			case "finalCode":
+				return ec.fieldContext_StudentEnrollment_finalCode(ctx, field)
+			case "gradeReviewCode":
+				return ec.fieldContext_StudentEnrollment_gradeReviewCode(ctx, field)
+			case "midtermCode":
+				return ec.fieldContext_StudentEnrollment_midtermCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_StudentEnrollment_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_StudentEnrollment_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_StudentEnrollment_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_StudentEnrollment_updatedBy(ctx, field)
+			case "topicCouncil":
+				return ec.fieldContext_StudentEnrollment_topicCouncil(ctx, field)
+			case "midterm":
+				return ec.fieldContext_StudentEnrollment_midterm(ctx, field)
+			case "final":
+				return ec.fieldContext_StudentEnrollment_final(ctx, field)
+			case "gradeReview":
+				return ec.fieldContext_StudentEnrollment_gradeReview(ctx, field)
+			case "gradeDefences":
+				return ec.fieldContext_StudentEnrollment_gradeDefences(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StudentEnrollment", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StudentGradeDefenceListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.StudentGradeDefenceListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StudentGradeDefenceListResponse_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_StudentGradeDefenceListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StudentGradeDefenceListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StudentGradeDefenceListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.StudentGradeDefenceListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StudentGradeDefenceListResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalNStudentGradeDefence2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentGradeDefenceᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_StudentGradeDefenceListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StudentGradeDefenceListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_StudentGradeDefence_id(ctx, field)
+			case "defenceCode":
+				return ec.fieldContext_StudentGradeDefence_defenceCode(ctx, field)
+			case "enrollmentCode":
+				return ec.fieldContext_StudentGradeDefence_enrollmentCode(ctx, field)
+			case "note":
+				return ec.fieldContext_StudentGradeDefence_note(ctx, field)
+			case "totalScore":
+				return ec.fieldContext_StudentGradeDefence_totalScore(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_StudentGradeDefence_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_StudentGradeDefence_updatedAt(ctx, field)
+			case "criteria":
+				return ec.fieldContext_StudentGradeDefence_criteria(ctx, field)
+			case "defence":
+				return ec.fieldContext_StudentGradeDefence_defence(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StudentGradeDefence", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StudentListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.StudentListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StudentListResponse_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_StudentListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StudentListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StudentListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.StudentListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StudentListResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalNStudent2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_StudentListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StudentListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Student_id(ctx, field)
+			case "email":
+				return ec.fieldContext_Student_email(ctx, field)
+			case "phone":
+				return ec.fieldContext_Student_phone(ctx, field)
+			case "username":
+				return ec.fieldContext_Student_username(ctx, field)
+			case "gender":
+				return ec.fieldContext_Student_gender(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Student_majorCode(ctx, field)
+			case "classCode":
+				return ec.fieldContext_Student_classCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Student_semesterCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Student_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Student_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Student_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Student_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Student_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Student_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Student_deletedBy(ctx, field)
+			case "enrollments":
+				return ec.fieldContext_Student_enrollments(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Student", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StudentTopicSupervisorListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.StudentTopicSupervisorListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StudentTopicSupervisorListResponse_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_StudentTopicSupervisorListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StudentTopicSupervisorListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StudentTopicSupervisorListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.StudentTopicSupervisorListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StudentTopicSupervisorListResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalNStudentTopicSupervisor2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentTopicSupervisorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_StudentTopicSupervisorListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StudentTopicSupervisorListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_StudentTopicSupervisor_id(ctx, field)
+			case "teacherSupervisorCode":
+				return ec.fieldContext_StudentTopicSupervisor_teacherSupervisorCode(ctx, field)
+			case "topicCouncilCode":
+				return ec.fieldContext_StudentTopicSupervisor_topicCouncilCode(ctx, field)
+			case "teacher":
+				return ec.fieldContext_StudentTopicSupervisor_teacher(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StudentTopicSupervisor", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription__empty(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscription__empty,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Subscription().Empty(ctx)
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscription__empty(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SupervisorEnrollmentListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.SupervisorEnrollmentListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SupervisorEnrollmentListResponse_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SupervisorEnrollmentListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SupervisorEnrollmentListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SupervisorEnrollmentListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.SupervisorEnrollmentListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SupervisorEnrollmentListResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalNSupervisorEnrollment2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐSupervisorEnrollmentᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SupervisorEnrollmentListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SupervisorEnrollmentListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SupervisorEnrollment_id(ctx, field)
+			case "title":
+				return ec.fieldContext_SupervisorEnrollment_title(ctx, field)
+			case "studentCode":
+				return ec.fieldContext_SupervisorEnrollment_studentCode(ctx, field)
+			case "topicCouncilCode":
+				return ec.fieldContext_SupervisorEnrollment_topicCouncilCode(ctx, field)
+			case "finalCode":
+				return ec.fieldContext_SupervisorEnrollment_finalCode(ctx, field)
+			case "gradeReviewCode":
+				return ec.fieldContext_SupervisorEnrollment_gradeReviewCode(ctx, field)
+			case "midtermCode":
+				return ec.fieldContext_SupervisorEnrollment_midtermCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_SupervisorEnrollment_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_SupervisorEnrollment_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_SupervisorEnrollment_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_SupervisorEnrollment_updatedBy(ctx, field)
+			case "student":
+				return ec.fieldContext_SupervisorEnrollment_student(ctx, field)
+			case "topicCouncil":
+				return ec.fieldContext_SupervisorEnrollment_topicCouncil(ctx, field)
+			case "midterm":
+				return ec.fieldContext_SupervisorEnrollment_midterm(ctx, field)
+			case "final":
+				return ec.fieldContext_SupervisorEnrollment_final(ctx, field)
+			case "gradeReview":
+				return ec.fieldContext_SupervisorEnrollment_gradeReview(ctx, field)
+			case "gradeDefences":
+				return ec.fieldContext_SupervisorEnrollment_gradeDefences(ctx, field)
+			case "similarityReport":
+				return ec.fieldContext_SupervisorEnrollment_similarityReport(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SupervisorEnrollment", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SupervisorTopicCouncilAssignmentListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.SupervisorTopicCouncilAssignmentListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SupervisorTopicCouncilAssignmentListResponse_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SupervisorTopicCouncilAssignmentListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SupervisorTopicCouncilAssignmentListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SupervisorTopicCouncilAssignmentListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.SupervisorTopicCouncilAssignmentListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SupervisorTopicCouncilAssignmentListResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalNSupervisorTopicCouncilAssignment2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐSupervisorTopicCouncilAssignmentᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SupervisorTopicCouncilAssignmentListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SupervisorTopicCouncilAssignmentListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SupervisorTopicCouncilAssignment_id(ctx, field)
+			case "teacherSupervisorCode":
+				return ec.fieldContext_SupervisorTopicCouncilAssignment_teacherSupervisorCode(ctx, field)
+			case "topicCouncilCode":
+				return ec.fieldContext_SupervisorTopicCouncilAssignment_topicCouncilCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_SupervisorTopicCouncilAssignment_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_SupervisorTopicCouncilAssignment_updatedAt(ctx, field)
+			case "topicCouncil":
+				return ec.fieldContext_SupervisorTopicCouncilAssignment_topicCouncil(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SupervisorTopicCouncilAssignment", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SupervisorTopicCouncilListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.SupervisorTopicCouncilListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SupervisorTopicCouncilListResponse_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SupervisorTopicCouncilListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SupervisorTopicCouncilListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SupervisorTopicCouncilListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.SupervisorTopicCouncilListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SupervisorTopicCouncilListResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalNSupervisorTopicCouncil2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐSupervisorTopicCouncilᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SupervisorTopicCouncilListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SupervisorTopicCouncilListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SupervisorTopicCouncil_id(ctx, field)
+			case "title":
+				return ec.fieldContext_SupervisorTopicCouncil_title(ctx, field)
+			case "stage":
+				return ec.fieldContext_SupervisorTopicCouncil_stage(ctx, field)
+			case "topicCode":
+				return ec.fieldContext_SupervisorTopicCouncil_topicCode(ctx, field)
+			case "councilCode":
+				return ec.fieldContext_SupervisorTopicCouncil_councilCode(ctx, field)
+			case "timeStart":
+				return ec.fieldContext_SupervisorTopicCouncil_timeStart(ctx, field)
+			case "timeEnd":
+				return ec.fieldContext_SupervisorTopicCouncil_timeEnd(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_SupervisorTopicCouncil_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_SupervisorTopicCouncil_updatedAt(ctx, field)
+			case "topic":
+				return ec.fieldContext_SupervisorTopicCouncil_topic(ctx, field)
+			case "council":
+				return ec.fieldContext_SupervisorTopicCouncil_council(ctx, field)
+			case "enrollments":
+				return ec.fieldContext_SupervisorTopicCouncil_enrollments(ctx, field)
+			case "supervisors":
+				return ec.fieldContext_SupervisorTopicCouncil_supervisors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SupervisorTopicCouncil", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TeacherListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.TeacherListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TeacherListResponse_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TeacherListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TeacherListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TeacherListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.TeacherListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TeacherListResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalNTeacher2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTeacherᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TeacherListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TeacherListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Teacher_id(ctx, field)
+			case "email":
+				return ec.fieldContext_Teacher_email(ctx, field)
+			case "username":
+				return ec.fieldContext_Teacher_username(ctx, field)
+			case "gender":
+				return ec.fieldContext_Teacher_gender(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Teacher_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Teacher_semesterCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Teacher_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Teacher_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Teacher_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Teacher_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Teacher_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Teacher_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Teacher_deletedBy(ctx, field)
+			case "roles":
+				return ec.fieldContext_Teacher_roles(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Teacher", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TopicCouncilListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.TopicCouncilListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TopicCouncilListResponse_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TopicCouncilListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TopicCouncilListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TopicCouncilListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.TopicCouncilListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TopicCouncilListResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalNTopicCouncil2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicCouncilᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TopicCouncilListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TopicCouncilListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TopicCouncil_id(ctx, field)
+			case "title":
+				return ec.fieldContext_TopicCouncil_title(ctx, field)
+			case "stage":
+				return ec.fieldContext_TopicCouncil_stage(ctx, field)
+			case "topicCode":
+				return ec.fieldContext_TopicCouncil_topicCode(ctx, field)
+			case "councilCode":
+				return ec.fieldContext_TopicCouncil_councilCode(ctx, field)
+			case "timeStart":
+				return ec.fieldContext_TopicCouncil_timeStart(ctx, field)
+			case "timeEnd":
+				return ec.fieldContext_TopicCouncil_timeEnd(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TopicCouncil_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TopicCouncil_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_TopicCouncil_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_TopicCouncil_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_TopicCouncil_version(ctx, field)
+			case "topic":
+				return ec.fieldContext_TopicCouncil_topic(ctx, field)
+			case "council":
+				return ec.fieldContext_TopicCouncil_council(ctx, field)
+			case "enrollments":
+				return ec.fieldContext_TopicCouncil_enrollments(ctx, field)
+			case "supervisors":
+				return ec.fieldContext_TopicCouncil_supervisors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TopicCouncil", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TopicCouncilSupervisorListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.TopicCouncilSupervisorListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TopicCouncilSupervisorListResponse_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TopicCouncilSupervisorListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TopicCouncilSupervisorListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TopicCouncilSupervisorListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.TopicCouncilSupervisorListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TopicCouncilSupervisorListResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalNTopicCouncilSupervisor2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicCouncilSupervisorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TopicCouncilSupervisorListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TopicCouncilSupervisorListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TopicCouncilSupervisor_id(ctx, field)
+			case "teacherSupervisorCode":
+				return ec.fieldContext_TopicCouncilSupervisor_teacherSupervisorCode(ctx, field)
+			case "topicCouncilCode":
+				return ec.fieldContext_TopicCouncilSupervisor_topicCouncilCode(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TopicCouncilSupervisor_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TopicCouncilSupervisor_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_TopicCouncilSupervisor_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_TopicCouncilSupervisor_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_TopicCouncilSupervisor_version(ctx, field)
+			case "teacher":
+				return ec.fieldContext_TopicCouncilSupervisor_teacher(ctx, field)
+			case "topicCouncil":
+				return ec.fieldContext_TopicCouncilSupervisor_topicCouncil(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TopicCouncilSupervisor", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TopicListResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.TopicListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TopicListResponse_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TopicListResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TopicListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TopicListResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.TopicListResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TopicListResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalNTopic2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TopicListResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TopicListResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_Topic_total(ctx, field)
+			case "id":
+				return ec.fieldContext_Topic_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Topic_title(ctx, field)
+			case "majorCode":
+				return ec.fieldContext_Topic_majorCode(ctx, field)
+			case "semesterCode":
+				return ec.fieldContext_Topic_semesterCode(ctx, field)
+			case "status":
+				return ec.fieldContext_Topic_status(ctx, field)
+			case "percentStage1":
+				return ec.fieldContext_Topic_percentStage1(ctx, field)
+			case "percentStage2":
+				return ec.fieldContext_Topic_percentStage2(ctx, field)
+			case "maxStudents":
+				return ec.fieldContext_Topic_maxStudents(ctx, field)
+			case "requiredSkills":
+				return ec.fieldContext_Topic_requiredSkills(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Topic_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Topic_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Topic_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_Topic_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Topic_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Topic_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Topic_deletedBy(ctx, field)
+			case "files":
+				return ec.fieldContext_Topic_files(ctx, field)
+			case "topicCouncils":
+				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Topic_statusHistory(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputFilterConditionInput(ctx context.Context, obj any) (model.FilterConditionInput, error) {
+	var it model.FilterConditionInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"field", "operator", "values"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "field":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Field = data
+		case "operator":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("operator"))
+			data, err := ec.unmarshalNFilterOperator2thailyᚋsrcᚋgraphᚋmodelᚐFilterOperator(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Operator = data
+		case "values":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("values"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Values = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputFilterCriteriaInput(ctx context.Context, obj any) (model.FilterCriteriaInput, error) {
+	var it model.FilterCriteriaInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"condition", "group"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "condition":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("condition"))
+			data, err := ec.unmarshalOFilterConditionInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFilterConditionInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Condition = data
+		case "group":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("group"))
+			data, err := ec.unmarshalOFilterGroupInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐFilterGroupInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Group = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputFilterGroupInput(ctx context.Context, obj any) (model.FilterGroupInput, error) {
+	var it model.FilterGroupInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["logic"]; !present {
+		asMap["logic"] = "AND"
+	}
+
+	fieldsInOrder := [...]string{"logic", "filters"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "logic":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("logic"))
+			data, err := ec.unmarshalOLogicalCondition2ᚖthailyᚋsrcᚋgraphᚋmodelᚐLogicalCondition(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Logic = data
+		case "filters":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filters"))
+			data, err := ec.unmarshalNFilterCriteriaInput2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐFilterCriteriaInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Filters = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPaginationInput(ctx context.Context, obj any) (model.PaginationInput, error) {
+	var it model.PaginationInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["page"]; !present {
+		asMap["page"] = 1
+	}
+	if _, present := asMap["pageSize"]; !present {
+		asMap["pageSize"] = 20
+	}
+	if _, present := asMap["descending"]; !present {
+		asMap["descending"] = false
+	}
+
+	fieldsInOrder := [...]string{"page", "pageSize", "sortBy", "descending", "sort"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "page":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("page"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Page = data
+		case "pageSize":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pageSize"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PageSize = data
+		case "sortBy":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sortBy"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SortBy = data
+		case "descending":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("descending"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Descending = data
+		case "sort":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sort"))
+			data, err := ec.unmarshalOSortInput2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐSortInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Sort = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSearchRequestInput(ctx context.Context, obj any) (model.SearchRequestInput, error) {
+	var it model.SearchRequestInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"pagination", "filters"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "pagination":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pagination"))
+			data, err := ec.unmarshalOPaginationInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐPaginationInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Pagination = data
+		case "filters":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filters"))
+			data, err := ec.unmarshalOFilterCriteriaInput2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐFilterCriteriaInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Filters = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSortInput(ctx context.Context, obj any) (model.SortInput, error) {
+	var it model.SortInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["direction"]; !present {
+		asMap["direction"] = "ASC"
+	}
+
+	fieldsInOrder := [...]string{"field", "direction"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "field":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Field = data
+		case "direction":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("direction"))
+			data, err := ec.unmarshalOSortDirection2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSortDirection(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Direction = data
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var auditChangeImplementors = []string{"AuditChange"}
+
+func (ec *executionContext) _AuditChange(ctx context.Context, sel ast.SelectionSet, obj *model.AuditChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditChangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditChange")
+		case "field":
+			out.Values[i] = ec._AuditChange_field(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "before":
+			out.Values[i] = ec._AuditChange_before(ctx, field, obj)
+		case "after":
+			out.Values[i] = ec._AuditChange_after(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var auditLogEntryImplementors = []string{"AuditLogEntry"}
+
+func (ec *executionContext) _AuditLogEntry(ctx context.Context, sel ast.SelectionSet, obj *model.AuditLogEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditLogEntryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditLogEntry")
+		case "id":
+			out.Values[i] = ec._AuditLogEntry_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "at":
+			out.Values[i] = ec._AuditLogEntry_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "service":
+			out.Values[i] = ec._AuditLogEntry_service(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "method":
+			out.Values[i] = ec._AuditLogEntry_method(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "entity":
+			out.Values[i] = ec._AuditLogEntry_entity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "entityId":
+			out.Values[i] = ec._AuditLogEntry_entityId(ctx, field, obj)
+		case "actor":
+			out.Values[i] = ec._AuditLogEntry_actor(ctx, field, obj)
+		case "role":
+			out.Values[i] = ec._AuditLogEntry_role(ctx, field, obj)
+		case "semester":
+			out.Values[i] = ec._AuditLogEntry_semester(ctx, field, obj)
+		case "requestId":
+			out.Values[i] = ec._AuditLogEntry_requestId(ctx, field, obj)
+		case "clientIp":
+			out.Values[i] = ec._AuditLogEntry_clientIp(ctx, field, obj)
+		case "changes":
+			out.Values[i] = ec._AuditLogEntry_changes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var councilDefenceListResponseImplementors = []string{"CouncilDefenceListResponse"}
+
+func (ec *executionContext) _CouncilDefenceListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CouncilDefenceListResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, councilDefenceListResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CouncilDefenceListResponse")
+		case "total":
+			out.Values[i] = ec._CouncilDefenceListResponse_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "data":
+			out.Values[i] = ec._CouncilDefenceListResponse_data(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var councilEnrollmentListResponseImplementors = []string{"CouncilEnrollmentListResponse"}
+
+func (ec *executionContext) _CouncilEnrollmentListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CouncilEnrollmentListResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, councilEnrollmentListResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CouncilEnrollmentListResponse")
+		case "total":
+			out.Values[i] = ec._CouncilEnrollmentListResponse_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "data":
+			out.Values[i] = ec._CouncilEnrollmentListResponse_data(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var councilListResponseImplementors = []string{"CouncilListResponse"}
+
+func (ec *executionContext) _CouncilListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CouncilListResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, councilListResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CouncilListResponse")
+		case "total":
+			out.Values[i] = ec._CouncilListResponse_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "data":
+			out.Values[i] = ec._CouncilListResponse_data(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var councilTopicCouncilListResponseImplementors = []string{"CouncilTopicCouncilListResponse"}
+
+func (ec *executionContext) _CouncilTopicCouncilListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CouncilTopicCouncilListResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, councilTopicCouncilListResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CouncilTopicCouncilListResponse")
+		case "total":
+			out.Values[i] = ec._CouncilTopicCouncilListResponse_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "data":
+			out.Values[i] = ec._CouncilTopicCouncilListResponse_data(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var defenceListResponseImplementors = []string{"DefenceListResponse"}
+
+func (ec *executionContext) _DefenceListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.DefenceListResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, defenceListResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DefenceListResponse")
+		case "total":
+			out.Values[i] = ec._DefenceListResponse_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "data":
+			out.Values[i] = ec._DefenceListResponse_data(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var enrollmentListResponseImplementors = []string{"EnrollmentListResponse"}
+
+func (ec *executionContext) _EnrollmentListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.EnrollmentListResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, enrollmentListResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("EnrollmentListResponse")
+		case "total":
+			out.Values[i] = ec._EnrollmentListResponse_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "data":
+			out.Values[i] = ec._EnrollmentListResponse_data(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var facultyListResponseImplementors = []string{"FacultyListResponse"}
+
+func (ec *executionContext) _FacultyListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.FacultyListResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, facultyListResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FacultyListResponse")
+		case "total":
+			out.Values[i] = ec._FacultyListResponse_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "data":
+			out.Values[i] = ec._FacultyListResponse_data(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var fileListResponseImplementors = []string{"FileListResponse"}
+
+func (ec *executionContext) _FileListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.FileListResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fileListResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FileListResponse")
+		case "total":
+			out.Values[i] = ec._FileListResponse_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "data":
+			out.Values[i] = ec._FileListResponse_data(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var finalListResponseImplementors = []string{"FinalListResponse"}
+
+func (ec *executionContext) _FinalListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.FinalListResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, finalListResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FinalListResponse")
+		case "total":
+			out.Values[i] = ec._FinalListResponse_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "data":
+			out.Values[i] = ec._FinalListResponse_data(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var gradeDefenceCriterionListResponseImplementors = []string{"GradeDefenceCriterionListResponse"}
+
+func (ec *executionContext) _GradeDefenceCriterionListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GradeDefenceCriterionListResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, gradeDefenceCriterionListResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GradeDefenceCriterionListResponse")
+		case "total":
+			out.Values[i] = ec._GradeDefenceCriterionListResponse_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "data":
+			out.Values[i] = ec._GradeDefenceCriterionListResponse_data(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var gradeDefenceListResponseImplementors = []string{"GradeDefenceListResponse"}
+
+func (ec *executionContext) _GradeDefenceListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GradeDefenceListResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, gradeDefenceListResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CouncilEnrollmentListResponse")
+			out.Values[i] = graphql.MarshalString("GradeDefenceListResponse")
 		case "total":
-			out.Values[i] = ec._CouncilEnrollmentListResponse_total(ctx, field, obj)
+			out.Values[i] = ec._GradeDefenceListResponse_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "data":
+			out.Values[i] = ec._GradeDefenceListResponse_data(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var gradeReviewListResponseImplementors = []string{"GradeReviewListResponse"}
+
+func (ec *executionContext) _GradeReviewListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GradeReviewListResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, gradeReviewListResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GradeReviewListResponse")
+		case "total":
+			out.Values[i] = ec._GradeReviewListResponse_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "data":
+			out.Values[i] = ec._GradeReviewListResponse_data(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var majorInfoImplementors = []string{"MajorInfo"}
+
+func (ec *executionContext) _MajorInfo(ctx context.Context, sel ast.SelectionSet, obj *model.MajorInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, majorInfoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MajorInfo")
+		case "id":
+			out.Values[i] = ec._MajorInfo_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "title":
+			out.Values[i] = ec._MajorInfo_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "facultyCode":
+			out.Values[i] = ec._MajorInfo_facultyCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var majorListResponseImplementors = []string{"MajorListResponse"}
+
+func (ec *executionContext) _MajorListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.MajorListResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, majorListResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MajorListResponse")
+		case "total":
+			out.Values[i] = ec._MajorListResponse_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "data":
+			out.Values[i] = ec._MajorListResponse_data(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var midtermListResponseImplementors = []string{"MidtermListResponse"}
+
+func (ec *executionContext) _MidtermListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.MidtermListResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, midtermListResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MidtermListResponse")
+		case "total":
+			out.Values[i] = ec._MidtermListResponse_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "data":
+			out.Values[i] = ec._MidtermListResponse_data(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var mutationImplementors = []string{"Mutation"}
+
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "_empty":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation__empty(ctx, field)
+			})
+		case "createTeacher":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createTeacher(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateTeacher":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateTeacher(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteTeacher":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteTeacher(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "restoreTeacher":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_restoreTeacher(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createStudent":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createStudent(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateStudent":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateStudent(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteStudent":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteStudent(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "restoreStudent":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_restoreStudent(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createSemester":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createSemester(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateSemester":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateSemester(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteSemester":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteSemester(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "restoreSemester":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_restoreSemester(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createMajor":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createMajor(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateMajor":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateMajor(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteMajor":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteMajor(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "restoreMajor":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_restoreMajor(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createFaculty":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createFaculty(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateFaculty":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateFaculty(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteFaculty":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteFaculty(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "approveCouncil":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_approveCouncil(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateCouncil":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateCouncil(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteCouncil":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteCouncil(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "restoreCouncil":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_restoreCouncil(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "approveTopic":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_approveTopic(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rejectTopic":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_rejectTopic(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "startTopic":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_startTopic(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "completeTopic":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_completeTopic(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateTopic":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateTopic(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteTopic":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteTopic(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "restoreTopic":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_restoreTopic(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "setSubmissionDeadline":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setSubmissionDeadline(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createMidtermMilestone":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createMidtermMilestone(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateMidtermMilestone":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateMidtermMilestone(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteMidtermMilestone":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteMidtermMilestone(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "setRegistrationWindow":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setRegistrationWindow(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "commitTopicMatching":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_commitTopicMatching(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "commitDefenceSchedule":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_commitDefenceSchedule(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createRubricTemplate":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createRubricTemplate(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateRubricTemplate":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateRubricTemplate(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteRubricTemplate":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteRubricTemplate(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "setGradingPolicy":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setGradingPolicy(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteGradingPolicy":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteGradingPolicy(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "finalizeFinalGrade":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_finalizeFinalGrade(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "data":
-			out.Values[i] = ec._CouncilEnrollmentListResponse_data(ctx, field, obj)
+		case "lockSemesterGrades":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_lockSemesterGrades(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var councilListResponseImplementors = []string{"CouncilListResponse"}
-
-func (ec *executionContext) _CouncilListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CouncilListResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, councilListResponseImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("CouncilListResponse")
-		case "total":
-			out.Values[i] = ec._CouncilListResponse_total(ctx, field, obj)
+		case "publishSemesterGrades":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_publishSemesterGrades(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "data":
-			out.Values[i] = ec._CouncilListResponse_data(ctx, field, obj)
+		case "lockCouncilGrades":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_lockCouncilGrades(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var councilTopicCouncilListResponseImplementors = []string{"CouncilTopicCouncilListResponse"}
-
-func (ec *executionContext) _CouncilTopicCouncilListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CouncilTopicCouncilListResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, councilTopicCouncilListResponseImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("CouncilTopicCouncilListResponse")
-		case "total":
-			out.Values[i] = ec._CouncilTopicCouncilListResponse_total(ctx, field, obj)
+		case "publishCouncilGrades":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_publishCouncilGrades(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "data":
-			out.Values[i] = ec._CouncilTopicCouncilListResponse_data(ctx, field, obj)
+		case "decideGradeAmendment":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_decideGradeAmendment(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var defenceListResponseImplementors = []string{"DefenceListResponse"}
-
-func (ec *executionContext) _DefenceListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.DefenceListResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, defenceListResponseImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("DefenceListResponse")
-		case "total":
-			out.Values[i] = ec._DefenceListResponse_total(ctx, field, obj)
+		case "setGradeAppealWindow":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setGradeAppealWindow(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "data":
-			out.Values[i] = ec._DefenceListResponse_data(ctx, field, obj)
+		case "assignGradeAppeal":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_assignGradeAppeal(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var enrollmentListResponseImplementors = []string{"EnrollmentListResponse"}
-
-func (ec *executionContext) _EnrollmentListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.EnrollmentListResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, enrollmentListResponseImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("EnrollmentListResponse")
-		case "total":
-			out.Values[i] = ec._EnrollmentListResponse_total(ctx, field, obj)
+		case "createCouncil":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createCouncil(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "data":
-			out.Values[i] = ec._EnrollmentListResponse_data(ctx, field, obj)
+		case "updateDepartmentCouncil":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateDepartmentCouncil(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var facultyListResponseImplementors = []string{"FacultyListResponse"}
-
-func (ec *executionContext) _FacultyListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.FacultyListResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, facultyListResponseImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("FacultyListResponse")
-		case "total":
-			out.Values[i] = ec._FacultyListResponse_total(ctx, field, obj)
+		case "addDefenceToCouncil":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_addDefenceToCouncil(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "removeDefenceFromCouncil":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_removeDefenceFromCouncil(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "approveTopicStage1":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_approveTopicStage1(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rejectTopicStage1":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_rejectTopicStage1(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "assignTopicToCouncil":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_assignTopicToCouncil(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "overrideCouncilConflicts":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_overrideCouncilConflicts(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateMyProfile":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateMyProfile(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "data":
-			out.Values[i] = ec._FacultyListResponse_data(ctx, field, obj)
+		case "uploadMidtermFile":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_uploadMidtermFile(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var fileListResponseImplementors = []string{"FileListResponse"}
-
-func (ec *executionContext) _FileListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.FileListResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, fileListResponseImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("FileListResponse")
-		case "total":
-			out.Values[i] = ec._FileListResponse_total(ctx, field, obj)
+		case "uploadFinalFile":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_uploadFinalFile(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "data":
-			out.Values[i] = ec._FileListResponse_data(ctx, field, obj)
+		case "registerTopicPreferences":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_registerTopicPreferences(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var finalListResponseImplementors = []string{"FinalListResponse"}
-
-func (ec *executionContext) _FinalListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.FinalListResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, finalListResponseImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("FinalListResponse")
-		case "total":
-			out.Values[i] = ec._FinalListResponse_total(ctx, field, obj)
+		case "submitMilestoneCheckin":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_submitMilestoneCheckin(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "data":
-			out.Values[i] = ec._FinalListResponse_data(ctx, field, obj)
+		case "fileGradeAppeal":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_fileGradeAppeal(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var gradeDefenceCriterionListResponseImplementors = []string{"GradeDefenceCriterionListResponse"}
-
-func (ec *executionContext) _GradeDefenceCriterionListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GradeDefenceCriterionListResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, gradeDefenceCriterionListResponseImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("GradeDefenceCriterionListResponse")
-		case "total":
-			out.Values[i] = ec._GradeDefenceCriterionListResponse_total(ctx, field, obj)
+		case "updateMyTeacherProfile":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateMyTeacherProfile(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "data":
-			out.Values[i] = ec._GradeDefenceCriterionListResponse_data(ctx, field, obj)
+		case "proposeTopic":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_proposeTopic(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var gradeDefenceListResponseImplementors = []string{"GradeDefenceListResponse"}
-
-func (ec *executionContext) _GradeDefenceListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GradeDefenceListResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, gradeDefenceListResponseImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("GradeDefenceListResponse")
-		case "total":
-			out.Values[i] = ec._GradeDefenceListResponse_total(ctx, field, obj)
+		case "coSignTopic":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_coSignTopic(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "data":
-			out.Values[i] = ec._GradeDefenceListResponse_data(ctx, field, obj)
+		case "submitTopic":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_submitTopic(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var gradeReviewListResponseImplementors = []string{"GradeReviewListResponse"}
-
-func (ec *executionContext) _GradeReviewListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GradeReviewListResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, gradeReviewListResponseImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("GradeReviewListResponse")
-		case "total":
-			out.Values[i] = ec._GradeReviewListResponse_total(ctx, field, obj)
+		case "decideTopicRegistration":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_decideTopicRegistration(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rankTopicApplicants":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_rankTopicApplicants(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reviewMilestoneCheckin":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_reviewMilestoneCheckin(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "gradeFinal":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_gradeFinal(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "feedbackFinal":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_feedbackFinal(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "approveMidtermFile":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_approveMidtermFile(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rejectMidtermFile":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_rejectMidtermFile(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "data":
-			out.Values[i] = ec._GradeReviewListResponse_data(ctx, field, obj)
+		case "approveFinalFile":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_approveFinalFile(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var majorInfoImplementors = []string{"MajorInfo"}
-
-func (ec *executionContext) _MajorInfo(ctx context.Context, sel ast.SelectionSet, obj *model.MajorInfo) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, majorInfoImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("MajorInfo")
-		case "id":
-			out.Values[i] = ec._MajorInfo_id(ctx, field, obj)
+		case "rejectFinalFile":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_rejectFinalFile(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "title":
-			out.Values[i] = ec._MajorInfo_title(ctx, field, obj)
+		case "grantDeadlineExtension":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_grantDeadlineExtension(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "facultyCode":
-			out.Values[i] = ec._MajorInfo_facultyCode(ctx, field, obj)
+		case "createGradeDefence":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createGradeDefence(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var majorListResponseImplementors = []string{"MajorListResponse"}
-
-func (ec *executionContext) _MajorListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.MajorListResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, majorListResponseImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("MajorListResponse")
-		case "total":
-			out.Values[i] = ec._MajorListResponse_total(ctx, field, obj)
+		case "updateGradeDefence":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateGradeDefence(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "data":
-			out.Values[i] = ec._MajorListResponse_data(ctx, field, obj)
+		case "addGradeDefenceCriterion":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_addGradeDefenceCriterion(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var midtermListResponseImplementors = []string{"MidtermListResponse"}
-
-func (ec *executionContext) _MidtermListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.MidtermListResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, midtermListResponseImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("MidtermListResponse")
-		case "total":
-			out.Values[i] = ec._MidtermListResponse_total(ctx, field, obj)
+		case "updateGradeDefenceCriterion":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateGradeDefenceCriterion(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "data":
-			out.Values[i] = ec._MidtermListResponse_data(ctx, field, obj)
+		case "deleteGradeDefenceCriterion":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteGradeDefenceCriterion(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "requestGradeAmendment":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_requestGradeAmendment(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "resolveGradeAppeal":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_resolveGradeAppeal(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateGradeReview":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateGradeReview(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "completeGradeReview":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_completeGradeReview(ctx, field)
+			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -10002,12 +17127,12 @@ func (ec *executionContext) _MidtermListResponse(ctx context.Context, sel ast.Se
 	return out
 }
 
-var mutationImplementors = []string{"Mutation"}
+var queryImplementors = []string{"Query"}
 
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
 	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Mutation",
+		Object: "Query",
 	})
 
 	out := graphql.NewFieldSet(fields)
@@ -10020,399 +17145,593 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
+			out.Values[i] = graphql.MarshalString("Query")
 		case "_empty":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation__empty(ctx, field)
-			})
-		case "createTeacher":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createTeacher(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query__empty(ctx, field)
+				return res
 			}
-		case "updateTeacher":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateTeacher(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "deleteTeacher":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_deleteTeacher(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "globalSearch":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_globalSearch(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "createStudent":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createStudent(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getListTeachers":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getListTeachers(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "updateStudent":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateStudent(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "deleteStudent":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_deleteStudent(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getListStudents":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getListStudents(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "createSemester":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createSemester(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "updateSemester":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateSemester(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getStudentDetail":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getStudentDetail(ctx, field)
+				return res
 			}
-		case "deleteSemester":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_deleteSemester(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "createMajor":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createMajor(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getTeacherDetail":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getTeacherDetail(ctx, field)
+				return res
 			}
-		case "updateMajor":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateMajor(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "deleteMajor":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_deleteMajor(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getAllSemesters":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getAllSemesters(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "createFaculty":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createFaculty(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "updateFaculty":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateFaculty(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getAllMajors":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getAllMajors(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "deleteFaculty":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_deleteFaculty(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "approveCouncil":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_approveCouncil(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getAllFaculties":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getAllFaculties(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "updateCouncil":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateCouncil(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "deleteCouncil":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_deleteCouncil(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getAllTopics":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getAllTopics(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "approveTopic":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_approveTopic(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "rejectTopic":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_rejectTopic(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getTopicDetail":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getTopicDetail(ctx, field)
+				return res
 			}
-		case "updateTopic":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateTopic(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "deleteTopic":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_deleteTopic(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getAllEnrollments":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getAllEnrollments(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "createCouncil":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createCouncil(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "updateDepartmentCouncil":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateDepartmentCouncil(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getEnrollmentDetail":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getEnrollmentDetail(ctx, field)
+				return res
 			}
-		case "addDefenceToCouncil":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_addDefenceToCouncil(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "removeDefenceFromCouncil":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_removeDefenceFromCouncil(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getAllCouncils":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getAllCouncils(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "approveTopicStage1":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_approveTopicStage1(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "rejectTopicStage1":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_rejectTopicStage1(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getCouncilDetail":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getCouncilDetail(ctx, field)
+				return res
 			}
-		case "assignTopicToCouncil":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_assignTopicToCouncil(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "updateMyProfile":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateMyProfile(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getDefencesByCouncil":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getDefencesByCouncil(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "uploadMidtermFile":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_uploadMidtermFile(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "uploadFinalFile":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_uploadFinalFile(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getAllGradeDefences":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getAllGradeDefences(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "updateMyTeacherProfile":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateMyTeacherProfile(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "gradeMidterm":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_gradeMidterm(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getSubmissionDeadlines":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getSubmissionDeadlines(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "feedbackMidterm":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_feedbackMidterm(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "gradeFinal":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_gradeFinal(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getMidtermMilestones":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getMidtermMilestones(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "feedbackFinal":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_feedbackFinal(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getRegistrationWindow":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getRegistrationWindow(ctx, field)
+				return res
 			}
-		case "approveMidtermFile":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_approveMidtermFile(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "rejectMidtermFile":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_rejectMidtermFile(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getTopicRegistrations":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getTopicRegistrations(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "approveFinalFile":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_approveFinalFile(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "rejectFinalFile":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_rejectFinalFile(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "previewTopicMatching":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_previewTopicMatching(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "createGradeDefence":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createGradeDefence(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "updateGradeDefence":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateGradeDefence(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "previewDefenceSchedule":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_previewDefenceSchedule(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "addGradeDefenceCriterion":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_addGradeDefenceCriterion(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "updateGradeDefenceCriterion":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateGradeDefenceCriterion(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getRubricTemplates":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getRubricTemplates(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "deleteGradeDefenceCriterion":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_deleteGradeDefenceCriterion(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "updateGradeReview":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateGradeReview(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getGradingPolicies":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getGradingPolicies(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "completeGradeReview":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_completeGradeReview(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "previewFinalGrade":
+			field := field
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_previewFinalGrade(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-	return out
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-var queryImplementors = []string{"Query"}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getSemesterGradeStatus":
+			field := field
 
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getSemesterGradeStatus(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "_empty":
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getGradeAmendments":
 			field := field
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
 				defer func() {
 					if r := recover(); r != nil {
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query__empty(ctx, field)
+				res = ec._Query_getGradeAmendments(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
 				return res
 			}
 
@@ -10422,7 +17741,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getListTeachers":
+		case "getGradeAppeals":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10431,7 +17750,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getListTeachers(ctx, field)
+				res = ec._Query_getGradeAppeals(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -10444,7 +17763,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getListStudents":
+		case "auditLog":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10453,7 +17772,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getListStudents(ctx, field)
+				res = ec._Query_auditLog(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -10466,16 +17785,19 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getStudentDetail":
+		case "getDepartmentTeachers":
 			field := field
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
 				defer func() {
 					if r := recover(); r != nil {
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getStudentDetail(ctx, field)
+				res = ec._Query_getDepartmentTeachers(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
 				return res
 			}
 
@@ -10485,16 +17807,19 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getTeacherDetail":
+		case "getDepartmentStudents":
 			field := field
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
 				defer func() {
 					if r := recover(); r != nil {
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getTeacherDetail(ctx, field)
+				res = ec._Query_getDepartmentStudents(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
 				return res
 			}
 
@@ -10504,7 +17829,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getAllSemesters":
+		case "getDepartmentSemesters":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10513,7 +17838,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getAllSemesters(ctx, field)
+				res = ec._Query_getDepartmentSemesters(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -10526,7 +17851,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getAllMajors":
+		case "getDepartmentMajors":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10535,7 +17860,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getAllMajors(ctx, field)
+				res = ec._Query_getDepartmentMajors(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -10548,7 +17873,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getAllFaculties":
+		case "getDepartmentFaculties":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10557,7 +17882,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getAllFaculties(ctx, field)
+				res = ec._Query_getDepartmentFaculties(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -10570,7 +17895,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getAllTopics":
+		case "getDepartmentTopics":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10579,7 +17904,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getAllTopics(ctx, field)
+				res = ec._Query_getDepartmentTopics(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -10592,7 +17917,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getTopicDetail":
+		case "getDepartmentTopicDetail":
 			field := field
 
 			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10601,7 +17926,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getTopicDetail(ctx, field)
+				res = ec._Query_getDepartmentTopicDetail(ctx, field)
 				return res
 			}
 
@@ -10611,7 +17936,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getAllEnrollments":
+		case "getDepartmentEnrollments":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10620,7 +17945,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getAllEnrollments(ctx, field)
+				res = ec._Query_getDepartmentEnrollments(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -10633,7 +17958,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getEnrollmentDetail":
+		case "getDepartmentEnrollmentDetail":
 			field := field
 
 			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10642,7 +17967,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getEnrollmentDetail(ctx, field)
+				res = ec._Query_getDepartmentEnrollmentDetail(ctx, field)
 				return res
 			}
 
@@ -10652,7 +17977,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getAllCouncils":
+		case "getDepartmentCouncils":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10661,7 +17986,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getAllCouncils(ctx, field)
+				res = ec._Query_getDepartmentCouncils(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -10674,7 +17999,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getCouncilDetail":
+		case "getDepartmentCouncilDetail":
 			field := field
 
 			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10683,7 +18008,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getCouncilDetail(ctx, field)
+				res = ec._Query_getDepartmentCouncilDetail(ctx, field)
 				return res
 			}
 
@@ -10693,7 +18018,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getDefencesByCouncil":
+		case "getDepartmentDefences":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10702,7 +18027,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getDefencesByCouncil(ctx, field)
+				res = ec._Query_getDepartmentDefences(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -10715,7 +18040,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getAllGradeDefences":
+		case "getDepartmentGradeDefences":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10724,7 +18049,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getAllGradeDefences(ctx, field)
+				res = ec._Query_getDepartmentGradeDefences(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -10737,7 +18062,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getDepartmentTeachers":
+		case "validateCouncil":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10746,7 +18071,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getDepartmentTeachers(ctx, field)
+				res = ec._Query_validateCouncil(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -10759,7 +18084,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getDepartmentStudents":
+		case "getCouncilConflictOverrides":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10768,7 +18093,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getDepartmentStudents(ctx, field)
+				res = ec._Query_getCouncilConflictOverrides(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -10781,7 +18106,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getDepartmentSemesters":
+		case "getMyProfile":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10790,7 +18115,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getDepartmentSemesters(ctx, field)
+				res = ec._Query_getMyProfile(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -10803,7 +18128,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getDepartmentMajors":
+		case "getMyEnrollments":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10812,7 +18137,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getDepartmentMajors(ctx, field)
+				res = ec._Query_getMyEnrollments(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -10825,19 +18150,16 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getDepartmentFaculties":
+		case "getMyEnrollmentDetail":
 			field := field
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
 				defer func() {
 					if r := recover(); r != nil {
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getDepartmentFaculties(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
+				res = ec._Query_getMyEnrollmentDetail(ctx, field)
 				return res
 			}
 
@@ -10847,7 +18169,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getDepartmentTopics":
+		case "getMySemesters":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10856,7 +18178,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getDepartmentTopics(ctx, field)
+				res = ec._Query_getMySemesters(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -10869,26 +18191,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getDepartmentTopicDetail":
-			field := field
-
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_getDepartmentTopicDetail(ctx, field)
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getDepartmentEnrollments":
+		case "getMySubmissionWindow":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10897,7 +18200,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getDepartmentEnrollments(ctx, field)
+				res = ec._Query_getMySubmissionWindow(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -10910,7 +18213,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getDepartmentEnrollmentDetail":
+		case "getMyRegistrationWindow":
 			field := field
 
 			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10919,7 +18222,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getDepartmentEnrollmentDetail(ctx, field)
+				res = ec._Query_getMyRegistrationWindow(ctx, field)
 				return res
 			}
 
@@ -10929,7 +18232,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getDepartmentCouncils":
+		case "getRegistrableTopics":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10938,7 +18241,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getDepartmentCouncils(ctx, field)
+				res = ec._Query_getRegistrableTopics(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -10951,16 +18254,19 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getDepartmentCouncilDetail":
+		case "getMyTopicRegistrations":
 			field := field
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
 				defer func() {
 					if r := recover(); r != nil {
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getDepartmentCouncilDetail(ctx, field)
+				res = ec._Query_getMyTopicRegistrations(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
 				return res
 			}
 
@@ -10970,7 +18276,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getDepartmentDefences":
+		case "getMyMidtermMilestones":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -10979,7 +18285,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getDepartmentDefences(ctx, field)
+				res = ec._Query_getMyMidtermMilestones(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -10992,7 +18298,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getDepartmentGradeDefences":
+		case "getMyMilestoneCheckins":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -11001,7 +18307,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getDepartmentGradeDefences(ctx, field)
+				res = ec._Query_getMyMilestoneCheckins(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -11014,7 +18320,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getMyProfile":
+		case "getMyGradeAppeals":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -11023,7 +18329,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getMyProfile(ctx, field)
+				res = ec._Query_getMyGradeAppeals(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -11036,7 +18342,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getMyEnrollments":
+		case "getMyTeacherProfile":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -11045,7 +18351,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getMyEnrollments(ctx, field)
+				res = ec._Query_getMyTeacherProfile(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -11058,16 +18364,19 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getMyEnrollmentDetail":
+		case "getMySupervisedTopicCouncils":
 			field := field
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
 				defer func() {
 					if r := recover(); r != nil {
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getMyEnrollmentDetail(ctx, field)
+				res = ec._Query_getMySupervisedTopicCouncils(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
 				return res
 			}
 
@@ -11077,19 +18386,16 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getMySemesters":
+		case "getMySupervisedTopicCouncilDetail":
 			field := field
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
 				defer func() {
 					if r := recover(); r != nil {
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getMySemesters(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
+				res = ec._Query_getMySupervisedTopicCouncilDetail(ctx, field)
 				return res
 			}
 
@@ -11099,7 +18405,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getMyTeacherProfile":
+		case "getMyTopicApplicants":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -11108,7 +18414,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getMyTeacherProfile(ctx, field)
+				res = ec._Query_getMyTopicApplicants(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -11121,7 +18427,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getMySupervisedTopicCouncils":
+		case "getTopicCoSigns":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -11130,7 +18436,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getMySupervisedTopicCouncils(ctx, field)
+				res = ec._Query_getTopicCoSigns(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -11143,16 +18449,19 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getMySupervisedTopicCouncilDetail":
+		case "getEnrollmentMilestoneCheckins":
 			field := field
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
 				defer func() {
 					if r := recover(); r != nil {
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getMySupervisedTopicCouncilDetail(ctx, field)
+				res = ec._Query_getEnrollmentMilestoneCheckins(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
 				return res
 			}
 
@@ -11243,6 +18552,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getMyAssignedGradeAppeals":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getMyAssignedGradeAppeals(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "__type":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
@@ -11363,6 +18694,62 @@ func (ec *executionContext) _RoleSystemListResponse(ctx context.Context, sel ast
 	return out
 }
 
+var searchHitImplementors = []string{"SearchHit"}
+
+func (ec *executionContext) _SearchHit(ctx context.Context, sel ast.SelectionSet, obj *model.SearchHit) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, searchHitImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SearchHit")
+		case "type":
+			out.Values[i] = ec._SearchHit_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "id":
+			out.Values[i] = ec._SearchHit_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "title":
+			out.Values[i] = ec._SearchHit_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "subtitle":
+			out.Values[i] = ec._SearchHit_subtitle(ctx, field, obj)
+		case "score":
+			out.Values[i] = ec._SearchHit_score(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var semesterInfoImplementors = []string{"SemesterInfo"}
 
 func (ec *executionContext) _SemesterInfo(ctx context.Context, sel ast.SelectionSet, obj *model.SemesterInfo) graphql.Marshaler {
@@ -11976,32 +19363,150 @@ func (ec *executionContext) _TopicListResponse(ctx context.Context, sel ast.Sele
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) marshalNAuditChange2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐAuditChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.AuditChange) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAuditChange2ᚖthailyᚋsrcᚋgraphᚋmodelᚐAuditChange(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAuditChange2ᚖthailyᚋsrcᚋgraphᚋmodelᚐAuditChange(ctx context.Context, sel ast.SelectionSet, v *model.AuditChange) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AuditChange(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAuditLogEntry2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐAuditLogEntryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.AuditLogEntry) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAuditLogEntry2ᚖthailyᚋsrcᚋgraphᚋmodelᚐAuditLogEntry(ctx, sel, v[i])
 		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNAuditLogEntry2ᚖthailyᚋsrcᚋgraphᚋmodelᚐAuditLogEntry(ctx context.Context, sel ast.SelectionSet, v *model.AuditLogEntry) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._AuditLogEntry(ctx, sel, v)
 }
 
-// endregion **************************** object.gotpl ****************************
+func (ec *executionContext) unmarshalNCoSignStatus2thailyᚋsrcᚋgraphᚋmodelᚐCoSignStatus(ctx context.Context, v any) (model.CoSignStatus, error) {
+	var res model.CoSignStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) marshalNCoSignStatus2thailyᚋsrcᚋgraphᚋmodelᚐCoSignStatus(ctx context.Context, sel ast.SelectionSet, v model.CoSignStatus) graphql.Marshaler {
+	return v
+}
 
 func (ec *executionContext) marshalNCouncilDefenceListResponse2thailyᚋsrcᚋgraphᚋmodelᚐCouncilDefenceListResponse(ctx context.Context, sel ast.SelectionSet, v model.CouncilDefenceListResponse) graphql.Marshaler {
 	return ec._CouncilDefenceListResponse(ctx, sel, &v)
@@ -12153,6 +19658,46 @@ func (ec *executionContext) marshalNGender2thailyᚋsrcᚋgraphᚋmodelᚐGender
 	return v
 }
 
+func (ec *executionContext) unmarshalNGradeAmendmentStatus2thailyᚋsrcᚋgraphᚋmodelᚐGradeAmendmentStatus(ctx context.Context, v any) (model.GradeAmendmentStatus, error) {
+	var res model.GradeAmendmentStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNGradeAmendmentStatus2thailyᚋsrcᚋgraphᚋmodelᚐGradeAmendmentStatus(ctx context.Context, sel ast.SelectionSet, v model.GradeAmendmentStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNGradeAmendmentTarget2thailyᚋsrcᚋgraphᚋmodelᚐGradeAmendmentTarget(ctx context.Context, v any) (model.GradeAmendmentTarget, error) {
+	var res model.GradeAmendmentTarget
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNGradeAmendmentTarget2thailyᚋsrcᚋgraphᚋmodelᚐGradeAmendmentTarget(ctx context.Context, sel ast.SelectionSet, v model.GradeAmendmentTarget) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNGradeAppealComponent2thailyᚋsrcᚋgraphᚋmodelᚐGradeAppealComponent(ctx context.Context, v any) (model.GradeAppealComponent, error) {
+	var res model.GradeAppealComponent
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNGradeAppealComponent2thailyᚋsrcᚋgraphᚋmodelᚐGradeAppealComponent(ctx context.Context, sel ast.SelectionSet, v model.GradeAppealComponent) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNGradeAppealStatus2thailyᚋsrcᚋgraphᚋmodelᚐGradeAppealStatus(ctx context.Context, v any) (model.GradeAppealStatus, error) {
+	var res model.GradeAppealStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNGradeAppealStatus2thailyᚋsrcᚋgraphᚋmodelᚐGradeAppealStatus(ctx context.Context, sel ast.SelectionSet, v model.GradeAppealStatus) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalNGradeDefenceListResponse2thailyᚋsrcᚋgraphᚋmodelᚐGradeDefenceListResponse(ctx context.Context, sel ast.SelectionSet, v model.GradeDefenceListResponse) graphql.Marshaler {
 	return ec._GradeDefenceListResponse(ctx, sel, &v)
 }
@@ -12167,6 +19712,16 @@ func (ec *executionContext) marshalNGradeDefenceListResponse2ᚖthailyᚋsrcᚋg
 	return ec._GradeDefenceListResponse(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNGradeRounding2thailyᚋsrcᚋgraphᚋmodelᚐGradeRounding(ctx context.Context, v any) (model.GradeRounding, error) {
+	var res model.GradeRounding
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNGradeRounding2thailyᚋsrcᚋgraphᚋmodelᚐGradeRounding(ctx context.Context, sel ast.SelectionSet, v model.GradeRounding) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalNMajorListResponse2thailyᚋsrcᚋgraphᚋmodelᚐMajorListResponse(ctx context.Context, sel ast.SelectionSet, v model.MajorListResponse) graphql.Marshaler {
 	return ec._MajorListResponse(ctx, sel, &v)
 }
@@ -12181,6 +19736,16 @@ func (ec *executionContext) marshalNMajorListResponse2ᚖthailyᚋsrcᚋgraphᚋ
 	return ec._MajorListResponse(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNMatchRejectionReason2thailyᚋsrcᚋgraphᚋmodelᚐMatchRejectionReason(ctx context.Context, v any) (model.MatchRejectionReason, error) {
+	var res model.MatchRejectionReason
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNMatchRejectionReason2thailyᚋsrcᚋgraphᚋmodelᚐMatchRejectionReason(ctx context.Context, sel ast.SelectionSet, v model.MatchRejectionReason) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalNMidtermStatus2thailyᚋsrcᚋgraphᚋmodelᚐMidtermStatus(ctx context.Context, v any) (model.MidtermStatus, error) {
 	var res model.MidtermStatus
 	err := res.UnmarshalGQL(v)
@@ -12191,6 +19756,26 @@ func (ec *executionContext) marshalNMidtermStatus2thailyᚋsrcᚋgraphᚋmodel
 	return v
 }
 
+func (ec *executionContext) unmarshalNMilestoneResult2thailyᚋsrcᚋgraphᚋmodelᚐMilestoneResult(ctx context.Context, v any) (model.MilestoneResult, error) {
+	var res model.MilestoneResult
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNMilestoneResult2thailyᚋsrcᚋgraphᚋmodelᚐMilestoneResult(ctx context.Context, sel ast.SelectionSet, v model.MilestoneResult) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNRegistrationStatus2thailyᚋsrcᚋgraphᚋmodelᚐRegistrationStatus(ctx context.Context, v any) (model.RegistrationStatus, error) {
+	var res model.RegistrationStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRegistrationStatus2thailyᚋsrcᚋgraphᚋmodelᚐRegistrationStatus(ctx context.Context, sel ast.SelectionSet, v model.RegistrationStatus) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalNReviewerGradeReviewListResponse2thailyᚋsrcᚋgraphᚋmodelᚐReviewerGradeReviewListResponse(ctx context.Context, sel ast.SelectionSet, v model.ReviewerGradeReviewListResponse) graphql.Marshaler {
 	return ec._ReviewerGradeReviewListResponse(ctx, sel, &v)
 }
@@ -12215,11 +19800,75 @@ func (ec *executionContext) marshalNRoleSystemRole2thailyᚋsrcᚋgraphᚋmodel
 	return v
 }
 
+func (ec *executionContext) marshalNSearchHit2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐSearchHitᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SearchHit) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSearchHit2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSearchHit(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNSearchHit2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSearchHit(ctx context.Context, sel ast.SelectionSet, v *model.SearchHit) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SearchHit(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNSearchRequestInput2thailyᚋsrcᚋgraphᚋmodelᚐSearchRequestInput(ctx context.Context, v any) (model.SearchRequestInput, error) {
 	res, err := ec.unmarshalInputSearchRequestInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNSearchType2thailyᚋsrcᚋgraphᚋmodelᚐSearchType(ctx context.Context, v any) (model.SearchType, error) {
+	var res model.SearchType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSearchType2thailyᚋsrcᚋgraphᚋmodelᚐSearchType(ctx context.Context, sel ast.SelectionSet, v model.SearchType) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalNSemesterListResponse2thailyᚋsrcᚋgraphᚋmodelᚐSemesterListResponse(ctx context.Context, sel ast.SelectionSet, v model.SemesterListResponse) graphql.Marshaler {
 	return ec._SemesterListResponse(ctx, sel, &v)
 }
@@ -12234,6 +19883,11 @@ func (ec *executionContext) marshalNSemesterListResponse2ᚖthailyᚋsrcᚋgraph
 	return ec._SemesterListResponse(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNSortInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSortInput(ctx context.Context, v any) (*model.SortInput, error) {
+	res, err := ec.unmarshalInputSortInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalNStudentEnrollmentListResponse2thailyᚋsrcᚋgraphᚋmodelᚐStudentEnrollmentListResponse(ctx context.Context, sel ast.SelectionSet, v model.StudentEnrollmentListResponse) graphql.Marshaler {
 	return ec._StudentEnrollmentListResponse(ctx, sel, &v)
 }
@@ -12262,6 +19916,16 @@ func (ec *executionContext) marshalNStudentListResponse2ᚖthailyᚋsrcᚋgraph
 	return ec._StudentListResponse(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNSubmissionKind2thailyᚋsrcᚋgraphᚋmodelᚐSubmissionKind(ctx context.Context, v any) (model.SubmissionKind, error) {
+	var res model.SubmissionKind
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSubmissionKind2thailyᚋsrcᚋgraphᚋmodelᚐSubmissionKind(ctx context.Context, sel ast.SelectionSet, v model.SubmissionKind) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalNSupervisorTopicCouncilAssignmentListResponse2thailyᚋsrcᚋgraphᚋmodelᚐSupervisorTopicCouncilAssignmentListResponse(ctx context.Context, sel ast.SelectionSet, v model.SupervisorTopicCouncilAssignmentListResponse) graphql.Marshaler {
 	return ec._SupervisorTopicCouncilAssignmentListResponse(ctx, sel, &v)
 }
@@ -12406,6 +20070,72 @@ func (ec *executionContext) marshalOGender2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGen
 	return v
 }
 
+func (ec *executionContext) unmarshalOGradeAmendmentStatus2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeAmendmentStatus(ctx context.Context, v any) (*model.GradeAmendmentStatus, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.GradeAmendmentStatus)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOGradeAmendmentStatus2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeAmendmentStatus(ctx context.Context, sel ast.SelectionSet, v *model.GradeAmendmentStatus) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOGradeAppealStatus2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeAppealStatus(ctx context.Context, v any) (*model.GradeAppealStatus, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.GradeAppealStatus)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOGradeAppealStatus2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeAppealStatus(ctx context.Context, sel ast.SelectionSet, v *model.GradeAppealStatus) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOGradeRounding2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeRounding(ctx context.Context, v any) (*model.GradeRounding, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.GradeRounding)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOGradeRounding2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeRounding(ctx context.Context, sel ast.SelectionSet, v *model.GradeRounding) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOInt642ᚖint(ctx context.Context, v any) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt642ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalInt(*v)
+	return res
+}
+
 func (ec *executionContext) unmarshalOLogicalCondition2ᚖthailyᚋsrcᚋgraphᚋmodelᚐLogicalCondition(ctx context.Context, v any) (*model.LogicalCondition, error) {
 	if v == nil {
 		return nil, nil
@@ -12445,6 +20175,71 @@ func (ec *executionContext) unmarshalOSearchRequestInput2ᚖthailyᚋsrcᚋgraph
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalOSearchType2ᚕthailyᚋsrcᚋgraphᚋmodelᚐSearchTypeᚄ(ctx context.Context, v any) ([]model.SearchType, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]model.SearchType, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNSearchType2thailyᚋsrcᚋgraphᚋmodelᚐSearchType(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOSearchType2ᚕthailyᚋsrcᚋgraphᚋmodelᚐSearchTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []model.SearchType) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSearchType2thailyᚋsrcᚋgraphᚋmodelᚐSearchType(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalOSemesterInfo2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSemesterInfo(ctx context.Context, sel ast.SelectionSet, v *model.SemesterInfo) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -12452,6 +20247,40 @@ func (ec *executionContext) marshalOSemesterInfo2ᚖthailyᚋsrcᚋgraphᚋmodel
 	return ec._SemesterInfo(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOSortDirection2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSortDirection(ctx context.Context, v any) (*model.SortDirection, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.SortDirection)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOSortDirection2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSortDirection(ctx context.Context, sel ast.SelectionSet, v *model.SortDirection) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOSortInput2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐSortInputᚄ(ctx context.Context, v any) ([]*model.SortInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.SortInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNSortInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSortInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) unmarshalOTime2ᚖtimeᚐTime(ctx context.Context, v any) (*time.Time, error) {
 	if v == nil {
 		return nil, nil
@@ -12470,6 +20299,22 @@ func (ec *executionContext) marshalOTime2ᚖtimeᚐTime(ctx context.Context, sel
 	return res
 }
 
+func (ec *executionContext) unmarshalOTopicStage2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicStage(ctx context.Context, v any) (*model.TopicStage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.TopicStage)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOTopicStage2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicStage(ctx context.Context, sel ast.SelectionSet, v *model.TopicStage) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) unmarshalOTopicStatus2ᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicStatus(ctx context.Context, v any) (*model.TopicStatus, error) {
 	if v == nil {
 		return nil, nil
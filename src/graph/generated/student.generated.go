@@ -982,6 +982,8 @@ func (ec *executionContext) fieldContext_StudentEnrollment_midterm(_ context.Con
 				return ec.fieldContext_Midterm_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Midterm_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Midterm_version(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Midterm", field.Name)
 		},
@@ -1037,6 +1039,8 @@ func (ec *executionContext) fieldContext_StudentEnrollment_final(_ context.Conte
 				return ec.fieldContext_Final_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Final_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Final_version(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Final", field.Name)
 		},
@@ -1090,6 +1094,8 @@ func (ec *executionContext) fieldContext_StudentEnrollment_gradeReview(_ context
 				return ec.fieldContext_GradeReview_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_GradeReview_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_GradeReview_version(ctx, field)
 			case "teacher":
 				return ec.fieldContext_GradeReview_teacher(ctx, field)
 			}
@@ -1274,7 +1280,7 @@ func (ec *executionContext) _StudentGradeDefence_totalScore(ctx context.Context,
 			return obj.TotalScore, nil
 		},
 		nil,
-		ec.marshalOInt2ᚖint32,
+		ec.marshalOFloat2ᚖfloat64,
 		true,
 		false,
 	)
@@ -1287,7 +1293,7 @@ func (ec *executionContext) fieldContext_StudentGradeDefence_totalScore(_ contex
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
@@ -1381,10 +1387,16 @@ func (ec *executionContext) fieldContext_StudentGradeDefence_criteria(_ context.
 				return ec.fieldContext_GradeDefenceCriterion_gradeDefenceCode(ctx, field)
 			case "name":
 				return ec.fieldContext_GradeDefenceCriterion_name(ctx, field)
+			case "description":
+				return ec.fieldContext_GradeDefenceCriterion_description(ctx, field)
 			case "score":
 				return ec.fieldContext_GradeDefenceCriterion_score(ctx, field)
 			case "maxScore":
 				return ec.fieldContext_GradeDefenceCriterion_maxScore(ctx, field)
+			case "weight":
+				return ec.fieldContext_GradeDefenceCriterion_weight(ctx, field)
+			case "rubricCriterionCode":
+				return ec.fieldContext_GradeDefenceCriterion_rubricCriterionCode(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_GradeDefenceCriterion_createdAt(ctx, field)
 			case "updatedAt":
@@ -1393,6 +1405,8 @@ func (ec *executionContext) fieldContext_StudentGradeDefence_criteria(_ context.
 				return ec.fieldContext_GradeDefenceCriterion_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_GradeDefenceCriterion_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_GradeDefenceCriterion_version(ctx, field)
 			case "gradeDefence":
 				return ec.fieldContext_GradeDefenceCriterion_gradeDefence(ctx, field)
 			}
@@ -1793,6 +1807,64 @@ func (ec *executionContext) fieldContext_StudentTopic_percentStage2(_ context.Co
 	return fc, nil
 }
 
+func (ec *executionContext) _StudentTopic_maxStudents(ctx context.Context, field graphql.CollectedField, obj *model.StudentTopic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StudentTopic_maxStudents,
+		func(ctx context.Context) (any, error) {
+			return obj.MaxStudents, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_StudentTopic_maxStudents(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StudentTopic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StudentTopic_requiredSkills(ctx context.Context, field graphql.CollectedField, obj *model.StudentTopic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StudentTopic_requiredSkills,
+		func(ctx context.Context) (any, error) {
+			return obj.RequiredSkills, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_StudentTopic_requiredSkills(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StudentTopic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _StudentTopic_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.StudentTopic) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -1961,6 +2033,20 @@ func (ec *executionContext) fieldContext_StudentTopic_files(_ context.Context, f
 				return ec.fieldContext_File_option(ctx, field)
 			case "tableId":
 				return ec.fieldContext_File_tableId(ctx, field)
+			case "version":
+				return ec.fieldContext_File_version(ctx, field)
+			case "size":
+				return ec.fieldContext_File_size(ctx, field)
+			case "checksum":
+				return ec.fieldContext_File_checksum(ctx, field)
+			case "contentType":
+				return ec.fieldContext_File_contentType(ctx, field)
+			case "late":
+				return ec.fieldContext_File_late(ctx, field)
+			case "quarantined":
+				return ec.fieldContext_File_quarantined(ctx, field)
+			case "scanResult":
+				return ec.fieldContext_File_scanResult(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_File_createdAt(ctx, field)
 			case "updatedAt":
@@ -1969,6 +2055,10 @@ func (ec *executionContext) fieldContext_StudentTopic_files(_ context.Context, f
 				return ec.fieldContext_File_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_File_updatedBy(ctx, field)
+			case "revision":
+				return ec.fieldContext_File_revision(ctx, field)
+			case "versions":
+				return ec.fieldContext_File_versions(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
 		},
@@ -2275,6 +2365,10 @@ func (ec *executionContext) fieldContext_StudentTopicCouncil_topic(_ context.Con
 				return ec.fieldContext_StudentTopic_percentStage1(ctx, field)
 			case "percentStage2":
 				return ec.fieldContext_StudentTopic_percentStage2(ctx, field)
+			case "maxStudents":
+				return ec.fieldContext_StudentTopic_maxStudents(ctx, field)
+			case "requiredSkills":
+				return ec.fieldContext_StudentTopic_requiredSkills(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_StudentTopic_createdAt(ctx, field)
 			case "updatedAt":
@@ -2514,6 +2608,170 @@ func (ec *executionContext) fieldContext_StudentTopicSupervisor_teacher(_ contex
 
 // region    **************************** input.gotpl *****************************
 
+func (ec *executionContext) unmarshalInputFileGradeAppealInput(ctx context.Context, obj any) (model.FileGradeAppealInput, error) {
+	var it model.FileGradeAppealInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"enrollmentId", "component", "reason", "evidence"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "enrollmentId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enrollmentId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.EnrollmentID = data
+		case "component":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("component"))
+			data, err := ec.unmarshalNGradeAppealComponent2thailyᚋsrcᚋgraphᚋmodelᚐGradeAppealComponent(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Component = data
+		case "reason":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reason"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Reason = data
+		case "evidence":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("evidence"))
+			data, err := ec.unmarshalOGradeAppealEvidenceInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeAppealEvidenceInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Evidence = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputGradeAppealEvidenceInput(ctx context.Context, obj any) (model.GradeAppealEvidenceInput, error) {
+	var it model.GradeAppealEvidenceInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"title", "file"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "title":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Title = data
+		case "file":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("file"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.File = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputMilestoneAttachmentInput(ctx context.Context, obj any) (model.MilestoneAttachmentInput, error) {
+	var it model.MilestoneAttachmentInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"title", "file"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "title":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Title = data
+		case "file":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("file"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.File = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSubmitMilestoneCheckinInput(ctx context.Context, obj any) (model.SubmitMilestoneCheckinInput, error) {
+	var it model.SubmitMilestoneCheckinInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"enrollmentId", "milestoneId", "report", "attachment"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "enrollmentId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enrollmentId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.EnrollmentID = data
+		case "milestoneId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("milestoneId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MilestoneID = data
+		case "report":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("report"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Report = data
+		case "attachment":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("attachment"))
+			data, err := ec.unmarshalOMilestoneAttachmentInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐMilestoneAttachmentInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Attachment = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputUpdateStudentProfileInput(ctx context.Context, obj any) (model.UpdateStudentProfileInput, error) {
 	var it model.UpdateStudentProfileInput
 	asMap := map[string]any{}
@@ -3308,6 +3566,16 @@ func (ec *executionContext) _StudentTopic(ctx context.Context, sel ast.Selection
 			out.Values[i] = ec._StudentTopic_percentStage1(ctx, field, obj)
 		case "percentStage2":
 			out.Values[i] = ec._StudentTopic_percentStage2(ctx, field, obj)
+		case "maxStudents":
+			out.Values[i] = ec._StudentTopic_maxStudents(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "requiredSkills":
+			out.Values[i] = ec._StudentTopic_requiredSkills(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
 		case "createdAt":
 			out.Values[i] = ec._StudentTopic_createdAt(ctx, field, obj)
 		case "updatedAt":
@@ -3689,6 +3957,11 @@ func (ec *executionContext) _StudentTopicSupervisor(ctx context.Context, sel ast
 
 // region    ***************************** type.gotpl *****************************
 
+func (ec *executionContext) unmarshalNFileGradeAppealInput2thailyᚋsrcᚋgraphᚋmodelᚐFileGradeAppealInput(ctx context.Context, v any) (model.FileGradeAppealInput, error) {
+	res, err := ec.unmarshalInputFileGradeAppealInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalNStudentDefenceInfo2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentDefenceInfoᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.StudentDefenceInfo) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -3851,6 +4124,60 @@ func (ec *executionContext) marshalNStudentGradeDefence2ᚖthailyᚋsrcᚋgraph
 	return ec._StudentGradeDefence(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNStudentTopic2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentTopicᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.StudentTopic) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNStudentTopic2ᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentTopic(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNStudentTopic2ᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentTopic(ctx context.Context, sel ast.SelectionSet, v *model.StudentTopic) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._StudentTopic(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNStudentTopicSupervisor2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentTopicSupervisorᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.StudentTopicSupervisor) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -3905,6 +4232,11 @@ func (ec *executionContext) marshalNStudentTopicSupervisor2ᚖthailyᚋsrcᚋgra
 	return ec._StudentTopicSupervisor(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNSubmitMilestoneCheckinInput2thailyᚋsrcᚋgraphᚋmodelᚐSubmitMilestoneCheckinInput(ctx context.Context, v any) (model.SubmitMilestoneCheckinInput, error) {
+	res, err := ec.unmarshalInputSubmitMilestoneCheckinInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNUpdateStudentProfileInput2thailyᚋsrcᚋgraphᚋmodelᚐUpdateStudentProfileInput(ctx context.Context, v any) (model.UpdateStudentProfileInput, error) {
 	res, err := ec.unmarshalInputUpdateStudentProfileInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -3915,6 +4247,22 @@ func (ec *executionContext) unmarshalNUploadFileInput2thailyᚋsrcᚋgraphᚋmod
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalOGradeAppealEvidenceInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐGradeAppealEvidenceInput(ctx context.Context, v any) (*model.GradeAppealEvidenceInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputGradeAppealEvidenceInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOMilestoneAttachmentInput2ᚖthailyᚋsrcᚋgraphᚋmodelᚐMilestoneAttachmentInput(ctx context.Context, v any) (*model.MilestoneAttachmentInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputMilestoneAttachmentInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalOStudentCouncil2ᚖthailyᚋsrcᚋgraphᚋmodelᚐStudentCouncil(ctx context.Context, sel ast.SelectionSet, v *model.StudentCouncil) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
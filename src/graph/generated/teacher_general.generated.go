@@ -48,6 +48,7 @@ type ReviewerEnrollmentResolver interface {
 	Midterm(ctx context.Context, obj *model.ReviewerEnrollment) (*model.Midterm, error)
 	Final(ctx context.Context, obj *model.ReviewerEnrollment) (*model.Final, error)
 	GradeReview(ctx context.Context, obj *model.ReviewerEnrollment) (*model.GradeReview, error)
+	SimilarityReport(ctx context.Context, obj *model.ReviewerEnrollment, topN *int32) (*model.SimilarityReport, error)
 }
 type ReviewerGradeReviewResolver interface {
 	Enrollment(ctx context.Context, obj *model.ReviewerGradeReview) (*model.ReviewerEnrollment, error)
@@ -67,12 +68,14 @@ type SupervisorEnrollmentResolver interface {
 	Final(ctx context.Context, obj *model.SupervisorEnrollment) (*model.Final, error)
 	GradeReview(ctx context.Context, obj *model.SupervisorEnrollment) (*model.GradeReview, error)
 	GradeDefences(ctx context.Context, obj *model.SupervisorEnrollment) ([]*model.GradeDefence, error)
+	SimilarityReport(ctx context.Context, obj *model.SupervisorEnrollment, topN *int32) (*model.SimilarityReport, error)
 }
 type SupervisorTopicResolver interface {
 	Major(ctx context.Context, obj *model.SupervisorTopic) (*model.MajorInfo, error)
 	Semester(ctx context.Context, obj *model.SupervisorTopic) (*model.SemesterInfo, error)
 	Files(ctx context.Context, obj *model.SupervisorTopic) ([]*model.File, error)
 	TopicCouncils(ctx context.Context, obj *model.SupervisorTopic) ([]*model.SupervisorTopicCouncil, error)
+	StatusHistory(ctx context.Context, obj *model.SupervisorTopic) ([]*model.TopicStatusHistory, error)
 }
 type SupervisorTopicCouncilResolver interface {
 	Topic(ctx context.Context, obj *model.SupervisorTopicCouncil) (*model.SupervisorTopic, error)
@@ -88,6 +91,28 @@ type SupervisorTopicCouncilAssignmentResolver interface {
 
 // region    ***************************** args.gotpl *****************************
 
+func (ec *executionContext) field_ReviewerEnrollment_similarityReport_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "topN", ec.unmarshalOInt2ᚖint32)
+	if err != nil {
+		return nil, err
+	}
+	args["topN"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_SupervisorEnrollment_similarityReport_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "topN", ec.unmarshalOInt2ᚖint32)
+	if err != nil {
+		return nil, err
+	}
+	args["topN"] = arg0
+	return args, nil
+}
+
 // endregion ***************************** args.gotpl *****************************
 
 // region    ************************** directives.gotpl **************************
@@ -400,6 +425,12 @@ func (ec *executionContext) fieldContext_CouncilDefence_teacher(_ context.Contex
 				return ec.fieldContext_Teacher_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Teacher_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Teacher_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Teacher_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Teacher_deletedBy(ctx, field)
 			case "roles":
 				return ec.fieldContext_Teacher_roles(ctx, field)
 			}
@@ -443,6 +474,8 @@ func (ec *executionContext) fieldContext_CouncilDefence_gradeDefences(_ context.
 				return ec.fieldContext_GradeDefence_note(ctx, field)
 			case "totalScore":
 				return ec.fieldContext_GradeDefence_totalScore(ctx, field)
+			case "rubricTemplateCode":
+				return ec.fieldContext_GradeDefence_rubricTemplateCode(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_GradeDefence_createdAt(ctx, field)
 			case "updatedAt":
@@ -451,6 +484,8 @@ func (ec *executionContext) fieldContext_CouncilDefence_gradeDefences(_ context.
 				return ec.fieldContext_GradeDefence_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_GradeDefence_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_GradeDefence_version(ctx, field)
 			case "defence":
 				return ec.fieldContext_GradeDefence_defence(ctx, field)
 			case "enrollment":
@@ -773,6 +808,12 @@ func (ec *executionContext) fieldContext_CouncilEnrollment_student(_ context.Con
 				return ec.fieldContext_Student_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Student_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Student_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Student_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Student_deletedBy(ctx, field)
 			case "enrollments":
 				return ec.fieldContext_Student_enrollments(ctx, field)
 			}
@@ -881,6 +922,8 @@ func (ec *executionContext) fieldContext_CouncilEnrollment_midterm(_ context.Con
 				return ec.fieldContext_Midterm_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Midterm_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Midterm_version(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Midterm", field.Name)
 		},
@@ -936,6 +979,8 @@ func (ec *executionContext) fieldContext_CouncilEnrollment_final(_ context.Conte
 				return ec.fieldContext_Final_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Final_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Final_version(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Final", field.Name)
 		},
@@ -989,6 +1034,8 @@ func (ec *executionContext) fieldContext_CouncilEnrollment_gradeReview(_ context
 				return ec.fieldContext_GradeReview_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_GradeReview_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_GradeReview_version(ctx, field)
 			case "teacher":
 				return ec.fieldContext_GradeReview_teacher(ctx, field)
 			}
@@ -1032,6 +1079,8 @@ func (ec *executionContext) fieldContext_CouncilEnrollment_gradeDefences(_ conte
 				return ec.fieldContext_GradeDefence_note(ctx, field)
 			case "totalScore":
 				return ec.fieldContext_GradeDefence_totalScore(ctx, field)
+			case "rubricTemplateCode":
+				return ec.fieldContext_GradeDefence_rubricTemplateCode(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_GradeDefence_createdAt(ctx, field)
 			case "updatedAt":
@@ -1040,6 +1089,8 @@ func (ec *executionContext) fieldContext_CouncilEnrollment_gradeDefences(_ conte
 				return ec.fieldContext_GradeDefence_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_GradeDefence_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_GradeDefence_version(ctx, field)
 			case "defence":
 				return ec.fieldContext_GradeDefence_defence(ctx, field)
 			case "enrollment":
@@ -1795,6 +1846,10 @@ func (ec *executionContext) fieldContext_CouncilTopicCouncil_topic(_ context.Con
 				return ec.fieldContext_Topic_percentStage1(ctx, field)
 			case "percentStage2":
 				return ec.fieldContext_Topic_percentStage2(ctx, field)
+			case "maxStudents":
+				return ec.fieldContext_Topic_maxStudents(ctx, field)
+			case "requiredSkills":
+				return ec.fieldContext_Topic_requiredSkills(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_Topic_createdAt(ctx, field)
 			case "updatedAt":
@@ -1803,10 +1858,18 @@ func (ec *executionContext) fieldContext_CouncilTopicCouncil_topic(_ context.Con
 				return ec.fieldContext_Topic_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Topic_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Topic_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Topic_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Topic_deletedBy(ctx, field)
 			case "files":
 				return ec.fieldContext_Topic_files(ctx, field)
 			case "topicCouncils":
 				return ec.fieldContext_Topic_topicCouncils(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Topic_statusHistory(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Topic", field.Name)
 		},
@@ -1970,6 +2033,8 @@ func (ec *executionContext) fieldContext_CouncilTopicCouncil_supervisors(_ conte
 				return ec.fieldContext_TopicCouncilSupervisor_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_TopicCouncilSupervisor_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_TopicCouncilSupervisor_version(ctx, field)
 			case "teacher":
 				return ec.fieldContext_TopicCouncilSupervisor_teacher(ctx, field)
 			case "topicCouncil":
@@ -2290,6 +2355,12 @@ func (ec *executionContext) fieldContext_ReviewerEnrollment_student(_ context.Co
 				return ec.fieldContext_Student_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Student_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Student_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Student_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Student_deletedBy(ctx, field)
 			case "enrollments":
 				return ec.fieldContext_Student_enrollments(ctx, field)
 			}
@@ -2388,6 +2459,8 @@ func (ec *executionContext) fieldContext_ReviewerEnrollment_midterm(_ context.Co
 				return ec.fieldContext_Midterm_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Midterm_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Midterm_version(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Midterm", field.Name)
 		},
@@ -2443,6 +2516,8 @@ func (ec *executionContext) fieldContext_ReviewerEnrollment_final(_ context.Cont
 				return ec.fieldContext_Final_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Final_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Final_version(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Final", field.Name)
 		},
@@ -2496,6 +2571,8 @@ func (ec *executionContext) fieldContext_ReviewerEnrollment_gradeReview(_ contex
 				return ec.fieldContext_GradeReview_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_GradeReview_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_GradeReview_version(ctx, field)
 			case "teacher":
 				return ec.fieldContext_GradeReview_teacher(ctx, field)
 			}
@@ -2505,6 +2582,59 @@ func (ec *executionContext) fieldContext_ReviewerEnrollment_gradeReview(_ contex
 	return fc, nil
 }
 
+func (ec *executionContext) _ReviewerEnrollment_similarityReport(ctx context.Context, field graphql.CollectedField, obj *model.ReviewerEnrollment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ReviewerEnrollment_similarityReport,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.ReviewerEnrollment().SimilarityReport(ctx, obj, fc.Args["topN"].(*int32))
+		},
+		nil,
+		ec.marshalOSimilarityReport2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSimilarityReport,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ReviewerEnrollment_similarityReport(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReviewerEnrollment",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "file":
+				return ec.fieldContext_SimilarityReport_file(ctx, field)
+			case "indexed":
+				return ec.fieldContext_SimilarityReport_indexed(ctx, field)
+			case "wordCount":
+				return ec.fieldContext_SimilarityReport_wordCount(ctx, field)
+			case "indexedAt":
+				return ec.fieldContext_SimilarityReport_indexedAt(ctx, field)
+			case "matches":
+				return ec.fieldContext_SimilarityReport_matches(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SimilarityReport", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_ReviewerEnrollment_similarityReport_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _ReviewerGradeReview_id(ctx context.Context, field graphql.CollectedField, obj *model.ReviewerGradeReview) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -2818,6 +2948,8 @@ func (ec *executionContext) fieldContext_ReviewerGradeReview_enrollment(_ contex
 				return ec.fieldContext_ReviewerEnrollment_final(ctx, field)
 			case "gradeReview":
 				return ec.fieldContext_ReviewerEnrollment_gradeReview(ctx, field)
+			case "similarityReport":
+				return ec.fieldContext_ReviewerEnrollment_similarityReport(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type ReviewerEnrollment", field.Name)
 		},
@@ -3016,6 +3148,20 @@ func (ec *executionContext) fieldContext_ReviewerTopic_files(_ context.Context,
 				return ec.fieldContext_File_option(ctx, field)
 			case "tableId":
 				return ec.fieldContext_File_tableId(ctx, field)
+			case "version":
+				return ec.fieldContext_File_version(ctx, field)
+			case "size":
+				return ec.fieldContext_File_size(ctx, field)
+			case "checksum":
+				return ec.fieldContext_File_checksum(ctx, field)
+			case "contentType":
+				return ec.fieldContext_File_contentType(ctx, field)
+			case "late":
+				return ec.fieldContext_File_late(ctx, field)
+			case "quarantined":
+				return ec.fieldContext_File_quarantined(ctx, field)
+			case "scanResult":
+				return ec.fieldContext_File_scanResult(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_File_createdAt(ctx, field)
 			case "updatedAt":
@@ -3024,6 +3170,10 @@ func (ec *executionContext) fieldContext_ReviewerTopic_files(_ context.Context,
 				return ec.fieldContext_File_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_File_updatedBy(ctx, field)
+			case "revision":
+				return ec.fieldContext_File_revision(ctx, field)
+			case "versions":
+				return ec.fieldContext_File_versions(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
 		},
@@ -3286,6 +3436,8 @@ func (ec *executionContext) fieldContext_ReviewerTopicCouncil_supervisors(_ cont
 				return ec.fieldContext_TopicCouncilSupervisor_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_TopicCouncilSupervisor_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_TopicCouncilSupervisor_version(ctx, field)
 			case "teacher":
 				return ec.fieldContext_TopicCouncilSupervisor_teacher(ctx, field)
 			case "topicCouncil":
@@ -3664,6 +3816,12 @@ func (ec *executionContext) fieldContext_SupervisorEnrollment_student(_ context.
 				return ec.fieldContext_Student_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Student_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Student_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Student_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Student_deletedBy(ctx, field)
 			case "enrollments":
 				return ec.fieldContext_Student_enrollments(ctx, field)
 			}
@@ -3772,6 +3930,8 @@ func (ec *executionContext) fieldContext_SupervisorEnrollment_midterm(_ context.
 				return ec.fieldContext_Midterm_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Midterm_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Midterm_version(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Midterm", field.Name)
 		},
@@ -3827,6 +3987,8 @@ func (ec *executionContext) fieldContext_SupervisorEnrollment_final(_ context.Co
 				return ec.fieldContext_Final_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Final_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Final_version(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Final", field.Name)
 		},
@@ -3880,6 +4042,8 @@ func (ec *executionContext) fieldContext_SupervisorEnrollment_gradeReview(_ cont
 				return ec.fieldContext_GradeReview_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_GradeReview_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_GradeReview_version(ctx, field)
 			case "teacher":
 				return ec.fieldContext_GradeReview_teacher(ctx, field)
 			}
@@ -3923,6 +4087,8 @@ func (ec *executionContext) fieldContext_SupervisorEnrollment_gradeDefences(_ co
 				return ec.fieldContext_GradeDefence_note(ctx, field)
 			case "totalScore":
 				return ec.fieldContext_GradeDefence_totalScore(ctx, field)
+			case "rubricTemplateCode":
+				return ec.fieldContext_GradeDefence_rubricTemplateCode(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_GradeDefence_createdAt(ctx, field)
 			case "updatedAt":
@@ -3931,6 +4097,8 @@ func (ec *executionContext) fieldContext_SupervisorEnrollment_gradeDefences(_ co
 				return ec.fieldContext_GradeDefence_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_GradeDefence_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_GradeDefence_version(ctx, field)
 			case "defence":
 				return ec.fieldContext_GradeDefence_defence(ctx, field)
 			case "enrollment":
@@ -3944,6 +4112,59 @@ func (ec *executionContext) fieldContext_SupervisorEnrollment_gradeDefences(_ co
 	return fc, nil
 }
 
+func (ec *executionContext) _SupervisorEnrollment_similarityReport(ctx context.Context, field graphql.CollectedField, obj *model.SupervisorEnrollment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SupervisorEnrollment_similarityReport,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.SupervisorEnrollment().SimilarityReport(ctx, obj, fc.Args["topN"].(*int32))
+		},
+		nil,
+		ec.marshalOSimilarityReport2ᚖthailyᚋsrcᚋgraphᚋmodelᚐSimilarityReport,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SupervisorEnrollment_similarityReport(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SupervisorEnrollment",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "file":
+				return ec.fieldContext_SimilarityReport_file(ctx, field)
+			case "indexed":
+				return ec.fieldContext_SimilarityReport_indexed(ctx, field)
+			case "wordCount":
+				return ec.fieldContext_SimilarityReport_wordCount(ctx, field)
+			case "indexedAt":
+				return ec.fieldContext_SimilarityReport_indexedAt(ctx, field)
+			case "matches":
+				return ec.fieldContext_SimilarityReport_matches(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SimilarityReport", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_SupervisorEnrollment_similarityReport_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _SupervisorTopic_id(ctx context.Context, field graphql.CollectedField, obj *model.SupervisorTopic) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -4147,6 +4368,64 @@ func (ec *executionContext) fieldContext_SupervisorTopic_percentStage2(_ context
 	return fc, nil
 }
 
+func (ec *executionContext) _SupervisorTopic_maxStudents(ctx context.Context, field graphql.CollectedField, obj *model.SupervisorTopic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SupervisorTopic_maxStudents,
+		func(ctx context.Context) (any, error) {
+			return obj.MaxStudents, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SupervisorTopic_maxStudents(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SupervisorTopic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SupervisorTopic_requiredSkills(ctx context.Context, field graphql.CollectedField, obj *model.SupervisorTopic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SupervisorTopic_requiredSkills,
+		func(ctx context.Context) (any, error) {
+			return obj.RequiredSkills, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SupervisorTopic_requiredSkills(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SupervisorTopic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _SupervisorTopic_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.SupervisorTopic) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -4373,6 +4652,20 @@ func (ec *executionContext) fieldContext_SupervisorTopic_files(_ context.Context
 				return ec.fieldContext_File_option(ctx, field)
 			case "tableId":
 				return ec.fieldContext_File_tableId(ctx, field)
+			case "version":
+				return ec.fieldContext_File_version(ctx, field)
+			case "size":
+				return ec.fieldContext_File_size(ctx, field)
+			case "checksum":
+				return ec.fieldContext_File_checksum(ctx, field)
+			case "contentType":
+				return ec.fieldContext_File_contentType(ctx, field)
+			case "late":
+				return ec.fieldContext_File_late(ctx, field)
+			case "quarantined":
+				return ec.fieldContext_File_quarantined(ctx, field)
+			case "scanResult":
+				return ec.fieldContext_File_scanResult(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_File_createdAt(ctx, field)
 			case "updatedAt":
@@ -4381,6 +4674,10 @@ func (ec *executionContext) fieldContext_SupervisorTopic_files(_ context.Context
 				return ec.fieldContext_File_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_File_updatedBy(ctx, field)
+			case "revision":
+				return ec.fieldContext_File_revision(ctx, field)
+			case "versions":
+				return ec.fieldContext_File_versions(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type File", field.Name)
 		},
@@ -4445,6 +4742,51 @@ func (ec *executionContext) fieldContext_SupervisorTopic_topicCouncils(_ context
 	return fc, nil
 }
 
+func (ec *executionContext) _SupervisorTopic_statusHistory(ctx context.Context, field graphql.CollectedField, obj *model.SupervisorTopic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SupervisorTopic_statusHistory,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.SupervisorTopic().StatusHistory(ctx, obj)
+		},
+		nil,
+		ec.marshalNTopicStatusHistory2ᚕᚖthailyᚋsrcᚋgraphᚋmodelᚐTopicStatusHistoryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SupervisorTopic_statusHistory(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SupervisorTopic",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TopicStatusHistory_id(ctx, field)
+			case "topicCode":
+				return ec.fieldContext_TopicStatusHistory_topicCode(ctx, field)
+			case "fromStatus":
+				return ec.fieldContext_TopicStatusHistory_fromStatus(ctx, field)
+			case "toStatus":
+				return ec.fieldContext_TopicStatusHistory_toStatus(ctx, field)
+			case "reason":
+				return ec.fieldContext_TopicStatusHistory_reason(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_TopicStatusHistory_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TopicStatusHistory_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TopicStatusHistory", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _SupervisorTopicCouncil_id(ctx context.Context, field graphql.CollectedField, obj *model.SupervisorTopicCouncil) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -4744,6 +5086,10 @@ func (ec *executionContext) fieldContext_SupervisorTopicCouncil_topic(_ context.
 				return ec.fieldContext_SupervisorTopic_percentStage1(ctx, field)
 			case "percentStage2":
 				return ec.fieldContext_SupervisorTopic_percentStage2(ctx, field)
+			case "maxStudents":
+				return ec.fieldContext_SupervisorTopic_maxStudents(ctx, field)
+			case "requiredSkills":
+				return ec.fieldContext_SupervisorTopic_requiredSkills(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_SupervisorTopic_createdAt(ctx, field)
 			case "updatedAt":
@@ -4760,6 +5106,8 @@ func (ec *executionContext) fieldContext_SupervisorTopicCouncil_topic(_ context.
 				return ec.fieldContext_SupervisorTopic_files(ctx, field)
 			case "topicCouncils":
 				return ec.fieldContext_SupervisorTopic_topicCouncils(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_SupervisorTopic_statusHistory(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type SupervisorTopic", field.Name)
 		},
@@ -4803,6 +5151,16 @@ func (ec *executionContext) fieldContext_SupervisorTopicCouncil_council(_ contex
 				return ec.fieldContext_Council_semesterCode(ctx, field)
 			case "timeStart":
 				return ec.fieldContext_Council_timeStart(ctx, field)
+			case "room":
+				return ec.fieldContext_Council_room(ctx, field)
+			case "gradesLocked":
+				return ec.fieldContext_Council_gradesLocked(ctx, field)
+			case "gradesLockedAt":
+				return ec.fieldContext_Council_gradesLockedAt(ctx, field)
+			case "gradesPublished":
+				return ec.fieldContext_Council_gradesPublished(ctx, field)
+			case "gradesPublishedAt":
+				return ec.fieldContext_Council_gradesPublishedAt(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_Council_createdAt(ctx, field)
 			case "updatedAt":
@@ -4811,6 +5169,12 @@ func (ec *executionContext) fieldContext_SupervisorTopicCouncil_council(_ contex
 				return ec.fieldContext_Council_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_Council_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_Council_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Council_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_Council_deletedBy(ctx, field)
 			case "defences":
 				return ec.fieldContext_Council_defences(ctx, field)
 			case "topicCouncils":
@@ -4880,6 +5244,8 @@ func (ec *executionContext) fieldContext_SupervisorTopicCouncil_enrollments(_ co
 				return ec.fieldContext_SupervisorEnrollment_gradeReview(ctx, field)
 			case "gradeDefences":
 				return ec.fieldContext_SupervisorEnrollment_gradeDefences(ctx, field)
+			case "similarityReport":
+				return ec.fieldContext_SupervisorEnrollment_similarityReport(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type SupervisorEnrollment", field.Name)
 		},
@@ -4925,6 +5291,8 @@ func (ec *executionContext) fieldContext_SupervisorTopicCouncil_supervisors(_ co
 				return ec.fieldContext_TopicCouncilSupervisor_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_TopicCouncilSupervisor_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_TopicCouncilSupervisor_version(ctx, field)
 			case "teacher":
 				return ec.fieldContext_TopicCouncilSupervisor_teacher(ctx, field)
 			case "topicCouncil":
@@ -5149,7 +5517,7 @@ func (ec *executionContext) unmarshalInputCreateGradeDefenceCriterionInput(ctx c
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"gradeDefenceCode", "name", "score", "maxScore"}
+	fieldsInOrder := [...]string{"gradeDefenceCode", "name", "description", "score", "maxScore", "weight"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -5170,20 +5538,34 @@ func (ec *executionContext) unmarshalInputCreateGradeDefenceCriterionInput(ctx c
 				return it, err
 			}
 			it.Name = data
+		case "description":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Description = data
 		case "score":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("score"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.Score = data
 		case "maxScore":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxScore"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.MaxScore = data
+		case "weight":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("weight"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Weight = data
 		}
 	}
 
@@ -5197,7 +5579,7 @@ func (ec *executionContext) unmarshalInputCreateGradeDefenceInput(ctx context.Co
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"defenceCode", "enrollmentCode", "note", "totalScore"}
+	fieldsInOrder := [...]string{"defenceCode", "enrollmentCode", "note"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -5225,13 +5607,6 @@ func (ec *executionContext) unmarshalInputCreateGradeDefenceInput(ctx context.Co
 				return it, err
 			}
 			it.Note = data
-		case "totalScore":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("totalScore"))
-			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.TotalScore = data
 		}
 	}
 
@@ -5279,41 +5654,227 @@ func (ec *executionContext) unmarshalInputGradeFinalInput(ctx context.Context, o
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputGradeMidtermInput(ctx context.Context, obj any) (model.GradeMidtermInput, error) {
-	var it model.GradeMidtermInput
+func (ec *executionContext) unmarshalInputGrantDeadlineExtensionInput(ctx context.Context, obj any) (model.GrantDeadlineExtensionInput, error) {
+	var it model.GrantDeadlineExtensionInput
 	asMap := map[string]any{}
 	for k, v := range obj.(map[string]any) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"grade", "status", "feedback"}
+	fieldsInOrder := [...]string{"studentCode", "kind", "dueAt", "reason"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "grade":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("grade"))
-			data, err := ec.unmarshalNInt2int32(ctx, v)
+		case "studentCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("studentCode"))
+			data, err := ec.unmarshalNID2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Grade = data
-		case "status":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
-			data, err := ec.unmarshalNMidtermStatus2thailyᚋsrcᚋgraphᚋmodelᚐMidtermStatus(ctx, v)
+			it.StudentCode = data
+		case "kind":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("kind"))
+			data, err := ec.unmarshalNSubmissionKind2thailyᚋsrcᚋgraphᚋmodelᚐSubmissionKind(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Status = data
-		case "feedback":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("feedback"))
+			it.Kind = data
+		case "dueAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dueAt"))
+			data, err := ec.unmarshalNTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DueAt = data
+		case "reason":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reason"))
 			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Feedback = data
+			it.Reason = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputProposeTopicInput(ctx context.Context, obj any) (model.ProposeTopicInput, error) {
+	var it model.ProposeTopicInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"title", "majorCode", "stage", "maxStudents", "requiredSkills", "coSupervisorCodes", "timeStart", "timeEnd"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "title":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Title = data
+		case "majorCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("majorCode"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MajorCode = data
+		case "stage":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stage"))
+			data, err := ec.unmarshalNTopicStage2thailyᚋsrcᚋgraphᚋmodelᚐTopicStage(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Stage = data
+		case "maxStudents":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxStudents"))
+			data, err := ec.unmarshalNInt2int32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MaxStudents = data
+		case "requiredSkills":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requiredSkills"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RequiredSkills = data
+		case "coSupervisorCodes":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("coSupervisorCodes"))
+			data, err := ec.unmarshalOID2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CoSupervisorCodes = data
+		case "timeStart":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("timeStart"))
+			data, err := ec.unmarshalNTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TimeStart = data
+		case "timeEnd":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("timeEnd"))
+			data, err := ec.unmarshalNTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TimeEnd = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRequestGradeAmendmentInput(ctx context.Context, obj any) (model.RequestGradeAmendmentInput, error) {
+	var it model.RequestGradeAmendmentInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"target", "targetId", "newValue", "reason"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "target":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("target"))
+			data, err := ec.unmarshalNGradeAmendmentTarget2thailyᚋsrcᚋgraphᚋmodelᚐGradeAmendmentTarget(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Target = data
+		case "targetId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("targetId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TargetID = data
+		case "newValue":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("newValue"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NewValue = data
+		case "reason":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reason"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Reason = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputResolveGradeAppealInput(ctx context.Context, obj any) (model.ResolveGradeAppealInput, error) {
+	var it model.ResolveGradeAppealInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"id", "amend", "note", "newValue", "criterionId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ID = data
+		case "amend":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amend"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Amend = data
+		case "note":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("note"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Note = data
+		case "newValue":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("newValue"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NewValue = data
+		case "criterionId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("criterionId"))
+			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CriterionID = data
 		}
 	}
 
@@ -5327,7 +5888,7 @@ func (ec *executionContext) unmarshalInputUpdateGradeDefenceCriterionInput(ctx c
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"name", "score", "maxScore"}
+	fieldsInOrder := [...]string{"name", "description", "score", "maxScore", "weight", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -5341,20 +5902,41 @@ func (ec *executionContext) unmarshalInputUpdateGradeDefenceCriterionInput(ctx c
 				return it, err
 			}
 			it.Name = data
+		case "description":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Description = data
 		case "score":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("score"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.Score = data
 		case "maxScore":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxScore"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.MaxScore = data
+		case "weight":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("weight"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Weight = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -5368,7 +5950,7 @@ func (ec *executionContext) unmarshalInputUpdateGradeDefenceInput(ctx context.Co
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"note", "totalScore"}
+	fieldsInOrder := [...]string{"note", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -5382,13 +5964,13 @@ func (ec *executionContext) unmarshalInputUpdateGradeDefenceInput(ctx context.Co
 				return it, err
 			}
 			it.Note = data
-		case "totalScore":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("totalScore"))
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
 			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.TotalScore = data
+			it.Version = data
 		}
 	}
 
@@ -5402,7 +5984,7 @@ func (ec *executionContext) unmarshalInputUpdateGradeReviewInput(ctx context.Con
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"reviewGrade", "status", "notes"}
+	fieldsInOrder := [...]string{"reviewGrade", "status", "notes", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -5430,6 +6012,13 @@ func (ec *executionContext) unmarshalInputUpdateGradeReviewInput(ctx context.Con
 				return it, err
 			}
 			it.Notes = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -6505,6 +7094,39 @@ func (ec *executionContext) _ReviewerEnrollment(ctx context.Context, sel ast.Sel
 				continue
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "similarityReport":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ReviewerEnrollment_similarityReport(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -7118,6 +7740,39 @@ func (ec *executionContext) _SupervisorEnrollment(ctx context.Context, sel ast.S
 				continue
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "similarityReport":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SupervisorEnrollment_similarityReport(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -7182,6 +7837,16 @@ func (ec *executionContext) _SupervisorTopic(ctx context.Context, sel ast.Select
 			out.Values[i] = ec._SupervisorTopic_percentStage1(ctx, field, obj)
 		case "percentStage2":
 			out.Values[i] = ec._SupervisorTopic_percentStage2(ctx, field, obj)
+		case "maxStudents":
+			out.Values[i] = ec._SupervisorTopic_maxStudents(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "requiredSkills":
+			out.Values[i] = ec._SupervisorTopic_requiredSkills(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
 		case "createdAt":
 			out.Values[i] = ec._SupervisorTopic_createdAt(ctx, field, obj)
 		case "updatedAt":
@@ -7321,6 +7986,42 @@ func (ec *executionContext) _SupervisorTopic(ctx context.Context, sel ast.Select
 				continue
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "statusHistory":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SupervisorTopic_statusHistory(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -7814,8 +8515,23 @@ func (ec *executionContext) unmarshalNGradeFinalInput2thailyᚋsrcᚋgraphᚋmod
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalNGradeMidtermInput2thailyᚋsrcᚋgraphᚋmodelᚐGradeMidtermInput(ctx context.Context, v any) (model.GradeMidtermInput, error) {
-	res, err := ec.unmarshalInputGradeMidtermInput(ctx, v)
+func (ec *executionContext) unmarshalNGrantDeadlineExtensionInput2thailyᚋsrcᚋgraphᚋmodelᚐGrantDeadlineExtensionInput(ctx context.Context, v any) (model.GrantDeadlineExtensionInput, error) {
+	res, err := ec.unmarshalInputGrantDeadlineExtensionInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNProposeTopicInput2thailyᚋsrcᚋgraphᚋmodelᚐProposeTopicInput(ctx context.Context, v any) (model.ProposeTopicInput, error) {
+	res, err := ec.unmarshalInputProposeTopicInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNRequestGradeAmendmentInput2thailyᚋsrcᚋgraphᚋmodelᚐRequestGradeAmendmentInput(ctx context.Context, v any) (model.RequestGradeAmendmentInput, error) {
+	res, err := ec.unmarshalInputRequestGradeAmendmentInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNResolveGradeAppealInput2thailyᚋsrcᚋgraphᚋmodelᚐResolveGradeAppealInput(ctx context.Context, v any) (model.ResolveGradeAppealInput, error) {
+	res, err := ec.unmarshalInputResolveGradeAppealInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
@@ -10,19 +10,84 @@ import (
 	"time"
 )
 
+// Xếp hạng sinh viên đăng ký của một đề tài
+type ApplicantRanking struct {
+	TopicCode    string   `json:"topicCode"`
+	StudentCodes []string `json:"studentCodes"`
+	// Chỉ sinh viên trong danh sách mới được ghép
+	Restricted bool `json:"restricted"`
+}
+
+// Giá trị trước và sau của một cột; null khi bản ghi chưa có / đã bị xóa hẳn
+type AuditChange struct {
+	Field  string  `json:"field"`
+	Before *string `json:"before,omitempty"`
+	After  *string `json:"after,omitempty"`
+}
+
+// Một thao tác thay đổi dữ liệu đã được ghi vào nhật ký (không sửa, không xóa được)
+type AuditLogEntry struct {
+	ID      string    `json:"id"`
+	At      time.Time `json:"at"`
+	Service string    `json:"service"`
+	// Tên RPC, vd. UpdateGradeDefence
+	Method   string  `json:"method"`
+	Entity   string  `json:"entity"`
+	EntityID *string `json:"entityId,omitempty"`
+	// Người thực hiện (id của người dùng trong học kỳ)
+	Actor     *string `json:"actor,omitempty"`
+	Role      *string `json:"role,omitempty"`
+	Semester  *string `json:"semester,omitempty"`
+	RequestID *string `json:"requestId,omitempty"`
+	ClientIP  *string `json:"clientIp,omitempty"`
+	// Các cột đã thay đổi, giá trị trước và sau
+	Changes []*AuditChange `json:"changes"`
+}
+
 type Council struct {
-	Total         *int32          `json:"total,omitempty"`
-	ID            string          `json:"id"`
-	Title         string          `json:"title"`
-	MajorCode     string          `json:"majorCode"`
-	SemesterCode  string          `json:"semesterCode"`
-	TimeStart     *time.Time      `json:"timeStart,omitempty"`
-	CreatedAt     *time.Time      `json:"createdAt,omitempty"`
-	UpdatedAt     *time.Time      `json:"updatedAt,omitempty"`
-	CreatedBy     *string         `json:"createdBy,omitempty"`
-	UpdatedBy     *string         `json:"updatedBy,omitempty"`
-	Defences      []*Defence      `json:"defences,omitempty"`
-	TopicCouncils []*TopicCouncil `json:"topicCouncils,omitempty"`
+	Total        *int32     `json:"total,omitempty"`
+	ID           string     `json:"id"`
+	Title        string     `json:"title"`
+	MajorCode    string     `json:"majorCode"`
+	SemesterCode string     `json:"semesterCode"`
+	TimeStart    *time.Time `json:"timeStart,omitempty"`
+	Room         *string    `json:"room,omitempty"`
+	// Đã khóa điểm: chỉ sửa qua yêu cầu sửa điểm được duyệt
+	GradesLocked   bool       `json:"gradesLocked"`
+	GradesLockedAt *time.Time `json:"gradesLockedAt,omitempty"`
+	// Đã công bố điểm cho sinh viên
+	GradesPublished   bool            `json:"gradesPublished"`
+	GradesPublishedAt *time.Time      `json:"gradesPublishedAt,omitempty"`
+	CreatedAt         *time.Time      `json:"createdAt,omitempty"`
+	UpdatedAt         *time.Time      `json:"updatedAt,omitempty"`
+	CreatedBy         *string         `json:"createdBy,omitempty"`
+	UpdatedBy         *string         `json:"updatedBy,omitempty"`
+	Version           int32           `json:"version"`
+	DeletedAt         *time.Time      `json:"deletedAt,omitempty"`
+	DeletedBy         *string         `json:"deletedBy,omitempty"`
+	Defences          []*Defence      `json:"defences,omitempty"`
+	TopicCouncils     []*TopicCouncil `json:"topicCouncils,omitempty"`
+}
+
+type CouncilConflict struct {
+	Kind             CouncilConflictKind `json:"kind"`
+	TeacherCode      *string             `json:"teacherCode,omitempty"`
+	TopicCouncilCode *string             `json:"topicCouncilCode,omitempty"`
+	Message          string              `json:"message"`
+	// Đã được bỏ qua kèm lý do
+	Overridden bool `json:"overridden"`
+}
+
+type CouncilConflictOverride struct {
+	ID               string              `json:"id"`
+	CouncilCode      string              `json:"councilCode"`
+	Kind             CouncilConflictKind `json:"kind"`
+	TeacherCode      *string             `json:"teacherCode,omitempty"`
+	TopicCouncilCode *string             `json:"topicCouncilCode,omitempty"`
+	Message          string              `json:"message"`
+	Reason           string              `json:"reason"`
+	CreatedAt        *time.Time          `json:"createdAt,omitempty"`
+	CreatedBy        string              `json:"createdBy"`
 }
 
 // Defence view cho Council Member
@@ -113,6 +178,13 @@ type CouncilTopicCouncilListResponse struct {
 	Data  []*CouncilTopicCouncil `json:"data"`
 }
 
+type CouncilValidation struct {
+	CouncilCode string `json:"councilCode"`
+	// Không còn xung đột nào chưa được bỏ qua
+	Valid     bool               `json:"valid"`
+	Conflicts []*CouncilConflict `json:"conflicts"`
+}
+
 type CreateCouncilInput struct {
 	Title        string `json:"title"`
 	MajorCode    string `json:"majorCode"`
@@ -124,6 +196,8 @@ type CreateDefenceInput struct {
 	CouncilCode string          `json:"councilCode"`
 	TeacherCode string          `json:"teacherCode"`
 	Position    DefencePosition `json:"position"`
+	// Lý do thêm thành viên dù có xung đột lợi ích
+	OverrideReason *string `json:"overrideReason,omitempty"`
 }
 
 type CreateFacultyInput struct {
@@ -132,17 +206,19 @@ type CreateFacultyInput struct {
 }
 
 type CreateGradeDefenceCriterionInput struct {
-	GradeDefenceCode string `json:"gradeDefenceCode"`
-	Name             string `json:"name"`
-	Score            string `json:"score"`
-	MaxScore         string `json:"maxScore"`
+	GradeDefenceCode string   `json:"gradeDefenceCode"`
+	Name             string   `json:"name"`
+	Description      *string  `json:"description,omitempty"`
+	Score            *float64 `json:"score,omitempty"`
+	MaxScore         float64  `json:"maxScore"`
+	// Mặc định 1
+	Weight *float64 `json:"weight,omitempty"`
 }
 
 type CreateGradeDefenceInput struct {
 	DefenceCode    string  `json:"defenceCode"`
 	EnrollmentCode string  `json:"enrollmentCode"`
 	Note           *string `json:"note,omitempty"`
-	TotalScore     *int32  `json:"totalScore,omitempty"`
 }
 
 type CreateMajorInput struct {
@@ -151,6 +227,22 @@ type CreateMajorInput struct {
 	FacultyCode string `json:"facultyCode"`
 }
 
+type CreateMidtermMilestoneInput struct {
+	SemesterCode string     `json:"semesterCode"`
+	Stage        TopicStage `json:"stage"`
+	Title        string     `json:"title"`
+	Description  *string    `json:"description,omitempty"`
+	Sequence     int32      `json:"sequence"`
+	DueAt        *time.Time `json:"dueAt,omitempty"`
+}
+
+type CreateRubricTemplateInput struct {
+	Title     string                  `json:"title"`
+	MajorCode string                  `json:"majorCode"`
+	Stage     TopicStage              `json:"stage"`
+	Criteria  []*RubricCriterionInput `json:"criteria"`
+}
+
 type CreateSemesterInput struct {
 	ID    string `json:"id"`
 	Title string `json:"title"`
@@ -176,6 +268,28 @@ type CreateTeacherInput struct {
 	SemesterCode string `json:"semesterCode"`
 }
 
+// Gia hạn nộp bài cho một sinh viên (do giáo viên hướng dẫn cấp)
+type DeadlineExtension struct {
+	ID           string         `json:"id"`
+	SemesterCode string         `json:"semesterCode"`
+	Stage        TopicStage     `json:"stage"`
+	Kind         SubmissionKind `json:"kind"`
+	StudentCode  string         `json:"studentCode"`
+	DueAt        time.Time      `json:"dueAt"`
+	Reason       *string        `json:"reason,omitempty"`
+	CreatedAt    *time.Time     `json:"createdAt,omitempty"`
+	UpdatedAt    *time.Time     `json:"updatedAt,omitempty"`
+	CreatedBy    *string        `json:"createdBy,omitempty"`
+	UpdatedBy    *string        `json:"updatedBy,omitempty"`
+}
+
+type DecideGradeAmendmentInput struct {
+	ID      string               `json:"id"`
+	Target  GradeAmendmentTarget `json:"target"`
+	Approve bool                 `json:"approve"`
+	Note    *string              `json:"note,omitempty"`
+}
+
 type Defence struct {
 	ID            string          `json:"id"`
 	Title         string          `json:"title"`
@@ -186,6 +300,7 @@ type Defence struct {
 	UpdatedAt     *time.Time      `json:"updatedAt,omitempty"`
 	CreatedBy     *string         `json:"createdBy,omitempty"`
 	UpdatedBy     *string         `json:"updatedBy,omitempty"`
+	Version       int32           `json:"version"`
 	Council       *Council        `json:"council,omitempty"`
 	Teacher       *Teacher        `json:"teacher,omitempty"`
 	GradeDefences []*GradeDefence `json:"gradeDefences,omitempty"`
@@ -196,6 +311,53 @@ type DefenceListResponse struct {
 	Data  []*Defence `json:"data"`
 }
 
+// Lịch bảo vệ sinh ra cho một học kỳ
+type DefenceSchedule struct {
+	SemesterCode string `json:"semesterCode"`
+	// Mọi hội đồng và topic council đều đã được xếp
+	Complete bool `json:"complete"`
+	// Đã ghi lịch vào Council / Topic_council
+	Committed bool              `json:"committed"`
+	Sittings  []*DefenceSitting `json:"sittings"`
+	// Topic council không có hội đồng nào tránh được người hướng dẫn
+	Unassigned []*DefenceScheduleProblem `json:"unassigned"`
+	// Hội đồng không tìm được phòng và thời gian
+	Unplaced []*DefenceScheduleProblem `json:"unplaced"`
+	// Lịch dạng CSV: council, room, topic_council, start, end
+	CSV string `json:"csv"`
+}
+
+type DefenceScheduleInput struct {
+	SemesterCode string   `json:"semesterCode"`
+	Rooms        []string `json:"rooms"`
+	// Các khoảng thời gian có thể tổ chức bảo vệ, ví dụ 08:00-17:00 mỗi ngày
+	Days []*TimeRangeInput `json:"days"`
+	// Thời lượng bảo vệ một đề tài (phút)
+	SlotMinutes int32 `json:"slotMinutes"`
+	// Thời gian giáo viên bận
+	Unavailability []*TeacherUnavailabilityInput `json:"unavailability,omitempty"`
+}
+
+type DefenceScheduleProblem struct {
+	Code   string `json:"code"`
+	Reason string `json:"reason"`
+}
+
+type DefenceSession struct {
+	TopicCouncilCode string    `json:"topicCouncilCode"`
+	Start            time.Time `json:"start"`
+	End              time.Time `json:"end"`
+}
+
+// Một buổi họp của hội đồng trong một phòng
+type DefenceSitting struct {
+	CouncilCode string            `json:"councilCode"`
+	Room        string            `json:"room"`
+	Start       time.Time         `json:"start"`
+	End         time.Time         `json:"end"`
+	Sessions    []*DefenceSession `json:"sessions"`
+}
+
 type Enrollment struct {
 	ID               string          `json:"id"`
 	Title            string          `json:"title"`
@@ -208,6 +370,7 @@ type Enrollment struct {
 	UpdatedAt        *time.Time      `json:"updatedAt,omitempty"`
 	CreatedBy        *string         `json:"createdBy,omitempty"`
 	UpdatedBy        *string         `json:"updatedBy,omitempty"`
+	Version          int32           `json:"version"`
 	Student          *Student        `json:"student,omitempty"`
 	Midterm          *Midterm        `json:"midterm,omitempty"`
 	Final            *Final          `json:"final,omitempty"`
@@ -228,6 +391,7 @@ type Faculty struct {
 	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
 	CreatedBy *string    `json:"createdBy,omitempty"`
 	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	Version   int32      `json:"version"`
 	Majors    []*Major   `json:"majors"`
 }
 
@@ -237,17 +401,38 @@ type FacultyListResponse struct {
 }
 
 type File struct {
-	ID        string     `json:"id"`
-	Title     string     `json:"title"`
-	File      *string    `json:"file,omitempty"`
-	Status    FileStatus `json:"status"`
-	Table     FileTable  `json:"table"`
-	Option    *string    `json:"option,omitempty"`
-	TableID   string     `json:"tableId"`
-	CreatedAt *time.Time `json:"createdAt,omitempty"`
-	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
-	CreatedBy *string    `json:"createdBy,omitempty"`
-	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	ID      string     `json:"id"`
+	Title   string     `json:"title"`
+	File    *string    `json:"file,omitempty"`
+	Status  FileStatus `json:"status"`
+	Table   FileTable  `json:"table"`
+	Option  *string    `json:"option,omitempty"`
+	TableID string     `json:"tableId"`
+	// Phiên bản nộp (tăng dần theo table + tableId + option)
+	Version int32 `json:"version"`
+	Size    *int  `json:"size,omitempty"`
+	// sha256 hex; upload nhiều phần có dạng <sha256>-<số phần>
+	Checksum    *string `json:"checksum,omitempty"`
+	ContentType *string `json:"contentType,omitempty"`
+	// Nộp trong thời gian gia hạn (sau hạn chót)
+	Late bool `json:"late"`
+	// File nghi ngờ (macro, mã độc, không quét được) bị cách ly cho đến khi được duyệt
+	Quarantined bool       `json:"quarantined"`
+	ScanResult  *string    `json:"scanResult,omitempty"`
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+	CreatedBy   *string    `json:"createdBy,omitempty"`
+	UpdatedBy   *string    `json:"updatedBy,omitempty"`
+	Revision    int32      `json:"revision"`
+	// Toàn bộ lịch sử nộp của cùng table + tableId + option, mới nhất trước
+	Versions []*File `json:"versions"`
+}
+
+type FileGradeAppealInput struct {
+	EnrollmentID string                    `json:"enrollmentId"`
+	Component    GradeAppealComponent      `json:"component"`
+	Reason       string                    `json:"reason"`
+	Evidence     *GradeAppealEvidenceInput `json:"evidence,omitempty"`
 }
 
 type FileListResponse struct {
@@ -272,18 +457,39 @@ type FilterGroupInput struct {
 }
 
 type Final struct {
-	ID              string      `json:"id"`
-	Title           string      `json:"title"`
-	SupervisorGrade *int32      `json:"supervisorGrade,omitempty"`
-	DepartmentGrade *int32      `json:"departmentGrade,omitempty"`
-	FinalGrade      *int32      `json:"finalGrade,omitempty"`
-	Status          FinalStatus `json:"status"`
-	Notes           *string     `json:"notes,omitempty"`
-	CompletionDate  *time.Time  `json:"completionDate,omitempty"`
-	CreatedAt       *time.Time  `json:"createdAt,omitempty"`
-	UpdatedAt       *time.Time  `json:"updatedAt,omitempty"`
-	CreatedBy       *string     `json:"createdBy,omitempty"`
-	UpdatedBy       *string     `json:"updatedBy,omitempty"`
+	ID              string `json:"id"`
+	Title           string `json:"title"`
+	SupervisorGrade *int32 `json:"supervisorGrade,omitempty"`
+	DepartmentGrade *int32 `json:"departmentGrade,omitempty"`
+	// Tính theo grading policy (thang 10)
+	FinalGrade     *float64    `json:"finalGrade,omitempty"`
+	Status         FinalStatus `json:"status"`
+	Notes          *string     `json:"notes,omitempty"`
+	CompletionDate *time.Time  `json:"completionDate,omitempty"`
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	CreatedBy      *string     `json:"createdBy,omitempty"`
+	UpdatedBy      *string     `json:"updatedBy,omitempty"`
+	Version        int32       `json:"version"`
+}
+
+// Chi tiết cách tính điểm tổng kết
+type FinalGradeBreakdown struct {
+	EnrollmentCode string            `json:"enrollmentCode"`
+	PolicyCode     string            `json:"policyCode"`
+	Components     []*GradeComponent `json:"components"`
+	CouncilSpread  *float64          `json:"councilSpread,omitempty"`
+	// Điểm hội đồng chênh lệch quá mức cho phép
+	NeedsReconciliation bool `json:"needsReconciliation"`
+	// Thành phần còn thiếu: supervisor, reviewer, council hoặc council:<mã giáo viên>
+	Missing    []string    `json:"missing"`
+	RawGrade   *float64    `json:"rawGrade,omitempty"`
+	FinalGrade *float64    `json:"finalGrade,omitempty"`
+	Status     FinalStatus `json:"status"`
+	// Giải thích từng bước tính
+	Steps     []string `json:"steps"`
+	Finalized bool     `json:"finalized"`
+	Final     *Final   `json:"final,omitempty"`
 }
 
 type FinalListResponse struct {
@@ -291,32 +497,106 @@ type FinalListResponse struct {
 	Data  []*Final `json:"data"`
 }
 
+// Yêu cầu sửa điểm đã khóa
+type GradeAmendment struct {
+	ID     string               `json:"id"`
+	Target GradeAmendmentTarget `json:"target"`
+	// Mã Final, GradeReview, Midterm hoặc GradeDefenceCriterion
+	TargetID       string `json:"targetId"`
+	EnrollmentCode string `json:"enrollmentCode"`
+	// Có với điểm của thesis service
+	SemesterCode *string `json:"semesterCode,omitempty"`
+	// Có với điểm hội đồng
+	CouncilCode  *string              `json:"councilCode,omitempty"`
+	OldValue     *float64             `json:"oldValue,omitempty"`
+	NewValue     float64              `json:"newValue"`
+	Reason       string               `json:"reason"`
+	Status       GradeAmendmentStatus `json:"status"`
+	RequestedBy  string               `json:"requestedBy"`
+	DecidedBy    *string              `json:"decidedBy,omitempty"`
+	DecidedAt    *time.Time           `json:"decidedAt,omitempty"`
+	DecisionNote *string              `json:"decisionNote,omitempty"`
+	CreatedAt    *time.Time           `json:"createdAt,omitempty"`
+	UpdatedAt    *time.Time           `json:"updatedAt,omitempty"`
+}
+
+// Đơn phúc khảo điểm của sinh viên
+type GradeAppeal struct {
+	ID             string               `json:"id"`
+	EnrollmentCode string               `json:"enrollmentCode"`
+	StudentCode    string               `json:"studentCode"`
+	SemesterCode   string               `json:"semesterCode"`
+	Component      GradeAppealComponent `json:"component"`
+	Reason         string               `json:"reason"`
+	// File minh chứng (table GRADE_APPEAL)
+	EvidenceFileCode *string           `json:"evidenceFileCode,omitempty"`
+	Status           GradeAppealStatus `json:"status"`
+	ReviewerCode     *string           `json:"reviewerCode,omitempty"`
+	ResolutionNote   *string           `json:"resolutionNote,omitempty"`
+	// Yêu cầu sửa điểm tạo khi phúc khảo được chấp nhận
+	AmendmentCode *string    `json:"amendmentCode,omitempty"`
+	ResolvedBy    *string    `json:"resolvedBy,omitempty"`
+	ResolvedAt    *time.Time `json:"resolvedAt,omitempty"`
+	CreatedAt     *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt     *time.Time `json:"updatedAt,omitempty"`
+	// Lịch sử xử lý, cũ nhất trước
+	History []*GradeAppealEvent `json:"history"`
+}
+
+type GradeAppealEvent struct {
+	Status    GradeAppealStatus `json:"status"`
+	Note      *string           `json:"note,omitempty"`
+	Actor     string            `json:"actor"`
+	CreatedAt *time.Time        `json:"createdAt,omitempty"`
+}
+
+type GradeAppealEvidenceInput struct {
+	Title string `json:"title"`
+	File  string `json:"file"`
+}
+
+type GradeComponent struct {
+	// supervisor, reviewer hoặc council
+	Name   string   `json:"name"`
+	Score  *float64 `json:"score,omitempty"`
+	Weight float64  `json:"weight"`
+}
+
 type GradeDefence struct {
-	ID             string                   `json:"id"`
-	DefenceCode    string                   `json:"defenceCode"`
-	EnrollmentCode string                   `json:"enrollmentCode"`
-	Note           *string                  `json:"note,omitempty"`
-	TotalScore     *int32                   `json:"totalScore,omitempty"`
-	CreatedAt      *time.Time               `json:"createdAt,omitempty"`
-	UpdatedAt      *time.Time               `json:"updatedAt,omitempty"`
-	CreatedBy      *string                  `json:"createdBy,omitempty"`
-	UpdatedBy      *string                  `json:"updatedBy,omitempty"`
-	Defence        *Defence                 `json:"defence,omitempty"`
-	Enrollment     *Enrollment              `json:"enrollment,omitempty"`
-	Criteria       []*GradeDefenceCriterion `json:"criteria,omitempty"`
+	ID             string  `json:"id"`
+	DefenceCode    string  `json:"defenceCode"`
+	EnrollmentCode string  `json:"enrollmentCode"`
+	Note           *string `json:"note,omitempty"`
+	// Tính từ các tiêu chí theo trọng số (thang 10), null khi chưa chấm đủ
+	TotalScore *float64 `json:"totalScore,omitempty"`
+	// Rubric template dùng để tạo các tiêu chí
+	RubricTemplateCode *string                  `json:"rubricTemplateCode,omitempty"`
+	CreatedAt          *time.Time               `json:"createdAt,omitempty"`
+	UpdatedAt          *time.Time               `json:"updatedAt,omitempty"`
+	CreatedBy          *string                  `json:"createdBy,omitempty"`
+	UpdatedBy          *string                  `json:"updatedBy,omitempty"`
+	Version            int32                    `json:"version"`
+	Defence            *Defence                 `json:"defence,omitempty"`
+	Enrollment         *Enrollment              `json:"enrollment,omitempty"`
+	Criteria           []*GradeDefenceCriterion `json:"criteria,omitempty"`
 }
 
 type GradeDefenceCriterion struct {
-	ID               string        `json:"id"`
-	GradeDefenceCode string        `json:"gradeDefenceCode"`
-	Name             *string       `json:"name,omitempty"`
-	Score            *string       `json:"score,omitempty"`
-	MaxScore         *string       `json:"maxScore,omitempty"`
-	CreatedAt        *time.Time    `json:"createdAt,omitempty"`
-	UpdatedAt        *time.Time    `json:"updatedAt,omitempty"`
-	CreatedBy        *string       `json:"createdBy,omitempty"`
-	UpdatedBy        *string       `json:"updatedBy,omitempty"`
-	GradeDefence     *GradeDefence `json:"gradeDefence,omitempty"`
+	ID               string   `json:"id"`
+	GradeDefenceCode string   `json:"gradeDefenceCode"`
+	Name             *string  `json:"name,omitempty"`
+	Description      *string  `json:"description,omitempty"`
+	Score            *float64 `json:"score,omitempty"`
+	MaxScore         float64  `json:"maxScore"`
+	Weight           float64  `json:"weight"`
+	// Có giá trị khi tiêu chí lấy từ rubric template: chỉ được sửa điểm
+	RubricCriterionCode *string       `json:"rubricCriterionCode,omitempty"`
+	CreatedAt           *time.Time    `json:"createdAt,omitempty"`
+	UpdatedAt           *time.Time    `json:"updatedAt,omitempty"`
+	CreatedBy           *string       `json:"createdBy,omitempty"`
+	UpdatedBy           *string       `json:"updatedBy,omitempty"`
+	Version             int32         `json:"version"`
+	GradeDefence        *GradeDefence `json:"gradeDefence,omitempty"`
 }
 
 type GradeDefenceCriterionListResponse struct {
@@ -335,12 +615,6 @@ type GradeFinalInput struct {
 	Notes           *string     `json:"notes,omitempty"`
 }
 
-type GradeMidtermInput struct {
-	Grade    int32         `json:"grade"`
-	Status   MidtermStatus `json:"status"`
-	Feedback *string       `json:"feedback,omitempty"`
-}
-
 type GradeReview struct {
 	ID             string      `json:"id"`
 	Title          string      `json:"title"`
@@ -353,6 +627,7 @@ type GradeReview struct {
 	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
 	CreatedBy      *string     `json:"createdBy,omitempty"`
 	UpdatedBy      *string     `json:"updatedBy,omitempty"`
+	Version        int32       `json:"version"`
 	Teacher        *Teacher    `json:"teacher,omitempty"`
 }
 
@@ -361,6 +636,35 @@ type GradeReviewListResponse struct {
 	Data  []*GradeReview `json:"data"`
 }
 
+// Cách tính điểm tổng kết theo ngành + học kỳ + giai đoạn
+type GradingPolicy struct {
+	ID           string     `json:"id"`
+	MajorCode    string     `json:"majorCode"`
+	SemesterCode string     `json:"semesterCode"`
+	Stage        TopicStage `json:"stage"`
+	// Trọng số tương đối, 0 = không dùng thành phần này
+	SupervisorWeight float64       `json:"supervisorWeight"`
+	ReviewerWeight   float64       `json:"reviewerWeight"`
+	CouncilWeight    float64       `json:"councilWeight"`
+	Rounding         GradeRounding `json:"rounding"`
+	// Bước làm tròn, ví dụ 0.5 hoặc 0.1
+	RoundingStep  float64 `json:"roundingStep"`
+	PassThreshold float64 `json:"passThreshold"`
+	// Chênh lệch tối đa giữa điểm các thành viên hội đồng, 0 = không kiểm tra
+	MaxCouncilSpread float64    `json:"maxCouncilSpread"`
+	CreatedAt        *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt        *time.Time `json:"updatedAt,omitempty"`
+	CreatedBy        *string    `json:"createdBy,omitempty"`
+	UpdatedBy        *string    `json:"updatedBy,omitempty"`
+}
+
+type GrantDeadlineExtensionInput struct {
+	StudentCode string         `json:"studentCode"`
+	Kind        SubmissionKind `json:"kind"`
+	DueAt       time.Time      `json:"dueAt"`
+	Reason      *string        `json:"reason,omitempty"`
+}
+
 type Major struct {
 	ID          string     `json:"id"`
 	Title       string     `json:"title"`
@@ -369,6 +673,9 @@ type Major struct {
 	UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
 	CreatedBy   *string    `json:"createdBy,omitempty"`
 	UpdatedBy   *string    `json:"updatedBy,omitempty"`
+	Version     int32      `json:"version"`
+	DeletedAt   *time.Time `json:"deletedAt,omitempty"`
+	DeletedBy   *string    `json:"deletedBy,omitempty"`
 	Topics      []*Topic   `json:"topics,omitempty"`
 }
 
@@ -386,16 +693,43 @@ type MajorListResponse struct {
 	Data  []*Major `json:"data"`
 }
 
+type MatchAssignment struct {
+	StudentCode      string `json:"studentCode"`
+	TopicCode        string `json:"topicCode"`
+	TopicCouncilCode string `json:"topicCouncilCode"`
+	// Thứ hạng nguyện vọng của sinh viên cho đề tài này
+	Choice int32 `json:"choice"`
+	// Enrollment được tạo khi commit
+	EnrollmentCode *string `json:"enrollmentCode,omitempty"`
+}
+
+type MatchRejection struct {
+	TopicCode string               `json:"topicCode"`
+	Choice    int32                `json:"choice"`
+	Reason    MatchRejectionReason `json:"reason"`
+}
+
+type MatchedPassage struct {
+	Text string `json:"text"`
+	// Vị trí (theo từ) trong bài được kiểm tra
+	Offset int32 `json:"offset"`
+	// Vị trí (theo từ) trong bài trùng
+	SourceOffset int32 `json:"sourceOffset"`
+	Words        int32 `json:"words"`
+}
+
 type Midterm struct {
-	ID        string        `json:"id"`
-	Title     string        `json:"title"`
-	Grade     *int32        `json:"grade,omitempty"`
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Grade *int32 `json:"grade,omitempty"`
+	// Tính từ kết quả các mốc tiến độ
 	Status    MidtermStatus `json:"status"`
 	Feedback  *string       `json:"feedback,omitempty"`
 	CreatedAt *time.Time    `json:"createdAt,omitempty"`
 	UpdatedAt *time.Time    `json:"updatedAt,omitempty"`
 	CreatedBy *string       `json:"createdBy,omitempty"`
 	UpdatedBy *string       `json:"updatedBy,omitempty"`
+	Version   int32         `json:"version"`
 }
 
 type MidtermListResponse struct {
@@ -403,6 +737,46 @@ type MidtermListResponse struct {
 	Data  []*Midterm `json:"data"`
 }
 
+// Mốc tiến độ midterm của học kỳ + giai đoạn (đề cương, tổng quan tài liệu, prototype, bản nháp...)
+type MidtermMilestone struct {
+	ID           string     `json:"id"`
+	SemesterCode string     `json:"semesterCode"`
+	Stage        TopicStage `json:"stage"`
+	Title        string     `json:"title"`
+	Description  *string    `json:"description,omitempty"`
+	// Thứ tự trong học kỳ + giai đoạn
+	Sequence int32 `json:"sequence"`
+	// Hạn nộp báo cáo, null = không giới hạn
+	DueAt     *time.Time `json:"dueAt,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	CreatedBy *string    `json:"createdBy,omitempty"`
+	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	Version   int32      `json:"version"`
+}
+
+type MilestoneAttachmentInput struct {
+	Title string `json:"title"`
+	File  string `json:"file"`
+}
+
+// Báo cáo của một enrollment cho một mốc tiến độ
+type MilestoneCheckin struct {
+	ID             string `json:"id"`
+	MilestoneCode  string `json:"milestoneCode"`
+	EnrollmentCode string `json:"enrollmentCode"`
+	Report         string `json:"report"`
+	// File đính kèm (table MILESTONE_CHECKIN)
+	FileCode *string         `json:"fileCode,omitempty"`
+	Result   MilestoneResult `json:"result"`
+	// Nhận xét của giáo viên hướng dẫn
+	Comment     *string    `json:"comment,omitempty"`
+	ReviewedBy  *string    `json:"reviewedBy,omitempty"`
+	ReviewedAt  *time.Time `json:"reviewedAt,omitempty"`
+	SubmittedAt *time.Time `json:"submittedAt,omitempty"`
+	UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+}
+
 type Mutation struct {
 }
 
@@ -411,11 +785,65 @@ type PaginationInput struct {
 	PageSize   *int32  `json:"pageSize,omitempty"`
 	SortBy     *string `json:"sortBy,omitempty"`
 	Descending *bool   `json:"descending,omitempty"`
+	// Sắp xếp theo nhiều trường, theo thứ tự ưu tiên; khi có thì bỏ qua sortBy/descending
+	Sort []*SortInput `json:"sort,omitempty"`
+}
+
+type ProposeTopicInput struct {
+	Title          string     `json:"title"`
+	MajorCode      string     `json:"majorCode"`
+	Stage          TopicStage `json:"stage"`
+	MaxStudents    int32      `json:"maxStudents"`
+	RequiredSkills []string   `json:"requiredSkills,omitempty"`
+	// Giáo viên được mời đồng hướng dẫn
+	CoSupervisorCodes []string  `json:"coSupervisorCodes,omitempty"`
+	TimeStart         time.Time `json:"timeStart"`
+	TimeEnd           time.Time `json:"timeEnd"`
+}
+
+// Kết quả đề xuất đề tài: topic, topic council và các lời mời đồng hướng dẫn
+type ProposeTopicResult struct {
+	Topic          *Topic         `json:"topic"`
+	TopicCouncilID string         `json:"topicCouncilId"`
+	CoSigns        []*TopicCoSign `json:"coSigns"`
 }
 
 type Query struct {
 }
 
+// Khung thời gian đăng ký đề tài của học kỳ
+type RegistrationWindow struct {
+	ID           string    `json:"id"`
+	SemesterCode string    `json:"semesterCode"`
+	OpensAt      time.Time `json:"opensAt"`
+	ClosesAt     time.Time `json:"closesAt"`
+	// Số nguyện vọng tối đa mỗi sinh viên
+	MaxChoices int32      `json:"maxChoices"`
+	CreatedAt  *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt  *time.Time `json:"updatedAt,omitempty"`
+	CreatedBy  *string    `json:"createdBy,omitempty"`
+	UpdatedBy  *string    `json:"updatedBy,omitempty"`
+}
+
+type RequestGradeAmendmentInput struct {
+	Target GradeAmendmentTarget `json:"target"`
+	// Mã Final, GradeReview, Midterm hoặc GradeDefenceCriterion
+	TargetID string  `json:"targetId"`
+	NewValue float64 `json:"newValue"`
+	Reason   string  `json:"reason"`
+}
+
+type ResolveGradeAppealInput struct {
+	ID string `json:"id"`
+	// false = giữ nguyên điểm
+	Amend bool   `json:"amend"`
+	Note  string `json:"note"`
+	// Bắt buộc khi amend
+	NewValue *float64 `json:"newValue,omitempty"`
+	// Tiêu chí cần sửa, bắt buộc khi amend đơn DEFENCE
+	CriterionID *string `json:"criterionId,omitempty"`
+}
+
 // Enrollment view cho Reviewer
 // Reviewer được xem để chấm phản biện
 type ReviewerEnrollment struct {
@@ -433,6 +861,8 @@ type ReviewerEnrollment struct {
 	Midterm          *Midterm              `json:"midterm,omitempty"`
 	Final            *Final                `json:"final,omitempty"`
 	GradeReview      *GradeReview          `json:"gradeReview,omitempty"`
+	// Báo cáo trùng lặp của bài nộp cuối kỳ (mặc định 5 bài giống nhất, tối đa 20)
+	SimilarityReport *SimilarityReport `json:"similarityReport,omitempty"`
 }
 
 // Grade_review assignment cho Reviewer
@@ -489,6 +919,7 @@ type RoleSystem struct {
 	UpdatedAt    *time.Time     `json:"updatedAt,omitempty"`
 	CreatedBy    *string        `json:"createdBy,omitempty"`
 	UpdatedBy    *string        `json:"updatedBy,omitempty"`
+	Version      int32          `json:"version"`
 	Teacher      *Teacher       `json:"teacher,omitempty"`
 	Semester     *Semester      `json:"semester,omitempty"`
 }
@@ -498,6 +929,45 @@ type RoleSystemListResponse struct {
 	Data  []*RoleSystem `json:"data"`
 }
 
+type RubricCriterion struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	MaxScore    float64 `json:"maxScore"`
+	Weight      float64 `json:"weight"`
+	SortOrder   int32   `json:"sortOrder"`
+}
+
+type RubricCriterionInput struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	MaxScore    float64 `json:"maxScore"`
+	Weight      float64 `json:"weight"`
+}
+
+// Rubric chấm bảo vệ theo ngành và giai đoạn
+type RubricTemplate struct {
+	ID        string             `json:"id"`
+	Title     string             `json:"title"`
+	MajorCode string             `json:"majorCode"`
+	Stage     TopicStage         `json:"stage"`
+	Criteria  []*RubricCriterion `json:"criteria"`
+	CreatedAt *time.Time         `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time         `json:"updatedAt,omitempty"`
+	CreatedBy *string            `json:"createdBy,omitempty"`
+	UpdatedBy *string            `json:"updatedBy,omitempty"`
+	Version   int32              `json:"version"`
+}
+
+// Một kết quả tìm kiếm toàn văn
+type SearchHit struct {
+	Type     SearchType `json:"type"`
+	ID       string     `json:"id"`
+	Title    string     `json:"title"`
+	Subtitle *string    `json:"subtitle,omitempty"`
+	Score    float64    `json:"score"`
+}
+
 type SearchRequestInput struct {
 	Pagination *PaginationInput       `json:"pagination,omitempty"`
 	Filters    []*FilterCriteriaInput `json:"filters,omitempty"`
@@ -510,11 +980,31 @@ type Semester struct {
 	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
 	CreatedBy *string    `json:"createdBy,omitempty"`
 	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	Version   int32      `json:"version"`
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	DeletedBy *string    `json:"deletedBy,omitempty"`
 	Students  []*Student `json:"students,omitempty"`
 	Teachers  []*Teacher `json:"teachers,omitempty"`
 	Topics    []*Topic   `json:"topics,omitempty"`
 }
 
+// Trạng thái khóa / công bố điểm của học kỳ
+type SemesterGradeStatus struct {
+	SemesterCode string `json:"semesterCode"`
+	// Đã khóa: chỉ sửa điểm qua yêu cầu sửa điểm được duyệt
+	Locked   bool       `json:"locked"`
+	LockedAt *time.Time `json:"lockedAt,omitempty"`
+	LockedBy *string    `json:"lockedBy,omitempty"`
+	// Đã công bố: sinh viên xem được điểm
+	Published   bool       `json:"published"`
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
+	PublishedBy *string    `json:"publishedBy,omitempty"`
+	// Số ngày sau khi công bố sinh viên được phúc khảo
+	AppealWindowDays int32 `json:"appealWindowDays"`
+	// Hạn phúc khảo, có khi đã công bố
+	AppealDeadline *time.Time `json:"appealDeadline,omitempty"`
+}
+
 // Semester info - Restricted type để tránh circular query
 // Không có relationships (students, teachers, topics) để tránh vòng lặp
 // Dùng chung cho tất cả custom types
@@ -528,6 +1018,69 @@ type SemesterListResponse struct {
 	Data  []*Semester `json:"data"`
 }
 
+type SetGradingPolicyInput struct {
+	MajorCode        string     `json:"majorCode"`
+	SemesterCode     string     `json:"semesterCode"`
+	Stage            TopicStage `json:"stage"`
+	SupervisorWeight float64    `json:"supervisorWeight"`
+	ReviewerWeight   float64    `json:"reviewerWeight"`
+	CouncilWeight    float64    `json:"councilWeight"`
+	// Mặc định HALF_UP
+	Rounding *GradeRounding `json:"rounding,omitempty"`
+	// Mặc định 0.1
+	RoundingStep *float64 `json:"roundingStep,omitempty"`
+	// Mặc định 5
+	PassThreshold *float64 `json:"passThreshold,omitempty"`
+	// Mặc định 0 (không kiểm tra)
+	MaxCouncilSpread *float64 `json:"maxCouncilSpread,omitempty"`
+}
+
+type SetRegistrationWindowInput struct {
+	SemesterCode string    `json:"semesterCode"`
+	OpensAt      time.Time `json:"opensAt"`
+	ClosesAt     time.Time `json:"closesAt"`
+	// Mặc định 3
+	MaxChoices *int32 `json:"maxChoices,omitempty"`
+}
+
+type SetSubmissionDeadlineInput struct {
+	SemesterCode string         `json:"semesterCode"`
+	Stage        TopicStage     `json:"stage"`
+	Kind         SubmissionKind `json:"kind"`
+	OpensAt      time.Time      `json:"opensAt"`
+	DueAt        time.Time      `json:"dueAt"`
+	GraceMinutes *int32         `json:"graceMinutes,omitempty"`
+}
+
+type SimilarityMatch struct {
+	File         *File  `json:"file"`
+	SemesterCode string `json:"semesterCode"`
+	// Độ tương đồng Jaccard ước lượng bằng MinHash (0-1)
+	Similarity float64 `json:"similarity"`
+	// Tỉ lệ số từ của bài được kiểm tra xuất hiện trong bài này (0-100)
+	OverlapPercent float64 `json:"overlapPercent"`
+	// Các đoạn trùng dài nhất
+	Passages []*MatchedPassage `json:"passages"`
+}
+
+// Báo cáo trùng lặp nội dung của bài nộp cuối kỳ.
+// So sánh với bài của sinh viên khác cùng học kỳ và các bài đã nộp ở học kỳ trước.
+type SimilarityReport struct {
+	// Bài nộp được kiểm tra (phiên bản mới nhất)
+	File *File `json:"file,omitempty"`
+	// false khi chưa trích xuất được văn bản (đang xử lý, file bị cách ly, PDF dạng ảnh)
+	Indexed   bool       `json:"indexed"`
+	WordCount int32      `json:"wordCount"`
+	IndexedAt *time.Time `json:"indexedAt,omitempty"`
+	// Các bài giống nhất, sắp theo tỉ lệ trùng lặp giảm dần
+	Matches []*SimilarityMatch `json:"matches"`
+}
+
+type SortInput struct {
+	Field     string         `json:"field"`
+	Direction *SortDirection `json:"direction,omitempty"`
+}
+
 type Student struct {
 	ID           string        `json:"id"`
 	Email        string        `json:"email"`
@@ -541,6 +1094,9 @@ type Student struct {
 	UpdatedAt    *time.Time    `json:"updatedAt,omitempty"`
 	CreatedBy    *string       `json:"createdBy,omitempty"`
 	UpdatedBy    *string       `json:"updatedBy,omitempty"`
+	Version      int32         `json:"version"`
+	DeletedAt    *time.Time    `json:"deletedAt,omitempty"`
+	DeletedBy    *string       `json:"deletedBy,omitempty"`
 	Enrollments  []*Enrollment `json:"enrollments,omitempty"`
 }
 
@@ -609,7 +1165,7 @@ type StudentGradeDefence struct {
 	DefenceCode    string                   `json:"defenceCode"`
 	EnrollmentCode string                   `json:"enrollmentCode"`
 	Note           *string                  `json:"note,omitempty"`
-	TotalScore     *int32                   `json:"totalScore,omitempty"`
+	TotalScore     *float64                 `json:"totalScore,omitempty"`
 	CreatedAt      *time.Time               `json:"createdAt,omitempty"`
 	UpdatedAt      *time.Time               `json:"updatedAt,omitempty"`
 	Criteria       []*GradeDefenceCriterion `json:"criteria,omitempty"`
@@ -642,18 +1198,20 @@ type StudentTeacherInfo struct {
 // Chỉ xem thông tin cơ bản
 // KHÔNG có field 'enrollments' vì không cần xem enrollment người khác
 type StudentTopic struct {
-	ID            string        `json:"id"`
-	Title         string        `json:"title"`
-	MajorCode     string        `json:"majorCode"`
-	SemesterCode  string        `json:"semesterCode"`
-	Status        TopicStatus   `json:"status"`
-	PercentStage1 *int32        `json:"percentStage1,omitempty"`
-	PercentStage2 *int32        `json:"percentStage2,omitempty"`
-	CreatedAt     *time.Time    `json:"createdAt,omitempty"`
-	UpdatedAt     *time.Time    `json:"updatedAt,omitempty"`
-	Major         *MajorInfo    `json:"major,omitempty"`
-	Semester      *SemesterInfo `json:"semester,omitempty"`
-	Files         []*File       `json:"files,omitempty"`
+	ID             string        `json:"id"`
+	Title          string        `json:"title"`
+	MajorCode      string        `json:"majorCode"`
+	SemesterCode   string        `json:"semesterCode"`
+	Status         TopicStatus   `json:"status"`
+	PercentStage1  *int32        `json:"percentStage1,omitempty"`
+	PercentStage2  *int32        `json:"percentStage2,omitempty"`
+	MaxStudents    int32         `json:"maxStudents"`
+	RequiredSkills []string      `json:"requiredSkills"`
+	CreatedAt      *time.Time    `json:"createdAt,omitempty"`
+	UpdatedAt      *time.Time    `json:"updatedAt,omitempty"`
+	Major          *MajorInfo    `json:"major,omitempty"`
+	Semester       *SemesterInfo `json:"semester,omitempty"`
+	Files          []*File       `json:"files,omitempty"`
 }
 
 // TopicCouncil view cho Student
@@ -688,6 +1246,41 @@ type StudentTopicSupervisorListResponse struct {
 	Data  []*StudentTopicSupervisor `json:"data"`
 }
 
+// Khung thời gian nộp bài theo học kỳ + giai đoạn + loại bài nộp
+type SubmissionDeadline struct {
+	ID           string         `json:"id"`
+	SemesterCode string         `json:"semesterCode"`
+	Stage        TopicStage     `json:"stage"`
+	Kind         SubmissionKind `json:"kind"`
+	OpensAt      time.Time      `json:"opensAt"`
+	DueAt        time.Time      `json:"dueAt"`
+	// Số phút cho phép nộp trễ sau dueAt (file bị đánh dấu late)
+	GraceMinutes int32      `json:"graceMinutes"`
+	CreatedAt    *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt    *time.Time `json:"updatedAt,omitempty"`
+	CreatedBy    *string    `json:"createdBy,omitempty"`
+	UpdatedBy    *string    `json:"updatedBy,omitempty"`
+}
+
+// Trạng thái khung nộp bài hiện tại của sinh viên
+type SubmissionWindow struct {
+	Allowed  bool       `json:"allowed"`
+	Late     bool       `json:"late"`
+	Extended bool       `json:"extended"`
+	Stage    TopicStage `json:"stage"`
+	OpensAt  *time.Time `json:"opensAt,omitempty"`
+	DueAt    *time.Time `json:"dueAt,omitempty"`
+	ClosesAt *time.Time `json:"closesAt,omitempty"`
+	Reason   *string    `json:"reason,omitempty"`
+}
+
+type SubmitMilestoneCheckinInput struct {
+	EnrollmentID string                    `json:"enrollmentId"`
+	MilestoneID  string                    `json:"milestoneId"`
+	Report       string                    `json:"report"`
+	Attachment   *MilestoneAttachmentInput `json:"attachment,omitempty"`
+}
+
 type Subscription struct {
 }
 
@@ -711,6 +1304,8 @@ type SupervisorEnrollment struct {
 	Final            *Final                  `json:"final,omitempty"`
 	GradeReview      *GradeReview            `json:"gradeReview,omitempty"`
 	GradeDefences    []*GradeDefence         `json:"gradeDefences,omitempty"`
+	// Báo cáo trùng lặp của bài nộp cuối kỳ (mặc định 5 bài giống nhất, tối đa 20)
+	SimilarityReport *SimilarityReport `json:"similarityReport,omitempty"`
 }
 
 type SupervisorEnrollmentListResponse struct {
@@ -721,21 +1316,24 @@ type SupervisorEnrollmentListResponse struct {
 // Topic view cho Supervisor
 // Supervisor được xem đầy đủ thông tin topic mình hướng dẫn
 type SupervisorTopic struct {
-	ID            string                    `json:"id"`
-	Title         string                    `json:"title"`
-	MajorCode     string                    `json:"majorCode"`
-	SemesterCode  string                    `json:"semesterCode"`
-	Status        TopicStatus               `json:"status"`
-	PercentStage1 *int32                    `json:"percentStage1,omitempty"`
-	PercentStage2 *int32                    `json:"percentStage2,omitempty"`
-	CreatedAt     *time.Time                `json:"createdAt,omitempty"`
-	UpdatedAt     *time.Time                `json:"updatedAt,omitempty"`
-	CreatedBy     *string                   `json:"createdBy,omitempty"`
-	UpdatedBy     *string                   `json:"updatedBy,omitempty"`
-	Major         *MajorInfo                `json:"major,omitempty"`
-	Semester      *SemesterInfo             `json:"semester,omitempty"`
-	Files         []*File                   `json:"files,omitempty"`
-	TopicCouncils []*SupervisorTopicCouncil `json:"topicCouncils,omitempty"`
+	ID             string                    `json:"id"`
+	Title          string                    `json:"title"`
+	MajorCode      string                    `json:"majorCode"`
+	SemesterCode   string                    `json:"semesterCode"`
+	Status         TopicStatus               `json:"status"`
+	PercentStage1  *int32                    `json:"percentStage1,omitempty"`
+	PercentStage2  *int32                    `json:"percentStage2,omitempty"`
+	MaxStudents    int32                     `json:"maxStudents"`
+	RequiredSkills []string                  `json:"requiredSkills"`
+	CreatedAt      *time.Time                `json:"createdAt,omitempty"`
+	UpdatedAt      *time.Time                `json:"updatedAt,omitempty"`
+	CreatedBy      *string                   `json:"createdBy,omitempty"`
+	UpdatedBy      *string                   `json:"updatedBy,omitempty"`
+	Major          *MajorInfo                `json:"major,omitempty"`
+	Semester       *SemesterInfo             `json:"semester,omitempty"`
+	Files          []*File                   `json:"files,omitempty"`
+	TopicCouncils  []*SupervisorTopicCouncil `json:"topicCouncils,omitempty"`
+	StatusHistory  []*TopicStatusHistory     `json:"statusHistory"`
 }
 
 // TopicCouncil view cho Supervisor
@@ -787,6 +1385,9 @@ type Teacher struct {
 	UpdatedAt    *time.Time    `json:"updatedAt,omitempty"`
 	CreatedBy    *string       `json:"createdBy,omitempty"`
 	UpdatedBy    *string       `json:"updatedBy,omitempty"`
+	Version      int32         `json:"version"`
+	DeletedAt    *time.Time    `json:"deletedAt,omitempty"`
+	DeletedBy    *string       `json:"deletedBy,omitempty"`
 	Roles        []*RoleSystem `json:"roles,omitempty"`
 }
 
@@ -795,21 +1396,50 @@ type TeacherListResponse struct {
 	Data  []*Teacher `json:"data"`
 }
 
+type TeacherUnavailabilityInput struct {
+	TeacherCode string    `json:"teacherCode"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+}
+
+type TimeRangeInput struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
 type Topic struct {
-	Total         *int32          `json:"total,omitempty"`
-	ID            string          `json:"id"`
-	Title         string          `json:"title"`
-	MajorCode     string          `json:"majorCode"`
-	SemesterCode  string          `json:"semesterCode"`
-	Status        TopicStatus     `json:"status"`
-	PercentStage1 *int32          `json:"percentStage1,omitempty"`
-	PercentStage2 *int32          `json:"percentStage2,omitempty"`
-	CreatedAt     *time.Time      `json:"createdAt,omitempty"`
-	UpdatedAt     *time.Time      `json:"updatedAt,omitempty"`
-	CreatedBy     *string         `json:"createdBy,omitempty"`
-	UpdatedBy     *string         `json:"updatedBy,omitempty"`
-	Files         []*File         `json:"files,omitempty"`
-	TopicCouncils []*TopicCouncil `json:"topicCouncils,omitempty"`
+	Total         *int32      `json:"total,omitempty"`
+	ID            string      `json:"id"`
+	Title         string      `json:"title"`
+	MajorCode     string      `json:"majorCode"`
+	SemesterCode  string      `json:"semesterCode"`
+	Status        TopicStatus `json:"status"`
+	PercentStage1 *int32      `json:"percentStage1,omitempty"`
+	PercentStage2 *int32      `json:"percentStage2,omitempty"`
+	// Số sinh viên tối đa
+	MaxStudents    int32           `json:"maxStudents"`
+	RequiredSkills []string        `json:"requiredSkills"`
+	CreatedAt      *time.Time      `json:"createdAt,omitempty"`
+	UpdatedAt      *time.Time      `json:"updatedAt,omitempty"`
+	CreatedBy      *string         `json:"createdBy,omitempty"`
+	UpdatedBy      *string         `json:"updatedBy,omitempty"`
+	Version        int32           `json:"version"`
+	DeletedAt      *time.Time      `json:"deletedAt,omitempty"`
+	DeletedBy      *string         `json:"deletedBy,omitempty"`
+	Files          []*File         `json:"files,omitempty"`
+	TopicCouncils  []*TopicCouncil `json:"topicCouncils,omitempty"`
+	// Lịch sử chuyển trạng thái (cũ nhất trước)
+	StatusHistory []*TopicStatusHistory `json:"statusHistory"`
+}
+
+// Lời mời đồng hướng dẫn một đề tài đề xuất
+type TopicCoSign struct {
+	ID          string       `json:"id"`
+	TopicCode   string       `json:"topicCode"`
+	TeacherCode string       `json:"teacherCode"`
+	Status      CoSignStatus `json:"status"`
+	CreatedAt   *time.Time   `json:"createdAt,omitempty"`
+	UpdatedAt   *time.Time   `json:"updatedAt,omitempty"`
 }
 
 type TopicCouncil struct {
@@ -824,6 +1454,7 @@ type TopicCouncil struct {
 	UpdatedAt   *time.Time                `json:"updatedAt,omitempty"`
 	CreatedBy   *string                   `json:"createdBy,omitempty"`
 	UpdatedBy   *string                   `json:"updatedBy,omitempty"`
+	Version     int32                     `json:"version"`
 	Topic       *Topic                    `json:"topic,omitempty"`
 	Council     *Council                  `json:"council,omitempty"`
 	Enrollments []*Enrollment             `json:"enrollments,omitempty"`
@@ -843,6 +1474,7 @@ type TopicCouncilSupervisor struct {
 	UpdatedAt             *time.Time    `json:"updatedAt,omitempty"`
 	CreatedBy             *string       `json:"createdBy,omitempty"`
 	UpdatedBy             *string       `json:"updatedBy,omitempty"`
+	Version               int32         `json:"version"`
 	Teacher               *Teacher      `json:"teacher,omitempty"`
 	TopicCouncil          *TopicCouncil `json:"topicCouncil,omitempty"`
 }
@@ -857,6 +1489,54 @@ type TopicListResponse struct {
 	Data  []*Topic `json:"data"`
 }
 
+// Kết quả ghép sinh viên - đề tài (deferred acceptance, sinh viên đề nghị)
+type TopicMatchingResult struct {
+	SemesterCode  string              `json:"semesterCode"`
+	StudentsTotal int32               `json:"studentsTotal"`
+	MatchedTotal  int32               `json:"matchedTotal"`
+	Assignments   []*MatchAssignment  `json:"assignments"`
+	Unmatched     []*UnmatchedStudent `json:"unmatched"`
+}
+
+// Một nguyện vọng đăng ký đề tài của sinh viên
+type TopicRegistration struct {
+	ID           string `json:"id"`
+	TopicCode    string `json:"topicCode"`
+	StudentCode  string `json:"studentCode"`
+	SemesterCode string `json:"semesterCode"`
+	// 1 = nguyện vọng cao nhất
+	Rank   int32              `json:"rank"`
+	Status RegistrationStatus `json:"status"`
+	// Lý do giáo viên từ chối
+	Reason    *string    `json:"reason,omitempty"`
+	DecidedBy *string    `json:"decidedBy,omitempty"`
+	DecidedAt *time.Time `json:"decidedAt,omitempty"`
+	// Enrollment được tạo khi giáo viên chấp nhận
+	EnrollmentCode *string    `json:"enrollmentCode,omitempty"`
+	CreatedAt      *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt      *time.Time `json:"updatedAt,omitempty"`
+}
+
+// Một lần chuyển trạng thái topic: ai, khi nào, vì sao.
+// Vòng đời: SUBMIT → TOPIC_PENDING → APPROVED_1 → APPROVED_2 → IN_PROGRESS → TOPIC_COMPLETED;
+// TOPIC_PENDING/APPROVED_1 có thể bị REJECTED, topic bị từ chối được gửi lại về TOPIC_PENDING.
+type TopicStatusHistory struct {
+	ID        string `json:"id"`
+	TopicCode string `json:"topicCode"`
+	// null với bản ghi tạo topic
+	FromStatus *TopicStatus `json:"fromStatus,omitempty"`
+	ToStatus   TopicStatus  `json:"toStatus"`
+	Reason     *string      `json:"reason,omitempty"`
+	CreatedBy  string       `json:"createdBy"`
+	CreatedAt  time.Time    `json:"createdAt"`
+}
+
+// Sinh viên chưa được ghép, kèm lý do cho từng nguyện vọng
+type UnmatchedStudent struct {
+	StudentCode string            `json:"studentCode"`
+	Rejections  []*MatchRejection `json:"rejections"`
+}
+
 type UpdateCouncilInput struct {
 	Title     *string    `json:"title,omitempty"`
 	TimeStart *time.Time `json:"timeStart,omitempty"`
@@ -867,20 +1547,27 @@ type UpdateFacultyInput struct {
 }
 
 type UpdateGradeDefenceCriterionInput struct {
-	Name     *string `json:"name,omitempty"`
-	Score    *string `json:"score,omitempty"`
-	MaxScore *string `json:"maxScore,omitempty"`
+	Name        *string  `json:"name,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Score       *float64 `json:"score,omitempty"`
+	MaxScore    *float64 `json:"maxScore,omitempty"`
+	Weight      *float64 `json:"weight,omitempty"`
+	// Phiên bản đã đọc; nếu bản ghi đã bị sửa thì trả lỗi CONFLICT
+	Version *int32 `json:"version,omitempty"`
 }
 
 type UpdateGradeDefenceInput struct {
-	Note       *string `json:"note,omitempty"`
-	TotalScore *int32  `json:"totalScore,omitempty"`
+	Note *string `json:"note,omitempty"`
+	// Phiên bản đã đọc; nếu bản ghi đã bị sửa thì trả lỗi CONFLICT
+	Version *int32 `json:"version,omitempty"`
 }
 
 type UpdateGradeReviewInput struct {
 	ReviewGrade *int32       `json:"reviewGrade,omitempty"`
 	Status      *FinalStatus `json:"status,omitempty"`
 	Notes       *string      `json:"notes,omitempty"`
+	// Phiên bản đã đọc; nếu bản ghi đã bị sửa thì trả lỗi CONFLICT
+	Version *int32 `json:"version,omitempty"`
 }
 
 type UpdateMajorInput struct {
@@ -888,6 +1575,23 @@ type UpdateMajorInput struct {
 	FacultyCode *string `json:"facultyCode,omitempty"`
 }
 
+type UpdateMidtermMilestoneInput struct {
+	Title       *string    `json:"title,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Sequence    *int32     `json:"sequence,omitempty"`
+	DueAt       *time.Time `json:"dueAt,omitempty"`
+	// Phiên bản đã đọc; nếu bản ghi đã bị sửa thì trả lỗi CONFLICT
+	Version *int32 `json:"version,omitempty"`
+}
+
+type UpdateRubricTemplateInput struct {
+	Title *string `json:"title,omitempty"`
+	// Thay toàn bộ tiêu chí khi có giá trị
+	Criteria []*RubricCriterionInput `json:"criteria,omitempty"`
+	// Phiên bản đã đọc; nếu bản ghi đã bị sửa thì trả lỗi CONFLICT
+	Version *int32 `json:"version,omitempty"`
+}
+
 type UpdateSemesterInput struct {
 	Title *string `json:"title,omitempty"`
 }
@@ -922,10 +1626,9 @@ type UpdateTeacherProfileInput struct {
 }
 
 type UpdateTopicInput struct {
-	Title         *string      `json:"title,omitempty"`
-	Status        *TopicStatus `json:"status,omitempty"`
-	PercentStage1 *int32       `json:"percentStage1,omitempty"`
-	PercentStage2 *int32       `json:"percentStage2,omitempty"`
+	Title         *string `json:"title,omitempty"`
+	PercentStage1 *int32  `json:"percentStage1,omitempty"`
+	PercentStage2 *int32  `json:"percentStage2,omitempty"`
 }
 
 type UploadFileInput struct {
@@ -935,6 +1638,135 @@ type UploadFileInput struct {
 	Option  *string `json:"option,omitempty"`
 }
 
+// Trạng thái xác nhận đồng hướng dẫn
+type CoSignStatus string
+
+const (
+	CoSignStatusCosignPending  CoSignStatus = "COSIGN_PENDING"
+	CoSignStatusCosignSigned   CoSignStatus = "COSIGN_SIGNED"
+	CoSignStatusCosignDeclined CoSignStatus = "COSIGN_DECLINED"
+)
+
+var AllCoSignStatus = []CoSignStatus{
+	CoSignStatusCosignPending,
+	CoSignStatusCosignSigned,
+	CoSignStatusCosignDeclined,
+}
+
+func (e CoSignStatus) IsValid() bool {
+	switch e {
+	case CoSignStatusCosignPending, CoSignStatusCosignSigned, CoSignStatusCosignDeclined:
+		return true
+	}
+	return false
+}
+
+func (e CoSignStatus) String() string {
+	return string(e)
+}
+
+func (e *CoSignStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CoSignStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CoSignStatus", str)
+	}
+	return nil
+}
+
+func (e CoSignStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CoSignStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CoSignStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Loại xung đột lợi ích trong council
+type CouncilConflictKind string
+
+const (
+	// Người hướng dẫn ngồi trong council chấm đề tài của mình
+	CouncilConflictKindSupervisorOnCouncil CouncilConflictKind = "SUPERVISOR_ON_COUNCIL"
+	// Người phản biện cũng là người hướng dẫn
+	CouncilConflictKindReviewerIsSupervisor CouncilConflictKind = "REVIEWER_IS_SUPERVISOR"
+	// Trùng chủ tịch hoặc thư ký
+	CouncilConflictKindDuplicatePosition CouncilConflictKind = "DUPLICATE_POSITION"
+	// Một giáo viên giữ nhiều vị trí
+	CouncilConflictKindDuplicateMember  CouncilConflictKind = "DUPLICATE_MEMBER"
+	CouncilConflictKindMissingPresident CouncilConflictKind = "MISSING_PRESIDENT"
+	CouncilConflictKindMissingSecretary CouncilConflictKind = "MISSING_SECRETARY"
+	// Thành viên khác ngành với council
+	CouncilConflictKindCrossMajorMember CouncilConflictKind = "CROSS_MAJOR_MEMBER"
+)
+
+var AllCouncilConflictKind = []CouncilConflictKind{
+	CouncilConflictKindSupervisorOnCouncil,
+	CouncilConflictKindReviewerIsSupervisor,
+	CouncilConflictKindDuplicatePosition,
+	CouncilConflictKindDuplicateMember,
+	CouncilConflictKindMissingPresident,
+	CouncilConflictKindMissingSecretary,
+	CouncilConflictKindCrossMajorMember,
+}
+
+func (e CouncilConflictKind) IsValid() bool {
+	switch e {
+	case CouncilConflictKindSupervisorOnCouncil, CouncilConflictKindReviewerIsSupervisor, CouncilConflictKindDuplicatePosition, CouncilConflictKindDuplicateMember, CouncilConflictKindMissingPresident, CouncilConflictKindMissingSecretary, CouncilConflictKindCrossMajorMember:
+		return true
+	}
+	return false
+}
+
+func (e CouncilConflictKind) String() string {
+	return string(e)
+}
+
+func (e *CouncilConflictKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CouncilConflictKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CouncilConflictKind", str)
+	}
+	return nil
+}
+
+func (e CouncilConflictKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CouncilConflictKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CouncilConflictKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Vai trò trong hội đồng bảo vệ
 type DefencePosition string
 
@@ -1057,10 +1889,12 @@ func (e FileStatus) MarshalJSON() ([]byte, error) {
 type FileTable string
 
 const (
-	FileTableTopic   FileTable = "TOPIC"
-	FileTableMidterm FileTable = "MIDTERM"
-	FileTableFinal   FileTable = "FINAL"
-	FileTableOrder   FileTable = "ORDER"
+	FileTableTopic            FileTable = "TOPIC"
+	FileTableMidterm          FileTable = "MIDTERM"
+	FileTableFinal            FileTable = "FINAL"
+	FileTableOrder            FileTable = "ORDER"
+	FileTableGradeAppeal      FileTable = "GRADE_APPEAL"
+	FileTableMilestoneCheckin FileTable = "MILESTONE_CHECKIN"
 )
 
 var AllFileTable = []FileTable{
@@ -1068,11 +1902,13 @@ var AllFileTable = []FileTable{
 	FileTableMidterm,
 	FileTableFinal,
 	FileTableOrder,
+	FileTableGradeAppeal,
+	FileTableMilestoneCheckin,
 }
 
 func (e FileTable) IsValid() bool {
 	switch e {
-	case FileTableTopic, FileTableMidterm, FileTableFinal, FileTableOrder:
+	case FileTableTopic, FileTableMidterm, FileTableFinal, FileTableOrder, FileTableGradeAppeal, FileTableMilestoneCheckin:
 		return true
 	}
 	return false
@@ -1128,6 +1964,8 @@ const (
 	FilterOperatorIsNull           FilterOperator = "IS_NULL"
 	FilterOperatorIsNotNull        FilterOperator = "IS_NOT_NULL"
 	FilterOperatorBetween          FilterOperator = "BETWEEN"
+	// Tìm kiếm toàn văn, chỉ dùng được trên các trường có chỉ mục FULLTEXT
+	FilterOperatorSearch FilterOperator = "SEARCH"
 )
 
 var AllFilterOperator = []FilterOperator{
@@ -1143,11 +1981,12 @@ var AllFilterOperator = []FilterOperator{
 	FilterOperatorIsNull,
 	FilterOperatorIsNotNull,
 	FilterOperatorBetween,
+	FilterOperatorSearch,
 }
 
 func (e FilterOperator) IsValid() bool {
 	switch e {
-	case FilterOperatorEqual, FilterOperatorNotEqual, FilterOperatorGreaterThan, FilterOperatorGreaterThanEqual, FilterOperatorLessThan, FilterOperatorLessThanEqual, FilterOperatorLike, FilterOperatorIn, FilterOperatorNotIn, FilterOperatorIsNull, FilterOperatorIsNotNull, FilterOperatorBetween:
+	case FilterOperatorEqual, FilterOperatorNotEqual, FilterOperatorGreaterThan, FilterOperatorGreaterThanEqual, FilterOperatorLessThan, FilterOperatorLessThanEqual, FilterOperatorLike, FilterOperatorIn, FilterOperatorNotIn, FilterOperatorIsNull, FilterOperatorIsNotNull, FilterOperatorBetween, FilterOperatorSearch:
 		return true
 	}
 	return false
@@ -1306,48 +2145,50 @@ func (e Gender) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-type LogicalCondition string
+type GradeAmendmentStatus string
 
 const (
-	LogicalConditionAnd LogicalCondition = "AND"
-	LogicalConditionOr  LogicalCondition = "OR"
+	GradeAmendmentStatusPending  GradeAmendmentStatus = "PENDING"
+	GradeAmendmentStatusApproved GradeAmendmentStatus = "APPROVED"
+	GradeAmendmentStatusRejected GradeAmendmentStatus = "REJECTED"
 )
 
-var AllLogicalCondition = []LogicalCondition{
-	LogicalConditionAnd,
-	LogicalConditionOr,
+var AllGradeAmendmentStatus = []GradeAmendmentStatus{
+	GradeAmendmentStatusPending,
+	GradeAmendmentStatusApproved,
+	GradeAmendmentStatusRejected,
 }
 
-func (e LogicalCondition) IsValid() bool {
+func (e GradeAmendmentStatus) IsValid() bool {
 	switch e {
-	case LogicalConditionAnd, LogicalConditionOr:
+	case GradeAmendmentStatusPending, GradeAmendmentStatusApproved, GradeAmendmentStatusRejected:
 		return true
 	}
 	return false
 }
 
-func (e LogicalCondition) String() string {
+func (e GradeAmendmentStatus) String() string {
 	return string(e)
 }
 
-func (e *LogicalCondition) UnmarshalGQL(v any) error {
+func (e *GradeAmendmentStatus) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = LogicalCondition(str)
+	*e = GradeAmendmentStatus(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid LogicalCondition", str)
+		return fmt.Errorf("%s is not a valid GradeAmendmentStatus", str)
 	}
 	return nil
 }
 
-func (e LogicalCondition) MarshalGQL(w io.Writer) {
+func (e GradeAmendmentStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *LogicalCondition) UnmarshalJSON(b []byte) error {
+func (e *GradeAmendmentStatus) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1355,59 +2196,429 @@ func (e *LogicalCondition) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e LogicalCondition) MarshalJSON() ([]byte, error) {
+func (e GradeAmendmentStatus) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Trạng thái giữa kỳ
-type MidtermStatus string
+// Điểm được yêu cầu sửa sau khi đã khóa
+type GradeAmendmentTarget string
 
 const (
-	MidtermStatusNotSubmitted MidtermStatus = "NOT_SUBMITTED"
-	MidtermStatusSubmitted    MidtermStatus = "SUBMITTED"
-	MidtermStatusPass         MidtermStatus = "PASS"
-	MidtermStatusFail         MidtermStatus = "FAIL"
+	// Final.supervisorGrade
+	GradeAmendmentTargetSupervisorGrade GradeAmendmentTarget = "SUPERVISOR_GRADE"
+	// GradeReview.reviewGrade
+	GradeAmendmentTargetReviewGrade GradeAmendmentTarget = "REVIEW_GRADE"
+	// Midterm.grade
+	GradeAmendmentTargetMidtermGrade GradeAmendmentTarget = "MIDTERM_GRADE"
+	// Final.finalGrade, trạng thái tính lại theo ngưỡng đạt
+	GradeAmendmentTargetFinalGrade GradeAmendmentTarget = "FINAL_GRADE"
+	// GradeDefenceCriterion.score, totalScore tính lại
+	GradeAmendmentTargetDefenceCriterion GradeAmendmentTarget = "DEFENCE_CRITERION"
 )
 
-var AllMidtermStatus = []MidtermStatus{
-	MidtermStatusNotSubmitted,
-	MidtermStatusSubmitted,
-	MidtermStatusPass,
-	MidtermStatusFail,
+var AllGradeAmendmentTarget = []GradeAmendmentTarget{
+	GradeAmendmentTargetSupervisorGrade,
+	GradeAmendmentTargetReviewGrade,
+	GradeAmendmentTargetMidtermGrade,
+	GradeAmendmentTargetFinalGrade,
+	GradeAmendmentTargetDefenceCriterion,
 }
 
-func (e MidtermStatus) IsValid() bool {
+func (e GradeAmendmentTarget) IsValid() bool {
 	switch e {
-	case MidtermStatusNotSubmitted, MidtermStatusSubmitted, MidtermStatusPass, MidtermStatusFail:
+	case GradeAmendmentTargetSupervisorGrade, GradeAmendmentTargetReviewGrade, GradeAmendmentTargetMidtermGrade, GradeAmendmentTargetFinalGrade, GradeAmendmentTargetDefenceCriterion:
 		return true
 	}
 	return false
 }
 
-func (e MidtermStatus) String() string {
+func (e GradeAmendmentTarget) String() string {
 	return string(e)
 }
 
-func (e *MidtermStatus) UnmarshalGQL(v any) error {
+func (e *GradeAmendmentTarget) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = MidtermStatus(str)
+	*e = GradeAmendmentTarget(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid MidtermStatus", str)
+		return fmt.Errorf("%s is not a valid GradeAmendmentTarget", str)
 	}
 	return nil
 }
 
-func (e MidtermStatus) MarshalGQL(w io.Writer) {
+func (e GradeAmendmentTarget) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *MidtermStatus) UnmarshalJSON(b []byte) error {
+func (e *GradeAmendmentTarget) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GradeAmendmentTarget) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Thành phần điểm sinh viên phúc khảo
+type GradeAppealComponent string
+
+const (
+	GradeAppealComponentSupervisorGrade GradeAppealComponent = "SUPERVISOR_GRADE"
+	GradeAppealComponentReviewGrade     GradeAppealComponent = "REVIEW_GRADE"
+	GradeAppealComponentMidtermGrade    GradeAppealComponent = "MIDTERM_GRADE"
+	GradeAppealComponentFinalGrade      GradeAppealComponent = "FINAL_GRADE"
+	// Điểm bảo vệ trước hội đồng
+	GradeAppealComponentDefence GradeAppealComponent = "DEFENCE"
+)
+
+var AllGradeAppealComponent = []GradeAppealComponent{
+	GradeAppealComponentSupervisorGrade,
+	GradeAppealComponentReviewGrade,
+	GradeAppealComponentMidtermGrade,
+	GradeAppealComponentFinalGrade,
+	GradeAppealComponentDefence,
+}
+
+func (e GradeAppealComponent) IsValid() bool {
+	switch e {
+	case GradeAppealComponentSupervisorGrade, GradeAppealComponentReviewGrade, GradeAppealComponentMidtermGrade, GradeAppealComponentFinalGrade, GradeAppealComponentDefence:
+		return true
+	}
+	return false
+}
+
+func (e GradeAppealComponent) String() string {
+	return string(e)
+}
+
+func (e *GradeAppealComponent) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GradeAppealComponent(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GradeAppealComponent", str)
+	}
+	return nil
+}
+
+func (e GradeAppealComponent) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GradeAppealComponent) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GradeAppealComponent) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Trạng thái phúc khảo
+type GradeAppealStatus string
+
+const (
+	GradeAppealStatusSubmitted GradeAppealStatus = "SUBMITTED"
+	// Đã giao cho người xem xét
+	GradeAppealStatusAssigned GradeAppealStatus = "ASSIGNED"
+	// Giữ nguyên điểm
+	GradeAppealStatusConfirmed GradeAppealStatus = "CONFIRMED"
+	// Sửa điểm qua yêu cầu sửa điểm
+	GradeAppealStatusAmended GradeAppealStatus = "AMENDED"
+)
+
+var AllGradeAppealStatus = []GradeAppealStatus{
+	GradeAppealStatusSubmitted,
+	GradeAppealStatusAssigned,
+	GradeAppealStatusConfirmed,
+	GradeAppealStatusAmended,
+}
+
+func (e GradeAppealStatus) IsValid() bool {
+	switch e {
+	case GradeAppealStatusSubmitted, GradeAppealStatusAssigned, GradeAppealStatusConfirmed, GradeAppealStatusAmended:
+		return true
+	}
+	return false
+}
+
+func (e GradeAppealStatus) String() string {
+	return string(e)
+}
+
+func (e *GradeAppealStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GradeAppealStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GradeAppealStatus", str)
+	}
+	return nil
+}
+
+func (e GradeAppealStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GradeAppealStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GradeAppealStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Cách làm tròn điểm tổng kết
+type GradeRounding string
+
+const (
+	GradeRoundingHalfUp GradeRounding = "HALF_UP"
+	GradeRoundingDown   GradeRounding = "DOWN"
+	GradeRoundingUp     GradeRounding = "UP"
+)
+
+var AllGradeRounding = []GradeRounding{
+	GradeRoundingHalfUp,
+	GradeRoundingDown,
+	GradeRoundingUp,
+}
+
+func (e GradeRounding) IsValid() bool {
+	switch e {
+	case GradeRoundingHalfUp, GradeRoundingDown, GradeRoundingUp:
+		return true
+	}
+	return false
+}
+
+func (e GradeRounding) String() string {
+	return string(e)
+}
+
+func (e *GradeRounding) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GradeRounding(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GradeRounding", str)
+	}
+	return nil
+}
+
+func (e GradeRounding) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GradeRounding) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GradeRounding) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type LogicalCondition string
+
+const (
+	LogicalConditionAnd LogicalCondition = "AND"
+	LogicalConditionOr  LogicalCondition = "OR"
+)
+
+var AllLogicalCondition = []LogicalCondition{
+	LogicalConditionAnd,
+	LogicalConditionOr,
+}
+
+func (e LogicalCondition) IsValid() bool {
+	switch e {
+	case LogicalConditionAnd, LogicalConditionOr:
+		return true
+	}
+	return false
+}
+
+func (e LogicalCondition) String() string {
+	return string(e)
+}
+
+func (e *LogicalCondition) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LogicalCondition(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LogicalCondition", str)
+	}
+	return nil
+}
+
+func (e LogicalCondition) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LogicalCondition) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LogicalCondition) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Lý do sinh viên không được ghép vào một nguyện vọng
+type MatchRejectionReason string
+
+const (
+	// Đề tài đã đủ sinh viên được giáo viên xếp hạng cao hơn
+	MatchRejectionReasonMatchOutranked MatchRejectionReason = "MATCH_OUTRANKED"
+	// Không nằm trong danh sách chấp nhận của giáo viên
+	MatchRejectionReasonMatchNotAcceptable MatchRejectionReason = "MATCH_NOT_ACCEPTABLE"
+	// Đề tài không còn chỗ
+	MatchRejectionReasonMatchNoCapacity MatchRejectionReason = "MATCH_NO_CAPACITY"
+	// Đề tài không mở đăng ký
+	MatchRejectionReasonMatchTopicClosed MatchRejectionReason = "MATCH_TOPIC_CLOSED"
+)
+
+var AllMatchRejectionReason = []MatchRejectionReason{
+	MatchRejectionReasonMatchOutranked,
+	MatchRejectionReasonMatchNotAcceptable,
+	MatchRejectionReasonMatchNoCapacity,
+	MatchRejectionReasonMatchTopicClosed,
+}
+
+func (e MatchRejectionReason) IsValid() bool {
+	switch e {
+	case MatchRejectionReasonMatchOutranked, MatchRejectionReasonMatchNotAcceptable, MatchRejectionReasonMatchNoCapacity, MatchRejectionReasonMatchTopicClosed:
+		return true
+	}
+	return false
+}
+
+func (e MatchRejectionReason) String() string {
+	return string(e)
+}
+
+func (e *MatchRejectionReason) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MatchRejectionReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MatchRejectionReason", str)
+	}
+	return nil
+}
+
+func (e MatchRejectionReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MatchRejectionReason) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MatchRejectionReason) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Trạng thái giữa kỳ
+type MidtermStatus string
+
+const (
+	MidtermStatusNotSubmitted MidtermStatus = "NOT_SUBMITTED"
+	MidtermStatusSubmitted    MidtermStatus = "SUBMITTED"
+	MidtermStatusPass         MidtermStatus = "PASS"
+	MidtermStatusFail         MidtermStatus = "FAIL"
+)
+
+var AllMidtermStatus = []MidtermStatus{
+	MidtermStatusNotSubmitted,
+	MidtermStatusSubmitted,
+	MidtermStatusPass,
+	MidtermStatusFail,
+}
+
+func (e MidtermStatus) IsValid() bool {
+	switch e {
+	case MidtermStatusNotSubmitted, MidtermStatusSubmitted, MidtermStatusPass, MidtermStatusFail:
+		return true
+	}
+	return false
+}
+
+func (e MidtermStatus) String() string {
+	return string(e)
+}
+
+func (e *MidtermStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MidtermStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MidtermStatus", str)
+	}
+	return nil
+}
+
+func (e MidtermStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MidtermStatus) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1421,6 +2632,125 @@ func (e MidtermStatus) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Kết quả một mốc tiến độ midterm
+type MilestoneResult string
+
+const (
+	// Chờ giáo viên hướng dẫn đánh giá
+	MilestoneResultPending MilestoneResult = "PENDING"
+	MilestoneResultPassed  MilestoneResult = "PASSED"
+	MilestoneResultFailed  MilestoneResult = "FAILED"
+)
+
+var AllMilestoneResult = []MilestoneResult{
+	MilestoneResultPending,
+	MilestoneResultPassed,
+	MilestoneResultFailed,
+}
+
+func (e MilestoneResult) IsValid() bool {
+	switch e {
+	case MilestoneResultPending, MilestoneResultPassed, MilestoneResultFailed:
+		return true
+	}
+	return false
+}
+
+func (e MilestoneResult) String() string {
+	return string(e)
+}
+
+func (e *MilestoneResult) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MilestoneResult(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MilestoneResult", str)
+	}
+	return nil
+}
+
+func (e MilestoneResult) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MilestoneResult) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MilestoneResult) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Trạng thái đăng ký đề tài của sinh viên
+type RegistrationStatus string
+
+const (
+	RegistrationStatusRegistrationPending   RegistrationStatus = "REGISTRATION_PENDING"
+	RegistrationStatusRegistrationAccepted  RegistrationStatus = "REGISTRATION_ACCEPTED"
+	RegistrationStatusRegistrationDeclined  RegistrationStatus = "REGISTRATION_DECLINED"
+	RegistrationStatusRegistrationWithdrawn RegistrationStatus = "REGISTRATION_WITHDRAWN"
+)
+
+var AllRegistrationStatus = []RegistrationStatus{
+	RegistrationStatusRegistrationPending,
+	RegistrationStatusRegistrationAccepted,
+	RegistrationStatusRegistrationDeclined,
+	RegistrationStatusRegistrationWithdrawn,
+}
+
+func (e RegistrationStatus) IsValid() bool {
+	switch e {
+	case RegistrationStatusRegistrationPending, RegistrationStatusRegistrationAccepted, RegistrationStatusRegistrationDeclined, RegistrationStatusRegistrationWithdrawn:
+		return true
+	}
+	return false
+}
+
+func (e RegistrationStatus) String() string {
+	return string(e)
+}
+
+func (e *RegistrationStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RegistrationStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RegistrationStatus", str)
+	}
+	return nil
+}
+
+func (e RegistrationStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RegistrationStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RegistrationStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type Role string
 
 const (
@@ -1538,6 +2868,177 @@ func (e RoleSystemRole) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Loại kết quả tìm kiếm
+type SearchType string
+
+const (
+	SearchTypeTopic   SearchType = "TOPIC"
+	SearchTypeStudent SearchType = "STUDENT"
+	SearchTypeTeacher SearchType = "TEACHER"
+	SearchTypeFile    SearchType = "FILE"
+)
+
+var AllSearchType = []SearchType{
+	SearchTypeTopic,
+	SearchTypeStudent,
+	SearchTypeTeacher,
+	SearchTypeFile,
+}
+
+func (e SearchType) IsValid() bool {
+	switch e {
+	case SearchTypeTopic, SearchTypeStudent, SearchTypeTeacher, SearchTypeFile:
+		return true
+	}
+	return false
+}
+
+func (e SearchType) String() string {
+	return string(e)
+}
+
+func (e *SearchType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SearchType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SearchType", str)
+	}
+	return nil
+}
+
+func (e SearchType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SearchType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SearchType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "ASC"
+	SortDirectionDesc SortDirection = "DESC"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SortDirection) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SortDirection) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Loại bài nộp có hạn nộp
+type SubmissionKind string
+
+const (
+	SubmissionKindMidterm SubmissionKind = "MIDTERM"
+	SubmissionKindFinal   SubmissionKind = "FINAL"
+)
+
+var AllSubmissionKind = []SubmissionKind{
+	SubmissionKindMidterm,
+	SubmissionKindFinal,
+}
+
+func (e SubmissionKind) IsValid() bool {
+	switch e {
+	case SubmissionKindMidterm, SubmissionKindFinal:
+		return true
+	}
+	return false
+}
+
+func (e SubmissionKind) String() string {
+	return string(e)
+}
+
+func (e *SubmissionKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SubmissionKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SubmissionKind", str)
+	}
+	return nil
+}
+
+func (e SubmissionKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SubmissionKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SubmissionKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Giai đoạn đề tài
 type TopicStage string
 
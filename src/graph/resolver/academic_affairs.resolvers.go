@@ -23,7 +23,12 @@ func (r *mutationResolver) UpdateTeacher(ctx context.Context, id string, input m
 
 // DeleteTeacher is the resolver for the deleteTeacher field.
 func (r *mutationResolver) DeleteTeacher(ctx context.Context, id string) (bool, error) {
-	panic(fmt.Errorf("not implemented: DeleteTeacher - deleteTeacher"))
+	return r.Ctrl.DeleteTeacher(ctx, id)
+}
+
+// RestoreTeacher is the resolver for the restoreTeacher field.
+func (r *mutationResolver) RestoreTeacher(ctx context.Context, id string) (*model.Teacher, error) {
+	return r.Ctrl.RestoreTeacher(ctx, id)
 }
 
 // CreateStudent is the resolver for the createStudent field.
@@ -38,7 +43,12 @@ func (r *mutationResolver) UpdateStudent(ctx context.Context, id string, input m
 
 // DeleteStudent is the resolver for the deleteStudent field.
 func (r *mutationResolver) DeleteStudent(ctx context.Context, id string) (bool, error) {
-	panic(fmt.Errorf("not implemented: DeleteStudent - deleteStudent"))
+	return r.Ctrl.DeleteStudent(ctx, id)
+}
+
+// RestoreStudent is the resolver for the restoreStudent field.
+func (r *mutationResolver) RestoreStudent(ctx context.Context, id string) (*model.Student, error) {
+	return r.Ctrl.RestoreStudent(ctx, id)
 }
 
 // CreateSemester is the resolver for the createSemester field.
@@ -53,7 +63,12 @@ func (r *mutationResolver) UpdateSemester(ctx context.Context, id string, input
 
 // DeleteSemester is the resolver for the deleteSemester field.
 func (r *mutationResolver) DeleteSemester(ctx context.Context, id string) (bool, error) {
-	panic(fmt.Errorf("not implemented: DeleteSemester - deleteSemester"))
+	return r.Ctrl.DeleteSemester(ctx, id)
+}
+
+// RestoreSemester is the resolver for the restoreSemester field.
+func (r *mutationResolver) RestoreSemester(ctx context.Context, id string) (*model.Semester, error) {
+	return r.Ctrl.RestoreSemester(ctx, id)
 }
 
 // CreateMajor is the resolver for the createMajor field.
@@ -68,7 +83,12 @@ func (r *mutationResolver) UpdateMajor(ctx context.Context, id string, input mod
 
 // DeleteMajor is the resolver for the deleteMajor field.
 func (r *mutationResolver) DeleteMajor(ctx context.Context, id string) (bool, error) {
-	panic(fmt.Errorf("not implemented: DeleteMajor - deleteMajor"))
+	return r.Ctrl.DeleteMajor(ctx, id)
+}
+
+// RestoreMajor is the resolver for the restoreMajor field.
+func (r *mutationResolver) RestoreMajor(ctx context.Context, id string) (*model.Major, error) {
+	return r.Ctrl.RestoreMajor(ctx, id)
 }
 
 // CreateFaculty is the resolver for the createFaculty field.
@@ -98,17 +118,32 @@ func (r *mutationResolver) UpdateCouncil(ctx context.Context, id string, input m
 
 // DeleteCouncil is the resolver for the deleteCouncil field.
 func (r *mutationResolver) DeleteCouncil(ctx context.Context, id string) (bool, error) {
-	panic(fmt.Errorf("not implemented: DeleteCouncil - deleteCouncil"))
+	return r.Ctrl.DeleteCouncil(ctx, id)
+}
+
+// RestoreCouncil is the resolver for the restoreCouncil field.
+func (r *mutationResolver) RestoreCouncil(ctx context.Context, id string) (*model.Council, error) {
+	return r.Ctrl.RestoreCouncil(ctx, id)
 }
 
 // ApproveTopic is the resolver for the approveTopic field.
-func (r *mutationResolver) ApproveTopic(ctx context.Context, id string) (*model.Topic, error) {
-	panic(fmt.Errorf("not implemented: ApproveTopic - approveTopic"))
+func (r *mutationResolver) ApproveTopic(ctx context.Context, id string, note *string) (*model.Topic, error) {
+	return r.Ctrl.ApproveTopic(ctx, id, 2, note)
 }
 
 // RejectTopic is the resolver for the rejectTopic field.
-func (r *mutationResolver) RejectTopic(ctx context.Context, id string, reason *string) (*model.Topic, error) {
-	panic(fmt.Errorf("not implemented: RejectTopic - rejectTopic"))
+func (r *mutationResolver) RejectTopic(ctx context.Context, id string, reason string) (*model.Topic, error) {
+	return r.Ctrl.RejectTopic(ctx, id, 2, reason)
+}
+
+// StartTopic is the resolver for the startTopic field.
+func (r *mutationResolver) StartTopic(ctx context.Context, id string) (*model.Topic, error) {
+	return r.Ctrl.StartTopic(ctx, id)
+}
+
+// CompleteTopic is the resolver for the completeTopic field.
+func (r *mutationResolver) CompleteTopic(ctx context.Context, id string) (*model.Topic, error) {
+	return r.Ctrl.CompleteTopic(ctx, id)
 }
 
 // UpdateTopic is the resolver for the updateTopic field.
@@ -118,7 +153,112 @@ func (r *mutationResolver) UpdateTopic(ctx context.Context, id string, input mod
 
 // DeleteTopic is the resolver for the deleteTopic field.
 func (r *mutationResolver) DeleteTopic(ctx context.Context, id string) (bool, error) {
-	panic(fmt.Errorf("not implemented: DeleteTopic - deleteTopic"))
+	return r.Ctrl.DeleteTopic(ctx, id)
+}
+
+// RestoreTopic is the resolver for the restoreTopic field.
+func (r *mutationResolver) RestoreTopic(ctx context.Context, id string) (*model.Topic, error) {
+	return r.Ctrl.RestoreTopic(ctx, id)
+}
+
+// SetSubmissionDeadline is the resolver for the setSubmissionDeadline field.
+func (r *mutationResolver) SetSubmissionDeadline(ctx context.Context, input model.SetSubmissionDeadlineInput) (*model.SubmissionDeadline, error) {
+	return r.Ctrl.SetSubmissionDeadline(ctx, input)
+}
+
+// CreateMidtermMilestone is the resolver for the createMidtermMilestone field.
+func (r *mutationResolver) CreateMidtermMilestone(ctx context.Context, input model.CreateMidtermMilestoneInput) (*model.MidtermMilestone, error) {
+	return r.Ctrl.CreateMidtermMilestone(ctx, input)
+}
+
+// UpdateMidtermMilestone is the resolver for the updateMidtermMilestone field.
+func (r *mutationResolver) UpdateMidtermMilestone(ctx context.Context, id string, input model.UpdateMidtermMilestoneInput) (*model.MidtermMilestone, error) {
+	return r.Ctrl.UpdateMidtermMilestone(ctx, id, input)
+}
+
+// DeleteMidtermMilestone is the resolver for the deleteMidtermMilestone field.
+func (r *mutationResolver) DeleteMidtermMilestone(ctx context.Context, id string) (bool, error) {
+	return r.Ctrl.DeleteMidtermMilestone(ctx, id)
+}
+
+// SetRegistrationWindow is the resolver for the setRegistrationWindow field.
+func (r *mutationResolver) SetRegistrationWindow(ctx context.Context, input model.SetRegistrationWindowInput) (*model.RegistrationWindow, error) {
+	return r.Ctrl.SetRegistrationWindow(ctx, input)
+}
+
+// CommitTopicMatching is the resolver for the commitTopicMatching field.
+func (r *mutationResolver) CommitTopicMatching(ctx context.Context, semesterCode string) (*model.TopicMatchingResult, error) {
+	return r.Ctrl.CommitTopicMatching(ctx, semesterCode)
+}
+
+// CommitDefenceSchedule is the resolver for the commitDefenceSchedule field.
+func (r *mutationResolver) CommitDefenceSchedule(ctx context.Context, input model.DefenceScheduleInput) (*model.DefenceSchedule, error) {
+	return r.Ctrl.CommitDefenceSchedule(ctx, input)
+}
+
+// CreateRubricTemplate is the resolver for the createRubricTemplate field.
+func (r *mutationResolver) CreateRubricTemplate(ctx context.Context, input model.CreateRubricTemplateInput) (*model.RubricTemplate, error) {
+	return r.Ctrl.CreateRubricTemplate(ctx, input)
+}
+
+// UpdateRubricTemplate is the resolver for the updateRubricTemplate field.
+func (r *mutationResolver) UpdateRubricTemplate(ctx context.Context, id string, input model.UpdateRubricTemplateInput) (*model.RubricTemplate, error) {
+	return r.Ctrl.UpdateRubricTemplate(ctx, id, input)
+}
+
+// DeleteRubricTemplate is the resolver for the deleteRubricTemplate field.
+func (r *mutationResolver) DeleteRubricTemplate(ctx context.Context, id string) (bool, error) {
+	return r.Ctrl.DeleteRubricTemplate(ctx, id)
+}
+
+// SetGradingPolicy is the resolver for the setGradingPolicy field.
+func (r *mutationResolver) SetGradingPolicy(ctx context.Context, input model.SetGradingPolicyInput) (*model.GradingPolicy, error) {
+	return r.Ctrl.SetGradingPolicy(ctx, input)
+}
+
+// DeleteGradingPolicy is the resolver for the deleteGradingPolicy field.
+func (r *mutationResolver) DeleteGradingPolicy(ctx context.Context, id string) (bool, error) {
+	return r.Ctrl.DeleteGradingPolicy(ctx, id)
+}
+
+// FinalizeFinalGrade is the resolver for the finalizeFinalGrade field.
+func (r *mutationResolver) FinalizeFinalGrade(ctx context.Context, enrollmentID string) (*model.FinalGradeBreakdown, error) {
+	return r.Ctrl.FinalizeFinalGrade(ctx, enrollmentID)
+}
+
+// LockSemesterGrades is the resolver for the lockSemesterGrades field.
+func (r *mutationResolver) LockSemesterGrades(ctx context.Context, semesterCode string) (*model.SemesterGradeStatus, error) {
+	return r.Ctrl.LockSemesterGrades(ctx, semesterCode)
+}
+
+// PublishSemesterGrades is the resolver for the publishSemesterGrades field.
+func (r *mutationResolver) PublishSemesterGrades(ctx context.Context, semesterCode string) (*model.SemesterGradeStatus, error) {
+	return r.Ctrl.PublishSemesterGrades(ctx, semesterCode)
+}
+
+// LockCouncilGrades is the resolver for the lockCouncilGrades field.
+func (r *mutationResolver) LockCouncilGrades(ctx context.Context, councilID string) (*model.Council, error) {
+	return r.Ctrl.LockCouncilGrades(ctx, councilID)
+}
+
+// PublishCouncilGrades is the resolver for the publishCouncilGrades field.
+func (r *mutationResolver) PublishCouncilGrades(ctx context.Context, councilID string) (*model.Council, error) {
+	return r.Ctrl.PublishCouncilGrades(ctx, councilID)
+}
+
+// DecideGradeAmendment is the resolver for the decideGradeAmendment field.
+func (r *mutationResolver) DecideGradeAmendment(ctx context.Context, input model.DecideGradeAmendmentInput) (*model.GradeAmendment, error) {
+	return r.Ctrl.DecideGradeAmendment(ctx, input)
+}
+
+// SetGradeAppealWindow is the resolver for the setGradeAppealWindow field.
+func (r *mutationResolver) SetGradeAppealWindow(ctx context.Context, semesterCode string, days int32) (*model.SemesterGradeStatus, error) {
+	return r.Ctrl.SetGradeAppealWindow(ctx, semesterCode, days)
+}
+
+// AssignGradeAppeal is the resolver for the assignGradeAppeal field.
+func (r *mutationResolver) AssignGradeAppeal(ctx context.Context, id string, reviewerID string) (*model.GradeAppeal, error) {
+	return r.Ctrl.AssignGradeAppeal(ctx, id, reviewerID)
 }
 
 // GetListTeachers is the resolver for the getListTeachers field.
@@ -195,3 +335,68 @@ func (r *queryResolver) GetDefencesByCouncil(ctx context.Context, councilID stri
 func (r *queryResolver) GetAllGradeDefences(ctx context.Context, search model.SearchRequestInput) (*model.GradeDefenceListResponse, error) {
 	panic(fmt.Errorf("not implemented: GetAllGradeDefences - getAllGradeDefences"))
 }
+
+// GetSubmissionDeadlines is the resolver for the getSubmissionDeadlines field.
+func (r *queryResolver) GetSubmissionDeadlines(ctx context.Context, semesterCode string) ([]*model.SubmissionDeadline, error) {
+	return r.Ctrl.GetSubmissionDeadlines(ctx, semesterCode)
+}
+
+// GetMidtermMilestones is the resolver for the getMidtermMilestones field.
+func (r *queryResolver) GetMidtermMilestones(ctx context.Context, semesterCode string, stage *model.TopicStage) ([]*model.MidtermMilestone, error) {
+	return r.Ctrl.GetMidtermMilestones(ctx, semesterCode, stage)
+}
+
+// GetRegistrationWindow is the resolver for the getRegistrationWindow field.
+func (r *queryResolver) GetRegistrationWindow(ctx context.Context, semesterCode string) (*model.RegistrationWindow, error) {
+	return r.Ctrl.GetRegistrationWindow(ctx, semesterCode)
+}
+
+// GetTopicRegistrations is the resolver for the getTopicRegistrations field.
+func (r *queryResolver) GetTopicRegistrations(ctx context.Context, semesterCode string) ([]*model.TopicRegistration, error) {
+	return r.Ctrl.GetTopicRegistrations(ctx, semesterCode)
+}
+
+// PreviewTopicMatching is the resolver for the previewTopicMatching field.
+func (r *queryResolver) PreviewTopicMatching(ctx context.Context, semesterCode string) (*model.TopicMatchingResult, error) {
+	return r.Ctrl.PreviewTopicMatching(ctx, semesterCode)
+}
+
+// PreviewDefenceSchedule is the resolver for the previewDefenceSchedule field.
+func (r *queryResolver) PreviewDefenceSchedule(ctx context.Context, input model.DefenceScheduleInput) (*model.DefenceSchedule, error) {
+	return r.Ctrl.PreviewDefenceSchedule(ctx, input)
+}
+
+// GetRubricTemplates is the resolver for the getRubricTemplates field.
+func (r *queryResolver) GetRubricTemplates(ctx context.Context, majorCode *string, stage *model.TopicStage) ([]*model.RubricTemplate, error) {
+	return r.Ctrl.GetRubricTemplates(ctx, majorCode, stage)
+}
+
+// GetGradingPolicies is the resolver for the getGradingPolicies field.
+func (r *queryResolver) GetGradingPolicies(ctx context.Context, semesterCode string) ([]*model.GradingPolicy, error) {
+	return r.Ctrl.GetGradingPolicies(ctx, semesterCode)
+}
+
+// PreviewFinalGrade is the resolver for the previewFinalGrade field.
+func (r *queryResolver) PreviewFinalGrade(ctx context.Context, enrollmentID string) (*model.FinalGradeBreakdown, error) {
+	return r.Ctrl.PreviewFinalGrade(ctx, enrollmentID)
+}
+
+// GetSemesterGradeStatus is the resolver for the getSemesterGradeStatus field.
+func (r *queryResolver) GetSemesterGradeStatus(ctx context.Context, semesterCode string) (*model.SemesterGradeStatus, error) {
+	return r.Ctrl.GetSemesterGradeStatus(ctx, semesterCode)
+}
+
+// GetGradeAmendments is the resolver for the getGradeAmendments field.
+func (r *queryResolver) GetGradeAmendments(ctx context.Context, semesterCode string, status *model.GradeAmendmentStatus) ([]*model.GradeAmendment, error) {
+	return r.Ctrl.GetGradeAmendments(ctx, semesterCode, status)
+}
+
+// GetGradeAppeals is the resolver for the getGradeAppeals field.
+func (r *queryResolver) GetGradeAppeals(ctx context.Context, semesterCode string, status *model.GradeAppealStatus) ([]*model.GradeAppeal, error) {
+	return r.Ctrl.GetGradeAppeals(ctx, semesterCode, status)
+}
+
+// AuditLog is the resolver for the auditLog field.
+func (r *queryResolver) AuditLog(ctx context.Context, entity *string, entityID *string, actor *string, from *time.Time, to *time.Time) ([]*model.AuditLogEntry, error) {
+	return r.Ctrl.AuditLog(ctx, entity, entityID, actor, from, to)
+}
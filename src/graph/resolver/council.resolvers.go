@@ -48,7 +48,7 @@ func (r *gradeDefenceResolver) Enrollment(ctx context.Context, obj *model.GradeD
 
 // Criteria is the resolver for the criteria field.
 func (r *gradeDefenceResolver) Criteria(ctx context.Context, obj *model.GradeDefence) ([]*model.GradeDefenceCriterion, error) {
-	panic(fmt.Errorf("not implemented: Criteria - criteria"))
+	return r.Ctrl.GetGradeDefenceCriteria(ctx, obj.ID)
 }
 
 // GradeDefence is the resolver for the gradeDefence field.
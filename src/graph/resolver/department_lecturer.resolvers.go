@@ -22,7 +22,7 @@ func (r *mutationResolver) UpdateDepartmentCouncil(ctx context.Context, id strin
 
 // AddDefenceToCouncil is the resolver for the addDefenceToCouncil field.
 func (r *mutationResolver) AddDefenceToCouncil(ctx context.Context, input model.CreateDefenceInput) (*model.Defence, error) {
-	panic(fmt.Errorf("not implemented: AddDefenceToCouncil - addDefenceToCouncil"))
+	return r.Ctrl.AddDefenceToCouncil(ctx, input)
 }
 
 // RemoveDefenceFromCouncil is the resolver for the removeDefenceFromCouncil field.
@@ -31,18 +31,23 @@ func (r *mutationResolver) RemoveDefenceFromCouncil(ctx context.Context, id stri
 }
 
 // ApproveTopicStage1 is the resolver for the approveTopicStage1 field.
-func (r *mutationResolver) ApproveTopicStage1(ctx context.Context, id string) (*model.Topic, error) {
-	panic(fmt.Errorf("not implemented: ApproveTopicStage1 - approveTopicStage1"))
+func (r *mutationResolver) ApproveTopicStage1(ctx context.Context, id string, note *string) (*model.Topic, error) {
+	return r.Ctrl.ApproveTopic(ctx, id, 1, note)
 }
 
 // RejectTopicStage1 is the resolver for the rejectTopicStage1 field.
-func (r *mutationResolver) RejectTopicStage1(ctx context.Context, id string, reason *string) (*model.Topic, error) {
-	panic(fmt.Errorf("not implemented: RejectTopicStage1 - rejectTopicStage1"))
+func (r *mutationResolver) RejectTopicStage1(ctx context.Context, id string, reason string) (*model.Topic, error) {
+	return r.Ctrl.RejectTopic(ctx, id, 1, reason)
 }
 
 // AssignTopicToCouncil is the resolver for the assignTopicToCouncil field.
-func (r *mutationResolver) AssignTopicToCouncil(ctx context.Context, topicCouncilID string, councilID string) (*model.TopicCouncil, error) {
-	panic(fmt.Errorf("not implemented: AssignTopicToCouncil - assignTopicToCouncil"))
+func (r *mutationResolver) AssignTopicToCouncil(ctx context.Context, topicCouncilID string, councilID string, overrideReason *string) (*model.TopicCouncil, error) {
+	return r.Ctrl.AssignTopicToCouncil(ctx, topicCouncilID, councilID, overrideReason)
+}
+
+// OverrideCouncilConflicts is the resolver for the overrideCouncilConflicts field.
+func (r *mutationResolver) OverrideCouncilConflicts(ctx context.Context, councilID string, reason string) (*model.CouncilValidation, error) {
+	return r.Ctrl.OverrideCouncilConflicts(ctx, councilID, reason)
 }
 
 // GetDepartmentTeachers is the resolver for the getDepartmentTeachers field.
@@ -109,3 +114,13 @@ func (r *queryResolver) GetDepartmentDefences(ctx context.Context, councilID str
 func (r *queryResolver) GetDepartmentGradeDefences(ctx context.Context, search model.SearchRequestInput) ([]*model.GradeDefence, error) {
 	panic(fmt.Errorf("not implemented: GetDepartmentGradeDefences - getDepartmentGradeDefences"))
 }
+
+// ValidateCouncil is the resolver for the validateCouncil field.
+func (r *queryResolver) ValidateCouncil(ctx context.Context, councilID string) (*model.CouncilValidation, error) {
+	return r.Ctrl.ValidateCouncil(ctx, councilID)
+}
+
+// GetCouncilConflictOverrides is the resolver for the getCouncilConflictOverrides field.
+func (r *queryResolver) GetCouncilConflictOverrides(ctx context.Context, councilID string) ([]*model.CouncilConflictOverride, error) {
+	return r.Ctrl.GetCouncilConflictOverrides(ctx, councilID)
+}
@@ -0,0 +1,21 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"thaily/src/graph/generated"
+	"thaily/src/graph/model"
+)
+
+// Versions is the resolver for the versions field.
+func (r *fileResolver) Versions(ctx context.Context, obj *model.File) ([]*model.File, error) {
+	return r.Ctrl.GetFileVersions(ctx, obj)
+}
+
+// File returns generated.FileResolver implementation.
+func (r *Resolver) File() generated.FileResolver { return &fileResolver{r} }
+
+type fileResolver struct{ *Resolver }
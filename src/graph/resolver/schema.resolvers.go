@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"thaily/src/graph/generated"
+	"thaily/src/graph/model"
 )
 
 // Empty is the resolver for the _empty field.
@@ -20,6 +21,11 @@ func (r *queryResolver) Empty(ctx context.Context) (*string, error) {
 	panic(fmt.Errorf("not implemented: Empty - _empty"))
 }
 
+// GlobalSearch is the resolver for the globalSearch field.
+func (r *queryResolver) GlobalSearch(ctx context.Context, query string, types []model.SearchType, limit *int32) ([]*model.SearchHit, error) {
+	return r.Ctrl.GlobalSearch(ctx, query, types, limit)
+}
+
 // Empty is the resolver for the _empty field.
 func (r *subscriptionResolver) Empty(ctx context.Context) (<-chan *string, error) {
 	panic(fmt.Errorf("not implemented: Empty - _empty"))
@@ -18,12 +18,27 @@ func (r *mutationResolver) UpdateMyProfile(ctx context.Context, input model.Upda
 
 // UploadMidtermFile is the resolver for the uploadMidtermFile field.
 func (r *mutationResolver) UploadMidtermFile(ctx context.Context, input model.UploadFileInput) (*model.File, error) {
-	panic(fmt.Errorf("not implemented: UploadMidtermFile - uploadMidtermFile"))
+	return r.Ctrl.UploadSubmissionFile(ctx, input, model.SubmissionKindMidterm)
 }
 
 // UploadFinalFile is the resolver for the uploadFinalFile field.
 func (r *mutationResolver) UploadFinalFile(ctx context.Context, input model.UploadFileInput) (*model.File, error) {
-	panic(fmt.Errorf("not implemented: UploadFinalFile - uploadFinalFile"))
+	return r.Ctrl.UploadSubmissionFile(ctx, input, model.SubmissionKindFinal)
+}
+
+// RegisterTopicPreferences is the resolver for the registerTopicPreferences field.
+func (r *mutationResolver) RegisterTopicPreferences(ctx context.Context, topicIds []string) ([]*model.TopicRegistration, error) {
+	return r.Ctrl.RegisterTopicPreferences(ctx, topicIds)
+}
+
+// SubmitMilestoneCheckin is the resolver for the submitMilestoneCheckin field.
+func (r *mutationResolver) SubmitMilestoneCheckin(ctx context.Context, input model.SubmitMilestoneCheckinInput) (*model.MilestoneCheckin, error) {
+	return r.Ctrl.SubmitMilestoneCheckin(ctx, input)
+}
+
+// FileGradeAppeal is the resolver for the fileGradeAppeal field.
+func (r *mutationResolver) FileGradeAppeal(ctx context.Context, input model.FileGradeAppealInput) (*model.GradeAppeal, error) {
+	return r.Ctrl.FileGradeAppeal(ctx, input)
 }
 
 // GetMyProfile is the resolver for the getMyProfile field.
@@ -33,12 +48,12 @@ func (r *queryResolver) GetMyProfile(ctx context.Context) (*model.Student, error
 
 // GetMyEnrollments is the resolver for the getMyEnrollments field.
 func (r *queryResolver) GetMyEnrollments(ctx context.Context, search *model.SearchRequestInput) (*model.StudentEnrollmentListResponse, error) {
-	panic(fmt.Errorf("not implemented: GetMyEnrollments - getMyEnrollments"))
+	return r.Ctrl.GetMyEnrollments(ctx, search)
 }
 
 // GetMyEnrollmentDetail is the resolver for the getMyEnrollmentDetail field.
 func (r *queryResolver) GetMyEnrollmentDetail(ctx context.Context, id string) (*model.StudentEnrollment, error) {
-	panic(fmt.Errorf("not implemented: GetMyEnrollmentDetail - getMyEnrollmentDetail"))
+	return r.Ctrl.GetMyEnrollmentDetail(ctx, id)
 }
 
 // GetMySemesters is the resolver for the getMySemesters field.
@@ -46,6 +61,41 @@ func (r *queryResolver) GetMySemesters(ctx context.Context, search *model.Search
 	panic(fmt.Errorf("not implemented: GetMySemesters - getMySemesters"))
 }
 
+// GetMySubmissionWindow is the resolver for the getMySubmissionWindow field.
+func (r *queryResolver) GetMySubmissionWindow(ctx context.Context, kind model.SubmissionKind) (*model.SubmissionWindow, error) {
+	return r.Ctrl.GetMySubmissionWindow(ctx, kind)
+}
+
+// GetMyRegistrationWindow is the resolver for the getMyRegistrationWindow field.
+func (r *queryResolver) GetMyRegistrationWindow(ctx context.Context) (*model.RegistrationWindow, error) {
+	return r.Ctrl.GetMyRegistrationWindow(ctx)
+}
+
+// GetRegistrableTopics is the resolver for the getRegistrableTopics field.
+func (r *queryResolver) GetRegistrableTopics(ctx context.Context) ([]*model.StudentTopic, error) {
+	return r.Ctrl.GetRegistrableTopics(ctx)
+}
+
+// GetMyTopicRegistrations is the resolver for the getMyTopicRegistrations field.
+func (r *queryResolver) GetMyTopicRegistrations(ctx context.Context) ([]*model.TopicRegistration, error) {
+	return r.Ctrl.GetMyTopicRegistrations(ctx)
+}
+
+// GetMyMidtermMilestones is the resolver for the getMyMidtermMilestones field.
+func (r *queryResolver) GetMyMidtermMilestones(ctx context.Context, enrollmentID string) ([]*model.MidtermMilestone, error) {
+	return r.Ctrl.GetMyMidtermMilestones(ctx, enrollmentID)
+}
+
+// GetMyMilestoneCheckins is the resolver for the getMyMilestoneCheckins field.
+func (r *queryResolver) GetMyMilestoneCheckins(ctx context.Context, enrollmentID string) ([]*model.MilestoneCheckin, error) {
+	return r.Ctrl.GetMyMilestoneCheckins(ctx, enrollmentID)
+}
+
+// GetMyGradeAppeals is the resolver for the getMyGradeAppeals field.
+func (r *queryResolver) GetMyGradeAppeals(ctx context.Context) ([]*model.GradeAppeal, error) {
+	return r.Ctrl.GetMyGradeAppeals(ctx)
+}
+
 // Major is the resolver for the major field.
 func (r *studentCouncilResolver) Major(ctx context.Context, obj *model.StudentCouncil) (*model.MajorInfo, error) {
 	panic(fmt.Errorf("not implemented: Major - major"))
@@ -73,27 +123,27 @@ func (r *studentEnrollmentResolver) TopicCouncil(ctx context.Context, obj *model
 
 // Midterm is the resolver for the midterm field.
 func (r *studentEnrollmentResolver) Midterm(ctx context.Context, obj *model.StudentEnrollment) (*model.Midterm, error) {
-	panic(fmt.Errorf("not implemented: Midterm - midterm"))
+	return r.Ctrl.GetStudentMidterm(ctx, obj)
 }
 
 // Final is the resolver for the final field.
 func (r *studentEnrollmentResolver) Final(ctx context.Context, obj *model.StudentEnrollment) (*model.Final, error) {
-	panic(fmt.Errorf("not implemented: Final - final"))
+	return r.Ctrl.GetStudentFinal(ctx, obj)
 }
 
 // GradeReview is the resolver for the gradeReview field.
 func (r *studentEnrollmentResolver) GradeReview(ctx context.Context, obj *model.StudentEnrollment) (*model.GradeReview, error) {
-	panic(fmt.Errorf("not implemented: GradeReview - gradeReview"))
+	return r.Ctrl.GetStudentGradeReview(ctx, obj)
 }
 
 // GradeDefences is the resolver for the gradeDefences field.
 func (r *studentEnrollmentResolver) GradeDefences(ctx context.Context, obj *model.StudentEnrollment) ([]*model.StudentGradeDefence, error) {
-	panic(fmt.Errorf("not implemented: GradeDefences - gradeDefences"))
+	return r.Ctrl.GetStudentGradeDefences(ctx, obj)
 }
 
 // Criteria is the resolver for the criteria field.
 func (r *studentGradeDefenceResolver) Criteria(ctx context.Context, obj *model.StudentGradeDefence) ([]*model.GradeDefenceCriterion, error) {
-	panic(fmt.Errorf("not implemented: Criteria - criteria"))
+	return r.Ctrl.GetGradeDefenceCriteria(ctx, obj.ID)
 }
 
 // Defence is the resolver for the defence field.
@@ -101,14 +101,34 @@ func (r *mutationResolver) UpdateMyTeacherProfile(ctx context.Context, input mod
 	panic(fmt.Errorf("not implemented: UpdateMyTeacherProfile - updateMyTeacherProfile"))
 }
 
-// GradeMidterm is the resolver for the gradeMidterm field.
-func (r *mutationResolver) GradeMidterm(ctx context.Context, enrollmentID string, input model.GradeMidtermInput) (*model.Midterm, error) {
-	panic(fmt.Errorf("not implemented: GradeMidterm - gradeMidterm"))
+// ProposeTopic is the resolver for the proposeTopic field.
+func (r *mutationResolver) ProposeTopic(ctx context.Context, input model.ProposeTopicInput) (*model.ProposeTopicResult, error) {
+	return r.Ctrl.ProposeTopic(ctx, input)
 }
 
-// FeedbackMidterm is the resolver for the feedbackMidterm field.
-func (r *mutationResolver) FeedbackMidterm(ctx context.Context, midtermID string, feedback string) (*model.Midterm, error) {
-	panic(fmt.Errorf("not implemented: FeedbackMidterm - feedbackMidterm"))
+// CoSignTopic is the resolver for the coSignTopic field.
+func (r *mutationResolver) CoSignTopic(ctx context.Context, topicID string, accept bool) (*model.TopicCoSign, error) {
+	return r.Ctrl.CoSignTopic(ctx, topicID, accept)
+}
+
+// SubmitTopic is the resolver for the submitTopic field.
+func (r *mutationResolver) SubmitTopic(ctx context.Context, id string) (*model.Topic, error) {
+	return r.Ctrl.SubmitTopic(ctx, id)
+}
+
+// DecideTopicRegistration is the resolver for the decideTopicRegistration field.
+func (r *mutationResolver) DecideTopicRegistration(ctx context.Context, registrationID string, accept bool, reason *string) (*model.TopicRegistration, error) {
+	return r.Ctrl.DecideTopicRegistration(ctx, registrationID, accept, reason)
+}
+
+// RankTopicApplicants is the resolver for the rankTopicApplicants field.
+func (r *mutationResolver) RankTopicApplicants(ctx context.Context, topicID string, studentIds []string, restricted *bool) (*model.ApplicantRanking, error) {
+	return r.Ctrl.RankTopicApplicants(ctx, topicID, studentIds, restricted)
+}
+
+// ReviewMilestoneCheckin is the resolver for the reviewMilestoneCheckin field.
+func (r *mutationResolver) ReviewMilestoneCheckin(ctx context.Context, id string, pass bool, comment *string) (*model.MilestoneCheckin, error) {
+	return r.Ctrl.ReviewMilestoneCheckin(ctx, id, pass, comment)
 }
 
 // GradeFinal is the resolver for the gradeFinal field.
@@ -141,29 +161,44 @@ func (r *mutationResolver) RejectFinalFile(ctx context.Context, fileID string, r
 	panic(fmt.Errorf("not implemented: RejectFinalFile - rejectFinalFile"))
 }
 
+// GrantDeadlineExtension is the resolver for the grantDeadlineExtension field.
+func (r *mutationResolver) GrantDeadlineExtension(ctx context.Context, input model.GrantDeadlineExtensionInput) (*model.DeadlineExtension, error) {
+	return r.Ctrl.GrantDeadlineExtension(ctx, input)
+}
+
 // CreateGradeDefence is the resolver for the createGradeDefence field.
 func (r *mutationResolver) CreateGradeDefence(ctx context.Context, input model.CreateGradeDefenceInput) (*model.GradeDefence, error) {
-	panic(fmt.Errorf("not implemented: CreateGradeDefence - createGradeDefence"))
+	return r.Ctrl.CreateGradeDefence(ctx, input)
 }
 
 // UpdateGradeDefence is the resolver for the updateGradeDefence field.
 func (r *mutationResolver) UpdateGradeDefence(ctx context.Context, id string, input model.UpdateGradeDefenceInput) (*model.GradeDefence, error) {
-	panic(fmt.Errorf("not implemented: UpdateGradeDefence - updateGradeDefence"))
+	return r.Ctrl.UpdateGradeDefence(ctx, id, input)
 }
 
 // AddGradeDefenceCriterion is the resolver for the addGradeDefenceCriterion field.
 func (r *mutationResolver) AddGradeDefenceCriterion(ctx context.Context, input model.CreateGradeDefenceCriterionInput) (*model.GradeDefenceCriterion, error) {
-	panic(fmt.Errorf("not implemented: AddGradeDefenceCriterion - addGradeDefenceCriterion"))
+	return r.Ctrl.AddGradeDefenceCriterion(ctx, input)
 }
 
 // UpdateGradeDefenceCriterion is the resolver for the updateGradeDefenceCriterion field.
 func (r *mutationResolver) UpdateGradeDefenceCriterion(ctx context.Context, id string, input model.UpdateGradeDefenceCriterionInput) (*model.GradeDefenceCriterion, error) {
-	panic(fmt.Errorf("not implemented: UpdateGradeDefenceCriterion - updateGradeDefenceCriterion"))
+	return r.Ctrl.UpdateGradeDefenceCriterion(ctx, id, input)
 }
 
 // DeleteGradeDefenceCriterion is the resolver for the deleteGradeDefenceCriterion field.
 func (r *mutationResolver) DeleteGradeDefenceCriterion(ctx context.Context, id string) (bool, error) {
-	panic(fmt.Errorf("not implemented: DeleteGradeDefenceCriterion - deleteGradeDefenceCriterion"))
+	return r.Ctrl.DeleteGradeDefenceCriterion(ctx, id)
+}
+
+// RequestGradeAmendment is the resolver for the requestGradeAmendment field.
+func (r *mutationResolver) RequestGradeAmendment(ctx context.Context, input model.RequestGradeAmendmentInput) (*model.GradeAmendment, error) {
+	return r.Ctrl.RequestGradeAmendment(ctx, input)
+}
+
+// ResolveGradeAppeal is the resolver for the resolveGradeAppeal field.
+func (r *mutationResolver) ResolveGradeAppeal(ctx context.Context, input model.ResolveGradeAppealInput) (*model.GradeAppeal, error) {
+	return r.Ctrl.ResolveGradeAppeal(ctx, input)
 }
 
 // UpdateGradeReview is the resolver for the updateGradeReview field.
@@ -191,6 +226,21 @@ func (r *queryResolver) GetMySupervisedTopicCouncilDetail(ctx context.Context, i
 	panic(fmt.Errorf("not implemented: GetMySupervisedTopicCouncilDetail - getMySupervisedTopicCouncilDetail"))
 }
 
+// GetMyTopicApplicants is the resolver for the getMyTopicApplicants field.
+func (r *queryResolver) GetMyTopicApplicants(ctx context.Context, topicID string) ([]*model.TopicRegistration, error) {
+	return r.Ctrl.GetMyTopicApplicants(ctx, topicID)
+}
+
+// GetTopicCoSigns is the resolver for the getTopicCoSigns field.
+func (r *queryResolver) GetTopicCoSigns(ctx context.Context, topicID string) ([]*model.TopicCoSign, error) {
+	return r.Ctrl.GetTopicCoSigns(ctx, topicID)
+}
+
+// GetEnrollmentMilestoneCheckins is the resolver for the getEnrollmentMilestoneCheckins field.
+func (r *queryResolver) GetEnrollmentMilestoneCheckins(ctx context.Context, enrollmentID string) ([]*model.MilestoneCheckin, error) {
+	return r.Ctrl.GetEnrollmentMilestoneCheckins(ctx, enrollmentID)
+}
+
 // GetMyDefences is the resolver for the getMyDefences field.
 func (r *queryResolver) GetMyDefences(ctx context.Context, search *model.SearchRequestInput) (*model.CouncilDefenceListResponse, error) {
 	panic(fmt.Errorf("not implemented: GetMyDefences - getMyDefences"))
@@ -211,6 +261,11 @@ func (r *queryResolver) GetMyGradeReviewDetail(ctx context.Context, id string) (
 	panic(fmt.Errorf("not implemented: GetMyGradeReviewDetail - getMyGradeReviewDetail"))
 }
 
+// GetMyAssignedGradeAppeals is the resolver for the getMyAssignedGradeAppeals field.
+func (r *queryResolver) GetMyAssignedGradeAppeals(ctx context.Context, status *model.GradeAppealStatus) ([]*model.GradeAppeal, error) {
+	return r.Ctrl.GetMyAssignedGradeAppeals(ctx, status)
+}
+
 // Student is the resolver for the student field.
 func (r *reviewerEnrollmentResolver) Student(ctx context.Context, obj *model.ReviewerEnrollment) (*model.Student, error) {
 	panic(fmt.Errorf("not implemented: Student - student"))
@@ -236,6 +291,11 @@ func (r *reviewerEnrollmentResolver) GradeReview(ctx context.Context, obj *model
 	panic(fmt.Errorf("not implemented: GradeReview - gradeReview"))
 }
 
+// SimilarityReport is the resolver for the similarityReport field.
+func (r *reviewerEnrollmentResolver) SimilarityReport(ctx context.Context, obj *model.ReviewerEnrollment, topN *int32) (*model.SimilarityReport, error) {
+	return r.Ctrl.GetFinalSimilarityReport(ctx, obj.FinalCode, topN)
+}
+
 // Enrollment is the resolver for the enrollment field.
 func (r *reviewerGradeReviewResolver) Enrollment(ctx context.Context, obj *model.ReviewerGradeReview) (*model.ReviewerEnrollment, error) {
 	panic(fmt.Errorf("not implemented: Enrollment - enrollment"))
@@ -291,6 +351,11 @@ func (r *supervisorEnrollmentResolver) GradeDefences(ctx context.Context, obj *m
 	panic(fmt.Errorf("not implemented: GradeDefences - gradeDefences"))
 }
 
+// SimilarityReport is the resolver for the similarityReport field.
+func (r *supervisorEnrollmentResolver) SimilarityReport(ctx context.Context, obj *model.SupervisorEnrollment, topN *int32) (*model.SimilarityReport, error) {
+	return r.Ctrl.GetFinalSimilarityReport(ctx, obj.FinalCode, topN)
+}
+
 // Major is the resolver for the major field.
 func (r *supervisorTopicResolver) Major(ctx context.Context, obj *model.SupervisorTopic) (*model.MajorInfo, error) {
 	panic(fmt.Errorf("not implemented: Major - major"))
@@ -311,6 +376,11 @@ func (r *supervisorTopicResolver) TopicCouncils(ctx context.Context, obj *model.
 	panic(fmt.Errorf("not implemented: TopicCouncils - topicCouncils"))
 }
 
+// StatusHistory is the resolver for the statusHistory field.
+func (r *supervisorTopicResolver) StatusHistory(ctx context.Context, obj *model.SupervisorTopic) ([]*model.TopicStatusHistory, error) {
+	return r.Ctrl.GetTopicStatusHistory(ctx, obj.ID)
+}
+
 // Topic is the resolver for the topic field.
 func (r *supervisorTopicCouncilResolver) Topic(ctx context.Context, obj *model.SupervisorTopicCouncil) (*model.SupervisorTopic, error) {
 	panic(fmt.Errorf("not implemented: Topic - topic"))
@@ -56,6 +56,11 @@ func (r *topicResolver) TopicCouncils(ctx context.Context, obj *model.Topic) ([]
 	panic(fmt.Errorf("not implemented: TopicCouncils - topicCouncils"))
 }
 
+// StatusHistory is the resolver for the statusHistory field.
+func (r *topicResolver) StatusHistory(ctx context.Context, obj *model.Topic) ([]*model.TopicStatusHistory, error) {
+	return r.Ctrl.GetTopicStatusHistory(ctx, obj.ID)
+}
+
 // Topic is the resolver for the topic field.
 func (r *topicCouncilResolver) Topic(ctx context.Context, obj *model.TopicCouncil) (*model.Topic, error) {
 	panic(fmt.Errorf("not implemented: Topic - topic"))
@@ -0,0 +1,112 @@
+// Package audit keeps an append-only log of every mutating RPC. The gateway
+// sends who is calling as gRPC metadata; each service's interceptor
+// snapshots the touched rows before and after the call, in the handler's
+// transaction when it has one, and appends the differences to a Mongo
+// collection that is never updated or deleted from.
+package audit
+
+import (
+	"context"
+	"time"
+
+	"thaily/src/pkg/logger"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys carrying the caller from the gateway to the services
+const (
+	actorKey    = "x-audit-actor"
+	roleKey     = "x-audit-role"
+	semesterKey = "x-audit-semester"
+	clientIPKey = "x-audit-client-ip"
+)
+
+// Entry is one audited RPC
+type Entry struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	At        time.Time          `bson:"at" json:"at"`
+	Service   string             `bson:"service" json:"service"`
+	Method    string             `bson:"method" json:"method"`
+	Entity    string             `bson:"entity" json:"entity"`
+	EntityID  string             `bson:"entity_id,omitempty" json:"entity_id,omitempty"`
+	Actor     string             `bson:"actor,omitempty" json:"actor,omitempty"`
+	Role      string             `bson:"role,omitempty" json:"role,omitempty"`
+	Semester  string             `bson:"semester,omitempty" json:"semester,omitempty"`
+	RequestID string             `bson:"request_id,omitempty" json:"request_id,omitempty"`
+	ClientIP  string             `bson:"client_ip,omitempty" json:"client_ip,omitempty"`
+	Changes   []Change           `bson:"changes" json:"changes"`
+}
+
+// Change is a column whose value the RPC changed. Before is nil for a created
+// row and After is nil for a purged one.
+type Change struct {
+	Field  string      `bson:"field" json:"field"`
+	Before interface{} `bson:"before" json:"before"`
+	After  interface{} `bson:"after" json:"after"`
+}
+
+// Caller is who makes a request, as seen by the gateway
+type Caller struct {
+	Actor     string
+	Role      string
+	Semester  string
+	RequestID string
+	ClientIP  string
+}
+
+type callerKey struct{}
+
+// WithCaller stores the caller in the context for UnaryClientInterceptor
+func WithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFrom returns the caller stored by WithCaller
+func CallerFrom(ctx context.Context) (Caller, bool) {
+	caller, ok := ctx.Value(callerKey{}).(Caller)
+	return caller, ok
+}
+
+// UnaryClientInterceptor forwards the caller of the context to the service
+// as metadata
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if caller, ok := CallerFrom(ctx); ok {
+			pairs := []string{}
+			for key, val := range map[string]string{
+				actorKey:               caller.Actor,
+				roleKey:                caller.Role,
+				semesterKey:            caller.Semester,
+				clientIPKey:            caller.ClientIP,
+				logger.RequestIDHeader: caller.RequestID,
+			} {
+				if val != "" {
+					pairs = append(pairs, key, val)
+				}
+			}
+			ctx = metadata.AppendToOutgoingContext(ctx, pairs...)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// incomingCaller reads the caller the gateway forwarded
+func incomingCaller(ctx context.Context) Caller {
+	md, _ := metadata.FromIncomingContext(ctx)
+	first := func(key string) string {
+		if vals := md.Get(key); len(vals) > 0 {
+			return vals[0]
+		}
+		return ""
+	}
+	return Caller{
+		Actor:     first(actorKey),
+		Role:      first(roleKey),
+		Semester:  first(semesterKey),
+		RequestID: first(logger.RequestIDHeader),
+		ClientIP:  first(clientIPKey),
+	}
+}
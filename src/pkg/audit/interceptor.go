@@ -0,0 +1,319 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"thaily/src/pkg/logger"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// readPrefixes start the names of the RPCs that change nothing
+var readPrefixes = []string{"Get", "List", "Search", "Check", "Validate", "Preview"}
+
+// actorFields are the request fields naming the actor, for calls that did
+// not come through the gateway
+var actorFields = []string{"actor", "updated_by", "created_by", "deleted_by", "restored_by"}
+
+// Entity maps the entity named in RPC names, e.g. GradeDefence in
+// UpdateGradeDefence or LockCouncilGrades' Council, to its table. Key is the
+// column identifying a row, "id" when empty.
+type Entity struct {
+	Name  string
+	Table string
+	Key   string
+}
+
+func (e Entity) key() string {
+	if e.Key == "" {
+		return "id"
+	}
+	return e.Key
+}
+
+// UnaryServerInterceptor appends an entry for every successful mutating RPC
+// of the service. The row of the entity the RPC names, and every row the
+// handler tracks besides, is read before and after the call and the changed
+// columns are recorded, one entry per row. Rows written in a transaction
+// begun with BeginTx are read in it (see Recorder); the others are read
+// around the call. A nil store audits nothing; a failed write is logged and
+// does not fail the RPC, which has already been committed.
+func UnaryServerInterceptor(store *Store, db *sql.DB, service string, entities []Entity) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		method := path.Base(info.FullMethod)
+		if store == nil || !isMutation(method) {
+			return handler(ctx, req)
+		}
+
+		rec := newRecorder(entities)
+		ctx = context.WithValue(ctx, recorderKey{}, rec)
+
+		entity := matchEntity(entities, method)
+		id := requestID(req, entity)
+		if entity.Table != "" && id != "" {
+			row := rec.add(entity, id)
+			var err error
+			if row.before, err = snapshot(ctx, db, entity, id, false); err != nil {
+				log.Printf("Audit: failed to read %s %s before %s: %v", entity.Name, id, method, err)
+			}
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+
+		if id == "" {
+			id = responseID(resp)
+			if entity.Table != "" && id != "" {
+				rec.add(entity, id)
+			}
+		}
+		for _, row := range rec.rows {
+			if row.settled {
+				continue
+			}
+			if row.after, err = snapshot(ctx, db, row.entity, row.id, false); err != nil {
+				log.Printf("Audit: failed to read %s %s after %s: %v", row.entity.Name, row.id, method, err)
+			}
+		}
+
+		caller := incomingCaller(ctx)
+		if caller.Actor == "" {
+			caller.Actor = requestActor(req)
+		}
+		if caller.RequestID == "" {
+			caller.RequestID = logger.GetRequestID(ctx)
+		}
+		if caller.ClientIP == "" {
+			if p, ok := peer.FromContext(ctx); ok {
+				caller.ClientIP = p.Addr.String()
+			}
+		}
+
+		// The row the RPC names is always recorded, the others when changed
+		entries := []*Entry{{Entity: entity.Name, EntityID: id, Changes: []Change{}}}
+		for _, row := range rec.rows {
+			changes := diff(row.before, row.after)
+			if row.entity.Table == entity.Table && row.entity.key() == entity.key() && row.id == id {
+				entries[0].Changes = changes
+			} else if len(changes) > 0 {
+				entries = append(entries, &Entry{Entity: row.entity.Name, EntityID: row.id, Changes: changes})
+			}
+		}
+		now := time.Now()
+		for _, entry := range entries {
+			entry.At = now
+			entry.Service = service
+			entry.Method = method
+			entry.Actor = caller.Actor
+			entry.Role = caller.Role
+			entry.Semester = caller.Semester
+			entry.RequestID = caller.RequestID
+			entry.ClientIP = caller.ClientIP
+			if err := store.Append(context.WithoutCancel(ctx), entry); err != nil {
+				log.Printf("Audit: %s %s %s by %q not recorded: %v", method, entry.Entity, entry.EntityID, caller.Actor, err)
+			}
+		}
+		return resp, nil
+	}
+}
+
+// isMutation reports whether an RPC may change data
+func isMutation(method string) bool {
+	for _, prefix := range readPrefixes {
+		if atWord(method, len(prefix)) && strings.HasPrefix(method, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// atWord reports whether a word of a CamelCase name ends at i
+func atWord(name string, i int) bool {
+	return i >= len(name) || (name[i] >= 'A' && name[i] <= 'Z')
+}
+
+// matchEntity returns the longest entity named in the method. An RPC naming
+// none is recorded under the name with its verb dropped, without snapshots.
+func matchEntity(entities []Entity, method string) Entity {
+	var best Entity
+	for _, e := range entities {
+		if len(e.Name) <= len(best.Name) {
+			continue
+		}
+		for i := strings.Index(method, e.Name); i >= 0; {
+			if i > 0 && atWord(method, i) && atWord(method, i+len(e.Name)) {
+				best = e
+				break
+			}
+			next := strings.Index(method[i+1:], e.Name)
+			if next < 0 {
+				break
+			}
+			i += next + 1
+		}
+	}
+	if best.Name != "" {
+		return best
+	}
+	for i := 1; i < len(method); i++ {
+		if atWord(method, i) {
+			return Entity{Name: method[i:]}
+		}
+	}
+	return Entity{Name: method}
+}
+
+// requestID finds the id of the touched row in the request: its id, the
+// entity's key column or <entity>_code / <entity>_id
+func requestID(req interface{}, entity Entity) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+	snake := toSnake(entity.Name)
+	for _, name := range []string{"id", entity.key(), snake + "_code", snake + "_id"} {
+		if id := stringField(msg.ProtoReflect(), name); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// responseID finds the id of a created row: the id of the first record the
+// response holds
+func responseID(resp interface{}) string {
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return ""
+	}
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() || !m.Has(fd) {
+			continue
+		}
+		if id := stringField(m.Get(fd).Message(), "id"); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// requestActor finds who acts in the request fields
+func requestActor(req interface{}) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+	for _, name := range actorFields {
+		if actor := stringField(msg.ProtoReflect(), name); actor != "" {
+			return actor
+		}
+	}
+	return ""
+}
+
+func stringField(m protoreflect.Message, name string) string {
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() || fd.IsMap() {
+		return ""
+	}
+	return m.Get(fd).String()
+}
+
+// toSnake turns GradeDefence into grade_defence
+func toSnake(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if r >= 'A' && r <= 'Z' {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r += 'a' - 'A'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// snapshot reads a row as column -> value, nil when it does not exist. lock
+// reads it FOR UPDATE, in the transaction about to write it.
+func snapshot(ctx context.Context, q querier, entity Entity, id string, lock bool) (map[string]interface{}, error) {
+	query := fmt.Sprintf("SELECT * FROM `%s` WHERE `%s` = ?", entity.Table, entity.key())
+	if lock {
+		query += " FOR UPDATE"
+	}
+	rows, err := q.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+
+	values := make([]interface{}, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	row := make(map[string]interface{}, len(columns))
+	for i, column := range columns {
+		if b, ok := values[i].([]byte); ok {
+			row[column] = string(b)
+		} else {
+			row[column] = values[i]
+		}
+	}
+	return row, nil
+}
+
+// diff lists the columns whose value differs, by name
+func diff(before, after map[string]interface{}) []Change {
+	fields := map[string]bool{}
+	for field := range before {
+		fields[field] = true
+	}
+	for field := range after {
+		fields[field] = true
+	}
+
+	changes := []Change{}
+	for field := range fields {
+		b, a := before[field], after[field]
+		if reflect.DeepEqual(b, a) {
+			continue
+		}
+		changes = append(changes, Change{Field: field, Before: b, After: a})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	return changes
+}
@@ -0,0 +1,151 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"log"
+)
+
+// querier is satisfied by both *sql.DB and *sql.Tx
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// Recorder collects the rows a mutating RPC touches. The interceptor puts one
+// in the context of every audited call with the row the RPC names; handlers
+// writing more rows, like the composite RPCs, track those too. Snapshots taken
+// in the handler's transaction replace the ones the interceptor takes around
+// the call, so a concurrent write cannot slip into the recorded difference.
+type Recorder struct {
+	entities []Entity
+	rows     []*trackedRow
+	index    map[string]*trackedRow
+}
+
+// trackedRow is a row of the RPC with its snapshots; inTx tells whether
+// before was read, under lock, in the transaction writing the row
+type trackedRow struct {
+	entity Entity
+	id     string
+	before map[string]interface{}
+	after  map[string]interface{}
+	inTx   bool
+	// settled is set once after was read in the transaction before commit
+	settled bool
+}
+
+type recorderKey struct{}
+
+func newRecorder(entities []Entity) *Recorder {
+	return &Recorder{entities: entities, index: map[string]*trackedRow{}}
+}
+
+func recorderFrom(ctx context.Context) *Recorder {
+	rec, _ := ctx.Value(recorderKey{}).(*Recorder)
+	return rec
+}
+
+// add registers a row, returning nil when it already is
+func (r *Recorder) add(entity Entity, id string) *trackedRow {
+	key := entity.Table + "|" + entity.key() + "|" + id
+	if r.index[key] != nil {
+		return nil
+	}
+	row := &trackedRow{entity: entity, id: id}
+	r.index[key] = row
+	r.rows = append(r.rows, row)
+	return row
+}
+
+// entity returns the first audited entity kept in table
+func (r *Recorder) entity(table string) (Entity, bool) {
+	for _, e := range r.entities {
+		if e.Table == table && e.Key == "" {
+			return e, true
+		}
+	}
+	return Entity{}, false
+}
+
+// Track snapshots, in the transaction tx about to write it, a row of table
+// the RPC touches besides the one it names. The row is read FOR UPDATE so the
+// snapshot is the version the write replaces. Outside an audited call, or
+// for a table not audited, it does nothing.
+func Track(ctx context.Context, tx *sql.Tx, table, id string) {
+	rec := recorderFrom(ctx)
+	if rec == nil || id == "" {
+		return
+	}
+	entity, ok := rec.entity(table)
+	if !ok {
+		return
+	}
+	row := rec.add(entity, id)
+	if row == nil {
+		return
+	}
+	before, err := snapshot(ctx, tx, entity, id, true)
+	if err != nil {
+		log.Printf("Audit: failed to read %s %s before the write: %v", entity.Name, id, err)
+	}
+	row.before, row.inTx = before, true
+}
+
+// Created records a row of table the RPC inserts besides the one it names; it
+// has no before snapshot and its after snapshot is read when the transaction
+// commits
+func Created(ctx context.Context, table, id string) {
+	rec := recorderFrom(ctx)
+	if rec == nil || id == "" {
+		return
+	}
+	if entity, ok := rec.entity(table); ok {
+		if row := rec.add(entity, id); row != nil {
+			row.inTx = true
+		}
+	}
+}
+
+// BeginTx begins a transaction and snapshots in it, under lock, the row the
+// RPC names before the handler writes it
+func BeginTx(ctx context.Context, db *sql.DB) (*sql.Tx, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	rec := recorderFrom(ctx)
+	if rec == nil {
+		return tx, nil
+	}
+	for _, row := range rec.rows {
+		if row.inTx {
+			continue
+		}
+		before, err := snapshot(ctx, tx, row.entity, row.id, true)
+		if err != nil {
+			log.Printf("Audit: failed to read %s %s before the write: %v", row.entity.Name, row.id, err)
+			continue
+		}
+		row.before, row.inTx = before, true
+	}
+	return tx, nil
+}
+
+// Commit snapshots every tracked row as the transaction leaves it, then
+// commits it
+func Commit(ctx context.Context, tx *sql.Tx) error {
+	if rec := recorderFrom(ctx); rec != nil {
+		for _, row := range rec.rows {
+			if !row.inTx {
+				continue
+			}
+			after, err := snapshot(ctx, tx, row.entity, row.id, false)
+			if err != nil {
+				log.Printf("Audit: failed to read %s %s after the write: %v", row.entity.Name, row.id, err)
+				continue
+			}
+			row.after, row.settled = after, true
+		}
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,143 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// Collection holds the audit entries
+	Collection = "audit_log"
+	// maxEntries bounds the entries returned by one Find
+	maxEntries = 500
+	// writeTimeout bounds appending one entry
+	writeTimeout = 5 * time.Second
+)
+
+// Store appends to and reads the audit collection. It has no way to update
+// or delete an entry.
+type Store struct {
+	collection *mongo.Collection
+}
+
+// NewStore uses the audit collection of the database
+func NewStore(db *mongo.Database) *Store {
+	return &Store{collection: db.Collection(Collection)}
+}
+
+// Connect opens the audit store of a service from MONGO_URI and
+// MONGO_DATABASE, the database the gateway reads it from. Without MONGO_URI
+// it returns nil, which audits nothing.
+func Connect(ctx context.Context) (*Store, func(), error) {
+	uri := os.Getenv("MONGO_URI")
+	if uri == "" {
+		log.Printf("Warning: MONGO_URI not set, mutations are not audited")
+		return nil, func() {}, nil
+	}
+	database := os.Getenv("MONGO_DATABASE")
+	if database == "" {
+		database = "thesis_db"
+	}
+
+	clientOptions := options.Client().ApplyURI(uri)
+	if user := os.Getenv("MONGO_USERNAME"); user != "" {
+		credential := options.Credential{Username: user, Password: os.Getenv("MONGO_PASSWORD")}
+		if source := os.Getenv("MONGO_AUTH_SOURCE"); source != "" {
+			credential.AuthSource = source
+		}
+		clientOptions.SetAuth(credential)
+	}
+
+	connectCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	client, err := mongo.Connect(connectCtx, clientOptions)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to MongoDB: %w", err)
+	}
+	closeFn := func() { client.Disconnect(context.Background()) }
+
+	store := NewStore(client.Database(database))
+	if err := store.ensureIndexes(connectCtx); err != nil {
+		closeFn()
+		return nil, nil, err
+	}
+	return store, closeFn, nil
+}
+
+// ensureIndexes creates the indexes of the auditLog query filters
+func (s *Store) ensureIndexes(ctx context.Context) error {
+	_, err := s.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "entity", Value: 1}, {Key: "entity_id", Value: 1}, {Key: "at", Value: -1}}},
+		{Keys: bson.D{{Key: "actor", Value: 1}, {Key: "at", Value: -1}}},
+		{Keys: bson.D{{Key: "at", Value: -1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create audit indexes: %w", err)
+	}
+	return nil
+}
+
+// Append adds an entry
+func (s *Store) Append(ctx context.Context, entry *Entry) error {
+	ctx, cancel := context.WithTimeout(ctx, writeTimeout)
+	defer cancel()
+
+	if _, err := s.collection.InsertOne(ctx, entry); err != nil {
+		return fmt.Errorf("failed to append audit entry: %w", err)
+	}
+	return nil
+}
+
+// Filter narrows Find; empty fields match everything
+type Filter struct {
+	Entity   string
+	EntityID string
+	Actor    string
+	From     *time.Time
+	To       *time.Time
+}
+
+// Find returns the newest entries matching the filter
+func (s *Store) Find(ctx context.Context, filter Filter) ([]*Entry, error) {
+	query := bson.M{}
+	if filter.Entity != "" {
+		query["entity"] = filter.Entity
+	}
+	if filter.EntityID != "" {
+		query["entity_id"] = filter.EntityID
+	}
+	if filter.Actor != "" {
+		query["actor"] = filter.Actor
+	}
+	if filter.From != nil || filter.To != nil {
+		at := bson.M{}
+		if filter.From != nil {
+			at["$gte"] = *filter.From
+		}
+		if filter.To != nil {
+			at["$lte"] = *filter.To
+		}
+		query["at"] = at
+	}
+
+	cursor, err := s.collection.Find(ctx, query, options.Find().
+		SetSort(bson.D{{Key: "at", Value: -1}}).
+		SetLimit(maxEntries))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	entries := []*Entry{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+	return entries, nil
+}
@@ -24,6 +24,7 @@ type Clients struct {
 	MinIO    *client.ServiceMinIo
 	Redis    *client.RedisClient
 	MongoDB  *client.MongoClient
+	Scanner  client.Scanner
 }
 
 // New tạo container mới và khởi tạo tất cả dependencies
@@ -88,6 +89,12 @@ func initClients(cfg *config.Config) (*Clients, error) {
 		return nil, fmt.Errorf("mongodb client: %w", err)
 	}
 
+	// Initialize malware scanner for uploads
+	scanner, err := client.NewScanner(cfg.Upload)
+	if err != nil {
+		return nil, fmt.Errorf("scanner: %w", err)
+	}
+
 	return &Clients{
 		Academic: academic,
 		Council:  council,
@@ -98,5 +105,6 @@ func initClients(cfg *config.Config) (*Clients, error) {
 		MinIO:    minio,
 		Redis:    redis,
 		MongoDB:  mongodb,
+		Scanner:  scanner,
 	}, nil
 }
@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -26,7 +27,7 @@ type ConnectionPoolConfig struct {
 }
 
 // DefaultConnectionPoolConfig trả về config mặc định
-// Với 6 services cùng dùng 1 DB (max_connections=151), mỗi service dùng tối đa 20-25 connections
+// Với 6 services cùng dùng 1 MySQL server (max_connections=151), mỗi service dùng tối đa 20-25 connections
 func DefaultConnectionPoolConfig() ConnectionPoolConfig {
 	return ConnectionPoolConfig{
 		MaxOpenConns:    20, // Giảm xuống 20 để an toàn hơn (6 services * 20 = 120 < 151)
@@ -110,18 +111,38 @@ func ConnectWithConfig(dsn string, config ConnectionPoolConfig) (*sql.DB, error)
 	return db, nil
 }
 
-// InitDB initializes the global database connection from environment variables
-func InitDB() error {
-	dbHost := os.Getenv("DB_HOST")
-	dbPort := os.Getenv("DB_PORT")
-	dbUser := os.Getenv("DB_USER")
-	dbPassword := os.Getenv("DB_PASSWORD")
-	dbName := os.Getenv("DB_NAME")
+// serviceEnv reads <SERVICE>_<key>, falling back to the shared <key>
+func serviceEnv(service, key string) string {
+	if service != "" {
+		if val := os.Getenv(strings.ToUpper(service) + "_" + key); val != "" {
+			return val
+		}
+	}
+	return os.Getenv(key)
+}
+
+// InitDB initializes the global database connection of a service from
+// environment variables. Every service owns its tables, so each may point
+// at its own database: <SERVICE>_DB_NAME (e.g. THESIS_DB_NAME) and the other
+// <SERVICE>_DB_* variables override the shared DB_* ones. With DB_CREATE set
+// to "true" a missing database is created before connecting.
+func InitDB(service string) error {
+	dbHost := serviceEnv(service, "DB_HOST")
+	dbPort := serviceEnv(service, "DB_PORT")
+	dbUser := serviceEnv(service, "DB_USER")
+	dbPassword := serviceEnv(service, "DB_PASSWORD")
+	dbName := serviceEnv(service, "DB_NAME")
 
 	if dbHost == "" || dbPort == "" || dbUser == "" || dbName == "" {
 		return fmt.Errorf("missing required database environment variables")
 	}
 
+	if serviceEnv(service, "DB_CREATE") == "true" {
+		if err := createDatabase(dbUser, dbPassword, dbHost, dbPort, dbName); err != nil {
+			return err
+		}
+	}
+
 	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&loc=Local",
 		dbUser, dbPassword, dbHost, dbPort, dbName)
 
@@ -134,6 +155,26 @@ func InitDB() error {
 	globalDB = db
 	dbMutex.Unlock()
 
+	log.Printf("  - Database: %s", dbName)
+	return nil
+}
+
+// createDatabase creates the service's database when it does not exist yet
+func createDatabase(user, password, host, port, name string) error {
+	if strings.Contains(name, "`") {
+		return fmt.Errorf("invalid database name %q", name)
+	}
+
+	db, err := sql.Open("mysql", fmt.Sprintf("%s:%s@tcp(%s:%s)/", user, password, host, port))
+	if err != nil {
+		return fmt.Errorf("failed to open database server: %w", err)
+	}
+	defer db.Close()
+
+	query := fmt.Sprintf("CREATE DATABASE IF NOT EXISTS `%s` CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci", name)
+	if _, err := db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create database %s: %w", name, err)
+	}
 	return nil
 }
 
@@ -0,0 +1,225 @@
+// Package grading derives a thesis' final grade from its supervisor, reviewer
+// and council scores under the grading policy of its major, semester and stage.
+package grading
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Rounding is how the weighted grade is brought onto the policy's step
+type Rounding int
+
+const (
+	RoundHalfUp Rounding = iota
+	RoundDown
+	RoundUp
+)
+
+func (r Rounding) String() string {
+	switch r {
+	case RoundDown:
+		return "down"
+	case RoundUp:
+		return "up"
+	default:
+		return "half up"
+	}
+}
+
+// Scale is the upper bound of every score and of the final grade
+const Scale = 10
+
+// Component names, also used in Result.Missing
+const (
+	Supervisor = "supervisor"
+	Reviewer   = "reviewer"
+	Council    = "council"
+)
+
+// Policy weighs the three components of a final grade. A component with a
+// zero weight is not required.
+type Policy struct {
+	SupervisorWeight float64
+	ReviewerWeight   float64
+	CouncilWeight    float64
+	Rounding         Rounding
+	// Step the grade is rounded to, e.g. 0.5 or 0.1
+	Step          float64
+	PassThreshold float64
+	// MaxCouncilSpread is the largest gap allowed between the highest and the
+	// lowest council member's score; 0 disables the check
+	MaxCouncilSpread float64
+}
+
+// Validate reports the first inconsistency of a policy
+func (p Policy) Validate() error {
+	if p.SupervisorWeight < 0 || p.ReviewerWeight < 0 || p.CouncilWeight < 0 {
+		return fmt.Errorf("weights must not be negative")
+	}
+	if p.SupervisorWeight+p.ReviewerWeight+p.CouncilWeight <= 0 {
+		return fmt.Errorf("at least one weight must be positive")
+	}
+	if p.Step <= 0 || p.Step > Scale {
+		return fmt.Errorf("rounding step must be in (0, %d]", Scale)
+	}
+	if p.PassThreshold < 0 || p.PassThreshold > Scale {
+		return fmt.Errorf("pass threshold must be in [0, %d]", Scale)
+	}
+	if p.MaxCouncilSpread < 0 {
+		return fmt.Errorf("max council spread must not be negative")
+	}
+	return nil
+}
+
+// CouncilScore is one council member's total; Score is nil until they finish grading
+type CouncilScore struct {
+	Member string
+	Score  *float64
+}
+
+// Input holds the scores of one enrollment; nil means not graded yet
+type Input struct {
+	Supervisor *float64
+	Reviewer   *float64
+	Council    []CouncilScore
+}
+
+// Component is one weighted part of the final grade
+type Component struct {
+	Name   string
+	Score  *float64
+	Weight float64
+}
+
+// Result is the grade and how it was reached. Grade is nil while inputs are
+// missing or the council needs to reconcile.
+type Result struct {
+	Components []Component
+	// CouncilSpread is the gap between the council's highest and lowest score
+	CouncilSpread       *float64
+	NeedsReconciliation bool
+	Missing             []string
+	Raw                 *float64
+	Grade               *float64
+	Passed              bool
+	// Steps explain the calculation in order
+	Steps []string
+}
+
+// Ready reports whether the grade can be finalized
+func (r *Result) Ready() bool {
+	return r.Grade != nil
+}
+
+// Compute applies a policy to the scores of one enrollment
+func Compute(p Policy, in Input) *Result {
+	r := &Result{}
+
+	council, ok := r.councilAverage(p, in.Council)
+	r.Components = []Component{
+		{Name: Supervisor, Score: in.Supervisor, Weight: p.SupervisorWeight},
+		{Name: Reviewer, Score: in.Reviewer, Weight: p.ReviewerWeight},
+		{Name: Council, Weight: p.CouncilWeight},
+	}
+	if ok {
+		r.Components[2].Score = &council
+	}
+
+	sum, weights := 0.0, 0.0
+	terms := []string{}
+	for _, c := range r.Components {
+		if c.Weight == 0 {
+			continue
+		}
+		if c.Score == nil {
+			if c.Name != Council {
+				r.Missing = append(r.Missing, c.Name)
+				r.Steps = append(r.Steps, fmt.Sprintf("%s score is missing", c.Name))
+			}
+			continue
+		}
+		sum += *c.Score * c.Weight
+		weights += c.Weight
+		terms = append(terms, fmt.Sprintf("%s %s × %s", c.Name, format(*c.Score), format(c.Weight)))
+	}
+	if len(r.Missing) > 0 || r.NeedsReconciliation {
+		return r
+	}
+
+	raw := round(sum/weights, 0.01, RoundHalfUp)
+	r.Raw = &raw
+	r.Steps = append(r.Steps, fmt.Sprintf("weighted grade = (%s) / %s = %s", strings.Join(terms, " + "), format(weights), format(raw)))
+
+	grade := math.Min(round(raw, p.Step, p.Rounding), Scale)
+	r.Grade = &grade
+	r.Steps = append(r.Steps, fmt.Sprintf("rounded %s to a step of %s: %s", p.Rounding, format(p.Step), format(grade)))
+
+	r.Passed = grade >= p.PassThreshold
+	if r.Passed {
+		r.Steps = append(r.Steps, fmt.Sprintf("%s ≥ pass threshold %s: passed", format(grade), format(p.PassThreshold)))
+	} else {
+		r.Steps = append(r.Steps, fmt.Sprintf("%s < pass threshold %s: failed", format(grade), format(p.PassThreshold)))
+	}
+	return r
+}
+
+// councilAverage averages the council members' scores once every member has
+// graded and their spread is within the policy
+func (r *Result) councilAverage(p Policy, scores []CouncilScore) (float64, bool) {
+	if p.CouncilWeight == 0 {
+		return 0, false
+	}
+	if len(scores) == 0 {
+		r.Missing = append(r.Missing, Council)
+		r.Steps = append(r.Steps, "council has no member")
+		return 0, false
+	}
+
+	lo, hi, sum := math.Inf(1), math.Inf(-1), 0.0
+	graded := 0
+	for _, s := range scores {
+		if s.Score == nil {
+			r.Missing = append(r.Missing, Council+":"+s.Member)
+			r.Steps = append(r.Steps, fmt.Sprintf("council member %s has not graded", s.Member))
+			continue
+		}
+		lo, hi, sum = math.Min(lo, *s.Score), math.Max(hi, *s.Score), sum+*s.Score
+		graded++
+	}
+	if graded < len(scores) {
+		return 0, false
+	}
+
+	spread := round(hi-lo, 0.01, RoundHalfUp)
+	r.CouncilSpread = &spread
+	if p.MaxCouncilSpread > 0 && spread > p.MaxCouncilSpread {
+		r.NeedsReconciliation = true
+		r.Steps = append(r.Steps, fmt.Sprintf("council scores spread %s > allowed %s: the council must reconcile", format(spread), format(p.MaxCouncilSpread)))
+		return 0, false
+	}
+
+	average := round(sum/float64(len(scores)), 0.01, RoundHalfUp)
+	r.Steps = append(r.Steps, fmt.Sprintf("council average of %d members = %s (spread %s)", len(scores), format(average), format(spread)))
+	return average, true
+}
+
+// round brings v onto a multiple of step
+func round(v, step float64, mode Rounding) float64 {
+	// Absorb binary representation error before flooring, so 7.45 / 0.05 is 149
+	n := math.Round(v/step*1e6) / 1e6
+	switch mode {
+	case RoundDown:
+		n = math.Floor(n)
+	case RoundUp:
+		n = math.Ceil(n)
+	default:
+		n = math.Floor(n + 0.5)
+	}
+	return math.Round(n*step*100) / 100
+}
+
+func format(v float64) string {
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.2f", v), "0"), ".")
+}
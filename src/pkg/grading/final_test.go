@@ -0,0 +1,210 @@
+package grading
+
+import (
+	"reflect"
+	"testing"
+)
+
+func score(v float64) *float64 { return &v }
+
+func TestRound(t *testing.T) {
+	cases := []struct {
+		v    float64
+		step float64
+		mode Rounding
+		want float64
+	}{
+		{7.25, 0.5, RoundHalfUp, 7.5},
+		{7.24, 0.5, RoundHalfUp, 7},
+		{7.75, 0.5, RoundDown, 7.5},
+		{7.01, 0.5, RoundUp, 7.5},
+		{7, 0.5, RoundUp, 7},
+		{7.45, 0.05, RoundDown, 7.45},
+		{7.45, 0.1, RoundHalfUp, 7.5},
+		{8.15, 0.1, RoundDown, 8.1},
+		{8.15, 0.1, RoundUp, 8.2},
+		{6.666, 0.01, RoundHalfUp, 6.67},
+		{0, 0.5, RoundUp, 0},
+		{9.99, 1, RoundHalfUp, 10},
+	}
+	for _, tc := range cases {
+		if got := round(tc.v, tc.step, tc.mode); got != tc.want {
+			t.Errorf("round(%v, %v, %s) = %v, want %v", tc.v, tc.step, tc.mode, got, tc.want)
+		}
+	}
+}
+
+func TestCompute(t *testing.T) {
+	policy := Policy{SupervisorWeight: 0.3, ReviewerWeight: 0.3, CouncilWeight: 0.4, Step: 0.5, PassThreshold: 5, MaxCouncilSpread: 2}
+	council := func(scores ...*float64) []CouncilScore {
+		out := make([]CouncilScore, len(scores))
+		for i, s := range scores {
+			out[i] = CouncilScore{Member: string(rune('A' + i)), Score: s}
+		}
+		return out
+	}
+
+	cases := []struct {
+		name        string
+		policy      Policy
+		in          Input
+		wantGrade   *float64
+		wantRaw     *float64
+		wantPassed  bool
+		wantMissing []string
+		wantReconc  bool
+		wantSpread  *float64
+	}{
+		{
+			name:       "weighted average rounded half up",
+			policy:     policy,
+			in:         Input{Supervisor: score(8), Reviewer: score(7), Council: council(score(7), score(8))},
+			wantRaw:    score(7.5),
+			wantGrade:  score(7.5),
+			wantPassed: true,
+			wantSpread: score(1),
+		},
+		{
+			name:       "rounding down the same raw grade",
+			policy:     Policy{SupervisorWeight: 1, Step: 0.5, Rounding: RoundDown, PassThreshold: 5},
+			in:         Input{Supervisor: score(7.4)},
+			wantRaw:    score(7.4),
+			wantGrade:  score(7),
+			wantPassed: true,
+		},
+		{
+			name:       "grade below the pass threshold fails",
+			policy:     policy,
+			in:         Input{Supervisor: score(4), Reviewer: score(4), Council: council(score(5), score(5))},
+			wantRaw:    score(4.4),
+			wantGrade:  score(4.5),
+			wantSpread: score(0),
+		},
+		{
+			name:       "grade equal to the pass threshold passes",
+			policy:     Policy{SupervisorWeight: 1, Step: 0.5, PassThreshold: 5},
+			in:         Input{Supervisor: score(5)},
+			wantRaw:    score(5),
+			wantGrade:  score(5),
+			wantPassed: true,
+		},
+		{
+			name:       "rounding up never exceeds the scale",
+			policy:     Policy{SupervisorWeight: 1, Step: 3, Rounding: RoundUp},
+			in:         Input{Supervisor: score(9.5)},
+			wantRaw:    score(9.5),
+			wantGrade:  score(10),
+			wantPassed: true,
+		},
+		{
+			name:       "zero-weight component is not required",
+			policy:     Policy{SupervisorWeight: 0.5, CouncilWeight: 0.5, Step: 0.1, PassThreshold: 5},
+			in:         Input{Supervisor: score(6), Council: council(score(7))},
+			wantRaw:    score(6.5),
+			wantGrade:  score(6.5),
+			wantPassed: true,
+			wantSpread: score(0),
+		},
+		{
+			name:        "missing supervisor and reviewer",
+			policy:      policy,
+			in:          Input{Council: council(score(7))},
+			wantMissing: []string{Supervisor, Reviewer},
+			wantSpread:  score(0),
+		},
+		{
+			name:        "council member who has not graded",
+			policy:      policy,
+			in:          Input{Supervisor: score(8), Reviewer: score(7), Council: council(score(7), nil)},
+			wantMissing: []string{Council + ":B"},
+		},
+		{
+			name:        "council without members",
+			policy:      policy,
+			in:          Input{Supervisor: score(8), Reviewer: score(7)},
+			wantMissing: []string{Council},
+		},
+		{
+			name:       "spread at the limit is accepted",
+			policy:     policy,
+			in:         Input{Supervisor: score(8), Reviewer: score(8), Council: council(score(6), score(8))},
+			wantRaw:    score(7.6),
+			wantGrade:  score(7.5),
+			wantPassed: true,
+			wantSpread: score(2),
+		},
+		{
+			name:       "spread over the limit needs reconciliation",
+			policy:     policy,
+			in:         Input{Supervisor: score(8), Reviewer: score(8), Council: council(score(5.5), score(8))},
+			wantReconc: true,
+			wantSpread: score(2.5),
+		},
+		{
+			name:       "spread check disabled",
+			policy:     Policy{CouncilWeight: 1, Step: 0.5},
+			in:         Input{Council: council(score(2), score(9))},
+			wantRaw:    score(5.5),
+			wantGrade:  score(5.5),
+			wantPassed: true,
+			wantSpread: score(7),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := Compute(tc.policy, tc.in)
+			if !reflect.DeepEqual(r.Grade, tc.wantGrade) {
+				t.Errorf("Grade = %v, want %v", deref(r.Grade), deref(tc.wantGrade))
+			}
+			if !reflect.DeepEqual(r.Raw, tc.wantRaw) {
+				t.Errorf("Raw = %v, want %v", deref(r.Raw), deref(tc.wantRaw))
+			}
+			if r.Passed != tc.wantPassed {
+				t.Errorf("Passed = %v, want %v", r.Passed, tc.wantPassed)
+			}
+			if !reflect.DeepEqual(r.Missing, tc.wantMissing) {
+				t.Errorf("Missing = %v, want %v", r.Missing, tc.wantMissing)
+			}
+			if r.NeedsReconciliation != tc.wantReconc {
+				t.Errorf("NeedsReconciliation = %v, want %v", r.NeedsReconciliation, tc.wantReconc)
+			}
+			if !reflect.DeepEqual(r.CouncilSpread, tc.wantSpread) {
+				t.Errorf("CouncilSpread = %v, want %v", deref(r.CouncilSpread), deref(tc.wantSpread))
+			}
+			if r.Ready() != (tc.wantGrade != nil) {
+				t.Errorf("Ready() = %v", r.Ready())
+			}
+		})
+	}
+}
+
+func TestPolicyValidate(t *testing.T) {
+	cases := []struct {
+		name    string
+		policy  Policy
+		wantErr bool
+	}{
+		{"valid", Policy{SupervisorWeight: 1, Step: 0.5, PassThreshold: 5}, false},
+		{"negative weight", Policy{SupervisorWeight: 1, ReviewerWeight: -1, Step: 0.5}, true},
+		{"no positive weight", Policy{Step: 0.5}, true},
+		{"zero step", Policy{SupervisorWeight: 1}, true},
+		{"step over the scale", Policy{SupervisorWeight: 1, Step: Scale + 1}, true},
+		{"pass threshold over the scale", Policy{SupervisorWeight: 1, Step: 1, PassThreshold: Scale + 1}, true},
+		{"negative spread", Policy{SupervisorWeight: 1, Step: 1, MaxCouncilSpread: -1}, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.policy.Validate(); (err != nil) != tc.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func deref(v *float64) interface{} {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
@@ -2,62 +2,248 @@ package helper
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
+
 	pbCommon "thaily/proto/common"
 )
 
-// BuildFilterCondition builds SQL WHERE condition from FilterCondition (MySQL syntax with ?)
-func BuildFilterCondition(condition *pbCommon.FilterCondition, args *[]interface{}) string {
-	field := condition.Field
-	operator := condition.Operator
+// maxFilterDepth bounds the nesting of filter groups
+const maxFilterDepth = 8
+
+// FieldType tells the filter builder how to coerce the values of a field
+type FieldType int
+
+const (
+	FieldString FieldType = iota
+	FieldInt
+	FieldFloat
+	FieldBool
+	FieldTime
+	FieldEnum
+)
+
+// FilterField describes a filterable column of an entity
+type FilterField struct {
+	// Column is the SQL column, the field name when empty
+	Column string
+	Type   FieldType
+	// Values are the accepted values of an enum column
+	Values []string
+	// Aliases map other accepted spellings (e.g. proto enum names) to a value
+	Aliases map[string]string
+	// FullText marks a column with a FULLTEXT index, which SEARCH requires
+	FullText bool
+}
+
+// quotedColumn returns the quoted SQL column of the field
+func (f FilterField) quotedColumn(name string) string {
+	if f.Column != "" {
+		name = f.Column
+	}
+	return "`" + name + "`"
+}
+
+// FilterFields is the whitelist of filterable fields of an entity, by field name
+type FilterFields map[string]FilterField
+
+// timeLayouts are the accepted formats of a time filter value
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// BuildWhere compiles the filters of a search request into a WHERE clause
+// (MySQL syntax with ?), appending the coerced values to args. Top-level
+// filters are joined with AND, groups use their own logic. It returns an
+// empty string when there is nothing to filter on.
+func BuildWhere(filters []*pbCommon.FilterCriteria, fields FilterFields, args *[]interface{}) (string, error) {
+	clause, err := buildGroup(pbCommon.LogicalCondition_AND, filters, fields, args, 0)
+	if err != nil {
+		return "", err
+	}
+	if clause == "" {
+		return "", nil
+	}
+	return "WHERE " + clause, nil
+}
+
+func buildCriteria(criteria *pbCommon.FilterCriteria, fields FilterFields, args *[]interface{}, depth int) (string, error) {
+	if condition := criteria.GetCondition(); condition != nil {
+		return buildCondition(condition, fields, args)
+	}
+	if group := criteria.GetGroup(); group != nil {
+		if depth >= maxFilterDepth {
+			return "", fmt.Errorf("filter groups are nested deeper than %d levels", maxFilterDepth)
+		}
+		return buildGroup(group.Logic, group.Filters, fields, args, depth+1)
+	}
+	return "", nil
+}
+
+func buildGroup(logic pbCommon.LogicalCondition, filters []*pbCommon.FilterCriteria, fields FilterFields, args *[]interface{}, depth int) (string, error) {
+	var joiner string
+	switch logic {
+	case pbCommon.LogicalCondition_AND:
+		joiner = " AND "
+	case pbCommon.LogicalCondition_OR:
+		joiner = " OR "
+	default:
+		return "", fmt.Errorf("unknown logical condition %v", logic)
+	}
+
+	parts := []string{}
+	for _, filter := range filters {
+		if filter == nil {
+			continue
+		}
+		part, err := buildCriteria(filter, fields, args, depth)
+		if err != nil {
+			return "", err
+		}
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	switch len(parts) {
+	case 0:
+		return "", nil
+	case 1:
+		return parts[0], nil
+	}
+	return "(" + strings.Join(parts, joiner) + ")", nil
+}
+
+func buildCondition(condition *pbCommon.FilterCondition, fields FilterFields, args *[]interface{}) (string, error) {
+	field, ok := fields[condition.Field]
+	if !ok {
+		return "", fmt.Errorf("field %q cannot be filtered on", condition.Field)
+	}
+	column := field.quotedColumn(condition.Field)
 	values := condition.Values
 
-	switch operator {
+	// Check the number of values the operator takes
+	switch condition.Operator {
+	case pbCommon.FilterOperator_IS_NULL, pbCommon.FilterOperator_IS_NOT_NULL:
+		if len(values) != 0 {
+			return "", fmt.Errorf("%s on %q takes no values", condition.Operator, condition.Field)
+		}
+	case pbCommon.FilterOperator_IN, pbCommon.FilterOperator_NOT_IN:
+		if len(values) == 0 {
+			return "", fmt.Errorf("%s on %q needs at least one value", condition.Operator, condition.Field)
+		}
+	case pbCommon.FilterOperator_BETWEEN:
+		if len(values) != 2 {
+			return "", fmt.Errorf("BETWEEN on %q needs two values", condition.Field)
+		}
+	default:
+		if len(values) != 1 {
+			return "", fmt.Errorf("%s on %q needs one value", condition.Operator, condition.Field)
+		}
+	}
+
+	if condition.Operator == pbCommon.FilterOperator_SEARCH {
+		if !field.FullText {
+			return "", fmt.Errorf("SEARCH is not supported on %q", condition.Field)
+		}
+		query := FullTextQuery(values[0])
+		if query == "" {
+			return "", fmt.Errorf("SEARCH on %q needs some words to search for", condition.Field)
+		}
+		*args = append(*args, query)
+		return fmt.Sprintf("MATCH(%s) AGAINST (? IN BOOLEAN MODE)", column), nil
+	}
+
+	if condition.Operator == pbCommon.FilterOperator_LIKE {
+		if field.Type != FieldString {
+			return "", fmt.Errorf("LIKE is only supported on text fields, not %q", condition.Field)
+		}
+		*args = append(*args, "%"+values[0]+"%")
+		return fmt.Sprintf("%s LIKE ?", column), nil
+	}
+
+	coerced := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		v, err := coerceFilterValue(field, value)
+		if err != nil {
+			return "", fmt.Errorf("invalid value for %q: %v", condition.Field, err)
+		}
+		coerced = append(coerced, v)
+	}
+
+	switch condition.Operator {
 	case pbCommon.FilterOperator_EQUAL:
-		*args = append(*args, values[0])
-		return fmt.Sprintf("%s = ?", field)
+		*args = append(*args, coerced[0])
+		return fmt.Sprintf("%s = ?", column), nil
 	case pbCommon.FilterOperator_NOT_EQUAL:
-		*args = append(*args, values[0])
-		return fmt.Sprintf("%s != ?", field)
+		*args = append(*args, coerced[0])
+		return fmt.Sprintf("%s != ?", column), nil
 	case pbCommon.FilterOperator_GREATER_THAN:
-		*args = append(*args, values[0])
-		return fmt.Sprintf("%s > ?", field)
+		*args = append(*args, coerced[0])
+		return fmt.Sprintf("%s > ?", column), nil
 	case pbCommon.FilterOperator_GREATER_THAN_EQUAL:
-		*args = append(*args, values[0])
-		return fmt.Sprintf("%s >= ?", field)
+		*args = append(*args, coerced[0])
+		return fmt.Sprintf("%s >= ?", column), nil
 	case pbCommon.FilterOperator_LESS_THAN:
-		*args = append(*args, values[0])
-		return fmt.Sprintf("%s < ?", field)
+		*args = append(*args, coerced[0])
+		return fmt.Sprintf("%s < ?", column), nil
 	case pbCommon.FilterOperator_LESS_THAN_EQUAL:
-		*args = append(*args, values[0])
-		return fmt.Sprintf("%s <= ?", field)
-	case pbCommon.FilterOperator_LIKE:
-		*args = append(*args, "%"+values[0]+"%")
-		return fmt.Sprintf("%s LIKE ?", field)
-	case pbCommon.FilterOperator_IN:
-		placeholders := []string{}
-		for _, val := range values {
-			*args = append(*args, val)
-			placeholders = append(placeholders, "?")
-		}
-		return fmt.Sprintf("%s IN (%s)", field, strings.Join(placeholders, ", "))
-	case pbCommon.FilterOperator_NOT_IN:
-		placeholders := []string{}
-		for _, val := range values {
-			*args = append(*args, val)
-			placeholders = append(placeholders, "?")
-		}
-		return fmt.Sprintf("%s NOT IN (%s)", field, strings.Join(placeholders, ", "))
+		*args = append(*args, coerced[0])
+		return fmt.Sprintf("%s <= ?", column), nil
+	case pbCommon.FilterOperator_IN, pbCommon.FilterOperator_NOT_IN:
+		placeholders := make([]string, len(coerced))
+		for i := range coerced {
+			placeholders[i] = "?"
+		}
+		*args = append(*args, coerced...)
+		op := "IN"
+		if condition.Operator == pbCommon.FilterOperator_NOT_IN {
+			op = "NOT IN"
+		}
+		return fmt.Sprintf("%s %s (%s)", column, op, strings.Join(placeholders, ", ")), nil
 	case pbCommon.FilterOperator_IS_NULL:
-		return fmt.Sprintf("%s IS NULL", field)
+		return fmt.Sprintf("%s IS NULL", column), nil
 	case pbCommon.FilterOperator_IS_NOT_NULL:
-		return fmt.Sprintf("%s IS NOT NULL", field)
+		return fmt.Sprintf("%s IS NOT NULL", column), nil
 	case pbCommon.FilterOperator_BETWEEN:
-		if len(values) >= 2 {
-			*args = append(*args, values[0], values[1])
-			return fmt.Sprintf("%s BETWEEN ? AND ?", field)
-		}
+		*args = append(*args, coerced[0], coerced[1])
+		return fmt.Sprintf("%s BETWEEN ? AND ?", column), nil
 	}
 
-	return "1=1" // fallback
+	return "", fmt.Errorf("unknown filter operator %v", condition.Operator)
+}
+
+// coerceFilterValue converts a filter value to the type of its column
+func coerceFilterValue(field FilterField, value string) (interface{}, error) {
+	switch field.Type {
+	case FieldInt:
+		return strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	case FieldFloat:
+		return strconv.ParseFloat(strings.TrimSpace(value), 64)
+	case FieldBool:
+		return strconv.ParseBool(strings.TrimSpace(value))
+	case FieldTime:
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, strings.TrimSpace(value)); err == nil {
+				return t, nil
+			}
+		}
+		return nil, fmt.Errorf("%q is not a date", value)
+	case FieldEnum:
+		for _, v := range field.Values {
+			if strings.EqualFold(v, value) {
+				return v, nil
+			}
+		}
+		for alias, v := range field.Aliases {
+			if strings.EqualFold(alias, value) {
+				return v, nil
+			}
+		}
+		return nil, fmt.Errorf("%q is not one of %s", value, strings.Join(field.Values, ", "))
+	}
+	return value, nil
 }
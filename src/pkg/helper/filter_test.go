@@ -0,0 +1,184 @@
+package helper
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	pbCommon "thaily/proto/common"
+)
+
+var testFields = FilterFields{
+	"id":         {},
+	"title":      {FullText: true},
+	"major":      {Column: "major_code"},
+	"max":        {Type: FieldInt},
+	"score":      {Type: FieldFloat},
+	"restricted": {Type: FieldBool},
+	"created_at": {Type: FieldTime},
+	"status":     {Type: FieldEnum, Values: []string{"pending", "approved"}, Aliases: map[string]string{"TOPIC_PENDING": "pending"}},
+}
+
+func cond(field string, op pbCommon.FilterOperator, values ...string) *pbCommon.FilterCriteria {
+	return &pbCommon.FilterCriteria{Criteria: &pbCommon.FilterCriteria_Condition{Condition: &pbCommon.FilterCondition{
+		Field: field, Operator: op, Values: values,
+	}}}
+}
+
+func group(logic pbCommon.LogicalCondition, filters ...*pbCommon.FilterCriteria) *pbCommon.FilterCriteria {
+	return &pbCommon.FilterCriteria{Criteria: &pbCommon.FilterCriteria_Group{Group: &pbCommon.FilterGroup{
+		Logic: logic, Filters: filters,
+	}}}
+}
+
+// nested wraps a condition in depth AND groups
+func nested(depth int) *pbCommon.FilterCriteria {
+	criteria := cond("id", pbCommon.FilterOperator_EQUAL, "1")
+	for i := 0; i < depth; i++ {
+		criteria = group(pbCommon.LogicalCondition_AND, criteria)
+	}
+	return criteria
+}
+
+func TestBuildWhere(t *testing.T) {
+	cases := []struct {
+		name     string
+		filters  []*pbCommon.FilterCriteria
+		want     string
+		wantArgs []interface{}
+	}{
+		{
+			name: "no filters",
+			want: "",
+		},
+		{
+			name:     "equal on a renamed column",
+			filters:  []*pbCommon.FilterCriteria{cond("major", pbCommon.FilterOperator_EQUAL, "CNTT")},
+			want:     "WHERE `major_code` = ?",
+			wantArgs: []interface{}{"CNTT"},
+		},
+		{
+			name: "top-level filters are joined with AND",
+			filters: []*pbCommon.FilterCriteria{
+				cond("max", pbCommon.FilterOperator_GREATER_THAN_EQUAL, " 2 "),
+				cond("restricted", pbCommon.FilterOperator_NOT_EQUAL, "true"),
+			},
+			want:     "WHERE (`max` >= ? AND `restricted` != ?)",
+			wantArgs: []interface{}{int64(2), true},
+		},
+		{
+			name: "OR group inside AND",
+			filters: []*pbCommon.FilterCriteria{
+				cond("id", pbCommon.FilterOperator_NOT_EQUAL, "x"),
+				group(pbCommon.LogicalCondition_OR,
+					cond("score", pbCommon.FilterOperator_LESS_THAN, "5.5"),
+					cond("score", pbCommon.FilterOperator_IS_NULL),
+				),
+			},
+			want:     "WHERE (`id` != ? AND (`score` < ? OR `score` IS NULL))",
+			wantArgs: []interface{}{"x", 5.5},
+		},
+		{
+			name:     "IN",
+			filters:  []*pbCommon.FilterCriteria{cond("status", pbCommon.FilterOperator_IN, "Pending", "TOPIC_PENDING", "approved")},
+			want:     "WHERE `status` IN (?, ?, ?)",
+			wantArgs: []interface{}{"pending", "pending", "approved"},
+		},
+		{
+			name:     "NOT IN",
+			filters:  []*pbCommon.FilterCriteria{cond("max", pbCommon.FilterOperator_NOT_IN, "1", "2")},
+			want:     "WHERE `max` NOT IN (?, ?)",
+			wantArgs: []interface{}{int64(1), int64(2)},
+		},
+		{
+			name:    "BETWEEN dates",
+			filters: []*pbCommon.FilterCriteria{cond("created_at", pbCommon.FilterOperator_BETWEEN, "2026-01-01", "2026-06-30T12:00:00Z")},
+			want:    "WHERE `created_at` BETWEEN ? AND ?",
+			wantArgs: []interface{}{
+				time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 6, 30, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "LIKE wraps the value",
+			filters:  []*pbCommon.FilterCriteria{cond("id", pbCommon.FilterOperator_LIKE, "abc")},
+			want:     "WHERE `id` LIKE ?",
+			wantArgs: []interface{}{"%abc%"},
+		},
+		{
+			name:     "SEARCH on a full-text column",
+			filters:  []*pbCommon.FilterCriteria{cond("title", pbCommon.FilterOperator_SEARCH, "hệ thống+")},
+			want:     "WHERE MATCH(`title`) AGAINST (? IN BOOLEAN MODE)",
+			wantArgs: []interface{}{"hệ* thống*"},
+		},
+		{
+			name: "empty groups and nil filters are dropped",
+			filters: []*pbCommon.FilterCriteria{
+				nil,
+				group(pbCommon.LogicalCondition_OR),
+				cond("id", pbCommon.FilterOperator_IS_NOT_NULL),
+			},
+			want: "WHERE `id` IS NOT NULL",
+		},
+		{
+			name:     "nesting at the depth limit",
+			filters:  []*pbCommon.FilterCriteria{nested(maxFilterDepth)},
+			want:     "WHERE `id` = ?",
+			wantArgs: []interface{}{"1"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			args := []interface{}{}
+			got, err := BuildWhere(tc.filters, testFields, &args)
+			if err != nil {
+				t.Fatalf("BuildWhere() error = %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("BuildWhere() = %q, want %q", got, tc.want)
+			}
+			if len(args) != 0 || len(tc.wantArgs) != 0 {
+				if !reflect.DeepEqual(args, tc.wantArgs) {
+					t.Errorf("args = %#v, want %#v", args, tc.wantArgs)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildWhereErrors(t *testing.T) {
+	cases := []struct {
+		name    string
+		filters []*pbCommon.FilterCriteria
+		wantErr string
+	}{
+		{"nesting past the depth limit", []*pbCommon.FilterCriteria{nested(maxFilterDepth + 1)}, "nested deeper"},
+		{"unknown field", []*pbCommon.FilterCriteria{cond("password", pbCommon.FilterOperator_EQUAL, "x")}, "cannot be filtered on"},
+		{"unknown logic", []*pbCommon.FilterCriteria{group(pbCommon.LogicalCondition(42), cond("id", pbCommon.FilterOperator_EQUAL, "1"))}, "unknown logical condition"},
+		{"IN without values", []*pbCommon.FilterCriteria{cond("id", pbCommon.FilterOperator_IN)}, "at least one value"},
+		{"BETWEEN with one value", []*pbCommon.FilterCriteria{cond("max", pbCommon.FilterOperator_BETWEEN, "1")}, "needs two values"},
+		{"BETWEEN with three values", []*pbCommon.FilterCriteria{cond("max", pbCommon.FilterOperator_BETWEEN, "1", "2", "3")}, "needs two values"},
+		{"IS NULL with a value", []*pbCommon.FilterCriteria{cond("id", pbCommon.FilterOperator_IS_NULL, "x")}, "takes no values"},
+		{"EQUAL with two values", []*pbCommon.FilterCriteria{cond("id", pbCommon.FilterOperator_EQUAL, "1", "2")}, "needs one value"},
+		{"int coercion", []*pbCommon.FilterCriteria{cond("max", pbCommon.FilterOperator_EQUAL, "two")}, `invalid value for "max"`},
+		{"float coercion", []*pbCommon.FilterCriteria{cond("score", pbCommon.FilterOperator_IN, "1.5", "x")}, `invalid value for "score"`},
+		{"bool coercion", []*pbCommon.FilterCriteria{cond("restricted", pbCommon.FilterOperator_EQUAL, "maybe")}, `invalid value for "restricted"`},
+		{"time coercion", []*pbCommon.FilterCriteria{cond("created_at", pbCommon.FilterOperator_BETWEEN, "2026-01-01", "tomorrow")}, "is not a date"},
+		{"enum coercion", []*pbCommon.FilterCriteria{cond("status", pbCommon.FilterOperator_EQUAL, "deleted")}, "is not one of pending, approved"},
+		{"LIKE on a non-text field", []*pbCommon.FilterCriteria{cond("max", pbCommon.FilterOperator_LIKE, "1")}, "only supported on text fields"},
+		{"SEARCH without a full-text index", []*pbCommon.FilterCriteria{cond("id", pbCommon.FilterOperator_SEARCH, "x")}, "not supported"},
+		{"SEARCH without words", []*pbCommon.FilterCriteria{cond("title", pbCommon.FilterOperator_SEARCH, "+-*")}, "needs some words"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			args := []interface{}{}
+			_, err := BuildWhere(tc.filters, testFields, &args)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("BuildWhere() error = %v, want one containing %q", err, tc.wantErr)
+			}
+		})
+	}
+}
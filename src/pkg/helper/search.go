@@ -0,0 +1,47 @@
+package helper
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	pbCommon "thaily/proto/common"
+)
+
+// FullTextQuery turns free text into a MySQL boolean-mode query where every
+// word may match as a prefix. Against the ngram indexes a word of one letter
+// matches the syllables it starts and a longer one is searched as a phrase of
+// its n-grams. Operator characters are dropped so user input cannot change
+// the query's meaning; an empty result means nothing to search.
+func FullTextQuery(text string) string {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r)
+	})
+	for i, word := range words {
+		words[i] = word + "*"
+	}
+	return strings.Join(words, " ")
+}
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 50
+)
+
+// SearchLimit clamps the number of hits a full-text search returns
+func SearchLimit(limit int32) int32 {
+	if limit <= 0 {
+		return defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		return maxSearchLimit
+	}
+	return limit
+}
+
+// SortSearchHits orders hits from different sources best first
+func SortSearchHits(hits []*pbCommon.SearchHit) {
+	sort.SliceStable(hits, func(i, j int) bool {
+		return hits[i].Score > hits[j].Score
+	})
+}
@@ -0,0 +1,13 @@
+package helper
+
+// NotDeleted narrows a WHERE clause built by BuildWhere to the rows that are
+// not soft-deleted, unless the deleted ones are asked for as well
+func NotDeleted(whereClause string, includeDeleted bool) string {
+	if includeDeleted {
+		return whereClause
+	}
+	if whereClause == "" {
+		return "WHERE deleted_at IS NULL"
+	}
+	return whereClause + " AND deleted_at IS NULL"
+}
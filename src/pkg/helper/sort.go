@@ -0,0 +1,65 @@
+package helper
+
+import (
+	"fmt"
+	"strings"
+
+	pbCommon "thaily/proto/common"
+)
+
+// maxSortKeys bounds the number of sort keys of a request
+const maxSortKeys = 5
+
+// BuildOrderBy compiles the sort of a pagination into an ORDER BY clause.
+// Sort keys come from pagination.sort, or from sort_by/descending when it
+// is empty, and default to created_at ascending. Every key must be in the
+// entity's whitelist, and id is appended as a tiebreaker so pages are stable.
+func BuildOrderBy(pagination *pbCommon.Pagination, fields FilterFields) (string, error) {
+	specs := pagination.GetSort()
+	if len(specs) == 0 {
+		direction := pbCommon.SortDirection_ASC
+		if pagination.GetDescending() {
+			direction = pbCommon.SortDirection_DESC
+		}
+		sortBy := pagination.GetSortBy()
+		if sortBy == "" {
+			sortBy = "created_at"
+		}
+		specs = []*pbCommon.SortSpec{{Field: sortBy, Direction: direction}}
+	}
+	if len(specs) > maxSortKeys {
+		return "", fmt.Errorf("at most %d sort keys are allowed", maxSortKeys)
+	}
+
+	keys := []string{}
+	seen := map[string]bool{}
+	for _, spec := range specs {
+		if spec == nil {
+			continue
+		}
+		field, ok := fields[spec.Field]
+		if !ok {
+			return "", fmt.Errorf("field %q cannot be sorted on", spec.Field)
+		}
+		if seen[spec.Field] {
+			return "", fmt.Errorf("field %q is sorted on twice", spec.Field)
+		}
+		seen[spec.Field] = true
+
+		var direction string
+		switch spec.Direction {
+		case pbCommon.SortDirection_ASC:
+			direction = "ASC"
+		case pbCommon.SortDirection_DESC:
+			direction = "DESC"
+		default:
+			return "", fmt.Errorf("unknown sort direction %v for %q", spec.Direction, spec.Field)
+		}
+		keys = append(keys, field.quotedColumn(spec.Field)+" "+direction)
+	}
+
+	if !seen["id"] {
+		keys = append(keys, "`id` ASC")
+	}
+	return "ORDER BY " + strings.Join(keys, ", "), nil
+}
@@ -0,0 +1,89 @@
+package helper
+
+import (
+	"strings"
+	"testing"
+
+	pbCommon "thaily/proto/common"
+)
+
+func TestBuildOrderBy(t *testing.T) {
+	sortSpec := func(field string, direction pbCommon.SortDirection) *pbCommon.SortSpec {
+		return &pbCommon.SortSpec{Field: field, Direction: direction}
+	}
+
+	cases := []struct {
+		name       string
+		pagination *pbCommon.Pagination
+		want       string
+	}{
+		{
+			name: "no pagination sorts by created_at",
+			want: "ORDER BY `created_at` ASC, `id` ASC",
+		},
+		{
+			name:       "sort_by and descending",
+			pagination: &pbCommon.Pagination{SortBy: "max", Descending: true},
+			want:       "ORDER BY `max` DESC, `id` ASC",
+		},
+		{
+			name:       "renamed column",
+			pagination: &pbCommon.Pagination{SortBy: "major"},
+			want:       "ORDER BY `major_code` ASC, `id` ASC",
+		},
+		{
+			name: "sort keys take precedence over sort_by",
+			pagination: &pbCommon.Pagination{SortBy: "max", Sort: []*pbCommon.SortSpec{
+				sortSpec("status", pbCommon.SortDirection_ASC),
+				nil,
+				sortSpec("score", pbCommon.SortDirection_DESC),
+			}},
+			want: "ORDER BY `status` ASC, `score` DESC, `id` ASC",
+		},
+		{
+			name:       "id is not appended twice",
+			pagination: &pbCommon.Pagination{Sort: []*pbCommon.SortSpec{sortSpec("id", pbCommon.SortDirection_DESC)}},
+			want:       "ORDER BY `id` DESC",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := BuildOrderBy(tc.pagination, testFields)
+			if err != nil {
+				t.Fatalf("BuildOrderBy() error = %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("BuildOrderBy() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBuildOrderByErrors(t *testing.T) {
+	tooMany := make([]*pbCommon.SortSpec, maxSortKeys+1)
+	for i := range tooMany {
+		tooMany[i] = &pbCommon.SortSpec{Field: "id"}
+	}
+
+	cases := []struct {
+		name       string
+		pagination *pbCommon.Pagination
+		wantErr    string
+	}{
+		{"unknown sort_by", &pbCommon.Pagination{SortBy: "password"}, "cannot be sorted on"},
+		{"unknown sort key", &pbCommon.Pagination{Sort: []*pbCommon.SortSpec{{Field: "password"}}}, "cannot be sorted on"},
+		{"field sorted twice", &pbCommon.Pagination{Sort: []*pbCommon.SortSpec{{Field: "max"}, {Field: "max", Direction: pbCommon.SortDirection_DESC}}}, "sorted on twice"},
+		{"unknown direction", &pbCommon.Pagination{Sort: []*pbCommon.SortSpec{{Field: "max", Direction: pbCommon.SortDirection(9)}}}, "unknown sort direction"},
+		{"too many keys", &pbCommon.Pagination{Sort: tooMany}, "at most"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := BuildOrderBy(tc.pagination, testFields)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("BuildOrderBy() error = %v, want one containing %q", err, tc.wantErr)
+			}
+		})
+	}
+}
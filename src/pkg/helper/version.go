@@ -0,0 +1,30 @@
+package helper
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// WhereVersion returns the WHERE condition of an update by id, appending its
+// args. With an expected version only a record still at that version
+// matches, so a concurrent update makes it affect no rows.
+func WhereVersion(id string, version *int32, args *[]interface{}) string {
+	*args = append(*args, id)
+	if version == nil {
+		return "id = ?"
+	}
+	*args = append(*args, *version)
+	return "id = ? AND version = ?"
+}
+
+// VersionConflict is the Aborted error of an update whose expected version
+// is stale. The current record travels as the status detail so the caller
+// can show it without another round trip.
+func VersionConflict(entity string, current protoadapt.MessageV1, version int32) error {
+	st := status.Newf(codes.Aborted, "%s was changed by someone else (now at version %d); reload it and try again", entity, version)
+	if detailed, err := st.WithDetails(current); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
@@ -3,6 +3,7 @@ package logger
 import (
 	"context"
 	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
 )
 
 type contextKey string
@@ -11,8 +12,17 @@ const (
 	requestIDKey contextKey = "request_id"
 )
 
-// WithRequestID adds a request ID to the context
+// RequestIDHeader is the metadata key the gateway sends its request ID in,
+// so the traces of every service a request went through share one ID
+const RequestIDHeader = "x-request-id"
+
+// WithRequestID adds a request ID to the context, reusing the caller's
 func WithRequestID(ctx context.Context) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(RequestIDHeader); len(ids) > 0 && ids[0] != "" {
+			return context.WithValue(ctx, requestIDKey, ids[0])
+		}
+	}
 	requestID := uuid.New().String()
 	return context.WithValue(ctx, requestIDKey, requestID)
 }
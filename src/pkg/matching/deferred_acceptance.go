@@ -0,0 +1,215 @@
+// Package matching assigns students to topics with student-proposing deferred
+// acceptance (Gale-Shapley). The result is stable: no student and topic both
+// prefer each other to what they were assigned, and no student can do better
+// in any other stable assignment.
+package matching
+
+import "sort"
+
+// Reason explains why a student was not placed on one of their choices
+type Reason int
+
+const (
+	// ReasonOutranked means the topic filled up with applicants its supervisors rank higher
+	ReasonOutranked Reason = iota
+	// ReasonNotAcceptable means the student is not on the topic's acceptance list
+	ReasonNotAcceptable
+	// ReasonNoCapacity means the topic had no seats left before matching
+	ReasonNoCapacity
+	// ReasonUnknownTopic means the topic is not open in this round
+	ReasonUnknownTopic
+)
+
+// Student is one applicant with topics listed from most to least preferred
+type Student struct {
+	Code    string
+	Choices []string
+}
+
+// Topic is a topic taking part in the round. Ranking orders the acceptable
+// students, best first; with Restricted false, students not in Ranking are
+// still acceptable and rank after everyone in it, in tie-break order.
+type Topic struct {
+	Code       string
+	Capacity   int
+	Ranking    []string
+	Restricted bool
+}
+
+// Assignment places a student on a topic; Choice is the 1-based rank the
+// student gave the topic
+type Assignment struct {
+	StudentCode string
+	TopicCode   string
+	Choice      int
+}
+
+// Rejection is one of an unmatched student's choices and why it failed
+type Rejection struct {
+	TopicCode string
+	Choice    int
+	Reason    Reason
+}
+
+// Unmatched is a student left without a topic, with every choice explained
+type Unmatched struct {
+	StudentCode string
+	Rejections  []Rejection
+}
+
+// Result is the outcome of a matching round
+type Result struct {
+	Assignments []Assignment
+	Unmatched   []Unmatched
+}
+
+type topicState struct {
+	topic    Topic
+	rank     map[string]int
+	held     []string
+	capacity int
+}
+
+// priority returns the topic's rank of a student (lower is better) and
+// whether the student is acceptable at all. tieBreak orders students the
+// ranking does not mention.
+func (t *topicState) priority(student string, tieBreak map[string]int) (int, bool) {
+	if r, ok := t.rank[student]; ok {
+		return r, true
+	}
+	if t.topic.Restricted {
+		return 0, false
+	}
+	return len(t.rank) + tieBreak[student], true
+}
+
+// Match runs student-proposing deferred acceptance. Students are given in
+// tie-break order: when a topic does not rank two applicants, the one listed
+// first wins. Choices of unknown topics and repeated choices are ignored for
+// proposing but still explained.
+func Match(students []Student, topics []Topic) Result {
+	tieBreak := make(map[string]int, len(students))
+	for i, s := range students {
+		tieBreak[s.Code] = i
+	}
+
+	states := make(map[string]*topicState, len(topics))
+	for _, t := range topics {
+		state := &topicState{topic: t, rank: make(map[string]int, len(t.Ranking)), capacity: t.Capacity}
+		for i, code := range t.Ranking {
+			if _, dup := state.rank[code]; !dup {
+				state.rank[code] = i
+			}
+		}
+		if state.capacity < 0 {
+			state.capacity = 0
+		}
+		states[t.Code] = state
+	}
+
+	next := make([]int, len(students)) // next choice index each student proposes to
+	index := make(map[string]int, len(students))
+	for i, s := range students {
+		index[s.Code] = i
+	}
+
+	free := make([]int, 0, len(students))
+	for i := range students {
+		free = append(free, i)
+	}
+
+	for len(free) > 0 {
+		i := free[len(free)-1]
+		free = free[:len(free)-1]
+		s := students[i]
+
+		for next[i] < len(s.Choices) {
+			state := states[s.Choices[next[i]]]
+			next[i]++
+			if state == nil || state.capacity == 0 {
+				continue
+			}
+			if _, ok := state.priority(s.Code, tieBreak); !ok {
+				continue
+			}
+
+			state.held = append(state.held, s.Code)
+			if len(state.held) <= state.capacity {
+				break
+			}
+
+			// Over capacity: release the applicant the topic likes least
+			worst := 0
+			worstPriority, _ := state.priority(state.held[0], tieBreak)
+			for k := 1; k < len(state.held); k++ {
+				p, _ := state.priority(state.held[k], tieBreak)
+				if p > worstPriority {
+					worst, worstPriority = k, p
+				}
+			}
+			released := state.held[worst]
+			state.held = append(state.held[:worst], state.held[worst+1:]...)
+			if released != s.Code {
+				free = append(free, index[released])
+				break
+			}
+		}
+	}
+
+	assigned := make(map[string]string, len(students))
+	for _, state := range states {
+		for _, code := range state.held {
+			assigned[code] = state.topic.Code
+		}
+	}
+
+	var result Result
+	for _, s := range students {
+		if topicCode, ok := assigned[s.Code]; ok {
+			result.Assignments = append(result.Assignments, Assignment{
+				StudentCode: s.Code,
+				TopicCode:   topicCode,
+				Choice:      choiceOf(s, topicCode),
+			})
+			continue
+		}
+
+		unmatched := Unmatched{StudentCode: s.Code}
+		for k, topicCode := range s.Choices {
+			rejection := Rejection{TopicCode: topicCode, Choice: k + 1}
+			state := states[topicCode]
+			switch {
+			case state == nil:
+				rejection.Reason = ReasonUnknownTopic
+			case state.capacity == 0:
+				rejection.Reason = ReasonNoCapacity
+			default:
+				if _, ok := state.priority(s.Code, tieBreak); !ok {
+					rejection.Reason = ReasonNotAcceptable
+				} else {
+					rejection.Reason = ReasonOutranked
+				}
+			}
+			unmatched.Rejections = append(unmatched.Rejections, rejection)
+		}
+		result.Unmatched = append(result.Unmatched, unmatched)
+	}
+
+	sort.SliceStable(result.Assignments, func(a, b int) bool {
+		if result.Assignments[a].TopicCode != result.Assignments[b].TopicCode {
+			return result.Assignments[a].TopicCode < result.Assignments[b].TopicCode
+		}
+		return result.Assignments[a].StudentCode < result.Assignments[b].StudentCode
+	})
+	return result
+}
+
+// choiceOf returns the 1-based rank a student gave a topic
+func choiceOf(s Student, topicCode string) int {
+	for k, code := range s.Choices {
+		if code == topicCode {
+			return k + 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,296 @@
+package matching
+
+import (
+	"reflect"
+	"testing"
+)
+
+var matchCases = []struct {
+	name     string
+	students []Student
+	topics   []Topic
+	want     map[string]string // student -> topic, "" when unmatched
+}{
+	{
+		name:     "empty round",
+		students: nil,
+		topics:   []Topic{{Code: "T1", Capacity: 1}},
+		want:     map[string]string{},
+	},
+	{
+		name: "everyone gets their first choice",
+		students: []Student{
+			{Code: "S1", Choices: []string{"T1", "T2"}},
+			{Code: "S2", Choices: []string{"T2", "T1"}},
+		},
+		topics: []Topic{{Code: "T1", Capacity: 1}, {Code: "T2", Capacity: 1}},
+		want:   map[string]string{"S1": "T1", "S2": "T2"},
+	},
+	{
+		name: "topic ranking beats tie-break order",
+		students: []Student{
+			{Code: "S1", Choices: []string{"T1", "T2"}},
+			{Code: "S2", Choices: []string{"T1", "T2"}},
+		},
+		topics: []Topic{{Code: "T1", Capacity: 1, Ranking: []string{"S2", "S1"}}, {Code: "T2", Capacity: 1}},
+		want:   map[string]string{"S1": "T2", "S2": "T1"},
+	},
+	{
+		name: "unranked students rank after ranked ones in tie-break order",
+		students: []Student{
+			{Code: "S1", Choices: []string{"T1"}},
+			{Code: "S2", Choices: []string{"T1"}},
+			{Code: "S3", Choices: []string{"T1"}},
+		},
+		topics: []Topic{{Code: "T1", Capacity: 2, Ranking: []string{"S3"}}},
+		want:   map[string]string{"S1": "T1", "S2": "", "S3": "T1"},
+	},
+	{
+		name: "restricted topic refuses students it does not rank",
+		students: []Student{
+			{Code: "S1", Choices: []string{"T1", "T2"}},
+			{Code: "S2", Choices: []string{"T1"}},
+		},
+		topics: []Topic{{Code: "T1", Capacity: 2, Ranking: []string{"S2"}, Restricted: true}, {Code: "T2", Capacity: 1}},
+		want:   map[string]string{"S1": "T2", "S2": "T1"},
+	},
+	{
+		name: "rejection chain moves a held student down their list",
+		students: []Student{
+			{Code: "S1", Choices: []string{"T1", "T2", "T3"}},
+			{Code: "S2", Choices: []string{"T2", "T1", "T3"}},
+			{Code: "S3", Choices: []string{"T1", "T2", "T3"}},
+		},
+		topics: []Topic{
+			{Code: "T1", Capacity: 1, Ranking: []string{"S2", "S3", "S1"}},
+			{Code: "T2", Capacity: 1, Ranking: []string{"S1", "S3", "S2"}},
+			{Code: "T3", Capacity: 1},
+		},
+		want: map[string]string{"S1": "T2", "S2": "T1", "S3": "T3"},
+	},
+	{
+		name: "students and topics disagree; the students' preferred stable matching wins",
+		students: []Student{
+			{Code: "S1", Choices: []string{"T1", "T2"}},
+			{Code: "S2", Choices: []string{"T2", "T1"}},
+		},
+		topics: []Topic{
+			{Code: "T1", Capacity: 1, Ranking: []string{"S2", "S1"}},
+			{Code: "T2", Capacity: 1, Ranking: []string{"S1", "S2"}},
+		},
+		want: map[string]string{"S1": "T1", "S2": "T2"},
+	},
+	{
+		name: "unknown, full and repeated choices are skipped",
+		students: []Student{
+			{Code: "S1", Choices: []string{"X", "T0", "T1", "T1"}},
+			{Code: "S2", Choices: []string{"T1", "T0"}},
+		},
+		topics: []Topic{{Code: "T0", Capacity: 0}, {Code: "T1", Capacity: 1, Ranking: []string{"S1"}}},
+		want:   map[string]string{"S1": "T1", "S2": ""},
+	},
+	{
+		name: "negative capacity is no capacity",
+		students: []Student{
+			{Code: "S1", Choices: []string{"T1", "T2"}},
+		},
+		topics: []Topic{{Code: "T1", Capacity: -1}, {Code: "T2", Capacity: 1}},
+		want:   map[string]string{"S1": "T2"},
+	},
+}
+
+func TestMatch(t *testing.T) {
+	for _, tc := range matchCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := Match(tc.students, tc.topics)
+
+			got := assignmentMap(result)
+			for _, u := range result.Unmatched {
+				got[u.StudentCode] = ""
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("Match() = %v, want %v", got, tc.want)
+			}
+			for _, a := range result.Assignments {
+				if a.Choice != choiceOf(studentByCode(tc.students, a.StudentCode), a.TopicCode) || a.Choice == 0 {
+					t.Errorf("assignment %+v has the wrong choice rank", a)
+				}
+			}
+		})
+	}
+}
+
+// TestMatchStable checks that no student and topic would both rather be
+// together than with what Match gave them
+func TestMatchStable(t *testing.T) {
+	for _, tc := range matchCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assigned := assignmentMap(Match(tc.students, tc.topics))
+			if pair, blocked := blockingPair(tc.students, tc.topics, assigned); blocked {
+				t.Fatalf("matching %v is blocked by %v", assigned, pair)
+			}
+		})
+	}
+}
+
+// TestMatchStudentOptimal compares Match with every stable matching of the
+// round: no student is better off in any of them
+func TestMatchStudentOptimal(t *testing.T) {
+	for _, tc := range matchCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assigned := assignmentMap(Match(tc.students, tc.topics))
+			for _, stable := range stableMatchings(tc.students, tc.topics) {
+				for _, s := range tc.students {
+					if prefers(s, stable[s.Code], assigned[s.Code]) {
+						t.Errorf("%s gets %q but %q in the stable matching %v", s.Code, assigned[s.Code], stable[s.Code], stable)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestMatchReasons(t *testing.T) {
+	students := []Student{
+		{Code: "S1", Choices: []string{"T1"}},
+		{Code: "S2", Choices: []string{"T1", "T2", "T3", "X"}},
+	}
+	topics := []Topic{
+		{Code: "T1", Capacity: 1},
+		{Code: "T2", Capacity: 1, Ranking: []string{"S1"}, Restricted: true},
+		{Code: "T3", Capacity: 0},
+	}
+
+	result := Match(students, topics)
+	want := []Unmatched{{StudentCode: "S2", Rejections: []Rejection{
+		{TopicCode: "T1", Choice: 1, Reason: ReasonOutranked},
+		{TopicCode: "T2", Choice: 2, Reason: ReasonNotAcceptable},
+		{TopicCode: "T3", Choice: 3, Reason: ReasonNoCapacity},
+		{TopicCode: "X", Choice: 4, Reason: ReasonUnknownTopic},
+	}}}
+	if !reflect.DeepEqual(result.Unmatched, want) {
+		t.Fatalf("Unmatched = %+v, want %+v", result.Unmatched, want)
+	}
+}
+
+func assignmentMap(result Result) map[string]string {
+	assigned := map[string]string{}
+	for _, a := range result.Assignments {
+		assigned[a.StudentCode] = a.TopicCode
+	}
+	return assigned
+}
+
+func studentByCode(students []Student, code string) Student {
+	for _, s := range students {
+		if s.Code == code {
+			return s
+		}
+	}
+	return Student{}
+}
+
+// prefers reports whether the student ranks topic a above topic b; "" is no
+// topic, which every listed choice beats
+func prefers(s Student, a, b string) bool {
+	if a == "" || a == b {
+		return false
+	}
+	ra := choiceOf(s, a)
+	if ra == 0 {
+		return false
+	}
+	rb := choiceOf(s, b)
+	return rb == 0 || ra < rb
+}
+
+// topicPriority mirrors how a topic orders applicants: its ranking first,
+// then, unless restricted, everyone else in tie-break order
+func topicPriority(topic Topic, students []Student, student string) (int, bool) {
+	for i, code := range topic.Ranking {
+		if code == student {
+			return i, true
+		}
+	}
+	if topic.Restricted {
+		return 0, false
+	}
+	for i, s := range students {
+		if s.Code == student {
+			return len(topic.Ranking) + i, true
+		}
+	}
+	return 0, false
+}
+
+// blockingPair returns a student and topic that would both rather be
+// together than with their assignment
+func blockingPair(students []Student, topics []Topic, assigned map[string]string) ([2]string, bool) {
+	for _, topic := range topics {
+		if topic.Capacity <= 0 {
+			continue
+		}
+		held := []string{}
+		for student, code := range assigned {
+			if code == topic.Code {
+				held = append(held, student)
+			}
+		}
+		for _, s := range students {
+			p, ok := topicPriority(topic, students, s.Code)
+			if !ok || !prefers(s, topic.Code, assigned[s.Code]) {
+				continue
+			}
+			if len(held) < topic.Capacity {
+				return [2]string{s.Code, topic.Code}, true
+			}
+			for _, h := range held {
+				if q, _ := topicPriority(topic, students, h); q > p {
+					return [2]string{s.Code, topic.Code}, true
+				}
+			}
+		}
+	}
+	return [2]string{}, false
+}
+
+// stableMatchings enumerates every stable matching of a small round
+func stableMatchings(students []Student, topics []Topic) []map[string]string {
+	capacity := map[string]int{}
+	for _, topic := range topics {
+		capacity[topic.Code] = topic.Capacity
+	}
+
+	var found []map[string]string
+	current := map[string]string{}
+	var walk func(i int)
+	walk = func(i int) {
+		if i == len(students) {
+			if _, blocked := blockingPair(students, topics, current); !blocked {
+				stable := make(map[string]string, len(current))
+				for k, v := range current {
+					stable[k] = v
+				}
+				found = append(found, stable)
+			}
+			return
+		}
+		s := students[i]
+		walk(i + 1)
+		for _, topic := range topics {
+			if choiceOf(s, topic.Code) == 0 || capacity[topic.Code] <= 0 {
+				continue
+			}
+			if _, ok := topicPriority(topic, students, s.Code); !ok {
+				continue
+			}
+			capacity[topic.Code]--
+			current[s.Code] = topic.Code
+			walk(i + 1)
+			delete(current, s.Code)
+			capacity[topic.Code]++
+		}
+	}
+	walk(0)
+	return found
+}
@@ -0,0 +1,113 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+)
+
+// Usage describes the migrate subcommand of the service binaries
+const Usage = `usage: <service> migrate <command>
+  up [n]           apply all pending migrations, or the next n
+  down [n]         revert the last migration, or the last n
+  status           show the applied version and pending migrations
+  force <version>  mark version as applied and clean, after repairing a dirty schema
+  check            compare the database's ENUM columns with the proto enums`
+
+// Run executes a migrate subcommand
+func Run(ctx context.Context, db *sql.DB, m *Migrator, enums []EnumColumn, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%s", Usage)
+	}
+
+	steps := func(fallback int) (int, error) {
+		if len(args) < 2 {
+			return fallback, nil
+		}
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid number of steps %q", args[1])
+		}
+		return n, nil
+	}
+
+	switch args[0] {
+	case "up":
+		n, err := steps(0)
+		if err != nil {
+			return err
+		}
+		applied, err := m.Up(ctx, n)
+		log.Printf("%s: applied %d migration(s)", m.Service(), applied)
+		return err
+	case "down":
+		n, err := steps(1)
+		if err != nil {
+			return err
+		}
+		reverted, err := m.Down(ctx, n)
+		log.Printf("%s: reverted %d migration(s)", m.Service(), reverted)
+		return err
+	case "status":
+		state, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		dirty := ""
+		if state.Dirty {
+			dirty = " (dirty)"
+		}
+		fmt.Printf("%s: version %d%s\n", m.Service(), state.Version, dirty)
+		for _, migration := range state.Applied {
+			fmt.Printf("  applied  %04d_%s\n", migration.Version, migration.Name)
+		}
+		for _, migration := range state.Pending {
+			fmt.Printf("  pending  %04d_%s\n", migration.Version, migration.Name)
+		}
+		return nil
+	case "force":
+		if len(args) < 2 {
+			return fmt.Errorf("force needs a version")
+		}
+		version, err := strconv.Atoi(args[1])
+		if err != nil || version < 0 {
+			return fmt.Errorf("invalid version %q", args[1])
+		}
+		if err := m.Force(ctx, version); err != nil {
+			return err
+		}
+		log.Printf("%s: forced to version %d", m.Service(), version)
+		return nil
+	case "check":
+		problems, err := CheckEnums(ctx, db, m.Tables(), enums)
+		if err != nil {
+			return err
+		}
+		for _, problem := range problems {
+			fmt.Println(problem)
+		}
+		if len(problems) > 0 {
+			return fmt.Errorf("%s: %d enum mismatch(es)", m.Service(), len(problems))
+		}
+		fmt.Printf("%s: ENUM columns match the proto enums\n", m.Service())
+		return nil
+	}
+	return fmt.Errorf("unknown migrate command %q\n%s", args[0], Usage)
+}
+
+// OnStartup applies pending migrations when MIGRATE_ON_START is "true".
+// Otherwise the schema is left to the migrate command.
+func OnStartup(ctx context.Context, m *Migrator) error {
+	if os.Getenv("MIGRATE_ON_START") != "true" {
+		return nil
+	}
+	applied, err := m.Up(ctx, 0)
+	if err != nil {
+		return err
+	}
+	log.Printf("%s: applied %d migration(s) on startup", m.Service(), applied)
+	return nil
+}
@@ -0,0 +1,115 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// EnumColumn is an ENUM column together with the values a service writes
+// to it for every value of a proto enum
+type EnumColumn struct {
+	Table  string
+	Column string
+	Enum   string // proto enum name, for messages
+	Values []string
+}
+
+// EnumValues maps every value of a proto enum (its _name map) through the
+// service's conversion to the database string
+func EnumValues(names map[int32]string, toString func(int32) string) []string {
+	seen := map[string]bool{}
+	values := []string{}
+	for number := range names {
+		value := toString(number)
+		if !seen[value] {
+			seen[value] = true
+			values = append(values, value)
+		}
+	}
+	sort.Strings(values)
+	return values
+}
+
+// CheckEnums compares the ENUM columns of the connected database with what
+// the service writes. It reports values the service writes that the column
+// does not declare, declared values no proto value maps to, and ENUM
+// columns of the service's tables that nothing checks.
+func CheckEnums(ctx context.Context, db *sql.DB, tables []string, columns []EnumColumn) ([]string, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT TABLE_NAME, COLUMN_NAME, COLUMN_TYPE
+		FROM information_schema.COLUMNS
+		WHERE TABLE_SCHEMA = DATABASE() AND DATA_TYPE = 'enum'
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ENUM columns: %w", err)
+	}
+	defer rows.Close()
+
+	declared := map[string][]string{}
+	for rows.Next() {
+		var table, column, columnType string
+		if err := rows.Scan(&table, &column, &columnType); err != nil {
+			return nil, fmt.Errorf("failed to scan ENUM column: %w", err)
+		}
+		declared[table+"."+column] = parseEnumType(columnType)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ENUM columns: %w", err)
+	}
+
+	problems := []string{}
+	checked := map[string]bool{}
+	for _, column := range columns {
+		key := column.Table + "." + column.Column
+		checked[key] = true
+		values, ok := declared[key]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s: no ENUM column in the database", key))
+			continue
+		}
+		dbValues := map[string]bool{}
+		for _, v := range values {
+			dbValues[v] = true
+		}
+		written := map[string]bool{}
+		for _, v := range column.Values {
+			written[v] = true
+			if !dbValues[v] {
+				problems = append(problems, fmt.Sprintf("%s: %s is written as '%s', which the ENUM does not declare", key, column.Enum, v))
+			}
+		}
+		for _, v := range values {
+			if !written[v] {
+				problems = append(problems, fmt.Sprintf("%s: ENUM value '%s' matches no %s value", key, v, column.Enum))
+			}
+		}
+	}
+
+	owned := map[string]bool{}
+	for _, table := range tables {
+		owned[table] = true
+	}
+	unchecked := []string{}
+	for key := range declared {
+		table := key[:strings.Index(key, ".")]
+		if owned[table] && !checked[key] {
+			unchecked = append(unchecked, fmt.Sprintf("%s: ENUM column is not paired with a proto enum", key))
+		}
+	}
+	sort.Strings(unchecked)
+	return append(problems, unchecked...), nil
+}
+
+// parseEnumType reads the values of a COLUMN_TYPE like enum('a','b')
+func parseEnumType(columnType string) []string {
+	body := strings.TrimSuffix(strings.TrimPrefix(columnType, "enum("), ")")
+	values := []string{}
+	for _, part := range strings.Split(body, "','") {
+		part = strings.TrimPrefix(strings.TrimSuffix(part, "'"), "'")
+		values = append(values, strings.ReplaceAll(part, "''", "'"))
+	}
+	return values
+}
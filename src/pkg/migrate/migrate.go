@@ -0,0 +1,372 @@
+// Package migrate applies the numbered schema migrations each service embeds.
+//
+// A service owns the tables its migrations create and nothing else, so the
+// migrations of one service never reference another service's tables. The
+// applied version of every service is kept in Schema_migration. MySQL
+// commits DDL implicitly, so a migration that fails half way leaves the
+// service "dirty": it has to be repaired by hand and marked with force.
+package migrate
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// versionTable records the applied migration version of every service
+const versionTable = "Schema_migration"
+
+// lockTimeoutSeconds is how long a run waits for another instance's run
+const lockTimeoutSeconds = 60
+
+// Migration is one numbered schema change of a service
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// State is the migration state of a service's schema
+type State struct {
+	Version int
+	Dirty   bool
+	Applied []Migration
+	Pending []Migration
+}
+
+// Migrator runs the migrations of one service
+type Migrator struct {
+	db         *sql.DB
+	service    string
+	migrations []Migration
+}
+
+var fileName = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+var createTable = regexp.MustCompile("(?i)CREATE TABLE (?:IF NOT EXISTS )?`?([A-Za-z0-9_]+)`?")
+
+// Load reads NNNN_name.up.sql / NNNN_name.down.sql pairs from fsys
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := map[int]*Migration{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".sql") {
+			continue
+		}
+		match := fileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("migration file %s is not named NNNN_name.up.sql or NNNN_name.down.sql", entry.Name())
+		}
+		version, _ := strconv.Atoi(match[1])
+		if version <= 0 {
+			return nil, fmt.Errorf("migration file %s: versions start at 1", entry.Name())
+		}
+		body, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		} else if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d is named both %s and %s", version, migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.Up = string(body)
+		} else {
+			migration.Down = string(body)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if strings.TrimSpace(migration.Up) == "" || strings.TrimSpace(migration.Down) == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both an up and a down file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// New loads the migrations of a service
+func New(db *sql.DB, service string, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, service: service, migrations: migrations}, nil
+}
+
+// Service is the name the migrator records its version under
+func (m *Migrator) Service() string {
+	return m.service
+}
+
+// Tables lists the tables the service's migrations create
+func (m *Migrator) Tables() []string {
+	seen := map[string]bool{}
+	tables := []string{}
+	for _, migration := range m.migrations {
+		for _, match := range createTable.FindAllStringSubmatch(migration.Up, -1) {
+			if !seen[match[1]] {
+				seen[match[1]] = true
+				tables = append(tables, match[1])
+			}
+		}
+	}
+	return tables
+}
+
+// Up applies up to steps pending migrations, all of them when steps <= 0,
+// and returns how many were applied
+func (m *Migrator) Up(ctx context.Context, steps int) (int, error) {
+	conn, unlock, err := m.lock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	version, dirty, err := m.current(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+	if dirty {
+		return 0, m.dirtyError(version)
+	}
+
+	applied := 0
+	for _, migration := range m.migrations {
+		if migration.Version <= version {
+			continue
+		}
+		if steps > 0 && applied == steps {
+			break
+		}
+		if err := m.setVersion(ctx, conn, migration.Version, true); err != nil {
+			return applied, err
+		}
+		if err := execScript(ctx, conn, migration.Up); err != nil {
+			return applied, fmt.Errorf("migration %d_%s failed, schema left dirty: %w", migration.Version, migration.Name, err)
+		}
+		if err := m.setVersion(ctx, conn, migration.Version, false); err != nil {
+			return applied, err
+		}
+		applied++
+	}
+	return applied, nil
+}
+
+// Down reverts the last steps applied migrations and returns how many were
+// reverted
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	if steps <= 0 {
+		return 0, fmt.Errorf("down needs a positive number of steps")
+	}
+
+	conn, unlock, err := m.lock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	version, dirty, err := m.current(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+	if dirty {
+		return 0, m.dirtyError(version)
+	}
+
+	reverted := 0
+	for i := len(m.migrations) - 1; i >= 0 && reverted < steps; i-- {
+		migration := m.migrations[i]
+		if migration.Version > version {
+			continue
+		}
+		previous := 0
+		if i > 0 {
+			previous = m.migrations[i-1].Version
+		}
+		if err := m.setVersion(ctx, conn, migration.Version, true); err != nil {
+			return reverted, err
+		}
+		if err := execScript(ctx, conn, migration.Down); err != nil {
+			return reverted, fmt.Errorf("reverting migration %d_%s failed, schema left dirty: %w", migration.Version, migration.Name, err)
+		}
+		if err := m.setVersion(ctx, conn, previous, false); err != nil {
+			return reverted, err
+		}
+		version = previous
+		reverted++
+	}
+	return reverted, nil
+}
+
+// Status reports the applied version and the pending migrations
+func (m *Migrator) Status(ctx context.Context) (*State, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a connection: %w", err)
+	}
+	defer conn.Close()
+
+	version, dirty, err := m.current(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &State{Version: version, Dirty: dirty}
+	for _, migration := range m.migrations {
+		if migration.Version <= version {
+			state.Applied = append(state.Applied, migration)
+		} else {
+			state.Pending = append(state.Pending, migration)
+		}
+	}
+	return state, nil
+}
+
+// Force records version as applied and clean without running anything. It
+// is how a dirty schema is marked repaired; version 0 means nothing applied.
+func (m *Migrator) Force(ctx context.Context, version int) error {
+	known := version == 0
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			known = true
+		}
+	}
+	if !known {
+		return fmt.Errorf("no migration with version %d", version)
+	}
+
+	conn, unlock, err := m.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := m.ensureVersionTable(ctx, conn); err != nil {
+		return err
+	}
+	return m.setVersion(ctx, conn, version, false)
+}
+
+func (m *Migrator) dirtyError(version int) error {
+	return fmt.Errorf("schema of %s is dirty at version %d: repair it, then run force", m.service, version)
+}
+
+// lock takes a named lock so instances starting together migrate one at a
+// time; the returned connection holds the lock until unlock
+func (m *Migrator) lock(ctx context.Context) (*sql.Conn, func(), error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get a connection: %w", err)
+	}
+
+	name := "migrate_" + m.service
+	var got sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", name, lockTimeoutSeconds).Scan(&got); err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("failed to take the migration lock: %w", err)
+	}
+	if !got.Valid || got.Int64 != 1 {
+		conn.Close()
+		return nil, nil, fmt.Errorf("another instance is migrating %s", m.service)
+	}
+
+	unlock := func() {
+		conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", name)
+		conn.Close()
+	}
+	return conn, unlock, nil
+}
+
+func (m *Migrator) ensureVersionTable(ctx context.Context, conn *sql.Conn) error {
+	_, err := conn.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS `"+versionTable+"` ("+
+		"`service` varchar(64) PRIMARY KEY, "+
+		"`version` int NOT NULL, "+
+		"`dirty` boolean NOT NULL DEFAULT false, "+
+		"`updated_at` datetime NOT NULL)")
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", versionTable, err)
+	}
+	return nil
+}
+
+func (m *Migrator) current(ctx context.Context, conn *sql.Conn) (int, bool, error) {
+	if err := m.ensureVersionTable(ctx, conn); err != nil {
+		return 0, false, err
+	}
+
+	var version int
+	var dirty bool
+	err := conn.QueryRowContext(ctx, "SELECT version, dirty FROM `"+versionTable+"` WHERE service = ?", m.service).Scan(&version, &dirty)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read the schema version: %w", err)
+	}
+	return version, dirty, nil
+}
+
+func (m *Migrator) setVersion(ctx context.Context, conn *sql.Conn, version int, dirty bool) error {
+	_, err := conn.ExecContext(ctx, "INSERT INTO `"+versionTable+"` (service, version, dirty, updated_at) VALUES (?, ?, ?, NOW()) "+
+		"ON DUPLICATE KEY UPDATE version = VALUES(version), dirty = VALUES(dirty), updated_at = VALUES(updated_at)",
+		m.service, version, dirty)
+	if err != nil {
+		return fmt.Errorf("failed to record schema version %d: %w", version, err)
+	}
+	return nil
+}
+
+// execScript runs the statements of a migration file one by one. A statement
+// ends with a line ending in ';'; lines starting with "--" are comments.
+func execScript(ctx context.Context, conn *sql.Conn, script string) error {
+	for _, statement := range splitStatements(script) {
+		if _, err := conn.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("%w\n%s", err, statement)
+		}
+	}
+	return nil
+}
+
+func splitStatements(script string) []string {
+	statements := []string{}
+	var current strings.Builder
+	scanner := bufio.NewScanner(strings.NewReader(script))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		current.WriteString(line)
+		current.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			statements = append(statements, strings.TrimSuffix(strings.TrimSpace(current.String()), ";"))
+			current.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(current.String()); rest != "" {
+		statements = append(statements, rest)
+	}
+	return statements
+}
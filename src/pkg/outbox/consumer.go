@@ -0,0 +1,185 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	pb "thaily/proto/event"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// readBlock is how long a consumer waits for new events per read
+	readBlock = 5 * time.Second
+	// claimIdle is how long an event stays pending with a consumer, which
+	// failed it or died, before another consumer of the group takes it over
+	claimIdle = time.Minute
+)
+
+// Handler handles one event. An error leaves the event pending so it is
+// delivered again; as every event may be delivered more than once, handlers
+// skip event ids they have already handled.
+type Handler func(ctx context.Context, event *pb.Event) error
+
+// Consumer reads the stream as a member of a consumer group: each event goes
+// to one consumer of the group, and every group sees every event
+type Consumer struct {
+	redis *redis.Client
+	group string
+	name  string
+}
+
+// NewConsumer joins the group under a name unique within it, e.g. the host
+func NewConsumer(rdb *redis.Client, group, name string) *Consumer {
+	return &Consumer{redis: rdb, group: group, name: name}
+}
+
+// Run hands the events to handle until ctx is done. A new group starts with
+// the events published from then on; Rewind replays older ones.
+func (c *Consumer) Run(ctx context.Context, handle Handler) error {
+	if err := createGroup(ctx, c.redis, c.group); err != nil {
+		return err
+	}
+
+	var lastClaim time.Time
+	for ctx.Err() == nil {
+		if time.Since(lastClaim) >= claimIdle {
+			if err := c.claim(ctx, handle); err != nil {
+				log.Printf("Events: %s failed to claim pending events: %v", c.group, err)
+			}
+			lastClaim = time.Now()
+		}
+
+		streams, err := c.redis.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    c.group,
+			Consumer: c.name,
+			Streams:  []string{Stream, ">"},
+			Count:    batchSize,
+			Block:    readBlock,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			log.Printf("Events: %s failed to read: %v", c.group, err)
+			time.Sleep(readBlock)
+			continue
+		}
+		for _, stream := range streams {
+			for _, msg := range stream.Messages {
+				c.deliver(ctx, msg, handle)
+			}
+		}
+	}
+	return ctx.Err()
+}
+
+// claim takes over and handles the events left pending too long
+func (c *Consumer) claim(ctx context.Context, handle Handler) error {
+	start := "0-0"
+	for {
+		msgs, next, err := c.redis.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   Stream,
+			Group:    c.group,
+			Consumer: c.name,
+			MinIdle:  claimIdle,
+			Start:    start,
+			Count:    batchSize,
+		}).Result()
+		if err != nil {
+			return err
+		}
+		for _, msg := range msgs {
+			c.deliver(ctx, msg, handle)
+		}
+		if next == "0-0" || len(msgs) == 0 {
+			return nil
+		}
+		start = next
+	}
+}
+
+// deliver handles one entry and acknowledges it unless the handler failed.
+// An entry that cannot be decoded is acknowledged, as no retry can handle it.
+func (c *Consumer) deliver(ctx context.Context, msg redis.XMessage, handle Handler) {
+	event, err := Decode(msg)
+	if err != nil {
+		log.Printf("Events: %s dropping entry %s: %v", c.group, msg.ID, err)
+	} else if err := handle(ctx, event); err != nil {
+		log.Printf("Events: %s failed to handle %s %s, left pending: %v", c.group, event.Type, event.Id, err)
+		return
+	}
+	if err := c.redis.XAck(ctx, Stream, c.group, msg.ID).Err(); err != nil {
+		log.Printf("Events: %s failed to acknowledge %s: %v", c.group, msg.ID, err)
+	}
+}
+
+// createGroup creates the group at the end of the stream unless it exists
+func createGroup(ctx context.Context, rdb *redis.Client, group string) error {
+	err := rdb.XGroupCreateMkStream(ctx, Stream, group, "$").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("failed to create consumer group %s: %w", group, err)
+	}
+	return nil
+}
+
+// Rewind moves the group back so its consumers receive again every event
+// after the stream id, "0" for all the stream keeps
+func Rewind(ctx context.Context, rdb *redis.Client, group, id string) error {
+	if err := createGroup(ctx, rdb, group); err != nil {
+		return err
+	}
+	if err := rdb.XGroupSetID(ctx, Stream, group, id).Err(); err != nil {
+		return fmt.Errorf("failed to rewind consumer group %s: %w", group, err)
+	}
+	return nil
+}
+
+// Replay hands the events from the stream id on ("-" for the oldest kept) to
+// handle in order, outside any group, stopping at the first error
+func Replay(ctx context.Context, rdb *redis.Client, from string, handle Handler) error {
+	start := from
+	for {
+		msgs, err := rdb.XRangeN(ctx, Stream, start, "+", batchSize).Result()
+		if err != nil {
+			return fmt.Errorf("failed to read events: %w", err)
+		}
+		for _, msg := range msgs {
+			event, err := Decode(msg)
+			if err != nil {
+				log.Printf("Events: replay skipping entry %s: %v", msg.ID, err)
+				continue
+			}
+			if err := handle(ctx, event); err != nil {
+				return fmt.Errorf("replay stopped at %s: %w", msg.ID, err)
+			}
+		}
+		if len(msgs) < batchSize {
+			return nil
+		}
+		// Exclusive start after the last entry read
+		start = "(" + msgs[len(msgs)-1].ID
+	}
+}
+
+// Decode reads the event of a stream entry
+func Decode(msg redis.XMessage) (*pb.Event, error) {
+	data, ok := msg.Values["data"].(string)
+	if !ok {
+		return nil, fmt.Errorf("entry %s has no data", msg.ID)
+	}
+	var event pb.Event
+	if err := proto.Unmarshal([]byte(data), &event); err != nil {
+		return nil, fmt.Errorf("failed to decode entry %s: %w", msg.ID, err)
+	}
+	return &event, nil
+}
@@ -0,0 +1,71 @@
+// Package outbox carries domain events from the services to a Redis stream.
+// A handler writes the event to its service's Outbox table in the
+// transaction of the change, so an event exists exactly when its change was
+// committed; the Relay then appends unpublished rows to the stream, and
+// Consumers read it through consumer groups. Delivery is at least once.
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	pb "thaily/proto/event"
+	"thaily/src/pkg/logger"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Stream is the Redis stream every service publishes to
+const Stream = "thaily:events"
+
+// Execer is satisfied by both *sql.DB and *sql.Tx
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// Write adds the event to the outbox through tx, the transaction of the
+// change it describes. Id, Type, OccurredAt and RequestId are filled in when
+// empty; Service is stamped by the relay.
+func Write(ctx context.Context, tx Execer, event *pb.Event) error {
+	if event.Type == "" {
+		event.Type = payloadType(event)
+	}
+	if event.Type == "" {
+		return fmt.Errorf("event on %s %s has no payload", event.Aggregate, event.AggregateId)
+	}
+	if event.Id == "" {
+		event.Id = uuid.New().String()
+	}
+	if event.OccurredAt == nil {
+		event.OccurredAt = timestamppb.Now()
+	}
+	if event.RequestId == "" {
+		event.RequestId = logger.GetRequestID(ctx)
+	}
+
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", event.Type, err)
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO Outbox (id, type, aggregate, aggregate_id, payload, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, event.Id, event.Type, event.Aggregate, event.AggregateId, data, event.OccurredAt.AsTime())
+	if err != nil {
+		return fmt.Errorf("failed to write %s event: %w", event.Type, err)
+	}
+	return nil
+}
+
+// payloadType names the message set in the payload oneof, e.g. "FileUploaded"
+func payloadType(event *pb.Event) string {
+	m := event.ProtoReflect()
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("payload"))
+	if fd == nil {
+		return ""
+	}
+	return string(fd.Message().Name())
+}
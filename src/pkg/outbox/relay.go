@@ -0,0 +1,278 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	pb "thaily/proto/event"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// defaultInterval is how often the relay polls when OUTBOX_INTERVAL is not set
+	defaultInterval = time.Second
+	// defaultRetention is how long published rows are kept when
+	// OUTBOX_RETENTION is not set
+	defaultRetention = 7 * 24 * time.Hour
+	// defaultMaxLen bounds the stream when EVENT_STREAM_MAXLEN is not set; it
+	// is the window events can be replayed from
+	defaultMaxLen = 1000000
+	// batchSize bounds the rows published or cleaned up in one pass
+	batchSize = 100
+	// maxErrorLen is the size of the error column of a dead-lettered row
+	maxErrorLen = 1024
+)
+
+// Interval reads OUTBOX_INTERVAL (a Go duration, "0" disables the relay),
+// defaulting to a second
+func Interval() time.Duration {
+	return durationEnv("OUTBOX_INTERVAL", defaultInterval)
+}
+
+// Retention reads OUTBOX_RETENTION (a Go duration, "0" keeps published rows),
+// defaulting to a week
+func Retention() time.Duration {
+	return durationEnv("OUTBOX_RETENTION", defaultRetention)
+}
+
+func durationEnv(name string, def time.Duration) time.Duration {
+	if val := os.Getenv(name); val != "" {
+		if d, err := time.ParseDuration(val); err == nil && d >= 0 {
+			return d
+		}
+		log.Printf("Warning: invalid %s %q, using %v", name, val, def)
+	}
+	return def
+}
+
+// Connect opens the event bus from REDIS_ADDRESS, REDIS_PASSWORD and
+// REDIS_DB, the Redis the gateway uses. Without REDIS_ADDRESS it returns nil
+// and events wait in the outbox.
+func Connect(ctx context.Context) (*redis.Client, func(), error) {
+	addr := os.Getenv("REDIS_ADDRESS")
+	if addr == "" {
+		log.Printf("Warning: REDIS_ADDRESS not set, events stay in the outbox")
+		return nil, func() {}, nil
+	}
+	db, _ := strconv.Atoi(os.Getenv("REDIS_DB"))
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     addr,
+		Password: os.Getenv("REDIS_PASSWORD"),
+		DB:       db,
+	})
+	pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := client.Ping(pingCtx).Err(); err != nil {
+		client.Close()
+		return nil, nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+	return client, func() { client.Close() }, nil
+}
+
+// Relay publishes a service's outbox to the stream
+type Relay struct {
+	db      *sql.DB
+	redis   *redis.Client
+	service string
+	maxLen  int64
+}
+
+// NewRelay relays the outbox of db, stamping its events with the service
+func NewRelay(db *sql.DB, rdb *redis.Client, service string) *Relay {
+	maxLen := int64(defaultMaxLen)
+	if val := os.Getenv("EVENT_STREAM_MAXLEN"); val != "" {
+		if n, err := strconv.ParseInt(val, 10, 64); err == nil && n >= 0 {
+			maxLen = n
+		} else {
+			log.Printf("Warning: invalid EVENT_STREAM_MAXLEN %q, using %d", val, maxLen)
+		}
+	}
+	return &Relay{db: db, redis: rdb, service: service, maxLen: maxLen}
+}
+
+// Publish appends the oldest unpublished events to the stream and marks them
+// published. A pass holds the service's relay lease, so with several replicas
+// one publishes at a time and events reach the stream in seq order; the
+// others find the lease taken and publish nothing. An event appended but not
+// marked, because the commit failed, is appended again by the next pass.
+// Undecodable rows are dead-lettered.
+func (r *Relay) Publish(ctx context.Context) (int, error) {
+	conn, release, err := r.lease(ctx)
+	if err != nil || conn == nil {
+		return 0, err
+	}
+	defer release()
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT seq, payload FROM Outbox
+		WHERE published_at IS NULL AND failed_at IS NULL
+		ORDER BY seq
+		LIMIT ?
+		FOR UPDATE
+	`, batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read outbox: %w", err)
+	}
+	type pending struct {
+		seq     int64
+		payload []byte
+	}
+	batch := []pending{}
+	for rows.Next() {
+		var p pending
+		if err := rows.Scan(&p.seq, &p.payload); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan outbox: %w", err)
+		}
+		batch = append(batch, p)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to read outbox: %w", err)
+	}
+
+	published := 0
+	for _, p := range batch {
+		var event pb.Event
+		if err := proto.Unmarshal(p.payload, &event); err != nil {
+			// Dead-letter the row so it does not hold up the rest
+			log.Printf("Outbox: dead-lettering undecodable event %d: %v", p.seq, err)
+			if _, err := tx.ExecContext(ctx, `UPDATE Outbox SET failed_at = NOW(), error = ? WHERE seq = ?`, truncate(err.Error(), maxErrorLen), p.seq); err != nil {
+				return published, fmt.Errorf("failed to dead-letter event %d: %w", p.seq, err)
+			}
+			continue
+		}
+		event.Service = r.service
+
+		streamID, err := r.append(ctx, &event)
+		if err != nil {
+			// Keep the order: the rest waits for the next pass
+			log.Printf("Outbox: failed to publish %s %s: %v", event.Type, event.Id, err)
+			break
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE Outbox SET published_at = NOW(), stream_id = ? WHERE seq = ?`, streamID, p.seq); err != nil {
+			return published, fmt.Errorf("failed to mark %s published: %w", event.Id, err)
+		}
+		published++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit outbox: %w", err)
+	}
+	return published, nil
+}
+
+// lease takes the service's relay lock without waiting; the returned
+// connection holds it until release. It returns no connection while another
+// replica holds the lock.
+func (r *Relay) lease(ctx context.Context) (*sql.Conn, func(), error) {
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get a connection: %w", err)
+	}
+
+	name := "outbox_" + r.service
+	var got sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, 0)", name).Scan(&got); err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("failed to take the relay lease: %w", err)
+	}
+	if !got.Valid || got.Int64 != 1 {
+		conn.Close()
+		return nil, nil, nil
+	}
+
+	release := func() {
+		conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", name)
+		conn.Close()
+	}
+	return conn, release, nil
+}
+
+// truncate cuts s to at most n bytes
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
+
+// append adds the event to the stream and returns its stream id
+func (r *Relay) append(ctx context.Context, event *pb.Event) (string, error) {
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return "", err
+	}
+	args := &redis.XAddArgs{
+		Stream: Stream,
+		Values: map[string]interface{}{
+			"id":           event.Id,
+			"type":         event.Type,
+			"aggregate":    event.Aggregate,
+			"aggregate_id": event.AggregateId,
+			"data":         data,
+		},
+	}
+	if r.maxLen > 0 {
+		args.MaxLen = r.maxLen
+		args.Approx = true
+	}
+	return r.redis.XAdd(ctx, args).Result()
+}
+
+// Cleanup deletes rows published longer ago than the retention; the stream
+// keeps them for replay
+func (r *Relay) Cleanup(ctx context.Context, retention time.Duration) (int64, error) {
+	if retention <= 0 {
+		return 0, nil
+	}
+	result, err := r.db.ExecContext(ctx, `DELETE FROM Outbox WHERE published_at < ? LIMIT ?`, time.Now().Add(-retention), batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to clean up outbox: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+// Run publishes the outbox every interval until ctx is done, draining it
+// when a pass fills a whole batch. A nil relay or bus, or a zero interval,
+// publishes nothing.
+func (r *Relay) Run(ctx context.Context, interval, retention time.Duration) {
+	if r == nil || r.redis == nil || interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		for {
+			published, err := r.Publish(ctx)
+			if err != nil {
+				log.Printf("Outbox: %v", err)
+			}
+			if published < batchSize {
+				break
+			}
+		}
+		if _, err := r.Cleanup(ctx, retention); err != nil {
+			log.Printf("Outbox: %v", err)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package reference
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"time"
+)
+
+// defaultReportInterval is how often the orphan report runs when
+// ORPHAN_REPORT_INTERVAL is not set
+const defaultReportInterval = 24 * time.Hour
+
+// Column is a column of a service's table that points at another service's
+// table, the foreign key it replaces
+type Column struct {
+	Table      string
+	Column     string
+	References string
+}
+
+// Orphan is a value of a reference column that no longer exists in the
+// referenced table
+type Orphan struct {
+	Column
+	Value string
+	Rows  int
+}
+
+// Orphans lists the values of the columns that the owning services do not
+// know. Columns whose referenced table has no owner configured are skipped.
+func (r *Resolver) Orphans(ctx context.Context, db *sql.DB, columns []Column) ([]Orphan, error) {
+	orphans := []Orphan{}
+	for _, c := range columns {
+		if r == nil {
+			break
+		}
+		if _, ok := r.owners[c.References]; !ok {
+			continue
+		}
+
+		query := fmt.Sprintf("SELECT `%s`, COUNT(*) FROM `%s` WHERE `%s` IS NOT NULL AND `%s` != '' GROUP BY `%s`",
+			c.Column, c.Table, c.Column, c.Column, c.Column)
+		rows, err := db.QueryContext(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s.%s: %w", c.Table, c.Column, err)
+		}
+		counts := map[string]int{}
+		values := []string{}
+		for rows.Next() {
+			var value string
+			var count int
+			if err := rows.Scan(&value, &count); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("failed to scan %s.%s: %w", c.Table, c.Column, err)
+			}
+			counts[value] = count
+			values = append(values, value)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read %s.%s: %w", c.Table, c.Column, err)
+		}
+
+		missing, err := r.Missing(ctx, c.References, values...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check %s.%s: %w", c.Table, c.Column, err)
+		}
+		for _, value := range missing {
+			orphans = append(orphans, Orphan{Column: c, Value: value, Rows: counts[value]})
+		}
+	}
+	return orphans, nil
+}
+
+// ReportInterval reads ORPHAN_REPORT_INTERVAL (a Go duration, "0" disables
+// the report), defaulting to a day
+func ReportInterval() time.Duration {
+	if val := os.Getenv("ORPHAN_REPORT_INTERVAL"); val != "" {
+		if d, err := time.ParseDuration(val); err == nil && d >= 0 {
+			return d
+		}
+		log.Printf("Warning: invalid ORPHAN_REPORT_INTERVAL %q, using %v", val, defaultReportInterval)
+	}
+	return defaultReportInterval
+}
+
+// Report logs the orphaned references of the columns, failing when there
+// are any
+func (r *Resolver) Report(ctx context.Context, db *sql.DB, columns []Column) error {
+	orphans, err := r.Orphans(ctx, db, columns)
+	if err != nil {
+		return err
+	}
+	for _, o := range orphans {
+		log.Printf("Orphan reference: %s.%s = %s (%d rows) has no %s", o.Table, o.Column, o.Value, o.Rows, o.References)
+	}
+	if len(orphans) > 0 {
+		return fmt.Errorf("%d orphaned reference(s)", len(orphans))
+	}
+	log.Printf("Orphan report: no orphaned references")
+	return nil
+}
+
+// RunReport runs Report every interval until ctx is done
+func (r *Resolver) RunReport(ctx context.Context, db *sql.DB, columns []Column, interval time.Duration) {
+	if r == nil || interval <= 0 || len(columns) == 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := r.Report(ctx, db, columns); err != nil {
+			log.Printf("Orphan report: %v", err)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+// Package reference replaces the foreign keys between services. Every
+// service owns its tables (and may keep them in its own database), so a
+// column pointing at another service's table, e.g. Enrollment.student_code,
+// is checked against the owning service over its CheckReferences RPC
+// before it is written, and drifted values are reported as orphans.
+package reference
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	pbCommon "thaily/proto/common"
+	"thaily/src/pkg/tls"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxCheckIDs bounds the ids of a single CheckReferences request
+const maxCheckIDs = 500
+
+// Check answers a CheckReferences request against the tables a service
+// owns. Every table is keyed by its id column, and a soft-deleted row counts
+// as missing.
+func Check(ctx context.Context, db *sql.DB, owned []string, req *pbCommon.ReferenceCheckRequest) (*pbCommon.ReferenceCheckResponse, error) {
+	table := ""
+	for _, t := range owned {
+		if t == req.Table {
+			table = t
+		}
+	}
+	if table == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "table %q is not owned by this service", req.Table)
+	}
+
+	ids := unique(req.Ids)
+	if len(ids) > maxCheckIDs {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d ids can be checked at once", maxCheckIDs)
+	}
+	if len(ids) == 0 {
+		return &pbCommon.ReferenceCheckResponse{}, nil
+	}
+
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	query := fmt.Sprintf("SELECT id FROM `%s` WHERE id IN (%s) AND deleted_at IS NULL", table, strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", "))
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check references: %v", err)
+	}
+	defer rows.Close()
+
+	found := map[string]bool{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan reference: %v", err)
+		}
+		found[id] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check references: %v", err)
+	}
+
+	missing := []string{}
+	for _, id := range ids {
+		if !found[id] {
+			missing = append(missing, id)
+		}
+	}
+	return &pbCommon.ReferenceCheckResponse{Missing: missing}, nil
+}
+
+// owner is the CheckReferences RPC of the service owning a table
+type owner struct {
+	conn   *grpc.ClientConn
+	method string
+}
+
+// Resolver routes reference checks to the services owning the tables. A nil
+// Resolver, or a table without an owner, checks nothing, so a service runs
+// without its peers configured.
+type Resolver struct {
+	owners map[string]owner
+	conns  []*grpc.ClientConn
+}
+
+// NewResolver returns a Resolver without owners
+func NewResolver() *Resolver {
+	return &Resolver{owners: map[string]owner{}}
+}
+
+// Dial connects to the service whose address is in the addrEnv environment
+// variable and routes the given tables to its CheckReferences method (the
+// generated <Service>_CheckReferences_FullMethodName). An empty address
+// leaves the tables unchecked.
+func (r *Resolver) Dial(addrEnv, serverName, method string, tables ...string) error {
+	addr := os.Getenv(addrEnv)
+	if addr == "" {
+		log.Printf("Warning: %s is not set, references to %s are not checked", addrEnv, strings.Join(tables, ", "))
+		return nil
+	}
+
+	creds, err := tls.LoadClientTLSCredentials(serverName)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS credentials for %s: %v", serverName, err)
+	}
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %v", serverName, err)
+	}
+
+	r.conns = append(r.conns, conn)
+	for _, table := range tables {
+		r.owners[table] = owner{conn: conn, method: method}
+	}
+	return nil
+}
+
+// Close closes the connections to the owning services
+func (r *Resolver) Close() {
+	if r == nil {
+		return
+	}
+	for _, conn := range r.conns {
+		conn.Close()
+	}
+}
+
+// Conn returns the connection to the service owning the table, for its
+// other RPCs, or nil when the table has no owner configured
+func (r *Resolver) Conn(table string) *grpc.ClientConn {
+	if r == nil {
+		return nil
+	}
+	return r.owners[table].conn
+}
+
+// Missing returns the ids that do not exist in the table of another service.
+// It returns nil when the table has no owner configured.
+func (r *Resolver) Missing(ctx context.Context, table string, ids ...string) ([]string, error) {
+	if r == nil {
+		return nil, nil
+	}
+	o, ok := r.owners[table]
+	if !ok {
+		return nil, nil
+	}
+
+	ids = unique(ids)
+	missing := []string{}
+	for start := 0; start < len(ids); start += maxCheckIDs {
+		end := start + maxCheckIDs
+		if end > len(ids) {
+			end = len(ids)
+		}
+		resp := &pbCommon.ReferenceCheckResponse{}
+		req := &pbCommon.ReferenceCheckRequest{Table: table, Ids: ids[start:end]}
+		if err := o.conn.Invoke(ctx, o.method, req, resp); err != nil {
+			return nil, err
+		}
+		missing = append(missing, resp.Missing...)
+	}
+	return missing, nil
+}
+
+// Require fails with FailedPrecondition when one of the ids does not exist
+// in the table, and with Unavailable when the owner cannot be asked. Empty
+// ids are skipped, since optional references are stored as "".
+func (r *Resolver) Require(ctx context.Context, field, table string, ids ...string) error {
+	missing, err := r.Missing(ctx, table, ids...)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to check %s: %v", field, err)
+	}
+	if len(missing) > 0 {
+		return status.Errorf(codes.FailedPrecondition, "%s %s does not exist", field, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// unique drops empty and repeated ids, keeping them sorted
+func unique(ids []string) []string {
+	seen := map[string]bool{}
+	result := []string{}
+	for _, id := range ids {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
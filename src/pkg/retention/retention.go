@@ -0,0 +1,193 @@
+// Package retention purges soft-deleted rows. Delete RPCs only stamp
+// deleted_at/deleted_by, so an accidental deletion can be restored; once a
+// row has been deleted for longer than the retention period it is removed
+// for good.
+package retention
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"time"
+)
+
+const (
+	// defaultPeriod is how long a soft-deleted row is kept when
+	// RETENTION_PERIOD is not set
+	defaultPeriod = 30 * 24 * time.Hour
+	// defaultInterval is how often the purge runs when RETENTION_INTERVAL is
+	// not set
+	defaultInterval = 24 * time.Hour
+	// batchSize bounds the rows of a table read at a time
+	batchSize = 500
+)
+
+// Dependent is a table whose rows go with a purged row, through the column
+// holding its id, where the foreign key does not cascade
+type Dependent struct {
+	Table  string
+	Column string
+}
+
+// Table is a soft-deleted table. Tables are purged in the order given, so
+// rows pointing at another soft-deleted table come first.
+type Table struct {
+	Name       string
+	Dependents []Dependent
+}
+
+// Period reads RETENTION_PERIOD (a Go duration, "0" keeps deleted rows
+// forever), defaulting to 30 days
+func Period() time.Duration {
+	return durationEnv("RETENTION_PERIOD", defaultPeriod)
+}
+
+// Interval reads RETENTION_INTERVAL (a Go duration, "0" disables the purge),
+// defaulting to a day
+func Interval() time.Duration {
+	return durationEnv("RETENTION_INTERVAL", defaultInterval)
+}
+
+func durationEnv(name string, def time.Duration) time.Duration {
+	if val := os.Getenv(name); val != "" {
+		if d, err := time.ParseDuration(val); err == nil && d >= 0 {
+			return d
+		}
+		log.Printf("Warning: invalid %s %q, using %v", name, val, def)
+	}
+	return def
+}
+
+// Purge hard-deletes the rows of the tables that were deleted before the
+// period. A row still referenced by a foreign key is logged and kept for the
+// next run; the pass pages past it so it does not hold up the rows after it.
+func Purge(ctx context.Context, db *sql.DB, tables []Table, period time.Duration) (int, error) {
+	if period <= 0 {
+		return 0, nil
+	}
+	cutoff := time.Now().Add(-period)
+
+	purged := 0
+	for _, t := range tables {
+		n, err := purgeTable(ctx, db, t, cutoff)
+		purged += n
+		if err != nil {
+			return purged, err
+		}
+	}
+	return purged, nil
+}
+
+// expiredRow is the paging key of a row deleted before the cutoff
+type expiredRow struct {
+	id        string
+	deletedAt time.Time
+}
+
+// purgeTable purges the expired rows of one table, a batch at a time in
+// (deleted_at, id) order
+func purgeTable(ctx context.Context, db *sql.DB, t Table, cutoff time.Time) (int, error) {
+	purged := 0
+	var after *expiredRow
+	for {
+		batch, err := expiredRows(ctx, db, t, cutoff, after)
+		if err != nil {
+			return purged, err
+		}
+		for _, row := range batch {
+			ok, err := purgeRow(ctx, db, t, row.id, cutoff)
+			if err != nil {
+				log.Printf("Retention: keeping %s %s: %v", t.Name, row.id, err)
+				continue
+			}
+			if ok {
+				purged++
+			}
+		}
+		if len(batch) < batchSize {
+			return purged, nil
+		}
+		after = &batch[len(batch)-1]
+	}
+}
+
+// expiredRows reads the next batch of rows deleted before the cutoff, after
+// the given row when there is one
+func expiredRows(ctx context.Context, db *sql.DB, t Table, cutoff time.Time, after *expiredRow) ([]expiredRow, error) {
+	query := fmt.Sprintf("SELECT id, deleted_at FROM `%s` WHERE deleted_at < ?", t.Name)
+	args := []interface{}{cutoff}
+	if after != nil {
+		query += " AND (deleted_at > ? OR (deleted_at = ? AND id > ?))"
+		args = append(args, after.deletedAt, after.deletedAt, after.id)
+	}
+	query += " ORDER BY deleted_at, id LIMIT ?"
+	args = append(args, batchSize)
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read deleted %s: %w", t.Name, err)
+	}
+	defer rows.Close()
+
+	batch := []expiredRow{}
+	for rows.Next() {
+		var row expiredRow
+		if err := rows.Scan(&row.id, &row.deletedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan deleted %s: %w", t.Name, err)
+		}
+		batch = append(batch, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read deleted %s: %w", t.Name, err)
+	}
+	return batch, nil
+}
+
+// purgeRow deletes a row with its dependents in one transaction. It reports
+// false when the row was restored in the meantime.
+func purgeRow(ctx context.Context, db *sql.DB, t Table, id string, cutoff time.Time) (bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	for _, d := range t.Dependents {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM `%s` WHERE `%s` = ?", d.Table, d.Column), id); err != nil {
+			return false, err
+		}
+	}
+	result, err := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM `%s` WHERE id = ? AND deleted_at < ?", t.Name), id, cutoff)
+	if err != nil {
+		return false, err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return false, nil
+	}
+	return true, tx.Commit()
+}
+
+// Run purges the tables every interval until ctx is done
+func Run(ctx context.Context, db *sql.DB, tables []Table, period, interval time.Duration) {
+	if period <= 0 || interval <= 0 || len(tables) == 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		purged, err := Purge(ctx, db, tables, period)
+		if err != nil {
+			log.Printf("Retention: %v", err)
+		}
+		if purged > 0 {
+			log.Printf("Retention: purged %d row(s) deleted more than %v ago", purged, period)
+		}
+	}
+}
@@ -0,0 +1,298 @@
+// Package schedule builds the defence timetable of a semester: which council
+// judges each topic council, and when and where every council sits.
+package schedule
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Window is a half-open period [Start, End)
+type Window struct {
+	Start time.Time
+	End   time.Time
+}
+
+func (w Window) overlaps(o Window) bool {
+	return w.Start.Before(o.End) && o.Start.Before(w.End)
+}
+
+// Council is a defence council with the teachers sitting on it
+type Council struct {
+	Code      string
+	MajorCode string
+	Members   []string
+}
+
+// TopicCouncil is one defence to schedule. CouncilCode is the council it is
+// currently assigned to; it is kept when that respects the constraints.
+type TopicCouncil struct {
+	Code        string
+	CouncilCode string
+	Supervisors []string
+}
+
+// Input is everything the scheduler needs for one semester
+type Input struct {
+	Councils      []Council
+	TopicCouncils []TopicCouncil
+	Rooms         []string
+	// Days are the periods defences can take place in, e.g. 08:00-17:00 of each defence day
+	Days       []Window
+	SlotLength time.Duration
+	// Unavailable lists the periods each teacher cannot sit
+	Unavailable map[string][]Window
+}
+
+// Session is one topic council defended in a council's sitting
+type Session struct {
+	TopicCouncilCode string
+	Start            time.Time
+	End              time.Time
+}
+
+// Sitting is a council's block in one room
+type Sitting struct {
+	CouncilCode string
+	Room        string
+	Start       time.Time
+	End         time.Time
+	Sessions    []Session
+}
+
+// Problem explains why a council or topic council could not be scheduled
+type Problem struct {
+	Code   string
+	Reason string
+}
+
+// Schedule is the generated timetable
+type Schedule struct {
+	Sittings []Sitting
+	// Unassigned topic councils have no council without a conflict of interest
+	Unassigned []Problem
+	// Unplaced councils found no free room and time for all their members
+	Unplaced []Problem
+}
+
+// Complete reports whether every council and topic council was scheduled
+func (s *Schedule) Complete() bool {
+	return len(s.Unassigned) == 0 && len(s.Unplaced) == 0
+}
+
+// Generate assigns topic councils to councils and places every council.
+//
+// A topic council may only go to a council of the same major (that of its
+// current council) on which none of its supervisors sits. The most
+// constrained topic councils are assigned first, each to the eligible council
+// with the lightest load, preferring the current one on ties, which balances
+// load per council.
+//
+// Councils are then placed, busiest first, at the earliest slot where a room
+// is free for the whole block and no member is unavailable or sitting on
+// another council at the same time.
+func Generate(in Input) (*Schedule, error) {
+	if in.SlotLength <= 0 {
+		return nil, fmt.Errorf("slot length must be positive")
+	}
+	if len(in.Rooms) == 0 {
+		return nil, fmt.Errorf("at least one room is required")
+	}
+	if len(in.Days) == 0 {
+		return nil, fmt.Errorf("at least one day is required")
+	}
+	for _, d := range in.Days {
+		if !d.End.After(d.Start) {
+			return nil, fmt.Errorf("day %s ends before it starts", d.Start.Format(time.RFC3339))
+		}
+	}
+
+	result := &Schedule{}
+	councils := make(map[string]Council, len(in.Councils))
+	members := make(map[string]map[string]bool, len(in.Councils))
+	for _, c := range in.Councils {
+		councils[c.Code] = c
+		members[c.Code] = map[string]bool{}
+		for _, m := range c.Members {
+			members[c.Code][m] = true
+		}
+	}
+
+	// Eligible councils of every topic council
+	eligible := make(map[string][]string, len(in.TopicCouncils))
+	for _, tc := range in.TopicCouncils {
+		current, ok := councils[tc.CouncilCode]
+		if !ok {
+			result.Unassigned = append(result.Unassigned, Problem{Code: tc.Code, Reason: "not assigned to a council of this semester"})
+			continue
+		}
+		for _, c := range in.Councils {
+			if c.MajorCode != current.MajorCode {
+				continue
+			}
+			conflict := false
+			for _, s := range tc.Supervisors {
+				if members[c.Code][s] {
+					conflict = true
+					break
+				}
+			}
+			if !conflict {
+				eligible[tc.Code] = append(eligible[tc.Code], c.Code)
+			}
+		}
+		if len(eligible[tc.Code]) == 0 {
+			result.Unassigned = append(result.Unassigned, Problem{Code: tc.Code, Reason: "a supervisor sits on every council of the major"})
+		}
+	}
+
+	order := make([]TopicCouncil, 0, len(in.TopicCouncils))
+	for _, tc := range in.TopicCouncils {
+		if len(eligible[tc.Code]) > 0 {
+			order = append(order, tc)
+		}
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		ea, eb := len(eligible[order[a].Code]), len(eligible[order[b].Code])
+		if ea != eb {
+			return ea < eb
+		}
+		return order[a].Code < order[b].Code
+	})
+
+	assigned := make(map[string][]string, len(in.Councils))
+	for _, tc := range order {
+		best := ""
+		for _, code := range eligible[tc.Code] {
+			switch {
+			case best == "":
+				best = code
+			case len(assigned[code]) < len(assigned[best]):
+				best = code
+			case len(assigned[code]) == len(assigned[best]) && code == tc.CouncilCode:
+				best = code
+			}
+		}
+		assigned[best] = append(assigned[best], tc.Code)
+	}
+
+	// Place councils, busiest first
+	placeOrder := make([]Council, 0, len(in.Councils))
+	for _, c := range in.Councils {
+		if len(assigned[c.Code]) > 0 {
+			sort.Strings(assigned[c.Code])
+			placeOrder = append(placeOrder, c)
+		}
+	}
+	sort.SliceStable(placeOrder, func(a, b int) bool {
+		la, lb := len(assigned[placeOrder[a].Code]), len(assigned[placeOrder[b].Code])
+		if la != lb {
+			return la > lb
+		}
+		return placeOrder[a].Code < placeOrder[b].Code
+	})
+
+	busyTeacher := map[string][]Window{}
+	for teacher, windows := range in.Unavailable {
+		busyTeacher[teacher] = append(busyTeacher[teacher], windows...)
+	}
+	busyRoom := map[string][]Window{}
+
+	for _, c := range placeOrder {
+		sessions := assigned[c.Code]
+		length := time.Duration(len(sessions)) * in.SlotLength
+
+		placed := false
+		for _, day := range in.Days {
+			for start := day.Start; !start.Add(length).After(day.End) && !placed; start = start.Add(in.SlotLength) {
+				block := Window{Start: start, End: start.Add(length)}
+				if !free(block, c.Members, busyTeacher) {
+					continue
+				}
+				for _, room := range in.Rooms {
+					if overlapsAny(block, busyRoom[room]) {
+						continue
+					}
+
+					sitting := Sitting{CouncilCode: c.Code, Room: room, Start: block.Start, End: block.End}
+					for i, code := range sessions {
+						s := block.Start.Add(time.Duration(i) * in.SlotLength)
+						sitting.Sessions = append(sitting.Sessions, Session{TopicCouncilCode: code, Start: s, End: s.Add(in.SlotLength)})
+					}
+					result.Sittings = append(result.Sittings, sitting)
+
+					busyRoom[room] = append(busyRoom[room], block)
+					for _, m := range c.Members {
+						busyTeacher[m] = append(busyTeacher[m], block)
+					}
+					placed = true
+					break
+				}
+			}
+			if placed {
+				break
+			}
+		}
+		if !placed {
+			result.Unplaced = append(result.Unplaced, Problem{
+				Code:   c.Code,
+				Reason: fmt.Sprintf("no room and time for %d defences with all members available", len(sessions)),
+			})
+		}
+	}
+
+	sort.SliceStable(result.Sittings, func(a, b int) bool {
+		if !result.Sittings[a].Start.Equal(result.Sittings[b].Start) {
+			return result.Sittings[a].Start.Before(result.Sittings[b].Start)
+		}
+		return result.Sittings[a].Room < result.Sittings[b].Room
+	})
+	return result, nil
+}
+
+// free reports whether none of the teachers is busy during the block
+func free(block Window, teachers []string, busy map[string][]Window) bool {
+	for _, t := range teachers {
+		if overlapsAny(block, busy[t]) {
+			return false
+		}
+	}
+	return true
+}
+
+func overlapsAny(w Window, windows []Window) bool {
+	for _, o := range windows {
+		if w.overlaps(o) {
+			return true
+		}
+	}
+	return false
+}
+
+// CSV exports the timetable with one row per defence
+func (s *Schedule) CSV() (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"council", "room", "topic_council", "start", "end"}); err != nil {
+		return "", err
+	}
+	for _, sitting := range s.Sittings {
+		for _, session := range sitting.Sessions {
+			err := w.Write([]string{
+				sitting.CouncilCode,
+				sitting.Room,
+				session.TopicCouncilCode,
+				session.Start.Format(time.RFC3339),
+				session.End.Format(time.RFC3339),
+			})
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+	w.Flush()
+	return buf.String(), w.Error()
+}
@@ -0,0 +1,206 @@
+package schedule
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+var day1 = time.Date(2026, 6, 1, 8, 0, 0, 0, time.UTC)
+
+func day(offset int, from, to int) Window {
+	start := day1.AddDate(0, 0, offset)
+	return Window{Start: start.Add(time.Duration(from) * time.Hour), End: start.Add(time.Duration(to) * time.Hour)}
+}
+
+func topicCouncils(councilCode string, n int, supervisors ...string) []TopicCouncil {
+	tcs := make([]TopicCouncil, n)
+	for i := range tcs {
+		tcs[i] = TopicCouncil{Code: fmt.Sprintf("%s-TC%d", councilCode, i), CouncilCode: councilCode, Supervisors: supervisors}
+	}
+	return tcs
+}
+
+func TestGenerate(t *testing.T) {
+	cases := []struct {
+		name           string
+		in             Input
+		wantUnassigned int
+		wantUnplaced   int
+	}{
+		{
+			name: "councils sharing a member sit at different times",
+			in: Input{
+				Councils: []Council{
+					{Code: "C1", MajorCode: "M1", Members: []string{"A", "B"}},
+					{Code: "C2", MajorCode: "M2", Members: []string{"B", "C"}},
+				},
+				TopicCouncils: append(topicCouncils("C1", 2), topicCouncils("C2", 2)...),
+				Rooms:         []string{"R1", "R2"},
+				Days:          []Window{day(0, 0, 4)},
+				SlotLength:    time.Hour,
+			},
+		},
+		{
+			name: "councils share one room in turn",
+			in: Input{
+				Councils: []Council{
+					{Code: "C1", MajorCode: "M1", Members: []string{"A"}},
+					{Code: "C2", MajorCode: "M1", Members: []string{"B"}},
+					{Code: "C3", MajorCode: "M1", Members: []string{"C"}},
+				},
+				TopicCouncils: append(append(topicCouncils("C1", 1, "X"), topicCouncils("C2", 1, "Y")...), topicCouncils("C3", 1, "Z")...),
+				Rooms:         []string{"R1"},
+				Days:          []Window{day(0, 0, 3)},
+				SlotLength:    time.Hour,
+			},
+		},
+		{
+			name: "unavailable teacher pushes the council to the next day",
+			in: Input{
+				Councils:      []Council{{Code: "C1", MajorCode: "M1", Members: []string{"A", "B"}}},
+				TopicCouncils: topicCouncils("C1", 2),
+				Rooms:         []string{"R1"},
+				Days:          []Window{day(0, 0, 2), day(1, 0, 2)},
+				SlotLength:    time.Hour,
+				Unavailable:   map[string][]Window{"B": {day(0, 1, 2)}},
+			},
+		},
+		{
+			name: "supervisor on every council of the major leaves the topic council unassigned",
+			in: Input{
+				Councils:      []Council{{Code: "C1", MajorCode: "M1", Members: []string{"A"}}},
+				TopicCouncils: topicCouncils("C1", 1, "A"),
+				Rooms:         []string{"R1"},
+				Days:          []Window{day(0, 0, 1)},
+				SlotLength:    time.Hour,
+			},
+			wantUnassigned: 1,
+		},
+		{
+			name: "council that does not fit in a day is unplaced",
+			in: Input{
+				Councils:      []Council{{Code: "C1", MajorCode: "M1", Members: []string{"A"}}},
+				TopicCouncils: topicCouncils("C1", 3),
+				Rooms:         []string{"R1"},
+				Days:          []Window{day(0, 0, 2)},
+				SlotLength:    time.Hour,
+			},
+			wantUnplaced: 1,
+		},
+		{
+			name: "busy rooms and members across many councils",
+			in: Input{
+				Councils: []Council{
+					{Code: "C1", MajorCode: "M1", Members: []string{"A", "B", "C"}},
+					{Code: "C2", MajorCode: "M1", Members: []string{"C", "D", "E"}},
+					{Code: "C3", MajorCode: "M1", Members: []string{"E", "F", "A"}},
+					{Code: "C4", MajorCode: "M1", Members: []string{"G", "H", "I"}},
+				},
+				TopicCouncils: append(append(append(topicCouncils("C1", 3, "D"), topicCouncils("C2", 2, "B")...), topicCouncils("C3", 2)...), topicCouncils("C4", 4, "A")...),
+				Rooms:         []string{"R1", "R2"},
+				Days:          []Window{day(0, 0, 5), day(1, 0, 5)},
+				SlotLength:    30 * time.Minute,
+				Unavailable:   map[string][]Window{"C": {day(0, 0, 1)}, "G": {day(0, 2, 3)}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := Generate(tc.in)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+			if len(s.Unassigned) != tc.wantUnassigned {
+				t.Errorf("Unassigned = %+v, want %d", s.Unassigned, tc.wantUnassigned)
+			}
+			if len(s.Unplaced) != tc.wantUnplaced {
+				t.Errorf("Unplaced = %+v, want %d", s.Unplaced, tc.wantUnplaced)
+			}
+			checkSchedule(t, tc.in, s)
+		})
+	}
+}
+
+// checkSchedule verifies the constraints every generated timetable keeps
+func checkSchedule(t *testing.T, in Input, s *Schedule) {
+	t.Helper()
+
+	councils := map[string]Council{}
+	for _, c := range in.Councils {
+		councils[c.Code] = c
+	}
+	supervisors := map[string][]string{}
+	for _, tc := range in.TopicCouncils {
+		supervisors[tc.Code] = tc.Supervisors
+	}
+
+	for i, a := range s.Sittings {
+		block := Window{Start: a.Start, End: a.End}
+		inDay := false
+		for _, d := range in.Days {
+			if !a.Start.Before(d.Start) && !a.End.After(d.End) {
+				inDay = true
+			}
+		}
+		if !inDay {
+			t.Errorf("sitting of %s at %s is outside every day", a.CouncilCode, a.Start)
+		}
+
+		members := councils[a.CouncilCode].Members
+		for _, m := range members {
+			if overlapsAny(block, in.Unavailable[m]) {
+				t.Errorf("%s sits on %s at %s while unavailable", m, a.CouncilCode, a.Start)
+			}
+		}
+		for _, session := range a.Sessions {
+			for _, sup := range supervisors[session.TopicCouncilCode] {
+				for _, m := range members {
+					if sup == m {
+						t.Errorf("supervisor %s judges %s on %s", sup, session.TopicCouncilCode, a.CouncilCode)
+					}
+				}
+			}
+		}
+
+		for _, b := range s.Sittings[i+1:] {
+			if !block.overlaps(Window{Start: b.Start, End: b.End}) {
+				continue
+			}
+			if a.Room == b.Room {
+				t.Errorf("%s and %s overlap in room %s", a.CouncilCode, b.CouncilCode, a.Room)
+			}
+			for _, m := range members {
+				for _, n := range councils[b.CouncilCode].Members {
+					if m == n {
+						t.Errorf("%s sits on %s and %s at the same time", m, a.CouncilCode, b.CouncilCode)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestGenerateRejectsInvalidInput(t *testing.T) {
+	valid := Input{Rooms: []string{"R1"}, Days: []Window{day(0, 0, 1)}, SlotLength: time.Hour}
+
+	cases := []struct {
+		name   string
+		mutate func(in *Input)
+	}{
+		{"zero slot length", func(in *Input) { in.SlotLength = 0 }},
+		{"no room", func(in *Input) { in.Rooms = nil }},
+		{"no day", func(in *Input) { in.Days = nil }},
+		{"day ending before it starts", func(in *Input) { in.Days = []Window{{Start: day1, End: day1}} }},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := valid
+			tc.mutate(&in)
+			if _, err := Generate(in); err == nil {
+				t.Fatal("Generate() error = nil, want an error")
+			}
+		})
+	}
+}
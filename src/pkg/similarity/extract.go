@@ -0,0 +1,112 @@
+package similarity
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/ledongthuc/pdf"
+)
+
+// MaxTextBytes caps the extracted text of one document. A thesis is far
+// below this; the cap only guards against pathological files.
+const MaxTextBytes = 2 << 20
+
+// ExtractText returns the plain text of a PDF or DOCX document
+func ExtractText(r io.ReaderAt, size int64, filename string) (string, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".pdf":
+		return extractPDF(r, size)
+	case ".docx":
+		return extractDOCX(r, size)
+	default:
+		return "", fmt.Errorf("text extraction is not supported for %s", filename)
+	}
+}
+
+// extractPDF reads the text layer of every page. Scanned PDFs without a text
+// layer yield an empty string.
+func extractPDF(r io.ReaderAt, size int64) (text string, err error) {
+	// The PDF parser panics on some malformed documents
+	defer func() {
+		if p := recover(); p != nil {
+			text, err = "", fmt.Errorf("failed to parse PDF: %v", p)
+		}
+	}()
+
+	doc, err := pdf.NewReader(r, size)
+	if err != nil {
+		return "", fmt.Errorf("failed to open PDF: %w", err)
+	}
+	plain, err := doc.GetPlainText()
+	if err != nil {
+		return "", fmt.Errorf("failed to read PDF text: %w", err)
+	}
+	b, err := io.ReadAll(io.LimitReader(plain, MaxTextBytes))
+	if err != nil {
+		return "", fmt.Errorf("failed to read PDF text: %w", err)
+	}
+	return string(b), nil
+}
+
+// extractDOCX collects the <w:t> runs of word/document.xml, one line per paragraph
+func extractDOCX(r io.ReaderAt, size int64) (string, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return "", fmt.Errorf("failed to open DOCX: %w", err)
+	}
+
+	var body *zip.File
+	for _, f := range zr.File {
+		if f.Name == "word/document.xml" {
+			body = f
+			break
+		}
+	}
+	if body == nil {
+		return "", fmt.Errorf("DOCX has no word/document.xml")
+	}
+
+	rc, err := body.Open()
+	if err != nil {
+		return "", fmt.Errorf("failed to read DOCX: %w", err)
+	}
+	defer rc.Close()
+
+	var sb strings.Builder
+	decoder := xml.NewDecoder(io.LimitReader(rc, 8*MaxTextBytes))
+	inText := false
+	for sb.Len() < MaxTextBytes {
+		tok, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to parse DOCX: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "t":
+				inText = true
+			case "tab", "br":
+				sb.WriteByte(' ')
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				sb.WriteByte('\n')
+			}
+		case xml.CharData:
+			if inText {
+				sb.Write(t)
+			}
+		}
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,207 @@
+package similarity
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// ShingleSize is the number of consecutive words in a shingle
+	ShingleSize = 5
+
+	// SignatureSize is the number of MinHash slots in a signature
+	SignatureSize = 128
+
+	// MinPassageWords is the shortest run of shared words reported as a passage
+	MinPassageWords = 8
+
+	// maxPassageChars truncates long passages in reports
+	maxPassageChars = 600
+)
+
+// Words splits text into words. Punctuation and layout are dropped so the
+// same prose extracted from different file formats compares equal.
+func Words(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// Shingles hashes every window of ShingleSize words (case-insensitive).
+// A document shorter than one window is a single shingle.
+func Shingles(words []string) []uint64 {
+	if len(words) == 0 {
+		return nil
+	}
+	n := len(words) - ShingleSize + 1
+	if n < 1 {
+		n = 1
+	}
+
+	shingles := make([]uint64, n)
+	h := fnv.New64a()
+	for i := 0; i < n; i++ {
+		h.Reset()
+		end := i + ShingleSize
+		if end > len(words) {
+			end = len(words)
+		}
+		for j := i; j < end; j++ {
+			h.Write([]byte(strings.ToLower(words[j])))
+			h.Write([]byte{' '})
+		}
+		shingles[i] = h.Sum64()
+	}
+	return shingles
+}
+
+// mix is the splitmix64 finalizer; with a per-slot seed it gives the
+// independent hash functions MinHash needs
+func mix(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
+
+// Signature is a MinHash sketch of a document's shingle set
+type Signature [SignatureSize]uint64
+
+// NewSignature computes the MinHash signature of a shingle set
+func NewSignature(shingles []uint64) Signature {
+	var sig, seeds Signature
+	for i := range sig {
+		sig[i] = ^uint64(0)
+		seeds[i] = mix(uint64(i))
+	}
+	for _, s := range shingles {
+		for i := range sig {
+			if v := mix(s ^ seeds[i]); v < sig[i] {
+				sig[i] = v
+			}
+		}
+	}
+	return sig
+}
+
+// Jaccard estimates the Jaccard similarity of the two shingle sets
+func (s Signature) Jaccard(other Signature) float64 {
+	same := 0
+	for i := range s {
+		if s[i] == other[i] {
+			same++
+		}
+	}
+	return float64(same) / SignatureSize
+}
+
+// Bytes encodes the signature for storage
+func (s Signature) Bytes() []byte {
+	b := make([]byte, SignatureSize*8)
+	for i, v := range s {
+		binary.LittleEndian.PutUint64(b[i*8:], v)
+	}
+	return b
+}
+
+// ParseSignature decodes a stored signature
+func ParseSignature(b []byte) (Signature, error) {
+	var sig Signature
+	if len(b) != SignatureSize*8 {
+		return sig, fmt.Errorf("invalid signature length %d", len(b))
+	}
+	for i := range sig {
+		sig[i] = binary.LittleEndian.Uint64(b[i*8:])
+	}
+	return sig, nil
+}
+
+// Passage is a run of words shared by two documents
+type Passage struct {
+	Text         string
+	Offset       int // word offset in the checked document
+	SourceOffset int // word offset in the earlier document
+	Words        int
+}
+
+// Overlap compares a document against an earlier one. It returns the share
+// of the document's words covered by shared shingles (0-100) and the longest
+// shared passages, longest first.
+func Overlap(words, sourceWords []string, maxPassages int) (float64, []Passage) {
+	if len(words) == 0 {
+		return 0, nil
+	}
+
+	shingles := Shingles(words)
+	sourcePos := make(map[uint64]int)
+	for i, s := range Shingles(sourceWords) {
+		if _, seen := sourcePos[s]; !seen {
+			sourcePos[s] = i
+		}
+	}
+
+	covered := make([]bool, len(words))
+	var passages []Passage
+	for i := 0; i < len(shingles); {
+		src, ok := sourcePos[shingles[i]]
+		if !ok {
+			i++
+			continue
+		}
+
+		// Extend the run while the following shingles match too
+		start := i
+		for i < len(shingles) {
+			if _, ok := sourcePos[shingles[i]]; !ok {
+				break
+			}
+			i++
+		}
+		end := i - 1 + ShingleSize
+		if end > len(words) {
+			end = len(words)
+		}
+		for j := start; j < end; j++ {
+			covered[j] = true
+		}
+		if end-start >= MinPassageWords {
+			passages = append(passages, Passage{
+				Text:         truncate(strings.Join(words[start:end], " "), maxPassageChars),
+				Offset:       start,
+				SourceOffset: src,
+				Words:        end - start,
+			})
+		}
+	}
+
+	count := 0
+	for _, c := range covered {
+		if c {
+			count++
+		}
+	}
+
+	sort.SliceStable(passages, func(i, j int) bool {
+		return passages[i].Words > passages[j].Words
+	})
+	if maxPassages > 0 && len(passages) > maxPassages {
+		passages = passages[:maxPassages]
+	}
+	return float64(count) * 100 / float64(len(words)), passages
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	// Cut on a rune boundary
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n] + "…"
+}
@@ -2,6 +2,7 @@ package router
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"thaily/src/api"
@@ -11,7 +12,9 @@ import (
 	"thaily/src/graph/generated"
 	"thaily/src/graph/helper"
 	"thaily/src/graph/resolver"
+	"thaily/src/pkg/audit"
 	"thaily/src/pkg/container"
+	"thaily/src/server/client"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
@@ -20,6 +23,7 @@ import (
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/vektah/gqlparser/v2/ast"
 )
 
@@ -32,6 +36,9 @@ func Setup(cfg *config.Config, c *container.Container) *gin.Engine {
 	// Setup CORS
 	setupCORS(r)
 
+	// Name the caller of every request for the services' audit log
+	r.Use(auditCaller(cfg.JWT))
+
 	// Setup GraphQL
 	setupGraphQL(r, c)
 
@@ -45,8 +52,8 @@ func setupCORS(r *gin.Engine) {
 	r.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"*"},
 		AllowMethods:     []string{"POST", "GET", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization", api.PartChecksumHeader},
+		ExposeHeaders:    []string{"Content-Length", "X-Request-ID"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
@@ -61,6 +68,7 @@ func setupGraphQL(r *gin.Engine, c *container.Container) {
 		c.Clients.Role,
 		c.Clients.Thesis,
 		c.Clients.User,
+		audit.NewStore(c.Clients.MongoDB.GetDatabase()),
 	)
 
 	// Create GraphQL handler
@@ -96,14 +104,60 @@ func setupRestAPI(r *gin.Engine, c *container.Container) {
 		api.WithUserClient(c.Clients.User),
 		api.WithFileClient(c.Clients.File),
 		api.WithAcademicClient(c.Clients.Academic),
+		api.WithThesisClient(c.Clients.Thesis),
 		api.WithRedisClient(c.Clients.Redis),
 		api.WithMongoClient(c.Clients.MongoDB),
 		api.WithMimIo(c.Clients.MinIO),
+		api.WithScanner(c.Clients.Scanner),
 	)
 
 	// Register routes
 	apiV1 := r.Group("/api/v1")
 	apiHandler.RegisterRoutes(apiV1)
+
+	// Purge files soft-deleted longer than the retention period
+	go apiHandler.RunFileRetention(context.Background())
+
+	// Abort MinIO multipart uploads whose resumable session expired
+	go apiHandler.RunUploadSweep(context.Background())
+
+	// Drop cached reads as the services' domain events arrive
+	go client.RunCacheInvalidation(context.Background(), c.Clients.Redis.GetClient())
+}
+
+// auditCaller puts who makes the request in its context, which the gRPC
+// clients forward to the services' audit log. The token is only read to name
+// the actor; authorization stays with the resolvers and the REST middleware.
+func auditCaller(cfg config.JWTConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		caller := audit.Caller{
+			Semester:  c.GetHeader("x-semester"),
+			RequestID: c.GetHeader("X-Request-ID"),
+			ClientIP:  c.ClientIP(),
+		}
+		if caller.RequestID == "" {
+			caller.RequestID = uuid.New().String()
+		}
+		if claims, err := helper.ValidateAndParseClaims(c.GetHeader("Authorization"), cfg.AccessSecret); err == nil {
+			caller.Role, _ = claims["role"].(string)
+			// The actor is the user's id in the semester, as in created_by
+			ids, _ := claims["ids"].(string)
+			for _, id := range strings.Split(ids, ",") {
+				parts := strings.Split(id, "-")
+				if len(parts) != 2 {
+					continue
+				}
+				if caller.Semester == "" || parts[0] == caller.Semester {
+					caller.Actor = parts[1]
+					break
+				}
+			}
+		}
+
+		c.Header("X-Request-ID", caller.RequestID)
+		c.Request = c.Request.WithContext(audit.WithCaller(c.Request.Context(), caller))
+		c.Next()
+	}
 }
 
 // dataloaderMiddleware injects dataloaders into the context
@@ -8,6 +8,7 @@ import (
 
 	pbCommon "thaily/proto/common"
 	pb "thaily/proto/academic"
+	"thaily/src/pkg/audit"
 	"thaily/src/pkg/tls"
 
 	"github.com/redis/go-redis/v9"
@@ -37,7 +38,11 @@ func NewGRPCAcadamicClient(addr string, redisClient *redis.Client) (*GRPCAcadami
 		return nil, fmt.Errorf("failed to load TLS credentials: %v", err)
 	}
 
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(creds),
+		// Forward the caller for the services' audit log
+		grpc.WithUnaryInterceptor(audit.UnaryClientInterceptor()),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -106,8 +111,22 @@ func (g *GRPCAcadamicClient) UpdateMajor(ctx context.Context, req *pb.UpdateMajo
 	return resp, nil
 }
 
-func (g *GRPCAcadamicClient) DeleteMajor(ctx context.Context, id string) (*pb.DeleteMajorResponse, error) {
-	resp, err := g.client.DeleteMajor(ctx, &pb.DeleteMajorRequest{Id: id})
+func (g *GRPCAcadamicClient) DeleteMajor(ctx context.Context, id, deletedBy string) (*pb.DeleteMajorResponse, error) {
+	resp, err := g.client.DeleteMajor(ctx, &pb.DeleteMajorRequest{Id: id, DeletedBy: deletedBy})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	cacheKey := fmt.Sprintf("%s%s", majorCachePrefix, id)
+	InvalidateCacheByKey(ctx, g.redisClient, cacheKey)
+	InvalidateCacheByPattern(ctx, g.redisClient, majorCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (g *GRPCAcadamicClient) RestoreMajor(ctx context.Context, id, restoredBy string) (*pb.RestoreMajorResponse, error) {
+	resp, err := g.client.RestoreMajor(ctx, &pb.RestoreMajorRequest{Id: id, RestoredBy: restoredBy})
 	if err != nil {
 		return nil, err
 	}
@@ -247,8 +266,22 @@ func (g *GRPCAcadamicClient) UpdateSemester(ctx context.Context, req *pb.UpdateS
 	return resp, nil
 }
 
-func (g *GRPCAcadamicClient) DeleteSemester(ctx context.Context, id string) (*pb.DeleteSemesterResponse, error) {
-	resp, err := g.client.DeleteSemester(ctx, &pb.DeleteSemesterRequest{Id: id})
+func (g *GRPCAcadamicClient) DeleteSemester(ctx context.Context, id, deletedBy string) (*pb.DeleteSemesterResponse, error) {
+	resp, err := g.client.DeleteSemester(ctx, &pb.DeleteSemesterRequest{Id: id, DeletedBy: deletedBy})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	cacheKey := fmt.Sprintf("%s%s", semesterCachePrefix, id)
+	InvalidateCacheByKey(ctx, g.redisClient, cacheKey)
+	InvalidateCacheByPattern(ctx, g.redisClient, semesterCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (g *GRPCAcadamicClient) RestoreSemester(ctx context.Context, id, restoredBy string) (*pb.RestoreSemesterResponse, error) {
+	resp, err := g.client.RestoreSemester(ctx, &pb.RestoreSemesterRequest{Id: id, RestoredBy: restoredBy})
 	if err != nil {
 		return nil, err
 	}
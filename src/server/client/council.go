@@ -8,6 +8,7 @@ import (
 
 	pbCommon "thaily/proto/common"
 	pb "thaily/proto/council"
+	"thaily/src/pkg/audit"
 	"thaily/src/pkg/tls"
 
 	"github.com/redis/go-redis/v9"
@@ -26,12 +27,15 @@ const (
 	defenceCacheTTL      = 5 * time.Minute
 	scheduleCacheTTL     = 5 * time.Minute
 	gradeDefenceCacheTTL = 10 * time.Minute
+	rubricCacheTTL       = 30 * time.Minute
 
 	// Cache key prefixes
 	councilCachePrefix      = "council:council:"
 	defenceCachePrefix      = "council:defence:"
 	scheduleCachePrefix     = "council:schedule:"
 	gradeDefenceCachePrefix = "council:grade_defence:"
+	criterionCachePrefix    = "council:grade_defence_criterion:"
+	rubricCachePrefix       = "council:rubric:"
 )
 
 func NewGRPCCouncil(addr string, redisClient *redis.Client) (*GRPCCouncil, error) {
@@ -41,7 +45,11 @@ func NewGRPCCouncil(addr string, redisClient *redis.Client) (*GRPCCouncil, error
 		return nil, fmt.Errorf("failed to load TLS credentials: %v", err)
 	}
 
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(creds),
+		// Forward the caller for the services' audit log
+		grpc.WithUnaryInterceptor(audit.UnaryClientInterceptor()),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -110,8 +118,37 @@ func (c *GRPCCouncil) UpdateCouncil(ctx context.Context, req *pb.UpdateCouncilRe
 	return resp, nil
 }
 
-func (c *GRPCCouncil) DeleteCouncil(ctx context.Context, id string) (*pb.DeleteCouncilResponse, error) {
-	resp, err := c.client.DeleteCouncil(ctx, &pb.DeleteCouncilRequest{Id: id})
+func (c *GRPCCouncil) ScheduleCouncils(ctx context.Context, req *pb.ScheduleCouncilsRequest) (*pb.ScheduleCouncilsResponse, error) {
+	resp, err := c.client.ScheduleCouncils(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	for _, slot := range req.Councils {
+		InvalidateCacheByKey(ctx, c.redisClient, councilCachePrefix+slot.Id)
+	}
+	InvalidateCacheByPattern(ctx, c.redisClient, councilCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (c *GRPCCouncil) DeleteCouncil(ctx context.Context, id, deletedBy string) (*pb.DeleteCouncilResponse, error) {
+	resp, err := c.client.DeleteCouncil(ctx, &pb.DeleteCouncilRequest{Id: id, DeletedBy: deletedBy})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	cacheKey := fmt.Sprintf("%s%s", councilCachePrefix, id)
+	InvalidateCacheByKey(ctx, c.redisClient, cacheKey)
+	InvalidateCacheByPattern(ctx, c.redisClient, councilCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (c *GRPCCouncil) RestoreCouncil(ctx context.Context, id, restoredBy string) (*pb.RestoreCouncilResponse, error) {
+	resp, err := c.client.RestoreCouncil(ctx, &pb.RestoreCouncilRequest{Id: id, RestoredBy: restoredBy})
 	if err != nil {
 		return nil, err
 	}
@@ -195,10 +232,31 @@ func (c *GRPCCouncil) GetCouncilsByIds(ctx context.Context, ids []string) (*pb.L
 	return result, nil
 }
 
+// ValidateCouncil is not cached: conflicts depend on data the caller passes in
+func (c *GRPCCouncil) ValidateCouncil(ctx context.Context, req *pb.ValidateCouncilRequest) (*pb.ValidateCouncilResponse, error) {
+	return c.client.ValidateCouncil(ctx, req)
+}
+
+func (c *GRPCCouncil) ListCouncilConflictOverrides(ctx context.Context, councilCode string) (*pb.ListCouncilConflictOverridesResponse, error) {
+	return c.client.ListCouncilConflictOverrides(ctx, &pb.ListCouncilConflictOverridesRequest{CouncilCode: councilCode})
+}
+
 // ============================================
 // DEFENCE METHODS
 // ============================================
 
+func (c *GRPCCouncil) CreateDefence(ctx context.Context, req *pb.CreateDefenceRequest) (*pb.CreateDefenceResponse, error) {
+	resp, err := c.client.CreateDefence(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	InvalidateCacheByPattern(ctx, c.redisClient, defenceCachePrefix+"*")
+
+	return resp, nil
+}
+
 func (c *GRPCCouncil) GetDefencesBySearch(ctx context.Context, search *pbCommon.SearchRequest) (*pb.ListDefencesResponse, error) {
 	cacheKey := GenerateCacheKey(defenceCachePrefix, search)
 	var cached pb.ListDefencesResponse
@@ -251,8 +309,22 @@ func (c *GRPCCouncil) UpdateDefence(ctx context.Context, req *pb.UpdateDefenceRe
 	return resp, nil
 }
 
-func (c *GRPCCouncil) DeleteDefence(ctx context.Context, id string) (*pb.DeleteDefenceResponse, error) {
-	resp, err := c.client.DeleteDefence(ctx, &pb.DeleteDefenceRequest{Id: id})
+func (c *GRPCCouncil) DeleteDefence(ctx context.Context, id, deletedBy string) (*pb.DeleteDefenceResponse, error) {
+	resp, err := c.client.DeleteDefence(ctx, &pb.DeleteDefenceRequest{Id: id, DeletedBy: deletedBy})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	cacheKey := fmt.Sprintf("%s%s", defenceCachePrefix, id)
+	InvalidateCacheByKey(ctx, c.redisClient, cacheKey)
+	InvalidateCacheByPattern(ctx, c.redisClient, defenceCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (c *GRPCCouncil) RestoreDefence(ctx context.Context, id, restoredBy string) (*pb.RestoreDefenceResponse, error) {
+	resp, err := c.client.RestoreDefence(ctx, &pb.RestoreDefenceRequest{Id: id, RestoredBy: restoredBy})
 	if err != nil {
 		return nil, err
 	}
@@ -351,7 +423,7 @@ func (c *GRPCCouncil) GetDefencesByCouncilCode(ctx context.Context, councilCode
 				Descending: true,
 				Page:       1,
 				PageSize:   100,
-				SortBy:     "created_by",
+				SortBy:     "created_at",
 			},
 			Filters: []*pbCommon.FilterCriteria{
 				{
@@ -379,6 +451,18 @@ func (c *GRPCCouncil) GetDefencesByCouncilCode(ctx context.Context, councilCode
 // GRADE DEFENCE METHODS
 // ============================================
 
+func (c *GRPCCouncil) CreateGradeDefence(ctx context.Context, req *pb.CreateGradeDefenceRequest) (*pb.CreateGradeDefenceResponse, error) {
+	resp, err := c.client.CreateGradeDefence(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	InvalidateCacheByPattern(ctx, c.redisClient, gradeDefenceCachePrefix+"*")
+
+	return resp, nil
+}
+
 func (c *GRPCCouncil) GetGradeDefenceBySearch(ctx context.Context, search *pbCommon.SearchRequest) (*pb.ListGradeDefencesResponse, error) {
 	cacheKey := GenerateCacheKey(gradeDefenceCachePrefix, search)
 	var cached pb.ListGradeDefencesResponse
@@ -431,8 +515,22 @@ func (c *GRPCCouncil) UpdateGradeDefence(ctx context.Context, req *pb.UpdateGrad
 	return resp, nil
 }
 
-func (c *GRPCCouncil) DeleteGradeDefence(ctx context.Context, id string) (*pb.DeleteGradeDefenceResponse, error) {
-	resp, err := c.client.DeleteGradeDefence(ctx, &pb.DeleteGradeDefenceRequest{Id: id})
+func (c *GRPCCouncil) DeleteGradeDefence(ctx context.Context, id, deletedBy string) (*pb.DeleteGradeDefenceResponse, error) {
+	resp, err := c.client.DeleteGradeDefence(ctx, &pb.DeleteGradeDefenceRequest{Id: id, DeletedBy: deletedBy})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	cacheKey := fmt.Sprintf("%s%s", gradeDefenceCachePrefix, id)
+	InvalidateCacheByKey(ctx, c.redisClient, cacheKey)
+	InvalidateCacheByPattern(ctx, c.redisClient, gradeDefenceCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (c *GRPCCouncil) RestoreGradeDefence(ctx context.Context, id, restoredBy string) (*pb.RestoreGradeDefenceResponse, error) {
+	resp, err := c.client.RestoreGradeDefence(ctx, &pb.RestoreGradeDefenceRequest{Id: id, RestoredBy: restoredBy})
 	if err != nil {
 		return nil, err
 	}
@@ -515,3 +613,191 @@ func (c *GRPCCouncil) GetGradeDefencesByIds(ctx context.Context, ids []string) (
 
 	return result, nil
 }
+
+// ============================================
+// GRADE DEFENCE CRITERION METHODS
+// ============================================
+// Every criterion write recomputes the grade defence's total_score, so it
+// also invalidates the grade defence cache.
+
+func (c *GRPCCouncil) CreateGradeDefenceCriterion(ctx context.Context, req *pb.CreateGradeDefenceCriterionRequest) (*pb.CreateGradeDefenceCriterionResponse, error) {
+	resp, err := c.client.CreateGradeDefenceCriterion(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	InvalidateCacheByPattern(ctx, c.redisClient, criterionCachePrefix+"*")
+	InvalidateCacheByPattern(ctx, c.redisClient, gradeDefenceCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (c *GRPCCouncil) GetGradeDefenceCriterionById(ctx context.Context, id string) (*pb.GetGradeDefenceCriterionResponse, error) {
+	cacheKey := fmt.Sprintf("%s%s", criterionCachePrefix, id)
+	var cached pb.GetGradeDefenceCriterionResponse
+	if hit, _ := GetCachedProto(ctx, c.redisClient, cacheKey, &cached); hit {
+		return &cached, nil
+	}
+
+	resp, err := c.client.GetGradeDefenceCriterion(ctx, &pb.GetGradeDefenceCriterionRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	SetCachedProto(ctx, c.redisClient, cacheKey, resp, gradeDefenceCacheTTL)
+	return resp, nil
+}
+
+func (c *GRPCCouncil) GetGradeDefenceCriteriaBySearch(ctx context.Context, search *pbCommon.SearchRequest) (*pb.ListGradeDefenceCriteriaResponse, error) {
+	cacheKey := GenerateCacheKey(criterionCachePrefix, search)
+	var cached pb.ListGradeDefenceCriteriaResponse
+	if hit, _ := GetCachedProto(ctx, c.redisClient, cacheKey, &cached); hit {
+		return &cached, nil
+	}
+
+	resp, err := c.client.ListGradeDefenceCriteria(ctx, &pb.ListGradeDefenceCriteriaRequest{Search: search})
+	if err != nil {
+		return nil, err
+	}
+
+	SetCachedProto(ctx, c.redisClient, cacheKey, resp, gradeDefenceCacheTTL)
+	return resp, nil
+}
+
+func (c *GRPCCouncil) UpdateGradeDefenceCriterion(ctx context.Context, req *pb.UpdateGradeDefenceCriterionRequest) (*pb.UpdateGradeDefenceCriterionResponse, error) {
+	resp, err := c.client.UpdateGradeDefenceCriterion(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	InvalidateCacheByPattern(ctx, c.redisClient, criterionCachePrefix+"*")
+	InvalidateCacheByPattern(ctx, c.redisClient, gradeDefenceCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (c *GRPCCouncil) DeleteGradeDefenceCriterion(ctx context.Context, id string) (*pb.DeleteGradeDefenceCriterionResponse, error) {
+	resp, err := c.client.DeleteGradeDefenceCriterion(ctx, &pb.DeleteGradeDefenceCriterionRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	InvalidateCacheByPattern(ctx, c.redisClient, criterionCachePrefix+"*")
+	InvalidateCacheByPattern(ctx, c.redisClient, gradeDefenceCachePrefix+"*")
+
+	return resp, nil
+}
+
+// ============================================
+// RUBRIC TEMPLATE METHODS
+// ============================================
+
+func (c *GRPCCouncil) CreateRubricTemplate(ctx context.Context, req *pb.CreateRubricTemplateRequest) (*pb.CreateRubricTemplateResponse, error) {
+	resp, err := c.client.CreateRubricTemplate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	InvalidateCacheByPattern(ctx, c.redisClient, rubricCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (c *GRPCCouncil) ListRubricTemplates(ctx context.Context, req *pb.ListRubricTemplatesRequest) (*pb.ListRubricTemplatesResponse, error) {
+	cacheKey := GenerateCacheKey(rubricCachePrefix, req)
+	var cached pb.ListRubricTemplatesResponse
+	if hit, _ := GetCachedProto(ctx, c.redisClient, cacheKey, &cached); hit {
+		return &cached, nil
+	}
+
+	resp, err := c.client.ListRubricTemplates(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	SetCachedProto(ctx, c.redisClient, cacheKey, resp, rubricCacheTTL)
+	return resp, nil
+}
+
+func (c *GRPCCouncil) UpdateRubricTemplate(ctx context.Context, req *pb.UpdateRubricTemplateRequest) (*pb.UpdateRubricTemplateResponse, error) {
+	resp, err := c.client.UpdateRubricTemplate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	InvalidateCacheByPattern(ctx, c.redisClient, rubricCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (c *GRPCCouncil) DeleteRubricTemplate(ctx context.Context, id string) (*pb.DeleteRubricTemplateResponse, error) {
+	resp, err := c.client.DeleteRubricTemplate(ctx, &pb.DeleteRubricTemplateRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	InvalidateCacheByPattern(ctx, c.redisClient, rubricCachePrefix+"*")
+
+	return resp, nil
+}
+
+// ============================================
+// GRADE LOCK & AMENDMENT METHODS
+// ============================================
+
+func (c *GRPCCouncil) LockCouncilGrades(ctx context.Context, councilCode, actor string) (*pb.LockCouncilGradesResponse, error) {
+	resp, err := c.client.LockCouncilGrades(ctx, &pb.LockCouncilGradesRequest{CouncilCode: councilCode, Actor: actor})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	InvalidateCacheByPattern(ctx, c.redisClient, councilCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (c *GRPCCouncil) PublishCouncilGrades(ctx context.Context, councilCode, actor string) (*pb.PublishCouncilGradesResponse, error) {
+	resp, err := c.client.PublishCouncilGrades(ctx, &pb.PublishCouncilGradesRequest{CouncilCode: councilCode, Actor: actor})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	InvalidateCacheByPattern(ctx, c.redisClient, councilCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (c *GRPCCouncil) RequestGradeDefenceAmendment(ctx context.Context, req *pb.RequestGradeDefenceAmendmentRequest) (*pb.RequestGradeDefenceAmendmentResponse, error) {
+	return c.client.RequestGradeDefenceAmendment(ctx, req)
+}
+
+func (c *GRPCCouncil) DecideGradeDefenceAmendment(ctx context.Context, req *pb.DecideGradeDefenceAmendmentRequest) (*pb.DecideGradeDefenceAmendmentResponse, error) {
+	resp, err := c.client.DecideGradeDefenceAmendment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// An approved amendment changes a criterion's score and its grade defence's total
+	if resp.GetAmendment().GetStatus() == pb.GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_APPROVED {
+		InvalidateCacheByPattern(ctx, c.redisClient, criterionCachePrefix+"*")
+		InvalidateCacheByPattern(ctx, c.redisClient, gradeDefenceCachePrefix+"*")
+	}
+
+	return resp, nil
+}
+
+func (c *GRPCCouncil) GetGradeDefenceAmendment(ctx context.Context, id string) (*pb.GetGradeDefenceAmendmentResponse, error) {
+	return c.client.GetGradeDefenceAmendment(ctx, &pb.GetGradeDefenceAmendmentRequest{Id: id})
+}
+
+func (c *GRPCCouncil) ListGradeDefenceAmendments(ctx context.Context, req *pb.ListGradeDefenceAmendmentsRequest) (*pb.ListGradeDefenceAmendmentsResponse, error) {
+	return c.client.ListGradeDefenceAmendments(ctx, req)
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	pbEvent "thaily/proto/event"
+	"thaily/src/pkg/outbox"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// cacheGroup is the consumer group of the gateway's cache invalidation
+const cacheGroup = "gateway-cache"
+
+// eventCachePrefixes are the cached reads an event on the aggregate stales
+var eventCachePrefixes = map[string][]string{
+	"Topic":        {topicCachePrefix},
+	"Enrollment":   {enrollmentCachePrefix},
+	"File":         {fileCachePrefix},
+	"GradeDefence": {gradeDefenceCachePrefix},
+	"Council":      {councilCachePrefix, gradeDefenceCachePrefix},
+}
+
+// RunCacheInvalidation drops cached reads as the services' events arrive,
+// which covers the changes made without going through these clients, such as
+// a grade defence total the council service recomputed. Invalidating twice is
+// harmless, so redelivered events need no deduplication.
+func RunCacheInvalidation(ctx context.Context, redisClient *redis.Client) {
+	if redisClient == nil {
+		return
+	}
+	name, err := os.Hostname()
+	if err != nil {
+		name = fmt.Sprintf("gateway-%d", os.Getpid())
+	}
+
+	consumer := outbox.NewConsumer(redisClient, cacheGroup, name)
+	err = consumer.Run(ctx, func(ctx context.Context, event *pbEvent.Event) error {
+		for _, prefix := range eventCachePrefixes[event.Aggregate] {
+			InvalidateCacheByKey(ctx, redisClient, prefix+event.AggregateId)
+			if err := InvalidateCacheByPattern(ctx, redisClient, prefix+"*"); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil && ctx.Err() == nil {
+		log.Printf("Events: cache invalidation stopped: %v", err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	pbCommon "thaily/proto/common"
 	pb "thaily/proto/file"
+	"thaily/src/pkg/audit"
 	"thaily/src/pkg/tls"
 
 	"github.com/redis/go-redis/v9"
@@ -22,10 +23,12 @@ type GRPCfile struct {
 
 const (
 	// Cache TTL configurations
-	fileCacheTTL = 15 * time.Minute // Files are relatively stable once uploaded
+	fileCacheTTL       = 15 * time.Minute // Files are relatively stable once uploaded
+	similarityCacheTTL = 5 * time.Minute  // Reports change whenever another submission is indexed
 
 	// Cache key prefixes
-	fileCachePrefix = "file:file:"
+	fileCachePrefix       = "file:file:"
+	similarityCachePrefix = "file:similarity:"
 )
 
 func NewGRPCfile(addr string, redisClient *redis.Client) (*GRPCfile, error) {
@@ -35,7 +38,11 @@ func NewGRPCfile(addr string, redisClient *redis.Client) (*GRPCfile, error) {
 		return nil, fmt.Errorf("failed to load TLS credentials: %v", err)
 	}
 
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(creds),
+		// Forward the caller for the services' audit log
+		grpc.WithUnaryInterceptor(audit.UnaryClientInterceptor()),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -88,14 +95,21 @@ func (f *GRPCfile) GetFileById(ctx context.Context, id string) (*pb.GetFileRespo
 	return resp, nil
 }
 
+// GetFileIncludingDeleted also finds a soft-deleted file. Not cached, so a
+// restore always sees the current state.
+func (f *GRPCfile) GetFileIncludingDeleted(ctx context.Context, id string) (*pb.GetFileResponse, error) {
+	return f.client.GetFile(ctx, &pb.GetFileRequest{Id: id, IncludeDeleted: true})
+}
+
 func (f *GRPCfile) CreateFile(ctx context.Context, req *pb.CreateFileRequest) (*pb.CreateFileResponse, error) {
 	resp, err := f.client.CreateFile(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
-	// Invalidate search caches (new file added)
+	// Invalidate search and version caches (new file added)
 	InvalidateCacheByPattern(ctx, f.redisClient, fileCachePrefix+"search:*")
+	InvalidateCacheByPattern(ctx, f.redisClient, fileCachePrefix+"versions:*")
 
 	return resp, nil
 }
@@ -116,8 +130,25 @@ func (f *GRPCfile) UpdateFile(ctx context.Context, req *pb.UpdateFileRequest) (*
 	return resp, nil
 }
 
-func (f *GRPCfile) DeleteFile(ctx context.Context, id string) (*pb.DeleteFileResponse, error) {
-	resp, err := f.client.DeleteFile(ctx, &pb.DeleteFileRequest{Id: id})
+// DeleteFile soft-deletes a file; its object stays until the retention job
+// purges it
+func (f *GRPCfile) DeleteFile(ctx context.Context, id, deletedBy string) (*pb.DeleteFileResponse, error) {
+	resp, err := f.client.DeleteFile(ctx, &pb.DeleteFileRequest{Id: id, DeletedBy: deletedBy})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	cacheKey := fmt.Sprintf("%s%s", fileCachePrefix, id)
+	InvalidateCacheByKey(ctx, f.redisClient, cacheKey)
+	InvalidateCacheByPattern(ctx, f.redisClient, fileCachePrefix+"*")
+	InvalidateCacheByPattern(ctx, f.redisClient, similarityCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (f *GRPCfile) RestoreFile(ctx context.Context, id, restoredBy string) (*pb.RestoreFileResponse, error) {
+	resp, err := f.client.RestoreFile(ctx, &pb.RestoreFileRequest{Id: id, RestoredBy: restoredBy})
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +157,103 @@ func (f *GRPCfile) DeleteFile(ctx context.Context, id string) (*pb.DeleteFileRes
 	cacheKey := fmt.Sprintf("%s%s", fileCachePrefix, id)
 	InvalidateCacheByKey(ctx, f.redisClient, cacheKey)
 	InvalidateCacheByPattern(ctx, f.redisClient, fileCachePrefix+"*")
+	InvalidateCacheByPattern(ctx, f.redisClient, similarityCachePrefix+"*")
+
+	return resp, nil
+}
+
+// ListExpiredFiles lists files soft-deleted longer than the retention period.
+// Not cached: the retention job is the only caller.
+func (f *GRPCfile) ListExpiredFiles(ctx context.Context, retention time.Duration, limit int32) (*pb.ListExpiredFilesResponse, error) {
+	return f.client.ListExpiredFiles(ctx, &pb.ListExpiredFilesRequest{
+		RetentionSeconds: int64(retention / time.Second),
+		Limit:            limit,
+	})
+}
+
+// ClaimFilePurge makes a file past the retention period no longer
+// restorable, before its object is removed
+func (f *GRPCfile) ClaimFilePurge(ctx context.Context, id string, retention time.Duration) (*pb.ClaimFilePurgeResponse, error) {
+	return f.client.ClaimFilePurge(ctx, &pb.ClaimFilePurgeRequest{
+		Id:               id,
+		RetentionSeconds: int64(retention / time.Second),
+	})
+}
+
+// PurgeFile hard-deletes a file claimed for purging
+func (f *GRPCfile) PurgeFile(ctx context.Context, id string, retention time.Duration) (*pb.PurgeFileResponse, error) {
+	resp, err := f.client.PurgeFile(ctx, &pb.PurgeFileRequest{
+		Id:               id,
+		RetentionSeconds: int64(retention / time.Second),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	cacheKey := fmt.Sprintf("%s%s", fileCachePrefix, id)
+	InvalidateCacheByKey(ctx, f.redisClient, cacheKey)
+
+	return resp, nil
+}
+
+func (f *GRPCfile) ListFileVersions(ctx context.Context, table pb.TableType, tableID, option string) (*pb.ListFileVersionsResponse, error) {
+	cacheKey := fmt.Sprintf("%sversions:%s:%s:%s", fileCachePrefix, table.String(), tableID, option)
+	var cached pb.ListFileVersionsResponse
+	if hit, _ := GetCachedProto(ctx, f.redisClient, cacheKey, &cached); hit {
+		log.Printf("Cache HIT for file versions: %s/%s/%s", table.String(), tableID, option)
+		return &cached, nil
+	}
+
+	log.Printf("Cache MISS for file versions: %s/%s/%s", table.String(), tableID, option)
+	resp, err := f.client.ListFileVersions(ctx, &pb.ListFileVersionsRequest{
+		Table:   table,
+		TableId: tableID,
+		Option:  option,
+	})
+	if err != nil {
+		return nil, err
+	}
 
+	SetCachedProto(ctx, f.redisClient, cacheKey, resp, fileCacheTTL)
+	return resp, nil
+}
+
+// IndexFileContent stores the similarity fingerprint of a submission's text
+func (f *GRPCfile) IndexFileContent(ctx context.Context, fileID, semesterCode, text string) (*pb.IndexFileContentResponse, error) {
+	resp, err := f.client.IndexFileContent(ctx, &pb.IndexFileContentRequest{
+		FileId:       fileID,
+		SemesterCode: semesterCode,
+		Text:         text,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// A new fingerprint can appear in any report of the semester
+	InvalidateCacheByPattern(ctx, f.redisClient, similarityCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (f *GRPCfile) GetSimilarityReport(ctx context.Context, fileID string, topN int32) (*pb.GetSimilarityReportResponse, error) {
+	cacheKey := fmt.Sprintf("%s%s:%d", similarityCachePrefix, fileID, topN)
+	var cached pb.GetSimilarityReportResponse
+	if hit, _ := GetCachedProto(ctx, f.redisClient, cacheKey, &cached); hit {
+		log.Printf("Cache HIT for similarity report: %s", fileID)
+		return &cached, nil
+	}
+
+	log.Printf("Cache MISS for similarity report: %s", fileID)
+	resp, err := f.client.GetSimilarityReport(ctx, &pb.GetSimilarityReportRequest{
+		FileId: fileID,
+		TopN:   topN,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	SetCachedProto(ctx, f.redisClient, cacheKey, resp, similarityCacheTTL)
 	return resp, nil
 }
 
@@ -200,3 +327,7 @@ func (f *GRPCfile) GetFilesByIds(ctx context.Context, ids []string) (*pb.ListFil
 
 	return result, nil
 }
+
+func (f *GRPCfile) SearchFiles(ctx context.Context, req *pb.SearchFilesRequest) (*pb.SearchFilesResponse, error) {
+	return f.client.SearchFiles(ctx, req)
+}
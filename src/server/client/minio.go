@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 	"log"
 	"thaily/src/config"
 
@@ -60,28 +61,107 @@ func NewServiceMinIo(cfg config.MinioConfig) (*ServiceMinIo, error) {
 }
 
 // UploadFile uploads a file to MinIO
-func (s *ServiceMinIo) UploadFile(ctx context.Context, objectName string, reader interface{}, objectSize int64, contentType string) (string, error) {
+func (s *ServiceMinIo) UploadFile(ctx context.Context, objectName string, reader io.Reader, objectSize int64, contentType string) (string, error) {
 	bucketName := s.config.BucketName
 
-	_, err := s.client.PutObject(ctx, bucketName, objectName, reader.(interface {
-		Read(p []byte) (n int, err error)
-		Seek(offset int64, whence int) (int64, error)
-	}), objectSize, minio.PutObjectOptions{
+	_, err := s.client.PutObject(ctx, bucketName, objectName, reader, objectSize, minio.PutObjectOptions{
 		ContentType: contentType,
 	})
 	if err != nil {
 		return "", fmt.Errorf("failed to upload file: %w", err)
 	}
 
-	// Generate file URL
-	fileURL := fmt.Sprintf("%s/%s/%s", s.config.Endpont, bucketName, objectName)
+	fileURL := s.objectURL(objectName)
+	log.Printf("File uploaded successfully: %s", fileURL)
+	return fileURL, nil
+}
+
+// objectURL builds the public URL stored in File.file for an object
+func (s *ServiceMinIo) objectURL(objectName string) string {
 	if s.config.UseSSL {
-		fileURL = fmt.Sprintf("https://%s/%s/%s", s.config.Endpont, bucketName, objectName)
-	} else {
-		fileURL = fmt.Sprintf("http://%s/%s/%s", s.config.Endpont, bucketName, objectName)
+		return fmt.Sprintf("https://%s/%s/%s", s.config.Endpont, s.config.BucketName, objectName)
 	}
+	return fmt.Sprintf("http://%s/%s/%s", s.config.Endpont, s.config.BucketName, objectName)
+}
 
-	log.Printf("File uploaded successfully: %s", fileURL)
+// NewMultipartUpload starts a multipart upload and returns the MinIO upload ID
+func (s *ServiceMinIo) NewMultipartUpload(ctx context.Context, objectName string, contentType string) (string, error) {
+	core := minio.Core{Client: s.client}
+
+	uploadID, err := core.NewMultipartUpload(ctx, s.config.BucketName, objectName, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to start multipart upload: %w", err)
+	}
+
+	return uploadID, nil
+}
+
+// UploadPart uploads one part of a multipart upload.
+// sha256Hex is verified by MinIO against the received bytes.
+func (s *ServiceMinIo) UploadPart(ctx context.Context, objectName, uploadID string, partNumber int, reader io.Reader, size int64, sha256Hex string) (string, error) {
+	core := minio.Core{Client: s.client}
+
+	part, err := core.PutObjectPart(ctx, s.config.BucketName, objectName, uploadID, partNumber, reader, size, minio.PutObjectPartOptions{
+		Sha256Hex: sha256Hex,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload part %d: %w", partNumber, err)
+	}
+
+	return part.ETag, nil
+}
+
+// CompleteMultipartUpload assembles the uploaded parts into the final object
+func (s *ServiceMinIo) CompleteMultipartUpload(ctx context.Context, objectName, uploadID string, parts []minio.CompletePart) (string, error) {
+	core := minio.Core{Client: s.client}
+
+	_, err := core.CompleteMultipartUpload(ctx, s.config.BucketName, objectName, uploadID, parts, minio.PutObjectOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to complete multipart upload: %w", err)
+	}
+
+	fileURL := s.objectURL(objectName)
+	log.Printf("Multipart upload completed: %s", fileURL)
+	return fileURL, nil
+}
+
+// AbortMultipartUpload discards a multipart upload and all parts uploaded so far
+func (s *ServiceMinIo) AbortMultipartUpload(ctx context.Context, objectName, uploadID string) error {
+	core := minio.Core{Client: s.client}
+
+	if err := core.AbortMultipartUpload(ctx, s.config.BucketName, objectName, uploadID); err != nil {
+		return fmt.Errorf("failed to abort multipart upload: %w", err)
+	}
+
+	return nil
+}
+
+// ListIncompleteUploads lists the multipart uploads of the bucket that were
+// neither completed nor aborted
+func (s *ServiceMinIo) ListIncompleteUploads(ctx context.Context) <-chan minio.ObjectMultipartInfo {
+	return s.client.ListIncompleteUploads(ctx, s.config.BucketName, "", true)
+}
+
+// MoveObject copies an object to a new name, removes the source and returns the new URL
+func (s *ServiceMinIo) MoveObject(ctx context.Context, srcName, dstName string) (string, error) {
+	bucketName := s.config.BucketName
+
+	_, err := s.client.CopyObject(ctx,
+		minio.CopyDestOptions{Bucket: bucketName, Object: dstName},
+		minio.CopySrcOptions{Bucket: bucketName, Object: srcName},
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to copy object: %w", err)
+	}
+
+	if err := s.client.RemoveObject(ctx, bucketName, srcName, minio.RemoveObjectOptions{}); err != nil {
+		return "", fmt.Errorf("failed to remove source object: %w", err)
+	}
+
+	fileURL := s.objectURL(dstName)
+	log.Printf("Object moved: %s -> %s", srcName, dstName)
 	return fileURL, nil
 }
 
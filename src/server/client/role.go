@@ -8,6 +8,7 @@ import (
 
 	pbCommon "thaily/proto/common"
 	pb "thaily/proto/role"
+	"thaily/src/pkg/audit"
 	"thaily/src/pkg/tls"
 
 	"github.com/redis/go-redis/v9"
@@ -35,7 +36,11 @@ func NewGRPCRole(addr string, redisClient *redis.Client) (*GRPCRole, error) {
 		return nil, fmt.Errorf("failed to load TLS credentials: %v", err)
 	}
 
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(creds),
+		// Forward the caller for the services' audit log
+		grpc.WithUnaryInterceptor(audit.UnaryClientInterceptor()),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -109,8 +114,22 @@ func (r *GRPCRole) UpdateRole(ctx context.Context, req *pb.UpdateRoleSystemReque
 	return resp, nil
 }
 
-func (r *GRPCRole) DeleteRole(ctx context.Context, id string) (*pb.DeleteRoleSystemResponse, error) {
-	resp, err := r.client.DeleteRoleSystem(ctx, &pb.DeleteRoleSystemRequest{Id: id})
+func (r *GRPCRole) DeleteRole(ctx context.Context, id, deletedBy string) (*pb.DeleteRoleSystemResponse, error) {
+	resp, err := r.client.DeleteRoleSystem(ctx, &pb.DeleteRoleSystemRequest{Id: id, DeletedBy: deletedBy})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	cacheKey := fmt.Sprintf("%s%s", roleSystemCachePrefix, id)
+	InvalidateCacheByKey(ctx, r.redisClient, cacheKey)
+	InvalidateCacheByPattern(ctx, r.redisClient, roleSystemCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (r *GRPCRole) RestoreRole(ctx context.Context, id, restoredBy string) (*pb.RestoreRoleSystemResponse, error) {
+	resp, err := r.client.RestoreRoleSystem(ctx, &pb.RestoreRoleSystemRequest{Id: id, RestoredBy: restoredBy})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"thaily/src/config"
+	"time"
+)
+
+// ScanResult is the verdict of a malware scan
+type ScanResult struct {
+	Clean     bool
+	Signature string // name of the detected threat when not clean
+}
+
+// Scanner scans uploaded content for malware
+type Scanner interface {
+	Scan(ctx context.Context, reader io.Reader) (*ScanResult, error)
+}
+
+// NewScanner builds the scanner selected by UPLOAD_SCANNER
+func NewScanner(cfg config.UploadConfig) (Scanner, error) {
+	timeout := time.Duration(cfg.ScanTimeout) * time.Second
+	switch cfg.Scanner {
+	case "", "none":
+		return noopScanner{}, nil
+	case "clamav":
+		return NewClamAVScanner(cfg.ClamAVAddress, timeout)
+	default:
+		return nil, fmt.Errorf("unknown scanner %q", cfg.Scanner)
+	}
+}
+
+// noopScanner accepts everything; used when no scanner is configured
+type noopScanner struct{}
+
+func (noopScanner) Scan(ctx context.Context, reader io.Reader) (*ScanResult, error) {
+	return &ScanResult{Clean: true}, nil
+}
+
+// clamAVChunkSize is the size of each INSTREAM chunk sent to clamd
+const clamAVChunkSize = 64 << 10
+
+// ClamAVScanner streams content to a clamd daemon using the INSTREAM command
+type ClamAVScanner struct {
+	network string
+	address string
+	timeout time.Duration
+}
+
+// NewClamAVScanner parses an address of the form tcp://host:port or unix:///path
+func NewClamAVScanner(address string, timeout time.Duration) (*ClamAVScanner, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid clamav address %q: %w", address, err)
+	}
+
+	scanner := &ClamAVScanner{timeout: timeout}
+	switch u.Scheme {
+	case "tcp":
+		scanner.network, scanner.address = "tcp", u.Host
+	case "unix":
+		scanner.network, scanner.address = "unix", u.Path
+	default:
+		return nil, fmt.Errorf("invalid clamav address %q: scheme must be tcp or unix", address)
+	}
+	return scanner, nil
+}
+
+// Scan sends the content to clamd and parses the "stream: ..." reply
+func (s *ClamAVScanner) Scan(ctx context.Context, reader io.Reader) (*ScanResult, error) {
+	dialer := net.Dialer{Timeout: s.timeout}
+	conn, err := dialer.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to clamd: %w", err)
+	}
+	defer conn.Close()
+
+	if s.timeout > 0 {
+		_ = conn.SetDeadline(time.Now().Add(s.timeout))
+	}
+
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return nil, fmt.Errorf("failed to start clamd stream: %w", err)
+	}
+
+	buf := make([]byte, clamAVChunkSize)
+	size := make([]byte, 4)
+	for {
+		n, readErr := reader.Read(buf)
+		if n > 0 {
+			binary.BigEndian.PutUint32(size, uint32(n))
+			if _, err := conn.Write(size); err != nil {
+				return nil, fmt.Errorf("failed to send chunk to clamd: %w", err)
+			}
+			if _, err := conn.Write(buf[:n]); err != nil {
+				return nil, fmt.Errorf("failed to send chunk to clamd: %w", err)
+			}
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return nil, fmt.Errorf("failed to read content: %w", readErr)
+		}
+	}
+
+	// Zero-length chunk terminates the stream
+	binary.BigEndian.PutUint32(size, 0)
+	if _, err := conn.Write(size); err != nil {
+		return nil, fmt.Errorf("failed to end clamd stream: %w", err)
+	}
+
+	reply, err := bufio.NewReader(conn).ReadString('\x00')
+	if err != nil && reply == "" {
+		return nil, fmt.Errorf("failed to read clamd reply: %w", err)
+	}
+	return parseClamAVReply(strings.TrimRight(reply, "\x00\n"))
+}
+
+// parseClamAVReply interprets "stream: OK", "stream: <name> FOUND" and "... ERROR"
+func parseClamAVReply(reply string) (*ScanResult, error) {
+	reply = strings.TrimPrefix(reply, "stream: ")
+	switch {
+	case reply == "OK":
+		return &ScanResult{Clean: true}, nil
+	case strings.HasSuffix(reply, " FOUND"):
+		return &ScanResult{Clean: false, Signature: strings.TrimSuffix(reply, " FOUND")}, nil
+	default:
+		return nil, fmt.Errorf("clamd: %s", reply)
+	}
+}
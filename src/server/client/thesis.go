@@ -8,6 +8,7 @@ import (
 
 	pbCommon "thaily/proto/common"
 	pb "thaily/proto/thesis"
+	"thaily/src/pkg/audit"
 	"thaily/src/pkg/tls"
 
 	"github.com/redis/go-redis/v9"
@@ -29,6 +30,8 @@ const (
 	gradeReviewCacheTTL         = 10 * time.Minute
 	topicCouncilCacheTTL        = 5 * time.Minute
 	topicCouncilSupervisorCacheTTL = 5 * time.Minute
+	submissionDeadlineCacheTTL     = 5 * time.Minute
+	registrationWindowCacheTTL     = 5 * time.Minute
 
 	// Cache key prefixes
 	topicCachePrefix               = "thesis:topic:"
@@ -38,6 +41,8 @@ const (
 	gradeReviewCachePrefix         = "thesis:grade_review:"
 	topicCouncilCachePrefix        = "thesis:topic_council:"
 	topicCouncilSupervisorCachePrefix = "thesis:topic_council_supervisor:"
+	submissionDeadlineCachePrefix     = "thesis:submission_deadline:"
+	registrationWindowCachePrefix     = "thesis:registration_window:"
 )
 
 func NewGRPCthesis(addr string, redisClient *redis.Client) (*GRPCthesis, error) {
@@ -47,7 +52,11 @@ func NewGRPCthesis(addr string, redisClient *redis.Client) (*GRPCthesis, error)
 		return nil, fmt.Errorf("failed to load TLS credentials: %v", err)
 	}
 
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(creds),
+		// Forward the caller for the services' audit log
+		grpc.WithUnaryInterceptor(audit.UnaryClientInterceptor()),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -116,8 +125,22 @@ func (t *GRPCthesis) UpdateTopic(ctx context.Context, req *pb.UpdateTopicRequest
 	return resp, nil
 }
 
-func (t *GRPCthesis) DeleteTopic(ctx context.Context, id string) (*pb.DeleteTopicResponse, error) {
-	resp, err := t.client.DeleteTopic(ctx, &pb.DeleteTopicRequest{Id: id})
+func (t *GRPCthesis) DeleteTopic(ctx context.Context, id, deletedBy string) (*pb.DeleteTopicResponse, error) {
+	resp, err := t.client.DeleteTopic(ctx, &pb.DeleteTopicRequest{Id: id, DeletedBy: deletedBy})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	cacheKey := fmt.Sprintf("%s%s", topicCachePrefix, id)
+	InvalidateCacheByKey(ctx, t.redisClient, cacheKey)
+	InvalidateCacheByPattern(ctx, t.redisClient, topicCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (t *GRPCthesis) RestoreTopic(ctx context.Context, id, restoredBy string) (*pb.RestoreTopicResponse, error) {
+	resp, err := t.client.RestoreTopic(ctx, &pb.RestoreTopicRequest{Id: id, RestoredBy: restoredBy})
 	if err != nil {
 		return nil, err
 	}
@@ -257,8 +280,22 @@ func (t *GRPCthesis) UpdateEnrollment(ctx context.Context, req *pb.UpdateEnrollm
 	return resp, nil
 }
 
-func (t *GRPCthesis) DeleteEnrollment(ctx context.Context, id string) (*pb.DeleteEnrollmentResponse, error) {
-	resp, err := t.client.DeleteEnrollment(ctx, &pb.DeleteEnrollmentRequest{Id: id})
+func (t *GRPCthesis) DeleteEnrollment(ctx context.Context, id, deletedBy string) (*pb.DeleteEnrollmentResponse, error) {
+	resp, err := t.client.DeleteEnrollment(ctx, &pb.DeleteEnrollmentRequest{Id: id, DeletedBy: deletedBy})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	cacheKey := fmt.Sprintf("%s%s", enrollmentCachePrefix, id)
+	InvalidateCacheByKey(ctx, t.redisClient, cacheKey)
+	InvalidateCacheByPattern(ctx, t.redisClient, enrollmentCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (t *GRPCthesis) RestoreEnrollment(ctx context.Context, id, restoredBy string) (*pb.RestoreEnrollmentResponse, error) {
+	resp, err := t.client.RestoreEnrollment(ctx, &pb.RestoreEnrollmentRequest{Id: id, RestoredBy: restoredBy})
 	if err != nil {
 		return nil, err
 	}
@@ -271,6 +308,42 @@ func (t *GRPCthesis) DeleteEnrollment(ctx context.Context, id string) (*pb.Delet
 	return resp, nil
 }
 
+func (t *GRPCthesis) CreateEnrollmentBundle(ctx context.Context, req *pb.CreateEnrollmentBundleRequest) (*pb.CreateEnrollmentBundleResponse, error) {
+	resp, err := t.client.CreateEnrollmentBundle(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate list caches of every entity of the bundle
+	InvalidateCacheByPattern(ctx, t.redisClient, enrollmentCachePrefix+"*")
+	InvalidateCacheByPattern(ctx, t.redisClient, midtermCachePrefix+"*")
+	InvalidateCacheByPattern(ctx, t.redisClient, finalCachePrefix+"*")
+	InvalidateCacheByPattern(ctx, t.redisClient, gradeReviewCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (t *GRPCthesis) DeleteEnrollmentCascade(ctx context.Context, id, deletedBy string) (*pb.DeleteEnrollmentCascadeResponse, error) {
+	resp, err := t.client.DeleteEnrollmentCascade(ctx, &pb.DeleteEnrollmentCascadeRequest{Id: id, DeletedBy: deletedBy})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache of the enrollment and the rows deleted with it
+	InvalidateCacheByPattern(ctx, t.redisClient, enrollmentCachePrefix+"*")
+	if resp.MidtermCode != nil {
+		InvalidateCacheByPattern(ctx, t.redisClient, midtermCachePrefix+"*")
+	}
+	if resp.FinalCode != nil {
+		InvalidateCacheByPattern(ctx, t.redisClient, finalCachePrefix+"*")
+	}
+	if resp.GradeReviewCode != nil {
+		InvalidateCacheByPattern(ctx, t.redisClient, gradeReviewCachePrefix+"*")
+	}
+
+	return resp, nil
+}
+
 func (t *GRPCthesis) GetEnrollmentsByIds(ctx context.Context, ids []string) (*pb.ListEnrollmentsResponse, error) {
 	if len(ids) == 0 {
 		return &pb.ListEnrollmentsResponse{Enrollments: []*pb.Enrollment{}}, nil
@@ -398,8 +471,22 @@ func (t *GRPCthesis) UpdateMidterm(ctx context.Context, req *pb.UpdateMidtermReq
 	return resp, nil
 }
 
-func (t *GRPCthesis) DeleteMidterm(ctx context.Context, id string) (*pb.DeleteMidtermResponse, error) {
-	resp, err := t.client.DeleteMidterm(ctx, &pb.DeleteMidtermRequest{Id: id})
+func (t *GRPCthesis) DeleteMidterm(ctx context.Context, id, deletedBy string) (*pb.DeleteMidtermResponse, error) {
+	resp, err := t.client.DeleteMidterm(ctx, &pb.DeleteMidtermRequest{Id: id, DeletedBy: deletedBy})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	cacheKey := fmt.Sprintf("%s%s", midtermCachePrefix, id)
+	InvalidateCacheByKey(ctx, t.redisClient, cacheKey)
+	InvalidateCacheByPattern(ctx, t.redisClient, midtermCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (t *GRPCthesis) RestoreMidterm(ctx context.Context, id, restoredBy string) (*pb.RestoreMidtermResponse, error) {
+	resp, err := t.client.RestoreMidterm(ctx, &pb.RestoreMidtermRequest{Id: id, RestoredBy: restoredBy})
 	if err != nil {
 		return nil, err
 	}
@@ -539,8 +626,22 @@ func (t *GRPCthesis) UpdateFinal(ctx context.Context, req *pb.UpdateFinalRequest
 	return resp, nil
 }
 
-func (t *GRPCthesis) DeleteFinal(ctx context.Context, id string) (*pb.DeleteFinalResponse, error) {
-	resp, err := t.client.DeleteFinal(ctx, &pb.DeleteFinalRequest{Id: id})
+func (t *GRPCthesis) DeleteFinal(ctx context.Context, id, deletedBy string) (*pb.DeleteFinalResponse, error) {
+	resp, err := t.client.DeleteFinal(ctx, &pb.DeleteFinalRequest{Id: id, DeletedBy: deletedBy})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	cacheKey := fmt.Sprintf("%s%s", finalCachePrefix, id)
+	InvalidateCacheByKey(ctx, t.redisClient, cacheKey)
+	InvalidateCacheByPattern(ctx, t.redisClient, finalCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (t *GRPCthesis) RestoreFinal(ctx context.Context, id, restoredBy string) (*pb.RestoreFinalResponse, error) {
+	resp, err := t.client.RestoreFinal(ctx, &pb.RestoreFinalRequest{Id: id, RestoredBy: restoredBy})
 	if err != nil {
 		return nil, err
 	}
@@ -680,8 +781,22 @@ func (t *GRPCthesis) UpdateGradeReview(ctx context.Context, req *pb.UpdateGradeR
 	return resp, nil
 }
 
-func (t *GRPCthesis) DeleteGradeReview(ctx context.Context, id string) (*pb.DeleteGradeReviewResponse, error) {
-	resp, err := t.client.DeleteGradeReview(ctx, &pb.DeleteGradeReviewRequest{Id: id})
+func (t *GRPCthesis) DeleteGradeReview(ctx context.Context, id, deletedBy string) (*pb.DeleteGradeReviewResponse, error) {
+	resp, err := t.client.DeleteGradeReview(ctx, &pb.DeleteGradeReviewRequest{Id: id, DeletedBy: deletedBy})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	cacheKey := fmt.Sprintf("%s%s", gradeReviewCachePrefix, id)
+	InvalidateCacheByKey(ctx, t.redisClient, cacheKey)
+	InvalidateCacheByPattern(ctx, t.redisClient, gradeReviewCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (t *GRPCthesis) RestoreGradeReview(ctx context.Context, id, restoredBy string) (*pb.RestoreGradeReviewResponse, error) {
+	resp, err := t.client.RestoreGradeReview(ctx, &pb.RestoreGradeReviewRequest{Id: id, RestoredBy: restoredBy})
 	if err != nil {
 		return nil, err
 	}
@@ -821,8 +936,37 @@ func (t *GRPCthesis) UpdateTopicCouncil(ctx context.Context, req *pb.UpdateTopic
 	return resp, nil
 }
 
-func (t *GRPCthesis) DeleteTopicCouncil(ctx context.Context, id string) (*pb.DeleteTopicCouncilResponse, error) {
-	resp, err := t.client.DeleteTopicCouncil(ctx, &pb.DeleteTopicCouncilRequest{Id: id})
+func (t *GRPCthesis) ScheduleTopicCouncils(ctx context.Context, req *pb.ScheduleTopicCouncilsRequest) (*pb.ScheduleTopicCouncilsResponse, error) {
+	resp, err := t.client.ScheduleTopicCouncils(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	for _, slot := range req.TopicCouncils {
+		InvalidateCacheByKey(ctx, t.redisClient, topicCouncilCachePrefix+slot.Id)
+	}
+	InvalidateCacheByPattern(ctx, t.redisClient, topicCouncilCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (t *GRPCthesis) DeleteTopicCouncil(ctx context.Context, id, deletedBy string) (*pb.DeleteTopicCouncilResponse, error) {
+	resp, err := t.client.DeleteTopicCouncil(ctx, &pb.DeleteTopicCouncilRequest{Id: id, DeletedBy: deletedBy})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	cacheKey := fmt.Sprintf("%s%s", topicCouncilCachePrefix, id)
+	InvalidateCacheByKey(ctx, t.redisClient, cacheKey)
+	InvalidateCacheByPattern(ctx, t.redisClient, topicCouncilCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (t *GRPCthesis) RestoreTopicCouncil(ctx context.Context, id, restoredBy string) (*pb.RestoreTopicCouncilResponse, error) {
+	resp, err := t.client.RestoreTopicCouncil(ctx, &pb.RestoreTopicCouncilRequest{Id: id, RestoredBy: restoredBy})
 	if err != nil {
 		return nil, err
 	}
@@ -962,8 +1106,22 @@ func (t *GRPCthesis) UpdateTopicCouncilSupervisor(ctx context.Context, req *pb.U
 	return resp, nil
 }
 
-func (t *GRPCthesis) DeleteTopicCouncilSupervisor(ctx context.Context, id string) (*pb.DeleteTopicCouncilSupervisorResponse, error) {
-	resp, err := t.client.DeleteTopicCouncilSupervisor(ctx, &pb.DeleteTopicCouncilSupervisorRequest{Id: id})
+func (t *GRPCthesis) DeleteTopicCouncilSupervisor(ctx context.Context, id, deletedBy string) (*pb.DeleteTopicCouncilSupervisorResponse, error) {
+	resp, err := t.client.DeleteTopicCouncilSupervisor(ctx, &pb.DeleteTopicCouncilSupervisorRequest{Id: id, DeletedBy: deletedBy})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	cacheKey := fmt.Sprintf("%s%s", topicCouncilSupervisorCachePrefix, id)
+	InvalidateCacheByKey(ctx, t.redisClient, cacheKey)
+	InvalidateCacheByPattern(ctx, t.redisClient, topicCouncilSupervisorCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (t *GRPCthesis) RestoreTopicCouncilSupervisor(ctx context.Context, id, restoredBy string) (*pb.RestoreTopicCouncilSupervisorResponse, error) {
+	resp, err := t.client.RestoreTopicCouncilSupervisor(ctx, &pb.RestoreTopicCouncilSupervisorRequest{Id: id, RestoredBy: restoredBy})
 	if err != nil {
 		return nil, err
 	}
@@ -1046,3 +1204,417 @@ func (t *GRPCthesis) GetTopicCouncilSupervisorsByIds(ctx context.Context, ids []
 
 	return result, nil
 }
+
+// ============================================
+// SUBMISSION DEADLINE METHODS
+// ============================================
+
+func (t *GRPCthesis) ListSubmissionDeadlines(ctx context.Context, semesterCode string) (*pb.ListSubmissionDeadlinesResponse, error) {
+	cacheKey := fmt.Sprintf("%s%s", submissionDeadlineCachePrefix, semesterCode)
+	var cached pb.ListSubmissionDeadlinesResponse
+	if hit, _ := GetCachedProto(ctx, t.redisClient, cacheKey, &cached); hit {
+		log.Printf("Cache HIT for submission deadlines: %s", semesterCode)
+		return &cached, nil
+	}
+
+	log.Printf("Cache MISS for submission deadlines: %s", semesterCode)
+	resp, err := t.client.ListSubmissionDeadlines(ctx, &pb.ListSubmissionDeadlinesRequest{SemesterCode: semesterCode})
+	if err != nil {
+		return nil, err
+	}
+
+	SetCachedProto(ctx, t.redisClient, cacheKey, resp, submissionDeadlineCacheTTL)
+	return resp, nil
+}
+
+func (t *GRPCthesis) SetSubmissionDeadline(ctx context.Context, req *pb.SetSubmissionDeadlineRequest) (*pb.SetSubmissionDeadlineResponse, error) {
+	resp, err := t.client.SetSubmissionDeadline(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	InvalidateCacheByKey(ctx, t.redisClient, fmt.Sprintf("%s%s", submissionDeadlineCachePrefix, req.SemesterCode))
+
+	return resp, nil
+}
+
+func (t *GRPCthesis) GrantDeadlineExtension(ctx context.Context, req *pb.GrantDeadlineExtensionRequest) (*pb.GrantDeadlineExtensionResponse, error) {
+	return t.client.GrantDeadlineExtension(ctx, req)
+}
+
+// CheckSubmissionWindow is never cached: the answer depends on the current time
+func (t *GRPCthesis) CheckSubmissionWindow(ctx context.Context, semesterCode, studentCode string, kind pb.SubmissionKind) (*pb.CheckSubmissionWindowResponse, error) {
+	return t.client.CheckSubmissionWindow(ctx, &pb.CheckSubmissionWindowRequest{
+		SemesterCode: semesterCode,
+		StudentCode:  studentCode,
+		Kind:         kind,
+	})
+}
+
+// ============================================
+// TOPIC LIFECYCLE METHODS
+// ============================================
+
+// invalidateTopic drops the cached topic, topic searches and its status history
+func (t *GRPCthesis) invalidateTopic(ctx context.Context, id string) {
+	InvalidateCacheByKey(ctx, t.redisClient, fmt.Sprintf("%s%s", topicCachePrefix, id))
+	InvalidateCacheByPattern(ctx, t.redisClient, topicCachePrefix+"*")
+}
+
+func (t *GRPCthesis) SubmitTopic(ctx context.Context, id, actor string) (*pb.SubmitTopicResponse, error) {
+	resp, err := t.client.SubmitTopic(ctx, &pb.SubmitTopicRequest{Id: id, Actor: actor})
+	if err != nil {
+		return nil, err
+	}
+	t.invalidateTopic(ctx, id)
+	return resp, nil
+}
+
+func (t *GRPCthesis) ApproveTopic(ctx context.Context, id string, stage int32, actor, note string) (*pb.ApproveTopicResponse, error) {
+	resp, err := t.client.ApproveTopic(ctx, &pb.ApproveTopicRequest{Id: id, Stage: stage, Actor: actor, Note: note})
+	if err != nil {
+		return nil, err
+	}
+	t.invalidateTopic(ctx, id)
+	return resp, nil
+}
+
+func (t *GRPCthesis) RejectTopic(ctx context.Context, id string, stage int32, reason, actor string) (*pb.RejectTopicResponse, error) {
+	resp, err := t.client.RejectTopic(ctx, &pb.RejectTopicRequest{Id: id, Stage: stage, Reason: reason, Actor: actor})
+	if err != nil {
+		return nil, err
+	}
+	t.invalidateTopic(ctx, id)
+	return resp, nil
+}
+
+func (t *GRPCthesis) StartTopic(ctx context.Context, id, actor string) (*pb.StartTopicResponse, error) {
+	resp, err := t.client.StartTopic(ctx, &pb.StartTopicRequest{Id: id, Actor: actor})
+	if err != nil {
+		return nil, err
+	}
+	t.invalidateTopic(ctx, id)
+	return resp, nil
+}
+
+func (t *GRPCthesis) CompleteTopic(ctx context.Context, id, actor string) (*pb.CompleteTopicResponse, error) {
+	resp, err := t.client.CompleteTopic(ctx, &pb.CompleteTopicRequest{Id: id, Actor: actor})
+	if err != nil {
+		return nil, err
+	}
+	t.invalidateTopic(ctx, id)
+	return resp, nil
+}
+
+func (t *GRPCthesis) ListTopicStatusHistory(ctx context.Context, topicID string) (*pb.ListTopicStatusHistoryResponse, error) {
+	cacheKey := fmt.Sprintf("%shistory:%s", topicCachePrefix, topicID)
+	var cached pb.ListTopicStatusHistoryResponse
+	if hit, _ := GetCachedProto(ctx, t.redisClient, cacheKey, &cached); hit {
+		log.Printf("Cache HIT for topic history: %s", topicID)
+		return &cached, nil
+	}
+
+	log.Printf("Cache MISS for topic history: %s", topicID)
+	resp, err := t.client.ListTopicStatusHistory(ctx, &pb.ListTopicStatusHistoryRequest{TopicId: topicID})
+	if err != nil {
+		return nil, err
+	}
+
+	SetCachedProto(ctx, t.redisClient, cacheKey, resp, topicCacheTTL)
+	return resp, nil
+}
+
+// ============================================
+// TOPIC PROPOSAL & REGISTRATION METHODS
+// ============================================
+
+func (t *GRPCthesis) ProposeTopic(ctx context.Context, req *pb.ProposeTopicRequest) (*pb.ProposeTopicResponse, error) {
+	resp, err := t.client.ProposeTopic(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache: a proposal creates a topic, its council and a supervisor
+	InvalidateCacheByPattern(ctx, t.redisClient, topicCachePrefix+"*")
+	InvalidateCacheByPattern(ctx, t.redisClient, topicCouncilCachePrefix+"*")
+	InvalidateCacheByPattern(ctx, t.redisClient, topicCouncilSupervisorCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (t *GRPCthesis) CoSignTopic(ctx context.Context, topicID, teacherCode string, accept bool) (*pb.CoSignTopicResponse, error) {
+	resp, err := t.client.CoSignTopic(ctx, &pb.CoSignTopicRequest{TopicId: topicID, TeacherCode: teacherCode, Accept: accept})
+	if err != nil {
+		return nil, err
+	}
+	if accept {
+		InvalidateCacheByPattern(ctx, t.redisClient, topicCouncilSupervisorCachePrefix+"*")
+	}
+	return resp, nil
+}
+
+func (t *GRPCthesis) ListTopicCoSigns(ctx context.Context, topicID string) (*pb.ListTopicCoSignsResponse, error) {
+	return t.client.ListTopicCoSigns(ctx, &pb.ListTopicCoSignsRequest{TopicId: topicID})
+}
+
+func (t *GRPCthesis) GetRegistrationWindow(ctx context.Context, semesterCode string) (*pb.GetRegistrationWindowResponse, error) {
+	cacheKey := fmt.Sprintf("%s%s", registrationWindowCachePrefix, semesterCode)
+	var cached pb.GetRegistrationWindowResponse
+	if hit, _ := GetCachedProto(ctx, t.redisClient, cacheKey, &cached); hit {
+		log.Printf("Cache HIT for registration window: %s", semesterCode)
+		return &cached, nil
+	}
+
+	log.Printf("Cache MISS for registration window: %s", semesterCode)
+	resp, err := t.client.GetRegistrationWindow(ctx, &pb.GetRegistrationWindowRequest{SemesterCode: semesterCode})
+	if err != nil {
+		return nil, err
+	}
+
+	SetCachedProto(ctx, t.redisClient, cacheKey, resp, registrationWindowCacheTTL)
+	return resp, nil
+}
+
+func (t *GRPCthesis) SetRegistrationWindow(ctx context.Context, req *pb.SetRegistrationWindowRequest) (*pb.SetRegistrationWindowResponse, error) {
+	resp, err := t.client.SetRegistrationWindow(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	InvalidateCacheByKey(ctx, t.redisClient, fmt.Sprintf("%s%s", registrationWindowCachePrefix, req.SemesterCode))
+
+	return resp, nil
+}
+
+// Registrations are never cached: they change while the window is open
+func (t *GRPCthesis) RegisterTopicPreferences(ctx context.Context, studentCode, semesterCode string, topicCodes []string) (*pb.RegisterTopicPreferencesResponse, error) {
+	return t.client.RegisterTopicPreferences(ctx, &pb.RegisterTopicPreferencesRequest{
+		StudentCode:  studentCode,
+		SemesterCode: semesterCode,
+		TopicCodes:   topicCodes,
+	})
+}
+
+func (t *GRPCthesis) ListTopicRegistrations(ctx context.Context, req *pb.ListTopicRegistrationsRequest) (*pb.ListTopicRegistrationsResponse, error) {
+	return t.client.ListTopicRegistrations(ctx, req)
+}
+
+func (t *GRPCthesis) DecideTopicRegistration(ctx context.Context, req *pb.DecideTopicRegistrationRequest) (*pb.DecideTopicRegistrationResponse, error) {
+	resp, err := t.client.DecideTopicRegistration(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetEnrollment() != nil {
+		InvalidateCacheByPattern(ctx, t.redisClient, enrollmentCachePrefix+"*")
+	}
+	return resp, nil
+}
+
+// ============================================
+// TOPIC MATCHING METHODS
+// ============================================
+
+func (t *GRPCthesis) SetApplicantRanking(ctx context.Context, req *pb.SetApplicantRankingRequest) (*pb.SetApplicantRankingResponse, error) {
+	return t.client.SetApplicantRanking(ctx, req)
+}
+
+// PreviewTopicMatching is never cached: registrations change while the window is open
+func (t *GRPCthesis) PreviewTopicMatching(ctx context.Context, semesterCode string) (*pb.PreviewTopicMatchingResponse, error) {
+	return t.client.PreviewTopicMatching(ctx, &pb.PreviewTopicMatchingRequest{SemesterCode: semesterCode})
+}
+
+func (t *GRPCthesis) CommitTopicMatching(ctx context.Context, semesterCode, committedBy string) (*pb.CommitTopicMatchingResponse, error) {
+	resp, err := t.client.CommitTopicMatching(ctx, &pb.CommitTopicMatchingRequest{SemesterCode: semesterCode, CommittedBy: committedBy})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	if len(resp.GetResult().GetAssignments()) > 0 {
+		InvalidateCacheByPattern(ctx, t.redisClient, enrollmentCachePrefix+"*")
+	}
+
+	return resp, nil
+}
+
+// ============================================
+// GRADING POLICY METHODS
+// ============================================
+
+func (t *GRPCthesis) ListGradingPolicies(ctx context.Context, semesterCode string) (*pb.ListGradingPoliciesResponse, error) {
+	return t.client.ListGradingPolicies(ctx, &pb.ListGradingPoliciesRequest{SemesterCode: semesterCode})
+}
+
+func (t *GRPCthesis) SetGradingPolicy(ctx context.Context, req *pb.SetGradingPolicyRequest) (*pb.SetGradingPolicyResponse, error) {
+	return t.client.SetGradingPolicy(ctx, req)
+}
+
+func (t *GRPCthesis) DeleteGradingPolicy(ctx context.Context, id string) (*pb.DeleteGradingPolicyResponse, error) {
+	return t.client.DeleteGradingPolicy(ctx, &pb.DeleteGradingPolicyRequest{Id: id})
+}
+
+// ComputeFinalGrade is never cached; when it finalizes, the Final changes
+func (t *GRPCthesis) ComputeFinalGrade(ctx context.Context, req *pb.ComputeFinalGradeRequest) (*pb.ComputeFinalGradeResponse, error) {
+	resp, err := t.client.ComputeFinalGrade(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	if resp.GetFinalized() {
+		InvalidateCacheByKey(ctx, t.redisClient, fmt.Sprintf("%s%s", finalCachePrefix, resp.GetFinal().GetId()))
+		InvalidateCacheByPattern(ctx, t.redisClient, finalCachePrefix+"*")
+	}
+
+	return resp, nil
+}
+
+// ============================================
+// GRADE LOCK & AMENDMENT METHODS
+// ============================================
+
+func (t *GRPCthesis) LockSemesterGrades(ctx context.Context, semesterCode, actor string) (*pb.LockSemesterGradesResponse, error) {
+	return t.client.LockSemesterGrades(ctx, &pb.LockSemesterGradesRequest{SemesterCode: semesterCode, Actor: actor})
+}
+
+func (t *GRPCthesis) PublishSemesterGrades(ctx context.Context, semesterCode, actor string) (*pb.PublishSemesterGradesResponse, error) {
+	return t.client.PublishSemesterGrades(ctx, &pb.PublishSemesterGradesRequest{SemesterCode: semesterCode, Actor: actor})
+}
+
+func (t *GRPCthesis) GetSemesterGradeStatus(ctx context.Context, semesterCode string) (*pb.GetSemesterGradeStatusResponse, error) {
+	return t.client.GetSemesterGradeStatus(ctx, &pb.GetSemesterGradeStatusRequest{SemesterCode: semesterCode})
+}
+
+func (t *GRPCthesis) RequestGradeAmendment(ctx context.Context, req *pb.RequestGradeAmendmentRequest) (*pb.RequestGradeAmendmentResponse, error) {
+	return t.client.RequestGradeAmendment(ctx, req)
+}
+
+func (t *GRPCthesis) DecideGradeAmendment(ctx context.Context, req *pb.DecideGradeAmendmentRequest) (*pb.DecideGradeAmendmentResponse, error) {
+	resp, err := t.client.DecideGradeAmendment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	if resp.GetAmendment().GetStatus() == pb.GradeAmendmentStatus_AMENDMENT_APPROVED {
+		switch resp.GetAmendment().GetTarget() {
+		case pb.GradeAmendmentTarget_AMEND_REVIEW_GRADE:
+			// A graded Final is recomputed from the new review grade
+			InvalidateCacheByPattern(ctx, t.redisClient, gradeReviewCachePrefix+"*")
+			InvalidateCacheByPattern(ctx, t.redisClient, finalCachePrefix+"*")
+		case pb.GradeAmendmentTarget_AMEND_MIDTERM_GRADE:
+			InvalidateCacheByPattern(ctx, t.redisClient, midtermCachePrefix+"*")
+		default:
+			InvalidateCacheByPattern(ctx, t.redisClient, finalCachePrefix+"*")
+		}
+	}
+
+	return resp, nil
+}
+
+func (t *GRPCthesis) GetGradeAmendment(ctx context.Context, id string) (*pb.GetGradeAmendmentResponse, error) {
+	return t.client.GetGradeAmendment(ctx, &pb.GetGradeAmendmentRequest{Id: id})
+}
+
+func (t *GRPCthesis) ListGradeAmendments(ctx context.Context, req *pb.ListGradeAmendmentsRequest) (*pb.ListGradeAmendmentsResponse, error) {
+	return t.client.ListGradeAmendments(ctx, req)
+}
+
+func (t *GRPCthesis) SetGradeAppealWindow(ctx context.Context, semesterCode string, days int32, actor string) (*pb.SetGradeAppealWindowResponse, error) {
+	return t.client.SetGradeAppealWindow(ctx, &pb.SetGradeAppealWindowRequest{SemesterCode: semesterCode, Days: days, Actor: actor})
+}
+
+func (t *GRPCthesis) FileGradeAppeal(ctx context.Context, req *pb.FileGradeAppealRequest) (*pb.FileGradeAppealResponse, error) {
+	return t.client.FileGradeAppeal(ctx, req)
+}
+
+func (t *GRPCthesis) AssignGradeAppeal(ctx context.Context, id, reviewerCode, actor string) (*pb.AssignGradeAppealResponse, error) {
+	return t.client.AssignGradeAppeal(ctx, &pb.AssignGradeAppealRequest{Id: id, ReviewerCode: reviewerCode, Actor: actor})
+}
+
+func (t *GRPCthesis) ResolveGradeAppeal(ctx context.Context, req *pb.ResolveGradeAppealRequest) (*pb.ResolveGradeAppealResponse, error) {
+	return t.client.ResolveGradeAppeal(ctx, req)
+}
+
+func (t *GRPCthesis) RequestGradeAppealAmendment(ctx context.Context, req *pb.RequestGradeAppealAmendmentRequest) (*pb.RequestGradeAppealAmendmentResponse, error) {
+	return t.client.RequestGradeAppealAmendment(ctx, req)
+}
+
+func (t *GRPCthesis) SettleGradeAppealAmendment(ctx context.Context, amendmentCode string) (*pb.SettleGradeAppealAmendmentResponse, error) {
+	return t.client.SettleGradeAppealAmendment(ctx, &pb.SettleGradeAppealAmendmentRequest{AmendmentCode: amendmentCode})
+}
+
+func (t *GRPCthesis) GetGradeAppeal(ctx context.Context, id string) (*pb.GetGradeAppealResponse, error) {
+	return t.client.GetGradeAppeal(ctx, &pb.GetGradeAppealRequest{Id: id})
+}
+
+func (t *GRPCthesis) ListGradeAppeals(ctx context.Context, req *pb.ListGradeAppealsRequest) (*pb.ListGradeAppealsResponse, error) {
+	return t.client.ListGradeAppeals(ctx, req)
+}
+
+func (t *GRPCthesis) CreateMidtermMilestone(ctx context.Context, req *pb.CreateMidtermMilestoneRequest) (*pb.CreateMidtermMilestoneResponse, error) {
+	resp, err := t.client.CreateMidtermMilestone(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	InvalidateCacheByPattern(ctx, t.redisClient, midtermCachePrefix+"*")
+	return resp, nil
+}
+
+func (t *GRPCthesis) UpdateMidtermMilestone(ctx context.Context, req *pb.UpdateMidtermMilestoneRequest) (*pb.UpdateMidtermMilestoneResponse, error) {
+	return t.client.UpdateMidtermMilestone(ctx, req)
+}
+
+func (t *GRPCthesis) DeleteMidtermMilestone(ctx context.Context, id, deletedBy string) (*pb.DeleteMidtermMilestoneResponse, error) {
+	resp, err := t.client.DeleteMidtermMilestone(ctx, &pb.DeleteMidtermMilestoneRequest{Id: id, DeletedBy: deletedBy})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	InvalidateCacheByPattern(ctx, t.redisClient, midtermCachePrefix+"*")
+	return resp, nil
+}
+
+func (t *GRPCthesis) ListMidtermMilestones(ctx context.Context, req *pb.ListMidtermMilestonesRequest) (*pb.ListMidtermMilestonesResponse, error) {
+	return t.client.ListMidtermMilestones(ctx, req)
+}
+
+func (t *GRPCthesis) SubmitMilestoneCheckin(ctx context.Context, req *pb.SubmitMilestoneCheckinRequest) (*pb.SubmitMilestoneCheckinResponse, error) {
+	resp, err := t.client.SubmitMilestoneCheckin(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache: the first check-in attaches a midterm to the enrollment
+	InvalidateCacheByPattern(ctx, t.redisClient, midtermCachePrefix+"*")
+	InvalidateCacheByPattern(ctx, t.redisClient, enrollmentCachePrefix+"*")
+	return resp, nil
+}
+
+func (t *GRPCthesis) ReviewMilestoneCheckin(ctx context.Context, req *pb.ReviewMilestoneCheckinRequest) (*pb.ReviewMilestoneCheckinResponse, error) {
+	resp, err := t.client.ReviewMilestoneCheckin(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	InvalidateCacheByPattern(ctx, t.redisClient, midtermCachePrefix+"*")
+	return resp, nil
+}
+
+func (t *GRPCthesis) GetMilestoneCheckin(ctx context.Context, id string) (*pb.GetMilestoneCheckinResponse, error) {
+	return t.client.GetMilestoneCheckin(ctx, &pb.GetMilestoneCheckinRequest{Id: id})
+}
+
+func (t *GRPCthesis) ListMilestoneCheckins(ctx context.Context, enrollmentCode string) (*pb.ListMilestoneCheckinsResponse, error) {
+	return t.client.ListMilestoneCheckins(ctx, &pb.ListMilestoneCheckinsRequest{EnrollmentCode: enrollmentCode})
+}
+
+func (t *GRPCthesis) SearchTopics(ctx context.Context, req *pb.SearchTopicsRequest) (*pb.SearchTopicsResponse, error) {
+	return t.client.SearchTopics(ctx, req)
+}
@@ -8,6 +8,7 @@ import (
 
 	pbCommon "thaily/proto/common"
 	pb "thaily/proto/user"
+	"thaily/src/pkg/audit"
 	"thaily/src/pkg/tls"
 
 	"github.com/redis/go-redis/v9"
@@ -37,7 +38,11 @@ func NewGRPCUser(addr string, redisClient *redis.Client) (*GRPCUser, error) {
 		return nil, fmt.Errorf("failed to load TLS credentials: %v", err)
 	}
 
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(creds),
+		// Forward the caller for the services' audit log
+		grpc.WithUnaryInterceptor(audit.UnaryClientInterceptor()),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -106,8 +111,22 @@ func (u *GRPCUser) UpdateStudent(ctx context.Context, req *pb.UpdateStudentReque
 	return resp, nil
 }
 
-func (u *GRPCUser) DeleteStudent(ctx context.Context, id string) (*pb.DeleteStudentResponse, error) {
-	resp, err := u.client.DeleteStudent(ctx, &pb.DeleteStudentRequest{Id: id})
+func (u *GRPCUser) DeleteStudent(ctx context.Context, id, deletedBy string) (*pb.DeleteStudentResponse, error) {
+	resp, err := u.client.DeleteStudent(ctx, &pb.DeleteStudentRequest{Id: id, DeletedBy: deletedBy})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	cacheKey := fmt.Sprintf("%s%s", studentCachePrefix, id)
+	InvalidateCacheByKey(ctx, u.redisClient, cacheKey)
+	InvalidateCacheByPattern(ctx, u.redisClient, studentCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (u *GRPCUser) RestoreStudent(ctx context.Context, id, restoredBy string) (*pb.RestoreStudentResponse, error) {
+	resp, err := u.client.RestoreStudent(ctx, &pb.RestoreStudentRequest{Id: id, RestoredBy: restoredBy})
 	if err != nil {
 		return nil, err
 	}
@@ -418,8 +437,22 @@ func (u *GRPCUser) UpdateTeacher(ctx context.Context, req *pb.UpdateTeacherReque
 	return resp, nil
 }
 
-func (u *GRPCUser) DeleteTeacher(ctx context.Context, id string) (*pb.DeleteTeacherResponse, error) {
-	resp, err := u.client.DeleteTeacher(ctx, &pb.DeleteTeacherRequest{Id: id})
+func (u *GRPCUser) DeleteTeacher(ctx context.Context, id, deletedBy string) (*pb.DeleteTeacherResponse, error) {
+	resp, err := u.client.DeleteTeacher(ctx, &pb.DeleteTeacherRequest{Id: id, DeletedBy: deletedBy})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	cacheKey := fmt.Sprintf("%s%s", teacherCachePrefix, id)
+	InvalidateCacheByKey(ctx, u.redisClient, cacheKey)
+	InvalidateCacheByPattern(ctx, u.redisClient, teacherCachePrefix+"*")
+
+	return resp, nil
+}
+
+func (u *GRPCUser) RestoreTeacher(ctx context.Context, id, restoredBy string) (*pb.RestoreTeacherResponse, error) {
+	resp, err := u.client.RestoreTeacher(ctx, &pb.RestoreTeacherRequest{Id: id, RestoredBy: restoredBy})
 	if err != nil {
 		return nil, err
 	}
@@ -620,3 +653,7 @@ func (u *GRPCUser) GetTeachersByEmails(ctx context.Context, emails []string) (*p
 
 	return result, nil
 }
+
+func (u *GRPCUser) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
+	return u.client.SearchUsers(ctx, req)
+}
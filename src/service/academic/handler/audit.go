@@ -0,0 +1,12 @@
+package handler
+
+import "thaily/src/pkg/audit"
+
+// Audited maps the entities named in the academic RPCs to their tables
+func Audited() []audit.Entity {
+	return []audit.Entity{
+		{Name: "Semester", Table: "Semester"},
+		{Name: "Faculty", Table: "Faculty"},
+		{Name: "Major", Table: "Major"},
+	}
+}
@@ -0,0 +1,8 @@
+package handler
+
+import "thaily/src/pkg/migrate"
+
+// EnumColumns lists the ENUM columns of the academic tables, which have none
+func EnumColumns() []migrate.EnumColumn {
+	return nil
+}
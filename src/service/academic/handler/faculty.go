@@ -66,14 +66,17 @@ func (h *Handler) GetFaculty(ctx context.Context, req *pb.GetFacultyRequest) (*p
 	}
 
 	query := `
-		SELECT id, title, created_at, updated_at, created_by, updated_by
+		SELECT id, title, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM Faculty
 		WHERE id = ?
 	`
+	if !req.IncludeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
 
 	var entity pb.Faculty
-	var createdAt, updatedAt sql.NullTime
-	var updatedBy sql.NullString
+	var createdAt, updatedAt, deletedAt sql.NullTime
+	var updatedBy, deletedBy sql.NullString
 
 	err := h.queryRow(ctx, query, req.Id).Scan(
 		&entity.Id,
@@ -82,6 +85,9 @@ func (h *Handler) GetFaculty(ctx context.Context, req *pb.GetFacultyRequest) (*p
 		&updatedAt,
 		&entity.CreatedBy,
 		&updatedBy,
+		&entity.Version,
+		&deletedAt,
+		&deletedBy,
 	)
 
 	if err != nil {
@@ -100,6 +106,10 @@ func (h *Handler) GetFaculty(ctx context.Context, req *pb.GetFacultyRequest) (*p
 	if updatedBy.Valid {
 		entity.UpdatedBy = updatedBy.String
 	}
+	if deletedAt.Valid {
+		entity.DeletedAt = timestamppb.New(deletedAt.Time)
+		entity.DeletedBy = deletedBy.String
+	}
 
 	return &pb.GetFacultyResponse{
 		Faculty: &entity,
@@ -133,19 +143,27 @@ func (h *Handler) UpdateFaculty(ctx context.Context, req *pb.UpdateFacultyReques
 	args = append(args, req.UpdatedBy)
 	updateFields = append(updateFields, "updated_at = NOW()")
 
-	// Add id as last parameter
-	args = append(args, req.Id)
+	// Bump the version; an expected version only matches an unchanged record
+	updateFields = append(updateFields, "version = version + 1")
+	where := helper.WhereVersion(req.Id, req.Version, &args)
 
 	query := fmt.Sprintf(`
 		UPDATE Faculty
 		SET %s
-		WHERE id = ?
-	`, strings.Join(updateFields, ", "))
+		WHERE %s AND deleted_at IS NULL
+	`, strings.Join(updateFields, ", "), where)
 
-	_, err := h.execQuery(ctx, query, args...)
+	updated, err := h.execQuery(ctx, query, args...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update faculty: %v", err)
 	}
+	if n, _ := updated.RowsAffected(); n == 0 {
+		current, err := h.GetFaculty(ctx, &pb.GetFacultyRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		return nil, helper.VersionConflict("faculty", current.GetFaculty(), current.GetFaculty().Version)
+	}
 
 	result, err := h.GetFaculty(ctx, &pb.GetFacultyRequest{Id: req.Id})
 	if err != nil {
@@ -156,7 +174,7 @@ func (h *Handler) UpdateFaculty(ctx context.Context, req *pb.UpdateFacultyReques
 	}, nil
 }
 
-// DeleteFaculty deletes a Faculty by ID
+// DeleteFaculty soft-deletes a Faculty by ID
 func (h *Handler) DeleteFaculty(ctx context.Context, req *pb.DeleteFacultyRequest) (*pb.DeleteFacultyResponse, error) {
 	defer logger.TraceFunction(ctx)()
 
@@ -164,9 +182,10 @@ func (h *Handler) DeleteFaculty(ctx context.Context, req *pb.DeleteFacultyReques
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	query := `DELETE FROM Faculty WHERE id = ?`
+	// Soft delete; the retention job removes the row later
+	query := `UPDATE Faculty SET deleted_at = NOW(), deleted_by = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL`
 
-	result, err := h.execQuery(ctx, query, req.Id)
+	result, err := h.execQuery(ctx, query, req.DeletedBy, req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete faculty: %v", err)
 	}
@@ -185,6 +204,43 @@ func (h *Handler) DeleteFaculty(ctx context.Context, req *pb.DeleteFacultyReques
 	}, nil
 }
 
+// RestoreFaculty brings back a soft-deleted Faculty
+func (h *Handler) RestoreFaculty(ctx context.Context, req *pb.RestoreFacultyRequest) (*pb.RestoreFacultyResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	query := `UPDATE Faculty SET deleted_at = NULL, deleted_by = NULL, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ? AND deleted_at IS NOT NULL`
+
+	result, err := h.execQuery(ctx, query, req.RestoredBy, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore faculty: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		// Unknown ids are NotFound; a live record has nothing to restore
+		if _, err := h.GetFaculty(ctx, &pb.GetFacultyRequest{Id: req.Id}); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, "faculty is not deleted")
+	}
+
+	restored, err := h.GetFaculty(ctx, &pb.GetFacultyRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreFacultyResponse{
+		Faculty: restored.GetFaculty(),
+	}, nil
+}
+
 // ListFaculties lists Facultys with pagination and filtering
 func (h *Handler) ListFaculties(ctx context.Context, req *pb.ListFacultiesRequest) (*pb.ListFacultiesResponse, error) {
 	defer logger.TraceFunction(ctx)()
@@ -192,8 +248,6 @@ func (h *Handler) ListFaculties(ctx context.Context, req *pb.ListFacultiesReques
 	// Default pagination
 	page := int32(1)
 	pageSize := int32(10)
-	sortBy := "created_at"
-	descending := true
 	if req.Search != nil && req.Search.Pagination != nil {
 		if req.Search.Pagination.Page > 0 {
 			page = req.Search.Pagination.Page
@@ -201,48 +255,35 @@ func (h *Handler) ListFaculties(ctx context.Context, req *pb.ListFacultiesReques
 		if req.Search.Pagination.PageSize > 0 {
 			pageSize = req.Search.Pagination.PageSize
 		}
-		if req.Search.Pagination.SortBy != "" {
-			sortBy = req.Search.Pagination.SortBy
-		}
-		descending = req.Search.Pagination.Descending
 	}
 
 	// Calculate offset
 	offset := (page - 1) * pageSize
 
 	// Build WHERE clause from filters
-	whereClause := ""
 	args := []interface{}{}
-	whiteMap := map[string]bool{
-		"id":    true,
-		"title": true,
-	}
-	if req.Search != nil && len(req.Search.Filters) > 0 {
-		whereConditions := []string{}
-		for _, filter := range req.Search.Filters {
-			if filter.GetCondition() != nil {
-				condition := filter.GetCondition()
-				if _, ok := whiteMap[condition.Field]; !ok {
-					continue
-				}
-				whereConditions = append(whereConditions, helper.BuildFilterCondition(condition, &args))
-			}
-		}
-		if len(whereConditions) > 0 {
-			whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
-		}
+	filterFields := helper.FilterFields{
+		"id":         {},
+		"title":      {},
+		"created_at": {Type: helper.FieldTime},
+		"updated_at": {Type: helper.FieldTime},
 	}
+	whereClause, err := helper.BuildWhere(req.Search.GetFilters(), filterFields, &args)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	whereClause = helper.NotDeleted(whereClause, req.IncludeDeleted)
 
 	// Build ORDER BY clause
-	sortDirection := "ASC"
-	if descending {
-		sortDirection = "DESC"
+	orderBy, err := helper.BuildOrderBy(req.Search.GetPagination(), filterFields)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %v", err)
 	}
 
 	// Get total count
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM Faculty %s", whereClause)
 	var total int32
-	err := h.queryRow(ctx, countQuery, args...).Scan(&total)
+	err = h.queryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to count facultys: %v", err)
 	}
@@ -250,12 +291,12 @@ func (h *Handler) ListFaculties(ctx context.Context, req *pb.ListFacultiesReques
 	// Get entities with pagination
 	args = append(args, pageSize, offset)
 	query := fmt.Sprintf(`
-		SELECT id, title, created_at, updated_at, created_by, updated_by
+		SELECT id, title, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM Faculty
 		%s
-		ORDER BY %s %s
+		%s
 		LIMIT ? OFFSET ?
-	`, whereClause, sortBy, sortDirection)
+	`, whereClause, orderBy)
 
 	rows, err := h.query(ctx, query, args...)
 	if err != nil {
@@ -266,8 +307,8 @@ func (h *Handler) ListFaculties(ctx context.Context, req *pb.ListFacultiesReques
 	entities := []*pb.Faculty{}
 	for rows.Next() {
 		var entity pb.Faculty
-		var createdAt, updatedAt sql.NullTime
-		var updatedBy sql.NullString
+		var createdAt, updatedAt, deletedAt sql.NullTime
+		var updatedBy, deletedBy sql.NullString
 
 		err := rows.Scan(
 			&entity.Id,
@@ -276,6 +317,9 @@ func (h *Handler) ListFaculties(ctx context.Context, req *pb.ListFacultiesReques
 			&updatedAt,
 			&entity.CreatedBy,
 			&updatedBy,
+			&entity.Version,
+			&deletedAt,
+			&deletedBy,
 		)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan faculty: %v", err)
@@ -290,6 +334,10 @@ func (h *Handler) ListFaculties(ctx context.Context, req *pb.ListFacultiesReques
 		if updatedBy.Valid {
 			entity.UpdatedBy = updatedBy.String
 		}
+		if deletedAt.Valid {
+			entity.DeletedAt = timestamppb.New(deletedAt.Time)
+			entity.DeletedBy = deletedBy.String
+		}
 
 		entities = append(entities, &entity)
 	}
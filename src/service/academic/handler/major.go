@@ -70,14 +70,17 @@ func (h *Handler) GetMajor(ctx context.Context, req *pb.GetMajorRequest) (*pb.Ge
 	}
 
 	query := `
-		SELECT id, title, faculty_code, created_at, updated_at, created_by, updated_by
+		SELECT id, title, faculty_code, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM Major
 		WHERE id = ?
 	`
+	if !req.IncludeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
 
 	var entity pb.Major
-	var createdAt, updatedAt sql.NullTime
-	var updatedBy sql.NullString
+	var createdAt, updatedAt, deletedAt sql.NullTime
+	var updatedBy, deletedBy sql.NullString
 
 	err := h.queryRow(ctx, query, req.Id).Scan(
 		&entity.Id,
@@ -87,6 +90,9 @@ func (h *Handler) GetMajor(ctx context.Context, req *pb.GetMajorRequest) (*pb.Ge
 		&updatedAt,
 		&entity.CreatedBy,
 		&updatedBy,
+		&entity.Version,
+		&deletedAt,
+		&deletedBy,
 	)
 
 	if err != nil {
@@ -105,6 +111,10 @@ func (h *Handler) GetMajor(ctx context.Context, req *pb.GetMajorRequest) (*pb.Ge
 	if updatedBy.Valid {
 		entity.UpdatedBy = updatedBy.String
 	}
+	if deletedAt.Valid {
+		entity.DeletedAt = timestamppb.New(deletedAt.Time)
+		entity.DeletedBy = deletedBy.String
+	}
 
 	return &pb.GetMajorResponse{
 		Major: &entity,
@@ -143,19 +153,27 @@ func (h *Handler) UpdateMajor(ctx context.Context, req *pb.UpdateMajorRequest) (
 	args = append(args, req.UpdatedBy)
 	updateFields = append(updateFields, "updated_at = NOW()")
 
-	// Add id as last parameter
-	args = append(args, req.Id)
+	// Bump the version; an expected version only matches an unchanged record
+	updateFields = append(updateFields, "version = version + 1")
+	where := helper.WhereVersion(req.Id, req.Version, &args)
 
 	query := fmt.Sprintf(`
 		UPDATE Major
 		SET %s
-		WHERE id = ?
-	`, strings.Join(updateFields, ", "))
+		WHERE %s AND deleted_at IS NULL
+	`, strings.Join(updateFields, ", "), where)
 
-	_, err := h.execQuery(ctx, query, args...)
+	updated, err := h.execQuery(ctx, query, args...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update major: %v", err)
 	}
+	if n, _ := updated.RowsAffected(); n == 0 {
+		current, err := h.GetMajor(ctx, &pb.GetMajorRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		return nil, helper.VersionConflict("major", current.GetMajor(), current.GetMajor().Version)
+	}
 
 	result, err := h.GetMajor(ctx, &pb.GetMajorRequest{Id: req.Id})
 	if err != nil {
@@ -166,7 +184,7 @@ func (h *Handler) UpdateMajor(ctx context.Context, req *pb.UpdateMajorRequest) (
 	}, nil
 }
 
-// DeleteMajor deletes a Major by ID
+// DeleteMajor soft-deletes a Major by ID
 func (h *Handler) DeleteMajor(ctx context.Context, req *pb.DeleteMajorRequest) (*pb.DeleteMajorResponse, error) {
 	defer logger.TraceFunction(ctx)()
 
@@ -174,9 +192,10 @@ func (h *Handler) DeleteMajor(ctx context.Context, req *pb.DeleteMajorRequest) (
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	query := `DELETE FROM Major WHERE id = ?`
+	// Soft delete; the retention job removes the row later
+	query := `UPDATE Major SET deleted_at = NOW(), deleted_by = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL`
 
-	result, err := h.execQuery(ctx, query, req.Id)
+	result, err := h.execQuery(ctx, query, req.DeletedBy, req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete major: %v", err)
 	}
@@ -195,6 +214,43 @@ func (h *Handler) DeleteMajor(ctx context.Context, req *pb.DeleteMajorRequest) (
 	}, nil
 }
 
+// RestoreMajor brings back a soft-deleted Major
+func (h *Handler) RestoreMajor(ctx context.Context, req *pb.RestoreMajorRequest) (*pb.RestoreMajorResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	query := `UPDATE Major SET deleted_at = NULL, deleted_by = NULL, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ? AND deleted_at IS NOT NULL`
+
+	result, err := h.execQuery(ctx, query, req.RestoredBy, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore major: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		// Unknown ids are NotFound; a live record has nothing to restore
+		if _, err := h.GetMajor(ctx, &pb.GetMajorRequest{Id: req.Id}); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, "major is not deleted")
+	}
+
+	restored, err := h.GetMajor(ctx, &pb.GetMajorRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreMajorResponse{
+		Major: restored.GetMajor(),
+	}, nil
+}
+
 // ListMajors lists Majors with pagination and filtering
 func (h *Handler) ListMajors(ctx context.Context, req *pb.ListMajorsRequest) (*pb.ListMajorsResponse, error) {
 	defer logger.TraceFunction(ctx)()
@@ -202,8 +258,6 @@ func (h *Handler) ListMajors(ctx context.Context, req *pb.ListMajorsRequest) (*p
 	// Default pagination
 	page := int32(1)
 	pageSize := int32(10)
-	sortBy := "created_at"
-	descending := true
 	if req.Search != nil && req.Search.Pagination != nil {
 		if req.Search.Pagination.Page > 0 {
 			page = req.Search.Pagination.Page
@@ -211,49 +265,36 @@ func (h *Handler) ListMajors(ctx context.Context, req *pb.ListMajorsRequest) (*p
 		if req.Search.Pagination.PageSize > 0 {
 			pageSize = req.Search.Pagination.PageSize
 		}
-		if req.Search.Pagination.SortBy != "" {
-			sortBy = req.Search.Pagination.SortBy
-		}
-		descending = req.Search.Pagination.Descending
 	}
 
 	// Calculate offset
 	offset := (page - 1) * pageSize
 
 	// Build WHERE clause from filters
-	whereClause := ""
 	args := []interface{}{}
-	whiteMap := map[string]bool{
-		"id":           true,
-		"title":        true,
-		"faculty_code": true,
-	}
-	if req.Search != nil && len(req.Search.Filters) > 0 {
-		whereConditions := []string{}
-		for _, filter := range req.Search.Filters {
-			if filter.GetCondition() != nil {
-				condition := filter.GetCondition()
-				if _, ok := whiteMap[condition.Field]; !ok {
-					continue
-				}
-				whereConditions = append(whereConditions, helper.BuildFilterCondition(condition, &args))
-			}
-		}
-		if len(whereConditions) > 0 {
-			whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
-		}
+	filterFields := helper.FilterFields{
+		"id":           {},
+		"title":        {},
+		"faculty_code": {},
+		"created_at":   {Type: helper.FieldTime},
+		"updated_at":   {Type: helper.FieldTime},
 	}
+	whereClause, err := helper.BuildWhere(req.Search.GetFilters(), filterFields, &args)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	whereClause = helper.NotDeleted(whereClause, req.IncludeDeleted)
 
 	// Build ORDER BY clause
-	sortDirection := "ASC"
-	if descending {
-		sortDirection = "DESC"
+	orderBy, err := helper.BuildOrderBy(req.Search.GetPagination(), filterFields)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %v", err)
 	}
 
 	// Get total count
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM Major %s", whereClause)
 	var total int32
-	err := h.queryRow(ctx, countQuery, args...).Scan(&total)
+	err = h.queryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to count majors: %v", err)
 	}
@@ -261,12 +302,12 @@ func (h *Handler) ListMajors(ctx context.Context, req *pb.ListMajorsRequest) (*p
 	// Get entities with pagination
 	args = append(args, pageSize, offset)
 	query := fmt.Sprintf(`
-		SELECT id, title, faculty_code, created_at, updated_at, created_by, updated_by
+		SELECT id, title, faculty_code, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM Major
 		%s
-		ORDER BY %s %s
+		%s
 		LIMIT ? OFFSET ?
-	`, whereClause, sortBy, sortDirection)
+	`, whereClause, orderBy)
 
 	rows, err := h.query(ctx, query, args...)
 	if err != nil {
@@ -277,8 +318,8 @@ func (h *Handler) ListMajors(ctx context.Context, req *pb.ListMajorsRequest) (*p
 	entities := []*pb.Major{}
 	for rows.Next() {
 		var entity pb.Major
-		var createdAt, updatedAt sql.NullTime
-		var updatedBy sql.NullString
+		var createdAt, updatedAt, deletedAt sql.NullTime
+		var updatedBy, deletedBy sql.NullString
 
 		err := rows.Scan(
 			&entity.Id,
@@ -288,6 +329,9 @@ func (h *Handler) ListMajors(ctx context.Context, req *pb.ListMajorsRequest) (*p
 			&updatedAt,
 			&entity.CreatedBy,
 			&updatedBy,
+			&entity.Version,
+			&deletedAt,
+			&deletedBy,
 		)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan major: %v", err)
@@ -302,6 +346,10 @@ func (h *Handler) ListMajors(ctx context.Context, req *pb.ListMajorsRequest) (*p
 		if updatedBy.Valid {
 			entity.UpdatedBy = updatedBy.String
 		}
+		if deletedAt.Valid {
+			entity.DeletedAt = timestamppb.New(deletedAt.Time)
+			entity.DeletedBy = deletedBy.String
+		}
 
 		entities = append(entities, &entity)
 	}
@@ -0,0 +1,20 @@
+package handler
+
+import (
+	"context"
+
+	pbCommon "thaily/proto/common"
+	"thaily/src/pkg/logger"
+	"thaily/src/pkg/reference"
+)
+
+// referencedTables are the academic tables other services point at
+var referencedTables = []string{"Major", "Semester"}
+
+// CheckReferences tells another service which ids of one of our tables do
+// not exist, replacing the foreign keys across services
+func (h *Handler) CheckReferences(ctx context.Context, req *pbCommon.ReferenceCheckRequest) (*pbCommon.ReferenceCheckResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	return reference.Check(ctx, h.db, referencedTables, req)
+}
@@ -0,0 +1,13 @@
+package handler
+
+import "thaily/src/pkg/retention"
+
+// SoftDeleted lists the academic tables purged by the retention job, majors
+// before the faculties they belong to
+func SoftDeleted() []retention.Table {
+	return []retention.Table{
+		{Name: "Major"},
+		{Name: "Semester"},
+		{Name: "Faculty"},
+	}
+}
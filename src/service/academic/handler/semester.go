@@ -66,14 +66,17 @@ func (h *Handler) GetSemester(ctx context.Context, req *pb.GetSemesterRequest) (
 	}
 
 	query := `
-		SELECT id, title, created_at, updated_at, created_by, updated_by
+		SELECT id, title, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM Semester
 		WHERE id = ?
 	`
+	if !req.IncludeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
 
 	var entity pb.Semester
-	var createdAt, updatedAt sql.NullTime
-	var updatedBy sql.NullString
+	var createdAt, updatedAt, deletedAt sql.NullTime
+	var updatedBy, deletedBy sql.NullString
 
 	err := h.queryRow(ctx, query, req.Id).Scan(
 		&entity.Id,
@@ -82,6 +85,9 @@ func (h *Handler) GetSemester(ctx context.Context, req *pb.GetSemesterRequest) (
 		&updatedAt,
 		&entity.CreatedBy,
 		&updatedBy,
+		&entity.Version,
+		&deletedAt,
+		&deletedBy,
 	)
 
 	if err != nil {
@@ -100,6 +106,10 @@ func (h *Handler) GetSemester(ctx context.Context, req *pb.GetSemesterRequest) (
 	if updatedBy.Valid {
 		entity.UpdatedBy = updatedBy.String
 	}
+	if deletedAt.Valid {
+		entity.DeletedAt = timestamppb.New(deletedAt.Time)
+		entity.DeletedBy = deletedBy.String
+	}
 
 	return &pb.GetSemesterResponse{
 		Semester: &entity,
@@ -133,19 +143,27 @@ func (h *Handler) UpdateSemester(ctx context.Context, req *pb.UpdateSemesterRequ
 	args = append(args, req.UpdatedBy)
 	updateFields = append(updateFields, "updated_at = NOW()")
 
-	// Add id as last parameter
-	args = append(args, req.Id)
+	// Bump the version; an expected version only matches an unchanged record
+	updateFields = append(updateFields, "version = version + 1")
+	where := helper.WhereVersion(req.Id, req.Version, &args)
 
 	query := fmt.Sprintf(`
 		UPDATE Semester
 		SET %s
-		WHERE id = ?
-	`, strings.Join(updateFields, ", "))
+		WHERE %s AND deleted_at IS NULL
+	`, strings.Join(updateFields, ", "), where)
 
-	_, err := h.execQuery(ctx, query, args...)
+	updated, err := h.execQuery(ctx, query, args...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update semester: %v", err)
 	}
+	if n, _ := updated.RowsAffected(); n == 0 {
+		current, err := h.GetSemester(ctx, &pb.GetSemesterRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		return nil, helper.VersionConflict("semester", current.GetSemester(), current.GetSemester().Version)
+	}
 
 	result, err := h.GetSemester(ctx, &pb.GetSemesterRequest{Id: req.Id})
 	if err != nil {
@@ -156,7 +174,7 @@ func (h *Handler) UpdateSemester(ctx context.Context, req *pb.UpdateSemesterRequ
 	}, nil
 }
 
-// DeleteSemester deletes a Semester by ID
+// DeleteSemester soft-deletes a Semester by ID
 func (h *Handler) DeleteSemester(ctx context.Context, req *pb.DeleteSemesterRequest) (*pb.DeleteSemesterResponse, error) {
 	defer logger.TraceFunction(ctx)()
 
@@ -164,9 +182,10 @@ func (h *Handler) DeleteSemester(ctx context.Context, req *pb.DeleteSemesterRequ
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	query := `DELETE FROM Semester WHERE id = ?`
+	// Soft delete; the retention job removes the row later
+	query := `UPDATE Semester SET deleted_at = NOW(), deleted_by = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL`
 
-	result, err := h.execQuery(ctx, query, req.Id)
+	result, err := h.execQuery(ctx, query, req.DeletedBy, req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete semester: %v", err)
 	}
@@ -185,6 +204,43 @@ func (h *Handler) DeleteSemester(ctx context.Context, req *pb.DeleteSemesterRequ
 	}, nil
 }
 
+// RestoreSemester brings back a soft-deleted Semester
+func (h *Handler) RestoreSemester(ctx context.Context, req *pb.RestoreSemesterRequest) (*pb.RestoreSemesterResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	query := `UPDATE Semester SET deleted_at = NULL, deleted_by = NULL, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ? AND deleted_at IS NOT NULL`
+
+	result, err := h.execQuery(ctx, query, req.RestoredBy, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore semester: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		// Unknown ids are NotFound; a live record has nothing to restore
+		if _, err := h.GetSemester(ctx, &pb.GetSemesterRequest{Id: req.Id}); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, "semester is not deleted")
+	}
+
+	restored, err := h.GetSemester(ctx, &pb.GetSemesterRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreSemesterResponse{
+		Semester: restored.GetSemester(),
+	}, nil
+}
+
 // ListSemesters lists Semesters with pagination and filtering
 func (h *Handler) ListSemesters(ctx context.Context, req *pb.ListSemestersRequest) (*pb.ListSemestersResponse, error) {
 	defer logger.TraceFunction(ctx)()
@@ -192,8 +248,6 @@ func (h *Handler) ListSemesters(ctx context.Context, req *pb.ListSemestersReques
 	// Default pagination
 	page := int32(1)
 	pageSize := int32(10)
-	sortBy := "created_at"
-	descending := true
 	if req.Search != nil && req.Search.Pagination != nil {
 		if req.Search.Pagination.Page > 0 {
 			page = req.Search.Pagination.Page
@@ -201,48 +255,35 @@ func (h *Handler) ListSemesters(ctx context.Context, req *pb.ListSemestersReques
 		if req.Search.Pagination.PageSize > 0 {
 			pageSize = req.Search.Pagination.PageSize
 		}
-		if req.Search.Pagination.SortBy != "" {
-			sortBy = req.Search.Pagination.SortBy
-		}
-		descending = req.Search.Pagination.Descending
 	}
 
 	// Calculate offset
 	offset := (page - 1) * pageSize
 
 	// Build WHERE clause from filters
-	whereClause := ""
 	args := []interface{}{}
-	whiteMap := map[string]bool{
-		"id":    true,
-		"title": true,
-	}
-	if req.Search != nil && len(req.Search.Filters) > 0 {
-		whereConditions := []string{}
-		for _, filter := range req.Search.Filters {
-			if filter.GetCondition() != nil {
-				condition := filter.GetCondition()
-				if _, ok := whiteMap[condition.Field]; !ok {
-					continue
-				}
-				whereConditions = append(whereConditions, helper.BuildFilterCondition(condition, &args))
-			}
-		}
-		if len(whereConditions) > 0 {
-			whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
-		}
+	filterFields := helper.FilterFields{
+		"id":         {},
+		"title":      {},
+		"created_at": {Type: helper.FieldTime},
+		"updated_at": {Type: helper.FieldTime},
 	}
+	whereClause, err := helper.BuildWhere(req.Search.GetFilters(), filterFields, &args)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	whereClause = helper.NotDeleted(whereClause, req.IncludeDeleted)
 
 	// Build ORDER BY clause
-	sortDirection := "ASC"
-	if descending {
-		sortDirection = "DESC"
+	orderBy, err := helper.BuildOrderBy(req.Search.GetPagination(), filterFields)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %v", err)
 	}
 
 	// Get total count
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM Semester %s", whereClause)
 	var total int32
-	err := h.queryRow(ctx, countQuery, args...).Scan(&total)
+	err = h.queryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to count semesters: %v", err)
 	}
@@ -250,12 +291,12 @@ func (h *Handler) ListSemesters(ctx context.Context, req *pb.ListSemestersReques
 	// Get entities with pagination
 	args = append(args, pageSize, offset)
 	query := fmt.Sprintf(`
-		SELECT id, title, created_at, updated_at, created_by, updated_by
+		SELECT id, title, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM Semester
 		%s
-		ORDER BY %s %s
+		%s
 		LIMIT ? OFFSET ?
-	`, whereClause, sortBy, sortDirection)
+	`, whereClause, orderBy)
 
 	rows, err := h.query(ctx, query, args...)
 	if err != nil {
@@ -266,8 +307,8 @@ func (h *Handler) ListSemesters(ctx context.Context, req *pb.ListSemestersReques
 	entities := []*pb.Semester{}
 	for rows.Next() {
 		var entity pb.Semester
-		var createdAt, updatedAt sql.NullTime
-		var updatedBy sql.NullString
+		var createdAt, updatedAt, deletedAt sql.NullTime
+		var updatedBy, deletedBy sql.NullString
 
 		err := rows.Scan(
 			&entity.Id,
@@ -276,6 +317,9 @@ func (h *Handler) ListSemesters(ctx context.Context, req *pb.ListSemestersReques
 			&updatedAt,
 			&entity.CreatedBy,
 			&updatedBy,
+			&entity.Version,
+			&deletedAt,
+			&deletedBy,
 		)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan semester: %v", err)
@@ -290,6 +334,10 @@ func (h *Handler) ListSemesters(ctx context.Context, req *pb.ListSemestersReques
 		if updatedBy.Valid {
 			entity.UpdatedBy = updatedBy.String
 		}
+		if deletedAt.Valid {
+			entity.DeletedAt = timestamppb.New(deletedAt.Time)
+			entity.DeletedBy = deletedBy.String
+		}
 
 		entities = append(entities, &entity)
 	}
@@ -0,0 +1,7 @@
+SET FOREIGN_KEY_CHECKS = 0;
+
+DROP TABLE IF EXISTS `Major`;
+DROP TABLE IF EXISTS `Faculty`;
+DROP TABLE IF EXISTS `Semester`;
+
+SET FOREIGN_KEY_CHECKS = 1;
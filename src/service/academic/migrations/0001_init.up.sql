@@ -0,0 +1,31 @@
+-- Initial academic service schema
+
+CREATE TABLE `Semester` (
+  `id` varchar(255) PRIMARY KEY,
+  `title` varchar(255) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL
+);
+
+CREATE TABLE `Faculty` (
+  `id` varchar(255) PRIMARY KEY,
+  `title` varchar(255) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL
+);
+
+CREATE TABLE `Major` (
+  `id` varchar(255) PRIMARY KEY,
+  `title` varchar(255) NOT NULL,
+  `faculty_code` varchar(255) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL
+);
+
+ALTER TABLE `Major` ADD FOREIGN KEY (`faculty_code`) REFERENCES `Faculty` (`id`);
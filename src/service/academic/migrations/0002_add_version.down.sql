@@ -0,0 +1,3 @@
+ALTER TABLE `Major` DROP COLUMN `version`;
+ALTER TABLE `Faculty` DROP COLUMN `version`;
+ALTER TABLE `Semester` DROP COLUMN `version`;
@@ -0,0 +1,6 @@
+-- Optimistic concurrency: every update bumps `version`, and an update that
+-- carries the expected version only applies to a record still at it
+
+ALTER TABLE `Semester` ADD COLUMN `version` INT NOT NULL DEFAULT 1;
+ALTER TABLE `Faculty` ADD COLUMN `version` INT NOT NULL DEFAULT 1;
+ALTER TABLE `Major` ADD COLUMN `version` INT NOT NULL DEFAULT 1;
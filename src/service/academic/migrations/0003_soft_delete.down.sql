@@ -0,0 +1,3 @@
+ALTER TABLE `Major` DROP KEY `idx_major_deleted_at`, DROP COLUMN `deleted_by`, DROP COLUMN `deleted_at`;
+ALTER TABLE `Faculty` DROP KEY `idx_faculty_deleted_at`, DROP COLUMN `deleted_by`, DROP COLUMN `deleted_at`;
+ALTER TABLE `Semester` DROP KEY `idx_semester_deleted_at`, DROP COLUMN `deleted_by`, DROP COLUMN `deleted_at`;
@@ -0,0 +1,15 @@
+package handler
+
+import "thaily/src/pkg/audit"
+
+// Audited maps the entities named in the council RPCs to their tables
+func Audited() []audit.Entity {
+	return []audit.Entity{
+		{Name: "Council", Table: "Council"},
+		{Name: "Defence", Table: "Defence"},
+		{Name: "GradeDefence", Table: "Grade_defence"},
+		{Name: "GradeDefenceCriterion", Table: "Grade_defence_criterion"},
+		{Name: "GradeDefenceAmendment", Table: "Grade_defence_amendment"},
+		{Name: "RubricTemplate", Table: "Rubric_template"},
+	}
+}
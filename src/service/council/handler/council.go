@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	pb "thaily/proto/council"
+	"thaily/src/pkg/audit"
 	"thaily/src/pkg/helper"
 	"thaily/src/pkg/logger"
 
@@ -30,15 +31,31 @@ func (h *Handler) CreateCouncil(ctx context.Context, req *pb.CreateCouncilReques
 		return nil, status.Error(codes.InvalidArgument, "semester_code is required")
 	}
 
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "major_code", "Major", req.MajorCode); err != nil {
+		return nil, err
+	}
+	if err := h.refs.Require(ctx, "semester_code", "Semester", req.SemesterCode); err != nil {
+		return nil, err
+	}
+
 	// Generate UUID
 	id := uuid.New().String()
 
 	// Prepare fields
+	var TimeStart sql.NullTime
+	if req.TimeStart != nil {
+		TimeStart = sql.NullTime{Time: req.TimeStart.AsTime(), Valid: true}
+	}
+	var Room sql.NullString
+	if req.Room != nil {
+		Room = sql.NullString{String: *req.Room, Valid: true}
+	}
 
 	// Insert into database
 	query := `
-		INSERT INTO Council (id, title, major_code, semester_code, created_by, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, NOW(), NOW())
+		INSERT INTO Council (id, title, major_code, semester_code, time_start, room, created_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
 	`
 
 	_, err := h.execQuery(ctx, query,
@@ -46,6 +63,8 @@ func (h *Handler) CreateCouncil(ctx context.Context, req *pb.CreateCouncilReques
 		req.Title,
 		req.MajorCode,
 		req.SemesterCode,
+		TimeStart,
+		Room,
 		req.CreatedBy,
 	)
 
@@ -74,24 +93,38 @@ func (h *Handler) GetCouncil(ctx context.Context, req *pb.GetCouncilRequest) (*p
 	}
 
 	query := `
-		SELECT id, title, major_code, semester_code, created_at, updated_at, created_by, updated_by
+		SELECT id, title, major_code, semester_code, time_start, room, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by,
+			grades_locked_at, grades_locked_by, grades_published_at, grades_published_by
 		FROM Council
 		WHERE id = ?
 	`
+	if !req.IncludeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
 
 	var entity pb.Council
-	var createdAt, updatedAt sql.NullTime
-	var updatedBy sql.NullString
+	var timeStart, createdAt, updatedAt, deletedAt sql.NullTime
+	var room, updatedBy, deletedBy sql.NullString
+	var grades councilGradeState
 
 	err := h.queryRow(ctx, query, req.Id).Scan(
 		&entity.Id,
 		&entity.Title,
 		&entity.MajorCode,
 		&entity.SemesterCode,
+		&timeStart,
+		&room,
 		&createdAt,
 		&updatedAt,
 		&entity.CreatedBy,
 		&updatedBy,
+		&entity.Version,
+		&deletedAt,
+		&deletedBy,
+		&grades.lockedAt,
+		&grades.lockedBy,
+		&grades.publishedAt,
+		&grades.publishedBy,
 	)
 
 	if err != nil {
@@ -101,6 +134,10 @@ func (h *Handler) GetCouncil(ctx context.Context, req *pb.GetCouncilRequest) (*p
 		return nil, status.Errorf(codes.Internal, "failed to get council: %v", err)
 	}
 
+	if timeStart.Valid {
+		entity.TimeStart = timestamppb.New(timeStart.Time)
+	}
+	entity.Room = room.String
 	if createdAt.Valid {
 		entity.CreatedAt = timestamppb.New(createdAt.Time)
 	}
@@ -110,6 +147,11 @@ func (h *Handler) GetCouncil(ctx context.Context, req *pb.GetCouncilRequest) (*p
 	if updatedBy.Valid {
 		entity.UpdatedBy = updatedBy.String
 	}
+	if deletedAt.Valid {
+		entity.DeletedAt = timestamppb.New(deletedAt.Time)
+		entity.DeletedBy = deletedBy.String
+	}
+	grades.apply(&entity)
 
 	return &pb.GetCouncilResponse{
 		Council: &entity,
@@ -143,6 +185,24 @@ func (h *Handler) UpdateCouncil(ctx context.Context, req *pb.UpdateCouncilReques
 		args = append(args, *req.SemesterCode)
 
 	}
+	if req.TimeStart != nil {
+		updateFields = append(updateFields, "time_start = ?")
+		args = append(args, req.TimeStart.AsTime())
+
+	}
+	if req.Room != nil {
+		updateFields = append(updateFields, "room = ?")
+		args = append(args, *req.Room)
+
+	}
+
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "major_code", "Major", req.GetMajorCode()); err != nil {
+		return nil, err
+	}
+	if err := h.refs.Require(ctx, "semester_code", "Semester", req.GetSemesterCode()); err != nil {
+		return nil, err
+	}
 
 	if len(updateFields) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "no fields to update")
@@ -153,19 +213,27 @@ func (h *Handler) UpdateCouncil(ctx context.Context, req *pb.UpdateCouncilReques
 	args = append(args, req.UpdatedBy)
 	updateFields = append(updateFields, "updated_at = NOW()")
 
-	// Add id as last parameter
-	args = append(args, req.Id)
+	// Bump the version; an expected version only matches an unchanged record
+	updateFields = append(updateFields, "version = version + 1")
+	where := helper.WhereVersion(req.Id, req.Version, &args)
 
 	query := fmt.Sprintf(`
 		UPDATE Council
 		SET %s
-		WHERE id = ?
-	`, strings.Join(updateFields, ", "))
+		WHERE %s AND deleted_at IS NULL
+	`, strings.Join(updateFields, ", "), where)
 
-	_, err := h.execQuery(ctx, query, args...)
+	updated, err := h.execQuery(ctx, query, args...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update council: %v", err)
 	}
+	if n, _ := updated.RowsAffected(); n == 0 {
+		current, err := h.GetCouncil(ctx, &pb.GetCouncilRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		return nil, helper.VersionConflict("council", current.GetCouncil(), current.GetCouncil().Version)
+	}
 
 	result, err := h.GetCouncil(ctx, &pb.GetCouncilRequest{Id: req.Id})
 	if err != nil {
@@ -176,7 +244,64 @@ func (h *Handler) UpdateCouncil(ctx context.Context, req *pb.UpdateCouncilReques
 	}, nil
 }
 
-// DeleteCouncil deletes a Council by ID
+// ScheduleCouncils writes the room and time of every council of a timetable
+// in one transaction, so a failing one leaves all unchanged
+func (h *Handler) ScheduleCouncils(ctx context.Context, req *pb.ScheduleCouncilsRequest) (*pb.ScheduleCouncilsResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	for _, slot := range req.Councils {
+		if slot.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, "every slot needs an id")
+		}
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	for _, slot := range req.Councils {
+		timeStart := sql.NullTime{}
+		if slot.TimeStart != nil {
+			timeStart = sql.NullTime{Time: slot.TimeStart.AsTime(), Valid: true}
+		}
+		args := []interface{}{slot.Room, timeStart, req.UpdatedBy}
+		where := helper.WhereVersion(slot.Id, slot.Version, &args)
+
+		updated, err := tx.ExecContext(ctx, `
+			UPDATE Council
+			SET room = ?, time_start = ?, updated_by = ?, updated_at = NOW(), version = version + 1
+			WHERE `+where+` AND deleted_at IS NULL
+		`, args...)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update council: %v", err)
+		}
+		if n, _ := updated.RowsAffected(); n == 0 {
+			tx.Rollback()
+			current, err := h.GetCouncil(ctx, &pb.GetCouncilRequest{Id: slot.Id})
+			if err != nil {
+				return nil, err
+			}
+			return nil, helper.VersionConflict("council", current.GetCouncil(), current.GetCouncil().Version)
+		}
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	resp := &pb.ScheduleCouncilsResponse{}
+	for _, slot := range req.Councils {
+		result, err := h.GetCouncil(ctx, &pb.GetCouncilRequest{Id: slot.Id})
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to get council")
+		}
+		resp.Councils = append(resp.Councils, result.GetCouncil())
+	}
+	return resp, nil
+}
+
+// DeleteCouncil soft-deletes a Council by ID
 func (h *Handler) DeleteCouncil(ctx context.Context, req *pb.DeleteCouncilRequest) (*pb.DeleteCouncilResponse, error) {
 	defer logger.TraceFunction(ctx)()
 
@@ -184,9 +309,10 @@ func (h *Handler) DeleteCouncil(ctx context.Context, req *pb.DeleteCouncilReques
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	query := `DELETE FROM Council WHERE id = ?`
+	// Soft delete; the retention job removes the row later
+	query := `UPDATE Council SET deleted_at = NOW(), deleted_by = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL`
 
-	result, err := h.execQuery(ctx, query, req.Id)
+	result, err := h.execQuery(ctx, query, req.DeletedBy, req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete council: %v", err)
 	}
@@ -205,6 +331,43 @@ func (h *Handler) DeleteCouncil(ctx context.Context, req *pb.DeleteCouncilReques
 	}, nil
 }
 
+// RestoreCouncil brings back a soft-deleted Council
+func (h *Handler) RestoreCouncil(ctx context.Context, req *pb.RestoreCouncilRequest) (*pb.RestoreCouncilResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	query := `UPDATE Council SET deleted_at = NULL, deleted_by = NULL, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ? AND deleted_at IS NOT NULL`
+
+	result, err := h.execQuery(ctx, query, req.RestoredBy, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore council: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		// Unknown ids are NotFound; a live record has nothing to restore
+		if _, err := h.GetCouncil(ctx, &pb.GetCouncilRequest{Id: req.Id}); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, "council is not deleted")
+	}
+
+	restored, err := h.GetCouncil(ctx, &pb.GetCouncilRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreCouncilResponse{
+		Council: restored.GetCouncil(),
+	}, nil
+}
+
 // ListCouncils lists Councils with pagination and filtering
 func (h *Handler) ListCouncils(ctx context.Context, req *pb.ListCouncilsRequest) (*pb.ListCouncilsResponse, error) {
 	defer logger.TraceFunction(ctx)()
@@ -212,8 +375,6 @@ func (h *Handler) ListCouncils(ctx context.Context, req *pb.ListCouncilsRequest)
 	// Default pagination
 	page := int32(1)
 	pageSize := int32(10)
-	sortBy := "created_at"
-	descending := true
 	if req.Search != nil && req.Search.Pagination != nil {
 		if req.Search.Pagination.Page > 0 {
 			page = req.Search.Pagination.Page
@@ -221,50 +382,38 @@ func (h *Handler) ListCouncils(ctx context.Context, req *pb.ListCouncilsRequest)
 		if req.Search.Pagination.PageSize > 0 {
 			pageSize = req.Search.Pagination.PageSize
 		}
-		if req.Search.Pagination.SortBy != "" {
-			sortBy = req.Search.Pagination.SortBy
-		}
-		descending = req.Search.Pagination.Descending
 	}
 
 	// Calculate offset
 	offset := (page - 1) * pageSize
 
 	// Build WHERE clause from filters
-	whereClause := ""
 	args := []interface{}{}
-	whiteMap := map[string]bool{
-		"id":            true,
-		"title":         true,
-		"major_code":    true,
-		"semester_code": true,
-	}
-	if req.Search != nil && len(req.Search.Filters) > 0 {
-		whereConditions := []string{}
-		for _, filter := range req.Search.Filters {
-			if filter.GetCondition() != nil {
-				condition := filter.GetCondition()
-				if _, ok := whiteMap[condition.Field]; !ok {
-					continue
-				}
-				whereConditions = append(whereConditions, helper.BuildFilterCondition(condition, &args))
-			}
-		}
-		if len(whereConditions) > 0 {
-			whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
-		}
+	filterFields := helper.FilterFields{
+		"id":            {},
+		"title":         {},
+		"major_code":    {},
+		"semester_code": {},
+		"time_start":    {Type: helper.FieldTime},
+		"created_at":    {Type: helper.FieldTime},
+		"updated_at":    {Type: helper.FieldTime},
+	}
+	whereClause, err := helper.BuildWhere(req.Search.GetFilters(), filterFields, &args)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
+	whereClause = helper.NotDeleted(whereClause, req.IncludeDeleted)
 
 	// Build ORDER BY clause
-	sortDirection := "ASC"
-	if descending {
-		sortDirection = "DESC"
+	orderBy, err := helper.BuildOrderBy(req.Search.GetPagination(), filterFields)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %v", err)
 	}
 
 	// Get total count
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM Council %s", whereClause)
 	var total int32
-	err := h.queryRow(ctx, countQuery, args...).Scan(&total)
+	err = h.queryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to count councils: %v", err)
 	}
@@ -272,12 +421,13 @@ func (h *Handler) ListCouncils(ctx context.Context, req *pb.ListCouncilsRequest)
 	// Get entities with pagination
 	args = append(args, pageSize, offset)
 	query := fmt.Sprintf(`
-		SELECT id, title, major_code, semester_code, created_at, updated_at, created_by, updated_by
+		SELECT id, title, major_code, semester_code, time_start, room, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by,
+			grades_locked_at, grades_locked_by, grades_published_at, grades_published_by
 		FROM Council
 		%s
-		ORDER BY %s %s
+		%s
 		LIMIT ? OFFSET ?
-	`, whereClause, sortBy, sortDirection)
+	`, whereClause, orderBy)
 
 	rows, err := h.query(ctx, query, args...)
 	if err != nil {
@@ -288,23 +438,37 @@ func (h *Handler) ListCouncils(ctx context.Context, req *pb.ListCouncilsRequest)
 	entities := []*pb.Council{}
 	for rows.Next() {
 		var entity pb.Council
-		var createdAt, updatedAt sql.NullTime
-		var updatedBy sql.NullString
+		var timeStart, createdAt, updatedAt, deletedAt sql.NullTime
+		var room, updatedBy, deletedBy sql.NullString
+		var grades councilGradeState
 
 		err := rows.Scan(
 			&entity.Id,
 			&entity.Title,
 			&entity.MajorCode,
 			&entity.SemesterCode,
+			&timeStart,
+			&room,
 			&createdAt,
 			&updatedAt,
 			&entity.CreatedBy,
 			&updatedBy,
+			&entity.Version,
+			&deletedAt,
+			&deletedBy,
+			&grades.lockedAt,
+			&grades.lockedBy,
+			&grades.publishedAt,
+			&grades.publishedBy,
 		)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan council: %v", err)
 		}
 
+		if timeStart.Valid {
+			entity.TimeStart = timestamppb.New(timeStart.Time)
+		}
+		entity.Room = room.String
 		if createdAt.Valid {
 			entity.CreatedAt = timestamppb.New(createdAt.Time)
 		}
@@ -314,6 +478,11 @@ func (h *Handler) ListCouncils(ctx context.Context, req *pb.ListCouncilsRequest)
 		if updatedBy.Valid {
 			entity.UpdatedBy = updatedBy.String
 		}
+		if deletedAt.Valid {
+			entity.DeletedAt = timestamppb.New(deletedAt.Time)
+			entity.DeletedBy = deletedBy.String
+		}
+		grades.apply(&entity)
 
 		entities = append(entities, &entity)
 	}
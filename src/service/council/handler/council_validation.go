@@ -0,0 +1,533 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	pb "thaily/proto/council"
+	pbThesis "thaily/proto/thesis"
+	"thaily/src/pkg/audit"
+	"thaily/src/pkg/logger"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// sameMajorOnlyEnv makes members from another major than the council's a
+// conflict when set to "true"
+const sameMajorOnlyEnv = "COUNCIL_SAME_MAJOR_ONLY"
+
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+func conflictKindToString(k pb.CouncilConflictKind) string {
+	switch k {
+	case pb.CouncilConflictKind_REVIEWER_IS_SUPERVISOR:
+		return "reviewer_is_supervisor"
+	case pb.CouncilConflictKind_DUPLICATE_POSITION:
+		return "duplicate_position"
+	case pb.CouncilConflictKind_DUPLICATE_MEMBER:
+		return "duplicate_member"
+	case pb.CouncilConflictKind_MISSING_PRESIDENT:
+		return "missing_president"
+	case pb.CouncilConflictKind_MISSING_SECRETARY:
+		return "missing_secretary"
+	case pb.CouncilConflictKind_CROSS_MAJOR_MEMBER:
+		return "cross_major_member"
+	default:
+		return "supervisor_on_council"
+	}
+}
+
+func conflictKindFromString(s string) pb.CouncilConflictKind {
+	switch s {
+	case "reviewer_is_supervisor":
+		return pb.CouncilConflictKind_REVIEWER_IS_SUPERVISOR
+	case "duplicate_position":
+		return pb.CouncilConflictKind_DUPLICATE_POSITION
+	case "duplicate_member":
+		return pb.CouncilConflictKind_DUPLICATE_MEMBER
+	case "missing_president":
+		return pb.CouncilConflictKind_MISSING_PRESIDENT
+	case "missing_secretary":
+		return pb.CouncilConflictKind_MISSING_SECRETARY
+	case "cross_major_member":
+		return pb.CouncilConflictKind_CROSS_MAJOR_MEMBER
+	default:
+		return pb.CouncilConflictKind_SUPERVISOR_ON_COUNCIL
+	}
+}
+
+func defencePositionToString(p pb.DefencePosition) string {
+	switch p {
+	case pb.DefencePosition_SECRETARY:
+		return "secretary"
+	case pb.DefencePosition_REVIEWER:
+		return "reviewer"
+	case pb.DefencePosition_MEMBER:
+		return "member"
+	default:
+		return "president"
+	}
+}
+
+func defencePositionFromString(s string) pb.DefencePosition {
+	switch s {
+	case "secretary":
+		return pb.DefencePosition_SECRETARY
+	case "reviewer":
+		return pb.DefencePosition_REVIEWER
+	case "member":
+		return pb.DefencePosition_MEMBER
+	default:
+		return pb.DefencePosition_PRESIDENT
+	}
+}
+
+type councilMember struct {
+	defenceID   string
+	teacherCode string
+	position    pb.DefencePosition
+}
+
+// conflictKey identifies a conflict across validations so an override keeps applying
+func conflictKey(kind pb.CouncilConflictKind, teacherCode, topicCouncilCode string) string {
+	return conflictKindToString(kind) + "|" + teacherCode + "|" + topicCouncilCode
+}
+
+// loadCouncilMembers returns the council's major and its Defence members
+func loadCouncilMembers(ctx context.Context, q queryer, councilCode string) (string, []councilMember, error) {
+	rows, err := q.QueryContext(ctx, `SELECT major_code FROM Council WHERE id = ? AND deleted_at IS NULL`, councilCode)
+	if err != nil {
+		return "", nil, status.Errorf(codes.Internal, "failed to get council: %v", err)
+	}
+	var majorCode string
+	found := rows.Next()
+	if found {
+		err = rows.Scan(&majorCode)
+	}
+	rows.Close()
+	if err != nil {
+		return "", nil, status.Errorf(codes.Internal, "failed to get council: %v", err)
+	}
+	if !found {
+		return "", nil, status.Error(codes.NotFound, "council not found")
+	}
+
+	rows, err = q.QueryContext(ctx, `
+		SELECT id, teacher_code, position
+		FROM Defence
+		WHERE council_code = ? AND deleted_at IS NULL
+		ORDER BY created_at, id
+	`, councilCode)
+	if err != nil {
+		return "", nil, status.Errorf(codes.Internal, "failed to list defences: %v", err)
+	}
+	defer rows.Close()
+
+	members := []councilMember{}
+	for rows.Next() {
+		var m councilMember
+		var position string
+		if err := rows.Scan(&m.defenceID, &m.teacherCode, &position); err != nil {
+			return "", nil, status.Errorf(codes.Internal, "failed to scan defence: %v", err)
+		}
+		m.position = defencePositionFromString(position)
+		members = append(members, m)
+	}
+	return majorCode, members, rows.Err()
+}
+
+// councilTopics returns the validation context of a council with the
+// supervisors and reviewers read from the thesis service, which owns them,
+// for the topic councils assigned to the council and those of v about to be.
+// Only the topic council codes and member majors of v are used. Without the
+// thesis service configured the topics cannot be checked, so the council is
+// refused rather than passed unchecked.
+func (h *Handler) councilTopics(ctx context.Context, councilCode string, v *pb.CouncilValidationContext) (*pb.CouncilValidationContext, error) {
+	checked := &pb.CouncilValidationContext{MemberMajors: v.GetMemberMajors()}
+	conn := h.refs.Conn("Enrollment")
+	if conn == nil {
+		return nil, status.Error(codes.FailedPrecondition, "the thesis service is not configured (THESIS_SERVICE_ADDR), so the council's topics cannot be checked")
+	}
+
+	extra := []string{}
+	for _, topic := range v.GetTopics() {
+		extra = append(extra, topic.TopicCouncilCode)
+	}
+	resp, err := pbThesis.NewThesisServiceClient(conn).ListCouncilTopics(ctx, &pbThesis.ListCouncilTopicsRequest{
+		CouncilCode:       councilCode,
+		TopicCouncilCodes: extra,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to list the council's topics: %v", err)
+	}
+	for _, topic := range resp.Topics {
+		checked.Topics = append(checked.Topics, &pb.CouncilTopic{
+			TopicCouncilCode: topic.TopicCouncilCode,
+			SupervisorCodes:  topic.SupervisorCodes,
+			ReviewerCodes:    topic.ReviewerCodes,
+		})
+	}
+	return checked, nil
+}
+
+// checkCouncil lists the conflicts of a council's members against the topic
+// councils it judges. Missing positions are only reported when complete is
+// set, since a council being formed lacks them by nature.
+func checkCouncil(majorCode string, members []councilMember, v *pb.CouncilValidationContext, sameMajorOnly, complete bool) []*pb.CouncilConflict {
+	conflicts := []*pb.CouncilConflict{}
+
+	seen := map[string]bool{}
+	holders := map[pb.DefencePosition]string{}
+	for _, m := range members {
+		if seen[m.teacherCode] {
+			conflicts = append(conflicts, &pb.CouncilConflict{
+				Kind:        pb.CouncilConflictKind_DUPLICATE_MEMBER,
+				TeacherCode: m.teacherCode,
+				Message:     fmt.Sprintf("teacher %s holds more than one position on the council", m.teacherCode),
+			})
+		}
+		seen[m.teacherCode] = true
+
+		if m.position != pb.DefencePosition_PRESIDENT && m.position != pb.DefencePosition_SECRETARY {
+			continue
+		}
+		if holder, ok := holders[m.position]; ok {
+			conflicts = append(conflicts, &pb.CouncilConflict{
+				Kind:        pb.CouncilConflictKind_DUPLICATE_POSITION,
+				TeacherCode: m.teacherCode,
+				Message:     fmt.Sprintf("%s is already held by teacher %s", strings.ToLower(m.position.String()), holder),
+			})
+			continue
+		}
+		holders[m.position] = m.teacherCode
+	}
+
+	if complete {
+		if _, ok := holders[pb.DefencePosition_PRESIDENT]; !ok {
+			conflicts = append(conflicts, &pb.CouncilConflict{
+				Kind:    pb.CouncilConflictKind_MISSING_PRESIDENT,
+				Message: "the council has no president",
+			})
+		}
+		if _, ok := holders[pb.DefencePosition_SECRETARY]; !ok {
+			conflicts = append(conflicts, &pb.CouncilConflict{
+				Kind:    pb.CouncilConflictKind_MISSING_SECRETARY,
+				Message: "the council has no secretary",
+			})
+		}
+	}
+
+	if sameMajorOnly {
+		majors := map[string]string{}
+		for _, mm := range v.GetMemberMajors() {
+			majors[mm.TeacherCode] = mm.MajorCode
+		}
+		for _, m := range members {
+			if major, ok := majors[m.teacherCode]; ok && major != majorCode {
+				conflicts = append(conflicts, &pb.CouncilConflict{
+					Kind:        pb.CouncilConflictKind_CROSS_MAJOR_MEMBER,
+					TeacherCode: m.teacherCode,
+					Message:     fmt.Sprintf("teacher %s belongs to major %s, the council to %s", m.teacherCode, major, majorCode),
+				})
+			}
+		}
+	}
+
+	for _, topic := range v.GetTopics() {
+		supervisors := map[string]bool{}
+		for _, s := range topic.SupervisorCodes {
+			supervisors[s] = true
+		}
+		for _, m := range members {
+			if supervisors[m.teacherCode] {
+				conflicts = append(conflicts, &pb.CouncilConflict{
+					Kind:             pb.CouncilConflictKind_SUPERVISOR_ON_COUNCIL,
+					TeacherCode:      m.teacherCode,
+					TopicCouncilCode: topic.TopicCouncilCode,
+					Message:          fmt.Sprintf("teacher %s supervises topic council %s judged by this council", m.teacherCode, topic.TopicCouncilCode),
+				})
+			}
+		}
+		for _, r := range topic.ReviewerCodes {
+			if supervisors[r] {
+				conflicts = append(conflicts, &pb.CouncilConflict{
+					Kind:             pb.CouncilConflictKind_REVIEWER_IS_SUPERVISOR,
+					TeacherCode:      r,
+					TopicCouncilCode: topic.TopicCouncilCode,
+					Message:          fmt.Sprintf("teacher %s both supervises and reviews topic council %s", r, topic.TopicCouncilCode),
+				})
+			}
+		}
+	}
+	return conflicts
+}
+
+// markOverridden flags the conflicts an override was recorded for and
+// reports whether any conflict is left open
+func markOverridden(ctx context.Context, q queryer, councilCode string, conflicts []*pb.CouncilConflict) (bool, error) {
+	if len(conflicts) == 0 {
+		return false, nil
+	}
+	rows, err := q.QueryContext(ctx, `
+		SELECT kind, teacher_code, topic_council_code
+		FROM Council_conflict_override
+		WHERE council_code = ?
+	`, councilCode)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to list overrides: %v", err)
+	}
+	defer rows.Close()
+
+	overridden := map[string]bool{}
+	for rows.Next() {
+		var kind, teacherCode, topicCouncilCode string
+		if err := rows.Scan(&kind, &teacherCode, &topicCouncilCode); err != nil {
+			return false, status.Errorf(codes.Internal, "failed to scan override: %v", err)
+		}
+		overridden[conflictKey(conflictKindFromString(kind), teacherCode, topicCouncilCode)] = true
+	}
+	if err := rows.Err(); err != nil {
+		return false, status.Errorf(codes.Internal, "failed to list overrides: %v", err)
+	}
+
+	open := false
+	for _, c := range conflicts {
+		c.Overridden = overridden[conflictKey(c.Kind, c.TeacherCode, c.TopicCouncilCode)]
+		if !c.Overridden {
+			open = true
+		}
+	}
+	return open, nil
+}
+
+// recordOverrides stores an override for every open conflict and logs it
+func recordOverrides(ctx context.Context, e execer, councilCode string, conflicts []*pb.CouncilConflict, reason, actor string) error {
+	for _, c := range conflicts {
+		if c.Overridden {
+			continue
+		}
+		_, err := e.ExecContext(ctx, `
+			INSERT INTO Council_conflict_override (id, council_code, kind, teacher_code, topic_council_code, message, reason, created_at, created_by)
+			VALUES (?, ?, ?, ?, ?, ?, ?, NOW(), ?)
+			ON DUPLICATE KEY UPDATE message = VALUES(message), reason = VALUES(reason), created_at = NOW(), created_by = VALUES(created_by)
+		`, uuid.New().String(), councilCode, conflictKindToString(c.Kind), c.TeacherCode, c.TopicCouncilCode, c.Message, reason, actor)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to record override: %v", err)
+		}
+		log.Printf("council %s: %s overrode %s (%s): %s", councilCode, actor, conflictKindToString(c.Kind), c.Message, reason)
+		c.Overridden = true
+	}
+	return nil
+}
+
+// admitMember checks the conflicts a member, new or changed, brings to a
+// council: the Defence with the member's defenceID is replaced, or the member
+// added when there is none. Open conflicts block it unless overridden, in
+// which case the overrides are recorded through tx.
+func (h *Handler) admitMember(ctx context.Context, tx *sql.Tx, councilCode string, member councilMember, v *pb.CouncilValidationContext, overrideReason *string, actor string) error {
+	majorCode, members, err := loadCouncilMembers(ctx, tx, councilCode)
+	if err != nil {
+		return err
+	}
+	replaced := false
+	for i, m := range members {
+		if member.defenceID != "" && m.defenceID == member.defenceID {
+			members[i] = member
+			replaced = true
+		}
+	}
+	if !replaced {
+		members = append(members, member)
+	}
+
+	conflicts := []*pb.CouncilConflict{}
+	for _, c := range checkCouncil(majorCode, members, v, h.sameMajorOnly, false) {
+		if c.TeacherCode == member.teacherCode {
+			conflicts = append(conflicts, c)
+		}
+	}
+	open, err := markOverridden(ctx, tx, councilCode, conflicts)
+	if err != nil {
+		return err
+	}
+	if !open {
+		return nil
+	}
+	if overrideReason == nil {
+		return status.Errorf(codes.FailedPrecondition, "conflicts of interest: %s", strings.Join(openConflicts(conflicts), "; "))
+	}
+	return recordOverrides(ctx, tx, councilCode, conflicts, strings.TrimSpace(*overrideReason), actor)
+}
+
+// openConflicts reports the conflicts no override was recorded for
+func openConflicts(conflicts []*pb.CouncilConflict) []string {
+	messages := []string{}
+	for _, c := range conflicts {
+		if !c.Overridden {
+			messages = append(messages, c.Message)
+		}
+	}
+	return messages
+}
+
+// ValidateCouncil reports the conflicts of interest of a council and the
+// topic councils it judges. With an override reason, every open conflict is
+// recorded as overridden and stops blocking CreateDefence and assignments.
+func (h *Handler) ValidateCouncil(ctx context.Context, req *pb.ValidateCouncilRequest) (*pb.ValidateCouncilResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.CouncilCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "council_code is required")
+	}
+	if req.OverrideReason != nil && strings.TrimSpace(*req.OverrideReason) == "" {
+		return nil, status.Error(codes.InvalidArgument, "override_reason must not be empty")
+	}
+
+	validation, err := h.councilTopics(ctx, req.CouncilCode, req.Validation)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	majorCode, members, err := loadCouncilMembers(ctx, tx, req.CouncilCode)
+	if err != nil {
+		return nil, err
+	}
+	conflicts := checkCouncil(majorCode, members, validation, h.sameMajorOnly, true)
+	open, err := markOverridden(ctx, tx, req.CouncilCode, conflicts)
+	if err != nil {
+		return nil, err
+	}
+
+	if open && req.OverrideReason != nil {
+		if err := recordOverrides(ctx, tx, req.CouncilCode, conflicts, strings.TrimSpace(*req.OverrideReason), req.Actor); err != nil {
+			return nil, err
+		}
+		if err := audit.Commit(ctx, tx); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+		}
+		open = false
+	}
+
+	return &pb.ValidateCouncilResponse{
+		Conflicts: conflicts,
+		Valid:     !open,
+	}, nil
+}
+
+// AdmitTopicCouncil checks the conflicts a topic council brings to the council
+// it is being assigned to, leaving those of the rest of the council aside.
+// Open conflicts block it unless overridden. The thesis service calls it last
+// before committing the assignment, so overrides are only recorded for an
+// assignment that is about to land.
+func (h *Handler) AdmitTopicCouncil(ctx context.Context, req *pb.AdmitTopicCouncilRequest) (*pb.AdmitTopicCouncilResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.CouncilCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "council_code is required")
+	}
+	if req.Topic.GetTopicCouncilCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "topic.topic_council_code is required")
+	}
+	if req.OverrideReason != nil && strings.TrimSpace(*req.OverrideReason) == "" {
+		return nil, status.Error(codes.InvalidArgument, "override_reason must not be empty")
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	majorCode, members, err := loadCouncilMembers(ctx, tx, req.CouncilCode)
+	if err != nil {
+		return nil, err
+	}
+	topic := &pb.CouncilValidationContext{Topics: []*pb.CouncilTopic{req.Topic}}
+	conflicts := []*pb.CouncilConflict{}
+	for _, c := range checkCouncil(majorCode, members, topic, false, false) {
+		if c.TopicCouncilCode == req.Topic.TopicCouncilCode {
+			conflicts = append(conflicts, c)
+		}
+	}
+	open, err := markOverridden(ctx, tx, req.CouncilCode, conflicts)
+	if err != nil {
+		return nil, err
+	}
+	if !open {
+		return &pb.AdmitTopicCouncilResponse{Conflicts: conflicts}, nil
+	}
+	if req.OverrideReason == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "conflicts of interest: %s", strings.Join(openConflicts(conflicts), "; "))
+	}
+	if err := recordOverrides(ctx, tx, req.CouncilCode, conflicts, strings.TrimSpace(*req.OverrideReason), req.Actor); err != nil {
+		return nil, err
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+	return &pb.AdmitTopicCouncilResponse{Conflicts: conflicts}, nil
+}
+
+// ListCouncilConflictOverrides lists the overrides recorded for a council
+func (h *Handler) ListCouncilConflictOverrides(ctx context.Context, req *pb.ListCouncilConflictOverridesRequest) (*pb.ListCouncilConflictOverridesResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.CouncilCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "council_code is required")
+	}
+
+	rows, err := h.query(ctx, `
+		SELECT id, council_code, kind, teacher_code, topic_council_code, message, reason, created_at, created_by
+		FROM Council_conflict_override
+		WHERE council_code = ?
+		ORDER BY created_at DESC
+	`, req.CouncilCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list overrides: %v", err)
+	}
+	defer rows.Close()
+
+	overrides := []*pb.CouncilConflictOverride{}
+	for rows.Next() {
+		var o pb.CouncilConflictOverride
+		var kind string
+		var createdAt sql.NullTime
+		err := rows.Scan(&o.Id, &o.CouncilCode, &kind, &o.TeacherCode, &o.TopicCouncilCode, &o.Message, &o.Reason, &createdAt, &o.CreatedBy)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan override: %v", err)
+		}
+		o.Kind = conflictKindFromString(kind)
+		if createdAt.Valid {
+			o.CreatedAt = timestamppb.New(createdAt.Time)
+		}
+		overrides = append(overrides, &o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list overrides: %v", err)
+	}
+
+	return &pb.ListCouncilConflictOverridesResponse{Overrides: overrides}, nil
+}
+
+func sameMajorOnlyFromEnv() bool {
+	return os.Getenv(sameMajorOnlyEnv) == "true"
+}
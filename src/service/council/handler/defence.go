@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	pb "thaily/proto/council"
+	"thaily/src/pkg/audit"
 	"thaily/src/pkg/helper"
 	"thaily/src/pkg/logger"
 
@@ -30,40 +31,54 @@ func (h *Handler) CreateDefence(ctx context.Context, req *pb.CreateDefenceReques
 		return nil, status.Error(codes.InvalidArgument, "teacher_code is required")
 	}
 
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "teacher_code", "Teacher", req.TeacherCode); err != nil {
+		return nil, err
+	}
+
 	// Generate UUID
 	id := uuid.New().String()
 
 	// Prepare fields
 
 	// Convert Position enum to string
-	PositionValue := pb.DefencePosition_PRESIDENT
+	PositionStr := defencePositionToString(req.Position)
+
+	if req.OverrideReason != nil && strings.TrimSpace(*req.OverrideReason) == "" {
+		return nil, status.Error(codes.InvalidArgument, "override_reason must not be empty")
+	}
+
+	validation, err := h.councilTopics(ctx, req.CouncilCode, req.Validation)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
 
-	PositionValue = req.Position
-	PositionStr := "president"
-	switch PositionValue {
-	case pb.DefencePosition_PRESIDENT:
-		PositionStr = "president"
-	case pb.DefencePosition_SECRETARY:
-		PositionStr = "secretary"
-	case pb.DefencePosition_REVIEWER:
-		PositionStr = "reviewer"
-	case pb.DefencePosition_MEMBER:
-		PositionStr = "member"
+	// Conflicts the new member brings to the council block it unless overridden
+	member := councilMember{teacherCode: req.TeacherCode, position: req.Position}
+	if err := h.admitMember(ctx, tx, req.CouncilCode, member, validation, req.OverrideReason, req.CreatedBy); err != nil {
+		return nil, err
 	}
 
 	// Insert into database
 	query := `
-		INSERT INTO Defence (id, title, council_code, teacher_code, position, created_by, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, NOW(), NOW())
+		INSERT INTO Defence (id, title, council_code, teacher_code, position, created_by, updated_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
 	`
 
-	_, err := h.execQuery(ctx, query,
+	_, err = tx.ExecContext(ctx, query,
 		id,
 		req.Title,
 		req.CouncilCode,
 		req.TeacherCode,
 		PositionStr,
 		req.CreatedBy,
+		req.CreatedBy,
 	)
 
 	if err != nil {
@@ -72,6 +87,9 @@ func (h *Handler) CreateDefence(ctx context.Context, req *pb.CreateDefenceReques
 		}
 		return nil, status.Errorf(codes.Internal, "failed to create defence: %v", err)
 	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
 
 	result, err := h.GetDefence(ctx, &pb.GetDefenceRequest{Id: id})
 	if err != nil {
@@ -91,14 +109,17 @@ func (h *Handler) GetDefence(ctx context.Context, req *pb.GetDefenceRequest) (*p
 	}
 
 	query := `
-		SELECT id, title, council_code, teacher_code, position, created_at, updated_at, created_by, updated_by
+		SELECT id, title, council_code, teacher_code, position, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM Defence
 		WHERE id = ?
 	`
+	if !req.IncludeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
 
 	var entity pb.Defence
-	var createdAt, updatedAt sql.NullTime
-	var updatedBy sql.NullString
+	var createdAt, updatedAt, deletedAt sql.NullTime
+	var updatedBy, deletedBy sql.NullString
 	var PositionStr string
 
 	err := h.queryRow(ctx, query, req.Id).Scan(
@@ -111,6 +132,9 @@ func (h *Handler) GetDefence(ctx context.Context, req *pb.GetDefenceRequest) (*p
 		&updatedAt,
 		&entity.CreatedBy,
 		&updatedBy,
+		&entity.Version,
+		&deletedAt,
+		&deletedBy,
 	)
 
 	if err != nil {
@@ -121,18 +145,7 @@ func (h *Handler) GetDefence(ctx context.Context, req *pb.GetDefenceRequest) (*p
 	}
 
 	// Convert Position string to enum
-	switch PositionStr {
-	case "president":
-		entity.Position = pb.DefencePosition_PRESIDENT
-	case "secretary":
-		entity.Position = pb.DefencePosition_SECRETARY
-	case "reviewer":
-		entity.Position = pb.DefencePosition_REVIEWER
-	case "member":
-		entity.Position = pb.DefencePosition_MEMBER
-	default:
-		entity.Position = pb.DefencePosition_PRESIDENT
-	}
+	entity.Position = defencePositionFromString(PositionStr)
 
 	if createdAt.Valid {
 		entity.CreatedAt = timestamppb.New(createdAt.Time)
@@ -143,6 +156,10 @@ func (h *Handler) GetDefence(ctx context.Context, req *pb.GetDefenceRequest) (*p
 	if updatedBy.Valid {
 		entity.UpdatedBy = updatedBy.String
 	}
+	if deletedAt.Valid {
+		entity.DeletedAt = timestamppb.New(deletedAt.Time)
+		entity.DeletedBy = deletedBy.String
+	}
 
 	return &pb.GetDefenceResponse{
 		Defence: &entity,
@@ -178,43 +195,95 @@ func (h *Handler) UpdateDefence(ctx context.Context, req *pb.UpdateDefenceReques
 	}
 	if req.Position != nil {
 		updateFields = append(updateFields, "position = ?")
-		PositionStr := "president"
-		switch *req.Position {
-		case pb.DefencePosition_PRESIDENT:
-			PositionStr = "president"
-		case pb.DefencePosition_SECRETARY:
-			PositionStr = "secretary"
-		case pb.DefencePosition_REVIEWER:
-			PositionStr = "reviewer"
-		case pb.DefencePosition_MEMBER:
-			PositionStr = "member"
-		}
+		PositionStr := defencePositionToString(*req.Position)
 		args = append(args, PositionStr)
 
 	}
 
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "teacher_code", "Teacher", req.GetTeacherCode()); err != nil {
+		return nil, err
+	}
+
 	if len(updateFields) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "no fields to update")
 	}
+	if req.OverrideReason != nil && strings.TrimSpace(*req.OverrideReason) == "" {
+		return nil, status.Error(codes.InvalidArgument, "override_reason must not be empty")
+	}
 
 	// Add updated_by and updated_at
 	updateFields = append(updateFields, "updated_by = ?")
 	args = append(args, req.UpdatedBy)
 	updateFields = append(updateFields, "updated_at = NOW()")
 
-	// Add id as last parameter
-	args = append(args, req.Id)
+	// Bump the version; an expected version only matches an unchanged record
+	updateFields = append(updateFields, "version = version + 1")
+	where := helper.WhereVersion(req.Id, req.Version, &args)
 
 	query := fmt.Sprintf(`
 		UPDATE Defence
 		SET %s
-		WHERE id = ?
-	`, strings.Join(updateFields, ", "))
+		WHERE %s AND deleted_at IS NULL
+	`, strings.Join(updateFields, ", "), where)
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	// A changed council, teacher or position is checked like a new member
+	if req.CouncilCode != nil || req.TeacherCode != nil || req.Position != nil {
+		var member councilMember
+		var councilCode, position string
+		err := tx.QueryRowContext(ctx, `
+			SELECT council_code, teacher_code, position FROM Defence
+			WHERE id = ? AND deleted_at IS NULL
+			FOR UPDATE
+		`, req.Id).Scan(&councilCode, &member.teacherCode, &position)
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "defence not found")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get defence: %v", err)
+		}
+		member.defenceID = req.Id
+		member.position = defencePositionFromString(position)
+		if req.CouncilCode != nil {
+			councilCode = *req.CouncilCode
+		}
+		if req.TeacherCode != nil {
+			member.teacherCode = *req.TeacherCode
+		}
+		if req.Position != nil {
+			member.position = *req.Position
+		}
 
-	_, err := h.execQuery(ctx, query, args...)
+		validation, err := h.councilTopics(ctx, councilCode, req.Validation)
+		if err != nil {
+			return nil, err
+		}
+		if err := h.admitMember(ctx, tx, councilCode, member, validation, req.OverrideReason, req.UpdatedBy); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := tx.ExecContext(ctx, query, args...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update defence: %v", err)
 	}
+	if n, _ := updated.RowsAffected(); n == 0 {
+		tx.Rollback()
+		current, err := h.GetDefence(ctx, &pb.GetDefenceRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		return nil, helper.VersionConflict("defence", current.GetDefence(), current.GetDefence().Version)
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
 
 	result, err := h.GetDefence(ctx, &pb.GetDefenceRequest{Id: req.Id})
 	if err != nil {
@@ -225,7 +294,7 @@ func (h *Handler) UpdateDefence(ctx context.Context, req *pb.UpdateDefenceReques
 	}, nil
 }
 
-// DeleteDefence deletes a Defence by ID
+// DeleteDefence soft-deletes a Defence by ID
 func (h *Handler) DeleteDefence(ctx context.Context, req *pb.DeleteDefenceRequest) (*pb.DeleteDefenceResponse, error) {
 	defer logger.TraceFunction(ctx)()
 
@@ -233,9 +302,10 @@ func (h *Handler) DeleteDefence(ctx context.Context, req *pb.DeleteDefenceReques
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	query := `DELETE FROM Defence WHERE id = ?`
+	// Soft delete; the retention job removes the row later
+	query := `UPDATE Defence SET deleted_at = NOW(), deleted_by = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL`
 
-	result, err := h.execQuery(ctx, query, req.Id)
+	result, err := h.execQuery(ctx, query, req.DeletedBy, req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete defence: %v", err)
 	}
@@ -254,6 +324,43 @@ func (h *Handler) DeleteDefence(ctx context.Context, req *pb.DeleteDefenceReques
 	}, nil
 }
 
+// RestoreDefence brings back a soft-deleted Defence
+func (h *Handler) RestoreDefence(ctx context.Context, req *pb.RestoreDefenceRequest) (*pb.RestoreDefenceResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	query := `UPDATE Defence SET deleted_at = NULL, deleted_by = NULL, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ? AND deleted_at IS NOT NULL`
+
+	result, err := h.execQuery(ctx, query, req.RestoredBy, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore defence: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		// Unknown ids are NotFound; a live record has nothing to restore
+		if _, err := h.GetDefence(ctx, &pb.GetDefenceRequest{Id: req.Id}); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, "defence is not deleted")
+	}
+
+	restored, err := h.GetDefence(ctx, &pb.GetDefenceRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreDefenceResponse{
+		Defence: restored.GetDefence(),
+	}, nil
+}
+
 // ListDefences lists Defences with pagination and filtering
 func (h *Handler) ListDefences(ctx context.Context, req *pb.ListDefencesRequest) (*pb.ListDefencesResponse, error) {
 	defer logger.TraceFunction(ctx)()
@@ -261,8 +368,6 @@ func (h *Handler) ListDefences(ctx context.Context, req *pb.ListDefencesRequest)
 	// Default pagination
 	page := int32(1)
 	pageSize := int32(10)
-	sortBy := "created_at"
-	descending := true
 	if req.Search != nil && req.Search.Pagination != nil {
 		if req.Search.Pagination.Page > 0 {
 			page = req.Search.Pagination.Page
@@ -270,51 +375,38 @@ func (h *Handler) ListDefences(ctx context.Context, req *pb.ListDefencesRequest)
 		if req.Search.Pagination.PageSize > 0 {
 			pageSize = req.Search.Pagination.PageSize
 		}
-		if req.Search.Pagination.SortBy != "" {
-			sortBy = req.Search.Pagination.SortBy
-		}
-		descending = req.Search.Pagination.Descending
 	}
 
 	// Calculate offset
 	offset := (page - 1) * pageSize
 
 	// Build WHERE clause from filters
-	whereClause := ""
 	args := []interface{}{}
-	whiteMap := map[string]bool{
-		"id":           true,
-		"title":        true,
-		"council_code": true,
-		"teacher_code": true,
-		"position":     true,
-	}
-	if req.Search != nil && len(req.Search.Filters) > 0 {
-		whereConditions := []string{}
-		for _, filter := range req.Search.Filters {
-			if filter.GetCondition() != nil {
-				condition := filter.GetCondition()
-				if _, ok := whiteMap[condition.Field]; !ok {
-					continue
-				}
-				whereConditions = append(whereConditions, helper.BuildFilterCondition(condition, &args))
-			}
-		}
-		if len(whereConditions) > 0 {
-			whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
-		}
+	filterFields := helper.FilterFields{
+		"id":           {},
+		"title":        {},
+		"council_code": {},
+		"teacher_code": {},
+		"position":     {Type: helper.FieldEnum, Values: []string{"president", "secretary", "reviewer", "member"}},
+		"created_at":   {Type: helper.FieldTime},
+		"updated_at":   {Type: helper.FieldTime},
+	}
+	whereClause, err := helper.BuildWhere(req.Search.GetFilters(), filterFields, &args)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
+	whereClause = helper.NotDeleted(whereClause, req.IncludeDeleted)
 
 	// Build ORDER BY clause
-	sortDirection := "ASC"
-	if descending {
-		sortDirection = "DESC"
+	orderBy, err := helper.BuildOrderBy(req.Search.GetPagination(), filterFields)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %v", err)
 	}
 
 	// Get total count
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM Defence %s", whereClause)
 	var total int32
-	err := h.queryRow(ctx, countQuery, args...).Scan(&total)
+	err = h.queryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to count defences: %v", err)
 	}
@@ -322,12 +414,12 @@ func (h *Handler) ListDefences(ctx context.Context, req *pb.ListDefencesRequest)
 	// Get entities with pagination
 	args = append(args, pageSize, offset)
 	query := fmt.Sprintf(`
-		SELECT id, title, council_code, teacher_code, position, created_at, updated_at, created_by, updated_by
+		SELECT id, title, council_code, teacher_code, position, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM Defence
 		%s
-		ORDER BY %s %s
+		%s
 		LIMIT ? OFFSET ?
-	`, whereClause, sortBy, sortDirection)
+	`, whereClause, orderBy)
 
 	rows, err := h.query(ctx, query, args...)
 	if err != nil {
@@ -338,8 +430,8 @@ func (h *Handler) ListDefences(ctx context.Context, req *pb.ListDefencesRequest)
 	entities := []*pb.Defence{}
 	for rows.Next() {
 		var entity pb.Defence
-		var createdAt, updatedAt sql.NullTime
-		var updatedBy sql.NullString
+		var createdAt, updatedAt, deletedAt sql.NullTime
+		var updatedBy, deletedBy sql.NullString
 		var PositionStr string
 
 		err := rows.Scan(
@@ -352,24 +444,16 @@ func (h *Handler) ListDefences(ctx context.Context, req *pb.ListDefencesRequest)
 			&updatedAt,
 			&entity.CreatedBy,
 			&updatedBy,
+			&entity.Version,
+			&deletedAt,
+			&deletedBy,
 		)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan defence: %v", err)
 		}
 
 		// Convert Position string to enum
-		switch PositionStr {
-		case "president":
-			entity.Position = pb.DefencePosition_PRESIDENT
-		case "secretary":
-			entity.Position = pb.DefencePosition_SECRETARY
-		case "reviewer":
-			entity.Position = pb.DefencePosition_REVIEWER
-		case "member":
-			entity.Position = pb.DefencePosition_MEMBER
-		default:
-			entity.Position = pb.DefencePosition_PRESIDENT
-		}
+		entity.Position = defencePositionFromString(PositionStr)
 
 		if createdAt.Valid {
 			entity.CreatedAt = timestamppb.New(createdAt.Time)
@@ -380,6 +464,10 @@ func (h *Handler) ListDefences(ctx context.Context, req *pb.ListDefencesRequest)
 		if updatedBy.Valid {
 			entity.UpdatedBy = updatedBy.String
 		}
+		if deletedAt.Valid {
+			entity.DeletedAt = timestamppb.New(deletedAt.Time)
+			entity.DeletedBy = deletedBy.String
+		}
 
 		entities = append(entities, &entity)
 	}
@@ -0,0 +1,21 @@
+package handler
+
+import (
+	pb "thaily/proto/council"
+	"thaily/src/pkg/migrate"
+)
+
+// EnumColumns lists the ENUM columns of the council tables with the values
+// the handlers write, for the migrate check command
+func EnumColumns() []migrate.EnumColumn {
+	return []migrate.EnumColumn{
+		{Table: "Defence", Column: "position", Enum: "DefencePosition",
+			Values: migrate.EnumValues(pb.DefencePosition_name, func(v int32) string { return defencePositionToString(pb.DefencePosition(v)) })},
+		{Table: "Council_conflict_override", Column: "kind", Enum: "CouncilConflictKind",
+			Values: migrate.EnumValues(pb.CouncilConflictKind_name, func(v int32) string { return conflictKindToString(pb.CouncilConflictKind(v)) })},
+		{Table: "Rubric_template", Column: "stage", Enum: "RubricStage",
+			Values: migrate.EnumValues(pb.RubricStage_name, func(v int32) string { return rubricStageToString(pb.RubricStage(v)) })},
+		{Table: "Grade_defence_amendment", Column: "status", Enum: "GradeDefenceAmendmentStatus",
+			Values: migrate.EnumValues(pb.GradeDefenceAmendmentStatus_name, func(v int32) string { return defenceAmendmentStatusToString(pb.GradeDefenceAmendmentStatus(v)) })},
+	}
+}
@@ -0,0 +1,444 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	pb "thaily/proto/council"
+	pbEvent "thaily/proto/event"
+	"thaily/src/pkg/audit"
+	"thaily/src/pkg/logger"
+	"thaily/src/pkg/outbox"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// councilGradeState holds the Council grade lock and publication columns
+type councilGradeState struct {
+	lockedAt, publishedAt sql.NullTime
+	lockedBy, publishedBy sql.NullString
+}
+
+func (s councilGradeState) apply(entity *pb.Council) {
+	if s.lockedAt.Valid {
+		entity.GradesLocked = true
+		entity.GradesLockedAt = timestamppb.New(s.lockedAt.Time)
+		entity.GradesLockedBy = s.lockedBy.String
+	}
+	if s.publishedAt.Valid {
+		entity.GradesPublished = true
+		entity.GradesPublishedAt = timestamppb.New(s.publishedAt.Time)
+		entity.GradesPublishedBy = s.publishedBy.String
+	}
+}
+
+// How a grade row reaches its council
+const (
+	councilByDefence      = `FROM Council c JOIN Defence d ON d.council_code = c.id WHERE d.id = ?`
+	councilByGradeDefence = `FROM Council c JOIN Defence d ON d.council_code = c.id JOIN Grade_defence gd ON gd.defence_code = d.id WHERE gd.id = ?`
+	councilByCriterion    = `FROM Council c JOIN Defence d ON d.council_code = c.id JOIN Grade_defence gd ON gd.defence_code = d.id JOIN Grade_defence_criterion gc ON gc.grade_defence_code = gd.id WHERE gc.id = ?`
+)
+
+// checkCouncilGradesUnlocked refuses a grade write once the council reached
+// through from (one of the councilBy constants) is locked. It runs in the
+// write's transaction and holds the council row FOR SHARE, so a concurrent
+// LockCouncilGrades waits for the write to commit. A missing row is left for
+// the write itself to report.
+func checkCouncilGradesUnlocked(ctx context.Context, tx *sql.Tx, from, code string) error {
+	rows, err := tx.QueryContext(ctx, `SELECT c.id, c.grades_locked_at `+from+` FOR SHARE OF c`, code)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check grade lock: %v", err)
+	}
+	var councilCode string
+	var lockedAt sql.NullTime
+	if rows.Next() {
+		err = rows.Scan(&councilCode, &lockedAt)
+	}
+	rows.Close()
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check grade lock: %v", err)
+	}
+	if lockedAt.Valid {
+		return status.Errorf(codes.FailedPrecondition, "grades of council %s are locked; request a grade amendment", councilCode)
+	}
+	return nil
+}
+
+// LockCouncilGrades freezes the council's grade defences; locking again is a no-op
+func (h *Handler) LockCouncilGrades(ctx context.Context, req *pb.LockCouncilGradesRequest) (*pb.LockCouncilGradesResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.CouncilCode == "" || req.Actor == "" {
+		return nil, status.Error(codes.InvalidArgument, "council_code and actor are required")
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var lockedAt sql.NullTime
+	err = tx.QueryRowContext(ctx, `SELECT grades_locked_at FROM Council WHERE id = ? FOR UPDATE`, req.CouncilCode).Scan(&lockedAt)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "council not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get council: %v", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE Council
+		SET grades_locked_by = COALESCE(grades_locked_by, ?), grades_locked_at = COALESCE(grades_locked_at, NOW()), updated_at = NOW(), version = version + 1
+		WHERE id = ?
+	`, req.Actor, req.CouncilCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to lock council grades: %v", err)
+	}
+
+	// GradeLocked is only written by the call that locks the council
+	if !lockedAt.Valid {
+		err = outbox.Write(ctx, tx, &pbEvent.Event{
+			Aggregate:   "Council",
+			AggregateId: req.CouncilCode,
+			Actor:       req.Actor,
+			Payload: &pbEvent.Event_GradeLocked{GradeLocked: &pbEvent.GradeLocked{
+				CouncilCode: req.CouncilCode,
+			}},
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to record grade lock event: %v", err)
+		}
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	council, err := h.GetCouncil(ctx, &pb.GetCouncilRequest{Id: req.CouncilCode})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LockCouncilGradesResponse{
+		Council: council.GetCouncil(),
+	}, nil
+}
+
+// PublishCouncilGrades makes the council's locked grades visible to students
+func (h *Handler) PublishCouncilGrades(ctx context.Context, req *pb.PublishCouncilGradesRequest) (*pb.PublishCouncilGradesResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.CouncilCode == "" || req.Actor == "" {
+		return nil, status.Error(codes.InvalidArgument, "council_code and actor are required")
+	}
+
+	council, err := h.GetCouncil(ctx, &pb.GetCouncilRequest{Id: req.CouncilCode})
+	if err != nil {
+		return nil, err
+	}
+	if !council.GetCouncil().GradesLocked {
+		return nil, status.Error(codes.FailedPrecondition, "council grades must be locked before they are published")
+	}
+
+	_, err = h.execQuery(ctx, `
+		UPDATE Council
+		SET grades_published_by = COALESCE(grades_published_by, ?), grades_published_at = COALESCE(grades_published_at, NOW()), updated_at = NOW(), version = version + 1
+		WHERE id = ?
+	`, req.Actor, req.CouncilCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to publish council grades: %v", err)
+	}
+
+	council, err = h.GetCouncil(ctx, &pb.GetCouncilRequest{Id: req.CouncilCode})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PublishCouncilGradesResponse{
+		Council: council.GetCouncil(),
+	}, nil
+}
+
+func defenceAmendmentStatusToString(s pb.GradeDefenceAmendmentStatus) string {
+	switch s {
+	case pb.GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_APPROVED:
+		return "approved"
+	case pb.GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_REJECTED:
+		return "rejected"
+	default:
+		return "pending"
+	}
+}
+
+func defenceAmendmentStatusFromString(s string) pb.GradeDefenceAmendmentStatus {
+	switch s {
+	case "approved":
+		return pb.GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_APPROVED
+	case "rejected":
+		return pb.GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_REJECTED
+	default:
+		return pb.GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_PENDING
+	}
+}
+
+const gradeDefenceAmendmentColumns = `a.id, a.criterion_code, a.grade_defence_code, a.council_code, a.enrollment_code, a.old_score, a.new_score, a.reason, a.status, a.requested_by, a.decided_by, a.decided_at, a.decision_note, a.created_at, a.updated_at`
+
+// scanGradeDefenceAmendment scans a row selected with gradeDefenceAmendmentColumns
+func scanGradeDefenceAmendment(scan func(dest ...interface{}) error) (*pb.GradeDefenceAmendment, error) {
+	var entity pb.GradeDefenceAmendment
+	var oldScore sql.NullFloat64
+	var decidedBy, decisionNote sql.NullString
+	var decidedAt, createdAt, updatedAt sql.NullTime
+	var StatusStr string
+
+	err := scan(
+		&entity.Id,
+		&entity.CriterionCode,
+		&entity.GradeDefenceCode,
+		&entity.CouncilCode,
+		&entity.EnrollmentCode,
+		&oldScore,
+		&entity.NewScore,
+		&entity.Reason,
+		&StatusStr,
+		&entity.RequestedBy,
+		&decidedBy,
+		&decidedAt,
+		&decisionNote,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entity.Status = defenceAmendmentStatusFromString(StatusStr)
+	if oldScore.Valid {
+		entity.OldScore = &oldScore.Float64
+	}
+	entity.DecidedBy = decidedBy.String
+	entity.DecisionNote = decisionNote.String
+	if decidedAt.Valid {
+		entity.DecidedAt = timestamppb.New(decidedAt.Time)
+	}
+	if createdAt.Valid {
+		entity.CreatedAt = timestamppb.New(createdAt.Time)
+	}
+	if updatedAt.Valid {
+		entity.UpdatedAt = timestamppb.New(updatedAt.Time)
+	}
+	return &entity, nil
+}
+
+func (h *Handler) getGradeDefenceAmendment(ctx context.Context, id string) (*pb.GradeDefenceAmendment, error) {
+	query := fmt.Sprintf(`SELECT %s FROM Grade_defence_amendment a WHERE a.id = ?`, gradeDefenceAmendmentColumns)
+
+	amendment, err := scanGradeDefenceAmendment(h.queryRow(ctx, query, id).Scan)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "grade defence amendment not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get grade defence amendment: %v", err)
+	}
+	return amendment, nil
+}
+
+// RequestGradeDefenceAmendment asks academic affairs to change a criterion's
+// score in a locked council; unlocked councils are scored directly
+func (h *Handler) RequestGradeDefenceAmendment(ctx context.Context, req *pb.RequestGradeDefenceAmendmentRequest) (*pb.RequestGradeDefenceAmendmentResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.CriterionCode == "" || req.RequestedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "criterion_code and requested_by are required")
+	}
+	if strings.TrimSpace(req.Reason) == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+
+	var gradeDefenceCode, enrollmentCode, councilCode string
+	var score sql.NullFloat64
+	var maxScore float64
+	var lockedAt sql.NullTime
+	err := h.queryRow(ctx, `
+		SELECT gd.id, gd.enrollment_code, c.id, gc.score, gc.maxScore, c.grades_locked_at
+		`+councilByCriterion, req.CriterionCode,
+	).Scan(&gradeDefenceCode, &enrollmentCode, &councilCode, &score, &maxScore, &lockedAt)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "grade defence criterion not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get grade defence criterion: %v", err)
+	}
+	if !lockedAt.Valid {
+		return nil, status.Errorf(codes.FailedPrecondition, "grades of council %s are not locked; score the criterion directly", councilCode)
+	}
+	if err := validateCriterionScore(req.NewScore, maxScore); err != nil {
+		return nil, err
+	}
+
+	var pending bool
+	err = h.queryRow(ctx, `
+		SELECT EXISTS(SELECT 1 FROM Grade_defence_amendment WHERE criterion_code = ? AND status = 'pending')
+	`, req.CriterionCode).Scan(&pending)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check pending amendments: %v", err)
+	}
+	if pending {
+		return nil, status.Error(codes.AlreadyExists, "an amendment of this criterion is already pending")
+	}
+
+	id := uuid.New().String()
+	_, err = h.execQuery(ctx, `
+		INSERT INTO Grade_defence_amendment (id, criterion_code, grade_defence_code, council_code, enrollment_code, old_score, new_score, reason, status, requested_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, 'pending', ?, NOW(), NOW())
+	`, id, req.CriterionCode, gradeDefenceCode, councilCode, enrollmentCode, score, req.NewScore, strings.TrimSpace(req.Reason), req.RequestedBy)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create grade defence amendment: %v", err)
+	}
+
+	amendment, err := h.getGradeDefenceAmendment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RequestGradeDefenceAmendmentResponse{
+		Amendment: amendment,
+	}, nil
+}
+
+// DecideGradeDefenceAmendment approves or rejects a pending amendment;
+// approving writes the score and recomputes the grade defence's total
+func (h *Handler) DecideGradeDefenceAmendment(ctx context.Context, req *pb.DecideGradeDefenceAmendmentRequest) (*pb.DecideGradeDefenceAmendmentResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" || req.DecidedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "id and decided_by are required")
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	amendment, err := scanGradeDefenceAmendment(tx.QueryRowContext(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM Grade_defence_amendment a
+		WHERE a.id = ?
+		FOR UPDATE
+	`, gradeDefenceAmendmentColumns), req.Id).Scan)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "grade defence amendment not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get grade defence amendment: %v", err)
+	}
+	if amendment.Status != pb.GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_PENDING {
+		return nil, status.Error(codes.FailedPrecondition, "grade defence amendment is already decided")
+	}
+
+	decision := pb.GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_REJECTED
+	if req.Approve {
+		decision = pb.GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_APPROVED
+		_, err := tx.ExecContext(ctx, `
+			UPDATE Grade_defence_criterion SET score = ?, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ?
+		`, amendment.NewScore, req.DecidedBy, amendment.CriterionCode)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to amend criterion score: %v", err)
+		}
+		if err := recomputeTotalScore(ctx, tx, amendment.GradeDefenceCode, req.DecidedBy); err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE Grade_defence_amendment
+		SET status = ?, decided_by = ?, decided_at = NOW(), decision_note = ?, updated_at = NOW()
+		WHERE id = ?
+	`, defenceAmendmentStatusToString(decision), req.DecidedBy, req.Note, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update grade defence amendment: %v", err)
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	amendment, err = h.getGradeDefenceAmendment(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DecideGradeDefenceAmendmentResponse{
+		Amendment: amendment,
+	}, nil
+}
+
+func (h *Handler) GetGradeDefenceAmendment(ctx context.Context, req *pb.GetGradeDefenceAmendmentRequest) (*pb.GetGradeDefenceAmendmentResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	amendment, err := h.getGradeDefenceAmendment(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetGradeDefenceAmendmentResponse{
+		Amendment: amendment,
+	}, nil
+}
+
+func (h *Handler) ListGradeDefenceAmendments(ctx context.Context, req *pb.ListGradeDefenceAmendmentsRequest) (*pb.ListGradeDefenceAmendmentsResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	conditions := []string{}
+	args := []interface{}{}
+	if req.CouncilCode != nil {
+		conditions = append(conditions, "a.council_code = ?")
+		args = append(args, *req.CouncilCode)
+	}
+	if req.SemesterCode != nil {
+		conditions = append(conditions, "c.semester_code = ?")
+		args = append(args, *req.SemesterCode)
+	}
+	if req.Status != nil {
+		conditions = append(conditions, "a.status = ?")
+		args = append(args, defenceAmendmentStatusToString(*req.Status))
+	}
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM Grade_defence_amendment a
+		JOIN Council c ON c.id = a.council_code
+		%s
+		ORDER BY a.created_at DESC
+	`, gradeDefenceAmendmentColumns, whereClause)
+
+	rows, err := h.query(ctx, query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list grade defence amendments: %v", err)
+	}
+	defer rows.Close()
+
+	amendments := []*pb.GradeDefenceAmendment{}
+	for rows.Next() {
+		amendment, err := scanGradeDefenceAmendment(rows.Scan)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan grade defence amendment: %v", err)
+		}
+		amendments = append(amendments, amendment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating grade defence amendments: %v", err)
+	}
+
+	return &pb.ListGradeDefenceAmendmentsResponse{
+		Amendments: amendments,
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	pb "thaily/proto/council"
+	"thaily/src/pkg/audit"
 	"thaily/src/pkg/helper"
 	"thaily/src/pkg/logger"
 
@@ -27,6 +28,11 @@ func (h *Handler) CreateGradeDefence(ctx context.Context, req *pb.CreateGradeDef
 		return nil, status.Error(codes.InvalidArgument, "enrollment_code is required")
 	}
 
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "enrollment_code", "Enrollment", req.EnrollmentCode); err != nil {
+		return nil, err
+	}
+
 	// Generate UUID
 	id := uuid.New().String()
 
@@ -35,23 +41,29 @@ func (h *Handler) CreateGradeDefence(ctx context.Context, req *pb.CreateGradeDef
 	if req.Note != nil {
 		Note = *req.Note
 	}
-	TotalScore := int32(0)
-	if req.TotalScore != nil {
-		TotalScore = *req.TotalScore
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
 	}
+	defer tx.Rollback()
 
-	// Insert into database
+	if err := checkCouncilGradesUnlocked(ctx, tx, councilByDefence, req.DefenceCode); err != nil {
+		return nil, err
+	}
+
+	// Insert into database; total_score stays NULL until the criteria are scored
 	query := `
-		INSERT INTO GradeDefence (id, defence_code, enrollment_code, note, total_score, created_by, created_at, updated_at)
+		INSERT INTO Grade_defence (id, defence_code, enrollment_code, note, created_by, updated_by, created_at, updated_at)
 		VALUES (?, ?, ?, ?, ?, ?, NOW(), NOW())
 	`
 
-	_, err := h.execQuery(ctx, query,
+	_, err = tx.ExecContext(ctx, query,
 		id,
 		req.DefenceCode,
 		req.EnrollmentCode,
 		Note,
-		TotalScore,
+		req.CreatedBy,
 		req.CreatedBy,
 	)
 
@@ -62,6 +74,20 @@ func (h *Handler) CreateGradeDefence(ctx context.Context, req *pb.CreateGradeDef
 		return nil, status.Errorf(codes.Internal, "failed to create gradedefence: %v", err)
 	}
 
+	// Pre-populate the criteria from the rubric of the council's major and the stage
+	templateCode, err := copyRubricCriteria(ctx, tx, id, req.DefenceCode, req.Stage, req.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+	if templateCode != "" {
+		if _, err := tx.ExecContext(ctx, `UPDATE Grade_defence SET rubric_template_code = ? WHERE id = ?`, templateCode, id); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create gradedefence: %v", err)
+		}
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
 	result, err := h.GetGradeDefence(ctx, &pb.GetGradeDefenceRequest{Id: id})
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to get gradedefence")
@@ -80,25 +106,33 @@ func (h *Handler) GetGradeDefence(ctx context.Context, req *pb.GetGradeDefenceRe
 	}
 
 	query := `
-		SELECT id, defence_code, enrollment_code, note, total_score, created_at, updated_at, created_by, updated_by
-		FROM GradeDefence
+		SELECT id, defence_code, enrollment_code, COALESCE(note, ''), total_score, COALESCE(rubric_template_code, ''), created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
+		FROM Grade_defence
 		WHERE id = ?
 	`
+	if !req.IncludeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
 
 	var entity pb.GradeDefence
-	var createdAt, updatedAt sql.NullTime
-	var updatedBy sql.NullString
+	var createdAt, updatedAt, deletedAt sql.NullTime
+	var updatedBy, deletedBy sql.NullString
+	var totalScore sql.NullFloat64
 
 	err := h.queryRow(ctx, query, req.Id).Scan(
 		&entity.Id,
 		&entity.DefenceCode,
 		&entity.EnrollmentCode,
 		&entity.Note,
-		&entity.TotalScore,
+		&totalScore,
+		&entity.RubricTemplateCode,
 		&createdAt,
 		&updatedAt,
 		&entity.CreatedBy,
 		&updatedBy,
+		&entity.Version,
+		&deletedAt,
+		&deletedBy,
 	)
 
 	if err != nil {
@@ -108,6 +142,9 @@ func (h *Handler) GetGradeDefence(ctx context.Context, req *pb.GetGradeDefenceRe
 		return nil, status.Errorf(codes.Internal, "failed to get gradedefence: %v", err)
 	}
 
+	if totalScore.Valid {
+		entity.TotalScore = &totalScore.Float64
+	}
 	if createdAt.Valid {
 		entity.CreatedAt = timestamppb.New(createdAt.Time)
 	}
@@ -117,6 +154,10 @@ func (h *Handler) GetGradeDefence(ctx context.Context, req *pb.GetGradeDefenceRe
 	if updatedBy.Valid {
 		entity.UpdatedBy = updatedBy.String
 	}
+	if deletedAt.Valid {
+		entity.DeletedAt = timestamppb.New(deletedAt.Time)
+		entity.DeletedBy = deletedBy.String
+	}
 
 	return &pb.GetGradeDefenceResponse{
 		GradeDefence: &entity,
@@ -150,10 +191,10 @@ func (h *Handler) UpdateGradeDefence(ctx context.Context, req *pb.UpdateGradeDef
 		args = append(args, *req.Note)
 
 	}
-	if req.TotalScore != nil {
-		updateFields = append(updateFields, "total_score = ?")
-		args = append(args, *req.TotalScore)
 
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "enrollment_code", "Enrollment", req.GetEnrollmentCode()); err != nil {
+		return nil, err
 	}
 
 	if len(updateFields) == 0 {
@@ -165,19 +206,45 @@ func (h *Handler) UpdateGradeDefence(ctx context.Context, req *pb.UpdateGradeDef
 	args = append(args, req.UpdatedBy)
 	updateFields = append(updateFields, "updated_at = NOW()")
 
-	// Add id as last parameter
-	args = append(args, req.Id)
+	// Bump the version; an expected version only matches an unchanged record
+	updateFields = append(updateFields, "version = version + 1")
+	where := helper.WhereVersion(req.Id, req.Version, &args)
 
 	query := fmt.Sprintf(`
-		UPDATE GradeDefence
+		UPDATE Grade_defence
 		SET %s
-		WHERE id = ?
-	`, strings.Join(updateFields, ", "))
+		WHERE %s AND deleted_at IS NULL
+	`, strings.Join(updateFields, ", "), where)
 
-	_, err := h.execQuery(ctx, query, args...)
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	if err := checkCouncilGradesUnlocked(ctx, tx, councilByGradeDefence, req.Id); err != nil {
+		return nil, err
+	}
+	if req.DefenceCode != nil {
+		if err := checkCouncilGradesUnlocked(ctx, tx, councilByDefence, *req.DefenceCode); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := tx.ExecContext(ctx, query, args...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update gradedefence: %v", err)
 	}
+	if n, _ := updated.RowsAffected(); n == 0 {
+		current, err := h.GetGradeDefence(ctx, &pb.GetGradeDefenceRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		return nil, helper.VersionConflict("grade defence", current.GetGradeDefence(), current.GetGradeDefence().Version)
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
 
 	result, err := h.GetGradeDefence(ctx, &pb.GetGradeDefenceRequest{Id: req.Id})
 	if err != nil {
@@ -188,7 +255,7 @@ func (h *Handler) UpdateGradeDefence(ctx context.Context, req *pb.UpdateGradeDef
 	}, nil
 }
 
-// DeleteGradeDefence deletes a GradeDefence by ID
+// DeleteGradeDefence soft-deletes a GradeDefence by ID
 func (h *Handler) DeleteGradeDefence(ctx context.Context, req *pb.DeleteGradeDefenceRequest) (*pb.DeleteGradeDefenceResponse, error) {
 	defer logger.TraceFunction(ctx)()
 
@@ -196,9 +263,20 @@ func (h *Handler) DeleteGradeDefence(ctx context.Context, req *pb.DeleteGradeDef
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	query := `DELETE FROM GradeDefence WHERE id = ?`
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	if err := checkCouncilGradesUnlocked(ctx, tx, councilByGradeDefence, req.Id); err != nil {
+		return nil, err
+	}
+
+	// Soft delete; the retention job removes the row later
+	query := `UPDATE Grade_defence SET deleted_at = NOW(), deleted_by = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL`
 
-	result, err := h.execQuery(ctx, query, req.Id)
+	result, err := tx.ExecContext(ctx, query, req.DeletedBy, req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete gradedefence: %v", err)
 	}
@@ -211,12 +289,52 @@ func (h *Handler) DeleteGradeDefence(ctx context.Context, req *pb.DeleteGradeDef
 	if rowsAffected == 0 {
 		return nil, status.Error(codes.NotFound, "gradedefence not found")
 	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
 
 	return &pb.DeleteGradeDefenceResponse{
 		Success: true,
 	}, nil
 }
 
+// RestoreGradeDefence brings back a soft-deleted GradeDefence
+func (h *Handler) RestoreGradeDefence(ctx context.Context, req *pb.RestoreGradeDefenceRequest) (*pb.RestoreGradeDefenceResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	query := `UPDATE Grade_defence SET deleted_at = NULL, deleted_by = NULL, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ? AND deleted_at IS NOT NULL`
+
+	result, err := h.execQuery(ctx, query, req.RestoredBy, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore grade defence: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		// Unknown ids are NotFound; a live record has nothing to restore
+		if _, err := h.GetGradeDefence(ctx, &pb.GetGradeDefenceRequest{Id: req.Id}); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, "grade defence is not deleted")
+	}
+
+	restored, err := h.GetGradeDefence(ctx, &pb.GetGradeDefenceRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreGradeDefenceResponse{
+		GradeDefence: restored.GetGradeDefence(),
+	}, nil
+}
+
 // ListGradeDefences lists GradeDefences with pagination and filtering
 func (h *Handler) ListGradeDefences(ctx context.Context, req *pb.ListGradeDefencesRequest) (*pb.ListGradeDefencesResponse, error) {
 	defer logger.TraceFunction(ctx)()
@@ -224,8 +342,6 @@ func (h *Handler) ListGradeDefences(ctx context.Context, req *pb.ListGradeDefenc
 	// Default pagination
 	page := int32(1)
 	pageSize := int32(10)
-	sortBy := "created_at"
-	descending := true
 	if req.Search != nil && req.Search.Pagination != nil {
 		if req.Search.Pagination.Page > 0 {
 			page = req.Search.Pagination.Page
@@ -233,51 +349,39 @@ func (h *Handler) ListGradeDefences(ctx context.Context, req *pb.ListGradeDefenc
 		if req.Search.Pagination.PageSize > 0 {
 			pageSize = req.Search.Pagination.PageSize
 		}
-		if req.Search.Pagination.SortBy != "" {
-			sortBy = req.Search.Pagination.SortBy
-		}
-		descending = req.Search.Pagination.Descending
 	}
 
 	// Calculate offset
 	offset := (page - 1) * pageSize
 
 	// Build WHERE clause from filters
-	whereClause := ""
 	args := []interface{}{}
-	whiteMap := map[string]bool{
-		"id":              true,
-		"defence_code":    true,
-		"enrollment_code": true,
-		"note":            true,
-		"total_score":     true,
-	}
-	if req.Search != nil && len(req.Search.Filters) > 0 {
-		whereConditions := []string{}
-		for _, filter := range req.Search.Filters {
-			if filter.GetCondition() != nil {
-				condition := filter.GetCondition()
-				if _, ok := whiteMap[condition.Field]; !ok {
-					continue
-				}
-				whereConditions = append(whereConditions, helper.BuildFilterCondition(condition, &args))
-			}
-		}
-		if len(whereConditions) > 0 {
-			whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
-		}
+	filterFields := helper.FilterFields{
+		"id":                   {},
+		"defence_code":         {},
+		"enrollment_code":      {},
+		"note":                 {},
+		"total_score":          {Type: helper.FieldFloat},
+		"rubric_template_code": {},
+		"created_at":           {Type: helper.FieldTime},
+		"updated_at":           {Type: helper.FieldTime},
+	}
+	whereClause, err := helper.BuildWhere(req.Search.GetFilters(), filterFields, &args)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
+	whereClause = helper.NotDeleted(whereClause, req.IncludeDeleted)
 
 	// Build ORDER BY clause
-	sortDirection := "ASC"
-	if descending {
-		sortDirection = "DESC"
+	orderBy, err := helper.BuildOrderBy(req.Search.GetPagination(), filterFields)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %v", err)
 	}
 
 	// Get total count
-	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM GradeDefence %s", whereClause)
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM Grade_defence %s", whereClause)
 	var total int32
-	err := h.queryRow(ctx, countQuery, args...).Scan(&total)
+	err = h.queryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to count gradedefences: %v", err)
 	}
@@ -285,12 +389,12 @@ func (h *Handler) ListGradeDefences(ctx context.Context, req *pb.ListGradeDefenc
 	// Get entities with pagination
 	args = append(args, pageSize, offset)
 	query := fmt.Sprintf(`
-		SELECT id, defence_code, enrollment_code, note, total_score, created_at, updated_at, created_by, updated_by
-		FROM GradeDefence
+		SELECT id, defence_code, enrollment_code, COALESCE(note, ''), total_score, COALESCE(rubric_template_code, ''), created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
+		FROM Grade_defence
+		%s
 		%s
-		ORDER BY %s %s
 		LIMIT ? OFFSET ?
-	`, whereClause, sortBy, sortDirection)
+	`, whereClause, orderBy)
 
 	rows, err := h.query(ctx, query, args...)
 	if err != nil {
@@ -301,24 +405,32 @@ func (h *Handler) ListGradeDefences(ctx context.Context, req *pb.ListGradeDefenc
 	entities := []*pb.GradeDefence{}
 	for rows.Next() {
 		var entity pb.GradeDefence
-		var createdAt, updatedAt sql.NullTime
-		var updatedBy sql.NullString
+		var createdAt, updatedAt, deletedAt sql.NullTime
+		var updatedBy, deletedBy sql.NullString
+		var totalScore sql.NullFloat64
 
 		err := rows.Scan(
 			&entity.Id,
 			&entity.DefenceCode,
 			&entity.EnrollmentCode,
 			&entity.Note,
-			&entity.TotalScore,
+			&totalScore,
+			&entity.RubricTemplateCode,
 			&createdAt,
 			&updatedAt,
 			&entity.CreatedBy,
 			&updatedBy,
+			&entity.Version,
+			&deletedAt,
+			&deletedBy,
 		)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan gradedefence: %v", err)
 		}
 
+		if totalScore.Valid {
+			entity.TotalScore = &totalScore.Float64
+		}
 		if createdAt.Valid {
 			entity.CreatedAt = timestamppb.New(createdAt.Time)
 		}
@@ -328,6 +440,10 @@ func (h *Handler) ListGradeDefences(ctx context.Context, req *pb.ListGradeDefenc
 		if updatedBy.Valid {
 			entity.UpdatedBy = updatedBy.String
 		}
+		if deletedAt.Valid {
+			entity.DeletedAt = timestamppb.New(deletedAt.Time)
+			entity.DeletedBy = deletedBy.String
+		}
 
 		entities = append(entities, &entity)
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	pb "thaily/proto/council"
+	"thaily/src/pkg/audit"
 	"thaily/src/pkg/helper"
 	"thaily/src/pkg/logger"
 
@@ -15,7 +16,50 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// CreateGradeDefenceCriterion creates a new GradeDefenceCriterion record
+const gradeDefenceCriterionColumns = `id, grade_defence_code, COALESCE(name, ''), score, maxScore, COALESCE(description, ''), weight, COALESCE(rubric_criterion_code, ''), created_at, updated_at, created_by, updated_by, version`
+
+// scanGradeDefenceCriterion scans a row selected with gradeDefenceCriterionColumns
+func scanGradeDefenceCriterion(scan func(dest ...interface{}) error) (*pb.GradeDefenceCriterion, error) {
+	var entity pb.GradeDefenceCriterion
+	var score sql.NullFloat64
+	var createdAt, updatedAt sql.NullTime
+	var updatedBy sql.NullString
+
+	err := scan(
+		&entity.Id,
+		&entity.GradeDefenceCode,
+		&entity.Name,
+		&score,
+		&entity.MaxScore,
+		&entity.Description,
+		&entity.Weight,
+		&entity.RubricCriterionCode,
+		&createdAt,
+		&updatedAt,
+		&entity.CreatedBy,
+		&updatedBy,
+		&entity.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if score.Valid {
+		entity.Score = &score.Float64
+	}
+	if createdAt.Valid {
+		entity.CreatedAt = timestamppb.New(createdAt.Time)
+	}
+	if updatedAt.Valid {
+		entity.UpdatedAt = timestamppb.New(updatedAt.Time)
+	}
+	if updatedBy.Valid {
+		entity.UpdatedBy = updatedBy.String
+	}
+	return &entity, nil
+}
+
+// CreateGradeDefenceCriterion adds a criterion outside the rubric to a GradeDefence
 func (h *Handler) CreateGradeDefenceCriterion(ctx context.Context, req *pb.CreateGradeDefenceCriterionRequest) (*pb.CreateGradeDefenceCriterionResponse, error) {
 	defer logger.TraceFunction(ctx)()
 
@@ -23,37 +67,78 @@ func (h *Handler) CreateGradeDefenceCriterion(ctx context.Context, req *pb.Creat
 	if req.GradeDefenceCode == "" {
 		return nil, status.Error(codes.InvalidArgument, "grade_defence_code is required")
 	}
+	if req.Name == nil || strings.TrimSpace(*req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if req.MaxScore == nil || *req.MaxScore <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "maxScore must be positive")
+	}
+	weight := 1.0
+	if req.Weight != nil {
+		weight = *req.Weight
+	}
+	if weight <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "weight must be positive")
+	}
+	score := sql.NullFloat64{}
+	if req.Score != nil {
+		if err := validateCriterionScore(*req.Score, *req.MaxScore); err != nil {
+			return nil, err
+		}
+		score = sql.NullFloat64{Float64: *req.Score, Valid: true}
+	}
+	description := ""
+	if req.Description != nil {
+		description = *req.Description
+	}
+	createdBy := ""
+	if req.CreatedBy != nil {
+		createdBy = *req.CreatedBy
+	}
 
 	// Generate UUID
 	id := uuid.New().String()
 
-	// Prepare optional fields
-	name := ""
-	if req.Name != nil {
-		name = *req.Name
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
 	}
-	score := ""
-	if req.Score != nil {
-		score = *req.Score
+	defer tx.Rollback()
+
+	if err := checkCouncilGradesUnlocked(ctx, tx, councilByGradeDefence, req.GradeDefenceCode); err != nil {
+		return nil, err
 	}
-	maxScore := ""
-	if req.MaxScore != nil {
-		maxScore = *req.MaxScore
+
+	// A grade defence copied from a rubric is scored on the rubric's criteria
+	// only, so the template's weights are the ones its total uses
+	var templateCode sql.NullString
+	err = tx.QueryRowContext(ctx, `SELECT rubric_template_code FROM Grade_defence WHERE id = ? AND deleted_at IS NULL FOR UPDATE`, req.GradeDefenceCode).Scan(&templateCode)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "grade defence not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get grade defence: %v", err)
+	}
+	if templateCode.Valid && templateCode.String != "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "grade defence is scored on rubric template %s; criteria outside it cannot be added", templateCode.String)
 	}
 
 	// Insert into database
 	query := `
-		INSERT INTO Grade_defence_criterion (id, grade_defence_code, name, score, maxScore, created_by, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, NOW(), NOW())
+		INSERT INTO Grade_defence_criterion (id, grade_defence_code, name, description, score, maxScore, weight, created_by, updated_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
 	`
 
-	_, err := h.execQuery(ctx, query,
+	_, err = tx.ExecContext(ctx, query,
 		id,
 		req.GradeDefenceCode,
-		name,
+		strings.TrimSpace(*req.Name),
+		description,
 		score,
-		maxScore,
-		req.CreatedBy,
+		*req.MaxScore,
+		weight,
+		createdBy,
+		createdBy,
 	)
 
 	if err != nil {
@@ -62,6 +147,12 @@ func (h *Handler) CreateGradeDefenceCriterion(ctx context.Context, req *pb.Creat
 		}
 		return nil, status.Errorf(codes.Internal, "failed to create grade defence criterion: %v", err)
 	}
+	if err := recomputeTotalScore(ctx, tx, req.GradeDefenceCode, createdBy); err != nil {
+		return nil, err
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
 
 	result, err := h.GetGradeDefenceCriterion(ctx, &pb.GetGradeDefenceCriterionRequest{Id: id})
 	if err != nil {
@@ -80,28 +171,13 @@ func (h *Handler) GetGradeDefenceCriterion(ctx context.Context, req *pb.GetGrade
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	query := `
-		SELECT id, grade_defence_code, name, score, maxScore, created_at, updated_at, created_by, updated_by
+	query := fmt.Sprintf(`
+		SELECT %s
 		FROM Grade_defence_criterion
 		WHERE id = ?
-	`
-
-	var entity pb.GradeDefenceCriterion
-	var createdAt, updatedAt sql.NullTime
-	var updatedBy sql.NullString
-
-	err := h.queryRow(ctx, query, req.Id).Scan(
-		&entity.Id,
-		&entity.GradeDefenceCode,
-		&entity.Name,
-		&entity.Score,
-		&entity.MaxScore,
-		&createdAt,
-		&updatedAt,
-		&entity.CreatedBy,
-		&updatedBy,
-	)
+	`, gradeDefenceCriterionColumns)
 
+	entity, err := scanGradeDefenceCriterion(h.queryRow(ctx, query, req.Id).Scan)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, status.Error(codes.NotFound, "grade defence criterion not found")
@@ -109,22 +185,13 @@ func (h *Handler) GetGradeDefenceCriterion(ctx context.Context, req *pb.GetGrade
 		return nil, status.Errorf(codes.Internal, "failed to get grade defence criterion: %v", err)
 	}
 
-	if createdAt.Valid {
-		entity.CreatedAt = timestamppb.New(createdAt.Time)
-	}
-	if updatedAt.Valid {
-		entity.UpdatedAt = timestamppb.New(updatedAt.Time)
-	}
-	if updatedBy.Valid {
-		entity.UpdatedBy = updatedBy.String
-	}
-
 	return &pb.GetGradeDefenceCriterionResponse{
-		GradeDefenceCriterion: &entity,
+		GradeDefenceCriterion: entity,
 	}, nil
 }
 
-// UpdateGradeDefenceCriterion updates an existing GradeDefenceCriterion
+// UpdateGradeDefenceCriterion updates an existing GradeDefenceCriterion.
+// Criteria copied from a rubric template only take a score.
 func (h *Handler) UpdateGradeDefenceCriterion(ctx context.Context, req *pb.UpdateGradeDefenceCriterionRequest) (*pb.UpdateGradeDefenceCriterionResponse, error) {
 	defer logger.TraceFunction(ctx)()
 
@@ -132,17 +199,76 @@ func (h *Handler) UpdateGradeDefenceCriterion(ctx context.Context, req *pb.Updat
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	if err := checkCouncilGradesUnlocked(ctx, tx, councilByCriterion, req.Id); err != nil {
+		return nil, err
+	}
+
+	current, err := scanGradeDefenceCriterion(tx.QueryRowContext(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM Grade_defence_criterion
+		WHERE id = ?
+		FOR UPDATE
+	`, gradeDefenceCriterionColumns), req.Id).Scan)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "grade defence criterion not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get grade defence criterion: %v", err)
+	}
+
+	if req.Version != nil && *req.Version != current.Version {
+		return nil, helper.VersionConflict("grade defence criterion", current, current.Version)
+	}
+
+	if current.RubricCriterionCode != "" &&
+		(req.GradeDefenceCode != nil || req.Name != nil || req.MaxScore != nil || req.Weight != nil || req.Description != nil) {
+		return nil, status.Error(codes.InvalidArgument, "criteria from a rubric template only accept a score")
+	}
+
 	// Build dynamic update query
 	updateFields := []string{}
 	args := []interface{}{}
 
+	maxScore := current.MaxScore
+	if req.MaxScore != nil {
+		if *req.MaxScore <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "maxScore must be positive")
+		}
+		maxScore = *req.MaxScore
+	}
+	score := current.Score
+	if req.Score != nil {
+		score = req.Score
+	}
+	if score != nil {
+		if err := validateCriterionScore(*score, maxScore); err != nil {
+			return nil, err
+		}
+	}
+
 	if req.GradeDefenceCode != nil {
+		if err := checkCouncilGradesUnlocked(ctx, tx, councilByGradeDefence, *req.GradeDefenceCode); err != nil {
+			return nil, err
+		}
 		updateFields = append(updateFields, "grade_defence_code = ?")
 		args = append(args, *req.GradeDefenceCode)
 	}
 	if req.Name != nil {
+		if strings.TrimSpace(*req.Name) == "" {
+			return nil, status.Error(codes.InvalidArgument, "name must not be empty")
+		}
 		updateFields = append(updateFields, "name = ?")
-		args = append(args, *req.Name)
+		args = append(args, strings.TrimSpace(*req.Name))
+	}
+	if req.Description != nil {
+		updateFields = append(updateFields, "description = ?")
+		args = append(args, *req.Description)
 	}
 	if req.Score != nil {
 		updateFields = append(updateFields, "score = ?")
@@ -152,6 +278,13 @@ func (h *Handler) UpdateGradeDefenceCriterion(ctx context.Context, req *pb.Updat
 		updateFields = append(updateFields, "maxScore = ?")
 		args = append(args, *req.MaxScore)
 	}
+	if req.Weight != nil {
+		if *req.Weight <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "weight must be positive")
+		}
+		updateFields = append(updateFields, "weight = ?")
+		args = append(args, *req.Weight)
+	}
 
 	if len(updateFields) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "no fields to update")
@@ -159,8 +292,9 @@ func (h *Handler) UpdateGradeDefenceCriterion(ctx context.Context, req *pb.Updat
 
 	// Add updated_by and updated_at
 	updateFields = append(updateFields, "updated_by = ?")
-	args = append(args, req.UpdatedBy)
+	args = append(args, req.GetUpdatedBy())
 	updateFields = append(updateFields, "updated_at = NOW()")
+	updateFields = append(updateFields, "version = version + 1")
 
 	// Add id as last parameter
 	args = append(args, req.Id)
@@ -171,11 +305,23 @@ func (h *Handler) UpdateGradeDefenceCriterion(ctx context.Context, req *pb.Updat
 		WHERE id = ?
 	`, strings.Join(updateFields, ", "))
 
-	_, err := h.execQuery(ctx, query, args...)
+	_, err = tx.ExecContext(ctx, query, args...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update grade defence criterion: %v", err)
 	}
 
+	if err := recomputeTotalScore(ctx, tx, current.GradeDefenceCode, req.GetUpdatedBy()); err != nil {
+		return nil, err
+	}
+	if req.GradeDefenceCode != nil && *req.GradeDefenceCode != current.GradeDefenceCode {
+		if err := recomputeTotalScore(ctx, tx, *req.GradeDefenceCode, req.GetUpdatedBy()); err != nil {
+			return nil, err
+		}
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
 	result, err := h.GetGradeDefenceCriterion(ctx, &pb.GetGradeDefenceCriterionRequest{Id: req.Id})
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to get grade defence criterion")
@@ -185,7 +331,8 @@ func (h *Handler) UpdateGradeDefenceCriterion(ctx context.Context, req *pb.Updat
 	}, nil
 }
 
-// DeleteGradeDefenceCriterion deletes a GradeDefenceCriterion by ID
+// DeleteGradeDefenceCriterion deletes a GradeDefenceCriterion by ID; criteria
+// copied from a rubric template cannot be removed
 func (h *Handler) DeleteGradeDefenceCriterion(ctx context.Context, req *pb.DeleteGradeDefenceCriterionRequest) (*pb.DeleteGradeDefenceCriterionResponse, error) {
 	defer logger.TraceFunction(ctx)()
 
@@ -193,20 +340,42 @@ func (h *Handler) DeleteGradeDefenceCriterion(ctx context.Context, req *pb.Delet
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	query := `DELETE FROM Grade_defence_criterion WHERE id = ?`
-
-	result, err := h.execQuery(ctx, query, req.Id)
+	tx, err := audit.BeginTx(ctx, h.db)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete grade defence criterion: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
 	}
+	defer tx.Rollback()
 
-	rowsAffected, err := result.RowsAffected()
+	if err := checkCouncilGradesUnlocked(ctx, tx, councilByCriterion, req.Id); err != nil {
+		return nil, err
+	}
+
+	var gradeDefenceCode string
+	var rubricCriterionCode sql.NullString
+	err = tx.QueryRowContext(ctx, `
+		SELECT grade_defence_code, rubric_criterion_code
+		FROM Grade_defence_criterion
+		WHERE id = ?
+		FOR UPDATE
+	`, req.Id).Scan(&gradeDefenceCode, &rubricCriterionCode)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get rows affected: %v", err)
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "grade defence criterion not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get grade defence criterion: %v", err)
+	}
+	if rubricCriterionCode.Valid {
+		return nil, status.Error(codes.FailedPrecondition, "criteria from a rubric template cannot be deleted")
 	}
 
-	if rowsAffected == 0 {
-		return nil, status.Error(codes.NotFound, "grade defence criterion not found")
+	if _, err := tx.ExecContext(ctx, `DELETE FROM Grade_defence_criterion WHERE id = ?`, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete grade defence criterion: %v", err)
+	}
+	if err := recomputeTotalScore(ctx, tx, gradeDefenceCode, ""); err != nil {
+		return nil, err
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
 	}
 
 	return &pb.DeleteGradeDefenceCriterionResponse{
@@ -221,8 +390,6 @@ func (h *Handler) ListGradeDefenceCriteria(ctx context.Context, req *pb.ListGrad
 	// Default pagination
 	page := int32(1)
 	pageSize := int32(10)
-	sortBy := "created_at"
-	descending := true
 	if req.Search != nil && req.Search.Pagination != nil {
 		if req.Search.Pagination.Page > 0 {
 			page = req.Search.Pagination.Page
@@ -230,51 +397,39 @@ func (h *Handler) ListGradeDefenceCriteria(ctx context.Context, req *pb.ListGrad
 		if req.Search.Pagination.PageSize > 0 {
 			pageSize = req.Search.Pagination.PageSize
 		}
-		if req.Search.Pagination.SortBy != "" {
-			sortBy = req.Search.Pagination.SortBy
-		}
-		descending = req.Search.Pagination.Descending
 	}
 
 	// Calculate offset
 	offset := (page - 1) * pageSize
 
 	// Build WHERE clause from filters
-	whereClause := ""
 	args := []interface{}{}
-	whiteMap := map[string]bool{
-		"id":                 true,
-		"grade_defence_code": true,
-		"name":               true,
-		"score":              true,
-		"maxScore":           true,
-	}
-	if req.Search != nil && len(req.Search.Filters) > 0 {
-		whereConditions := []string{}
-		for _, filter := range req.Search.Filters {
-			if filter.GetCondition() != nil {
-				condition := filter.GetCondition()
-				if _, ok := whiteMap[condition.Field]; !ok {
-					continue
-				}
-				whereConditions = append(whereConditions, helper.BuildFilterCondition(condition, &args))
-			}
-		}
-		if len(whereConditions) > 0 {
-			whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
-		}
+	filterFields := helper.FilterFields{
+		"id":                    {},
+		"grade_defence_code":    {},
+		"rubric_criterion_code": {},
+		"name":                  {},
+		"score":                 {Type: helper.FieldFloat},
+		"maxScore":              {Type: helper.FieldFloat},
+		"weight":                {Type: helper.FieldFloat},
+		"created_at":            {Type: helper.FieldTime},
+		"updated_at":            {Type: helper.FieldTime},
+	}
+	whereClause, err := helper.BuildWhere(req.Search.GetFilters(), filterFields, &args)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
 
 	// Build ORDER BY clause
-	sortDirection := "ASC"
-	if descending {
-		sortDirection = "DESC"
+	orderBy, err := helper.BuildOrderBy(req.Search.GetPagination(), filterFields)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %v", err)
 	}
 
 	// Get total count
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM Grade_defence_criterion %s", whereClause)
 	var total int32
-	err := h.queryRow(ctx, countQuery, args...).Scan(&total)
+	err = h.queryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to count grade defence criteria: %v", err)
 	}
@@ -282,12 +437,12 @@ func (h *Handler) ListGradeDefenceCriteria(ctx context.Context, req *pb.ListGrad
 	// Get entities with pagination
 	args = append(args, pageSize, offset)
 	query := fmt.Sprintf(`
-		SELECT id, grade_defence_code, name, score, maxScore, created_at, updated_at, created_by, updated_by
+		SELECT %s
 		FROM Grade_defence_criterion
 		%s
-		ORDER BY %s %s
+		%s
 		LIMIT ? OFFSET ?
-	`, whereClause, sortBy, sortDirection)
+	`, gradeDefenceCriterionColumns, whereClause, orderBy)
 
 	rows, err := h.query(ctx, query, args...)
 	if err != nil {
@@ -297,36 +452,11 @@ func (h *Handler) ListGradeDefenceCriteria(ctx context.Context, req *pb.ListGrad
 
 	entities := []*pb.GradeDefenceCriterion{}
 	for rows.Next() {
-		var entity pb.GradeDefenceCriterion
-		var createdAt, updatedAt sql.NullTime
-		var updatedBy sql.NullString
-
-		err := rows.Scan(
-			&entity.Id,
-			&entity.GradeDefenceCode,
-			&entity.Name,
-			&entity.Score,
-			&entity.MaxScore,
-			&createdAt,
-			&updatedAt,
-			&entity.CreatedBy,
-			&updatedBy,
-		)
+		entity, err := scanGradeDefenceCriterion(rows.Scan)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan grade defence criterion: %v", err)
 		}
-
-		if createdAt.Valid {
-			entity.CreatedAt = timestamppb.New(createdAt.Time)
-		}
-		if updatedAt.Valid {
-			entity.UpdatedAt = timestamppb.New(updatedAt.Time)
-		}
-		if updatedBy.Valid {
-			entity.UpdatedBy = updatedBy.String
-		}
-
-		entities = append(entities, &entity)
+		entities = append(entities, entity)
 	}
 
 	if err := rows.Err(); err != nil {
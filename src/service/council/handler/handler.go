@@ -5,15 +5,22 @@ import (
 	"database/sql"
 
 	pb "thaily/proto/council"
+	"thaily/src/pkg/reference"
 )
 
 type Handler struct {
 	pb.UnimplementedCouncilServiceServer
 	db *sql.DB
+
+	// refs checks the columns pointing at other services' tables
+	refs *reference.Resolver
+
+	// sameMajorOnly forbids council members from another major than the council's
+	sameMajorOnly bool
 }
 
-func NewHandler(db *sql.DB) *Handler {
-	return &Handler{db: db}
+func NewHandler(db *sql.DB, refs *reference.Resolver) *Handler {
+	return &Handler{db: db, refs: refs, sameMajorOnly: sameMajorOnlyFromEnv()}
 }
 
 func (h *Handler) queryRow(ctx context.Context, query string, args ...interface{}) *sql.Row {
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"context"
+
+	pbCommon "thaily/proto/common"
+	"thaily/src/pkg/logger"
+	"thaily/src/pkg/reference"
+)
+
+// referencedTables are the council tables other services point at
+var referencedTables = []string{"Council"}
+
+// CheckReferences tells another service which ids of one of our tables do
+// not exist, replacing the foreign keys across services
+func (h *Handler) CheckReferences(ctx context.Context, req *pbCommon.ReferenceCheckRequest) (*pbCommon.ReferenceCheckResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	return reference.Check(ctx, h.db, referencedTables, req)
+}
+
+// References lists the columns of the council tables that point at other
+// services' tables, for the orphan report
+func References() []reference.Column {
+	return []reference.Column{
+		{Table: "Council", Column: "major_code", References: "Major"},
+		{Table: "Council", Column: "semester_code", References: "Semester"},
+		{Table: "Defence", Column: "teacher_code", References: "Teacher"},
+		{Table: "Grade_defence", Column: "enrollment_code", References: "Enrollment"},
+		{Table: "Rubric_template", Column: "major_code", References: "Major"},
+	}
+}
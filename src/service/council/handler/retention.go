@@ -0,0 +1,15 @@
+package handler
+
+import "thaily/src/pkg/retention"
+
+// SoftDeleted lists the council tables purged by the retention job, children
+// before their parents. Criterion grades are hard-deleted with their grade.
+func SoftDeleted() []retention.Table {
+	return []retention.Table{
+		{Name: "Grade_defence", Dependents: []retention.Dependent{
+			{Table: "Grade_defence_criterion", Column: "grade_defence_code"},
+		}},
+		{Name: "Defence"},
+		{Name: "Council"},
+	}
+}
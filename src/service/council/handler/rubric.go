@@ -0,0 +1,443 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"strings"
+
+	pb "thaily/proto/council"
+	pbEvent "thaily/proto/event"
+	"thaily/src/pkg/audit"
+	"thaily/src/pkg/helper"
+	"thaily/src/pkg/logger"
+	"thaily/src/pkg/outbox"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// gradeScale is the scale GradeDefence.total_score is expressed on
+const gradeScale = 10
+
+func rubricStageToString(s pb.RubricStage) string {
+	if s == pb.RubricStage_RUBRIC_STAGE_LVTN {
+		return "stage_lvtn"
+	}
+	return "stage_dacn"
+}
+
+func rubricStageFromString(s string) pb.RubricStage {
+	if s == "stage_lvtn" {
+		return pb.RubricStage_RUBRIC_STAGE_LVTN
+	}
+	return pb.RubricStage_RUBRIC_STAGE_DACN
+}
+
+// validateRubricCriteria checks the criteria of a template
+func validateRubricCriteria(criteria []*pb.RubricCriterionInput) error {
+	if len(criteria) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one criterion is required")
+	}
+	for i, c := range criteria {
+		if strings.TrimSpace(c.Name) == "" {
+			return status.Errorf(codes.InvalidArgument, "criterion %d: name is required", i+1)
+		}
+		if c.MaxScore <= 0 {
+			return status.Errorf(codes.InvalidArgument, "criterion %q: max_score must be positive", c.Name)
+		}
+		if c.Weight <= 0 {
+			return status.Errorf(codes.InvalidArgument, "criterion %q: weight must be positive", c.Name)
+		}
+	}
+	return nil
+}
+
+// validateCriterionScore checks a score against its criterion's range
+func validateCriterionScore(score, maxScore float64) error {
+	if score < 0 || score > maxScore {
+		return status.Errorf(codes.InvalidArgument, "score must be between 0 and %g", maxScore)
+	}
+	return nil
+}
+
+func insertRubricCriteria(ctx context.Context, e execer, templateCode string, criteria []*pb.RubricCriterionInput) error {
+	for i, c := range criteria {
+		_, err := e.ExecContext(ctx, `
+			INSERT INTO Rubric_criterion (id, rubric_template_code, name, description, max_score, weight, sort_order)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+		`, uuid.New().String(), templateCode, strings.TrimSpace(c.Name), c.Description, c.MaxScore, c.Weight, i)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to create rubric criterion: %v", err)
+		}
+	}
+	return nil
+}
+
+// loadRubricTemplates returns the templates matching the condition with their criteria
+func loadRubricTemplates(ctx context.Context, q queryer, where string, args ...interface{}) ([]*pb.RubricTemplate, error) {
+	rows, err := q.QueryContext(ctx, fmt.Sprintf(`
+		SELECT id, title, major_code, stage, created_at, updated_at, created_by, updated_by, version
+		FROM Rubric_template
+		%s
+		ORDER BY major_code, stage
+	`, where), args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list rubric templates: %v", err)
+	}
+
+	templates := []*pb.RubricTemplate{}
+	byId := map[string]*pb.RubricTemplate{}
+	for rows.Next() {
+		var t pb.RubricTemplate
+		var stage string
+		var createdAt, updatedAt sql.NullTime
+		if err := rows.Scan(&t.Id, &t.Title, &t.MajorCode, &stage, &createdAt, &updatedAt, &t.CreatedBy, &t.UpdatedBy, &t.Version); err != nil {
+			rows.Close()
+			return nil, status.Errorf(codes.Internal, "failed to scan rubric template: %v", err)
+		}
+		t.Stage = rubricStageFromString(stage)
+		if createdAt.Valid {
+			t.CreatedAt = timestamppb.New(createdAt.Time)
+		}
+		if updatedAt.Valid {
+			t.UpdatedAt = timestamppb.New(updatedAt.Time)
+		}
+		t.Criteria = []*pb.RubricCriterion{}
+		templates = append(templates, &t)
+		byId[t.Id] = &t
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list rubric templates: %v", err)
+	}
+	if len(templates) == 0 {
+		return templates, nil
+	}
+
+	ids := make([]interface{}, 0, len(templates))
+	for _, t := range templates {
+		ids = append(ids, t.Id)
+	}
+	rows, err = q.QueryContext(ctx, fmt.Sprintf(`
+		SELECT id, rubric_template_code, name, COALESCE(description, ''), max_score, weight, sort_order
+		FROM Rubric_criterion
+		WHERE rubric_template_code IN (?%s)
+		ORDER BY sort_order
+	`, strings.Repeat(", ?", len(ids)-1)), ids...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list rubric criteria: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var c pb.RubricCriterion
+		if err := rows.Scan(&c.Id, &c.RubricTemplateCode, &c.Name, &c.Description, &c.MaxScore, &c.Weight, &c.SortOrder); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan rubric criterion: %v", err)
+		}
+		if t, ok := byId[c.RubricTemplateCode]; ok {
+			t.Criteria = append(t.Criteria, &c)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list rubric criteria: %v", err)
+	}
+	return templates, nil
+}
+
+func (h *Handler) getRubricTemplate(ctx context.Context, q queryer, id string) (*pb.RubricTemplate, error) {
+	templates, err := loadRubricTemplates(ctx, q, "WHERE id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(templates) == 0 {
+		return nil, status.Error(codes.NotFound, "rubric template not found")
+	}
+	return templates[0], nil
+}
+
+// CreateRubricTemplate creates the rubric of a major and stage
+func (h *Handler) CreateRubricTemplate(ctx context.Context, req *pb.CreateRubricTemplateRequest) (*pb.CreateRubricTemplateResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	if req.MajorCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "major_code is required")
+	}
+	if err := validateRubricCriteria(req.Criteria); err != nil {
+		return nil, err
+	}
+
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "major_code", "Major", req.MajorCode); err != nil {
+		return nil, err
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	id := uuid.New().String()
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO Rubric_template (id, title, major_code, stage, created_by, updated_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, NOW(), NOW())
+	`, id, req.Title, req.MajorCode, rubricStageToString(req.Stage), req.CreatedBy, req.CreatedBy)
+	if err != nil {
+		if strings.Contains(err.Error(), "Duplicate entry") {
+			return nil, status.Error(codes.AlreadyExists, "a rubric template already exists for this major and stage")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create rubric template: %v", err)
+	}
+	if err := insertRubricCriteria(ctx, tx, id, req.Criteria); err != nil {
+		return nil, err
+	}
+
+	template, err := h.getRubricTemplate(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+	return &pb.CreateRubricTemplateResponse{RubricTemplate: template}, nil
+}
+
+func (h *Handler) GetRubricTemplate(ctx context.Context, req *pb.GetRubricTemplateRequest) (*pb.GetRubricTemplateResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	template, err := h.getRubricTemplate(ctx, h.db, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetRubricTemplateResponse{RubricTemplate: template}, nil
+}
+
+// UpdateRubricTemplate renames a template and/or replaces its criteria.
+// GradeDefence rows created earlier keep the criteria they were given.
+func (h *Handler) UpdateRubricTemplate(ctx context.Context, req *pb.UpdateRubricTemplateRequest) (*pb.UpdateRubricTemplateResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.Title == nil && len(req.Criteria) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no fields to update")
+	}
+	if len(req.Criteria) > 0 {
+		if err := validateRubricCriteria(req.Criteria); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	updateFields := []string{"updated_by = ?", "updated_at = NOW()", "version = version + 1"}
+	args := []interface{}{req.UpdatedBy}
+	if req.Title != nil {
+		updateFields = append(updateFields, "title = ?")
+		args = append(args, *req.Title)
+	}
+	where := helper.WhereVersion(req.Id, req.Version, &args)
+	result, err := tx.ExecContext(ctx, fmt.Sprintf(`UPDATE Rubric_template SET %s WHERE %s`, strings.Join(updateFields, ", "), where), args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update rubric template: %v", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		current, err := h.getRubricTemplate(ctx, tx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		return nil, helper.VersionConflict("rubric template", current, current.Version)
+	}
+
+	if len(req.Criteria) > 0 {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM Rubric_criterion WHERE rubric_template_code = ?`, req.Id); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to replace rubric criteria: %v", err)
+		}
+		if err := insertRubricCriteria(ctx, tx, req.Id, req.Criteria); err != nil {
+			return nil, err
+		}
+	}
+
+	template, err := h.getRubricTemplate(ctx, tx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+	return &pb.UpdateRubricTemplateResponse{RubricTemplate: template}, nil
+}
+
+func (h *Handler) DeleteRubricTemplate(ctx context.Context, req *pb.DeleteRubricTemplateRequest) (*pb.DeleteRubricTemplateResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	result, err := h.execQuery(ctx, `DELETE FROM Rubric_template WHERE id = ?`, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete rubric template: %v", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return nil, status.Error(codes.NotFound, "rubric template not found")
+	}
+	return &pb.DeleteRubricTemplateResponse{Success: true}, nil
+}
+
+func (h *Handler) ListRubricTemplates(ctx context.Context, req *pb.ListRubricTemplatesRequest) (*pb.ListRubricTemplatesResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	conditions := []string{}
+	args := []interface{}{}
+	if req.MajorCode != nil {
+		conditions = append(conditions, "major_code = ?")
+		args = append(args, *req.MajorCode)
+	}
+	if req.Stage != nil {
+		conditions = append(conditions, "stage = ?")
+		args = append(args, rubricStageToString(*req.Stage))
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	templates, err := loadRubricTemplates(ctx, h.db, where, args...)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListRubricTemplatesResponse{RubricTemplates: templates}, nil
+}
+
+// copyRubricCriteria gives a new GradeDefence the criteria of the rubric of
+// its council's major and the given stage. It returns the template used, or
+// "" when the major has no rubric for the stage.
+func copyRubricCriteria(ctx context.Context, tx *sql.Tx, gradeDefenceCode, defenceCode string, stage pb.RubricStage, actor string) (string, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT t.id
+		FROM Defence d
+		JOIN Council c ON c.id = d.council_code AND c.deleted_at IS NULL
+		JOIN Rubric_template t ON t.major_code = c.major_code AND t.stage = ?
+		WHERE d.id = ? AND d.deleted_at IS NULL
+	`, rubricStageToString(stage), defenceCode)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to find rubric template: %v", err)
+	}
+	templateCode := ""
+	if rows.Next() {
+		err = rows.Scan(&templateCode)
+	}
+	rows.Close()
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to find rubric template: %v", err)
+	}
+	if templateCode == "" {
+		return "", nil
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO Grade_defence_criterion (id, grade_defence_code, rubric_criterion_code, name, description, maxScore, weight, created_by, updated_by, created_at, updated_at)
+		SELECT UUID(), ?, id, name, description, max_score, weight, ?, ?, NOW(), NOW()
+		FROM Rubric_criterion
+		WHERE rubric_template_code = ?
+	`, gradeDefenceCode, actor, actor, templateCode)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to copy rubric criteria: %v", err)
+	}
+	return templateCode, nil
+}
+
+// weightedTotal is the weighted mean of the criteria's score ratios on
+// gradeScale, rounded to two decimals. It is not defined until every
+// criterion is scored.
+func weightedTotal(scores []sql.NullFloat64, maxScores, weights []float64) (float64, bool) {
+	if len(scores) == 0 {
+		return 0, false
+	}
+	var sum, totalWeight float64
+	for i, score := range scores {
+		if !score.Valid || maxScores[i] <= 0 {
+			return 0, false
+		}
+		sum += weights[i] * score.Float64 / maxScores[i]
+		totalWeight += weights[i]
+	}
+	if totalWeight <= 0 {
+		return 0, false
+	}
+	return math.Round(sum/totalWeight*gradeScale*100) / 100, true
+}
+
+// recomputeTotalScore stores the GradeDefence's total from its criteria and
+// writes its GradeDefenceChanged event, by actor, in the same transaction
+func recomputeTotalScore(ctx context.Context, tx *sql.Tx, gradeDefenceCode, actor string) error {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT score, maxScore, weight
+		FROM Grade_defence_criterion
+		WHERE grade_defence_code = ?
+	`, gradeDefenceCode)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to load criteria: %v", err)
+	}
+	var scores []sql.NullFloat64
+	var maxScores, weights []float64
+	for rows.Next() {
+		var score sql.NullFloat64
+		var maxScore, weight float64
+		if err := rows.Scan(&score, &maxScore, &weight); err != nil {
+			rows.Close()
+			return status.Errorf(codes.Internal, "failed to scan criterion: %v", err)
+		}
+		scores = append(scores, score)
+		maxScores = append(maxScores, maxScore)
+		weights = append(weights, weight)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return status.Errorf(codes.Internal, "failed to load criteria: %v", err)
+	}
+
+	total := sql.NullFloat64{}
+	if value, ok := weightedTotal(scores, maxScores, weights); ok {
+		total = sql.NullFloat64{Float64: value, Valid: true}
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE Grade_defence SET total_score = ?, updated_at = NOW(), version = version + 1 WHERE id = ?`, total, gradeDefenceCode); err != nil {
+		return status.Errorf(codes.Internal, "failed to update total score: %v", err)
+	}
+
+	changed := &pbEvent.GradeDefenceChanged{GradeDefenceId: gradeDefenceCode}
+	err = tx.QueryRowContext(ctx, `SELECT defence_code, enrollment_code FROM Grade_defence WHERE id = ?`, gradeDefenceCode).
+		Scan(&changed.DefenceCode, &changed.EnrollmentCode)
+	if err != nil && err != sql.ErrNoRows {
+		return status.Errorf(codes.Internal, "failed to get grade defence: %v", err)
+	}
+	if total.Valid {
+		changed.TotalScore = &total.Float64
+	}
+	err = outbox.Write(ctx, tx, &pbEvent.Event{
+		Aggregate:   "GradeDefence",
+		AggregateId: gradeDefenceCode,
+		Actor:       actor,
+		Payload:     &pbEvent.Event_GradeDefenceChanged{GradeDefenceChanged: changed},
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to record grade defence event: %v", err)
+	}
+	return nil
+}
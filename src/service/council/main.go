@@ -1,15 +1,25 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"os"
 
+	pbAcademic "thaily/proto/academic"
 	pb "thaily/proto/council"
+	pbThesis "thaily/proto/thesis"
+	pbUser "thaily/proto/user"
+	"thaily/src/pkg/audit"
 	"thaily/src/pkg/database"
 	"thaily/src/pkg/logger"
+	"thaily/src/pkg/migrate"
+	"thaily/src/pkg/outbox"
+	"thaily/src/pkg/reference"
+	"thaily/src/pkg/retention"
 	"thaily/src/pkg/tls"
 	"thaily/src/service/council/handler"
+	"thaily/src/service/council/migrations"
 
 	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
@@ -28,11 +38,65 @@ func main() {
 	defer logger.GetFileLogger().Close()
 
 	// Initialize database
-	if err := database.InitDB(); err != nil {
+	if err := database.InitDB("council"); err != nil {
 		log.Fatalf("Failed to initialize database: %v", err)
 	}
 	defer database.CloseDB()
 
+	// Run the migrate command, or apply pending migrations on startup
+	migrator, err := migrate.New(database.GetDB(), "council", migrations.FS)
+	if err != nil {
+		log.Fatalf("Failed to load migrations: %v", err)
+	}
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := migrate.Run(context.Background(), database.GetDB(), migrator, handler.EnumColumns(), os.Args[2:]); err != nil {
+			log.Fatalf("Migrate failed: %v", err)
+		}
+		return
+	}
+	if err := migrate.OnStartup(context.Background(), migrator); err != nil {
+		log.Fatalf("Failed to apply migrations: %v", err)
+	}
+
+	// Check references to the tables of other services, which own them
+	refs := reference.NewResolver()
+	defer refs.Close()
+	if err := refs.Dial("ACADEMIC_SERVICE_ADDR", "academic-service", pbAcademic.AcademicService_CheckReferences_FullMethodName, "Major", "Semester"); err != nil {
+		log.Fatalf("Failed to connect to the academic service: %v", err)
+	}
+	if err := refs.Dial("USER_SERVICE_ADDR", "user-service", pbUser.UserService_CheckReferences_FullMethodName, "Student", "Teacher"); err != nil {
+		log.Fatalf("Failed to connect to the user service: %v", err)
+	}
+	// The conflict checks read topic council staff over the same connection
+	if err := refs.Dial("THESIS_SERVICE_ADDR", "thesis-service", pbThesis.ThesisService_CheckReferences_FullMethodName, "Enrollment"); err != nil {
+		log.Fatalf("Failed to connect to the thesis service: %v", err)
+	}
+	if len(os.Args) > 1 && os.Args[1] == "orphans" {
+		if err := refs.Report(context.Background(), database.GetDB(), handler.References()); err != nil {
+			log.Fatalf("Orphan report failed: %v", err)
+		}
+		return
+	}
+	go refs.RunReport(context.Background(), database.GetDB(), handler.References(), reference.ReportInterval())
+
+	// Purge rows soft-deleted longer than the retention period
+	go retention.Run(context.Background(), database.GetDB(), handler.SoftDeleted(), retention.Period(), retention.Interval())
+
+	// Publish the events written to the outbox to the event bus
+	bus, closeBus, err := outbox.Connect(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to connect to the event bus: %v", err)
+	}
+	defer closeBus()
+	go outbox.NewRelay(database.GetDB(), bus, "council").Run(context.Background(), outbox.Interval(), outbox.Retention())
+
+	// Append every mutation to the audit log
+	auditStore, closeAudit, err := audit.Connect(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to connect to the audit log: %v", err)
+	}
+	defer closeAudit()
+
 	// Verify TLS certificates exist
 	if err := tls.VerifyCertificatesExist("council"); err != nil {
 		log.Fatalf("TLS certificate verification failed: %v", err)
@@ -57,10 +121,13 @@ func main() {
 
 	grpcServer := grpc.NewServer(
 		grpc.Creds(creds),
-		grpc.UnaryInterceptor(logger.UnaryServerInterceptor()),
+		grpc.ChainUnaryInterceptor(
+			logger.UnaryServerInterceptor(),
+			audit.UnaryServerInterceptor(auditStore, database.GetDB(), "council", handler.Audited()),
+		),
 	)
 
-	h := handler.NewHandler(database.GetDB())
+	h := handler.NewHandler(database.GetDB(), refs)
 	pb.RegisterCouncilServiceServer(grpcServer, h)
 
 	log.Printf("CouncilService listening on port %s", port)
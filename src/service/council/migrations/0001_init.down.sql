@@ -0,0 +1,12 @@
+SET FOREIGN_KEY_CHECKS = 0;
+
+DROP TABLE IF EXISTS `Grade_defence_amendment`;
+DROP TABLE IF EXISTS `Rubric_criterion`;
+DROP TABLE IF EXISTS `Rubric_template`;
+DROP TABLE IF EXISTS `Grade_defence_criterion`;
+DROP TABLE IF EXISTS `Grade_defence`;
+DROP TABLE IF EXISTS `Council_conflict_override`;
+DROP TABLE IF EXISTS `Defence`;
+DROP TABLE IF EXISTS `Council`;
+
+SET FOREIGN_KEY_CHECKS = 1;
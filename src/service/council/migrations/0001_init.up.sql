@@ -0,0 +1,138 @@
+-- Initial council service schema
+--
+-- References to other services' tables are not foreign keys, each
+-- service owns its schema:
+--   Council.major_code -> Major (academic service)
+--   Council.semester_code -> Semester (academic service)
+--   Defence.teacher_code -> Teacher (user service)
+--   Grade_defence.enrollment_code -> Enrollment (thesis service)
+--   Rubric_template.major_code -> Major (academic service)
+
+CREATE TABLE `Council` (
+  `id` varchar(255) PRIMARY KEY,
+  `title` varchar(255) NOT NULL,
+  `major_code` varchar(255) NOT NULL,
+  `semester_code` varchar(255) NOT NULL,
+  `time_start` datetime,
+  `room` varchar(255),
+  `grades_locked_at` datetime,
+  `grades_locked_by` varchar(255),
+  `grades_published_at` datetime,
+  `grades_published_by` varchar(255),
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL
+);
+
+CREATE TABLE `Defence` (
+  `id` varchar(255) PRIMARY KEY,
+  `title` varchar(255) NOT NULL,
+  `council_code` varchar(255) NOT NULL,
+  `teacher_code` varchar(255) NOT NULL,
+  `position` ENUM ('president', 'secretary', 'reviewer', 'member') NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL
+);
+
+CREATE TABLE `Council_conflict_override` (
+  `id` varchar(255) PRIMARY KEY,
+  `council_code` varchar(255) NOT NULL,
+  `kind` ENUM ('supervisor_on_council', 'reviewer_is_supervisor', 'duplicate_position', 'duplicate_member', 'missing_president', 'missing_secretary', 'cross_major_member') NOT NULL,
+  `teacher_code` varchar(255) NOT NULL DEFAULT '',
+  `topic_council_code` varchar(255) NOT NULL DEFAULT '',
+  `message` text NOT NULL,
+  `reason` text NOT NULL,
+  `created_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  UNIQUE KEY `uq_council_conflict_override` (`council_code`, `kind`, `teacher_code`, `topic_council_code`)
+);
+
+CREATE TABLE `Grade_defence` (
+  `id` varchar(255) PRIMARY KEY,
+  `defence_code` varchar(255) NOT NULL,
+  `enrollment_code` varchar(255) NOT NULL,
+  `note` varchar(255),
+  `total_score` decimal(5,2),
+  `rubric_template_code` varchar(255),
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL,
+  UNIQUE KEY `uq_grade_defence` (`defence_code`, `enrollment_code`)
+);
+
+CREATE TABLE `Grade_defence_criterion` (
+  `id` varchar(255) PRIMARY KEY,
+  `grade_defence_code` varchar(255) NOT NULL,
+  `rubric_criterion_code` varchar(255),
+  `name` varchar(255),
+  `description` text,
+  `score` decimal(5,2),
+  `maxScore` decimal(5,2) NOT NULL,
+  `weight` decimal(5,2) NOT NULL DEFAULT 1,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL
+);
+
+CREATE TABLE `Rubric_template` (
+  `id` varchar(255) PRIMARY KEY,
+  `title` varchar(255) NOT NULL,
+  `major_code` varchar(255) NOT NULL,
+  `stage` ENUM ('stage_dacn', 'stage_lvtn') NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL,
+  UNIQUE KEY `uq_rubric_template` (`major_code`, `stage`)
+);
+
+CREATE TABLE `Rubric_criterion` (
+  `id` varchar(255) PRIMARY KEY,
+  `rubric_template_code` varchar(255) NOT NULL,
+  `name` varchar(255) NOT NULL,
+  `description` text,
+  `max_score` decimal(5,2) NOT NULL,
+  `weight` decimal(5,2) NOT NULL,
+  `sort_order` int NOT NULL
+);
+
+CREATE TABLE `Grade_defence_amendment` (
+  `id` varchar(255) PRIMARY KEY,
+  `criterion_code` varchar(255) NOT NULL,
+  `grade_defence_code` varchar(255) NOT NULL,
+  `council_code` varchar(255) NOT NULL,
+  `enrollment_code` varchar(255) NOT NULL,
+  `old_score` decimal(5,2),
+  `new_score` decimal(5,2) NOT NULL,
+  `reason` text NOT NULL,
+  `status` ENUM ('pending', 'approved', 'rejected') NOT NULL DEFAULT 'pending',
+  `requested_by` varchar(255) NOT NULL,
+  `decided_by` varchar(255),
+  `decided_at` datetime,
+  `decision_note` text,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL
+);
+
+ALTER TABLE `Defence` ADD FOREIGN KEY (`council_code`) REFERENCES `Council` (`id`);
+
+ALTER TABLE `Grade_defence` ADD FOREIGN KEY (`defence_code`) REFERENCES `Defence` (`id`);
+
+ALTER TABLE `Grade_defence_criterion` ADD FOREIGN KEY (`grade_defence_code`) REFERENCES `Grade_defence` (`id`);
+
+ALTER TABLE `Council_conflict_override` ADD FOREIGN KEY (`council_code`) REFERENCES `Council` (`id`) ON DELETE CASCADE;
+
+ALTER TABLE `Rubric_criterion` ADD FOREIGN KEY (`rubric_template_code`) REFERENCES `Rubric_template` (`id`) ON DELETE CASCADE;
+
+ALTER TABLE `Grade_defence` ADD FOREIGN KEY (`rubric_template_code`) REFERENCES `Rubric_template` (`id`) ON DELETE SET NULL;
+
+ALTER TABLE `Grade_defence_criterion` ADD FOREIGN KEY (`rubric_criterion_code`) REFERENCES `Rubric_criterion` (`id`) ON DELETE SET NULL;
+
+ALTER TABLE `Grade_defence_amendment` ADD FOREIGN KEY (`criterion_code`) REFERENCES `Grade_defence_criterion` (`id`) ON DELETE CASCADE;
+
+ALTER TABLE `Grade_defence_amendment` ADD FOREIGN KEY (`council_code`) REFERENCES `Council` (`id`) ON DELETE CASCADE;
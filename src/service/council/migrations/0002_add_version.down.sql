@@ -0,0 +1,5 @@
+ALTER TABLE `Rubric_template` DROP COLUMN `version`;
+ALTER TABLE `Grade_defence_criterion` DROP COLUMN `version`;
+ALTER TABLE `Grade_defence` DROP COLUMN `version`;
+ALTER TABLE `Defence` DROP COLUMN `version`;
+ALTER TABLE `Council` DROP COLUMN `version`;
@@ -0,0 +1,8 @@
+-- Optimistic concurrency: every update bumps `version`, and an update that
+-- carries the expected version only applies to a record still at it
+
+ALTER TABLE `Council` ADD COLUMN `version` INT NOT NULL DEFAULT 1;
+ALTER TABLE `Defence` ADD COLUMN `version` INT NOT NULL DEFAULT 1;
+ALTER TABLE `Grade_defence` ADD COLUMN `version` INT NOT NULL DEFAULT 1;
+ALTER TABLE `Grade_defence_criterion` ADD COLUMN `version` INT NOT NULL DEFAULT 1;
+ALTER TABLE `Rubric_template` ADD COLUMN `version` INT NOT NULL DEFAULT 1;
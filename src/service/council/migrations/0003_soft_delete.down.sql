@@ -0,0 +1,3 @@
+ALTER TABLE `Grade_defence` DROP KEY `idx_grade_defence_deleted_at`, DROP COLUMN `deleted_by`, DROP COLUMN `deleted_at`;
+ALTER TABLE `Defence` DROP KEY `idx_defence_deleted_at`, DROP COLUMN `deleted_by`, DROP COLUMN `deleted_at`;
+ALTER TABLE `Council` DROP KEY `idx_council_deleted_at`, DROP COLUMN `deleted_by`, DROP COLUMN `deleted_at`;
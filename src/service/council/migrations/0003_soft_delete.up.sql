@@ -0,0 +1,7 @@
+-- Soft delete: Delete* only stamps `deleted_at`/`deleted_by`, Get*/List*
+-- skip stamped rows unless asked, Restore* clears them, and the retention
+-- job hard-deletes rows stamped longer ago than RETENTION_PERIOD
+
+ALTER TABLE `Council` ADD COLUMN `deleted_at` datetime NULL, ADD COLUMN `deleted_by` varchar(255) NULL, ADD KEY `idx_council_deleted_at` (`deleted_at`);
+ALTER TABLE `Defence` ADD COLUMN `deleted_at` datetime NULL, ADD COLUMN `deleted_by` varchar(255) NULL, ADD KEY `idx_defence_deleted_at` (`deleted_at`);
+ALTER TABLE `Grade_defence` ADD COLUMN `deleted_at` datetime NULL, ADD COLUMN `deleted_by` varchar(255) NULL, ADD KEY `idx_grade_defence_deleted_at` (`deleted_at`);
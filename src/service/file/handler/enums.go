@@ -0,0 +1,17 @@
+package handler
+
+import (
+	pb "thaily/proto/file"
+	"thaily/src/pkg/migrate"
+)
+
+// EnumColumns lists the ENUM columns of the file tables with the values
+// the handlers write, for the migrate check command
+func EnumColumns() []migrate.EnumColumn {
+	return []migrate.EnumColumn{
+		{Table: "File", Column: "status", Enum: "FileStatus",
+			Values: migrate.EnumValues(pb.FileStatus_name, func(v int32) string { return fileStatusToString(pb.FileStatus(v)) })},
+		{Table: "File", Column: "table", Enum: "TableType",
+			Values: migrate.EnumValues(pb.TableType_name, func(v int32) string { return tableTypeToString(pb.TableType(v)) })},
+	}
+}
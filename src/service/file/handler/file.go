@@ -5,9 +5,12 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	pbEvent "thaily/proto/event"
 	pb "thaily/proto/file"
+	"thaily/src/pkg/audit"
 	"thaily/src/pkg/helper"
 	"thaily/src/pkg/logger"
+	"thaily/src/pkg/outbox"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -15,6 +18,62 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+func fileStatusToString(s pb.FileStatus) string {
+	switch s {
+	case pb.FileStatus_APPROVED:
+		return "approved"
+	case pb.FileStatus_REJECTED:
+		return "rejected"
+	default:
+		return "pending"
+	}
+}
+
+func fileStatusFromString(s string) pb.FileStatus {
+	switch s {
+	case "approved":
+		return pb.FileStatus_APPROVED
+	case "rejected":
+		return pb.FileStatus_REJECTED
+	default:
+		return pb.FileStatus_FILE_PENDING
+	}
+}
+
+func tableTypeToString(t pb.TableType) string {
+	switch t {
+	case pb.TableType_MIDTERM:
+		return "midterm"
+	case pb.TableType_FINAL:
+		return "final"
+	case pb.TableType_ORDER:
+		return "order"
+	case pb.TableType_GRADE_APPEAL:
+		return "grade_appeal"
+	case pb.TableType_MILESTONE_CHECKIN:
+		return "milestone_checkin"
+	default:
+		return "topic"
+	}
+}
+
+func tableTypeFromString(t string) pb.TableType {
+	switch t {
+	case "midterm":
+		return pb.TableType_MIDTERM
+	case "final":
+		return pb.TableType_FINAL
+	case "order":
+		return pb.TableType_ORDER
+	case "grade_appeal":
+		return pb.TableType_GRADE_APPEAL
+	case "milestone_checkin":
+		return pb.TableType_MILESTONE_CHECKIN
+	default:
+		return pb.TableType_TOPIC
+	}
+}
+
 // CreateFile creates a new File record
 func (h *Handler) CreateFile(ctx context.Context, req *pb.CreateFileRequest) (*pb.CreateFileResponse, error) {
 	defer logger.TraceFunction(ctx)()
@@ -39,41 +98,34 @@ func (h *Handler) CreateFile(ctx context.Context, req *pb.CreateFileRequest) (*p
 	// Prepare fields
 
 	// Convert Status enum to string
-	StatusValue := pb.FileStatus_FILE_PENDING
+	StatusStr := fileStatusToString(req.Status)
+	// Convert Table enum to string
+	TableStr := tableTypeToString(req.Table)
 
-	StatusValue = req.Status
-	StatusStr := "file_pending"
-	switch StatusValue {
-	case pb.FileStatus_FILE_PENDING:
-		StatusStr = "file_pending"
-	case pb.FileStatus_APPROVED:
-		StatusStr = "approved"
-	case pb.FileStatus_REJECTED:
-		StatusStr = "rejected"
+	// Assign the next version inside a transaction so concurrent uploads
+	// for the same (table, table_id, option) cannot get the same number
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
 	}
-	// Convert Table enum to string
-	TableValue := pb.TableType_TOPIC
+	defer tx.Rollback()
 
-	TableValue = req.Table
-	TableStr := "topic"
-	switch TableValue {
-	case pb.TableType_TOPIC:
-		TableStr = "topic"
-	case pb.TableType_MIDTERM:
-		TableStr = "midterm"
-	case pb.TableType_FINAL:
-		TableStr = "final"
-	case pb.TableType_ORDER:
-		TableStr = "order"
+	var version int32
+	err = tx.QueryRowContext(ctx,
+		"SELECT COALESCE(MAX(version), 0) + 1 FROM File WHERE `table` = ? AND `option` = ? AND table_id = ? FOR UPDATE",
+		TableStr, req.Option, req.TableId,
+	).Scan(&version)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get next file version: %v", err)
 	}
 
 	// Insert into database
 	query := `
-		INSERT INTO File (id, title, file, status, table, option, table_id, created_by, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
+		INSERT INTO File (id, title, file, status, ` + "`table`" + `, ` + "`option`" + `, table_id, version, size, checksum, content_type, late, quarantined, scan_result, created_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
 	`
 
-	_, err := h.execQuery(ctx, query,
+	_, err = tx.ExecContext(ctx, query,
 		id,
 		req.Title,
 		req.File,
@@ -81,6 +133,13 @@ func (h *Handler) CreateFile(ctx context.Context, req *pb.CreateFileRequest) (*p
 		TableStr,
 		req.Option,
 		req.TableId,
+		version,
+		req.Size,
+		req.Checksum,
+		req.ContentType,
+		req.Late,
+		req.Quarantined,
+		req.ScanResult,
 		req.CreatedBy,
 	)
 
@@ -91,6 +150,29 @@ func (h *Handler) CreateFile(ctx context.Context, req *pb.CreateFileRequest) (*p
 		return nil, status.Errorf(codes.Internal, "failed to create file: %v", err)
 	}
 
+	err = outbox.Write(ctx, tx, &pbEvent.Event{
+		Aggregate:   "File",
+		AggregateId: id,
+		Actor:       req.CreatedBy,
+		Payload: &pbEvent.Event_FileUploaded{FileUploaded: &pbEvent.FileUploaded{
+			FileId:      id,
+			Table:       TableStr,
+			TableId:     req.TableId,
+			Option:      req.Option,
+			Version:     version,
+			Size:        req.Size,
+			ContentType: req.ContentType,
+			Late:        req.Late,
+		}},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record file event: %v", err)
+	}
+
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit file: %v", err)
+	}
+
 	result, err := h.GetFile(ctx, &pb.GetFileRequest{Id: id})
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to get file")
@@ -109,14 +191,17 @@ func (h *Handler) GetFile(ctx context.Context, req *pb.GetFileRequest) (*pb.GetF
 	}
 
 	query := `
-		SELECT id, title, file, status, table, option, table_id, created_at, updated_at, created_by, updated_by
+		SELECT id, title, file, status, ` + "`table`" + `, ` + "`option`" + `, table_id, version, size, checksum, content_type, late, quarantined, scan_result, created_at, updated_at, created_by, updated_by, revision, deleted_at, deleted_by
 		FROM File
 		WHERE id = ?
 	`
+	if !req.IncludeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
 
 	var entity pb.File
-	var createdAt, updatedAt sql.NullTime
-	var updatedBy sql.NullString
+	var createdAt, updatedAt, deletedAt sql.NullTime
+	var updatedBy, deletedBy sql.NullString
 	var StatusStr string
 	var TableStr string
 
@@ -128,10 +213,20 @@ func (h *Handler) GetFile(ctx context.Context, req *pb.GetFileRequest) (*pb.GetF
 		&TableStr,
 		&entity.Option,
 		&entity.TableId,
+		&entity.Version,
+		&entity.Size,
+		&entity.Checksum,
+		&entity.ContentType,
+		&entity.Late,
+		&entity.Quarantined,
+		&entity.ScanResult,
 		&createdAt,
 		&updatedAt,
 		&entity.CreatedBy,
 		&updatedBy,
+		&entity.Revision,
+		&deletedAt,
+		&deletedBy,
 	)
 
 	if err != nil {
@@ -142,29 +237,9 @@ func (h *Handler) GetFile(ctx context.Context, req *pb.GetFileRequest) (*pb.GetF
 	}
 
 	// Convert Status string to enum
-	switch StatusStr {
-	case "file_pending":
-		entity.Status = pb.FileStatus_FILE_PENDING
-	case "approved":
-		entity.Status = pb.FileStatus_APPROVED
-	case "rejected":
-		entity.Status = pb.FileStatus_REJECTED
-	default:
-		entity.Status = pb.FileStatus_FILE_PENDING
-	}
+	entity.Status = fileStatusFromString(StatusStr)
 	// Convert Table string to enum
-	switch TableStr {
-	case "topic":
-		entity.Table = pb.TableType_TOPIC
-	case "midterm":
-		entity.Table = pb.TableType_MIDTERM
-	case "final":
-		entity.Table = pb.TableType_FINAL
-	case "order":
-		entity.Table = pb.TableType_ORDER
-	default:
-		entity.Table = pb.TableType_TOPIC
-	}
+	entity.Table = tableTypeFromString(TableStr)
 
 	if createdAt.Valid {
 		entity.CreatedAt = timestamppb.New(createdAt.Time)
@@ -175,6 +250,10 @@ func (h *Handler) GetFile(ctx context.Context, req *pb.GetFileRequest) (*pb.GetF
 	if updatedBy.Valid {
 		entity.UpdatedBy = updatedBy.String
 	}
+	if deletedAt.Valid {
+		entity.DeletedAt = timestamppb.New(deletedAt.Time)
+		entity.DeletedBy = deletedBy.String
+	}
 
 	return &pb.GetFileResponse{
 		File: &entity,
@@ -189,6 +268,44 @@ func (h *Handler) UpdateFile(ctx context.Context, req *pb.UpdateFileRequest) (*p
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
+	// The checks below and the update run on the locked row, so a concurrent
+	// review cannot slip in between
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var currentStatus string
+	var currentVersion int32
+	var quarantined bool
+	err = tx.QueryRowContext(ctx, `SELECT status, version, quarantined FROM File WHERE id = ? AND deleted_at IS NULL FOR UPDATE`, req.Id).
+		Scan(&currentStatus, &currentVersion, &quarantined)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "file not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get file: %v", err)
+	}
+
+	// Reviewed versions are immutable: only the title and the review itself
+	// may change, and the review cannot be undone
+	if fileStatusFromString(currentStatus) != pb.FileStatus_FILE_PENDING {
+		if req.File != nil || req.Table != nil || req.Option != nil || req.TableId != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "file version %d has been reviewed and can no longer be modified", currentVersion)
+		}
+		if req.Status != nil && *req.Status == pb.FileStatus_FILE_PENDING {
+			return nil, status.Errorf(codes.FailedPrecondition, "file version %d has been reviewed and cannot be set back to pending", currentVersion)
+		}
+	}
+
+	// A quarantined file cannot be approved until it has been cleared
+	if req.Status != nil && *req.Status == pb.FileStatus_APPROVED {
+		if (req.Quarantined == nil && quarantined) || (req.Quarantined != nil && *req.Quarantined) {
+			return nil, status.Error(codes.FailedPrecondition, "file is quarantined and must be cleared before approval")
+		}
+	}
+
 	// Build dynamic update query
 	updateFields := []string{}
 	args := []interface{}{}
@@ -205,36 +322,18 @@ func (h *Handler) UpdateFile(ctx context.Context, req *pb.UpdateFileRequest) (*p
 	}
 	if req.Status != nil {
 		updateFields = append(updateFields, "status = ?")
-		StatusStr := "file_pending"
-		switch *req.Status {
-		case pb.FileStatus_FILE_PENDING:
-			StatusStr = "file_pending"
-		case pb.FileStatus_APPROVED:
-			StatusStr = "approved"
-		case pb.FileStatus_REJECTED:
-			StatusStr = "rejected"
-		}
+		StatusStr := fileStatusToString(*req.Status)
 		args = append(args, StatusStr)
 
 	}
 	if req.Table != nil {
-		updateFields = append(updateFields, "table = ?")
-		TableStr := "topic"
-		switch *req.Table {
-		case pb.TableType_TOPIC:
-			TableStr = "topic"
-		case pb.TableType_MIDTERM:
-			TableStr = "midterm"
-		case pb.TableType_FINAL:
-			TableStr = "final"
-		case pb.TableType_ORDER:
-			TableStr = "order"
-		}
+		updateFields = append(updateFields, "`table` = ?")
+		TableStr := tableTypeToString(*req.Table)
 		args = append(args, TableStr)
 
 	}
 	if req.Option != nil {
-		updateFields = append(updateFields, "option = ?")
+		updateFields = append(updateFields, "`option` = ?")
 		args = append(args, *req.Option)
 
 	}
@@ -242,6 +341,16 @@ func (h *Handler) UpdateFile(ctx context.Context, req *pb.UpdateFileRequest) (*p
 		updateFields = append(updateFields, "table_id = ?")
 		args = append(args, *req.TableId)
 
+	}
+	if req.Quarantined != nil {
+		updateFields = append(updateFields, "quarantined = ?")
+		args = append(args, *req.Quarantined)
+
+	}
+	if req.ScanResult != nil {
+		updateFields = append(updateFields, "scan_result = ?")
+		args = append(args, *req.ScanResult)
+
 	}
 
 	if len(updateFields) == 0 {
@@ -253,19 +362,37 @@ func (h *Handler) UpdateFile(ctx context.Context, req *pb.UpdateFileRequest) (*p
 	args = append(args, req.UpdatedBy)
 	updateFields = append(updateFields, "updated_at = NOW()")
 
-	// Add id as last parameter
+	// Bump the revision (version is the document version); an expected
+	// revision only matches an unchanged record
+	updateFields = append(updateFields, "revision = revision + 1")
 	args = append(args, req.Id)
+	where := "id = ?"
+	if req.Revision != nil {
+		where += " AND revision = ?"
+		args = append(args, *req.Revision)
+	}
 
 	query := fmt.Sprintf(`
 		UPDATE File
 		SET %s
-		WHERE id = ?
-	`, strings.Join(updateFields, ", "))
+		WHERE %s AND deleted_at IS NULL
+	`, strings.Join(updateFields, ", "), where)
 
-	_, err := h.execQuery(ctx, query, args...)
+	updated, err := tx.ExecContext(ctx, query, args...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update file: %v", err)
 	}
+	if n, _ := updated.RowsAffected(); n == 0 {
+		tx.Rollback()
+		current, err := h.GetFile(ctx, &pb.GetFileRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		return nil, helper.VersionConflict("file", current.GetFile(), current.GetFile().Revision)
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit file: %v", err)
+	}
 
 	result, err := h.GetFile(ctx, &pb.GetFileRequest{Id: req.Id})
 	if err != nil {
@@ -276,7 +403,7 @@ func (h *Handler) UpdateFile(ctx context.Context, req *pb.UpdateFileRequest) (*p
 	}, nil
 }
 
-// DeleteFile deletes a File by ID
+// DeleteFile soft-deletes a File by ID
 func (h *Handler) DeleteFile(ctx context.Context, req *pb.DeleteFileRequest) (*pb.DeleteFileResponse, error) {
 	defer logger.TraceFunction(ctx)()
 
@@ -284,12 +411,58 @@ func (h *Handler) DeleteFile(ctx context.Context, req *pb.DeleteFileRequest) (*p
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	query := `DELETE FROM File WHERE id = ?`
+	// The review check and the delete run on the locked row, so a concurrent
+	// review cannot slip in between
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
 
-	result, err := h.execQuery(ctx, query, req.Id)
+	var currentStatus string
+	var currentVersion int32
+	err = tx.QueryRowContext(ctx, `SELECT status, version FROM File WHERE id = ? AND deleted_at IS NULL FOR UPDATE`, req.Id).
+		Scan(&currentStatus, &currentVersion)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "file not found")
+	}
 	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get file: %v", err)
+	}
+	if fileStatusFromString(currentStatus) != pb.FileStatus_FILE_PENDING {
+		return nil, status.Errorf(codes.FailedPrecondition, "file version %d has been reviewed and cannot be deleted", currentVersion)
+	}
+
+	// Soft delete; the gateway's retention job purges the row and its object later
+	query := `UPDATE File SET deleted_at = NOW(), deleted_by = ?, revision = revision + 1 WHERE id = ? AND deleted_at IS NULL`
+
+	if _, err := tx.ExecContext(ctx, query, req.DeletedBy, req.Id); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete file: %v", err)
 	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit file: %v", err)
+	}
+
+	return &pb.DeleteFileResponse{
+		Success: true,
+	}, nil
+}
+
+// RestoreFile brings back a soft-deleted File
+func (h *Handler) RestoreFile(ctx context.Context, req *pb.RestoreFileRequest) (*pb.RestoreFileResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	// A file claimed by the retention job may have lost its object already
+	query := `UPDATE File SET deleted_at = NULL, deleted_by = NULL, updated_by = ?, updated_at = NOW(), revision = revision + 1 WHERE id = ? AND deleted_at IS NOT NULL AND purge_claimed_at IS NULL`
+
+	result, err := h.execQuery(ctx, query, req.RestoredBy, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore file: %v", err)
+	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
@@ -297,11 +470,24 @@ func (h *Handler) DeleteFile(ctx context.Context, req *pb.DeleteFileRequest) (*p
 	}
 
 	if rowsAffected == 0 {
-		return nil, status.Error(codes.NotFound, "file not found")
+		// Unknown ids are NotFound; a live record has nothing to restore, and
+		// a deleted one left is being purged
+		current, err := h.GetFile(ctx, &pb.GetFileRequest{Id: req.Id, IncludeDeleted: true})
+		if err != nil {
+			return nil, err
+		}
+		if current.GetFile().DeletedAt != nil {
+			return nil, status.Error(codes.FailedPrecondition, "file is being purged and can no longer be restored")
+		}
+		return nil, status.Error(codes.FailedPrecondition, "file is not deleted")
 	}
 
-	return &pb.DeleteFileResponse{
-		Success: true,
+	restored, err := h.GetFile(ctx, &pb.GetFileRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreFileResponse{
+		File: restored.GetFile(),
 	}, nil
 }
 
@@ -312,8 +498,6 @@ func (h *Handler) ListFiles(ctx context.Context, req *pb.ListFilesRequest) (*pb.
 	// Default pagination
 	page := int32(1)
 	pageSize := int32(10)
-	sortBy := "created_at"
-	descending := true
 	if req.Search != nil && req.Search.Pagination != nil {
 		if req.Search.Pagination.Page > 0 {
 			page = req.Search.Pagination.Page
@@ -321,54 +505,40 @@ func (h *Handler) ListFiles(ctx context.Context, req *pb.ListFilesRequest) (*pb.
 		if req.Search.Pagination.PageSize > 0 {
 			pageSize = req.Search.Pagination.PageSize
 		}
-		if req.Search.Pagination.SortBy != "" {
-			sortBy = req.Search.Pagination.SortBy
-		}
-		descending = req.Search.Pagination.Descending
 	}
 
 	// Calculate offset
 	offset := (page - 1) * pageSize
 
 	// Build WHERE clause from filters
-	whereClause := ""
 	args := []interface{}{}
-	whiteMap := map[string]bool{
-		"id": true,
-
-		"title":    true,
-		"file":     true,
-		"status":   true,
-		"table":    true,
-		"option":   true,
-		"table_id": true,
-	}
-	if req.Search != nil && len(req.Search.Filters) > 0 {
-		whereConditions := []string{}
-		for _, filter := range req.Search.Filters {
-			if filter.GetCondition() != nil {
-				condition := filter.GetCondition()
-				if _, ok := whiteMap[condition.Field]; !ok {
-					continue
-				}
-				whereConditions = append(whereConditions, helper.BuildFilterCondition(condition, &args))
-			}
-		}
-		if len(whereConditions) > 0 {
-			whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
-		}
+	filterFields := helper.FilterFields{
+		"id":         {},
+		"title":      {FullText: true},
+		"file":       {},
+		"status":     {Type: helper.FieldEnum, Values: []string{"pending", "approved", "rejected"}, Aliases: map[string]string{"FILE_PENDING": "pending"}},
+		"table":      {Type: helper.FieldEnum, Values: []string{"topic", "midterm", "final", "order", "grade_appeal", "milestone_checkin"}},
+		"option":     {},
+		"table_id":   {},
+		"created_at": {Type: helper.FieldTime},
+		"updated_at": {Type: helper.FieldTime},
+	}
+	whereClause, err := helper.BuildWhere(req.Search.GetFilters(), filterFields, &args)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
+	whereClause = helper.NotDeleted(whereClause, req.IncludeDeleted)
 
 	// Build ORDER BY clause
-	sortDirection := "ASC"
-	if descending {
-		sortDirection = "DESC"
+	orderBy, err := helper.BuildOrderBy(req.Search.GetPagination(), filterFields)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %v", err)
 	}
 
 	// Get total count
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM File %s", whereClause)
 	var total int32
-	err := h.queryRow(ctx, countQuery, args...).Scan(&total)
+	err = h.queryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to count files: %v", err)
 	}
@@ -376,12 +546,12 @@ func (h *Handler) ListFiles(ctx context.Context, req *pb.ListFilesRequest) (*pb.
 	// Get entities with pagination
 	args = append(args, pageSize, offset)
 	query := fmt.Sprintf(`
-		SELECT id, title, file, status, table, option, table_id, created_at, updated_at, created_by, updated_by
+		SELECT id, title, file, status, `+"`table`"+`, `+"`option`"+`, table_id, version, size, checksum, content_type, late, quarantined, scan_result, created_at, updated_at, created_by, updated_by, revision, deleted_at, deleted_by
 		FROM File
 		%s
-		ORDER BY %s %s
+		%s
 		LIMIT ? OFFSET ?
-	`, whereClause, sortBy, sortDirection)
+	`, whereClause, orderBy)
 
 	rows, err := h.query(ctx, query, args...)
 	if err != nil {
@@ -392,8 +562,8 @@ func (h *Handler) ListFiles(ctx context.Context, req *pb.ListFilesRequest) (*pb.
 	entities := []*pb.File{}
 	for rows.Next() {
 		var entity pb.File
-		var createdAt, updatedAt sql.NullTime
-		var updatedBy sql.NullString
+		var createdAt, updatedAt, deletedAt sql.NullTime
+		var updatedBy, deletedBy sql.NullString
 		var StatusStr string
 		var TableStr string
 
@@ -405,39 +575,29 @@ func (h *Handler) ListFiles(ctx context.Context, req *pb.ListFilesRequest) (*pb.
 			&TableStr,
 			&entity.Option,
 			&entity.TableId,
+			&entity.Version,
+			&entity.Size,
+			&entity.Checksum,
+			&entity.ContentType,
+			&entity.Late,
+			&entity.Quarantined,
+			&entity.ScanResult,
 			&createdAt,
 			&updatedAt,
 			&entity.CreatedBy,
 			&updatedBy,
+			&entity.Revision,
+			&deletedAt,
+			&deletedBy,
 		)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan file: %v", err)
 		}
 
 		// Convert Status string to enum
-		switch StatusStr {
-		case "file_pending":
-			entity.Status = pb.FileStatus_FILE_PENDING
-		case "approved":
-			entity.Status = pb.FileStatus_APPROVED
-		case "rejected":
-			entity.Status = pb.FileStatus_REJECTED
-		default:
-			entity.Status = pb.FileStatus_FILE_PENDING
-		}
+		entity.Status = fileStatusFromString(StatusStr)
 		// Convert Table string to enum
-		switch TableStr {
-		case "topic":
-			entity.Table = pb.TableType_TOPIC
-		case "midterm":
-			entity.Table = pb.TableType_MIDTERM
-		case "final":
-			entity.Table = pb.TableType_FINAL
-		case "order":
-			entity.Table = pb.TableType_ORDER
-		default:
-			entity.Table = pb.TableType_TOPIC
-		}
+		entity.Table = tableTypeFromString(TableStr)
 
 		if createdAt.Valid {
 			entity.CreatedAt = timestamppb.New(createdAt.Time)
@@ -448,6 +608,10 @@ func (h *Handler) ListFiles(ctx context.Context, req *pb.ListFilesRequest) (*pb.
 		if updatedBy.Valid {
 			entity.UpdatedBy = updatedBy.String
 		}
+		if deletedAt.Valid {
+			entity.DeletedAt = timestamppb.New(deletedAt.Time)
+			entity.DeletedBy = deletedBy.String
+		}
 
 		entities = append(entities, &entity)
 	}
@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	pb "thaily/proto/file"
+	"thaily/src/pkg/logger"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultExpiredLimit = 100
+	maxExpiredLimit     = 500
+)
+
+// Retention. The file service has no access to the object store, so the
+// gateway purges files: it lists the expired ones, claims each, which makes it
+// no longer restorable, removes its object and then purges the row.
+
+// ListExpiredFiles lists files soft-deleted longer than the retention period,
+// oldest deletion first
+func (h *Handler) ListExpiredFiles(ctx context.Context, req *pb.ListExpiredFilesRequest) (*pb.ListExpiredFilesResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.RetentionSeconds <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "retention_seconds must be positive")
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultExpiredLimit
+	}
+	if limit > maxExpiredLimit {
+		limit = maxExpiredLimit
+	}
+	cutoff := time.Now().Add(-time.Duration(req.RetentionSeconds) * time.Second)
+
+	rows, err := h.query(ctx, "SELECT "+fileColumns+" FROM File WHERE deleted_at < ? ORDER BY deleted_at LIMIT ?", cutoff, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list expired files: %v", err)
+	}
+	defer rows.Close()
+
+	entities := []*pb.File{}
+	for rows.Next() {
+		entity, err := scanFile(rows.Scan)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan file: %v", err)
+		}
+		entities = append(entities, entity)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating expired files: %v", err)
+	}
+
+	return &pb.ListExpiredFilesResponse{
+		Files: entities,
+	}, nil
+}
+
+// ClaimFilePurge marks a file soft-deleted past the retention period as being
+// purged, so RestoreFile refuses it before its object is removed. Claiming a
+// file already claimed succeeds, so a purge whose object removal failed is
+// retried.
+func (h *Handler) ClaimFilePurge(ctx context.Context, req *pb.ClaimFilePurgeRequest) (*pb.ClaimFilePurgeResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.RetentionSeconds <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "retention_seconds must be positive")
+	}
+	cutoff := time.Now().Add(-time.Duration(req.RetentionSeconds) * time.Second)
+
+	result, err := h.execQuery(ctx, "UPDATE File SET purge_claimed_at = NOW() WHERE id = ? AND deleted_at < ? AND purge_claimed_at IS NULL", req.Id, cutoff)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to claim file: %v", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		var claimed bool
+		err := h.queryRow(ctx, "SELECT purge_claimed_at IS NOT NULL FROM File WHERE id = ?", req.Id).Scan(&claimed)
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "file not found")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get file: %v", err)
+		}
+		if !claimed {
+			// Restored, or deleted too recently, in the meantime
+			return nil, status.Error(codes.FailedPrecondition, "file is not past the retention period")
+		}
+	}
+
+	return &pb.ClaimFilePurgeResponse{
+		Success: true,
+	}, nil
+}
+
+// PurgeFile hard-deletes a file claimed by ClaimFilePurge; its fingerprints
+// go through ON DELETE CASCADE
+func (h *Handler) PurgeFile(ctx context.Context, req *pb.PurgeFileRequest) (*pb.PurgeFileResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.RetentionSeconds <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "retention_seconds must be positive")
+	}
+	cutoff := time.Now().Add(-time.Duration(req.RetentionSeconds) * time.Second)
+
+	result, err := h.execQuery(ctx, "DELETE FROM File WHERE id = ? AND deleted_at < ? AND purge_claimed_at IS NOT NULL", req.Id, cutoff)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to purge file: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		if _, err := h.GetFile(ctx, &pb.GetFileRequest{Id: req.Id, IncludeDeleted: true}); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, "file is not claimed for purging past the retention period")
+	}
+
+	return &pb.PurgeFileResponse{
+		Success: true,
+	}, nil
+}
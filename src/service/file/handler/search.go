@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"context"
+
+	pbCommon "thaily/proto/common"
+	pb "thaily/proto/file"
+	"thaily/src/pkg/helper"
+	"thaily/src/pkg/logger"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SearchFiles ranks files by how well their title matches the query, through
+// an ngram FULLTEXT index like SearchTopics. Quarantined files are never
+// returned.
+func (h *Handler) SearchFiles(ctx context.Context, req *pb.SearchFilesRequest) (*pb.SearchFilesResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	query := helper.FullTextQuery(req.Query)
+	if query == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+
+	where := "MATCH(title) AGAINST (? IN BOOLEAN MODE) AND quarantined = false AND deleted_at IS NULL"
+	args := []interface{}{query, query}
+	if req.CreatedBy != nil {
+		where += " AND created_by = ?"
+		args = append(args, *req.CreatedBy)
+	}
+	args = append(args, helper.SearchLimit(req.Limit))
+
+	rows, err := h.query(ctx, `
+		SELECT id, title, `+"`table`"+`, MATCH(title) AGAINST (? IN BOOLEAN MODE) AS score
+		FROM File
+		WHERE `+where+`
+		ORDER BY score DESC, id
+		LIMIT ?
+	`, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search files: %v", err)
+	}
+	defer rows.Close()
+
+	hits := []*pbCommon.SearchHit{}
+	for rows.Next() {
+		hit := &pbCommon.SearchHit{Type: "file"}
+		if err := rows.Scan(&hit.Id, &hit.Title, &hit.Subtitle, &hit.Score); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan file: %v", err)
+		}
+		hits = append(hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search files: %v", err)
+	}
+
+	return &pb.SearchFilesResponse{Hits: hits}, nil
+}
@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strings"
+	"time"
+
+	pb "thaily/proto/file"
+	"thaily/src/pkg/logger"
+	"thaily/src/pkg/similarity"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultSimilarityTopN = 5
+	maxSimilarityTopN     = 20
+
+	// maxReportPassages is how many shared passages are reported per match
+	maxReportPassages = 5
+)
+
+// IndexFileContent stores the MinHash fingerprint and word stream of a
+// submission. Re-indexing a file replaces its fingerprint.
+func (h *Handler) IndexFileContent(ctx context.Context, req *pb.IndexFileContentRequest) (*pb.IndexFileContentResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.FileId == "" {
+		return nil, status.Error(codes.InvalidArgument, "file_id is required")
+	}
+	if req.SemesterCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester_code is required")
+	}
+
+	fileResp, err := h.GetFile(ctx, &pb.GetFileRequest{Id: req.FileId})
+	if err != nil {
+		return nil, err
+	}
+	if fileResp.File.Quarantined {
+		return nil, status.Error(codes.FailedPrecondition, "quarantined files cannot be indexed")
+	}
+
+	words := similarity.Words(req.Text)
+	shingles := similarity.Shingles(words)
+	signature := similarity.NewSignature(shingles)
+
+	now := time.Now()
+	_, err = h.execQuery(ctx, `
+		INSERT INTO File_fingerprint (file_id, semester_code, student_code, word_count, signature, content, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE semester_code = VALUES(semester_code), word_count = VALUES(word_count),
+			signature = VALUES(signature), content = VALUES(content), updated_at = VALUES(updated_at)
+	`, req.FileId, req.SemesterCode, fileResp.File.CreatedBy, len(words), signature.Bytes(), strings.Join(words, " "), now, now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store fingerprint: %v", err)
+	}
+
+	return &pb.IndexFileContentResponse{
+		WordCount:    int32(len(words)),
+		ShingleCount: int32(len(shingles)),
+	}, nil
+}
+
+// similarityCandidate is an indexed submission considered for a report
+type similarityCandidate struct {
+	fileID     string
+	semester   string
+	similarity float64
+}
+
+// GetSimilarityReport compares a submission against the other students'
+// submissions of the same semester and everything indexed before it in earlier
+// semesters. MinHash similarity shortlists candidates; the shortlist is then
+// compared word by word for the overlap percentage and shared passages.
+func (h *Handler) GetSimilarityReport(ctx context.Context, req *pb.GetSimilarityReportRequest) (*pb.GetSimilarityReportResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.FileId == "" {
+		return nil, status.Error(codes.InvalidArgument, "file_id is required")
+	}
+
+	topN := int(req.TopN)
+	if topN <= 0 {
+		topN = defaultSimilarityTopN
+	}
+	if topN > maxSimilarityTopN {
+		topN = maxSimilarityTopN
+	}
+
+	var semester, studentCode, content string
+	var wordCount int32
+	var signatureBytes []byte
+	var indexedAt time.Time
+	err := h.queryRow(ctx, `
+		SELECT semester_code, student_code, word_count, signature, content, created_at
+		FROM File_fingerprint
+		WHERE file_id = ?
+	`, req.FileId).Scan(&semester, &studentCode, &wordCount, &signatureBytes, &content, &indexedAt)
+	if err == sql.ErrNoRows {
+		return &pb.GetSimilarityReportResponse{FileId: req.FileId, Indexed: false}, nil
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get fingerprint: %v", err)
+	}
+
+	signature, err := similarity.ParseSignature(signatureBytes)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode fingerprint: %v", err)
+	}
+
+	resp := &pb.GetSimilarityReportResponse{
+		FileId:    req.FileId,
+		Indexed:   true,
+		WordCount: wordCount,
+		IndexedAt: timestamppb.New(indexedAt),
+	}
+	if wordCount == 0 {
+		return resp, nil
+	}
+
+	rows, err := h.query(ctx, `
+		SELECT file_id, semester_code, signature
+		FROM File_fingerprint
+		WHERE file_id <> ? AND student_code <> ? AND word_count > 0
+			AND (semester_code = ? OR created_at < ?)
+	`, req.FileId, studentCode, semester, indexedAt)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list fingerprints: %v", err)
+	}
+	defer rows.Close()
+
+	candidates := []similarityCandidate{}
+	for rows.Next() {
+		var candidate similarityCandidate
+		var candidateBytes []byte
+		if err := rows.Scan(&candidate.fileID, &candidate.semester, &candidateBytes); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan fingerprint: %v", err)
+		}
+		candidateSignature, err := similarity.ParseSignature(candidateBytes)
+		if err != nil {
+			continue
+		}
+		candidate.similarity = signature.Jaccard(candidateSignature)
+		if candidate.similarity > 0 {
+			candidates = append(candidates, candidate)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list fingerprints: %v", err)
+	}
+
+	// Short documents copied into long ones have a low Jaccard similarity but a
+	// high overlap, so the shortlist is wider than the report
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].similarity > candidates[j].similarity
+	})
+	if len(candidates) > topN*3 {
+		candidates = candidates[:topN*3]
+	}
+
+	words := strings.Fields(content)
+	for _, candidate := range candidates {
+		var sourceContent string
+		err := h.queryRow(ctx, "SELECT content FROM File_fingerprint WHERE file_id = ?", candidate.fileID).Scan(&sourceContent)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				continue
+			}
+			return nil, status.Errorf(codes.Internal, "failed to get fingerprint: %v", err)
+		}
+
+		overlap, passages := similarity.Overlap(words, strings.Fields(sourceContent), maxReportPassages)
+		if overlap == 0 {
+			continue
+		}
+
+		fileResp, err := h.GetFile(ctx, &pb.GetFileRequest{Id: candidate.fileID})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+			return nil, err
+		}
+
+		match := &pb.SimilarityMatch{
+			File:           fileResp.File,
+			SemesterCode:   candidate.semester,
+			Similarity:     candidate.similarity,
+			OverlapPercent: overlap,
+		}
+		for _, p := range passages {
+			match.Passages = append(match.Passages, &pb.MatchedPassage{
+				Text:         p.Text,
+				Offset:       int32(p.Offset),
+				SourceOffset: int32(p.SourceOffset),
+				Words:        int32(p.Words),
+			})
+		}
+		resp.Matches = append(resp.Matches, match)
+	}
+
+	sort.SliceStable(resp.Matches, func(i, j int) bool {
+		return resp.Matches[i].OverlapPercent > resp.Matches[j].OverlapPercent
+	})
+	if len(resp.Matches) > topN {
+		resp.Matches = resp.Matches[:topN]
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	pb "thaily/proto/file"
+	"thaily/src/pkg/logger"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const fileColumns = "id, title, file, status, `table`, `option`, table_id, version, size, checksum, content_type, late, quarantined, scan_result, created_at, updated_at, created_by, updated_by, revision, deleted_at, deleted_by"
+
+// scanFile scans a row selected with fileColumns
+func scanFile(scan func(dest ...interface{}) error) (*pb.File, error) {
+	var entity pb.File
+	var createdAt, updatedAt, deletedAt sql.NullTime
+	var updatedBy, deletedBy sql.NullString
+	var StatusStr string
+	var TableStr string
+
+	err := scan(
+		&entity.Id,
+		&entity.Title,
+		&entity.File,
+		&StatusStr,
+		&TableStr,
+		&entity.Option,
+		&entity.TableId,
+		&entity.Version,
+		&entity.Size,
+		&entity.Checksum,
+		&entity.ContentType,
+		&entity.Late,
+		&entity.Quarantined,
+		&entity.ScanResult,
+		&createdAt,
+		&updatedAt,
+		&entity.CreatedBy,
+		&updatedBy,
+		&entity.Revision,
+		&deletedAt,
+		&deletedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entity.Status = fileStatusFromString(StatusStr)
+	entity.Table = tableTypeFromString(TableStr)
+	if createdAt.Valid {
+		entity.CreatedAt = timestamppb.New(createdAt.Time)
+	}
+	if updatedAt.Valid {
+		entity.UpdatedAt = timestamppb.New(updatedAt.Time)
+	}
+	if updatedBy.Valid {
+		entity.UpdatedBy = updatedBy.String
+	}
+	if deletedAt.Valid {
+		entity.DeletedAt = timestamppb.New(deletedAt.Time)
+		entity.DeletedBy = deletedBy.String
+	}
+	return &entity, nil
+}
+
+// ListFileVersions lists every version submitted for a (table, table_id, option), newest first
+func (h *Handler) ListFileVersions(ctx context.Context, req *pb.ListFileVersionsRequest) (*pb.ListFileVersionsResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.TableId == "" {
+		return nil, status.Error(codes.InvalidArgument, "table_id is required")
+	}
+	if req.Option == "" {
+		return nil, status.Error(codes.InvalidArgument, "option is required")
+	}
+
+	TableStr := tableTypeToString(req.Table)
+
+	query := "SELECT " + fileColumns + " FROM File WHERE `table` = ? AND `option` = ? AND table_id = ?"
+	if !req.IncludeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
+	query += " ORDER BY version DESC"
+
+	rows, err := h.query(ctx, query, TableStr, req.Option, req.TableId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list file versions: %v", err)
+	}
+	defer rows.Close()
+
+	entities := []*pb.File{}
+	for rows.Next() {
+		entity, err := scanFile(rows.Scan)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan file: %v", err)
+		}
+		entities = append(entities, entity)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating file versions: %v", err)
+	}
+
+	return &pb.ListFileVersionsResponse{
+		Files: entities,
+	}, nil
+}
@@ -1,15 +1,20 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"os"
 
 	pb "thaily/proto/file"
+	"thaily/src/pkg/audit"
 	"thaily/src/pkg/database"
 	"thaily/src/pkg/logger"
+	"thaily/src/pkg/migrate"
+	"thaily/src/pkg/outbox"
 	"thaily/src/pkg/tls"
 	"thaily/src/service/file/handler"
+	"thaily/src/service/file/migrations"
 
 	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
@@ -28,11 +33,41 @@ func main() {
 	defer logger.GetFileLogger().Close()
 
 	// Initialize database
-	if err := database.InitDB(); err != nil {
+	if err := database.InitDB("file"); err != nil {
 		log.Fatalf("Failed to initialize database: %v", err)
 	}
 	defer database.CloseDB()
 
+	// Run the migrate command, or apply pending migrations on startup
+	migrator, err := migrate.New(database.GetDB(), "file", migrations.FS)
+	if err != nil {
+		log.Fatalf("Failed to load migrations: %v", err)
+	}
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := migrate.Run(context.Background(), database.GetDB(), migrator, handler.EnumColumns(), os.Args[2:]); err != nil {
+			log.Fatalf("Migrate failed: %v", err)
+		}
+		return
+	}
+	if err := migrate.OnStartup(context.Background(), migrator); err != nil {
+		log.Fatalf("Failed to apply migrations: %v", err)
+	}
+
+	// Publish the events written to the outbox to the event bus
+	bus, closeBus, err := outbox.Connect(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to connect to the event bus: %v", err)
+	}
+	defer closeBus()
+	go outbox.NewRelay(database.GetDB(), bus, "file").Run(context.Background(), outbox.Interval(), outbox.Retention())
+
+	// Append every mutation to the audit log
+	auditStore, closeAudit, err := audit.Connect(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to connect to the audit log: %v", err)
+	}
+	defer closeAudit()
+
 	// Verify TLS certificates exist
 	if err := tls.VerifyCertificatesExist("file"); err != nil {
 		log.Fatalf("TLS certificate verification failed: %v", err)
@@ -57,7 +92,10 @@ func main() {
 
 	grpcServer := grpc.NewServer(
 		grpc.Creds(creds),
-		grpc.UnaryInterceptor(logger.UnaryServerInterceptor()),
+		grpc.ChainUnaryInterceptor(
+			logger.UnaryServerInterceptor(),
+			audit.UnaryServerInterceptor(auditStore, database.GetDB(), "file", handler.Audited()),
+		),
 	)
 
 	h := handler.NewHandler(database.GetDB())
@@ -0,0 +1,6 @@
+SET FOREIGN_KEY_CHECKS = 0;
+
+DROP TABLE IF EXISTS `File_fingerprint`;
+DROP TABLE IF EXISTS `File`;
+
+SET FOREIGN_KEY_CHECKS = 1;
@@ -0,0 +1,38 @@
+-- Initial file service schema
+
+CREATE TABLE `File` (
+  `id` varchar(255) PRIMARY KEY,
+  `title` varchar(255) COLLATE utf8mb4_0900_ai_ci NOT NULL,
+  `file` varchar(255) NOT NULL,
+  `status` ENUM ('pending', 'approved', 'rejected') NOT NULL,
+  `table` ENUM ('topic', 'midterm', 'final', 'order', 'grade_appeal', 'milestone_checkin') NOT NULL,
+  `option` varchar(255),
+  `table_id` varchar(255) NOT NULL,
+  `version` int NOT NULL DEFAULT 1,
+  `size` bigint NOT NULL DEFAULT 0,
+  `checksum` varchar(80) NOT NULL DEFAULT '',
+  `content_type` varchar(255) NOT NULL DEFAULT '',
+  `late` boolean NOT NULL DEFAULT false,
+  `quarantined` boolean NOT NULL DEFAULT false,
+  `scan_result` varchar(255) NOT NULL DEFAULT '',
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL,
+  UNIQUE KEY `uq_file_version` (`table`, `table_id`, `option`, `version`),
+  FULLTEXT KEY `ft_file_title` (`title`)
+);
+
+CREATE TABLE `File_fingerprint` (
+  `file_id` varchar(255) PRIMARY KEY,
+  `semester_code` varchar(255) NOT NULL,
+  `student_code` varchar(255) NOT NULL,
+  `word_count` int NOT NULL DEFAULT 0,
+  `signature` varbinary(1024) NOT NULL,
+  `content` mediumtext NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  KEY `idx_file_fingerprint_semester` (`semester_code`, `created_at`)
+);
+
+ALTER TABLE `File_fingerprint` ADD FOREIGN KEY (`file_id`) REFERENCES `File` (`id`) ON DELETE CASCADE;
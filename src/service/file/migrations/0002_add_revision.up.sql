@@ -0,0 +1,5 @@
+-- Optimistic concurrency: every update bumps `revision`, and an update that
+-- carries the expected revision only applies to a record still at it
+-- (File.version already numbers the versions of a document)
+
+ALTER TABLE `File` ADD COLUMN `revision` INT NOT NULL DEFAULT 1;
@@ -0,0 +1 @@
+ALTER TABLE `File` DROP KEY `idx_file_deleted_at`, DROP COLUMN `deleted_by`, DROP COLUMN `deleted_at`;
@@ -0,0 +1,5 @@
+-- Soft delete: Delete* only stamps `deleted_at`/`deleted_by`, Get*/List*
+-- skip stamped rows unless asked, Restore* clears them, and the retention
+-- job hard-deletes rows stamped longer ago than RETENTION_PERIOD
+
+ALTER TABLE `File` ADD COLUMN `deleted_at` datetime NULL, ADD COLUMN `deleted_by` varchar(255) NULL, ADD KEY `idx_file_deleted_at` (`deleted_at`);
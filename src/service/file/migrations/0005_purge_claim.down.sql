@@ -0,0 +1 @@
+ALTER TABLE `File` DROP COLUMN `purge_claimed_at`;
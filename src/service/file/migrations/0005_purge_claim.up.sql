@@ -0,0 +1,4 @@
+-- The retention job claims a file before removing its object, so the row can
+-- no longer be restored once the object may be gone
+
+ALTER TABLE `File` ADD COLUMN `purge_claimed_at` datetime NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE `File` DROP INDEX `ft_file_title`;
+ALTER TABLE `File` ADD FULLTEXT KEY `ft_file_title` (`title`);
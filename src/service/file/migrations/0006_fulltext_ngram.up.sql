@@ -0,0 +1,5 @@
+-- File titles are indexed with the ngram parser, like Topic titles: the
+-- default parser skips the 2-character Vietnamese syllables
+
+ALTER TABLE `File` DROP INDEX `ft_file_title`;
+ALTER TABLE `File` ADD FULLTEXT KEY `ft_file_title` (`title`) WITH PARSER ngram;
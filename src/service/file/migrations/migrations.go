@@ -0,0 +1,9 @@
+// Package migrations embeds the file service's schema migrations
+package migrations
+
+import "embed"
+
+// FS holds the NNNN_name.up.sql / NNNN_name.down.sql files
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,10 @@
+package handler
+
+import "thaily/src/pkg/audit"
+
+// Audited maps the entities named in the role RPCs to their tables
+func Audited() []audit.Entity {
+	return []audit.Entity{
+		{Name: "RoleSystem", Table: "RoleSystem"},
+	}
+}
@@ -0,0 +1,15 @@
+package handler
+
+import (
+	pb "thaily/proto/role"
+	"thaily/src/pkg/migrate"
+)
+
+// EnumColumns lists the ENUM columns of the role tables with the values
+// the handlers write, for the migrate check command
+func EnumColumns() []migrate.EnumColumn {
+	return []migrate.EnumColumn{
+		{Table: "RoleSystem", Column: "role", Enum: "RoleType",
+			Values: migrate.EnumValues(pb.RoleType_name, func(v int32) string { return roleTypeToString(pb.RoleType(v)) })},
+	}
+}
@@ -0,0 +1,12 @@
+package handler
+
+import "thaily/src/pkg/reference"
+
+// References lists the columns of the role tables that point at other
+// services' tables, for the orphan report
+func References() []reference.Column {
+	return []reference.Column{
+		{Table: "RoleSystem", Column: "teacher_code", References: "Teacher"},
+		{Table: "RoleSystem", Column: "semester_code", References: "Semester"},
+	}
+}
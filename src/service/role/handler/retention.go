@@ -0,0 +1,10 @@
+package handler
+
+import "thaily/src/pkg/retention"
+
+// SoftDeleted lists the role tables purged by the retention job
+func SoftDeleted() []retention.Table {
+	return []retention.Table{
+		{Name: "RoleSystem"},
+	}
+}
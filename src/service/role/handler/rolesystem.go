@@ -15,6 +15,29 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// RoleSystem.role keeps the capitalisation of its ENUM declaration
+func roleTypeToString(r pb.RoleType) string {
+	switch r {
+	case pb.RoleType_DEPARTMENT_LECTURER:
+		return "Department_Lecturer"
+	case pb.RoleType_TEACHER:
+		return "Teacher"
+	default:
+		return "Academic_affairs_staff"
+	}
+}
+
+func roleTypeFromString(r string) pb.RoleType {
+	switch strings.ToLower(r) {
+	case "academic_affairs_staff":
+		return pb.RoleType_ACADEMIC_AFFAIRS_STAFF
+	case "department_lecturer":
+		return pb.RoleType_DEPARTMENT_LECTURER
+	default:
+		return pb.RoleType_TEACHER
+	}
+}
+
 // CreateRoleSystem creates a new RoleSystem record
 func (h *Handler) CreateRoleSystem(ctx context.Context, req *pb.CreateRoleSystemRequest) (*pb.CreateRoleSystemResponse, error) {
 	defer logger.TraceFunction(ctx)()
@@ -30,24 +53,21 @@ func (h *Handler) CreateRoleSystem(ctx context.Context, req *pb.CreateRoleSystem
 		return nil, status.Error(codes.InvalidArgument, "semester_code is required")
 	}
 
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "teacher_code", "Teacher", req.TeacherCode); err != nil {
+		return nil, err
+	}
+	if err := h.refs.Require(ctx, "semester_code", "Semester", req.SemesterCode); err != nil {
+		return nil, err
+	}
+
 	// Generate UUID
 	id := uuid.New().String()
 
 	// Prepare fields
 
 	// Convert Role enum to string
-	RoleValue := pb.RoleType_ACADEMIC_AFFAIRS_STAFF
-
-	RoleValue = req.Role
-	RoleStr := "academic_affairs_staff"
-	switch RoleValue {
-	case pb.RoleType_ACADEMIC_AFFAIRS_STAFF:
-		RoleStr = "academic_affairs_staff"
-	case pb.RoleType_DEPARTMENT_LECTURER:
-		RoleStr = "department_lecturer"
-	case pb.RoleType_TEACHER:
-		RoleStr = "teacher"
-	}
+	RoleStr := roleTypeToString(req.Role)
 
 	// Insert into database
 	query := `
@@ -90,14 +110,17 @@ func (h *Handler) GetRoleSystem(ctx context.Context, req *pb.GetRoleSystemReques
 	}
 
 	query := `
-		SELECT id, title, teacher_code, role, semester_code, activate, created_at, updated_at, created_by, updated_by
+		SELECT id, title, teacher_code, role, semester_code, activate, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM RoleSystem
 		WHERE id = ?
 	`
+	if !req.IncludeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
 
 	var entity pb.RoleSystem
-	var createdAt, updatedAt sql.NullTime
-	var updatedBy sql.NullString
+	var createdAt, updatedAt, deletedAt sql.NullTime
+	var updatedBy, deletedBy sql.NullString
 	var RoleStr string
 
 	err := h.queryRow(ctx, query, req.Id).Scan(
@@ -111,6 +134,9 @@ func (h *Handler) GetRoleSystem(ctx context.Context, req *pb.GetRoleSystemReques
 		&updatedAt,
 		&entity.CreatedBy,
 		&updatedBy,
+		&entity.Version,
+		&deletedAt,
+		&deletedBy,
 	)
 
 	if err != nil {
@@ -121,16 +147,7 @@ func (h *Handler) GetRoleSystem(ctx context.Context, req *pb.GetRoleSystemReques
 	}
 
 	// Convert Role string to enum
-	switch RoleStr {
-	case "academic_affairs_staff":
-		entity.Role = pb.RoleType_ACADEMIC_AFFAIRS_STAFF
-	case "department_lecturer":
-		entity.Role = pb.RoleType_DEPARTMENT_LECTURER
-	case "teacher":
-		entity.Role = pb.RoleType_TEACHER
-	default:
-		entity.Role = pb.RoleType_TEACHER
-	}
+	entity.Role = roleTypeFromString(RoleStr)
 
 	if createdAt.Valid {
 		entity.CreatedAt = timestamppb.New(createdAt.Time)
@@ -141,6 +158,10 @@ func (h *Handler) GetRoleSystem(ctx context.Context, req *pb.GetRoleSystemReques
 	if updatedBy.Valid {
 		entity.UpdatedBy = updatedBy.String
 	}
+	if deletedAt.Valid {
+		entity.DeletedAt = timestamppb.New(deletedAt.Time)
+		entity.DeletedBy = deletedBy.String
+	}
 
 	return &pb.GetRoleSystemResponse{
 		RoleSystem: &entity,
@@ -171,15 +192,7 @@ func (h *Handler) UpdateRoleSystem(ctx context.Context, req *pb.UpdateRoleSystem
 	}
 	if req.Role != nil {
 		updateFields = append(updateFields, "role = ?")
-		RoleStr := "academic_affairs_staff"
-		switch *req.Role {
-		case pb.RoleType_ACADEMIC_AFFAIRS_STAFF:
-			RoleStr = "academic_affairs_staff"
-		case pb.RoleType_DEPARTMENT_LECTURER:
-			RoleStr = "department_lecturer"
-		case pb.RoleType_TEACHER:
-			RoleStr = "teacher"
-		}
+		RoleStr := roleTypeToString(*req.Role)
 		args = append(args, RoleStr)
 
 	}
@@ -194,6 +207,14 @@ func (h *Handler) UpdateRoleSystem(ctx context.Context, req *pb.UpdateRoleSystem
 
 	}
 
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "teacher_code", "Teacher", req.GetTeacherCode()); err != nil {
+		return nil, err
+	}
+	if err := h.refs.Require(ctx, "semester_code", "Semester", req.GetSemesterCode()); err != nil {
+		return nil, err
+	}
+
 	if len(updateFields) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "no fields to update")
 	}
@@ -203,19 +224,27 @@ func (h *Handler) UpdateRoleSystem(ctx context.Context, req *pb.UpdateRoleSystem
 	args = append(args, req.UpdatedBy)
 	updateFields = append(updateFields, "updated_at = NOW()")
 
-	// Add id as last parameter
-	args = append(args, req.Id)
+	// Bump the version; an expected version only matches an unchanged record
+	updateFields = append(updateFields, "version = version + 1")
+	where := helper.WhereVersion(req.Id, req.Version, &args)
 
 	query := fmt.Sprintf(`
 		UPDATE RoleSystem
 		SET %s
-		WHERE id = ?
-	`, strings.Join(updateFields, ", "))
+		WHERE %s AND deleted_at IS NULL
+	`, strings.Join(updateFields, ", "), where)
 
-	_, err := h.execQuery(ctx, query, args...)
+	updated, err := h.execQuery(ctx, query, args...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update rolesystem: %v", err)
 	}
+	if n, _ := updated.RowsAffected(); n == 0 {
+		current, err := h.GetRoleSystem(ctx, &pb.GetRoleSystemRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		return nil, helper.VersionConflict("role", current.GetRoleSystem(), current.GetRoleSystem().Version)
+	}
 
 	result, err := h.GetRoleSystem(ctx, &pb.GetRoleSystemRequest{Id: req.Id})
 	if err != nil {
@@ -226,7 +255,7 @@ func (h *Handler) UpdateRoleSystem(ctx context.Context, req *pb.UpdateRoleSystem
 	}, nil
 }
 
-// DeleteRoleSystem deletes a RoleSystem by ID
+// DeleteRoleSystem soft-deletes a RoleSystem by ID
 func (h *Handler) DeleteRoleSystem(ctx context.Context, req *pb.DeleteRoleSystemRequest) (*pb.DeleteRoleSystemResponse, error) {
 	defer logger.TraceFunction(ctx)()
 
@@ -234,9 +263,10 @@ func (h *Handler) DeleteRoleSystem(ctx context.Context, req *pb.DeleteRoleSystem
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	query := `DELETE FROM RoleSystem WHERE id = ?`
+	// Soft delete; the retention job removes the row later
+	query := `UPDATE RoleSystem SET deleted_at = NOW(), deleted_by = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL`
 
-	result, err := h.execQuery(ctx, query, req.Id)
+	result, err := h.execQuery(ctx, query, req.DeletedBy, req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete rolesystem: %v", err)
 	}
@@ -255,6 +285,43 @@ func (h *Handler) DeleteRoleSystem(ctx context.Context, req *pb.DeleteRoleSystem
 	}, nil
 }
 
+// RestoreRoleSystem brings back a soft-deleted RoleSystem
+func (h *Handler) RestoreRoleSystem(ctx context.Context, req *pb.RestoreRoleSystemRequest) (*pb.RestoreRoleSystemResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	query := `UPDATE RoleSystem SET deleted_at = NULL, deleted_by = NULL, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ? AND deleted_at IS NOT NULL`
+
+	result, err := h.execQuery(ctx, query, req.RestoredBy, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore role: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		// Unknown ids are NotFound; a live record has nothing to restore
+		if _, err := h.GetRoleSystem(ctx, &pb.GetRoleSystemRequest{Id: req.Id}); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, "role is not deleted")
+	}
+
+	restored, err := h.GetRoleSystem(ctx, &pb.GetRoleSystemRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreRoleSystemResponse{
+		RoleSystem: restored.GetRoleSystem(),
+	}, nil
+}
+
 // ListRoleSystems lists RoleSystems with pagination and filtering
 func (h *Handler) ListRoleSystems(ctx context.Context, req *pb.ListRoleSystemsRequest) (*pb.ListRoleSystemsResponse, error) {
 	defer logger.TraceFunction(ctx)()
@@ -262,8 +329,6 @@ func (h *Handler) ListRoleSystems(ctx context.Context, req *pb.ListRoleSystemsRe
 	// Default pagination
 	page := int32(1)
 	pageSize := int32(10)
-	sortBy := "created_at"
-	descending := true
 	if req.Search != nil && req.Search.Pagination != nil {
 		if req.Search.Pagination.Page > 0 {
 			page = req.Search.Pagination.Page
@@ -271,53 +336,39 @@ func (h *Handler) ListRoleSystems(ctx context.Context, req *pb.ListRoleSystemsRe
 		if req.Search.Pagination.PageSize > 0 {
 			pageSize = req.Search.Pagination.PageSize
 		}
-		if req.Search.Pagination.SortBy != "" {
-			sortBy = req.Search.Pagination.SortBy
-		}
-		descending = req.Search.Pagination.Descending
 	}
 
 	// Calculate offset
 	offset := (page - 1) * pageSize
 
 	// Build WHERE clause from filters
-	whereClause := ""
 	args := []interface{}{}
-	whiteMap := map[string]bool{
-		"id": true,
-
-		"title":         true,
-		"teacher_code":  true,
-		"role":          true,
-		"semester_code": true,
-		"activate":      true,
-	}
-	if req.Search != nil && len(req.Search.Filters) > 0 {
-		whereConditions := []string{}
-		for _, filter := range req.Search.Filters {
-			if filter.GetCondition() != nil {
-				condition := filter.GetCondition()
-				if _, ok := whiteMap[condition.Field]; !ok {
-					continue
-				}
-				whereConditions = append(whereConditions, helper.BuildFilterCondition(condition, &args))
-			}
-		}
-		if len(whereConditions) > 0 {
-			whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
-		}
+	filterFields := helper.FilterFields{
+		"id":            {},
+		"title":         {},
+		"teacher_code":  {},
+		"role":          {Type: helper.FieldEnum, Values: []string{"Academic_affairs_staff", "Teacher", "Department_Lecturer"}},
+		"semester_code": {},
+		"activate":      {Type: helper.FieldBool},
+		"created_at":    {Type: helper.FieldTime},
+		"updated_at":    {Type: helper.FieldTime},
+	}
+	whereClause, err := helper.BuildWhere(req.Search.GetFilters(), filterFields, &args)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
+	whereClause = helper.NotDeleted(whereClause, req.IncludeDeleted)
 
 	// Build ORDER BY clause
-	sortDirection := "ASC"
-	if descending {
-		sortDirection = "DESC"
+	orderBy, err := helper.BuildOrderBy(req.Search.GetPagination(), filterFields)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %v", err)
 	}
 
 	// Get total count
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM RoleSystem %s", whereClause)
 	var total int32
-	err := h.queryRow(ctx, countQuery, args...).Scan(&total)
+	err = h.queryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to count rolesystems: %v", err)
 	}
@@ -325,12 +376,12 @@ func (h *Handler) ListRoleSystems(ctx context.Context, req *pb.ListRoleSystemsRe
 	// Get entities with pagination
 	args = append(args, pageSize, offset)
 	query := fmt.Sprintf(`
-		SELECT id, title, teacher_code, role, semester_code, activate, created_at, updated_at, created_by, updated_by
+		SELECT id, title, teacher_code, role, semester_code, activate, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM RoleSystem
 		%s
-		ORDER BY %s %s
+		%s
 		LIMIT ? OFFSET ?
-	`, whereClause, sortBy, sortDirection)
+	`, whereClause, orderBy)
 
 	rows, err := h.query(ctx, query, args...)
 	if err != nil {
@@ -341,8 +392,8 @@ func (h *Handler) ListRoleSystems(ctx context.Context, req *pb.ListRoleSystemsRe
 	entities := []*pb.RoleSystem{}
 	for rows.Next() {
 		var entity pb.RoleSystem
-		var createdAt, updatedAt sql.NullTime
-		var updatedBy sql.NullString
+		var createdAt, updatedAt, deletedAt sql.NullTime
+		var updatedBy, deletedBy sql.NullString
 		var RoleStr string
 
 		err := rows.Scan(
@@ -356,22 +407,16 @@ func (h *Handler) ListRoleSystems(ctx context.Context, req *pb.ListRoleSystemsRe
 			&updatedAt,
 			&entity.CreatedBy,
 			&updatedBy,
+			&entity.Version,
+			&deletedAt,
+			&deletedBy,
 		)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan rolesystem: %v", err)
 		}
 
 		// Convert Role string to enum
-		switch RoleStr {
-		case "academic_affairs_staff":
-			entity.Role = pb.RoleType_ACADEMIC_AFFAIRS_STAFF
-		case "department_lecturer":
-			entity.Role = pb.RoleType_DEPARTMENT_LECTURER
-		case "teacher":
-			entity.Role = pb.RoleType_TEACHER
-		default:
-			entity.Role = pb.RoleType_TEACHER
-		}
+		entity.Role = roleTypeFromString(RoleStr)
 
 		if createdAt.Valid {
 			entity.CreatedAt = timestamppb.New(createdAt.Time)
@@ -382,6 +427,10 @@ func (h *Handler) ListRoleSystems(ctx context.Context, req *pb.ListRoleSystemsRe
 		if updatedBy.Valid {
 			entity.UpdatedBy = updatedBy.String
 		}
+		if deletedAt.Valid {
+			entity.DeletedAt = timestamppb.New(deletedAt.Time)
+			entity.DeletedBy = deletedBy.String
+		}
 
 		entities = append(entities, &entity)
 	}
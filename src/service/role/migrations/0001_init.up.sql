@@ -0,0 +1,19 @@
+-- Initial role service schema
+--
+-- References to other services' tables are not foreign keys, each
+-- service owns its schema:
+--   RoleSystem.teacher_code -> Teacher (user service)
+--   RoleSystem.semester_code -> Semester (academic service)
+
+CREATE TABLE `RoleSystem` (
+  `id` varchar(255) PRIMARY KEY,
+  `title` varchar(255) NOT NULL,
+  `teacher_code` varchar(255) NOT NULL,
+  `role` ENUM ('Academic_affairs_staff', 'Teacher', 'Department_Lecturer') NOT NULL,
+  `semester_code` varchar(255) NOT NULL,
+  `activate` boolean NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL
+);
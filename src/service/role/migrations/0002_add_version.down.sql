@@ -0,0 +1 @@
+ALTER TABLE `RoleSystem` DROP COLUMN `version`;
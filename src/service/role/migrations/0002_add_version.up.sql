@@ -0,0 +1,4 @@
+-- Optimistic concurrency: every update bumps `version`, and an update that
+-- carries the expected version only applies to a record still at it
+
+ALTER TABLE `RoleSystem` ADD COLUMN `version` INT NOT NULL DEFAULT 1;
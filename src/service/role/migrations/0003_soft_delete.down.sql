@@ -0,0 +1 @@
+ALTER TABLE `RoleSystem` DROP KEY `idx_rolesystem_deleted_at`, DROP COLUMN `deleted_by`, DROP COLUMN `deleted_at`;
@@ -0,0 +1,29 @@
+package handler
+
+import "thaily/src/pkg/audit"
+
+// Audited maps the entities named in the thesis RPCs to their tables. Grade
+// locks, publication and the appeal window live in the semester's
+// Semester_grade_status row.
+func Audited() []audit.Entity {
+	return []audit.Entity{
+		{Name: "Midterm", Table: "Midterm"},
+		{Name: "MidtermMilestone", Table: "Midterm_milestone"},
+		{Name: "MilestoneCheckin", Table: "Milestone_checkin"},
+		{Name: "Final", Table: "Final"},
+		{Name: "Enrollment", Table: "Enrollment"},
+		{Name: "Topic", Table: "Topic"},
+		{Name: "TopicCouncil", Table: "Topic_council"},
+		{Name: "TopicCouncilSupervisor", Table: "Topic_council_supervisor"},
+		{Name: "TopicRegistration", Table: "Topic_registration"},
+		{Name: "RegistrationWindow", Table: "Registration_window"},
+		{Name: "GradeReview", Table: "Grade_review"},
+		{Name: "SubmissionDeadline", Table: "Submission_deadline"},
+		{Name: "DeadlineExtension", Table: "Deadline_extension"},
+		{Name: "GradingPolicy", Table: "Grading_policy"},
+		{Name: "SemesterGrades", Table: "Semester_grade_status", Key: "semester_code"},
+		{Name: "GradeAppealWindow", Table: "Semester_grade_status", Key: "semester_code"},
+		{Name: "GradeAmendment", Table: "Grade_amendment"},
+		{Name: "GradeAppeal", Table: "Grade_appeal"},
+	}
+}
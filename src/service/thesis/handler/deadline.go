@@ -0,0 +1,436 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	pb "thaily/proto/thesis"
+	"thaily/src/pkg/logger"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func stageToString(stage pb.TopicStage) string {
+	switch stage {
+	case pb.TopicStage_STAGE_LVTN:
+		return "stage_lvtn"
+	default:
+		return "stage_dacn"
+	}
+}
+
+func stageFromString(stage string) pb.TopicStage {
+	switch stage {
+	case "stage_lvtn":
+		return pb.TopicStage_STAGE_LVTN
+	default:
+		return pb.TopicStage_STAGE_DACN
+	}
+}
+
+func kindToString(kind pb.SubmissionKind) string {
+	switch kind {
+	case pb.SubmissionKind_SUBMISSION_FINAL:
+		return "final"
+	default:
+		return "midterm"
+	}
+}
+
+func kindFromString(kind string) pb.SubmissionKind {
+	switch kind {
+	case "final":
+		return pb.SubmissionKind_SUBMISSION_FINAL
+	default:
+		return pb.SubmissionKind_SUBMISSION_MIDTERM
+	}
+}
+
+// studentCouncil is the topic council a student is enrolled in for a semester
+type studentCouncil struct {
+	id          string
+	stage       string
+	timeStart   sql.NullTime
+	timeEnd     sql.NullTime
+	midtermCode string
+	finalCode   string
+}
+
+// getStudentCouncil resolves the student's current topic council (and so its stage) in a semester
+func (h *Handler) getStudentCouncil(ctx context.Context, semesterCode, studentCode string) (*studentCouncil, error) {
+	query := `
+		SELECT tc.id, tc.stage, tc.time_start, tc.time_end, COALESCE(e.midterm_code, ''), COALESCE(e.final_code, '')
+		FROM Enrollment e
+		JOIN Topic_council tc ON tc.id = e.topic_council_code AND tc.deleted_at IS NULL
+		JOIN Topic t ON t.id = tc.topic_code AND t.deleted_at IS NULL
+		WHERE e.student_code = ? AND t.semester_code = ? AND e.deleted_at IS NULL
+		ORDER BY tc.time_start DESC
+		LIMIT 1
+	`
+
+	var council studentCouncil
+	err := h.queryRow(ctx, query, studentCode, semesterCode).Scan(
+		&council.id,
+		&council.stage,
+		&council.timeStart,
+		&council.timeEnd,
+		&council.midtermCode,
+		&council.finalCode,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.FailedPrecondition, "student %s has no enrollment in semester %s", studentCode, semesterCode)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get enrollment: %v", err)
+	}
+	return &council, nil
+}
+
+func scanSubmissionDeadline(scan func(dest ...interface{}) error) (*pb.SubmissionDeadline, error) {
+	var entity pb.SubmissionDeadline
+	var opensAt, dueAt, createdAt, updatedAt sql.NullTime
+	var updatedBy sql.NullString
+	var StageStr, KindStr string
+
+	err := scan(
+		&entity.Id,
+		&entity.SemesterCode,
+		&StageStr,
+		&KindStr,
+		&opensAt,
+		&dueAt,
+		&entity.GraceMinutes,
+		&createdAt,
+		&updatedAt,
+		&entity.CreatedBy,
+		&updatedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entity.Stage = stageFromString(StageStr)
+	entity.Kind = kindFromString(KindStr)
+	if opensAt.Valid {
+		entity.OpensAt = timestamppb.New(opensAt.Time)
+	}
+	if dueAt.Valid {
+		entity.DueAt = timestamppb.New(dueAt.Time)
+	}
+	if createdAt.Valid {
+		entity.CreatedAt = timestamppb.New(createdAt.Time)
+	}
+	if updatedAt.Valid {
+		entity.UpdatedAt = timestamppb.New(updatedAt.Time)
+	}
+	if updatedBy.Valid {
+		entity.UpdatedBy = updatedBy.String
+	}
+	return &entity, nil
+}
+
+const submissionDeadlineColumns = `id, semester_code, stage, kind, opens_at, due_at, grace_minutes, created_at, updated_at, created_by, updated_by`
+
+// SetSubmissionDeadline creates or replaces the submission window of a semester, stage and kind
+func (h *Handler) SetSubmissionDeadline(ctx context.Context, req *pb.SetSubmissionDeadlineRequest) (*pb.SetSubmissionDeadlineResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.SemesterCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester_code is required")
+	}
+	if req.OpensAt == nil || req.DueAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "opens_at and due_at are required")
+	}
+	if !req.DueAt.AsTime().After(req.OpensAt.AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "due_at must be after opens_at")
+	}
+	if req.GraceMinutes < 0 {
+		return nil, status.Error(codes.InvalidArgument, "grace_minutes must not be negative")
+	}
+
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "semester_code", "Semester", req.SemesterCode); err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO Submission_deadline (id, semester_code, stage, kind, opens_at, due_at, grace_minutes, created_by, updated_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
+		ON DUPLICATE KEY UPDATE
+			opens_at = VALUES(opens_at),
+			due_at = VALUES(due_at),
+			grace_minutes = VALUES(grace_minutes),
+			updated_by = VALUES(updated_by),
+			updated_at = NOW()
+	`
+
+	_, err := h.execQuery(ctx, query,
+		uuid.New().String(),
+		req.SemesterCode,
+		stageToString(req.Stage),
+		kindToString(req.Kind),
+		req.OpensAt.AsTime(),
+		req.DueAt.AsTime(),
+		req.GraceMinutes,
+		req.CreatedBy,
+		req.CreatedBy,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set submission deadline: %v", err)
+	}
+
+	deadline, err := h.getSubmissionDeadline(ctx, req.SemesterCode, stageToString(req.Stage), kindToString(req.Kind))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get submission deadline: %v", err)
+	}
+	return &pb.SetSubmissionDeadlineResponse{
+		Deadline: deadline,
+	}, nil
+}
+
+// getSubmissionDeadline returns the configured window, or nil when none is configured
+func (h *Handler) getSubmissionDeadline(ctx context.Context, semesterCode, stage, kind string) (*pb.SubmissionDeadline, error) {
+	query := `SELECT ` + submissionDeadlineColumns + ` FROM Submission_deadline WHERE semester_code = ? AND stage = ? AND kind = ?`
+
+	deadline, err := scanSubmissionDeadline(h.queryRow(ctx, query, semesterCode, stage, kind).Scan)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return deadline, err
+}
+
+// ListSubmissionDeadlines lists the submission windows configured for a semester
+func (h *Handler) ListSubmissionDeadlines(ctx context.Context, req *pb.ListSubmissionDeadlinesRequest) (*pb.ListSubmissionDeadlinesResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.SemesterCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester_code is required")
+	}
+
+	query := `SELECT ` + submissionDeadlineColumns + ` FROM Submission_deadline WHERE semester_code = ? ORDER BY stage, kind`
+
+	rows, err := h.query(ctx, query, req.SemesterCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list submission deadlines: %v", err)
+	}
+	defer rows.Close()
+
+	deadlines := []*pb.SubmissionDeadline{}
+	for rows.Next() {
+		deadline, err := scanSubmissionDeadline(rows.Scan)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan submission deadline: %v", err)
+		}
+		deadlines = append(deadlines, deadline)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating submission deadlines: %v", err)
+	}
+
+	return &pb.ListSubmissionDeadlinesResponse{
+		Deadlines: deadlines,
+	}, nil
+}
+
+// GrantDeadlineExtension gives one student a later due date; only a supervisor of the
+// student's topic council may grant it
+func (h *Handler) GrantDeadlineExtension(ctx context.Context, req *pb.GrantDeadlineExtensionRequest) (*pb.GrantDeadlineExtensionResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.SemesterCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester_code is required")
+	}
+	if req.StudentCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_code is required")
+	}
+	if req.GrantedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "granted_by is required")
+	}
+	if req.DueAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "due_at is required")
+	}
+
+	council, err := h.getStudentCouncil(ctx, req.SemesterCode, req.StudentCode)
+	if err != nil {
+		return nil, err
+	}
+
+	var supervises bool
+	err = h.queryRow(ctx,
+		`SELECT EXISTS(SELECT 1 FROM Topic_council_supervisor WHERE topic_council_code = ? AND teacher_supervisor_code = ? AND deleted_at IS NULL)`,
+		council.id, req.GrantedBy,
+	).Scan(&supervises)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check supervisor: %v", err)
+	}
+	if !supervises {
+		return nil, status.Error(codes.PermissionDenied, "only a supervisor of the student's topic council can grant an extension")
+	}
+
+	query := `
+		INSERT INTO Deadline_extension (id, semester_code, stage, kind, student_code, due_at, reason, created_by, updated_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
+		ON DUPLICATE KEY UPDATE
+			due_at = VALUES(due_at),
+			reason = VALUES(reason),
+			updated_by = VALUES(updated_by),
+			updated_at = NOW()
+	`
+
+	_, err = h.execQuery(ctx, query,
+		uuid.New().String(),
+		req.SemesterCode,
+		council.stage,
+		kindToString(req.Kind),
+		req.StudentCode,
+		req.DueAt.AsTime(),
+		req.Reason,
+		req.GrantedBy,
+		req.GrantedBy,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to grant extension: %v", err)
+	}
+
+	extension, err := h.getDeadlineExtension(ctx, req.SemesterCode, council.stage, kindToString(req.Kind), req.StudentCode)
+	if err != nil || extension == nil {
+		return nil, status.Error(codes.Internal, "failed to get extension")
+	}
+	return &pb.GrantDeadlineExtensionResponse{
+		Extension: extension,
+	}, nil
+}
+
+// getDeadlineExtension returns the student's extension, or nil when none was granted
+func (h *Handler) getDeadlineExtension(ctx context.Context, semesterCode, stage, kind, studentCode string) (*pb.DeadlineExtension, error) {
+	query := `
+		SELECT id, semester_code, stage, kind, student_code, due_at, reason, created_at, updated_at, created_by, updated_by
+		FROM Deadline_extension
+		WHERE semester_code = ? AND stage = ? AND kind = ? AND student_code = ?
+	`
+
+	var entity pb.DeadlineExtension
+	var dueAt, createdAt, updatedAt sql.NullTime
+	var reason, updatedBy sql.NullString
+	var StageStr, KindStr string
+
+	err := h.queryRow(ctx, query, semesterCode, stage, kind, studentCode).Scan(
+		&entity.Id,
+		&entity.SemesterCode,
+		&StageStr,
+		&KindStr,
+		&entity.StudentCode,
+		&dueAt,
+		&reason,
+		&createdAt,
+		&updatedAt,
+		&entity.CreatedBy,
+		&updatedBy,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	entity.Stage = stageFromString(StageStr)
+	entity.Kind = kindFromString(KindStr)
+	if dueAt.Valid {
+		entity.DueAt = timestamppb.New(dueAt.Time)
+	}
+	if reason.Valid {
+		entity.Reason = reason.String
+	}
+	if createdAt.Valid {
+		entity.CreatedAt = timestamppb.New(createdAt.Time)
+	}
+	if updatedAt.Valid {
+		entity.UpdatedAt = timestamppb.New(updatedAt.Time)
+	}
+	if updatedBy.Valid {
+		entity.UpdatedBy = updatedBy.String
+	}
+	return &entity, nil
+}
+
+// CheckSubmissionWindow tells whether a student may submit now and whether the submission is late.
+// The configured Submission_deadline of the student's stage is used; without one the window falls
+// back to the topic council's time_start/time_end with no grace period. An extension only moves
+// the due date, the grace period still applies after it.
+func (h *Handler) CheckSubmissionWindow(ctx context.Context, req *pb.CheckSubmissionWindowRequest) (*pb.CheckSubmissionWindowResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.SemesterCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester_code is required")
+	}
+	if req.StudentCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_code is required")
+	}
+
+	council, err := h.getStudentCouncil(ctx, req.SemesterCode, req.StudentCode)
+	if err != nil {
+		return nil, err
+	}
+	kind := kindToString(req.Kind)
+
+	resp := &pb.CheckSubmissionWindowResponse{
+		Stage:   stageFromString(council.stage),
+		TableId: council.midtermCode,
+	}
+	if req.Kind == pb.SubmissionKind_SUBMISSION_FINAL {
+		resp.TableId = council.finalCode
+	}
+
+	var opensAt, dueAt time.Time
+	var grace time.Duration
+	deadline, err := h.getSubmissionDeadline(ctx, req.SemesterCode, council.stage, kind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get submission deadline: %v", err)
+	}
+	if deadline != nil {
+		opensAt = deadline.OpensAt.AsTime()
+		dueAt = deadline.DueAt.AsTime()
+		grace = time.Duration(deadline.GraceMinutes) * time.Minute
+	} else if council.timeStart.Valid && council.timeEnd.Valid {
+		opensAt = council.timeStart.Time
+		dueAt = council.timeEnd.Time
+	} else {
+		// No window configured for this stage: submissions are not restricted
+		resp.Allowed = true
+		return resp, nil
+	}
+
+	extension, err := h.getDeadlineExtension(ctx, req.SemesterCode, council.stage, kind, req.StudentCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get deadline extension: %v", err)
+	}
+	if extension != nil && extension.DueAt.AsTime().After(dueAt) {
+		dueAt = extension.DueAt.AsTime()
+		resp.Extended = true
+	}
+
+	closesAt := dueAt.Add(grace)
+	resp.OpensAt = timestamppb.New(opensAt)
+	resp.DueAt = timestamppb.New(dueAt)
+	resp.ClosesAt = timestamppb.New(closesAt)
+
+	now := time.Now()
+	switch {
+	case now.Before(opensAt):
+		resp.Reason = "submission window opens at " + opensAt.Format(time.RFC3339)
+	case !now.After(dueAt):
+		resp.Allowed = true
+	case !now.After(closesAt):
+		resp.Allowed = true
+		resp.Late = true
+	default:
+		resp.Reason = "submission window closed at " + closesAt.Format(time.RFC3339)
+	}
+	return resp, nil
+}
@@ -5,9 +5,11 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	pbEvent "thaily/proto/event"
 	pb "thaily/proto/thesis"
 	"thaily/src/pkg/helper"
 	"thaily/src/pkg/logger"
+	"thaily/src/pkg/outbox"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -30,6 +32,11 @@ func (h *Handler) CreateEnrollment(ctx context.Context, req *pb.CreateEnrollment
 		return nil, status.Error(codes.InvalidArgument, "topic_council_code is required")
 	}
 
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "student_code", "Student", req.StudentCode); err != nil {
+		return nil, err
+	}
+
 	// Generate UUID
 	id := uuid.New().String()
 
@@ -53,22 +60,36 @@ func (h *Handler) CreateEnrollment(ctx context.Context, req *pb.CreateEnrollment
 		VALUES (?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
 	`
 
-	_, err := h.execQuery(ctx, query,
-		id,
-		req.Title,
-		req.StudentCode,
-		req.TopicCouncilCode,
-		FinalCode,
-		GradeReviewCode,
-		MidtermCode,
-		req.CreatedBy,
-	)
+	// The EnrollmentCreated event is written with the row
+	err := h.withTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		_, err := h.execQuery(ctx, query,
+			id,
+			req.Title,
+			req.StudentCode,
+			req.TopicCouncilCode,
+			FinalCode,
+			GradeReviewCode,
+			MidtermCode,
+			req.CreatedBy,
+		)
+		if err != nil {
+			if strings.Contains(err.Error(), "Duplicate entry") {
+				return status.Error(codes.AlreadyExists, "enrollment already exists")
+			}
+			return status.Errorf(codes.Internal, "failed to create enrollment: %v", err)
+		}
 
+		return writeEnrollmentCreated(ctx, tx, req.CreatedBy, &pbEvent.EnrollmentCreated{
+			EnrollmentId:     id,
+			StudentCode:      req.StudentCode,
+			TopicCouncilCode: req.TopicCouncilCode,
+			MidtermCode:      MidtermCode,
+			FinalCode:        FinalCode,
+			GradeReviewCode:  GradeReviewCode,
+		})
+	})
 	if err != nil {
-		if strings.Contains(err.Error(), "Duplicate entry") {
-			return nil, status.Error(codes.AlreadyExists, "enrollment already exists")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to create enrollment: %v", err)
+		return nil, err
 	}
 
 	result, err := h.GetEnrollment(ctx, &pb.GetEnrollmentRequest{Id: id})
@@ -80,6 +101,21 @@ func (h *Handler) CreateEnrollment(ctx context.Context, req *pb.CreateEnrollment
 	}, nil
 }
 
+// writeEnrollmentCreated writes the EnrollmentCreated event of a new
+// enrollment in the transaction that inserted it
+func writeEnrollmentCreated(ctx context.Context, tx *sql.Tx, actor string, created *pbEvent.EnrollmentCreated) error {
+	err := outbox.Write(ctx, tx, &pbEvent.Event{
+		Aggregate:   "Enrollment",
+		AggregateId: created.EnrollmentId,
+		Actor:       actor,
+		Payload:     &pbEvent.Event_EnrollmentCreated{EnrollmentCreated: created},
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to record enrollment event: %v", err)
+	}
+	return nil
+}
+
 // GetEnrollment retrieves a Enrollment by ID
 func (h *Handler) GetEnrollment(ctx context.Context, req *pb.GetEnrollmentRequest) (*pb.GetEnrollmentResponse, error) {
 	defer logger.TraceFunction(ctx)()
@@ -89,14 +125,17 @@ func (h *Handler) GetEnrollment(ctx context.Context, req *pb.GetEnrollmentReques
 	}
 
 	query := `
-		SELECT id, title, student_code, topic_council_code, final_code, grade_review_code, midterm_code, created_at, updated_at, created_by, updated_by
+		SELECT id, title, student_code, topic_council_code, final_code, grade_review_code, midterm_code, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM Enrollment
 		WHERE id = ?
 	`
+	if !req.IncludeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
 
 	var entity pb.Enrollment
-	var createdAt, updatedAt sql.NullTime
-	var updatedBy sql.NullString
+	var createdAt, updatedAt, deletedAt sql.NullTime
+	var updatedBy, deletedBy sql.NullString
 
 	err := h.queryRow(ctx, query, req.Id).Scan(
 		&entity.Id,
@@ -110,6 +149,9 @@ func (h *Handler) GetEnrollment(ctx context.Context, req *pb.GetEnrollmentReques
 		&updatedAt,
 		&entity.CreatedBy,
 		&updatedBy,
+		&entity.Version,
+		&deletedAt,
+		&deletedBy,
 	)
 
 	if err != nil {
@@ -128,6 +170,10 @@ func (h *Handler) GetEnrollment(ctx context.Context, req *pb.GetEnrollmentReques
 	if updatedBy.Valid {
 		entity.UpdatedBy = updatedBy.String
 	}
+	if deletedAt.Valid {
+		entity.DeletedAt = timestamppb.New(deletedAt.Time)
+		entity.DeletedBy = deletedBy.String
+	}
 
 	return &pb.GetEnrollmentResponse{
 		Enrollment: &entity,
@@ -177,6 +223,11 @@ func (h *Handler) UpdateEnrollment(ctx context.Context, req *pb.UpdateEnrollment
 
 	}
 
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "student_code", "Student", req.GetStudentCode()); err != nil {
+		return nil, err
+	}
+
 	if len(updateFields) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "no fields to update")
 	}
@@ -186,19 +237,27 @@ func (h *Handler) UpdateEnrollment(ctx context.Context, req *pb.UpdateEnrollment
 	args = append(args, req.UpdatedBy)
 	updateFields = append(updateFields, "updated_at = NOW()")
 
-	// Add id as last parameter
-	args = append(args, req.Id)
+	// Bump the version; an expected version only matches an unchanged record
+	updateFields = append(updateFields, "version = version + 1")
+	where := helper.WhereVersion(req.Id, req.Version, &args)
 
 	query := fmt.Sprintf(`
 		UPDATE Enrollment
 		SET %s
-		WHERE id = ?
-	`, strings.Join(updateFields, ", "))
+		WHERE %s AND deleted_at IS NULL
+	`, strings.Join(updateFields, ", "), where)
 
-	_, err := h.execQuery(ctx, query, args...)
+	updated, err := h.execQuery(ctx, query, args...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update enrollment: %v", err)
 	}
+	if n, _ := updated.RowsAffected(); n == 0 {
+		current, err := h.GetEnrollment(ctx, &pb.GetEnrollmentRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		return nil, helper.VersionConflict("enrollment", current.GetEnrollment(), current.GetEnrollment().Version)
+	}
 
 	result, err := h.GetEnrollment(ctx, &pb.GetEnrollmentRequest{Id: req.Id})
 	if err != nil {
@@ -209,7 +268,7 @@ func (h *Handler) UpdateEnrollment(ctx context.Context, req *pb.UpdateEnrollment
 	}, nil
 }
 
-// DeleteEnrollment deletes a Enrollment by ID
+// DeleteEnrollment soft-deletes a Enrollment by ID
 func (h *Handler) DeleteEnrollment(ctx context.Context, req *pb.DeleteEnrollmentRequest) (*pb.DeleteEnrollmentResponse, error) {
 	defer logger.TraceFunction(ctx)()
 
@@ -217,9 +276,10 @@ func (h *Handler) DeleteEnrollment(ctx context.Context, req *pb.DeleteEnrollment
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	query := `DELETE FROM Enrollment WHERE id = ?`
+	// Soft delete; the retention job removes the row later
+	query := `UPDATE Enrollment SET deleted_at = NOW(), deleted_by = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL`
 
-	result, err := h.execQuery(ctx, query, req.Id)
+	result, err := h.execQuery(ctx, query, req.DeletedBy, req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete enrollment: %v", err)
 	}
@@ -238,6 +298,58 @@ func (h *Handler) DeleteEnrollment(ctx context.Context, req *pb.DeleteEnrollment
 	}, nil
 }
 
+// RestoreEnrollment brings back a soft-deleted Enrollment with the Midterm,
+// Final and Grade_review rows it links
+func (h *Handler) RestoreEnrollment(ctx context.Context, req *pb.RestoreEnrollmentRequest) (*pb.RestoreEnrollmentResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	// The parts DeleteEnrollmentCascade soft-deleted come back with it
+	err := h.withTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		query := `UPDATE Enrollment SET deleted_at = NULL, deleted_by = NULL, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ? AND deleted_at IS NOT NULL`
+
+		result, err := h.execQuery(ctx, query, req.RestoredBy, req.Id)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to restore enrollment: %v", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get rows affected: %v", err)
+		}
+
+		if rowsAffected == 0 {
+			// Unknown ids are NotFound; a live record has nothing to restore
+			if _, err := h.GetEnrollment(ctx, &pb.GetEnrollmentRequest{Id: req.Id}); err != nil {
+				return err
+			}
+			return status.Error(codes.FailedPrecondition, "enrollment is not deleted")
+		}
+
+		for _, part := range enrollmentParts {
+			query := fmt.Sprintf("UPDATE %s SET deleted_at = NULL, deleted_by = NULL, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = (SELECT %s FROM Enrollment WHERE id = ?) AND deleted_at IS NOT NULL", part.table, part.column)
+			if _, err := h.execQuery(ctx, query, req.RestoredBy, req.Id); err != nil {
+				return status.Errorf(codes.Internal, "failed to restore %s: %v", part.table, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	restored, err := h.GetEnrollment(ctx, &pb.GetEnrollmentRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreEnrollmentResponse{
+		Enrollment: restored.GetEnrollment(),
+	}, nil
+}
+
 // ListEnrollments lists Enrollments with pagination and filtering
 func (h *Handler) ListEnrollments(ctx context.Context, req *pb.ListEnrollmentsRequest) (*pb.ListEnrollmentsResponse, error) {
 	defer logger.TraceFunction(ctx)()
@@ -245,8 +357,6 @@ func (h *Handler) ListEnrollments(ctx context.Context, req *pb.ListEnrollmentsRe
 	// Default pagination
 	page := int32(1)
 	pageSize := int32(10)
-	sortBy := "created_at"
-	descending := true
 	if req.Search != nil && req.Search.Pagination != nil {
 		if req.Search.Pagination.Page > 0 {
 			page = req.Search.Pagination.Page
@@ -254,53 +364,39 @@ func (h *Handler) ListEnrollments(ctx context.Context, req *pb.ListEnrollmentsRe
 		if req.Search.Pagination.PageSize > 0 {
 			pageSize = req.Search.Pagination.PageSize
 		}
-		if req.Search.Pagination.SortBy != "" {
-			sortBy = req.Search.Pagination.SortBy
-		}
-		descending = req.Search.Pagination.Descending
 	}
 
 	// Calculate offset
 	offset := (page - 1) * pageSize
 
 	// Build WHERE clause from filters
-	whereClause := ""
 	args := []interface{}{}
-	whiteMap := map[string]bool{
-		"id": true,
-
-		"title":              true,
-		"student_code":       true,
-		"topic_council_code": true,
-		"final_code":         true,
-		"grade_review_code":  true,
-		"midterm_code":       true,
-	}
-	if req.Search != nil && len(req.Search.Filters) > 0 {
-		whereConditions := []string{}
-		for _, filter := range req.Search.Filters {
-			if filter.GetCondition() != nil {
-				condition := filter.GetCondition()
-				if _, ok := whiteMap[condition.Field]; !ok {
-					continue
-				}
-				whereConditions = append(whereConditions, helper.BuildFilterCondition(condition, &args))
-			}
-		}
-		if len(whereConditions) > 0 {
-			whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
-		}
+	filterFields := helper.FilterFields{
+		"id":                 {},
+		"title":              {},
+		"student_code":       {},
+		"topic_council_code": {},
+		"final_code":         {},
+		"grade_review_code":  {},
+		"midterm_code":       {},
+		"created_at":         {Type: helper.FieldTime},
+		"updated_at":         {Type: helper.FieldTime},
+	}
+	whereClause, err := helper.BuildWhere(req.Search.GetFilters(), filterFields, &args)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
+	whereClause = helper.NotDeleted(whereClause, req.IncludeDeleted)
 
 	// Build ORDER BY clause
-	sortDirection := "ASC"
-	if descending {
-		sortDirection = "DESC"
+	orderBy, err := helper.BuildOrderBy(req.Search.GetPagination(), filterFields)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %v", err)
 	}
 	// Get total count
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM Enrollment %s", whereClause)
 	var total int32
-	err := h.queryRow(ctx, countQuery, args...).Scan(&total)
+	err = h.queryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to count enrollments: %v", err)
 	}
@@ -308,12 +404,12 @@ func (h *Handler) ListEnrollments(ctx context.Context, req *pb.ListEnrollmentsRe
 	// Get entities with pagination
 	args = append(args, pageSize, offset)
 	query := fmt.Sprintf(`
-		SELECT id, title, student_code, topic_council_code, final_code, grade_review_code, midterm_code, created_at, updated_at, created_by, updated_by
+		SELECT id, title, student_code, topic_council_code, final_code, grade_review_code, midterm_code, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM Enrollment
 		%s
-		ORDER BY %s %s
+		%s
 		LIMIT ? OFFSET ?
-	`, whereClause, sortBy, sortDirection)
+	`, whereClause, orderBy)
 
 	rows, err := h.query(ctx, query, args...)
 	if err != nil {
@@ -324,8 +420,8 @@ func (h *Handler) ListEnrollments(ctx context.Context, req *pb.ListEnrollmentsRe
 	entities := []*pb.Enrollment{}
 	for rows.Next() {
 		var entity pb.Enrollment
-		var createdAt, updatedAt sql.NullTime
-		var updatedBy sql.NullString
+		var createdAt, updatedAt, deletedAt sql.NullTime
+		var updatedBy, deletedBy sql.NullString
 
 		err := rows.Scan(
 			&entity.Id,
@@ -339,6 +435,9 @@ func (h *Handler) ListEnrollments(ctx context.Context, req *pb.ListEnrollmentsRe
 			&updatedAt,
 			&entity.CreatedBy,
 			&updatedBy,
+			&entity.Version,
+			&deletedAt,
+			&deletedBy,
 		)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan enrollment: %v", err)
@@ -353,6 +452,10 @@ func (h *Handler) ListEnrollments(ctx context.Context, req *pb.ListEnrollmentsRe
 		if updatedBy.Valid {
 			entity.UpdatedBy = updatedBy.String
 		}
+		if deletedAt.Valid {
+			entity.DeletedAt = timestamppb.New(deletedAt.Time)
+			entity.DeletedBy = deletedBy.String
+		}
 
 		entities = append(entities, &entity)
 	}
@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	pb "thaily/proto/thesis"
+	"thaily/src/pkg/audit"
+	"thaily/src/pkg/logger"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// CreateEnrollmentBundle creates an enrollment with its Midterm, Final and,
+// when given, Grade_review rows linked by code. Everything is written in one
+// transaction, so a failing step leaves nothing behind.
+func (h *Handler) CreateEnrollmentBundle(ctx context.Context, req *pb.CreateEnrollmentBundleRequest) (*pb.CreateEnrollmentBundleResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	if req.StudentCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_code is required")
+	}
+	if req.TopicCouncilCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "topic_council_code is required")
+	}
+
+	// The parts default to the enrollment's title and author
+	midtermReq := &pb.CreateMidtermRequest{}
+	if req.Midterm != nil {
+		midtermReq = proto.Clone(req.Midterm).(*pb.CreateMidtermRequest)
+	}
+	if midtermReq.Title == "" {
+		midtermReq.Title = req.Title
+	}
+	if midtermReq.CreatedBy == "" {
+		midtermReq.CreatedBy = req.CreatedBy
+	}
+
+	finalReq := &pb.CreateFinalRequest{}
+	if req.Final != nil {
+		finalReq = proto.Clone(req.Final).(*pb.CreateFinalRequest)
+	}
+	if finalReq.Title == "" {
+		finalReq.Title = req.Title
+	}
+	if finalReq.CreatedBy == "" {
+		finalReq.CreatedBy = req.CreatedBy
+	}
+
+	var reviewReq *pb.CreateGradeReviewRequest
+	if req.GradeReview != nil {
+		reviewReq = proto.Clone(req.GradeReview).(*pb.CreateGradeReviewRequest)
+		if reviewReq.Title == "" {
+			reviewReq.Title = req.Title
+		}
+		if reviewReq.CreatedBy == "" {
+			reviewReq.CreatedBy = req.CreatedBy
+		}
+	}
+
+	resp := &pb.CreateEnrollmentBundleResponse{}
+	err := h.withTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		midterm, err := h.CreateMidterm(ctx, midtermReq)
+		if err != nil {
+			return err
+		}
+		audit.Created(ctx, "Midterm", midterm.GetMidterm().Id)
+		final, err := h.CreateFinal(ctx, finalReq)
+		if err != nil {
+			return err
+		}
+		audit.Created(ctx, "Final", final.GetFinal().Id)
+
+		enrollmentReq := &pb.CreateEnrollmentRequest{
+			Title:            req.Title,
+			StudentCode:      req.StudentCode,
+			TopicCouncilCode: req.TopicCouncilCode,
+			MidtermCode:      &midterm.GetMidterm().Id,
+			FinalCode:        &final.GetFinal().Id,
+			CreatedBy:        req.CreatedBy,
+		}
+		if reviewReq != nil {
+			review, err := h.CreateGradeReview(ctx, reviewReq)
+			if err != nil {
+				return err
+			}
+			resp.GradeReview = review.GetGradeReview()
+			enrollmentReq.GradeReviewCode = &resp.GradeReview.Id
+			audit.Created(ctx, "Grade_review", resp.GradeReview.Id)
+		}
+
+		enrollment, err := h.CreateEnrollment(ctx, enrollmentReq)
+		if err != nil {
+			return err
+		}
+		audit.Created(ctx, "Enrollment", enrollment.GetEnrollment().Id)
+
+		resp.Enrollment = enrollment.GetEnrollment()
+		resp.Midterm = midterm.GetMidterm()
+		resp.Final = final.GetFinal()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// enrollmentParts are the rows an enrollment links by code
+var enrollmentParts = []struct {
+	table  string
+	column string
+}{
+	{"Midterm", "midterm_code"},
+	{"Final", "final_code"},
+	{"Grade_review", "grade_review_code"},
+}
+
+// DeleteEnrollmentCascade soft-deletes an enrollment with the Midterm, Final
+// and Grade_review rows it links, unless another live enrollment shares them;
+// RestoreEnrollment brings them back together. Grade amendments, appeals and
+// milestone check-ins stay until the retention job purges the enrollment.
+// Enrollments of a semester with locked grades cannot be deleted.
+func (h *Handler) DeleteEnrollmentCascade(ctx context.Context, req *pb.DeleteEnrollmentCascadeRequest) (*pb.DeleteEnrollmentCascadeResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	resp := &pb.DeleteEnrollmentCascadeResponse{}
+	err := h.withTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		var midtermCode, finalCode, gradeReviewCode sql.NullString
+		err := h.queryRow(ctx, "SELECT midterm_code, final_code, grade_review_code FROM Enrollment WHERE id = ? AND deleted_at IS NULL FOR UPDATE", req.Id).
+			Scan(&midtermCode, &finalCode, &gradeReviewCode)
+		if err == sql.ErrNoRows {
+			return status.Error(codes.NotFound, "enrollment not found")
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get enrollment: %v", err)
+		}
+		if err := checkGradesUnlocked(ctx, tx, "id", req.Id); err != nil {
+			return err
+		}
+
+		if _, err := h.execQuery(ctx, "UPDATE Enrollment SET deleted_at = NOW(), deleted_by = ?, version = version + 1 WHERE id = ?", req.DeletedBy, req.Id); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete enrollment: %v", err)
+		}
+
+		linked := []sql.NullString{midtermCode, finalCode, gradeReviewCode}
+		deleted := []**string{&resp.MidtermCode, &resp.FinalCode, &resp.GradeReviewCode}
+		for i, part := range enrollmentParts {
+			code := linked[i]
+			if !code.Valid || code.String == "" {
+				continue
+			}
+			audit.Track(ctx, tx, part.table, code.String)
+			query := fmt.Sprintf("UPDATE %s SET deleted_at = NOW(), deleted_by = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL AND NOT EXISTS (SELECT 1 FROM Enrollment WHERE %s = ? AND deleted_at IS NULL)", part.table, part.column)
+			result, err := h.execQuery(ctx, query, req.DeletedBy, code.String, code.String)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to delete %s: %v", part.table, err)
+			}
+			if n, _ := result.RowsAffected(); n > 0 {
+				value := code.String
+				*deleted[i] = &value
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Success = true
+	return resp, nil
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	pb "thaily/proto/thesis"
+	"thaily/src/pkg/migrate"
+)
+
+// EnumColumns lists the ENUM columns of the thesis tables with the values
+// the handlers write, for the migrate check command
+func EnumColumns() []migrate.EnumColumn {
+	topicStatus := migrate.EnumValues(pb.TopicStatus_name, func(v int32) string { return topicStatusToString(pb.TopicStatus(v)) })
+	stage := migrate.EnumValues(pb.TopicStage_name, func(v int32) string { return stageToString(pb.TopicStage(v)) })
+	kind := migrate.EnumValues(pb.SubmissionKind_name, func(v int32) string { return kindToString(pb.SubmissionKind(v)) })
+	coSign := migrate.EnumValues(pb.CoSignStatus_name, func(v int32) string { return coSignStatusToString(pb.CoSignStatus(v)) })
+	registration := migrate.EnumValues(pb.RegistrationStatus_name, func(v int32) string { return registrationStatusToString(pb.RegistrationStatus(v)) })
+	midterm := migrate.EnumValues(pb.MidtermStatus_name, func(v int32) string { return midtermStatusToString(pb.MidtermStatus(v)) })
+	final := migrate.EnumValues(pb.FinalStatus_name, func(v int32) string { return finalStatusToString(pb.FinalStatus(v)) })
+	amendmentTarget := migrate.EnumValues(pb.GradeAmendmentTarget_name, func(v int32) string { return amendmentTargetToString(pb.GradeAmendmentTarget(v)) })
+	amendmentStatus := migrate.EnumValues(pb.GradeAmendmentStatus_name, func(v int32) string { return amendmentStatusToString(pb.GradeAmendmentStatus(v)) })
+	appealComponent := migrate.EnumValues(pb.GradeAppealComponent_name, func(v int32) string { return appealComponentToString(pb.GradeAppealComponent(v)) })
+	appealStatus := migrate.EnumValues(pb.GradeAppealStatus_name, func(v int32) string { return appealStatusToString(pb.GradeAppealStatus(v)) })
+	milestoneResult := migrate.EnumValues(pb.MilestoneResult_name, func(v int32) string { return milestoneResultToString(pb.MilestoneResult(v)) })
+	rounding := migrate.EnumValues(pb.GradeRounding_name, func(v int32) string { return roundingToString(pb.GradeRounding(v)) })
+
+	return []migrate.EnumColumn{
+		{Table: "Topic", Column: "status", Enum: "TopicStatus", Values: topicStatus},
+		{Table: "Topic_council", Column: "stage", Enum: "TopicStage", Values: stage},
+		{Table: "Topic_cosign", Column: "status", Enum: "CoSignStatus", Values: coSign},
+		{Table: "Topic_registration", Column: "status", Enum: "RegistrationStatus", Values: registration},
+		{Table: "Midterm", Column: "status", Enum: "MidtermStatus", Values: midterm},
+		{Table: "Final", Column: "status", Enum: "FinalStatus", Values: final},
+		{Table: "Grade_review", Column: "status", Enum: "FinalStatus", Values: final},
+		{Table: "Submission_deadline", Column: "stage", Enum: "TopicStage", Values: stage},
+		{Table: "Submission_deadline", Column: "kind", Enum: "SubmissionKind", Values: kind},
+		{Table: "Deadline_extension", Column: "stage", Enum: "TopicStage", Values: stage},
+		{Table: "Deadline_extension", Column: "kind", Enum: "SubmissionKind", Values: kind},
+		{Table: "Grade_amendment", Column: "target", Enum: "GradeAmendmentTarget", Values: amendmentTarget},
+		{Table: "Grade_amendment", Column: "status", Enum: "GradeAmendmentStatus", Values: amendmentStatus},
+		{Table: "Grade_appeal", Column: "component", Enum: "GradeAppealComponent", Values: appealComponent},
+		{Table: "Grade_appeal", Column: "status", Enum: "GradeAppealStatus", Values: appealStatus},
+		{Table: "Grade_appeal_event", Column: "status", Enum: "GradeAppealStatus", Values: appealStatus},
+		{Table: "Midterm_milestone", Column: "stage", Enum: "TopicStage", Values: stage},
+		{Table: "Milestone_checkin", Column: "result", Enum: "MilestoneResult", Values: milestoneResult},
+		{Table: "Grading_policy", Column: "stage", Enum: "TopicStage", Values: stage},
+		{Table: "Grading_policy", Column: "rounding", Enum: "GradeRounding", Values: rounding},
+	}
+}
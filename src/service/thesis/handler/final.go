@@ -27,55 +27,31 @@ func (h *Handler) CreateFinal(ctx context.Context, req *pb.CreateFinalRequest) (
 	// Generate UUID
 	id := uuid.New().String()
 
-	// Prepare fields
-	SupervisorGrade := int32(0)
-	if req.SupervisorGrade != nil {
-		SupervisorGrade = *req.SupervisorGrade
-	}
-	DepartmentGrade := int32(0)
-	if req.DepartmentGrade != nil {
-		DepartmentGrade = *req.DepartmentGrade
-	}
-	FinalGrade := int32(0)
-	if req.FinalGrade != nil {
-		FinalGrade = *req.FinalGrade
-	}
+	// Prepare fields; grades left unset stay NULL so ComputeFinalGrade sees them as missing,
+	// and final_grade is only written by ComputeFinalGrade
 	Notes := ""
 	if req.Notes != nil {
 		Notes = *req.Notes
 	}
 
 	// Convert Status enum to string
-	StatusValue := pb.FinalStatus_PENDING
-
-	StatusValue = req.Status
-	StatusStr := "pending"
-	switch StatusValue {
-	case pb.FinalStatus_PENDING:
-		StatusStr = "pending"
-	case pb.FinalStatus_PASSED:
-		StatusStr = "passed"
-	case pb.FinalStatus_FAILED:
-		StatusStr = "failed"
-	case pb.FinalStatus_COMPLETED:
-		StatusStr = "completed"
-	}
+	StatusStr := finalStatusToString(req.Status)
 
 	// Insert into database
 	query := `
-		INSERT INTO Final (id, title, supervisor_grade, department_grade, final_grade, status, notes, created_by, created_at, updated_at)
+		INSERT INTO Final (id, title, supervisor_grade, department_grade, status, notes, created_by, updated_by, created_at, updated_at)
 		VALUES (?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
 	`
 
 	_, err := h.execQuery(ctx, query,
 		id,
 		req.Title,
-		SupervisorGrade,
-		DepartmentGrade,
-		FinalGrade,
+		req.SupervisorGrade,
+		req.DepartmentGrade,
 		StatusStr,
 		Notes,
 		req.CreatedBy,
+		req.CreatedBy,
 	)
 
 	if err != nil {
@@ -103,14 +79,17 @@ func (h *Handler) GetFinal(ctx context.Context, req *pb.GetFinalRequest) (*pb.Ge
 	}
 
 	query := `
-		SELECT id, title, supervisor_grade, department_grade, final_grade, status, notes, created_at, updated_at, created_by, updated_by
+		SELECT id, title, supervisor_grade, department_grade, final_grade, status, notes, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM Final
 		WHERE id = ?
 	`
+	if !req.IncludeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
 
 	var entity pb.Final
-	var createdAt, updatedAt sql.NullTime
-	var updatedBy sql.NullString
+	var createdAt, updatedAt, deletedAt sql.NullTime
+	var updatedBy, deletedBy sql.NullString
 	var StatusStr string
 
 	err := h.queryRow(ctx, query, req.Id).Scan(
@@ -125,6 +104,9 @@ func (h *Handler) GetFinal(ctx context.Context, req *pb.GetFinalRequest) (*pb.Ge
 		&updatedAt,
 		&entity.CreatedBy,
 		&updatedBy,
+		&entity.Version,
+		&deletedAt,
+		&deletedBy,
 	)
 
 	if err != nil {
@@ -135,18 +117,7 @@ func (h *Handler) GetFinal(ctx context.Context, req *pb.GetFinalRequest) (*pb.Ge
 	}
 
 	// Convert Status string to enum
-	switch StatusStr {
-	case "pending":
-		entity.Status = pb.FinalStatus_PENDING
-	case "passed":
-		entity.Status = pb.FinalStatus_PASSED
-	case "failed":
-		entity.Status = pb.FinalStatus_FAILED
-	case "completed":
-		entity.Status = pb.FinalStatus_COMPLETED
-	default:
-		entity.Status = pb.FinalStatus_PENDING
-	}
+	entity.Status = finalStatusFromString(StatusStr)
 
 	if createdAt.Valid {
 		entity.CreatedAt = timestamppb.New(createdAt.Time)
@@ -157,6 +128,10 @@ func (h *Handler) GetFinal(ctx context.Context, req *pb.GetFinalRequest) (*pb.Ge
 	if updatedBy.Valid {
 		entity.UpdatedBy = updatedBy.String
 	}
+	if deletedAt.Valid {
+		entity.DeletedAt = timestamppb.New(deletedAt.Time)
+		entity.DeletedBy = deletedBy.String
+	}
 
 	return &pb.GetFinalResponse{
 		Final: &entity,
@@ -171,6 +146,11 @@ func (h *Handler) UpdateFinal(ctx context.Context, req *pb.UpdateFinalRequest) (
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
+	// The status follows the final grade
+	if req.Status != nil {
+		return nil, status.Error(codes.InvalidArgument, "final status is derived by ComputeFinalGrade")
+	}
+
 	// Build dynamic update query
 	updateFields := []string{}
 	args := []interface{}{}
@@ -181,6 +161,13 @@ func (h *Handler) UpdateFinal(ctx context.Context, req *pb.UpdateFinalRequest) (
 
 	}
 	if req.SupervisorGrade != nil {
+		// A changed supervisor grade voids the final grade derived from it
+		// until ComputeFinalGrade runs again. MySQL assigns left to right, so
+		// these compare against the grade before it is overwritten.
+		updateFields = append(updateFields, "final_grade = IF(supervisor_grade <=> ?, final_grade, NULL)")
+		args = append(args, *req.SupervisorGrade)
+		updateFields = append(updateFields, "status = IF(supervisor_grade <=> ?, status, ?)")
+		args = append(args, *req.SupervisorGrade, finalStatusToString(pb.FinalStatus_PENDING))
 		updateFields = append(updateFields, "supervisor_grade = ?")
 		args = append(args, *req.SupervisorGrade)
 
@@ -189,27 +176,6 @@ func (h *Handler) UpdateFinal(ctx context.Context, req *pb.UpdateFinalRequest) (
 		updateFields = append(updateFields, "department_grade = ?")
 		args = append(args, *req.DepartmentGrade)
 
-	}
-	if req.FinalGrade != nil {
-		updateFields = append(updateFields, "final_grade = ?")
-		args = append(args, *req.FinalGrade)
-
-	}
-	if req.Status != nil {
-		updateFields = append(updateFields, "status = ?")
-		StatusStr := "pending"
-		switch *req.Status {
-		case pb.FinalStatus_PENDING:
-			StatusStr = "pending"
-		case pb.FinalStatus_PASSED:
-			StatusStr = "passed"
-		case pb.FinalStatus_FAILED:
-			StatusStr = "failed"
-		case pb.FinalStatus_COMPLETED:
-			StatusStr = "completed"
-		}
-		args = append(args, StatusStr)
-
 	}
 	if req.Notes != nil {
 		updateFields = append(updateFields, "notes = ?")
@@ -226,18 +192,41 @@ func (h *Handler) UpdateFinal(ctx context.Context, req *pb.UpdateFinalRequest) (
 	args = append(args, req.UpdatedBy)
 	updateFields = append(updateFields, "updated_at = NOW()")
 
-	// Add id as last parameter
-	args = append(args, req.Id)
+	// Bump the version; an expected version only matches an unchanged record
+	updateFields = append(updateFields, "version = version + 1")
+	where := helper.WhereVersion(req.Id, req.Version, &args)
 
 	query := fmt.Sprintf(`
 		UPDATE Final
 		SET %s
-		WHERE id = ?
-	`, strings.Join(updateFields, ", "))
+		WHERE %s AND deleted_at IS NULL
+	`, strings.Join(updateFields, ", "), where)
+
+	// The lock is checked in the transaction of the write, so a semester
+	// locked in between cannot let it through
+	err := h.withTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		// Grades of a locked semester only change through an approved amendment
+		if req.SupervisorGrade != nil || req.DepartmentGrade != nil {
+			if err := checkGradesUnlocked(ctx, tx, enrollmentByFinal, req.Id); err != nil {
+				return err
+			}
+		}
 
-	_, err := h.execQuery(ctx, query, args...)
+		updated, err := h.execQuery(ctx, query, args...)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to update final: %v", err)
+		}
+		if n, _ := updated.RowsAffected(); n == 0 {
+			current, err := h.GetFinal(ctx, &pb.GetFinalRequest{Id: req.Id})
+			if err != nil {
+				return err
+			}
+			return helper.VersionConflict("final", current.GetFinal(), current.GetFinal().Version)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update final: %v", err)
+		return nil, err
 	}
 
 	result, err := h.GetFinal(ctx, &pb.GetFinalRequest{Id: req.Id})
@@ -249,7 +238,7 @@ func (h *Handler) UpdateFinal(ctx context.Context, req *pb.UpdateFinalRequest) (
 	}, nil
 }
 
-// DeleteFinal deletes a Final by ID
+// DeleteFinal soft-deletes a Final by ID
 func (h *Handler) DeleteFinal(ctx context.Context, req *pb.DeleteFinalRequest) (*pb.DeleteFinalResponse, error) {
 	defer logger.TraceFunction(ctx)()
 
@@ -257,11 +246,52 @@ func (h *Handler) DeleteFinal(ctx context.Context, req *pb.DeleteFinalRequest) (
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	query := `DELETE FROM Final WHERE id = ?`
+	// Soft delete; the retention job removes the row later
+	query := `UPDATE Final SET deleted_at = NOW(), deleted_by = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL`
+
+	var rowsAffected int64
+	err := h.withTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		// Grades of a locked semester only change through an approved amendment
+		if err := checkGradesUnlocked(ctx, tx, enrollmentByFinal, req.Id); err != nil {
+			return err
+		}
+
+		result, err := h.execQuery(ctx, query, req.DeletedBy, req.Id)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to delete final: %v", err)
+		}
+		rowsAffected, err = result.RowsAffected()
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get rows affected: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "final not found")
+	}
+
+	return &pb.DeleteFinalResponse{
+		Success: true,
+	}, nil
+}
+
+// RestoreFinal brings back a soft-deleted Final
+func (h *Handler) RestoreFinal(ctx context.Context, req *pb.RestoreFinalRequest) (*pb.RestoreFinalResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	query := `UPDATE Final SET deleted_at = NULL, deleted_by = NULL, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ? AND deleted_at IS NOT NULL`
 
-	result, err := h.execQuery(ctx, query, req.Id)
+	result, err := h.execQuery(ctx, query, req.RestoredBy, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete final: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to restore final: %v", err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
@@ -270,11 +300,19 @@ func (h *Handler) DeleteFinal(ctx context.Context, req *pb.DeleteFinalRequest) (
 	}
 
 	if rowsAffected == 0 {
-		return nil, status.Error(codes.NotFound, "final not found")
+		// Unknown ids are NotFound; a live record has nothing to restore
+		if _, err := h.GetFinal(ctx, &pb.GetFinalRequest{Id: req.Id}); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, "final is not deleted")
 	}
 
-	return &pb.DeleteFinalResponse{
-		Success: true,
+	restored, err := h.GetFinal(ctx, &pb.GetFinalRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreFinalResponse{
+		Final: restored.GetFinal(),
 	}, nil
 }
 
@@ -285,8 +323,6 @@ func (h *Handler) ListFinals(ctx context.Context, req *pb.ListFinalsRequest) (*p
 	// Default pagination
 	page := int32(1)
 	pageSize := int32(10)
-	sortBy := "created_at"
-	descending := true
 	if req.Search != nil && req.Search.Pagination != nil {
 		if req.Search.Pagination.Page > 0 {
 			page = req.Search.Pagination.Page
@@ -294,54 +330,41 @@ func (h *Handler) ListFinals(ctx context.Context, req *pb.ListFinalsRequest) (*p
 		if req.Search.Pagination.PageSize > 0 {
 			pageSize = req.Search.Pagination.PageSize
 		}
-		if req.Search.Pagination.SortBy != "" {
-			sortBy = req.Search.Pagination.SortBy
-		}
-		descending = req.Search.Pagination.Descending
 	}
 
 	// Calculate offset
 	offset := (page - 1) * pageSize
 
 	// Build WHERE clause from filters
-	whereClause := ""
 	args := []interface{}{}
-	whiteMap := map[string]bool{
-		"id": true,
-
-		"title":            true,
-		"supervisor_grade": true,
-		"department_grade": true,
-		"final_grade":      true,
-		"status":           true,
-		"notes":            true,
-	}
-	if req.Search != nil && len(req.Search.Filters) > 0 {
-		whereConditions := []string{}
-		for _, filter := range req.Search.Filters {
-			if filter.GetCondition() != nil {
-				condition := filter.GetCondition()
-				if _, ok := whiteMap[condition.Field]; !ok {
-					continue
-				}
-				whereConditions = append(whereConditions, helper.BuildFilterCondition(condition, &args))
-			}
-		}
-		if len(whereConditions) > 0 {
-			whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
-		}
+	filterFields := helper.FilterFields{
+		"id":               {},
+		"title":            {},
+		"supervisor_grade": {Type: helper.FieldInt},
+		"department_grade": {Type: helper.FieldInt},
+		"final_grade":      {Type: helper.FieldFloat},
+		"status":           {Type: helper.FieldEnum, Values: []string{"pending", "passed", "failed", "completed"}},
+		"notes":            {},
+		"completion_date":  {Type: helper.FieldTime},
+		"created_at":       {Type: helper.FieldTime},
+		"updated_at":       {Type: helper.FieldTime},
+	}
+	whereClause, err := helper.BuildWhere(req.Search.GetFilters(), filterFields, &args)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
+	whereClause = helper.NotDeleted(whereClause, req.IncludeDeleted)
 
 	// Build ORDER BY clause
-	sortDirection := "ASC"
-	if descending {
-		sortDirection = "DESC"
+	orderBy, err := helper.BuildOrderBy(req.Search.GetPagination(), filterFields)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %v", err)
 	}
 
 	// Get total count
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM Final %s", whereClause)
 	var total int32
-	err := h.queryRow(ctx, countQuery, args...).Scan(&total)
+	err = h.queryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to count finals: %v", err)
 	}
@@ -349,12 +372,12 @@ func (h *Handler) ListFinals(ctx context.Context, req *pb.ListFinalsRequest) (*p
 	// Get entities with pagination
 	args = append(args, pageSize, offset)
 	query := fmt.Sprintf(`
-		SELECT id, title, supervisor_grade, department_grade, final_grade, status, notes, created_at, updated_at, created_by, updated_by
+		SELECT id, title, supervisor_grade, department_grade, final_grade, status, notes, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM Final
 		%s
-		ORDER BY %s %s
+		%s
 		LIMIT ? OFFSET ?
-	`, whereClause, sortBy, sortDirection)
+	`, whereClause, orderBy)
 
 	rows, err := h.query(ctx, query, args...)
 	if err != nil {
@@ -365,8 +388,8 @@ func (h *Handler) ListFinals(ctx context.Context, req *pb.ListFinalsRequest) (*p
 	entities := []*pb.Final{}
 	for rows.Next() {
 		var entity pb.Final
-		var createdAt, updatedAt sql.NullTime
-		var updatedBy sql.NullString
+		var createdAt, updatedAt, deletedAt sql.NullTime
+		var updatedBy, deletedBy sql.NullString
 		var StatusStr string
 
 		err := rows.Scan(
@@ -381,24 +404,16 @@ func (h *Handler) ListFinals(ctx context.Context, req *pb.ListFinalsRequest) (*p
 			&updatedAt,
 			&entity.CreatedBy,
 			&updatedBy,
+			&entity.Version,
+			&deletedAt,
+			&deletedBy,
 		)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan final: %v", err)
 		}
 
 		// Convert Status string to enum
-		switch StatusStr {
-		case "pending":
-			entity.Status = pb.FinalStatus_PENDING
-		case "passed":
-			entity.Status = pb.FinalStatus_PASSED
-		case "failed":
-			entity.Status = pb.FinalStatus_FAILED
-		case "completed":
-			entity.Status = pb.FinalStatus_COMPLETED
-		default:
-			entity.Status = pb.FinalStatus_PENDING
-		}
+		entity.Status = finalStatusFromString(StatusStr)
 
 		if createdAt.Valid {
 			entity.CreatedAt = timestamppb.New(createdAt.Time)
@@ -409,6 +424,10 @@ func (h *Handler) ListFinals(ctx context.Context, req *pb.ListFinalsRequest) (*p
 		if updatedBy.Valid {
 			entity.UpdatedBy = updatedBy.String
 		}
+		if deletedAt.Valid {
+			entity.DeletedAt = timestamppb.New(deletedAt.Time)
+			entity.DeletedBy = deletedBy.String
+		}
 
 		entities = append(entities, &entity)
 	}
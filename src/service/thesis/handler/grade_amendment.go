@@ -0,0 +1,418 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"strings"
+
+	pb "thaily/proto/thesis"
+	"thaily/src/pkg/audit"
+	"thaily/src/pkg/grading"
+	"thaily/src/pkg/logger"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// amendmentTarget is where a GradeAmendmentTarget's value lives
+type amendmentTarget struct {
+	// enrollmentColumn reaches the enrollment from the grade row
+	enrollmentColumn string
+	table            string
+	column           string
+	// integer columns only take whole grades
+	integer bool
+}
+
+var amendmentTargets = map[pb.GradeAmendmentTarget]amendmentTarget{
+	pb.GradeAmendmentTarget_AMEND_SUPERVISOR_GRADE: {enrollmentByFinal, "Final", "supervisor_grade", true},
+	pb.GradeAmendmentTarget_AMEND_REVIEW_GRADE:     {enrollmentByGradeReview, "Grade_review", "review_grade", true},
+	pb.GradeAmendmentTarget_AMEND_MIDTERM_GRADE:    {enrollmentByMidterm, "Midterm", "grade", true},
+	pb.GradeAmendmentTarget_AMEND_FINAL_GRADE:      {enrollmentByFinal, "Final", "final_grade", false},
+}
+
+func amendmentTargetToString(t pb.GradeAmendmentTarget) string {
+	switch t {
+	case pb.GradeAmendmentTarget_AMEND_REVIEW_GRADE:
+		return "review_grade"
+	case pb.GradeAmendmentTarget_AMEND_MIDTERM_GRADE:
+		return "midterm_grade"
+	case pb.GradeAmendmentTarget_AMEND_FINAL_GRADE:
+		return "final_grade"
+	default:
+		return "supervisor_grade"
+	}
+}
+
+func amendmentTargetFromString(t string) pb.GradeAmendmentTarget {
+	switch t {
+	case "review_grade":
+		return pb.GradeAmendmentTarget_AMEND_REVIEW_GRADE
+	case "midterm_grade":
+		return pb.GradeAmendmentTarget_AMEND_MIDTERM_GRADE
+	case "final_grade":
+		return pb.GradeAmendmentTarget_AMEND_FINAL_GRADE
+	default:
+		return pb.GradeAmendmentTarget_AMEND_SUPERVISOR_GRADE
+	}
+}
+
+func amendmentStatusToString(s pb.GradeAmendmentStatus) string {
+	switch s {
+	case pb.GradeAmendmentStatus_AMENDMENT_APPROVED:
+		return "approved"
+	case pb.GradeAmendmentStatus_AMENDMENT_REJECTED:
+		return "rejected"
+	default:
+		return "pending"
+	}
+}
+
+func amendmentStatusFromString(s string) pb.GradeAmendmentStatus {
+	switch s {
+	case "approved":
+		return pb.GradeAmendmentStatus_AMENDMENT_APPROVED
+	case "rejected":
+		return pb.GradeAmendmentStatus_AMENDMENT_REJECTED
+	default:
+		return pb.GradeAmendmentStatus_AMENDMENT_PENDING
+	}
+}
+
+const gradeAmendmentColumns = `id, target, target_code, enrollment_code, semester_code, old_value, new_value, reason, status, requested_by, decided_by, decided_at, decision_note, created_at, updated_at`
+
+func scanGradeAmendment(scan func(dest ...interface{}) error) (*pb.GradeAmendment, error) {
+	var entity pb.GradeAmendment
+	var oldValue sql.NullFloat64
+	var decidedBy, decisionNote sql.NullString
+	var decidedAt, createdAt, updatedAt sql.NullTime
+	var TargetStr, StatusStr string
+
+	err := scan(
+		&entity.Id,
+		&TargetStr,
+		&entity.TargetCode,
+		&entity.EnrollmentCode,
+		&entity.SemesterCode,
+		&oldValue,
+		&entity.NewValue,
+		&entity.Reason,
+		&StatusStr,
+		&entity.RequestedBy,
+		&decidedBy,
+		&decidedAt,
+		&decisionNote,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entity.Target = amendmentTargetFromString(TargetStr)
+	entity.Status = amendmentStatusFromString(StatusStr)
+	if oldValue.Valid {
+		entity.OldValue = &oldValue.Float64
+	}
+	entity.DecidedBy = decidedBy.String
+	entity.DecisionNote = decisionNote.String
+	if decidedAt.Valid {
+		entity.DecidedAt = timestamppb.New(decidedAt.Time)
+	}
+	if createdAt.Valid {
+		entity.CreatedAt = timestamppb.New(createdAt.Time)
+	}
+	if updatedAt.Valid {
+		entity.UpdatedAt = timestamppb.New(updatedAt.Time)
+	}
+	return &entity, nil
+}
+
+func getGradeAmendment(ctx context.Context, q dbtx, id string, lock bool) (*pb.GradeAmendment, error) {
+	query := `SELECT ` + gradeAmendmentColumns + ` FROM Grade_amendment WHERE id = ?`
+	if lock {
+		query += ` FOR UPDATE`
+	}
+
+	amendment, err := scanGradeAmendment(q.QueryRowContext(ctx, query, id).Scan)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "grade amendment not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get grade amendment: %v", err)
+	}
+	return amendment, nil
+}
+
+// RequestGradeAmendment asks academic affairs to change a grade of a locked
+// semester; grades of an unlocked semester are edited directly
+func (h *Handler) RequestGradeAmendment(ctx context.Context, req *pb.RequestGradeAmendmentRequest) (*pb.RequestGradeAmendmentResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	target, ok := amendmentTargets[req.Target]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown amendment target")
+	}
+	if req.TargetCode == "" || req.RequestedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "target_code and requested_by are required")
+	}
+	if strings.TrimSpace(req.Reason) == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+	if req.NewValue < 0 || req.NewValue > grading.Scale {
+		return nil, status.Errorf(codes.InvalidArgument, "new_value must be in [0, %d]", grading.Scale)
+	}
+	if target.integer && req.NewValue != math.Trunc(req.NewValue) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s takes whole grades", target.column)
+	}
+
+	owner, err := getGradeOwner(ctx, h.conn(ctx), target.enrollmentColumn, req.TargetCode, false)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "grade is not attached to an enrollment")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get enrollment: %v", err)
+	}
+	if !owner.locked {
+		return nil, status.Errorf(codes.FailedPrecondition, "grades of semester %s are not locked; edit the grade directly", owner.semesterCode)
+	}
+
+	var oldValue sql.NullFloat64
+	err = h.queryRow(ctx, `SELECT `+target.column+` FROM `+target.table+` WHERE id = ?`, req.TargetCode).Scan(&oldValue)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "%s not found", strings.ToLower(target.table))
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current grade: %v", err)
+	}
+
+	var pending bool
+	err = h.queryRow(ctx, `
+		SELECT EXISTS(SELECT 1 FROM Grade_amendment WHERE target = ? AND target_code = ? AND status = 'pending')
+	`, amendmentTargetToString(req.Target), req.TargetCode).Scan(&pending)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check pending amendments: %v", err)
+	}
+	if pending {
+		return nil, status.Error(codes.AlreadyExists, "an amendment of this grade is already pending")
+	}
+
+	id := uuid.New().String()
+	_, err = h.execQuery(ctx, `
+		INSERT INTO Grade_amendment (id, target, target_code, enrollment_code, semester_code, old_value, new_value, reason, status, requested_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, 'pending', ?, NOW(), NOW())
+	`, id, amendmentTargetToString(req.Target), req.TargetCode, owner.enrollmentCode, owner.semesterCode,
+		oldValue, req.NewValue, strings.TrimSpace(req.Reason), req.RequestedBy)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create grade amendment: %v", err)
+	}
+
+	amendment, err := getGradeAmendment(ctx, h.conn(ctx), id, false)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RequestGradeAmendmentResponse{
+		Amendment: amendment,
+	}, nil
+}
+
+// DecideGradeAmendment approves or rejects a pending amendment; approving
+// writes the new value to the locked grade. The appeal waiting on the
+// amendment is settled in the same transaction.
+func (h *Handler) DecideGradeAmendment(ctx context.Context, req *pb.DecideGradeAmendmentRequest) (*pb.DecideGradeAmendmentResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" || req.DecidedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "id and decided_by are required")
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	amendment, err := getGradeAmendment(ctx, tx, req.Id, true)
+	if err != nil {
+		return nil, err
+	}
+	if amendment.Status != pb.GradeAmendmentStatus_AMENDMENT_PENDING {
+		return nil, status.Error(codes.FailedPrecondition, "grade amendment is already decided")
+	}
+
+	decision := pb.GradeAmendmentStatus_AMENDMENT_REJECTED
+	if req.Approve {
+		decision = pb.GradeAmendmentStatus_AMENDMENT_APPROVED
+		if err := applyGradeAmendment(ctx, tx, amendment, req.CouncilScores, req.DecidedBy); err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE Grade_amendment
+		SET status = ?, decided_by = ?, decided_at = NOW(), decision_note = ?, updated_at = NOW()
+		WHERE id = ?
+	`, amendmentStatusToString(decision), req.DecidedBy, req.Note, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update grade amendment: %v", err)
+	}
+	if _, err := settleAppealAmendment(ctx, tx, req.Id, req.Approve, req.DecidedBy, req.Note); err != nil {
+		return nil, err
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	amendment, err = getGradeAmendment(ctx, h.db, req.Id, false)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DecideGradeAmendmentResponse{
+		Amendment: amendment,
+	}, nil
+}
+
+// applyGradeAmendment writes an approved amendment; a new final grade also
+// re-derives the Final's status from the pass threshold of its policy, and a
+// new supervisor or review grade recomputes a Final already graded
+func applyGradeAmendment(ctx context.Context, tx *sql.Tx, amendment *pb.GradeAmendment, councilScores []*pb.CouncilMemberScore, actor string) error {
+	target := amendmentTargets[amendment.Target]
+	audit.Track(ctx, tx, target.table, amendment.TargetCode)
+
+	if amendment.Target == pb.GradeAmendmentTarget_AMEND_FINAL_GRADE {
+		in, err := loadGradeInputs(ctx, tx, amendment.EnrollmentCode, true)
+		if err != nil {
+			return err
+		}
+		finalStatus := pb.FinalStatus_FAILED
+		if amendment.NewValue >= in.policy.PassThreshold {
+			finalStatus = pb.FinalStatus_PASSED
+		}
+		_, err = tx.ExecContext(ctx, `
+			UPDATE Final SET final_grade = ?, status = ?, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ?
+		`, amendment.NewValue, finalStatusToString(finalStatus), actor, amendment.TargetCode)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to amend final grade: %v", err)
+		}
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx,
+		`UPDATE `+target.table+` SET `+target.column+` = ?, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ?`,
+		int32(amendment.NewValue), actor, amendment.TargetCode,
+	)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to amend %s: %v", target.column, err)
+	}
+
+	if amendment.Target == pb.GradeAmendmentTarget_AMEND_SUPERVISOR_GRADE || amendment.Target == pb.GradeAmendmentTarget_AMEND_REVIEW_GRADE {
+		return recomputeFinalGrade(ctx, tx, amendment.EnrollmentCode, councilScores, actor)
+	}
+	return nil
+}
+
+// recomputeFinalGrade derives again the final grade and status of an
+// enrollment whose Final was graded, after one of its components changed.
+// A Final not graded yet is left to ComputeFinalGrade.
+func recomputeFinalGrade(ctx context.Context, tx *sql.Tx, enrollmentCode string, councilScores []*pb.CouncilMemberScore, actor string) error {
+	in, err := loadGradeInputs(ctx, tx, enrollmentCode, true)
+	if err != nil {
+		return err
+	}
+	if !in.finalCode.Valid {
+		return nil
+	}
+	audit.Track(ctx, tx, "Final", in.finalCode.String)
+	var finalGrade sql.NullFloat64
+	err = tx.QueryRowContext(ctx, `SELECT final_grade FROM Final WHERE id = ? AND deleted_at IS NULL`, in.finalCode.String).Scan(&finalGrade)
+	if err == sql.ErrNoRows || (err == nil && !finalGrade.Valid) {
+		return nil
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get final: %v", err)
+	}
+
+	result := grading.Compute(gradingPolicyOf(in.policy), gradingInputOf(in, councilScores))
+	if result.NeedsReconciliation {
+		return status.Errorf(codes.FailedPrecondition, "council scores spread %.2f exceeds %.2f: the council must reconcile first",
+			*result.CouncilSpread, in.policy.MaxCouncilSpread)
+	}
+	if !result.Ready() {
+		return status.Errorf(codes.FailedPrecondition, "cannot recompute the final grade, missing: %v", result.Missing)
+	}
+	breakdown := gradeBreakdown(in.policy, result)
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE Final
+		SET final_grade = ?, status = ?, updated_by = ?, updated_at = NOW(), version = version + 1
+		WHERE id = ?
+	`, *result.Grade, finalStatusToString(breakdown.Status), actor, in.finalCode.String)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to update final: %v", err)
+	}
+	return nil
+}
+
+// GetGradeAmendment retrieves a grade amendment by ID
+func (h *Handler) GetGradeAmendment(ctx context.Context, req *pb.GetGradeAmendmentRequest) (*pb.GetGradeAmendmentResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	amendment, err := getGradeAmendment(ctx, h.db, req.Id, false)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetGradeAmendmentResponse{
+		Amendment: amendment,
+	}, nil
+}
+
+func (h *Handler) ListGradeAmendments(ctx context.Context, req *pb.ListGradeAmendmentsRequest) (*pb.ListGradeAmendmentsResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	conditions := []string{}
+	args := []interface{}{}
+	if req.SemesterCode != nil {
+		conditions = append(conditions, "semester_code = ?")
+		args = append(args, *req.SemesterCode)
+	}
+	if req.Status != nil {
+		conditions = append(conditions, "status = ?")
+		args = append(args, amendmentStatusToString(*req.Status))
+	}
+
+	query := `SELECT ` + gradeAmendmentColumns + ` FROM Grade_amendment`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	query += ` ORDER BY created_at DESC`
+
+	rows, err := h.query(ctx, query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list grade amendments: %v", err)
+	}
+	defer rows.Close()
+
+	amendments := []*pb.GradeAmendment{}
+	for rows.Next() {
+		amendment, err := scanGradeAmendment(rows.Scan)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan grade amendment: %v", err)
+		}
+		amendments = append(amendments, amendment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating grade amendments: %v", err)
+	}
+
+	return &pb.ListGradeAmendmentsResponse{
+		Amendments: amendments,
+	}, nil
+}
@@ -0,0 +1,736 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	pbCouncil "thaily/proto/council"
+	pb "thaily/proto/thesis"
+	"thaily/src/pkg/audit"
+	"thaily/src/pkg/logger"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultAppealWindowDays applies to semesters without a Semester_grade_status row
+const defaultAppealWindowDays = 14
+
+// maxAppealWindowDays bounds SetGradeAppealWindow
+const maxAppealWindowDays = 90
+
+func appealComponentToString(c pb.GradeAppealComponent) string {
+	switch c {
+	case pb.GradeAppealComponent_APPEAL_REVIEW_GRADE:
+		return "review_grade"
+	case pb.GradeAppealComponent_APPEAL_MIDTERM_GRADE:
+		return "midterm_grade"
+	case pb.GradeAppealComponent_APPEAL_FINAL_GRADE:
+		return "final_grade"
+	case pb.GradeAppealComponent_APPEAL_DEFENCE:
+		return "defence"
+	default:
+		return "supervisor_grade"
+	}
+}
+
+func appealComponentFromString(c string) pb.GradeAppealComponent {
+	switch c {
+	case "review_grade":
+		return pb.GradeAppealComponent_APPEAL_REVIEW_GRADE
+	case "midterm_grade":
+		return pb.GradeAppealComponent_APPEAL_MIDTERM_GRADE
+	case "final_grade":
+		return pb.GradeAppealComponent_APPEAL_FINAL_GRADE
+	case "defence":
+		return pb.GradeAppealComponent_APPEAL_DEFENCE
+	default:
+		return pb.GradeAppealComponent_APPEAL_SUPERVISOR_GRADE
+	}
+}
+
+func appealStatusToString(s pb.GradeAppealStatus) string {
+	switch s {
+	case pb.GradeAppealStatus_APPEAL_ASSIGNED:
+		return "assigned"
+	case pb.GradeAppealStatus_APPEAL_CONFIRMED:
+		return "confirmed"
+	case pb.GradeAppealStatus_APPEAL_AMENDED:
+		return "amended"
+	default:
+		return "submitted"
+	}
+}
+
+func appealStatusFromString(s string) pb.GradeAppealStatus {
+	switch s {
+	case "assigned":
+		return pb.GradeAppealStatus_APPEAL_ASSIGNED
+	case "confirmed":
+		return pb.GradeAppealStatus_APPEAL_CONFIRMED
+	case "amended":
+		return pb.GradeAppealStatus_APPEAL_AMENDED
+	default:
+		return pb.GradeAppealStatus_APPEAL_SUBMITTED
+	}
+}
+
+// appealAmendmentTarget is the GradeAmendment target amending a thesis-side
+// component; the defence is amended in the council service
+func appealAmendmentTarget(c pb.GradeAppealComponent) (pb.GradeAmendmentTarget, bool) {
+	switch c {
+	case pb.GradeAppealComponent_APPEAL_SUPERVISOR_GRADE:
+		return pb.GradeAmendmentTarget_AMEND_SUPERVISOR_GRADE, true
+	case pb.GradeAppealComponent_APPEAL_REVIEW_GRADE:
+		return pb.GradeAmendmentTarget_AMEND_REVIEW_GRADE, true
+	case pb.GradeAppealComponent_APPEAL_MIDTERM_GRADE:
+		return pb.GradeAmendmentTarget_AMEND_MIDTERM_GRADE, true
+	case pb.GradeAppealComponent_APPEAL_FINAL_GRADE:
+		return pb.GradeAmendmentTarget_AMEND_FINAL_GRADE, true
+	default:
+		return 0, false
+	}
+}
+
+const gradeAppealColumns = `id, enrollment_code, student_code, semester_code, component, reason, evidence_file_code, status, reviewer_code, resolution_note, amendment_code, resolved_by, resolved_at, created_at, updated_at`
+
+func scanGradeAppeal(scan func(dest ...interface{}) error) (*pb.GradeAppeal, error) {
+	var entity pb.GradeAppeal
+	var evidenceFileCode, reviewerCode, resolutionNote, amendmentCode, resolvedBy sql.NullString
+	var resolvedAt, createdAt, updatedAt sql.NullTime
+	var ComponentStr, StatusStr string
+
+	err := scan(
+		&entity.Id,
+		&entity.EnrollmentCode,
+		&entity.StudentCode,
+		&entity.SemesterCode,
+		&ComponentStr,
+		&entity.Reason,
+		&evidenceFileCode,
+		&StatusStr,
+		&reviewerCode,
+		&resolutionNote,
+		&amendmentCode,
+		&resolvedBy,
+		&resolvedAt,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entity.Component = appealComponentFromString(ComponentStr)
+	entity.Status = appealStatusFromString(StatusStr)
+	entity.EvidenceFileCode = evidenceFileCode.String
+	entity.ReviewerCode = reviewerCode.String
+	entity.ResolutionNote = resolutionNote.String
+	entity.AmendmentCode = amendmentCode.String
+	entity.ResolvedBy = resolvedBy.String
+	if resolvedAt.Valid {
+		entity.ResolvedAt = timestamppb.New(resolvedAt.Time)
+	}
+	if createdAt.Valid {
+		entity.CreatedAt = timestamppb.New(createdAt.Time)
+	}
+	if updatedAt.Valid {
+		entity.UpdatedAt = timestamppb.New(updatedAt.Time)
+	}
+	return &entity, nil
+}
+
+// loadAppealHistory fills the history of the given appeals, oldest event first
+func (h *Handler) loadAppealHistory(ctx context.Context, appeals []*pb.GradeAppeal) error {
+	if len(appeals) == 0 {
+		return nil
+	}
+	byId := make(map[string]*pb.GradeAppeal, len(appeals))
+	placeholders := make([]string, 0, len(appeals))
+	args := make([]interface{}, 0, len(appeals))
+	for _, appeal := range appeals {
+		byId[appeal.Id] = appeal
+		placeholders = append(placeholders, "?")
+		args = append(args, appeal.Id)
+	}
+
+	rows, err := h.query(ctx, `
+		SELECT appeal_code, status, note, actor, created_at
+		FROM Grade_appeal_event
+		WHERE appeal_code IN (`+strings.Join(placeholders, ", ")+`)
+		ORDER BY created_at, id
+	`, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var appealCode, StatusStr string
+		var note sql.NullString
+		var createdAt sql.NullTime
+		event := &pb.GradeAppealEvent{}
+		if err := rows.Scan(&appealCode, &StatusStr, &note, &event.Actor, &createdAt); err != nil {
+			return err
+		}
+		event.Status = appealStatusFromString(StatusStr)
+		event.Note = note.String
+		if createdAt.Valid {
+			event.CreatedAt = timestamppb.New(createdAt.Time)
+		}
+		if appeal, ok := byId[appealCode]; ok {
+			appeal.History = append(appeal.History, event)
+		}
+	}
+	return rows.Err()
+}
+
+func (h *Handler) getGradeAppeal(ctx context.Context, q dbtx, id string, lock bool) (*pb.GradeAppeal, error) {
+	query := `SELECT ` + gradeAppealColumns + ` FROM Grade_appeal WHERE id = ?`
+	if lock {
+		query += ` FOR UPDATE`
+	}
+
+	appeal, err := scanGradeAppeal(q.QueryRowContext(ctx, query, id).Scan)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "grade appeal not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get grade appeal: %v", err)
+	}
+	return appeal, nil
+}
+
+// appealResponse reloads an appeal with its history
+func (h *Handler) appealResponse(ctx context.Context, id string) (*pb.GradeAppeal, error) {
+	appeal, err := h.getGradeAppeal(ctx, h.db, id, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.loadAppealHistory(ctx, []*pb.GradeAppeal{appeal}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load grade appeal history: %v", err)
+	}
+	return appeal, nil
+}
+
+func insertAppealEvent(ctx context.Context, e dbtx, appealCode string, s pb.GradeAppealStatus, note, actor string) error {
+	_, err := e.ExecContext(ctx, `
+		INSERT INTO Grade_appeal_event (id, appeal_code, status, note, actor, created_at)
+		VALUES (?, ?, ?, ?, ?, NOW())
+	`, uuid.New().String(), appealCode, appealStatusToString(s), note, actor)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to record grade appeal event: %v", err)
+	}
+	return nil
+}
+
+// SetGradeAppealWindow sets how many days after publication students may appeal
+func (h *Handler) SetGradeAppealWindow(ctx context.Context, req *pb.SetGradeAppealWindowRequest) (*pb.SetGradeAppealWindowResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.SemesterCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester_code is required")
+	}
+	if req.Days < 0 || req.Days > maxAppealWindowDays {
+		return nil, status.Errorf(codes.InvalidArgument, "days must be in [0, %d]", maxAppealWindowDays)
+	}
+
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "semester_code", "Semester", req.SemesterCode); err != nil {
+		return nil, err
+	}
+
+	_, err := h.execQuery(ctx, `
+		INSERT INTO Semester_grade_status (semester_code, appeal_window_days, updated_at)
+		VALUES (?, ?, NOW())
+		ON DUPLICATE KEY UPDATE appeal_window_days = VALUES(appeal_window_days), updated_at = NOW()
+	`, req.SemesterCode, req.Days)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set appeal window: %v", err)
+	}
+
+	gradeStatus, err := getSemesterGradeStatus(ctx, h.db, req.SemesterCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get grade status: %v", err)
+	}
+	return &pb.SetGradeAppealWindowResponse{
+		Status: gradeStatus,
+	}, nil
+}
+
+// FileGradeAppeal records a student's appeal of a published grade within the
+// semester's appeal window; one appeal per component stays open at a time
+func (h *Handler) FileGradeAppeal(ctx context.Context, req *pb.FileGradeAppealRequest) (*pb.FileGradeAppealResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.EnrollmentCode == "" || req.StudentCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "enrollment_code and student_code are required")
+	}
+	if strings.TrimSpace(req.Reason) == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+
+	var studentCode string
+	var finalCode, gradeReviewCode, midtermCode sql.NullString
+	err := h.queryRow(ctx, `
+		SELECT student_code, final_code, grade_review_code, midterm_code FROM Enrollment WHERE id = ? AND deleted_at IS NULL
+	`, req.EnrollmentCode).Scan(&studentCode, &finalCode, &gradeReviewCode, &midtermCode)
+	if err == sql.ErrNoRows || (err == nil && studentCode != req.StudentCode) {
+		return nil, status.Error(codes.NotFound, "enrollment not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get enrollment: %v", err)
+	}
+	graded := true
+	switch req.Component {
+	case pb.GradeAppealComponent_APPEAL_SUPERVISOR_GRADE, pb.GradeAppealComponent_APPEAL_FINAL_GRADE:
+		graded = finalCode.Valid
+	case pb.GradeAppealComponent_APPEAL_REVIEW_GRADE:
+		graded = gradeReviewCode.Valid
+	case pb.GradeAppealComponent_APPEAL_MIDTERM_GRADE:
+		graded = midtermCode.Valid
+	}
+	if !graded {
+		return nil, status.Error(codes.FailedPrecondition, "the enrollment has no such grade to appeal")
+	}
+
+	owner, err := getGradeOwner(ctx, h.db, enrollmentById, req.EnrollmentCode, false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get enrollment semester: %v", err)
+	}
+	gradeStatus, err := getSemesterGradeStatus(ctx, h.db, owner.semesterCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get grade status: %v", err)
+	}
+	if !gradeStatus.Published {
+		return nil, status.Error(codes.FailedPrecondition, "grades are not published yet")
+	}
+	if time.Now().After(gradeStatus.AppealDeadline.AsTime()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "the appeal window closed at %s", gradeStatus.AppealDeadline.AsTime().Format(time.RFC3339))
+	}
+
+	var open bool
+	err = h.queryRow(ctx, `
+		SELECT EXISTS(SELECT 1 FROM Grade_appeal WHERE enrollment_code = ? AND component = ? AND status IN ('submitted', 'assigned'))
+	`, req.EnrollmentCode, appealComponentToString(req.Component)).Scan(&open)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check open appeals: %v", err)
+	}
+	if open {
+		return nil, status.Error(codes.AlreadyExists, "an appeal of this grade is already open")
+	}
+
+	var evidenceFileCode sql.NullString
+	if req.EvidenceFileCode != nil && *req.EvidenceFileCode != "" {
+		evidenceFileCode = sql.NullString{String: *req.EvidenceFileCode, Valid: true}
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	id := uuid.New().String()
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO Grade_appeal (id, enrollment_code, student_code, semester_code, component, reason, evidence_file_code, status, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, 'submitted', NOW(), NOW())
+	`, id, req.EnrollmentCode, req.StudentCode, owner.semesterCode, appealComponentToString(req.Component),
+		strings.TrimSpace(req.Reason), evidenceFileCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create grade appeal: %v", err)
+	}
+	if err := insertAppealEvent(ctx, tx, id, pb.GradeAppealStatus_APPEAL_SUBMITTED, "", req.StudentCode); err != nil {
+		return nil, err
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	appeal, err := h.appealResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FileGradeAppealResponse{
+		Appeal: appeal,
+	}, nil
+}
+
+// AssignGradeAppeal gives an open appeal to a reviewer, or to another one
+func (h *Handler) AssignGradeAppeal(ctx context.Context, req *pb.AssignGradeAppealRequest) (*pb.AssignGradeAppealResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" || req.ReviewerCode == "" || req.Actor == "" {
+		return nil, status.Error(codes.InvalidArgument, "id, reviewer_code and actor are required")
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	appeal, err := h.getGradeAppeal(ctx, tx, req.Id, true)
+	if err != nil {
+		return nil, err
+	}
+	if appeal.Status != pb.GradeAppealStatus_APPEAL_SUBMITTED && appeal.Status != pb.GradeAppealStatus_APPEAL_ASSIGNED {
+		return nil, status.Error(codes.FailedPrecondition, "grade appeal is already resolved")
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE Grade_appeal SET reviewer_code = ?, status = 'assigned', updated_at = NOW() WHERE id = ?
+	`, req.ReviewerCode, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to assign grade appeal: %v", err)
+	}
+	if err := insertAppealEvent(ctx, tx, req.Id, pb.GradeAppealStatus_APPEAL_ASSIGNED, "reviewer "+req.ReviewerCode, req.Actor); err != nil {
+		return nil, err
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	appeal, err = h.appealResponse(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AssignGradeAppealResponse{
+		Appeal: appeal,
+	}, nil
+}
+
+// ResolveGradeAppeal closes an assigned appeal by confirming the grade. An
+// appeal is amended through RequestGradeAppealAmendment instead, so one
+// waiting on an amendment cannot be confirmed.
+func (h *Handler) ResolveGradeAppeal(ctx context.Context, req *pb.ResolveGradeAppealRequest) (*pb.ResolveGradeAppealResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" || req.ResolvedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "id and resolved_by are required")
+	}
+	if strings.TrimSpace(req.Note) == "" {
+		return nil, status.Error(codes.InvalidArgument, "note is required")
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	appeal, err := h.getGradeAppeal(ctx, tx, req.Id, true)
+	if err != nil {
+		return nil, err
+	}
+	if appeal.Status != pb.GradeAppealStatus_APPEAL_ASSIGNED {
+		return nil, status.Error(codes.FailedPrecondition, "only an assigned, unresolved appeal can be resolved")
+	}
+	if appeal.ReviewerCode != req.ResolvedBy {
+		return nil, status.Error(codes.PermissionDenied, "only the assigned reviewer resolves the appeal")
+	}
+	if appeal.AmendmentCode != "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "amendment %s of the appeal is awaiting a decision", appeal.AmendmentCode)
+	}
+
+	resolution := pb.GradeAppealStatus_APPEAL_CONFIRMED
+	_, err = tx.ExecContext(ctx, `
+		UPDATE Grade_appeal
+		SET status = ?, resolution_note = ?, resolved_by = ?, resolved_at = NOW(), updated_at = NOW()
+		WHERE id = ?
+	`, appealStatusToString(resolution), strings.TrimSpace(req.Note), req.ResolvedBy, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve grade appeal: %v", err)
+	}
+	if err := insertAppealEvent(ctx, tx, req.Id, resolution, strings.TrimSpace(req.Note), req.ResolvedBy); err != nil {
+		return nil, err
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	appeal, err = h.appealResponse(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ResolveGradeAppealResponse{
+		Appeal: appeal,
+	}, nil
+}
+
+// RequestGradeAppealAmendment records on an assigned appeal the amendment it
+// leads to. A thesis-side grade gets its GradeAmendment requested in the
+// same transaction; a defence appeal takes the council amendment the
+// reviewer requested, which must be pending and change a defence grade of
+// the appealed enrollment. The appeal is amended once the amendment is
+// approved.
+func (h *Handler) RequestGradeAppealAmendment(ctx context.Context, req *pb.RequestGradeAppealAmendmentRequest) (*pb.RequestGradeAppealAmendmentResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" || req.RequestedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "id and requested_by are required")
+	}
+	note := strings.TrimSpace(req.Note)
+	if note == "" {
+		return nil, status.Error(codes.InvalidArgument, "note is required")
+	}
+
+	err := h.withTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		appeal, err := h.getGradeAppeal(ctx, tx, req.Id, true)
+		if err != nil {
+			return err
+		}
+		if appeal.Status != pb.GradeAppealStatus_APPEAL_ASSIGNED {
+			return status.Error(codes.FailedPrecondition, "only an assigned, unresolved appeal can be amended")
+		}
+		if appeal.ReviewerCode != req.RequestedBy {
+			return status.Error(codes.PermissionDenied, "only the assigned reviewer amends the appeal")
+		}
+		if appeal.AmendmentCode != "" {
+			return status.Errorf(codes.FailedPrecondition, "amendment %s of the appeal is awaiting a decision", appeal.AmendmentCode)
+		}
+
+		var amendmentCode string
+		if target, ok := appealAmendmentTarget(appeal.Component); ok {
+			targetCode, err := appealTargetCode(ctx, tx, appeal)
+			if err != nil {
+				return err
+			}
+			resp, err := h.RequestGradeAmendment(ctx, &pb.RequestGradeAmendmentRequest{
+				Target:      target,
+				TargetCode:  targetCode,
+				NewValue:    req.NewValue,
+				Reason:      "grade appeal " + appeal.Id + ": " + note,
+				RequestedBy: req.RequestedBy,
+			})
+			if err != nil {
+				return err
+			}
+			amendmentCode = resp.Amendment.Id
+		} else {
+			amendment, err := h.defenceAmendment(ctx, req.GetDefenceAmendmentCode())
+			if err != nil {
+				return err
+			}
+			if amendment.EnrollmentCode != appeal.EnrollmentCode || amendment.RequestedBy != req.RequestedBy {
+				return status.Error(codes.InvalidArgument, "amendment is not the reviewer's change of a defence grade of the appealed enrollment")
+			}
+			if amendment.Status != pbCouncil.GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_PENDING ||
+				amendment.CreatedAt.AsTime().Before(appeal.CreatedAt.AsTime()) {
+				return status.Error(codes.FailedPrecondition, "amendment must be pending and requested after the appeal was filed")
+			}
+			amendmentCode = amendment.Id
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE Grade_appeal SET amendment_code = ?, resolution_note = ?, updated_at = NOW() WHERE id = ?
+		`, amendmentCode, note, req.Id)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to record grade amendment: %v", err)
+		}
+		return insertAppealEvent(ctx, tx, req.Id, pb.GradeAppealStatus_APPEAL_ASSIGNED, "amendment "+amendmentCode+" requested: "+note, req.RequestedBy)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	appeal, err := h.appealResponse(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RequestGradeAppealAmendmentResponse{
+		Appeal: appeal,
+	}, nil
+}
+
+// SettleGradeAppealAmendment settles the defence appeal waiting on a council
+// amendment, whose decision is read back from the council service
+func (h *Handler) SettleGradeAppealAmendment(ctx context.Context, req *pb.SettleGradeAppealAmendmentRequest) (*pb.SettleGradeAppealAmendmentResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	amendment, err := h.defenceAmendment(ctx, req.AmendmentCode)
+	if err != nil {
+		return nil, err
+	}
+	if amendment.Status == pbCouncil.GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_PENDING {
+		return nil, status.Error(codes.FailedPrecondition, "grade defence amendment is not decided yet")
+	}
+
+	var appealCode string
+	err = h.withTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		appealCode, err = settleAppealAmendment(ctx, tx, amendment.Id,
+			amendment.Status == pbCouncil.GradeDefenceAmendmentStatus_DEFENCE_AMENDMENT_APPROVED,
+			amendment.DecidedBy, amendment.DecisionNote)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.SettleGradeAppealAmendmentResponse{}
+	if appealCode != "" {
+		if resp.Appeal, err = h.appealResponse(ctx, appealCode); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// appealTargetCode returns the grade row a thesis-side appeal amends
+func appealTargetCode(ctx context.Context, q dbtx, appeal *pb.GradeAppeal) (string, error) {
+	var finalCode, gradeReviewCode, midtermCode sql.NullString
+	err := q.QueryRowContext(ctx, `
+		SELECT final_code, grade_review_code, midterm_code FROM Enrollment WHERE id = ? AND deleted_at IS NULL
+	`, appeal.EnrollmentCode).Scan(&finalCode, &gradeReviewCode, &midtermCode)
+	if err == sql.ErrNoRows {
+		return "", status.Error(codes.NotFound, "enrollment not found")
+	}
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get enrollment: %v", err)
+	}
+
+	code := finalCode
+	switch appeal.Component {
+	case pb.GradeAppealComponent_APPEAL_REVIEW_GRADE:
+		code = gradeReviewCode
+	case pb.GradeAppealComponent_APPEAL_MIDTERM_GRADE:
+		code = midtermCode
+	}
+	if !code.Valid {
+		return "", status.Error(codes.FailedPrecondition, "the enrollment has no such grade to amend")
+	}
+	return code.String, nil
+}
+
+// defenceAmendment reads a council amendment from the council service
+func (h *Handler) defenceAmendment(ctx context.Context, id string) (*pbCouncil.GradeDefenceAmendment, error) {
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "defence_amendment_code is required")
+	}
+	conn := h.refs.Conn("Council")
+	if conn == nil {
+		return nil, status.Error(codes.FailedPrecondition, "the council service is not configured to check defence amendments")
+	}
+	resp, err := pbCouncil.NewCouncilServiceClient(conn).GetGradeDefenceAmendment(ctx, &pbCouncil.GetGradeDefenceAmendmentRequest{Id: id})
+	if status.Code(err) == codes.NotFound {
+		return nil, status.Error(codes.NotFound, "grade defence amendment not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to get grade defence amendment: %v", err)
+	}
+	return resp.Amendment, nil
+}
+
+// settleAppealAmendment amends the appeal waiting on a decided amendment, or
+// hands it back to its reviewer when the amendment was rejected. It returns
+// the appeal's id, empty when no appeal waits on the amendment.
+func settleAppealAmendment(ctx context.Context, tx *sql.Tx, amendmentCode string, approved bool, actor, note string) (string, error) {
+	var appealCode string
+	err := tx.QueryRowContext(ctx, `
+		SELECT id FROM Grade_appeal WHERE amendment_code = ? AND status = 'assigned' FOR UPDATE
+	`, amendmentCode).Scan(&appealCode)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get grade appeal: %v", err)
+	}
+	audit.Track(ctx, tx, "Grade_appeal", appealCode)
+
+	if approved {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE Grade_appeal SET status = 'amended', resolved_by = ?, resolved_at = NOW(), updated_at = NOW() WHERE id = ?
+		`, actor, appealCode)
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to amend grade appeal: %v", err)
+		}
+		return appealCode, insertAppealEvent(ctx, tx, appealCode, pb.GradeAppealStatus_APPEAL_AMENDED, "amendment "+amendmentCode+" approved", actor)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE Grade_appeal SET amendment_code = NULL, resolution_note = NULL, updated_at = NOW() WHERE id = ?
+	`, appealCode)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to reopen grade appeal: %v", err)
+	}
+	event := "amendment " + amendmentCode + " rejected"
+	if note != "" {
+		event += ": " + note
+	}
+	return appealCode, insertAppealEvent(ctx, tx, appealCode, pb.GradeAppealStatus_APPEAL_ASSIGNED, event, actor)
+}
+
+func (h *Handler) GetGradeAppeal(ctx context.Context, req *pb.GetGradeAppealRequest) (*pb.GetGradeAppealResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	appeal, err := h.appealResponse(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetGradeAppealResponse{
+		Appeal: appeal,
+	}, nil
+}
+
+func (h *Handler) ListGradeAppeals(ctx context.Context, req *pb.ListGradeAppealsRequest) (*pb.ListGradeAppealsResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	conditions := []string{}
+	args := []interface{}{}
+	if req.SemesterCode != nil {
+		conditions = append(conditions, "semester_code = ?")
+		args = append(args, *req.SemesterCode)
+	}
+	if req.StudentCode != nil {
+		conditions = append(conditions, "student_code = ?")
+		args = append(args, *req.StudentCode)
+	}
+	if req.ReviewerCode != nil {
+		conditions = append(conditions, "reviewer_code = ?")
+		args = append(args, *req.ReviewerCode)
+	}
+	if req.Status != nil {
+		conditions = append(conditions, "status = ?")
+		args = append(args, appealStatusToString(*req.Status))
+	}
+
+	query := `SELECT ` + gradeAppealColumns + ` FROM Grade_appeal`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	query += ` ORDER BY created_at DESC`
+
+	rows, err := h.query(ctx, query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list grade appeals: %v", err)
+	}
+	defer rows.Close()
+
+	appeals := []*pb.GradeAppeal{}
+	for rows.Next() {
+		appeal, err := scanGradeAppeal(rows.Scan)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan grade appeal: %v", err)
+		}
+		appeals = append(appeals, appeal)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating grade appeals: %v", err)
+	}
+	rows.Close()
+
+	if err := h.loadAppealHistory(ctx, appeals); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load grade appeal history: %v", err)
+	}
+	return &pb.ListGradeAppealsResponse{
+		Appeals: appeals,
+	}, nil
+}
@@ -0,0 +1,213 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+
+	pbEvent "thaily/proto/event"
+	pb "thaily/proto/thesis"
+	"thaily/src/pkg/logger"
+	"thaily/src/pkg/outbox"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// getSemesterGradeStatus returns the lock and publication state of a semester;
+// a semester without a row is neither locked nor published
+func getSemesterGradeStatus(ctx context.Context, q dbtx, semesterCode string) (*pb.SemesterGradeStatus, error) {
+	entity := &pb.SemesterGradeStatus{SemesterCode: semesterCode, AppealWindowDays: defaultAppealWindowDays}
+	var lockedAt, publishedAt sql.NullTime
+	var lockedBy, publishedBy sql.NullString
+
+	err := q.QueryRowContext(ctx,
+		`SELECT locked_at, locked_by, published_at, published_by, appeal_window_days FROM Semester_grade_status WHERE semester_code = ?`,
+		semesterCode,
+	).Scan(&lockedAt, &lockedBy, &publishedAt, &publishedBy, &entity.AppealWindowDays)
+	if err == sql.ErrNoRows {
+		return entity, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if lockedAt.Valid {
+		entity.Locked = true
+		entity.LockedAt = timestamppb.New(lockedAt.Time)
+		entity.LockedBy = lockedBy.String
+	}
+	if publishedAt.Valid {
+		entity.Published = true
+		entity.PublishedAt = timestamppb.New(publishedAt.Time)
+		entity.PublishedBy = publishedBy.String
+		entity.AppealDeadline = timestamppb.New(publishedAt.Time.AddDate(0, 0, int(entity.AppealWindowDays)))
+	}
+	return entity, nil
+}
+
+// Enrollment columns a grade row is reached through
+const (
+	enrollmentByFinal       = "final_code"
+	enrollmentByGradeReview = "grade_review_code"
+	enrollmentByMidterm     = "midterm_code"
+	enrollmentById          = "id"
+)
+
+// gradeOwner is the enrollment, and its semester, a Final, Grade_review or Midterm belongs to
+type gradeOwner struct {
+	enrollmentCode string
+	semesterCode   string
+	locked         bool
+}
+
+// getGradeOwner finds the enrollment whose column (one of the enrollmentBy
+// constants) is code, and whether its semester's grades are locked; with
+// lock, the rows read are share-locked so the semester cannot be locked
+// until the transaction ends
+func getGradeOwner(ctx context.Context, q dbtx, column, code string, lock bool) (*gradeOwner, error) {
+	var owner gradeOwner
+	var lockedAt sql.NullTime
+	suffix := ""
+	if lock {
+		suffix = " FOR SHARE"
+	}
+	err := q.QueryRowContext(ctx, `
+		SELECT e.id, t.semester_code, s.locked_at
+		FROM Enrollment e
+		JOIN Topic_council tc ON tc.id = e.topic_council_code AND tc.deleted_at IS NULL
+		JOIN Topic t ON t.id = tc.topic_code AND t.deleted_at IS NULL
+		LEFT JOIN Semester_grade_status s ON s.semester_code = t.semester_code
+		WHERE e.`+column+` = ? AND e.deleted_at IS NULL
+		LIMIT 1
+	`+suffix, code).Scan(&owner.enrollmentCode, &owner.semesterCode, &lockedAt)
+	if err != nil {
+		return nil, err
+	}
+	owner.locked = lockedAt.Valid
+	return &owner, nil
+}
+
+// checkGradesUnlocked refuses a grade write once the owning semester is
+// locked. It runs in the transaction of the write and holds off
+// LockSemesterGrades until that ends. Grade rows not attached to an
+// enrollment yet are not locked.
+func checkGradesUnlocked(ctx context.Context, q dbtx, column, code string) error {
+	owner, err := getGradeOwner(ctx, q, column, code, true)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check grade lock: %v", err)
+	}
+	if owner.locked {
+		return status.Errorf(codes.FailedPrecondition, "grades of semester %s are locked; request a grade amendment", owner.semesterCode)
+	}
+	return nil
+}
+
+// LockSemesterGrades freezes the semester's grades; locking again is a no-op
+func (h *Handler) LockSemesterGrades(ctx context.Context, req *pb.LockSemesterGradesRequest) (*pb.LockSemesterGradesResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.SemesterCode == "" || req.Actor == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester_code and actor are required")
+	}
+
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "semester_code", "Semester", req.SemesterCode); err != nil {
+		return nil, err
+	}
+
+	// GradeLocked is only written by the call that locks the semester
+	err := h.withTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		current, err := getSemesterGradeStatus(ctx, tx, req.SemesterCode)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get grade status: %v", err)
+		}
+		if current.Locked {
+			return nil
+		}
+
+		_, err = h.execQuery(ctx, `
+			INSERT INTO Semester_grade_status (semester_code, locked_at, locked_by, updated_at)
+			VALUES (?, NOW(), ?, NOW())
+			ON DUPLICATE KEY UPDATE
+				locked_by = COALESCE(locked_by, VALUES(locked_by)),
+				locked_at = COALESCE(locked_at, VALUES(locked_at)),
+				updated_at = NOW()
+		`, req.SemesterCode, req.Actor)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to lock grades: %v", err)
+		}
+
+		err = outbox.Write(ctx, tx, &pbEvent.Event{
+			Aggregate:   "Semester",
+			AggregateId: req.SemesterCode,
+			Actor:       req.Actor,
+			Payload: &pbEvent.Event_GradeLocked{GradeLocked: &pbEvent.GradeLocked{
+				SemesterCode: req.SemesterCode,
+			}},
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to record grade lock event: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gradeStatus, err := getSemesterGradeStatus(ctx, h.db, req.SemesterCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get grade status: %v", err)
+	}
+	return &pb.LockSemesterGradesResponse{
+		Status: gradeStatus,
+	}, nil
+}
+
+// PublishSemesterGrades makes the semester's locked grades visible to students
+func (h *Handler) PublishSemesterGrades(ctx context.Context, req *pb.PublishSemesterGradesRequest) (*pb.PublishSemesterGradesResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.SemesterCode == "" || req.Actor == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester_code and actor are required")
+	}
+
+	result, err := h.execQuery(ctx, `
+		UPDATE Semester_grade_status
+		SET published_by = COALESCE(published_by, ?), published_at = COALESCE(published_at, NOW()), updated_at = NOW()
+		WHERE semester_code = ? AND locked_at IS NOT NULL
+	`, req.Actor, req.SemesterCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to publish grades: %v", err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "grades of semester %s must be locked before they are published", req.SemesterCode)
+	}
+
+	gradeStatus, err := getSemesterGradeStatus(ctx, h.db, req.SemesterCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get grade status: %v", err)
+	}
+	return &pb.PublishSemesterGradesResponse{
+		Status: gradeStatus,
+	}, nil
+}
+
+func (h *Handler) GetSemesterGradeStatus(ctx context.Context, req *pb.GetSemesterGradeStatusRequest) (*pb.GetSemesterGradeStatusResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.SemesterCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester_code is required")
+	}
+
+	gradeStatus, err := getSemesterGradeStatus(ctx, h.db, req.SemesterCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get grade status: %v", err)
+	}
+	return &pb.GetSemesterGradeStatusResponse{
+		Status: gradeStatus,
+	}, nil
+}
@@ -27,45 +27,33 @@ func (h *Handler) CreateGradeReview(ctx context.Context, req *pb.CreateGradeRevi
 		return nil, status.Error(codes.InvalidArgument, "teacher_code is required")
 	}
 
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "teacher_code", "Teacher", req.TeacherCode); err != nil {
+		return nil, err
+	}
+
 	// Generate UUID
 	id := uuid.New().String()
 
-	// Prepare fields
-	ReviewGrade := int32(0)
-	if req.ReviewGrade != nil {
-		ReviewGrade = *req.ReviewGrade
-	}
+	// Prepare fields; an unset review_grade stays NULL until the reviewer grades
 	Notes := ""
 	if req.Notes != nil {
 		Notes = *req.Notes
 	}
 
 	// Convert Status enum to string
-	StatusValue := pb.FinalStatus_PENDING
-
-	StatusValue = req.Status
-	StatusStr := "pending"
-	switch StatusValue {
-	case pb.FinalStatus_PENDING:
-		StatusStr = "pending"
-	case pb.FinalStatus_PASSED:
-		StatusStr = "passed"
-	case pb.FinalStatus_FAILED:
-		StatusStr = "failed"
-	case pb.FinalStatus_COMPLETED:
-		StatusStr = "completed"
-	}
+	StatusStr := finalStatusToString(req.Status)
 
 	// Insert into database
 	query := `
-		INSERT INTO GradeReview (id, title, review_grade, teacher_code, status, notes, created_by, created_at, updated_at)
+		INSERT INTO Grade_review (id, title, review_grade, teacher_code, status, notes, created_by, created_at, updated_at)
 		VALUES (?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
 	`
 
 	_, err := h.execQuery(ctx, query,
 		id,
 		req.Title,
-		ReviewGrade,
+		req.ReviewGrade,
 		req.TeacherCode,
 		StatusStr,
 		Notes,
@@ -97,14 +85,17 @@ func (h *Handler) GetGradeReview(ctx context.Context, req *pb.GetGradeReviewRequ
 	}
 
 	query := `
-		SELECT id, title, review_grade, teacher_code, status, notes, created_at, updated_at, created_by, updated_by
-		FROM GradeReview
+		SELECT id, title, review_grade, teacher_code, status, notes, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
+		FROM Grade_review
 		WHERE id = ?
 	`
+	if !req.IncludeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
 
 	var entity pb.GradeReview
-	var createdAt, updatedAt sql.NullTime
-	var updatedBy sql.NullString
+	var createdAt, updatedAt, deletedAt sql.NullTime
+	var updatedBy, deletedBy sql.NullString
 	var StatusStr string
 
 	err := h.queryRow(ctx, query, req.Id).Scan(
@@ -118,6 +109,9 @@ func (h *Handler) GetGradeReview(ctx context.Context, req *pb.GetGradeReviewRequ
 		&updatedAt,
 		&entity.CreatedBy,
 		&updatedBy,
+		&entity.Version,
+		&deletedAt,
+		&deletedBy,
 	)
 
 	if err != nil {
@@ -128,18 +122,7 @@ func (h *Handler) GetGradeReview(ctx context.Context, req *pb.GetGradeReviewRequ
 	}
 
 	// Convert Status string to enum
-	switch StatusStr {
-	case "pending":
-		entity.Status = pb.FinalStatus_PENDING
-	case "passed":
-		entity.Status = pb.FinalStatus_PASSED
-	case "failed":
-		entity.Status = pb.FinalStatus_FAILED
-	case "completed":
-		entity.Status = pb.FinalStatus_COMPLETED
-	default:
-		entity.Status = pb.FinalStatus_PENDING
-	}
+	entity.Status = finalStatusFromString(StatusStr)
 
 	if createdAt.Valid {
 		entity.CreatedAt = timestamppb.New(createdAt.Time)
@@ -150,6 +133,10 @@ func (h *Handler) GetGradeReview(ctx context.Context, req *pb.GetGradeReviewRequ
 	if updatedBy.Valid {
 		entity.UpdatedBy = updatedBy.String
 	}
+	if deletedAt.Valid {
+		entity.DeletedAt = timestamppb.New(deletedAt.Time)
+		entity.DeletedBy = deletedBy.String
+	}
 
 	return &pb.GetGradeReviewResponse{
 		GradeReview: &entity,
@@ -185,17 +172,7 @@ func (h *Handler) UpdateGradeReview(ctx context.Context, req *pb.UpdateGradeRevi
 	}
 	if req.Status != nil {
 		updateFields = append(updateFields, "status = ?")
-		StatusStr := "pending"
-		switch *req.Status {
-		case pb.FinalStatus_PENDING:
-			StatusStr = "pending"
-		case pb.FinalStatus_PASSED:
-			StatusStr = "passed"
-		case pb.FinalStatus_FAILED:
-			StatusStr = "failed"
-		case pb.FinalStatus_COMPLETED:
-			StatusStr = "completed"
-		}
+		StatusStr := finalStatusToString(*req.Status)
 		args = append(args, StatusStr)
 
 	}
@@ -205,6 +182,11 @@ func (h *Handler) UpdateGradeReview(ctx context.Context, req *pb.UpdateGradeRevi
 
 	}
 
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "teacher_code", "Teacher", req.GetTeacherCode()); err != nil {
+		return nil, err
+	}
+
 	if len(updateFields) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "no fields to update")
 	}
@@ -214,18 +196,41 @@ func (h *Handler) UpdateGradeReview(ctx context.Context, req *pb.UpdateGradeRevi
 	args = append(args, req.UpdatedBy)
 	updateFields = append(updateFields, "updated_at = NOW()")
 
-	// Add id as last parameter
-	args = append(args, req.Id)
+	// Bump the version; an expected version only matches an unchanged record
+	updateFields = append(updateFields, "version = version + 1")
+	where := helper.WhereVersion(req.Id, req.Version, &args)
 
 	query := fmt.Sprintf(`
-		UPDATE GradeReview
+		UPDATE Grade_review
 		SET %s
-		WHERE id = ?
-	`, strings.Join(updateFields, ", "))
+		WHERE %s AND deleted_at IS NULL
+	`, strings.Join(updateFields, ", "), where)
+
+	// The lock is checked in the transaction of the write, so a semester
+	// locked in between cannot let it through
+	err := h.withTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		// Grades of a locked semester only change through an approved amendment
+		if req.ReviewGrade != nil || req.Status != nil {
+			if err := checkGradesUnlocked(ctx, tx, enrollmentByGradeReview, req.Id); err != nil {
+				return err
+			}
+		}
 
-	_, err := h.execQuery(ctx, query, args...)
+		updated, err := h.execQuery(ctx, query, args...)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to update gradereview: %v", err)
+		}
+		if n, _ := updated.RowsAffected(); n == 0 {
+			current, err := h.GetGradeReview(ctx, &pb.GetGradeReviewRequest{Id: req.Id})
+			if err != nil {
+				return err
+			}
+			return helper.VersionConflict("grade review", current.GetGradeReview(), current.GetGradeReview().Version)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update gradereview: %v", err)
+		return nil, err
 	}
 
 	result, err := h.GetGradeReview(ctx, &pb.GetGradeReviewRequest{Id: req.Id})
@@ -237,7 +242,7 @@ func (h *Handler) UpdateGradeReview(ctx context.Context, req *pb.UpdateGradeRevi
 	}, nil
 }
 
-// DeleteGradeReview deletes a GradeReview by ID
+// DeleteGradeReview soft-deletes a GradeReview by ID
 func (h *Handler) DeleteGradeReview(ctx context.Context, req *pb.DeleteGradeReviewRequest) (*pb.DeleteGradeReviewResponse, error) {
 	defer logger.TraceFunction(ctx)()
 
@@ -245,11 +250,52 @@ func (h *Handler) DeleteGradeReview(ctx context.Context, req *pb.DeleteGradeRevi
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	query := `DELETE FROM GradeReview WHERE id = ?`
+	// Soft delete; the retention job removes the row later
+	query := `UPDATE Grade_review SET deleted_at = NOW(), deleted_by = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL`
 
-	result, err := h.execQuery(ctx, query, req.Id)
+	var rowsAffected int64
+	err := h.withTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		// Grades of a locked semester only change through an approved amendment
+		if err := checkGradesUnlocked(ctx, tx, enrollmentByGradeReview, req.Id); err != nil {
+			return err
+		}
+
+		result, err := h.execQuery(ctx, query, req.DeletedBy, req.Id)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to delete gradereview: %v", err)
+		}
+		rowsAffected, err = result.RowsAffected()
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get rows affected: %v", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete gradereview: %v", err)
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "gradereview not found")
+	}
+
+	return &pb.DeleteGradeReviewResponse{
+		Success: true,
+	}, nil
+}
+
+// RestoreGradeReview brings back a soft-deleted GradeReview
+func (h *Handler) RestoreGradeReview(ctx context.Context, req *pb.RestoreGradeReviewRequest) (*pb.RestoreGradeReviewResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	query := `UPDATE Grade_review SET deleted_at = NULL, deleted_by = NULL, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ? AND deleted_at IS NOT NULL`
+
+	result, err := h.execQuery(ctx, query, req.RestoredBy, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore grade review: %v", err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
@@ -258,11 +304,19 @@ func (h *Handler) DeleteGradeReview(ctx context.Context, req *pb.DeleteGradeRevi
 	}
 
 	if rowsAffected == 0 {
-		return nil, status.Error(codes.NotFound, "gradereview not found")
+		// Unknown ids are NotFound; a live record has nothing to restore
+		if _, err := h.GetGradeReview(ctx, &pb.GetGradeReviewRequest{Id: req.Id}); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, "grade review is not deleted")
 	}
 
-	return &pb.DeleteGradeReviewResponse{
-		Success: true,
+	restored, err := h.GetGradeReview(ctx, &pb.GetGradeReviewRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreGradeReviewResponse{
+		GradeReview: restored.GetGradeReview(),
 	}, nil
 }
 
@@ -273,8 +327,6 @@ func (h *Handler) ListGradeReviews(ctx context.Context, req *pb.ListGradeReviews
 	// Default pagination
 	page := int32(1)
 	pageSize := int32(10)
-	sortBy := "created_at"
-	descending := true
 	if req.Search != nil && req.Search.Pagination != nil {
 		if req.Search.Pagination.Page > 0 {
 			page = req.Search.Pagination.Page
@@ -282,53 +334,40 @@ func (h *Handler) ListGradeReviews(ctx context.Context, req *pb.ListGradeReviews
 		if req.Search.Pagination.PageSize > 0 {
 			pageSize = req.Search.Pagination.PageSize
 		}
-		if req.Search.Pagination.SortBy != "" {
-			sortBy = req.Search.Pagination.SortBy
-		}
-		descending = req.Search.Pagination.Descending
 	}
 
 	// Calculate offset
 	offset := (page - 1) * pageSize
 
 	// Build WHERE clause from filters
-	whereClause := ""
 	args := []interface{}{}
-	whiteMap := map[string]bool{
-		"id": true,
-
-		"title":        true,
-		"review_grade": true,
-		"teacher_code": true,
-		"status":       true,
-		"notes":        true,
-	}
-	if req.Search != nil && len(req.Search.Filters) > 0 {
-		whereConditions := []string{}
-		for _, filter := range req.Search.Filters {
-			if filter.GetCondition() != nil {
-				condition := filter.GetCondition()
-				if _, ok := whiteMap[condition.Field]; !ok {
-					continue
-				}
-				whereConditions = append(whereConditions, helper.BuildFilterCondition(condition, &args))
-			}
-		}
-		if len(whereConditions) > 0 {
-			whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
-		}
+	filterFields := helper.FilterFields{
+		"id":              {},
+		"title":           {},
+		"review_grade":    {Type: helper.FieldInt},
+		"teacher_code":    {},
+		"status":          {Type: helper.FieldEnum, Values: []string{"pending", "passed", "failed", "completed"}},
+		"notes":           {},
+		"completion_date": {Type: helper.FieldTime},
+		"created_at":      {Type: helper.FieldTime},
+		"updated_at":      {Type: helper.FieldTime},
+	}
+	whereClause, err := helper.BuildWhere(req.Search.GetFilters(), filterFields, &args)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
+	whereClause = helper.NotDeleted(whereClause, req.IncludeDeleted)
 
 	// Build ORDER BY clause
-	sortDirection := "ASC"
-	if descending {
-		sortDirection = "DESC"
+	orderBy, err := helper.BuildOrderBy(req.Search.GetPagination(), filterFields)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %v", err)
 	}
 
 	// Get total count
-	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM GradeReview %s", whereClause)
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM Grade_review %s", whereClause)
 	var total int32
-	err := h.queryRow(ctx, countQuery, args...).Scan(&total)
+	err = h.queryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to count gradereviews: %v", err)
 	}
@@ -336,12 +375,12 @@ func (h *Handler) ListGradeReviews(ctx context.Context, req *pb.ListGradeReviews
 	// Get entities with pagination
 	args = append(args, pageSize, offset)
 	query := fmt.Sprintf(`
-		SELECT id, title, review_grade, teacher_code, status, notes, created_at, updated_at, created_by, updated_by
-		FROM GradeReview
+		SELECT id, title, review_grade, teacher_code, status, notes, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
+		FROM Grade_review
+		%s
 		%s
-		ORDER BY %s %s
 		LIMIT ? OFFSET ?
-	`, whereClause, sortBy, sortDirection)
+	`, whereClause, orderBy)
 
 	rows, err := h.query(ctx, query, args...)
 	if err != nil {
@@ -352,8 +391,8 @@ func (h *Handler) ListGradeReviews(ctx context.Context, req *pb.ListGradeReviews
 	entities := []*pb.GradeReview{}
 	for rows.Next() {
 		var entity pb.GradeReview
-		var createdAt, updatedAt sql.NullTime
-		var updatedBy sql.NullString
+		var createdAt, updatedAt, deletedAt sql.NullTime
+		var updatedBy, deletedBy sql.NullString
 		var StatusStr string
 
 		err := rows.Scan(
@@ -367,24 +406,16 @@ func (h *Handler) ListGradeReviews(ctx context.Context, req *pb.ListGradeReviews
 			&updatedAt,
 			&entity.CreatedBy,
 			&updatedBy,
+			&entity.Version,
+			&deletedAt,
+			&deletedBy,
 		)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan gradereview: %v", err)
 		}
 
 		// Convert Status string to enum
-		switch StatusStr {
-		case "pending":
-			entity.Status = pb.FinalStatus_PENDING
-		case "passed":
-			entity.Status = pb.FinalStatus_PASSED
-		case "failed":
-			entity.Status = pb.FinalStatus_FAILED
-		case "completed":
-			entity.Status = pb.FinalStatus_COMPLETED
-		default:
-			entity.Status = pb.FinalStatus_PENDING
-		}
+		entity.Status = finalStatusFromString(StatusStr)
 
 		if createdAt.Valid {
 			entity.CreatedAt = timestamppb.New(createdAt.Time)
@@ -395,6 +426,10 @@ func (h *Handler) ListGradeReviews(ctx context.Context, req *pb.ListGradeReviews
 		if updatedBy.Valid {
 			entity.UpdatedBy = updatedBy.String
 		}
+		if deletedAt.Valid {
+			entity.DeletedAt = timestamppb.New(deletedAt.Time)
+			entity.DeletedBy = deletedBy.String
+		}
 
 		entities = append(entities, &entity)
 	}
@@ -0,0 +1,430 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+
+	pb "thaily/proto/thesis"
+	"thaily/src/pkg/audit"
+	"thaily/src/pkg/grading"
+	"thaily/src/pkg/logger"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func roundingToString(r pb.GradeRounding) string {
+	switch r {
+	case pb.GradeRounding_ROUND_DOWN:
+		return "down"
+	case pb.GradeRounding_ROUND_UP:
+		return "up"
+	default:
+		return "half_up"
+	}
+}
+
+func roundingFromString(r string) pb.GradeRounding {
+	switch r {
+	case "down":
+		return pb.GradeRounding_ROUND_DOWN
+	case "up":
+		return pb.GradeRounding_ROUND_UP
+	default:
+		return pb.GradeRounding_ROUND_HALF_UP
+	}
+}
+
+func gradingPolicyOf(p *pb.GradingPolicy) grading.Policy {
+	rounding := grading.RoundHalfUp
+	switch p.Rounding {
+	case pb.GradeRounding_ROUND_DOWN:
+		rounding = grading.RoundDown
+	case pb.GradeRounding_ROUND_UP:
+		rounding = grading.RoundUp
+	}
+	return grading.Policy{
+		SupervisorWeight: p.SupervisorWeight,
+		ReviewerWeight:   p.ReviewerWeight,
+		CouncilWeight:    p.CouncilWeight,
+		Rounding:         rounding,
+		Step:             p.RoundingStep,
+		PassThreshold:    p.PassThreshold,
+		MaxCouncilSpread: p.MaxCouncilSpread,
+	}
+}
+
+const gradingPolicyColumns = `id, major_code, semester_code, stage, supervisor_weight, reviewer_weight, council_weight, rounding, rounding_step, pass_threshold, max_council_spread, created_at, updated_at, created_by, updated_by`
+
+func scanGradingPolicy(scan func(dest ...interface{}) error) (*pb.GradingPolicy, error) {
+	var entity pb.GradingPolicy
+	var createdAt, updatedAt sql.NullTime
+	var StageStr, RoundingStr string
+
+	err := scan(
+		&entity.Id,
+		&entity.MajorCode,
+		&entity.SemesterCode,
+		&StageStr,
+		&entity.SupervisorWeight,
+		&entity.ReviewerWeight,
+		&entity.CouncilWeight,
+		&RoundingStr,
+		&entity.RoundingStep,
+		&entity.PassThreshold,
+		&entity.MaxCouncilSpread,
+		&createdAt,
+		&updatedAt,
+		&entity.CreatedBy,
+		&entity.UpdatedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entity.Stage = stageFromString(StageStr)
+	entity.Rounding = roundingFromString(RoundingStr)
+	if createdAt.Valid {
+		entity.CreatedAt = timestamppb.New(createdAt.Time)
+	}
+	if updatedAt.Valid {
+		entity.UpdatedAt = timestamppb.New(updatedAt.Time)
+	}
+	return &entity, nil
+}
+
+// getGradingPolicy returns the policy of a major, semester and stage, or nil when none is set
+func getGradingPolicy(ctx context.Context, q dbtx, majorCode, semesterCode, stage string) (*pb.GradingPolicy, error) {
+	query := `SELECT ` + gradingPolicyColumns + ` FROM Grading_policy WHERE major_code = ? AND semester_code = ? AND stage = ?`
+
+	policy, err := scanGradingPolicy(q.QueryRowContext(ctx, query, majorCode, semesterCode, stage).Scan)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return policy, err
+}
+
+// SetGradingPolicy creates or replaces the grading policy of a major, semester and stage
+func (h *Handler) SetGradingPolicy(ctx context.Context, req *pb.SetGradingPolicyRequest) (*pb.SetGradingPolicyResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.MajorCode == "" || req.SemesterCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "major_code and semester_code are required")
+	}
+	policy := &pb.GradingPolicy{
+		SupervisorWeight: req.SupervisorWeight,
+		ReviewerWeight:   req.ReviewerWeight,
+		CouncilWeight:    req.CouncilWeight,
+		Rounding:         req.Rounding,
+		RoundingStep:     req.RoundingStep,
+		PassThreshold:    req.PassThreshold,
+		MaxCouncilSpread: req.MaxCouncilSpread,
+	}
+	if err := gradingPolicyOf(policy).Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "major_code", "Major", req.MajorCode); err != nil {
+		return nil, err
+	}
+	if err := h.refs.Require(ctx, "semester_code", "Semester", req.SemesterCode); err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO Grading_policy (id, major_code, semester_code, stage, supervisor_weight, reviewer_weight, council_weight, rounding, rounding_step, pass_threshold, max_council_spread, created_by, updated_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
+		ON DUPLICATE KEY UPDATE
+			supervisor_weight = VALUES(supervisor_weight),
+			reviewer_weight = VALUES(reviewer_weight),
+			council_weight = VALUES(council_weight),
+			rounding = VALUES(rounding),
+			rounding_step = VALUES(rounding_step),
+			pass_threshold = VALUES(pass_threshold),
+			max_council_spread = VALUES(max_council_spread),
+			updated_by = VALUES(updated_by),
+			updated_at = NOW()
+	`
+
+	_, err := h.execQuery(ctx, query,
+		uuid.New().String(),
+		req.MajorCode,
+		req.SemesterCode,
+		stageToString(req.Stage),
+		req.SupervisorWeight,
+		req.ReviewerWeight,
+		req.CouncilWeight,
+		roundingToString(req.Rounding),
+		req.RoundingStep,
+		req.PassThreshold,
+		req.MaxCouncilSpread,
+		req.CreatedBy,
+		req.CreatedBy,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set grading policy: %v", err)
+	}
+
+	policy, err = getGradingPolicy(ctx, h.db, req.MajorCode, req.SemesterCode, stageToString(req.Stage))
+	if err != nil || policy == nil {
+		return nil, status.Errorf(codes.Internal, "failed to get grading policy: %v", err)
+	}
+	return &pb.SetGradingPolicyResponse{
+		Policy: policy,
+	}, nil
+}
+
+// ListGradingPolicies lists the grading policies of a semester
+func (h *Handler) ListGradingPolicies(ctx context.Context, req *pb.ListGradingPoliciesRequest) (*pb.ListGradingPoliciesResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.SemesterCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester_code is required")
+	}
+
+	query := `SELECT ` + gradingPolicyColumns + ` FROM Grading_policy WHERE semester_code = ? ORDER BY major_code, stage`
+
+	rows, err := h.query(ctx, query, req.SemesterCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list grading policies: %v", err)
+	}
+	defer rows.Close()
+
+	policies := []*pb.GradingPolicy{}
+	for rows.Next() {
+		policy, err := scanGradingPolicy(rows.Scan)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan grading policy: %v", err)
+		}
+		policies = append(policies, policy)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating grading policies: %v", err)
+	}
+
+	return &pb.ListGradingPoliciesResponse{
+		Policies: policies,
+	}, nil
+}
+
+func (h *Handler) DeleteGradingPolicy(ctx context.Context, req *pb.DeleteGradingPolicyRequest) (*pb.DeleteGradingPolicyResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	result, err := h.execQuery(ctx, `DELETE FROM Grading_policy WHERE id = ?`, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete grading policy: %v", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "grading policy not found")
+	}
+
+	return &pb.DeleteGradingPolicyResponse{
+		Success: true,
+	}, nil
+}
+
+// gradeInputs are the thesis-side inputs of an enrollment's final grade
+type gradeInputs struct {
+	finalCode  sql.NullString
+	supervisor sql.NullInt32
+	reviewer   sql.NullInt32
+	policy     *pb.GradingPolicy
+}
+
+// loadGradeInputs reads the enrollment's policy, supervisor grade and reviewer
+// grade; with lock, the Final row is locked until the transaction ends
+func loadGradeInputs(ctx context.Context, q dbtx, enrollmentCode string, lock bool) (*gradeInputs, error) {
+	var in gradeInputs
+	var reviewCode sql.NullString
+	var stage, majorCode, semesterCode string
+
+	err := q.QueryRowContext(ctx, `
+		SELECT e.final_code, e.grade_review_code, tc.stage, t.major_code, t.semester_code
+		FROM Enrollment e
+		JOIN Topic_council tc ON tc.id = e.topic_council_code AND tc.deleted_at IS NULL
+		JOIN Topic t ON t.id = tc.topic_code AND t.deleted_at IS NULL
+		WHERE e.id = ? AND e.deleted_at IS NULL
+	`, enrollmentCode).Scan(&in.finalCode, &reviewCode, &stage, &majorCode, &semesterCode)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "enrollment not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get enrollment: %v", err)
+	}
+
+	in.policy, err = getGradingPolicy(ctx, q, majorCode, semesterCode, stage)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get grading policy: %v", err)
+	}
+	if in.policy == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "no grading policy for major %s, semester %s, %s", majorCode, semesterCode, stage)
+	}
+
+	if in.finalCode.Valid {
+		query := `SELECT supervisor_grade FROM Final WHERE id = ? AND deleted_at IS NULL`
+		if lock {
+			query += ` FOR UPDATE`
+		}
+		err := q.QueryRowContext(ctx, query, in.finalCode.String).Scan(&in.supervisor)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, status.Errorf(codes.Internal, "failed to get final: %v", err)
+		}
+	}
+	if reviewCode.Valid {
+		err := q.QueryRowContext(ctx, `SELECT review_grade FROM Grade_review WHERE id = ? AND deleted_at IS NULL`, reviewCode.String).Scan(&in.reviewer)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, status.Errorf(codes.Internal, "failed to get grade review: %v", err)
+		}
+	}
+	return &in, nil
+}
+
+// gradingInputOf combines the thesis-side grades with the council's scores
+func gradingInputOf(in *gradeInputs, councilScores []*pb.CouncilMemberScore) grading.Input {
+	input := grading.Input{}
+	if in.supervisor.Valid {
+		v := float64(in.supervisor.Int32)
+		input.Supervisor = &v
+	}
+	if in.reviewer.Valid {
+		v := float64(in.reviewer.Int32)
+		input.Reviewer = &v
+	}
+	for _, s := range councilScores {
+		input.Council = append(input.Council, grading.CouncilScore{Member: s.TeacherCode, Score: s.TotalScore})
+	}
+	return input
+}
+
+func gradeBreakdown(policy *pb.GradingPolicy, r *grading.Result) *pb.FinalGradeBreakdown {
+	breakdown := &pb.FinalGradeBreakdown{
+		PolicyCode:          policy.Id,
+		CouncilSpread:       r.CouncilSpread,
+		NeedsReconciliation: r.NeedsReconciliation,
+		Missing:             r.Missing,
+		RawGrade:            r.Raw,
+		FinalGrade:          r.Grade,
+		Status:              pb.FinalStatus_PENDING,
+		Steps:               r.Steps,
+	}
+	for _, c := range r.Components {
+		breakdown.Components = append(breakdown.Components, &pb.GradeComponent{
+			Name:   c.Name,
+			Score:  c.Score,
+			Weight: c.Weight,
+		})
+	}
+	if r.Ready() {
+		breakdown.Status = pb.FinalStatus_FAILED
+		if r.Passed {
+			breakdown.Status = pb.FinalStatus_PASSED
+		}
+	}
+	return breakdown
+}
+
+// ComputeFinalGrade derives an enrollment's final grade and status under its
+// grading policy; with finalize, they are written to the enrollment's Final
+func (h *Handler) ComputeFinalGrade(ctx context.Context, req *pb.ComputeFinalGradeRequest) (*pb.ComputeFinalGradeResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.EnrollmentCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "enrollment_code is required")
+	}
+	if req.Finalize && req.Actor == "" {
+		return nil, status.Error(codes.InvalidArgument, "actor is required to finalize")
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	in, err := loadGradeInputs(ctx, tx, req.EnrollmentCode, req.Finalize)
+	if err != nil {
+		return nil, err
+	}
+
+	result := grading.Compute(gradingPolicyOf(in.policy), gradingInputOf(in, req.CouncilScores))
+	breakdown := gradeBreakdown(in.policy, result)
+	if !req.Finalize {
+		return &pb.ComputeFinalGradeResponse{
+			Breakdown: breakdown,
+		}, nil
+	}
+
+	if !in.finalCode.Valid {
+		return nil, status.Error(codes.FailedPrecondition, "enrollment has no final to grade")
+	}
+	if err := checkGradesUnlocked(ctx, tx, enrollmentById, req.EnrollmentCode); err != nil {
+		return nil, err
+	}
+	if result.NeedsReconciliation {
+		return nil, status.Errorf(codes.FailedPrecondition, "council scores spread %.2f exceeds %.2f: the council must reconcile first",
+			*result.CouncilSpread, in.policy.MaxCouncilSpread)
+	}
+	if !result.Ready() {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot finalize, missing: %v", result.Missing)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE Final
+		SET final_grade = ?, status = ?, updated_by = ?, updated_at = NOW(), version = version + 1
+		WHERE id = ?
+	`, *result.Grade, finalStatusToString(breakdown.Status), req.Actor, in.finalCode.String)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update final: %v", err)
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	final, err := h.GetFinal(ctx, &pb.GetFinalRequest{Id: in.finalCode.String})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ComputeFinalGradeResponse{
+		Breakdown: breakdown,
+		Finalized: true,
+		Final:     final.GetFinal(),
+	}, nil
+}
+
+func finalStatusToString(s pb.FinalStatus) string {
+	switch s {
+	case pb.FinalStatus_PASSED:
+		return "passed"
+	case pb.FinalStatus_FAILED:
+		return "failed"
+	case pb.FinalStatus_COMPLETED:
+		return "completed"
+	default:
+		return "pending"
+	}
+}
+
+func finalStatusFromString(s string) pb.FinalStatus {
+	switch s {
+	case "passed":
+		return pb.FinalStatus_PASSED
+	case "failed":
+		return pb.FinalStatus_FAILED
+	case "completed":
+		return pb.FinalStatus_COMPLETED
+	default:
+		return pb.FinalStatus_PENDING
+	}
+}
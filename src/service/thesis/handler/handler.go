@@ -5,25 +5,82 @@ import (
 	"database/sql"
 
 	pb "thaily/proto/thesis"
+	"thaily/src/pkg/audit"
+	"thaily/src/pkg/reference"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Handler struct {
 	pb.UnimplementedThesisServiceServer
 	db *sql.DB
+
+	// refs checks the columns pointing at other services' tables
+	refs *reference.Resolver
+}
+
+func NewHandler(db *sql.DB, refs *reference.Resolver) *Handler {
+	return &Handler{db: db, refs: refs}
+}
+
+// txKey carries the transaction of withTx in the context
+type txKey struct{}
+
+// dbtx is satisfied by both *sql.DB and *sql.Tx
+type dbtx interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// conn returns the transaction of ctx, or the database outside withTx
+func (h *Handler) conn(ctx context.Context) dbtx {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return h.db
+}
+
+// withTx runs fn in a transaction, committed when fn returns nil and rolled
+// back otherwise. The context passed to fn carries the transaction, so
+// queryRow, query and execQuery (and the handlers built on them) join it.
+// Nested calls run in the outer transaction. The audit log snapshots the
+// rows of the call in it, through audit.BeginTx and audit.Commit.
+func (h *Handler) withTx(ctx context.Context, fn func(ctx context.Context, tx *sql.Tx) error) error {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx, tx)
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(inTx(ctx, tx), tx); err != nil {
+		return err
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+	return nil
 }
 
-func NewHandler(db *sql.DB) *Handler {
-	return &Handler{db: db}
+// inTx returns ctx carrying tx, so handlers called with it from a
+// transaction begun outside withTx join that transaction
+func inTx(ctx context.Context, tx *sql.Tx) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
 }
 
 func (h *Handler) queryRow(ctx context.Context, query string, args ...interface{}) *sql.Row {
-	return h.db.QueryRowContext(ctx, query, args...)
+	return h.conn(ctx).QueryRowContext(ctx, query, args...)
 }
 
 func (h *Handler) query(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
-	return h.db.QueryContext(ctx, query, args...)
+	return h.conn(ctx).QueryContext(ctx, query, args...)
 }
 
 func (h *Handler) execQuery(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
-	return h.db.ExecContext(ctx, query, args...)
+	return h.conn(ctx).ExecContext(ctx, query, args...)
 }
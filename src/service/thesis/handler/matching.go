@@ -0,0 +1,395 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	pb "thaily/proto/thesis"
+	"thaily/src/pkg/audit"
+	"thaily/src/pkg/logger"
+	"thaily/src/pkg/matching"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// matchingTopic is a topic open in the matching round
+type matchingTopic struct {
+	title            string
+	topicCouncilCode string
+}
+
+// matchingRound is everything one matching run reads from the database
+type matchingRound struct {
+	students      []matching.Student
+	topics        []matching.Topic
+	topicInfo     map[string]matchingTopic
+	registrations map[string]string // student_code + "/" + topic_code -> registration id
+}
+
+func registrationKey(studentCode, topicCode string) string {
+	return studentCode + "/" + topicCode
+}
+
+func matchReasonToPb(r matching.Reason) pb.MatchRejectionReason {
+	switch r {
+	case matching.ReasonNotAcceptable:
+		return pb.MatchRejectionReason_MATCH_NOT_ACCEPTABLE
+	case matching.ReasonNoCapacity:
+		return pb.MatchRejectionReason_MATCH_NO_CAPACITY
+	case matching.ReasonUnknownTopic:
+		return pb.MatchRejectionReason_MATCH_TOPIC_CLOSED
+	default:
+		return pb.MatchRejectionReason_MATCH_OUTRANKED
+	}
+}
+
+// loadMatchingRound reads the open topics of a semester with their remaining
+// seats and supervisor rankings, and the pending choices of every student who
+// has not been accepted anywhere yet. Students are ordered by when they first
+// registered, which is the tie-break for applicants a topic does not rank.
+func loadMatchingRound(ctx context.Context, q dbtx, semesterCode string) (*matchingRound, error) {
+	round := &matchingRound{
+		topicInfo:     map[string]matchingTopic{},
+		registrations: map[string]string{},
+	}
+
+	// Each topic takes the seats of its first topic council
+	rows, err := q.QueryContext(ctx, `
+		SELECT t.id, t.title, t.max_students, t.ranking_restricted, tc.id, COUNT(e.id)
+		FROM Topic t
+		JOIN Topic_council tc ON tc.topic_code = t.id AND tc.deleted_at IS NULL
+		LEFT JOIN Enrollment e ON e.topic_council_code = tc.id AND e.deleted_at IS NULL
+		WHERE t.semester_code = ? AND t.status IN (?, ?) AND t.deleted_at IS NULL
+		GROUP BY t.id, t.title, t.max_students, t.ranking_restricted, tc.id, tc.created_at
+		ORDER BY t.id, tc.created_at
+	`, semesterCode, topicStatusToString(pb.TopicStatus_APPROVED_2), topicStatusToString(pb.TopicStatus_IN_PROGRESS))
+	if err != nil {
+		return nil, err
+	}
+	topicIndex := map[string]int{}
+	for rows.Next() {
+		var topicCode, title, topicCouncilCode string
+		var maxStudents, enrolled int
+		var restricted bool
+		if err := rows.Scan(&topicCode, &title, &maxStudents, &restricted, &topicCouncilCode, &enrolled); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		if _, seen := topicIndex[topicCode]; seen {
+			continue
+		}
+		topicIndex[topicCode] = len(round.topics)
+		round.topics = append(round.topics, matching.Topic{
+			Code:       topicCode,
+			Capacity:   maxStudents - enrolled,
+			Restricted: restricted,
+		})
+		round.topicInfo[topicCode] = matchingTopic{title: title, topicCouncilCode: topicCouncilCode}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = q.QueryContext(ctx, `
+		SELECT r.topic_code, r.student_code
+		FROM Topic_applicant_rank r
+		JOIN Topic t ON t.id = r.topic_code AND t.deleted_at IS NULL
+		WHERE t.semester_code = ?
+		ORDER BY r.topic_code, r.preference_rank
+	`, semesterCode)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var topicCode, studentCode string
+		if err := rows.Scan(&topicCode, &studentCode); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		if i, ok := topicIndex[topicCode]; ok {
+			round.topics[i].Ranking = append(round.topics[i].Ranking, studentCode)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = q.QueryContext(ctx, `
+		SELECT r.id, r.student_code, r.topic_code
+		FROM Topic_registration r
+		JOIN (
+			SELECT student_code, MIN(created_at) AS first_at
+			FROM Topic_registration
+			WHERE semester_code = ? AND status = ?
+			GROUP BY student_code
+		) f ON f.student_code = r.student_code
+		WHERE r.semester_code = ? AND r.status = ?
+			AND r.student_code NOT IN (
+				SELECT student_code FROM Topic_registration WHERE semester_code = ? AND status = ?
+			)
+		ORDER BY f.first_at, r.student_code, r.preference_rank
+	`, semesterCode, registrationStatusToString(pb.RegistrationStatus_REGISTRATION_PENDING),
+		semesterCode, registrationStatusToString(pb.RegistrationStatus_REGISTRATION_PENDING),
+		semesterCode, registrationStatusToString(pb.RegistrationStatus_REGISTRATION_ACCEPTED))
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var id, studentCode, topicCode string
+		if err := rows.Scan(&id, &studentCode, &topicCode); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		last := len(round.students) - 1
+		if last < 0 || round.students[last].Code != studentCode {
+			round.students = append(round.students, matching.Student{Code: studentCode})
+			last++
+		}
+		round.students[last].Choices = append(round.students[last].Choices, topicCode)
+		round.registrations[registrationKey(studentCode, topicCode)] = id
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return round, nil
+}
+
+// run matches the round and describes the outcome
+func (round *matchingRound) run(semesterCode string) *pb.TopicMatchingResult {
+	outcome := matching.Match(round.students, round.topics)
+
+	result := &pb.TopicMatchingResult{
+		SemesterCode:  semesterCode,
+		StudentsTotal: int32(len(round.students)),
+		MatchedTotal:  int32(len(outcome.Assignments)),
+	}
+	for _, a := range outcome.Assignments {
+		result.Assignments = append(result.Assignments, &pb.MatchAssignment{
+			StudentCode:      a.StudentCode,
+			TopicCode:        a.TopicCode,
+			TopicCouncilCode: round.topicInfo[a.TopicCode].topicCouncilCode,
+			Choice:           int32(a.Choice),
+			RegistrationId:   round.registrations[registrationKey(a.StudentCode, a.TopicCode)],
+		})
+	}
+	for _, u := range outcome.Unmatched {
+		unmatched := &pb.UnmatchedStudent{StudentCode: u.StudentCode}
+		for _, r := range u.Rejections {
+			unmatched.Rejections = append(unmatched.Rejections, &pb.MatchRejection{
+				TopicCode: r.TopicCode,
+				Choice:    int32(r.Choice),
+				Reason:    matchReasonToPb(r.Reason),
+			})
+		}
+		result.Unmatched = append(result.Unmatched, unmatched)
+	}
+	return result
+}
+
+// SetApplicantRanking stores a supervisor's ranking of the applicants of a
+// topic. With restricted set, only ranked students can be matched to it.
+func (h *Handler) SetApplicantRanking(ctx context.Context, req *pb.SetApplicantRankingRequest) (*pb.SetApplicantRankingResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.TopicCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "topic_code is required")
+	}
+	if req.SupervisorCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "supervisor_code is required")
+	}
+	seen := map[string]bool{}
+	for _, studentCode := range req.StudentCodes {
+		if studentCode == "" {
+			return nil, status.Error(codes.InvalidArgument, "student_codes must not contain empty values")
+		}
+		if seen[studentCode] {
+			return nil, status.Errorf(codes.InvalidArgument, "student %s is ranked more than once", studentCode)
+		}
+		seen[studentCode] = true
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var exists int
+	err = tx.QueryRowContext(ctx, "SELECT 1 FROM Topic WHERE id = ? AND deleted_at IS NULL FOR UPDATE", req.TopicCode).Scan(&exists)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "topic not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get topic: %v", err)
+	}
+
+	var supervisors int
+	err = tx.QueryRowContext(ctx, `
+		SELECT COUNT(*)
+		FROM Topic_council_supervisor s
+		JOIN Topic_council tc ON tc.id = s.topic_council_code AND tc.deleted_at IS NULL
+		WHERE tc.topic_code = ? AND s.teacher_supervisor_code = ? AND s.deleted_at IS NULL
+	`, req.TopicCode, req.SupervisorCode).Scan(&supervisors)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check supervisor: %v", err)
+	}
+	if supervisors == 0 {
+		return nil, status.Error(codes.PermissionDenied, "only a supervisor of the topic can rank its applicants")
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM Topic_applicant_rank WHERE topic_code = ?", req.TopicCode); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to clear ranking: %v", err)
+	}
+	for i, studentCode := range req.StudentCodes {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO Topic_applicant_rank (id, topic_code, student_code, preference_rank, created_by, created_at)
+			VALUES (?, ?, ?, ?, ?, NOW())
+		`, uuid.New().String(), req.TopicCode, studentCode, i+1, req.SupervisorCode)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to rank applicant: %v", err)
+		}
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE Topic SET ranking_restricted = ?, updated_by = ?, updated_at = ?, version = version + 1 WHERE id = ?",
+		req.Restricted, req.SupervisorCode, time.Now(), req.TopicCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update topic: %v", err)
+	}
+
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	return &pb.SetApplicantRankingResponse{
+		TopicCode:    req.TopicCode,
+		StudentCodes: req.StudentCodes,
+		Restricted:   req.Restricted,
+	}, nil
+}
+
+// PreviewTopicMatching computes the assignment without changing anything
+func (h *Handler) PreviewTopicMatching(ctx context.Context, req *pb.PreviewTopicMatchingRequest) (*pb.PreviewTopicMatchingResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.SemesterCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester_code is required")
+	}
+
+	round, err := loadMatchingRound(ctx, h.db, req.SemesterCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load matching round: %v", err)
+	}
+	return &pb.PreviewTopicMatchingResponse{Result: round.run(req.SemesterCode)}, nil
+}
+
+// trackPendingRegistrations has the audit log record the pending
+// registrations of a student that a commit decides
+func trackPendingRegistrations(ctx context.Context, tx *sql.Tx, studentCode, semesterCode string) error {
+	rows, err := tx.QueryContext(ctx, "SELECT id FROM Topic_registration WHERE student_code = ? AND semester_code = ? AND status = ?",
+		studentCode, semesterCode, registrationStatusToString(pb.RegistrationStatus_REGISTRATION_PENDING))
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list registrations: %v", err)
+	}
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return status.Errorf(codes.Internal, "failed to scan registration: %v", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return status.Errorf(codes.Internal, "failed to list registrations: %v", err)
+	}
+	for _, id := range ids {
+		audit.Track(ctx, tx, "Topic_registration", id)
+	}
+	return nil
+}
+
+// CommitTopicMatching recomputes the assignment under lock and applies it in
+// one transaction: an Enrollment per matched student, their registration
+// accepted and their other pending choices withdrawn. Unmatched students keep
+// their pending choices for a later round or a manual decision.
+func (h *Handler) CommitTopicMatching(ctx context.Context, req *pb.CommitTopicMatchingRequest) (*pb.CommitTopicMatchingResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.SemesterCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester_code is required")
+	}
+	if req.CommittedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "committed_by is required")
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the semester's topics and registrations so manual decisions and
+	// re-registrations cannot interleave with the commit
+	for _, table := range []string{"Topic", "Topic_registration"} {
+		rows, err := tx.QueryContext(ctx, "SELECT id FROM "+table+" WHERE semester_code = ? FOR UPDATE", req.SemesterCode)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to lock %s: %v", table, err)
+		}
+		rows.Close()
+	}
+
+	round, err := loadMatchingRound(ctx, tx, req.SemesterCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load matching round: %v", err)
+	}
+	result := round.run(req.SemesterCode)
+
+	now := time.Now()
+	for _, a := range result.Assignments {
+		// Each enrollment comes with its Midterm and Final, as in
+		// CreateEnrollmentBundle; its Grade_review waits for a reviewer
+		bundle, err := h.CreateEnrollmentBundle(inTx(ctx, tx), &pb.CreateEnrollmentBundleRequest{
+			Title:            round.topicInfo[a.TopicCode].title,
+			StudentCode:      a.StudentCode,
+			TopicCouncilCode: a.TopicCouncilCode,
+			CreatedBy:        req.CommittedBy,
+		})
+		if err != nil {
+			return nil, err
+		}
+		enrollmentID := bundle.GetEnrollment().Id
+
+		// The accepted registration is among the student's pending ones
+		if err := trackPendingRegistrations(ctx, tx, a.StudentCode, req.SemesterCode); err != nil {
+			return nil, err
+		}
+
+		_, err = tx.ExecContext(ctx, "UPDATE Topic_registration SET status = ?, decided_by = ?, decided_at = ?, enrollment_code = ?, updated_at = ? WHERE id = ?",
+			registrationStatusToString(pb.RegistrationStatus_REGISTRATION_ACCEPTED), req.CommittedBy, now, enrollmentID, now, a.RegistrationId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to accept registration: %v", err)
+		}
+
+		_, err = tx.ExecContext(ctx, "UPDATE Topic_registration SET status = ?, updated_at = ? WHERE student_code = ? AND semester_code = ? AND status = ?",
+			registrationStatusToString(pb.RegistrationStatus_REGISTRATION_WITHDRAWN), now,
+			a.StudentCode, req.SemesterCode, registrationStatusToString(pb.RegistrationStatus_REGISTRATION_PENDING))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to withdraw other registrations: %v", err)
+		}
+
+		a.EnrollmentCode = enrollmentID
+	}
+
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	return &pb.CommitTopicMatchingResponse{Result: result}, nil
+}
@@ -15,6 +15,32 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+func midtermStatusToString(s pb.MidtermStatus) string {
+	switch s {
+	case pb.MidtermStatus_SUBMITTED:
+		return "submitted"
+	case pb.MidtermStatus_PASS:
+		return "pass"
+	case pb.MidtermStatus_FAIL:
+		return "fail"
+	default:
+		return "not_submitted"
+	}
+}
+
+func midtermStatusFromString(s string) pb.MidtermStatus {
+	switch s {
+	case "submitted":
+		return pb.MidtermStatus_SUBMITTED
+	case "pass":
+		return pb.MidtermStatus_PASS
+	case "fail":
+		return pb.MidtermStatus_FAIL
+	default:
+		return pb.MidtermStatus_NOT_SUBMITTED
+	}
+}
+
 // CreateMidterm creates a new Midterm record
 func (h *Handler) CreateMidterm(ctx context.Context, req *pb.CreateMidtermRequest) (*pb.CreateMidtermResponse, error) {
 	defer logger.TraceFunction(ctx)()
@@ -38,20 +64,7 @@ func (h *Handler) CreateMidterm(ctx context.Context, req *pb.CreateMidtermReques
 	}
 
 	// Convert Status enum to string
-	StatusValue := pb.MidtermStatus_NOT_SUBMITTED
-
-	StatusValue = req.Status
-	StatusStr := "not_submitted"
-	switch StatusValue {
-	case pb.MidtermStatus_NOT_SUBMITTED:
-		StatusStr = "not_submitted"
-	case pb.MidtermStatus_SUBMITTED:
-		StatusStr = "submitted"
-	case pb.MidtermStatus_PASS:
-		StatusStr = "pass"
-	case pb.MidtermStatus_FAIL:
-		StatusStr = "fail"
-	}
+	StatusStr := midtermStatusToString(req.Status)
 
 	// Insert into database
 	query := `
@@ -93,14 +106,17 @@ func (h *Handler) GetMidterm(ctx context.Context, req *pb.GetMidtermRequest) (*p
 	}
 
 	query := `
-		SELECT id, title, grade, status, feedback, created_at, updated_at, created_by, updated_by
+		SELECT id, title, grade, status, feedback, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM Midterm
 		WHERE id = ?
 	`
+	if !req.IncludeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
 
 	var entity pb.Midterm
-	var createdAt, updatedAt sql.NullTime
-	var updatedBy sql.NullString
+	var createdAt, updatedAt, deletedAt sql.NullTime
+	var updatedBy, deletedBy sql.NullString
 	var StatusStr string
 
 	err := h.queryRow(ctx, query, req.Id).Scan(
@@ -113,6 +129,9 @@ func (h *Handler) GetMidterm(ctx context.Context, req *pb.GetMidtermRequest) (*p
 		&updatedAt,
 		&entity.CreatedBy,
 		&updatedBy,
+		&entity.Version,
+		&deletedAt,
+		&deletedBy,
 	)
 
 	if err != nil {
@@ -123,18 +142,7 @@ func (h *Handler) GetMidterm(ctx context.Context, req *pb.GetMidtermRequest) (*p
 	}
 
 	// Convert Status string to enum
-	switch StatusStr {
-	case "not_submitted":
-		entity.Status = pb.MidtermStatus_NOT_SUBMITTED
-	case "submitted":
-		entity.Status = pb.MidtermStatus_SUBMITTED
-	case "pass":
-		entity.Status = pb.MidtermStatus_PASS
-	case "fail":
-		entity.Status = pb.MidtermStatus_FAIL
-	default:
-		entity.Status = pb.MidtermStatus_NOT_SUBMITTED
-	}
+	entity.Status = midtermStatusFromString(StatusStr)
 
 	if createdAt.Valid {
 		entity.CreatedAt = timestamppb.New(createdAt.Time)
@@ -145,6 +153,10 @@ func (h *Handler) GetMidterm(ctx context.Context, req *pb.GetMidtermRequest) (*p
 	if updatedBy.Valid {
 		entity.UpdatedBy = updatedBy.String
 	}
+	if deletedAt.Valid {
+		entity.DeletedAt = timestamppb.New(deletedAt.Time)
+		entity.DeletedBy = deletedBy.String
+	}
 
 	return &pb.GetMidtermResponse{
 		Midterm: &entity,
@@ -159,6 +171,11 @@ func (h *Handler) UpdateMidterm(ctx context.Context, req *pb.UpdateMidtermReques
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
+	// The status follows the milestone reviews
+	if req.Status != nil {
+		return nil, status.Error(codes.InvalidArgument, "midterm status is derived from milestone reviews")
+	}
+
 	// Build dynamic update query
 	updateFields := []string{}
 	args := []interface{}{}
@@ -172,22 +189,6 @@ func (h *Handler) UpdateMidterm(ctx context.Context, req *pb.UpdateMidtermReques
 		updateFields = append(updateFields, "grade = ?")
 		args = append(args, *req.Grade)
 
-	}
-	if req.Status != nil {
-		updateFields = append(updateFields, "status = ?")
-		StatusStr := "not_submitted"
-		switch *req.Status {
-		case pb.MidtermStatus_NOT_SUBMITTED:
-			StatusStr = "not_submitted"
-		case pb.MidtermStatus_SUBMITTED:
-			StatusStr = "submitted"
-		case pb.MidtermStatus_PASS:
-			StatusStr = "pass"
-		case pb.MidtermStatus_FAIL:
-			StatusStr = "fail"
-		}
-		args = append(args, StatusStr)
-
 	}
 	if req.Feedback != nil {
 		updateFields = append(updateFields, "feedback = ?")
@@ -204,18 +205,41 @@ func (h *Handler) UpdateMidterm(ctx context.Context, req *pb.UpdateMidtermReques
 	args = append(args, req.UpdatedBy)
 	updateFields = append(updateFields, "updated_at = NOW()")
 
-	// Add id as last parameter
-	args = append(args, req.Id)
+	// Bump the version; an expected version only matches an unchanged record
+	updateFields = append(updateFields, "version = version + 1")
+	where := helper.WhereVersion(req.Id, req.Version, &args)
 
 	query := fmt.Sprintf(`
 		UPDATE Midterm
 		SET %s
-		WHERE id = ?
-	`, strings.Join(updateFields, ", "))
+		WHERE %s AND deleted_at IS NULL
+	`, strings.Join(updateFields, ", "), where)
+
+	// The lock is checked in the transaction of the write, so a semester
+	// locked in between cannot let it through
+	err := h.withTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		// Grades of a locked semester only change through an approved amendment
+		if req.Grade != nil {
+			if err := checkGradesUnlocked(ctx, tx, enrollmentByMidterm, req.Id); err != nil {
+				return err
+			}
+		}
 
-	_, err := h.execQuery(ctx, query, args...)
+		updated, err := h.execQuery(ctx, query, args...)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to update midterm: %v", err)
+		}
+		if n, _ := updated.RowsAffected(); n == 0 {
+			current, err := h.GetMidterm(ctx, &pb.GetMidtermRequest{Id: req.Id})
+			if err != nil {
+				return err
+			}
+			return helper.VersionConflict("midterm", current.GetMidterm(), current.GetMidterm().Version)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update midterm: %v", err)
+		return nil, err
 	}
 
 	result, err := h.GetMidterm(ctx, &pb.GetMidtermRequest{Id: req.Id})
@@ -227,7 +251,7 @@ func (h *Handler) UpdateMidterm(ctx context.Context, req *pb.UpdateMidtermReques
 	}, nil
 }
 
-// DeleteMidterm deletes a Midterm by ID
+// DeleteMidterm soft-deletes a Midterm by ID
 func (h *Handler) DeleteMidterm(ctx context.Context, req *pb.DeleteMidtermRequest) (*pb.DeleteMidtermResponse, error) {
 	defer logger.TraceFunction(ctx)()
 
@@ -235,11 +259,52 @@ func (h *Handler) DeleteMidterm(ctx context.Context, req *pb.DeleteMidtermReques
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	query := `DELETE FROM Midterm WHERE id = ?`
+	// Soft delete; the retention job removes the row later
+	query := `UPDATE Midterm SET deleted_at = NOW(), deleted_by = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL`
 
-	result, err := h.execQuery(ctx, query, req.Id)
+	var rowsAffected int64
+	err := h.withTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		// Grades of a locked semester only change through an approved amendment
+		if err := checkGradesUnlocked(ctx, tx, enrollmentByMidterm, req.Id); err != nil {
+			return err
+		}
+
+		result, err := h.execQuery(ctx, query, req.DeletedBy, req.Id)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to delete midterm: %v", err)
+		}
+		rowsAffected, err = result.RowsAffected()
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get rows affected: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "midterm not found")
+	}
+
+	return &pb.DeleteMidtermResponse{
+		Success: true,
+	}, nil
+}
+
+// RestoreMidterm brings back a soft-deleted Midterm
+func (h *Handler) RestoreMidterm(ctx context.Context, req *pb.RestoreMidtermRequest) (*pb.RestoreMidtermResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	query := `UPDATE Midterm SET deleted_at = NULL, deleted_by = NULL, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ? AND deleted_at IS NOT NULL`
+
+	result, err := h.execQuery(ctx, query, req.RestoredBy, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete midterm: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to restore midterm: %v", err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
@@ -248,11 +313,19 @@ func (h *Handler) DeleteMidterm(ctx context.Context, req *pb.DeleteMidtermReques
 	}
 
 	if rowsAffected == 0 {
-		return nil, status.Error(codes.NotFound, "midterm not found")
+		// Unknown ids are NotFound; a live record has nothing to restore
+		if _, err := h.GetMidterm(ctx, &pb.GetMidtermRequest{Id: req.Id}); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, "midterm is not deleted")
 	}
 
-	return &pb.DeleteMidtermResponse{
-		Success: true,
+	restored, err := h.GetMidterm(ctx, &pb.GetMidtermRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreMidtermResponse{
+		Midterm: restored.GetMidterm(),
 	}, nil
 }
 
@@ -263,8 +336,6 @@ func (h *Handler) ListMidterms(ctx context.Context, req *pb.ListMidtermsRequest)
 	// Default pagination
 	page := int32(1)
 	pageSize := int32(10)
-	sortBy := "created_at"
-	descending := true
 	if req.Search != nil && req.Search.Pagination != nil {
 		if req.Search.Pagination.Page > 0 {
 			page = req.Search.Pagination.Page
@@ -272,46 +343,32 @@ func (h *Handler) ListMidterms(ctx context.Context, req *pb.ListMidtermsRequest)
 		if req.Search.Pagination.PageSize > 0 {
 			pageSize = req.Search.Pagination.PageSize
 		}
-		if req.Search.Pagination.SortBy != "" {
-			sortBy = req.Search.Pagination.SortBy
-		}
-		descending = req.Search.Pagination.Descending
 	}
 
 	// Calculate offset
 	offset := (page - 1) * pageSize
 
 	// Build WHERE clause from filters
-	whereClause := ""
 	args := []interface{}{}
-	whiteMap := map[string]bool{
-
-		"id":       true, // Required for DataLoader batch fetching
-		"title":    true,
-		"grade":    true,
-		"status":   true,
-		"feedback": true,
-	}
-	if req.Search != nil && len(req.Search.Filters) > 0 {
-		whereConditions := []string{}
-		for _, filter := range req.Search.Filters {
-			if filter.GetCondition() != nil {
-				condition := filter.GetCondition()
-				if _, ok := whiteMap[condition.Field]; !ok {
-					continue
-				}
-				whereConditions = append(whereConditions, helper.BuildFilterCondition(condition, &args))
-			}
-		}
-		if len(whereConditions) > 0 {
-			whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
-		}
+	filterFields := helper.FilterFields{
+		"id":         {},
+		"title":      {},
+		"grade":      {Type: helper.FieldInt},
+		"status":     {Type: helper.FieldEnum, Values: []string{"not_submitted", "submitted", "pass", "fail"}},
+		"feedback":   {},
+		"created_at": {Type: helper.FieldTime},
+		"updated_at": {Type: helper.FieldTime},
+	}
+	whereClause, err := helper.BuildWhere(req.Search.GetFilters(), filterFields, &args)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
+	whereClause = helper.NotDeleted(whereClause, req.IncludeDeleted)
 
 	// Build ORDER BY clause
-	sortDirection := "ASC"
-	if descending {
-		sortDirection = "DESC"
+	orderBy, err := helper.BuildOrderBy(req.Search.GetPagination(), filterFields)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %v", err)
 	}
 
 	// Get total count
@@ -320,7 +377,7 @@ func (h *Handler) ListMidterms(ctx context.Context, req *pb.ListMidtermsRequest)
 	fmt.Printf("[COUNT Args] Count: %d, Values: %v\n", len(args), args)
 
 	var total int32
-	err := h.queryRow(ctx, countQuery, args...).Scan(&total)
+	err = h.queryRow(ctx, countQuery, args...).Scan(&total)
 	fmt.Printf("[COUNT Result] Total: %d\n\n", total)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to count midterms: %v", err)
@@ -331,12 +388,12 @@ func (h *Handler) ListMidterms(ctx context.Context, req *pb.ListMidtermsRequest)
 	fmt.Print("cccccccccccccccccc", whereClause)
 
 	query := fmt.Sprintf(`
-		SELECT id, title, grade, status, feedback, created_at, updated_at, created_by, updated_by
+		SELECT id, title, grade, status, feedback, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM Midterm
 		%s
-		ORDER BY %s %s
+		%s
 		LIMIT ? OFFSET ?
-	`, whereClause, sortBy, sortDirection)
+	`, whereClause, orderBy)
 
 	// Log full query with parameters
 	fmt.Printf("\n[SQL Query] %s\n", query)
@@ -351,8 +408,8 @@ func (h *Handler) ListMidterms(ctx context.Context, req *pb.ListMidtermsRequest)
 	entities := []*pb.Midterm{}
 	for rows.Next() {
 		var entity pb.Midterm
-		var createdAt, updatedAt sql.NullTime
-		var updatedBy sql.NullString
+		var createdAt, updatedAt, deletedAt sql.NullTime
+		var updatedBy, deletedBy sql.NullString
 		var StatusStr string
 
 		err := rows.Scan(
@@ -365,24 +422,16 @@ func (h *Handler) ListMidterms(ctx context.Context, req *pb.ListMidtermsRequest)
 			&updatedAt,
 			&entity.CreatedBy,
 			&updatedBy,
+			&entity.Version,
+			&deletedAt,
+			&deletedBy,
 		)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan midterm: %v", err)
 		}
 
 		// Convert Status string to enum
-		switch StatusStr {
-		case "not_submitted":
-			entity.Status = pb.MidtermStatus_NOT_SUBMITTED
-		case "submitted":
-			entity.Status = pb.MidtermStatus_SUBMITTED
-		case "pass":
-			entity.Status = pb.MidtermStatus_PASS
-		case "fail":
-			entity.Status = pb.MidtermStatus_FAIL
-		default:
-			entity.Status = pb.MidtermStatus_NOT_SUBMITTED
-		}
+		entity.Status = midtermStatusFromString(StatusStr)
 
 		if createdAt.Valid {
 			entity.CreatedAt = timestamppb.New(createdAt.Time)
@@ -393,6 +442,10 @@ func (h *Handler) ListMidterms(ctx context.Context, req *pb.ListMidtermsRequest)
 		if updatedBy.Valid {
 			entity.UpdatedBy = updatedBy.String
 		}
+		if deletedAt.Valid {
+			entity.DeletedAt = timestamppb.New(deletedAt.Time)
+			entity.DeletedBy = deletedBy.String
+		}
 
 		entities = append(entities, &entity)
 	}
@@ -0,0 +1,712 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	pb "thaily/proto/thesis"
+	"thaily/src/pkg/audit"
+	"thaily/src/pkg/helper"
+	"thaily/src/pkg/logger"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func milestoneResultToString(r pb.MilestoneResult) string {
+	switch r {
+	case pb.MilestoneResult_MILESTONE_PASSED:
+		return "passed"
+	case pb.MilestoneResult_MILESTONE_FAILED:
+		return "failed"
+	default:
+		return "pending"
+	}
+}
+
+func milestoneResultFromString(r string) pb.MilestoneResult {
+	switch r {
+	case "passed":
+		return pb.MilestoneResult_MILESTONE_PASSED
+	case "failed":
+		return pb.MilestoneResult_MILESTONE_FAILED
+	default:
+		return pb.MilestoneResult_MILESTONE_PENDING
+	}
+}
+
+const midtermMilestoneColumns = `id, semester_code, stage, title, description, sequence, due_at, created_at, updated_at, created_by, updated_by, version`
+
+func scanMidtermMilestone(scan func(dest ...interface{}) error) (*pb.MidtermMilestone, error) {
+	var entity pb.MidtermMilestone
+	var description sql.NullString
+	var dueAt, createdAt, updatedAt sql.NullTime
+	var StageStr string
+
+	err := scan(
+		&entity.Id,
+		&entity.SemesterCode,
+		&StageStr,
+		&entity.Title,
+		&description,
+		&entity.Sequence,
+		&dueAt,
+		&createdAt,
+		&updatedAt,
+		&entity.CreatedBy,
+		&entity.UpdatedBy,
+		&entity.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entity.Stage = stageFromString(StageStr)
+	entity.Description = description.String
+	if dueAt.Valid {
+		entity.DueAt = timestamppb.New(dueAt.Time)
+	}
+	if createdAt.Valid {
+		entity.CreatedAt = timestamppb.New(createdAt.Time)
+	}
+	if updatedAt.Valid {
+		entity.UpdatedAt = timestamppb.New(updatedAt.Time)
+	}
+	return &entity, nil
+}
+
+const milestoneCheckinColumns = `id, milestone_code, enrollment_code, report, file_code, result, comment, reviewed_by, reviewed_at, submitted_at, updated_at`
+
+func scanMilestoneCheckin(scan func(dest ...interface{}) error) (*pb.MilestoneCheckin, error) {
+	var entity pb.MilestoneCheckin
+	var fileCode, comment, reviewedBy sql.NullString
+	var reviewedAt, submittedAt, updatedAt sql.NullTime
+	var ResultStr string
+
+	err := scan(
+		&entity.Id,
+		&entity.MilestoneCode,
+		&entity.EnrollmentCode,
+		&entity.Report,
+		&fileCode,
+		&ResultStr,
+		&comment,
+		&reviewedBy,
+		&reviewedAt,
+		&submittedAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entity.Result = milestoneResultFromString(ResultStr)
+	entity.FileCode = fileCode.String
+	entity.Comment = comment.String
+	entity.ReviewedBy = reviewedBy.String
+	if reviewedAt.Valid {
+		entity.ReviewedAt = timestamppb.New(reviewedAt.Time)
+	}
+	if submittedAt.Valid {
+		entity.SubmittedAt = timestamppb.New(submittedAt.Time)
+	}
+	if updatedAt.Valid {
+		entity.UpdatedAt = timestamppb.New(updatedAt.Time)
+	}
+	return &entity, nil
+}
+
+func (h *Handler) getMidtermMilestone(ctx context.Context, q dbtx, id string, lock bool) (*pb.MidtermMilestone, error) {
+	query := `SELECT ` + midtermMilestoneColumns + ` FROM Midterm_milestone WHERE id = ?`
+	if lock {
+		query += ` FOR UPDATE`
+	}
+
+	milestone, err := scanMidtermMilestone(q.QueryRowContext(ctx, query, id).Scan)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "midterm milestone not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get midterm milestone: %v", err)
+	}
+	return milestone, nil
+}
+
+func (h *Handler) getMilestoneCheckin(ctx context.Context, q dbtx, id string, lock bool) (*pb.MilestoneCheckin, error) {
+	query := `SELECT ` + milestoneCheckinColumns + ` FROM Milestone_checkin WHERE id = ?`
+	if lock {
+		query += ` FOR UPDATE`
+	}
+
+	checkin, err := scanMilestoneCheckin(q.QueryRowContext(ctx, query, id).Scan)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "milestone check-in not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get milestone check-in: %v", err)
+	}
+	return checkin, nil
+}
+
+// checkSemesterUnlocked refuses milestone changes once the semester's grades
+// are locked, since they would change the derived midterm status
+func checkSemesterUnlocked(ctx context.Context, q dbtx, semesterCode string) error {
+	gradeStatus, err := getSemesterGradeStatus(ctx, q, semesterCode)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check grade lock: %v", err)
+	}
+	if gradeStatus.Locked {
+		return status.Errorf(codes.FailedPrecondition, "grades of semester %s are locked", semesterCode)
+	}
+	return nil
+}
+
+// milestoneEnrollment is what a check-in needs to know about its enrollment
+type milestoneEnrollment struct {
+	title        string
+	studentCode  string
+	semesterCode string
+	stage        string
+	midtermCode  sql.NullString
+}
+
+func getMilestoneEnrollment(ctx context.Context, q dbtx, enrollmentCode string) (*milestoneEnrollment, error) {
+	var e milestoneEnrollment
+	err := q.QueryRowContext(ctx, `
+		SELECT e.title, e.student_code, t.semester_code, tc.stage, e.midterm_code
+		FROM Enrollment e
+		JOIN Topic_council tc ON tc.id = e.topic_council_code AND tc.deleted_at IS NULL
+		JOIN Topic t ON t.id = tc.topic_code AND t.deleted_at IS NULL
+		WHERE e.id = ? AND e.deleted_at IS NULL
+	`, enrollmentCode).Scan(&e.title, &e.studentCode, &e.semesterCode, &e.stage, &e.midtermCode)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// deriveMidtermStatus recomputes Midterm.status of an enrollment from its
+// check-ins: any failed milestone fails the midterm, every milestone passed
+// passes it, and any check-in at all makes it submitted
+func deriveMidtermStatus(ctx context.Context, tx *sql.Tx, enrollmentCode, actor string) error {
+	e, err := getMilestoneEnrollment(ctx, tx, enrollmentCode)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get enrollment: %v", err)
+	}
+	if !e.midtermCode.Valid {
+		return nil
+	}
+
+	var milestones, checkins, passed, failed int
+	err = tx.QueryRowContext(ctx, `
+		SELECT
+			(SELECT COUNT(*) FROM Midterm_milestone WHERE semester_code = ? AND stage = ?),
+			COUNT(c.id),
+			COALESCE(SUM(c.result = 'passed'), 0),
+			COALESCE(SUM(c.result = 'failed'), 0)
+		FROM Milestone_checkin c
+		JOIN Midterm_milestone m ON m.id = c.milestone_code
+		WHERE c.enrollment_code = ? AND m.semester_code = ? AND m.stage = ?
+	`, e.semesterCode, e.stage, enrollmentCode, e.semesterCode, e.stage).Scan(&milestones, &checkins, &passed, &failed)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to count milestone results: %v", err)
+	}
+
+	midtermStatus := "not_submitted"
+	switch {
+	case failed > 0:
+		midtermStatus = "fail"
+	case milestones > 0 && passed == milestones:
+		midtermStatus = "pass"
+	case checkins > 0:
+		midtermStatus = "submitted"
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE Midterm SET status = ?, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ? AND status <> ?
+	`, midtermStatus, actor, e.midtermCode.String, midtermStatus)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to update midterm status: %v", err)
+	}
+	return nil
+}
+
+// rederiveMidtermStatuses recomputes the midterm of every enrollment of a
+// semester and stage, after its set of milestones changed
+func rederiveMidtermStatuses(ctx context.Context, tx *sql.Tx, semesterCode, stage, actor string) error {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT e.id
+		FROM Enrollment e
+		JOIN Topic_council tc ON tc.id = e.topic_council_code AND tc.deleted_at IS NULL
+		JOIN Topic t ON t.id = tc.topic_code AND t.deleted_at IS NULL
+		WHERE t.semester_code = ? AND tc.stage = ? AND e.midterm_code IS NOT NULL AND e.deleted_at IS NULL
+	`, semesterCode, stage)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list enrollments: %v", err)
+	}
+	enrollmentCodes := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return status.Errorf(codes.Internal, "failed to scan enrollment: %v", err)
+		}
+		enrollmentCodes = append(enrollmentCodes, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return status.Errorf(codes.Internal, "error iterating enrollments: %v", err)
+	}
+
+	for _, enrollmentCode := range enrollmentCodes {
+		if err := deriveMidtermStatus(ctx, tx, enrollmentCode, actor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *Handler) CreateMidtermMilestone(ctx context.Context, req *pb.CreateMidtermMilestoneRequest) (*pb.CreateMidtermMilestoneResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.SemesterCode == "" || req.CreatedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester_code and created_by are required")
+	}
+	if strings.TrimSpace(req.Title) == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	if req.Sequence <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "sequence must be positive")
+	}
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "semester_code", "Semester", req.SemesterCode); err != nil {
+		return nil, err
+	}
+
+	if err := checkSemesterUnlocked(ctx, h.db, req.SemesterCode); err != nil {
+		return nil, err
+	}
+
+	var dueAt sql.NullTime
+	if req.DueAt != nil {
+		dueAt = sql.NullTime{Time: req.DueAt.AsTime(), Valid: true}
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	id := uuid.New().String()
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO Midterm_milestone (id, semester_code, stage, title, description, sequence, due_at, created_at, updated_at, created_by, updated_by)
+		VALUES (?, ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?)
+	`, id, req.SemesterCode, stageToString(req.Stage), strings.TrimSpace(req.Title), req.Description, req.Sequence, dueAt,
+		req.CreatedBy, req.CreatedBy)
+	if err != nil {
+		if strings.Contains(err.Error(), "Duplicate entry") {
+			return nil, status.Errorf(codes.AlreadyExists, "a milestone with sequence %d already exists", req.Sequence)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create midterm milestone: %v", err)
+	}
+
+	// A new milestone has to be passed too
+	if err := rederiveMidtermStatuses(ctx, tx, req.SemesterCode, stageToString(req.Stage), req.CreatedBy); err != nil {
+		return nil, err
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	milestone, err := h.getMidtermMilestone(ctx, h.db, id, false)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateMidtermMilestoneResponse{
+		Milestone: milestone,
+	}, nil
+}
+
+func (h *Handler) UpdateMidtermMilestone(ctx context.Context, req *pb.UpdateMidtermMilestoneRequest) (*pb.UpdateMidtermMilestoneResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" || req.UpdatedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "id and updated_by are required")
+	}
+
+	updateFields := []string{}
+	args := []interface{}{}
+	if req.Title != nil {
+		if strings.TrimSpace(*req.Title) == "" {
+			return nil, status.Error(codes.InvalidArgument, "title cannot be empty")
+		}
+		updateFields = append(updateFields, "title = ?")
+		args = append(args, strings.TrimSpace(*req.Title))
+	}
+	if req.Description != nil {
+		updateFields = append(updateFields, "description = ?")
+		args = append(args, *req.Description)
+	}
+	if req.Sequence != nil {
+		if *req.Sequence <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "sequence must be positive")
+		}
+		updateFields = append(updateFields, "sequence = ?")
+		args = append(args, *req.Sequence)
+	}
+	if req.DueAt != nil {
+		updateFields = append(updateFields, "due_at = ?")
+		args = append(args, req.DueAt.AsTime())
+	}
+	if len(updateFields) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no fields to update")
+	}
+	updateFields = append(updateFields, "updated_by = ?", "updated_at = NOW()", "version = version + 1")
+	args = append(args, req.UpdatedBy)
+	where := helper.WhereVersion(req.Id, req.Version, &args)
+
+	result, err := h.execQuery(ctx, `UPDATE Midterm_milestone SET `+strings.Join(updateFields, ", ")+` WHERE `+where, args...)
+	if err != nil {
+		if strings.Contains(err.Error(), "Duplicate entry") {
+			return nil, status.Error(codes.AlreadyExists, "a milestone with this sequence already exists")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update midterm milestone: %v", err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		current, err := h.getMidtermMilestone(ctx, h.db, req.Id, false)
+		if err != nil {
+			return nil, err
+		}
+		return nil, helper.VersionConflict("midterm milestone", current, current.Version)
+	}
+
+	milestone, err := h.getMidtermMilestone(ctx, h.db, req.Id, false)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateMidtermMilestoneResponse{
+		Milestone: milestone,
+	}, nil
+}
+
+func (h *Handler) DeleteMidtermMilestone(ctx context.Context, req *pb.DeleteMidtermMilestoneRequest) (*pb.DeleteMidtermMilestoneResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" || req.DeletedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "id and deleted_by are required")
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	// The milestone row is locked, so a check-in submitted meanwhile waits on
+	// its foreign key and cannot be left without a milestone
+	milestone, err := h.getMidtermMilestone(ctx, tx, req.Id, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSemesterUnlocked(ctx, tx, milestone.SemesterCode); err != nil {
+		return nil, err
+	}
+
+	var used int
+	err = tx.QueryRowContext(ctx, `SELECT 1 FROM Milestone_checkin WHERE milestone_code = ? LIMIT 1 FOR UPDATE`, req.Id).Scan(&used)
+	if err == nil {
+		return nil, status.Error(codes.FailedPrecondition, "milestone already has check-ins")
+	}
+	if err != sql.ErrNoRows {
+		return nil, status.Errorf(codes.Internal, "failed to check milestone check-ins: %v", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM Midterm_milestone WHERE id = ?`, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete midterm milestone: %v", err)
+	}
+	if err := rederiveMidtermStatuses(ctx, tx, milestone.SemesterCode, stageToString(milestone.Stage), req.DeletedBy); err != nil {
+		return nil, err
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	return &pb.DeleteMidtermMilestoneResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *Handler) ListMidtermMilestones(ctx context.Context, req *pb.ListMidtermMilestonesRequest) (*pb.ListMidtermMilestonesResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.SemesterCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester_code is required")
+	}
+
+	query := `SELECT ` + midtermMilestoneColumns + ` FROM Midterm_milestone WHERE semester_code = ?`
+	args := []interface{}{req.SemesterCode}
+	if req.Stage != nil {
+		query += ` AND stage = ?`
+		args = append(args, stageToString(*req.Stage))
+	}
+	query += ` ORDER BY stage, sequence`
+
+	rows, err := h.query(ctx, query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list midterm milestones: %v", err)
+	}
+	defer rows.Close()
+
+	milestones := []*pb.MidtermMilestone{}
+	for rows.Next() {
+		milestone, err := scanMidtermMilestone(rows.Scan)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan midterm milestone: %v", err)
+		}
+		milestones = append(milestones, milestone)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating midterm milestones: %v", err)
+	}
+
+	return &pb.ListMidtermMilestonesResponse{
+		Milestones: milestones,
+	}, nil
+}
+
+// SubmitMilestoneCheckin records the student's report for a milestone of the
+// enrollment's semester and stage. New check-ins close at the milestone's due
+// date; a failed check-in may still be reworked after it.
+func (h *Handler) SubmitMilestoneCheckin(ctx context.Context, req *pb.SubmitMilestoneCheckinRequest) (*pb.SubmitMilestoneCheckinResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.MilestoneCode == "" || req.EnrollmentCode == "" || req.StudentCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "milestone_code, enrollment_code and student_code are required")
+	}
+	if strings.TrimSpace(req.Report) == "" {
+		return nil, status.Error(codes.InvalidArgument, "report is required")
+	}
+
+	milestone, err := h.getMidtermMilestone(ctx, h.db, req.MilestoneCode, false)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	enrollment, err := getMilestoneEnrollment(ctx, tx, req.EnrollmentCode)
+	if err == sql.ErrNoRows || (err == nil && enrollment.studentCode != req.StudentCode) {
+		return nil, status.Error(codes.NotFound, "enrollment not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get enrollment: %v", err)
+	}
+	if enrollment.semesterCode != milestone.SemesterCode || enrollment.stage != stageToString(milestone.Stage) {
+		return nil, status.Error(codes.FailedPrecondition, "milestone does not belong to the enrollment's semester and stage")
+	}
+	if err := checkSemesterUnlocked(ctx, tx, enrollment.semesterCode); err != nil {
+		return nil, err
+	}
+
+	existing, err := scanMilestoneCheckin(tx.QueryRowContext(ctx,
+		`SELECT `+milestoneCheckinColumns+` FROM Milestone_checkin WHERE milestone_code = ? AND enrollment_code = ? FOR UPDATE`,
+		req.MilestoneCode, req.EnrollmentCode,
+	).Scan)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, status.Errorf(codes.Internal, "failed to get milestone check-in: %v", err)
+	}
+	if existing != nil && existing.Result == pb.MilestoneResult_MILESTONE_PASSED {
+		return nil, status.Error(codes.FailedPrecondition, "milestone is already passed")
+	}
+	reworkingFailed := existing != nil && existing.Result == pb.MilestoneResult_MILESTONE_FAILED
+	if milestone.DueAt != nil && time.Now().After(milestone.DueAt.AsTime()) && !reworkingFailed {
+		return nil, status.Errorf(codes.FailedPrecondition, "milestone was due at %s", milestone.DueAt.AsTime().Format(time.RFC3339))
+	}
+
+	var fileCode sql.NullString
+	if req.FileCode != nil && *req.FileCode != "" {
+		fileCode = sql.NullString{String: *req.FileCode, Valid: true}
+	}
+
+	id := uuid.New().String()
+	if existing != nil {
+		id = existing.Id
+		_, err = tx.ExecContext(ctx, `
+			UPDATE Milestone_checkin
+			SET report = ?, file_code = ?, result = 'pending', comment = NULL, reviewed_by = NULL, reviewed_at = NULL,
+				submitted_at = NOW(), updated_at = NOW()
+			WHERE id = ?
+		`, strings.TrimSpace(req.Report), fileCode, id)
+	} else {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO Milestone_checkin (id, milestone_code, enrollment_code, report, file_code, result, submitted_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, 'pending', NOW(), NOW())
+		`, id, req.MilestoneCode, req.EnrollmentCode, strings.TrimSpace(req.Report), fileCode)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save milestone check-in: %v", err)
+	}
+
+	// The first check-in opens the enrollment's midterm
+	midtermCode := enrollment.midtermCode.String
+	if !enrollment.midtermCode.Valid {
+		midtermCode = uuid.New().String()
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO Midterm (id, title, grade, status, feedback, created_at, updated_at, created_by, updated_by)
+			VALUES (?, ?, 0, 'not_submitted', '', NOW(), NOW(), ?, ?)
+		`, midtermCode, enrollment.title, req.StudentCode, req.StudentCode)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create midterm: %v", err)
+		}
+		_, err = tx.ExecContext(ctx, `
+			UPDATE Enrollment SET midterm_code = ?, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ?
+		`, midtermCode, req.StudentCode, req.EnrollmentCode)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to attach midterm: %v", err)
+		}
+	}
+
+	if err := deriveMidtermStatus(ctx, tx, req.EnrollmentCode, req.StudentCode); err != nil {
+		return nil, err
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	checkin, err := h.getMilestoneCheckin(ctx, h.db, id, false)
+	if err != nil {
+		return nil, err
+	}
+	midterm, err := h.GetMidterm(ctx, &pb.GetMidtermRequest{Id: midtermCode})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SubmitMilestoneCheckinResponse{
+		Checkin: checkin,
+		Midterm: midterm.GetMidterm(),
+	}, nil
+}
+
+// ReviewMilestoneCheckin passes or fails a check-in; a review may be revised
+// until the semester's grades are locked
+func (h *Handler) ReviewMilestoneCheckin(ctx context.Context, req *pb.ReviewMilestoneCheckinRequest) (*pb.ReviewMilestoneCheckinResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" || req.ReviewedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "id and reviewed_by are required")
+	}
+	if !req.Pass && strings.TrimSpace(req.Comment) == "" {
+		return nil, status.Error(codes.InvalidArgument, "comment is required to fail a milestone")
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	checkin, err := h.getMilestoneCheckin(ctx, tx, req.Id, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkGradesUnlocked(ctx, tx, enrollmentById, checkin.EnrollmentCode); err != nil {
+		return nil, err
+	}
+
+	result := pb.MilestoneResult_MILESTONE_FAILED
+	if req.Pass {
+		result = pb.MilestoneResult_MILESTONE_PASSED
+	}
+	_, err = tx.ExecContext(ctx, `
+		UPDATE Milestone_checkin
+		SET result = ?, comment = ?, reviewed_by = ?, reviewed_at = NOW(), updated_at = NOW()
+		WHERE id = ?
+	`, milestoneResultToString(result), strings.TrimSpace(req.Comment), req.ReviewedBy, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to review milestone check-in: %v", err)
+	}
+	if err := deriveMidtermStatus(ctx, tx, checkin.EnrollmentCode, req.ReviewedBy); err != nil {
+		return nil, err
+	}
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	checkin, err = h.getMilestoneCheckin(ctx, h.db, req.Id, false)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ReviewMilestoneCheckinResponse{
+		Checkin: checkin,
+	}
+	var midtermCode sql.NullString
+	if err := h.queryRow(ctx, `SELECT midterm_code FROM Enrollment WHERE id = ?`, checkin.EnrollmentCode).Scan(&midtermCode); err == nil && midtermCode.Valid {
+		midterm, err := h.GetMidterm(ctx, &pb.GetMidtermRequest{Id: midtermCode.String})
+		if err != nil {
+			return nil, err
+		}
+		resp.Midterm = midterm.GetMidterm()
+	}
+	return resp, nil
+}
+
+func (h *Handler) GetMilestoneCheckin(ctx context.Context, req *pb.GetMilestoneCheckinRequest) (*pb.GetMilestoneCheckinResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	checkin, err := h.getMilestoneCheckin(ctx, h.db, req.Id, false)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetMilestoneCheckinResponse{
+		Checkin: checkin,
+	}, nil
+}
+
+func (h *Handler) ListMilestoneCheckins(ctx context.Context, req *pb.ListMilestoneCheckinsRequest) (*pb.ListMilestoneCheckinsResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.EnrollmentCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "enrollment_code is required")
+	}
+
+	rows, err := h.query(ctx, `
+		SELECT c.id, c.milestone_code, c.enrollment_code, c.report, c.file_code, c.result, c.comment, c.reviewed_by, c.reviewed_at, c.submitted_at, c.updated_at
+		FROM Milestone_checkin c
+		JOIN Midterm_milestone m ON m.id = c.milestone_code
+		WHERE c.enrollment_code = ?
+		ORDER BY m.sequence
+	`, req.EnrollmentCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list milestone check-ins: %v", err)
+	}
+	defer rows.Close()
+
+	checkins := []*pb.MilestoneCheckin{}
+	for rows.Next() {
+		checkin, err := scanMilestoneCheckin(rows.Scan)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan milestone check-in: %v", err)
+		}
+		checkins = append(checkins, checkin)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating milestone check-ins: %v", err)
+	}
+
+	return &pb.ListMilestoneCheckinsResponse{
+		Checkins: checkins,
+	}, nil
+}
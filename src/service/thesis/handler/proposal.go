@@ -0,0 +1,337 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	pb "thaily/proto/thesis"
+	"thaily/src/pkg/audit"
+	"thaily/src/pkg/logger"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// joinSkills stores the skill list as a comma separated column
+func joinSkills(skills []string) string {
+	cleaned := make([]string, 0, len(skills))
+	for _, skill := range skills {
+		skill = strings.TrimSpace(strings.ReplaceAll(skill, ",", " "))
+		if skill != "" {
+			cleaned = append(cleaned, skill)
+		}
+	}
+	return strings.Join(cleaned, ",")
+}
+
+func splitSkills(skills string) []string {
+	if skills == "" {
+		return nil
+	}
+	return strings.Split(skills, ",")
+}
+
+func coSignStatusToString(s pb.CoSignStatus) string {
+	switch s {
+	case pb.CoSignStatus_COSIGN_SIGNED:
+		return "signed"
+	case pb.CoSignStatus_COSIGN_DECLINED:
+		return "declined"
+	default:
+		return "pending"
+	}
+}
+
+func coSignStatusFromString(s string) pb.CoSignStatus {
+	switch s {
+	case "signed":
+		return pb.CoSignStatus_COSIGN_SIGNED
+	case "declined":
+		return pb.CoSignStatus_COSIGN_DECLINED
+	default:
+		return pb.CoSignStatus_COSIGN_PENDING
+	}
+}
+
+const topicCoSignColumns = `id, topic_code, teacher_code, status, created_at, updated_at`
+
+func scanTopicCoSign(scan func(dest ...interface{}) error) (*pb.TopicCoSign, error) {
+	var entity pb.TopicCoSign
+	var StatusStr string
+	var createdAt, updatedAt sql.NullTime
+
+	err := scan(
+		&entity.Id,
+		&entity.TopicCode,
+		&entity.TeacherCode,
+		&StatusStr,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entity.Status = coSignStatusFromString(StatusStr)
+	if createdAt.Valid {
+		entity.CreatedAt = timestamppb.New(createdAt.Time)
+	}
+	if updatedAt.Valid {
+		entity.UpdatedAt = timestamppb.New(updatedAt.Time)
+	}
+	return &entity, nil
+}
+
+// getTopicCouncilOfTopic returns the topic council created with a proposed topic
+func getTopicCouncilOfTopic(ctx context.Context, tx *sql.Tx, topicCode string) (string, error) {
+	var id string
+	err := tx.QueryRowContext(ctx, "SELECT id FROM Topic_council WHERE topic_code = ? AND deleted_at IS NULL ORDER BY created_at ASC LIMIT 1", topicCode).Scan(&id)
+	if err == sql.ErrNoRows {
+		return "", status.Error(codes.FailedPrecondition, "topic has no topic council")
+	}
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get topic council: %v", err)
+	}
+	return id, nil
+}
+
+// insertTopicCouncilSupervisor makes a teacher a supervisor of a topic council
+func insertTopicCouncilSupervisor(ctx context.Context, tx *sql.Tx, teacherCode, topicCouncilCode, actor string) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO Topic_council_supervisor (id, teacher_supervisor_code, topic_council_code, created_by, updated_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, NOW(), NOW())
+	`, uuid.New().String(), teacherCode, topicCouncilCode, actor, actor)
+	return err
+}
+
+// ProposeTopic creates a teacher's topic proposal: the Topic (in SUBMIT), its
+// Topic_council, the proposer as first supervisor and a pending co-signature
+// for every invited co-supervisor. All rows are written in one transaction.
+func (h *Handler) ProposeTopic(ctx context.Context, req *pb.ProposeTopicRequest) (*pb.ProposeTopicResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	if req.MajorCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "major_code is required")
+	}
+	if req.SemesterCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester_code is required")
+	}
+	if req.ProposedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "proposed_by is required")
+	}
+	if req.MaxStudents < 1 {
+		return nil, status.Error(codes.InvalidArgument, "max_students must be at least 1")
+	}
+	if req.TimeStart == nil || req.TimeEnd == nil {
+		return nil, status.Error(codes.InvalidArgument, "time_start and time_end are required")
+	}
+	if !req.TimeEnd.AsTime().After(req.TimeStart.AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "time_end must be after time_start")
+	}
+
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "major_code", "Major", req.MajorCode); err != nil {
+		return nil, err
+	}
+	if err := h.refs.Require(ctx, "semester_code", "Semester", req.SemesterCode); err != nil {
+		return nil, err
+	}
+	if err := h.refs.Require(ctx, "proposed_by", "Teacher", req.ProposedBy); err != nil {
+		return nil, err
+	}
+	if err := h.refs.Require(ctx, "co_supervisor_codes", "Teacher", req.CoSupervisorCodes...); err != nil {
+		return nil, err
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	topicID := uuid.New().String()
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO Topic (id, title, major_code, semester_code, status, percent_stage_1, percent_stage_2, max_students, required_skills, created_by, updated_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, 0, 0, ?, ?, ?, ?, NOW(), NOW())
+	`, topicID, req.Title, req.MajorCode, req.SemesterCode, topicStatusToString(pb.TopicStatus_SUBMIT),
+		req.MaxStudents, joinSkills(req.RequiredSkills), req.ProposedBy, req.ProposedBy)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create topic: %v", err)
+	}
+
+	err = insertTopicStatusHistory(ctx, tx, &pb.TopicStatusHistory{
+		Id:        uuid.New().String(),
+		TopicCode: topicID,
+		ToStatus:  pb.TopicStatus_SUBMIT,
+		Reason:    "proposed",
+		CreatedBy: req.ProposedBy,
+		CreatedAt: timestamppb.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record topic history: %v", err)
+	}
+
+	topicCouncilID := uuid.New().String()
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO Topic_council (id, title, stage, topic_code, time_start, time_end, created_by, updated_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
+	`, topicCouncilID, req.Title, stageToString(req.Stage), topicID, req.TimeStart.AsTime(), req.TimeEnd.AsTime(), req.ProposedBy, req.ProposedBy)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create topic council: %v", err)
+	}
+
+	if err := insertTopicCouncilSupervisor(ctx, tx, req.ProposedBy, topicCouncilID, req.ProposedBy); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add supervisor: %v", err)
+	}
+
+	seen := map[string]bool{req.ProposedBy: true}
+	for _, teacherCode := range req.CoSupervisorCodes {
+		if teacherCode == "" || seen[teacherCode] {
+			continue
+		}
+		seen[teacherCode] = true
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO Topic_cosign (id, topic_code, teacher_code, status, created_at, updated_at)
+			VALUES (?, ?, ?, ?, NOW(), NOW())
+		`, uuid.New().String(), topicID, teacherCode, coSignStatusToString(pb.CoSignStatus_COSIGN_PENDING))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to invite co-supervisor: %v", err)
+		}
+	}
+
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	topic, err := h.GetTopic(ctx, &pb.GetTopicRequest{Id: topicID})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get topic")
+	}
+	coSigns, err := h.ListTopicCoSigns(ctx, &pb.ListTopicCoSignsRequest{TopicId: topicID})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ProposeTopicResponse{
+		Topic:          topic.GetTopic(),
+		TopicCouncilId: topicCouncilID,
+		CoSigns:        coSigns.GetCoSigns(),
+	}, nil
+}
+
+// CoSignTopic records an invited co-supervisor's answer. Signing adds the
+// teacher to the topic council's supervisors. Proposals can only be co-signed
+// before they are submitted (or after a rejection, before resubmission).
+func (h *Handler) CoSignTopic(ctx context.Context, req *pb.CoSignTopicRequest) (*pb.CoSignTopicResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.TopicId == "" {
+		return nil, status.Error(codes.InvalidArgument, "topic_id is required")
+	}
+	if req.TeacherCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "teacher_code is required")
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var topicStatus string
+	err = tx.QueryRowContext(ctx, "SELECT status FROM Topic WHERE id = ? AND deleted_at IS NULL", req.TopicId).Scan(&topicStatus)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "topic not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get topic: %v", err)
+	}
+	switch topicStatusFromString(topicStatus) {
+	case pb.TopicStatus_SUBMIT, pb.TopicStatus_REJECTED:
+	default:
+		return nil, status.Error(codes.FailedPrecondition, "topic has already been submitted")
+	}
+
+	query := `SELECT ` + topicCoSignColumns + ` FROM Topic_cosign WHERE topic_code = ? AND teacher_code = ? FOR UPDATE`
+	coSign, err := scanTopicCoSign(tx.QueryRowContext(ctx, query, req.TopicId, req.TeacherCode).Scan)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.PermissionDenied, "teacher was not invited to co-supervise this topic")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get co-signature: %v", err)
+	}
+	if coSign.Status != pb.CoSignStatus_COSIGN_PENDING {
+		return nil, status.Error(codes.FailedPrecondition, "co-signature already answered")
+	}
+
+	next := pb.CoSignStatus_COSIGN_DECLINED
+	if req.Accept {
+		next = pb.CoSignStatus_COSIGN_SIGNED
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE Topic_cosign SET status = ?, updated_at = ? WHERE id = ?",
+		coSignStatusToString(next), time.Now(), coSign.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update co-signature: %v", err)
+	}
+
+	if req.Accept {
+		topicCouncilID, err := getTopicCouncilOfTopic(ctx, tx, req.TopicId)
+		if err != nil {
+			return nil, err
+		}
+		if err := insertTopicCouncilSupervisor(ctx, tx, req.TeacherCode, topicCouncilID, req.TeacherCode); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to add supervisor: %v", err)
+		}
+	}
+
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	coSign.Status = next
+	coSign.UpdatedAt = timestamppb.Now()
+	return &pb.CoSignTopicResponse{CoSign: coSign}, nil
+}
+
+// ListTopicCoSigns lists the co-supervisors invited to a topic
+func (h *Handler) ListTopicCoSigns(ctx context.Context, req *pb.ListTopicCoSignsRequest) (*pb.ListTopicCoSignsResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.TopicId == "" {
+		return nil, status.Error(codes.InvalidArgument, "topic_id is required")
+	}
+
+	rows, err := h.query(ctx, `SELECT `+topicCoSignColumns+` FROM Topic_cosign WHERE topic_code = ? ORDER BY created_at ASC`, req.TopicId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list co-signatures: %v", err)
+	}
+	defer rows.Close()
+
+	coSigns := []*pb.TopicCoSign{}
+	for rows.Next() {
+		coSign, err := scanTopicCoSign(rows.Scan)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan co-signature: %v", err)
+		}
+		coSigns = append(coSigns, coSign)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list co-signatures: %v", err)
+	}
+
+	return &pb.ListTopicCoSignsResponse{CoSigns: coSigns}, nil
+}
+
+// pendingCoSigns counts invited co-supervisors who have not answered yet
+func (h *Handler) pendingCoSigns(ctx context.Context, topicID string) (int, error) {
+	var count int
+	err := h.queryRow(ctx, "SELECT COUNT(*) FROM Topic_cosign WHERE topic_code = ? AND status = ?",
+		topicID, coSignStatusToString(pb.CoSignStatus_COSIGN_PENDING)).Scan(&count)
+	return count, err
+}
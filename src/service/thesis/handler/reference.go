@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"context"
+
+	pbCommon "thaily/proto/common"
+	"thaily/src/pkg/logger"
+	"thaily/src/pkg/reference"
+)
+
+// referencedTables are the thesis tables other services point at
+var referencedTables = []string{"Enrollment"}
+
+// CheckReferences tells another service which ids of one of our tables do
+// not exist, replacing the foreign keys across services
+func (h *Handler) CheckReferences(ctx context.Context, req *pbCommon.ReferenceCheckRequest) (*pbCommon.ReferenceCheckResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	return reference.Check(ctx, h.db, referencedTables, req)
+}
+
+// References lists the columns of the thesis tables that point at other
+// services' tables, for the orphan report
+func References() []reference.Column {
+	return []reference.Column{
+		{Table: "Enrollment", Column: "student_code", References: "Student"},
+		{Table: "Topic", Column: "major_code", References: "Major"},
+		{Table: "Topic", Column: "semester_code", References: "Semester"},
+		{Table: "Topic_council", Column: "council_code", References: "Council"},
+		{Table: "Topic_council_supervisor", Column: "teacher_supervisor_code", References: "Teacher"},
+		{Table: "Grade_review", Column: "teacher_code", References: "Teacher"},
+		{Table: "Submission_deadline", Column: "semester_code", References: "Semester"},
+		{Table: "Deadline_extension", Column: "semester_code", References: "Semester"},
+		{Table: "Deadline_extension", Column: "student_code", References: "Student"},
+		{Table: "Topic_cosign", Column: "teacher_code", References: "Teacher"},
+		{Table: "Registration_window", Column: "semester_code", References: "Semester"},
+		{Table: "Topic_registration", Column: "student_code", References: "Student"},
+		{Table: "Topic_applicant_rank", Column: "student_code", References: "Student"},
+		{Table: "Grading_policy", Column: "major_code", References: "Major"},
+		{Table: "Grading_policy", Column: "semester_code", References: "Semester"},
+		{Table: "Semester_grade_status", Column: "semester_code", References: "Semester"},
+		{Table: "Midterm_milestone", Column: "semester_code", References: "Semester"},
+	}
+}
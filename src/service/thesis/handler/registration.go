@@ -0,0 +1,523 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	pb "thaily/proto/thesis"
+	"thaily/src/pkg/audit"
+	"thaily/src/pkg/logger"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultMaxChoices is used when a registration window does not set one
+const defaultMaxChoices = 3
+
+func registrationStatusToString(s pb.RegistrationStatus) string {
+	switch s {
+	case pb.RegistrationStatus_REGISTRATION_ACCEPTED:
+		return "accepted"
+	case pb.RegistrationStatus_REGISTRATION_DECLINED:
+		return "declined"
+	case pb.RegistrationStatus_REGISTRATION_WITHDRAWN:
+		return "withdrawn"
+	default:
+		return "pending"
+	}
+}
+
+func registrationStatusFromString(s string) pb.RegistrationStatus {
+	switch s {
+	case "accepted":
+		return pb.RegistrationStatus_REGISTRATION_ACCEPTED
+	case "declined":
+		return pb.RegistrationStatus_REGISTRATION_DECLINED
+	case "withdrawn":
+		return pb.RegistrationStatus_REGISTRATION_WITHDRAWN
+	default:
+		return pb.RegistrationStatus_REGISTRATION_PENDING
+	}
+}
+
+const registrationWindowColumns = `id, semester_code, opens_at, closes_at, max_choices, created_at, updated_at, created_by, updated_by`
+
+func scanRegistrationWindow(scan func(dest ...interface{}) error) (*pb.RegistrationWindow, error) {
+	var entity pb.RegistrationWindow
+	var opensAt, closesAt, createdAt, updatedAt time.Time
+
+	err := scan(
+		&entity.Id,
+		&entity.SemesterCode,
+		&opensAt,
+		&closesAt,
+		&entity.MaxChoices,
+		&createdAt,
+		&updatedAt,
+		&entity.CreatedBy,
+		&entity.UpdatedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entity.OpensAt = timestamppb.New(opensAt)
+	entity.ClosesAt = timestamppb.New(closesAt)
+	entity.CreatedAt = timestamppb.New(createdAt)
+	entity.UpdatedAt = timestamppb.New(updatedAt)
+	return &entity, nil
+}
+
+const topicRegistrationColumns = `id, topic_code, student_code, semester_code, preference_rank, status, reason, decided_by, decided_at, enrollment_code, created_at, updated_at`
+
+func scanTopicRegistration(scan func(dest ...interface{}) error) (*pb.TopicRegistration, error) {
+	var entity pb.TopicRegistration
+	var StatusStr string
+	var reason, decidedBy, enrollmentCode sql.NullString
+	var decidedAt, createdAt, updatedAt sql.NullTime
+
+	err := scan(
+		&entity.Id,
+		&entity.TopicCode,
+		&entity.StudentCode,
+		&entity.SemesterCode,
+		&entity.Rank,
+		&StatusStr,
+		&reason,
+		&decidedBy,
+		&decidedAt,
+		&enrollmentCode,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entity.Status = registrationStatusFromString(StatusStr)
+	entity.Reason = reason.String
+	entity.DecidedBy = decidedBy.String
+	entity.EnrollmentCode = enrollmentCode.String
+	if decidedAt.Valid {
+		entity.DecidedAt = timestamppb.New(decidedAt.Time)
+	}
+	if createdAt.Valid {
+		entity.CreatedAt = timestamppb.New(createdAt.Time)
+	}
+	if updatedAt.Valid {
+		entity.UpdatedAt = timestamppb.New(updatedAt.Time)
+	}
+	return &entity, nil
+}
+
+// SetRegistrationWindow opens (or reschedules) topic registration for a semester
+func (h *Handler) SetRegistrationWindow(ctx context.Context, req *pb.SetRegistrationWindowRequest) (*pb.SetRegistrationWindowResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.SemesterCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester_code is required")
+	}
+	if req.OpensAt == nil || req.ClosesAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "opens_at and closes_at are required")
+	}
+	if !req.ClosesAt.AsTime().After(req.OpensAt.AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "closes_at must be after opens_at")
+	}
+	if req.MaxChoices < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_choices must not be negative")
+	}
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "semester_code", "Semester", req.SemesterCode); err != nil {
+		return nil, err
+	}
+
+	maxChoices := req.MaxChoices
+	if maxChoices == 0 {
+		maxChoices = defaultMaxChoices
+	}
+
+	_, err := h.execQuery(ctx, `
+		INSERT INTO Registration_window (id, semester_code, opens_at, closes_at, max_choices, created_by, updated_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
+		ON DUPLICATE KEY UPDATE opens_at = VALUES(opens_at), closes_at = VALUES(closes_at),
+			max_choices = VALUES(max_choices), updated_by = VALUES(updated_by), updated_at = NOW()
+	`, uuid.New().String(), req.SemesterCode, req.OpensAt.AsTime(), req.ClosesAt.AsTime(), maxChoices, req.CreatedBy, req.CreatedBy)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set registration window: %v", err)
+	}
+
+	result, err := h.GetRegistrationWindow(ctx, &pb.GetRegistrationWindowRequest{SemesterCode: req.SemesterCode})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetRegistrationWindowResponse{Window: result.GetWindow()}, nil
+}
+
+func (h *Handler) GetRegistrationWindow(ctx context.Context, req *pb.GetRegistrationWindowRequest) (*pb.GetRegistrationWindowResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.SemesterCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester_code is required")
+	}
+
+	query := `SELECT ` + registrationWindowColumns + ` FROM Registration_window WHERE semester_code = ?`
+	window, err := scanRegistrationWindow(h.queryRow(ctx, query, req.SemesterCode).Scan)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "registration window not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get registration window: %v", err)
+	}
+	return &pb.GetRegistrationWindowResponse{Window: window}, nil
+}
+
+// RegisterTopicPreferences replaces a student's pending choices with a new
+// ranked list. Choices are only accepted while the semester's registration
+// window is open, and only for fully approved topics of that semester.
+func (h *Handler) RegisterTopicPreferences(ctx context.Context, req *pb.RegisterTopicPreferencesRequest) (*pb.RegisterTopicPreferencesResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.StudentCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_code is required")
+	}
+	if req.SemesterCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "semester_code is required")
+	}
+
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "student_code", "Student", req.StudentCode); err != nil {
+		return nil, err
+	}
+
+	window, err := h.GetRegistrationWindow(ctx, &pb.GetRegistrationWindowRequest{SemesterCode: req.SemesterCode})
+	if status.Code(err) == codes.NotFound {
+		return nil, status.Error(codes.FailedPrecondition, "topic registration is not open for this semester")
+	}
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if now.Before(window.GetWindow().OpensAt.AsTime()) || now.After(window.GetWindow().ClosesAt.AsTime()) {
+		return nil, status.Error(codes.FailedPrecondition, "topic registration window is closed")
+	}
+	if int32(len(req.TopicCodes)) > window.GetWindow().MaxChoices {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d choices are allowed", window.GetWindow().MaxChoices)
+	}
+
+	seen := map[string]bool{}
+	for _, topicCode := range req.TopicCodes {
+		if topicCode == "" {
+			return nil, status.Error(codes.InvalidArgument, "topic_codes must not contain empty values")
+		}
+		if seen[topicCode] {
+			return nil, status.Errorf(codes.InvalidArgument, "topic %s is listed more than once", topicCode)
+		}
+		seen[topicCode] = true
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the student's registrations so concurrent submissions serialize
+	rows, err := tx.QueryContext(ctx, "SELECT topic_code, status FROM Topic_registration WHERE student_code = ? AND semester_code = ? FOR UPDATE",
+		req.StudentCode, req.SemesterCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get registrations: %v", err)
+	}
+	decided := map[string]pb.RegistrationStatus{}
+	for rows.Next() {
+		var topicCode, StatusStr string
+		if err := rows.Scan(&topicCode, &StatusStr); err != nil {
+			rows.Close()
+			return nil, status.Errorf(codes.Internal, "failed to scan registration: %v", err)
+		}
+		decided[topicCode] = registrationStatusFromString(StatusStr)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get registrations: %v", err)
+	}
+
+	for topicCode, st := range decided {
+		if st == pb.RegistrationStatus_REGISTRATION_ACCEPTED {
+			return nil, status.Errorf(codes.FailedPrecondition, "student has already been accepted for topic %s", topicCode)
+		}
+	}
+
+	for _, topicCode := range req.TopicCodes {
+		if decided[topicCode] == pb.RegistrationStatus_REGISTRATION_DECLINED {
+			return nil, status.Errorf(codes.FailedPrecondition, "the supervisor already declined topic %s", topicCode)
+		}
+
+		var semesterCode, topicStatus string
+		err := tx.QueryRowContext(ctx, "SELECT semester_code, status FROM Topic WHERE id = ? AND deleted_at IS NULL", topicCode).Scan(&semesterCode, &topicStatus)
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "topic %s not found", topicCode)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get topic: %v", err)
+		}
+		if semesterCode != req.SemesterCode {
+			return nil, status.Errorf(codes.InvalidArgument, "topic %s belongs to another semester", topicCode)
+		}
+		if topicStatusFromString(topicStatus) != pb.TopicStatus_APPROVED_2 {
+			return nil, status.Errorf(codes.FailedPrecondition, "topic %s is not open for registration", topicCode)
+		}
+	}
+
+	// Pending and withdrawn choices are replaced; declined ones stay as a record
+	_, err = tx.ExecContext(ctx, "DELETE FROM Topic_registration WHERE student_code = ? AND semester_code = ? AND status IN (?, ?)",
+		req.StudentCode, req.SemesterCode,
+		registrationStatusToString(pb.RegistrationStatus_REGISTRATION_PENDING),
+		registrationStatusToString(pb.RegistrationStatus_REGISTRATION_WITHDRAWN))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to clear registrations: %v", err)
+	}
+
+	for i, topicCode := range req.TopicCodes {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO Topic_registration (id, topic_code, student_code, semester_code, preference_rank, status, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, NOW(), NOW())
+		`, uuid.New().String(), topicCode, req.StudentCode, req.SemesterCode, i+1,
+			registrationStatusToString(pb.RegistrationStatus_REGISTRATION_PENDING))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to register topic: %v", err)
+		}
+	}
+
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	result, err := h.ListTopicRegistrations(ctx, &pb.ListTopicRegistrationsRequest{
+		StudentCode:  req.StudentCode,
+		SemesterCode: req.SemesterCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RegisterTopicPreferencesResponse{Registrations: result.GetRegistrations()}, nil
+}
+
+// ListTopicRegistrations lists registrations by topic, student and/or semester
+func (h *Handler) ListTopicRegistrations(ctx context.Context, req *pb.ListTopicRegistrationsRequest) (*pb.ListTopicRegistrationsResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	var conditions []string
+	var args []interface{}
+	if req.TopicCode != "" {
+		conditions = append(conditions, "topic_code = ?")
+		args = append(args, req.TopicCode)
+	}
+	if req.StudentCode != "" {
+		conditions = append(conditions, "student_code = ?")
+		args = append(args, req.StudentCode)
+	}
+	if req.SemesterCode != "" {
+		conditions = append(conditions, "semester_code = ?")
+		args = append(args, req.SemesterCode)
+	}
+	if len(conditions) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "topic_code, student_code or semester_code is required")
+	}
+
+	query := `SELECT ` + topicRegistrationColumns + ` FROM Topic_registration WHERE ` +
+		strings.Join(conditions, " AND ") + ` ORDER BY student_code ASC, preference_rank ASC`
+	rows, err := h.query(ctx, query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list registrations: %v", err)
+	}
+	defer rows.Close()
+
+	registrations := []*pb.TopicRegistration{}
+	for rows.Next() {
+		registration, err := scanTopicRegistration(rows.Scan)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan registration: %v", err)
+		}
+		registrations = append(registrations, registration)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list registrations: %v", err)
+	}
+
+	return &pb.ListTopicRegistrationsResponse{Registrations: registrations}, nil
+}
+
+// DecideTopicRegistration lets a supervisor of the topic accept or decline an
+// applicant. Accepting creates the student's Enrollment against the topic's
+// Topic_council and withdraws their other pending choices.
+func (h *Handler) DecideTopicRegistration(ctx context.Context, req *pb.DecideTopicRegistrationRequest) (*pb.DecideTopicRegistrationResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.RegistrationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "registration_id is required")
+	}
+	if req.SupervisorCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "supervisor_code is required")
+	}
+	if !req.Accept && req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required when declining")
+	}
+
+	// The student and semester of a registration never change, so they are
+	// read before the transaction, whose first reads are then the locks
+	var studentCode, semesterCode string
+	err := h.db.QueryRowContext(ctx, "SELECT student_code, semester_code FROM Topic_registration WHERE id = ?", req.RegistrationId).
+		Scan(&studentCode, &semesterCode)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "registration not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get registration: %v", err)
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Lock all of the student's registrations of the semester, in id order,
+	// so concurrent decisions on their choices serialize: the later one sees
+	// the student accepted or its registration withdrawn
+	rows, err := tx.QueryContext(ctx, "SELECT id FROM Topic_registration WHERE student_code = ? AND semester_code = ? ORDER BY id FOR UPDATE",
+		studentCode, semesterCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to lock registrations: %v", err)
+	}
+	rows.Close()
+
+	query := `SELECT ` + topicRegistrationColumns + ` FROM Topic_registration WHERE id = ? FOR UPDATE`
+	registration, err := scanTopicRegistration(tx.QueryRowContext(ctx, query, req.RegistrationId).Scan)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "registration not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get registration: %v", err)
+	}
+	if registration.Status != pb.RegistrationStatus_REGISTRATION_PENDING {
+		return nil, status.Error(codes.FailedPrecondition, "registration has already been decided")
+	}
+
+	// Lock the topic so capacity checks of concurrent decisions serialize
+	var title, topicStatus string
+	var maxStudents int32
+	err = tx.QueryRowContext(ctx, "SELECT title, status, max_students FROM Topic WHERE id = ? AND deleted_at IS NULL FOR UPDATE", registration.TopicCode).
+		Scan(&title, &topicStatus, &maxStudents)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "topic not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get topic: %v", err)
+	}
+	switch topicStatusFromString(topicStatus) {
+	case pb.TopicStatus_APPROVED_2, pb.TopicStatus_IN_PROGRESS:
+	default:
+		return nil, status.Error(codes.FailedPrecondition, "topic is not open for registration")
+	}
+
+	topicCouncilID, err := getTopicCouncilOfTopic(ctx, tx, registration.TopicCode)
+	if err != nil {
+		return nil, err
+	}
+
+	var supervisors int
+	err = tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM Topic_council_supervisor WHERE topic_council_code = ? AND teacher_supervisor_code = ? AND deleted_at IS NULL",
+		topicCouncilID, req.SupervisorCode).Scan(&supervisors)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check supervisor: %v", err)
+	}
+	if supervisors == 0 {
+		return nil, status.Error(codes.PermissionDenied, "only a supervisor of the topic can decide its registrations")
+	}
+
+	now := time.Now()
+	registration.DecidedBy = req.SupervisorCode
+	registration.DecidedAt = timestamppb.New(now)
+	registration.UpdatedAt = timestamppb.New(now)
+
+	if !req.Accept {
+		registration.Status = pb.RegistrationStatus_REGISTRATION_DECLINED
+		registration.Reason = req.Reason
+		_, err = tx.ExecContext(ctx, "UPDATE Topic_registration SET status = ?, reason = ?, decided_by = ?, decided_at = ?, updated_at = ? WHERE id = ?",
+			registrationStatusToString(registration.Status), req.Reason, req.SupervisorCode, now, now, registration.Id)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to decline registration: %v", err)
+		}
+		if err := audit.Commit(ctx, tx); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+		}
+		return &pb.DecideTopicRegistrationResponse{Registration: registration}, nil
+	}
+
+	var enrolled int32
+	err = tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM Enrollment WHERE topic_council_code = ? AND deleted_at IS NULL", topicCouncilID).Scan(&enrolled)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count enrollments: %v", err)
+	}
+	if enrolled >= maxStudents {
+		return nil, status.Errorf(codes.FailedPrecondition, "topic is full (%d/%d students)", enrolled, maxStudents)
+	}
+
+	var accepted int
+	err = tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM Topic_registration WHERE student_code = ? AND semester_code = ? AND status = ?",
+		registration.StudentCode, registration.SemesterCode,
+		registrationStatusToString(pb.RegistrationStatus_REGISTRATION_ACCEPTED)).Scan(&accepted)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check registrations: %v", err)
+	}
+	if accepted > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "student has already been accepted for another topic")
+	}
+
+	// The enrollment comes with its Midterm and Final, as in
+	// CreateEnrollmentBundle; its Grade_review waits for a reviewer
+	bundle, err := h.CreateEnrollmentBundle(inTx(ctx, tx), &pb.CreateEnrollmentBundleRequest{
+		Title:            title,
+		StudentCode:      registration.StudentCode,
+		TopicCouncilCode: topicCouncilID,
+		CreatedBy:        req.SupervisorCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+	enrollmentID := bundle.GetEnrollment().Id
+
+	registration.Status = pb.RegistrationStatus_REGISTRATION_ACCEPTED
+	registration.EnrollmentCode = enrollmentID
+	_, err = tx.ExecContext(ctx, "UPDATE Topic_registration SET status = ?, decided_by = ?, decided_at = ?, enrollment_code = ?, updated_at = ? WHERE id = ?",
+		registrationStatusToString(registration.Status), req.SupervisorCode, now, enrollmentID, now, registration.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to accept registration: %v", err)
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE Topic_registration SET status = ?, updated_at = ? WHERE student_code = ? AND semester_code = ? AND status = ? AND id <> ?",
+		registrationStatusToString(pb.RegistrationStatus_REGISTRATION_WITHDRAWN), now,
+		registration.StudentCode, registration.SemesterCode,
+		registrationStatusToString(pb.RegistrationStatus_REGISTRATION_PENDING), registration.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to withdraw other registrations: %v", err)
+	}
+
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	enrollment, err := h.GetEnrollment(ctx, &pb.GetEnrollmentRequest{Id: enrollmentID})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get enrollment")
+	}
+	return &pb.DecideTopicRegistrationResponse{
+		Registration: registration,
+		Enrollment:   enrollment.GetEnrollment(),
+	}, nil
+}
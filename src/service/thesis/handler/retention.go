@@ -0,0 +1,17 @@
+package handler
+
+import "thaily/src/pkg/retention"
+
+// SoftDeleted lists the thesis tables purged by the retention job, children
+// before their parents so enrollments go before the parts they link
+func SoftDeleted() []retention.Table {
+	return []retention.Table{
+		{Name: "Enrollment"},
+		{Name: "Midterm"},
+		{Name: "Final"},
+		{Name: "Grade_review"},
+		{Name: "Topic_council_supervisor"},
+		{Name: "Topic_council"},
+		{Name: "Topic"},
+	}
+}
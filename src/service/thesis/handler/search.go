@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pbCommon "thaily/proto/common"
+	pb "thaily/proto/thesis"
+	"thaily/src/pkg/helper"
+	"thaily/src/pkg/logger"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SearchTopics ranks topics by how well their title matches the query. The
+// title has an ngram FULLTEXT index with an accent-insensitive collation, so
+// 2-letter syllables are indexed and "he thong" also finds "hệ thống".
+func (h *Handler) SearchTopics(ctx context.Context, req *pb.SearchTopicsRequest) (*pb.SearchTopicsResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	query := helper.FullTextQuery(req.Query)
+	if query == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+
+	where := "MATCH(title) AGAINST (? IN BOOLEAN MODE) AND deleted_at IS NULL"
+	args := []interface{}{query, query}
+
+	// Visibility: in one of the statuses, or created by the caller
+	visible := []string{}
+	if len(req.Statuses) > 0 {
+		placeholders := make([]string, len(req.Statuses))
+		for i, s := range req.Statuses {
+			placeholders[i] = "?"
+			args = append(args, topicStatusToString(s))
+		}
+		visible = append(visible, fmt.Sprintf("status IN (%s)", strings.Join(placeholders, ", ")))
+	}
+	if req.CreatedBy != nil {
+		visible = append(visible, "created_by = ?")
+		args = append(args, *req.CreatedBy)
+	}
+	if len(visible) > 0 {
+		where += " AND (" + strings.Join(visible, " OR ") + ")"
+	}
+	args = append(args, helper.SearchLimit(req.Limit))
+
+	rows, err := h.query(ctx, fmt.Sprintf(`
+		SELECT id, title, status, MATCH(title) AGAINST (? IN BOOLEAN MODE) AS score
+		FROM Topic
+		WHERE %s
+		ORDER BY score DESC, id
+		LIMIT ?
+	`, where), args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search topics: %v", err)
+	}
+	defer rows.Close()
+
+	hits := []*pbCommon.SearchHit{}
+	for rows.Next() {
+		hit := &pbCommon.SearchHit{Type: "topic"}
+		if err := rows.Scan(&hit.Id, &hit.Title, &hit.Subtitle, &hit.Score); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan topic: %v", err)
+		}
+		hits = append(hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search topics: %v", err)
+	}
+
+	return &pb.SearchTopicsResponse{Hits: hits}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	pb "thaily/proto/thesis"
+	"thaily/src/pkg/audit"
 	"thaily/src/pkg/helper"
 	"thaily/src/pkg/logger"
 
@@ -30,6 +31,14 @@ func (h *Handler) CreateTopic(ctx context.Context, req *pb.CreateTopicRequest) (
 		return nil, status.Error(codes.InvalidArgument, "semester_code is required")
 	}
 
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "major_code", "Major", req.MajorCode); err != nil {
+		return nil, err
+	}
+	if err := h.refs.Require(ctx, "semester_code", "Semester", req.SemesterCode); err != nil {
+		return nil, err
+	}
+
 	// Generate UUID
 	id := uuid.New().String()
 
@@ -43,27 +52,18 @@ func (h *Handler) CreateTopic(ctx context.Context, req *pb.CreateTopicRequest) (
 		PercentStage_2 = *req.PercentStage_2
 	}
 
-	// Convert Status enum to string
-	StatusValue := pb.TopicStatus_SUBMIT
-
-	StatusValue = req.Status
-	StatusStr := "submit"
-	switch StatusValue {
-	case pb.TopicStatus_SUBMIT:
-		StatusStr = "submit"
-	case pb.TopicStatus_TOPIC_PENDING:
-		StatusStr = "topic_pending"
-	case pb.TopicStatus_APPROVED_1:
-		StatusStr = "approved_1"
-	case pb.TopicStatus_APPROVED_2:
-		StatusStr = "approved_2"
-	case pb.TopicStatus_IN_PROGRESS:
-		StatusStr = "in_progress"
-	case pb.TopicStatus_TOPIC_COMPLETED:
-		StatusStr = "topic_completed"
-	case pb.TopicStatus_REJECTED:
-		StatusStr = "rejected"
+	// Every topic starts as a draft; later statuses go through the transition RPCs
+	if req.Status != pb.TopicStatus_SUBMIT {
+		return nil, status.Error(codes.InvalidArgument, "new topics must start in SUBMIT")
+	}
+	StatusStr := topicStatusToString(pb.TopicStatus_SUBMIT)
+
+	// The topic and its first history entry are written together
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
 	}
+	defer tx.Rollback()
 
 	// Insert into database
 	query := `
@@ -71,7 +71,7 @@ func (h *Handler) CreateTopic(ctx context.Context, req *pb.CreateTopicRequest) (
 		VALUES (?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
 	`
 
-	_, err := h.execQuery(ctx, query,
+	_, err = tx.ExecContext(ctx, query,
 		id,
 		req.Title,
 		req.MajorCode,
@@ -89,6 +89,21 @@ func (h *Handler) CreateTopic(ctx context.Context, req *pb.CreateTopicRequest) (
 		return nil, status.Errorf(codes.Internal, "failed to create topic: %v", err)
 	}
 
+	err = insertTopicStatusHistory(ctx, tx, &pb.TopicStatusHistory{
+		Id:        uuid.New().String(),
+		TopicCode: id,
+		ToStatus:  pb.TopicStatus_SUBMIT,
+		CreatedBy: req.CreatedBy,
+		CreatedAt: timestamppb.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record topic history: %v", err)
+	}
+
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
 	result, err := h.GetTopic(ctx, &pb.GetTopicRequest{Id: id})
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to get topic")
@@ -107,15 +122,19 @@ func (h *Handler) GetTopic(ctx context.Context, req *pb.GetTopicRequest) (*pb.Ge
 	}
 
 	query := `
-		SELECT id, title, major_code, semester_code, status, percent_stage_1, percent_stage_2, created_at, updated_at, created_by, updated_by
+		SELECT id, title, major_code, semester_code, status, percent_stage_1, percent_stage_2, max_students, required_skills, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM Topic
 		WHERE id = ?
 	`
+	if !req.IncludeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
 
 	var entity pb.Topic
-	var createdAt, updatedAt sql.NullTime
-	var updatedBy sql.NullString
+	var createdAt, updatedAt, deletedAt sql.NullTime
+	var updatedBy, deletedBy sql.NullString
 	var StatusStr string
+	var requiredSkills string
 
 	err := h.queryRow(ctx, query, req.Id).Scan(
 		&entity.Id,
@@ -125,10 +144,15 @@ func (h *Handler) GetTopic(ctx context.Context, req *pb.GetTopicRequest) (*pb.Ge
 		&StatusStr,
 		&entity.PercentStage_1,
 		&entity.PercentStage_2,
+		&entity.MaxStudents,
+		&requiredSkills,
 		&createdAt,
 		&updatedAt,
 		&entity.CreatedBy,
 		&updatedBy,
+		&entity.Version,
+		&deletedAt,
+		&deletedBy,
 	)
 
 	if err != nil {
@@ -139,24 +163,7 @@ func (h *Handler) GetTopic(ctx context.Context, req *pb.GetTopicRequest) (*pb.Ge
 	}
 
 	// Convert Status string to enum
-	switch StatusStr {
-	case "submit":
-		entity.Status = pb.TopicStatus_SUBMIT
-	case "topic_pending":
-		entity.Status = pb.TopicStatus_TOPIC_PENDING
-	case "approved_1":
-		entity.Status = pb.TopicStatus_APPROVED_1
-	case "approved_2":
-		entity.Status = pb.TopicStatus_APPROVED_2
-	case "in_progress":
-		entity.Status = pb.TopicStatus_IN_PROGRESS
-	case "topic_completed":
-		entity.Status = pb.TopicStatus_TOPIC_COMPLETED
-	case "rejected":
-		entity.Status = pb.TopicStatus_REJECTED
-	default:
-		entity.Status = pb.TopicStatus_SUBMIT
-	}
+	entity.Status = topicStatusFromString(StatusStr)
 
 	if createdAt.Valid {
 		entity.CreatedAt = timestamppb.New(createdAt.Time)
@@ -167,6 +174,11 @@ func (h *Handler) GetTopic(ctx context.Context, req *pb.GetTopicRequest) (*pb.Ge
 	if updatedBy.Valid {
 		entity.UpdatedBy = updatedBy.String
 	}
+	if deletedAt.Valid {
+		entity.DeletedAt = timestamppb.New(deletedAt.Time)
+		entity.DeletedBy = deletedBy.String
+	}
+	entity.RequiredSkills = splitSkills(requiredSkills)
 
 	return &pb.GetTopicResponse{
 		Topic: &entity,
@@ -201,26 +213,14 @@ func (h *Handler) UpdateTopic(ctx context.Context, req *pb.UpdateTopicRequest) (
 
 	}
 	if req.Status != nil {
-		updateFields = append(updateFields, "status = ?")
-		StatusStr := "submit"
-		switch *req.Status {
-		case pb.TopicStatus_SUBMIT:
-			StatusStr = "submit"
-		case pb.TopicStatus_TOPIC_PENDING:
-			StatusStr = "topic_pending"
-		case pb.TopicStatus_APPROVED_1:
-			StatusStr = "approved_1"
-		case pb.TopicStatus_APPROVED_2:
-			StatusStr = "approved_2"
-		case pb.TopicStatus_IN_PROGRESS:
-			StatusStr = "in_progress"
-		case pb.TopicStatus_TOPIC_COMPLETED:
-			StatusStr = "topic_completed"
-		case pb.TopicStatus_REJECTED:
-			StatusStr = "rejected"
+		// A no-op status is tolerated; real changes must use the lifecycle RPCs
+		current, err := h.GetTopic(ctx, &pb.GetTopicRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		if current.GetTopic().Status != *req.Status {
+			return nil, errTopicStatusViaTransitions
 		}
-		args = append(args, StatusStr)
-
 	}
 	if req.PercentStage_1 != nil {
 		updateFields = append(updateFields, "percent_stage_1 = ?")
@@ -232,6 +232,25 @@ func (h *Handler) UpdateTopic(ctx context.Context, req *pb.UpdateTopicRequest) (
 		args = append(args, *req.PercentStage_2)
 
 	}
+	if req.MaxStudents != nil {
+		if *req.MaxStudents < 1 {
+			return nil, status.Error(codes.InvalidArgument, "max_students must be at least 1")
+		}
+		updateFields = append(updateFields, "max_students = ?")
+		args = append(args, *req.MaxStudents)
+	}
+	if len(req.RequiredSkills) > 0 {
+		updateFields = append(updateFields, "required_skills = ?")
+		args = append(args, joinSkills(req.RequiredSkills))
+	}
+
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "major_code", "Major", req.GetMajorCode()); err != nil {
+		return nil, err
+	}
+	if err := h.refs.Require(ctx, "semester_code", "Semester", req.GetSemesterCode()); err != nil {
+		return nil, err
+	}
 
 	if len(updateFields) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "no fields to update")
@@ -242,19 +261,27 @@ func (h *Handler) UpdateTopic(ctx context.Context, req *pb.UpdateTopicRequest) (
 	args = append(args, req.UpdatedBy)
 	updateFields = append(updateFields, "updated_at = NOW()")
 
-	// Add id as last parameter
-	args = append(args, req.Id)
+	// Bump the version; an expected version only matches an unchanged record
+	updateFields = append(updateFields, "version = version + 1")
+	where := helper.WhereVersion(req.Id, req.Version, &args)
 
 	query := fmt.Sprintf(`
 		UPDATE Topic
 		SET %s
-		WHERE id = ?
-	`, strings.Join(updateFields, ", "))
+		WHERE %s AND deleted_at IS NULL
+	`, strings.Join(updateFields, ", "), where)
 
-	_, err := h.execQuery(ctx, query, args...)
+	updated, err := h.execQuery(ctx, query, args...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update topic: %v", err)
 	}
+	if n, _ := updated.RowsAffected(); n == 0 {
+		current, err := h.GetTopic(ctx, &pb.GetTopicRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		return nil, helper.VersionConflict("topic", current.GetTopic(), current.GetTopic().Version)
+	}
 
 	result, err := h.GetTopic(ctx, &pb.GetTopicRequest{Id: req.Id})
 	if err != nil {
@@ -265,7 +292,7 @@ func (h *Handler) UpdateTopic(ctx context.Context, req *pb.UpdateTopicRequest) (
 	}, nil
 }
 
-// DeleteTopic deletes a Topic by ID
+// DeleteTopic soft-deletes a Topic by ID
 func (h *Handler) DeleteTopic(ctx context.Context, req *pb.DeleteTopicRequest) (*pb.DeleteTopicResponse, error) {
 	defer logger.TraceFunction(ctx)()
 
@@ -273,9 +300,10 @@ func (h *Handler) DeleteTopic(ctx context.Context, req *pb.DeleteTopicRequest) (
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	query := `DELETE FROM Topic WHERE id = ?`
+	// Soft delete; the retention job removes the row later
+	query := `UPDATE Topic SET deleted_at = NOW(), deleted_by = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL`
 
-	result, err := h.execQuery(ctx, query, req.Id)
+	result, err := h.execQuery(ctx, query, req.DeletedBy, req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete topic: %v", err)
 	}
@@ -294,6 +322,43 @@ func (h *Handler) DeleteTopic(ctx context.Context, req *pb.DeleteTopicRequest) (
 	}, nil
 }
 
+// RestoreTopic brings back a soft-deleted Topic
+func (h *Handler) RestoreTopic(ctx context.Context, req *pb.RestoreTopicRequest) (*pb.RestoreTopicResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	query := `UPDATE Topic SET deleted_at = NULL, deleted_by = NULL, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ? AND deleted_at IS NOT NULL`
+
+	result, err := h.execQuery(ctx, query, req.RestoredBy, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore topic: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		// Unknown ids are NotFound; a live record has nothing to restore
+		if _, err := h.GetTopic(ctx, &pb.GetTopicRequest{Id: req.Id}); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, "topic is not deleted")
+	}
+
+	restored, err := h.GetTopic(ctx, &pb.GetTopicRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreTopicResponse{
+		Topic: restored.GetTopic(),
+	}, nil
+}
+
 // ListTopics lists Topics with pagination and filtering
 func (h *Handler) ListTopics(ctx context.Context, req *pb.ListTopicsRequest) (*pb.ListTopicsResponse, error) {
 	defer logger.TraceFunction(ctx)()
@@ -301,8 +366,6 @@ func (h *Handler) ListTopics(ctx context.Context, req *pb.ListTopicsRequest) (*p
 	// Default pagination
 	page := int32(1)
 	pageSize := int32(10)
-	sortBy := "created_at"
-	descending := true
 	if req.Search != nil && req.Search.Pagination != nil {
 		if req.Search.Pagination.Page > 0 {
 			page = req.Search.Pagination.Page
@@ -310,54 +373,42 @@ func (h *Handler) ListTopics(ctx context.Context, req *pb.ListTopicsRequest) (*p
 		if req.Search.Pagination.PageSize > 0 {
 			pageSize = req.Search.Pagination.PageSize
 		}
-		if req.Search.Pagination.SortBy != "" {
-			sortBy = req.Search.Pagination.SortBy
-		}
-		descending = req.Search.Pagination.Descending
 	}
 
 	// Calculate offset
 	offset := (page - 1) * pageSize
 
 	// Build WHERE clause from filters
-	whereClause := ""
 	args := []interface{}{}
-	whiteMap := map[string]bool{
-		"id": true,
-
-		"title":           true,
-		"major_code":      true,
-		"semester_code":   true,
-		"status":          true,
-		"percent_stage_1": true,
-		"percent_stage_2": true,
-	}
-	if req.Search != nil && len(req.Search.Filters) > 0 {
-		whereConditions := []string{}
-		for _, filter := range req.Search.Filters {
-			if filter.GetCondition() != nil {
-				condition := filter.GetCondition()
-				if _, ok := whiteMap[condition.Field]; !ok {
-					continue
-				}
-				whereConditions = append(whereConditions, helper.BuildFilterCondition(condition, &args))
-			}
-		}
-		if len(whereConditions) > 0 {
-			whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
-		}
+	filterFields := helper.FilterFields{
+		"id":              {},
+		"title":           {FullText: true},
+		"major_code":      {},
+		"semester_code":   {},
+		"status":          {Type: helper.FieldEnum, Values: []string{"submit", "pending", "approved_1", "approved_2", "in_progress", "completed", "rejected"}, Aliases: map[string]string{"TOPIC_PENDING": "pending", "TOPIC_COMPLETED": "completed"}},
+		"percent_stage_1": {Type: helper.FieldInt},
+		"percent_stage_2": {Type: helper.FieldInt},
+		"max_students":    {Type: helper.FieldInt},
+		"created_by":      {},
+		"created_at":      {Type: helper.FieldTime},
+		"updated_at":      {Type: helper.FieldTime},
+	}
+	whereClause, err := helper.BuildWhere(req.Search.GetFilters(), filterFields, &args)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
+	whereClause = helper.NotDeleted(whereClause, req.IncludeDeleted)
 
 	// Build ORDER BY clause
-	sortDirection := "ASC"
-	if descending {
-		sortDirection = "DESC"
+	orderBy, err := helper.BuildOrderBy(req.Search.GetPagination(), filterFields)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %v", err)
 	}
 
 	// Get total count
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM Topic %s", whereClause)
 	var total int32
-	err := h.queryRow(ctx, countQuery, args...).Scan(&total)
+	err = h.queryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to count topics: %v", err)
 	}
@@ -365,12 +416,12 @@ func (h *Handler) ListTopics(ctx context.Context, req *pb.ListTopicsRequest) (*p
 	// Get entities with pagination
 	args = append(args, pageSize, offset)
 	query := fmt.Sprintf(`
-		SELECT id, title, major_code, semester_code, status, percent_stage_1, percent_stage_2, created_at, updated_at, created_by, updated_by
+		SELECT id, title, major_code, semester_code, status, percent_stage_1, percent_stage_2, max_students, required_skills, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM Topic
 		%s
-		ORDER BY %s %s
+		%s
 		LIMIT ? OFFSET ?
-	`, whereClause, sortBy, sortDirection)
+	`, whereClause, orderBy)
 
 	rows, err := h.query(ctx, query, args...)
 	if err != nil {
@@ -381,9 +432,10 @@ func (h *Handler) ListTopics(ctx context.Context, req *pb.ListTopicsRequest) (*p
 	entities := []*pb.Topic{}
 	for rows.Next() {
 		var entity pb.Topic
-		var createdAt, updatedAt sql.NullTime
-		var updatedBy sql.NullString
+		var createdAt, updatedAt, deletedAt sql.NullTime
+		var updatedBy, deletedBy sql.NullString
 		var StatusStr string
+		var requiredSkills string
 
 		err := rows.Scan(
 			&entity.Id,
@@ -393,34 +445,22 @@ func (h *Handler) ListTopics(ctx context.Context, req *pb.ListTopicsRequest) (*p
 			&StatusStr,
 			&entity.PercentStage_1,
 			&entity.PercentStage_2,
+			&entity.MaxStudents,
+			&requiredSkills,
 			&createdAt,
 			&updatedAt,
 			&entity.CreatedBy,
 			&updatedBy,
+			&entity.Version,
+			&deletedAt,
+			&deletedBy,
 		)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan topic: %v", err)
 		}
 
 		// Convert Status string to enum
-		switch StatusStr {
-		case "submit":
-			entity.Status = pb.TopicStatus_SUBMIT
-		case "topic_pending":
-			entity.Status = pb.TopicStatus_TOPIC_PENDING
-		case "approved_1":
-			entity.Status = pb.TopicStatus_APPROVED_1
-		case "approved_2":
-			entity.Status = pb.TopicStatus_APPROVED_2
-		case "in_progress":
-			entity.Status = pb.TopicStatus_IN_PROGRESS
-		case "topic_completed":
-			entity.Status = pb.TopicStatus_TOPIC_COMPLETED
-		case "rejected":
-			entity.Status = pb.TopicStatus_REJECTED
-		default:
-			entity.Status = pb.TopicStatus_SUBMIT
-		}
+		entity.Status = topicStatusFromString(StatusStr)
 
 		if createdAt.Valid {
 			entity.CreatedAt = timestamppb.New(createdAt.Time)
@@ -431,6 +471,11 @@ func (h *Handler) ListTopics(ctx context.Context, req *pb.ListTopicsRequest) (*p
 		if updatedBy.Valid {
 			entity.UpdatedBy = updatedBy.String
 		}
+		if deletedAt.Valid {
+			entity.DeletedAt = timestamppb.New(deletedAt.Time)
+			entity.DeletedBy = deletedBy.String
+		}
+		entity.RequiredSkills = splitSkills(requiredSkills)
 
 		entities = append(entities, &entity)
 	}
@@ -0,0 +1,311 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	pbEvent "thaily/proto/event"
+	pb "thaily/proto/thesis"
+	"thaily/src/pkg/audit"
+	"thaily/src/pkg/logger"
+	"thaily/src/pkg/outbox"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// topicTransitions is the topic lifecycle: the statuses each status may move to.
+//
+//	SUBMIT -> TOPIC_PENDING -> APPROVED_1 -> APPROVED_2 -> IN_PROGRESS -> TOPIC_COMPLETED
+//	TOPIC_PENDING, APPROVED_1 -> REJECTED -> TOPIC_PENDING (resubmission)
+var topicTransitions = map[pb.TopicStatus][]pb.TopicStatus{
+	pb.TopicStatus_SUBMIT:        {pb.TopicStatus_TOPIC_PENDING},
+	pb.TopicStatus_TOPIC_PENDING: {pb.TopicStatus_APPROVED_1, pb.TopicStatus_REJECTED},
+	pb.TopicStatus_APPROVED_1:    {pb.TopicStatus_APPROVED_2, pb.TopicStatus_REJECTED},
+	pb.TopicStatus_APPROVED_2:    {pb.TopicStatus_IN_PROGRESS},
+	pb.TopicStatus_IN_PROGRESS:   {pb.TopicStatus_TOPIC_COMPLETED},
+	pb.TopicStatus_REJECTED:      {pb.TopicStatus_TOPIC_PENDING},
+}
+
+// canTransitionTopic reports whether the lifecycle allows from -> to
+func canTransitionTopic(from, to pb.TopicStatus) bool {
+	for _, next := range topicTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+func topicStatusToString(s pb.TopicStatus) string {
+	switch s {
+	case pb.TopicStatus_TOPIC_PENDING:
+		return "pending"
+	case pb.TopicStatus_APPROVED_1:
+		return "approved_1"
+	case pb.TopicStatus_APPROVED_2:
+		return "approved_2"
+	case pb.TopicStatus_IN_PROGRESS:
+		return "in_progress"
+	case pb.TopicStatus_TOPIC_COMPLETED:
+		return "completed"
+	case pb.TopicStatus_REJECTED:
+		return "rejected"
+	default:
+		return "submit"
+	}
+}
+
+func topicStatusFromString(s string) pb.TopicStatus {
+	switch s {
+	case "pending":
+		return pb.TopicStatus_TOPIC_PENDING
+	case "approved_1":
+		return pb.TopicStatus_APPROVED_1
+	case "approved_2":
+		return pb.TopicStatus_APPROVED_2
+	case "in_progress":
+		return pb.TopicStatus_IN_PROGRESS
+	case "completed":
+		return pb.TopicStatus_TOPIC_COMPLETED
+	case "rejected":
+		return pb.TopicStatus_REJECTED
+	default:
+		return pb.TopicStatus_SUBMIT
+	}
+}
+
+// insertTopicStatusHistory records one lifecycle entry
+func insertTopicStatusHistory(ctx context.Context, db dbtx, entry *pb.TopicStatusHistory) error {
+	var from sql.NullString
+	if entry.FromStatus != nil {
+		from = sql.NullString{String: topicStatusToString(*entry.FromStatus), Valid: true}
+	}
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO Topic_status_history (id, topic_code, from_status, to_status, reason, created_by, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`, entry.Id, entry.TopicCode, from, topicStatusToString(entry.ToStatus), entry.Reason, entry.CreatedBy, entry.CreatedAt.AsTime())
+	return err
+}
+
+// transitionTopic moves a topic to a new status if the lifecycle allows it and
+// records the change, with its TopicStatusChanged event. The row is locked so concurrent transitions serialize.
+// When expected is set, the topic must also be in that status.
+func (h *Handler) transitionTopic(ctx context.Context, id string, expected *pb.TopicStatus, to pb.TopicStatus, actor, reason string) (*pb.Topic, *pb.TopicStatusHistory, error) {
+	if id == "" {
+		return nil, nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if actor == "" {
+		return nil, nil, status.Error(codes.InvalidArgument, "actor is required")
+	}
+
+	tx, err := audit.BeginTx(ctx, h.db)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var current string
+	err = tx.QueryRowContext(ctx, "SELECT status FROM Topic WHERE id = ? AND deleted_at IS NULL FOR UPDATE", id).Scan(&current)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil, status.Error(codes.NotFound, "topic not found")
+		}
+		return nil, nil, status.Errorf(codes.Internal, "failed to get topic: %v", err)
+	}
+
+	from := topicStatusFromString(current)
+	if expected != nil && from != *expected {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "topic is %s, not %s", from, *expected)
+	}
+	if !canTransitionTopic(from, to) {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "topic cannot move from %s to %s", from, to)
+	}
+
+	now := time.Now()
+	_, err = tx.ExecContext(ctx, "UPDATE Topic SET status = ?, updated_by = ?, updated_at = ?, version = version + 1 WHERE id = ?",
+		topicStatusToString(to), actor, now, id)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to update topic: %v", err)
+	}
+
+	entry := &pb.TopicStatusHistory{
+		Id:         uuid.New().String(),
+		TopicCode:  id,
+		FromStatus: &from,
+		ToStatus:   to,
+		Reason:     reason,
+		CreatedBy:  actor,
+		CreatedAt:  timestamppb.New(now),
+	}
+	if err := insertTopicStatusHistory(ctx, tx, entry); err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to record topic history: %v", err)
+	}
+
+	err = outbox.Write(ctx, tx, &pbEvent.Event{
+		Aggregate:   "Topic",
+		AggregateId: id,
+		Actor:       actor,
+		OccurredAt:  timestamppb.New(now),
+		Payload: &pbEvent.Event_TopicStatusChanged{TopicStatusChanged: &pbEvent.TopicStatusChanged{
+			TopicId:    id,
+			FromStatus: current,
+			ToStatus:   topicStatusToString(to),
+			Reason:     reason,
+		}},
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to record topic event: %v", err)
+	}
+
+	if err := audit.Commit(ctx, tx); err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	result, err := h.GetTopic(ctx, &pb.GetTopicRequest{Id: id})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, "failed to get topic")
+	}
+	return result.GetTopic(), entry, nil
+}
+
+// SubmitTopic sends a topic for approval; a rejected topic is resubmitted the same way
+func (h *Handler) SubmitTopic(ctx context.Context, req *pb.SubmitTopicRequest) (*pb.SubmitTopicResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	// Every invited co-supervisor must have answered before the proposal is reviewed
+	pending, err := h.pendingCoSigns(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check co-signatures: %v", err)
+	}
+	if pending > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "%d co-supervisor(s) have not signed yet", pending)
+	}
+
+	topic, entry, err := h.transitionTopic(ctx, req.Id, nil, pb.TopicStatus_TOPIC_PENDING, req.Actor, "")
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SubmitTopicResponse{Topic: topic, History: entry}, nil
+}
+
+// ApproveTopic records approval stage 1 (department) or stage 2 (academic affairs)
+func (h *Handler) ApproveTopic(ctx context.Context, req *pb.ApproveTopicRequest) (*pb.ApproveTopicResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	var to pb.TopicStatus
+	switch req.Stage {
+	case 1:
+		to = pb.TopicStatus_APPROVED_1
+	case 2:
+		to = pb.TopicStatus_APPROVED_2
+	default:
+		return nil, status.Error(codes.InvalidArgument, "stage must be 1 or 2")
+	}
+
+	topic, entry, err := h.transitionTopic(ctx, req.Id, nil, to, req.Actor, req.Note)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ApproveTopicResponse{Topic: topic, History: entry}, nil
+}
+
+// RejectTopic rejects a topic at approval stage 1 (department), while it is
+// pending, or stage 2 (academic affairs), once approved at stage 1; the reason
+// is shown to its author
+func (h *Handler) RejectTopic(ctx context.Context, req *pb.RejectTopicRequest) (*pb.RejectTopicResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+
+	var from pb.TopicStatus
+	switch req.Stage {
+	case 1:
+		from = pb.TopicStatus_TOPIC_PENDING
+	case 2:
+		from = pb.TopicStatus_APPROVED_1
+	default:
+		return nil, status.Error(codes.InvalidArgument, "stage must be 1 or 2")
+	}
+
+	topic, entry, err := h.transitionTopic(ctx, req.Id, &from, pb.TopicStatus_REJECTED, req.Actor, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RejectTopicResponse{Topic: topic, History: entry}, nil
+}
+
+// StartTopic starts work on a fully approved topic
+func (h *Handler) StartTopic(ctx context.Context, req *pb.StartTopicRequest) (*pb.StartTopicResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	topic, entry, err := h.transitionTopic(ctx, req.Id, nil, pb.TopicStatus_IN_PROGRESS, req.Actor, "")
+	if err != nil {
+		return nil, err
+	}
+	return &pb.StartTopicResponse{Topic: topic, History: entry}, nil
+}
+
+// CompleteTopic closes a topic in progress
+func (h *Handler) CompleteTopic(ctx context.Context, req *pb.CompleteTopicRequest) (*pb.CompleteTopicResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	topic, entry, err := h.transitionTopic(ctx, req.Id, nil, pb.TopicStatus_TOPIC_COMPLETED, req.Actor, "")
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CompleteTopicResponse{Topic: topic, History: entry}, nil
+}
+
+// ListTopicStatusHistory lists the lifecycle of a topic, oldest first
+func (h *Handler) ListTopicStatusHistory(ctx context.Context, req *pb.ListTopicStatusHistoryRequest) (*pb.ListTopicStatusHistoryResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.TopicId == "" {
+		return nil, status.Error(codes.InvalidArgument, "topic_id is required")
+	}
+
+	rows, err := h.query(ctx, `
+		SELECT id, topic_code, from_status, to_status, reason, created_by, created_at
+		FROM Topic_status_history
+		WHERE topic_code = ?
+		ORDER BY created_at ASC, id ASC
+	`, req.TopicId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list topic history: %v", err)
+	}
+	defer rows.Close()
+
+	history := []*pb.TopicStatusHistory{}
+	for rows.Next() {
+		var entry pb.TopicStatusHistory
+		var from, reason sql.NullString
+		var to string
+		var createdAt time.Time
+		if err := rows.Scan(&entry.Id, &entry.TopicCode, &from, &to, &reason, &entry.CreatedBy, &createdAt); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan topic history: %v", err)
+		}
+		if from.Valid {
+			fromStatus := topicStatusFromString(from.String)
+			entry.FromStatus = &fromStatus
+		}
+		entry.ToStatus = topicStatusFromString(to)
+		entry.Reason = reason.String
+		entry.CreatedAt = timestamppb.New(createdAt)
+		history = append(history, &entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list topic history: %v", err)
+	}
+
+	return &pb.ListTopicStatusHistoryResponse{History: history}, nil
+}
+
+// errTopicStatusViaTransitions is returned when UpdateTopic is asked to change the status
+var errTopicStatusViaTransitions = status.Error(codes.FailedPrecondition,
+	"topic status can only change through SubmitTopic, ApproveTopic, RejectTopic, StartTopic or CompleteTopic")
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	pbCouncil "thaily/proto/council"
 	pb "thaily/proto/thesis"
 	"thaily/src/pkg/helper"
 	"thaily/src/pkg/logger"
@@ -26,6 +27,9 @@ func (h *Handler) CreateTopicCouncil(ctx context.Context, req *pb.CreateTopicCou
 	if req.TopicCode == "" {
 		return nil, status.Error(codes.InvalidArgument, "topic_code is required")
 	}
+	if req.TimeStart == nil || req.TimeEnd == nil {
+		return nil, status.Error(codes.InvalidArgument, "time_start and time_end are required")
+	}
 
 	// Generate UUID
 	id := uuid.New().String()
@@ -37,21 +41,17 @@ func (h *Handler) CreateTopicCouncil(ctx context.Context, req *pb.CreateTopicCou
 	}
 
 	// Convert Stage enum to string
-	StageValue := pb.TopicStage_STAGE_DACN
+	StageStr := stageToString(req.Stage)
 
-	StageValue = req.Stage
-	StageStr := "stage_dacn"
-	switch StageValue {
-	case pb.TopicStage_STAGE_DACN:
-		StageStr = "stage_dacn"
-	case pb.TopicStage_STAGE_LVTN:
-		StageStr = "stage_lvtn"
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "council_code", "Council", CouncilCode); err != nil {
+		return nil, err
 	}
 
 	// Insert into database
 	query := `
-		INSERT INTO TopicCouncil (id, title, stage, topic_code, council_code, created_by, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, NOW(), NOW())
+		INSERT INTO Topic_council (id, title, stage, topic_code, council_code, time_start, time_end, created_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
 	`
 
 	_, err := h.execQuery(ctx, query,
@@ -60,6 +60,8 @@ func (h *Handler) CreateTopicCouncil(ctx context.Context, req *pb.CreateTopicCou
 		StageStr,
 		req.TopicCode,
 		CouncilCode,
+		req.TimeStart.AsTime(),
+		req.TimeEnd.AsTime(),
 		req.CreatedBy,
 	)
 
@@ -88,14 +90,17 @@ func (h *Handler) GetTopicCouncil(ctx context.Context, req *pb.GetTopicCouncilRe
 	}
 
 	query := `
-		SELECT id, title, stage, topic_code, council_code, created_at, updated_at, created_by, updated_by
-		FROM TopicCouncil
+		SELECT id, title, stage, topic_code, council_code, time_start, time_end, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
+		FROM Topic_council
 		WHERE id = ?
 	`
+	if !req.IncludeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
 
 	var entity pb.TopicCouncil
-	var createdAt, updatedAt sql.NullTime
-	var updatedBy sql.NullString
+	var timeStart, timeEnd, createdAt, updatedAt, deletedAt sql.NullTime
+	var updatedBy, deletedBy sql.NullString
 	var StageStr string
 
 	err := h.queryRow(ctx, query, req.Id).Scan(
@@ -104,10 +109,15 @@ func (h *Handler) GetTopicCouncil(ctx context.Context, req *pb.GetTopicCouncilRe
 		&StageStr,
 		&entity.TopicCode,
 		&entity.CouncilCode,
+		&timeStart,
+		&timeEnd,
 		&createdAt,
 		&updatedAt,
 		&entity.CreatedBy,
 		&updatedBy,
+		&entity.Version,
+		&deletedAt,
+		&deletedBy,
 	)
 
 	if err != nil {
@@ -118,15 +128,14 @@ func (h *Handler) GetTopicCouncil(ctx context.Context, req *pb.GetTopicCouncilRe
 	}
 
 	// Convert Stage string to enum
-	switch StageStr {
-	case "stage_dacn":
-		entity.Stage = pb.TopicStage_STAGE_DACN
-	case "stage_lvtn":
-		entity.Stage = pb.TopicStage_STAGE_LVTN
-	default:
-		entity.Stage = pb.TopicStage_STAGE_DACN
-	}
+	entity.Stage = stageFromString(StageStr)
 
+	if timeStart.Valid {
+		entity.TimeStart = timestamppb.New(timeStart.Time)
+	}
+	if timeEnd.Valid {
+		entity.TimeEnd = timestamppb.New(timeEnd.Time)
+	}
 	if createdAt.Valid {
 		entity.CreatedAt = timestamppb.New(createdAt.Time)
 	}
@@ -136,6 +145,10 @@ func (h *Handler) GetTopicCouncil(ctx context.Context, req *pb.GetTopicCouncilRe
 	if updatedBy.Valid {
 		entity.UpdatedBy = updatedBy.String
 	}
+	if deletedAt.Valid {
+		entity.DeletedAt = timestamppb.New(deletedAt.Time)
+		entity.DeletedBy = deletedBy.String
+	}
 
 	return &pb.GetTopicCouncilResponse{
 		TopicCouncil: &entity,
@@ -161,13 +174,7 @@ func (h *Handler) UpdateTopicCouncil(ctx context.Context, req *pb.UpdateTopicCou
 	}
 	if req.Stage != nil {
 		updateFields = append(updateFields, "stage = ?")
-		StageStr := "stage_dacn"
-		switch *req.Stage {
-		case pb.TopicStage_STAGE_DACN:
-			StageStr = "stage_dacn"
-		case pb.TopicStage_STAGE_LVTN:
-			StageStr = "stage_lvtn"
-		}
+		StageStr := stageToString(*req.Stage)
 		args = append(args, StageStr)
 
 	}
@@ -181,6 +188,24 @@ func (h *Handler) UpdateTopicCouncil(ctx context.Context, req *pb.UpdateTopicCou
 		args = append(args, *req.CouncilCode)
 
 	}
+	if req.TimeStart != nil {
+		updateFields = append(updateFields, "time_start = ?")
+		args = append(args, req.TimeStart.AsTime())
+
+	}
+	if req.TimeEnd != nil {
+		updateFields = append(updateFields, "time_end = ?")
+		args = append(args, req.TimeEnd.AsTime())
+
+	}
+	if req.TimeStart != nil && req.TimeEnd != nil && !req.TimeEnd.AsTime().After(req.TimeStart.AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "time_end must be after time_start")
+	}
+
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "council_code", "Council", req.GetCouncilCode()); err != nil {
+		return nil, err
+	}
 
 	if len(updateFields) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "no fields to update")
@@ -191,18 +216,47 @@ func (h *Handler) UpdateTopicCouncil(ctx context.Context, req *pb.UpdateTopicCou
 	args = append(args, req.UpdatedBy)
 	updateFields = append(updateFields, "updated_at = NOW()")
 
-	// Add id as last parameter
-	args = append(args, req.Id)
+	// Bump the version; an expected version only matches an unchanged record
+	updateFields = append(updateFields, "version = version + 1")
+	where := helper.WhereVersion(req.Id, req.Version, &args)
 
 	query := fmt.Sprintf(`
-		UPDATE TopicCouncil
+		UPDATE Topic_council
 		SET %s
-		WHERE id = ?
-	`, strings.Join(updateFields, ", "))
+		WHERE %s AND deleted_at IS NULL
+	`, strings.Join(updateFields, ", "), where)
+
+	// A new council is only kept once the council service admits the topic
+	// council, which it does last so a refusal rolls the update back
+	err := h.withTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		var councilCode string
+		err := tx.QueryRowContext(ctx, `SELECT council_code FROM Topic_council WHERE id = ? AND deleted_at IS NULL FOR UPDATE`, req.Id).Scan(&councilCode)
+		if err == sql.ErrNoRows {
+			return status.Error(codes.NotFound, "topiccouncil not found")
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get topiccouncil: %v", err)
+		}
 
-	_, err := h.execQuery(ctx, query, args...)
+		updated, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to update topiccouncil: %v", err)
+		}
+		if n, _ := updated.RowsAffected(); n == 0 {
+			current, err := h.GetTopicCouncil(ctx, &pb.GetTopicCouncilRequest{Id: req.Id})
+			if err != nil {
+				return err
+			}
+			return helper.VersionConflict("topic council", current.GetTopicCouncil(), current.GetTopicCouncil().Version)
+		}
+
+		if req.GetCouncilCode() == "" || req.GetCouncilCode() == councilCode {
+			return nil
+		}
+		return h.admitTopicCouncil(ctx, req.Id, req.GetCouncilCode(), req.OverrideReason, req.UpdatedBy)
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update topiccouncil: %v", err)
+		return nil, err
 	}
 
 	result, err := h.GetTopicCouncil(ctx, &pb.GetTopicCouncilRequest{Id: req.Id})
@@ -214,7 +268,70 @@ func (h *Handler) UpdateTopicCouncil(ctx context.Context, req *pb.UpdateTopicCou
 	}, nil
 }
 
-// DeleteTopicCouncil deletes a TopicCouncil by ID
+// admitTopicCouncil has the council service check the conflicts of interest
+// the topic council's supervisors and reviewers bring to councilCode, and
+// record their override when a reason is given
+func (h *Handler) admitTopicCouncil(ctx context.Context, id, councilCode string, overrideReason *string, actor string) error {
+	conn := h.refs.Conn("Council")
+	if conn == nil {
+		return status.Error(codes.FailedPrecondition, "the council service is not configured to check conflicts of interest")
+	}
+
+	staff, err := h.ListCouncilTopics(ctx, &pb.ListCouncilTopicsRequest{TopicCouncilCodes: []string{id}})
+	if err != nil {
+		return err
+	}
+	topic := &pbCouncil.CouncilTopic{TopicCouncilCode: id}
+	for _, t := range staff.Topics {
+		topic.SupervisorCodes = append(topic.SupervisorCodes, t.SupervisorCodes...)
+		topic.ReviewerCodes = append(topic.ReviewerCodes, t.ReviewerCodes...)
+	}
+
+	_, err = pbCouncil.NewCouncilServiceClient(conn).AdmitTopicCouncil(ctx, &pbCouncil.AdmitTopicCouncilRequest{
+		CouncilCode:    councilCode,
+		Topic:          topic,
+		OverrideReason: overrideReason,
+		Actor:          actor,
+	})
+	return err
+}
+
+// ScheduleTopicCouncils writes the council and time of every topic council of
+// a timetable in one transaction, so a failing one leaves all unchanged
+func (h *Handler) ScheduleTopicCouncils(ctx context.Context, req *pb.ScheduleTopicCouncilsRequest) (*pb.ScheduleTopicCouncilsResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	for _, slot := range req.TopicCouncils {
+		if slot.Id == "" || slot.CouncilCode == "" || slot.TimeStart == nil || slot.TimeEnd == nil {
+			return nil, status.Error(codes.InvalidArgument, "every slot needs id, council_code, time_start and time_end")
+		}
+	}
+
+	resp := &pb.ScheduleTopicCouncilsResponse{}
+	err := h.withTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		for _, slot := range req.TopicCouncils {
+			updated, err := h.UpdateTopicCouncil(ctx, &pb.UpdateTopicCouncilRequest{
+				Id:          slot.Id,
+				CouncilCode: &slot.CouncilCode,
+				TimeStart:   slot.TimeStart,
+				TimeEnd:     slot.TimeEnd,
+				UpdatedBy:   req.UpdatedBy,
+				Version:     slot.Version,
+			})
+			if err != nil {
+				return err
+			}
+			resp.TopicCouncils = append(resp.TopicCouncils, updated.GetTopicCouncil())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteTopicCouncil soft-deletes a TopicCouncil by ID
 func (h *Handler) DeleteTopicCouncil(ctx context.Context, req *pb.DeleteTopicCouncilRequest) (*pb.DeleteTopicCouncilResponse, error) {
 	defer logger.TraceFunction(ctx)()
 
@@ -222,9 +339,10 @@ func (h *Handler) DeleteTopicCouncil(ctx context.Context, req *pb.DeleteTopicCou
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	query := `DELETE FROM TopicCouncil WHERE id = ?`
+	// Soft delete; the retention job removes the row later
+	query := `UPDATE Topic_council SET deleted_at = NOW(), deleted_by = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL`
 
-	result, err := h.execQuery(ctx, query, req.Id)
+	result, err := h.execQuery(ctx, query, req.DeletedBy, req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete topiccouncil: %v", err)
 	}
@@ -243,6 +361,43 @@ func (h *Handler) DeleteTopicCouncil(ctx context.Context, req *pb.DeleteTopicCou
 	}, nil
 }
 
+// RestoreTopicCouncil brings back a soft-deleted TopicCouncil
+func (h *Handler) RestoreTopicCouncil(ctx context.Context, req *pb.RestoreTopicCouncilRequest) (*pb.RestoreTopicCouncilResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	query := `UPDATE Topic_council SET deleted_at = NULL, deleted_by = NULL, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ? AND deleted_at IS NOT NULL`
+
+	result, err := h.execQuery(ctx, query, req.RestoredBy, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore topic council: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		// Unknown ids are NotFound; a live record has nothing to restore
+		if _, err := h.GetTopicCouncil(ctx, &pb.GetTopicCouncilRequest{Id: req.Id}); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, "topic council is not deleted")
+	}
+
+	restored, err := h.GetTopicCouncil(ctx, &pb.GetTopicCouncilRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreTopicCouncilResponse{
+		TopicCouncil: restored.GetTopicCouncil(),
+	}, nil
+}
+
 // ListTopicCouncils lists TopicCouncils with pagination and filtering
 func (h *Handler) ListTopicCouncils(ctx context.Context, req *pb.ListTopicCouncilsRequest) (*pb.ListTopicCouncilsResponse, error) {
 	defer logger.TraceFunction(ctx)()
@@ -250,8 +405,6 @@ func (h *Handler) ListTopicCouncils(ctx context.Context, req *pb.ListTopicCounci
 	// Default pagination
 	page := int32(1)
 	pageSize := int32(10)
-	sortBy := "created_at"
-	descending := true
 	if req.Search != nil && req.Search.Pagination != nil {
 		if req.Search.Pagination.Page > 0 {
 			page = req.Search.Pagination.Page
@@ -259,52 +412,40 @@ func (h *Handler) ListTopicCouncils(ctx context.Context, req *pb.ListTopicCounci
 		if req.Search.Pagination.PageSize > 0 {
 			pageSize = req.Search.Pagination.PageSize
 		}
-		if req.Search.Pagination.SortBy != "" {
-			sortBy = req.Search.Pagination.SortBy
-		}
-		descending = req.Search.Pagination.Descending
 	}
 
 	// Calculate offset
 	offset := (page - 1) * pageSize
 
 	// Build WHERE clause from filters
-	whereClause := ""
 	args := []interface{}{}
-	whiteMap := map[string]bool{
-		"id": true,
-
-		"title":        true,
-		"stage":        true,
-		"topic_code":   true,
-		"council_code": true,
-	}
-	if req.Search != nil && len(req.Search.Filters) > 0 {
-		whereConditions := []string{}
-		for _, filter := range req.Search.Filters {
-			if filter.GetCondition() != nil {
-				condition := filter.GetCondition()
-				if _, ok := whiteMap[condition.Field]; !ok {
-					continue
-				}
-				whereConditions = append(whereConditions, helper.BuildFilterCondition(condition, &args))
-			}
-		}
-		if len(whereConditions) > 0 {
-			whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
-		}
+	filterFields := helper.FilterFields{
+		"id":           {},
+		"title":        {},
+		"stage":        {Type: helper.FieldEnum, Values: []string{"stage_dacn", "stage_lvtn"}},
+		"topic_code":   {},
+		"council_code": {},
+		"time_start":   {Type: helper.FieldTime},
+		"time_end":     {Type: helper.FieldTime},
+		"created_at":   {Type: helper.FieldTime},
+		"updated_at":   {Type: helper.FieldTime},
+	}
+	whereClause, err := helper.BuildWhere(req.Search.GetFilters(), filterFields, &args)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
+	whereClause = helper.NotDeleted(whereClause, req.IncludeDeleted)
 
 	// Build ORDER BY clause
-	sortDirection := "ASC"
-	if descending {
-		sortDirection = "DESC"
+	orderBy, err := helper.BuildOrderBy(req.Search.GetPagination(), filterFields)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %v", err)
 	}
 
 	// Get total count
-	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM TopicCouncil %s", whereClause)
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM Topic_council %s", whereClause)
 	var total int32
-	err := h.queryRow(ctx, countQuery, args...).Scan(&total)
+	err = h.queryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to count topiccouncils: %v", err)
 	}
@@ -312,12 +453,12 @@ func (h *Handler) ListTopicCouncils(ctx context.Context, req *pb.ListTopicCounci
 	// Get entities with pagination
 	args = append(args, pageSize, offset)
 	query := fmt.Sprintf(`
-		SELECT id, title, stage, topic_code, council_code, created_at, updated_at, created_by, updated_by
-		FROM TopicCouncil
+		SELECT id, title, stage, topic_code, council_code, time_start, time_end, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
+		FROM Topic_council
+		%s
 		%s
-		ORDER BY %s %s
 		LIMIT ? OFFSET ?
-	`, whereClause, sortBy, sortDirection)
+	`, whereClause, orderBy)
 
 	rows, err := h.query(ctx, query, args...)
 	if err != nil {
@@ -328,8 +469,8 @@ func (h *Handler) ListTopicCouncils(ctx context.Context, req *pb.ListTopicCounci
 	entities := []*pb.TopicCouncil{}
 	for rows.Next() {
 		var entity pb.TopicCouncil
-		var createdAt, updatedAt sql.NullTime
-		var updatedBy sql.NullString
+		var timeStart, timeEnd, createdAt, updatedAt, deletedAt sql.NullTime
+		var updatedBy, deletedBy sql.NullString
 		var StageStr string
 
 		err := rows.Scan(
@@ -338,25 +479,29 @@ func (h *Handler) ListTopicCouncils(ctx context.Context, req *pb.ListTopicCounci
 			&StageStr,
 			&entity.TopicCode,
 			&entity.CouncilCode,
+			&timeStart,
+			&timeEnd,
 			&createdAt,
 			&updatedAt,
 			&entity.CreatedBy,
 			&updatedBy,
+			&entity.Version,
+			&deletedAt,
+			&deletedBy,
 		)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan topiccouncil: %v", err)
 		}
 
 		// Convert Stage string to enum
-		switch StageStr {
-		case "stage_dacn":
-			entity.Stage = pb.TopicStage_STAGE_DACN
-		case "stage_lvtn":
-			entity.Stage = pb.TopicStage_STAGE_LVTN
-		default:
-			entity.Stage = pb.TopicStage_STAGE_DACN
-		}
+		entity.Stage = stageFromString(StageStr)
 
+		if timeStart.Valid {
+			entity.TimeStart = timestamppb.New(timeStart.Time)
+		}
+		if timeEnd.Valid {
+			entity.TimeEnd = timestamppb.New(timeEnd.Time)
+		}
 		if createdAt.Valid {
 			entity.CreatedAt = timestamppb.New(createdAt.Time)
 		}
@@ -366,6 +511,10 @@ func (h *Handler) ListTopicCouncils(ctx context.Context, req *pb.ListTopicCounci
 		if updatedBy.Valid {
 			entity.UpdatedBy = updatedBy.String
 		}
+		if deletedAt.Valid {
+			entity.DeletedAt = timestamppb.New(deletedAt.Time)
+			entity.DeletedBy = deletedBy.String
+		}
 
 		entities = append(entities, &entity)
 	}
@@ -381,3 +530,98 @@ func (h *Handler) ListTopicCouncils(ctx context.Context, req *pb.ListTopicCounci
 		PageSize:      pageSize,
 	}, nil
 }
+
+// ListCouncilTopics lists the supervisors and reviewers of the topic councils
+// assigned to a council, and of those about to be, for the council service's
+// conflict checks
+func (h *Handler) ListCouncilTopics(ctx context.Context, req *pb.ListCouncilTopicsRequest) (*pb.ListCouncilTopicsResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	topics := map[string]*pb.CouncilTopicStaff{}
+	topicCodes := []string{}
+	add := func(code string) {
+		if code == "" || topics[code] != nil {
+			return
+		}
+		topics[code] = &pb.CouncilTopicStaff{TopicCouncilCode: code}
+		topicCodes = append(topicCodes, code)
+	}
+
+	if req.CouncilCode != "" {
+		rows, err := h.query(ctx, `SELECT id FROM Topic_council WHERE council_code = ? AND deleted_at IS NULL`, req.CouncilCode)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list topic councils: %v", err)
+		}
+		for rows.Next() {
+			var code string
+			if err := rows.Scan(&code); err != nil {
+				rows.Close()
+				return nil, status.Errorf(codes.Internal, "failed to scan topic council: %v", err)
+			}
+			add(code)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, status.Errorf(codes.Internal, "error iterating topic councils: %v", err)
+		}
+	}
+	for _, code := range req.TopicCouncilCodes {
+		add(code)
+	}
+	if len(topicCodes) == 0 {
+		return &pb.ListCouncilTopicsResponse{}, nil
+	}
+
+	placeholders := make([]string, len(topicCodes))
+	args := make([]interface{}, len(topicCodes))
+	for i, code := range topicCodes {
+		placeholders[i] = "?"
+		args[i] = code
+	}
+	in := strings.Join(placeholders, ", ")
+
+	staff := []struct {
+		query  string
+		assign func(topic *pb.CouncilTopicStaff, teacher string)
+	}{
+		{
+			query: `SELECT topic_council_code, teacher_supervisor_code FROM Topic_council_supervisor
+				WHERE topic_council_code IN (` + in + `) AND deleted_at IS NULL`,
+			assign: func(topic *pb.CouncilTopicStaff, teacher string) {
+				topic.SupervisorCodes = append(topic.SupervisorCodes, teacher)
+			},
+		},
+		{
+			query: `SELECT e.topic_council_code, gr.teacher_code FROM Enrollment e
+				JOIN Grade_review gr ON gr.id = e.grade_review_code AND gr.deleted_at IS NULL
+				WHERE e.topic_council_code IN (` + in + `) AND e.deleted_at IS NULL AND gr.teacher_code <> ''`,
+			assign: func(topic *pb.CouncilTopicStaff, teacher string) {
+				topic.ReviewerCodes = append(topic.ReviewerCodes, teacher)
+			},
+		},
+	}
+	for _, s := range staff {
+		rows, err := h.query(ctx, s.query, args...)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list council topic staff: %v", err)
+		}
+		for rows.Next() {
+			var code, teacher string
+			if err := rows.Scan(&code, &teacher); err != nil {
+				rows.Close()
+				return nil, status.Errorf(codes.Internal, "failed to scan council topic staff: %v", err)
+			}
+			s.assign(topics[code], teacher)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, status.Errorf(codes.Internal, "error iterating council topic staff: %v", err)
+		}
+	}
+
+	resp := &pb.ListCouncilTopicsResponse{Topics: make([]*pb.CouncilTopicStaff, 0, len(topicCodes))}
+	for _, code := range topicCodes {
+		resp.Topics = append(resp.Topics, topics[code])
+	}
+	return resp, nil
+}
@@ -27,6 +27,11 @@ func (h *Handler) CreateTopicCouncilSupervisor(ctx context.Context, req *pb.Crea
 		return nil, status.Error(codes.InvalidArgument, "topic_council_code is required")
 	}
 
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "teacher_supervisor_code", "Teacher", req.TeacherSupervisorCode); err != nil {
+		return nil, err
+	}
+
 	// Generate UUID
 	id := uuid.New().String()
 
@@ -34,7 +39,7 @@ func (h *Handler) CreateTopicCouncilSupervisor(ctx context.Context, req *pb.Crea
 
 	// Insert into database
 	query := `
-		INSERT INTO TopicCouncilSupervisor (id, teacher_supervisor_code, topic_council_code, created_by, created_at, updated_at)
+		INSERT INTO Topic_council_supervisor (id, teacher_supervisor_code, topic_council_code, created_by, created_at, updated_at)
 		VALUES (?, ?, ?, ?, NOW(), NOW())
 	`
 
@@ -70,14 +75,17 @@ func (h *Handler) GetTopicCouncilSupervisor(ctx context.Context, req *pb.GetTopi
 	}
 
 	query := `
-		SELECT id, teacher_supervisor_code, topic_council_code, created_at, updated_at, created_by, updated_by
-		FROM TopicCouncilSupervisor
+		SELECT id, teacher_supervisor_code, topic_council_code, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
+		FROM Topic_council_supervisor
 		WHERE id = ?
 	`
+	if !req.IncludeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
 
 	var entity pb.TopicCouncilSupervisor
-	var createdAt, updatedAt sql.NullTime
-	var updatedBy sql.NullString
+	var createdAt, updatedAt, deletedAt sql.NullTime
+	var updatedBy, deletedBy sql.NullString
 
 	err := h.queryRow(ctx, query, req.Id).Scan(
 		&entity.Id,
@@ -87,6 +95,9 @@ func (h *Handler) GetTopicCouncilSupervisor(ctx context.Context, req *pb.GetTopi
 		&updatedAt,
 		&entity.CreatedBy,
 		&updatedBy,
+		&entity.Version,
+		&deletedAt,
+		&deletedBy,
 	)
 
 	if err != nil {
@@ -105,6 +116,10 @@ func (h *Handler) GetTopicCouncilSupervisor(ctx context.Context, req *pb.GetTopi
 	if updatedBy.Valid {
 		entity.UpdatedBy = updatedBy.String
 	}
+	if deletedAt.Valid {
+		entity.DeletedAt = timestamppb.New(deletedAt.Time)
+		entity.DeletedBy = deletedBy.String
+	}
 
 	return &pb.GetTopicCouncilSupervisorResponse{
 		TopicCouncilSupervisor: &entity,
@@ -134,6 +149,11 @@ func (h *Handler) UpdateTopicCouncilSupervisor(ctx context.Context, req *pb.Upda
 
 	}
 
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "teacher_supervisor_code", "Teacher", req.GetTeacherSupervisorCode()); err != nil {
+		return nil, err
+	}
+
 	if len(updateFields) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "no fields to update")
 	}
@@ -143,19 +163,27 @@ func (h *Handler) UpdateTopicCouncilSupervisor(ctx context.Context, req *pb.Upda
 	args = append(args, req.UpdatedBy)
 	updateFields = append(updateFields, "updated_at = NOW()")
 
-	// Add id as last parameter
-	args = append(args, req.Id)
+	// Bump the version; an expected version only matches an unchanged record
+	updateFields = append(updateFields, "version = version + 1")
+	where := helper.WhereVersion(req.Id, req.Version, &args)
 
 	query := fmt.Sprintf(`
-		UPDATE TopicCouncilSupervisor
+		UPDATE Topic_council_supervisor
 		SET %s
-		WHERE id = ?
-	`, strings.Join(updateFields, ", "))
+		WHERE %s AND deleted_at IS NULL
+	`, strings.Join(updateFields, ", "), where)
 
-	_, err := h.execQuery(ctx, query, args...)
+	updated, err := h.execQuery(ctx, query, args...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update topiccouncilsupervisor: %v", err)
 	}
+	if n, _ := updated.RowsAffected(); n == 0 {
+		current, err := h.GetTopicCouncilSupervisor(ctx, &pb.GetTopicCouncilSupervisorRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		return nil, helper.VersionConflict("topic council supervisor", current.GetTopicCouncilSupervisor(), current.GetTopicCouncilSupervisor().Version)
+	}
 
 	result, err := h.GetTopicCouncilSupervisor(ctx, &pb.GetTopicCouncilSupervisorRequest{Id: req.Id})
 	if err != nil {
@@ -166,7 +194,7 @@ func (h *Handler) UpdateTopicCouncilSupervisor(ctx context.Context, req *pb.Upda
 	}, nil
 }
 
-// DeleteTopicCouncilSupervisor deletes a TopicCouncilSupervisor by ID
+// DeleteTopicCouncilSupervisor soft-deletes a TopicCouncilSupervisor by ID
 func (h *Handler) DeleteTopicCouncilSupervisor(ctx context.Context, req *pb.DeleteTopicCouncilSupervisorRequest) (*pb.DeleteTopicCouncilSupervisorResponse, error) {
 	defer logger.TraceFunction(ctx)()
 
@@ -174,9 +202,10 @@ func (h *Handler) DeleteTopicCouncilSupervisor(ctx context.Context, req *pb.Dele
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	query := `DELETE FROM TopicCouncilSupervisor WHERE id = ?`
+	// Soft delete; the retention job removes the row later
+	query := `UPDATE Topic_council_supervisor SET deleted_at = NOW(), deleted_by = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL`
 
-	result, err := h.execQuery(ctx, query, req.Id)
+	result, err := h.execQuery(ctx, query, req.DeletedBy, req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete topiccouncilsupervisor: %v", err)
 	}
@@ -195,6 +224,43 @@ func (h *Handler) DeleteTopicCouncilSupervisor(ctx context.Context, req *pb.Dele
 	}, nil
 }
 
+// RestoreTopicCouncilSupervisor brings back a soft-deleted TopicCouncilSupervisor
+func (h *Handler) RestoreTopicCouncilSupervisor(ctx context.Context, req *pb.RestoreTopicCouncilSupervisorRequest) (*pb.RestoreTopicCouncilSupervisorResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	query := `UPDATE Topic_council_supervisor SET deleted_at = NULL, deleted_by = NULL, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ? AND deleted_at IS NOT NULL`
+
+	result, err := h.execQuery(ctx, query, req.RestoredBy, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore topic council supervisor: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		// Unknown ids are NotFound; a live record has nothing to restore
+		if _, err := h.GetTopicCouncilSupervisor(ctx, &pb.GetTopicCouncilSupervisorRequest{Id: req.Id}); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, "topic council supervisor is not deleted")
+	}
+
+	restored, err := h.GetTopicCouncilSupervisor(ctx, &pb.GetTopicCouncilSupervisorRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreTopicCouncilSupervisorResponse{
+		TopicCouncilSupervisor: restored.GetTopicCouncilSupervisor(),
+	}, nil
+}
+
 // ListTopicCouncilSupervisors lists TopicCouncilSupervisors with pagination and filtering
 func (h *Handler) ListTopicCouncilSupervisors(ctx context.Context, req *pb.ListTopicCouncilSupervisorsRequest) (*pb.ListTopicCouncilSupervisorsResponse, error) {
 	defer logger.TraceFunction(ctx)()
@@ -202,8 +268,6 @@ func (h *Handler) ListTopicCouncilSupervisors(ctx context.Context, req *pb.ListT
 	// Default pagination
 	page := int32(1)
 	pageSize := int32(10)
-	sortBy := "created_at"
-	descending := true
 	if req.Search != nil && req.Search.Pagination != nil {
 		if req.Search.Pagination.Page > 0 {
 			page = req.Search.Pagination.Page
@@ -211,50 +275,36 @@ func (h *Handler) ListTopicCouncilSupervisors(ctx context.Context, req *pb.ListT
 		if req.Search.Pagination.PageSize > 0 {
 			pageSize = req.Search.Pagination.PageSize
 		}
-		if req.Search.Pagination.SortBy != "" {
-			sortBy = req.Search.Pagination.SortBy
-		}
-		descending = req.Search.Pagination.Descending
 	}
 
 	// Calculate offset
 	offset := (page - 1) * pageSize
 
 	// Build WHERE clause from filters
-	whereClause := ""
 	args := []interface{}{}
-	whiteMap := map[string]bool{
-		"id": true,
-
-		"teacher_supervisor_code": true,
-		"topic_council_code":      true,
-	}
-	if req.Search != nil && len(req.Search.Filters) > 0 {
-		whereConditions := []string{}
-		for _, filter := range req.Search.Filters {
-			if filter.GetCondition() != nil {
-				condition := filter.GetCondition()
-				if _, ok := whiteMap[condition.Field]; !ok {
-					continue
-				}
-				whereConditions = append(whereConditions, helper.BuildFilterCondition(condition, &args))
-			}
-		}
-		if len(whereConditions) > 0 {
-			whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
-		}
+	filterFields := helper.FilterFields{
+		"id":                      {},
+		"teacher_supervisor_code": {},
+		"topic_council_code":      {},
+		"created_at":              {Type: helper.FieldTime},
+		"updated_at":              {Type: helper.FieldTime},
 	}
+	whereClause, err := helper.BuildWhere(req.Search.GetFilters(), filterFields, &args)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	whereClause = helper.NotDeleted(whereClause, req.IncludeDeleted)
 
 	// Build ORDER BY clause
-	sortDirection := "ASC"
-	if descending {
-		sortDirection = "DESC"
+	orderBy, err := helper.BuildOrderBy(req.Search.GetPagination(), filterFields)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %v", err)
 	}
 
 	// Get total count
-	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM TopicCouncilSupervisor %s", whereClause)
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM Topic_council_supervisor %s", whereClause)
 	var total int32
-	err := h.queryRow(ctx, countQuery, args...).Scan(&total)
+	err = h.queryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to count topiccouncilsupervisors: %v", err)
 	}
@@ -262,12 +312,12 @@ func (h *Handler) ListTopicCouncilSupervisors(ctx context.Context, req *pb.ListT
 	// Get entities with pagination
 	args = append(args, pageSize, offset)
 	query := fmt.Sprintf(`
-		SELECT id, teacher_supervisor_code, topic_council_code, created_at, updated_at, created_by, updated_by
-		FROM TopicCouncilSupervisor
+		SELECT id, teacher_supervisor_code, topic_council_code, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
+		FROM Topic_council_supervisor
+		%s
 		%s
-		ORDER BY %s %s
 		LIMIT ? OFFSET ?
-	`, whereClause, sortBy, sortDirection)
+	`, whereClause, orderBy)
 
 	rows, err := h.query(ctx, query, args...)
 	if err != nil {
@@ -278,8 +328,8 @@ func (h *Handler) ListTopicCouncilSupervisors(ctx context.Context, req *pb.ListT
 	entities := []*pb.TopicCouncilSupervisor{}
 	for rows.Next() {
 		var entity pb.TopicCouncilSupervisor
-		var createdAt, updatedAt sql.NullTime
-		var updatedBy sql.NullString
+		var createdAt, updatedAt, deletedAt sql.NullTime
+		var updatedBy, deletedBy sql.NullString
 
 		err := rows.Scan(
 			&entity.Id,
@@ -289,6 +339,9 @@ func (h *Handler) ListTopicCouncilSupervisors(ctx context.Context, req *pb.ListT
 			&updatedAt,
 			&entity.CreatedBy,
 			&updatedBy,
+			&entity.Version,
+			&deletedAt,
+			&deletedBy,
 		)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan topiccouncilsupervisor: %v", err)
@@ -303,6 +356,10 @@ func (h *Handler) ListTopicCouncilSupervisors(ctx context.Context, req *pb.ListT
 		if updatedBy.Valid {
 			entity.UpdatedBy = updatedBy.String
 		}
+		if deletedAt.Valid {
+			entity.DeletedAt = timestamppb.New(deletedAt.Time)
+			entity.DeletedBy = deletedBy.String
+		}
 
 		entities = append(entities, &entity)
 	}
@@ -1,15 +1,25 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"os"
 
+	pbAcademic "thaily/proto/academic"
+	pbCouncil "thaily/proto/council"
 	pb "thaily/proto/thesis"
+	pbUser "thaily/proto/user"
+	"thaily/src/pkg/audit"
 	"thaily/src/pkg/database"
 	"thaily/src/pkg/logger"
+	"thaily/src/pkg/migrate"
+	"thaily/src/pkg/outbox"
+	"thaily/src/pkg/reference"
+	"thaily/src/pkg/retention"
 	"thaily/src/pkg/tls"
 	"thaily/src/service/thesis/handler"
+	"thaily/src/service/thesis/migrations"
 
 	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
@@ -28,11 +38,64 @@ func main() {
 	defer logger.GetFileLogger().Close()
 
 	// Initialize database
-	if err := database.InitDB(); err != nil {
+	if err := database.InitDB("thesis"); err != nil {
 		log.Fatalf("Failed to initialize database: %v", err)
 	}
 	defer database.CloseDB()
 
+	// Run the migrate command, or apply pending migrations on startup
+	migrator, err := migrate.New(database.GetDB(), "thesis", migrations.FS)
+	if err != nil {
+		log.Fatalf("Failed to load migrations: %v", err)
+	}
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := migrate.Run(context.Background(), database.GetDB(), migrator, handler.EnumColumns(), os.Args[2:]); err != nil {
+			log.Fatalf("Migrate failed: %v", err)
+		}
+		return
+	}
+	if err := migrate.OnStartup(context.Background(), migrator); err != nil {
+		log.Fatalf("Failed to apply migrations: %v", err)
+	}
+
+	// Check references to the tables of other services, which own them
+	refs := reference.NewResolver()
+	defer refs.Close()
+	if err := refs.Dial("ACADEMIC_SERVICE_ADDR", "academic-service", pbAcademic.AcademicService_CheckReferences_FullMethodName, "Major", "Semester"); err != nil {
+		log.Fatalf("Failed to connect to the academic service: %v", err)
+	}
+	if err := refs.Dial("USER_SERVICE_ADDR", "user-service", pbUser.UserService_CheckReferences_FullMethodName, "Student", "Teacher"); err != nil {
+		log.Fatalf("Failed to connect to the user service: %v", err)
+	}
+	if err := refs.Dial("COUNCIL_SERVICE_ADDR", "council-service", pbCouncil.CouncilService_CheckReferences_FullMethodName, "Council"); err != nil {
+		log.Fatalf("Failed to connect to the council service: %v", err)
+	}
+	if len(os.Args) > 1 && os.Args[1] == "orphans" {
+		if err := refs.Report(context.Background(), database.GetDB(), handler.References()); err != nil {
+			log.Fatalf("Orphan report failed: %v", err)
+		}
+		return
+	}
+	go refs.RunReport(context.Background(), database.GetDB(), handler.References(), reference.ReportInterval())
+
+	// Purge rows soft-deleted longer than the retention period
+	go retention.Run(context.Background(), database.GetDB(), handler.SoftDeleted(), retention.Period(), retention.Interval())
+
+	// Publish the events written to the outbox to the event bus
+	bus, closeBus, err := outbox.Connect(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to connect to the event bus: %v", err)
+	}
+	defer closeBus()
+	go outbox.NewRelay(database.GetDB(), bus, "thesis").Run(context.Background(), outbox.Interval(), outbox.Retention())
+
+	// Append every mutation to the audit log
+	auditStore, closeAudit, err := audit.Connect(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to connect to the audit log: %v", err)
+	}
+	defer closeAudit()
+
 	// Verify TLS certificates exist
 	if err := tls.VerifyCertificatesExist("thesis"); err != nil {
 		log.Fatalf("TLS certificate verification failed: %v", err)
@@ -57,10 +120,13 @@ func main() {
 
 	grpcServer := grpc.NewServer(
 		grpc.Creds(creds),
-		grpc.UnaryInterceptor(logger.UnaryServerInterceptor()),
+		grpc.ChainUnaryInterceptor(
+			logger.UnaryServerInterceptor(),
+			audit.UnaryServerInterceptor(auditStore, database.GetDB(), "thesis", handler.Audited()),
+		),
 	)
 
-	h := handler.NewHandler(database.GetDB())
+	h := handler.NewHandler(database.GetDB(), refs)
 	pb.RegisterThesisServiceServer(grpcServer, h)
 
 	log.Printf("ThesisService listening on port %s", port)
@@ -0,0 +1,25 @@
+SET FOREIGN_KEY_CHECKS = 0;
+
+DROP TABLE IF EXISTS `Grading_policy`;
+DROP TABLE IF EXISTS `Milestone_checkin`;
+DROP TABLE IF EXISTS `Midterm_milestone`;
+DROP TABLE IF EXISTS `Grade_appeal_event`;
+DROP TABLE IF EXISTS `Grade_appeal`;
+DROP TABLE IF EXISTS `Grade_amendment`;
+DROP TABLE IF EXISTS `Semester_grade_status`;
+DROP TABLE IF EXISTS `Deadline_extension`;
+DROP TABLE IF EXISTS `Submission_deadline`;
+DROP TABLE IF EXISTS `Grade_review`;
+DROP TABLE IF EXISTS `Final`;
+DROP TABLE IF EXISTS `Topic_applicant_rank`;
+DROP TABLE IF EXISTS `Topic_registration`;
+DROP TABLE IF EXISTS `Registration_window`;
+DROP TABLE IF EXISTS `Topic_cosign`;
+DROP TABLE IF EXISTS `Topic_council_supervisor`;
+DROP TABLE IF EXISTS `Topic_council`;
+DROP TABLE IF EXISTS `Topic_status_history`;
+DROP TABLE IF EXISTS `Topic`;
+DROP TABLE IF EXISTS `Enrollment`;
+DROP TABLE IF EXISTS `Midterm`;
+
+SET FOREIGN_KEY_CHECKS = 1;
@@ -0,0 +1,344 @@
+-- Initial thesis service schema
+--
+-- References to other services' tables are not foreign keys, each
+-- service owns its schema:
+--   Enrollment.student_code -> Student (user service)
+--   Topic.major_code -> Major (academic service)
+--   Topic.semester_code -> Semester (academic service)
+--   Topic_council.council_code -> Council (council service)
+--   Topic_council_supervisor.teacher_supervisor_code -> Teacher (user service)
+--   Grade_review.teacher_code -> Teacher (user service)
+--   Submission_deadline.semester_code -> Semester (academic service)
+--   Deadline_extension.semester_code -> Semester (academic service)
+--   Deadline_extension.student_code -> Student (user service)
+--   Topic_cosign.teacher_code -> Teacher (user service)
+--   Registration_window.semester_code -> Semester (academic service)
+--   Topic_registration.student_code -> Student (user service)
+--   Topic_applicant_rank.student_code -> Student (user service)
+--   Grading_policy.major_code -> Major (academic service)
+--   Grading_policy.semester_code -> Semester (academic service)
+--   Semester_grade_status.semester_code -> Semester (academic service)
+--   Midterm_milestone.semester_code -> Semester (academic service)
+
+CREATE TABLE `Midterm` (
+  `id` varchar(255) PRIMARY KEY,
+  `title` varchar(255) NOT NULL,
+  `grade` int,
+  `status` ENUM ('not_submitted', 'submitted', 'pass', 'fail') NOT NULL,
+  `feedback` text,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL
+);
+
+CREATE TABLE `Enrollment` (
+  `id` varchar(255) PRIMARY KEY,
+  `title` varchar(255) NOT NULL,
+  `student_code` varchar(255) NOT NULL,
+  `topic_council_code` varchar(255) NOT NULL,
+  `final_code` varchar(255),
+  `grade_review_code` varchar(255),
+  `midterm_code` varchar(255),
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL
+);
+
+CREATE TABLE `Topic` (
+  `id` varchar(255) PRIMARY KEY,
+  `title` varchar(255) COLLATE utf8mb4_0900_ai_ci NOT NULL,
+  `major_code` varchar(255) NOT NULL,
+  `semester_code` varchar(255) NOT NULL,
+  `status` ENUM ('submit', 'pending', 'approved_1', 'approved_2', 'in_progress', 'completed', 'rejected') NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `percent_stage_1` int,
+  `percent_stage_2` int,
+  `max_students` int NOT NULL DEFAULT 1,
+  `required_skills` varchar(1000) NOT NULL DEFAULT '',
+  `ranking_restricted` boolean NOT NULL DEFAULT false,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL,
+  FULLTEXT KEY `ft_topic_title` (`title`)
+);
+
+CREATE TABLE `Topic_status_history` (
+  `id` varchar(255) PRIMARY KEY,
+  `topic_code` varchar(255) NOT NULL,
+  `from_status` varchar(32),
+  `to_status` varchar(32) NOT NULL,
+  `reason` text,
+  `created_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  KEY `idx_topic_status_history_topic` (`topic_code`, `created_at`)
+);
+
+CREATE TABLE `Topic_council` (
+  `id` varchar(255) PRIMARY KEY,
+  `title` varchar(255) NOT NULL,
+  `stage` ENUM ('stage_dacn', 'stage_lvtn') NOT NULL,
+  `topic_code` varchar(255) NOT NULL,
+  `council_code` varchar(255),
+  `time_start` datetime NOT NULL,
+  `time_end` datetime NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL
+);
+
+CREATE TABLE `Topic_council_supervisor` (
+  `id` varchar(255) PRIMARY KEY,
+  `teacher_supervisor_code` varchar(255) NOT NULL,
+  `topic_council_code` varchar(255) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL
+);
+
+CREATE TABLE `Topic_cosign` (
+  `id` varchar(255) PRIMARY KEY,
+  `topic_code` varchar(255) NOT NULL,
+  `teacher_code` varchar(255) NOT NULL,
+  `status` ENUM ('pending', 'signed', 'declined') NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  UNIQUE KEY `uq_topic_cosign` (`topic_code`, `teacher_code`)
+);
+
+CREATE TABLE `Registration_window` (
+  `id` varchar(255) PRIMARY KEY,
+  `semester_code` varchar(255) NOT NULL,
+  `opens_at` datetime NOT NULL,
+  `closes_at` datetime NOT NULL,
+  `max_choices` int NOT NULL DEFAULT 3,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL,
+  UNIQUE KEY `uq_registration_window` (`semester_code`)
+);
+
+CREATE TABLE `Topic_registration` (
+  `id` varchar(255) PRIMARY KEY,
+  `topic_code` varchar(255) NOT NULL,
+  `student_code` varchar(255) NOT NULL,
+  `semester_code` varchar(255) NOT NULL,
+  `preference_rank` int NOT NULL,
+  `status` ENUM ('pending', 'accepted', 'declined', 'withdrawn') NOT NULL,
+  `reason` text,
+  `decided_by` varchar(255),
+  `decided_at` datetime,
+  `enrollment_code` varchar(255),
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  UNIQUE KEY `uq_topic_registration` (`student_code`, `topic_code`),
+  KEY `idx_topic_registration_topic` (`topic_code`, `status`)
+);
+
+CREATE TABLE `Topic_applicant_rank` (
+  `id` varchar(255) PRIMARY KEY,
+  `topic_code` varchar(255) NOT NULL,
+  `student_code` varchar(255) NOT NULL,
+  `preference_rank` int NOT NULL,
+  `created_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  UNIQUE KEY `uq_topic_applicant_rank` (`topic_code`, `student_code`)
+);
+
+CREATE TABLE `Final` (
+  `id` varchar(255) PRIMARY KEY,
+  `title` varchar(255) NOT NULL,
+  `supervisor_grade` int,
+  `department_grade` int,
+  `final_grade` decimal(4,2),
+  `status` ENUM ('pending', 'passed', 'failed', 'completed') NOT NULL,
+  `notes` text,
+  `completion_date` datetime,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL
+);
+
+CREATE TABLE `Grade_review` (
+  `id` varchar(255) PRIMARY KEY,
+  `title` varchar(255) NOT NULL,
+  `review_grade` int,
+  `teacher_code` varchar(255) NOT NULL,
+  `status` ENUM ('pending', 'passed', 'failed', 'completed') NOT NULL,
+  `notes` text,
+  `completion_date` datetime,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL
+);
+
+CREATE TABLE `Submission_deadline` (
+  `id` varchar(255) PRIMARY KEY,
+  `semester_code` varchar(255) NOT NULL,
+  `stage` ENUM ('stage_dacn', 'stage_lvtn') NOT NULL,
+  `kind` ENUM ('midterm', 'final') NOT NULL,
+  `opens_at` datetime NOT NULL,
+  `due_at` datetime NOT NULL,
+  `grace_minutes` int NOT NULL DEFAULT 0,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL,
+  UNIQUE KEY `uq_submission_deadline` (`semester_code`, `stage`, `kind`)
+);
+
+CREATE TABLE `Deadline_extension` (
+  `id` varchar(255) PRIMARY KEY,
+  `semester_code` varchar(255) NOT NULL,
+  `stage` ENUM ('stage_dacn', 'stage_lvtn') NOT NULL,
+  `kind` ENUM ('midterm', 'final') NOT NULL,
+  `student_code` varchar(255) NOT NULL,
+  `due_at` datetime NOT NULL,
+  `reason` text,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL,
+  UNIQUE KEY `uq_deadline_extension` (`semester_code`, `stage`, `kind`, `student_code`)
+);
+
+CREATE TABLE `Semester_grade_status` (
+  `semester_code` varchar(255) PRIMARY KEY,
+  `locked_at` datetime,
+  `locked_by` varchar(255),
+  `published_at` datetime,
+  `published_by` varchar(255),
+  `appeal_window_days` int NOT NULL DEFAULT 14,
+  `updated_at` datetime NOT NULL
+);
+
+CREATE TABLE `Grade_amendment` (
+  `id` varchar(255) PRIMARY KEY,
+  `target` ENUM ('supervisor_grade', 'review_grade', 'midterm_grade', 'final_grade') NOT NULL,
+  `target_code` varchar(255) NOT NULL,
+  `enrollment_code` varchar(255) NOT NULL,
+  `semester_code` varchar(255) NOT NULL,
+  `old_value` decimal(4,2),
+  `new_value` decimal(4,2) NOT NULL,
+  `reason` text NOT NULL,
+  `status` ENUM ('pending', 'approved', 'rejected') NOT NULL DEFAULT 'pending',
+  `requested_by` varchar(255) NOT NULL,
+  `decided_by` varchar(255),
+  `decided_at` datetime,
+  `decision_note` text,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL
+);
+
+CREATE TABLE `Grade_appeal` (
+  `id` varchar(255) PRIMARY KEY,
+  `enrollment_code` varchar(255) NOT NULL,
+  `student_code` varchar(255) NOT NULL,
+  `semester_code` varchar(255) NOT NULL,
+  `component` ENUM ('supervisor_grade', 'review_grade', 'midterm_grade', 'final_grade', 'defence') NOT NULL,
+  `reason` text NOT NULL,
+  `evidence_file_code` varchar(255),
+  `status` ENUM ('submitted', 'assigned', 'confirmed', 'amended') NOT NULL DEFAULT 'submitted',
+  `reviewer_code` varchar(255),
+  `resolution_note` text,
+  `amendment_code` varchar(255),
+  `resolved_by` varchar(255),
+  `resolved_at` datetime,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  KEY `idx_grade_appeal_semester` (`semester_code`, `status`)
+);
+
+CREATE TABLE `Grade_appeal_event` (
+  `id` varchar(255) PRIMARY KEY,
+  `appeal_code` varchar(255) NOT NULL,
+  `status` ENUM ('submitted', 'assigned', 'confirmed', 'amended') NOT NULL,
+  `note` text,
+  `actor` varchar(255) NOT NULL,
+  `created_at` datetime NOT NULL
+);
+
+CREATE TABLE `Midterm_milestone` (
+  `id` varchar(255) PRIMARY KEY,
+  `semester_code` varchar(255) NOT NULL,
+  `stage` ENUM ('stage_dacn', 'stage_lvtn') NOT NULL,
+  `title` varchar(255) NOT NULL,
+  `description` text,
+  `sequence` int NOT NULL,
+  `due_at` datetime,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL,
+  UNIQUE KEY `uq_midterm_milestone` (`semester_code`, `stage`, `sequence`)
+);
+
+CREATE TABLE `Milestone_checkin` (
+  `id` varchar(255) PRIMARY KEY,
+  `milestone_code` varchar(255) NOT NULL,
+  `enrollment_code` varchar(255) NOT NULL,
+  `report` text NOT NULL,
+  `file_code` varchar(255),
+  `result` ENUM ('pending', 'passed', 'failed') NOT NULL DEFAULT 'pending',
+  `comment` text,
+  `reviewed_by` varchar(255),
+  `reviewed_at` datetime,
+  `submitted_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  UNIQUE KEY `uq_milestone_checkin` (`milestone_code`, `enrollment_code`)
+);
+
+CREATE TABLE `Grading_policy` (
+  `id` varchar(255) PRIMARY KEY,
+  `major_code` varchar(255) NOT NULL,
+  `semester_code` varchar(255) NOT NULL,
+  `stage` ENUM ('stage_dacn', 'stage_lvtn') NOT NULL,
+  `supervisor_weight` decimal(5,2) NOT NULL,
+  `reviewer_weight` decimal(5,2) NOT NULL,
+  `council_weight` decimal(5,2) NOT NULL,
+  `rounding` ENUM ('half_up', 'down', 'up') NOT NULL DEFAULT 'half_up',
+  `rounding_step` decimal(4,2) NOT NULL DEFAULT 0.1,
+  `pass_threshold` decimal(4,2) NOT NULL DEFAULT 5,
+  `max_council_spread` decimal(4,2) NOT NULL DEFAULT 0,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL,
+  UNIQUE KEY `uq_grading_policy` (`major_code`, `semester_code`, `stage`)
+);
+
+ALTER TABLE `Enrollment` ADD FOREIGN KEY (`topic_council_code`) REFERENCES `Topic_council` (`id`);
+
+ALTER TABLE `Topic_council_supervisor` ADD FOREIGN KEY (`topic_council_code`) REFERENCES `Topic_council` (`id`);
+
+ALTER TABLE `Enrollment` ADD FOREIGN KEY (`final_code`) REFERENCES `Final` (`id`);
+
+ALTER TABLE `Enrollment` ADD FOREIGN KEY (`midterm_code`) REFERENCES `Midterm` (`id`);
+
+ALTER TABLE `Topic_council` ADD FOREIGN KEY (`topic_code`) REFERENCES `Topic` (`id`);
+
+ALTER TABLE `Topic_status_history` ADD FOREIGN KEY (`topic_code`) REFERENCES `Topic` (`id`) ON DELETE CASCADE;
+
+ALTER TABLE `Topic_cosign` ADD FOREIGN KEY (`topic_code`) REFERENCES `Topic` (`id`) ON DELETE CASCADE;
+
+ALTER TABLE `Topic_registration` ADD FOREIGN KEY (`topic_code`) REFERENCES `Topic` (`id`) ON DELETE CASCADE;
+
+ALTER TABLE `Topic_registration` ADD FOREIGN KEY (`enrollment_code`) REFERENCES `Enrollment` (`id`) ON DELETE SET NULL;
+
+ALTER TABLE `Topic_applicant_rank` ADD FOREIGN KEY (`topic_code`) REFERENCES `Topic` (`id`) ON DELETE CASCADE;
+
+ALTER TABLE `Grade_amendment` ADD FOREIGN KEY (`enrollment_code`) REFERENCES `Enrollment` (`id`) ON DELETE CASCADE;
+
+ALTER TABLE `Grade_appeal` ADD FOREIGN KEY (`enrollment_code`) REFERENCES `Enrollment` (`id`) ON DELETE CASCADE;
+
+ALTER TABLE `Grade_appeal_event` ADD FOREIGN KEY (`appeal_code`) REFERENCES `Grade_appeal` (`id`) ON DELETE CASCADE;
+
+ALTER TABLE `Milestone_checkin` ADD FOREIGN KEY (`milestone_code`) REFERENCES `Midterm_milestone` (`id`);
+
+ALTER TABLE `Milestone_checkin` ADD FOREIGN KEY (`enrollment_code`) REFERENCES `Enrollment` (`id`) ON DELETE CASCADE;
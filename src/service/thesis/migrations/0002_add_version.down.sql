@@ -0,0 +1,8 @@
+ALTER TABLE `Grade_review` DROP COLUMN `version`;
+ALTER TABLE `Topic_council_supervisor` DROP COLUMN `version`;
+ALTER TABLE `Topic_council` DROP COLUMN `version`;
+ALTER TABLE `Topic` DROP COLUMN `version`;
+ALTER TABLE `Enrollment` DROP COLUMN `version`;
+ALTER TABLE `Final` DROP COLUMN `version`;
+ALTER TABLE `Midterm_milestone` DROP COLUMN `version`;
+ALTER TABLE `Midterm` DROP COLUMN `version`;
@@ -0,0 +1,11 @@
+-- Optimistic concurrency: every update bumps `version`, and an update that
+-- carries the expected version only applies to a record still at it
+
+ALTER TABLE `Midterm` ADD COLUMN `version` INT NOT NULL DEFAULT 1;
+ALTER TABLE `Midterm_milestone` ADD COLUMN `version` INT NOT NULL DEFAULT 1;
+ALTER TABLE `Final` ADD COLUMN `version` INT NOT NULL DEFAULT 1;
+ALTER TABLE `Enrollment` ADD COLUMN `version` INT NOT NULL DEFAULT 1;
+ALTER TABLE `Topic` ADD COLUMN `version` INT NOT NULL DEFAULT 1;
+ALTER TABLE `Topic_council` ADD COLUMN `version` INT NOT NULL DEFAULT 1;
+ALTER TABLE `Topic_council_supervisor` ADD COLUMN `version` INT NOT NULL DEFAULT 1;
+ALTER TABLE `Grade_review` ADD COLUMN `version` INT NOT NULL DEFAULT 1;
@@ -0,0 +1,7 @@
+ALTER TABLE `Grade_review` DROP KEY `idx_grade_review_deleted_at`, DROP COLUMN `deleted_by`, DROP COLUMN `deleted_at`;
+ALTER TABLE `Topic_council_supervisor` DROP KEY `idx_topic_council_supervisor_deleted_at`, DROP COLUMN `deleted_by`, DROP COLUMN `deleted_at`;
+ALTER TABLE `Topic_council` DROP KEY `idx_topic_council_deleted_at`, DROP COLUMN `deleted_by`, DROP COLUMN `deleted_at`;
+ALTER TABLE `Topic` DROP KEY `idx_topic_deleted_at`, DROP COLUMN `deleted_by`, DROP COLUMN `deleted_at`;
+ALTER TABLE `Enrollment` DROP KEY `idx_enrollment_deleted_at`, DROP COLUMN `deleted_by`, DROP COLUMN `deleted_at`;
+ALTER TABLE `Final` DROP KEY `idx_final_deleted_at`, DROP COLUMN `deleted_by`, DROP COLUMN `deleted_at`;
+ALTER TABLE `Midterm` DROP KEY `idx_midterm_deleted_at`, DROP COLUMN `deleted_by`, DROP COLUMN `deleted_at`;
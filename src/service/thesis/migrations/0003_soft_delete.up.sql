@@ -0,0 +1,11 @@
+-- Soft delete: Delete* only stamps `deleted_at`/`deleted_by`, Get*/List*
+-- skip stamped rows unless asked, Restore* clears them, and the retention
+-- job hard-deletes rows stamped longer ago than RETENTION_PERIOD
+
+ALTER TABLE `Midterm` ADD COLUMN `deleted_at` datetime NULL, ADD COLUMN `deleted_by` varchar(255) NULL, ADD KEY `idx_midterm_deleted_at` (`deleted_at`);
+ALTER TABLE `Final` ADD COLUMN `deleted_at` datetime NULL, ADD COLUMN `deleted_by` varchar(255) NULL, ADD KEY `idx_final_deleted_at` (`deleted_at`);
+ALTER TABLE `Enrollment` ADD COLUMN `deleted_at` datetime NULL, ADD COLUMN `deleted_by` varchar(255) NULL, ADD KEY `idx_enrollment_deleted_at` (`deleted_at`);
+ALTER TABLE `Topic` ADD COLUMN `deleted_at` datetime NULL, ADD COLUMN `deleted_by` varchar(255) NULL, ADD KEY `idx_topic_deleted_at` (`deleted_at`);
+ALTER TABLE `Topic_council` ADD COLUMN `deleted_at` datetime NULL, ADD COLUMN `deleted_by` varchar(255) NULL, ADD KEY `idx_topic_council_deleted_at` (`deleted_at`);
+ALTER TABLE `Topic_council_supervisor` ADD COLUMN `deleted_at` datetime NULL, ADD COLUMN `deleted_by` varchar(255) NULL, ADD KEY `idx_topic_council_supervisor_deleted_at` (`deleted_at`);
+ALTER TABLE `Grade_review` ADD COLUMN `deleted_at` datetime NULL, ADD COLUMN `deleted_by` varchar(255) NULL, ADD KEY `idx_grade_review_deleted_at` (`deleted_at`);
@@ -0,0 +1,22 @@
+-- Transactional outbox: handlers insert a domain event (proto/event) in the
+-- transaction of the change it describes, and the relay appends unpublished
+-- rows to the Redis stream in `seq` order, stamping `published_at`. Published
+-- rows are deleted after OUTBOX_RETENTION. A row whose payload cannot be
+-- decoded is dead-lettered: `failed_at` and `error` are set, the relay moves
+-- past it and the row is kept for inspection.
+
+CREATE TABLE `Outbox` (
+  `seq` bigint AUTO_INCREMENT PRIMARY KEY,
+  `id` varchar(36) NOT NULL,
+  `type` varchar(64) NOT NULL,
+  `aggregate` varchar(64) NOT NULL,
+  `aggregate_id` varchar(255) NOT NULL,
+  `payload` mediumblob NOT NULL,
+  `created_at` datetime NOT NULL,
+  `published_at` datetime NULL,
+  `stream_id` varchar(64) NULL,
+  `failed_at` datetime NULL,
+  `error` varchar(1024) NULL,
+  UNIQUE KEY `uq_outbox_id` (`id`),
+  KEY `idx_outbox_published_at` (`published_at`, `seq`)
+);
@@ -0,0 +1,2 @@
+ALTER TABLE `Topic` DROP INDEX `ft_topic_title`;
+ALTER TABLE `Topic` ADD FULLTEXT KEY `ft_topic_title` (`title`);
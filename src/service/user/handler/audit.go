@@ -0,0 +1,11 @@
+package handler
+
+import "thaily/src/pkg/audit"
+
+// Audited maps the entities named in the user RPCs to their tables
+func Audited() []audit.Entity {
+	return []audit.Entity{
+		{Name: "Student", Table: "Student"},
+		{Name: "Teacher", Table: "Teacher"},
+	}
+}
@@ -0,0 +1,16 @@
+package handler
+
+import (
+	pb "thaily/proto/user"
+	"thaily/src/pkg/migrate"
+)
+
+// EnumColumns lists the ENUM columns of the user tables with the values
+// the handlers write, for the migrate check command
+func EnumColumns() []migrate.EnumColumn {
+	gender := migrate.EnumValues(pb.Gender_name, func(v int32) string { return genderToString(pb.Gender(v)) })
+	return []migrate.EnumColumn{
+		{Table: "Student", Column: "gender", Enum: "Gender", Values: gender},
+		{Table: "Teacher", Column: "gender", Enum: "Gender", Values: gender},
+	}
+}
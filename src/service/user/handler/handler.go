@@ -5,15 +5,19 @@ import (
 	"database/sql"
 
 	pb "thaily/proto/user"
+	"thaily/src/pkg/reference"
 )
 
 type Handler struct {
 	pb.UnimplementedUserServiceServer
 	db *sql.DB
+
+	// refs checks the columns pointing at other services' tables
+	refs *reference.Resolver
 }
 
-func NewHandler(db *sql.DB) *Handler {
-	return &Handler{db: db}
+func NewHandler(db *sql.DB, refs *reference.Resolver) *Handler {
+	return &Handler{db: db, refs: refs}
 }
 
 func (h *Handler) queryRow(ctx context.Context, query string, args ...interface{}) *sql.Row {
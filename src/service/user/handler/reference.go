@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"context"
+
+	pbCommon "thaily/proto/common"
+	"thaily/src/pkg/logger"
+	"thaily/src/pkg/reference"
+)
+
+// referencedTables are the user tables other services point at
+var referencedTables = []string{"Student", "Teacher"}
+
+// CheckReferences tells another service which ids of one of our tables do
+// not exist, replacing the foreign keys across services
+func (h *Handler) CheckReferences(ctx context.Context, req *pbCommon.ReferenceCheckRequest) (*pbCommon.ReferenceCheckResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	return reference.Check(ctx, h.db, referencedTables, req)
+}
+
+// References lists the columns of the user tables that point at other
+// services' tables, for the orphan report
+func References() []reference.Column {
+	return []reference.Column{
+		{Table: "Student", Column: "major_code", References: "Major"},
+		{Table: "Student", Column: "semester_code", References: "Semester"},
+		{Table: "Teacher", Column: "major_code", References: "Major"},
+		{Table: "Teacher", Column: "semester_code", References: "Semester"},
+	}
+}
@@ -0,0 +1,11 @@
+package handler
+
+import "thaily/src/pkg/retention"
+
+// SoftDeleted lists the user tables purged by the retention job
+func SoftDeleted() []retention.Table {
+	return []retention.Table{
+		{Name: "Student"},
+		{Name: "Teacher"},
+	}
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"context"
+
+	pbCommon "thaily/proto/common"
+	pb "thaily/proto/user"
+	"thaily/src/pkg/helper"
+	"thaily/src/pkg/logger"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SearchUsers ranks students and teachers by how well their username or
+// email matches the query. Both columns have ngram FULLTEXT indexes with an
+// accent-insensitive collation, so Vietnamese names match without accents
+// down to their 2-letter syllables.
+func (h *Handler) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	query := helper.FullTextQuery(req.Query)
+	if query == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+	if !req.Students && !req.Teachers {
+		return nil, status.Error(codes.InvalidArgument, "students or teachers must be searched")
+	}
+	limit := helper.SearchLimit(req.Limit)
+
+	hits := []*pbCommon.SearchHit{}
+	if req.Students {
+		studentHits, err := h.searchUserTable(ctx, "Student", "student", query, limit)
+		if err != nil {
+			return nil, err
+		}
+		hits = append(hits, studentHits...)
+	}
+	if req.Teachers {
+		teacherHits, err := h.searchUserTable(ctx, "Teacher", "teacher", query, limit)
+		if err != nil {
+			return nil, err
+		}
+		hits = append(hits, teacherHits...)
+	}
+
+	helper.SortSearchHits(hits)
+	if int32(len(hits)) > limit {
+		hits = hits[:limit]
+	}
+	return &pb.SearchUsersResponse{Hits: hits}, nil
+}
+
+// searchUserTable searches the Student or Teacher table
+func (h *Handler) searchUserTable(ctx context.Context, table, hitType, query string, limit int32) ([]*pbCommon.SearchHit, error) {
+	rows, err := h.query(ctx, `
+		SELECT id, username, email,
+			MATCH(username) AGAINST (? IN BOOLEAN MODE) + MATCH(email) AGAINST (? IN BOOLEAN MODE) AS score
+		FROM `+table+`
+		WHERE (MATCH(username) AGAINST (? IN BOOLEAN MODE) OR MATCH(email) AGAINST (? IN BOOLEAN MODE)) AND deleted_at IS NULL
+		ORDER BY score DESC, id
+		LIMIT ?
+	`, query, query, query, query, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search %ss: %v", hitType, err)
+	}
+	defer rows.Close()
+
+	hits := []*pbCommon.SearchHit{}
+	for rows.Next() {
+		hit := &pbCommon.SearchHit{Type: hitType}
+		if err := rows.Scan(&hit.Id, &hit.Title, &hit.Subtitle, &hit.Score); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan %s: %v", hitType, err)
+		}
+		hits = append(hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search %ss: %v", hitType, err)
+	}
+	return hits, nil
+}
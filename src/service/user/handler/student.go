@@ -15,6 +15,28 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+func genderToString(g pb.Gender) string {
+	switch g {
+	case pb.Gender_FEMALE:
+		return "female"
+	case pb.Gender_OTHER:
+		return "other"
+	default:
+		return "male"
+	}
+}
+
+func genderFromString(g string) pb.Gender {
+	switch g {
+	case "female":
+		return pb.Gender_FEMALE
+	case "other":
+		return pb.Gender_OTHER
+	default:
+		return pb.Gender_MALE
+	}
+}
+
 // CreateStudent creates a new Student record
 func (h *Handler) CreateStudent(ctx context.Context, req *pb.CreateStudentRequest) (*pb.CreateStudentResponse, error) {
 	defer logger.TraceFunction(ctx)()
@@ -36,6 +58,14 @@ func (h *Handler) CreateStudent(ctx context.Context, req *pb.CreateStudentReques
 		return nil, status.Error(codes.InvalidArgument, "semester_code is required")
 	}
 
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "major_code", "Major", req.MajorCode); err != nil {
+		return nil, err
+	}
+	if err := h.refs.Require(ctx, "semester_code", "Semester", req.SemesterCode); err != nil {
+		return nil, err
+	}
+
 	// Generate UUID
 	id := uuid.New().String()
 
@@ -50,15 +80,7 @@ func (h *Handler) CreateStudent(ctx context.Context, req *pb.CreateStudentReques
 	if req.Gender != nil {
 		GenderValue = *req.Gender
 	}
-	GenderStr := "male"
-	switch GenderValue {
-	case pb.Gender_MALE:
-		GenderStr = "male"
-	case pb.Gender_FEMALE:
-		GenderStr = "female"
-	case pb.Gender_OTHER:
-		GenderStr = "other"
-	}
+	GenderStr := genderToString(GenderValue)
 
 	// Insert into database
 	query := `
@@ -103,14 +125,17 @@ func (h *Handler) GetStudent(ctx context.Context, req *pb.GetStudentRequest) (*p
 	}
 
 	query := `
-		SELECT id, email, phone, username, gender, major_code, class_code, semester_code, created_at, updated_at, created_by, updated_by
+		SELECT id, email, phone, username, gender, major_code, class_code, semester_code, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM Student
 		WHERE id = ?
 	`
+	if !req.IncludeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
 
 	var entity pb.Student
-	var createdAt, updatedAt sql.NullTime
-	var updatedBy sql.NullString
+	var createdAt, updatedAt, deletedAt sql.NullTime
+	var updatedBy, deletedBy sql.NullString
 	var GenderStr string
 
 	err := h.queryRow(ctx, query, req.Id).Scan(
@@ -126,6 +151,9 @@ func (h *Handler) GetStudent(ctx context.Context, req *pb.GetStudentRequest) (*p
 		&updatedAt,
 		&entity.CreatedBy,
 		&updatedBy,
+		&entity.Version,
+		&deletedAt,
+		&deletedBy,
 	)
 
 	if err != nil {
@@ -136,16 +164,7 @@ func (h *Handler) GetStudent(ctx context.Context, req *pb.GetStudentRequest) (*p
 	}
 
 	// Convert Gender string to enum
-	switch GenderStr {
-	case "male":
-		entity.Gender = pb.Gender_MALE
-	case "female":
-		entity.Gender = pb.Gender_FEMALE
-	case "other":
-		entity.Gender = pb.Gender_OTHER
-	default:
-		entity.Gender = pb.Gender_MALE
-	}
+	entity.Gender = genderFromString(GenderStr)
 
 	if createdAt.Valid {
 		entity.CreatedAt = timestamppb.New(createdAt.Time)
@@ -156,6 +175,10 @@ func (h *Handler) GetStudent(ctx context.Context, req *pb.GetStudentRequest) (*p
 	if updatedBy.Valid {
 		entity.UpdatedBy = updatedBy.String
 	}
+	if deletedAt.Valid {
+		entity.DeletedAt = timestamppb.New(deletedAt.Time)
+		entity.DeletedBy = deletedBy.String
+	}
 
 	return &pb.GetStudentResponse{
 		Student: &entity,
@@ -191,15 +214,7 @@ func (h *Handler) UpdateStudent(ctx context.Context, req *pb.UpdateStudentReques
 	}
 	if req.Gender != nil {
 		updateFields = append(updateFields, "gender = ?")
-		GenderStr := "male"
-		switch *req.Gender {
-		case pb.Gender_MALE:
-			GenderStr = "male"
-		case pb.Gender_FEMALE:
-			GenderStr = "female"
-		case pb.Gender_OTHER:
-			GenderStr = "other"
-		}
+		GenderStr := genderToString(*req.Gender)
 		args = append(args, GenderStr)
 
 	}
@@ -219,6 +234,14 @@ func (h *Handler) UpdateStudent(ctx context.Context, req *pb.UpdateStudentReques
 
 	}
 
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "major_code", "Major", req.GetMajorCode()); err != nil {
+		return nil, err
+	}
+	if err := h.refs.Require(ctx, "semester_code", "Semester", req.GetSemesterCode()); err != nil {
+		return nil, err
+	}
+
 	if len(updateFields) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "no fields to update")
 	}
@@ -228,19 +251,27 @@ func (h *Handler) UpdateStudent(ctx context.Context, req *pb.UpdateStudentReques
 	args = append(args, req.UpdatedBy)
 	updateFields = append(updateFields, "updated_at = NOW()")
 
-	// Add id as last parameter
-	args = append(args, req.Id)
+	// Bump the version; an expected version only matches an unchanged record
+	updateFields = append(updateFields, "version = version + 1")
+	where := helper.WhereVersion(req.Id, req.Version, &args)
 
 	query := fmt.Sprintf(`
 		UPDATE Student
 		SET %s
-		WHERE id = ?
-	`, strings.Join(updateFields, ", "))
+		WHERE %s AND deleted_at IS NULL
+	`, strings.Join(updateFields, ", "), where)
 
-	_, err := h.execQuery(ctx, query, args...)
+	updated, err := h.execQuery(ctx, query, args...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update student: %v", err)
 	}
+	if n, _ := updated.RowsAffected(); n == 0 {
+		current, err := h.GetStudent(ctx, &pb.GetStudentRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		return nil, helper.VersionConflict("student", current.GetStudent(), current.GetStudent().Version)
+	}
 
 	result, err := h.GetStudent(ctx, &pb.GetStudentRequest{Id: req.Id})
 	if err != nil {
@@ -251,7 +282,7 @@ func (h *Handler) UpdateStudent(ctx context.Context, req *pb.UpdateStudentReques
 	}, nil
 }
 
-// DeleteStudent deletes a Student by ID
+// DeleteStudent soft-deletes a Student by ID
 func (h *Handler) DeleteStudent(ctx context.Context, req *pb.DeleteStudentRequest) (*pb.DeleteStudentResponse, error) {
 	defer logger.TraceFunction(ctx)()
 
@@ -259,9 +290,10 @@ func (h *Handler) DeleteStudent(ctx context.Context, req *pb.DeleteStudentReques
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	query := `DELETE FROM Student WHERE id = ?`
+	// Soft delete; the retention job removes the row later
+	query := `UPDATE Student SET deleted_at = NOW(), deleted_by = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL`
 
-	result, err := h.execQuery(ctx, query, req.Id)
+	result, err := h.execQuery(ctx, query, req.DeletedBy, req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete student: %v", err)
 	}
@@ -280,6 +312,43 @@ func (h *Handler) DeleteStudent(ctx context.Context, req *pb.DeleteStudentReques
 	}, nil
 }
 
+// RestoreStudent brings back a soft-deleted Student
+func (h *Handler) RestoreStudent(ctx context.Context, req *pb.RestoreStudentRequest) (*pb.RestoreStudentResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	query := `UPDATE Student SET deleted_at = NULL, deleted_by = NULL, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ? AND deleted_at IS NOT NULL`
+
+	result, err := h.execQuery(ctx, query, req.RestoredBy, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore student: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		// Unknown ids are NotFound; a live record has nothing to restore
+		if _, err := h.GetStudent(ctx, &pb.GetStudentRequest{Id: req.Id}); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, "student is not deleted")
+	}
+
+	restored, err := h.GetStudent(ctx, &pb.GetStudentRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreStudentResponse{
+		Student: restored.GetStudent(),
+	}, nil
+}
+
 // ListStudents lists Students with pagination and filtering
 func (h *Handler) ListStudents(ctx context.Context, req *pb.ListStudentsRequest) (*pb.ListStudentsResponse, error) {
 	defer logger.TraceFunction(ctx)()
@@ -287,8 +356,6 @@ func (h *Handler) ListStudents(ctx context.Context, req *pb.ListStudentsRequest)
 	// Default pagination
 	page := int32(1)
 	pageSize := int32(10)
-	sortBy := "created_at"
-	descending := true
 	if req.Search != nil && req.Search.Pagination != nil {
 		if req.Search.Pagination.Page > 0 {
 			page = req.Search.Pagination.Page
@@ -296,55 +363,41 @@ func (h *Handler) ListStudents(ctx context.Context, req *pb.ListStudentsRequest)
 		if req.Search.Pagination.PageSize > 0 {
 			pageSize = req.Search.Pagination.PageSize
 		}
-		if req.Search.Pagination.SortBy != "" {
-			sortBy = req.Search.Pagination.SortBy
-		}
-		descending = req.Search.Pagination.Descending
 	}
 
 	// Calculate offset
 	offset := (page - 1) * pageSize
 
 	// Build WHERE clause from filters
-	whereClause := ""
 	args := []interface{}{}
-	whiteMap := map[string]bool{
-		"id": true,
-
-		"email":         true,
-		"phone":         true,
-		"username":      true,
-		"gender":        true,
-		"major_code":    true,
-		"class_code":    true,
-		"semester_code": true,
-	}
-	if req.Search != nil && len(req.Search.Filters) > 0 {
-		whereConditions := []string{}
-		for _, filter := range req.Search.Filters {
-			if filter.GetCondition() != nil {
-				condition := filter.GetCondition()
-				if _, ok := whiteMap[condition.Field]; !ok {
-					continue
-				}
-				whereConditions = append(whereConditions, helper.BuildFilterCondition(condition, &args))
-			}
-		}
-		if len(whereConditions) > 0 {
-			whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
-		}
+	filterFields := helper.FilterFields{
+		"id":            {},
+		"email":         {FullText: true},
+		"phone":         {},
+		"username":      {FullText: true},
+		"gender":        {Type: helper.FieldEnum, Values: []string{"male", "female", "other"}},
+		"major_code":    {},
+		"class_code":    {},
+		"semester_code": {},
+		"created_at":    {Type: helper.FieldTime},
+		"updated_at":    {Type: helper.FieldTime},
+	}
+	whereClause, err := helper.BuildWhere(req.Search.GetFilters(), filterFields, &args)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
+	whereClause = helper.NotDeleted(whereClause, req.IncludeDeleted)
 
 	// Build ORDER BY clause
-	sortDirection := "ASC"
-	if descending {
-		sortDirection = "DESC"
+	orderBy, err := helper.BuildOrderBy(req.Search.GetPagination(), filterFields)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %v", err)
 	}
 
 	// Get total count
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM Student %s", whereClause)
 	var total int32
-	err := h.queryRow(ctx, countQuery, args...).Scan(&total)
+	err = h.queryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to count students: %v", err)
 	}
@@ -352,12 +405,12 @@ func (h *Handler) ListStudents(ctx context.Context, req *pb.ListStudentsRequest)
 	// Get entities with pagination
 	args = append(args, pageSize, offset)
 	query := fmt.Sprintf(`
-		SELECT id, email, phone, username, gender, major_code, class_code, semester_code, created_at, updated_at, created_by, updated_by
+		SELECT id, email, phone, username, gender, major_code, class_code, semester_code, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM Student
 		%s
-		ORDER BY %s %s
+		%s
 		LIMIT ? OFFSET ?
-	`, whereClause, sortBy, sortDirection)
+	`, whereClause, orderBy)
 
 	rows, err := h.query(ctx, query, args...)
 	if err != nil {
@@ -368,8 +421,8 @@ func (h *Handler) ListStudents(ctx context.Context, req *pb.ListStudentsRequest)
 	entities := []*pb.Student{}
 	for rows.Next() {
 		var entity pb.Student
-		var createdAt, updatedAt sql.NullTime
-		var updatedBy sql.NullString
+		var createdAt, updatedAt, deletedAt sql.NullTime
+		var updatedBy, deletedBy sql.NullString
 		var GenderStr string
 
 		err := rows.Scan(
@@ -385,22 +438,16 @@ func (h *Handler) ListStudents(ctx context.Context, req *pb.ListStudentsRequest)
 			&updatedAt,
 			&entity.CreatedBy,
 			&updatedBy,
+			&entity.Version,
+			&deletedAt,
+			&deletedBy,
 		)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan student: %v", err)
 		}
 
 		// Convert Gender string to enum
-		switch GenderStr {
-		case "male":
-			entity.Gender = pb.Gender_MALE
-		case "female":
-			entity.Gender = pb.Gender_FEMALE
-		case "other":
-			entity.Gender = pb.Gender_OTHER
-		default:
-			entity.Gender = pb.Gender_MALE
-		}
+		entity.Gender = genderFromString(GenderStr)
 
 		if createdAt.Valid {
 			entity.CreatedAt = timestamppb.New(createdAt.Time)
@@ -411,6 +458,10 @@ func (h *Handler) ListStudents(ctx context.Context, req *pb.ListStudentsRequest)
 		if updatedBy.Valid {
 			entity.UpdatedBy = updatedBy.String
 		}
+		if deletedAt.Valid {
+			entity.DeletedAt = timestamppb.New(deletedAt.Time)
+			entity.DeletedBy = deletedBy.String
+		}
 
 		entities = append(entities, &entity)
 	}
@@ -33,24 +33,21 @@ func (h *Handler) CreateTeacher(ctx context.Context, req *pb.CreateTeacherReques
 		return nil, status.Error(codes.InvalidArgument, "semester_code is required")
 	}
 
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "major_code", "Major", req.MajorCode); err != nil {
+		return nil, err
+	}
+	if err := h.refs.Require(ctx, "semester_code", "Semester", req.SemesterCode); err != nil {
+		return nil, err
+	}
+
 	// Generate UUID
 	id := uuid.New().String()
 
 	// Prepare fields
 
 	// Convert Gender enum to string
-	GenderValue := pb.Gender_MALE
-
-	GenderValue = req.Gender
-	GenderStr := "male"
-	switch GenderValue {
-	case pb.Gender_MALE:
-		GenderStr = "male"
-	case pb.Gender_FEMALE:
-		GenderStr = "female"
-	case pb.Gender_OTHER:
-		GenderStr = "other"
-	}
+	GenderStr := genderToString(req.Gender)
 
 	// Insert into database
 	query := `
@@ -93,14 +90,17 @@ func (h *Handler) GetTeacher(ctx context.Context, req *pb.GetTeacherRequest) (*p
 	}
 
 	query := `
-		SELECT id, email, username, gender, major_code, semester_code, created_at, updated_at, created_by, updated_by
+		SELECT id, email, username, gender, major_code, semester_code, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM Teacher
 		WHERE id = ?
 	`
+	if !req.IncludeDeleted {
+		query += " AND deleted_at IS NULL"
+	}
 
 	var entity pb.Teacher
-	var createdAt, updatedAt sql.NullTime
-	var updatedBy sql.NullString
+	var createdAt, updatedAt, deletedAt sql.NullTime
+	var updatedBy, deletedBy sql.NullString
 	var GenderStr string
 
 	err := h.queryRow(ctx, query, req.Id).Scan(
@@ -114,6 +114,9 @@ func (h *Handler) GetTeacher(ctx context.Context, req *pb.GetTeacherRequest) (*p
 		&updatedAt,
 		&entity.CreatedBy,
 		&updatedBy,
+		&entity.Version,
+		&deletedAt,
+		&deletedBy,
 	)
 
 	if err != nil {
@@ -124,16 +127,7 @@ func (h *Handler) GetTeacher(ctx context.Context, req *pb.GetTeacherRequest) (*p
 	}
 
 	// Convert Gender string to enum
-	switch GenderStr {
-	case "male":
-		entity.Gender = pb.Gender_MALE
-	case "female":
-		entity.Gender = pb.Gender_FEMALE
-	case "other":
-		entity.Gender = pb.Gender_OTHER
-	default:
-		entity.Gender = pb.Gender_MALE
-	}
+	entity.Gender = genderFromString(GenderStr)
 
 	if createdAt.Valid {
 		entity.CreatedAt = timestamppb.New(createdAt.Time)
@@ -144,6 +138,10 @@ func (h *Handler) GetTeacher(ctx context.Context, req *pb.GetTeacherRequest) (*p
 	if updatedBy.Valid {
 		entity.UpdatedBy = updatedBy.String
 	}
+	if deletedAt.Valid {
+		entity.DeletedAt = timestamppb.New(deletedAt.Time)
+		entity.DeletedBy = deletedBy.String
+	}
 
 	return &pb.GetTeacherResponse{
 		Teacher: &entity,
@@ -174,15 +172,7 @@ func (h *Handler) UpdateTeacher(ctx context.Context, req *pb.UpdateTeacherReques
 	}
 	if req.Gender != nil {
 		updateFields = append(updateFields, "gender = ?")
-		GenderStr := "male"
-		switch *req.Gender {
-		case pb.Gender_MALE:
-			GenderStr = "male"
-		case pb.Gender_FEMALE:
-			GenderStr = "female"
-		case pb.Gender_OTHER:
-			GenderStr = "other"
-		}
+		GenderStr := genderToString(*req.Gender)
 		args = append(args, GenderStr)
 
 	}
@@ -197,6 +187,14 @@ func (h *Handler) UpdateTeacher(ctx context.Context, req *pb.UpdateTeacherReques
 
 	}
 
+	// Check the references to other services' tables
+	if err := h.refs.Require(ctx, "major_code", "Major", req.GetMajorCode()); err != nil {
+		return nil, err
+	}
+	if err := h.refs.Require(ctx, "semester_code", "Semester", req.GetSemesterCode()); err != nil {
+		return nil, err
+	}
+
 	if len(updateFields) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "no fields to update")
 	}
@@ -206,19 +204,27 @@ func (h *Handler) UpdateTeacher(ctx context.Context, req *pb.UpdateTeacherReques
 	args = append(args, req.UpdatedBy)
 	updateFields = append(updateFields, "updated_at = NOW()")
 
-	// Add id as last parameter
-	args = append(args, req.Id)
+	// Bump the version; an expected version only matches an unchanged record
+	updateFields = append(updateFields, "version = version + 1")
+	where := helper.WhereVersion(req.Id, req.Version, &args)
 
 	query := fmt.Sprintf(`
 		UPDATE Teacher
 		SET %s
-		WHERE id = ?
-	`, strings.Join(updateFields, ", "))
+		WHERE %s AND deleted_at IS NULL
+	`, strings.Join(updateFields, ", "), where)
 
-	_, err := h.execQuery(ctx, query, args...)
+	updated, err := h.execQuery(ctx, query, args...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update teacher: %v", err)
 	}
+	if n, _ := updated.RowsAffected(); n == 0 {
+		current, err := h.GetTeacher(ctx, &pb.GetTeacherRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		return nil, helper.VersionConflict("teacher", current.GetTeacher(), current.GetTeacher().Version)
+	}
 
 	result, err := h.GetTeacher(ctx, &pb.GetTeacherRequest{Id: req.Id})
 	if err != nil {
@@ -229,7 +235,7 @@ func (h *Handler) UpdateTeacher(ctx context.Context, req *pb.UpdateTeacherReques
 	}, nil
 }
 
-// DeleteTeacher deletes a Teacher by ID
+// DeleteTeacher soft-deletes a Teacher by ID
 func (h *Handler) DeleteTeacher(ctx context.Context, req *pb.DeleteTeacherRequest) (*pb.DeleteTeacherResponse, error) {
 	defer logger.TraceFunction(ctx)()
 
@@ -237,9 +243,10 @@ func (h *Handler) DeleteTeacher(ctx context.Context, req *pb.DeleteTeacherReques
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	query := `DELETE FROM Teacher WHERE id = ?`
+	// Soft delete; the retention job removes the row later
+	query := `UPDATE Teacher SET deleted_at = NOW(), deleted_by = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL`
 
-	result, err := h.execQuery(ctx, query, req.Id)
+	result, err := h.execQuery(ctx, query, req.DeletedBy, req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete teacher: %v", err)
 	}
@@ -258,6 +265,43 @@ func (h *Handler) DeleteTeacher(ctx context.Context, req *pb.DeleteTeacherReques
 	}, nil
 }
 
+// RestoreTeacher brings back a soft-deleted Teacher
+func (h *Handler) RestoreTeacher(ctx context.Context, req *pb.RestoreTeacherRequest) (*pb.RestoreTeacherResponse, error) {
+	defer logger.TraceFunction(ctx)()
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	query := `UPDATE Teacher SET deleted_at = NULL, deleted_by = NULL, updated_by = ?, updated_at = NOW(), version = version + 1 WHERE id = ? AND deleted_at IS NOT NULL`
+
+	result, err := h.execQuery(ctx, query, req.RestoredBy, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore teacher: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		// Unknown ids are NotFound; a live record has nothing to restore
+		if _, err := h.GetTeacher(ctx, &pb.GetTeacherRequest{Id: req.Id}); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, "teacher is not deleted")
+	}
+
+	restored, err := h.GetTeacher(ctx, &pb.GetTeacherRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreTeacherResponse{
+		Teacher: restored.GetTeacher(),
+	}, nil
+}
+
 // ListTeachers lists Teachers with pagination and filtering
 func (h *Handler) ListTeachers(ctx context.Context, req *pb.ListTeachersRequest) (*pb.ListTeachersResponse, error) {
 	defer logger.TraceFunction(ctx)()
@@ -265,8 +309,6 @@ func (h *Handler) ListTeachers(ctx context.Context, req *pb.ListTeachersRequest)
 	// Default pagination
 	page := int32(1)
 	pageSize := int32(10)
-	sortBy := "created_at"
-	descending := true
 	if req.Search != nil && req.Search.Pagination != nil {
 		if req.Search.Pagination.Page > 0 {
 			page = req.Search.Pagination.Page
@@ -274,53 +316,39 @@ func (h *Handler) ListTeachers(ctx context.Context, req *pb.ListTeachersRequest)
 		if req.Search.Pagination.PageSize > 0 {
 			pageSize = req.Search.Pagination.PageSize
 		}
-		if req.Search.Pagination.SortBy != "" {
-			sortBy = req.Search.Pagination.SortBy
-		}
-		descending = req.Search.Pagination.Descending
 	}
 
 	// Calculate offset
 	offset := (page - 1) * pageSize
 
 	// Build WHERE clause from filters
-	whereClause := ""
 	args := []interface{}{}
-	whiteMap := map[string]bool{
-		"id": true,
-
-		"email":         true,
-		"username":      true,
-		"gender":        true,
-		"major_code":    true,
-		"semester_code": true,
-	}
-	if req.Search != nil && len(req.Search.Filters) > 0 {
-		whereConditions := []string{}
-		for _, filter := range req.Search.Filters {
-			if filter.GetCondition() != nil {
-				condition := filter.GetCondition()
-				if _, ok := whiteMap[condition.Field]; !ok {
-					continue
-				}
-				whereConditions = append(whereConditions, helper.BuildFilterCondition(condition, &args))
-			}
-		}
-		if len(whereConditions) > 0 {
-			whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
-		}
+	filterFields := helper.FilterFields{
+		"id":            {},
+		"email":         {FullText: true},
+		"username":      {FullText: true},
+		"gender":        {Type: helper.FieldEnum, Values: []string{"male", "female", "other"}},
+		"major_code":    {},
+		"semester_code": {},
+		"created_at":    {Type: helper.FieldTime},
+		"updated_at":    {Type: helper.FieldTime},
+	}
+	whereClause, err := helper.BuildWhere(req.Search.GetFilters(), filterFields, &args)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
+	whereClause = helper.NotDeleted(whereClause, req.IncludeDeleted)
 
 	// Build ORDER BY clause
-	sortDirection := "ASC"
-	if descending {
-		sortDirection = "DESC"
+	orderBy, err := helper.BuildOrderBy(req.Search.GetPagination(), filterFields)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort: %v", err)
 	}
 
 	// Get total count
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM Teacher %s", whereClause)
 	var total int32
-	err := h.queryRow(ctx, countQuery, args...).Scan(&total)
+	err = h.queryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to count teachers: %v", err)
 	}
@@ -328,12 +356,12 @@ func (h *Handler) ListTeachers(ctx context.Context, req *pb.ListTeachersRequest)
 	// Get entities with pagination
 	args = append(args, pageSize, offset)
 	query := fmt.Sprintf(`
-		SELECT id, email, username, gender, major_code, semester_code, created_at, updated_at, created_by, updated_by
+		SELECT id, email, username, gender, major_code, semester_code, created_at, updated_at, created_by, updated_by, version, deleted_at, deleted_by
 		FROM Teacher
 		%s
-		ORDER BY %s %s
+		%s
 		LIMIT ? OFFSET ?
-	`, whereClause, sortBy, sortDirection)
+	`, whereClause, orderBy)
 
 	rows, err := h.query(ctx, query, args...)
 	if err != nil {
@@ -344,8 +372,8 @@ func (h *Handler) ListTeachers(ctx context.Context, req *pb.ListTeachersRequest)
 	entities := []*pb.Teacher{}
 	for rows.Next() {
 		var entity pb.Teacher
-		var createdAt, updatedAt sql.NullTime
-		var updatedBy sql.NullString
+		var createdAt, updatedAt, deletedAt sql.NullTime
+		var updatedBy, deletedBy sql.NullString
 		var GenderStr string
 
 		err := rows.Scan(
@@ -359,22 +387,16 @@ func (h *Handler) ListTeachers(ctx context.Context, req *pb.ListTeachersRequest)
 			&updatedAt,
 			&entity.CreatedBy,
 			&updatedBy,
+			&entity.Version,
+			&deletedAt,
+			&deletedBy,
 		)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan teacher: %v", err)
 		}
 
 		// Convert Gender string to enum
-		switch GenderStr {
-		case "male":
-			entity.Gender = pb.Gender_MALE
-		case "female":
-			entity.Gender = pb.Gender_FEMALE
-		case "other":
-			entity.Gender = pb.Gender_OTHER
-		default:
-			entity.Gender = pb.Gender_MALE
-		}
+		entity.Gender = genderFromString(GenderStr)
 
 		if createdAt.Valid {
 			entity.CreatedAt = timestamppb.New(createdAt.Time)
@@ -385,6 +407,10 @@ func (h *Handler) ListTeachers(ctx context.Context, req *pb.ListTeachersRequest)
 		if updatedBy.Valid {
 			entity.UpdatedBy = updatedBy.String
 		}
+		if deletedAt.Valid {
+			entity.DeletedAt = timestamppb.New(deletedAt.Time)
+			entity.DeletedBy = deletedBy.String
+		}
 
 		entities = append(entities, &entity)
 	}
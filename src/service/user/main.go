@@ -1,15 +1,22 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"os"
 
+	pbAcademic "thaily/proto/academic"
 	pb "thaily/proto/user"
+	"thaily/src/pkg/audit"
 	"thaily/src/pkg/database"
 	"thaily/src/pkg/logger"
+	"thaily/src/pkg/migrate"
+	"thaily/src/pkg/reference"
+	"thaily/src/pkg/retention"
 	"thaily/src/pkg/tls"
 	"thaily/src/service/user/handler"
+	"thaily/src/service/user/migrations"
 
 	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
@@ -28,11 +35,50 @@ func main() {
 	defer logger.GetFileLogger().Close()
 
 	// Initialize database
-	if err := database.InitDB(); err != nil {
+	if err := database.InitDB("user"); err != nil {
 		log.Fatalf("Failed to initialize database: %v", err)
 	}
 	defer database.CloseDB()
 
+	// Run the migrate command, or apply pending migrations on startup
+	migrator, err := migrate.New(database.GetDB(), "user", migrations.FS)
+	if err != nil {
+		log.Fatalf("Failed to load migrations: %v", err)
+	}
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := migrate.Run(context.Background(), database.GetDB(), migrator, handler.EnumColumns(), os.Args[2:]); err != nil {
+			log.Fatalf("Migrate failed: %v", err)
+		}
+		return
+	}
+	if err := migrate.OnStartup(context.Background(), migrator); err != nil {
+		log.Fatalf("Failed to apply migrations: %v", err)
+	}
+
+	// Check references to the tables of other services, which own them
+	refs := reference.NewResolver()
+	defer refs.Close()
+	if err := refs.Dial("ACADEMIC_SERVICE_ADDR", "academic-service", pbAcademic.AcademicService_CheckReferences_FullMethodName, "Major", "Semester"); err != nil {
+		log.Fatalf("Failed to connect to the academic service: %v", err)
+	}
+	if len(os.Args) > 1 && os.Args[1] == "orphans" {
+		if err := refs.Report(context.Background(), database.GetDB(), handler.References()); err != nil {
+			log.Fatalf("Orphan report failed: %v", err)
+		}
+		return
+	}
+	go refs.RunReport(context.Background(), database.GetDB(), handler.References(), reference.ReportInterval())
+
+	// Purge rows soft-deleted longer than the retention period
+	go retention.Run(context.Background(), database.GetDB(), handler.SoftDeleted(), retention.Period(), retention.Interval())
+
+	// Append every mutation to the audit log
+	auditStore, closeAudit, err := audit.Connect(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to connect to the audit log: %v", err)
+	}
+	defer closeAudit()
+
 	// Verify TLS certificates exist
 	if err := tls.VerifyCertificatesExist("user"); err != nil {
 		log.Fatalf("TLS certificate verification failed: %v", err)
@@ -57,10 +103,13 @@ func main() {
 
 	grpcServer := grpc.NewServer(
 		grpc.Creds(creds),
-		grpc.UnaryInterceptor(logger.UnaryServerInterceptor()),
+		grpc.ChainUnaryInterceptor(
+			logger.UnaryServerInterceptor(),
+			audit.UnaryServerInterceptor(auditStore, database.GetDB(), "user", handler.Audited()),
+		),
 	)
 
-	h := handler.NewHandler(database.GetDB())
+	h := handler.NewHandler(database.GetDB(), refs)
 	pb.RegisterUserServiceServer(grpcServer, h)
 
 	log.Printf("UserService listening on port %s", port)
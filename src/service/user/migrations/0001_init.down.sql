@@ -0,0 +1,6 @@
+SET FOREIGN_KEY_CHECKS = 0;
+
+DROP TABLE IF EXISTS `Teacher`;
+DROP TABLE IF EXISTS `Student`;
+
+SET FOREIGN_KEY_CHECKS = 1;
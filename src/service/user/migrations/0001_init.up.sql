@@ -0,0 +1,40 @@
+-- Initial user service schema
+--
+-- References to other services' tables are not foreign keys, each
+-- service owns its schema:
+--   Student.major_code -> Major (academic service)
+--   Student.semester_code -> Semester (academic service)
+--   Teacher.major_code -> Major (academic service)
+--   Teacher.semester_code -> Semester (academic service)
+
+CREATE TABLE `Student` (
+  `id` varchar(255) PRIMARY KEY,
+  `email` varchar(255) COLLATE utf8mb4_0900_ai_ci NOT NULL,
+  `phone` varchar(255) NOT NULL,
+  `username` varchar(255) COLLATE utf8mb4_0900_ai_ci NOT NULL,
+  `gender` ENUM ('male', 'female', 'other') NOT NULL,
+  `major_code` varchar(255) NOT NULL,
+  `class_code` varchar(255),
+  `semester_code` varchar(255) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL,
+  FULLTEXT KEY `ft_student_username` (`username`),
+  FULLTEXT KEY `ft_student_email` (`email`)
+);
+
+CREATE TABLE `Teacher` (
+  `id` varchar(255) PRIMARY KEY,
+  `email` varchar(255) COLLATE utf8mb4_0900_ai_ci NOT NULL,
+  `username` varchar(255) COLLATE utf8mb4_0900_ai_ci NOT NULL,
+  `gender` ENUM ('male', 'female', 'other') NOT NULL,
+  `major_code` varchar(255) NOT NULL,
+  `semester_code` varchar(255) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `created_by` varchar(255) NOT NULL,
+  `updated_by` varchar(255) NOT NULL,
+  FULLTEXT KEY `ft_teacher_username` (`username`),
+  FULLTEXT KEY `ft_teacher_email` (`email`)
+);
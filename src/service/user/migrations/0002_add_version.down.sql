@@ -0,0 +1,2 @@
+ALTER TABLE `Teacher` DROP COLUMN `version`;
+ALTER TABLE `Student` DROP COLUMN `version`;
@@ -0,0 +1,5 @@
+-- Optimistic concurrency: every update bumps `version`, and an update that
+-- carries the expected version only applies to a record still at it
+
+ALTER TABLE `Student` ADD COLUMN `version` INT NOT NULL DEFAULT 1;
+ALTER TABLE `Teacher` ADD COLUMN `version` INT NOT NULL DEFAULT 1;
@@ -0,0 +1,2 @@
+ALTER TABLE `Teacher` DROP KEY `idx_teacher_deleted_at`, DROP COLUMN `deleted_by`, DROP COLUMN `deleted_at`;
+ALTER TABLE `Student` DROP KEY `idx_student_deleted_at`, DROP COLUMN `deleted_by`, DROP COLUMN `deleted_at`;
@@ -0,0 +1,6 @@
+ALTER TABLE `Student` DROP INDEX `ft_student_username`, DROP INDEX `ft_student_email`;
+ALTER TABLE `Student` ADD FULLTEXT KEY `ft_student_username` (`username`);
+ALTER TABLE `Student` ADD FULLTEXT KEY `ft_student_email` (`email`);
+ALTER TABLE `Teacher` DROP INDEX `ft_teacher_username`, DROP INDEX `ft_teacher_email`;
+ALTER TABLE `Teacher` ADD FULLTEXT KEY `ft_teacher_username` (`username`);
+ALTER TABLE `Teacher` ADD FULLTEXT KEY `ft_teacher_email` (`email`);
@@ -0,0 +1,12 @@
+-- Usernames and emails are indexed with the ngram parser. The default parser
+-- skips words shorter than innodb_ft_min_token_size (3), which drops most
+-- Vietnamese syllables ("hà", "lý", "vũ"); ngram indexes every run of
+-- ngram_token_size (2) characters, compared in the columns'
+-- accent-insensitive collation
+
+ALTER TABLE `Student` DROP INDEX `ft_student_username`, DROP INDEX `ft_student_email`;
+ALTER TABLE `Student` ADD FULLTEXT KEY `ft_student_username` (`username`) WITH PARSER ngram;
+ALTER TABLE `Student` ADD FULLTEXT KEY `ft_student_email` (`email`) WITH PARSER ngram;
+ALTER TABLE `Teacher` DROP INDEX `ft_teacher_username`, DROP INDEX `ft_teacher_email`;
+ALTER TABLE `Teacher` ADD FULLTEXT KEY `ft_teacher_username` (`username`) WITH PARSER ngram;
+ALTER TABLE `Teacher` ADD FULLTEXT KEY `ft_teacher_email` (`email`) WITH PARSER ngram;